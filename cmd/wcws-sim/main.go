@@ -0,0 +1,85 @@
+// Command wcws-sim replays conversations written as YAML scripts through the
+// webhook, without Dialogflow, Firestore or OpenCage.
+//
+//	wcws-sim [-q] script.yaml...
+//
+// Every turn builds a Dialogflow request from its action, parameters,
+// contexts and source, carries the output contexts over to the next turn and
+// prints the fulfillment. The process exits with status 1 if an expectation
+// of a turn fails.
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+)
+
+func main() {
+	quiet := flag.Bool("q", false, "only print failed expectations")
+	flag.Usage = func() {
+		fmt.Fprintln(os.Stderr, "usage: wcws-sim [-q] script.yaml...")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if flag.NArg() == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+	failed := false
+	for _, path := range flag.Args() {
+		ok, err := runScript(os.Stdout, path, *quiet)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(2)
+		}
+		failed = failed || !ok
+	}
+	if failed {
+		os.Exit(1)
+	}
+}
+
+// runScript plays the script and reports whether every expectation passed
+func runScript(w io.Writer, path string, quiet bool) (bool, error) {
+	script, err := LoadScript(path)
+	if err != nil {
+		return false, err
+	}
+	sim, err := NewSimulator(script)
+	if err != nil {
+		return false, err
+	}
+	ok := true
+	err = sim.Run(func(i int, r Result) {
+		ok = ok && len(r.Failures) == 0
+		if quiet && len(r.Failures) == 0 {
+			return
+		}
+		name := r.Turn.Name
+		if name == "" {
+			name = r.Turn.Action
+		}
+		fmt.Fprintf(w, "--- %s turn %d: %s (%s)\n", path, i+1, name, r.Turn.Source)
+		if !quiet {
+			var out bytes.Buffer
+			if err := json.Indent(&out, r.Body, "", "  "); err != nil {
+				out.Write(r.Body)
+			}
+			fmt.Fprintln(w, out.String())
+		}
+		for _, f := range r.Failures {
+			fmt.Fprintln(w, "FAIL:", f)
+		}
+	})
+	if err != nil {
+		return false, fmt.Errorf("%s: %v", path, err)
+	}
+	if !quiet {
+		fmt.Fprintf(w, "--- %s: %d transaction(s) saved\n", path, len(sim.Transactions()))
+	}
+	return ok, nil
+}
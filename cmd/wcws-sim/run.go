@@ -0,0 +1,248 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+
+	"wcws/dialogflow"
+	"wcws/webhook"
+)
+
+// Result is the answer of the webhook to a turn
+type Result struct {
+	Turn        Turn
+	Request     dialogflow.Request
+	Fulfillment *dialogflow.Fulfillment
+	Body        []byte
+	Failures    []string
+}
+
+// Simulator replays scripts through the webhook handlers, with an in-memory
+// store and a fake geocoder
+type Simulator struct {
+	script   *Script
+	store    *webhook.MemoryStore
+	e        *echo.Echo
+	contexts []*dialogflow.Context
+}
+
+// NewSimulator returns a simulator for the script
+func NewSimulator(s *Script) (*Simulator, error) {
+	events, err := s.StoreEvents()
+	if err != nil {
+		return nil, err
+	}
+	store := webhook.NewMemoryStore(events)
+	geocoder := webhook.GeocoderFunc(func(ctx context.Context, c dialogflow.Coordinates) (string, error) {
+		if s.Geocoder.Error != "" {
+			return "", errors.New(s.Geocoder.Error)
+		}
+		if s.Geocoder.Address != "" {
+			return s.Geocoder.Address, nil
+		}
+		return fmt.Sprintf("%f, %f", c.Latitude, c.Longitude), nil
+	})
+	e := echo.New()
+	webhook.New(store, geocoder).Register(e)
+	return &Simulator{script: s, store: store, e: e}, nil
+}
+
+// Run plays every turn of the script and calls f with the result of each one
+func (sim *Simulator) Run(f func(i int, r Result)) error {
+	for i, t := range sim.script.Turns {
+		r, err := sim.play(i, t)
+		if err != nil {
+			return fmt.Errorf("turn %d: %v", i+1, err)
+		}
+		f(i, r)
+	}
+	return nil
+}
+
+func (sim *Simulator) play(i int, t Turn) (Result, error) {
+	sim.ageContexts()
+	if err := sim.mergeContexts(t.Contexts); err != nil {
+		return Result{}, err
+	}
+	dr := sim.request(i, t)
+	body, err := json.Marshal(dr)
+	if err != nil {
+		return Result{}, err
+	}
+	req := httptest.NewRequest(http.MethodPost, "/webhook", bytes.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	sim.e.ServeHTTP(rec, req)
+	if rec.Code != http.StatusOK {
+		return Result{}, fmt.Errorf("webhook answered %d: %s", rec.Code, rec.Body.String())
+	}
+
+	r := Result{Turn: t, Request: dr, Body: rec.Body.Bytes()}
+	if strings.TrimSpace(rec.Body.String()) != "null" {
+		r.Fulfillment = &dialogflow.Fulfillment{}
+		if err := json.Unmarshal(r.Body, r.Fulfillment); err != nil {
+			return Result{}, fmt.Errorf("invalid fulfillment: %v", err)
+		}
+		sim.applyContexts(r.Fulfillment.OutputContexts)
+	}
+	r.Failures = sim.check(t.Expect, r)
+	return r, nil
+}
+
+func (sim *Simulator) request(i int, t Turn) dialogflow.Request {
+	dr := dialogflow.Request{
+		Session:    sim.script.Session,
+		ResponseID: fmt.Sprintf("wcws-sim-%d", i+1),
+		QueryResult: dialogflow.QueryResult{
+			QueryText:                t.Query,
+			LanguageCode:             "en",
+			Action:                   t.Action,
+			Parameters:               t.Parameters,
+			AllRequiredParamsPresent: true,
+			OutputContexts:           sim.contexts,
+			Intent:                   dialogflow.Intent{DisplayName: t.Action},
+		},
+		OriginalDetectIntentRequest: dialogflow.OriginalDetectIntentRequest{Source: t.Source},
+	}
+	if t.Location != nil {
+		c := dialogflow.Coordinates{Latitude: t.Location.Latitude, Longitude: t.Location.Longitude}
+		p := &dr.OriginalDetectIntentRequest.Payload
+		switch dialogflow.PlatformOf(dr) {
+		case dialogflow.Facebook:
+			p.PostBack = map[string]interface{}{"data": map[string]interface{}{
+				"lat":  strconv.FormatFloat(c.Latitude, 'f', -1, 64),
+				"long": strconv.FormatFloat(c.Longitude, 'f', -1, 64),
+			}}
+		case dialogflow.Telegram:
+			p.Data = dialogflow.TelegramUpdate{Message: &dialogflow.TelegramIncoming{
+				Location: &dialogflow.TelegramLocation{Latitude: c.Latitude, Longitude: c.Longitude},
+			}}
+		default:
+			p.Device = &dialogflow.DeviceInfo{LocationInfo: dialogflow.LocationInfo{Coordinates: c}}
+		}
+	}
+	return dr
+}
+
+// ageContexts decrements the lifespan of the contexts like the agent does at
+// every turn
+func (sim *Simulator) ageContexts() {
+	var contexts []*dialogflow.Context
+	for _, c := range sim.contexts {
+		c.LifespanCount--
+		if c.LifespanCount > 0 {
+			contexts = append(contexts, c)
+		}
+	}
+	sim.contexts = contexts
+}
+
+func (sim *Simulator) mergeContexts(contexts []TurnContext) error {
+	for _, tc := range contexts {
+		name := fmt.Sprintf("%s/contexts/%s", sim.script.Session, tc.Name)
+		params := map[string]interface{}{}
+		lifespan := tc.Lifespan
+		if existing := sim.context(name); existing != nil {
+			if err := json.Unmarshal(existing.Parameters, &params); err != nil {
+				return err
+			}
+			if lifespan == 0 {
+				lifespan = existing.LifespanCount
+			}
+		}
+		if lifespan == 0 {
+			lifespan = 5
+		}
+		for k, v := range tc.Parameters {
+			params[k] = v
+		}
+		b, err := json.Marshal(params)
+		if err != nil {
+			return err
+		}
+		sim.setContext(&dialogflow.Context{Name: name, LifespanCount: lifespan, Parameters: b})
+	}
+	return nil
+}
+
+// applyContexts saves the contexts returned by the webhook, a context without
+// lifespan is removed
+func (sim *Simulator) applyContexts(contexts dialogflow.Contexts) {
+	for _, c := range contexts {
+		if c.LifespanCount == 0 {
+			sim.removeContext(c.Name)
+			continue
+		}
+		sim.setContext(c)
+	}
+}
+
+func (sim *Simulator) context(name string) *dialogflow.Context {
+	for _, c := range sim.contexts {
+		if c.Name == name {
+			return c
+		}
+	}
+	return nil
+}
+
+func (sim *Simulator) setContext(ctx *dialogflow.Context) {
+	for i, c := range sim.contexts {
+		if c.Name == ctx.Name {
+			sim.contexts[i] = ctx
+			return
+		}
+	}
+	sim.contexts = append(sim.contexts, ctx)
+}
+
+func (sim *Simulator) removeContext(name string) {
+	var contexts []*dialogflow.Context
+	for _, c := range sim.contexts {
+		if c.Name != name {
+			contexts = append(contexts, c)
+		}
+	}
+	sim.contexts = contexts
+}
+
+func (sim *Simulator) check(expect Expect, r Result) []string {
+	var failures []string
+	if expect.Error != (r.Fulfillment == nil) {
+		if expect.Error {
+			failures = append(failures, "expected an error response, got a fulfillment")
+		} else {
+			failures = append(failures, "expected a fulfillment, got an error response")
+		}
+	}
+	for _, s := range expect.Contains {
+		if !bytes.Contains(r.Body, []byte(s)) {
+			failures = append(failures, fmt.Sprintf("expected the fulfillment to contain %q", s))
+		}
+	}
+	for _, s := range expect.NotContains {
+		if bytes.Contains(r.Body, []byte(s)) {
+			failures = append(failures, fmt.Sprintf("expected the fulfillment not to contain %q", s))
+		}
+	}
+	if expect.Transactions != nil {
+		if n := len(sim.store.AddedTransactions()); n != *expect.Transactions {
+			failures = append(failures, fmt.Sprintf("expected %d saved transactions, got %d", *expect.Transactions, n))
+		}
+	}
+	return failures
+}
+
+// Transactions returns the donations saved during the simulation
+func (sim *Simulator) Transactions() []webhook.Transactions {
+	return sim.store.AddedTransactions()
+}
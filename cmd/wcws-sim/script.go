@@ -0,0 +1,154 @@
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"time"
+
+	"gopkg.in/yaml.v2"
+
+	"wcws/webhook"
+)
+
+// Script is a conversation replayed against the webhook
+type Script struct {
+	Session  string        `yaml:"session"`
+	Source   string        `yaml:"source"`
+	Events   []scriptEvent `yaml:"events"`
+	Geocoder struct {
+		Address string `yaml:"address"`
+		Error   string `yaml:"error"`
+	} `yaml:"geocoder"`
+	Turns []Turn `yaml:"turns"`
+}
+
+type scriptEvent struct {
+	Name        string `yaml:"name"`
+	Address     string `yaml:"address"`
+	Time        string `yaml:"time"`
+	Description string `yaml:"description"`
+	Inactive    bool   `yaml:"inactive"`
+}
+
+// Turn is a single webhook call. Contexts are merged into the contexts carried
+// over from the previous turns
+type Turn struct {
+	Name       string                 `yaml:"name"`
+	Source     string                 `yaml:"source"`
+	Query      string                 `yaml:"query"`
+	Action     string                 `yaml:"action"`
+	Parameters map[string]interface{} `yaml:"parameters"`
+	Contexts   []TurnContext          `yaml:"contexts"`
+	Location   *Location              `yaml:"location"`
+	Expect     Expect                 `yaml:"expect"`
+}
+
+// TurnContext is a context set by the agent before the turn
+type TurnContext struct {
+	Name       string                 `yaml:"name"`
+	Lifespan   int                    `yaml:"lifespan"`
+	Parameters map[string]interface{} `yaml:"parameters"`
+}
+
+// Location is the location shared by the user during the turn
+type Location struct {
+	Latitude  float64 `yaml:"latitude"`
+	Longitude float64 `yaml:"longitude"`
+}
+
+// Expect holds the assertions made on the answer of a turn
+type Expect struct {
+	Error        bool     `yaml:"error"`
+	Contains     []string `yaml:"contains"`
+	NotContains  []string `yaml:"not_contains"`
+	Transactions *int     `yaml:"transactions"`
+}
+
+// LoadScript reads and checks a script file
+func LoadScript(path string) (*Script, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	s := &Script{}
+	if err := yaml.UnmarshalStrict(b, s); err != nil {
+		return nil, fmt.Errorf("%s: %v", path, err)
+	}
+	if s.Session == "" {
+		s.Session = "projects/wcws-sim/agent/sessions/sim"
+	}
+	if s.Source == "" {
+		s.Source = "google"
+	}
+	for i, t := range s.Turns {
+		if t.Action == "" {
+			return nil, fmt.Errorf("%s: turn %d has no action", path, i+1)
+		}
+		if t.Source == "" {
+			s.Turns[i].Source = s.Source
+		}
+		s.Turns[i].Parameters = normalize(t.Parameters).(map[string]interface{})
+		for j, c := range t.Contexts {
+			s.Turns[i].Contexts[j].Parameters = normalize(c.Parameters).(map[string]interface{})
+		}
+	}
+	return s, nil
+}
+
+// StoreEvents returns the events of the script as saved in the store
+func (s *Script) StoreEvents() ([]webhook.Event, error) {
+	var events []webhook.Event
+	for _, e := range s.Events {
+		event := webhook.Event{
+			Name:        e.Name,
+			Address:     e.Address,
+			Description: e.Description,
+			Status:      !e.Inactive,
+		}
+		if e.Time != "" {
+			t, err := time.Parse(time.RFC3339, e.Time)
+			if err != nil {
+				return nil, fmt.Errorf("event %q: %v", e.Name, err)
+			}
+			event.Time = t
+		}
+		events = append(events, event)
+	}
+	return events, nil
+}
+
+// normalize converts the maps decoded by yaml to maps with string keys, so
+// they can be marshalled to JSON
+func normalize(v interface{}) interface{} {
+	switch v := v.(type) {
+	case nil:
+		return map[string]interface{}{}
+	case map[interface{}]interface{}:
+		rs := make(map[string]interface{}, len(v))
+		for k, val := range v {
+			rs[fmt.Sprint(k)] = normalizeValue(val)
+		}
+		return rs
+	case map[string]interface{}:
+		rs := make(map[string]interface{}, len(v))
+		for k, val := range v {
+			rs[k] = normalizeValue(val)
+		}
+		return rs
+	}
+	return v
+}
+
+func normalizeValue(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[interface{}]interface{}, map[string]interface{}:
+		return normalize(v)
+	case []interface{}:
+		rs := make([]interface{}, len(v))
+		for i, val := range v {
+			rs[i] = normalizeValue(val)
+		}
+		return rs
+	}
+	return v
+}
@@ -0,0 +1,491 @@
+// Package config loads the configuration of the webhook from a YAML file, the
+// environment and the command line, in that order of precedence
+package config
+
+import (
+	"encoding"
+	"errors"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/mail"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v2"
+)
+
+// Config is the configuration of the webhook. Every setting can be written in
+// the file, at its YAML path, in its environment variable, and as a flag
+// named after its path, like -opencage.api-key
+type Config struct {
+	// Name tells the deployments apart, like staging or production
+	Name string `yaml:"name" env:"WCWS_ENV"`
+	Port int    `yaml:"port" env:"PORT"`
+	// ShutdownTimeout is how long the requests in flight have to finish on
+	// SIGTERM
+	ShutdownTimeout time.Duration `yaml:"shutdown_timeout" env:"SHUTDOWN_TIMEOUT"`
+	// DrainDelay is how long the server keeps serving on SIGTERM while
+	// /readyz reports it is draining. It must be longer than the period of
+	// the readiness probe, for the load balancer to stop sending requests
+	// before they are refused
+	DrainDelay time.Duration `yaml:"drain_delay" env:"DRAIN_DELAY"`
+	// GracePeriod is how long the platform waits after SIGTERM before
+	// killing the server, the drain delay and the shutdown timeout must fit
+	// in it
+	GracePeriod time.Duration `yaml:"grace_period" env:"GRACE_PERIOD"`
+
+	Firestore     Firestore     `yaml:"firestore"`
+	OpenCage      OpenCage      `yaml:"opencage"`
+	GoogleActions GoogleActions `yaml:"google_actions"`
+	RateLimits    RateLimits    `yaml:"rate_limits"`
+	Alerts        Alerts        `yaml:"alerts"`
+	SMS           SMS           `yaml:"sms"`
+	SMTP          SMTP          `yaml:"smtp"`
+	Zalo          Zalo          `yaml:"zalo"`
+	Tracing       Tracing       `yaml:"tracing"`
+	Logging       Logging       `yaml:"logging"`
+	Transcripts   Transcripts   `yaml:"transcripts"`
+	Admin         Admin         `yaml:"admin"`
+	Partners      Partners      `yaml:"partners"`
+	Export        Export        `yaml:"export"`
+	Map           Map           `yaml:"map"`
+	Routes        Routes        `yaml:"routes"`
+}
+
+// Firestore is the database of the donations
+type Firestore struct {
+	// ProjectID is the Firebase project, the one of the credentials when it
+	// is empty
+	ProjectID string `yaml:"project_id" env:"FIREBASE_PROJECT_ID"`
+	// CredentialsFile is the service account key, the application default
+	// credentials are used when it is empty
+	CredentialsFile string `yaml:"credentials_file" env:"GOOGLE_APPLICATION_CREDENTIALS"`
+}
+
+// OpenCage is the geocoder of the shared locations
+type OpenCage struct {
+	APIKey  string `yaml:"api_key" env:"OPENCAGE_API_KEY" secret:"true"`
+	BaseURL string `yaml:"base_url" env:"OPENCAGE_BASE_URL"`
+}
+
+// GoogleActions is the Actions on Google project, account linking is disabled
+// without a client ID
+type GoogleActions struct {
+	ClientID string `yaml:"client_id" env:"GOOGLE_ACTIONS_CLIENT_ID"`
+}
+
+// RateLimits are the limits of the donations, kept in Redis when RedisURL is
+// set
+type RateLimits struct {
+	Session  RateLimit `yaml:"session" env:"RATE_LIMIT_SESSION"`
+	Sender   RateLimit `yaml:"sender" env:"RATE_LIMIT_SENDER"`
+	Phone    RateLimit `yaml:"phone" env:"RATE_LIMIT_PHONE"`
+	RedisURL string    `yaml:"redis_url" env:"REDIS_URL" secret:"url"`
+}
+
+// Alerts is where the admins are told about the abuses, the logs when the URL
+// is empty
+type Alerts struct {
+	URL string `yaml:"url" env:"ADMIN_ALERT_URL" secret:"true"`
+}
+
+// SMS is the gateway of the notifications, disabled without an account
+type SMS struct {
+	AccountSID     string        `yaml:"account_sid" env:"SMS_ACCOUNT_SID"`
+	AuthToken      string        `yaml:"auth_token" env:"SMS_AUTH_TOKEN" secret:"true"`
+	From           string        `yaml:"from" env:"SMS_FROM"`
+	GatewayURL     string        `yaml:"gateway_url" env:"SMS_GATEWAY_URL"`
+	ReminderBefore time.Duration `yaml:"reminder_before" env:"SMS_REMINDER_BEFORE"`
+}
+
+// SMTP is the server of the receipts, disabled without an address
+type SMTP struct {
+	Addr     string `yaml:"addr" env:"SMTP_ADDR"`
+	From     string `yaml:"from" env:"SMTP_FROM"`
+	Username string `yaml:"username" env:"SMTP_USERNAME"`
+	Password string `yaml:"password" env:"SMTP_PASSWORD" secret:"true"`
+}
+
+// Zalo is the Official Account of the Zalo webhook, the webhook is disabled
+// without the app ID, the OA secret and the access token
+type Zalo struct {
+	APIURL      string `yaml:"api_url" env:"ZALO_API_URL"`
+	AccessToken string `yaml:"access_token" env:"ZALO_ACCESS_TOKEN" secret:"true"`
+	AppID       string `yaml:"app_id" env:"ZALO_APP_ID"`
+	OASecret    string `yaml:"oa_secret" env:"ZALO_OA_SECRET" secret:"true"`
+}
+
+// Tracing is where the OpenTelemetry spans are exported, tracing is disabled
+// without an exporter
+type Tracing struct {
+	// Exporter is otlp, to send the spans to a collector, or stdout, to write
+	// them as JSON lines to the standard output or to File
+	Exporter    string `yaml:"exporter" env:"TRACING_EXPORTER"`
+	ServiceName string `yaml:"service_name" env:"TRACING_SERVICE_NAME"`
+	// Endpoint is the host:port of the gRPC receiver of the OTLP collector
+	Endpoint string `yaml:"endpoint" env:"OTLP_ENDPOINT"`
+	Insecure bool   `yaml:"insecure" env:"OTLP_INSECURE"`
+	File     string `yaml:"file" env:"TRACING_FILE"`
+	// SampleRatio is the share of the traces recorded, from 0 to 1
+	SampleRatio float64 `yaml:"sample_ratio" env:"TRACING_SAMPLE_RATIO"`
+}
+
+// Logging is how much is logged, the personal data of the donors is always
+// redacted
+type Logging struct {
+	// Level is debug, info, warn or error
+	Level string `yaml:"level" env:"LOG_LEVEL"`
+	// CoordinatePrecision is the number of decimals kept in the logged
+	// coordinates
+	CoordinatePrecision int `yaml:"coordinate_precision" env:"LOG_COORDINATE_PRECISION"`
+}
+
+// Transcripts are the redacted turns of the conversations kept for the
+// support, they aren't recorded when the retention is zero, the default
+type Transcripts struct {
+	// Retention is how long the turns are kept
+	Retention time.Duration `yaml:"retention" env:"TRANSCRIPT_RETENTION"`
+}
+
+// Admin protects the admin endpoints, they are disabled without a token
+type Admin struct {
+	// Token is sent by the admins as a bearer token
+	Token string `yaml:"token" env:"ADMIN_TOKEN" secret:"true"`
+}
+
+// Partners are the webhooks of the partner charities, their subscriptions
+// are managed on the admin endpoints
+type Partners struct {
+	Enabled bool `yaml:"enabled" env:"PARTNERS_ENABLED"`
+	// MaxAttempts is how many times a delivery fails before it is dead
+	MaxAttempts int `yaml:"max_attempts" env:"PARTNERS_MAX_ATTEMPTS"`
+	// RetryBase is the delay after the first failure, it doubles up to
+	// RetryMax
+	RetryBase time.Duration `yaml:"retry_base" env:"PARTNERS_RETRY_BASE"`
+	RetryMax  time.Duration `yaml:"retry_max" env:"PARTNERS_RETRY_MAX"`
+}
+
+// Export is how the donations are exported for the coordinators
+type Export struct {
+	// Timezone is the IANA time zone of the exported dates
+	Timezone string `yaml:"timezone" env:"EXPORT_TIMEZONE"`
+}
+
+// Location is the time zone of the exported dates
+func (e Export) Location() (*time.Location, error) {
+	return time.LoadLocation(e.Timezone)
+}
+
+// Map is the map of the pickups for the volunteers, it is disabled without a
+// token
+type Map struct {
+	// Token is sent by the volunteers as a bearer token, or in the token
+	// parameter of the embedded map
+	Token string `yaml:"token" env:"MAP_TOKEN" secret:"true"`
+}
+
+// Routes are how the routes of the volunteers are planned
+type Routes struct {
+	// SpeedKmh is the average speed of the volunteers on the streets
+	SpeedKmh float64 `yaml:"speed_kmh" env:"ROUTES_SPEED_KMH"`
+	// PickupWindow is how long after the time chosen by the donor the
+	// donation can be picked up
+	PickupWindow time.Duration `yaml:"pickup_window" env:"ROUTES_PICKUP_WINDOW"`
+	// PickupDuration is how long a pickup takes
+	PickupDuration time.Duration `yaml:"pickup_duration" env:"ROUTES_PICKUP_DURATION"`
+	// Secret signs the route tokens of the volunteers, the routes are
+	// disabled without it
+	Secret string `yaml:"secret" env:"ROUTES_SECRET" secret:"true"`
+}
+
+// FileEnv is the environment variable of the configuration file, when the
+// -config flag isn't given
+const FileEnv = "WCWS_CONFIG"
+
+// Default returns the configuration used for the settings set nowhere
+func Default() Config {
+	return Config{
+		Port:            1323,
+		ShutdownTimeout: 15 * time.Second,
+		DrainDelay:      10 * time.Second,
+		GracePeriod:     30 * time.Second,
+		SMS:             SMS{ReminderBefore: 2 * time.Hour},
+		Tracing:         Tracing{ServiceName: "wcws", Endpoint: "localhost:55680", SampleRatio: 1},
+		Logging:         Logging{Level: "info", CoordinatePrecision: 2},
+		Partners:        Partners{MaxAttempts: 8, RetryBase: 30 * time.Second, RetryMax: time.Hour},
+		Export:          Export{Timezone: "Asia/Ho_Chi_Minh"},
+		Routes:          Routes{SpeedKmh: 20, PickupWindow: time.Hour, PickupDuration: 10 * time.Minute},
+	}
+}
+
+// Load reads the configuration file, then the environment and then the
+// flags of the args, and validates the result
+func Load(args []string, getenv func(string) string) (*Config, error) {
+	cfg := Default()
+	fs := flag.NewFlagSet("wcws", flag.ContinueOnError)
+	file := fs.String("config", "", "configuration file, $"+FileEnv+" by default")
+	settings := cfg.settings()
+	flags := make(map[string]*flagValue)
+	for _, s := range settings {
+		flags[s.flag] = &flagValue{isBool: s.value.Kind() == reflect.Bool}
+		fs.Var(flags[s.flag], s.flag, fmt.Sprintf("%s, $%s", s.path, s.env))
+	}
+	if err := fs.Parse(args); err != nil {
+		return nil, err
+	}
+
+	if *file == "" {
+		*file = getenv(FileEnv)
+	}
+	if *file != "" {
+		b, err := ioutil.ReadFile(*file)
+		if err != nil {
+			return nil, err
+		}
+		if err := yaml.UnmarshalStrict(b, &cfg); err != nil {
+			return nil, fmt.Errorf("%s: %v", *file, err)
+		}
+	}
+	for _, s := range settings {
+		if v := getenv(s.env); v != "" {
+			if err := s.set(v); err != nil {
+				return nil, fmt.Errorf("$%s: %v", s.env, err)
+			}
+		}
+	}
+	var err error
+	fs.Visit(func(f *flag.Flag) {
+		if s, ok := settingOf(settings, f.Name); ok && err == nil {
+			if e := s.set(flags[f.Name].value); e != nil {
+				err = fmt.Errorf("-%s: %v", f.Name, e)
+			}
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	return &cfg, nil
+}
+
+// Validate checks the settings which would only fail once used
+func (c *Config) Validate() error {
+	var errs []string
+	check := func(ok bool, format string, a ...interface{}) {
+		if !ok {
+			errs = append(errs, fmt.Sprintf(format, a...))
+		}
+	}
+	check(c.Port > 0 && c.Port < 65536, "port: invalid port %d", c.Port)
+	check(c.ShutdownTimeout > 0, "shutdown_timeout: must be positive")
+	check(c.DrainDelay >= 0, "drain_delay: must not be negative")
+	check(c.DrainDelay+c.ShutdownTimeout < c.GracePeriod, "drain_delay: with the shutdown timeout, %v must be less than the grace period %v", c.DrainDelay+c.ShutdownTimeout, c.GracePeriod)
+	for path, u := range map[string]string{
+		"opencage.base_url":     c.OpenCage.BaseURL,
+		"alerts.url":            c.Alerts.URL,
+		"sms.gateway_url":       c.SMS.GatewayURL,
+		"zalo.api_url":          c.Zalo.APIURL,
+		"rate_limits.redis_url": c.RateLimits.RedisURL,
+	} {
+		if u != "" && strings.Contains(u, "://") {
+			_, err := url.ParseRequestURI(u)
+			check(err == nil, "%s: invalid URL", path)
+		}
+	}
+	if c.RateLimits.RedisURL != "" && !strings.Contains(c.RateLimits.RedisURL, "://") {
+		_, _, err := net.SplitHostPort(c.RateLimits.RedisURL)
+		check(err == nil, "rate_limits.redis_url: expected a URL or a host:port")
+	}
+	if c.SMS.AccountSID != "" {
+		check(c.SMS.AuthToken != "", "sms.auth_token: required with an account")
+		check(c.SMS.From != "", "sms.from: required with an account")
+		check(c.SMS.ReminderBefore > 0, "sms.reminder_before: must be positive")
+	}
+	if c.Zalo.AppID != "" || c.Zalo.OASecret != "" || c.Zalo.AccessToken != "" {
+		check(c.Zalo.AppID != "", "zalo.app_id: required with the Zalo webhook")
+		check(c.Zalo.OASecret != "", "zalo.oa_secret: required with the Zalo webhook")
+		check(c.Zalo.AccessToken != "", "zalo.access_token: required with the Zalo webhook")
+	}
+	if c.SMTP.Addr != "" {
+		_, _, err := net.SplitHostPort(c.SMTP.Addr)
+		check(err == nil, "smtp.addr: expected a host:port")
+		_, err = mail.ParseAddress(c.SMTP.From)
+		check(err == nil, "smtp.from: invalid address %q", c.SMTP.From)
+	}
+	switch c.Tracing.Exporter {
+	case "", "stdout":
+	case "otlp":
+		_, _, err := net.SplitHostPort(c.Tracing.Endpoint)
+		check(err == nil, "tracing.endpoint: expected a host:port")
+	default:
+		check(false, "tracing.exporter: unknown exporter %q, expected otlp or stdout", c.Tracing.Exporter)
+	}
+	switch strings.ToLower(c.Logging.Level) {
+	case "debug", "info", "warn", "error":
+	default:
+		check(false, "logging.level: unknown level %q", c.Logging.Level)
+	}
+	check(c.Logging.CoordinatePrecision >= 0 && c.Logging.CoordinatePrecision <= 6, "logging.coordinate_precision: must be between 0 and 6")
+	check(c.Tracing.SampleRatio >= 0 && c.Tracing.SampleRatio <= 1, "tracing.sample_ratio: must be between 0 and 1")
+	check(c.Transcripts.Retention >= 0, "transcripts.retention: must not be negative")
+	_, err := c.Export.Location()
+	check(err == nil, "export.timezone: unknown time zone %q", c.Export.Timezone)
+	if c.Partners.Enabled {
+		check(c.Partners.MaxAttempts > 0, "partners.max_attempts: must be positive")
+		check(c.Partners.RetryBase > 0, "partners.retry_base: must be positive")
+		check(c.Partners.RetryMax >= c.Partners.RetryBase, "partners.retry_max: must not be less than the retry base")
+	}
+	check(c.Routes.SpeedKmh > 0, "routes.speed_kmh: must be positive")
+	check(c.Routes.PickupWindow > 0, "routes.pickup_window: must be positive")
+	check(c.Routes.PickupDuration >= 0, "routes.pickup_duration: must not be negative")
+	if len(errs) > 0 {
+		return errors.New("invalid configuration: " + strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+// Addr is the address the webhook listens on
+func (c *Config) Addr() string {
+	return fmt.Sprintf(":%d", c.Port)
+}
+
+// String writes the configuration as YAML with the secrets redacted
+func (c Config) String() string {
+	for _, s := range c.settings() {
+		v := s.value.String()
+		switch {
+		case v == "":
+		case s.secret == "url":
+			s.value.SetString(redactURL(v))
+		case s.secret != "":
+			s.value.SetString(redacted)
+		}
+	}
+	b, err := yaml.Marshal(c)
+	if err != nil {
+		return err.Error()
+	}
+	return string(b)
+}
+
+const redacted = "REDACTED"
+
+// redactURL hides the password of a URL, the whole URL when it can't be parsed
+func redactURL(s string) string {
+	u, err := url.Parse(s)
+	if err != nil {
+		return redacted
+	}
+	if _, ok := u.User.Password(); ok {
+		u.User = url.UserPassword(u.User.Username(), redacted)
+	}
+	return u.String()
+}
+
+// setting is a leaf of the configuration
+type setting struct {
+	path   string
+	flag   string
+	env    string
+	secret string
+	value  reflect.Value
+}
+
+// settings returns the leaves of the configuration, which are addressable
+// so that they can be set
+func (c *Config) settings() []setting {
+	var settings []setting
+	var walk func(v reflect.Value, prefix string)
+	walk = func(v reflect.Value, prefix string) {
+		for i := 0; i < v.NumField(); i++ {
+			f := v.Type().Field(i)
+			path := prefix + strings.Split(f.Tag.Get("yaml"), ",")[0]
+			if f.Tag.Get("env") == "" {
+				walk(v.Field(i), path+".")
+				continue
+			}
+			settings = append(settings, setting{
+				path:   path,
+				flag:   strings.Replace(path, "_", "-", -1),
+				env:    f.Tag.Get("env"),
+				secret: f.Tag.Get("secret"),
+				value:  v.Field(i),
+			})
+		}
+	}
+	walk(reflect.ValueOf(c).Elem(), "")
+	return settings
+}
+
+// flagValue keeps the flag of a setting as given, it is parsed like the
+// environment
+type flagValue struct {
+	value  string
+	isBool bool
+}
+
+func (f *flagValue) String() string { return f.value }
+
+func (f *flagValue) Set(v string) error {
+	f.value = v
+	return nil
+}
+
+// IsBoolFlag lets the boolean settings be set without a value
+func (f *flagValue) IsBoolFlag() bool { return f.isBool }
+
+func settingOf(settings []setting, flag string) (setting, bool) {
+	for _, s := range settings {
+		if s.flag == flag {
+			return s, true
+		}
+	}
+	return setting{}, false
+}
+
+var durationType = reflect.TypeOf(time.Duration(0))
+
+// set parses the value of the setting from a string
+func (s setting) set(v string) error {
+	if u, ok := s.value.Addr().Interface().(encoding.TextUnmarshaler); ok {
+		return u.UnmarshalText([]byte(v))
+	}
+	switch {
+	case s.value.Type() == durationType:
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			return err
+		}
+		s.value.SetInt(int64(d))
+	case s.value.Kind() == reflect.Bool:
+		b, err := strconv.ParseBool(v)
+		if err != nil {
+			return err
+		}
+		s.value.SetBool(b)
+	case s.value.Kind() == reflect.Float64:
+		f, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return err
+		}
+		s.value.SetFloat(f)
+	case s.value.Kind() == reflect.Int:
+		i, err := strconv.Atoi(v)
+		if err != nil {
+			return err
+		}
+		s.value.SetInt(int64(i))
+	case s.value.Kind() == reflect.String:
+		s.value.SetString(v)
+	default:
+		return fmt.Errorf("unsupported type %s", s.value.Type())
+	}
+	return nil
+}
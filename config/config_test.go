@@ -0,0 +1,147 @@
+package config
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func env(vars map[string]string) func(string) string {
+	return func(k string) string { return vars[k] }
+}
+
+func writeFile(t *testing.T, content string) (string, func()) {
+	dir, err := ioutil.TempDir("", "wcws-config")
+	require.NoError(t, err)
+	path := filepath.Join(dir, "config.yaml")
+	require.NoError(t, ioutil.WriteFile(path, []byte(content), 0600))
+	return path, func() { os.RemoveAll(dir) }
+}
+
+func TestDefaults(t *testing.T) {
+	cfg, err := Load(nil, env(nil))
+	require.NoError(t, err)
+	assert.Equal(t, ":1323", cfg.Addr())
+	assert.Equal(t, 10*time.Second, cfg.DrainDelay)
+	assert.True(t, cfg.DrainDelay+cfg.ShutdownTimeout < cfg.GracePeriod)
+	assert.Empty(t, cfg.Firestore.CredentialsFile, "the application default credentials")
+	assert.Equal(t, 2*time.Hour, cfg.SMS.ReminderBefore)
+	assert.Zero(t, cfg.Transcripts.Retention, "the transcripts are opt-in")
+	loc, err := cfg.Export.Location()
+	require.NoError(t, err)
+	assert.Equal(t, "Asia/Ho_Chi_Minh", loc.String())
+}
+
+func TestPrecedence(t *testing.T) {
+	path, cleanup := writeFile(t, `
+name: staging
+port: 8080
+opencage:
+  api_key: from-file
+  base_url: http://geocoder.staging
+rate_limits:
+  session: 5/1h
+  phone: 3/24h
+sms:
+  reminder_before: 90m
+`)
+	defer cleanup()
+
+	cfg, err := Load([]string{"-config", path, "-port", "9090", "-rate-limits.phone", "1/1h"}, env(map[string]string{
+		"OPENCAGE_API_KEY": "from-env",
+		"PORT":             "8081",
+	}))
+	require.NoError(t, err)
+	assert.Equal(t, "staging", cfg.Name)
+	assert.Equal(t, 9090, cfg.Port)
+	assert.Equal(t, "from-env", cfg.OpenCage.APIKey)
+	assert.Equal(t, "http://geocoder.staging", cfg.OpenCage.BaseURL)
+	assert.Equal(t, RateLimit{Limit: 5, Window: time.Hour}, cfg.RateLimits.Session)
+	assert.Equal(t, RateLimit{Limit: 1, Window: time.Hour}, cfg.RateLimits.Phone)
+	assert.Equal(t, 90*time.Minute, cfg.SMS.ReminderBefore)
+}
+
+func TestBoolFlag(t *testing.T) {
+	cfg, err := Load([]string{"-tracing.exporter", "otlp", "-tracing.insecure", "-tracing.sample-ratio", "0.25"}, env(nil))
+	require.NoError(t, err)
+	assert.True(t, cfg.Tracing.Insecure)
+	assert.Equal(t, 0.25, cfg.Tracing.SampleRatio)
+}
+
+func TestFileFromEnv(t *testing.T) {
+	path, cleanup := writeFile(t, "name: production\n")
+	defer cleanup()
+	cfg, err := Load(nil, env(map[string]string{FileEnv: path}))
+	require.NoError(t, err)
+	assert.Equal(t, "production", cfg.Name)
+}
+
+func TestInvalid(t *testing.T) {
+	path, cleanup := writeFile(t, "prot: 8080\n")
+	defer cleanup()
+	_, err := Load([]string{"-config", path}, env(nil))
+	assert.Error(t, err, "unknown keys are rejected")
+
+	for name, c := range map[string]struct {
+		args []string
+		env  map[string]string
+	}{
+		"port":       {args: []string{"-port", "0"}},
+		"rate limit": {env: map[string]string{"RATE_LIMIT_SESSION": "5"}},
+		"duration":   {args: []string{"-sms.reminder-before", "soon"}},
+		"sms":        {env: map[string]string{"SMS_ACCOUNT_SID": "AC123"}},
+		"smtp":       {args: []string{"-smtp.addr", "localhost", "-smtp.from", "nobody"}},
+		"redis":      {env: map[string]string{"REDIS_URL": "localhost"}},
+		"flag":       {args: []string{"-unknown", "1"}},
+		"exporter":   {env: map[string]string{"TRACING_EXPORTER": "zipkin"}},
+		"ratio":      {args: []string{"-tracing.sample-ratio", "2"}},
+		"retention":  {env: map[string]string{"TRANSCRIPT_RETENTION": "-1h"}},
+		"partners":   {args: []string{"-partners.enabled", "-partners.retry-max", "1s"}},
+		"timezone":   {env: map[string]string{"EXPORT_TIMEZONE": "Mars/Olympus"}},
+		"speed":      {args: []string{"-routes.speed-kmh", "0"}},
+		"window":     {env: map[string]string{"ROUTES_PICKUP_WINDOW": "0s"}},
+		"drain":      {args: []string{"-drain-delay", "-5s"}},
+		"grace":      {args: []string{"-drain-delay", "15s", "-shutdown-timeout", "25s"}},
+		"zalo":       {env: map[string]string{"ZALO_APP_ID": "123", "ZALO_OA_SECRET": "secret"}},
+		"zalo token": {env: map[string]string{"ZALO_ACCESS_TOKEN": "token"}},
+	} {
+		_, err := Load(c.args, env(c.env))
+		assert.Error(t, err, name)
+	}
+}
+
+func TestStringRedactsSecrets(t *testing.T) {
+	cfg, err := Load([]string{
+		"-opencage.api-key", "opencage-key",
+		"-sms.account-sid", "AC123",
+		"-sms.auth-token", "sms-token",
+		"-sms.from", "+15005550006",
+		"-rate-limits.redis-url", "redis://:redis-password@redis:6379/0",
+		"-rate-limits.session", "5/1h",
+		"-admin.token", "admin-token",
+		"-map.token", "map-token",
+	}, env(nil))
+	require.NoError(t, err)
+	s := cfg.String()
+	assert.NotContains(t, s, "opencage-key")
+	assert.NotContains(t, s, "sms-token")
+	assert.NotContains(t, s, "redis-password")
+	assert.NotContains(t, s, "admin-token")
+	assert.NotContains(t, s, "map-token")
+	assert.Contains(t, s, "redis://:REDACTED@redis:6379/0")
+	assert.Contains(t, s, "account_sid: AC123")
+	assert.Contains(t, s, "session: 5/1h0m0s")
+	assert.Equal(t, "opencage-key", cfg.OpenCage.APIKey, "printing keeps the secrets")
+}
+
+func TestExample(t *testing.T) {
+	cfg, err := Load([]string{"-config", "example.yaml"}, env(nil))
+	require.NoError(t, err)
+	assert.Equal(t, "staging", cfg.Name)
+	assert.Equal(t, RateLimit{Limit: 3, Window: time.Hour}, cfg.RateLimits.Session)
+}
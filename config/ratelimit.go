@@ -0,0 +1,58 @@
+package config
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// RateLimit allows Limit donations per Window. A zero limit disables it
+type RateLimit struct {
+	Limit  int
+	Window time.Duration
+}
+
+// ParseRateLimit parses a limit written like "5/1h", an empty string is no
+// limit
+func ParseRateLimit(s string) (RateLimit, error) {
+	if s == "" {
+		return RateLimit{}, nil
+	}
+	parts := strings.SplitN(s, "/", 2)
+	if len(parts) != 2 {
+		return RateLimit{}, fmt.Errorf("invalid rate limit %q, expected <count>/<duration>", s)
+	}
+	limit, err := strconv.Atoi(parts[0])
+	if err != nil || limit < 0 {
+		return RateLimit{}, fmt.Errorf("invalid rate limit count %q", parts[0])
+	}
+	window, err := time.ParseDuration(parts[1])
+	if err != nil || window <= 0 {
+		return RateLimit{}, fmt.Errorf("invalid rate limit window %q", parts[1])
+	}
+	return RateLimit{Limit: limit, Window: window}, nil
+}
+
+func (l RateLimit) String() string {
+	return fmt.Sprintf("%d/%s", l.Limit, l.Window)
+}
+
+// MarshalText writes the limit like "5/1h0m0s", an empty string when there is
+// no limit
+func (l RateLimit) MarshalText() ([]byte, error) {
+	if l == (RateLimit{}) {
+		return []byte{}, nil
+	}
+	return []byte(l.String()), nil
+}
+
+// UnmarshalText parses the limit with ParseRateLimit
+func (l *RateLimit) UnmarshalText(b []byte) error {
+	parsed, err := ParseRateLimit(string(b))
+	if err != nil {
+		return err
+	}
+	*l = parsed
+	return nil
+}
@@ -0,0 +1,234 @@
+package dialogflow
+
+// PermissionPlaceholder is the fulfillment text sent along a permission
+// request, it is never shown to the user
+const PermissionPlaceholder = "PLACEHOLDER_FOR_PERMISSION"
+
+// Choice is one of the options offered to the user with Builder.Choices
+type Choice struct {
+	Key         string
+	Title       string
+	Description string
+	Synonyms    []string
+	Image       *Image
+}
+
+// Builder builds a fulfillment for the platform the request comes from, so
+// handlers describe the answer once and don't branch on the source
+type Builder struct {
+	dr       Request
+	platform Platform
+	rs       Fulfillment
+	google   *DialogFlowResponseGoogle
+	facebook *FBRQ
+	telegram *TelegramMessage
+}
+
+// NewBuilder returns a builder for the platform of the request. Requests that
+// don't come from Messenger or Telegram are answered for Actions on Google
+func NewBuilder(dr Request) *Builder {
+	return &Builder{dr: dr, platform: PlatformOf(dr)}
+}
+
+// PlatformOf returns the platform the request comes from
+func PlatformOf(dr Request) Platform {
+	switch dr.OriginalDetectIntentRequest.Source {
+	case "facebook":
+		return Facebook
+	case "telegram":
+		return Telegram
+	}
+	return ActionsOnGoogle
+}
+
+// Platform returns the platform the fulfillment is built for
+func (b *Builder) Platform() Platform {
+	return b.platform
+}
+
+func (b *Builder) add(r RichMessage) {
+	b.rs.FulfillmentMessages = append(b.rs.FulfillmentMessages, Message{Platform: b.platform, RichMessage: r})
+}
+
+// Text adds a text that is both displayed and spoken
+func (b *Builder) Text(text string) *Builder {
+	switch b.platform {
+	case Facebook, Telegram:
+		b.add(TextWrapper{Text: []string{text}})
+	default:
+		b.add(SingleSimpleResponse(text, text))
+	}
+	return b
+}
+
+// Speech adds a text that is displayed on screens and spoken with the given
+// SSML on voice devices
+func (b *Builder) Speech(display string, speech *SSML) *Builder {
+	switch b.platform {
+	case Facebook, Telegram:
+		b.add(TextWrapper{Text: []string{display}})
+	default:
+		b.add(SingleSSMLResponse(display, speech))
+	}
+	return b
+}
+
+// Card adds a card with an image
+func (b *Builder) Card(title, text string, image Image) *Builder {
+	switch b.platform {
+	case Facebook, Telegram:
+		b.add(Card{Title: title, Subtitle: text, ImageURI: image.ImageURI})
+	default:
+		b.add(BasicCard{Title: title, FormattedText: text, Image: &image})
+	}
+	return b
+}
+
+// Choices offers the user to pick one of the choices. On Actions on Google it
+// is a list after a simple response with the title, on Messenger each choice
+// is a card with a button sending back the key of the choice and on Telegram
+// it is an inline keyboard. Without choices the title is said as a text
+func (b *Builder) Choices(title string, choices []Choice) *Builder {
+	switch b.platform {
+	case Telegram:
+		if len(choices) == 0 {
+			return b.Text(title)
+		}
+		keyboard := TelegramInlineKeyboard{}
+		for _, c := range choices {
+			keyboard.InlineKeyboard = append(keyboard.InlineKeyboard, []TelegramInlineButton{
+				{Text: c.Title, CallbackData: c.Key},
+			})
+		}
+		b.add(PayloadWrapper{Payload: TelegramPayloadRequest{
+			Telegram: TelegramMessage{Text: title, ReplyMarkup: keyboard},
+		}})
+	case Facebook:
+		if len(choices) == 0 {
+			return b.Text(title)
+		}
+		for _, c := range choices {
+			card := Card{
+				Title:    c.Title,
+				Subtitle: c.Description,
+				Buttons:  []Button{{Text: "Choose", PostBack: c.Key}},
+			}
+			if c.Image != nil {
+				card.ImageURI = c.Image.ImageURI
+			}
+			b.add(card)
+		}
+	default:
+		if len(choices) > 0 {
+			// a selection needs a simple response right before it
+			b.add(SingleSimpleResponse(title, title))
+		}
+		list := ListSelect{Title: title}
+		for _, c := range choices {
+			list.Items = append(list.Items, Item{
+				Info:        SelectItemInfo{Key: c.Key, Synonyms: c.Synonyms},
+				Title:       c.Title,
+				Description: c.Description,
+				Image:       c.Image,
+			})
+		}
+		b.add(list)
+	}
+	return b
+}
+
+// AskLocation asks the user to share their location. The reason is read
+// before the permission prompt on Actions on Google, e.g. "To send a
+// volunteer, I'll need your precise location"
+func (b *Builder) AskLocation(reason string) *Builder {
+	b.rs.FulfillmentText = PermissionPlaceholder
+	switch b.platform {
+	case Facebook:
+		b.facebook = &FBRQ{
+			Text:           reason + ", please share your location.",
+			FBQuickReplies: QuickRep{ContentType: "location"},
+		}
+	case Telegram:
+		b.telegram = &TelegramMessage{
+			Text: reason + ", please share your location.",
+			ReplyMarkup: TelegramReplyKeyboard{
+				Keyboard:        [][]TelegramKeyboardButton{{{Text: "Share my location", RequestLocation: true}}},
+				ResizeKeyboard:  true,
+				OneTimeKeyboard: true,
+			},
+		}
+	default:
+		b.googlePayload().SystemIntent = &DialogFlowResponseSystemIntent{
+			Intent: "actions.intent.PERMISSION",
+			Data: DialogFlowResponseSystemIntentData{
+				Type:        "type.googleapis.com/google.actions.v2.PermissionValueSpec",
+				OptContext:  reason,
+				Permissions: []string{"DEVICE_PRECISE_LOCATION"},
+			},
+		}
+	}
+	return b
+}
+
+// AskSignIn asks an Actions on Google user to link their Google account, the
+// reason is read before the sign in prompt, e.g. "To remember your name". The
+// other platforms get the reason as a text
+func (b *Builder) AskSignIn(reason string) *Builder {
+	if b.platform != ActionsOnGoogle {
+		return b.Text(reason + ".")
+	}
+	b.rs.FulfillmentText = PermissionPlaceholder
+	b.googlePayload().SystemIntent = &DialogFlowResponseSystemIntent{
+		Intent: "actions.intent.SIGN_IN",
+		Data: DialogFlowResponseSystemIntentData{
+			Type:       "type.googleapis.com/google.actions.v2.SignInValueSpec",
+			OptContext: reason,
+		},
+	}
+	return b
+}
+
+// ResetContexts clears every context of the request
+func (b *Builder) ResetContexts() *Builder {
+	b.rs.OutputContexts = nil
+	for _, c := range b.dr.QueryResult.OutputContexts {
+		b.rs.OutputContexts = append(b.rs.OutputContexts, &Context{Name: c.Name})
+	}
+	return b
+}
+
+// Context sets an output context, to remember parameters for the next turns
+func (b *Builder) Context(c *Context) *Builder {
+	b.rs.OutputContexts = append(b.rs.OutputContexts, c)
+	return b
+}
+
+// EndConversation closes the conversation on Actions on Google and resets
+// every context of the request
+func (b *Builder) EndConversation() *Builder {
+	if b.platform == ActionsOnGoogle {
+		b.googlePayload().ExpectUserResponse = false
+	}
+	return b.ResetContexts()
+}
+
+func (b *Builder) googlePayload() *DialogFlowResponseGoogle {
+	if b.google == nil {
+		b.google = &DialogFlowResponseGoogle{ExpectUserResponse: true}
+	}
+	return b.google
+}
+
+// Build returns the fulfillment
+func (b *Builder) Build() *Fulfillment {
+	rs := b.rs
+	switch {
+	case b.google != nil:
+		rs.Payload = DialogFlowResponseData{Google: *b.google}
+	case b.facebook != nil:
+		rs.Payload = FacebookPayloadRequest{Facebook: *b.facebook}
+	case b.telegram != nil:
+		rs.Payload = TelegramPayloadRequest{Telegram: *b.telegram}
+	}
+	return &rs
+}
@@ -0,0 +1,105 @@
+package dialogflow
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func request(source string) Request {
+	dr := Request{Session: "projects/p/agent/sessions/s"}
+	dr.OriginalDetectIntentRequest.Source = source
+	dr.QueryResult.OutputContexts = []*Context{{Name: "projects/p/agent/sessions/s/contexts/information", LifespanCount: 3}}
+	return dr
+}
+
+func TestBuilderGoogle(t *testing.T) {
+	rs := NewBuilder(request("google")).
+		Text("Hello").
+		Choices("Events", []Choice{{Key: "a", Title: "A"}, {Key: "b", Title: "B"}}).
+		AskLocation("To send a volunteer").
+		EndConversation().
+		Build()
+
+	b, err := json.Marshal(rs)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"fulfillmentText": "PLACEHOLDER_FOR_PERMISSION",
+		"fulfillmentMessages": [
+			{"platform": "ACTIONS_ON_GOOGLE", "simpleResponses": {"simpleResponses": [{"textToSpeech": "Hello", "displayText": "Hello"}]}},
+			{"platform": "ACTIONS_ON_GOOGLE", "simpleResponses": {"simpleResponses": [{"textToSpeech": "Events", "displayText": "Events"}]}},
+			{"platform": "ACTIONS_ON_GOOGLE", "listSelect": {"title": "Events", "items": [{"info": {"key": "a"}, "title": "A"}, {"info": {"key": "b"}, "title": "B"}]}}
+		],
+		"payload": {"google": {
+			"expectUserResponse": false,
+			"isSsml": false,
+			"systemIntent": {
+				"intent": "actions.intent.PERMISSION",
+				"data": {
+					"@type": "type.googleapis.com/google.actions.v2.PermissionValueSpec",
+					"optContext": "To send a volunteer",
+					"permissions": ["DEVICE_PRECISE_LOCATION"]
+				}
+			}
+		}},
+		"outputContexts": [{"name": "projects/p/agent/sessions/s/contexts/information"}]
+	}`, string(b))
+}
+
+func TestBuilderFacebook(t *testing.T) {
+	rs := NewBuilder(request("facebook")).
+		Text("Hello").
+		Choices("Events", []Choice{{Key: "a", Title: "A", Description: "first"}}).
+		AskLocation("To send a volunteer").
+		Build()
+
+	b, err := json.Marshal(rs)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"fulfillmentText": "PLACEHOLDER_FOR_PERMISSION",
+		"fulfillmentMessages": [
+			{"platform": "FACEBOOK", "text": {"text": ["Hello"]}},
+			{"platform": "FACEBOOK", "card": {"title": "A", "subtitle": "first", "buttons": [{"text": "Choose", "postback": "a"}]}}
+		],
+		"payload": {"facebook": {"text": "To send a volunteer, please share your location.", "quick_replies": {"content_type": "location"}}}
+	}`, string(b))
+}
+
+func TestBuilderTelegram(t *testing.T) {
+	rs := NewBuilder(request("telegram")).
+		Text("Hello").
+		Choices("Events", []Choice{{Key: "a", Title: "A"}, {Key: "b", Title: "B"}}).
+		AskLocation("To send a volunteer").
+		Build()
+
+	b, err := json.Marshal(rs)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"fulfillmentText": "PLACEHOLDER_FOR_PERMISSION",
+		"fulfillmentMessages": [
+			{"platform": "TELEGRAM", "text": {"text": ["Hello"]}},
+			{"platform": "TELEGRAM", "payload": {"telegram": {"text": "Events", "reply_markup": {"inline_keyboard": [
+				[{"text": "A", "callback_data": "a"}],
+				[{"text": "B", "callback_data": "b"}]
+			]}}}}
+		],
+		"payload": {"telegram": {
+			"text": "To send a volunteer, please share your location.",
+			"reply_markup": {
+				"keyboard": [[{"text": "Share my location", "request_location": true}]],
+				"resize_keyboard": true,
+				"one_time_keyboard": true
+			}
+		}}
+	}`, string(b))
+}
+
+func TestBuilderNoChoices(t *testing.T) {
+	for _, source := range []string{"facebook", "telegram"} {
+		rs := NewBuilder(request(source)).Choices("There are no events at the moment", nil).Build()
+		require.Len(t, rs.FulfillmentMessages, 1, source)
+		assert.Equal(t, TextWrapper{Text: []string{"There are no events at the moment"}}, rs.FulfillmentMessages[0].RichMessage, source)
+	}
+}
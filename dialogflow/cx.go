@@ -0,0 +1,292 @@
+package dialogflow
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// CXRequest is the webhook request sent by Dialogflow CX agents.
+// https://cloud.google.com/dialogflow/cx/docs/reference/rest/v3/WebhookRequest
+type CXRequest struct {
+	DetectIntentResponseID  string                 `json:"detectIntentResponseId,omitempty"`
+	Text                    string                 `json:"text,omitempty"`
+	TriggerIntent           string                 `json:"triggerIntent,omitempty"`
+	TriggerEvent            string                 `json:"triggerEvent,omitempty"`
+	Transcript              string                 `json:"transcript,omitempty"`
+	LanguageCode            string                 `json:"languageCode,omitempty"`
+	FulfillmentInfo         CXFulfillmentInfo      `json:"fulfillmentInfo"`
+	IntentInfo              *CXIntentInfo          `json:"intentInfo,omitempty"`
+	PageInfo                *CXPageInfo            `json:"pageInfo,omitempty"`
+	SessionInfo             CXSessionInfo          `json:"sessionInfo"`
+	Messages                []CXMessage            `json:"messages,omitempty"`
+	Payload                 json.RawMessage        `json:"payload,omitempty"`
+	SentimentAnalysisResult *Sentiment             `json:"sentimentAnalysisResult,omitempty"`
+	LanguageInfo            map[string]interface{} `json:"languageInfo,omitempty"`
+}
+
+// CXFulfillmentInfo holds the tag of the fulfillment that called the webhook.
+// The tag plays the role of the action of ES agents
+type CXFulfillmentInfo struct {
+	Tag string `json:"tag,omitempty"`
+}
+
+// CXIntentInfo describes the last matched intent
+type CXIntentInfo struct {
+	LastMatchedIntent string                            `json:"lastMatchedIntent,omitempty"`
+	DisplayName       string                            `json:"displayName,omitempty"`
+	Parameters        map[string]CXIntentParameterValue `json:"parameters,omitempty"`
+	Confidence        float64                           `json:"confidence,omitempty"`
+}
+
+// CXIntentParameterValue is the value of a parameter of the matched intent
+type CXIntentParameterValue struct {
+	OriginalValue string      `json:"originalValue,omitempty"`
+	ResolvedValue interface{} `json:"resolvedValue,omitempty"`
+}
+
+// CXPageInfo describes the current page. It can be sent back in the response
+// to invalidate form parameters
+type CXPageInfo struct {
+	CurrentPage string      `json:"currentPage,omitempty"`
+	DisplayName string      `json:"displayName,omitempty"`
+	FormInfo    *CXFormInfo `json:"formInfo,omitempty"`
+}
+
+// CXFormInfo holds the state of the form parameters of the current page
+type CXFormInfo struct {
+	ParameterInfo []CXParameterInfo `json:"parameterInfo,omitempty"`
+}
+
+// CXParameterInfo is the state of a single form parameter
+type CXParameterInfo struct {
+	DisplayName   string      `json:"displayName,omitempty"`
+	Required      bool        `json:"required,omitempty"`
+	State         string      `json:"state,omitempty"`
+	Value         interface{} `json:"value,omitempty"`
+	JustCollected bool        `json:"justCollected,omitempty"`
+}
+
+// CXSessionInfo holds the session and its parameters. Session parameters
+// replace the contexts of ES agents
+type CXSessionInfo struct {
+	Session    string                 `json:"session,omitempty"`
+	Parameters map[string]interface{} `json:"parameters,omitempty"`
+}
+
+// CXMessage is a response message of a CX agent. Only one field is set
+type CXMessage struct {
+	Text            *CXText            `json:"text,omitempty"`
+	Payload         interface{}        `json:"payload,omitempty"`
+	OutputAudioText *CXOutputAudioText `json:"outputAudioText,omitempty"`
+	EndInteraction  *struct{}          `json:"endInteraction,omitempty"`
+	Channel         string             `json:"channel,omitempty"`
+}
+
+// CXText is a text response message
+type CXText struct {
+	Text []string `json:"text,omitempty"`
+}
+
+// CXOutputAudioText is played on voice channels. One of Text or SSML is set
+type CXOutputAudioText struct {
+	Text string `json:"text,omitempty"`
+	SSML string `json:"ssml,omitempty"`
+}
+
+// CXResponse is the response sent back to Dialogflow CX agents
+// https://cloud.google.com/dialogflow/cx/docs/reference/rest/v3/WebhookResponse
+type CXResponse struct {
+	FulfillmentResponse *CXFulfillmentResponse `json:"fulfillmentResponse,omitempty"`
+	PageInfo            *CXPageInfo            `json:"pageInfo,omitempty"`
+	SessionInfo         *CXSessionInfo         `json:"sessionInfo,omitempty"`
+	Payload             interface{}            `json:"payload,omitempty"`
+	TargetPage          string                 `json:"targetPage,omitempty"`
+	TargetFlow          string                 `json:"targetFlow,omitempty"`
+}
+
+// CXMergeBehavior defines how the messages of the response are merged with
+// the messages of the agent
+type CXMergeBehavior string
+
+// Merge behaviors
+const (
+	CXMergeAppend  CXMergeBehavior = "APPEND"
+	CXMergeReplace CXMergeBehavior = "REPLACE"
+)
+
+// CXFulfillmentResponse holds the messages returned to the user
+type CXFulfillmentResponse struct {
+	Messages      []CXMessage     `json:"messages,omitempty"`
+	MergeBehavior CXMergeBehavior `json:"mergeBehavior,omitempty"`
+}
+
+// IsCXRequest tells whether the given webhook body was sent by a CX agent
+// rather than an ES agent
+func IsCXRequest(body []byte) bool {
+	var probe struct {
+		FulfillmentInfo json.RawMessage `json:"fulfillmentInfo"`
+		SessionInfo     json.RawMessage `json:"sessionInfo"`
+		QueryResult     json.RawMessage `json:"queryResult"`
+	}
+	if err := json.Unmarshal(body, &probe); err != nil {
+		return false
+	}
+	return probe.QueryResult == nil && (probe.FulfillmentInfo != nil || probe.SessionInfo != nil)
+}
+
+// ESRequest converts the CX request to an ES request, so that the same action
+// handlers can serve both agents. The fulfillment tag becomes the action and
+// the session parameters are exposed both as query parameters and as the
+// parameters of the given context
+func (r *CXRequest) ESRequest(ctxName string) (Request, error) {
+	dr := Request{
+		Session:    r.SessionInfo.Session,
+		ResponseID: r.DetectIntentResponseID,
+		QueryResult: QueryResult{
+			QueryText:    r.Text,
+			LanguageCode: r.LanguageCode,
+			Action:       r.FulfillmentInfo.Tag,
+			Parameters:   r.SessionInfo.Parameters,
+		},
+	}
+	if r.Text == "" {
+		dr.QueryResult.QueryText = r.Transcript
+	}
+	if r.IntentInfo != nil {
+		dr.QueryResult.Intent = Intent{
+			Name:        r.IntentInfo.LastMatchedIntent,
+			DisplayName: r.IntentInfo.DisplayName,
+		}
+		dr.QueryResult.IntentDetectionConfidence = r.IntentInfo.Confidence
+	}
+	if r.SentimentAnalysisResult != nil {
+		dr.QueryResult.SentimentAnalysisResult = &SentimentAnalysisResult{QueryTextSentiment: *r.SentimentAnalysisResult}
+	}
+	params := r.SessionInfo.Parameters
+	if params == nil {
+		params = map[string]interface{}{}
+	}
+	ctx, err := dr.NewContext(ctxName, 1, params)
+	if err != nil {
+		return dr, err
+	}
+	dr.QueryResult.OutputContexts = []*Context{ctx}
+	if len(r.Payload) > 0 {
+		if err := json.Unmarshal(r.Payload, &dr.OriginalDetectIntentRequest.Payload); err != nil {
+			return dr, fmt.Errorf("invalid payload: %v", err)
+		}
+		dr.OriginalDetectIntentRequest.Source = dr.OriginalDetectIntentRequest.Payload.Source
+	}
+	return dr, nil
+}
+
+// CXResponse converts a fulfillment built for ES agents to a CX response.
+// Texts and simple responses are mapped to CX messages, rich messages that
+// CX doesn't support are sent as custom payloads for their channel. Output
+// contexts are merged into the session parameters, a context with no
+// lifespan clears every parameter the request was sent with. The form
+// parameters of the current page set or cleared that way are sent back in
+// the page info, so that the page sees them as filled or to be asked again
+func (r *CXRequest) CXResponse(f *Fulfillment) (*CXResponse, error) {
+	rs := &CXResponse{}
+	var messages []CXMessage
+	for _, m := range f.FulfillmentMessages {
+		cm, err := cxMessage(m)
+		if err != nil {
+			return nil, err
+		}
+		messages = append(messages, cm...)
+	}
+	if len(messages) == 0 && f.FulfillmentText != "" {
+		messages = append(messages, CXMessage{Text: &CXText{Text: []string{f.FulfillmentText}}})
+	}
+	if f.Payload != nil {
+		messages = append(messages, CXMessage{Payload: f.Payload})
+	}
+	if len(messages) > 0 {
+		rs.FulfillmentResponse = &CXFulfillmentResponse{Messages: messages, MergeBehavior: CXMergeAppend}
+	}
+
+	params := map[string]interface{}{}
+	for _, c := range f.OutputContexts {
+		if c.LifespanCount == 0 {
+			for k := range r.SessionInfo.Parameters {
+				params[k] = nil
+			}
+			continue
+		}
+		if len(c.Parameters) == 0 {
+			continue
+		}
+		var p map[string]interface{}
+		if err := json.Unmarshal(c.Parameters, &p); err != nil {
+			return nil, err
+		}
+		for k, v := range p {
+			params[k] = v
+		}
+	}
+	if len(params) > 0 {
+		rs.SessionInfo = &CXSessionInfo{Parameters: params}
+		rs.PageInfo = r.formUpdates(params)
+	}
+	return rs, nil
+}
+
+// formUpdates is the page info updating the form parameters of the current
+// page which are in the params, or nil when there are none
+func (r *CXRequest) formUpdates(params map[string]interface{}) *CXPageInfo {
+	if r.PageInfo == nil || r.PageInfo.FormInfo == nil {
+		return nil
+	}
+	var updates []CXParameterInfo
+	for _, p := range r.PageInfo.FormInfo.ParameterInfo {
+		v, ok := params[p.DisplayName]
+		if !ok {
+			continue
+		}
+		state := "VALID"
+		if v == nil {
+			state = "EMPTY"
+		}
+		updates = append(updates, CXParameterInfo{DisplayName: p.DisplayName, Required: p.Required, State: state, Value: v})
+	}
+	if len(updates) == 0 {
+		return nil
+	}
+	return &CXPageInfo{CurrentPage: r.PageInfo.CurrentPage, FormInfo: &CXFormInfo{ParameterInfo: updates}}
+}
+
+func cxMessage(m Message) ([]CXMessage, error) {
+	channel := strings.ToLower(string(m.Platform))
+	switch r := m.RichMessage.(type) {
+	case nil:
+		return nil, nil
+	case TextWrapper:
+		return []CXMessage{{Text: &CXText{Text: r.Text}, Channel: channel}}, nil
+	case SimpleResponsesWrapper:
+		var rs []CXMessage
+		for _, s := range r.SimpleResponses {
+			display := s.DisplayText
+			if display == "" {
+				display = s.TextToSpeech
+			}
+			if display != "" {
+				rs = append(rs, CXMessage{Text: &CXText{Text: []string{display}}, Channel: channel})
+			}
+			rs = append(rs, CXMessage{OutputAudioText: &CXOutputAudioText{Text: s.TextToSpeech, SSML: s.SSML}, Channel: channel})
+		}
+		return rs, nil
+	case PayloadWrapper:
+		return []CXMessage{{Payload: r.Payload, Channel: channel}}, nil
+	}
+	b, err := json.Marshal(m.RichMessage)
+	if err != nil {
+		return nil, err
+	}
+	return []CXMessage{{
+		Payload: map[string]json.RawMessage{m.RichMessage.GetKey(): b},
+		Channel: channel,
+	}}, nil
+}
@@ -0,0 +1,107 @@
+package dialogflow
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsCXRequest(t *testing.T) {
+	cx, err := ioutil.ReadFile(filepath.Join("testdata", "cx_request.json"))
+	require.NoError(t, err)
+	es, err := ioutil.ReadFile(filepath.Join("testdata", "es_request_google.json"))
+	require.NoError(t, err)
+
+	assert.True(t, IsCXRequest(cx))
+	assert.False(t, IsCXRequest(es))
+	assert.False(t, IsCXRequest([]byte("not json")))
+}
+
+func TestCXRequestToES(t *testing.T) {
+	sample, err := ioutil.ReadFile(filepath.Join("testdata", "cx_request.json"))
+	require.NoError(t, err)
+	var cr CXRequest
+	require.NoError(t, json.Unmarshal(sample, &cr))
+
+	dr, err := cr.ESRequest("information")
+	require.NoError(t, err)
+	assert.Equal(t, "collect", dr.QueryResult.Action)
+	assert.Equal(t, "projects/wcws-agent/locations/global/agents/a1/sessions/s1", dr.Session)
+	assert.Equal(t, "5d1b3e6c-7a2f-4c1d-8e9b-0a1b2c3d4e5f", dr.ResponseID)
+	assert.Equal(t, "facebook", dr.OriginalDetectIntentRequest.Source)
+	assert.Equal(t, 0.87, dr.QueryResult.IntentDetectionConfidence)
+
+	var info map[string]interface{}
+	require.NoError(t, dr.GetContext("information", &info))
+	assert.Equal(t, "0905123456", info["phone-number"])
+	assert.Equal(t, "Hoang", info["person"].(map[string]interface{})["name"])
+}
+
+func TestFulfillmentToCX(t *testing.T) {
+	cr := CXRequest{SessionInfo: CXSessionInfo{Parameters: map[string]interface{}{"any": "books"}}}
+	dr, err := cr.ESRequest("information")
+	require.NoError(t, err)
+	dr.QueryResult.OutputContexts[0].LifespanCount = 0
+
+	rs, err := cr.CXResponse(&Fulfillment{
+		FulfillmentMessages: Messages{
+			ForFacebook(TextWrapper{Text: []string{"Thanks!"}}),
+			ForGoogle(SingleSSMLResponse("Thanks!", NewSSML().Text("Thanks!"))),
+			ForGoogle(BasicCard{Title: "We Collect We Share"}),
+		},
+		OutputContexts: dr.QueryResult.OutputContexts,
+	})
+	require.NoError(t, err)
+	b, err := json.Marshal(rs)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"fulfillmentResponse": {
+			"messages": [
+				{"text": {"text": ["Thanks!"]}, "channel": "facebook"},
+				{"text": {"text": ["Thanks!"]}, "channel": "actions_on_google"},
+				{"outputAudioText": {"ssml": "<speak>Thanks!</speak>"}, "channel": "actions_on_google"},
+				{"payload": {"basicCard": {"title": "We Collect We Share"}}, "channel": "actions_on_google"}
+			],
+			"mergeBehavior": "APPEND"
+		},
+		"sessionInfo": {"parameters": {"any": null}}
+	}`, string(b))
+}
+
+func TestFormParametersToCX(t *testing.T) {
+	cr := CXRequest{
+		PageInfo: &CXPageInfo{
+			CurrentPage: "projects/p/locations/l/agents/a/flows/f/pages/donation",
+			FormInfo: &CXFormInfo{ParameterInfo: []CXParameterInfo{
+				{DisplayName: "phone-number", Required: true, State: "INVALID", Value: "12"},
+				{DisplayName: "any", Required: true, State: "VALID", Value: "books"},
+				{DisplayName: "email", State: "EMPTY"},
+			}},
+		},
+		SessionInfo: CXSessionInfo{Parameters: map[string]interface{}{"any": "books"}},
+	}
+	params, err := json.Marshal(map[string]interface{}{"phone-number": "0905123456", "address": "12 Bach Dang"})
+	require.NoError(t, err)
+	rs, err := cr.CXResponse(&Fulfillment{OutputContexts: []*Context{{Name: "information", LifespanCount: 5, Parameters: params}}})
+	require.NoError(t, err)
+	require.NotNil(t, rs.PageInfo)
+	assert.Equal(t, cr.PageInfo.CurrentPage, rs.PageInfo.CurrentPage)
+	assert.Equal(t, []CXParameterInfo{{DisplayName: "phone-number", Required: true, State: "VALID", Value: "0905123456"}}, rs.PageInfo.FormInfo.ParameterInfo)
+
+	dr, err := cr.ESRequest("information")
+	require.NoError(t, err)
+	dr.QueryResult.OutputContexts[0].LifespanCount = 0
+	rs, err = cr.CXResponse(&Fulfillment{OutputContexts: dr.QueryResult.OutputContexts})
+	require.NoError(t, err)
+	require.NotNil(t, rs.PageInfo)
+	assert.Equal(t, []CXParameterInfo{{DisplayName: "any", Required: true, State: "EMPTY"}}, rs.PageInfo.FormInfo.ParameterInfo, "the cleared parameters are asked again")
+
+	cr.PageInfo = nil
+	rs, err = cr.CXResponse(&Fulfillment{OutputContexts: []*Context{{Name: "information", LifespanCount: 5, Parameters: params}}})
+	require.NoError(t, err)
+	assert.Nil(t, rs.PageInfo)
+}
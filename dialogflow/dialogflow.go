@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"strconv"
 	"strings"
 )
 
@@ -49,22 +50,98 @@ func (rw *Request) NewContext(name string, lifespan int, params interface{}) (*C
 	return ctx, nil
 }
 
+// IsFallback reports whether the matched intent is a fallback intent
+func (rw *Request) IsFallback() bool {
+	return rw.QueryResult.Intent.IsFallback
+}
+
+// Sentiment returns the sentiment of the user query and false if sentiment
+// analysis isn't enabled for the agent
+func (rw *Request) Sentiment() (Sentiment, bool) {
+	if rw.QueryResult.SentimentAnalysisResult == nil {
+		return Sentiment{}, false
+	}
+	return rw.QueryResult.SentimentAnalysisResult.QueryTextSentiment, true
+}
+
+// SenderID returns the ID of the user on the messaging platform the request
+// comes from: the page-scoped ID on Messenger or the user ID on Telegram. It
+// is empty on the other platforms
+func (rw *Request) SenderID() string {
+	switch PlatformOf(*rw) {
+	case Facebook:
+		data, _ := rw.OriginalDetectIntentRequest.Payload.Data.(map[string]interface{})
+		sender, _ := data["sender"].(map[string]interface{})
+		id, _ := sender["id"].(string)
+		return id
+	case Telegram:
+		update, err := rw.TelegramUpdate()
+		if err != nil {
+			return ""
+		}
+		var from *TelegramUser
+		if update.Message != nil {
+			from = update.Message.From
+		} else if update.CallbackQuery != nil {
+			from = update.CallbackQuery.From
+		}
+		if from == nil {
+			return ""
+		}
+		return strconv.FormatInt(from.ID, 10)
+	}
+	return ""
+}
+
 // QueryResult is the dataset sent back by DialogFlow
 type QueryResult struct {
-	QueryText                 string                 `json:"queryText,omitempty"`
-	Action                    string                 `json:"action,omitempty"`
-	LanguageCode              string                 `json:"languageCode,omitempty"`
-	AllRequiredParamsPresent  bool                   `json:"allRequiredParamsPresent,omitempty"`
-	IntentDetectionConfidence float64                `json:"intentDetectionConfidence,omitempty"`
-	Parameters                map[string]interface{} `json:"parameters,omitempty"`
-	OutputContexts            []*Context             `json:"outputContexts,omitempty"`
-	Intent                    Intent                 `json:"intent,omitempty"`
+	QueryText                   string                   `json:"queryText,omitempty"`
+	LanguageCode                string                   `json:"languageCode,omitempty"`
+	SpeechRecognitionConfidence float64                  `json:"speechRecognitionConfidence,omitempty"`
+	Action                      string                   `json:"action,omitempty"`
+	Parameters                  map[string]interface{}   `json:"parameters,omitempty"`
+	AllRequiredParamsPresent    bool                     `json:"allRequiredParamsPresent,omitempty"`
+	CancelsSlotFilling          bool                     `json:"cancelsSlotFilling,omitempty"`
+	FulfillmentText             string                   `json:"fulfillmentText,omitempty"`
+	FulfillmentMessages         Messages                 `json:"fulfillmentMessages,omitempty"`
+	WebhookSource               string                   `json:"webhookSource,omitempty"`
+	WebhookPayload              map[string]interface{}   `json:"webhookPayload,omitempty"`
+	OutputContexts              []*Context               `json:"outputContexts,omitempty"`
+	Intent                      Intent                   `json:"intent,omitempty"`
+	IntentDetectionConfidence   float64                  `json:"intentDetectionConfidence,omitempty"`
+	DiagnosticInfo              map[string]interface{}   `json:"diagnosticInfo,omitempty"`
+	SentimentAnalysisResult     *SentimentAnalysisResult `json:"sentimentAnalysisResult,omitempty"`
 }
 
 // Intent describes the matched intent
 type Intent struct {
-	Name        string `json:"name,omitempty"`
-	DisplayName string `json:"displayName,omitempty"`
+	Name                     string   `json:"name,omitempty"`
+	DisplayName              string   `json:"displayName,omitempty"`
+	WebhookState             string   `json:"webhookState,omitempty"`
+	Priority                 int      `json:"priority,omitempty"`
+	IsFallback               bool     `json:"isFallback,omitempty"`
+	MlDisabled               bool     `json:"mlDisabled,omitempty"`
+	LiveAgentHandoff         bool     `json:"liveAgentHandoff,omitempty"`
+	EndInteraction           bool     `json:"endInteraction,omitempty"`
+	InputContextNames        []string `json:"inputContextNames,omitempty"`
+	Events                   []string `json:"events,omitempty"`
+	Action                   string   `json:"action,omitempty"`
+	ResetContexts            bool     `json:"resetContexts,omitempty"`
+	RootFollowupIntentName   string   `json:"rootFollowupIntentName,omitempty"`
+	ParentFollowupIntentName string   `json:"parentFollowupIntentName,omitempty"`
+}
+
+// SentimentAnalysisResult contains the sentiment of the user query. It is only
+// present when sentiment analysis is enabled in the agent settings
+type SentimentAnalysisResult struct {
+	QueryTextSentiment Sentiment `json:"queryTextSentiment"`
+}
+
+// Sentiment is the sentiment of a text. Score goes from -1.0 (negative) to
+// 1.0 (positive), Magnitude is the strength of the emotion
+type Sentiment struct {
+	Score     float64 `json:"score"`
+	Magnitude float64 `json:"magnitude"`
 }
 
 // DialogFlowResponseData struct
@@ -74,9 +151,9 @@ type DialogFlowResponseData struct {
 
 // DialogFlowResponseGoogle struct
 type DialogFlowResponseGoogle struct {
-	ExpectUserResponse bool                           `json:"expectUserResponse"`
-	IsSsml             bool                           `json:"isSsml"`
-	SystemIntent       DialogFlowResponseSystemIntent `json:"systemIntent"`
+	ExpectUserResponse bool                            `json:"expectUserResponse"`
+	IsSsml             bool                            `json:"isSsml"`
+	SystemIntent       *DialogFlowResponseSystemIntent `json:"systemIntent,omitempty"`
 }
 
 // DialogFlowResponseSystemIntent struct
@@ -89,5 +166,5 @@ type DialogFlowResponseSystemIntent struct {
 type DialogFlowResponseSystemIntentData struct {
 	Type        string   `json:"@type"`
 	OptContext  string   `json:"optContext"`
-	Permissions []string `json:"permissions"`
+	Permissions []string `json:"permissions,omitempty"`
 }
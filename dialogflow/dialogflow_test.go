@@ -0,0 +1,80 @@
+package dialogflow
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRequestRoundTrip(t *testing.T) {
+	for _, name := range []string{"es_request_google.json", "es_request_facebook.json"} {
+		t.Run(name, func(t *testing.T) {
+			sample, err := ioutil.ReadFile(filepath.Join("testdata", name))
+			require.NoError(t, err)
+			var dr Request
+			require.NoError(t, json.Unmarshal(sample, &dr))
+			b, err := json.Marshal(dr)
+			require.NoError(t, err)
+			assert.JSONEq(t, string(sample), string(b))
+		})
+	}
+}
+
+func TestRequestFields(t *testing.T) {
+	sample, err := ioutil.ReadFile(filepath.Join("testdata", "es_request_google.json"))
+	require.NoError(t, err)
+	var dr Request
+	require.NoError(t, json.Unmarshal(sample, &dr))
+
+	assert.False(t, dr.IsFallback())
+	assert.True(t, dr.QueryResult.Intent.EndInteraction)
+	sentiment, ok := dr.Sentiment()
+	assert.True(t, ok)
+	assert.Equal(t, 0.3, sentiment.Score)
+	assert.Equal(t, 0.92, dr.QueryResult.SpeechRecognitionConfidence)
+	assert.Equal(t, "Sure.", dr.QueryResult.FulfillmentText)
+	assert.Equal(t, float64(212), dr.QueryResult.DiagnosticInfo["webhook_latency_ms"])
+	require.Len(t, dr.QueryResult.FulfillmentMessages, 3)
+	assert.Equal(t, TextWrapper{Text: []string{"Sure."}}, dr.QueryResult.FulfillmentMessages[0].RichMessage)
+	assert.Equal(t, ActionsOnGoogle, dr.QueryResult.FulfillmentMessages[1].Platform)
+	assert.Equal(t, "tableCard", dr.QueryResult.FulfillmentMessages[2].RichMessage.GetKey())
+	assert.Equal(t, 16.074345, dr.OriginalDetectIntentRequest.Payload.Device.LocationInfo.Coordinates.Latitude)
+	assert.Equal(t, "Hoang", dr.OriginalDetectIntentRequest.Payload.User.Profile.GivenName)
+
+	var info map[string]interface{}
+	require.NoError(t, dr.GetContext("information", &info))
+	assert.Equal(t, "0905123456", info["phone-number"])
+	assert.Equal(t, "", dr.SenderID())
+}
+
+func TestFallbackRequest(t *testing.T) {
+	sample, err := ioutil.ReadFile(filepath.Join("testdata", "es_request_facebook.json"))
+	require.NoError(t, err)
+	var dr Request
+	require.NoError(t, json.Unmarshal(sample, &dr))
+
+	assert.True(t, dr.IsFallback())
+	sentiment, ok := dr.Sentiment()
+	assert.True(t, ok)
+	assert.True(t, sentiment.Score < 0)
+	assert.Nil(t, dr.OriginalDetectIntentRequest.Payload.Device)
+	assert.Equal(t, "2561402863920917", dr.SenderID())
+}
+
+func TestFulfillmentRoundTrip(t *testing.T) {
+	sample, err := ioutil.ReadFile(filepath.Join("testdata", "es_response.json"))
+	require.NoError(t, err)
+	var rs Fulfillment
+	require.NoError(t, json.Unmarshal(sample, &rs))
+	b, err := json.Marshal(rs)
+	require.NoError(t, err)
+	assert.JSONEq(t, string(sample), string(b))
+
+	require.Len(t, rs.SessionEntityTypes, 1)
+	assert.Equal(t, EntityOverrideModeOverride, rs.SessionEntityTypes[0].EntityOverrideMode)
+	assert.Equal(t, "collect", rs.FollowupEventInput.Name)
+}
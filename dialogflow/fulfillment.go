@@ -10,15 +10,17 @@ type Platform string
 
 const ActionsOnGoogle Platform = "ACTIONS_ON_GOOGLE"
 const Facebook Platform = "FACEBOOK"
+const Telegram Platform = "TELEGRAM"
 
 // Fulfillment is the response sent back to dialogflow in case of a successful webhook call
 type Fulfillment struct {
-	FulfillmentText     string             `json:"fulfillmentText,omitempty"`
-	FulfillmentMessages Messages           `json:"fulfillmentMessages,omitempty"`
-	Source              string             `json:"source,omitempty"`
-	Payload             interface{}        `json:"payload,omitempty"`
-	OutputContexts      Contexts           `json:"outputContexts,omitempty"`
-	FollowupEventInput  FollowupEventInput `json:"followupEventInput,omitempty"`
+	FulfillmentText     string              `json:"fulfillmentText,omitempty"`
+	FulfillmentMessages Messages            `json:"fulfillmentMessages,omitempty"`
+	Source              string              `json:"source,omitempty"`
+	Payload             interface{}         `json:"payload,omitempty"`
+	OutputContexts      Contexts            `json:"outputContexts,omitempty"`
+	FollowupEventInput  *FollowupEventInput `json:"followupEventInput,omitempty"`
+	SessionEntityTypes  []SessionEntityType `json:"sessionEntityTypes,omitempty"`
 }
 
 type FacebookPayloadRequest struct {
@@ -41,6 +43,31 @@ type FollowupEventInput struct {
 	Parameters   interface{} `json:"parameters,omitempty"`
 }
 
+// EntityOverrideMode defines how session entities are merged with the entities
+// of the agent
+type EntityOverrideMode string
+
+// Entity override modes
+const (
+	EntityOverrideModeOverride   EntityOverrideMode = "ENTITY_OVERRIDE_MODE_OVERRIDE"
+	EntityOverrideModeSupplement EntityOverrideMode = "ENTITY_OVERRIDE_MODE_SUPPLEMENT"
+)
+
+// SessionEntityType Optional. Overrides or supplements the entities of an
+// entity type for the rest of the session.
+// https://cloud.google.com/dialogflow/docs/reference/rest/v2/projects.agent.sessions.entityTypes#SessionEntityType
+type SessionEntityType struct {
+	Name               string             `json:"name"`                         // Required. projects/<Project ID>/agent/sessions/<Session ID>/entityTypes/<Entity Type Display Name>
+	EntityOverrideMode EntityOverrideMode `json:"entityOverrideMode,omitempty"` // Required. How the entities are merged with the agent ones.
+	Entities           []Entity           `json:"entities,omitempty"`           // Required. The collection of entities of this session entity type.
+}
+
+// Entity is a single entity of an entity type
+type Entity struct {
+	Value    string   `json:"value"`              // Required. The primary value associated with this entity entry.
+	Synonyms []string `json:"synonyms,omitempty"` // Required. A collection of value synonyms.
+}
+
 // Messages is a simple slice of Message
 type Messages []Message
 
@@ -80,6 +107,100 @@ func (m *Message) MarshalJSON() ([]byte, error) {
 	return buffer.Bytes(), nil
 }
 
+// UnmarshalJSON implements the Unmarshaller interface for the JSON type.
+// The rich message type is picked from the key, unknown keys are kept as a
+// RawRichMessage so that nothing sent by dialogflow is lost
+func (m *Message) UnmarshalJSON(data []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	*m = Message{}
+	for k, v := range raw {
+		if k == "platform" {
+			if err := json.Unmarshal(v, &m.Platform); err != nil {
+				return err
+			}
+			continue
+		}
+		r, err := unmarshalRichMessage(k, v)
+		if err != nil {
+			return err
+		}
+		m.RichMessage = r
+	}
+	return nil
+}
+
+func unmarshalRichMessage(key string, data json.RawMessage) (RichMessage, error) {
+	var err error
+	switch key {
+	case "text":
+		var r TextWrapper
+		err = json.Unmarshal(data, &r)
+		return r, err
+	case "image":
+		var r Image
+		err = json.Unmarshal(data, &r)
+		return r, err
+	case "quickReplies":
+		var r QuickReplies
+		err = json.Unmarshal(data, &r)
+		return r, err
+	case "card":
+		var r Card
+		err = json.Unmarshal(data, &r)
+		return r, err
+	case "simpleResponses":
+		var r SimpleResponsesWrapper
+		err = json.Unmarshal(data, &r)
+		return r, err
+	case "basicCard":
+		var r BasicCard
+		err = json.Unmarshal(data, &r)
+		return r, err
+	case "suggestions":
+		var r Suggestions
+		err = json.Unmarshal(data, &r)
+		return r, err
+	case "linkOutSuggestion":
+		var r LinkOutSuggestion
+		err = json.Unmarshal(data, &r)
+		return r, err
+	case "listSelect":
+		var r ListSelect
+		err = json.Unmarshal(data, &r)
+		return r, err
+	case "carouselSelect":
+		var r CarouselSelect
+		err = json.Unmarshal(data, &r)
+		return r, err
+	case "payload":
+		var r PayloadWrapper
+		err = json.Unmarshal(data, &r.Payload)
+		return r, err
+	}
+	return RawRichMessage{Key: key, Data: data}, nil
+}
+
+// RawRichMessage holds a rich message this package doesn't model, so it can be
+// sent back as it was received
+type RawRichMessage struct {
+	Key  string
+	Data json.RawMessage
+}
+
+// MarshalJSON implements the Marshaller interface and returns the raw data
+func (r RawRichMessage) MarshalJSON() ([]byte, error) {
+	return r.Data.MarshalJSON()
+}
+
+// GetKey implements the RichMessage interface and returns the key the rich
+// message was received with
+func (r RawRichMessage) GetKey() string {
+	return r.Key
+}
+
 // ForGoogle takes a rich message wraps it in a message with the appropriate
 // platform set
 func ForGoogle(r RichMessage) Message {
@@ -111,21 +232,34 @@ type FBPostBack struct {
 }
 
 type PayloadInfo struct {
-	User              UserInfo    `json:"user,omitempty"`
+	Data              interface{} `json:"data,omitempty"`
+	Source            string      `json:"source,omitempty"`
+	User              *UserInfo   `json:"user,omitempty"`
 	Conversation      interface{} `json:"conversation,omitempty"`
+	Inputs            interface{} `json:"inputs,omitempty"`
 	Surface           interface{} `json:"surface,omitempty"`
-	Device            DeviceInfo  `json:"device,omitempty"`
+	Device            *DeviceInfo `json:"device,omitempty"`
 	IsInSandbox       interface{} `json:"isInSandbox,omitempty"`
 	AvailableSurfaces interface{} `json:"availableSurfaces,omitempty"`
 	PostBack          interface{} `json:"postback,omitempty"`
 }
 
 type UserInfo struct {
-	AccessToken            string   `json:"accessToken,omitempty"`
-	Permissions            []string `json:"permissions,omitempty"`
-	Locale                 string   `json:"locale,omitempty"`
-	LastSeen               string   `json:"lastSeen,omitempty"`
-	UserVerificationStatus string   `json:"userVerificationStatus,omitempty"`
+	UserID                 string       `json:"userId,omitempty"`
+	IDToken                string       `json:"idToken,omitempty"`
+	Profile                *UserProfile `json:"profile,omitempty"`
+	AccessToken            string       `json:"accessToken,omitempty"`
+	Permissions            []string     `json:"permissions,omitempty"`
+	Locale                 string       `json:"locale,omitempty"`
+	LastSeen               string       `json:"lastSeen,omitempty"`
+	UserVerificationStatus string       `json:"userVerificationStatus,omitempty"`
+}
+
+// UserProfile is only sent once the user granted the NAME permission
+type UserProfile struct {
+	DisplayName string `json:"displayName,omitempty"`
+	GivenName   string `json:"givenName,omitempty"`
+	FamilyName  string `json:"familyName,omitempty"`
 }
 
 type DeviceInfo struct {
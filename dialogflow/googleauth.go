@@ -0,0 +1,258 @@
+package dialogflow
+
+import (
+	"context"
+	"crypto"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// GoogleCertsURL serves the keys Google signs the ID tokens with
+const GoogleCertsURL = "https://www.googleapis.com/oauth2/v3/certs"
+
+// SignInStatusOK is the status of the SIGN_IN argument when the user linked
+// their account
+const SignInStatusOK = "OK"
+
+// Errors returned when an ID token is rejected
+var (
+	ErrNoIDToken      = errors.New("no ID token in request")
+	ErrInvalidIDToken = errors.New("invalid ID token")
+)
+
+// IDTokenVerifier verifies the ID tokens Actions on Google sends once the
+// user signed in with Google, see
+// https://developers.google.com/assistant/identity/google-sign-in
+type IDTokenVerifier struct {
+	// ClientID is the client ID of the Actions project, the audience of the
+	// tokens
+	ClientID   string
+	CertsURL   string
+	HTTPClient *http.Client
+
+	now     func() time.Time
+	mu      sync.Mutex
+	keys    map[string]*rsa.PublicKey
+	expires time.Time
+	fetched time.Time
+}
+
+// NewIDTokenVerifier returns a verifier for the tokens issued to the client
+func NewIDTokenVerifier(clientID string) *IDTokenVerifier {
+	return &IDTokenVerifier{
+		ClientID:   clientID,
+		CertsURL:   GoogleCertsURL,
+		HTTPClient: &http.Client{Timeout: 5 * time.Second},
+		now:        time.Now,
+	}
+}
+
+type idTokenHeader struct {
+	Alg string `json:"alg"`
+	Kid string `json:"kid"`
+}
+
+type idTokenClaims struct {
+	Issuer        string      `json:"iss"`
+	Audience      string      `json:"aud"`
+	Subject       string      `json:"sub"`
+	Expires       int64       `json:"exp"`
+	Email         string      `json:"email"`
+	EmailVerified interface{} `json:"email_verified"`
+	Name          string      `json:"name"`
+	GivenName     string      `json:"given_name"`
+	FamilyName    string      `json:"family_name"`
+	Picture       string      `json:"picture"`
+	Locale        string      `json:"locale"`
+	HD            string      `json:"hd"`
+}
+
+// Verify checks the signature, the issuer, the audience and the expiry of the
+// token and returns the profile of the user
+func (v *IDTokenVerifier) Verify(ctx context.Context, token string) (*GoogleAuthResponse, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, ErrInvalidIDToken
+	}
+	var header idTokenHeader
+	if err := decodeSegment(parts[0], &header); err != nil {
+		return nil, ErrInvalidIDToken
+	}
+	if header.Alg != "RS256" {
+		return nil, fmt.Errorf("%v: unexpected algorithm %q", ErrInvalidIDToken, header.Alg)
+	}
+	key, err := v.key(ctx, header.Kid)
+	if err != nil {
+		return nil, err
+	}
+	sig, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, ErrInvalidIDToken
+	}
+	hash := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+	if err := rsa.VerifyPKCS1v15(key, crypto.SHA256, hash[:], sig); err != nil {
+		return nil, fmt.Errorf("%v: bad signature", ErrInvalidIDToken)
+	}
+
+	var claims idTokenClaims
+	if err := decodeSegment(parts[1], &claims); err != nil {
+		return nil, ErrInvalidIDToken
+	}
+	if claims.Issuer != "accounts.google.com" && claims.Issuer != "https://accounts.google.com" {
+		return nil, fmt.Errorf("%v: unexpected issuer %q", ErrInvalidIDToken, claims.Issuer)
+	}
+	if claims.Audience != v.ClientID {
+		return nil, fmt.Errorf("%v: unexpected audience %q", ErrInvalidIDToken, claims.Audience)
+	}
+	if v.now().Unix() >= claims.Expires {
+		return nil, fmt.Errorf("%v: expired", ErrInvalidIDToken)
+	}
+	// email_verified is a boolean in the tokens, but was a string in older ones
+	verified := claims.EmailVerified == true || claims.EmailVerified == "true"
+	return &GoogleAuthResponse{
+		UserID:        claims.Subject,
+		Email:         claims.Email,
+		VerifiedEmail: verified,
+		Name:          claims.Name,
+		GivenName:     claims.GivenName,
+		FamilyName:    claims.FamilyName,
+		Picture:       claims.Picture,
+		Locale:        claims.Locale,
+		HD:            claims.HD,
+	}, nil
+}
+
+// VerifyRequest verifies the ID token of the user of the request
+func (v *IDTokenVerifier) VerifyRequest(ctx context.Context, dr Request) (*GoogleAuthResponse, error) {
+	user := dr.OriginalDetectIntentRequest.Payload.User
+	if user == nil || user.IDToken == "" {
+		return nil, ErrNoIDToken
+	}
+	return v.Verify(ctx, user.IDToken)
+}
+
+func decodeSegment(s string, i interface{}) error {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, i)
+}
+
+// key returns the key with the ID, the keys are fetched again once their
+// max-age is over or when an unknown key shows up after a rotation, at most
+// once a minute
+func (v *IDTokenVerifier) key(ctx context.Context, kid string) (*rsa.PublicKey, error) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	now := v.now()
+	key, ok := v.keys[kid]
+	if now.Before(v.expires) && (ok || now.Sub(v.fetched) < time.Minute) {
+		if !ok {
+			return nil, fmt.Errorf("%v: unknown key %q", ErrInvalidIDToken, kid)
+		}
+		return key, nil
+	}
+	if err := v.fetchKeys(ctx); err != nil {
+		return nil, err
+	}
+	key, ok = v.keys[kid]
+	if !ok {
+		return nil, fmt.Errorf("%v: unknown key %q", ErrInvalidIDToken, kid)
+	}
+	return key, nil
+}
+
+type jwks struct {
+	Keys []struct {
+		Kid string `json:"kid"`
+		Kty string `json:"kty"`
+		N   string `json:"n"`
+		E   string `json:"e"`
+	} `json:"keys"`
+}
+
+func (v *IDTokenVerifier) fetchKeys(ctx context.Context) error {
+	req, err := http.NewRequest(http.MethodGet, v.CertsURL, nil)
+	if err != nil {
+		return err
+	}
+	res, err := v.HTTPClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("fetching Google certs: unexpected status %s", res.Status)
+	}
+	var set jwks
+	if err := json.NewDecoder(res.Body).Decode(&set); err != nil {
+		return err
+	}
+	keys := make(map[string]*rsa.PublicKey)
+	for _, k := range set.Keys {
+		if k.Kty != "RSA" {
+			continue
+		}
+		n, err := base64.RawURLEncoding.DecodeString(k.N)
+		if err != nil {
+			return err
+		}
+		e, err := base64.RawURLEncoding.DecodeString(k.E)
+		if err != nil {
+			return err
+		}
+		keys[k.Kid] = &rsa.PublicKey{
+			N: new(big.Int).SetBytes(n),
+			E: int(new(big.Int).SetBytes(e).Int64()),
+		}
+	}
+	v.keys = keys
+	v.fetched = v.now()
+	v.expires = v.fetched.Add(maxAge(res.Header.Get("Cache-Control")))
+	return nil
+}
+
+// maxAge returns the max-age of a Cache-Control header, an hour when it has
+// none
+func maxAge(cacheControl string) time.Duration {
+	for _, d := range strings.Split(cacheControl, ",") {
+		d = strings.TrimSpace(d)
+		if strings.HasPrefix(d, "max-age=") {
+			if s, err := strconv.Atoi(strings.TrimPrefix(d, "max-age=")); err == nil {
+				return time.Duration(s) * time.Second
+			}
+		}
+	}
+	return time.Hour
+}
+
+// SignInStatus returns the status of the SIGN_IN argument Actions on Google
+// sends after asking the user to sign in, empty when there is none
+func (rw *Request) SignInStatus() string {
+	inputs, _ := rw.OriginalDetectIntentRequest.Payload.Inputs.([]interface{})
+	for _, i := range inputs {
+		input, _ := i.(map[string]interface{})
+		args, _ := input["arguments"].([]interface{})
+		for _, a := range args {
+			arg, _ := a.(map[string]interface{})
+			if arg["name"] != "SIGN_IN" {
+				continue
+			}
+			ext, _ := arg["extension"].(map[string]interface{})
+			status, _ := ext["status"].(string)
+			return status
+		}
+	}
+	return ""
+}
@@ -0,0 +1,136 @@
+package dialogflow
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func signToken(t *testing.T, key *rsa.PrivateKey, kid string, claims map[string]interface{}) string {
+	enc := func(i interface{}) string {
+		b, err := json.Marshal(i)
+		require.NoError(t, err)
+		return base64.RawURLEncoding.EncodeToString(b)
+	}
+	signed := enc(map[string]string{"alg": "RS256", "kid": kid, "typ": "JWT"}) + "." + enc(claims)
+	hash := sha256.Sum256([]byte(signed))
+	sig, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, hash[:])
+	require.NoError(t, err)
+	return signed + "." + base64.RawURLEncoding.EncodeToString(sig)
+}
+
+func certsServer(key *rsa.PrivateKey, kid string, fetches *int) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*fetches++
+		w.Header().Set("Cache-Control", "public, max-age=600, must-revalidate")
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"keys": []map[string]string{{
+			"kid": kid,
+			"kty": "RSA",
+			"alg": "RS256",
+			"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+			"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+		}}})
+	}))
+}
+
+func TestIDTokenVerifier(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	other, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	fetches := 0
+	srv := certsServer(key, "k1", &fetches)
+	defer srv.Close()
+
+	now := time.Unix(1573547400, 0)
+	v := NewIDTokenVerifier("client.apps.googleusercontent.com")
+	v.CertsURL = srv.URL
+	v.now = func() time.Time { return now }
+	claims := func() map[string]interface{} {
+		return map[string]interface{}{
+			"iss":            "https://accounts.google.com",
+			"aud":            "client.apps.googleusercontent.com",
+			"sub":            "1234567890",
+			"exp":            now.Add(time.Hour).Unix(),
+			"email":          "hoang@example.com",
+			"email_verified": true,
+			"name":           "Hoang Nguyen",
+			"given_name":     "Hoang",
+		}
+	}
+	ctx := context.Background()
+
+	user, err := v.Verify(ctx, signToken(t, key, "k1", claims()))
+	require.NoError(t, err)
+	assert.Equal(t, "1234567890", user.UserID)
+	assert.Equal(t, "hoang@example.com", user.Email)
+	assert.True(t, user.VerifiedEmail)
+	assert.Equal(t, "Hoang", user.GivenName)
+
+	bad := map[string]func(c map[string]interface{}){
+		"audience": func(c map[string]interface{}) { c["aud"] = "other" },
+		"issuer":   func(c map[string]interface{}) { c["iss"] = "evil.com" },
+		"expired":  func(c map[string]interface{}) { c["exp"] = now.Unix() },
+	}
+	for name, change := range bad {
+		c := claims()
+		change(c)
+		_, err := v.Verify(ctx, signToken(t, key, "k1", c))
+		assert.True(t, invalidIDToken(err), name)
+	}
+	_, err = v.Verify(ctx, signToken(t, other, "k1", claims()))
+	assert.True(t, invalidIDToken(err))
+	_, err = v.Verify(ctx, "not.a.token")
+	assert.True(t, invalidIDToken(err))
+
+	// keys are cached and unknown keys don't trigger a fetch every time
+	assert.Equal(t, 1, fetches)
+	_, err = v.Verify(ctx, signToken(t, key, "k2", claims()))
+	assert.Error(t, err)
+	assert.Equal(t, 1, fetches)
+	now = now.Add(2 * time.Minute)
+	_, _ = v.Verify(ctx, signToken(t, key, "k2", claims()))
+	assert.Equal(t, 2, fetches)
+}
+
+func TestSignInStatus(t *testing.T) {
+	var dr Request
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"originalDetectIntentRequest": {
+			"source": "google",
+			"payload": {
+				"user": {"idToken": "token"},
+				"inputs": [{
+					"intent": "actions.intent.SIGN_IN",
+					"arguments": [{
+						"name": "SIGN_IN",
+						"extension": {"@type": "type.googleapis.com/google.actions.v2.SignInValue", "status": "OK"}
+					}]
+				}]
+			}
+		}
+	}`), &dr))
+	assert.Equal(t, SignInStatusOK, dr.SignInStatus())
+	assert.Equal(t, "", (&Request{}).SignInStatus())
+
+	_, err := NewIDTokenVerifier("client").VerifyRequest(context.Background(), Request{})
+	assert.Equal(t, ErrNoIDToken, err)
+}
+
+// invalidIDToken reports whether the ID token was rejected, for whatever reason
+func invalidIDToken(err error) bool {
+	return err != nil && strings.HasPrefix(err.Error(), ErrInvalidIDToken.Error())
+}
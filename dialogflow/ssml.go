@@ -0,0 +1,135 @@
+package dialogflow
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"time"
+)
+
+// Interpretation is the value of the interpret-as attribute of a say-as tag
+type Interpretation string
+
+// Interpretations supported by Actions on Google
+const (
+	InterpretTelephone  Interpretation = "telephone"
+	InterpretDate       Interpretation = "date"
+	InterpretTime       Interpretation = "time"
+	InterpretCardinal   Interpretation = "cardinal"
+	InterpretOrdinal    Interpretation = "ordinal"
+	InterpretCharacters Interpretation = "characters"
+	InterpretVerbatim   Interpretation = "verbatim"
+)
+
+// Strength is the strength of a break or the level of an emphasis
+type Strength string
+
+// Break strengths
+const (
+	BreakNone    Strength = "none"
+	BreakXWeak   Strength = "x-weak"
+	BreakWeak    Strength = "weak"
+	BreakMedium  Strength = "medium"
+	BreakStrong  Strength = "strong"
+	BreakXStrong Strength = "x-strong"
+)
+
+// Emphasis levels
+const (
+	EmphasisStrong   Strength = "strong"
+	EmphasisModerate Strength = "moderate"
+	EmphasisReduced  Strength = "reduced"
+)
+
+// SSML builds a Speech Synthesis Markup Language document that can be sent in
+// the SSML field of a SimpleResponse. Every text and attribute added through
+// the builder is escaped, so it is safe to use it with user input.
+type SSML struct {
+	buf bytes.Buffer
+}
+
+// NewSSML returns an empty SSML builder
+func NewSSML() *SSML {
+	return &SSML{}
+}
+
+// Text adds plain text to the document
+func (s *SSML) Text(text string) *SSML {
+	escape(&s.buf, text)
+	return s
+}
+
+// SayAs tells the speech synthesizer how to read the given text
+func (s *SSML) SayAs(interpretAs Interpretation, format, text string) *SSML {
+	s.buf.WriteString(`<say-as interpret-as="`)
+	escape(&s.buf, string(interpretAs))
+	s.buf.WriteString(`"`)
+	if format != "" {
+		s.buf.WriteString(` format="`)
+		escape(&s.buf, format)
+		s.buf.WriteString(`"`)
+	}
+	s.buf.WriteString(">")
+	escape(&s.buf, text)
+	s.buf.WriteString("</say-as>")
+	return s
+}
+
+// Telephone reads the given number digit by digit as a phone number
+func (s *SSML) Telephone(number string) *SSML {
+	return s.SayAs(InterpretTelephone, "", number)
+}
+
+// Date reads the day, month and year of the given time
+func (s *SSML) Date(t time.Time) *SSML {
+	return s.SayAs(InterpretDate, "yyyymmdd", t.Format("2006-01-02"))
+}
+
+// Time reads the hour and minutes of the given time using a 12 hours clock
+func (s *SSML) Time(t time.Time) *SSML {
+	return s.SayAs(InterpretTime, "hms12", t.Format("3:04pm"))
+}
+
+// Break adds a pause of the given duration
+func (s *SSML) Break(d time.Duration) *SSML {
+	s.buf.WriteString(fmt.Sprintf(`<break time="%dms"/>`, d/time.Millisecond))
+	return s
+}
+
+// BreakStrength adds a pause of the given strength
+func (s *SSML) BreakStrength(strength Strength) *SSML {
+	s.buf.WriteString(`<break strength="`)
+	escape(&s.buf, string(strength))
+	s.buf.WriteString(`"/>`)
+	return s
+}
+
+// Emphasis adds text spoken with the given emphasis level
+func (s *SSML) Emphasis(level Strength, text string) *SSML {
+	s.buf.WriteString(`<emphasis level="`)
+	escape(&s.buf, string(level))
+	s.buf.WriteString(`">`)
+	escape(&s.buf, text)
+	s.buf.WriteString("</emphasis>")
+	return s
+}
+
+// Audio plays the audio file at the given URL. The fallback text is spoken if
+// the file can't be played.
+func (s *SSML) Audio(src, fallback string) *SSML {
+	s.buf.WriteString(`<audio src="`)
+	escape(&s.buf, src)
+	s.buf.WriteString(`">`)
+	escape(&s.buf, fallback)
+	s.buf.WriteString("</audio>")
+	return s
+}
+
+// String returns the document wrapped in a speak tag
+func (s *SSML) String() string {
+	return "<speak>" + s.buf.String() + "</speak>"
+}
+
+func escape(buf *bytes.Buffer, text string) {
+	_ = xml.EscapeText(buf, []byte(text))
+}
@@ -0,0 +1,25 @@
+package dialogflow
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSSMLEscapesInput(t *testing.T) {
+	s := NewSSML().Text(`Tom & "Jerry" <3`).Emphasis(EmphasisStrong, "a<b").Audio(`https://x.y/a.ogg?a=1&b=2`, "ding")
+	assert.Equal(t,
+		`<speak>Tom &amp; &#34;Jerry&#34; &lt;3<emphasis level="strong">a&lt;b</emphasis><audio src="https://x.y/a.ogg?a=1&amp;b=2">ding</audio></speak>`,
+		s.String())
+}
+
+func TestSSMLSayAs(t *testing.T) {
+	pickup := time.Date(2019, 11, 12, 14, 30, 0, 0, time.UTC)
+	s := NewSSML().Telephone("0905123456").Break(500 * time.Millisecond).Date(pickup).BreakStrength(BreakWeak).Time(pickup)
+	assert.Equal(t,
+		`<speak><say-as interpret-as="telephone">0905123456</say-as><break time="500ms"/>`+
+			`<say-as interpret-as="date" format="yyyymmdd">2019-11-12</say-as><break strength="weak"/>`+
+			`<say-as interpret-as="time" format="hms12">2:30pm</say-as></speak>`,
+		s.String())
+}
@@ -0,0 +1,147 @@
+package dialogflow
+
+import (
+	"encoding/json"
+	"errors"
+)
+
+// ForTelegram takes a rich message wraps it in a message with the appropriate
+// platform set
+func ForTelegram(r RichMessage) Message {
+	return Message{
+		Platform:    Telegram,
+		RichMessage: r,
+	}
+}
+
+// TelegramPayloadRequest is the custom payload sent to the Telegram integration
+type TelegramPayloadRequest struct {
+	Telegram TelegramMessage `json:"telegram"`
+}
+
+// TelegramMessage is a message sent with the sendMessage method of the
+// Telegram Bot API. ReplyMarkup is either a TelegramInlineKeyboard or a
+// TelegramReplyKeyboard
+// https://core.telegram.org/bots/api#sendmessage
+type TelegramMessage struct {
+	Text        string      `json:"text"`
+	ParseMode   string      `json:"parse_mode,omitempty"`
+	ReplyMarkup interface{} `json:"reply_markup,omitempty"`
+}
+
+// TelegramInlineKeyboard shows buttons right below the message
+type TelegramInlineKeyboard struct {
+	InlineKeyboard [][]TelegramInlineButton `json:"inline_keyboard"`
+}
+
+// TelegramInlineButton is a button of an inline keyboard. CallbackData is sent
+// back to the agent as the user query
+type TelegramInlineButton struct {
+	Text         string `json:"text"`
+	CallbackData string `json:"callback_data,omitempty"`
+	URL          string `json:"url,omitempty"`
+}
+
+// TelegramReplyKeyboard replaces the keyboard of the user with buttons
+type TelegramReplyKeyboard struct {
+	Keyboard        [][]TelegramKeyboardButton `json:"keyboard"`
+	ResizeKeyboard  bool                       `json:"resize_keyboard,omitempty"`
+	OneTimeKeyboard bool                       `json:"one_time_keyboard,omitempty"`
+}
+
+// TelegramKeyboardButton is a button of a reply keyboard. RequestLocation and
+// RequestContact make the client send the location or the phone number of the
+// user instead of the text
+type TelegramKeyboardButton struct {
+	Text            string `json:"text"`
+	RequestContact  bool   `json:"request_contact,omitempty"`
+	RequestLocation bool   `json:"request_location,omitempty"`
+}
+
+// TelegramUpdate is the update received by the Telegram integration, it is
+// forwarded in the data of the original request payload
+// https://core.telegram.org/bots/api#update
+type TelegramUpdate struct {
+	UpdateID      int                    `json:"update_id,omitempty"`
+	Message       *TelegramIncoming      `json:"message,omitempty"`
+	CallbackQuery *TelegramCallbackQuery `json:"callback_query,omitempty"`
+}
+
+// TelegramIncoming is a message sent by the user
+type TelegramIncoming struct {
+	MessageID int               `json:"message_id,omitempty"`
+	From      *TelegramUser     `json:"from,omitempty"`
+	Chat      *TelegramChat     `json:"chat,omitempty"`
+	Date      int64             `json:"date,omitempty"`
+	Text      string            `json:"text,omitempty"`
+	Location  *TelegramLocation `json:"location,omitempty"`
+	Contact   *TelegramContact  `json:"contact,omitempty"`
+}
+
+// TelegramCallbackQuery is sent when the user taps an inline button
+type TelegramCallbackQuery struct {
+	ID      string            `json:"id,omitempty"`
+	From    *TelegramUser     `json:"from,omitempty"`
+	Message *TelegramIncoming `json:"message,omitempty"`
+	Data    string            `json:"data,omitempty"`
+}
+
+// TelegramUser is the sender of a message
+type TelegramUser struct {
+	ID        int64  `json:"id"`
+	FirstName string `json:"first_name,omitempty"`
+	LastName  string `json:"last_name,omitempty"`
+	Username  string `json:"username,omitempty"`
+}
+
+// TelegramChat is the conversation a message belongs to
+type TelegramChat struct {
+	ID   int64  `json:"id"`
+	Type string `json:"type,omitempty"`
+}
+
+// TelegramLocation is the location shared by the user
+type TelegramLocation struct {
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+}
+
+// TelegramContact is the phone number shared by the user
+type TelegramContact struct {
+	PhoneNumber string `json:"phone_number"`
+	FirstName   string `json:"first_name,omitempty"`
+	LastName    string `json:"last_name,omitempty"`
+	UserID      int64  `json:"user_id,omitempty"`
+}
+
+// TelegramUpdate returns the Telegram update the request was made for
+func (rw *Request) TelegramUpdate() (*TelegramUpdate, error) {
+	data := rw.OriginalDetectIntentRequest.Payload.Data
+	if data == nil {
+		return nil, errors.New("no telegram update in payload")
+	}
+	b, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+	update := &TelegramUpdate{}
+	if err := json.Unmarshal(b, update); err != nil {
+		return nil, err
+	}
+	return update, nil
+}
+
+// TelegramLocation returns the location shared by the user
+func (rw *Request) TelegramLocation() (Coordinates, error) {
+	update, err := rw.TelegramUpdate()
+	if err != nil {
+		return Coordinates{}, err
+	}
+	if update.Message == nil || update.Message.Location == nil {
+		return Coordinates{}, errors.New("no location in telegram update")
+	}
+	return Coordinates{
+		Latitude:  update.Message.Location.Latitude,
+		Longitude: update.Message.Location.Longitude,
+	}, nil
+}
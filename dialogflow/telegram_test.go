@@ -0,0 +1,40 @@
+package dialogflow
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTelegramLocation(t *testing.T) {
+	var dr Request
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"originalDetectIntentRequest": {
+			"source": "telegram",
+			"payload": {
+				"source": "telegram",
+				"data": {
+					"update_id": 1,
+					"message": {
+						"message_id": 42,
+						"from": {"id": 7, "first_name": "Hoang"},
+						"chat": {"id": 7, "type": "private"},
+						"location": {"latitude": 16.074345, "longitude": 108.2238513}
+					}
+				}
+			}
+		}
+	}`), &dr))
+
+	assert.Equal(t, Telegram, PlatformOf(dr))
+	c, err := dr.TelegramLocation()
+	require.NoError(t, err)
+	assert.Equal(t, Coordinates{Latitude: 16.074345, Longitude: 108.2238513}, c)
+	assert.Equal(t, "7", dr.SenderID())
+
+	dr.OriginalDetectIntentRequest.Payload.Data = map[string]interface{}{"message": map[string]interface{}{"text": "hi"}}
+	_, err = dr.TelegramLocation()
+	assert.Error(t, err)
+}
@@ -26,8 +26,8 @@ func (bc BasicCard) GetKey() string {
 type Card struct {
 	Title    string   `json:"title,omitempty"`    // Optional. The title of the card.
 	Subtitle string   `json:"subtitle,omitempty"` // Optional. The subtitle of the card.
+	ImageURI string   `json:"imageUri,omitempty"` // Optional. The public URI to an image file for the card.
 	Buttons  []Button `json:"buttons,omitempty"`  // Optional. The collection of card buttons.
-	Image
 }
 
 // GetKey implements the RichMessage interface and returns the JSON key
@@ -57,6 +57,16 @@ func SingleSimpleResponse(display, speech string) SimpleResponsesWrapper {
 	}
 }
 
+// SingleSSMLResponse is a wrapper to create one simple response to display
+// to the user and play with the given SSML speech
+func SingleSSMLResponse(display string, speech *SSML) SimpleResponsesWrapper {
+	return SimpleResponsesWrapper{
+		SimpleResponses: []SimpleResponse{
+			{SSML: speech.String(), DisplayText: display},
+		},
+	}
+}
+
 // SimpleResponse is a simple response sent back to dialogflow.
 // Composed of two types, TextToSpeech will be converted to speech and
 // DisplayText will be displayed if the surface allows to display stuff
@@ -108,8 +118,8 @@ type OpenURIAction struct {
 
 // Image is a simple type of message sent back to dialogflow
 type Image struct {
-	ImageURI          string `json:"imageUri,omitempty"` // Optional. The public URI to an image file.
-	AccessibilityText string `json:"accessibility_text"`
+	ImageURI          string `json:"imageUri,omitempty"`          // Optional. The public URI to an image file.
+	AccessibilityText string `json:"accessibilityText,omitempty"` // Optional. A text description of the image to be used for accessibility.
 }
 
 // GetKey implements the RichMessage interface and returns the JSON key
@@ -201,17 +211,19 @@ func (c CarouselSelect) GetKey() string {
 	return "carouselSelect"
 }
 
+// GoogleAuthResponse is the profile of a user signed in with Google, as
+// returned by IDTokenVerifier
 type GoogleAuthResponse struct {
 	sync.Mutex
-	UserID        string `json:"id, omitempty" `
-	Email         string `json:"email, omitempty"`
-	VerifiedEmail bool   `json:"verified_email, omitempty"`
-	Name          string `json:"name, omitempty"`
-	GivenName     string `json:"given_name, omitempty"`
-	FamilyName    string `json:"family_name, omitempty"`
-	Picture       string `json:"picture, omitempty"`
-	Locale        string `json:"locale, omitempty"`
-	HD            string `json:"hd, omitempty"`
+	UserID        string `json:"id,omitempty"`
+	Email         string `json:"email,omitempty"`
+	VerifiedEmail bool   `json:"verified_email,omitempty"`
+	Name          string `json:"name,omitempty"`
+	GivenName     string `json:"given_name,omitempty"`
+	FamilyName    string `json:"family_name,omitempty"`
+	Picture       string `json:"picture,omitempty"`
+	Locale        string `json:"locale,omitempty"`
+	HD            string `json:"hd,omitempty"`
 }
 
 type CreateIntentRequest struct {
@@ -0,0 +1,355 @@
+package dialogflow
+
+import (
+	"fmt"
+	"unicode/utf8"
+)
+
+// Limits enforced by Actions on Google and Messenger. Responses going over
+// them are rejected by the platform and the user gets no answer at all.
+const (
+	MaxSimpleResponses      = 2
+	MaxSimpleResponseLength = 640
+	MinListItems            = 2
+	MaxListItems            = 30
+	MinCarouselItems        = 2
+	MaxCarouselItems        = 10
+	MaxSuggestions          = 8
+	MaxSuggestionLength     = 25
+	MaxBasicCards           = 1
+	MaxBasicCardButtons     = 1
+	// MaxTitleLength is the length of the titles of the basic cards, the
+	// lists and the items of the lists and carousels, a line of a phone
+	MaxTitleLength = 50
+
+	MaxMessengerTextLength       = 2000
+	MaxMessengerQuickReplies     = 13
+	MaxMessengerQuickReplyLength = 20
+	MaxMessengerCardTitleLength  = 80
+	MaxMessengerCardButtons      = 3
+)
+
+// Validator is implemented by the rich messages that have platform limits
+type Validator interface {
+	Validate() error
+}
+
+// Validate checks the fulfillment against the limits of Actions on Google and
+// Messenger and returns the first violation found
+func (f *Fulfillment) Validate() error {
+	simpleResponses, basicCards := 0, 0
+	firstGoogle := true
+	for i, m := range f.FulfillmentMessages {
+		if err := m.Validate(); err != nil {
+			return fmt.Errorf("fulfillmentMessages[%d]: %v", i, err)
+		}
+		if m.Platform != ActionsOnGoogle {
+			continue
+		}
+		sr, ok := m.RichMessage.(SimpleResponsesWrapper)
+		if firstGoogle && !ok {
+			return fmt.Errorf("fulfillmentMessages[%d]: the first Actions on Google message must be a simple response", i)
+		}
+		firstGoogle = false
+		if ok {
+			simpleResponses += len(sr.SimpleResponses)
+		}
+		if _, ok := m.RichMessage.(BasicCard); ok {
+			basicCards++
+		}
+	}
+	if simpleResponses > MaxSimpleResponses {
+		return fmt.Errorf("%d simple responses, Actions on Google allows at most %d", simpleResponses, MaxSimpleResponses)
+	}
+	if basicCards > MaxBasicCards {
+		return fmt.Errorf("%d basic cards, Actions on Google allows at most %d", basicCards, MaxBasicCards)
+	}
+	return nil
+}
+
+// Validate checks the rich message of the message and the limits specific to
+// its platform
+func (m *Message) Validate() error {
+	if v, ok := m.RichMessage.(Validator); ok {
+		if err := v.Validate(); err != nil {
+			return err
+		}
+	}
+	if m.Platform != Facebook {
+		return nil
+	}
+	switch r := m.RichMessage.(type) {
+	case TextWrapper:
+		for _, t := range r.Text {
+			if err := maxLength("text", t, MaxMessengerTextLength); err != nil {
+				return err
+			}
+		}
+	case QuickReplies:
+		if len(r.Replies) > MaxMessengerQuickReplies {
+			return fmt.Errorf("%d quick replies, Messenger allows at most %d", len(r.Replies), MaxMessengerQuickReplies)
+		}
+		for _, q := range r.Replies {
+			if err := maxLength("quick reply", q, MaxMessengerQuickReplyLength); err != nil {
+				return err
+			}
+		}
+	case Card:
+		if err := maxLength("card title", r.Title, MaxMessengerCardTitleLength); err != nil {
+			return err
+		}
+		if err := maxLength("card subtitle", r.Subtitle, MaxMessengerCardTitleLength); err != nil {
+			return err
+		}
+		if len(r.Buttons) > MaxMessengerCardButtons {
+			return fmt.Errorf("%d card buttons, Messenger allows at most %d", len(r.Buttons), MaxMessengerCardButtons)
+		}
+	}
+	return nil
+}
+
+// Validate implements the Validator interface
+func (s SimpleResponsesWrapper) Validate() error {
+	for _, r := range s.SimpleResponses {
+		if r.TextToSpeech == "" && r.SSML == "" {
+			return fmt.Errorf("simple response needs either a text to speech or an SSML")
+		}
+		if r.TextToSpeech != "" && r.SSML != "" {
+			return fmt.Errorf("simple response can't have both a text to speech and an SSML")
+		}
+		if err := maxLength("text to speech", r.TextToSpeech, MaxSimpleResponseLength); err != nil {
+			return err
+		}
+		if err := maxLength("SSML", r.SSML, MaxSimpleResponseLength); err != nil {
+			return err
+		}
+		if err := maxLength("display text", r.DisplayText, MaxSimpleResponseLength); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Validate implements the Validator interface
+func (bc BasicCard) Validate() error {
+	if bc.FormattedText == "" && bc.Image == nil {
+		return fmt.Errorf("basic card needs either a formatted text or an image")
+	}
+	if err := maxLength("basic card title", bc.Title, MaxTitleLength); err != nil {
+		return err
+	}
+	if len(bc.Buttons) > MaxBasicCardButtons {
+		return fmt.Errorf("%d basic card buttons, Actions on Google allows at most %d", len(bc.Buttons), MaxBasicCardButtons)
+	}
+	return nil
+}
+
+// Validate implements the Validator interface
+func (l ListSelect) Validate() error {
+	if l.Title == "" {
+		return fmt.Errorf("list needs a title")
+	}
+	if err := maxLength("list title", l.Title, MaxTitleLength); err != nil {
+		return err
+	}
+	if len(l.Items) < MinListItems || len(l.Items) > MaxListItems {
+		return fmt.Errorf("%d list items, Actions on Google needs between %d and %d", len(l.Items), MinListItems, MaxListItems)
+	}
+	return validateItems(l.Items)
+}
+
+// Validate implements the Validator interface
+func (c CarouselSelect) Validate() error {
+	if len(c.Items) < MinCarouselItems || len(c.Items) > MaxCarouselItems {
+		return fmt.Errorf("%d carousel items, Actions on Google needs between %d and %d", len(c.Items), MinCarouselItems, MaxCarouselItems)
+	}
+	return validateItems(c.Items)
+}
+
+// Validate implements the Validator interface
+func (s Suggestions) Validate() error {
+	if len(s.Suggestions) > MaxSuggestions {
+		return fmt.Errorf("%d suggestions, Actions on Google allows at most %d", len(s.Suggestions), MaxSuggestions)
+	}
+	for _, sg := range s.Suggestions {
+		if sg.Title == "" {
+			return fmt.Errorf("suggestion needs a title")
+		}
+		if err := maxLength("suggestion", sg.Title, MaxSuggestionLength); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Validate implements the Validator interface
+func (l LinkOutSuggestion) Validate() error {
+	if l.DestinationName == "" || l.URI == "" {
+		return fmt.Errorf("link out suggestion needs a destination name and an URI")
+	}
+	return nil
+}
+
+func validateItems(items []Item) error {
+	keys := make(map[string]bool, len(items))
+	for _, it := range items {
+		if it.Title == "" {
+			return fmt.Errorf("item needs a title")
+		}
+		if err := maxLength("item title", it.Title, MaxTitleLength); err != nil {
+			return err
+		}
+		if it.Info.Key == "" {
+			return fmt.Errorf("item %q needs a key", it.Title)
+		}
+		if keys[it.Info.Key] {
+			return fmt.Errorf("item key %q is used twice", it.Info.Key)
+		}
+		keys[it.Info.Key] = true
+	}
+	return nil
+}
+
+func maxLength(field, s string, max int) error {
+	if n := utf8.RuneCountInString(s); n > max {
+		return fmt.Errorf("%s is %d characters long, at most %d are allowed", field, n, max)
+	}
+	return nil
+}
+
+// Degrade replaces the selections that would be rejected by a simpler
+// equivalent: a single item becomes a basic card, or a simple response when
+// there is a card already, no item at all becomes a simple response with the
+// title of the selection and extra items are cut. The items with the key of
+// an earlier one are dropped and the titles too long are shortened
+func (f *Fulfillment) Degrade() {
+	hasCard := false
+	for _, m := range f.FulfillmentMessages {
+		if _, ok := m.RichMessage.(BasicCard); ok {
+			hasCard = true
+		}
+	}
+	for i, m := range f.FulfillmentMessages {
+		var degraded RichMessage
+		var items []Item
+		switch r := m.RichMessage.(type) {
+		case BasicCard:
+			r.Title = shortTitle(r.Title)
+			f.FulfillmentMessages[i].RichMessage = r
+			continue
+		case ListSelect:
+			r.Title = shortTitle(r.Title)
+			r.Items = shortTitles(uniqueItems(r.Items))
+			items = r.Items
+			degraded = degradeItems(r.Items, r.Title, MaxListItems, hasCard, func(items []Item) RichMessage {
+				return ListSelect{Title: r.Title, Items: items}
+			})
+		case CarouselSelect:
+			r.Items = shortTitles(uniqueItems(r.Items))
+			items = r.Items
+			degraded = degradeItems(r.Items, "", MaxCarouselItems, hasCard, func(items []Item) RichMessage {
+				return CarouselSelect{Items: items}
+			})
+		default:
+			continue
+		}
+		if len(items) == 1 && hasCard {
+			// the item said after the title, not as a third simple response
+			if f.mergeSimpleResponse(i, degraded.(SimpleResponsesWrapper)) {
+				degraded = nil
+			}
+		}
+		if _, ok := degraded.(BasicCard); ok {
+			hasCard = true
+		}
+		f.FulfillmentMessages[i].RichMessage = degraded
+	}
+	var messages Messages
+	for _, m := range f.FulfillmentMessages {
+		if m.RichMessage != nil {
+			messages = append(messages, m)
+		}
+	}
+	f.FulfillmentMessages = messages
+}
+
+// mergeSimpleResponse adds the simple response replacing the selection at i
+// to the simple response right before it, like the title of the selection,
+// so that it doesn't go over MaxSimpleResponses. It reports whether it could
+func (f *Fulfillment) mergeSimpleResponse(i int, sr SimpleResponsesWrapper) bool {
+	if i == 0 || f.FulfillmentMessages[i-1].Platform != f.FulfillmentMessages[i].Platform {
+		return false
+	}
+	prev, ok := f.FulfillmentMessages[i-1].RichMessage.(SimpleResponsesWrapper)
+	if !ok || len(prev.SimpleResponses) != 1 || prev.SimpleResponses[0].TextToSpeech == "" {
+		return false
+	}
+	merged := prev.SimpleResponses[0]
+	added := sr.SimpleResponses[0]
+	merged.TextToSpeech += "\n" + added.TextToSpeech
+	if merged.DisplayText != "" {
+		merged.DisplayText += "\n" + added.DisplayText
+	}
+	f.FulfillmentMessages[i-1].RichMessage = SimpleResponsesWrapper{SimpleResponses: []SimpleResponse{merged}}
+	return true
+}
+
+func degradeItems(items []Item, title string, max int, hasCard bool, selection func([]Item) RichMessage) RichMessage {
+	switch {
+	case len(items) == 0 && title == "":
+		return nil
+	case len(items) == 0:
+		return SingleSimpleResponse(title, title)
+	case len(items) == 1 && hasCard:
+		text := items[0].Title
+		if items[0].Description != "" {
+			text += ": " + items[0].Description
+		}
+		return SingleSimpleResponse(text, text)
+	case len(items) == 1:
+		card := BasicCard{
+			Title:         items[0].Title,
+			FormattedText: items[0].Description,
+			Image:         items[0].Image,
+		}
+		if card.FormattedText == "" && card.Image == nil {
+			card.FormattedText = items[0].Title
+		}
+		return card
+	case len(items) > max:
+		return selection(items[:max])
+	}
+	return selection(items)
+}
+
+// shortTitle cuts the title to MaxTitleLength, ending it with an ellipsis
+func shortTitle(title string) string {
+	if utf8.RuneCountInString(title) <= MaxTitleLength {
+		return title
+	}
+	return string([]rune(title)[:MaxTitleLength-1]) + "…"
+}
+
+// uniqueItems returns the items without the ones with the key of an earlier
+// one, which the platform would reject
+func uniqueItems(items []Item) []Item {
+	seen := make(map[string]bool, len(items))
+	var unique []Item
+	for _, it := range items {
+		if seen[it.Info.Key] {
+			continue
+		}
+		seen[it.Info.Key] = true
+		unique = append(unique, it)
+	}
+	return unique
+}
+
+func shortTitles(items []Item) []Item {
+	short := make([]Item, len(items))
+	for i, it := range items {
+		it.Title = shortTitle(it.Title)
+		short[i] = it
+	}
+	return short
+}
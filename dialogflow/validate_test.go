@@ -0,0 +1,117 @@
+package dialogflow
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func items(n int) []Item {
+	var rs []Item
+	for i := 0; i < n; i++ {
+		key := string(rune('a' + i))
+		rs = append(rs, Item{Info: SelectItemInfo{Key: key}, Title: key, Description: "event " + key})
+	}
+	return rs
+}
+
+func TestValidate(t *testing.T) {
+	intro := ForGoogle(SingleSimpleResponse("hi", "hi"))
+	cases := []struct {
+		name  string
+		msgs  Messages
+		valid bool
+	}{
+		{"list", Messages{intro, ForGoogle(ListSelect{Title: "Events", Items: items(2)})}, true},
+		{"one item list", Messages{intro, ForGoogle(ListSelect{Title: "Events", Items: items(1)})}, false},
+		{"list without simple response", Messages{ForGoogle(ListSelect{Title: "Events", Items: items(2)})}, false},
+		{"big carousel", Messages{intro, ForGoogle(CarouselSelect{Items: items(11)})}, false},
+		{"duplicated keys", Messages{intro, ForGoogle(ListSelect{Title: "Events", Items: append(items(2), items(1)...)})}, false},
+		{"three simple responses", Messages{intro, intro, intro}, false},
+		{"long speech", Messages{ForGoogle(SingleSimpleResponse("hi", strings.Repeat("a", 641)))}, false},
+		{"title", Messages{intro, ForGoogle(BasicCard{Title: strings.Repeat("a", 50), FormattedText: "b"})}, true},
+		{"two basic cards", Messages{intro, ForGoogle(BasicCard{Title: "a", FormattedText: "b"}), ForGoogle(BasicCard{Title: "c", FormattedText: "d"})}, false},
+		{"long card title", Messages{intro, ForGoogle(BasicCard{Title: strings.Repeat("a", 51), FormattedText: "b"})}, false},
+		{"long list title", Messages{intro, ForGoogle(ListSelect{Title: strings.Repeat("a", 51), Items: items(2)})}, false},
+		{"long item title", Messages{intro, ForGoogle(CarouselSelect{Items: append(items(1), Item{Info: SelectItemInfo{Key: "z"}, Title: strings.Repeat("ă", 51)})})}, false},
+		{"long suggestion", Messages{intro, ForGoogle(Suggestions{Suggestions: []Suggestion{{Title: strings.Repeat("a", 26)}}})}, false},
+		{"messenger text", Messages{ForFacebook(TextWrapper{Text: []string{strings.Repeat("a", 2000)}})}, true},
+		{"long messenger text", Messages{ForFacebook(TextWrapper{Text: []string{strings.Repeat("a", 2001)}})}, false},
+		{"long quick reply", Messages{ForFacebook(QuickReplies{Replies: []string{strings.Repeat("a", 21)}})}, false},
+		{"messenger card buttons", Messages{ForFacebook(Card{Title: "a", Buttons: make([]Button, 4)})}, false},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			f := Fulfillment{FulfillmentMessages: c.msgs}
+			err := f.Validate()
+			if c.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestDegrade(t *testing.T) {
+	intro := ForGoogle(SingleSimpleResponse("hi", "hi"))
+
+	f := Fulfillment{FulfillmentMessages: Messages{intro, ForGoogle(ListSelect{Title: "Events", Items: items(1)})}}
+	f.Degrade()
+	assert.Equal(t, BasicCard{Title: "a", FormattedText: "event a"}, f.FulfillmentMessages[1].RichMessage)
+	assert.NoError(t, f.Validate())
+
+	f = Fulfillment{FulfillmentMessages: Messages{intro, ForGoogle(ListSelect{Title: "No events"})}}
+	f.Degrade()
+	assert.Equal(t, SingleSimpleResponse("No events", "No events"), f.FulfillmentMessages[1].RichMessage)
+	assert.NoError(t, f.Validate())
+
+	f = Fulfillment{FulfillmentMessages: Messages{intro, ForGoogle(CarouselSelect{})}}
+	f.Degrade()
+	assert.Len(t, f.FulfillmentMessages, 1)
+
+	f = Fulfillment{FulfillmentMessages: Messages{intro, ForGoogle(CarouselSelect{Items: items(12)})}}
+	f.Degrade()
+	assert.Len(t, f.FulfillmentMessages[1].RichMessage.(CarouselSelect).Items, MaxCarouselItems)
+	assert.NoError(t, f.Validate())
+}
+
+func TestDegradeWithCard(t *testing.T) {
+	google := Request{}
+	logo := Image{ImageURI: "https://example.com/logo.png", AccessibilityText: "logo"}
+	event := Choice{Key: "Winter clothes", Title: "Winter clothes", Description: "12 Bach Dang"}
+
+	f := NewBuilder(google).Text("Welcome!").Card("We Collect We Share", "", logo).Choices("We have some events for you", []Choice{event}).Build()
+	f.Degrade()
+	assert.NoError(t, f.Validate(), "the single event isn't a second card")
+	assert.Len(t, f.FulfillmentMessages, 3)
+	assert.Equal(t, SingleSimpleResponse("We have some events for you\nWinter clothes: 12 Bach Dang", "We have some events for you\nWinter clothes: 12 Bach Dang"), f.FulfillmentMessages[2].RichMessage)
+
+	f = NewBuilder(google).Text("Welcome!").Card("We Collect We Share", "", logo).Choices("We have some events for you", []Choice{event, event}).Build()
+	f.Degrade()
+	assert.NoError(t, f.Validate(), "the events with the same name are said once")
+
+	other := Choice{Key: "Books for kids", Title: "Books for kids"}
+	f = NewBuilder(google).Text("Welcome!").Choices("Events", []Choice{event, other, event}).Build()
+	f.Degrade()
+	assert.NoError(t, f.Validate())
+	assert.Len(t, f.FulfillmentMessages[2].RichMessage.(ListSelect).Items, 2)
+}
+
+func TestDegradeTitles(t *testing.T) {
+	intro := ForGoogle(SingleSimpleResponse("hi", "hi"))
+	long := strings.Repeat("Quần áo ấm ", 6)
+	f := Fulfillment{FulfillmentMessages: Messages{
+		intro,
+		ForGoogle(BasicCard{Title: long, FormattedText: "b"}),
+		ForGoogle(ListSelect{Title: long, Items: append(items(1), Item{Info: SelectItemInfo{Key: "z"}, Title: long})}),
+	}}
+	assert.Error(t, f.Validate())
+	f.Degrade()
+	assert.NoError(t, f.Validate())
+	title := f.FulfillmentMessages[1].RichMessage.(BasicCard).Title
+	assert.Equal(t, MaxTitleLength, len([]rune(title)))
+	assert.True(t, strings.HasSuffix(title, "…"))
+	assert.Equal(t, title, f.FulfillmentMessages[2].RichMessage.(ListSelect).Items[1].Title)
+}
@@ -0,0 +1,100 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"wcws/config"
+	"wcws/webhook"
+)
+
+const exportUsage = `usage: wcws export [-config file] [-format csv|xlsx] [-status status] [-event id]
+                   [-from 2006-01-02] [-to 2006-01-02] [-columns a,b] [-tz zone] [-o file]`
+
+// runExport writes the donations of the Firestore database of the
+// configuration to a file or the standard output, and returns the exit
+// status
+func runExport(args []string) int {
+	fs := flag.NewFlagSet("export", flag.ContinueOnError)
+	fs.Usage = func() {
+		fmt.Fprintln(os.Stderr, exportUsage)
+		fs.PrintDefaults()
+	}
+	file := fs.String("config", "", "configuration file, $"+config.FileEnv+" by default")
+	format := fs.String("format", "csv", "csv or xlsx")
+	status := fs.String("status", "", "only the donations with the status")
+	event := fs.String("event", "", "only the donations to the event")
+	from := fs.String("from", "", "only the donations created from the day")
+	to := fs.String("to", "", "only the donations created until the day, included")
+	columns := fs.String("columns", strings.Join(webhook.DefaultExportColumns, ","), "columns of the export")
+	tz := fs.String("tz", "", "time zone of the dates, the configured one by default")
+	out := fs.String("o", "", "output file, the standard output by default")
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+
+	var configArgs []string
+	if *file != "" {
+		configArgs = []string{"-config", *file}
+	}
+	cfg, err := config.Load(configArgs, os.Getenv)
+	if err != nil {
+		return exportFailed(err)
+	}
+	if *tz != "" {
+		cfg.Export.Timezone = *tz
+	}
+	loc, err := cfg.Export.Location()
+	if err != nil {
+		return exportFailed(err)
+	}
+	opts := webhook.ExportOptions{Format: *format, Columns: strings.Split(*columns, ","), Location: loc}
+	opts.Filter.Status = *status
+	if *event != "" {
+		if opts.Filter.EventID, err = strconv.ParseFloat(*event, 64); err != nil {
+			return exportFailed(fmt.Errorf("invalid event %q", *event))
+		}
+	}
+	if *from != "" {
+		if opts.Filter.From, err = webhook.ParseExportDate(*from, loc, false); err != nil {
+			return exportFailed(err)
+		}
+	}
+	if *to != "" {
+		if opts.Filter.To, err = webhook.ParseExportDate(*to, loc, true); err != nil {
+			return exportFailed(err)
+		}
+	}
+	if err := opts.Validate(); err != nil {
+		return exportFailed(err)
+	}
+
+	ctx := context.Background()
+	store, err := webhook.NewFirestoreStore(ctx, cfg.Firestore)
+	if err != nil {
+		return exportFailed(err)
+	}
+	var w io.Writer = os.Stdout
+	if *out != "" {
+		f, err := os.Create(*out)
+		if err != nil {
+			return exportFailed(err)
+		}
+		defer f.Close()
+		w = f
+	}
+	if err := webhook.ExportTransactions(ctx, store, w, opts); err != nil {
+		return exportFailed(err)
+	}
+	return 0
+}
+
+func exportFailed(err error) int {
+	fmt.Fprintln(os.Stderr, "wcws export:", err)
+	return 1
+}
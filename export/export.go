@@ -0,0 +1,101 @@
+// Package export writes tables as CSV or XLSX files row by row, so that
+// exports of any size are streamed without being held in memory
+package export
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Formats of the exports
+const (
+	CSV  = "csv"
+	XLSX = "xlsx"
+)
+
+// TimeLayout is how the times are written in the CSV files
+const TimeLayout = "2006-01-02 15:04:05"
+
+// Writer writes the rows of a table. The cells are strings, numbers (float64
+// or int64) or times, written in their own location, or nil for the empty
+// cells
+type Writer interface {
+	Write(cells []interface{}) error
+	// Close writes the end of the file, it doesn't close the underlying
+	// writer
+	Close() error
+}
+
+// New returns the writer of the format
+func New(format string, w io.Writer) (Writer, error) {
+	switch format {
+	case CSV:
+		return NewCSV(w), nil
+	case XLSX:
+		return NewXLSX(w, "Sheet1")
+	}
+	return nil, fmt.Errorf("unknown export format %q, expected csv or xlsx", format)
+}
+
+// ContentType is the MIME type of the format
+func ContentType(format string) string {
+	if format == XLSX {
+		return "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	}
+	return "text/csv; charset=utf-8"
+}
+
+type csvWriter struct {
+	w *csv.Writer
+}
+
+// NewCSV returns a writer of CSV rows
+func NewCSV(w io.Writer) Writer {
+	return &csvWriter{w: csv.NewWriter(w)}
+}
+
+func (c *csvWriter) Write(cells []interface{}) error {
+	record := make([]string, len(cells))
+	for i, cell := range cells {
+		switch v := cell.(type) {
+		case nil:
+		case string:
+			record[i] = safeText(v)
+		case float64:
+			record[i] = strconv.FormatFloat(v, 'f', -1, 64)
+		case int64:
+			record[i] = strconv.FormatInt(v, 10)
+		case time.Time:
+			record[i] = v.Format(TimeLayout)
+		default:
+			return fmt.Errorf("unsupported cell %T", cell)
+		}
+	}
+	return c.w.Write(record)
+}
+
+func (c *csvWriter) Close() error {
+	c.w.Flush()
+	return c.w.Error()
+}
+
+// safeText keeps the spreadsheets from reading the texts written by the
+// donors as formulas. Phone numbers like +84 905 123 456 are left as is
+func safeText(s string) string {
+	if s == "" {
+		return s
+	}
+	switch s[0] {
+	case '=', '@', '\t', '\r':
+		return "'" + s
+	case '+', '-':
+		if strings.Trim(s[1:], "0123456789 .()") != "" {
+			return "'" + s
+		}
+	}
+	return s
+}
@@ -0,0 +1,108 @@
+package export
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"io/ioutil"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var ict = time.FixedZone("ICT", 7*60*60)
+
+func TestCSV(t *testing.T) {
+	var buf bytes.Buffer
+	w := NewCSV(&buf)
+	require.NoError(t, w.Write([]interface{}{"id", "createdDate", "eventId", "phoneNumber", "description"}))
+	require.NoError(t, w.Write([]interface{}{"t-1", time.Date(2019, 12, 1, 14, 30, 0, 0, ict), 1.0, "+84 905 123 456", "=HYPERLINK(\"http://evil\")"}))
+	require.NoError(t, w.Write([]interface{}{"t-2", nil, int64(2), "0905123456", "books, toys"}))
+	require.NoError(t, w.Close())
+	assert.Equal(t, `id,createdDate,eventId,phoneNumber,description
+t-1,2019-12-01 14:30:00,1,+84 905 123 456,"'=HYPERLINK(""http://evil"")"
+t-2,,2,0905123456,"books, toys"
+`, buf.String())
+	assert.Error(t, w.Write([]interface{}{true}))
+}
+
+func TestSafeText(t *testing.T) {
+	for in, want := range map[string]string{
+		"":                "",
+		"two bags":        "two bags",
+		"+84905123456":    "+84905123456",
+		"-16.07":          "-16.07",
+		"+cmd|' /C calc'": "'+cmd|' /C calc'",
+		"@SUM(A1:A2)":     "'@SUM(A1:A2)",
+		"-2+3":            "'-2+3",
+	} {
+		assert.Equal(t, want, safeText(in), in)
+	}
+}
+
+type sheet struct {
+	Rows []struct {
+		R     int `xml:"r,attr"`
+		Cells []struct {
+			R    string `xml:"r,attr"`
+			T    string `xml:"t,attr"`
+			S    string `xml:"s,attr"`
+			V    string `xml:"v"`
+			Text string `xml:"is>t"`
+		} `xml:"c"`
+	} `xml:"sheetData>row"`
+}
+
+func TestXLSX(t *testing.T) {
+	var buf bytes.Buffer
+	w, err := New(XLSX, &buf)
+	require.NoError(t, err)
+	require.NoError(t, w.Write([]interface{}{"id", "createdDate", "eventId", "description"}))
+	require.NoError(t, w.Write([]interface{}{"t-1", time.Date(2019, 12, 1, 12, 0, 0, 0, ict), 1.0, "clothes <& books>"}))
+	require.NoError(t, w.Write([]interface{}{"t-2", nil, int64(2), "=1+1"}))
+	require.NoError(t, w.Close())
+
+	z, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	require.NoError(t, err)
+	parts := map[string][]byte{}
+	for _, f := range z.File {
+		r, err := f.Open()
+		require.NoError(t, err)
+		parts[f.Name], err = ioutil.ReadAll(r)
+		require.NoError(t, err)
+		r.Close()
+	}
+	for _, name := range []string{"[Content_Types].xml", "_rels/.rels", "xl/workbook.xml", "xl/_rels/workbook.xml.rels", "xl/styles.xml"} {
+		assert.Contains(t, parts, name)
+		assert.NoError(t, xml.Unmarshal(parts[name], new(interface{})), name)
+	}
+
+	var s sheet
+	require.NoError(t, xml.Unmarshal(parts["xl/worksheets/sheet1.xml"], &s))
+	require.Len(t, s.Rows, 3)
+	row := s.Rows[1]
+	assert.Equal(t, 2, row.R)
+	require.Len(t, row.Cells, 4)
+	assert.Equal(t, "A2", row.Cells[0].R)
+	assert.Equal(t, "t-1", row.Cells[0].Text)
+	assert.Equal(t, "1", row.Cells[1].S)
+	assert.Equal(t, "43800.5", row.Cells[1].V, "noon of Dec 1, 2019 on the wall clock")
+	assert.Equal(t, "1", row.Cells[2].V)
+	assert.Equal(t, "clothes <& books>", row.Cells[3].Text)
+	assert.Len(t, s.Rows[2].Cells, 3, "the empty cells are skipped")
+	assert.Equal(t, "inlineStr", s.Rows[2].Cells[2].T, "the texts are never formulas")
+	assert.Equal(t, "D3", s.Rows[2].Cells[2].R)
+}
+
+func TestColumnName(t *testing.T) {
+	for i, want := range map[int]string{0: "A", 25: "Z", 26: "AA", 27: "AB", 701: "ZZ", 702: "AAA"} {
+		assert.Equal(t, want, columnName(i))
+	}
+}
+
+func TestUnknownFormat(t *testing.T) {
+	_, err := New("pdf", ioutil.Discard)
+	assert.Error(t, err)
+}
@@ -0,0 +1,214 @@
+// Package geo measures the distances between coordinates, writes GeoJSON
+// features and groups the points too close to be told apart on a map into
+// clusters
+package geo
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// MaxZoom is the deepest zoom level of the web maps
+const MaxZoom = 20
+
+// FeatureCollection is a GeoJSON feature collection
+type FeatureCollection struct {
+	Type     string    `json:"type"`
+	Features []Feature `json:"features"`
+}
+
+// NewFeatureCollection returns a collection of the features, an empty one
+// when there are none
+func NewFeatureCollection(features []Feature) FeatureCollection {
+	if features == nil {
+		features = []Feature{}
+	}
+	return FeatureCollection{Type: "FeatureCollection", Features: features}
+}
+
+// Feature is a GeoJSON feature with a point geometry
+type Feature struct {
+	Type       string                 `json:"type"`
+	Geometry   Point                  `json:"geometry"`
+	Properties map[string]interface{} `json:"properties"`
+}
+
+// NewFeature returns a feature at the coordinates
+func NewFeature(lat, lng float64, properties map[string]interface{}) Feature {
+	return Feature{Type: "Feature", Geometry: NewPoint(lat, lng), Properties: properties}
+}
+
+// Point is a GeoJSON point, its coordinates are the longitude then the
+// latitude
+type Point struct {
+	Type        string     `json:"type"`
+	Coordinates [2]float64 `json:"coordinates"`
+}
+
+// NewPoint returns the point of the coordinates
+func NewPoint(lat, lng float64) Point {
+	return Point{Type: "Point", Coordinates: [2]float64{lng, lat}}
+}
+
+// Lat is the latitude of the point
+func (p Point) Lat() float64 {
+	return p.Coordinates[1]
+}
+
+// Lng is the longitude of the point
+func (p Point) Lng() float64 {
+	return p.Coordinates[0]
+}
+
+// earthRadiusKm is the mean radius of the Earth
+const earthRadiusKm = 6371
+
+// DistanceKm is the great-circle distance between two coordinates
+func DistanceKm(lat1, lng1, lat2, lng2 float64) float64 {
+	rad := func(d float64) float64 { return d * math.Pi / 180 }
+	dLat := rad(lat2 - lat1)
+	dLng := rad(lng2 - lng1)
+	a := math.Sin(dLat/2)*math.Sin(dLat/2) + math.Cos(rad(lat1))*math.Cos(rad(lat2))*math.Sin(dLng/2)*math.Sin(dLng/2)
+	return 2 * earthRadiusKm * math.Asin(math.Sqrt(a))
+}
+
+// BBox is a bounding box, the zero box contains everything
+type BBox struct {
+	MinLng, MinLat, MaxLng, MaxLat float64
+}
+
+// ParseBBox parses a box written as in GeoJSON, like
+// 108.1,15.9,108.3,16.1 for the west, south, east and north edges
+func ParseBBox(s string) (BBox, error) {
+	parts := strings.Split(s, ",")
+	if len(parts) != 4 {
+		return BBox{}, fmt.Errorf("invalid bbox %q, expected minLng,minLat,maxLng,maxLat", s)
+	}
+	var edges [4]float64
+	for i, p := range parts {
+		v, err := strconv.ParseFloat(strings.TrimSpace(p), 64)
+		if err != nil {
+			return BBox{}, fmt.Errorf("invalid bbox %q, expected minLng,minLat,maxLng,maxLat", s)
+		}
+		edges[i] = v
+	}
+	b := BBox{edges[0], edges[1], edges[2], edges[3]}
+	if b.MinLng < -180 || b.MaxLng > 180 || b.MinLat < -90 || b.MaxLat > 90 || b.MinLng > b.MaxLng || b.MinLat > b.MaxLat {
+		return BBox{}, fmt.Errorf("invalid bbox %q, the edges are out of order or of range", s)
+	}
+	return b, nil
+}
+
+// Contains tells whether the coordinates are in the box, edges included
+func (b BBox) Contains(lat, lng float64) bool {
+	if b == (BBox{}) {
+		return true
+	}
+	return lat >= b.MinLat && lat <= b.MaxLat && lng >= b.MinLng && lng <= b.MaxLng
+}
+
+// MaxClusterZoom is the deepest zoom level of the grid of the clusters, its
+// cells are about 150 meters wide so that no point is shown more precisely
+const MaxClusterZoom = 16
+
+// cellsPerTile is how many cells of the clusters there are along a side of
+// a tile, a cell is 64 pixels of the 256 of a tile
+const cellsPerTile = 4
+
+// Clusterer groups the features of the same cell of a grid, the grid of the
+// zoom level of the map. Only the running totals of the cells are kept, so
+// any number of features can be added
+type Clusterer struct {
+	cells    map[[2]int]*cluster
+	scale    float64
+	property string
+}
+
+type cluster struct {
+	first    Feature
+	count    int
+	lat, lng float64
+	counts   map[string]int
+}
+
+// NewClusterer returns a clusterer for the map zoom level, counting the
+// values of the property in each cluster. The levels deeper than
+// MaxClusterZoom use its grid
+func NewClusterer(zoom int, property string) *Clusterer {
+	if zoom < 0 {
+		zoom = 0
+	}
+	if zoom > MaxClusterZoom {
+		zoom = MaxClusterZoom
+	}
+	return &Clusterer{cells: make(map[[2]int]*cluster), scale: math.Ldexp(cellsPerTile, zoom), property: property}
+}
+
+// Add puts the feature in the cluster of its cell
+func (c *Clusterer) Add(f Feature) {
+	cell := c.cell(f.Geometry)
+	cl := c.cells[cell]
+	if cl == nil {
+		cl = &cluster{first: f, counts: make(map[string]int)}
+		c.cells[cell] = cl
+	}
+	cl.count++
+	cl.lat += f.Geometry.Lat()
+	cl.lng += f.Geometry.Lng()
+	if v, ok := f.Properties[c.property].(string); ok {
+		cl.counts[v]++
+	}
+}
+
+// cell is the cell of the point in the Web Mercator projection of the maps,
+// so that the cells are squares on the screen
+func (c *Clusterer) cell(p Point) [2]int {
+	lat := math.Max(math.Min(p.Lat(), 85.05112878), -85.05112878) * math.Pi / 180
+	x := (p.Lng() + 180) / 360
+	y := (1 - math.Log(math.Tan(lat)+1/math.Cos(lat))/math.Pi) / 2
+	return [2]int{int(math.Floor(x * c.scale)), int(math.Floor(y * c.scale))}
+}
+
+// center is the point at the center of the cell, the inverse of cell
+func (c *Clusterer) center(cell [2]int) (lat, lng float64) {
+	x := (float64(cell[0]) + 0.5) / c.scale
+	y := (float64(cell[1]) + 0.5) / c.scale
+	return math.Atan(math.Sinh(math.Pi*(1-2*y))) * 180 / math.Pi, x*360 - 180
+}
+
+// Features returns the clusters, west to east then north to south. A
+// cluster of one is the feature itself moved to the center of its cell, the
+// others are at the centroid of their features with the properties cluster, count and the counts of the
+// values of the property, like {"cluster": true, "count": 3, "status":
+// {"pending": 2, "assigned": 1}}
+func (c *Clusterer) Features() []Feature {
+	cells := make([][2]int, 0, len(c.cells))
+	for cell := range c.cells {
+		cells = append(cells, cell)
+	}
+	sort.Slice(cells, func(i, j int) bool {
+		if cells[i][0] != cells[j][0] {
+			return cells[i][0] < cells[j][0]
+		}
+		return cells[i][1] < cells[j][1]
+	})
+	features := make([]Feature, 0, len(cells))
+	for _, cell := range cells {
+		cl := c.cells[cell]
+		if cl.count == 1 {
+			lat, lng := c.center(cell)
+			features = append(features, NewFeature(lat, lng, cl.first.Properties))
+			continue
+		}
+		n := float64(cl.count)
+		features = append(features, NewFeature(cl.lat/n, cl.lng/n, map[string]interface{}{
+			"cluster":  true,
+			"count":    cl.count,
+			c.property: cl.counts,
+		}))
+	}
+	return features
+}
@@ -0,0 +1,88 @@
+package geo
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFeatureJSON(t *testing.T) {
+	body, err := json.Marshal(NewFeatureCollection([]Feature{NewFeature(16.0544, 108.2022, map[string]interface{}{"status": "pending"})}))
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"type": "FeatureCollection", "features": [{
+		"type": "Feature",
+		"geometry": {"type": "Point", "coordinates": [108.2022, 16.0544]},
+		"properties": {"status": "pending"}
+	}]}`, string(body))
+
+	body, err = json.Marshal(NewFeatureCollection(nil))
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"type": "FeatureCollection", "features": []}`, string(body))
+}
+
+func TestParseBBox(t *testing.T) {
+	b, err := ParseBBox("108.1, 15.9,108.3,16.1")
+	require.NoError(t, err)
+	assert.Equal(t, BBox{MinLng: 108.1, MinLat: 15.9, MaxLng: 108.3, MaxLat: 16.1}, b)
+	assert.True(t, b.Contains(16.0544, 108.2022))
+	assert.True(t, b.Contains(16.1, 108.3))
+	assert.False(t, b.Contains(10.7769, 106.7009))
+	assert.True(t, BBox{}.Contains(10.7769, 106.7009))
+
+	for _, s := range []string{"", "108.1,15.9,108.3", "108.1,15.9,108.3,north", "108.3,15.9,108.1,16.1", "108.1,15.9,108.3,91"} {
+		_, err := ParseBBox(s)
+		assert.Error(t, err, s)
+	}
+}
+
+func TestClusterer(t *testing.T) {
+	pickup := func(lat, lng float64, status string) Feature {
+		return NewFeature(lat, lng, map[string]interface{}{"status": status})
+	}
+	// Hai Chau, a few hundred meters apart, and Son Tra across the river
+	features := []Feature{
+		pickup(16.0600, 108.2200, "pending"),
+		pickup(16.0620, 108.2210, "pending"),
+		pickup(16.0610, 108.2230, "assigned"),
+		pickup(16.0900, 108.2450, "pending"),
+	}
+
+	c := NewClusterer(12, "status")
+	for _, f := range features {
+		c.Add(f)
+	}
+	clusters := c.Features()
+	require.Len(t, clusters, 2)
+	assert.Equal(t, true, clusters[0].Properties["cluster"])
+	assert.Equal(t, 3, clusters[0].Properties["count"])
+	assert.Equal(t, map[string]int{"pending": 2, "assigned": 1}, clusters[0].Properties["status"])
+	assert.InDelta(t, 16.0610, clusters[0].Geometry.Lat(), 1e-9)
+	assert.InDelta(t, 108.2213333, clusters[0].Geometry.Lng(), 1e-6)
+	lone := clusters[1]
+	assert.Equal(t, features[3].Properties, lone.Properties)
+	assert.NotEqual(t, features[3].Geometry, lone.Geometry, "a lone point is moved to the center of its cell")
+	assert.InDelta(t, 16.0900, lone.Geometry.Lat(), 0.022)
+	assert.InDelta(t, 108.2450, lone.Geometry.Lng(), 0.022)
+	assert.Equal(t, c.cell(features[3].Geometry), c.cell(lone.Geometry))
+
+	c = NewClusterer(9, "status")
+	for _, f := range features {
+		c.Add(f)
+	}
+	clusters = c.Features()
+	require.Len(t, clusters, 1)
+	assert.Equal(t, 4, clusters[0].Properties["count"])
+
+	c = NewClusterer(MaxZoom+5, "status")
+	for _, f := range features {
+		c.Add(f)
+	}
+	clusters = c.Features()
+	assert.Len(t, clusters, 4)
+	for _, f := range clusters {
+		assert.NotContains(t, features, f, "no point is exact at the deepest zoom")
+	}
+	assert.Empty(t, NewClusterer(12, "status").Features())
+}
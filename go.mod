@@ -3,11 +3,19 @@ module wcws
 go 1.12
 
 require (
-	cloud.google.com/go/firestore v1.0.0 // indirect
+	cloud.google.com/go/firestore v1.0.0
 	cloud.google.com/go/storage v1.1.0 // indirect
 	firebase.google.com/go v3.9.0+incompatible
+	github.com/gomodule/redigo v2.0.0+incompatible
 	github.com/joho/godotenv v1.3.0
+	github.com/jung-kurt/gofpdf v1.16.2
 	github.com/labstack/echo/v4 v4.1.11
+	github.com/prometheus/client_golang v1.2.1
 	github.com/stretchr/testify v1.4.0
+	github.com/xeipuuv/gojsonschema v1.2.0
+	go.opentelemetry.io/otel v0.5.0
+	go.opentelemetry.io/otel/exporters/otlp v0.5.0
 	google.golang.org/api v0.11.0
+	google.golang.org/grpc v1.27.1
+	gopkg.in/yaml.v2 v2.2.7
 )
@@ -0,0 +1,39 @@
+package logging
+
+import (
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+// Middleware logs the requests without their query and puts the logger in
+// their context, with the request ID when the client sent one
+func Middleware(l *Logger) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			start := time.Now()
+			req := c.Request()
+			logger := l
+			if id := req.Header.Get(echo.HeaderXRequestID); id != "" {
+				logger = l.With("requestId", id)
+			}
+			c.SetRequest(req.WithContext(WithContext(req.Context(), logger)))
+			if err := next(c); err != nil {
+				c.Error(err)
+			}
+			res := c.Response()
+			level := Info
+			if res.Status >= 500 {
+				level = Error
+			}
+			logger.log(level, "request", []interface{}{
+				"method", req.Method,
+				"path", req.URL.Path,
+				"status", res.Status,
+				"latencyMs", time.Since(start).Seconds() * 1000,
+				"bytesOut", res.Size,
+			})
+			return nil
+		}
+	}
+}
@@ -0,0 +1,176 @@
+// Package logging writes the logs of the webhook as JSON lines, with the
+// personal data of the donors redacted
+package logging
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Level is the severity of a log
+type Level int
+
+// Levels of the logs, from the most verbose
+const (
+	Debug Level = iota
+	Info
+	Warn
+	Error
+)
+
+func (l Level) String() string {
+	switch l {
+	case Debug:
+		return "debug"
+	case Info:
+		return "info"
+	case Warn:
+		return "warn"
+	}
+	return "error"
+}
+
+// ParseLevel parses a level written like its String
+func ParseLevel(s string) (Level, error) {
+	for _, l := range []Level{Debug, Info, Warn, Error} {
+		if strings.EqualFold(s, l.String()) {
+			return l, nil
+		}
+	}
+	return Info, fmt.Errorf("unknown log level %q", s)
+}
+
+// Logger writes the logs at its level or above, with its fields
+type Logger struct {
+	out      *output
+	level    Level
+	redactor *Redactor
+	fields   []interface{}
+}
+
+// output is shared by a logger and the loggers derived from it
+type output struct {
+	mu  sync.Mutex
+	w   io.Writer
+	now func() time.Time
+}
+
+// New returns a logger writing to w the logs at level or above, redacted by
+// the redactor
+func New(w io.Writer, level Level, redactor *Redactor) *Logger {
+	return &Logger{out: &output{w: w, now: time.Now}, level: level, redactor: redactor}
+}
+
+// With returns a logger adding the fields, given as key value pairs, to the
+// logs
+func (l *Logger) With(kv ...interface{}) *Logger {
+	fields := make([]interface{}, 0, len(l.fields)+len(kv))
+	fields = append(append(fields, l.fields...), kv...)
+	return &Logger{out: l.out, level: l.level, redactor: l.redactor, fields: fields}
+}
+
+// Debug logs the message with the fields, given as key value pairs
+func (l *Logger) Debug(msg string, kv ...interface{}) { l.log(Debug, msg, kv) }
+
+// Info logs the message with the fields, given as key value pairs
+func (l *Logger) Info(msg string, kv ...interface{}) { l.log(Info, msg, kv) }
+
+// Warn logs the message with the fields, given as key value pairs
+func (l *Logger) Warn(msg string, kv ...interface{}) { l.log(Warn, msg, kv) }
+
+// Error logs the message with the fields, given as key value pairs
+func (l *Logger) Error(msg string, kv ...interface{}) { l.log(Error, msg, kv) }
+
+func (l *Logger) log(level Level, msg string, kv []interface{}) {
+	if level < l.level {
+		return
+	}
+	var buf bytes.Buffer
+	buf.WriteString(`{"time":`)
+	writeJSON(&buf, l.out.now().UTC().Format(time.RFC3339Nano))
+	buf.WriteString(`,"level":`)
+	writeJSON(&buf, level.String())
+	buf.WriteString(`,"msg":`)
+	writeJSON(&buf, l.redactor.String(msg))
+	fields := append(append([]interface{}{}, l.fields...), kv...)
+	for i := 0; i < len(fields); i += 2 {
+		key := fmt.Sprint(fields[i])
+		var v interface{} = "!MISSING"
+		if i+1 < len(fields) {
+			v = fields[i+1]
+		}
+		buf.WriteString(",")
+		writeJSON(&buf, key)
+		buf.WriteString(":")
+		writeJSON(&buf, l.redactor.Field(key, v))
+	}
+	buf.WriteString("}\n")
+	l.out.mu.Lock()
+	defer l.out.mu.Unlock()
+	_, _ = l.out.w.Write(buf.Bytes())
+}
+
+func writeJSON(buf *bytes.Buffer, v interface{}) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		b, _ = json.Marshal(fmt.Sprint(v))
+	}
+	buf.Write(b)
+}
+
+// Writer returns a writer logging every line written at the level, for the
+// packages using the standard logger
+func (l *Logger) Writer(level Level) io.Writer {
+	return writerFunc(func(p []byte) (int, error) {
+		for _, line := range strings.Split(strings.TrimRight(string(p), "\n"), "\n") {
+			l.log(level, line, nil)
+		}
+		return len(p), nil
+	})
+}
+
+type writerFunc func(p []byte) (int, error)
+
+func (f writerFunc) Write(p []byte) (int, error) { return f(p) }
+
+var (
+	defaultMu     sync.RWMutex
+	defaultLogger = New(os.Stderr, Info, DefaultRedactor)
+)
+
+// Default returns the logger used when there is none in the context
+func Default() *Logger {
+	defaultMu.RLock()
+	defer defaultMu.RUnlock()
+	return defaultLogger
+}
+
+// SetDefault replaces the logger used when there is none in the context
+func SetDefault(l *Logger) {
+	defaultMu.Lock()
+	defer defaultMu.Unlock()
+	defaultLogger = l
+}
+
+type contextKey struct{}
+
+// WithContext returns a context carrying the logger
+func WithContext(ctx context.Context, l *Logger) context.Context {
+	return context.WithValue(ctx, contextKey{}, l)
+}
+
+// FromContext returns the logger of the context, the default one when there
+// is none
+func FromContext(ctx context.Context) *Logger {
+	if l, ok := ctx.Value(contextKey{}).(*Logger); ok {
+		return l
+	}
+	return Default()
+}
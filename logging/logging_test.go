@@ -0,0 +1,108 @@
+package logging
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func lines(t *testing.T, buf *bytes.Buffer) []map[string]interface{} {
+	var logs []map[string]interface{}
+	for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+		if line == "" {
+			continue
+		}
+		var m map[string]interface{}
+		require.NoError(t, json.Unmarshal([]byte(line), &m), line)
+		logs = append(logs, m)
+	}
+	return logs
+}
+
+func TestLogger(t *testing.T) {
+	var buf bytes.Buffer
+	l := New(&buf, Info, DefaultRedactor)
+	l.out.now = func() time.Time { return time.Date(2019, 12, 1, 7, 30, 0, 0, time.UTC) }
+
+	l.Debug("hidden")
+	l.With("session", "s-1").Error("saving the donation", "err", errors.New("deadline exceeded"), "count", 2)
+	l.Warn("odd", "key")
+
+	assert.Equal(t, `{"time":"2019-12-01T07:30:00Z","level":"error","msg":"saving the donation","session":"s-1","err":"deadline exceeded","count":2}`+"\n"+
+		`{"time":"2019-12-01T07:30:00Z","level":"warn","msg":"odd","key":"!MISSING"}`+"\n", buf.String())
+}
+
+func TestContext(t *testing.T) {
+	var buf bytes.Buffer
+	l := New(&buf, Debug, DefaultRedactor).With("responseId", "r-1")
+	ctx := WithContext(context.Background(), l)
+	FromContext(ctx).Info("answered")
+	assert.Equal(t, "r-1", lines(t, &buf)[0]["responseId"])
+	assert.Equal(t, Default(), FromContext(context.Background()))
+}
+
+func TestLoggerRedacts(t *testing.T) {
+	var buf bytes.Buffer
+	l := New(&buf, Info, DefaultRedactor)
+	l.Info("called 0905 123 456",
+		"giverName", "Hoang",
+		"phoneNumber", "0905123456",
+		"lat", 16.074345,
+		"err", errors.New(`Get "https://api.opencagedata.com/geocode/v1/json?q=16.074345+108.223851&key=secret": timeout`),
+	)
+	log := lines(t, &buf)[0]
+	assert.Equal(t, "called *******456", log["msg"])
+	assert.Equal(t, "H***", log["giverName"])
+	assert.Equal(t, "*******456", log["phoneNumber"])
+	assert.Equal(t, 16.07, log["lat"])
+	assert.Equal(t, `Get "https://api.opencagedata.com/geocode/v1/json?q=16.07+108.22&key=[REDACTED]": timeout`, log["err"])
+}
+
+func TestWriter(t *testing.T) {
+	var buf bytes.Buffer
+	std := log.New(New(&buf, Info, DefaultRedactor).Writer(Warn), "", 0)
+	std.Println("grpc: retrying 0905123456")
+	log := lines(t, &buf)[0]
+	assert.Equal(t, "warn", log["level"])
+	assert.Equal(t, "grpc: retrying *******456", log["msg"])
+}
+
+func TestMiddleware(t *testing.T) {
+	var buf bytes.Buffer
+	e := echo.New()
+	e.Use(Middleware(New(&buf, Info, DefaultRedactor)))
+	e.GET("/donations", func(c echo.Context) error {
+		FromContext(c.Request().Context()).Info("handling")
+		return c.NoContent(http.StatusNoContent)
+	})
+	req := httptest.NewRequest(http.MethodGet, "/donations?phone=0905123456", nil)
+	req.Header.Set(echo.HeaderXRequestID, "req-1")
+	e.ServeHTTP(httptest.NewRecorder(), req)
+
+	logs := lines(t, &buf)
+	require.Len(t, logs, 2)
+	assert.Equal(t, "req-1", logs[0]["requestId"])
+	assert.Equal(t, "request", logs[1]["msg"])
+	assert.Equal(t, "/donations", logs[1]["path"])
+	assert.Equal(t, float64(http.StatusNoContent), logs[1]["status"])
+	assert.NotContains(t, buf.String(), "0905123456")
+}
+
+func TestParseLevel(t *testing.T) {
+	l, err := ParseLevel("WARN")
+	require.NoError(t, err)
+	assert.Equal(t, Warn, l)
+	_, err = ParseLevel("verbose")
+	assert.Error(t, err)
+}
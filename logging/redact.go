@@ -0,0 +1,197 @@
+package logging
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"strings"
+)
+
+// redacted replaces the values which can't be partly shown
+const redacted = "[REDACTED]"
+
+// DefaultCoordinatePrecision is the number of decimals kept in the
+// coordinates, about a kilometer
+const DefaultCoordinatePrecision = 2
+
+// Redactor masks the personal data of the donors before it is logged: the
+// values of the fields named after names, emails and addresses, and the phone
+// numbers, emails, precise coordinates and API keys found in any string. The names
+// can only be recognized in fields, they must never be written in messages
+type Redactor struct {
+	// CoordinatePrecision is the number of decimals kept in the coordinates
+	CoordinatePrecision int
+}
+
+// DefaultRedactor keeps DefaultCoordinatePrecision decimals
+var DefaultRedactor = &Redactor{CoordinatePrecision: DefaultCoordinatePrecision}
+
+type fieldKind int
+
+const (
+	plainField fieldKind = iota
+	nameField
+	phoneField
+	emailField
+	addressField
+	coordinateField
+)
+
+// fieldKinds are the sensitive fields, by their key in lower case without
+// separators
+var fieldKinds = map[string]fieldKind{
+	"name":          nameField,
+	"givername":     nameField,
+	"donorname":     nameField,
+	"personname":    nameField,
+	"volunteername": nameField,
+	"phone":         phoneField,
+	"phonenumber":   phoneField,
+	"to":            phoneField,
+	"email":         emailField,
+	"address":       addressField,
+	"lat":           coordinateField,
+	"latitude":      coordinateField,
+	"long":          coordinateField,
+	"lng":           coordinateField,
+	"longitude":     coordinateField,
+}
+
+func kindOf(key string) fieldKind {
+	k := strings.ToLower(strings.NewReplacer("_", "", "-", "", ".", "").Replace(key))
+	return fieldKinds[k]
+}
+
+var (
+	// phoneNumbers are the international numbers, with a + or 00, the
+	// Vietnamese numbers with 84 and no + and the national numbers, a 0 and
+	// groups of 3 or 4 digits. Dates, timestamps and IDs don't have these
+	// shapes. Dots aren't separators, they would match the coordinates
+	phoneNumbers = regexp.MustCompile(`(?:\+|\b00)\d[\d ()-]{6,18}\d|\b84\d{9}\b|\(?\b0\d{2,3}\)?[ -]?\d{3,4}[ -]?\d{3,4}\b`)
+	// emails are the addresses written in a text
+	emails = regexp.MustCompile(`[\w.%+-]+@[\w-]+(?:\.[\w-]+)*\.[A-Za-z]{2,}`)
+	// decimals are the numbers with more decimals than a coordinate keeps
+	decimals = regexp.MustCompile(`-?\d{1,3}\.\d+`)
+	// secretParams are the credentials passed in URLs, like the OpenCage key
+	secretParams = regexp.MustCompile(`(?i)([?&](?:key|api_key|access_token|token)=)[^&\s"]+`)
+)
+
+// Sensitive reports whether the values of the field are names, phone numbers,
+// emails or addresses
+func (r *Redactor) Sensitive(key string) bool {
+	k := kindOf(key)
+	return k != plainField && k != coordinateField
+}
+
+// Name reports whether the values of the field are names
+func (r *Redactor) Name(key string) bool {
+	return kindOf(key) == nameField
+}
+
+// Field returns the value of the field as it can be logged. The values of
+// the maps, like the parameters of Dialogflow, are redacted by their key
+func (r *Redactor) Field(key string, v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for k, e := range v {
+			m[k] = r.Field(k, e)
+		}
+		return m
+	case []interface{}:
+		l := make([]interface{}, len(v))
+		for i, e := range v {
+			l[i] = r.Field(key, e)
+		}
+		return l
+	}
+	switch kindOf(key) {
+	case nameField:
+		return r.name(fmt.Sprint(v))
+	case phoneField:
+		return maskPhone(fmt.Sprint(v))
+	case emailField:
+		return maskEmail(fmt.Sprint(v))
+	case addressField:
+		return redacted
+	case coordinateField:
+		if f, ok := v.(float64); ok {
+			return r.coordinate(f)
+		}
+		return r.String(fmt.Sprint(v))
+	}
+	switch v := v.(type) {
+	case string:
+		return r.String(v)
+	case error:
+		return r.String(v.Error())
+	case fmt.Stringer:
+		return r.String(v.String())
+	}
+	return v
+}
+
+// String masks the phone numbers, the emails, the coordinates and the
+// credentials of the URLs in s
+func (r *Redactor) String(s string) string {
+	s = secretParams.ReplaceAllString(s, "${1}"+redacted)
+	s = emails.ReplaceAllStringFunc(s, maskEmail)
+	s = phoneNumbers.ReplaceAllStringFunc(s, func(m string) string {
+		min, max := 9, 17
+		if national := strings.TrimPrefix(m, "("); strings.HasPrefix(national, "0") && !strings.HasPrefix(national, "00") {
+			min, max = 10, 11
+		}
+		if n := len(digits(m)); n < min || n > max {
+			return m
+		}
+		return maskPhone(m)
+	})
+	return decimals.ReplaceAllStringFunc(s, func(m string) string {
+		i := strings.Index(m, ".")
+		if len(m)-i-1 <= r.CoordinatePrecision {
+			return m
+		}
+		return m[:i+1+r.CoordinatePrecision]
+	})
+}
+
+// name keeps the initial of the name
+func (r *Redactor) name(s string) string {
+	for _, c := range s {
+		return string(c) + "***"
+	}
+	return ""
+}
+
+func (r *Redactor) coordinate(f float64) float64 {
+	p := math.Pow(10, float64(r.CoordinatePrecision))
+	return math.Trunc(f*p) / p
+}
+
+// maskPhone keeps the last 3 digits of the phone number
+func maskPhone(s string) string {
+	d := digits(s)
+	if len(d) <= 3 {
+		return strings.Repeat("*", len(d))
+	}
+	return strings.Repeat("*", len(d)-3) + d[len(d)-3:]
+}
+
+// maskEmail keeps the initial and the domain of the email
+func maskEmail(s string) string {
+	i := strings.LastIndex(s, "@")
+	if i < 1 {
+		return redacted
+	}
+	return s[:1] + "***" + s[i:]
+}
+
+func digits(s string) string {
+	var b strings.Builder
+	for _, c := range s {
+		if c >= '0' && c <= '9' {
+			b.WriteRune(c)
+		}
+	}
+	return b.String()
+}
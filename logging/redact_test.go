@@ -0,0 +1,74 @@
+package logging
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRedactFields(t *testing.T) {
+	r := &Redactor{CoordinatePrecision: 2}
+	for _, c := range []struct {
+		key  string
+		v    interface{}
+		want interface{}
+	}{
+		{"name", "Hoàng", "H***"},
+		{"person.name", "Lan", "L***"},
+		{"volunteer_name", "", ""},
+		{"phone", "+84 905 123 456", "********456"},
+		{"to", "+15550100", "*****100"},
+		{"email", "hoang@example.com", "h***@example.com"},
+		{"email", "nobody", "[REDACTED]"},
+		{"address", "12 Bach Dang, Da Nang", "[REDACTED]"},
+		{"long", 108.223851, 108.22},
+		{"latitude", -16.079, -16.07},
+		{"lat", "16.074345", "16.07"},
+		{"count", 3, 3},
+		{"err", errors.New("no donor with phone 0905123456"), "no donor with phone *******456"},
+		{"transactionId", "memory-1", "memory-1"},
+	} {
+		assert.Equal(t, c.want, r.Field(c.key, c.v), c.key)
+	}
+}
+
+func TestRedactString(t *testing.T) {
+	r := &Redactor{CoordinatePrecision: 3}
+	for in, want := range map[string]string{
+		"Donations from phone:0905123456 are over the limit": "Donations from phone:*******456 are over the limit",
+		"call (090) 512-3456":                                "call *******456",
+		"at 16.0743451, 108.2238519":                         "at 16.074, 108.223",
+		"pickup at 2019-12-01T14:30:00+07:00":                "pickup at 2019-12-01T14:30:00+07:00",
+		"event 12345678":                                     "event 12345678",
+		"from +84 905 123 456 and 0084905123456":             "from ********456 and **********456",
+		"Zalo 84905123456, Da Nang 0236 3822 123":            "Zalo ********456, Da Nang ********123",
+		"picked up on 2019-12-01 14:30:00":                   "picked up on 2019-12-01 14:30:00",
+		"between 01-12-2019 14 and 2019-12-01 0905":          "between 01-12-2019 14 and 2019-12-01 0905",
+		"sent at 1575187200000, event 1575187200":            "sent at 1575187200000, event 1575187200",
+		"transaction 20191201-0001 of 2019-12-01":            "transaction 20191201-0001 of 2019-12-01",
+		"signed in as hoang.nguyen+wcws@example.com.":        "signed in as h***@example.com.",
+		"https://graph.zalo.me/v2.0/oa?access_token=abc&x=1": "https://graph.zalo.me/v2.0/oa?access_token=[REDACTED]&x=1",
+	} {
+		assert.Equal(t, want, r.String(in), in)
+	}
+}
+
+func TestRedactParameters(t *testing.T) {
+	params := map[string]interface{}{
+		"person":       map[string]interface{}{"name": "Hoang"},
+		"phone-number": "0905123456",
+		"location":     map[string]interface{}{"lat": 16.074345, "long": 108.223851},
+		"any":          []interface{}{"two bags of clothes"},
+		"event-number": float64(1),
+	}
+	assert.Equal(t, map[string]interface{}{
+		"person":       map[string]interface{}{"name": "H***"},
+		"phone-number": "*******456",
+		"location":     map[string]interface{}{"lat": 16.07, "long": 108.22},
+		"any":          []interface{}{"two bags of clothes"},
+		"event-number": float64(1),
+	}, DefaultRedactor.Field("parameters", params))
+	assert.True(t, DefaultRedactor.Sensitive("phone-number"))
+	assert.False(t, DefaultRedactor.Sensitive("lat"))
+}
@@ -1,56 +1,118 @@
 package main
 
 import (
-	"github.com/joho/godotenv"
+	"context"
 	"log"
 	"net/http"
 	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
 
+	"github.com/joho/godotenv"
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
 
-	"wcws/dialogflow"
+	"wcws/config"
+	"wcws/logging"
+	"wcws/tracing"
+	"wcws/webhook"
+	"wcws/zalo"
 )
 
-var globalSession map[string]string
-
-func init() {
-	globalSession = make(map[string]string)
-}
-
 func main() {
 
-	_ = os.Setenv("GOOGLE_APPLICATION_CREDENTIALS", "cred.json")
 	_ = godotenv.Load()
+	if len(os.Args) > 1 && os.Args[1] == "export" {
+		os.Exit(runExport(os.Args[2:]))
+	}
+	cfg, err := config.Load(os.Args[1:], os.Getenv)
+	if err != nil {
+		fatal("loading the configuration", err)
+	}
+	level, _ := logging.ParseLevel(cfg.Logging.Level)
+	logger := logging.New(os.Stderr, level, &logging.Redactor{CoordinatePrecision: cfg.Logging.CoordinatePrecision})
+	logging.SetDefault(logger)
+	// the libraries logging with the standard logger are redacted too
+	log.SetFlags(0)
+	log.SetOutput(logger.Writer(logging.Info))
+	logger.Info("configuration", "config", cfg.String())
+	flushTraces, err := tracing.Setup(cfg.Tracing)
+	if err != nil {
+		fatal("setting up the tracing", err)
+	}
+	srv, err := webhook.NewFromConfig(context.Background(), cfg)
+	if err != nil {
+		fatal("creating the server", err)
+	}
+	jobsCtx, stopJobs := context.WithCancel(context.Background())
+	var jobs sync.WaitGroup
+	if srv.Notifier != nil || srv.Mailer != nil {
+		jobs.Add(1)
+		go func() {
+			defer jobs.Done()
+			srv.RunNotifications(jobsCtx, time.Minute)
+		}()
+	}
+	if srv.Partners != nil {
+		jobs.Add(1)
+		go func() {
+			defer jobs.Done()
+			srv.RunPartners(jobsCtx, time.Minute)
+		}()
+	}
+	if srv.Transcripts != nil {
+		jobs.Add(1)
+		go func() {
+			defer jobs.Done()
+			srv.RunTranscriptRetention(jobsCtx, time.Hour)
+		}()
+	}
+
 	e := echo.New()
-	e.Use(middleware.Logger())
+	e.Use(logging.Middleware(logger))
 	e.Use(middleware.Recover())
 	// Routes
 	e.GET("/", test)
-	e.POST("/webhook", webhook)
+	srv.Register(e)
+	// the configuration has the app ID, the secret which verifies the events
+	// and the token which answers them, or none of them
+	if cfg.Zalo.AppID != "" {
+		zaloClient := zalo.NewClient(cfg.Zalo.APIURL, cfg.Zalo.AccessToken)
+		e.POST("/zalo/webhook", webhook.NewZaloHandler(srv, zaloClient, cfg.Zalo.AppID, cfg.Zalo.OASecret).Webhook)
+	}
 
 	// Start server
-	e.Logger.Fatal(e.Start(":1323"))
+	go func() {
+		if err := e.Start(cfg.Addr()); err != nil && err != http.ErrServerClosed {
+			fatal("starting the server", err)
+		}
+	}()
 
+	// Drain the webhook calls in flight on SIGTERM, so that no donation is
+	// dropped in the middle of a write. The server is reported not ready
+	// first and keeps serving until the load balancer noticed it
+	quit := make(chan os.Signal, 1)
+	signal.Notify(quit, syscall.SIGTERM, os.Interrupt)
+	<-quit
+	logger.Info("shutting down")
+	srv.Drain()
+	time.Sleep(cfg.DrainDelay)
+	ctx, cancel := context.WithTimeout(context.Background(), cfg.ShutdownTimeout)
+	defer cancel()
+	if err := e.Shutdown(ctx); err != nil {
+		logger.Error("draining the requests", "err", err)
+	}
+	stopJobs()
+	jobs.Wait()
+	srv.Wait()
+	flushTraces()
 }
 
-func webhook(e echo.Context) error {
-	dr := dialogflow.Request{}
-	err := e.Bind(&dr)
-	if err != nil {
-		log.Println("got err:", err)
-		return err
-	}
-	action := dr.QueryResult.Action
-	switch action {
-	case "welcome":
-		return welcomeHandler(e, dr.OriginalDetectIntentRequest.Source)
-	case "collect":
-		return addLocationPermissionRequest(e, dr)
-	case "getPermission":
-		return permissionHander(e, dr)
-	}
-	return ErrResponse(e)
+func fatal(msg string, err error) {
+	logging.Default().Error(msg, "err", err)
+	os.Exit(1)
 }
 
 func test(e echo.Context) error {
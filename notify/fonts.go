@@ -0,0 +1,9409 @@
+// Code generated by genfonts.go; DO NOT EDIT.
+
+package notify
+
+// certificateFont is DejaVuSansCondensed.ttf, gzipped and base64 encoded
+const certificateFont = "" +
+	"H4sIAAAAAAAC/+z9B3xUxfo/AH+fOWU327Kb3WRXII1AQm9J6CAlRnoJEKqQ0DtIiUBo0kWkCQiICIgJRkREmoiIiIgKimIU" +
+	"EZAm0i5EBESSnH0/Z862FLx67/39/vd931/gfM/sM/XMPPPMM8/MnAMC4CBARLPk5K7tx390Nw+gdgDKPZn0RPKKrxZfAWgo" +
+	"wFY82aljl/hfX+4PCAXA+oVPdunW4qMTzXoCmxYAoefaN+/aavWCekZg71BAbtqxS806PatP/BQ4sAVA6oBR6WMPto4F8CEA" +
+	"Z5kBGROiMCy8AfBdOYCUwWOHjHo6IWM4cKocgDeHpI8fCx10wKmjAExDRk4ebLkw4Tvg1LfQdew6dFD6QNNQOgF9pUoA6g4d" +
+	"OijdTBFnoa+UBqDC0FETJh0L/+gy9JXmAOmrRgwaN9q0Rt8I+oXlgMcLRo4ZkN7um5Rm0H/uAtL3jkqfNLbcabE3gqqeABA1" +
+	"On3UoB86LTuNoKp5QJNFY8eMn9Dq6KquCBo2FbgzcOy4QWMb6X4FDL3PA9JQAAQAx5ps+goATh6qbPXdCYAFYJVYLQAMPSGw" +
+	"7SD2DvsEAjvCjoDYp+xzCOwLdgzEjrOvIbBv2EkQ+5ZdhsB+ZtdA7Dr7DQK7y+6C2D12DwK7z+6D2O/MDUGAAJBAgghBkAQJ" +
+	"JMiCDEHQCTqQoBeCIAgGwQASjIIJgmAWnSDRJZaDIIZLZpBkkSxgUrBkBUk26TEIUhmpLEgqJ0VAkCKlSJAUJUVBkKKliiAp" +
+	"VoqFIMVJbUFSO6kdBKm91BEkdZK6gkndpPEgaYI0E4L0rLQUJC2TlkGQlst2kOyQy0GQw+XHQXIzuRkEubncAiS3lNtAkNvK" +
+	"nUFyitwdgtxD/ztI/0BfCEGvGCNAxkhjJJgxylgZZKxirAZmrG6cATLONM4EMz5rXAYyLjeuhmBcY1wDZlxrfAVkXG98Fcy4" +
+	"wbgHZNxrfA/MuM/4Dch40ngSzPitfTvI/o79AzD7AfsBCPYP7R+C2Q/ar4Hs1+3Xwew3HENAjqGOoRAcwxzDQI7hjuEQHCMc" +
+	"o8Acox2jQY4xjslgjimOWyDHbcd9MMfvzmiQs7yzGgRndedMkPNZ5wIIzuecF0HOS85LEJyXXSNBEAEwflnZOz6uYOw4+x6M" +
+	"nWKnILAf2A8Q2Wl2GYxdZ/8AY7fYPRDnBMZ+Z7+D2AP2EIzls0IQU5gbTCCBgQRBEMAEURBBIAAGOsP6wJGU3K4rpqSPS++P" +
+	"eenjRo3Glv7j0odh54D00eNxcMDQQeNwbMDkcSNxasigMeNwd8i4QSMIQ9NHDyPr0EH9x1G5Eemj06nKyPQxUVR3ZPqE0ZQ8" +
+	"Kn3CUJo3esSYKFo+Zkj6KNowbuLocbR1wuDRQ2jvhKHpw+gIRABwu0EAwiC07ZYShbAuHZKiENYtRUXuKwBgkIuE/ituK1j7" +
+	"tklRsHZJaREFqy8tAbpS4/yZn9Yqj4olQo8E6Ft3aR+FhLYc23Fs37FdFBI6cHdKRxW7tO2iIqd44tO/kGvxUFIRnyBV2qE+" +
+	"x6aj0seNQDLHrhz7cBw4asSoERjOcRzHSQAIwQAYLPwuQYIMHfQI4lQTzPxugBEAeX4BxC+NexkEWFEeVVAHDdEC7dAVfTAQ" +
+	"IzEBUzEHi7AeWZBBICzy3M9BBkAsy3M/xVubCYPlZEggkKEAono3Ddbu0Y01evmG2u+YBzx3qp+j3Rt8AwkANZoFESLkJgkt" +
+	"WctZT0b1ODYuSivx+L7a/WUTCLUQC0JDmCBADwscIDqmmDh+q2LhbyAIUoY0WZoe4BI0uQ4HgDJqOKAo4jS2gUiGCQ5UQS3U" +
+	"RWO0QCt0QFf0QhoGYyTGYRKmYw4WYilWYZ0aV9dQRXmz3y2d8bt1+uL0gJBfyOP8vvoCFYMO+936hAAKd+tOcEoaCA6pnTRB" +
+	"mig9I02SpkiZ0lRpmjRDmiktcz7rnOWc7ZzjnOuc55zvXPC3wjoApADoAaAvgIEAhgMYCyADwFQAswAsALAYwAoAawFsAJAF" +
+	"YCuAHQD2AjgAgJdd3P7/AlEOYSBUgAkEEVS4rfA8CCS3AYHJT8qtwAAYAFhBhZcBL6IDmoLQAybInMPKIAqxqAYqPCzfV1PS" +
+	"PVMM39CN9vpClpvJLeUk+Qk5WW4N5hkbAZlzHhAFIBZANQB1ANRXUxHTOE4PwLSibupFsSBaARNi/glXbsIWbMNO7MNBHMEx" +
+	"fINTOIfLuI483EcBMdKThRwgJaHwnB+VUD8Wni5O8eBzxdETkqemTAhALXwkx0YcXQH0+iXww4DU1FiJip27jRwtf4p2T1wG" +
+	"PR+HwfUykX3LLiKIj70uPtKW42NsJB9do7nOVR4MQcXifI8gdppdhJnHDOMxy/CY4TxmJI8ZhWgA8wAsArAcwGoA6wFsBpAD" +
+	"YDuA3QD2AzgE4CiALwF8C+A0gPMArgC4CeAOgAcAFIBEgAwAWQEKA6gcQOUBqgRQDYASAGoIUDOAktUnB/6rMPFficVa0DkQ" +
+	"WwwTGiIDUzELC7AYK7AWG5CFrdiBvTiAw/gcJ/AdzuAiruIW7uIhgWQyUQi5KIIqUBWqRXWpMbWgVtSBulIvSqPBNJLG0SSa" +
+	"TnNoIS2lVbSONtEW2kY7aR8dpCN0TC2HPLQENuHoCMCkEpRPVNSJJeJuCUBO0aUGpPBiQAolctSdCAiZ5E8/EOXTXjegG10a" +
+	"Fiun4xE5Ov4qSg/+Av5YnCJHeLTkQwC7xW4hhOWx32Dn8yIn14NdXAN+jOu+5bjWG8FnQZF8/hPNZxQxfJ5Q2VneWR61nBWc" +
+	"FVDbGeushjpcL28ABtPfzYOnG8nTrci1/mo8rXqoD+AigKsAbgG4C+AhQABIBsgEUAhALoAiAKoAUBWAagFUF6DGALUAqBVA" +
+	"HQDqClAvgNIAGgzQSIDGATQJoOkAzQFoIUBLAVoF0DqANgG0BaBtAO0EaB9ABwE6AtAxgL4B6BRA5wC6DNB1gPIAug9QAcAY" +
+	"wPQAswDMAbAyAIsCWCzAqqntImaWwO0qSuzPsJRYj8C/lML2v5qLxIq7/zzWnz/Fv/ZEf4bYi20gnIYJeoSgAuqiFXphJKZj" +
+	"KRhkmECCyb0OhJ3u4f8ZSuHpf055RDrajBcgBEs2KUSySw4pVAqTnJJLeky1IkixHs05GEBTAEkA2gDoBCAVQB8A/QEMBTAa" +
+	"wAQAUwDMLDIqEmsP/CewpMbucNmPgByNYWLPiHnifbFAYpJeskgOqYwUJcVK1aQ6Un2pqZQktZE6SalSH6m/NFQaLU2Qpkgz" +
+	"pXnSImm5tFpaL22WcqTt0m5pv3RIOip9KX0rnZbOS1ekm9Id6YGkyKJskK1ymFxOLi9XkmvICXJDuZmcLLeTU+Qecl95oDxc" +
+	"HitnyFPlWfICebG8Ql4rb5Cz5K3yDnmvfEA+LH8un5C/k8/IF+Wr8i35rvxQB52sM+lCdC5dhK6Croqulq6urrGuha6VroOu" +
+	"q66XLk03WDdSN043STddN0e3ULdUt0q3TrdJt0W3TbdTt093UHdEd0z3je6U7pzusu66Lk93X1egZ3q93qJ36Mvoo/Sx+mr6" +
+	"Ovr6+qb6JH0bfSd9qr6Pvr9+qH60foJ+in6mfp5+kX65frV+vX6zPke/Xb9bv19/SH9U/6X+W/1p/Xn9Ff1N/R39A70SJAYZ" +
+	"gqxBYUHlgsoHVQqqEZQQ1DCoWVByULuglKAeQX2DBgYNDxoblBE0NWhW0IKgxUErgtYGbQjKCtoatCNob9CBoMNBnwedCPou" +
+	"6EzQxaCrQbeC7gY9NMAgG0yGEIPLEGGoYKhiqGWoa2hsaGFoZehg6GroZUgzDDaMNIwzTDJMN8wxLDQsNawyrDNsMmwxbDPs" +
+	"NOwzHDQcMRwzfGM4ZThnuGy4bsgz3DcUGJlRb7QYHcYyxihjrLGasY6xvrGpMcnYxtjJmGrsY+xvHGocbZxgnGKcaZxnXKRK" +
+	"fuN642ZjjnG7cbdxv/GQ8ajxS+O3xtPG88YrxpvGO8YHRsUkmgwmqynMVM5U3lTJVMOUYGpoamZKNrUzpZh6mPqaBpqGm8aa" +
+	"MkxTTbNMC0yLTStMa00bTFmmraYdpr2mA6bDps9NJ0zfmc6YLpqumm6Z7poemmGWzSZziNlljjBXMFcx1zLXNTc2tzC3Mncw" +
+	"dzX3MqeZB5tHmseZJ5mnm+eYF5qXmleZ15k3mbeYt5l3mveZD5qPmI+ZvzGfMp8zXzZfN+eZ75sLLMyit1gsDksZS5Ql1lLN" +
+	"UsdS39LUkmRpY+lkSbX0sfS3DLWMtkywTLHMtMyzLLIst6y2rLdstuRYtlt2W/ZbDlmOWr60fGs5bTlvuWK5abljeWBRgsVg" +
+	"Q7A1OCy4XHD54ErBNYITghsGNwtODm4XnBLcI7hv8MDg4cFjgzOCpwbPCl4QvDh4RfDa4A3BWcFbg3cE7w0+EHw4+PPgE8Hf" +
+	"BZ8Jvhh8NfhW8N3gh1ZYZavJGmJ1WSOsFaxVrLWsda2NrS2srawdrF2tvaxp1sHWkdZx1knW6dY51oXWpdZV1nXWTdYt1m3W" +
+	"ndZ91oPWI9Zj1m+sp6znrJet16151vvWAhuz6W0Wm8NWxhZli7VVs9Wx1bc1tSXZ2tg62VJtfWz9bUNto20TbFNsM23zbIts" +
+	"y22rbettm205tu223bb9tkO2o7Yvbd/aTtvO267Ybtru2B7YlBAxxBBiDQkLKRdSPqRSSI2QhJCGIc1CkkPahaSE9AjpGzIw" +
+	"ZHjI2JCMkKkhs0IWhCwOWRGyNmRDSFbI1pAdIXtDDoQcDvk85ETIdyFnQi6GXA25FXI35KEddtlusofYXfYIewV7FXste117" +
+	"Y3sLeyt7B3tXey97mn2wfaR9nH2Sfbp9jn2hfal9lX2dfZN9i32bfSeIrQBA7DEV6U3u3spxsIr4jdMtfgqboiKffRBN5nid" +
+	"4z7uO47jOyryOSnRdE75SEWhvPshiHXnlFAVwefJdJtTpnE3T5l9yLEmp7xTAuFe58ND3GYDdb7HMpSxXrpQhbsPuYerbj+y" +
+	"04UZHLd43bTSPdyHWmocWbnCLSUwCcQi3MN9uLhwixfJoubLTrjrqyUpPO13K01BOMVDvqY09ZbKkwsvsxbeU8IAtxfVuEIV" +
+	"pW9xit/te6J/ir4n7ctxrA81+kfKpmJ1UqJm/jqWWod+DKxDXldCd2WsF72Uwgyv21Or3rodXgLr+7EwI4AegFrKhUk+Cm+d" +
+	"IvhawTYQe68wydteHnyvMMTnPuEe7qvPRz27p2U5LipY6213PARAjPcpmsnd0Rx7BWCM3y3UBXyxqqtIv3P3Hn//Eni/o7c4" +
+	"/ZuAdJpx3MXxcx7SEhBS8y3n99WQfg6gc/Tym4pkLjztxb9P93M1md3rvPh36YFt7elN37iH/wk9sBeUU0w+LEJ3yz78S+H9" +
+	"dE+veVTf+cu9KZBXyeLHR9MLk7xIQwszvPjI8B5+LtxS1F2sbtWQhYGyonBLsboNSPNvywTeFzQM5I0iZQjoO2RWNvmQy9hA" +
+	"xDuaWzF5KaWh8q0fHxHmEOoUG1lKjjLesWaLF4uW01/+wLjaSKRhkWfxjALFR6i/xP9F+XCTD4vy8zofPoL+t/kzUHq3dg/3" +
+	"4V+g0233cC8+kh4wtlJtPz6S3to//nqeaL973Z/QHyUfvCPLlj+RJ4H9KGC8oNrudV7URnkNizzXI/paIA8E9rVHjiMBI2Ag" +
+	"Lz1KQhZ7xjVFKUU5jesDZuWQFx/JdSX56i9RAqRl0acrVoel9HpPeF4eAEWRWYojzSyOLLoE9voLGFMCS4QR6hZHPCyOrHpx" +
+	"pN+LI9tTHGl6cRTGFUd6qziyb0pgyedqVgJ3lcDPi6NgKY6l5F4y5XIl8PN/jvRzcSwlnRL4SJ32L2iwmiwS5ihjveilFJ72" +
+	"4qMoXq3SH6ukTCsitUrIJU3jDdQiSqMUZvjlQ+GW0np0KRRNB5jix1K1i+Ly7YRHl9jixdJi8RIOVTZ5sZQwpfXu4hSub3t0" +
+	"gEoqsvcKVhaVAOy9ggFFpZNXlvr0BwazFCclAVInaTwipCXSUrSQXpQuIEm6L9vxov2GIxXvOEY4blG4M97ZhDY6H3d2oq3O" +
+	"kc4JdMCZ4cygT9X9OXTUmedKYuVBsP65RVMuCyuAFgBaAegAoCuAXgDSAAwGMBLAOACTAEwHMAfAQgBLAawCsO5/0q5Jx9xu" +
+	"EO7iCJdEJjj/4m4E77ovsYpYBKJNrBYIP6hIz6rIUlVEHaEsCCGcMkZFSCqFevCQ7VgTECVx30Uq4gR3D+a4hGqD2Cecfp+O" +
+	"g/hOOaJ3VRRC1LhsBvdtJZQFg06Kk6oDUk0pHjopUUqESaon1YdZaig1QrDURGoOm/0D+wcIA4GkcBAA2VdWzap+9c+t6vI4" +
+	"EObr9H+BcvmfU6Qz/zLFb1UPk6IetevCfqDEvouwv81/wCYAWwBsA7ATwD4ABwEcAXAMwDcATqlcJqZ590T8+/ivpWZvF3IL" +
+	"ZF8EE+sj7hMPikfEY+I34inxnHhZvP5/tvv/s93/n+3+/2z3/922exAL47LvkorMyd3QrVeR785bKV5U3epoQIckh9ctVBGu" +
+	"ct923J2ioupmrYWrfuRxh/KQQ1U3y2C3VOS+5YSoUjFCHudFWilOV1GaBCILl9QZai50SEWWIanlb62WirUWdnvzpZVqeegQ" +
+	"u1UUPWU+pJVZvOgts0b3PoVw1Y9+X9ZatvC8zhR/xuIUWik38z91AGVlQG2sVGPRSinH62YZ6jOyDO0Z2a3SkeeSIV704aPq" +
+	"MKAm6ZA8zltvf450KBClSUWRXeE1cEWn99Z8yTAlUWyhTwjAwQHtxWtMey6pn4+iPVehxkuqmw5pKCVzzPFT2K1ibt7ueCjl" +
+	"gZhFRZrJ3dEcewVgjN9N2zme5dhayvOlMIBTpqsoDOPutzj9G0/cO+qskuMuTvmcY0pASM23XIAvR/pZyvPROQZyHZn9+Pfp" +
+	"Ht5WW9AsObz4J/SLXiSzlOPFolzBufebP6UH9ohyAfgfohftTeJFP0pn/Cg382KRPuLpWcU5ExdkixeL0Td78S/Rf9eN9KKH" +
+	"n1uzhT6uzhAHFatbjbcLhSh/XxOifD2LI37Xrfeij/OT/dyuoZTjx0A6L0M5dsvLFaWgN0xqMbpF3uxHdQc33hG3e2UvWaQz" +
+	"ftQ19GNgGJ0+AP1h+EhB0J3wo361DwPbVBtrAkYcVk4sw2sv2YcBYR7VFzw53g4coVQks1jGi4/qF3+fb8WLPiwnTvdiEb4d" +
+	"Kl70YmlSvcSY2FqY48NAeqF40YcBdLrtx2L0q14sMrbW9uOj6J7x95Bw1efer7k1/g8YnfeL072SwSsfeEt9I24P4POUonTP" +
+	"CM4xsD96xwjJ4SsJR98on8KfyIeBo0lg+9JKf5jAvlZSN/D0hUf0nZLcVWTE/EbY7X+6AErRFErwnuYbyHX82ctJOT7UtIty" +
+	"0iQflkIpznWB3KWNvFTbjyxDK5u43Yua1uGpJUh5RZFZiiPNLI4sugT2+gsYUwJLhKHtJfBsCWxdHPGwOLIBxZGmF0dhWHGk" +
+	"t4oj+6YEappAIDYrgZqGEIifl8CU4lhK7iVTLldclygl5c9L10ACsZR0SmBpOm2gBvun+qpXdpXx4aOkXAn5VppkEy/6sKTU" +
+	"8ozO3L3fo1cX660lZdSje3SgHNMofs1Hywsj/VhSu9A0Z/wub/ZiSYn3KB2jFMrv+sFeLNm7NR2bavuxNClaXE6WQskoJvEY" +
+	"gqVKUiVAqiPVAUmNpEZg3LotcOt2HLduV+bW7Uncur2KW7cZt27P49btZdy6/Rq3bm/l1u23uHVbB0LEoy2LskMOlcNkp+yS" +
+	"H5PLyOVKsTRGlLpL93/j7Mp/2BJJx8TMv4P/Yu4y7oPIChPC/4YFvvjJKxJPAiDxJse7KgqTlXCQOFeju5uBxN+4+2uO6zlu" +
+	"5nS+rige4DjTj9JTHLUUfvWjZAZ8qWk58lU+8QXua+XIV+fExf4wuiE+ZGByjNwEkPvLA1AWBEE9Lye3KX6KEupfEaTyJIOo" +
+	"KUyIRDMkox1S0AN9MRDDMfZvnesh8aQ4Qi2dOFmtMxWFydw9V1zro/8mTlHrTFT7+HrxGbXOxKFqnXHfAxxnqi0vzpQ6qXUm" +
+	"9lbrTHpMrS3VLf4qTVDrTEzzpaal/JbKNeILKl2yiivUOhMXqHUmTuRhloIAsY0XdUPEBR5kED3111nujLJympyGcrwuw0Ew" +
+	"BZ4PlFPkLnJXuZucKneHyXci8K/uuCdA1LD/I1DzTeM4PQDTirmjeHv28p6WVC/WX0kBgfDGI8/IXYb5n5yui/nLJ0WBzwGc" +
+	"APAdgDP/IydwSElwu/0YeAbNrRT3/V9Cf76J/0oK0nbxS5C0FyaspjpUn5pSErWhTpRKfag/DaXRNIGm0EyaR4toOa2m9bSZ" +
+	"cmg77ab9dIiO0pf0LZ2m83SFbtIdekAKE5mBWVkYK8fKs0qsBktgDVkzlszasRTWg/VlA9lwNpZlsKlsFlvAFrMVbC3bwLLY" +
+	"VraD7WUH2GH2OTvBvmNn2EV2ld1id9lDAYIsmIQQwSVECBWEKkItoa7QWGghtBI6CF2FXkKaMFgYKYwTJgnThTnCQmGpsEpY" +
+	"J2wStgjbhJ3CPuGgcEQ4JnwjnBLOCZeF60KecF8oEJmoFy2iQywjRomxYjWxjlhfbComiW3ETmKq2EfsLw4VR4sTxCniTHGe" +
+	"uEhcLq4W14ubxRxxu7hb3A8C19z/D/8P/w//C5ChIiqgCR/7nkIZrMVaJGAf9iERR/Ap6uIEvkZ9/Ibf0JAqUWU0oubUHE2o" +
+	"FbVCU+pMKXicelJPNKd0SkcLmkyZaMlCWAiSWRgLw5OsLCuLViychaM1q8KqoA2rx+qhLevKuqKdUFYoi/b6r/Vfo0OQPsiG" +
+	"jrZrthvoabtlu40+tnu2e+hr+8P2EP1CboXcRrpdbzdigN1mL4sh9gh7HMbZK9urY6q9jj0Rz9ob21thrr2dvROWO7s4u+Al" +
+	"EJhjqGN44LsHitRCf3QAYbhnv4MLEajgexsFsWDZAWIbdaNArIvqJsYp/Hwua+Lx7QQSXtI9B4IeH+MEcvEPCqbqQfqgIBAY" +
+	"e4ftCMw/8BSjsIh9BxKWw4Se//wNAlSGoiiWqv23jDsgcQYAYtoOvXvc3Zu7gzlaOZr9yL7j2JfjaxyfUVEYw939OVbi2MW/" +
+	"M5BYib2CCZx+n7ubcPeFgBxLIPvOn05JZAn+MogzlJmqW5npLQNZ/SH/ipsl+EvFIgOeelrx8mjPruXCnlRSvL7/JP1k7l7J" +
+	"3WEctdomf+504c9SY9/5Q0q5/jmJtIq7P+C+VznO9bcdHvrbTqt5GhaQspZ7EzVf9EQFxCIOlVAV1VALtVEHdVEfTZCEZDyJ" +
+	"VmiLduiEzkhBKnrgKSzGEizFMqzFNryN7XgH+/Axl0G5uI4buIl/4BZu4w5+U/sWtVJlD/WkdJpMUyiTltFy+py+oGN0nIWw" +
+	"MFaWhbMqrB7rGlQtqHrQkaBPbdds9+whdrvdYQ+1V7BXtLewt7Qn25+0t7J3dHZx3gbxt+t4dkMprQCQNcT8JcjaDiba+7+t" +
+	"K4mHxKPil+K34mnxvHhFvCneER+IiiRKBskqhUnlpPJSJamGlCA1lJpJyVI7KUXqIfWVBkrDpbFShjRVmiUtkBZLK6S10gYp" +
+	"S9oq7ZD2Sgekw9Ln0gnpO+mMdFG6Kt2S7koPZciybJJDZJccIVeQq8i15LpyY7mF3EruIHeVe8lp8mB5pDxOniRPl+fIC+Wl" +
+	"8ip5nbxJ3iJvk3fK++SD8hH5mPyNfEo+J1+Wr8t58n25QMd0ep1F59CV0UXpYnXVdHV09XVNdUm6NrpOulRdH11/3VDdaN0E" +
+	"3RTdTN083SLdct1q3XrdZl2Obrtut26/7pDuqO5L3be607rzuiu6m7o7ugc6RS/qDXqrPkxfTl9eX0lfQ5+gb6hvpk/Wt9On" +
+	"6Hvo++oH6ofrx+oz9FP1s/QL9Iv1K/Rr9Rv0Wfqt+h36vfoD+sP6z/Un9N/pz+gv6q/qb+nv6h8GIUgOMgWFBLmCIoIqBFUJ" +
+	"qhVUN6hxUIugVkEdgroG9QpKCxocNDJoXNCkoOlBc4IWBi0NWhW0LmhT0JagbUE7g/YFHQw6EnQs6JugU0Hngi4HXQ/KC7of" +
+	"VGBgBr3BYnAYyhiiDLGGaoY6hvqGpoYkQxtDJ0OqoY+hv2GoYbRhgmGKYaZhnmGRYblhtWG9YbMhx7DdsNuw33DIcNTwpeFb" +
+	"w2nDecMVw03DHcMDg2IUjQaj1RhmLGcsb6xkrGFMMDY0NjMmG9sZU4w9jH2NA43DjWONGcapxlnGBcbFxhXGtcYNxizjVuMO" +
+	"417jAeNh4+fGE8bvjGeMF41XjbeMd40PTTDJJpMpxOQyRZgqmKqYapnqmhqbWphamTqYupp6mdJMg00jTeNMk0zTTXNMC01L" +
+	"TatM60ybTFtM20w7TftMB01HTMdM35hOmc6ZLpuum/JM900FZmbWmy1mh7mMOcoca65mrmOub25qTjK3MXcyp5r7mPubh5pH" +
+	"myeYp5hnmueZF5mXm1eb15s3m3PM2827zftBrDXwL2NGAGo7xzf6RycNBdm/j9sjw4KVFK8vS+VhynD3GwFSs3eAW5PxnQOk" +
+	"/mv+fIUlfgnK4gPkPZfW7GmOacVHPCqrjYRKinc89I5UfoomiYU5AWNmQkAsbSTRxsNbHA8FlFbb18+tVawix5iA8H86fgoz" +
+	"/nQUbRJQkuASY+nG4k8qtOPuBf/SGKs9dSslxZuj8KY/98DnpbIBtR0ZUIbAMdnpHy01Oh4qU7w186+N0vjNX0465OeKQA7x" +
+	"8FKKn/c8rfxdcb3Gwz9aefj4z1wcuY2RtQ3QCLSacSkpvjAVAzSFAH2ByQH4WgCPVQtALbWKSoovnSYBdM5vRAAIXIOgnhzH" +
+	"cvzCU5Nq+NeUFC/HCnOUFC+yVoHob0FRK/n6gFwKAD+qFLh5rArctw8PmciR/Cen6baS4i2DhvRhgPsHjoeUFG9tl9JHNHds" +
+	"gFZ1IdDNcY4/X687oB0rcDQHtE4/931fbQ/mWD6AuwJSE17SUEnxutlvqlvkJ9HZCe1ZAmRXUyXF175N/PxchEtZCQnWO6Bs" +
+	"u0r48hqgywFc1z/AzZEuBfBS/4ATJ9pJneb+1GhuCfnMkc5zd4cA1NKvXLxF6IKfu1i5AF9N+gXKmY3qO9NYJY4b1TeesUoc" +
+	"NyoTuHsCd4dyd+ij+7JQj7v3+EcTgWvNrJC3C69tOs0xm9O1twVwCrvI8aSS4juvr8nSKhwbc9RzunY2qKE/TS0uHeZuLnsF" +
+	"LgeEc5zyqZ9OH0HPzy3pQMRHLsbloRDE833bfxrJIwlTA7B3QDseCuCB/iV445kAiaQ9RRklxduCbKr7srcthCX+9mLRAWn2" +
+	"8/dij6x+XRtDNUnrl64i5yghR4vrlxJChDZGa3yupHp5T5zpn8MJn3CcwOltAuh8fiOEB7rd9/3ugNGfz2yEBv5ZDpvN3bOL" +
+	"z42KlLO3nw+10U0YECAHtN79ZoCkPaCkeMPTYSXFp2n0D9BP+geUqn+ANtK/xBjR2T/ie/jHztaBWCcVBT5GMJu//jXdxlPb" +
+	"nQNS1kaoaxzf86Mg+2uDhiopvjbdwn23c3duwJm2RRwLOa7luUjcfYXj8xxHB4zLgfpbvb+n7xH5a5X6g3xScY1vzGJIgx3l" +
+	"AG7xMnCLVwUsxjI05XavFtiCLWiJbdiBJG4Da42P8THa8FloW24Ja49c5KIjruI2OqlzUfTAQzxELzKTGb0pmILRh0IpFE9x" +
+	"a1lfqk7VkcZtZun0BD2B/txyNoA6UAcM5PazQdSVumIIt6IN5Va0YTSSRmI4ZVAGRnCL2kh1fosx9Dkdx9N0nx5gIpOYCZOY" +
+	"hbXBTNaODcEHbBgbgR/YKDYKZ7hd7azwpPAkzglthDb4SWgvtMd54TnhOVwQXhZexkXhhnATl0SdqMfPYowYg1/EBDEBV8V6" +
+	"Yj1cE6eJ03FdP1Q/DDf1I/RjcUs/Xp+Ju/oZ+tko1M/XLyKm2u1Ir9rtKEidZ5NdnWlTaNCDoD/IaXzZ+DI9ZnzNmEVljFuM" +
+	"ORRhfMv4FkUbtxt3UXnjfuN+qmQ8aDxIlY2HjZ9QFeOnxs+omvEL4xdU03jc+DXVMn5r/J4SzcfMx6iB7YztDDW0nbP9RI1s" +
+	"F2x3qIntru0+dVStg5QS0iykGXVVbYTUTbURUne72W6h3narvSw9ZY+wV6dhqo2QJtsb2TvRtNBnQp+jF0M3hm6krNDXQl+j" +
+	"7NBToRdoi7OLsyvtcI5wjqCdzonOibTLOcM5g3Y7bztv057/W5f7712XCxtpXw4K2wsTG/kftJnskw7yc06lWUwcchk5So6V" +
+	"q8l1Sj3lpNlLFsnL5dXy+v/nZ5yKWku66nvp0/SD9SP14/ST9NP1c/QL9Uv1q/Tr9Jv0W/Tb9Dv1+/QH9Uf0x/Tf6E/pz+kv" +
+	"66//Pzjh9H+2kr9kKzEfMh81f2n+1nzafN58xXzTfMf8wKxYRIvBYrWEWcpZylsqWWpYEiwNLc0syZZ2lhRLD0tfy0DLcMtY" +
+	"S4ZlqmWWZYFlsWWFZa1lgyXLstWyw7LXcsBy2PK55YTlO8sZy0XLVcsty13Lw2AEy8Gm4JDgMsFRwbHB1YLrBNcPbhqcFNwm" +
+	"uFNw6n/x+aYatgRbQ1szW7KtnS3F1sPW1zbQNtw21pZhm2qbZVtgW2xbYVtr22DLsm2z7bTtsx20HbEds31jO2U7Z7tsu27L" +
+	"+294MxnfM5f3L2NGAP7OcSPH3n4UZP9OULJyDPb7komHKcMpb3D8LiAFzd2EY2eOCRxf8+crLOHuuRzjOT4j5YGxYDETMZ49" +
+	"sYyliZlwqjNe/quLmIkQ7y5bVk3M9IQjYnJNLwbuqRXmqGFYKzETjKnrbCYQ689ptVSkCxxvq4hCnkcTMRMOda7CQ/3GsSLH" +
+	"GH8M7QmFGWKmL70mUp4njzLe3FlG4BPxZyChnYpUU/UhiJkQfSmopSThTTHTW1+8RMRL5HnmwGdjkf72E17y74H11P60gNYq" +
+	"G9CikwJalCPyA9JsXqJFOQewyVKer54eBpQtobibDQhoUV5jvF299cbdrAvHJ/01id+kPG8NaKkREzM9NViEbzwc1lbM9HKk" +
+	"1uqBPOfJK7AMyRxdHF/wPQWjV8VMCN4W8LQQR0+bh/lQbcepfG4ti5k+fE3M9JW1mp8rPS1aMQCbBNQdRyK13LjJU+jJcSyn" +
+	"fyHllcbFgchaFUON8yz8WzUqbb1WCp7eQ9VND/1uD29X4Fib+/bmbuIYGtAnAsv7oZ9CP/hbSnsy1iQgZa3XaLvsYwP6WUmc" +
+	"w8OQlFfU7WlNrYRm/7Owflp98xYfXLzuA1MIbEsNGecx8TEe/kTAc2lyLZjTm0p5vqdoIuUFtLrBz6Hch3BXzPSWyNNvOJfR" +
+	"eTHTF76Jv449PJEYUFucV+gydw8K4BVV6pGgCyiRhn203hCQQmJAyNcCcukfUE9aXWqUg/429IS865fSmjwQe3JEQO/PKIGp" +
+	"KgplA8aL8wEy4A1/rxViAkaKXSXkSu+AMUiLW4uHdwWMDlrIUZz+gx895xKrBZTKHpDXKo5ab+gRwE0GjjlipleKCE0D+nPz" +
+	"gDbaynFPAF2r817F69mTC5c0gj1AemghrZze1o/0Dacf19qCU+YFlCrRnzLd9rdjIAoreGpcklGodsaDUziHaPxAH3G3Scz0" +
+	"1QlPX6jC3V+Lmd5WExryHE9rrVCC/gmnfys99EtZTv+Ku1PETF879g+Iq9WqJrkTS3d7pNs7HC1ipq+tNU6wBvSvVgFtEVgG" +
+	"rTXnBtT51hJ5bQ1owWh/jxPeDGg7bzvO9lLoUgBXcF8UlM4PNFcbqYqj8GaANOgQgFqOlQP6e4DU9MjFLwJyjA54Cq2cGwOk" +
+	"2l9wC/VUpD1cnql9LUy1W/NQXIIL2ji6mmM2p6+Q8nwccVG6qNqtec4f8jTUFjB5OUlozFHPQ73FsaE/VXaR4ywxSbVQczof" +
+	"l4VznP6plOel00fSL/x80i8gSpVrq5ZrXo9BKrK3NS4JkNum4m4PFzbx93zPCLXLH5L1DnAHaibfBcgOTZpMlU56a9+jrf4W" +
+	"0B67/OMRG+QfYbX+Q6+LmV6pquXCnvTn7pGzWi1tVkOKb3I3T4el+vuqJouFtwPoAX1AcHDKMh7yEqfkBHCYqwQ9oB6ECF95" +
+	"NA1V7+V5kZ+XE8bw5+Z9X5jA6W0C6Fy6CuFF3E8XpXuelfztQ9qJtQYcZ6vIrvL81R6EYmUNlChcZxYGBPT+WiW0kzd9GjVA" +
+	"bHPAaM1HDjocMDJxukcfrubPDQ+LhymiT3b268Ae3rZLt1WruhThlezMxsMU+HmJrDr17ELn4uMiuyaLqj1dyvOiIAfU0dCA" +
+	"kW8L99XmPLmeM3YqLvL3YLZWRVGSflet6hyf5zialypSzPTN/TSs9zdnjLzkRAEa16sBdc/8dA+uUVtDaInhABgml2JvVy3t" +
+	"TbEFb+Bx7MAONOeW9hY4iINoyS3tSfgaXyMZV3ENT/Kdp625jb0Nt7G35db1dty63p4SKREdqTm1QCduXU+hVtQaXbh1vZu6" +
+	"NxKp1IN64inqTU+hH/WjfujPbewDaDANxkAaRsMxiEbSWAyhCdzSvpW2YgLdpz88NvbJ3Mb+LLexH+A29tPcxn6W29jPCclC" +
+	"X/wkDBIG44EwXBiOh8JIYSTyhdHCaBQIY4WxKBQmCZOgCHOF5+EWXhBeIFlYJiwjnbBGeJn0wnrhVTIKm4W3yCy8LRwnp/CV" +
+	"cJnqCteEPGon3BUZdRUl0UADxBixAg0Tq4rVaIRYU6xHo8TmYnPKEDuIHegZsafYkyaJ/cR+NFkcIA6gKaoNnzLFReIimia+" +
+	"KK6g6eIWcQvNFN8Vd9Kz4m5xN80W94p7aY5+qH4szdWP10+lxaqFn1ar7zyiV/RL9UtpM7fzvx5kCLJRFrftb+O2/e3ctv8O" +
+	"t+3v4rb9vdy2/x637X/IbfsHuW3/I27b/5jb9o9w2/6n3LZ/jNv2T9jO2O7T19ySfzMkNiSWboU0DGlIt7lVPy8kN+QU/Rry" +
+	"Y8gFuhdyKeQKFYRcC7nGEHIj5AYj1ebPmGrzZ6Jq82d61ebPDKrNn4XZa9prslh7bXsii7M3tHdi1VTLP2upWv5Zqmr5Z91V" +
+	"yz/r4UxxdmX9Vcs/G6Ra/tlg52TnZDbEOdU5jQ1VVwHYcHUVgI3wfPnmE/7lm98Qyr95U5Z/86Yc/+ZNOP/mTRT/5k15/hXR" +
+	"GP7lm0r8yzc1+JdvEvmXb5qhuceOb/Gc3yvNht8UoGSA2gGUAlCP/6ov24DVAVh9gDUFWDLAOgCsK8B6ASwNYIMBNhJg4wA2" +
+	"CWDTATYHYAsBthRgq8D4bmix2F33yK+/FA2nf2QI3T/9lsx2YQx3jwtATg9w/9tYyHV+JYsjl80KH2cKG/ybKWtPafLcrf+5" +
+	"EmtITaGAKAkmRKApktAGnZCKPuiPoRiNCZiCmZiHRViO1ViPzcjBduzGfhzCUXyJb3Ea53EFN0FiHwAkpKlI2i6d7wPWmvmu" +
+	"IfaMWwEJXXlIh4pqb/Du6hG0ryVcUFJ9693f+30pwr+OXCT9Enu3AndPU0xACnwnj7jbT+dnASqhGuqgPhrzvcutPOcr++Ap" +
+	"rMU+/Eat/HuQ+b5jvufYds3ZBQRiP/j2E1eG2mHyIALRtWKikIhO+P+SP/dJ9x6Psy3GoQHaAu4kwL3A/ZZ7rdtcaqRYX+zP" +
+	"OJ4p6e8+WSJW279dsjPuM2jgTV9FToE7HXBPcy/VXBrVfcb3HIHhF7jfAtwT3Xt8FM+FBr54vn++tLRUl7rfcqe7J3op3P2W" +
+	"e6nywC2gZpGSnimROtwfqXmqZfwrf+6P/DFLpux1+/Phd0/6RWO597iXqiX/i/me+avh/mrIPyt/Sf55dAk8z7r0Xy3fXyuv" +
+	"e2lJt/uk+zO1BwDKHne65vqzvNxJ/tjuEZ42GFEkxSR/WPdSTxslFU3ZG5+3qspxAXHdezTu9uR0ElAW/OlT+XvC2v/Z9n1E" +
+	"vz35CLnwX/Hn3uPpm3v+c2X8j9afWj6VSyr7pMrSYlyS7v5MK7/GLcVb2r222H2Ee5pHop1Rae61f5UvHiGrPgqouZolOU+5" +
+	"4l7rjlS5Vcn7M0nguycV9fv3alNJL7zCHQ3cbz06JfcZ7xjyn5STf7F/nOG9+qTykVqGPw8LuDf7a5b/PsPlQZHxwleTmzWu" +
+	"+LfKG4tYZY82Qv43/vH6mwa43+I95a2SUk6VvtoY6J7m1US0WvTWUrG7OmKe4f3ipEpzbw4M/Xf/1N5W+lj33/XnTnKb3ZFc" +
+	"s1nwZ9yuvKVsBhSNC3nPVzYrJ5W3AOUz1RdQPvKMawvcNuU8oNxVftHC/astrDz465L3/1n9LVAeuHu6p/2z0rjTA/Ck363q" +
+	"pZ77Z+4OPsnu0TT/vbIpV3j/CHUv+OcSzjM2LAgo34JAmcMlzlLPKDNNDede4PX5V/7UlJQHgb3WPcLnmvbIWvxTXvJrX/9O" +
+	"z/W2l/stLmfNSrqS5073po5H6KMKH220kc69x+8G3KG+eJGaTFKuqH5Knt/n7/+pbaE8KL2W/hv+/HOp/1R6/0PlXKpp2/9e" +
+	"uTz6yp7/YCmbB3LOXy5HwJN43e6Piv7WSqppmPj/2T/3CPdSLgVi/0O6/Uf/8fLt4XrJNHeS+8xf1nhO+jnOfVJzeXw/K9LC" +
+	"J7UL8N/R6m8WMVUd2d2fudPdS/96pH8mE/6TEsEjbZP+O8vnnbv9nR4cyGV/xnHuj7QL/7ZkdJ9RzrhHBFL+e+pPOVk07aKz" +
+	"Qq5rahr7iL9SLk+8l/68jP+ihWuPdzbmLyGf3XXgs4rIv1G+//BI979jPy2KpdlPiz+b137631k+99L/zvIBxcv33/rnfsu9" +
+	"+T9tV/kPy5dY/0j8X1h/ZzQJ8t9qOfg76wv/L/5U7nPv+Z/ov0DR/utPM2Bs+ivyhf1n5UtRDf+flQ8ILB/wqPL9eQ/9d9dn" +
+	"/q1x7TN3Umk+fyn24/9rfPi/pt+7z/xL+v3/gP3PG1b5DP8h+9//1Po08O+uT2t/ygN1Ncn9kQf3FJmHLwjM270nwO7gXd3b" +
+	"41+JUqa59wSuHhb/+xfHqli+2qhqqXtQU5XdmsVKWeAW3HsC1xH968r+p3CHuhv7enmsdi/6jP9y+RhSYIUVhAqowN9VWRGC" +
+	"uvYAEXGIg4TKqAwZVVAFOlRFVehRHdURhBqoAQNqoiaMqIM6MCEe8TAjAQmwIBGJCEZd1IUV9VAPNtRHfYSgARrAjoZoCAca" +
+	"oRFC0RiNEYYmaAInWqEVXGiN1ngMHdABZdAZnVEWKUhBOXRDN4SjJ3oiAr3QC5Hojd6IQh/0QTRGYRTKYxqmIQazMAsVMBtz" +
+	"UBFzMRexmId5qIgFWIA4PIfnUAnLsRwSVmA1THgZ6xGCV/EqwrABG1AZG7ERVZCDt+Dieyir4l28i7LYib0ohw/xIaLxET5C" +
+	"NA7hEKrhMA6jGo7gCKrjKI6iEr7El6iEEzgBCd/gG9TAWZyFC+dxHjrkIx9GuOGGkRgx1CQDGVCWwigMweQkJ8KpDJVBMJWl" +
+	"sqhFURQFK5Wn8rBSLMWiNtWiWgihhtQQYfQ4PQ6n4TfDb6hjcBvciDeSkZBgl+0ybPYIewQM9kh7JMqAYZOnvW2wgSEc4RB4" +
+	"24u87SXe9jJvex1vez1v+6CAtjegBmpCz1vdyFtdz1vdxFtdz1vdzFvdwls9mLe6lbe6jbd6CG91O291B5KQhFAkIxlheBJP" +
+	"FuODNmiLMmiHdiiLjuiIcuiETh7OCOecEYGu6Aon549IpCIVUeiO7ohGD/RAec4xMZxjKnCOqcg5JpZzTBznmEqYhXkQOX9U" +
+	"5vxRhXOGkXNGMOcMOzZgI/TYhE2oitfwGkKxGdmoijfwBsKQgxxUw5t4Ey5sxVuoxvmmOuebcOzETtTALuxCBHZjL2rgfbyP" +
+	"mtiP/SiPD3AANTlXxXKuiuVcVYu/G6M2561a+ASfoDbnsFr4FJ+iNuczGZ/hM9TB5/gc8fgCXyABx3AM8TiO40jkXCjjK3yF" +
+	"OpwXdXznb12cxEnU42/aqI/v8T3q4wf8gPr4ET+ivodTz+Ec4jm/NsAFXEAZXMRFxOMyLqMhfsWvaIR7uIdGnJv1nJv1nI/D" +
+	"yUIWxPN3dTQmK1kRTzayoTGFUAiakJ3saMx5Xc95PZIeo8fQiHO8nnN8JJWjcjBTBEXAzLnfTNEUjSjeB8wUQzGIogpUAU2p" +
+	"IlVEIu8VFoqjOERTJaqEx6kqVUUz/naQZlSTaqIZ7zPBVJtqozzFUzya8d3Nzagu1UVzqkf10ILqU320pAbUAEm8d9l573LQ" +
+	"AlqABL5z+Ql6i95CMm2jbXiC3qa3kUyn6TTMdIbO4Ek6S2eRQHmUh3j6lX5FK/qNfkMC3aW7aE336B7aBE0Pmo7mQTOCZqBt" +
+	"0MygmWgX9GzQs2gRNCtoFpoHzQ6ajRZBeUF5aBn0a9CvSOK9ur0RRqAD79sdea/Wg5AOKyqgIn8XaGVUQVVU5/2zDuKRgETU" +
+	"RT3URwM0RCO+w64VWqMDfytoN/REL/RGH4zCNMzi8nIu5mEBnsNyrMBKrMJLWI2XsQ6vYD1e5T0gB29y/t6Bd7ETu7Abe7AX" +
+	"H+IjHMJhHOG7Ek/gG5zFeeTDTYwMFEZOKkNlSW29WKpFDelxw28Gt5Hssj3CHgmGNIgAKqAHJDDPSHYKcL8DuF8D3OtA9hQ4" +
+	"QWAQIIIAj5uV4hY9brIPVxEMoj3FngrYe9j7QLL3tfeHwT7QPhTBAAiAgbUR9sKRlNyuK6akj0vvj4Xp40aNxuH+49KH4ZsB" +
+	"6aPH49yAoYPG4fqAyeNG4v6QQWPGkWvIuEEjqMLQ9NHDKGHooP7jqNmI9NHplDIyfUwU9R2ZPmE0DR+VPmEo3Rk9YkwUKWOG" +
+	"pI9i1nETR49j5SYMHj2EVZowNH0YS4AI7U99NglBCIPQtltKFMK6dEiKQqVuKSoCbjdkyDDBihCEwVWC8piWktsNBgkG3y+C" +
+	"9Ei3Fax926QouLqktIjypEnQeVLRwVFqTIGHKVOqnwgGPSwo+8g8k2Fs2bxrFHo90aVTFHo92bxdFHq17tI+Cv3bcmzHsX3H" +
+	"dlHI6MDdKR3bRyGjS9suUejfhVN4ajpeZzoYEaydheA5iD4/r0+ox08OoJUrWT4AImSYYYPzL9VeoDs8KqVzEranp4+cgN0c" +
+	"93M8NCB9/CAcHTBg1Fh8yfFbjmc4Xh44ctgQ3OJ4l+PDwcNGpxM4ykNHDhtCJo4hw0YPm0AujhEjhw1Jpwocq40cM2Ak1eFY" +
+	"f9SggcOoKcekcWqsNhy7jk8fOYH6cOzPcSgA8vSYshwf4xgB/xdQRAAMzHMX+F3gvyUABBfHUI5hHIM46jk6ODo5mjmaADDY" +
+	"YOe/QjjKHHUcjRwNPBcLgmHl4bU7oRzHMhzDUQkp6IX+GI5xWIQVWIfN2Iqd2I/DOIZvcQaXcRN3UUAimchB5agCVaMEakxJ" +
+	"1I66Uh8aSCNpAk2lObSIVtA6EACiFMgIAlEaZBCIvtVKyKL43SCtkG7KSfI6+a6unW6x7jt9hH4wRBBIv1hLQb/Vc9/O64P0" +
+	"Oz33B9o96KF2NzTV7sYoz32glo4p2XOfAlGlm+G5R0AEQOY+mr95qXa3tNLolh6e+zrPfavnvh+k/gvu5Ll31coX3MNzz/Dc" +
+	"V0Dg9xxPuDMe+nntbg3x3Ft57p08d0861jTPfajnPhYMJgiuJ1zJridBEDAAz4b0AIMV1fg/BqAVGET+7SjI0XI0Igx5hjxE" +
+	"qt+IQpTPj7gfcT/ifmp6ergQq5XW8w8w+FzqpXGil2IC4++gj0IUf9YWmq/UwBeeQZJs0mNgUjmpHJgUIUWASbFSLJjUgIfT" +
+	"eoV6ZyAA6vgVhkX8nwQIJkGzixAaoB3a41dmZFVYP/Ud9exdtpPtYrvZHraXvcf2sffZfvYBO8A+ZAfZR+wQ+5gdZp+wW+w2" +
+	"y2O/sjvsN3aX3WO/s3xWwAoFEpggCguEG8I/RJf4mFhGLBtUNegz43Lji8YVxpXG88YLYBjOT4MJeAJPQOAatcB1aQFt0RYC" +
+	"LxOhAzpC4HqygFlYDQFrsREC1zwFrnMKXNsUuJ4pcA1T4LqlwLVKgeuTAp/bCPgW30LAd/gOAk7hFAScxmkIOIMzELgmKXBN" +
+	"UuA6pMB1SAFXcB0CbuImBNzCLQjIQx4ErlsS7uAOBNzFXQi4j/sQ8AAPICCfJAikIx0ECqIgCGQiEwSucwpc2xS4nimQgxwQ" +
+	"uJ4pkItcELiGKXDdUuC6pcB1S4FrlQLXJwWuSQpchxSoClWBQNWoGgSqQTUgcB1SoDpUBwIlUAIErj0KXG8UqCE1gkBNqAkE" +
+	"rjcK/L10An8jnUAplAKBv4tOoFRKhaCeaoBAS2gJBFpGL0KglbQSAm2mzRC4tilwPVPgGqbANUyB65YC1ycFZmRGEP/qA7F+" +
+	"rB+In5ti/B1qIjvOLkPk700T2S12D4zdZ/ch8pNUjL9JTeTnqRh/n5qochgYf6uayM9WMWGBsAAk3BBugIR/CP/g78EoCxZU" +
+	"NagqKOizoM9AxuXGlWCc+7Q+GYbYIj0kmv9iEIv8FiAGULzxVLq3L/LeX+S3FqdoiBCU4zNYAUACksFALFt7N6fnYmAsm2WD" +
+	"2Bb2JlhAD1b/AeVLofyVdIllQ1LpYPxf8VRiSqH8nXS9KRdPpUIplL+XLvO0TdFUKpZC+ev1mwPG3mRvFpGQ6j8gthSKJodd" +
+	"qMTTraX5smx/W9lT7N18Gj14ChTwO86XQg2eQl1PClvAILAtbAvInmpPBbP3sPf6F9J5g6fzBnsDZO9t7wNm72vv9y+kk8PT" +
+	"yWE5IHuavT+YfaB94F9Kp2jNvAnmrV/7IPvQP02BEAEGAxyqn/0DMCmUuz4Ek1wgMKmWlASCHHwdBBKOqO6wZNVNJjC0QITz" +
+	"Oeci5xLncudK52rny871zo3Ozc5sZ47zLed257vO3c73nPudHzoPOT9xHnV+4fzS+bXzW+f3ztPOs87zYCBtPAfDLUxwTHVM" +
+	"d8x0zHLMcSxwLHQsdix1rHCsdqxzbHBsdmQ5tjhyHFsd2x07HXsd+x0HHYcdRx3HHCcc3zpOOc44zjsuO646rjtuOuOdDRyw" +
+	"P7DfdD7ubGHPsxfY7zqfcLZyWB0mZ1uHw+FylHfmOX913nFEOMo5opy/Oe86Yp0TnPec950jnb87HzgqOP9wPnQWuuASXLIr" +
+	"yGVy1nUFu0IcoivUoXe5XGUdlVwRrmhXBUcLV5yjmquKI8FV3VHLVcs52hXvqutq4GrsqO9o6Hrc1cLR1NnB0cqR7GjjaOfo" +
+	"6nza0cGZwXWVTtJQQBoujcdj/NuYMfzbmBU9tbrQ+YJzqfNF5yrnGuc656vOTc7XnVucbzq3Od9x7nTuce5zfuA86PzYecT5" +
+	"mfOY8yvnN85c5ynnj85zzgtFajUHKY55jkWO5Y5VjrWO9Y5Njm2OHY7djn2OA45DjiOOzx1fOr5xfOc47TjnuOi44khx3HIm" +
+	"Ohs5mP2h/ZazuTPJfseu2O87n3S2cYQ4LM72jjBHGWeB0+1iLsmldxldFmd9l83lcMgup8PgKuMKd1RxRbliXLGOJFdlRw1X" +
+	"NUddV01HHVcd51hXoqu+q5GrqbOJo7GruSvJ0czZyZHqHO/oBAaD1FXqCkipUipI6iH1AZPSpaHQqW+ERLA0ThqPMF5TLulF" +
+	"aSPKSDulH1BZOiedR0dPrT3vXOxc5lzhfMm51vmKc4PzNWeW8w3nVufbzh3OXc69zvedB5wfOQ87P3V+7jzuPOE86fzO+YPz" +
+	"jPMn58UStTbX8bxjmWOlY43jFcdGx1uOdxy7HO85PnB85PjE8ZnjuONrR67jB8dZxwXHz47Ojn84E5wNHWT/w/4PZzNnS/uv" +
+	"9kL7PWeys7XD5jA72zlCHY85852Ki1yiS+cyuMzOei6ry+6QXGGOINdjrnKOyq5IV3lXRUdLVyVHdVdVR6KrhqO2q7ZzjCvB" +
+	"Vc/V0NXE2djRyNXM1dLxuLOjo5tznKPjv11rhDoQYEIYokAQnbPBpCncNY+7GGSYuHsy98sAQZAypMk81HTIXObHoj7aYCSP" +
+	"96wvhVkBKcwHk6Y55wRQFnDKXE4RYYAVYSjHf0/i4Z7h8Sfy9CYExJnE03oGBDngO7DTikoyxz0wxwLuuu9xMccPjtMgNOWh" +
+	"KnAtwgKH4zcwxyzHr2CO6Y7bYI6pPp+73OcO98njPv5U6oKghwQTHCiH8qhk38V15472nfzezv4uGDrad4Chnf0dMLQGgTju" +
+	"gAQrUjAS67Gl5JeuHJPApExHhlozjglqzTvGqc/tGKs+t2OM2gqOUWrdOEbwuvGUV+oCJk2XOqv1KnUCk6ZCVukogyjEoppj" +
+	"Mk/3GZ7uRJ7ueJ7u0zzdkTwtgiilqClwV1ePS4IgdZOGSU9L40EI4c+ujhLXwaR4EEhSR5IWHk6qz+NawKQgf8va94OJd+37" +
+	"wMQ79vfAxJv2vWBiIU/nfY9LEG+K+ZJUJI89YOgOAiG1CH23l07NQVjAdcJKaIhkdEIvDMY4jWeM1cBQ3hjp0SlVSlXVbayi" +
+	"0kEQjSf5ukYj7p4JxvMRjXvBUIu73oOASqjJ3WsgIIRrNjIsxlf5rx7G9fyewssmojwqe6yp6veVCLJlPa+jqCJuBgd0wlBh" +
+	"iDBMfV+FMEIYDoJOqCA0FJoJTwrthW5C778cygrJOcM5gtmdE51qOSRUQ3UkoSOZQKjC+2kYyiAC5RELcs4AOUeAmB3knAhS" +
+	"v7jE48neeOjDY8qW+Z5ZL0METM6KzhhnrLOCs7KzirO885KzqjPOWc1ZCQSTb9aqzVi1+epBdggMUTDjN/rDOZlF83c6/8F/" +
+	"TXVOc05xZoJgDpz9arNWPlvV5gmyNaeIJilb3y72e2ex3+8V+30g4DevK/tw778AH499F+dxFZ3Q2ATdWQBgswAxDZByPUa/" +
+	"tKILo97fNNTj7vbPF1PJArCMfx4OvbSw6KVdZPHQUQq9l/+icX43qw/kLwHQ2Be1kvhQaohaMPHVrIVYjOVYhbVYj03Yhn04" +
+	"gm9wDtdxnxhZqAzFUh1qSm0olfrTYBpOo2kcTaeFtIo20TbaR0foGzpH1+k+Y8zCyrBYVoc1ZW1YKuvPBrPhbDQbx6azhWwV" +
+	"28S2sX3sCOsEsLGeN05sB9gJQBABoQwgdID6x0xrdW1drQ2duPtlXVtXG497na6tq62hk9AMzLRbd8jVzvCA0/foDrnae9x7" +
+	"dYdcHTzu93SHXB097n26Q65OHvf7ukOuzh73ft0hV4rH/YHukKuLx31Ad8jVVXOb2+raurppZTC307V1pXrc7XVtXd097g66" +
+	"tq4ehk7sKMDOAewuIBi057GN0S1z9TS8y91jdctcvTzup3XLXL097nG6Q64+Wn628bpDrqc87gm6Q66+HvdE3SFXP487Q3fI" +
+	"leZxP6M75Er3uCfpDrn6e9yTdYdcAzzuKbpDroEed6bukGuQxz1Vd8g12OOepjvkGuJxT9cdcg31uGfoDrmGedwzdYdcwz3u" +
+	"Z/VvuUaYkrh7lv4t10jTE6yW5+0jfQA2ztOeZaVka+egpdwdLiVbUzzuSKmbtUvQq9xdU0q2dvfQa0vJ1h4ed28p2drV435K" +
+	"SrZ287j7Sd2sqTwuIJh3AagUNBEw70QlVAp6AWR+F5WAoFXeVRPzXl+YPb4wu4uFed8XZp8vzHs8zNO+MAd4mMGA+QMeZjrI" +
+	"vL9IGMn8EQ8zDjAf4mFWg8wHuWthEdqHPN5sTzyGfmo8PQD0U330AHlS/KSUFA+XkuLHRVM0/OFN0fBHkRQF82ae4hjAfITH" +
+	"fg4wv8Zjj/TGNu8DSq0FZv4AKPXZWbE6hBrb41O05qDG1nwQBaCCOAtAFCoA4iyfTwvVR1BjtlB9hAM+HzXHCqwMgKdVH1bm" +
+	"T30YDDDCBKAp1wSboRkE9EUaRMMfhj+gM601rYPetNt0AEZzW3MHOMwvmlfBZRtjm4VyIJgQhRZ42hhtKmsKN0Waappqm3qb" +
+	"njKpbUZCFi2FCEjx0hqAIrS78D0GsxCAGWVZ0IuMib9gtPsbFLoNFdIqi4gydRr8xEA0Q5TbLTsUB63VjaJLaSD3T24vLwEQ" +
+	"AFQDYAB034IAINTqsXBVg+Chi7otAF0hQIcrAKaza+qbkdjvQkehi9BD6CX0F54RZggLhUXCRqmilCr1k+ZLC6WPpU+l69Jd" +
+	"WScbZJMcKpeVI+WGcje5u9xLHitPkD+Uj8rH5C/lE3Ku/IfuF9093UPL45ZZljmWBZaFlhcsL1letbxmed2SbXnT8p7lA8th" +
+	"yxHLccsPlrPWT6wFDp2jrCPR0caR4vjAcT1UF9o0tFPo8NC5oatC3w49F3oh7MmwjLB14Y7wmPAa4b3Dh4dPCs8MnxY+N/zD" +
+	"8M8i8iNDI8Mjn4hsH9k9smdk78inIqdF7ow8HHky8sfI25F3I5WogVFPRy2OWhe1K2pf1AdRX0d9H3Um6kJ0UHTr6LbRHaLn" +
+	"Rj8XY4t5LCY6plJMtZiJMVNipsXMj1kRsy3mq5hLMT/HXK0QU2FY7LrYvbEfx16ME+OC4uLi2sa1j0uJS43rG7ci7u24A3FH" +
+	"4s7H3ajkrqyrbKzcvPKcyrmVb1TOr8KqlKtSs8qIKhuq7KvysGqZqhWqdq32TLUZ1StWH1R9TA1XjcgaFWvUaO/e7M56Mqt9" +
+	"Vues7lmjs17IWpm1JjssOzK7anbz7LbZXbK7Zw/Jnp29OHtZdk62+/ZjecLd2LvN73+SL+c78+vmN85vmt88Pym/Y/5r+W/l" +
+	"n8n/Kf9avlsJVmKUCUqB4nYXujUeicIGdpO3M4TOQnehl9BHGCtMEeYIi4TFwmtSbamnlC49J22XjkgnpDxZloN87RwtN+Pt" +
+	"nCZPkCfLR+QvPO2cpzuou6F7YHnc8qxljmU+b+cVljWWjbydcyxvWd63HLQcsRy1nLCcsW6wnnCQI8gR7qinWjocBx03Q62h" +
+	"zUJTQieELgzdwNs5OWx02MxwhIeFx4bXCn8q/BlPO78cfjgCkYh0RUZFtors5GnnfpEzI3dHHon8LvJs5J3I+1GIGh41IWpp" +
+	"1IaovVEfRJ2I+i7qx6gL0Yg28nbuFP1c9NIYV0y5mAoxVWPG+9r55ZgvPe1cvkJaRcSujz0YezGO4uQ4U1zluPZxHeNS43rE" +
+	"pcetjnsn7pO483FX4vIqy5UNlZtVnl35y8o3Kj+sgiqmKjWrDK8yrcq2Kn9URdXIql2qpVWbXB3Va3nauXyNGu0Pbj6RlZTV" +
+	"LqtTVtes/lkLs1Zkrc7WZ5fNLp9dKzs5u0N2avbA7JnZz2Uvzd6Q/eA28tQFi0r3P8lHvj0/PL9+ftP8Zvkt85PzU/Jz8k/m" +
+	"n8u/nF+gQIlSxioZitv9h9rO7kvuQ+6D7v3u99zvuLe733Jvdb/pXu9e517tfsm9yr3SvdC9wD3fPc+d7u7n7u5OcTd3N3M/" +
+	"7q7nruOu6a7hruyu5I51R7sj3GXcDneI2+a2uk3KHaWaUlmJ+/3S75t+3/j7q7+v/33d76t+X/n7it9f/H3570t/X/L74t8X" +
+	"/f787wt/X/D7vHuH7+27s/vOrjs777x7Z8edd+68fWfbnaw7r9/ZfOflOy/dWXwn7U7fXx/++u2vX9wYemPIjUE3Bt4YcKP7" +
+	"jW43ut5of6P+jYTryvWH1x9cv3/97vU713+9nnf99vVb129ev3H9+vWr13+5/vP1y9cvXT93/cz1H6+fuv7d9W+vH7p+4Pr7" +
+	"1/dcX3N99fWXrq+8vuL6suvPX597ffb1Z6/PuN7geuL1+OtVrkdcd13749qVaz9fu3Qt59roq6eufn/1xNUdV1dcffHqsqtL" +
+	"ry65uvjq81cXXl1wde7VOVenXx10Ne1qn6upV7tejfrlp19W/fLsL+N/GfPL4F+6/lLml6ArBVcOXHnvyq4r83+e+nPm5fzL" +
+	"v16+ernZ5YaX61+udfjVUV+NfH7k/JFzR84eOXlk35E9RqaOuDri8oiLI86NOD3i1IjvRnw+YseIt0ZsHbF+ROcKnWNGRI6J" +
+	"HF3uhusd1+uuDa5XXetdr7jWuV52rXG96BrtGuUa5uoe1j3sydD80IehDxxuEP7v7/8f/kwAYPbMyegxLsGB6Z5xfgPAv/nV" +
+	"H2A3AXYLYFsB9pvnehtgv3uu7VoUoSPA3gGEzoDQBRAIELoDQg/P3KqX55IBoQ8g9AcEHSCMBYRnAMECCFMA9buiQhggzAGE" +
+	"hYBQDhAWea4YQFgMCBsBQdXDXgOkioBQH5BqA1IqIL4PSD0BqR8gXgCkdECaD4hXAek5QFoISMGAtB2QPgYkGyAdAaRPtbSk" +
+	"E4B0HZDi+Pf/Id0FpBaALAOyDpCeBOQgQDYAUmtANmmX1BaQQ7VLagfIZbVLag/IkdoldVB3DgByQ0DqCMjNALkbIA0D5O7a" +
+	"Jc0A5F7aJc0E5DRAHgtIzwLyBO2SlgPyZED+EJBeBOQjgHwUkE4D8heAfAyQzgLyl9ol/QTIJ7RLOg/IudolXQDkPED+A5Au" +
+	"ArqDgO4XQLoJ6G4AunuA7AB0DwDdQ+0ZLI9rl1wesDwLWGYBcgxgmaNdckXAMh+wLADkWMCyULvkSoDlBe2SKwOWFYDlJUCu" +
+	"AljWAJZXAbkGYNkIWF4D5ETA8rp2yfUBS7Z2yQ0ASw5geVOrR8tbgOU9QG4MWN4HLB8AcmvAchCwHAbkdoDliHbJnQHLUcBy" +
+	"HJBTAMsJwPIDIPcALGcAi1pn/QHrBsD6CSAPBKwnAGsBIC8EHAQ4dIC8B3AEAQ61jQ8AjnDAkQjIXwOOeoCjDSBfBxztAEcK" +
+	"ICuAoyvg+ADQSYDjIOC4DujGAI6bQKgO0K3l+jRCmwK63UBoMyC0E6D7GQhNAUKHA7r7QOgEIHQuoA8GQhcCoasAfTQQugEI" +
+	"fRvQ1wBCz2mXvikQekG79I8DYclA2JOAvhkQNhoIywD0LYCwmUDYOkDfFggHEO4A9EOB8DAgPAbQTwPCY4HwGoD+eSC8FhDe" +
+	"G9CvAMKfAsKHA/qDQPgzQPgkQP8lEJ6pXfoTQPg07dJ/DYTP1S79N0D4y0D4h4D+JBB+GAj/DNA/ACIAROQDegWIBBAZCgQ9" +
+	"BCJdQGQ4YLACkVFA5BOAIQyIbAVEtgcMjYHITkBkd8DQAojsqV2GNkBkb+0ytAUin9IuQzsgsh8QOQ0wtAciZwKROwHDSCBy" +
+	"NxB5GDBsBCKPAJEnAcN2IPI7IPJHwHAAiDwLRN4GDIeByDtA5F3A8D0QeR+IVADDaU2sRg0EDJeAqOFA1NOAsRUQNQGIWgwY" +
+	"OwFRS4GodYBxBBC1AYjaBRgnAVF7gah9gHExEPWBdhmXAVEngKivAeNyIOo7IOp7wLgCiPoRiDoDGFcBURe0y7ha28YQHQQY" +
+	"1wDRRiC6NWB8DYhuq13Gi0B0B+0yXgKiOwHRcwHjZSD6Oe0ylQGilwIxNsBUFohxATGPAeZXgJhyQEw0YH4ViKkAxFQCzK8D" +
+	"MVWBmGqAOQeIGQ/EqPPxrUDMFO0yvw3ETNMu83YgZr52md8BYlZol3kHEPMyELMNML8LxHwJxHwFmD8FYi5pl/kzIOZn7TJ/" +
+	"DsRc1S7zF0CF8kCFGMB8DKiQBlQYBpi/BCoCiF0HmE8BseuB2L1A8EQg9iAQ+zEQvBSIvahdwSuAOALiRCB4JRAnA3FBQPAa" +
+	"IM4ExMUBwa8AcZWBuLZA8HtAXHvtCj4DxHUE4lKA4LNAXKp2BV8A4noAcX2B4ItAXDoQtwIIvgbErQbi3gas4UDcO0DcAcCa" +
+	"CMR9AsQdAawtgbjz2mV9Aoi7AsTdAKzJQFweUMkNWNsBlWWgsg6w9QEqG4DKRsDWF6jcDKjcHLClAZVnA5XnALb+QOUvgcq5" +
+	"gG0gUPmGdtlGApUfApXzAdsooAqAKgywjQGqmIAq5QDbOKBKTe2yzQaqDAeqjABsc4Aq04AqGwDbPKDKNqDKPsCWA1T5A6jy" +
+	"ELDtAaoCqFoGsL0HVI0EqlYAbN8CVbsAVbsCttNAtTSg2jOA7QxQbTJQbQZguwtUB1C9ImDLB6rXAqoPAkKigepjtCukO1DD" +
+	"pV0hPYAakdoV0hOoUR6oUREI6QXUqKFdIX2B9geB9m4gpB+w+QSwWXW/CmQlAVlPAiFfAVntgKz2QMhJIKsTkNUZCMkFsroC" +
+	"Wd2BkO+BrP5A1mgg5EcgayGQ9QIQchXIWgFkrQRCbgJZq4GsNUDILSBbD2SHASF5QHZZIFstpxvILg9kVwXsIpBdC8huDtgt" +
+	"QHYykN0WsEcB2R2A7C6APRbITgWyuwP2akD2QCB7CGCvAWTPBLJnA/Y6QPZzQPZiwF4XyF4KZC8D7I2B7A1Adg5gbwpkPwCy" +
+	"3YC9NXAbwO3HAHtnIA9AngDYRwB3AdyNBexjgLuVgLtq2VYB9z/RLvt2IB9AvgzY3wHy7UC+E7DvBfLDgfy6gP0DIF9dJ2gM" +
+	"2L8H8ptql/0MkN8MyFfTPAvktwTykwD7T0B+MpDfEbBfAPJTgPzXAPsNID8HyH8LcDQB8k8C+WcAR3Mg/xyQ/xPgaAvkXwby" +
+	"rwGO9kB+AZDvBhxdAAWAEgw4ugNKFKDEAI4hgDIWUCYAjhGAkgEoBYBDpbm1y/E74P4DcBcCjgcgASAhAnAUgoQo9Qs4QGg5" +
+	"0EmATqYCoVGg3Pqg3AZA6DOg3EagXHWsngzKbQbKTQJCp4Fyk/mF0Fmg3Dag3E5A6GxQbgoodwQf/yl3Ayj3JBC6GfT9KdD3" +
+	"N4DQU6BbAN0SgNCboFsS6FYkEJoHuhUNulUeCDOCblXgF8LMoFtx/EKYBXSrMuhWHSAsGHQrAXSrHhD2GOhWA34hLBx0qxG/" +
+	"EBYBupUOutUfCIsE3RrIL4Sp+QwB3RoOhJUH3RoJujUOCIsF3ZoAuvUMEFYddGsy6NZUIKw26NZ0fiEsEZRXC5T3IhBWF5S3" +
+	"AZS3DQhbC8p7B5R3AAh7A5T3ESjvKyBsDyjva1DeT0DYEdBvNtBvLiAsF3S3OuhuDSDsLOhubX4h7CfQ3UR+Iey89nLz+zFA" +
+	"2AXQ/Yqg+1UBpwS6Xx10vxngNIHut+QXnFGQmQEACCVmywTmcbF/tgQXcIpChg56BHlsw2bPfnIbQmCHA6EIgxMuPIYyKIty" +
+	"CIdqqopCNP+SrPdETSXPmZpqnlM1tVD7kSdrmuJx/rb5FmiJJDyBZDzJT9uoJ9jUlVB1x20nfvKmC7qiG1LRHT18Z3CeQl/0" +
+	"QxrSAczDfDyH5/Eif+f3a9iEzcjC68jGG3gTOfz8zdvYhu14B+9iF3byM2V7sB/vq2fJjALGYSAGYZhRwiRsxFiMMO5DBoab" +
+	"bmAB1prewHjTbVMehuAZ0wumdaa1QjfTbozEVEH9bsQ+PIsBGG16nbqaTpqWqaeDjEHoj1mYi5fIQaGG+4bfDW4jDPmGArxn" +
+	"2YSPqKExzjjHWM4Ybt9hf9coYrKh0EhGO+ZgEWbjBSzEEizFMizmp4rAd+u/gpfxG1vA1uNptpa9zNZhCnuVvcKeAygRe/lp" +
+	"to+Qg3WUhb0YzC3+X2AD24G5mIi9+Ji+oOdYdXyBLOThJHIwH18IOSKoDT8LB/wgMdylrtiJddSAHNRAJ4sQO4g7xRRxr/iL" +
+	"eBz1xPHicTFNHE/xwiYpVcqSUqUGwicsBJ8hEnvpJ4zHPuGaEC/sF5NEC34Sjgs5+FnIEUUAX2AJNiMTe+GgMZjBMlkKluBT" +
+	"6Tj/BscYfIHjtJ5OIg/7aDZysVoQWSusp1x8hC9wH7OFrkydl8ezwTiJT7EZx7EEazFehJRLBiisKjarpQfQn2O4UF3K5f/y" +
+	"MAOZ6IrN8l7ZoYuhk7zGsuhjuim/iA04KfQRnhZ+pLlijLhFbIUlWg0IaVgi5dJaNY48mCaLafxfppo6e0ZMoxxcE9N0/ZGJ" +
+	"T9QnArCTpUjHMRj7MRjPyFYcp0Y0V3gOS6D6huO4ro1YE+vUFHTTMAbAGCERwzEGmfxL39WFVVjCMrXnletJ97EE68QLbDCW" +
+	"0AvsPo4LSaiMweItjAccUPljj06WRIERqkVZt7OKrQdub9a5R9TRntHVqxX7GWXVRW1Hp+3myVF73e5OPcSyUs/tUrntQkX9" +
+	"drFizIVHeV6oXq1tpx5R2wufSPKk+kRaUvVqbbv02M4qqr+SekZHV6/2RBL3UzPdLlXcLlVsnbY9asDQqIXWhTENF1oHNawO" +
+	"AAx6MH4miqG3skq4L22GAB1gt0XbKkbbovsIawq+ZMcKE5RVOsuDO+Nk/q56CgPouJQLAUFAxfjQGFu8TYgRKGzPnj0hy+2K" +
+	"IuUWPq28TIPA8I6Qw17lYXUA2WJs0bYYG7VZQ/9YLeUW5rKq6gWGxYA0VToAAZEARQvRRoq2xwvRodH8irHzKzGaX8I25WFb" +
+	"0jV5iuR+S5+ix5TDbShSOfDU0j7KH08t66tcpibtlHP0RB9hrrJDmK+k06tK+lplxxqlP61XrzXUYS29CghYo5wUCmQHovmu" +
+	"GlCMUIPiEiPIaatBiQl16yXGh0aQM7QGxdkiKNQh6wSbhUIdYU5bU0pMiGVjelG/D/r12T/w012H9/bq8krbtq90OfbR8YO9" +
+	"ho0a9MW4CWOUk1SdVa++s1lzoqMVtq56bb/lxlUxsuzbVWqISreYneve+DhYAK01D++RmpardLSN7tVjKCQMcl+Sx0l5MMKJ" +
+	"GMQhHqDycqgjLL5O3cSE2Lg6YaEOOaZ8bGJC3UB6xXiKsQf4SY079OzZoX3Pnu0Xb3njhaXZWwpute/Zo0PHnr3YT0sL9iwt" +
+	"t+SNN5Ysycpmy1bMmbVy5azZK2ecef/9H398f/+PLH3lrDkrVsx5dtWMP36TzT++/8HpH/fvOwOGCe5L0iApD0GoBRCvjvg6" +
+	"desFUVOqFy8znT0mzkIx5aHWH6/F+DphahXG8ILSwZR2Hy7ttqq5sok+S2wsbTH27ZJ/6dmnvsw4pdycPKlq9aOvt13ZrsPi" +
+	"pn0zEoWYzht7LD/0eDO2pPBBzy/GzVGU6cqlF3t2J/v3My8MeHxa402fVKiwu2btMT3ihwAgld+oLec3zm3UZo3GZgBhvXKH" +
+	"NZZDYAZIZqGOEGdMLEtMCKnHGk+emDFp6YJ58xbIIVeUpr/8ojT6+QYdOf8THb4JAIQXlTusthbXHh8WEupgupi6IYkJbMXS" +
+	"+fPmzV/6TEaGHHJTafzTeaXhjZ/pk19+oUMAoS5rI6hfQLUBZCZdRVuMTYqrTvUkW7ytojBA+YBaJirfDFa+TqCWygcJVHMw" +
+	"1RB/OvRx/y+U+TT5i/4fHxrwBU1W5n8BgmqfTJZEGNVniw5Ve1J0YrRN6ET3FONLioHuS2LhyZzCk6x6DuM9nLDYHUKroEAC" +
+	"6sWHCjH2UwdWTGijbFUOUjOA0It+Ys3YbKj6e3RodC/6WSnLZm8GQPgEoNlQVL968aExnxw4oCgAAe4WbJSnjuMphr5do1Rb" +
+	"I+X+MQoMk92XxLIevgWVZzZrSHydEJuVxXGM4RTWZtHixYteeOGFFy7eunXx4q1bUifluHJMOa4cVw84UwLFb1DGK/OU+cp4" +
+	"eoF/Q0Ndsf8cEG5IuTAA9aJtUmLFeLUWfqbKyn5qsZsqFzzMEce32tvqYW4OAMJ8QDgr5aEcUDE6NDqxLurxThtTXtYlNqV4" +
+	"3k90MqP+bErBm0NocI3uT6ya3O3AyNEfdvz6QdMU5685OTnP0LKGo15q/cyqFi2P1a5z9VCf18eGKzcAEBa5L4lMykMlgFTh" +
+	"IMaUj1Vlh9YVq1Ki5iiSHbs560XldkG3d9Pa7ez/5rtZK9e9Nm/Ji8+1zRkyZFenr+7MFCpGHl569k7Fih/XrrNqyZyVWc+M" +
+	"HZ9ZIXZnVNTXO6a+CQAMvQCxhpQLBjMQRNFCvE0Vwio3sPeURPqi1s8ffrircKNUseCScLwgfouygdI+9vLDJeGulItwrb1t" +
+	"arkQ6kCRoqsl/pR9XnimUsdKF0in3Lnf7e2+nd9KW7d797rWy9pKuTnK8uBg5db1X5V7UVFf1K61e9263RViAYaZ7ktiNG//" +
+	"CkUllp0LACaoGardh3MCouuEsbQpS5ZMyVyy5FbSoqSdB8yJG9K+uHHv2LX7VAPupEVCo32bNr7//sZN+9jkvRVilTvK7e5P" +
+	"KbdvXFGuc9boT69HgPASIDyQciHz56IYin5JSFNcH9DnhZlSburDZyXVrCBgmvuS6OTli0HNoiWs6JVVKldEkE+G2r0BYsrL" +
+	"rM2sFStmzVq5Ujk6cdbPRz+7PGvC7Bdvnzt3e0XzWRkT58yZmDGLfbJ2/vy1L8+bvzY1asfMd0+ceHfmjqjyR5b8cPXqD0uO" +
+	"UPqEWbMmTJg1S5OjovrFPpdaV/V47YfYVcmpCiXea9RmIW/eoY6wz5/gVZSwIf3zG/e+uHZfOel+YhFFZC5ZkjllyZLIvRVi" +
+	"yUwhqX0o+MYVCuNd6FWldwR7yVuHABhOAbRKjNHG5Hp8/A6NOXXggNq/xRiFh+Fyg4cJUiUeD8OlxwFVfogxSuEPPhnyMfpK" +
+	"yWIWZCCIhVIiSU8U9BM25k8XZwl5yjLlxd30dRZ9DYDhY0qTkoVNHj0jOpT/+1icpQbOny5s2pKXg2Jp2hNJTZQHEl4V8nYr" +
+	"NbOUmrtpFMCgrgulSHl81yzXRewxiQl1H6eSHT2YLKSzUCil7dnzztb4zp3ja2SkP7mxR7dtfbI+a965Y9UYnSQrCi1bO2hW" +
+	"as/EvrV7jk5uub9B/UOvtnsuNbVm4mOhjROgfb1OWSe/K22CEe0ACuAOspDGMuqAlxivqQhxsRXUcmgDDu9h9ZyyGFO+Qpw2" +
+	"+NStEF9HdEZQqBU6tm3mmDEzZo0eNZOu133+qZc/OrT2qUWJz85e2aBBX+W39WOOpb6wYWj/vn+8MOF8385PK78/l6WcGj9+" +
+	"0pSnJ1DtnAP05JiWTyoXC9hjS17bvHjR65uVVu1b/3H06MO27WYXRoX99M6I/Z1mP9+82WBl10evKteHDx3VvfOY9CGzp02j" +
+	"1h/spjbTZszftqH/lUzlD+WEDAYLIJ7jcsYAkBCtCnshWohhGb/TbWXjVwwfZBZumnJQshQ+Jmx7WJVmKM8CAnYC4mgpF3rY" +
+	"EMXHKq2CfI6K0YGdLJpGUMyLL7zwonKWzHNnz56rNKETJy6MHzt/xb2LSgT7rPDs/OcXzWWDlaZjxj09NuvgO89tckR9sfro" +
+	"aRCGuC+J6rcdHwNIkzB8xKlbL9RCMVHQRIz4Wp8TE6/cu3dl4ok+/b7LUL5UNlFfqjPpO6l/br++yqfKKeUH5dO+/U62akWv" +
+	"0hAaSq8+CaY+hxTpeQ6QLV5LrmI0v1cmGvEjhZGgFCrXlTE0kebTKGWZkqk8L9XMf4ZcVIOqkTNLeUmZqUxXVoF4vTilXBg9" +
+	"stdz7RI6Fw5iMwtnsq0F61XZmpxTeClHCy/sk3J5D/SGj9kliIU7WGjhjd1q0FY5hfUATz0oUp4q1ytyrSDg8Z2BFSO2Vr6n" +
+	"s8OUpconyis0gBrN/sfAQZem3X/w4H76S7m0LKtwRpdutIZG0Wha0yr5+35pypfK18o3ypcV4XmG3Z5niLdF2+I1lX7XbrZi" +
+	"9+7Ckbul3MJ1bODDquzTwgae8DRC0xXs8Wq43VLuQ1UUg9wblME0wpeWVfaMmLt2v3fsifqTB0i5D19U7tzNWXXIk+95bey3" +
+	"x9soPohiiGfb+3rhIPb57cJMNevn2DOFrQousa8Ka3vq7w8pF5JW1tDoXbvZ4oL9Ui5XELi/NFvKhUmt3yDiMxOeKgVTd+pB" +
+	"we8or+9WXt8u5RbohQcPq0qRBRDx8IK3HrZ52iae1GpQI95jXbffK9y6XcrNjxQvPKwqXsiPBACmto+UVKpepLUSR/bdkWvX" +
+	"jhy5evUIlaUUZZvys/Kz8hZ1kdope5WflSvKXmrF30jSarPSW1mvynbaTP3VMRAav4qPcX61c34t0tmqki2GRlye8fzzM5TB" +
+	"tPbiL79cvLxbqln41fL585ZnXfrx7MXCLWo5lQeecoYXLWdZ4lq9v7TMV9oGt3/8NCIyWCur+h4cStlfssgPv1LO3maMXqd0" +
+	"tcD8AQqUxZ5yH5NytfdgUAQ57eosrCqfLfKy+55FqNNodP33Dm9tMW3kkd209peLEwuPXp4zb94ctj9s6XRlKM1Y1b/wOSn3" +
+	"u1Mv7GMdC2/Nnz17LgAQ10vVL4PHeeWEOpxGlqK21SSvGiCcHrK328qNI1aNOvaRkl+Y9v34Md8OeTlnyvOjj+3KP9PvsLT5" +
+	"k3p1Z2YMGBT5WNUfdv9wvlbNE08kL5g+emqkq/rBN49cjgXI/RAQR0q50AGCR2WXxLFKzG4lRsrNeZgraX0BG9yXxNelXNg4" +
+	"r2pjii2eawCwbdjzyttvr9ujbrFTCqTcgol5J0/mCc8X9FHOKN9RFaqgpeGV1zIQrfaQeGLBhe4DlENvfqBAys13itc8fQ9N" +
+	"APmSh/fjg7TQMUEU02Svetj8GJWlMnuVt1X+u3BZys0PEW+p18OqkvVhHp/XBMoBHl+NTRM+pg7U6WOlEx3/WJmnzD5M5z3T" +
+	"/PiCByyzcK4QrvX9AkAcznkVAs/cFmMTD9MEGndYqaB25bVscMGdwgbsUwAgpADiOp8sJFWdp+ihYmTB28Lg/D1C54LDUu7a" +
+	"/DE5a8Vl8M71yskOj5ahai/R62n6qVPKLNmx5I+CJcXmMXaKoTV8IqPOY+QXARDe5XM+h2a5iE6Mt8UkstrKrO+/lx0Pzi2R" +
+	"xSWqniJskZK1OvdUgdCHru1SJigTdtE1KbfwJD2vTFRnY+S+pYQLW5WtEFQejE4Utha0ULYuWQKAsEbMY63lwdzPHkPURlmo" +
+	"/CgPVhZSBgCG0e5LwsdiJgyoGKC1NqF6tphEr9bRqKjOwyZ99vKEgQuzsrLqv5H58u5LF6+uWpC6uV3vNzv/eIrFD87sP/6H" +
+	"nZXbFT6bMzj9o00fHAyZ8XyNGjlxcQUAGLLdl8QasgNG3ie9+md8nbrEFXg+lYuNUytWeHbV7DmrVs2ZvarweMKrY/b+/PPe" +
+	"Ma8mZGezml/88ssXX/zyC0sZmK7sVx4oD5T96QO3yA6AVPuGMEfM9I/bnuT5uF1eFTJqD5yd/EKrTTt2bGr1QvITK7tcVu5S" +
+	"8I32y8XErVWrXjp+/FLVqjkVKlBTslAINYwBwHi6N2QHrLzctnhP9WhyLCxQWROeyMpKeHXsnp9/3jP2VQWrZs956aU5s1cJ" +
+	"u1nfP25uGZhOSaQnPSWlK6GeB/GkL5rETDhQlrdiBBUrdIiOP41ONBXKxvc2p73bo+f7aXeUX8h46Yt/ZLEVU57PNrG+vQ58" +
+	"mpCwrUo1qk8GslNL5ezhl3ZuWw8AhCoA2yo7uBQPjSkvh5MmOWIS41XJxLau7/UE2ZTbWa++ui5HdqzpNHTAkoKawtdLOrz/" +
+	"Ji+jkircEDNh5DPTgLZzWplf1jWhgIpxJsYLT6ycN3fFirnzVmb9fLP7y61bL27z2qb4DWP3Xby4b+yG+CzW5OiZM0c/PXPm" +
+	"hnJRuRYe8W61Kh982HtAf2pIAonUsP+AHBCyAeGup+y2GJuWuU21EHKeFO5mjR0z7+Xs7EYbh7/1Lttc2Ietf3X9gc2F82VH" +
+	"4fpBA28DDG8B1FV2eOYIqoYRHxqz7fXXX39dTMvfIDuugbnPKak8jBHBmsT0ag9a2OWvPV6t7xM8wqLvDq5LXyNXugZo5RNj" +
+	"ZUcxPSI7m259W3iNVT+lIFt2FAyjS4V3C7eymMKzoIDy8NJse/112fGHxy6k7kEaImaiPEDaTCPwqf2PH6o9/oHHt05c/2b2" +
+	"4OEzV2UNGTFjZXZ2g/WjRq8Vnpuace+iWhkb16mVwdZvevnD1wrni2nbhvSfCm+9ipkl6zX0n9SrmLaNV6uHdwUx06uDBFoT" +
+	"A2wzCXNfXjtv7tq1c0/9/vupH+7dE3765bPPfrl69NNr65Sjyk3lH8qn1IDs5KD6qpxQUsUa/AuzqpXFVyCPgCgiODpkZ/vk" +
+	"A7m9QmNL4TbZkBMgIeiGr7sV4eVypcy1/Nyr6l/eLswSfP06ISvLJ4cKtwV06oE5f9z31Cu7L2bCBpAmhvyV6mT3y7WIe2FV" +
+	"dnbClmdsVR4TdobYvjhQuENM2zZ4gCQBIAxwXxLU9i9Fr5AfpVfUSn6pc+bUnnPrv7Pi3KHO29P77Og+cXrvtQ3WPvf5e302" +
+	"iY9vq1Spa9dmraMtVdY8t253TMyBxMSendt2qhhcYeWs9VsjABBqAyxbWq/xgyoeHGGNVGERm6iKCRulUDflzZZD3nzzw+WT" +
+	"J0vrlUNLCjc812Htq9+wtCXUFAAY1rkvCdc4TzlUm3+A2uEZU+iV18eOmf9yVlbjV4e/tZM20D6WVZj+6qsHNrPM/A1bBw/I" +
+	"E7Z4dAGTmMbn6poiUe9takSNtivzLohpBV2FrfkbAEJ7QHpJTNN0jrKk/qcYI8W0z/rqy4+/+jJLefDxD6c/FtMKVgnD1St/" +
+	"g7CqYDgAEBIBUSemwQgEsRg+1MYHkXBXeZFmfKz8qJz+mBYoaw6TkYxiWuFPhR/RXqUVa8PClKdJHafrKqm8jMGAZCFNVjxO" +
+	"XO9hnZPnjp2TntSuRoQyVCv40KNT1raa11XsULBCGAmA0BEQJotpRfWPwUJI4XI2uuB7NqZwi5i2peDHF7cIFQEQrig7hLdl" +
+	"ByoAktosTchrEWxC6kityXU+Bw5X2VrYVv/86vSZ9eo9m7b6fP0mM9qnDBjYuf2MA0tXnL390oQl41fmnX1xSY8XHryy+LGy" +
+	"i9c9eKEHCNeVcJoth/ts3bPXyOEPoC+ev9OTab0a5CtFmJqpvxRV6crj09t3Hjiwc/vpjzc4/1L6jPr1Z6S/dL7BgdQlD9Yt" +
+	"LvvY4lceLO6+5MWzeSvHL5nw0u2zK7iNhk5KyUJtPlPgI2BcrPrPp4E4w9R/8XXqSk/03dhjwGyDJJsXde20tme/jd0HzNVL" +
+	"OvPz3dqvFmrv6JjUSGSC1LR9lx0dn2jMne3AKEx5no6LaZoNilS2jrHZ4+10fM+ePfblIUJ/5WFVtoQGKS8DAMNa5VNhn7Qa" +
+	"MqJVWcQXJtROGSc0JXWI1tlibLGqyKhni7dHEOs/Z8UK+rHllCb0eGbzhlOf6PPDrVs/9KEWmbOFHiEX9753Udi4tXIVoWCD" +
+	"VCUuJyJGp1ymKAfFExGjOmEUqVwQowHCBEC4I+WhrE8GaCO2yiTxfNS28n5//okp9eYOozRlA90vmPXtu+9+9HW9BdLO3ZUr" +
+	"v/zt90uUmjk59PWSWyT95pkzdkWK2FLc4bFU1nNq9hKdU8eXV2LjdHHa8kq9uHpOLrDsAeJWKL9vyL7yUeUr7B+yL3FovYSR" +
+	"9d4ftC+6fPmY94fuqzO0Yd1hXRYNHrT4hcGDXhAufD3+y0bPNH18fIOvnv6qUmzFil+N/7JpRsNmE+p/Of6ruIoVlKlj164d" +
+	"O27dKyC0BsQ2Ui5cAHkWIBLjohPjtRlEfGi0LjRadCkv7FI113bKd/9QLr1J3ajLVuXiP5Tv29FE+lRpQJ9mxr+cydoUJrH9" +
+	"hbszX47Xnve6solmS6v9a4V2javXrKHVhQZ2X4SKWtgUdxPhCT5Pbepdg3I2JZ+dWQ51OMOcWn2VkMUeUexbm6JuDepNu1C/" +
+	"/rN7286M757+nst0IKNL1/oJe9c1f75jh7kJfQZ/GvbR+C4piYk7VzVfKGyp0atGu7nzq/eu0XYBe3FzXFynJ3uND9Y/s3ZQ" +
+	"r0WJCaOaLd4SHr45Lq5ru8GZlklrBvdeUrva2CdmvRoOgOEjKZn9IFs9zxgfGqPaLFn0nj3Kxt27Zevnn38OAAIUdc+zdBxO" +
+	"1ERrgOqEObVVJ63pNdNhU3KWl3Xaw2uKqGZxrEHOkjq1xy4prF3wj/7909P79//Hgue8rucyjnXtlprareuxjIzjXbt27dal" +
+	"6/GXGk9sNO+ltYsajmrcJPPxQxcvHmoxRTo+etQo5eHa1Ur+qFGjR48aRdLqtSSPGjV6SN++fX+e9eylvn379ev71MXZcy89" +
+	"1bfvvWejo95+5dVtkeVnhJe7e+LrO+X4sw2hXHaN22wjUFmzbdGjpzE6/2RnGhtRuJINmxc3q8uEBW/mvDZsWP0pTVvMbrp5" +
+	"44NlT/do89RE1imT7VWajX08af7T8xeKvXuXLz+jXLnteS07Nmu/dFw4X2d+QXhfjIEMs9pbg1TTsl27Ce8rv1Kw0itSu4kx" +
+	"Hyp3lbsf0qYU2uRzanIvUkoW+nK7Eu8DH4uzXioIFfoWfkQr/3RNTSzSrvXRjuthpbZg3CNa3B5jC1wC1kVQJMXEecwlwtpS" +
+	"GjKjlAYfO3r0gI6deq9/pV9qaJPKmWsnJjav3WiRNLi0VhzyqDZX+is3m7Zt9/iQZyanjrXp27f+6eu0eGWdkATCh1J/dlq6" +
+	"762Dg6xM4RXp/goADG8LE9kN3nfLlFjVdgYsvNDxVgMGtGo1YOCTDUbEVqmcNXnyptelMWM6dRw1qmPHsc/WrlF9XIs5e3bO" +
+	"n7cP2tqCb300uOgKqSqNA1ZJFYO6lCCJyrQcZRrNzqHZ+Vk5IHRl69gYvh7Dy5xYtx6KLSKQ/CtNL7wS0YDq9m1fY3TzJybF" +
+	"z16nfB8vWMaNjqxPtfu26BwTk1mu3JyRzyuXawGEHuwFNi9gjVD+C2uEdCql95uvxj/9eM0RjfuPHNC/fbeu3Xt1q9CndYvx" +
+	"dZ5d3VlMCxmVMnVeqGNy2TIdk9u0G/l444ZNQpzjbSEZA55IA1F78RYr9NgS4imGtXlX+XGhPFhZAMI6JVUcKqYhqpjGFygo" +
+	"PMOL7ZXXp06ZOy8rsry+XJmKLSKbtrFUn9Wz97OxSqpsKGy1bMWWLeyFgk2NGxhNK5xhHTp17NihQ+H3fI2xkaDuDzF7xgj1" +
+	"H5e1rCG9OOPNmR99e13K/WOwHPnHBaGD7ezeA1ehrTezVjRbSNbmWXy9WUhWQCQpE1kmADsQXbIO45irTq2Rwyt3iItoWSWx" +
+	"bmzlpsNq9extMs22BdeqEdG9iZZ2JlvHZkg/eeZ9usR66ppp9KK9n301da9iEQxsQOUJcYVVRwMCUilXsHD5FIyyHvkUsKfC" +
+	"GcCxXCJR3Y3vvbdx4/79G/s9Pa5v36fHsk6ZYtmzH37445kDH54dv3HB3A0b5j63Adrc9gXhR6/8satiJ4gqardtlKX0omDl" +
+	"V5rndYkxSt8Upe+HZCLTh36n241M9yX5oJRnjUUmYI0zdhKdhQOtOmMcuwR4/Of5/TGhmH8PX/yJJeODYaYyWujA+aQCH6e0" +
+	"9bPi44m6zqZKLHtMYjwderN2SqfEGhn9n9yU2m3rU1mfNe/UqWp5nU7avmsX+2rdoFnde5S6qlaXLAovE7df6kaExKp5WnXO" +
+	"imJLmlgKPaYIfZRGt+mcsSrd815jza+vz69CCb8OPr/yXj8I3jRhhAXRRa0nRoqmwCViz2KYu1ODhh06NmjQ6bl7dEOZVa/+" +
+	"kqFDljWoSz9pi2Nyr8d7dm/+eI8eym+FjwkdKo9vPX7DxnHJGZUfPq+tlzHoAHmxlMvf7azalGy+xSEhhpwUbZOnFg72LBC9" +
+	"olRc+h6Ln6Z8T6/41orUhKTc9QV5glV9viHKRHVNLET9JgKsOkyhYP7cfP2J12MlT/1Wooml0GOK0EdpdF6/lXx1qPl18PmV" +
+	"D/BLBGgxT6+alg87x9NbBtC1AHqMRncrAKvA86mm5cPO+dKSAFZO18HnV97rB6bOCqRK3Iat7kT3LsupCredV59nwe4AZdA8" +
+	"GqUsVzKVhbt27aEayjenvSt3Uq53gY7tX0qXlPClhde9a3fe59zG+ai2h4+m+8rG13T489Tx1OdrNLEUekwR+iiNzuvztWJp" +
+	"9fX5VSjh18HnV97rB0IBHpfM4ifavFgXRHFBVC+IJJMyiF4eoQxQBgyntcrg4fQKvSK2UxKVepPpCyVxMn1GX0xWt4oAAnq7" +
+	"90kdZSeCYEdV1Y7NdyLYuBqi2lgcYU5dLEvU1hLrOXVhIaFWRPMJiNikMLP+sm5HrlYYWW9Z6pFrMTGTkp9UF4EmftpheZ81" +
+	"ya3URazxn6Ys7yP2KNjRrKW6GDR2795mLdXVobH7k9YPJv0GdTmofdsPex4Zotzni1pt237Yw+3W1kR4Pdb31O9YmlgKPaYI" +
+	"fZRG5/U71leHml8Hn195v5+2FsHTa+xJj2hiwHqaCaF81Ij3LaTZvQtSu3Zf1lbSdl+e8fzCmVKu8uDCmTMXlW1iXOHXy+fN" +
+	"W8ZtTO5LYqLsUGdxdm1vgIX+3EpUz2uKyv7+k/c/ERa8lNAwYWXq5wcaTmnRanqjoSMTe3dJ7bBqgS5j8MQpb4hPH/3x6nn9" +
+	"+LSanatUHL544NY9j7k2R4T36/1416b1Gs3vPWNr+JCxz8/Of1GtB76OIA8OiUUSYJPRsg1QGj2jdDpbUIRez0dfX4Ru9aUz" +
+	"PJCuk33h17XR5O1o9yX5HTETMuL5TE4znmu7hMIp0LweG/eICYLgnyDIBlqRuTDb1KTFh898catQNu7d3Hdnr17vp6366YkW" +
+	"H8/M+SBgEWTmwe+19RG2QjW9U61efZWzD3t9eDQxcVuVapOfmTTp/S3+VZE0xeVZMdGeZ5AyUV2/CIlFsiZj8axGV9cHeH21" +
+	"AqwyWk5DqfSM0uhq/RahW710DNfo7mMATZUHW+PQ0p0AhMj4jYdXB5LanJ4RSHdfAFgUT/83le/ZAtXXw/f31JcWyVavH4Z7" +
+	"/by2YtkBCyp5W8W/lUudl0CVDAnc+honxdWLi3dWdErsTvWOFVdlvzJ79rpa+qeHuPHVCeXBp9aasUuUCxGFrx0fR9UilPvC" +
+	"izbrqY/3nzu3f8p+il2inH/LrXxG9eB+SPUkaeeIAX2OLRuTrn0nQVtPUPmss1rPbD2e89WPwJ8rRavnoSiVnlEaXa3nIvR6" +
+	"Pvr6InSrl47hQzV+/RirpGRxjOdrDHwGr644qDMMturs2bNnlep8M9OY+0rIPdY8R43TzL1JjBX3F5WvdRM98lWtV6euBiVq" +
+	"pmzNzKMt1OjihBpKm4pPNZy7NiQl9qn6C1bbQsbWrnXvh65LGk9LnV2z9u+nUpc1mJYqRBdur1Lp6tGOE9dWrnzjZKvRNV5q" +
+	"/8kzqoU+PmFLx3fbH5mkWu0T4rd04M/G7b68LnpodTcGpdIzSqOrdVeEbvXSMdxD57ZWHr6Plk43jaf4WoHsCFgrsP3ttQLd" +
+	"gcJNpS4W+PK1avmq5ekWqO/VtsbhaeRQI7uMCt4+oMkl6b41Fk+3hF1GkoeuxVlujWNr8R61KRlHjrTGsrVPfh8YR5kohUq5" +
+	"Xj2VvcLWauGViaKZy4okjU7LveO6uFk3wqunOWPELXwc42uj8mCPbJGRkYmA8KOscc5Y8TBNtMu+b5EWj6NjC7AiIE4na5yz" +
+	"ihonhMfxhXdY49hesTqXF8mBeWhlsumccWqZSs1DZs9l+nRBKZKXK04czfOI84aXkmVHSCye5GlFi8t95fXoblYZy33yJkN2" +
+	"aHshiZsV40P5rtZHr98qP1LFrIULS1/FpQ7TXnxx2sOCR6zk+nXYeK/ei6exjJp4Za90n/PLfKhc0cpXbo9ObI1zVhar8Dao" +
+	"FNgGokluxXmm9HhaO1TidVQpII7WDtG8HVpp4ZWJqu7hLRt7hdZ7xx11/dkzjqj0lID0h/j08TixJo0pUq50z1iiY89hrjvf" +
+	"r1uKioenTvLnqeJ/HnXtTR5sjWUL+qpP06ZonJBYNaxV56wsrqaJvvCtQmLVkFaZrZ0WGF579tLz4M8v/MGfv40WRyF1P5s1" +
+	"jn0vfuv+RwiP4wufYI1jHwtTyeQLz/eleZ5jFs+jqm+c+0UdQzQ/9in3a+sb51Tevcz3Hjm5NPexnc2z4u5UV3raqdvbVq9W" +
+	"N7i99NJutkLKPX/+/Ob8t9VdbmLnzZvPnwcIIwGxsqztDAhco1VXAOJDvbutw8TKWWPGzns5e+zYbGqifNxo07BiK7b3N0+Z" +
+	"slnZry3but3uKwBroOtrjXNWYGd5+av5nu17gFWR61nj2Hp19Lbzcd/3LSWwcE3uuVtSoyLx1Pe2WKR73C/B7Q6M576vpqnx" +
+	"eIn81LKU1Xi8RH7rlYl0W8q1xrFX3BN4W1bj9PnKRPqet/Er7j6c3s7bZjRK18k3t6sSMLfja+5ipvbtHnXV3b7t9ddT1PV/" +
+	"MVPrH4OF59W8UJ1FcWnvKyPeUlKFLmp+aE9u7tfOV/4NymAW4+EF7dmq+/zOKamqrmSN07Qku0x/+PuvEqHuP+T8uBqGEBk1" +
+	"NP1EiVD3E3B6Iqd38O8zENP+bJ+BmKaUpZ8L7xRuY9GFP2n1IfyhG2mNc8YIYZQRIvMv/brVumBWD12kDLuMjoHlUvc48vwr" +
+	"wuCLM0WJoNlaudiXMBSNo+2LtMY5o1kf98dF8tmpxnFGq6xjKzUOJtMfeKZInCQ1DibjI4z3xwGpPCYKmh3QHm+r6NRMsrq4" +
+	"egf20GOtlFfZ4oL35yZ/IeUWrv5xjHKr8FIOazR89Hrv952Yhe/vUOPWc6prd7q4eu9mjX1iV9aYFu/IjsKsXsNfKLjE1vYY" +
+	"/oJfxm7T6klsy+uptq/8mh45yhqLjMY6u4zOHrrarts89TeT119tb7uq+y04vRyndw7Io5/PHhAnZtCQInkM1nRVm8yem6HR" +
+	"9wJSHK+7zhTPJeN0F99DqqSKL2nnU8ga7d16ULde3fg6IY+RQ+fd0iJcVG5s/Gj37uS+ac8d/7p169c6VOvzpDCDtrrPFeyQ" +
+	"cn+d17Kl8pFyrGBMbnraul4bNP5TUtXy89N0Nm9S9eomBu4c4Tk5w4S7bVq/9vM3/cqX92wh6dR5+Fs9e7HNha2+S0tbV6v2" +
+	"GrbDv5ekTp1nJvlsEly2kIHLljpFxkFBuqfqVENVyZJSxI6hjZ2v0cSSceRWqk41FCXijLDGOauqcUJ4HL8+b41jr2Iplykp" +
+	"2j5bQNdUyoVV21nms6VppiCnZ/9qvK5G4UCPRa2FMkUZTctpXp8Jyk/KeYqi6Al+y9pUGkujaGqOckE5r/yk/AQIat5yIZ+z" +
+	"aufytDmrFFpyturdn8LV3IoBUyg5la3IXJhlZncLdcY9r/fb2bPX/n5LT7dutn/6D/fu/TD9QOPkr2ce+0d+fc9mHLZi9bvb" +
+	"1id6t42NHjV6lHcTzpiRI8YqZ+d7N+l4ePSYpx9EcTmS6OPPBlofaKQLkbV3Q3v6wDFPH1gMgy/8RCVC3Ruj0tlnnN7N1weO" +
+	"8T6Q6OkDhiJ9QOJ9oJvWB1wane+/LVKmupzO9894+2WIjFQEhtfG8wjOK3V9vKLF4XpJNOwBcZSJ6h7fkFg1rFXGFI/+MUCZ" +
+	"qO7RCYlFqkYPLZKHFp4/h5MmFs1Di2PTsQ+ED3zjxENlorqn1xqLKa1UzqvniVNbmajuyVHpl1V6d294dQ8wr696nnzIV1/q" +
+	"Pp4d8qyQWHTXdGRSMNYjP717h4M9e4c1vUTdB6HtIb59e/ft23wfceHBnMIPWfMc1ixwb1C4ZxWM/3M4tf0ecbFN1K0Uif5d" +
+	"QuoVXV7dKtS69eTJnt1C1xZfey4ubm2njt9cW3xN3THks6FxPUS1ntll1Pe1iTYXrGeNZesz1DbpUcTups29vqNGJeNociLD" +
+	"XSKOJidKz4fLiVLyGeO1CbJ1OIVhvvCf++au68YeDgivyZWxXK7U96V/2yNX1nO50kMLr0xU92f70n8FF7TwykRxhJjpmTOr" +
+	"+ngep/P91boJKv9KfWiqXUZD3zPwfVDyKFXPoApQR6NeQBFbJI8ngsdr7IunzW9HqXzfTY3Vp0icDpr9kts2ycfHfN90kX7X" +
+	"hNP5fqaAfvdUkfCarv4ETSwWXtPTt3G97ymgSBy1HzXh84E4NaYvjtqHnvLLgoD9rVYg2rurlfOmTg6lMXxX6yvre5WP0Xa0" +
+	"rqlUeYimE5bz7EkOVnU9SlB3Gun4Rg91ZCPPaGaz6mS+Eyk0QPEWnm3bdvactm3btp0zu23bwuMJnTqO2Ttu/Phxe8d07JSQ" +
+	"vXBhNlVQzrCaX0wYP37CFxxZysBatTdtUu0MmzbVrjVwiySqE0pAcG8ExKOec0GV/8L5Jpu6IUg9lkaf/NlBp2eGL9r6J4ed" +
+	"pFr9htVO3vWaX9cZLeWGyEJWkf3a/BtmpdVFdGjFElXWoVg9sIeFb7H4wKoSdherBdmRRQUHA+pJe7eJWhYY+BxKzSTaFq2m" +
+	"7zm+ERNtiyFh25PJ6oPHvJj8ZOEHVO3iPfWZd9FjtOf5pJaFZ5NailsK154+d/a0mETL+DOBP1MoQhDh2V0bsO88Rj0nHGgx" +
+	"Ctx7TlJrFu+1Ggm7i+w/F5Po64IyfkNAkTNk9qbkWTXyWuW1LGXd0KdyJ1Nt6qdsVL56Jrdf7xMTf7l795eJJ3pL/Z98UklX" +
+	"VitrlPRWrU7260sNKI4qUYO+/bS0pb26gdyq8ajzac4wZ916nj2BjziqlrrIZLS07aOdi3rkobUKgvhx/yk5q7T99WKK7ED5" +
+	"P9lf7/T2O7nUjfbNHl/2WtOqfZ8Qp98rZbu9KVjdV12aLUftH9Jk3j/KlHZuLqA7POIAndoTSj1EF9AHPOszo/nZGgdA1spk" +
+	"iy4v26Jt0dpep2hbNE2kKIpRzr74AvtDiVBZjqoVfkB7lNssZr7w4sOqKr/RwYBzA5zX7InRRe1OTlug5ekO+8Nvc0ooPOY1" +
+	"Okmjs4qeHBhI7xUc9HIa38ssMnEAHkMcUDEs1BpdPi4xLL5OYoK6bZLnFCI4mFDXw9ZOVXEUHC8o4z8d2efsuVvvD5t5/9T3" +
+	"D2hRDK2dO/flN8Y1qNFzQIuOLyh5yWuSJ/apVXHsfPXb5dRA+VT5xzqlgDZ88fPVjw99apQtVc6AMBEQTmlndriFzXMJpwrW" +
+	"s62FM9nMwoHq4bwcFp5D+3K0Oh7mObdmV9vSHm1l3nUX70GwemFqsesNE8s+uD/94qCBN+dQIxrAz66dUt4/Yvp59+4rRsFE" +
+	"JqqT1u/75FbKEGU5P7i259jHymUq5zmX/qL7khjk2SdT8p0RGpcGcnAFlX/eyJj561eddg0d8mab515cPG/zupXZO9/sv7Nt" +
+	"v3e7FSi3lwsVo9+cuuPrqKidsRUyx499JmvlnCWr6tT+uGLFO2eXHvadQRT2ec5deZfd/ScRvWctvQcS+YHLVz2HEnn855VU" +
+	"ttVj8/Gf1fCfRih+ZmPdm+vW90wOOLiRf+P9N9d0GipW0U5veOSRdFs3ELGlneX0bx7+s0OdnV9suouX+ZFHOx97bP/1nFWH" +
+	"ip7vZNApKeINrnNWBEidMarMKWuDhp3iKcwZFurQhpXEhHp161E8m1w77rFyNeIq1Sj3WKXa9MbRwntPNa6S1Ov553u3rNzk" +
+	"qcK7R5XCKEeLBq0n9+kzuXWDFo4oYYrQN/+ro9ueafF88+7dmz/f4pltR8XavvMUL8gOVUeo6M09McwZZnOgKqm724ufKAlV" +
+	"ty6Jh+e169Ci/qjP+w9UCj99/I0XAg5DJPVd13/Qy+fG9oteEb5kmXLznnLl6JekL3Lq5Kpy50Xt/SE7ATbDdybVu6netmv3" +
+	"gEkNnji+V8otqH5oVc5dsmjfjGZOX1ifdr5r99atu7dulXILwzSt3JOu1E/K4yNkDBcjOs0kZIu3ER+sQ6W+a5rHPB6tOLTj" +
+	"rLtZjXaDp7RvJ7aaXLdTTOEf2rFWfsi1Zcc+vUdrvJLlOc8SwteWPf3EZ2/KevG1rS/1Swo0Ok3nx2EK3QEnXDzfv6bfZEfx" +
+	"Z9n2+oYNr6vnXQrrL1V36y71nv0zcT0M8RQnxVXUFv/UPfn1aF8z5U4EGVvurEllIpSrtH+7ckXZLNbZP2jAgE8WDk0f+vB7" +
+	"oXHBYc+ZR3mslMulobZ9nO9Ec/r2pGlqiv9AQlys0Fo9QNMtdU9M/PCZq3YnxI8Y06btnj3qIZr27XKnZmROuSiWzb+9L7HD" +
+	"gc3Czfzbmzq075f2mnDzYeVtQ/o3berr8+I2T5/3nt3193nPGd69x5rxLu87yDtf6/Zut9e2YpWFdtp+Vt/Z3hCuQ3iP80ZH" +
+	"gcozXXQsozL83Q5DvcdjvUd72RhVOHYw/Vy4VnfxyJHzOnbNczb2VWW9erhX+ES5/PHHVK5wiuJWDlETYm43+rgvSc/KDmss" +
+	"6txT5xzHJBsluN0Y7r4k9hBvWOPYMeEajFYZKaLn7LGsvpPDoVqDotUeZJOjvbpHdJ2wx8h70LeeLVYaMWD0G8pl5Yp2mHdQ" +
+	"dzIce69gl3rUd/34qcLJQ6vynz/pPXVc9q5yp6A6P+t7RflZ2TtEdCXDe25H/FrMRBjfRWVTlUKb7JVXTs7vNu/Wz3q2WLFK" +
+	"+fJ9p6rHjX7o1ebQa21aFw5ULR8TGrZTUsUJa2rXKtj/itfgUTYt7bv8RdJzqsWjIcur69U5xPFc5yhX+tnnAK2j2CHo/w9r" +
+	"fwLX1PH1AeMzc+9NFBUTIAQXkBBIRBCVEBCRTQHZEVQ2EaIiiysKuID7hru41BUVlU3crYK21Vq3tlqttaW1rXaztrVVa+2q" +
+	"5k7+n5l7EwLa/p7/+76/59Gam5vZ58yZc77newRto00gdFs9o070W1LfFPFbtsrgf/VfNmzaf/9mTsS/BDz1ppvwvVc6MhE4" +
+	"if+h8dAK4Nw+HtoMQqE9lLULiG76XgiIJkgUeNISEI03EFiKGBL90UbYeQOAIM90j+3GPSGzY+/v6iij8D46GQpRtvuHkmuE" +
+	"n1bjKj19HW57ceqDqavLGnde+/rBO/ySxe98MGWLv354Mr50nauJ/x6vP9/XqdfchytW38wpKvq86XOvbr18dZ+HhU0BEGSa" +
+	"7jFjhBgpe2s2jlehCUVkR2XEvqw33l+5I3DH6Pmlaa+PHns0+eJXm48HLksvn5u8NYoNdXE5tHvxa+5dPYbHJWfo9efU6qaq" +
+	"ldv72KpiwkaO7N271SdG9FLCCA+QqQtORevpHUmwirZGTLnp/agarlN4aIQ7kg6mRGbsrmrcdfB3KN8f0192CB/qYOsyvohB" +
+	"k9iJL4ILhm8/+CZ75NZtQ9i0nTu8nfqH64S4LJzaxvYi1OJgtr8Gw5citDbvC/UyuLmd32IVp3Xk0/PE7upgDteCQNFqCzJH" +
+	"g1oW9BL0E1Y3jaLrWIgpzxRXr2gLMusnL8WJWS4AbePFdjVW7c6IZC+OtwoaIzfxZNEuhfPa2KXMRXaDgl1q/JzAYR+cpoap" +
+	"F8HCacleNJnATtPf3FtUbg00OlC5lUDl1m7TPfZrQW6xRVRupSMAIIgy3eOCuKsCI5wAOnMFcj+7AJVepaB8KjpfQsGl1au4" +
+	"QfibDQtxHf4VP8INi9fhb1HP6tcfX7zw+EQ16sk5rcW3L+BreBZcBUNOwz6rT5wYi09e/RXfhW5PrsL4sSfoGW36luo+PYju" +
+	"YSeoHgSDL5NKVERD7wYpThotuPA4I2j8vsqFhw/hx5EfpY1pGj97LSmobkpaBBSqunwJSUrKoC3zxCi7sMUS886lizqAm1Qi" +
+	"JRuanNT0+KdzqWFH6+zduuLGJuxOguH7Jm8qS4jfPoQbZuvUjX+bhMMztvwO+GxF1uipS+b4DwdAiG3jhgq2/jaxbdCe2gHE" +
+	"osmqlraPdcP2XkllM+uqY3Q6D7uOVmFv6LyfzvD28hkzBo3s49CtbQy+oCsTZ6kYB6dX0Vh8vI3HO/ECEpAP1+LfYSnMomH5" +
+	"WF7JP27cgdwq4dfWsXRCOYylHDWh98tj7L47ir/D90hcHexyDvo8pbF1+E4lXr+pAWZVwkAAQaXpHZbcMd1a9UQvSJuigL5K" +
+	"KyWVao8o93BG5oiRm3fB0XwMswOvyylOT4xZudI4qWjG7JwZMybFxa1fHhO7Df7cuAM7DuwzpWDVnbI59nYeFwd06yb03fQO" +
+	"O7i1vgCFxEoH9tdBPQGJK8zWFVRQPr04Z870osnGFStjEtKLDXgds4OPgZlVr41MycyY1K3bgIsedvZzyu6sKpjSZyD8eUcj" +
+	"dtwWG7N8fVwcrW8Mjmc+FnD6HdsVrvM1V+8u1I564XWMHd8Al1S9NnJEZia9AmVmkDtQdGJ6Mcm+37AJd6mLjVu6Pi6OXIDs" +
+	"7Z7epRXTuohPfalQF7T0zVXu5+Gu91P3VwmD6qqQcRKFAwqNjxwWkxidmIaHwykQfIKN8CTfwNjhCzAWZ1/DjxUK79ddu9jC" +
+	"bo/1HuPmooGbGnDuffxwgNPY1VacbO4iJxskm9lfmEC6Qs1REFKJVKdQSwVuNtgjH1+Fx1NV6tj4OaNycgSGtl49h2S3eDU2" +
+	"NsKw0sop0fExs+LiVCqRpi0lxlA5QFi3I0zvsGVcC/UIyVyJ/iPc8zz8lY6yrlAilbi662FH9Fb9ZuzFRPAzYOIPG2P03eWe" +
+	"K3ke/worCdBk+SbGuxF7QHizAH+T6unsPufpXat56g6A0lFnVbjO1wUKhavdtNnb3oBT6l7DF9H7/J13c66/pu9m13ul8cC5" +
+	"+vHmkjXjPxHLjaV7xHSPieZ2k3GC0vbLmZwkAXTFE+A9UQoU8Ge4PXfc+Ll/J8RERw6JGxa9N0MH/RIOD0Q3bpy6yfwwz2VW" +
+	"ceMb83bZydWHejs5zghfNi5+BuxaAx3wwxqrOG5boASAs3YPKmQQiLNDYFn95sScrYuM4lVIp/OdfKWqDN+U2DQOSRlQNH07" +
+	"TsPfw8pny4aE/yr6N1/i/vlnkdnnlIo+Iz4nuBhOBkAmgYvpXNnhVCaM4jEA1JmdF2KcIZEziU1wML5E/jQJ/4GDuRZ+a8OT" +
+	"Rt4bfdL4pEHEFUym9ih2j6gHdHyL+BNgKNeL1CXik04C0OE0sfXDMC7M6vkE0z1pk/CcDabPh4ltzuMqBMwB25M+72fuCxct" +
+	"4A2YP6yeH8apqEZ4DpPp8yRzOZJwsZxP6fMB5nIknYX32dNWz+tfKj/ZCqPWGv9A7e7g1bjdlcn0+UtYe20r1t50DwDkZoUV" +
+	"XWlq9bm1wa5TG/9+WNrqh7XgJ1dObOPDseCttewsS9va4wnb/KaDTqZRjvICdqLXx/y+6EOaBoPsJOCc1fuZQh0yiTJ9gPX7" +
+	"bmafjDLddBm/94r3x3i9+v0xpqtW7xss72d4SV75foZYvsgNYidBPVrnp4NOxANKlKOs50Y6wE4D6kTcWSwMesX7aW3m8g8z" +
+	"flCZZtqN3zGZhLgRqR8ZF7QOhthJwArz+5Lj3FPyHDabjHYS0GCxK03knlDLDrniK/6NIyyAeAf1Kj3LT5nyL0xhg7//Hn/G" +
+	"dMafppv+jS5Meyp9aZ35LlhOORz82vGROBAZT0WkaG0P8KfnwX9wk8j3vt7Tb4aZoCTZvit/CaU5vJKnZGp61JiwNZSpJEfZ" +
+	"M3JYdju2kpewbHSdXmzFO1Ks2XiZBq0cytu14t8oF5mId/SCpWYskOkXAFgNLStR3FvXWmM4cCmxERAMFFQA0IpjwKWEk0Om" +
+	"QbuKzSgGy/ut+Ao7CTzU+r4ZW2EngYfF96k+JLaJ+u3gHMFvh+OZS9RXvtKJIE9GWmGdtNTPudIURHahNdaJyEsqn4Jflpf0" +
+	"+ZhXyEsin8a0kZfC2Aq+x21wZjsM33gzThDMdOIF25vpnjRL5CYVuOVIELdoATLbHC1scwMDVi0LGtiUmcb/MybTwjz34uGe" +
+	"4YkwKa4G7eEzv144e/bMB7ALoaKj9+pUdjH3RDjjPNqfcdRG4cbASU1NvksSr70eG298gMb4+5e21Ffxb0gXnIlO088uq8EB" +
+	"+AY8AjuvHxaFTda4I+JL9mB30vUwwAoPlGvGroOhwGjCFrldbKcBFQLulzsD5wn7ljXQ5/Xi85FwnmWf03HkzsCZbfc5HccG" +
+	"Wv5MGE4zcNJ4HnHc59P3BRwyxaHT998Qxr0bb3WOFFni67zZAlhmkTu5Mi06z7qZsB1FOlve97e835sdCkMs7xNs4AVyTlne" +
+	"F7CpRZb4Mm/WB5aZ5aVkPC0/3MS3Yl/p+/6W93uz/WCI5X1aPuvbipU1kfxzemmRJa7Mm2FI+ab3AUDhQvlMVxNvjokALMlD" +
+	"LvW3vN8b/ULKN90neXmF8tEnVrEVwtlXZDn7vNk3LO1nxPHxpOOTYvW+v+X93ux2GGKFcSLtdzNjlkwm8DrF0xQJeBpavoSW" +
+	"X0q4acT2v2ni2+Fv/C3v92a7wBBxvXUQx/8CAJb3hbO4yBIv5c1uouUL57DQ/nQTbsU60Pf9Le/3ZqfCEMv7Qvv9rLARc7AD" +
+	"wd1Q/BALQCu2BzsQ3A15zrxDn6eK+BkHip8hz78HIoKG4mccKH6GPN8BzAgaCNbgFMqz7AMAZOUOtoxU7+8umoq6Qn1ACKP3" +
+	"82HUblK9P9G6FQ62CD2Eity6H5+vOgnt60YUTUjN3/S1f1Z2gOuAiHC32i8/OzOzuUjhNx7tG4q/LLpRvcLgVh2wZvuupAlL" +
+	"ddEHJ3YPH786JSBV59b59Lma1KaFg/OmlwAIQnEStWORU8yFUTjYclJ9CNvaDFZohsTSDgkaHzphoHPQ2JVr91YM2XhnXab7" +
+	"pBH+Ay++32tQ0kDPnt7Bg1xDti79dc6E2sIx8HqHiMx0j7hVk8dGBWu7LvYxjB43Wpde1C119aguroMj8wK9o/r0sAnK3LFs" +
+	"2MaxU7e3wTxr2aVU7njR8f3NgnfWoo/a4J1bMYf2lpNY5DtSEsyhmvlu9SoCNYw+s+b6RyeZBZ+1iBjDiuWQYAyff07xb0Vc" +
+	"DeXF603GwkNwYbpJ/XW+1Kcpkar9AzR68YYsE0SsUq4DMqWja4C/jJgU2WCZqrJfv1Pjy+7gD4b0d3E81n2wl1/S8KLm4hkz" +
+	"ipuLhif51fGa2HgojYuPj8PP4hdzR/tC19M38NtdowdFbhrsToEJSEZhCSjb+AOeSOAIcEtJcbEYT7CPewI6gyDB2iLUrffT" +
+	"MMTwKHoWqImHyH8HifmS5Sre6TXCf3VoaZ3BYDDAOMPYt++Ubk/Vn946Ki1yXOn1innbV+xITV2zhv9zzZrUtB07BseXFvyI" +
+	"Lick4KcJCQmJyyt2IC0zNykyum94+IrdXgkdcgvGvkG4oMePX/95SUlJyefrx4936xWVkb54RKw5BoJko+wC/Nq2WauxF27E" +
+	"1m00N1Enae0N8l0UFxsbB5m4uLjY+379wkuXxsTMmoWHzpq1aelifb/ADdoh4aUzw4f2tm5nQgKKDRu3PSzDumlX3NSpcesy" +
+	"YsJ/Cg8PDy+ZFSLagHAqtQEpAOBk0lazpkq0vajYqnXh+d9/lOPmZhxDaBkFikYH/PVwD2rS3MFuaNzxYhq94+JUagf617KY" +
+	"2evC8+7fynZT83MJxZJAt9RaVgPjsanB+GXrmSTglobSveBupTsLuKU71mcSLiW2YfMZA8rAxlfEV0hA2TSr+08HnSjTJcpR" +
+	"6Va4VarLnxZ1+QIY9Ir3s7Kt3pc8EOPTpMos01u4yXJmCO1/GedK289CqzOjfflpOe3aA3+zbo+IERPuUHn0DjW4FVMm6JOj" +
+	"THYipgwgMNdUxEgktkAKuv3rDg+Q62B1V1WlT78mYRv366U43j24L6xrv1kzuf4Uo1TEHWbLgRR4/UeZLwOUFWzUy8LCG7ZB" +
+	"K79UIzzfFrP8k9CvAaYiphO3G0iB6l/bIBjoqfUB1gg1jyv/El8fMsCZ1jxnTj2xlr9UJfp9PQzBF9YDAAV+QtZg9iObvYtm" +
+	"ZkILL6EZT3xN4gA6CkxPVEz6yzSuUntfpVwnJ3Bw4mHSKtR6s0QFVIgKAgLKiZjcuhU/i6+/k1+fd6f5hx+a7+TV59+hspT9" +
+	"JI7Iz1gqS9FzIiZ/hFt+LIZdLqNsft9l/Bb/BL91uaHhsiBOjQ+oDC2h8hTQ9uFUyTV6f/MQ2tdaN+Omlavl5lYRSJFOIbS3" +
+	"tReSXlYNqGttWLO5sS+8LR2AuVaV498v80dQitiuy42NYlsZpaUTpH0m3vQG+0SiBHbAkdr4dPZQ2ZGwM3SFaqlWHaANgIwO" +
+	"MioGdf1wNgzJwttg6tlybHumb6g+FkE7L5T4twOMOwiDDFwL3gv3ZmCtsQdc8Nu6qCgoH8he4rvB4zgJ9iJM6KY3WF6iBG5E" +
+	"d1Rq7TVyWYA/9R0FKKUKjRaazXOCEVVrD9XUril3yM+6Unj0KPSdPTFAn5mV4enND+lgU/ZDaWF+Vs6kSTNxy1E/tB4Wlv5Q" +
+	"Vh6VDrk3oRIWfHjT3a7H7IyZb3qpjHldOu/q1y/n42h393ehgsnqV7UL3z5Ms/iY9jGP2beAG9lXSm2ARm8+c1/VJjetPRRh" +
+	"gNmpjfErKn5JiPH0HD5qoqMj7iThMvZFRw2LioyNG3F7mS98KzRsX0ZGduIPaz+Adrt2yW1kk5PWj7LtxGd36DBd4ejV6G5n" +
+	"Vwk5lCqfPuN6voAjoJzbXSw4Amq/E1ARhH2bAiNmEYcARRMAgExnTG+whyVK0BXYURQN9YtoKUQpQNkRqthJ/XBKEw7JQi5Y" +
+	"zap9s/jvoC1+yhWG8B/wHZGUfz0dvYtSGodk4E5wnHBOjccyhsSVBwuIxH6QHpatqCLBlN7LDGeR9oKOOoqOpm9a2VnRW319" +
+	"dgTuiIgk3IwRhLkxJMS777KBr0fHTk2aVblUE6GOzwsb3tLT1VaWNjYrIyOtNDQUauN69/bo6h4bRwgbXY707t2nz8gwD3eV" +
+	"rXdc/Mqq0WH2e+a77wjWjP7dRcYgO/U5ffcefb2FM1HGvMcaRA4i6Ki0t+C2+0GNFwyGAu3gphHej5dqIsYti8sPG94SkDzK" +
+	"TEF4VzYA/yMWHlrVmdIRAkhOM8pl4AQAJNgOM/8Q1VaDBHoblKRu+pz4xr8P2T1s5Y7v7zx6jDL5OgZsqFixvo93U9Sdbz/7" +
+	"USiL8WPLaVm0HLPpXpDWDhKCzpRnLlgVET9nSEJi1Mod3xXNevvoKVi3oWJteLRC4dUUNbXky5b72EjlS0cRx0d4FnqLfkrF" +
+	"/8LUEsaZSXAgfu+/ILWHDqH336+8+i+IWlhUSc8EG9M9bhDXAhysLA/EPCaQbxNbGKcSIzeqm9HW5s2bBSbu115jEvlCQs3N" +
+	"tfBb0ER+S80WMyP3lpqaeYSl26KbUD/0ANrfk6YhrFKyicYcEr+sjljBiBuMeFOl6gC1XEVovnpAlZzJ2B6UtGTFE3ynDv7F" +
+	"twzaPmg3KsQT6y7+vQIvkmw6J4apnDtHSO2RM38PvQvfwBSDwYIJpiFsZ84FcEAD+oIBANClzXAKFdPqeNdK1fYUfUgAoQE6" +
+	"omJy9tQH3wOqHZk8FNvFNhdOx5nLh6YJZMbTModtH+fSbS3sdM+3b+j2EX59ZvJ/zbGzX13fOTn3TRu0eWumrAt+6p+Jt/ak" +
+	"0SqqC7Cj0rlKYDrueOgbN9+Nl2EsOrIbj4e2hyyYHIqlsqfrU2aNnyOzgZSHBAwegVahWfxvAhgHda1Dp/lokQ/4B8o7rLRI" +
+	"Hoe2pRBe4E2bhPN3/37CDYw71GABigFv1zCHHlD++zwJx/0mxiHrrSBgeitiamhmxVe7ITbo2Pg5IZHXT0d98tpTfAP6Pn3t" +
+	"E77fx6dPf/LJ6dMfs49+er5J8IEvWLoQHoRZMAseXMjPxvfwmzASOkNnGInftGBm3mDLxbopaWg7klvSGGskKvtG6P5NVmS3" +
+	"ETnexjQzHBWnnv/0rTaUty/WbB/LBLdCnwUOHYo1UQLX9lgTGmjyH/z7hw79KwM/mlWHTrcj4Yegs8i160SlvFiDyLXbS+SY" +
+	"gD/tqN+xQyTchZR+FxXU4MHwUg38h9LunrWT39hLz42/RbkhBw4AMDqlKkDYSYQIj25aJXtpBfys4tKKNU+eND35bS0aiJ+s" +
+	"EGJ38UziTIeramq+E8YBp7LBVO72aONFZ1R6HaOzJ7nodAqVF7S38qCfwX/cPni0DnrVHqv/DP95+PI40YEOn66H17GO/FmP" +
+	"n1i4dp9Y+NvN8+cMpeYBoBiRNogY+OP7O0pyV9bX1gYeKNvZ/D2hLkmriRt9MPmL28jLzN+OFrySv53imc28zFD//4QHvfFF" +
+	"9b8FzwvxBn0tvMz/kx+eFsl/8Epe5rb88I2soS3/vPt/l28BPf4LD/3e1+7fSk75NzJ6dv7Fj6vmzRPOyBmme0ylwElPcUpA" +
+	"SMDUNi/WjKjNI7+HnfFvDxM2REati9574sTe6HVRrM7dHV/AT/FjfEWttvDTi3NRTLnutZTH1Yqf1Hy/EWzbEoXaL8Asih0h" +
+	"vZuo3URofoZEoh44JGfj0MBRcWuiwyUrbXy9llM2fHzUL2rJrNFmUvwZHSTJ3rro4Ix5/TzlgREFyWrdXtiZEuQbB9kHxpnl" +
+	"jMTh3+SM/P+tnEHZz263FTR0PXJaS536Vqjqf6zLTfsPbsuJePXifI+K7ReLX71CW3n6uwE3goy1joex8qGrAjgR75U3c+YH" +
+	"95YtX7v2N8ObmRmZGTm1WVmdeGktSuoyMmV1WWwcyn6+ccPeffgR/quqyrvPEb/u3cfnnquEu9PSMjNbOXMsuQHs9SohLlTf" +
+	"upRQgBAhmoeedTpdm/N6Ruabht9gd/znvQ8e1qLXylbXdUHAHOOJ38N/4Uf4LFQLqQEQmGa6x31FZQixuvlr/UXNHjoqzIE+" +
+	"5ISXSqStBMwBOqvIPz16loVgR76lh3Ns2VYY3HP5snvXS0c6oeNyp4izY9MyaYPWujo117m5ZWsDdyXFfrrqw5CBuCpSnYkq" +
+	"DTPxo317N+RXwTe16lwIu3Un7axSe5Zu3j6gf5Uh8z3BBjHLdI8pYOfaSZjYdp8/oPtsluket5adS/meRAosK4VbhPKY/6l2" +
+	"+9fecBJUXjT3p0+jE+KSw+ITRqxIiIsKL1+2unBwcGjIpLCY6LT07ZHH8kbZflLn5pajId3B/CCU5bRx8uEmW9ueBz3s7QYO" +
+	"zEwfqNvm3TcqzMenSxfneienxCETl5s7VBclzmscOxd0FzLGtQZQtWuqVtzLoo5KXKsB/qjuu7SMjOyzK+Ljo8LLl68pCA4O" +
+	"Dck+PiHf339C0dyfPsM/4D8qVjB3ps64fnNaSZvmVCcmrR0TFu5E2yvE3ezaLdoWfmANwP7Vmo61ltNOx7FgczkNla39/+/5" +
+	"IVrl7H/niRA27f9OFvGeaP6wcPwTrgQ1RU3+Z3P87HQqJhJ/MKfi1U2AD1AwXHH9zVfX/Qz2EWQ9OR+/ZcuFfFlqIcbCrN21" +
+	"IU9m4lZD1egLU4WYqOzjI0cdziCRUsOm7YbyNfyT1oiogIDGkBAi9rsCBFJxCvMHnSM1AB3NcRMCgyiNmmgfW4E8oNthvCJH" +
+	"ra+oqKjQq3PwisPQbXDPbt3c/fzcu3XrCX9Cs413jo9YOH7R4sWLxi8ccZxxf76uh1PfyJjc8eNzYyL7OvUQ5jfVdO//77o7" +
+	"XcLbw7UhpO4QbThl0h/cs5u6p69vT3W3nugjuJ7/eVz87JDFixYtDpkdPw45Gnv1cOqrCvONivINU4l1U35Z5oG4NttkFKAD" +
+	"rGmbUMAqncCzv1Bq3vgnAFjlEGnPo9IWdm3JeUGR1pEvJ74QIh0+IDQqIh8CzfMLoNV2kb1UNOXI/mMTIUSI+NpS+sybtPBo" +
+	"WiQ6AYOta3j/V2HcOwMAf7LKSaKzUnLVR2s3b67dt8987SAKLblxAJMJvAYA6s8aZBJG4A4pAABFiLZDvZi8SK+TF8A727Zh" +
+	"9z3MWzU1xp9qahgnAMFQANAWiQPdOTq5+VzTCYYewncvSlC1oGtA5zqIunZ2k8nQdOTjk2Inr+M44XMR9PFJcXSQOBgfQdjR" +
+	"wz2lIiaW5ZcxiGXETxKAQCQAyCRxAHLh9i/20U+rdqPlS0ltDjo4u+7bb+tggBHCu/09bBUDouAIiYMRV/Pr0MiuXc/+1e35" +
+	"0jiaH45gpNR0zNogsuGIgMneoYdWShye3ZhbUvXBVUlvYX0dxvHscSGvgRnnyQrHhwAfsQBBKVhepUBPsksyEmLwY+OojMyc" +
+	"+JPjGjMzR6Rs2QWX8IdqaxlbeGeg1+SCVXef2tmTlKtxceuXxsXWwd+NOokDjhdwM/WmHyT57DgajyWAVcyMyQGt3MlClIro" +
+	"82KGB6eniole4uLETC8ByUlTpiTG354TPPiv71ANP25flSG7BpWRf5zfh8pe7D42QedLg1Toev0/1in/f1Pnsz9Qplipuc5y" +
+	"eioIB67CmjqEad2IYhyxJVWKUsxsMyo1NmY/df5AmuJG5zdpQWyckOQmPpFkuSkv+86KVsSS7YaG6Yj5bkJChDOOec6Wt5MB" +
+	"1ll+BBnwnHApVQnHXd3gvYVHTljJgGK6P9mSIxYZwF4UZD5sE0mmMEeSiboFsy3WKoDMzS27b+h+3GT41JoHJffXn/hooiUI" +
+	"63Ivzc9jaJsPcO+f8PDxP/GI46zBOIepeFHNTDZuBkC0+dNcPIQHupvAAq/ygYyvv17l4GhvnY/Hd+lyGdLLli+t2Mpf3lph" +
+	"ycrzzoIF7xirf9q8+SfG+FJiHuFcBYCrYA3AjvbYigtFK1itlWYzaBDkynBn+A12RZAfgL/csnXrFuihcbs9efLkybfdWMMO" +
+	"fHcn3ly1bj10gHbr1i+ePOn255Np/tVlpjvsaXYusBcinB0cyUqRIVdEBpOc2pwMuAI5ybNMOVHcNOiP1LFuQ97CW5uGuBlS" +
+	"cQB0gw+hh1+ftAsbOsnWnb+ANl/af6r/6F2wKxyAb+Inu0b3P7X/0ntwJMzCNXjh5BP+RftL5+x5JOiauTiVTZU42EmYiUDI" +
+	"6XYfJbEGYEv2jdqtH7TsE9iQ/9DNF/onrd1SV3fkfZuz6mvnUCLJTGP5ncTmv363frP4uxtvMv88+0v8HU5lXFgDcGz/O4ss" +
+	"s643Mts7dN+m1srXbB9bdf5TYe+l//+Zh8hoI+nQmoeoHqfS33dr/X2rNG1TzrotETm37u/f1KYwfjdtCVsilAjBTAAYD7Zc" +
+	"YNIXzh9LrMixzVi3STgh0X3+7ZoaFC7Eggtn10u/E1POoCX7sPtmoXLz70itgj0BANaN6g3/KwckuuxV0HfXsY1DpsVsq4fZ" +
+	"l87nYs8Tk4onlqAuNiVj8A3Ya+EgrGUNu2sL18Bd+GhqYoIBvFwH5fP1D7DXMbbQbFS20PrW12+LmTZk47FdfQu8DLnnL+F9" +
+	"9SdKJhZPYg1YO2gh7IVvjCmx6bGmsHY3fIiPGhISUwWfQypjlp8v54LqB82BzJpg6PjfSaFGJ9lFZN/6fv+m5iP/kRlKznam" +
+	"s/ZiYrv0UACa7oixTvYAKFttJBYBelAI8dm0P9Q7Z6gDe4HGIL/wFKfR6vckT4DVbV3xsl1VrRcL27hRKG7fvt2sIZGWyDcw" +
+	"VrcPWLjeEiN1j51A2sZZVkfreoVThciroTneofs3OTC9aayV8ZF5rbSN41KJCFJXvV8QbO0po3YTSS5Sag7J+sfsx7/+Lrbz" +
+	"2N5JiCka72K7c1qY4fYt9ght6vP9uvD+TmL7zqN6NlvMraVXWbWRdB9WEM6ciPzGxrc3lpXh8zBkPTLQMCm+ej2+ACx9PPi/" +
+	"8nNFZDe9v3+TGPdl7EEnk7lvyc9F+C82UNniImjTomPCnCuFEPmYPRO7ahGsq68XFOtdzBAMiqYtqkYF8B/cgfypwYNJvq4f" +
+	"bWpq0Z49b4h3oO9YOWsgowjVZJUCtRsgwBK1XqUwh+cRmtoAFSv7JK3YBK5eM4HitBa4Lqt8z6LFu8uyIFzBvBX9y7Zf8B3o" +
+	"/su2X6Jr7gz5bPWeCxf2rP5syB0hdx7ziB1HeiAwmrVG+QaIviN681c6Mg8XLHkvdH9MTK3aPctn8e4hQ8aPGb7FxwV1fbx+" +
+	"o8HwKbrK+28f4Isu7f5pwniXLR36jz5YDIAlHlnIP6YT0pgGwLJvYdkxfBlfJpSBzCHjyLb5x1Qk71gnqKZJyLgtdTc+JAnI" +
+	"YAeSgExIPmbcwhqME5ktVvHOclK+malDKukKhareHBY+tGjp2EgPTy8XuO0YvoSvsLfeDQne4a6uyH5xwnzmQzAaAOY5awAd" +
+	"zHnS5Gp51kXYAcou4opm1oAHwvf4SiyDT4T3k3AqGyXepWjOsVb5YZV9LDLbK2zfJn6+JQdZPV1HBBwDEEgybaAYm+5AJUZt" +
+	"mY2OjmQ2qM2RFAaJtVEqoWXucokPSp3l5wYHxszIZlYx+A1SNvzAd25oEq1h37CAtMA0Q79g3eAgvwbGw3jFfwxCYpvjmUvm" +
+	"WKL/0s/1KgVicooF3XxkRqYh/uS4g2KQ1hK+gbGHd2gc1kua+aYGABAYh+OZF6wBuIO+AEDXAH/OUfnqKDBHhdpP218qCRCu" +
+	"Jr4BsAl/jCDscnddp9ba8Lrsksz4GHXmKne7FR2Kjs+E6w5m4PizLzg2qM+AZbFCzdh9oNfk/NXTR02oSRkon/O8T1y/OGDJ" +
+	"DxctsaOnF+2nxuIUbTXySuVq6Bt4OMEP6jL2Rg8bFhUZHZPw97xx43K3NzEuM+LHLQuf4ajwOqSW2+2a90Zj8SwXvqRd2cKV" +
+	"Ti8aNJUKczXEIoVym7ZPGEtigKJjIqOio8wxQIyL8Tu0xioAyEshBgC93HZ7eol8ZbSRGvrCpu2548bNo0FGUcMsQUboD8aF" +
+	"L/mXCoQ1ES3cUe3bN1whd2gLTtDok8QeDImPit4z2s8va3d0VHRUZEx0wt9zx7NhbYKYXnzTZshAm3j8LkAJ3ACAfuYZkLhp" +
+	"NcCVaNWudnIZNAfpywAqcnH26uPiHDTK2QV645IHD+Can3BK2yzc6IGPm5ubm0+/ISoV2tguvfXD1oTc6BKsteC/b9F7/bsA" +
+	"/JsdMcg6sE80y7gqHQUKolaUnz/qNCkkNHhw4epl5eFRcQkrzo7JyEj7FYasWIH/wPc//Wlu0QR//7w85o6PT1hUX+9tuoHp" +
+	"mQMHlky7eX3G1Ordu/Bj/BRfaDo8eaOTU3jYmLVJiRY7GBcgcQDaV9nBrA7+f7WI+WZ7CyaSf7WLde2qohoAvm82jwljw9rS" +
+	"sblF5oyc6fBvmmPVjlrPif+dHnaWs12qdlOQ7etPMsHub5T5Dj2Af/39SIfawuJUTXeJw4NrL6pXzx5muHt99ZDw/q5UJ443" +
+	"c7BCktqdilwdq6nD8o+RI//xbQTrcDyzFTujzmgkfxepLTldmbWsoTVvfH0dCuV7sAajbpPZfmqQ2Im+qX/NSWrl43h1btJN" +
+	"+7+/mR1Z/y8JSq/QQXv2Rds96v6f8kVhMTlB38BDia+QMzdvNt24/mpZ80kNfggdal6qz16v0/+bzAmCogXoq5utomfYMIvo" +
+	"GXjjRhN0qPnkVbIB76AVWu7DEp7agTTAEwBrqLAHNeSQEGEv+BKoGAroNxEkDH+uY+Q0JeevTuvaoIm/QGnouRVEOBpl43ia" +
+	"sNMKR5xgfMZIzTbyeGmCxAH4gTAqdV+qmLbpv0441qoLzC917bDNjB1eZyhOT4zGv1LLVNzJcQczM0eMfK0KLnl+w6pLzHWj" +
+	"rqEt1hnHk4PolYfjx1ZdFDEgGyRGOq62QEc5CNuMLPQgKoGdzlfhqFILysArukqVA7WkzVCjSpTKNzIyfuMu/8vXIf7Eg2oK" +
+	"a9v1E161qwpNajP0OcbnjITgdqHrX2epBtG2e88X+I9GIncBV83tBgEWHVrpqFP5BrS9OSnbXbWkErXKTdtGz1a55SNpaWjo" +
+	"qOrAHSvff0PAcc0tT18WeGJTUcQjnqfMl2b2Ba22r7dfTEIf8T7lYrl0eW9duXFCyPAk6xy6A3Aqu0LiADRUpstV/ck2Mct0" +
+	"ewcrVLO6tVFILvQFpsCUxJhjVbsz3NxRTAwxYrmpBRpO+ALhIyq3fNKoxFOjU7f37k3uZ4LhzMHchudGuA++RtsMGIJt5fTc" +
+	"btAXBIIIYcza7lhZL+ivc1Wa3c1SvwCrRtHjR3iPIwhCSxNHLb/fet5nZEijz8AucHTE2rghaJXkOW0t+mlwbKp9Twhn5ruz" +
+	"+I/iefis2Pay1+R26kNejorpM3DejI4H/5rp6WLnG88khVv68MxJ2kkbl2rTcdc/ULpT6IzAE4lTOYKL1f8rh7ElK7plzJ2h" +
+	"KgiagcPc1djo/RQ/XjStYkc9/q1uz57EREIuqXaDXVFt0PDkwiMZGa9gOmY+EhOqj6IjP17AEAv3GfZN4bwkZ8P/ZTEeqYVX" +
+	"S0NDR1YPEq7xFERIF9/GaZEP+c0Sh+fL/3PFiXzo7ASat7yLeCoJAtFeDo/UMnb8BjTN+Fi5rhalU7u18ctNzHVBmiHQ3/Qd" +
+	"s4zrJebJEjMvM+T6w1h/GFCwZFH5kkWF9WUrV5bXWX9Ao7ASPiB/+LUI8ZgLbvuZ1AEO0zqk1PehEudG/DOAGbmIP7xQ+BuN" +
+	"wumQwy/YGcJ/zXa0WLanxIGizQVrd+ulW2Y2xTGlsz48ciKC2gW+Xr6zru7Q+7/+hC7R+w4/BF88V4PmPHsolpfK/iL4e/61" +
+	"PIl4ndK2L5eJHtM3tGYd+4riX6zbnFd1RuDMKkRVaL6E+bdc92heQdSwkWMnTvSYMHRKEavEy+CsjIxJWXg3k48PDhy43LqM" +
+	"/+HrMpc1Jr9yaZDXSwWipbMnpfmnziCFItMv8DD4TMKALgLDVZuto1Oopxb065vfu+dghWNBAdeVXzzb3z+9R49UZsZioT3w" +
+	"LufyajskvCvv0y09e+JE18L4Tt1l7FGbjtMn4SZUPDYwADF0TMLQWniS60prdoHmiRPsFdpwuWe3jOzCQuHnyEh+DmP5teaf" +
+	"k9/DwyiAE/IuWn7fevFFbQryCurZO29M+9I6pfqnT57dyVwoAj1RFUrkuopcVMK29IKUSynAPxTqCGDC6uiGMwaHDg6e+2XB" +
+	"WH83lXvWtFxnl174z+lD1R4e6qHTmQddE4NG9+2LivHfCofQvQf1Li4moHTq5+PUDUDQH1Uxy7iu1jnOX95cXNd2+wcCN3gY" +
+	"rRXGDUos8xUKhSzpM/v28YwaGezl5ORsj/G0d69MReOK3NRpDg5x/VAhdoQ/0xhgtgTOlOTJJIwdED+jyyT2l3yWSRk7KPAP" +
+	"VDEPYC3Bo9q8oJ+rGQwb6GcjnYNj7GN4RvIF4ACACns1o4dTY0s2S744hM/DMAGvCQqZX6GB5ocC9npB/yWBVA5SSeGgpKRB" +
+	"BdHDhkUzv5anh4Wmlw9P+mH48La/IWYgok8qhEsFNNAfFJCfMr8OH/5D0vDy9NCwdGDZZzESJFht/+Nu/Y/7ZF9X2Ctwgo93" +
+	"X28vH5/+uxKDg0MNJezosD4Bce5hnTs7GRw62qRHFeSEDZXjTe3K/s+7NTwzY2xYcEjSzv4+Pl7efb375g10haoBU9jROBxO" +
+	"kQ8NyymISrfp6GBw6tw5zD0uoE+Y0PYnrC9qlByjufE9FF2gwuMJPIPPwqF4GPfmn6tPnlz9Z/v3IKfvC/UcOoCHwaH4LDzD" +
+	"ac0vQnCYnYseSvLoezp7asJatezuvH375knysCPMx9sBaP8elfIMXHWHvHaXnQt/xtthPnYU3ntsXZ5Wal3eij//bP8Oo1MG" +
+	"WJX1GM7+80+8QqjzPPMjDJLYWfLRB5VJ7PgW5EXWmJgPWCYB0wH4Hzlqt7NPUIz4nb0awli8Cn8pySMZxYV68EcwCBgBI0Rx" +
+	"w6AyI62G1oPPoy9MI2nkE3iWTd/fjnuiGNNG8j5nKW+qWBxpC+6JeNNm+r2lLZNJrxAYDwCsY9UA0d3AqBh7FWSrYfjevfht" +
+	"9DmM5bW4idr+0efie+Qt+gr5mrapEGJoYNUyKVoIAO7a7tki4RmAEMFP0DZmC5BSbx0FPqOteFJFBZq4ZQv0xp+0e4diA9Ry" +
+	"iOBrFRUM2LIFfwK9AQSZwIRKmVHUpy6ks1Go9Wo9dK94//2Kq6j0m2+2fPONwElFclXDX1EpHWvCr/IrngRfg19vId+9znmi" +
+	"HyXRgsYhINd0VP6+PtEmLSkhrdPEDusXL1kniY6Li41fX1lJ+jCdi0CeNL85zY0Gp58+LZF9IOjKn7LfoktSCegk8ES34o4C" +
+	"zKl1yUmXOjwwKDExKHD4lPX5BevXF+Sv5/4ITU8LC09LDyuuri4u3rMHQNjHKiew7mUqObhmUm/tgAHOA3u4BfeYmt8vOL2X" +
+	"j0/XrstsbNLG9C8EEOznnNFfkgB67gpp2KRqEYhs3VP4vs7BpZPaFRWh0SnqQJWfg3NnNxWcjrJS3AZxmQEO9jEpFZt6qsz/" +
+	"oP28zT5mhtP1S2ZJx6gZHaNGx6q/LivZ/n45WeRwtrhnTCb4FSpkO1AutbboNil8LsDWdifGPmNya8yQtH3kd+Az9jG6IMmj" +
+	"c6yVqkn+vQCdVIlcR4zct3//vpEj9tXUsI/Hjn3zrMFw9k2RyxceRMdpfKkCAOiocCC8dwF0R0MJsa3o/eBHe7/4bf+3cw/W" +
+	"luO75WUlzJ1nJ5cuffsp7PfNN3jVkW0JCSuFdTMFVVEdQ+T2mVpQIGGM21vldRLXD/iSdazRal6KtrEyLdIztx+0krvI27u/" +
+	"j1+iVtOj50j36X19oiLLdQMDggcFBgTEJjl3y3Uf6+UVFloa6Kfjuox17NRJ6dTP3UFh18Gpj1dCmk5nY2M/yaVrV9++fZQO" +
+	"yg4Kzz4x6f0HdOxgbtdHXFc6ZgyVdfak8+jy/d/Kz5ye+/d35QcauK74Q3zur79gNPT6+mvBxyyeETIJWiLIHwDQDS4dcAAI" +
+	"HMpoBJ6KOrM3VzxvsP5eav5eR9+AG8uYqhXQ73nDf76DClaggv/xDvRbwVS1eYcjslAnV+nRiDL+jxXszecNKygHEf8QPTR9" +
+	"KpOiCgCeFQkykv0OfSGZaaelsnihHZHJCwW9gHEknPKdhH5/ih3QJRBIsymAZwK/4X48E/1lqqSalLYtuEiYXyv8Ed09Xbt2" +
+	"UhN0EfLpK2ygrrad3WRySOFHboPw6wEONiK+qKfK6t8AGXm2HB+m+4ilJ0FHyB8lohvqhcMAK+i65k+w5aZxlvd0UK3ER07i" +
+	"L1YtIlutWHjHgS03jaBnmNRy0nH8b8voOQZ7CYddMe0334FzNo2WBJBsk8YJAicK78mNM0Vwf5FzxThReGYcyc0FdpJOMmkn" +
+	"lTFPfM+O8zRFSaJlUrTDmAOEur9iy/EyiYO5fQq1kv+6tnakxOHBMQAAw2vZclMolZlS0EF4w0OnUHN4RVMTrm5uhp0ksqtX" +
+	"r14DAPLz2acmO4ng5xcSUVOOMYVaIOwUeP74+ROys4uHa10Z6aSefT09B4YlZ0qidaOGBoe7du8oc+3c29/Dza23wt2PtnkQ" +
+	"+62pt1RC5tnS3yHsY9MbkjyZFO0R+gsQr2TLTamWMRQ0ASn/h6AKRJl1ATKIkP+LfYinSxrMugAuKpM04Aj4Fik7hH1o6i1p" +
+	"kGnROZOQxe4cOC3UYXyDfWzytchOezWjslczfOaZsq/h9YPl75uFJ5xtHmtpKomNBgAOsRpzaJ4Hooczaot8d3V8Y6JNemJC" +
+	"WueJHddDdj3naTm6AMRJzANTGtebtJlR2+t+P7e5hHkAQ2l5N9gLuEHyDp0fmVrvSKLCcMMXE3I/5y5iUzmzEoOX3tPrFGqH" +
+	"AIUbf+Pz3AlfcBcxXsmUA2hazu4AZYKeRE6GQScnrpLYYcrpYuT5r/Fh0yjCFmOc8M+vdC5O8HdN4+izmcIzAPkp2AFPNN2g" +
+	"o0TTXMjVPF9ZOXkyPjV7Lw0AgzyPHfBz4R1OVAN4fnJl5WTfvbP3AogXcMtBoLQIcAAwRLyoh+AL8Ey55KcVVDc0dWImgWns" +
+	"N8AeAKY13b7OnLX4z4Cknn08bbvM7tgxaYRXDvPZrL5eAf6uIb00ES5lxXphPM5jO7zIdI7oEFA44kWVHy9KLipKdu/f3x3b" +
+	"zcvMmDtbr+0t/iYVO5hkpgRhpxJFSK/DRdV42J4yXLVixfGX3iHuatK3v/bAM9Vl+LL5nSli/zuZx8g8BpWVZBTISJGBEMtb" +
+	"YZKZBL1Fr1LwafAMXrFiBf3uDZxqagELSR+0CmuTnpcZ7bZws2g/2kzeb8appo+F9z3aHOn8aYKrv/X9/pjYhcJxbvgUQP4r" +
+	"vBIvMxUL+qdCTYTD2p9NJl6DV5qCTSNkUjDROOHZULoHi7Adfmo69986FF42QO3er5+7esCUouTkadOSk4vwzxp/MsL+msx5" +
+	"ZLjnAsgfwg74pGmkUC+j5v86t7nkED5P19xkXIq/FZgRLLJtg/kZ2iU8I/scO+DppmpahrDPDbgErqHfheDhpn6mHWSOpHK1" +
+	"Xq1Qy2+VP5ubh5suXICUTAsa38aBpv2mdOAAAJSo3Rg/vY4cITrC+6RDUjc8wL/gUtfCztmjbSd2In91uVKAV6fA7ojZsuU1" +
+	"uAV/B2nblNjBlGpaQ1jrjBOfaQRZjB1MyfTZCuMEy7NAU5QpXZAVz2SCrMD+pijTqP8hK7C/RVaQMwIHmkabRghnxLOewhmB" +
+	"z5siyN0DrW89I7AzsMOHZFIwxJhn/cz0WCa1eW4exxcYPjQ1MZ2JPsoIcZlidmCREFMpaEtxQxcNS86zt4nK8hm2YEjU0tj+" +
+	"mUNt7AtHhM5lOh8JCtDZsCqnoNAjg/0de3E2AcHCHJ2Gl0ybUQGVNwqVfjL2QAU1gp/nxVR4CQBUYF73L6axlfAS/RK+uGva" +
+	"Z7rIvkXnVguVRr9UZn1qdiL7AU3xB58fML1h2iVRmr9/Iclit2RFpUu4DKHeH7EdPiDsfU5IBCNaCvifyKIkK3RI69aHfCge" +
+	"bepvqqV7Wq5TEKHGh859Vo5Hw9gLF8g9C/ETsYMJmD4VzgdOrpJDvVphAngi7HOuDw6BF3DTrVtt1xXnr/O1d1DQOHy1Rusm" +
+	"VdsF+L5qXT1PwT+Iywq6QKEPxezvJiQ5TGWIVBugDSAx9UopHlSUWlSUWjSd/M0VzTPMM/8/gHgge8EUILlFUZdCOneFwDkj" +
+	"YsHIlFI/Ly5y6bZ0TsyQDp1kvs7mf3Hv9uzfc3gymop69LTp3NXWq+1Hsn7YQVTv0Ap6h6B/4HPC+cE+ITqTcH6I+/YE+5jo" +
+	"R8L5Af5dxxHPLpkE0RxZvD8XZZohvSWTMs5GkceON+FUfNlUI5MyjsIzAHkN52wKl8SQGWGoLJarzlfgPypQd86ZXFzJvBVg" +
+	"X4xNp8VzXWTzxJjcNukf39lbZpP3crCDSWqqFueXwC/JyWaoqICuFRVUtOASUmcBdsDY9CngAOColZkvhK9V4KdkDXgDyNuw" +
+	"j01jJHlACwAUopqtgfEacmVqB413xL6Dxxk6Oljrqz17uASNN3S2s1ZZnXtwpvwDHW1t3D1SKmJjbLs45zd06mL5BBjehl1p" +
+	"GiP5ADgDFY32FDayM1T7+bdeMIOhcMMkEavE2437+zn27OTqPn1MgirAS6dwsXV2L0JZ8S7+NysqzlZUcAUq206+fSUTZsp6" +
+	"uHTprPOU5M6S+WxBQ7fQebFhL5vGSCplbkp30CxjlO4AgHq6D3qb6k0X6PkboFKqtQE6qUqrU0rxtlk4ZtaMXbtmzILNs6bv" +
+	"3o1779x5/Tr5Q8a3DDvgh6Yaqseo9VJ9gFKhxYbM1afPrMEOPy6df/To/KVUtrDf4iNSCZAJdksa1kjufCTQBB8ZpNFqNYPy" +
+	"o6Kjo/Ilq2Ef77BwLy9YDpOG/zA8EVJ5zBpMUZLbhFMMAFApk6DT4L7JxBdjBxMy3ZBJ0WMAnqmAdV12AMD+5PpIzH799SJ7" +
+	"MH8mb1h09LC8QE3v3hrJ6nKYOPyH4UmwHHp5hYd59zGZTEO5CNMLiUwmRTv5W4D8jzH+hO1M801vA6nAtg8cfUOgxk0S4O/r" +
+	"SOAiEjeNn7+vnb+fxk3i4MiP6j+sHxowrP9XA/cXFdUEkL/33x8wYFj//viiXp8QoJ8SOvf1eeFh816fi2/4J/jrk/Qmk/Ea" +
+	"7sHoTctk0k5ufA/xDLgmmcTopS3Csw6Ux9t4TfIZo5dOEmwxCpXxmmTisy3SSZvpd/g8oxfPast3hzbTcZkh6YLWSbVADQCj" +
+	"bb+q21/KtBp2UbdorSqzfyi3gcmbcDJloF8yfZKmC2PNTySxAZ3sbNz7D6Nr2o1+8I6jH8ztZxm9dKpM2kkt9AlA40e4NzPQ" +
+	"dEHQfekiUwcYb3HXZ07fvXv6THwxl6wvkbcMEHuz/DdzDllmGsGCyJ+ac7BwC8W8KlZepFPstSZ8qBkfauJajH+gcYTont8t" +
+	"vM/8afW++H+nmNDa+rr6ujZvk/JFOzkZS2IYrRx0KJctgU+xLfkOp8KZIJp858Go7eHMoIPjo7EtfGrmeSTtZg6b8T2slLSb" +
+	"uU7KhatwKko21dC9JiRaFLU/NHyZ0sluecdOXXrhVFV3n/v3bTsL42iOOZZJkGNrjnXCmQfmQB/TUpk5L7v5PZJnd6FpqeX9" +
+	"dSY7uIVV20mAizm/MeFcthPyGv+HndVkAu9wUYiX3rLTgIkAyKUMsYFPts5/T/hBmZ74bwBkEkbg+ryMouESJspOAii/qOkB" +
+	"AOzP4ntV1MvgBT4WyjD9AQC3RPyOMt0yfcF18bvHAKAQ8bu99Lt+lt/9brrHFVj9TsLo3cnz7wHgHojPt9DfDIILzHymAHCT" +
+	"xO820N+EDDBzPYZJb8m0jLNpKAB2EibR1oqTw8ITZsmnRyS2uKYIjw0HAIUAyU8RYnCaXgEAgMy/BwhIAOgIVRzUQTQTr0f3" +
+	"jBoEP0SAs32xo4Gk0wLWuSgsed9FLn1LfkXyfRP97NUGw8YAO2prIVxUhEaIZmnxl8sISgyQeGZzNAfchsr5ZTDkSknpgytX" +
+	"CIfbFQJfO3CAwNcaDxxoZIoa0fKaGgG1VlNDUGs1NTUCbO3Wx7dukYwuH98S2yKuIe9Wvlv62YeOjbnvEgB0arKq4I3ztM9n" +
+	"8SjSY87W0mduCf1d/1aeVPpZ5EEBgHlK+cGlVL9SCQwoZLihPcnOm80EGy/SP9MbGdvGFwsaW7nPuCcWjjTL2DEnLZ+70Xp0" +
+	"5rlkxTxNlHNeJNSHy9EG4254FCczyfxCeLSxsREVIXljI/+7Vd5WrsXMTylwqNHPg4U5AoCLEPNAaq3OP514ImkZjTA3AXqd" +
+	"wlEIP5cq1Ki8Ir20NL2iqSK9pCS9ounLdY2Na+803Vl74MDaO03sOkVa8ZXLxWkKRVrx5SvFaQqjzHF5NUnstrfCcc8e5fK9" +
+	"+Df8dO9y5S7SV3/LugkSOJ4B4KIEDl2Vv5aEhcl1cpkAWPIP8CcpLJBUopYoUTmE5xtr2bdLx89tmje+5DxXe+AdCJcfu/UB" +
+	"LIDbcT7+oWaNLfObsavtGrJYyJNtH9yKfizYeKMA4IK4H2l8mkKltzD4I0EDUOlVxKRLoowImJEc1Nwg/vvqjIxZsy5cfJyR" +
+	"Wc1/D3utCw8/dAjaQ/nhw2FhG06cODG2ru7qkw0b8N1fr9bVjT1xYvVrr52GIZcvX76Er13YvFnM880BgHpKE2VapRthL7eX" +
+	"MP3a5U+l3zEm+t0gqxzTP7I0py8TRSVEz/FW8erUT9ozGghR62bedeYPQaaAE1R2xFviNVNpOYlUCvU0ZdDny033mPNEnjKZ" +
+	"tHxnJzMvwI9sPjsHdAF9qNXUCu9Jzh+lo8VCoBWPaLJodK7omdazenZA1NDIKXPCNmdsPuPewdXDOWn8qDgPmedIz4Uf5xfO" +
+	"WHACOqGOPz6auqrpTuGkFvgj9OD3fjnAs3/VyD7p09I8t+2C9lCzagdpxyGcyjpIVMAW9BQ8ZzKVq1wmdZTLVG4MSV4g4/Su" +
+	"cj+1mxyOqIMLYMVOI058e3juccjibDjs418isVzCwPfwAvw4NxP/iTUw9jAcBLs7NsKwL/F7AEDQk/AJCvFX0F6AuEMBeAEj" +
+	"78CgY7igFv+tTfUOPc4c4+OZ3BfVOBU2MhHzd7dyKDA0J3pfAAhPGV1PlkymckrNqZepfJXm7WaOItAwK7I++/7xGxMXls+9" +
+	"/dnc8vL+Q9fAte9OxtHBAxITEpYv25GcPn0QJxczJeJf8N9Vu3ZVQfsPasPck9ZCWRTu7Rwz/YNrM4p/OveuIb+TROQqINwF" +
+	"1JdhcVJYwZdfRvBapQbxh7kUlHsscnt6WnRMWFsE74iE+LDkuIToT0tK5qIsEZXr5FTv3KVLGwyvnb3HwZ62tqNHH568ke49" +
+	"A05hNkkoW5WH2aPip3cUt6ArI6MAeCCXoSUzh8UPidkYonF2dtbxX0GEsIrpypcugHFJs4uHp8XFc95qD713H6SDD2HGmTN4" +
+	"M9biQqv88Yr22JbWrPF1Yoxk8nAhXfyLE2x0W85Fiut8wDISdyoj6TlmJ4ySPRIGkIFWeWrJsmdS+Iu2IQnLK+KGdOjeP2HZ" +
+	"quhBcuh8e255+dzbn5eVl5ehK7hlQdWuVfB92DN3d9V8bEQnP/kYr8KrP2lp+QSWwpkffyLIKDGumupjciszHAPDXFzHDc/d" +
+	"FxsLWQPvtcGnf5Vh3CfoKoDgIACsnYW3UcijJ5WrDx2Er7+Pi+G1q3h1+UHWgPvBj3AmH4WGLMKh1jnxuhBtQE1+RVGgSkcU" +
+	"BD+iKfCOwjeG9jGkFX7ATXi+k6TAY32vzN9NISo0j3QqW0B0STCLtn0IAMwxgRtVBWnUCQHnaXUDxAmXwpn4+gnITs6MZJBj" +
+	"N0NDiKemrHoqazCOnHtoUZE+d9aSjLkzEi8d/b7EsmZ2UpziK9eMXOZB9ha5vbvKHTiFu70d2jBrWJy4eHr68R9Cb+OVN9EV" +
+	"/ir8kjch+NISgqegd7o7ZC+nnDmD777dsdERv4V/s3CSkrwIIEXom+lzVs0aKFMXsRa08d0KmL6hzOUFfQd06S3pNVQ1eQRe" +
+	"c2AhazjND48O5eCyLp2XHENVxnSmwSpviZxyt9G1aSYHIsuWs7KQ/hOwQl9jpgVCX1jSA2/Ly1S3UgLxR5gPf7xrxZEFwQSc" +
+	"QnRyoilyr+KIaD+cKPcphcYnbIwQ0urunhkdP1QcSWczLN7CEhTfdhxb5eBounZdzLECFkmnUqgdFVZbBvmtjEncsXzZjoRh" +
+	"q5HmzDhxp+QxN6YWn3v/x5/eay6buvF01V38SBB4ULFzEfX9kwuwkIfJw7I5iKORQG1RlbhF8HJmH178gbBF7E6f5u9tAFA4" +
+	"61iDgJsxS742K1QrbLX+PgMMFdn+XaQ9uuXUh3p6FK+5vzczg+67irDImzPfzFs4a0lmefEO/s3v4eyZl9FV2n8a18sWABua" +
+	"YdtNIbfiWKHigmT0E7UrhfkkkMvQz4NGjUpICOs/v7i8vLSp9uSs8vLRrwX5JSZETWCubeBTCsZ8fbNo9K6la/Hn+Le92/BU" +
+	"uGE7GRKXjVN67lcmFp+7WpyPjkIfACDQ41HMH+xYmhGHgWrICDtaSc41ezJgWg3auAz/chFW1Hiv9g79adBy6HAJz6EfHjyH" +
+	"C/ibjBP8bP5uJK3Hi1B/40/Yk3wgZQv9M1C2MY0lJE7U0sR8B1K5GnW6aZhZnzB0Wu3MwfENMydeqe3mMXbW72glP785fogt" +
+	"02L0th0y8ihaxM82Th2vxlNJ2XNM97hVQk4izlXuZ69DCpnOV64jf/kxOjtCdcsg+CtevfrEoveatze/u/DEGrzqT2cPZ5Ty" +
+	"CwzD55/gJNSd/wEef0I+/cIfgV64xcKdHyKREd3HlngHJpqGtuo+EplMAyZ2J5pSJrDmO6a6VRF5LuafEN+nz3tZv0/bTnWr" +
+	"bEG3gv4W3vJvKEekKJeR2h7STBCjf+YnoKu4ec6ffEYTTY86i4823sOpcCn6kx8AGLDF9ID5WuIObEAPqqf7EmnqR04faKbT" +
+	"RgH+ShdoR05umbCY/TQwMro5eDJsnjpiADx7Vz8Dh83LRZPnnN18CY38bvj7ftG7FiU67mSK2ODJm756YzJ+t/7B4pyWlgbG" +
+	"+1wLhN/h+01bHPDPC49V9f1ot7inhbPQhkZcUnVZuDCQK5wfpc0l0UlKBYlPYoZ7D14eO3ny0L/xLzPPvrnr0l0TwCOeHNgN" +
+	"5ZuZor/mN67MbMwMaAwvhP7VX+FcoxFPgE3wFqzGv+OzcAgAECQCwL7PfU1j8SSMyNyAtBpClO+rZO3tPPwt7GPM55WxmmZN" +
+	"TGXUJPeeuQnQLdwAGbdUj7iuyohZnDwa/762lp+JVtWuhV2i1R0bbbr0xzNg+dCOrmGjPfsNAeb7+T9t7uf5ZCOR74S2CHo5" +
+	"G0f4rZl8eocS4/eBArjQuZG/vL91cntRuKgZuZtUQvRBdOz12eXls1+nG3zWydoJkfEQJkZOqM2LTIQwPlLigMdvr8KP8KOq" +
+	"7XiauMftq7bDXc9n5Rdffa8033iaic4vfe9qcT6dm0Gme9z7rAHIxPzfVMR6aBhEEqEFeEIJ2UKMDgX4y/0GceuWRuFVf7h4" +
+	"PMarI5kbxgdh773JbmfffJdpOH36wxr+MCQApRaUUvPhR/V0K91jGHiknu770ewINh8ECvveL0BKrmNuej9oZruiLKqs3EEa" +
+	"YEdOM4UDNKuWSCoREoYzm+66dOzcmHZyQK8BUBrQ2WmBX8qgFGU3CLo3pp707TUAP6MPk91Oh24cmRq+2cb1Scwi/WT891y3" +
+	"pB2w46qyQf4Fk7/pz2rxxdEJk2FH8hj/LTzmv8lzH1A9gHJhplMbhJxmFhTQkeYbnUwqQa7EHkGjSeQyLdOn7rvKxsYDJMHt" +
+	"gQMH1k/DJSWlpSVwTUlJiRMugpXdX7+FLn1869bH1ODwOhcj2CJqakRbRGveWMKL0YXuEYEDXzz3GKEFcpl4yEslyG9ZgqDa" +
+	"JyT4bT9oKC8v+/z23+UG5uviEjy1pPin994tKTV2JJCZHvPICnCoqhKoMqrKAQTzcArrx7XQmCo3oS8vH+Zq0b7iCRXo6dPU" +
+	"aSnjTk4dHjckepOgF+FvFi6vnwtT4BDOa9Y2fB2fWrBtZNsD/dqncAJMfHS80axL3BPiY0VdQqVQ/Zse0ec01SPgYrx834kT" +
+	"uaXR8UOjN4ZoevZ0NmsRvarevfGyBiHYQEheczsJOT8BNI3Aqeh3IW5BJ385CJMe+e1iPj8tT93m3dfbx9XDo/dkv6FQhb+e" +
+	"l7HTz6dvH+++fb2SAmPZxdXrcjt2tKvpads1JHkafn89H7qxttimk2NNL5k8LofyopiMbAblFwWQgYxE6RigcNNqAuyhvb9W" +
+	"IyWYYciPR+duqZ2CZn4YM0q+mk/Zqe5G/81W4NFwPu9t6DNZfTYqpQl+gTegSvGTqKs7SOwFNIqacnSyDnztNX4aX3uNKTJW" +
+	"oitMAj8GAABBHk7lzgr5biHlzNH7q1zt7eQyRm0nl1EiLLnaX+4XCpHKDTluPzPmTTgaNra889XVn2NjRw06jfYfeBNf8eIG" +
+	"8WtQTFzhlQ/xDfyQv4DT8UYYD6/Cj+b1S/C7yV9RIYe/16+FLIBgEq5kVexs4EjbBzVaSP7SqPWuSkd7O7lapmUcP6uDYV36" +
+	"4uJ6+CC9z4FzsOsX7+8s3JYK/fAH8Lw6gb+Jji1QLa25/R4ejr/GK2G2yDuSj+MkKVwL5beWUcY/iVTrL+StsFgR/OyJ1Z+R" +
+	"KMQzTTRIk3/Sk0DnqLSTJAcEX973z8T56XOnnNmUFZzprs4cveTclKmZ+hHfxJUNDh9SlB8W9npoaOHsoLDBZfHfpvpmom/w" +
+	"r9d+qFuR20e5zKl/8kJlePbhw9nZx0/Cbe9D2z0LovDbe5rx9UlTCguu/PbblYLCKbOgtnkPDI9aXIV/oe2fg0dRHcUDAJUo" +
+	"ZameonQUhSzRUxysOsY6/NPd9Xe8es6lRe+9xW1n3nh34aUyXfaJVWfH5qbEzHsdwi74ERokqi9bIYSfYC+EYIGgwny09I/d" +
+	"5TEessmdvEKF8Utl3+GeUB+thNCzC8NC7GMWRiW5zN7fA+3dlzp7fPqxY+mZFy+GjMne/sPaDfUroA0Xa7f0DH787OC2bdth" +
+	"J/Twn0WocmlYaMtdOAz6ww4t0Hx3SGWd2HKqJ4tqqmtAW+ImIc1I7OrovJFx0Csuvq7OLyl50aXZ5etmfM/wtuPX3jy7qKS4" +
+	"5Boa8OwvlEJi5Rr+eUhhoFQviuPiuRYyjtBsabOsBau8mkJcCkkW+g/suOeg/+DtRz+vD0+fU3RpRbl2wPSC080FE5qaorJz" +
+	"2PLXL2D+g70nFm6K1Hde5uibVjalYMH5XVuWL5r/FdZyLfyOiiEUiwJ6m+6xzuwOoAQJgq1GqFuw2JivBAEWxKfaTaqlckcM" +
+	"taAvawW2dTGPKpVL/gFKCfqyeH5P3+6q7l0729g6Kns591CG6Ye4Phk7I/fxgoyIfkEx8WWpJbGBKWuHRXh36xkyMSvMPbzv" +
+	"IC+PHt17eg6MHj3q7CKdpmwAE7HNsZOqu2sPlauip9xBwnVzGODke/6TY1UrfNThm+J91NkZC9ZM76b089IgaCN16x7tNyQo" +
+	"KLhfqJvXntSC4YK9ZRhOZVdxfwEVAJxE7QpB67Ba3GwKBymCjjrXALb8x7/xTfwFKtk7blzGpn2jejne7p2wyfj3ho0bH+Po" +
+	"tW/Dzh89PAf3w9++XFq1dsKYjf6he/MuVG747XYTfoqXnXpdxGXjVOYZWy7obmq3V9RJx5VG7TEPL936G79AHbaMGBE3b1V0" +
+	"D7tmVfj8Tz/6Z+5unL142/fH8a/b4RyYcWHSvGkj4kq9fRelVP10oabqMv7mjUpyzgPAuXO/AgT0xELekaHHQldoDq9Q9oDm" +
+	"fynEJnSEgtFSRZFQ8CDqhx/iSJiJn8mVvQIdHUJcevbsnheh9frpcT91RF73Hs4uwQpHfze5Ej+BSZyke0jYi9jQcCebRngV" +
+	"F6Bg+KZzdzfW5s1OHDe0dxReBBfEqYdyXKc3bVg3uQZH8hc7dlA1Nqq60ng2ANhkttzcXqYj/D+0mGnb4ulw93W8DHb/zF7W" +
+	"vb+9XN/dSek4M9DN/fhxd7fAmY5Kp+56uX3/7jL7u7Arxznqw198G+6n5HbDgfgxrIHBPW27MdK1HVhW56LHz6FE76Jj2Q5r" +
+	"pUw32574NM7vwHXbvbsbB8T8++w+iQw4Ay8AoLtUIqWNAzJgVi5UZruhnczaOow+x38k+TrEJEwtvQvt8aNH+NHmjfOmwWX5" +
+	"FZVDR64Yt/Bk/YHs7OzRhw6kc31RcWx1qtsAIUVG1U44HH51600bG+1x9w4SYzhc/ENxyfmzs4uLLfYF5qFEBnrRuFWNuU1K" +
+	"Ryu7gqVV9n5tjNb+KP2DIVo7j8yUyadnlJTMuPxDeVnBGNg3dURs2IjQ5G1x8UuWJyYlxa9YFhvHBaDZsQei/Afs28c/2bfP" +
+	"ql31Wk5i/Of6jOLikmvvzi4uFuxUWpzKhop5zglmjBiDCKBErpOzIU3Pm543wWNNrAE/zX1ezRlyoS0sxasp384o5hD34at+" +
+	"xxyqulF/YycsrGdzTKDo+XKurAgCWIQriT5uekr1cR0A8P+uidMc+e5yvwD42/9WwgNTlN1MgHhQ2qvfHvidl9Rv8hiGjk6w" +
+	"sm1RzmR7v4C2M6S184QKlavc3SodS33ygHX6mtMziotnnJ6cMtyPhSy8gOPxUPRFbDx+FhcXFwel8cjj5v5x2WphRgb0z8Xp" +
+	"jegLP6vEK61+aDvR/yzksyE8ayChrQ8OjrXwqc5ky6l+ZYYgqBQqegyEQkXbiHJmppsqw1CTlSpD4/jdstSsmpLIodFjDE/X" +
+	"rF275unoce7uzJEuNt3HZx2oPJA1vnu3ejvHqirUu6rKsUNnAMEx0z3mHaEue731oPSDemGBBgj+J9KKY+7u40YLBRvGRA+N" +
+	"LCHV8rvROFKtIUPFHNnfuYNjVRV/u6rK0a6+m6VWm3a+xGqzT4jwkttJwHnzOLA1ZByAxoJdWCLaKVQdKQ0lJFSAp2BXmAbT" +
+	"YdfjuLYJ1x7jWrAKfv3ci+uFu8Efn39LfjsDp7JBAj+RvaoH/S1D2OxnANP9H4Cp/p9Vz+pZAy6Hy15UMyewAVY/rwEAgXhx" +
+	"nVDrhlL+0kKhaZRJBBBos1QCVpiXyrSk4X7wJI6vH83ordZJJTr145q8TKuF8l5jUVEj/MNqqbQZAxjdZkw0zDqKIJ0DX1g/" +
+	"pxiG69SfttEq3+MkOw3TSN5XerBFsNTyPFF4Lpcq3VgtFDFnpuc4j+sh5upvzRZBs2+rRRMm4Y3jepy+HhEwJ7doGj7chOex" +
+	"jlgNZ9e/VyCkj4Bue3bzgZxXYyOOevDY3BbmGW0LzampVLP+sBRAc9uF3AUSc9pcpYK4POlCt9P5svvffoJv4X0wG/r88ea5" +
+	"Hx5/6oBu8L4Onz7+AUZ9XAn3wAKYD/esazmL7/3a+Cv+XrB3kdyJlpyJ7X3vZl+nBGx4Bb4DgTgApNk0d0lP6kGXIU7vbycn" +
+	"wSIC5FLl4UpDn+UMxvEncdPF5Uyvj7/788/v8Cy4ELqu23iV8zrNX+VfDGzsB+tQOuZ3fHH3qy/qoHx1BX+X1nESACnHtQjM" +
+	"LCq5SK0q15mrgLR8wgxTy19pakKDmj7+7o8/voPKdRuvXq2sRO/ygUJyVn7HF1/d/QI2k5IrxHkEgM6jPQAkCY3gamqdQ+ZQ" +
+	"/XunrCaPmf7gMc6Dq1rnTVwnwpwdFeesdf0coc8Pi+uqgD7vBQAbQfMjerKA2qJOi7r028SXDjoB4AnFLqrkalSAE0jCWZyw" +
+	"g2RZ4byee+G3X8KQAGTGkIAO1GtG+6Hy9bcXlyKZCzlzhKaqUW9aO44fDb2/+/PRz9hFHBC2oQ6HkQHiWsD/I0xKJn6b+v6l" +
+	"NL8twVHIGAnnH2DXDarohYNKoxqUguMnKPDHs9VpTK/t243R2xvZRZ/gLvhUhFfobOiHNLD78xasgOH4bfhLe+wKgKS30t2W" +
+	"HO2Cj4wm3RAodpjlTWjCXaxANmdxxE9NP+GIc8gGO95BeVwL74K+4183zmYgfkDClqHSaGJWoATeRbBbsx7cE1IL9GXNyX5Y" +
+	"tZt7awoGcacLuRj0IRDZfgW7LZy5ZCN+57MpJqBdua3owJt1x5rP7Vu36cSclDN5n3BPPjox96Cq16XKHx4M6Xkzw6vytaWv" +
+	"1c0qKi53Srjc203EhxzhWkBHMW6VLG/IHjnGH/rzGBpJEC3sty96cS0verHftub7nSKsK7lU6cnONMslYS2KWDSrsTnVhFb+" +
+	"zP+D1Fdw3z+bxHE4YZwjjAOAZB+zpwS9R6f3D6A7WC3MYPzFgYlMryYcn5Xe2K8GIpSOn3vRTYv/4f8NZ2PBErXHy4htbKLr" +
+	"hPaXVMSeaqIplEnBz1u4lpdxNO3Puvb4GACFfUXzsVDOYqsY9o5QhxLtnYd9FzlwUp9uMk+cfBEmwqRLp3w1Yxp7yPtMZCcZ" +
+	"NzOTAQMmA8BdodgiwQJIQzuVOprtVcu4E7HrodcpWPIPTqFGb1/88MOLzRevX7/YjO/ha1APnZuhM9Th6/heM7vP4YPLl685" +
+	"OFy7fPkDB6NMCb1gR9gR9lWuXq3ELfhP/DduUZ54FY7mJH6bS2onC3RyNXvKCIgs2L5dFAUtNAgNgl0AsEHC/KnkKgtbM/kZ" +
+	"sxSvbHj3ZFERTGlGuQ8ewx145p7d6N3nXsJcSExmbJJF5uhYP+TQ9Ky5CTmcaiQCR6hNaJd0m1CPlYyibZNg4QfIwap5liYC" +
+	"RPrIzaW6idIcUagyS3CtIK0C/OFFXIyGEtH053dQOSyKiqsN0cM4r0aL+I4Yyt+NGGrBeNVxLTINyfArkwJvNqiNLLQRZGFr" +
+	"8TqLMGSOiKVvihrGS5uEKrGLpXy2QahQyOdmusdWC+evvaPO145qeEhQ0oXD2E2bS49c3hfdIEfuuTf/gH2hAVfjj5+8DaPO" +
+	"wp6/Nv4Knc+2rMNj8Xa8DY8ld9+zpnvSHO4J6Ao01lZgAWIH7Vqt3QRnxwhHH/vbFWLaFlB2xhtd9u0Tjd4Ua7dvXxf8a1MT" +
+	"F9/Ovl3D/w6drn8g2r8/vn4dOvGTuBZ+Ih2vMgDYeHp2OAqcYmQVSRiRWIbUq4ZwxvIlsAe+v2T5P7Ao88eff4IfNf2Od7Ha" +
+	"JRXIbtmLVLS2+4HPbnzz3AvN4leac59SHT5C8KnS/NkBwJn6VM0c04xgfHEPIHYXGkzYFfor3SwOZw0KppzTv0okrF8K7PF4" +
+	"an8f797fzoh4XS4tPbdsJ3E872DLLRTU/WHP+7M8q2eeqUZQvtEByjt6l24fOKvqx/eJK/rdBwLfl8iNR3EhrjTeQ8wwJde9" +
+	"Mt8bTHg4fdas6Q+/GDNr1hi8EK4+/va4nBnzP3wH5yEWr8/JzMyG7+OrI2JjkzZVLsuf09Vp24wtNa18btNYQ+u5WY/e5b9h" +
+	"DZuMOjouBnyf3Un5Qx3N56aU8w/wp1qU+dhcD7/BNRPxnynu6ahx6VLjh8s2MYPW4Nw7/r1HfQwPfGLUEb5AfB8esfg9O9Ox" +
+	"j6ZtCAVAEsIa2pyZUrlarhXOTPSwFp4+j7fC3Sfw4YbaBnzoONyNt7wDT7MG/imyxcl8EvJ/H2+CU97nr6Lj8Cj/FFjjk3q3" +
+	"5lLR/PtpKShsYtaUbclh20es2FZBMqbMmTcpbANNl/LBZDFBSs+eBz08ijLTJ23LiArL6k4yo+xaO2YRgGbcc7vzUlKDu7fU" +
+	"wvuUcHWJcR5rMC5gFllw0hIHOw3BSculaAdYZfoAWOaGdX4Zb1NfCz/+AM+Hr5/BO47XimOQxCcj/XsCb0cEACxiDebz0t9y" +
+	"XkolETW6YHSgFu9NGrm95/zj8HLzi2rm+jQ8/MKHrXW+J96zyNFkvqPV/w5dYK8ndfhBLX5Qzxp4O/T4RTVzjNeiz43DW39r" +
+	"a2mvZX3U16Nu9fX8j+R+FgDff1GNWN74KuyL+ffWZ6+t8EPSSqOONbTeM+nvRgDQ/j4u4I0odkNKMTS07/7MFTx1P55GFzYZ" +
+	"akt+X9YgZval/lbuEpXLvanNg2YUkJP1AeXtkkBZJ9qS01BDqZyIBChfPC09Pz992mJ4zK80butHH22NK/Wr082M23Lr1pa4" +
+	"mTp4zPwC6vfLuw3IoeHdF4NRYHYi1BMvLAyOzybDkx2PLxIPLb6WmM2/y3z+y7sN/MOGd4V264U1ZicBo4Vxw/fZjcK4tzmP" +
+	"bY3bydAvXUrYGcn4YWGcKwBgdgjYFiJJLQgLeiKjP/C1lVkj6nz6Z8NOdfCznNTTsCf+szQoCP71oto8T9wT1tD+XGZ2w4N1" +
+	"z0/Xw8a6Tcz1F9VCrUL7JCXCemx/LnO/Cj+AB+uWLTM309JUgEAAAGwjXY8K4RZErwrkeFaaE3Uy3d758MN38AT8Bnz06NGj" +
+	"6TNnoq37GxpqmOubcMAmQf6ZYxK4zjRO4Hdyq5FJmG/N3OeivBUyvwsXNmi5w6kY1czpDx9On8kvr/+d1pUH4zOz8focZioO" +
+	"IFW15s1ZKuTwC6CwLZWQ54oay4QsV9q8dWuXvWWHAXzeaf3rqzasfe0h7Ir/+bFxaUvqwS82FX6deigKXyQgZnwhmnKbme5x" +
+	"B2kuOYp1aj15oH9rmkUtzVoqZ+6QE2fpzp386/JvbtOD6WHHN2trma/N5wu+cA72tJxGPv/wf7MGHE7qIdz0Z1iD5YwNgnL1" +
+	"S2fsyOJJ33wzqfgh9CtYfeEiXFV3A3/GOI/IhmOzjOvhL91Xrt+390U1/BxrW+W8hNwxr5LRBkOTOavnMuG5XAomggpLDv4+" +
+	"OJVynfUCwN6SaVrgDJM7AKne305vxlu+v2dPHfwRdxtcPbHmAr72ztQ704pWba1jPlpP7DXr8ZQJuQ/wz9/hDM81jtDn1s09" +
+	"l3bjF2bu2ZkSckd5j7Zr5mxp+/mDgvmC2ulcoGjVJFOIDFvWblh1cr0NfIah/OyyteuWNv6En8GubHnUodSvCzd9cTC1MZp6" +
+	"oLvCYGG/jjfdI9z3dhKQao7pEe2FFDNt+hMA1Fkik0nA8ZftiQAReS6xp1znvawkus6yGYhg9xDPNDmV8Ot3vHPz5nl8Dp9L" +
+	"GsW4EuVgkyjuhfUqivyZZGvg9eIekHSm+TB6CBqHuXDRoAHN5TPf7Dj/ES1bUV8Pf4GBM2bNmrGRNQgFE9kliHmjTiwcgj4A" +
+	"sAohZ1H7OVVY4Wct87m3sLGpblrRym1t5vLnR/gm7LNn9zt7KS+eeHY603n8UJjHXCloPVMnCWcqWXcjuVZ5T8/a2+Q52jHq" +
+	"A4v8tGUNL9k2nPB6Ij/xelEyvajG94Fg76L+ZT8AuP+rK9mShU4ilSD43y7kqdPeWppJfc3O/ze38Y/ncN6J49nU0WzGidHY" +
+	"q2y6fgiOzpXaqIRcJ1ZSVK9TqBT2rVcQ1BP2/fbPP7/FzvgUjGuC8f9ER20k/PUbo6LZ5O/IlYNNrvnmmxo+z3zToeWzEyUO" +
+	"Qj58d1F+ciIrC5lqna+jTKJmzlApyvP4S+hRB+fjxb+/c/PmO09PZuP1m5jrm8hMb8JX9+Njojw9a7onyeOeUM8QXYY6OzGf" +
+	"uMLKjG7XNqm4hoElTU1ne6yl6cQnj8nKJ2nDxyjRFH6NtKT8viWn+I2j9R3M5jfYof6mu3pYS06/flWzrjTi3zeb04l/eAUm" +
+	"UZ4v0z32T7YcqC2ywcqIL/g8NKIYJiEuzEdETHw4olcnuBuP7rwuYfLadUsP/L5sasdltbUrFVMrqLg4GZ62/o9sXWpj9OHK" +
+	"7+nG0V1duNscQyWJondfWwBUaoYUrWbUUGffEUqirj1/0cQ/v4ZOfbgHP8PPUA/+PvnDtRh/RwH8+wCBvgBwM1nDK37fCXIz" +
+	"j33yQd2NlqMo/tjiL74Uo4wnsQb+DNyLc8x31A7e1I7l2Ob3ojyg7ejgLbYDxmHvpibkBnu2bU+rddPSrsMASDuwhv8otxOU" +
+	"dqDt++QYBHhTbS08DdHRJV9a2klkBGugDIGt7TXHGylBdzOKRSJXEwQsVBHvJkGUaLRQxfRvWppZ+nbj5O14LluJyzcXHHi7" +
+	"JPVr6MJfRBl8PcroOb8G34fjbi0fgH7m7X0X38K7ocvesquot3AfAoDtyBoAovWo7DsKhRP0rVCpQqgU/YYr3oKf4DuGiPJj" +
+	"CX6JtXG+iUfKh47FXzJNeAty4D/QpFbhh++WJHXHWXBf97iS96FD1Sgt8gPm8ZdgrgW4ABWNoWvtg3XfhFHjoIpZhS+2dmcV" +
+	"6eP5g/Hj+SdNTUwf0jlmOsq16o6rdVdz/IRpmir28TAAXCVrEOqGjOrVXRTqhip7dJ9nGvCXY4eWH03QJ9TF6hKOlnfriZtq" +
+	"axkNrngLzYIPXt3bXk7iRIbiLWbcCZKWgRFCPgy1m8ZMa063vNqtFRKiMmfA1PuKkAkVUbMCGJ29BSuhEPzeBHItI0HuLMxf" +
+	"eSKk3jX1SlBxgXN6fHCh/p5/0dCN/QIL4eDs0EENSd/IEhSbYibi7ze/V1myPr/S0KOLQtFrsLKXuqNtr77JW9Lz/1oQGw/P" +
+	"fZi/osPiGZ07IalHvbaH99iNZcnZ6SkTzwZ0q0Ma/7WPY0/27QKX4CVfhTlp7XrYpHFo2lWntNx6zEe6ZBkAABAk4xKmQCIB" +
+	"SW36SvF8Ol+rzsp0KhHZ7kZIJH11rhYHrLmz4vtdIUsyOigdmfyJ4zYGb1VGp7uoe1d7ZiTpM7zP9s0OLD8GbZPHR/nncdU2" +
+	"zs720HFk+IjFddOz1hdU5vSx18YoXfvauvgkQ/lHuWHRMGV3dmqHRWN7dHTXrnZTeqRshZ0NGfEDvTpsg9XJfVQB9b060S6O" +
+	"V/rapEmnXe339JKvY1iMGAMpUXAt1OsiymmaV5/EQ9KM5UIsglLi4Kfs6dY9yN8Evm36Fi8/BoOKmqbfHDZmcImPL+cgZboN" +
+	"3Mvb4KvQn4RuML8Y7dGMT4brZKSOvjhV4iZxsa6DeWUdbnpHZ1X3wf6mb2u/xcuPwsFFtUUfRWUHlfjomK/MVaTCRlIFN/H5" +
+	"ZnMV7f241vdVFGCO9eRWcU9ozL2vBUwB1eSmbufPOQUGhjg4DnEJi8cNH/Kmc7ARdsvfxT1REODG0LtGB67lhZIJhr6vkf6Q" +
+	"mFe2HNi/VBYkPWEH+/j6ydUqYbBoP25GZw1my2UEVhGwj7ch7UdFLUk6S4wyt0paJNMqvdl8WGYnYTvS57QegkNG59lo4mdk" +
+	"bYR4TZwqeUzxrC7Am0icViN1gD0hWoUyKXJlNLIAO1d7R0ZwlooIV8ljHBkbOoRk/ta4+U7DV45+f49fnjod62akps6A16en" +
+	"nu/mi9/Sd+vuByN0TqzhxcBrg4J3uKtWwgBmEr8KzcSr8N2b+CG0/whFfAQd8KOP8FdQMuPnn0vwC2ws+fnnGZQHH6dK4mk8" +
+	"Sy/g8ao2Apm1Lx3J1G4ynST+FY1rGpV/+o2C1NSCN07nZ16+evVVTTqIo+vff68OvlX33vvG64zBWC3iVSQzuSfAE6QA4NFK" +
+	"jEsBW24Ur9Ua+0YlEeXBlxLNhMBGdK3PlDqz+VTd+mvmUY9P8kpK8j7pYdPFwckpwMnJwbZjn4/zS0vzP+7T0db86Ps++NKK" +
+	"xsYVcLCnjfCwp8zWpjsMWnngwEp8uXvHruaHFzbUPP29ZoODraJzp06dFTK7DTW/P63ZYCcTP+9tvIVKPm5UdCWfbe3l9o0f" +
+	"4yv43VsH7eXiE0uOajlbDjRgeLt+k7x17XodAsVOi+LaeiSUZiyv9Zgx3XtvCE9ICNzeW2Ij0/brLeso8dwYlpgQvtFT0lHW" +
+	"u599t04dT/X5cXJ5ecEvfSQdOjs50EcSz58ml5cX/uIp6dipm30/B6fOHWoMJc31S8Z2spE7OMhtOuWUnH6rKId8sunc1WbT" +
+	"yqrHD/atsLHp2tmGfLZZUfXrT/tWWj6bTGCi6V4HhbREprH7GACZlu3BPSa2e7YbtxFqTSawwnRP8hWJ5bD7RPx+PK6i30tM" +
+	"FwWZIUmR6mRa5UOQAUfIJMyfrbEb3DcyjfIhtTz8BcxY1VTqOycxya2IJysVl9rcVXI1s7kKv9tw9Ggbtba8fMVtOOzUlZtw" +
+	"BCz40KLC7sK38ceLN/PHLDhLeq8ldbgJkcdtIc502uRqOUr9ZcHyFRW/JMTEREQNi4rel5GRkbHvzqkte55Du127LGz118/M" +
+	"mM5fhI0AAr2plilk6qnckmqlSqlWZAIS2ICYwswLORcyLxTtmn4x82L2hcwL2buykfzIvFPzjsy7nXt7nvCvU7mnADRGsF+Z" +
+	"RknKKOpK5SBtXR5WEEiV+A9hLTmaYbD4Czy2o43cTqnQKZR2cpuOwofucHfrU7uO4lMuv0e3Hnb2nWxsOtnb9ejWo6fwqfWh" +
+	"wqlbT/ETAJAfwXmCjpIEyvWqtmJXdu0wUbFxRMrS8nlDJxVBKee5s1+ccrm2z1AA+UfsBfyeZCzl+3CTC2x3+L0vPp89h70A" +
+	"O23evUvg9G7znoU7770vZs/6nLuO/8xndtHYX/i8kP3c3AZop/LlWA+Fu9Zdq5E+L5wDl+ZDD2gPGbzyM/xX1pWVHITx5SVw" +
+	"uTJrurQzMex2+KceV6LfpQbKc68BOhAGEkAmyAcAWrN6tfnA/X/6Df5hok1aYmJq54kd10FuHfqhlScXcuueD2/LPXjhv76V" +
+	"/Ea/NRf1929tSmYVbT5KwkVqMpws/mO9+N/nsN0D5NnuwYtt4j8AGcNnPfmLaG6HvoADnYAcKIEzUANPslq1AUp7KQOlWibA" +
+	"HmoDPJQcVEo5rQfU6zhFX6hQe+g5qNbb6zpBnYJR2xsqpuSO2PbiF/oftqpiKtSNMIHnXy2fgq+lQMCd3QFd8TfP5u7E30BX" +
+	"5tdzm0tiz53bVBqbuWUOPpAFnblTW+bAtCx8D//2WtnU0QdezNoyZ1pWA3L6pGZxyifkL/T1IQLt/ucC5SUnvkicyo2XTpFp" +
+	"lI8o3oP65YktCqeyr0scZBollXNoB6g0fQAQcBZ9lO1tBmauQGu35SSze3Lr1qat8FCr5/JfLAYAIOAGAHOb2w1sqF2THiWU" +
+	"ch7KzegslZAdWq5TMNqA4OJHj0oGB2DvP96cUliY/w7OX7Omdh2Mz+7jg7f6eKGv8dVRKfhYSgqxGIXgC+vNftYJ1B9MtESl" +
+	"VCsRc2YSuaH1DxAtFQHaAOaH+TlLp7/zfRPc8V1xybVOcPWC77/PD7tgYNSl88tH8A1cy/QZX27s6x24fCP/Yb+T8wW7sYgt" +
+	"kwFPADy0AUKSNVcxLqcXlGpFjJlVaDiJzPl8/vi5w8xYsqWheFnu8qSxBGdWX+83PGkawZ112lY/prb48rWS4pJigiVzODLm" +
+	"dZ/+A3L5IxKbRpoShn+yr6sZ9yKVAin131HD1Sl0cDuBvkBf7P68xezj43ZYv1OPhi7lv2YNcBDWGXWCD46dzrUQ+SIMvEg2" +
+	"Iod9Hz1iIvjp8BG2R5sb0dZGbN/ITwYAAl8AmB9Zwyt+0+vRI/Qu/zWciVfBPxrhhV34y0bcWcAO5LEpAjaNzoVCJcyHXNYm" +
+	"hQmc1ETwO3BWfd645hsRA2frBkwrEswJjThq1ozH+AaBqcXFUZyaGJNfwxqAc2u5LyVpprmZYUIdadq3j0JjY2P2H9k53sXN" +
+	"zXuOYFNoxO8MS76Pn1LCyoBNsEOjqyeAwB2/LW0S43QEP5yO3jRegeuBDeUn3P8F2cO7EdzQK9A91AeK70uGs4aX6niVH3TI" +
+	"1DqXf/OE/oTjYY/23lCif+BSgh+SaUCZjqALD4mxh7NwKfGRkucfk+dm3yPBeLjRvQPsrdvTiteBb1UeD7FG7PDq1v5Z7KpB" +
+	"rOGlMqx8mCkL63zbeDF/pu0XPJnWmCE7WkaAXKcQ/KBytVxKmrIzE242g4cyd9K2HIcjH8NrIoLIA5Yep82x8qeKZelby9LT" +
+	"JqWOhm5mx+roVNqmsXdnwijBuYovfzJW8K86i20iuScpb6oVzkut151q2rrVGtG0dSvX8k0lXmeNamInVLbm53QWctHaE1us" +
+	"ysr/rdbr6muJGLf29xYVSRzK5vF6K58v5zyPltUXAIlU2MP2Kqs2qfqieOsG4YntQVacF/m9BwDsn6yhze9JO1QaaLJuAq5r" +
+	"53RmrljWzETLmhE9fCI/GQEGnRKBVMQgKIDoyI42566/z77BGl712yO1qEddXV0df7+WWEjhdWMgXSTEi2xeI9IIsd/kt6LP" +
+	"V6iViJHWahuft1jVCYDEnzW8/DtSIxESrVU2GmOt6sN50iqBRwhaKNheJcUE5BgL4KyGvHGnr0cOnOPrWzSNijGKJOOrXpJk" +
+	"Yt5/ySQx96yl/FdKMynrQNrI5H37KDQmpq04Y64YBxHrWluJVqPx4cz32EjuNxAOMgDw8GH0fiGszteFVTjYIqkLtJBO+CAt" +
+	"/Q9Eej+7EBigsGUUDi6IfB/C6P18WLWbLUueMOd1Y2P7yjXBaSVxUdPTQj3tnQYaYtyy9QNH+/SZHOAWE5+kGXx0yvbfDmQk" +
+	"7/xqXcGOXJ3/tIMlscV7AuO87GwUrl4D3bzDfVxkEveg+CMDVjZemjRk1ZKSNF/fkUXlCwaNOHmiMba7wr57g9Kug4O6h8av" +
+	"5EPo3FANZdfm2dY75u24PnfeV/Xjuk0ZtHzdhsh+eeMzhvb2GjbakKMZd3Dd1FbeiIdsGQgld1h7vxBk3Rs6BEjn64IEZI3O" +
+	"V/ieePjJ3z6Iox1GajdbpHDIG7rorTkj56Z49k2bm6RO7KYa6OXkHl0YGTQh2nNEqmtwDydPDw/Hrm69daoBpcE9QsPDepad" +
+	"WxLBgF69nWwcXNxlyt4uctR7ye2qdGanJGrGtvRx1TNCOsk98mcvDhuzpzg8sGBjxs7fsu1tWBv7rrts5J2lPb3teth1HLn9" +
+	"09Oa7NzxXnGFQ3ppoguH/i3YqnCpiB8vg10pfryJPp+AS0XOvDKwiD7/VMSIv03widSHL+40AipG606dasJqtgirG8kGJThi" +
+	"QU7h+xRT0f79iXV1+/E0gq7YTjYl09TY+Ao+MGjGWoAOAruSAHucfxQXHIVBMOiYHeE/ZxMp0JG2T/w9kNH8lEImM/ojqNAx" +
+	"cx83PXqE3S/BEjjjEnZ/DIvxWljc2MjY8jtQnvFpI2hTpwyAjtCS6kMoh+2MZzx8ePSvv8QWsIYXJ9Ib33ijMd3cCvJ7AfPc" +
+	"1dxnyl5DG8EOn3DcDi/HSy7Bb/AYAtgcfhEPp0NGqOYYZ3SapF3hdQJXxn2KCXpFOczeCbUu+Ev8xUW4Am+/BDvBTpfwJjqU" +
+	"8DSORrHIEU+H6/mvxTmTrBXbY5aQKnEe1qLXmk6dMgJx7gRoo9X8sfg+t481vPK3gciltq7OuANPZa7gaduZd42BrXMJINiF" +
+	"32afUBy9Ze7NoBGKGWGDTp1qxisbJowTgJzCMLw7a8ZjGIA/37Mb5QrzUYHvs2ECb49VOWZvKUGQ7KitrbOGn9CBQB1zUk//" +
+	"/DFBoJxrgyd1ErkkhZYE0axV4rGhRkP0Qntki95pbn5nkVVzYCB+F+U+90Lv4g/b4GGcxJwwQhlksbQ2D16p3F1Xt7tSShun" +
+	"RTUXLsFzL6pRx0uXxNb1b491hXKVxcFNYXIj4WwzYp/Ifiu0foslJ+4f1O8fR+WVnemehOAv3Sg3moi3tFcREJKVu1NiaaMH" +
+	"ietxlUpghUsF4RpQ72+AL+7/9fe8exNyZ85agi8dP9Bx+8Sp24cOhq5MkmN6KS4pTmOmQJv6W7e+xEvxH/iGIeezKHf36xfg" +
+	"KJg/6ev4kOrwQPT23JqauaQ9XUz32DtsOfAAPkKWKvPUiXcbZ6hybMfzZYl3gv6Lh6eu8dINVRYu+eHazFm8pFPWmBpDZkb6" +
+	"mMzxo5+uWYaeoZi4stUjR3RGbErmoagBW1Way4v3btj4PPNc7vju3f2OeDs4Vm2Gi7ZmZqal7abjgyvo+EgpH5rYeekrxkkq" +
+	"sCNYDRWKtQzA568YrHGTqx9UmgeLmUJH4O2XxsmFw/vheNe6i3CkZbToOOFmOk4dwIDWiK+u/3vAxGay/uaROPHvYzZsyJYj" +
+	"05ahzcJorP+3EZOx+BbUdS/f/Kr4GRqrIMR6cKdprMdB+pziMSXRMi3aAefTM+OaWe9hj4l+Hd0r1B3RI6hDE+ZZX9vwkSbC" +
+	"RMNMb6/sUK/jSjSLlH2Q8O9RnyOAr1R1vKC5dJXdL+Hk7nZ4B1F2tPN/rquDjz9Bs9oqOnXuntS76AzvkfL741SqmzoAoBJj" +
+	"Bzi9OfxDAdXsySYcPysdN50LLmR6ncLNczivT7ATbuQH1vs0QA+kJ4EfjTgVQOCJU9l61iDIQwVU21sVSKA5LKzDL6bdqMV7" +
+	"J47EP+/1T0cHtm+nVH7vLscbsfFD5+09FnwI6z8Rx7RJlK1W0UOWgWVM/OQmojyfuU6HswDlmH3qzzcJY2nWGen4KQTmd3M5" +
+	"rYPITOXv1xJNmozcznEubg7ISYAcwOsv1gjDJnAJceK5q1JbFUTH6FST2BgyPq3OffO4kDa8wRpe/VuHOrF+MjYiTIjq8ttx" +
+	"qnjGBFnGoc3xQgU6DRQwHzDNpxqRs9XxQoKO4C/mM4bZIZ4x7Y8XWlBbfGNd3XZY1Hq8EIQPPClyKkkGivEapP1QxMHKSeAi" +
+	"t5gMAOwixiuawxcbG8kkc72MgAXPv+VajB2Yf8S54fJZg6Use3NZRAVg36nFL6beqKbA2uqHBGX7s7haRHCtALZtgxtLauUh" +
+	"lm4k+xScgbH2EqbWwudJ8e2SXjIN2jHsM3sJc9mau5jGDdaKcYNDYWmb3xBc3mUA5BIwMVZ4LgVAso7KjRXm9yTHKZ6twSpG" +
+	"UYgZ60N5RRuteEUJ1o/KEnLO2kuYq5bvCi3+1eS2uHDUo/V7kRdpF5FP7BSrMimfVjzxYE4FwEqe0fe5oy/JM5lMCybCUrM8" +
+	"E+MLPcTy7en7h6w4T2n5fhabCMkZaXqHnUPGAVJ+Z5q7/xLB4aKRrRy+0gEykjvqDgyya8OFzf1Jny8xmewoJ7blfaH+mbC0" +
+	"7ftCe8ESACzv5wvjYadhXhfnbr9l7gQuMTJ3HwtzVwD+tw/bukx2HrUL0zKty2PnUwyduTwaayK0mfmLtvlCKwZbGLP7pMXf" +
+	"ie0S4gmFMcmnY3K6FYvH/SnTgOmjyIjcbvO+MCaW+EPz+0L5o8BL70+SaZVer3g/T6ZBe9q8T/Uzsfxk+v5b9DnVAa3Kv9Nq" +
+	"B6NxhR3N8RFEitDYwlOnuJbGFwuIrivYumgcRZv36qkRoY41NBrvEU3WHBNE5/C8MN6cByw1Y5Pp/H0DKHcceCzaBVOpXbZb" +
+	"exurypJtx19vbaOFkWExh+7fyo5sNdY+3PyeYWrV2MPUZotTqc32v8uztt/GhMYe/v5WztBWQ+4aq/L8cR5bJ9hGPRylVg55" +
+	"q7tSHUS2sVnXIweWDXC3wSvaXZq6I6dz48oa4/TFaHbbm5NwdyJcq9Qu2hGJfmO9b0AI1JsrYP7Am+Awp8Exxw8fUw7rh7+y" +
+	"vkyxBv5rrNQEXh9bXMXYTNkGT7Zeqiz2tyZBztN4B0omTjlKVXpVidjGMpiIT8Hqi8KFrxYfh1O5FtrKWZV8BLnwobdEe56I" +
+	"zSflid3XtRYIV+Nr4h3vg6eYh2kXhatfBjaizfAD0ijsV8kb6dWPrQQAgcUAsIMtsWLCQaYi7Hw0kI5Cs+GQYcM2Qnd8Z+Ow" +
+	"Yeg5dqbRaX1h86qhEUg9NILZ9NyLRC2jPGoDAYAh2C4bAectOH7F8ihCIQjKoePAoJJHj4oHD0RlOO98QUHBlDf/gPGZXj6w" +
+	"0KcPanpRnZICh6eMgpcFu8pi0z3JKho71x8AqDITkwX46+0lUvEiIDRVZ+YSElkcyTfMctryYakl+4YmunTq4pMzavynJeWk" +
+	"D6cG9puQox/YND77nYtZ3uj9oRH45+E5U+btwXedexr8ByxaeZX068XD9aNjY5N2oj185jcLZs8OFPEKN6htrz+9JbYSdNBG" +
+	"tSITXtkm9DPpfVCgYdmI3t6DR44s3zl6NBmEuoH+OWP8B9ZlZp85l9kTIZ8+2VmDAqf4VeG/JvcfMNZQ10hGhR+7Z3hSUsou" +
+	"GI5PPyyePduDyBHTPclw7gkIMHu79GYMBQ0/sk7WS93aLzVJq2HiR4x4rWr/8uhhMbEr4xrz8zNHD/9wzpywsJmLY2MC++Xm" +
+	"6AObcsdcuJjl1c0FnlgaG1cXNLioODjEXXPSVSYrKDwxd1Sqqte4cZUL24zWwOFjAQTbTfe4OnYu0L+ifVIxPuo/24cuRYWT" +
+	"aKnBwaEhk8JiomnAVF6ev/+E0sCQgQHZ2f4DazPHvPH6hHQ4MG+gbpt336gwH58uXUjsVGLS2jFh4U7dopOi0yMiW0eudIKF" +
+	"37KJYm6IXvl/GD0a/QJP/cdwnToFjy/7txEauxE/48VczttxKitj51IPn6BA/h9Gh8k/UFf3b8MxY3DIdv4sbIRR6a8eBqeY" +
+	"uIm07v6me1KGaxF42V4ecX2bawx7UlwC47MvnM8Zb7nMcC3tt8e875jp7a82tD5P0z1JsMC/B+UO/1Gdv1zPwqljxJ1wMjfd" +
+	"ctNhDTybtsp6BsvR+naXHkH3Md2TZlFdSuAXOEz2h4Uzt33dwWZ8sJx1mJqVTet94/XcDOFK8YpKPzDfL0S8PmvknlCfo4NU" +
+	"tJsIZI0Kyu+soqnU2ReZvcuLVuC3jx86eAKGQ5urV19gO/j4wrRT8I1vFgSqXG59gG5ev7Xus7f5PXz15hr4pDXu53PKMQLs" +
+	"ZRY8pigFCfbDgXYib0nFk7fqDx5rzEhOzmi8Vnfqxs/LF6J+0HbX3qmNc+fOwHNnzIUh5y4//LFut2CjJfYW4ez/l/nXqxRo" +
+	"XWC/3Gw67++8kz2e2P84L+OWl6e8kXIssqZ77CzW8D/KDKQbNoDM7usT0oldkGnij6RGRbad2fmNjWQuN5nusR2pjpnSqj+z" +
+	"c8VsARDE4Twav+9kFb1iZTkQAvnf9Utkep2+Hhk423cADefvv/8vlIvFS278O+xFMagfggiCE2YNbcpzaFOeVBLR0C8YHdi0" +
+	"L6xvmqeahjs6z6uDN5tfrPn0fFV/z73Mt+agR8ov0EEn6DBmfgF6dgt22laeAVdRY4HXRU3ga3yJf9rKPCDoLcZ/RAuwrdhg" +
+	"IMZkSh4Jbe6ILAVLLTYDZUco+QJvhgsu4i/wlxdhBTbi7KSR+GcasAmhjaDL8O8IZmG4hDbfWYzqYXAJ1WcE3PhAGivd3Spy" +
+	"GJqRD0RG0a7BSd8vWL16AZxS/JyiHn7kYITQNa4f/+HGiuUb+ddXEdzDvS/790FOpFtmzAOHaYyUK+0JFLLZChgHQQwqIZBp" +
+	"XCUyR1fuF7wJLryEf1aOmEGZxev99kw7PSO+O5TizC342ZYtUCr2am9Dyb6xIrxh7L5JW+FKnoVbfvwRT/zRHAMu+YtrETJR" +
+	"6cxhaRbdVSVXQzuZoByVCQHg7CQ+D83nF6NG4+7f8C7f5UtgN/zjEjEQvBFHNfL3Gkk0ONuIn1cgOyH2usB0T3KaLQe9gIbi" +
+	"0BQqK2cYgTlCtblywrQqFRBOHuaAOclpXtLpTI3h9fSMNw1P165kbuDPXhfC5VDvhDHXHtWhzWWr6zvzlUIoHcrOPPeun9+R" +
+	"Pt5V2y5grRhD1wPfvbT15JHdq2honejf+Yfc42QS4Cf6dVKZX0huJCDcPYMBkNwj+ZfAIPo5AQBuK+XMz2zjR3cAANKk1kq1" +
+	"Vir6h3VyqEXeZSuu/IyvnFxYsk50EzfBpZe5VVu/wzvfW7jX4ilu4V3g6Ttt/On2YplSsUypUCY0zg+a+MGHSxdGFIuO41rY" +
+	"h/UMm/VgU/wi0XNMHMlwuCAjpDsFrkbzHnylS7Ud8wecXU88qxEB1LNKqHCsmEBedq8Sx6t5V1I5IklnDZT3tTWq7t9sj1bx" +
+	"023AI73n/WwdTy06XFvGWtshzSHWrb5jlcXP/a+9FL3mbZ3H+HCrM/tV/RO82xQPI5nAGoDaUs9/9EyM0f72IenWviM7xzur" +
+	"1dp5P7fGbL+yU6JDvNXv38nMgCTcfqnpkgWnTgnObsEhZcaFnBR8ibDVzS9nbWtrhSpF/5MQ8Q1BtekSe59rIX2BLlApnleO" +
+	"SqmG+qYBISY1e3GhlvFRx/fRNqcNGx6aNzZkt+EZ/qtZM3x+emCWe/9RbvCeX6bnhDPMamPW/UqddrdftE8/6Ak9mBpj6eyD" +
+	"U3R2Dn0NfqROynvzlJyTOprVAMgVaov7l0qcasgZeSht1qTMS02fl6xh0owHmBroAT3x5/jzQNc5B6f4+k45OAf/eBQHA4DA" +
+	"CAC4MO4psKc90SkED5gPon5nIGfkYvGkL2zOt98246Z5F5dFRi67OP8F5Jo1yXNTcxYlqLQp81LRjKM4GF4cvu7ST5fXJ+NP" +
+	"8R18A3YjNQ7KXbF7aY6expgAwAUIfVCZy3aGaj3tTjfaAGaPJnl+Wtq8ZE0zlPJGyH37LbPa3HDXQKiFWvwZ/hLfoF2gc3GP" +
+	"8gFR/YEyI5OBD0HEzEoCnEE3CNPISGiS56WnzkvRNONnvBG/4Frw6qN4e2vRZIyEgRfKBYDlud8p+4aDLUtC60iaU98Qlgy6" +
+	"2HC/EOTqglijT9y4vPG94ckXXzQnBKxau6IwzCtr3ZW1GZ7NkStvQIeP18cyi/JPbp87JrhLI4zf5zWifN+7pfkH5wwNK9qa" +
+	"Oixuzq0d6Zm1P9J6DwDAThPsOx60TtIpaEAbjc3NTFfjb0eP0pYDYLUmlP+2Jl5eEa9aD7z7UeHeXG26J2G4p8ATDAQA0DLU" +
+	"cqGPznR2HGyRWlgfQVDv5wOBgwvyDUF6udrNFjLjb++eMKA5ZcddqPxqR8qZM4MLNowatb5wUHDh+pSP++fiH90GDR+TMLDX" +
+	"x7rkgVwiqdlYytRsurs5Nnbz3U1+R/Fnk/ZPDRxUfPTnhsk6p7LDU4zfNhQN6dkjfNJwqO4WKrRxhOkeF0bPXTWdGXODdIJh" +
+	"X60S1yvRoNmcIYvfnjdfWLLzYGzzt9/yOHVeilaVsCjn0C6UtvHBvrQxuz766ebuLEJNeXTI1Mrdm4sj/34Kw81zcUTQE+0F" +
+	"igUXYdrpAlDI1QeaAw9MnPv2kohm7fD5h+Yna5u5FtwxISZ6090tcb50fCcfmsP/LpTFfCbwgQnrFBrQLOMtruX5F0cphxh5" +
+	"x3RP4mLG8ZiHVkdG3c0H6UUHBlM4IC4rNSPapzm8ePfZvbMi8JHmZtbAD4jLC+nRIyQvDn3Ix8w6OE2vn3ZwFupgTCLrRRi7" +
+	"R6YfuPk0fjVEQIqFMKRLSoUPQ5QGF0bhYMuS8SRpcNX24k4i3fWCtvC35qjBKzdtjfXPTIrv3T9n9Ah12t5hq/86PVEdmbt0" +
+	"X87625XREYPnNWtGv1ZUtDPHa/D6X092j0zkWv4MnTDMo6NdD7vP1P16dgr065ix96ftI3atLh3Zb/yRRysX/jgB++UcXTY8" +
+	"fkFDluHI0sSxv5jXdq0w9iq1XED+0MGgwxIMdWxtc0iNOPjJ8w7NT9E0w3uhQ8nQIw3/uzD4B8u2EZul6Sq7TZARHgrow4RA" +
+	"QqHD6OxtGaktclSyW43lA8cE2vjPm7Qadtv85mcP8poPH0gdM6qzr9e2GzDMPjDeULpqeEHdMNgPr/RKHzslLDIrREP5bbea" +
+	"brJ/cL8DPRgqYHgIIUcI85KQEHFMSqkPFABMyIPidhyFU6JWP3pW2ORPJ7oP8lL2yVx/dW167+ao1Teg7SeVcS6BiQN8x/uW" +
+	"Dcj1Hf/NjLBZowPgMOdA7+5JSYm7Y0btgh1jZiT2Ngz3LZ67PCr/yLyoVqGSvv/HytTaqnWhfXN06/wm9M/IdY+bfsfeXeca" +
+	"nhQdNmS4kFeGngFK6gtqJ5ZVvkDO5nyLC7TJ89PS5w33aIYMBBiyR48KUtlvysFZolT+FN8h45ENAKflvgPdBaZ+WySFCnKm" +
+	"hMCAEBgAdfYhjA7akrS6KmgL2X29JxTNCkZ644Cg3KG97KKjBm8YbgJfHoheX1Hua3wQHdw5ORI1YNewEs5THTY60DijxjVy" +
+	"8vb35k95Px1uxY+HTIrtbZy/7IBBjV7E1gnnAbdcxMYShgvX1tOY7CaVgq3FRmwCmKeHcNr84Rq4jdPwnfBd3EI6Yj54dVMO" +
+	"zmaIoIVgieke+xH3G7WVOdgiVx+k11k2h0at0quEf7lKFGq/EORrnm2GvRm56kP86MbKyGZt6poJE9aM1DSd4h8ih5hJUar1" +
+	"+EFl89CyQx8fmhuhGhTXJ6QwsS98K//w/GGxi49OCJ+R5uubWhSycMFR7dAs/czpM8pzXsv31+dtGhNUMGJwV3no6JkAQABM" +
+	"99gfzP19+exT6+E2j+T5GXTysNGEsZE4AoUeTm2c7RqI7+BPYV+oQn8co/lWTd+xj7gWiqjwgVQ1c1RChQe5V4jrVahAFL1k" +
+	"eQcgrW5u/ILLORvDUF/8hnKQR27DnIg3z+WsHt23OXbN9SVLbm1KiprbmMuBbnaNTi4Yf7K5U5fo5efnPPln8JRtWcPiFn68" +
+	"dURq7dOq+Z/VTrIHrfv/KVAIZ5uKKAhqueVsqxYXYuvRVmNehcasVo2BpL2GYLLpCvse9zvwAMBDqYB6pQs0z91LexT9c4yP" +
+	"/lQ51CO/YdaQs29mr84iXfgASm9tSowob3ww6gaM/CS/U5fUzVemf/1ra+tHVD/YUvJV0zyznqPmngq2I7XclhGroGA+Oe0F" +
+	"I2tOmJfh05xV8/XStT/sz2j2zVrIDSNAr84xRa+l9wlc/HlVWlrV54uNWcxrSa/NTKKyBgD2N+534AaCyN7yYfUasVCy3Mwa" +
+	"in+Av8ZDHCilC5Xw0hAG3k2YHN3HQcpEr7mxtLJlTWTE7JpxsPHFF81RMcdGuW+EdV6j1+Ua1o3uI0FyTYR+yp5x6q7a8H7d" +
+	"0U514LBhYYpJFzanZ9ffXzHn7lubBxAtJjT4xMUfchvLIwNzKxI0AR52YTOqRvedOLvCPH/c8na6Hum7ta73mOh6qfOSta/Q" +
+	"9Yyl4oyatT2Tiep6tdTvOxAAi+xqAd0pT4YgVenStBydOi4MFwxqEI4JTfL8Q/NStM3fwt+jY8k5cXyb+ZRAXZ5/IWLCTPfY" +
+	"tdxjcn+111mdgFofTqsR9rbahxHHVkmPTHI6VzT3S5sTG12e6TsgrTx+cPGE1FB3BMPLDt06tiCqGblHTU0YNnmYimXdhuZF" +
+	"l7+9bFhsxfmfzy6PhhcjSkb2d40ojBk0Krh3FxuXvqHpxXGZO2aEBU/eMNJ75BBPbVROgG+M3qOLckjh9ql5NcXBcYuPglft" +
+	"kfb6XzXknr+AnZq1I+anpc9N8hB1v7u4pVX3M5YCBCpN99i73G9gAAj7NwmnE+CoraINWkSegwti70at/BA/vLEyolmTtjpv" +
+	"wupRRM79ctV5gLtiSPmhW4fLI1WD4j2DC5P68rdjJkW6VeKfKxXBRa8Ud3Lf0FjPl2QdzKdisLikXDMqtj8w68rM12ZuTAEs" +
+	"DQ1oAb+omerIRPUBQOSpnEh1r65AS3kqZYSmkiHTyvgwshBEsPcujMwWkZSv6w6fPXu4eeTyMTrdmOUjm+dcrUxKqrw6p3nO" +
+	"1Q3JyRuuzmlm10k/xzNvS9mgSdsubZ8cxBhlkpFbWmDnT7eN5Pbs4UZu+xT/0bJtJLdL0LtIjPM97inoQZlDCITZwvIszJsL" +
+	"crBFojJNFQeIPh84fmSEkqQebD54EHIv5l1cHhm5/AIOdE1YcHZhgsotceFb8xPgZwoPPxf8w9EdO46iCdAduiauvvDTO6sS" +
+	"UGbVoixf39GLdi8b6x8wFryCW5QB400/cAauBfQCnkAHABBmXtxBSrmgCAZBua8L9JALejUgq0segriczPpH+MuHtenN/oZl" +
+	"SZN3Ffi2fHZ49tUN/Kzo0f4Khf9oVOCbs3xE8vIctBS/vyYuYd17c7Xa1Ioxfiz6oc/womHbN+LkuLW3YSeZShfl69qVX9W4" +
+	"0qDjnVk/moumkPkVGiQONDepG01Rr1MIbFPQUBA9bFh0waCkpEHMr8OH/5A0vDw9NCxd3BMMhg10romGy6jtfybRalzLNvwO" +
+	"DAWQRBTAY5IpgCHfQ7WRptiegleQ37Lsb2iD1IN6Nm0ZepYTULnOwRbBWL/kgJ5TfUbNiR2colNO9Uwu5y5y7oHRvcPKx4VK" +
+	"WI/A6N7DyrODKNbYxD6BRyV5MikYasoT/fZS9id0T/KI3Cft1VZimyLadURMi3JGY4sKx8GwGVVZgVPS/J0DUvzVwwZ7S+cw" +
+	"cqceXZRuyk7dvYLchs4erWefzDm3OMItamp8/5QQtb3XMF1XJ7uO9i4edtrBfZwYic/4TeQsYn9jRkoc6HjISUBH8ny0lP0N" +
+	"qg+U0XZVmb6XjGENoA8AHm20bJ359uFo/pdaT17QMJEhh2Ymlo/wruvml6gPy4/W1k+YHJofra2rC9wzuWjrp/NLQmcfm45q" +
+	"+MTk6dGuA7KWpKAyftz+nb5jFiejshfVRwrHlonxOELeUkcAoF5GSZeEXSxYbh2dIZP49fKdqI7PrK8P2jvxyAl44Q4MPleD" +
+	"tdU4VWJzJG/84wffteaiOkhj33oDYL6XCnsJmu8PMkE719OLIpRrYgqXFMZoe8cULCmMQSfr/PYUZW2dMnjwlK1ZRXv86t5+" +
+	"G22+urdo8OCivRNy904fPLjohQql5I4dtfNzKPl856ixuY3GsGqxH+wpoR+k9TJL+lWRUkqnYP6o+3r5TrET53EqG026QZOv" +
+	"kj7wxmphLu4xTyUO5DyzF5mKHCwMTuLwazW7atExfvjAgKKV8Ql1dYP3TDycmQnfYDNxfDWGe4Yn5Yzb+wvf/1D+uNmzrMZF" +
+	"QTGpRMNvNxZQmGtWPDdY5pe38c52Q8FrnIdMTvQfGerVo7PL0ElJ/iNCvHt0wn9VtxsP/sPEqvIEj/BRY/P6J+6cm6AJSzXk" +
+	"tfJ6rpA4gI5mTitiJmeC+Ml1KIi/DB9LHHB8tfGbNuvCgYxn67qwjKe4KoyvC+OJdpPBNM6rFlcEnyX6BUjeEW8wGACostJA" +
+	"dAp67WUJAF8IpPALQRyxGyjEZc90hfbhkyqGxc4fo6+v12SMmxgQXD59XIzn0gpZWsmyUHJYDUjJh0PwH8q+7o4KzSAPx5iY" +
+	"YNQvd29RsGfmugkvfpHYNA6O95ZrowuW7Eyrnr63cIB7zNTN02I8qruqdO7OAzQKZXChOQbkoMQByICGtNOqFXp7882/1bzB" +
+	"ROL74YUxGk1MYTg5R+sGz6j/4sCMoMDihmmwZ93bzHl93mtjJ5BVO716AtyAA2dcrExO3Xl7yfRrO0YTQqtq8/hKHChPiPV6" +
+	"FSjQ2qxVVMNnCeP74q70HL87b7yYU7wepyIfgaeVriodTPgWz6nDf1VLbMTvJfkSB4oUV7SXIUTIyCzzISfz4ch8kUekiKa+" +
+	"fuCeKf6lhuCvIsaHu9bXy4MSx+iPHUM137WRIJ4TDiyCCAb7Zi1JFpqmjQlwe2iJq0ESB+BE1nwPqJNyKoWaEK5ahjOAsVbt" +
+	"SEseHnl7E1RBp6LBAcEzD989XBo8cPzqlGvuMZOih02OdpdpwvqVRU2O8eBsoM13t6oTP1tadmPrqFFbb5TNvrI5W8afH/Ha" +
+	"tPDwaa+NSJg1PtU7snQH2c84lXkgxtIRDK3ZtynII82u2q8rEhLpFj7yOtnBLw7jCznZtaj82V8oNX/cr6/C4OeYvmMuSxxA" +
+	"XzCEjKwtlDraKciw0pUcEMLQwKFQqJPaQqnEBQWEQGi5FpLO2jLogbKfctveOqfwERMGp68xDEBo4LjVI4NH6pW9XF/XyLtA" +
+	"5BmW5O6kw4NdBo8Ojpoco+0RMiHGf1ysF+d9XOZql4vvn+FNh/rG+jsPmd1YaGg+vD/Oxm/YCM8IfWCoc5Cyb6gbktu5Gasj" +
+	"i0f0DRhTHpGqn1VaoPEtrdhhXjvsSWF/Q4XqZXnJvo53ti5B/Fd1O3FpFLk455vuMb9I3EFvas/SU3OWQMxki6TiZUscGK3G" +
+	"LsDehbEMgxe0ZeYf812w5YhB2duhX9+wGfnpGmbSW2uT6xxDUwpDslaM7s+w/XUJnOeQNN/QgjhPTfr6wrDumm4dmKZGQ21Z" +
+	"pIQ969wnriA4ftWFWfzRI96x/r3CZ+zMXAKdV/N8emmUs2703JiCsOVTIsMrls4Xz9p77AGJA3C0rAfBJKE2H627ar+OmBTX" +
+	"G054myyKoyfgG6iOH4svkEXO9qt+vuZQ/jhh/5lu41SYYOG2dTAj5errNu0P9c6JYA3UsTx2O3n3jukeXYeOADAquaztXtTo" +
+	"5Wo1nEArrhOqPS9xeHFYqBWVv6gmlT5g3qD1AoBTGTe2HAwAQEnWlYpad/R+IRLhACHgE0ZUbiRHmI52MuTAN2mCvZX+6dNn" +
+	"FqX7947ND0tZHeU2dNjw/vl1JSH+6TNKp6f7f95B3rVjdY9+oe4DJk6fOXfBkmVDowsjVJo+R2yVXTvoJ++bEvdaZcXcadmx" +
+	"oDU2hWJ+k2m7OuJUdJA1gAECx67QJlaMogvQCXF1xAQlFf55D76LbVWhA1x6+cel5fT3Gj8q0MZZ73VTH9m7q1tgbG/Hwb3g" +
+	"6G1TBrM2XfFf1Y4+Uf0GVayrXDlPh1wjJsW7hEYleHtEDw3p3j+ity3HKOyaM669dzqyc3eHLsI4VeHU/7NeJf//Qq969hdK" +
+	"p4oVAuNxKrOenQu8QQS9z1G12TwoJE5S68OZ7RGCFZLEWUoFqyWz3j99xszp6f497ZCCP+U22Lt7bJiylx3LdfAYoPd2HjJ5" +
+	"VfT4PVMH45GaxGBNzz4DPHv29S8dF+IekYNuJW7fsHLe1DEx3ZTdq7t5BboOqYzpFhGf5N4vJS46YaRv0nKD/6Cpuxu7+yXo" +
+	"VEMiouNHeCcnDZq/dFV0ZGG0u8WHm8uWm62HrXKh1cqhFxqKyoZNS+xdXx/YOCVjQ2GgS0BCf9gJDxiYFtTLNWws86VfZlkU" +
+	"X8waDo3Ni5p3JH/E6vLpgdVL/abMWTU8ZtHYgSSPO9kXdA2lm+fsFftTJzdrTeb9Wfc23vmK/fnPd9UoVdigdK9L8thyIU+u" +
+	"oHLQ2CvzsWfee2I1jF+d87Ck5N7HjtV97W+I9qwbXJ159ETt14G5cV7wPio/oovz7fbwF+EwJtXxFXRbCvX3G720nd4Eraoy" +
+	"C1ZysEdMiu1N5erR46imtbQXdyU2R/LHPRQx1eTcor78WGzW3QYLZ73gk4IJ8Fd+Ul11NWswr/U8IV8pNB/n1n2V/3tfdYZo" +
+	"77Z9Zfvxu6072y9z6agXd1Fqu94CgIC7JZeSN42usNypzStGRu5XGijY4oU7AUxEV/hB9YsX1/vtLsraOnXw4Klbs4p2QxO5" +
+	"DRTEaLUxBUsmRmsacMPOnJydnKwxd2zqztv4+e2dqWPhL0TBG1y096qgYJnx54iOVQoGgCH4aG4ZHQsfaqUiTSDpkX1Y8V6t" +
+	"k4s6iOViTbancK/2DYHc0jH7vphXl7h58eREr33RM7clp68d7+8/fm163YIvq3lDUeE09FbI9N05Obuno3fxP59uTSGxGdqY" +
+	"/IWvjRiTmrZtxhCmu1fS1KHRRQlaHB+18kOoSYoeksAvPL8+p5/xh8BJwLw+udvCWidz2m45Bsh1zAMyx3SZ1349pDDeE9VV" +
+	"C0eBoKj0S5+XgNbQebgNANzCpAAGSAEIIKY9nUJ9+9y5c+cwZlKwYKuDJ1AYWgIkJCdEgEKi0Wb+8VvZb2VoSWZmTV/oDQBC" +
+	"Nngo2mD6WCiHI/GxOoUa2VRUVFT03ZK7ha7N7Xgo42n6WKbl5MQeKpdyShOg/2PAfTyUiRR/3wkApV6lCBBL+QEWFJFyLm7Z" +
+	"soUW1f79rq01aukqUinQOfKLbyuKYAGp/vl7720hP4TIBr+JNpiSASP8hrTw6BaTCW7HbzLIlCzTcIoiIJdwCorBZiBpM2pt" +
+	"FzF+MjqFOkD8bdHy5d+sWIE/3wIrt2zZAiAMxW8yWWL5xPsbhnry3x+ldcNQXMpkmfbSETK3c0PFVdTzT9Iz8YzmAKjgtpDf" +
+	"k5moqOC2bCFjJz6XSbjuZCyhDPdgMk13ZVpOAd43GUi7f/pLLuUUJilYQ8cUInt4H+1A5YAR2o3sKypQuVCP1XwBTienfemL" +
+	"i8ScmMwVFMZOBIxwHmfC+7gHO7FG6AMHmCyhfdDSP9JGAMFbpoEwl+tNf6eTq99qaOB6P5fRMtktHOBcxN/pFGp2y4oVnLnP" +
+	"xzkAA4Xv7HUK9XGh0wi8BQDMZQ3CmrIX1+ZbDQ0NDazhKu93lfx2NABok8i7IPMPggrzlTPr9PUly+aMh5X8+ygb2v6xfssF" +
+	"7LhenIehTJbp45fn4eLz5+Y2kbPMU8DzEISbLUvkvl8IFwB1MITVy4kThWM0EF7EK7u5+roo6+x69vUc1LunCVyEs5xcfXvR" +
+	"J30Ce8OdeAJKt1Xow6dG4j/OJK8yJPt6K7rAnXxDF4V/+NRI2PlMysqxyQO8FQCCJACYL80xZ34+DPVQuTDEEKHSo902bqFT" +
+	"EvFn0HPansIQp9eMpevhh3Hj8wZ5rPcZtbpuBh61fr3Q/iTTX+iiyPvgYKdUC8QDNKs5PYJF9xAKDYl83c/RJTQ62tfTp5dr" +
+	"75AEnbvP6BHJaVeaUXYlPr+uoig539/e9oBNF7/RjaVb9wat/wOI7Twq5lcQI/iZIyfq+edMDvORsR/z0Xpg5sNnu9A5FDJG" +
+	"yjkxiFmv8nWEY+uZEfUl9YTH9dk1dIgfiQwa/goy3N6+/rllHmAS/T39NUxqIAkchLOLOFb6CXMvzrtaTrzfetQvOjGoPtL3" +
+	"a9awfudZvgLNWXBCu94yr13E39CWmMGMbJeG0lq+Hu775wOUze9Dc65sNPZjDU8ABHWme2wUO4mOpbzVPCWS8bsghQy5om4Q" +
+	"JngOL6gsGO4p90oODhqZn5i1OM7tHtbcYw38yZJ9o3v3Hr2vZPquecEu612S99xaiz/Bv8Mu0BtFAAjK4D7oZu6nVA7dGlgD" +
+	"vy4enSP9xKlMgBALSdvsw9DJc0FMQIN936QZI3/5cdv5Of3hY2MBs23GztIhvdYHb/1JXAcAMIUCRteeLCQHW0Z0epIeqJKg" +
+	"f2HD5OhevaInNxQSdtTMBVEuLlELMvFV1hA2aeOuQ7s2TgpbF1K64a0NpSFieWsoJhSoaLYzTgphJpzMrzyANjF4MfMRYvFq" +
+	"ONXYtXX+XSlXg5CxQUXGC8pVlNLE1VgBX19weFI/vh/yLK1jDYMWnV0Fi43n4M9XNlrazwazRcCDSAAXqAxhda7dSDyN3M+c" +
+	"CJlR2xMTGEq6yA627xYVkR7pAwuxFKZMTduX4O3BsOn10ciWlaIRrKHTrsSoXH+XDvxjft86ePPim9Wjb9gqsR3cJ4vpodM6" +
+	"hkGpxUby0rp79pfExrzuAoU15CEXk784KuQqPdyQmTN9+ukD2HE9Si+ftv74Xd5H3AfCGiIxXcSioHJ1lJNFI4cqucyV+Njq" +
+	"YDMED37EPj/CZvwEpXiOmsEanuNP8E38OdTC/nAtnoRj4NulVenuwv4ewnwp4iUpzoSVcnoP4qOHdozPIbl3UtnRUlwHpfjp" +
+	"0X9mLQ5lImBP/P3ME0dXLJjYb/WBAraL0TdwC+zQqnsKfFHinqBmH0pFTmDcRP9sWLzt+7AhHsGxg+esgav/uIGyX/zD2tzc" +
+	"+yQmsss22eZjMPdP8/7qLmDv7VU09S5ZbQLfv1TSWmg9XPgTtMF//ogXwqCWty5e3wxhuFg8a8B3+J/wnXXnH3/5xldi+eI+" +
+	"2MyOpzZnpaMyBIq+bCndFFDLwA7rs/wKs2sDxhSHN+gCvM+x47/fuN3n4talS6e9MX+EnXE5oxl+cGIMDLTsjT2sAXQHwAPq" +
+	"CNeoWbBL7Ym0beDrL8ExjFThpHWU18qUnuoA1x4PjMfXMbf49xlO/v8j7r/Dojq+B3B4ztx7d1Esu7DLigKyLOwqHZbdRboo" +
+	"igWsFBUVOzYsKBas2Hvv2FCxxJaoqIkao0ZNYiyxxl7TNJrkE01UdvZ9Zu7dZUHz+32f94/3zfNoZDj3zJmZc86cOXPmHI8o" +
+	"S1cL1DraemJ2WmiYWkEWtlxoz+W2mO+JOFSTaUeLO2VYNUDf7dutMKTiNvcLKSaZfM221s7clYWSrFTKqtIh55L25wZudw9N" +
+	"HdmKXIHQEbvzU7xwjPUsjmlVOLBF40Wm7gs2V8rKd3wuasSyoRvdE1wsRsxExD0yQWYx1pHDtJ3ztjcNbhbp36BubRdX2XKF" +
+	"h3/vjFWG7NnTc7skGH1qbFcGWJr3GLWWRiX9CXW1uiRT95RxQaRiwCcp8TLyfd1GzQYu/frd+rKKp1vmZMWLbz3cbP863tvb" +
+	"9ZNSJZO2G7NJqaOjwLxqew1tk36p9/PnhLeI0SU1LsHaqXt6NWLqNrd1n34xAdbvb1xWLKozcgs2Lmo296DoK8DH+VyFQQiG" +
+	"oQgpZIKYb4AelvtK7WrWLtbkGw+lOIm1h4O3vZ3yJX6KsyR5NoIOttDLIz6XTKfrhZ9yVrudQX/L0T+tD5Dbcz6xg8EEMh0h" +
+	"jJDtJp8r90MB7C0YfaDDkpkbPTQerAy8kRVlZ/9gryBkclkAuAtyA332bcJD6tRwG6D2yh7WwKNW/UCe7+dSu75fDXk/deOs" +
+	"tu5ePvVJIFz+Gdk0w8mjOOtveTKPiMhBtpdFpd8npeh4ub9X94m11QD16o34RBvn5yUXBr5bgL/u3T6kYoGsRW4lfY1RIDKx" +
+	"CqpSmXi19GhN45xQgyVClRKiCpVEcq1TmqRHBQdHtk1soA2MjkiNaNwipknnpCbxAZGNPX0nJ1qvVSFS3rhHTW+9TuXbAOcE" +
+	"+ieF+jWG2u718lWK2i0SurdJDazjVl+r65yqW1+VVh6NQYh/Kagk25pltFHr6B9g/wcd37a0lAwuLbV+X1qKOy62li5+9+7d" +
+	"O0H17t27F0ig38umOX3vXonBGYvwSMTyISYHLkBfIwTfA8WKtDS7JgfT6OUjy3yJEEaPeSs+IvsT1WQvf+wvtuQyDdX+YsV/" +
+	"8dEWbIxo6R7eAJJC1ev7tcrx7RGdGBsTLvSurVX7KsBLvaMfLCJftmpUr75nx26RgRQ361tQIoFV+rLn9tSJFJw4cYILYGRY" +
+	"Q4i4l0xHiOso/IE45I9QgFpnstCijaEQJr0moI5U9pZArfJoCD7gDbjwsEwmyHVB7To0/aYsZ29G9uaWvQpDjR06GAkhy4qi" +
+	"4tSeprDMzDltN56KbnKsWYv8LhE9TF0yi/tRX/hSlIEvcfeRH0IWk7mSZ6TnSVTDaFQaD4mzlgW2iQ+bEhrm5pXTJbtPh66J" +
+	"ISGZcNAY1TSqw5HLGftlniptvbbtOjdtU5gY73G0IYb64cMLIuvUddcdjWvfld7BHkMIp8inKQzCBFsUnFLIhAwm273oWVVu" +
+	"VRiEibYomGlvtx0gtfDv/CHWfp4kKGTCCBGe1IKVMpXCIEyyRSFkh0ejyf+EEAn+NYMfac/hRnMi2M8ve7dtk6neSn6V0aRU" +
+	"FoPXK/TCIvbWOgT/REptNjTRdpZrztdX6IXhrD0UPeKmU3jbC1kM5+uAD8WFeK69na/vaA+T2heQWvxWrr9CL3ST8H+OPBHY" +
+	"26ludTcbfTVqmipdpfHQso3UV8zAbKGG0IK3+0C+n/x76pvkAgjc8+WsR2T26sIvM4u6LRrRgzMkmtdu30vez8rdWnikz6mV" +
+	"DwE/6BflXjfE3je/36lvGV8fARpuu40vcB1Z7KVZZHim6MREyZJ6h9x1DeXm2JLv+8W19k590bhxTve8tt6rIoY3CEwfNXVp" +
+	"twmrB/Zpc6qxXH8kkM4jw8n66i72Bf60L7qGXC2sZ3ktaE9G2gsWeK3J12LGuS5h20Lkjck7GyIPQHn4zIaY1EmzC6d3TCCl" +
+	"61YdmTLXzgdcLa5coRd6SuOI5HwRoNGklksEfokSxXqpcplOTyvtWIwyjT1ljtmgDwOj/UemxY2RLPpQLqsstiOX8RPUbcfs" +
+	"TR1S0Oefntt6AUzPTO2SMTSjCUCdmDLfup6mvFaNtGXKmD6Tyzo1y9F6JwdNUARnhCSOCT4weFdCffBqCalvDl68e0BfLyB8" +
+	"w5CFE+N/K2kdN3X8gFPfR+6EeqAnu86NyKlXw7NF0UafupxM4r1aLhFsznqJPMP343wRZu1B+BmKpCMTPj4uOpX0R5bo2p7l" +
+	"OoiGZZhUtAynnILRF8F8g3Y3qowrp+NSg7duLdSu4T9g2IjRLVsNz9pRVm576xfWCPdIGbG6eMeNUTsAF2n/WpdWdWDN5w6Z" +
+	"vGxuXUujyKVD8s1+gANa/rpz6Fif+orP8c4OQ04ldmsxy11AjrEFsTXrI64ZP4GNbSpCshCZCtVCDaUc8QYp+ZF7Y2DFgNwq" +
+	"H+rilzuuj9oJuMgvKDV/40Dre1IOrctO/9spfkjO9m3lRxK7N5/lLnQYS5YeEXLe14HEPlpDyvOdQ8eKNEh9KfRCP5EGLp/r" +
+	"iAANJLX4GD4QBSIkOAmc065pVDs9BreIuy1EDIaIvVT8Zqzo0q5fi9CgdkeGPdk3sFNQo0D/gIVPX18/9umGwiN9jg375tN+" +
+	"0zx5n+jiy5PTdjaNymvjWc8txftI/RXTjo5mOUto/0ILhV4YIMlKR34/AttLhHAoOoI4hKjvwg0nHTnC5tK2VXZHoOP4QoQX" +
+	"cvhJNhvKJalCLda+WNJFVn4SnWPbN0KUTIW0KN7ZVmBTKkm3JPV6yj1gpgKhoGJg0Bv8TZTR1BKjcfnJfqm1XONz2rVuERcZ" +
+	"39hsNLdopvAG3CwNklO29Zl5bvzgz7uRt3XKauPFQ0dOaj+HH9aRr+Oj9XJT1KybnR9ocM2uWb++j16p8AoL7aAPqtjbCoD7" +
+	"tKIdOVq0vaE7YJ/s021MMcE5oboGYQjQVHKeXynWAQtgF7MKSVD9lXqL0bEm3K4WZV2G/jYuLSOZvIUmZbEr/Zum9ilpNekJ" +
+	"P+j9SnJ68afJfh45oJybU1BQnKDwM7G7SaLiXuH/ISWLpHWaBfHpv1wvF10NFrNR5IJIx6zJcEB+80buYYK7yb+xyTO+T0hz" +
+	"j4NDYjripSmdUlpu9ZRnD2m3CQyBHk1i6qmylbUaNG/e6Cuv9PrtiApe7OlvCWy0fHqif6ftIdFLm7bHNhuaTg7xXzH5uGbX" +
+	"zUw+2L6D76O6zCvhJ3eIPKOUyoWTkQeL4rzrAUQajR07N4sdNuezrZNh+vIxWa1WatviwKyUoo7BwU1CM9Kajj2wacLy820y" +
+	"dK1fHUo/ihAW91Y8E7na6/aymY0y6IFNOFPPFj97Wn1xJuB4k0bq0MA96VpQrMvstIccgWZjV0UPajTbP699j6L2TUdr6sKZ" +
+	"Jlp/Y1jwkVhP8je5sCk7MPNI257La2r9es9ZkDXA2HVRf4SY7v6fEMK1RmaquwVxge0aTszeLy6N2D/tXsd+svOyxoP/vhv5" +
+	"buOQUU3Jv022xQJelm0eOWpjW9PI2AFxUTG7Mr/ImuRZ5hrnn9Ai2jz8oTEFQ61drfT180AYPWJan2Uh9WWu9bTto02tveuG" +
+	"NjA2KVjZOiAwfk+8VoWxQe9nCeyUlNGluV2XUVoVemGkKGdwitRCGJ3lP8fXZGslP6YpQA2mAPVZXIfmvRV25Rfnk4X5xVJe" +
+	"URHWivTMfq9UNKKm8zB6GNV+YWBfW6OqUs87yi/ATa27j7pXQv3oZP8IV7daSrcgt9B6zdIy+kfW6eDaMMi4iMM1Grqpayoa" +
+	"K4W+/oHy2k16ZEWpDeZ2Bj1APhbi0zPXNh7Rq36bl3K5up6fV43aXgFuLhJ9xANfs72pHEtA5ViIR35xfj4dCojjEHYxW0qE" +
+	"4D8XB0nHeJSN0YfFNVUdoQ8Y1dJuqzNFWszVx/aXi5s0slp1tW6+3g089YkHp8+ZqE5zGtTnuGZ0T3FIviF0SJiLDyzKH+o2" +
+	"vNqAAJ0lv+BrtltOdJJfRDoBHeS/wz/J/mY1fyulSVQu4mHPg5aUslsrTC/Cndo+UfGpAT51smt6KEMsPM7k2ifV6u7q2ahN" +
+	"klc9YXrbRimJUUOH9p4Y3dnPU1VvV8cu/ubTewuatBo1w9ylZg2tjzjP1/hH+JRchlylOqI6qVxnAqhkfvoocyTu3C4mNj09" +
+	"NqZd/uKLixdfFP5OzM5KapqVfeCnTZt+2ogQoNn8UU4pfMU8Tpbq2RQN8jmLc+L14VEBvdp1bB8f1rB5SsJywVzUSV1b0bjt" +
+	"xCRvD6WyxRR21xDCf4n7yMYo5MJSdNK2QGwjtXAf2xmx7d/b0ro+x9fkYdK6Vm4kdNZCgZacYnYI2zTlbLdxaCzY4aLsleDZ" +
+	"JFkXWbuu1q2hdwNPQ8IhcV05l4Zu6hrKxm6y0P/7ut7mX+IvZVFIhhBw9EExB3P3TRw9euJ+/iW0OH2aiDERMB9O4Y74mP1e" +
+	"AuafPYuPSb7ndwjhQoHFvWl1CgPmjG7uHriwVBsy/9OhTb1h513QQj65R5avY/CtmG9UxXJ/SiXWMEcNZiNlF6NMjnV+MKGe" +
+	"2rvUL3j+gViudWl+hJe8VB7Q5U5frtegkeuIh4RznbAar8hoP24oeSe+9+d2CSoUUB1vNY+Dwu5xgCketb1LtcELPg30dwts" +
+	"KpeV1lIFmWrXKtUlzR/jExrWmDNldTlBmki9jZyQ4FIrKbLsvmcAxsGB35JLsUMSjLWYr3O97QXXWvAVY8H+b94EvcWMr+bn" +
+	"jO+U0rLDuL5BpuSu7UakJw/v1m1m3665Me2bNorE3/UTfHcoo5ol6ZqE81vbJo3sGNcaNNqgMwFemonDt82c0raeb3hM4sop" +
+	"iQhhNM72gusn+KPayAshxJhH4yFahJyHO63IJvNlhq8bDhqb3oLjkwKeBiTxXIu5n4xv37YHGdSjbfvxfN61k3vPFSQnF5zb" +
+	"exIWA9n8/AD5bvTixaPBdOA59EKA2iLELRBUzP+mcHdz12EOG+yWEH7rVz97f5qXJ86csmnVluXHBRW5SzaS52QmNIc2ELjE" +
+	"K/JgpKNm9A6Bxehp9Rw2ULbRmC1u7kbMYTlsqNtl0Yj98zoEYRzUYd7+5UPSGryBGCA/kW/J1ztcXXdALJjJVdKKfPuuOj4w" +
+	"eoiEUQ2JOZ3Z3Q02lDZIG7K8EuGIRV0E1TvyLWlFroIZYilK8jX59icgEIMQRl3ZOH2QCunECG3nzVvD0NvT0YibKB53NLok" +
+	"qkdm/sx5nUbVclFqO+zv2MjQtGPLqEhzvTZJOMPNaOlXtKjXxIwxZbNWku/JViiB/Kwhcb0s5pZNzPVUSoQQh8YgxA1w+GuQ" +
+	"s48GH67qm3H4ZZCtIW6M59E1Udu3e7u2la/ulxukUc3yaN5iRc9dLYQRQxe1b5ebmz5s16ayEamy/1NMeTq6yG3k21IZd9c2" +
+	"AK3gHiC4p0Pzvv2gGXnfl7wl7/rifyCDfHKNfHL1KmQgEM/weD1768lIEXiHXaJ22pENsuA6zUeRJ8RWtnsUudlkW8zh7rO3" +
+	"v/wpLsIDr+/aYla/T5Ji9L2g1tycHrMnNvNrYh7RvU1sPJJq5acKtbgfUV0UjRBIzia5TM7QQ6TFX1kpdE4GqFwWoJd2KSok" +
+	"fERAnJchzN8fIv0DmlpP3loKjZsMHds8W66e1SKli7G7zsfc6c5R8uLEmBatt6VhcA3DnkFpsUZPgMDGxvZ6T6us4DdIbdMs" +
+	"a2pU8PIU/8iuY/pvujD/+vGNAF/m7z146+i6uKhnCGAdfwVsck/xvsSohHV9+Cs41XoEIUBDhUjYIvuNvcV1MmjUDg6zqIX8" +
+	"Fo3q1ImM6hQdFhITq6if4T2EXBBWuqn8A0Jyg0K0fbVuvvXTGtT07p8mnRuZH2WN3Y8itIXu1h0f8a9E4J9EfwypVcV/E4F/" +
+	"sslY+2InP41B9NMo5JoE3I8stuPjyqXfyzXhuJDz/Qi+cFxok9nhnfqPxIUf7T9Sgme+F2Gf3fciTEQTbNGO9l32dk0Elgmq" +
+	"j/iJQnEr5PmR9gj8Odr3ETxhsIKv/xH4cNwZrfxIeyTujPbZ/TbCP3a/jdDWdp7RP5wspj4ie7smAVnZvA0npVXaQ2ASKbW3" +
+	"C/8oDGLLh/iq+YdC0SNG73ByrQq+UKhNrjno2uVoD7PDs/axjvZw9Ih//BH4SPSIzivzHfUSwhQGoS3ayXyCPR0+pYH8SIVB" +
+	"mPJB+3asd/iaErAXqWWHd2oPwY9JqtjOL8V6igd+eOtTFT9tFyErv0OlqJH0+0Ann1YonOJ87e38fkd72H+0R8Ipvr7kZ4ng" +
+	"yiUfkkETwt/gfEUfASm1/x6/dPiYwvkXNtkHvieD6Huq9vsg/MzhvwnlXzjwBfH7He1hvCvn+xFfSxgXyHW0+zpkSQ5fRxjU" +
+	"4fcjLPo0mB6McdaDopp1lwkyra/Bn3Mc+X3ZieED1SiXyWoExHo1CvP3j/DXN93chzx+Tazkn9HkFkDMtpjD7StAe/yrrg3d" +
+	"ovPHNsuWq2a3aN4hNquxB+Bq+pDcPtgivnFfqEGV9qi2fk1ih+jTK9Vjan/znMd2f/Ed7sdKXwynkual1MlHYxB9NM6/p+OV" +
+	"1XF8F8a/YD6c0dYdVfCF4y0fhY/kXwhjP+LzCeHyK31BsjqO9jDmC6J72nbZSBabjgLs2xeIcwhqQZxx90rPb+Vmhw+V7V2g" +
+	"rRNOfg2p0bAr3mj9Jcld8PonJzrs1YUljm0vu31TS/3QlGSYEN9Bpx0TCObP3/k0cvX0DSDlRUUj+mVmtNL3Blc6qZOS/UR6" +
+	"5NQ3FYoQiFRogW1pGoFRweu0VbZcbaS/OR6iDAH+sLaGYgkeAnEVZzJq1+CF9dzAB9FhJGUJSdhTYN+F4VQZpAYOrThMvuIG" +
+	"uwgJvXvBOusvFl1NnanBSv2/I/plbnCiZ+UgF5dB7kssNpt9nhR64bQo9/yXTL9LvjSFXhgiyf0WoYWj/YajPQxHiu3WHVXg" +
+	"w7GXtJ7bZSPlQQ78HbiBCFVp/6ZKv2J7jKM9lAuUbZX4zBk+1I7fuqNKewSeX9mvS5SjPUzCM5WcF0zyMwq9QG9f5cIUXAiL" +
+	"xXZ+pXyDvV0Twr2Vu0jtL1yiHO1h3HQRT8VD6q9ztIfDK+tt6t+yFjr5twyif4vNX6G1EIHo/8Ivma31IfNV+rg9+K+69n2Q" +
+	"nVrYm9wK2hZ8qFPnlKQ9KT7dtvWA6D2ffd28QcAg6Pu8w+Ldv0fVU5nu9849cr8E2f1rgofdvya0RQdJAKOr+0fpSoBX1u72" +
+	"7/j90u8l/Ys4NNV2nF/DPUM1Ra88ROn8ZCoWrMA5OxBY6ohKU0hvMHlokJg4j0pZXdHrGKXnamTGNJa5J/gm95rdO6rbnjpl" +
+	"tYfX0xgbNa2vaNQoTi4ztDpzDoL9kzeMKRCy64cFD1fIouH3WdlNAwqNDWaTvHa+8btbmxMapehraJOnd0ht3aETGOocTT5E" +
+	"XpHXA+JH4MQAw7KB2tBmPt2XIWTf//n9CoNoEVS9E3LctbH90Pa68q5Nar+uMAhjbXust6q2C1EKg7DE9pI0qAZP+wmDOpyv" +
+	"vd3u7xPv5Ow/8yPtPjWxV9peQRicQROBW1n/dcBLflGFjN7H2Wz2871CJkxlv5fO9AqZUMx+ls7sCpkwjf0snbUVMmG640zf" +
+	"Twhg8dGVhqt9C2LHX52focqpHNZy7Tr1atuuU3ykVwOPwOCESfssk6dVHvvr8aq67mNj4j1L/DFo/dRRagPGyoP1O1T1AERL" +
+	"b4Zec5MFf6RiXigP51ShkgBopOLeFjdjpLuHyEM4qVGot9KzXYf0dh3SPWtrQxt18J+2uHF45uY1iz7JDA+ZPw26bm49OPPm" +
+	"rYcPr9+Om9F68+UVG46MA/0Zmqb5C3JlXplYKxR9gRBnEhoiX4SMbqKr9wPznQX1yGXyL2L7xndtETg2u+/kIe3S86KDYiMa" +
+	"5UzK+q7vuHBkOzQsI2Doqr29hjU8aAxr2jvN2LjxwVarDz+y2eznW4VMEHPCV/48h/0snVcVMmEuEv0BT/gGwh/IFakRAj+s" +
+	"pNU53JQK7O/rJoZVKXDr+QsXzl+wYAFZAmdeviRxL4X25HtygXxPvgcjGCEKjJtIAZlJZpECWADjYLw43m8R4p6zHMkoQE3D" +
+	"zIyCOkDJXa54B4GHIJkc37VrlxCUeiT1/QIH/BUx5xN7Ginm+fE6aN178CD3M7y05lWcFq4Tt2qw7A6LoaeocUfrXtzx4C44" +
+	"ugufR9Xxsrh9lk0KJuGOFPqgde+uXVxtfL5ioTOsHCF7blPuMiUAd8Q3rIHCdesnqBqcVkyspuUuU0pxR9yHG2mNRoBOIsRd" +
+	"l3CpgeIC7pr1ytNDWMOPPl2RLlx/d4eTcjXbYTGjErQcfRoKOiiEb4n50CHcw3rl6X7OG7uQTacr0sVvFiDE1xBeIlfkw976" +
+	"QmQCRIWCnwxovZJQiGLR/6Ci/AT1O84vW9Chw4Ky+RB3MDVpwNix348bd7GwcEBCCv/F8FyLJXd4z+jodz9xDV19IrZ/vb13" +
+	"7+1fb4/wrmW/yxeuO93lC9ffBTlqd3FHuGVi5LibwiPSrND7IYWbr5sC+/61/fn27c/J92+h59u3ZDNOIO+OHgXZUQIwE9Tk" +
+	"ORlPnos+GXxT+JHV2nU3suqNRpi1ea91m3Ufb4Yt1g4VN7hAvM+eF7wWNwLJEKKsp6sBFhj/CMbvJ1+Tr7kRFYvwcWszKXau" +
+	"DjcCye3vbJVGvk7FGppOjxth9cP3q8IAYyCticLgk9YkimkZbrMM2XNzPxIeIzXSSq8EtPb5tU+v8+zjQsitWAu5rZoNGDPm" +
+	"4p+XCgvzmrUmExzzv2zZMlzk6hux4+udvXvv/HpHhK8rbByeGx2dS/+S4jzr8GXIBdVCCBTUx0vdO1qTFu7eHoPH/ogjK9Zw" +
+	"A7gSqNl/7YaKf5eJNArthZdIyWJq7AXzlDo/vcLsq/FgTig/mcrDyHliy3YyfMGDnRs2QnLGxAWdjJ/1HtCZj/mmYhG+T85A" +
+	"3A3IeNZ/NZn6zbTu0avPTnPU5MN/2mVTqg+qhk7bcDLpA+vxA6tWuL6MhC2V1giPl2C1kmzq4BoFJH1wszL8YClcWUY5iI2V" +
+	"28x0hIZJnNb52k3Fys0albgLeQV1WoU1amTx6FHQrl37XkS2Hd+3HurebvzEVj2Xzer5w55ZM2jyRTut3OdSjTJ3D42Hm1wX" +
+	"hamDkwYOLl44Piy2JmyrF96iaB9XS2hKXv125CfyzYg10HPl3bvQJHOZNAa4JVwXfd4myvYQ+f7tjjL+ATn3Bpq8C0Ic7Yf6" +
+	"J1BdVA9pETIqo9xY1iupUA4n3jQoteKVgzdo9945MHxIuHbWieMZ43cs6zBqJHxrXbi8fe8Z8B28LEO2NiEhP2RPybPq8YTi" +
+	"KV+vIPnQZ9rk7T/8JfktI8RcjmxOjVJy1V7kBgRug0ByYxs3ZTl8RRKXV0wR4YV8sRampByVjo+UWqX4XRmsLIMgcr2MDOKm" +
+	"LqMf0z/LKiZzUysmV8YcUxnhJClRamiyZ1GSqJBwI5ZZD2HxTS6VzdZMh9FZ09IstFw/azkE4Bqk52XSU0h+P0lQvwsS87yx" +
+	"+eOfsLWvw2zSyur1oNR6gla6eQ2j3Wo59aoMbYMGDXosJ4ngBdPHxCRAfKdJX1rXwCluRIeU+Li+PawllBb8z4zFE5Z0mwBg" +
+	"9eNGSPzgL86dc/0bzr+M3Cgrg8AybiqpwOtIBXzFxtEXIX4Oo6uByJPS3izYK6zRTZvx/25wI3+1DjMYLPBHGfm+1/B27dr1" +
+	"gncQhP/BbSTmpBlBRQa1+i1DyGkt5Q5JNXHh5MY2ckO4vuzdCSF5GfpwPgNqgKAFI9eP3CF3uND3L2Hz5WV88ftJy4TrDh1F" +
+	"cwDpEQITXXEPjaSclNJ2QO177LxNXCY34HK7hAFjxgxIblNG9VVcQt9mKxyaim/3v2WkqLZPZNnZsghfV1IEM10bRpSd7ZQZ" +
+	"1pDcp/tFT6aynOZYXlkbU5xfulUIyeRruOiQTX/+J4ceEfd4cSW4xxU+XDtxKRDCDNaD7TuuIu8FiKyshc7gRRLgFEnAA6xr" +
+	"qCLAbZZJKsVpLlyRP0JuTgMO+OiUWJTGvyo3x8gP5qNVGefnGOvFj8+GK8xEVfr2Em3Mj/ZnYuX9qm4ZH/ZKbuB35IbTvsG3" +
+	"/4++/7dsmfWh02ogBOi00BvfF97Y3x2dxg2sz4Q3y9nvYvmHXE9Zf3YbyrEHb2Eg17kbWT6wROB6LK2bYDG2qte1dOK9GQP5" +
+	"kFpa5RBZf3LMzzcgbN9rGPuwppf/YwQwXmiOI2UKx33f+CNHZIoLYv+f8C/xc1l/pgmM7jqDXAezZ96dWFo6UdafzH7N4ts7" +
+	"8C+5VAlGaxIMRg2Xaq2JY69tfc6/XI5/elqVVncjNY/NiSI+RrchduIQN22tED6P4u5Sr5XRklhX1v+xv1fNh2T2631hAb5+" +
+	"CFXry91kMcoD1B2fb72O4/iXy5/+hNm8wAgyCze2dbK/Z4MRR47suYAw+lJogW+yccrFkdLbFuxbXk42lZfLFN9++61Uk4Yf" +
+	"DQtlKmbHKOiNu85khIVsxxamkNcD1m74AM7dvrsfFMFqgCsFE++V9nHvK/tkL8R2HDlCPuM6V+zm3l+w9l2GOAnuvvObOrUT" +
+	"tB0eT7/w4gJ3fylCUszTbkS/RgatWutGTaZlTOcIaAP5ifeWGRg+H2RgubaphCqNtCIb3ULY7QitxEq3Z4NS566UAkc48R96" +
+	"aHX06FFSAn27HhswcEjexHkFo37s2nVIdHx89JA5TZOn8XNIIcy1/ky08MC6WKgPtcn/cMfOa8cudPP6dMa+3F6Y/CRsIWP4" +
+	"4PC+fSJCrM+JFmJixxeNQohDo23P+Z58EbM36ZtyPzFqgJP+767S+ZmitPRCxxRljNSY6QsXnTJK5yeH5k2axMQ0aRJT9vAS" +
+	"1NCrLl1a1aj5Lsgv+25C+h9JSUlJnJz+XfHV999chuEutw536EBOVJzjmuxayGKE7vHv+PlIzmbE0aspyt/oq1QIcp620KOc" +
+	"nO71Ol9TVACdDK2f3EOtgviYmCZxx6HDDuxCjv9Dnv5ddHL1wonjerYddHgtGQA7/jpFlvdt3oQbRikgL57OOUM+IS/SY1MG" +
+	"9V94vdeSyW9f9YsJXft5dHQGja4osr3gCxktddiNFj006g1+bpGmKD9vCIiysB2KFltkY5dOuGZjpIJeaatVGrMxUq3S+UJK" +
+	"BK8OSgZF8+7dyWV9wNjpUA/4iX23ZbTe/Ws7kz7qt0dGt/oq9drDh0kJzlW5KYEowgx+/J7JU8ztrvUfcWAR+cf6JW56bMao" +
+	"EW1buZNyV/+cJqELzpeLurA3qYet/HzkybJqR7G1ULCs9BYPYyRNfeJBwzzc9RqZxcNg7r30HDSdd+TkwFq7Gg5ZPwqDcOqL" +
+	"r+IwxMZxwJWNGG3927qIvP3j8oKaV/6HH0CeWnsJAGpA02YcTk5GGI202Xg/fi1yZdmKpSAPypEcmwq5e5RBRXdvY6RGQd2w" +
+	"LB2hxYwH+3p6eWs9vduCfPiwMuh28PSZOxHk+IWUumUNcnGur29AAz+fgAY4ZtxY8qN1EkDjhR3LAB8+mQcrZfxMn5lt2uxK" +
+	"uCuOeSLx5d/y85ErMlelQU6NBl+lqjbIKKvQY79a4S7HSqz1NUWFgMQs1OMA8VpPb29tPa97l6cyjiGXHv99c2jDobNmFn/S" +
+	"jCwlA2AohDCWSa6r2X2cG2YnMXzOuDbkVzJ8UHrf/q+a3yQVZHkH6ANjyOu3tyYPbLZkQrSp4DgS60y/4gv5FciVShEwCg16" +
+	"Si1EWfQWhegYpr4Yi5oaPwLP4hUD/OGQ1tPHS1vPm1xavgIg9ey5URG4Q+zUydOhL2RuI4L1JbfC19e/AYDW19/LunpR5tDf" +
+	"IATg8FkMs4+3jKq1WVNv+A6ZDE7gROtX1oMAop1pe8I3Y3LtVSnVgjJK66tWUmb1Mymj/I2RvFoJRTExTeBXcpvcsF66Btz3" +
+	"X4Hrcyo3z7mGZ8+S9mfOn8cLLxQWXqg4hzAaZXvMK/jNyBWFUryV6wFmS6RdNTCvj85Pzkk9KagBb4yEVHEdvCHIe+7tssiA" +
+	"aP+CfH2KbziA9eTj27P9Ihv6/mmfes44AcjOinIu/M6+EQOPPyLtz9z+Aa+ErNGfIMQhRFR8jKS3gipHaNck7pEaD9qzTMfC" +
+	"Q6neEkvUUsV1PSamCTnepEnM+veAv7/p43Ua4MTSiKF7oe7CMrKp82s6evyG/m1dfe/0E9xAjm+eA+jX9/rQd3eEgC1S3uN0" +
+	"2yu+A78EuaLg6rxJFQJ9eUDFMkrgpa7VdMWVCosf+PrW96Yi8vul68UTYcsWqAk1tw3qScpJ+ckT3L/2SUj68fq+BbN3XNiP" +
+	"swnA+4oCbsGAPOtOchQAckC9SqQjw/aET2H6y4/ynokOUsWxvC1KprrUSg8xQ5BC62eQ6cDUpEk03ja0c1rFdzc6F14oI0vK" +
+	"2vY/wxUwfT18Ub8SGOBy4R7AHevonQCfcmEVV8hnBQW0r6a2VzzmJyA5O2HYZ53yuNnoIZdhtYfW187aHjExMeSvrdsALl4H" +
+	"+BcgCJpvIxoSyL1kc9vx9hl9+Sl8bBW5suM7MOCD1rYkWeJf1o9M9bF+NP/nfnie9RP36uuAw6cAju8hP9CO+Lz3q8WOONTe" +
+	"9kqI5n9FrkiDUqquotbp36A3yAz0vaFZE0nNT7awRk5Fp1VviaLBYyaqhjX0dzKuXGJ0a0dfT28vv3reWDmzW/fSlfPhyZcA" +
+	"75cv3wbL5lvhxFf16ssUq7wE+HQT94N90e3/r/CGVm1gy1rc+xF+fk4ghdOnQxT5bp4gFMN8l+9eAqyOikyyhHTv/3U+abJt" +
+	"jUPu78tUyJtFZInr7esJjCF1EtnS/+yzyt8n383I6V5K7j89UbFiWdmS+WUVG6hO8CWnW7eGeDzhwfMLJGfrVkgiX5Zt5/+2" +
+	"XqDTKvJed9srfjk/EbmyuDqnGXN36kniO18l20opKRwNRk+SJid2WdnSeWUSEQ/mFF26djmucjoqDmynw965i7Eho+iPsoAN" +
+	"/96dQ/5MW7zdToNM9f8LGvhf3tdzpmEe+V/bJdsRoCa2J/xy6Z0qS3bBmFPra5AsJ3mkCQZvIw/LyHhyzT9062fzi3EAn2sN" +
+	"Jr+DO75W8TU5ER1dsrJonn0vkan+/7mXcLcr9I69ZKLtFb+En/+hnhOitLSqlC810nzVKvEHgzS9cqrxk6gg+Hp64zFkO3EF" +
+	"AgmXBj64SD6FQfDUaiXfj0s78a2q2bZ3kO3r6+9F/yh+gWjwv1v0xfyNZBFZl59S9N0P6aoASkdL2yveyOgIr7r3aGhQNbN9" +
+	"TAqtrztTdgrqZZAbzBYznR9VgB7U2nrMLPnDsDbe9QKGLx6CK3inaxMGka//0tXDW4W6STAWXpH75//VNmTUNPD5Hwed4fAN" +
+	"gOcwh+xfAngv7IB6I1v3UQ2LewtA5livvWPTxNbtGc+zPUnrZNO6UUWl0Pr5O6bF10T3YTlExsTEvAyAjXCKdPyZvL14NkEz" +
+	"FYA8J2/U0JmKGFyELuSm9Qi5s37RDzfjW7ncgjMXxNyzdC5eC8OQKzKh+KqzEaCU6fyZvWqOB4dSCtAbHLNkcRNnCXwN1CBQ" +
+	"OaYGd058S1QYwy/Qv39MjU6L83g4Se6fN9in7BHQEk3ipJ2HdCAHyF7HZMXDmjpQ8etasxfu3LvrJ31XlFlPvwP49YM5xFdS" +
+	"hqb1ZeMYaftFmMivQTJUGzVBCLFZs/iL/+PoZilpfLnM3YO+ppAFgd5k1kRqzJI5Lj4iVsCkUTFNAPbTv38nbZftA7h4E8PA" +
+	"ia4yQD+51ccX4cTS8P6zn5a1ah/upwVxb6Abnj9ACNS8GrbmJsC1eaPnkt82AhyCz2rIXn4DffoCJicrdnJZ0wAsBi/rDXKA" +
+	"0k1rrYlr7cs8E9JqW5gIeIKq2nrL3NlEa33Zol8nBngAx0nEcfLH2RuWmBjwaJNG7rDX9AHQklL1HUSBiVy2LnlbvOvGjy0b" +
+	"6/ZBQxOY163bhBwy+YK/hVxRVFWZBF9lVIC/KUrLTC53DRZtMaomqkonNJdsEJBDPiklKhhzOKtnbveYGSl3VvZvvuAIdIK/" +
+	"rD+Rn8b1/u6rjPkwz64MyVtAxyNSfqroMgT4cLJ8bPsevaM7klOkYHTPCfdvd2/hhTAqJ3uEbH4z4pCBrqoqkjMAMujrgszg" +
+	"7+6mNUYyaY2y+FH1nAjsgSrgl6NG/TgKa0BlNk7YN20xWQIAzY9+CXDiS3751ylpp0YsuEt+4GtPee87EweBT2yTQStHAMBi" +
+	"WHzxBsBllZaQn5LLenFC2U5yXsyj9IxP4KcoDLIkfqF1tEJO///uG4QQfn9D8LLtl9Mo9gYIcVjrq7eI27uH0d8QZbB4CFFy" +
+	"P7VM49EeE0Ru7ge4eJsDX58YaALpE1dZQ2ON0MQs8ya2HYN/hFvt/XnICG0xd1FaIiTES7ozn72bp/lj6yEE7PCs0PnJRcNY" +
+	"4c6YRucH92Be2bM+ljMXv11hio+O4YvIIGsD/B14Zn517hzsJR0tFlbHuOJPik82UqGXZSGkkMmaIvofvD/Kv7CNkw9ESoRA" +
+	"pVUzNlQqtKZIS5TWt6IfyEk6vILLZMjPmNwhx2QBX0yCREggp8hXk7742oEDIflApHLGoZLT7C6RFrMzmugvrc9+ASC3nTGN" +
+	"aT2JosJOtKg/Rg3Na/MhRbDvRjWi0u+KZ0Anuur9B2VVUTpRVwWrSGDnu3cRtraxGslN22eIpzTyfiqjmWNRsZKfg26xRDc6" +
+	"JrZTseAfv3lNtxYdjxybCuTSsG5Khf+Gz9O7zRt9AyFcUWFdSH6x7RPtVMF++vAzOawMmVqyP1TWPJXavf9tS/LAgb1HLxzz" +
+	"mYeHWkOSlhYk1Ow2bnRiUUpyNrQgn59GVXiTvSLjPOQGs8kcwM6zcpmBHX1RlNxPozK2h1UT04Gci/EJrOF+5iLkDyEYWSPM" +
+	"TcAoq9lz0dwWoRnAN8/aD/Bj5565ZO8kSIxH+P0a6zlUy/YpkrP6eYwJ1SrBD+s8RM7URrqx+/uKFkFBBlx8Zl1Zp/1lEHTu" +
+	"fFbnFeN2k/TwsNCIgWD0HfnldmhGLp8dyWpEYjSKqPhC4ShyRZEICWJVPqadgK0ZRUw7MFBd6AlRiE6SR6SFqim9zk+F4KR0" +
+	"VsdDSRQJh1anT09pDOGgt5Y8HPiq8/CCgbuS/2rbDI9ItBsM+IA1lySBcjIEn79SMHglpOCRE8F464r1TGfy/u1esobSNdT2" +
+	"BGfyRdKdI4vVYVYx8y+pWYZaeaTZIlIr88BpEBc/7/aVw7mJrcY3dW2ZunmLbz3waeBXD3xKhxsaQQuc/7R1YUg7q9bY/ZWd" +
+	"FJuN9sOn80UKvawtQgq5rC2weoK2m7Y3+IE8GLmKu4XzyUIuc2e1XCPlKjmjKgxEpwrIsbuko8m5PG/fwxNzVL/CCfy4eGK/" +
+	"g3PyodU4cLMrZaHe4vre5O3BaYeI98+XAKJx5N7PDy4ZV1ZCggsRRh1tT7hZch2qz86IUf6mqAC9WqWRM4tJqeLVSjNbeIv9" +
+	"qLUCUsibO4uHnIUpcJActF64eTMmJob7+cjd7M8BjkRtmvAiF9qT/Vt/PGD9p+azu/cSpUMBRjttv3EmuQdyRX7Vxmqg5khD" +
+	"0GpkaqVZWgOLDg+UjHCyECBvRSHPg8L0LQE4+XjBTZA5PALnZ68/0/Pf4SuO715N5tT88zJE44Q1o6W8AySA1/GjUBirYeHk" +
+	"hPAGs1om1+mVJskLQT1FnvSFINsMLR4Cr6zi25rYMDQuZGR+RLNGPlY62VvHBDVt2dESPHpLbDr5nJxp23KXO6jBYH0mHfK4" +
+	"7FE5zZvnjOpe889LwIHpDeC4nIxmhFTsIv/0nz1meGHNb11qwACcah8JAmt3/k+rTZYp+vqVOmv30lJZJlnHZP8v/qFtj9wX" +
+	"ydl9mYfBLPfTyAJUuDGYzCYZPW16g0ruazZVTNQD6A0AXOZXZBeZXLwNFhYnwcw7sqEQFIQbB098Ru7NCB0uXz8bYNrhnEYL" +
+	"3lE9f5g/jwJl/xMtB1oV1kOMRqVWgfgYSKbVmczMjyAT35aqVbGMKZn+qVh8C1yCR+r9kpOanl0wJralMAe6+xPbZ18094iN" +
+	"69zDL1o1vVt048weGEeH8udBBZpigKaJNyfF87UVecU5/jMG946OiQUIabF4M+7ZFQb3j2yMEEJQsZt/SD6T+zDfu8loMlLn" +
+	"O1Xue05NOXVqinBk4a1bC2/dYnH279cIGlRLTpeyNULy9ojq4z/5N2SwbKQYPyEde/0s0jZb8eeXX3z5xZjmbVNThRMHD54/" +
+	"n5qaao/ZYTmTvCvfkfoy37vj2tyPPeIx49ZTO6dEtYSEdsELFljHd+k76eW/Bf36TOdOTs87cDx36pqsvVsqNp1Z+qt14YFt" +
+	"y0H4acUZkT+72AbwEfw6xCE9QkgVGcVZzHZXslxGn56pVfbHLhraqUKQw9yCGreP90vaNa17F4CgsH6nL45NLp088M/Xo1aS" +
+	"F/ewx5Qp1t9mjYbvJg9PTWk2pF/OAIwfX8OQce3u2jkFMHrVeeulHbPs/Y/jfhc+FfNyoMgoPy7SItMxPcPCsli2hkTwN2kU" +
+	"IGZxwLcLCgZ84Vcn9Ow31zvlnCUvRq/4/ARw28jRM8P6JUGLKaR9uTt39hZ5+kf/YaNXnYfOpdse/grkHHm2sxd5P3l4KkKA" +
+	"3G2/81bZVhSGUECkJ0QZ/MX91SjqfMk09WCeMaezMj2v41MXtpKbN5eEdm4eOLwg75Ne7UMKYyHsE7KQvLOfoLdNg+Yw6ETH" +
+	"VWd/ihlE9X1edJt254efJaOIP8ZmdqLGS6w3yO9sDrL4TmwOAsQ5AH85/8G4Wcij3sjsQA2+UlAAxcT2N7k/YIJj+G2ibvbq" +
+	"chrjL6+51hg5ZCmsnmJNbAtQuGh7tUkY2iWu3teXn976RjjXRryjr6QhBEWKVAhuoq0gN5s04tuhD+lQM0rdVMbIKEqSdTa+" +
+	"OO37x3UWbNs8vIvv1OoESfQ+sMTD6infQd7mRrt+3rOnFI4/31orsX82+cuZrvWMcu78qFEIYeYPy5FNRlFizRaZwe74ijJ+" +
+	"4PhSGPyrbt90JasoU37mpuWi4+tppd/rDLn4aNDLjGGjBu1K/qtNCsScr9ggbQAQvG0N2L1eD6o7vQZBSq+1t65UnKb7elb0" +
+	"D/PICajv0KkcGk1U7C5LEF+EB/jR6eLY3//f3mNNmcLJp0ypeodFXcC7FiIezSId+Wf8KOSKlCgNZVTd6xC9PVILkgM6EZwd" +
+	"42qluCd5QpU9yaKRq832t1IGmTvIWBQn9JY2GryuTx9yY3DfcRj0jWeShiHxISPzI5Od96lO5uDRW6Y0O+2nmNk4tElJ2JBB" +
+	"ZJeutseaUa2gxD5T1g1NjMajeTA4LMw45hH+VNy9yANp+4LP7fuX9fzIzns+cQGX5S1CIoHr3RJiyeWGar/i3sFMp/Sw/cOP" +
+	"5ZcgL+SLEPg3AJXGg1m6Am8S3d12/78bFbbVkAowYnIZeUiebllRceLpuoICfgmQo9ad/ThuELegouA9AMHZ+7eSnAvPH+AJ" +
+	"0KRCPgXZ/QSyHyQ/QavqfgJB6WEU/dsGP4MswESd7qJbwC3gYy4EXbjO4UNw9hvgdjXbdN32PlsfQ/7+WnQTABn1/+RMcHgR" +
+	"hlqHmflNXOuKcnIcgsO4IxfgUaXHAP7fXAwIIZ6OUY6lMbZluaM/GGWlJ5PmvTBGav7vA+WcHYlVBq3JGVJG/hrra1x0mhf2" +
+	"a/WtuhiUkf9vI78r+hq9nGbg6vINbAJO9OmHubNfucCTZUqVv8L4f5qB979VeiZZbbVHwgPZRlZ/VYG0vkipYInqtLxSgSxm" +
+	"pGTn8wckj9wiV8l42E1K+NPWcutvoH7zCCLazB/EKchKEgv/QjacAn7Hzp+JH/mLdAeACVBrxpdt6KEXUB+bTXCTbURqhASq" +
+	"HZBJYQ+Ct7D4Lq7XzlkXCPnk77+P9Fu0vRTqAv6qNbR9APJ//vn3+L1sLuIdLkUiLnKF3yM7IOYq1CrN4smH2th6s5iFUcm7" +
+	"ks+gX9/CEV2Li+HZ1l3fQDpNnCdwC2aOLRjQt+izRXsfvtr/fj6JQBj1sT0WNsk2IldW8Y1V3hPLcVkUKIBHSkWAGPmi5vnX" +
+	"5Osjx8k30OjSNfDaRYrIc/InGYoziAs/g9TAHfB9cIV4cor8Q6ykDHKt5AhphQEOQiscX5K4c2fcNmSvfca9lR0Q49wUjhGw" +
+	"UDzkCVzW0dEzN04YevT17e+B47sAf/iTJVdnLPgSvPdDFLlGrr4vY3hSEQj+sgNS/Vwxr6p9NlgaMG/QKvli8u2WaZm5E9uN" +
+	"mfZ0y/YbcAu7wOsKXtDNGTBwadrgCYs37fr7l93TrEPE+C3bIwFkG1FNhlWn9AFjAiij9FATdH5YzLxpjET4+S+wcHPhnu+g" +
+	"EFsv3XxHrsHFn1+BnrOSoPe74RrUereZZJEHZC9gMHE/b7IScp/iH2J7JOspO4BcWNVKpY4yHdJS8rU8clewFKFK7rdR5Cy5" +
+	"DXqIwa3JRfI/spAy1VoYD7XhylRhORlPTpJnJA0GYeX7ZxgewUKYBo+tv1rbsVg1wJdkViSnPWhZCngcQmpshn9AOArNtr7d" +
+	"IdprQxDiXdna0zMAmz05ze1vdqd3mph6/GlddrWSG1g6f9b2jYvyzu6bdu9l6fle995gGRya+KAJ6bH+KZDmMCwDQ3PguDjy" +
+	"XTo5/LJisn0uy2Sb7LzF0CtorCXI6EyyUDLagQLx774fPXnOlimDvyCPrv1LvtmMxy+b/Pxf8gRHQ40v4skU8u4QxlvpnWQE" +
+	"hHNfWp/jQ9aTceRLawn5TRyL7bHwiq1bQ3GPZKXmTUo6nQpsELlaCkVT4gGjb+Md40vnwSNy6u6bA+QZeDwDbF3CucFjruIr" +
+	"cufhg7vwZYUn9zNpSl6QI1SxBIIB4iF7l5W8Hyvy8WgEvErUHwHS7NEEvLRcgV6mUdvnbte8jZNKL/4IS0mLwoF45ZonvwEe" +
+	"87i0V0V/ocaFb3ZB9rY97ckPxLqEvU0nO4XvZQeQK9JWlUd3o0Lry8lFfqF/Y7mH0Sz4kUuPb5LvIe7Sr9C4Rim5T/7yGEXu" +
+	"kCGwHjyhPmyp+ARmTuJ6gztsJAPJM/ItyYRvrIfITmhZBzeAfjAIfib1yEryO4nq/AeHEKAQ22MhSJRP8MBUX7F5U3kYE8Ck" +
+	"NAqBQL7/lrwmpRgdLpr8yWawPSSnIf433nXj+457bsL1vfxeST55lWwTy9NO9R42RVlowiYZ5wOi6uNGLi358fXyU7DzCsCm" +
+	"6dM+2Qz420wI+XnjrU3LCslPR/dlclFvezMd+lgWINskzjVdUlGNahwLTcNDhelkNDl7gbzbmTcAHyhYP6MUB03jxpFo8gO5" +
+	"C2FXwWvRiNFfrz3/4Co/xPr+/S/snd1jXi3bxMaKEaVRaRSF3kS1P6/G5NXuv15thn2LR22cnXkPB5LX5DQMes4PfL/865dw" +
+	"786VhYu/EuVptM3Gq5hsqxECMy09r00AiwrptGLwdSTiBuKvFxArcAtP7yKvfiNWPKHiCWz9GVT8vBPfWQHKv7xNzpCgzbKD" +
+	"5CuRH+b9Bz8wV6eeXjc6WILzEPzIDw8fkR8g4fJdMH5CVlzCePjp38h1MgJWQCPwhjWHDnG9wB02ke7kT3KVpMER6w6yc8Ox" +
+	"wuhPsR/kQk/4g7iREnKe3JLsoq4IhMey3cgFKZm3Rac0RrpZ9DhAJ8NqlVssgAKxNNI6GaJSFom477KzS88C/pL07bb5xrOl" +
+	"3ThX8sOv2SXdfiPnIfZnaPcbhMg0FVHg++0PdLcjf16+SJ7Ac/IdOUjawkGAdJwHyeSMGAeIgH8m2yRm0LbXxBf/8HFkz2ZS" +
+	"YxekbYYK3B86VLTmDsGP5DJuav2KCJKc2h6xdVEiJNBNQi6mytYjY6SbhleV7p444fAmgJrfXXwPDfndu+/Cnd3vO/M7foWm" +
+	"5Mgtad9FwHOyTUjp4GWlUSmTdnCu1/Hix/dK8azFZZtLAZ9KA7c7ON769acbMjjT215iHddHvKtsk5iPHMv1/ix3L7Xj40FW" +
+	"yXYUHe96990x8r9dW8dNObx19b1npbBy5bKSzXDxCPke4z8e+msXb922/X0naHIUR1vPr/oim4usoo8YjWZJHzEdznsCNxLm" +
+	"fj5+Q+nm9fNPgxLwJ8uOh1eYZBus34fbyC3rfMkemirup4JJpvNHSoy0Hsx7SVnM4kHHyt+7v5SUkL4A+6Dj83s5X+/5jayF" +
+	"rv8ufroFek0GLId8mAXX8bb9R8gS8hm5QrIxbIB/Kn516Okbsk2opugHkapI2/W02FGs+PgDTzlQfmD++MVwg3z/xxfPiQ30" +
+	"Lx9ugR+4CvL3httvzt2EuxUa7lfiT26TX6A9+EJdiMW8teL9GGd53Ihc2F4e5ZBHmY6F1Ju0YuZzbmC/zmQArEkduev4djIA" +
+	"nm0mx0px7PbjgtvUoUSFJ0/Z9Y+NFP1MGsk2PWb2i+0xr5MdYCcSFVNERpkbO5EF+DMjxk2tY4aZRoV4v7ff/0le7Yaja5fP" +
+	"JbPI3GcnLtzYDmuHjJqA8R9w5Sp5Cv0qDpG/d649v2sXNNwE7uTRlbPzVhZeJKUIHGNwl6TfIJaTo2Y6TUfHq8j7Wyvm4ekV" +
+	"t0snfzLm81L+B3KB3NIQn92yTRULPDaBDJ6wvRiBgGUbEWaZ37XuoOPoQxydSQu83HrnX5h26ipZdhaekdKf+AvEX3hj/bfi" +
+	"IryxtuN64pU7K0oQILPtsbBdtolpNw9RV4rSaFfBQhkm//vil7/ID6V4JGxePWrfZlzrf+Qb6HqZ+5vMwZr3Peb9eeUTvMF5" +
+	"/2QyBSYpE71Ryew2oxp/1W7M09LSnz756hNs2bhuXoUg22itOL699ybJpn7ML5UdcNKKeroZiNzkhkT7T62jQuCB+KXk2N3b" +
+	"5AJsKxp1adWJA9jacv/wafv2wrThGOMD2ER+JmchBnQ/nZ42Z+PTmz9fAC9o+2bqxo0LF048Qr4WbSruul3+dXocD2wn82C8" +
+	"ykXd+OrYJ9ChVodFP24GPApAOKjaOLT/W24ulX5Jf3BPpblT8Cy23J8aWD4QC2xX5Z6Qx813diB3S2FZVmHnmVv4WeTHR2Cd" +
+	"hifCM9BhT+uzczth63e4AdPJqbZHQg3ZAVQTqZERIZrtVi4OXny5omHVlOtAEKsuS2utsdy4sezRlInV1MFd9iaOwSObpg7G" +
+	"/JB2XbJhc48hu+H8ns0QTc7jkzM2njm5ZS/ZAdm4bMmuC7hJ90kTe+bl8rX7dpnV9dC4vhuvkp9XvSr5DuNzny17BJ4rDp04" +
+	"dQxJ8sGl88fZulLPv8UoVxgjlXpkMnogLp3MILP8Nja8fRPI5h0A2bCLRMMPly6RzyHuGgy+tpWsFOW3q+0Jlys7gNxQfQmT" +
+	"IoBmPWIpn+oCzykMMhoGw+WSaWQM9FUGTp+xbdToICX4lN579vMdrLWewnHYpWmz7QMHLWw6e8bBVkUkEg6rVSSVtBf76EB8" +
+	"uPv8QFSP5RKm0oxNigCzJUrn1xDkBmpzqeQ8F6U3yCRdxd3/+c6Vd7BlLWyej4XFdSAAIvBqyCotXrV6zbbiMbsDb5LvwHwD" +
+	"yhaMKV42M6JRFriQp5OLd5JZK954exHbnL3XrovjI42EsXwv1JC9rsVaFjek81MqBF6j0wewyBJ/6junriROQX9jjBTGAplF" +
+	"xnumD7oHTeFv/kBNsmRWXn7uAvIV9NjcN+cZjC+99ccft3B76xPcaGHyVrKHuH717bUNfbjSISPCrPAEXuz+1PoWxpNZpLe4" +
+	"XsSb1UjSICRUrpcnKHR+ao2OzoGSiyMlpNhnrcfd7747GLkuGFpB30SSA2WPnpBfwINzB/z347swkwyT8KXzucjPGZ+H0hRl" +
+	"0JmMzOVvR6uSe6i5dLKKjNau9/7t3JoVow5eO6jaoIZOUHjiTDzpCpuePSMvMNSfeav+6pwfL325bR10JqU/zMgjom3ib3vC" +
+	"NZUdRHWRhu2tjEssendFAK+UyXlOIdczFonS+WmwxswlkZlkxp5rANGghyEQWPrjj7dv7ly7EWBDCa5tvYEDYUSvEVAHUpRK" +
+	"svvTUc2JAbYBkBzSi//m3V3yTLpz72p7Igzic5EHy0wsrZESczo3pRvVOWqs9QVpybrC+Ls9Rk6DAfjit//iK936qj3JWjIX" +
+	"d7x949pd7Iozjh22viAnyU+ghiRY+Hnr49ZruBF9REr62s/LuXwus38UtAeFr1LhrsNKhS+XO3XrNJJQvG0yJ8PNyd/kG/AF" +
+	"HSwmQ8lD8gCioS72FG0o4s2l8pnICyHBzXlNaP5uf3tSKQ8ll4rJCjIyZI3/qzNXSruD69Yfht78NYF0g9WvfiLPMTRYT5a3" +
+	"OHlg947Dl3p1I/8T+aeRvD0/iGWEl3E6N1oPl5PrDHT40v8UAu+u0wfwzizNpkzeHg6cuv71aag527X80xqzapz/Gn8Os+LW" +
+	"B5Nh5IcxvUdfshIYcLJT5s6j7TKOwgC86wX5G/i/IYi8ffQIapG/Hz0COdlCEh72J0d+7uyzesowwzO4Dk++u/7jJbseecyl" +
+	"yw4gFfK2c4jWQ0O9hzSanLG33sBWSikyIywc2e2LM1shDzqviZ/1+vcLcIzocSLWukZA+tXzYWHQmWwiIcE1ySCSDl1EW+MR" +
+	"N1C2EylEbxKLx2oMai31w1qo8MrkjA8HkolkEYTAWNJj0MRp0K308bC860+xp/U8/MkVQc7ShSQ/ydV6E9bXjCWDyRJRn9qE" +
+	"dmJNCXDjdEiJdX60WjqdWAw6LM2nr5AO/xwHJRy/vPzyCQDPYwR6fdsu6yz0wJnkB3ILPCAJJpHtP/wAXUgxuUjugD8Ycc0n" +
+	"T1Cl3pbtFE/rlXpbwQp8WKL8TUYVrbXnIapwxaY6N388sH7UPk9yZS/ULf0Wg/UR/Hj+LPkUlsHQlZ9sXHOq7/kd+1KXI4dO" +
+	"oHqW8R9n1zECvYUy+RrMAaYALg3IfFKM3Urdbt8nC74n/cEVgLzGPyaR7lC6fDn5DTy5f9rNGknu4q0rR7cs/MxRS7+XbCfS" +
+	"Ubqhcn7cKOOx+XF3C1DzoLaI09SL2C5CCP7+/Eo4ewVCb7x79hQmkfXk6dbTHTPPwiDchr7XAxdIhjlkw+nT0JuMISfIPxAE" +
+	"vrAAWkFq0nYN6dA4zWqVzhePuYGyDcjdvvIsOFNpxvTRksqNG0gWkYlrfrsEB4Wt9c7AE6sNK/jd7zuTCTB6Jygm79ranVxg" +
+	"e5E3308YjmqxajWKAM7IUU+kzg+5Y/GFf4C7zp3vAFdUm9RwgTx7/pI8LIWzZesukSvqTSpyBWLw+Z5Ql/yeY40kX5HG8AO0" +
+	"IT+Q9dANzDh8qXUqnrQYSTUnvbksfinyrabz6c0Bp+OigsBg0YgiquSyyEpSoNmkfn4FjxtWY2qN+a17u6WnTm0Ztxjc6NJs" +
+	"efKE/AoabkD5fLINuo1ttDnyiy/IOXGP8xZm8LkoEsVU6klOJffwBhmv89XHQ5SZXjWLJ6EosRQoVRn0sZLCWYeO+bXzkJ3Q" +
+	"Gx5/A+TNe2Kr6NJ17slri7el1muznftyUVaNGmQWmYY737t+/R7uB+/PfkU6vtsyaHXOwUfHxng3bNbcxzuqYO2w7O2rDq0D" +
+	"y964HD+igWcwgiwhZahyHxT9OVXnxCBtWB4apZGLIxvIFJ+tvve/6b5uechGPfisHL6G7oVbHz0iv0A97kQb12/eXIQJNdvj" +
+	"NRLexkI7vicKQEhjtiC7uCpVcp2bCVdRkqyahvx16mYyAfqezuxYDsNXHdqDv/2aW8h/ewYuQavNl3bvOxBngsawAp78/DMx" +
+	"/fgOuMvQlPx65gxoyHbS+NGuP7ePCNpTOaZ0Ph01qDYmmdxXzCKjMNLHlFw6WUwmKMpUT68PLyCbN3x++MS03DXJpCdsWrmM" +
+	"/AqeUJhYE/bcvfHwB3LfNQHPFf17j7kU2QHkw+JNqupVlYwurU5usvOQMhREHculkJ2kD4wdP3RZQVKLERPHdWjXehl4rokY" +
+	"2vT2s2uwkzTBWpxYMxjWzF+ZaTIYTNlTor49coS8fP8Uy5RqgaSRwfbzA41TO0DHBhZ2F0R7EndI8fCr0Pqq5dhHtXj5jIEw" +
+	"tOTE4ZtjBq2BEPCtKwSsHnWJrGlJosgdsh86gQ80b1IxTegEua/XSPvlBb6peMaTe9AXP0qTwt2IlZQrlZgxqsGsNxh9gPv0" +
+	"wEUgKaRp3s5h4AO++KuSlQcwPlVjR439+JPtuVnkAuE5ny3QCGLJBXJ2tTUfhkD8rItZC8qnr6i0ddOZ/5JqETc2l2bKbkq9" +
+	"KABUOc4gs2Bcp0WrV48ZcuPmY/jH+gJH4SBoibe8D4XtyaQXyRb3osfcdLYuBoTcMeW2WFAb/DRqaVXsM6X1VWqYhtfp5YUA" +
+	"9Ze1btdh7ISR0CKpYNHIETCWdCWfrfnu5e2RQ4XN4HbkyLdRU7JNhkZRmStXktyW1uvW7+CtPzkMO2EjJFTyWz/ZBsrrwGrV" +
+	"qz3c1AqHwnePwpxJDPVQ62iwqBFurPrnH/wnmU1mgPf6erdvwM84/ZN5gYHkLzC2km2wbi1u844oL5KesPnZK/Ia6pXhWoDb" +
+	"HC6bSR9hxCLs0L81nTWwZDLbd915ZOqaogIYWnozc/6za7iR9XMcxi+wTku0nofdsaQtmSPS70Z8OCO/jO0lcgPnoZHxOlOk" +
+	"2aQPA/cAi2ARKzmZxPC9usAZW1VMfQVArt1YfAn2DDhJ9raC5DS3JikJCcUtWzfsBNen7Cc/jD107PLKguGrI1Ng0+fzT631" +
+	"CwiJmhUaMj+jaYlj3z3ATgFO+y4zlyNN5urbr7QFzyXF/qX+N3/sv3RpN9x96ZIOmOxYBV2Xbdu4C6aSXnBg+XLyI7jexcUl" +
+	"U2YsHbpiW+bcw5viU2YvSR3BfD2PudzKPu17lqiAHX3So5aHxt5nLmXANXfuD962rS/uv21rV/xk+y2uZNvGnazDV/yR96lk" +
+	"Pbg+GbtqUdGXQzt8NbtL6YGElKWzUkdI552HXBqr7+SJkCbSomcc6Cb3MKppOld3hcZMBXfy7MQXMAEK8b+tp31XvPCbibev" +
+	"3bjVueAz6InDrZdJ6YGCC62uXGrDdSLrx4+DvrC5Us/1ZBGS0hy6GSOVJoVcZzL6x4Og1ikDFAJPLbxpZI7XDh3cvnluS1+Q" +
+	"kWHr8SXyD9Sgx42tj++RJ9BwK/jgaV3/Huf+N4xuaL2aOIX8be9DdgAFVPZB50yyoWlEvFKhVfupVZZIi9JN4NVcGplDpnuX" +
+	"et++dntBHAR3mkKKYdJPT/efAxtCtqakB+y4/5jcA+0V61wlHGqMYRYUTv+rAvaTZ2RK5zEVov/V9pgrZ3d+4klRLR6FjUqd" +
+	"KMNqsTSkXCfG1YEqFughi2tHppHZUPjqFZkLE2DcgAEDKn67du8RSb7226N72PySrIX+MAbAHcbAQLLq1Wzy+1z46fHv3Mvf" +
+	"X92T9u61fC5qwCoau2n9DGrqdGb175UKo7rq2VStsEQKazGZVpRJZj94cPs3MgomXO7U4TpM3PLgwpXb2GD9YvY5Mn7+v3gZ" +
+	"bCadSL+5HPbD0S9eWGn08dc/k6OAHGeVHWLtRkEcqj8dj1w8NLqrBF6tMPoq6ZmF20FSSF+oB5pFu7GwoAYkQggmv5FfCagz" +
+	"P0nzJGb4FpocO75g3664MEgml+4fPfIHOQptp67eUH1/dKfvpCMZWjW9/mYCYTBqKAld1/QqPvHN6e1k8+j8xzfrl9Ync8g0" +
+	"Ps06JAHA75D15h3SKYjsJY+gwYLFJAe2M35PJd7cdT5P9C24i45B+ohVZYwMAwvF6x5l8XdX0V+IMaypd5++fEAshbB5PsgW" +
+	"1yF3yCU8kxwvnb5675Q5myaX4tQnEE9O3Cct+km+BfIveE2atgv6TiFvoMbkz+/es9vyfbh0vhfb96WEWeLRK8BXKddZRHPD" +
+	"Q4M3mEwZhXGFcePJ7zAdhtfcp/nuGfhNHrYeuLQ2i9sNDf8KpsOXmHT+7hz4k2Nk56rg9QhxqI/tBb7JPbS/YafVSQXpT5+j" +
+	"R+HkgQMkqbyce3j828///YLyEpcDf8oOsHM6gkhOIadxmaxeqTdo2GtCYyQ0/ga+//azx//GXh3WBwYOEj4jd8klgEjocyq3" +
+	"7c7Lh4+fRgijOojj7wu3xBwbNbAFdAAyGA4Frwm6ZLUJoe9L+L67pJwlNhuqgxB/36WNwoBL0B5IcZPJjla2C9fdZFxg5c/y" +
+	"dgqDJpBvAaNEOITRQYSEho4cKcoaoAQ1pHMtKrT8QP5URTrctnoI162zntpsIqyIgzvBcHyBkKM9XWHQ+HEnYHTVduG6wiC7" +
+	"A00REtsRRoMR4q/b+6yB3cEEfFzF51yLNe9X8oP4U6QR0ZM/8NineBzDw+Al2nezfr+U8HPC38IthUF2GDxYNP8daaycsIq1" +
+	"3+EDWfthBz1/C9cVegqnkMuOgocEj4RVwnUn+KOV8LRf2efV8CNhlbydQi/7nOFhX4nwsnkMzxcwxAm+sv2O4MPav6hsZ/iP" +
+	"fQjP8B8T8Qs+9nb7/MsaoHA3meyYvV2WzPB/WQXPYKlfvezL6nhkyazfkx/Cs35PVoF3mn/8gvV7UswbIdSAofY8XGrdocOH" +
+	"hev0rFeES7jpwgOJpzitAEYA2z04Zl0PzYqhmZBr3ZXPNas4jgB1xrfxOuEwwwEayIAnfYg3Z8FTulunoKq/BzlY8Lo+xLsP" +
+	"Z+mOp3RnNBRx4Xig0Fd8tyETS2VLIWQmI85bdGlRPq2jMJRvVrBpUwFoyYOmWdlJQW/Eb7V4oJAh1WSMkkLt2T13pAfOG0qL" +
+	"MOQvurSITyYP3gQlZWc1JQ9AW7Bpk9TvcCEDKVhVN2Okw3byUMlgHCEx7drFEJK3aBEXXpSdlJhdtPGnTQhhid6M/679AO/t" +
+	"tR+GLrq0aNElvlm12g8YJeH1+Lkg5thANUADAaABGA54HLGRLEDjiQ1f5BQRFX9EsL+RuCa4t/DAnjdJvGOEh1NJ/WJcQtdC" +
+	"Wo+DeBIM5do4rSnX5ns6Xl6GB/JTaTuNE8F5xB1+52VF4jxG4IFCJos5Fiu9s7hj2Jl/8mT+l7zs+vWi69eLEKqEnWXPy0Mv" +
+	"Gigc/C7UIgFwp6hIlF95HpPfa/wFJ/mtg5CsPuPza9zkqnIqGyK1X3aSr8EIydeI7Txh7V9W1Y2oyX/o0GfVdGjEf+jQZ2xM" +
+	"dn0mQ8hSA0yghsHcgwotrUJUjqNw+BfWWU+ts1F1WZJ06M9O7c461Kld1B1oK9OhP1fvk9acATXw16n2rNCWlzPN+YX18sd1" +
+	"5/MqutAge/KhbhOuu+lRE1EH8IHV4J99DF6hlz37EJ7pmJ/+Q3f+VB1e0mE//4cO+/k/dNgv/6HDfvkQnuF//h/4n/8H/hf/" +
+	"gf/FR3QkWz9+N1u/5yK/43Vcc+EB04TiOnHNrNH4vHULV6cAek+B3gXkCoMrQVYmn8hdaVQWSVLJcG+yPeG3MX6NFn9GiN8m" +
+	"8estyq9SXWPWLvyFlAgZadgEDTizB44pNwGPbAT4wyX79q3ntoI/zdFG7lbkcPP+uHr1j4rRqBK3xC+DYJQDt53flAhZWDwG" +
+	"S3Mp/WMwLAENYELIr2QYFMJMyKcuFzK3DOpBKASDpoysIlPIJLKyOk+msT7wB3OYBqOrtjvLAG2n5zyE+JsiTQFqOy3SP7T8" +
+	"DTKPFJElMBRmwWgyjPxGCGDwKCuDQVAMU2BgGfmVXCM/kF9oHwyXRNNGRpNYn++g7YkMO9kMBHLsayK8l2yGhYw/iINvsPCX" +
+	"wiBHkOPEN5sQEt4Lfyn0ciTx/cJKeNqvHD6Ep3wmhw/gGR/L8Qd8qZTo2Urb5bgSnuHnPoRn+DkJ/1YH/ACGXwaLneCHfIBf" +
+	"VgnP8Ms/hGf45VXw36qOXzNJkpMS/IXwgO3FalpEOspe8F/lYcSf9/n7l59f9xl1ZO4nq7ia+d/dvPlt/rJNR4uQYy1+Fx4o" +
+	"DDIr/4fTWtA5/F34q7Jd7tDBwk0J/rbTXFGaebFdkNvHiDBKRIj7QfgLubL7D+f8CDI5pdSfBafqsEv+uoMZBZuPHBm16eob" +
+	"kJ2+/vchoePWVTuW71y2dZXV2Ar+ePHu0R8v3v8mzhuf6rznyH3s9jif+rG+QIy/i+TVKhrAOCnn8KaCzIMlZHL562unQfb6" +
+	"2iah46qty3Yu37Fqq9VoLeI9Xvzx6N2LPwDZ14lPdd67pP4SbU+4H1huOhp9VuW9oZHVefan4QYe2CXnyOaCjIProPjQ39dP" +
+	"k3dvrm6aZu8Of49nvP9N6s5mE3GKfQkJrC9fRkOi7Qk/UZRvIYHJt684ZtsTPvVjNHBmS6SHWsWzSuVGPCm/5GBmwabDhws2" +
+	"XXtN3p2+9rp8mn168ff26eU9JLm1jxc/YTToHPvSBCafXvCJE78mIiT40XbZHe4QW3svB/wmBu8D2VVtdKFQhOdrMPhKG32T" +
+	"vJ1dX8gbQrajvZC2yxtK8lDDrl+ECUwefKvSY3si+FG9I/cV4blDlfBM3rQfwjP82g/gNzH8uqr0254IhQy/7gN6NjH8/h/C" +
+	"M/z+VeBvSfj1VI4VcrEXZm/Ow8eEBxIfV1lTKi0qsfq8Eda1S+3crFObrKxWGTPWbZ0wc20xfpvZonNqRssObWBi45nT50+a" +
+	"OR23Zjgj8TxcwHDWr4rTPVxiVjE2GjqmZrXtlJyR+nLq2plF29bNyMRv23RomZHauUUmTISB1vLpMyfNnz7Tvu6cpCNk1dY9" +
+	"XGo3Oq0vnWdOeOAE7+uYn3DhgRO8DiEEDt1RFyF3nV1TiHvy63JRRxzJKdjEa0TNwGu2rtqJPqof4sQzl6Qf6jpODw69wC3d" +
+	"XJBzWFQHvGbpzlVb3/8qaoGPyr+Izy7/lD4pXM0u9683FeQcEaUdTlB0vEaUcvRRGU/4DxlPEOmWZJzSbbRLtHhS45YeEoX5" +
+	"cE7BZjghyvD7X7eu2vmhHCtk8qbV5Djmo3Ksl8dUlQOHHMd9VI718jgRnq9RCc/kIP5DeCYH8f8hxwn/IccJ/yHHif8hx4n/" +
+	"IcdN/0OOm/6HHCf/hxwn/z/KMesFgUOOxXWTOa8bjpnKBDarZae23ApRTq3l7dPaVvuuKl/pTdY2nVpmM8nkVnRom9YetxYF" +
+	"0m5ncvvFml40N65Jy+0rt47kmgtBu+zzz72V5GI1k4tMu1xwb8XvxKzCh7jm1pHC9V3vrld+J/F/JIxyfOfUn5hzm9tXcQwv" +
+	"pFlidwlBDnuB+1TidS37NtvezudKvK6F0Y52iRY3GQqr3re0F3Wzt/P7K21LeWeotE98RFuL+5Tpks4O3st0gs+EHEe7WoLf" +
+	"weAzK+EZD2RVsS0ZPOOBLInHdlShxyDPBlTVVmT06OXZEvynDng2L/IuH8Iz/F2qwzP6DfJuH9LD8HerTo9Ef85/0J9TBf6W" +
+	"fX4kHma9IHD4PgQx96zSaIW+pH4xVzOfayb9nmv+X7Yn1+wFtT1fWD9ie1bFzbwqJuZT4Wri6xXH86mv0/ZEeMJN/NCeMzh2" +
+	"wSiL1sTXmz/xYEbmwTlTH//65vrVNynWm/hqWf6O5ct3TFuy69G9F3/88WLXoF3IoSMOy2oyGehh491k8hF2W044/NG+tGqt" +
+	"ozczrjF1zsHMjIMT53N7rDdTXl+79uZXfHXJNNpZftmuXYN20d7uPWJ91bA9EYoFDfNX/GuLo319J/lwhSfc2g9tNo3WpJWe" +
+	"KKlVMr6eva+KTrhxypur19/8uutjXdn55onYl2BjfRU4tauZnDnaRbtcOPxRGvwcFMi1alzDPrePfn1z7drrFNx414dTK+0d" +
+	"h6WxPmP9FNp1pnwUN1FhkA+DJc58bnsid6HtsjtCCW2XD7Pzp7w3t1ZhkBd+sDcdpu2yO7wMoUr8FJ72Kx/zIbygcdPLx0i6" +
+	"WubUzuajkk7mW3zA/S48Ra4sz8sH8+ErTgesy7VkJCd0Sc5YsGwd1FwXBDl8WuvkTm1aZDdrlT9pwvRZs6Z3iM2XdPg9SS6c" +
+	"Pav0HzLYvWptcVZ2dlbxWjzPrvrtyhxVo6eaDagJl5iEkiTDFzKTuyY0zYzuad0H3YPXgevapXxaq2bZLdp0Sm6dnx/bgZI0" +
+	"YZJdXzO+q+tYca1JK4oSX6/Lp3OmVnTi9lAZ2rZ0m7S8lXMmycxyJjPT7faZcFjCpxbZRqsWMxfiGtfe/PqI21PRaeqcz7Zt" +
+	"o3y6a8m0bUsRqsorfIgtzoHPiT4wiXRRAimlfL3rb359TOmbOudT/NoJ4Ud5f+Z/8P7M6nRLkk7JZvTjGtmfzZlK6ab8jl9X" +
+	"TsRH6Z7j4MFRjGeLq/I4QnIXbq1CLy+W/F0l1Xh8enWeldkY/HQJfmk1Hp/xIbygUejlMz6AF+mZ+R/0zPyAnlEM/6wP4Rn+" +
+	"Wf9B/5z/oH/Of9A/9z/on1sJj8DB+x9ZH9jdqmdqe9zeun/hsnUrhcLmXVOb5U+aNnGWs5+tlujXDaEuw9qgFqh3l3Ov+J0f" +
+	"VDGWm10xtrwcIp9eu/4UIr/49cWRXx1njS3it+4KpDWyd0BKk/E1RIKuS/kLklAu7CBryHErxtkcDIP1+Y59fI9oU8FvzKZa" +
+	"7zhreIr4wGRUKxB7c+OLuD6HSMLz8i6gg0hhR0g+6UOWcNYdmEAzBz5PyV6aBqMc+Jzos9AQT0Tf3Oh80etyOPWivAu5Ry6G" +
+	"hOTDehjG4WwrJsfF+eW2SLjcGa6N9nnnO0q2lzuzvTZWpzlAQU1Io9IDKdU6rg+5SO51KX8Opw6FwABohol1B0eWkD751WnG" +
+	"j1g/pfZ2IZ3ZLOs+0M2cZHOtpO3ydQ740Qx+/Qc2i58EX87g11fC037lGz6EZzbOBsnGKa9Gz8aP0qOXb5TgV1bCM/ybPoRn" +
+	"+Dd9AC/SX/pR+vXyUgm+vBr9W/6D/i1V4G9J+O02WqmTjbZBeMB4jT2Xoc9HvEGHvco+b1p84XoxPnuuBMrJUfWz3ZnS3lQq" +
+	"vERBCIE6FMS30R4NxcrkLKe3SXy5E2XQh4l16KlBgEfGDY6I6+HbyJg0Mi569TLLxGZJRXExvXWNg5JGNjEtnWoZIZye1dAn" +
+	"tXGSu1fdOvWS4vqPm9Sg/nSDoW10vK5RjdqaWHPPYVM8qtBcByH3cLXOFGW2UEeEzk9mLb5+objp52X4bGDm7mdqaElalyD7" +
+	"eZt/LPzF4vrE3dTIUpB7Sqd5nYKSqcTP0zpltSrfsXf1Cekfa47Diat5XR+Rn08dhgCcTf8NnqcOkzsf4FWKOHQiRiXDrzSy" +
+	"3rDpxOq9O8pbZXVKO75G+gecwNkQcPgU+flR17yr1h3kzuFT4Pmoa579PP9Ykr9u7Px92N6fLIQ7jAwIgTTRep0p0k2twlo/" +
+	"HAbSkngY1fRprJs20k3DLfcZ0z9/ePO1vx5cRt6s+yosxLswb+TgtkseHl8ONUpOhsH+7O+2T8h9to2c3rOCnAfPScOlBmi9" +
+	"aS0Yyc+T7PavLETaw6y2OIVMfvQDmixmN6MvpYZFuLGZqAsyrPPFhig3o9puHOObIWFfrYOay44/XJI2uKD/GJ+QsJMl5J9l" +
+	"cPDXtc2G5ecVwv7hk8CTnF9RRo5te5Y7Yft32cMnkZ8hesUeiBcbPk7TF477g3TqX5DObOXUCy/CC/dou7xcsu2GV4E3yA9X" +
+	"8ddXwh/+EJ7J35EP4Zn8HakOL/+HO6wwyI/Ct1Xh5VNou+yOzB0hcU7t8Gzf+/xDeLbvSTEQMvdKeIb/i4/i18u/+BCe4T/2" +
+	"H/iPVcKzffUSHy38xNY4AVsiPTQqmfMSi2U4osxOS9wwwH9A30+yYcyCZEszc7NQVWzN4rztOTByaUpks6i4BP5Ci/iVsCOt" +
+	"+6H8MSENhjTVFao/39u516eDx4VG9EuTfASXhOvIRfJJgBG03KVya0fMl1sr8F6cvQdftoZU7iPiXmp7xvbSi1X2JRfmnwAj" +
+	"LbRzCO+1VpRjztqJw/iqNXyPdUf1vYibDKMcOJzoAKUWdLTOHHfJQYZwvYJYQ/DlPTi70m9xTZLd5gzPZXs7P03aO5uzvfNy" +
+	"dRrdpXJv2kPleI/VWo55a0fhunXHHms4vlp9vxR9Glcdej+X7R8XqtwHUTs2Str/ztN2+YVq+83FD/aP8SI8X5vBX6y231z6" +
+	"EJ7x+yWJ32tXo+fyR+nRyy+L8Nx5B3xfhv/Kh/AM/5UP4EX6r36Ufr38qgjP165G/7X/oP9aFfjq++VVx365ju09zmtVVAyb" +
+	"ybliSCXthQfk8DgyCRZQHFJ8hptMds++b3H1mB8duRsbgJEDnSvoYMO0Tz+b9i+4FH93YRoohQfkPviRcaQ5dCR74Zjow5WF" +
+	"cBPF2jf2syB1qIiehUPlR7tlnMBridebq9fecBNJ8tbVy3fAiV3kBT1jS/fIkj/EnfKw+J09AQPFJjyh3g/ihdeeyOj2OcVB" +
+	"PwXVLpK8Y/nqrXDC+YzlzuTRXtXMpFWKZ37hSfnn3TJOWPvDU+pvgBOUDJK8C1QUl3RnGSKdBffYeIVM/tzO/7IQEa9RwsWG" +
+	"J/Vw6M21q2/gqbX/iYxuR+EERUZe7IITlDCSbMcr7QEt2dnqeRW5ovMmHVbZe2DxWKg7BGsf//zz46flsHbyvHmThbCyJ3fu" +
+	"Pab29NJZM5dW0wd7mKz9Xh2vRcKlFR8Zs2PnIREf6V/+lOIn/cuWzpy1lNq9j+/deeJkj9O1kJL1SzX62DGY20L6TZ47dzKs" +
+	"K39GEcA6IYxioKcHhgFVtc95BaPtjyq2vju7m5d8atRBJvZDcVOcz8rFPsrYmKnVXzlmyW7986N2a6Xd/WcVeVBVH4to+Wnx" +
+	"BmJNa90uFbjiqTM6tlq6EDhhREr75NakDaS2TJxQNLlqnAM9o4RUnr9gMD100T/8oIrfOff3K8tf/nrkxa9fkItPr197Si4i" +
+	"hNEsFi/0PyknjHNOTEqDhi2LvAYYQQcTwszmkEaxUdaADVk9Vy0eAQJ8cpygS0J6WHhSaHKwXzw5MWjcwBJYUtC/xKXisVCH" +
+	"1hKz2dAs2+OqsUryt4j1TdvZHapXtTtUsSolLY8k08n0piiYEBIV2yjEbN4MfS5hdJxkkPcjFq/qmbVhVrxfcHJoUngYJPO1" +
+	"3gUJdSoeu5T0L1gCJQPHOfqucock9p2CEH9ZaCON26nvALYbM8tbw6JfIEbqG/xdl2f2XrY+KaYZF/5+FfeAu2fvPLbX5M19" +
+	"Fk4NIOan1tnlOErcy1gfUt9m1vd7Ryzp64/1bajBeqy8beSRNOfnrc+5B+9XcuHNYpLWl3boM5e7Z5/0WPKD9QKNn4JvA6Yu" +
+	"HLpqlmO/fO0cQyW3OmIfetN2Ofl4jJCcVMYIsTvRdVxnYTFyRb7VaBUorQFmoyNbps5Pj496+/l5eer9isgZ3N0aDbbGAY38" +
+	"mgYHJwHEZPOnGjTw9wyo567z3ECu0EgiovIMaxcbHNSK9SXGDP2FaiKDvS/WlbzSh8de33mC3VFKC/187h8b1TUqNnb9vn0l" +
+	"h0kFsZGKkgm5K5OTR5r5KcnBfgkJfsHJs0lHGjHEzavIIXdp2Ubwx58N2rZ4VM+MzP/HvqVqxpJmlbq3byTcvEas71lB5hHN" +
+	"klf1nFBCKmyEVBxev3d/iVPnqsyMnqMWbxtkTbd3zm2tGE0J+kjfrFvRBS5NrrjjVAuOgs8dfY9MTl6ZO6HEOVgKMj7oHH9W" +
+	"PX7q/6lvi9JxU6tARl/kCXbHv9kYyc2T5rxk/971h4EnyAZ8yYSeq5KbjTA7dQ17xTCtytAta7p90u0xYs5xWy48+ni7wOap" +
+	"taTnXMSahB6RZpo7RmAqSiZq6ypRXgb9HXN3syksHNzcJo7JW7C0z+bKMC8x/GvqWAFyw8JywwCCIwat4rhVw/tstsd9SZFg" +
+	"86fabGLfzvFfLi6OnF3/2GlCUXqdXqbyiKTJpqtEdokSbaA5H/gBpvBwk7kHefl27FQa3QVLKqO+NvdetiBv7ER+c24ohOWG" +
+	"Hasxdb4Y7+WIANvcZzglc1AEssf/8P84x4C51HTI+Wza7uL68RgnF9fKGCcp/rkHs6/01eoasUVn9cU92A2TXmGOBTYcfNbL" +
+	"z8+nnp9fg4SutfYmp3yyysrunQa0zsgWRnj6N2hA5f1SZpzWi2vRetPRovzvYO+3XTrGZiHHXchi4S9JDzr16a50DlKi6/Rh" +
+	"mBJEzSNLKkOV3u3FM+zRNBU7cK3+1hK7rhWOfawP0IIFnKKTDNz/xHChEjKZvCdL5kHU39dPg+zN1c0FlVFK7/bivv2tf3PZ" +
+	"UuRQK7udfUw6s3zB1kDhuNta/LH9DbQgB6dIJY1TqBIIMGweuWAPWBrliFgSOlpL+uNaFTscXdvPzIudYxpc3MRx254Ixz66" +
+	"tyqdA5TAANz/hpaIc1uw+eob8u709b/JhXkwzBGmJHS0Ftmnlsu2/t0f90X2uyT7uPls1rfKHkfD7RQeIAWbcyVncGdJjyRN" +
+	"ViVB/MDP4h4sbpU5Y922oplrwZcF1rTN5lbASULioR1ZJUbT1LOH1zjOmuXsrlq0L6SzgZtM/pP4M0LyNtTekHtzHLO3Xle2" +
+	"M3/Ea3Yu8eG4ynZ5O0d7Q3u77YnsuvDA0e7r3O4Er61sl7dxgtc5tzvB+9vb8TzhSxp/I4+qpBOBQy7cEKJR8nZjXgrF4a4w" +
+	"1ifvaQyOGI5zgvE7i8NZSlcJgYPv3VguPMblOkctZQsOZLxdTqNlGLOfEDlaDMDBffvbfVOMf93s5x765FE6QYGcu8J4lOFg" +
+	"TCtoKnbYkfAaa0l/+9npmIijBhjAfmiSwmlwIGM1ENhYGO8JGsZh7391DMa+Ri5X2ZrG8vPoXLkEV7aLMTIftouxMFXbbU9k" +
+	"xcJTGvOC91dvZ/CJ1dtdrjL4ptXxSPiTK9sZ7+/gtwpPUU32BtvJjnC63nUkGjOKEhEPsK5LQu+BfVLbzVi3bsbMtYHbUxvF" +
+	"JRSv7Z21ju/aOaZNWlqbFqn5MyYMnzVreHbs9mHJLQdt3z591uxpTRBCHO1T3pn1aUQJVXrV/j91qnW+ca78xk4LlhkYLWWt" +
+	"RFqy11aYJPLg8+zEPnl9UiuJi83+kDY7vXaYyjtAYY7IU1q7u1U874BFKGcXl9nsEpMj+h7cX4TQ60Z28QgZa53uMedU3ge6" +
+	"hIrnIKJkZ2w3hDT2g6r9PhPkg7k9U+d+1oXdZxL4sXs2YAdm8slam038XrrPPMrwhos0EyW7t3RDSKA8LJIrOeu0Qrn1dg+4" +
+	"XWGlN5fiLWY2GbgWMuj1JbvRZTRTHM4xCS6R0hnwELtzo/MhGAKq4eZekKsWUBCe3rqJN3Dc7n+Sf6E3b/n0Ds5x/psu1moP" +
+	"oA9ZRG8fe+yt4zwPHXJ42w4dKn+49Rpz/JHirQ/pt94I8RbpW/EdjOTpo49hZh86dIi60g5RV9qhhw8fboVJzOl3bSsdE/tW" +
+	"OuOXMP1vrk4PiNn8Jc+fSWfiPMudCeJfP3y4lRQzF+C1rdK5Zbqz/88lGlWjk9XnFb1HSjpcI6WTuiUPUbfkoUOH+NdbrzGn" +
+	"H0za+rAancBwxog5N6Tzt4K9G6rESmehqHjkSIdnauRI4cHo/P7MOXUtv1r8b4P/iv81qP10H4kA3pafmv9hDHAqfR1Z+f7Q" +
+	"TYYMYn0xhPgrzL5UIgRSbkmju9L+YqDBHfIbzV8JHnfKK81IIcz+TkAIexdkNx3t9z/vhAeoBosroiWOTaCdjfuREHhgLYZr" +
+	"pInwINM6NT8TTxZ1C7zA72SLkDvyYBERah1lL5a0zEini7rx1BbOJIffe5AXoCJvQd4DkA3RVEC9T47GJfv3Z8JYkOfv359J" +
+	"SsmDfHx95kzrmk2bRN/FQITwFVzA3mFxrMa1O2fUWPDXz/4sOnpkwj+Pi3buwAXkEjnx5g2kQtCDB479QHZdjImuvse7HKV7" +
+	"PNXHCrk8hv/F3u5azNpDWHucPKayXd7O0R5vb7c9qREpPHW0Jwi5le1O8ImV7a7FTvBNK/FUwZ8sjxHrR8M/XDHeiDiEOFo/" +
+	"mltWMRRv3CH+zgbcRrwRycXfUa+bG7efTDtEivHGHdaa+A2ywwlGTPMF2+F0DFZoTqaXP6iEFr9g8LJzeCOqWwVe/EZ2i0wv" +
+	"v1/9qyp9uXTDLO9htW/F712GsO8/isGp/xqv8Eak+SgOEU9NJOH5T0xONME/3EZhuThPLHG31g0mHYKJFe3xRvza6rrDAScY" +
+	"heXiPFFnYyXsg3KY8O6YCC19weBl54Tl4jxJ8FW+uV8OE942qvyqal8uXYXl4jw5fVvte4rhn+VVMTj3X+OVsFycp2o4PsBD" +
+	"Mb1BH2JypskG3A77+N2NdO45nbvWDVZc/fIH6EeGXP3yB7JWmjI2vSJvJdjH4W7kjI7vdJXf3qj8/oYTDgeeSlyya8Jy1MAJ" +
+	"V1V81XDe+OHkVehPhlw9efXGR3BXwV/Zh8sgYTnSVevjw34+0tdN5/7+u88P+q3suyYSlqOgj/T98f7/g4YP6Ph/puWj9Nhp" +
+	"Qp24jXwzB8/b9QiTD0mVwFaSs4MmShLhBSPfrArfOusUmHTofjn9svy+41vH95U4ZGf4ZuI6V+XXKjLOcD0oh/FkZvmDaviq" +
+	"4KzE69KFbyau7Yey8IEOkfDfK4cJZHr5vY/28UE/lX3VeMU3E9fy47L3Ub3l6POB2OuD/4d+P9q3vX9JVpW0f47ddGnVWnd6" +
+	"2UU5pt8PX86bx4Ru7Q9fzp1r5wtSB/5ivACviBK/QRyT34nCcpadQYkQNIAAatdp1dQS0wKnhXdfHKuYCLHgfwX8IZacJXeu" +
+	"kDvkLD73OYyGf0h9+GmHg8HwAtLArkuyhJN4I6ot6XCtSUzhrnUTfqjoBefIKzhXcZEpbuKyYwdxwXSjF2wvidrlS7yR1fNr" +
+	"gBqytaR1DGqADmgiEbnOYqA+fyNYDEaNhdNYDBYN6NxcLpM7pBHcekE2jH7+vLDwxYtCsuEF3CKNXkBv+lPhi8LCwsLR0PsF" +
+	"/LNjB6lL6sLoCW8nTHg7gcyDP+FPMo/+ROpOoP/BaIRqijpabkFBKAwZkQXFokTUDLVEbVA7Oir2GNz+x6iu8ketc/xhU6k2" +
+	"Bkh/hP/4txtMKmf/Sfpa/I9qf1nM/ftkCP3z4AHX6OP/xht3fOw/7o8PmhC2vUTbecJsCrkYNU5XaCu3tCK/XKj77k9otwP+" +
+	"3YEA9UMx3Od8N3Ynr9aCoQZYgDtKtpJh/WE0mdcfFtPVazYSNsKGkaS5uO4ohksWv+G0oKkBctC6QXdY3J/Mg9H9yTCyFW+E" +
+	"YyNJb9JrJIj18c0I8bX4XCRHNRHS6jgtp2NhCHyt/d1I05x9MP4ReQJd9oA7+Z3PtbbEgugXrIEQ/wPLr0lvNYGxmf0PB5xR" +
+	"WRN2cW4kEE6RBHhsHUPu58GIK7/xucvJ1eVk9jKKj/veehvrxZyk+bYnsk/5IhSF4lFrhNgNj4EegGnAj1wmZ+l3NR4a8X/0" +
+	"WiEI1Kwak9milokFfGiQFq3ZYwkQ47Poh97AbWr2ZeGFwjH4revhbT26du3a9VjPlcOHpzQ9PXVXj54FIx7/0qbN7Kwtbbtl" +
+	"ZnSY0rOXNQXatC6a27FzbUg5vy4mpu/cjp3Xde2xZOm7rl+eb1DftDcwOD5uzNix3XvsOJ6b6za5RUpo6C6Dyj08HGat6to1" +
+	"K2tDbv+i3jFNhg452BhxyELfafG5qCZSIh09hdDMR4w3aeI+pTHSopKLd2ha8WAX6YGb/PLLi7NnYe+127evbf9q5KRLX5H+" +
+	"w4bBvE+/XHPoYP+tPfH6JeT2rssN660euXLrv1vxmcWivd3P9oSbzhcpZKiFWHMcIe4fPlc6lyilYKoA+4tmSJ98+vDh0+Q2" +
+	"1AM9KMnv5C75hfu+4ty5G9fPLSN/0myo5A+Eka+ER8yxaP+cnuu0ap2ENgiU253xzJoFGXsZ/sl8rh0XvN66lWyk6MkFBIy+" +
+	"CD6XxQs4MdB2PIkEwlckER5ZxzgYhuWMfML14yegCITEeDy5GJAnLTtdcjEHl2P15SZWQI85wsf1M5sH9N+fsiY7K7VV0uCE" +
+	"pIS4vPkzJiS1bNt2due0tkkd2rZNvfHLBMzVa5rUfUG79Hr1tnvXrh0amtQiJHi1MTq7a3S0m3vAJ1516pTvGbIUIYz2kLbQ" +
+	"mc+VZJmGxZqM6r3btm3jc99vIm1//RUBQrYnmOYJViAUzwLapIL/zL0zvUX3xobZi7YnTY0/Ghef1uImHmedRX76LVhcz+0I" +
+	"8Xo+VyFD7xBCQLFxK/kiqtfdjUqLRs5mTG6wbN9elHISp1qVfZK38UWkb69Bq8jgXXAsfdA0O57zFA93xYFXzn7+3s43PMf4" +
+	"piP7eaTtCbeY/ow9GB/1QyH8HD4C1UQeLIrSV/LQ0yoNfsgUhYyRiKu4TFZevnLwwA/zyc///kt+Bs9//wVPHLZ89ycrlu3e" +
+	"vewP8hLc/vgTlOQVYvptFD+H+w3Vo/4QWjhCxKrRhYKIUKmS8a3WGQzfk0UXDIa1vr7g879/oH7DUenr2x84e/ZA+/Xpadu6" +
+	"WyGQXCXvMkoRq9wm8Gf5LqgGckdB1JdZByRCWQc03beB6g9EL6M0cgPVMTTrucVg4TpZX+j9rxxsOXBegP/VE037NJ6d8umQ" +
+	"f8EzNGxVy80pnw35l/wUFrqqBdSDnxsPaLpstyoncEDCqp3uqolmM3n1Z/eNzeb1XGoyg9sfuZsT5+UyndbP9kRm5YuQHoWh" +
+	"eBajwWo2SsrMQ8N4U+OhVIjqyyDqPItdi0GkxawwSOV5PPrjt6453bb1PNil67Gei2+3Surec9KP44uKxv84qWfPuBZXplwY" +
+	"U1iGW0uq69qMtPS0tJkz1qalYdT1RN8+UVF7A4Pzh+abLetLyO/kRUmJJXrI4OFLlp5Z3bVLVtYGcrBgFCwdVfDL+XOjRtP1" +
+	"wWE8J/KaI4KU5VPlOq2dMWPd3tc/3voHh5379edvQEVznxMpDofxEg5j39njUGmCcCWo102fuXbfmx9vvRG/Iy/IeYgGlbif" +
+	"zEKIG8z2IbuuYqoq0kPBYlog5OeOXbp0JKNg/s69e3f+vI37mqzusbvHul2kfBdJEHGEIMRyoHkgLUJAGclilqI15DI5p3M3" +
+	"W8xsN6HzGQswp2e/3N6noXvZmiyTqcnSfevDwsJh54HB0TEx0YMP4NoN5g8s7dD+/Sb4McB/BHkEkT3MZvgmMLBzp6CgD/uj" +
+	"ddw5o7uHxkMKSGFX0qC0d2iEORHhYWHr9y1tYjJlrSkjW073zu0HN6X+cCel2dwDIsmjEf4B8OP7Te07lA6cT/pAh6CgTp0D" +
+	"A202ZEaIq2Bye5PN9XbbQ24324ORv/35jK/4ngYO/Tpg+xky7cz2iQtL8QFr7J2HD+/gr61ppQhQCWrLb+fesBga+yMS+ppE" +
+	"rWM7EbZZN+Buixd9stz6NffHupwut9oemTBh5e4jR5I/G3XqvLhfl6B0WTD3L9N9NA+cyajmTEa18BF86w8dOsRFWtfjnEWL" +
+	"dy+znuH+WNut6y089sIFMv7bb7cemTBh+d4jR5rvLzx5XuShQFKPNzK5QSy+RF751oX9Q9Lvjh7kMq7LsMH5w76zbsTdliz4" +
+	"ZLn1LO62NKVlVsYy61nuj5KcLtHRUCvlZPG+nj2Pjhy9qOxokyZjFnTqdPRo4v5x4zMzkM1mt4MUMtSF6cA0mk+G/dyV/Uyt" +
+	"znHsZzE/1BDbE66bmP/d4sG4NQhM7gEmrZqLZKP2NUxYcqJgzLA+I1+RWvjAq8TZfS+SrYeOJ/f9ou9iSIBly8ip1huHbiq3" +
+	"MHybJd3ueGe6Gb+2fsUX7aoIQ1Xow+kMnsrLbD7Xnu+Icjk3eytJ3Ur3Hu5wRSte9HlJcqWQuUyW6sIg7hXLT4howk9pN5LL" +
+	"5GqdSW/Qs7gJjYcGvt+WZkzfO773pm0bek/Yn25sw+daI+qnFXwDweQaeVEy0fPlS8+JJaCiP58bnVbfZkPNEeIx48/Loo2I" +
+	"Szil8BBhalsCPSqBzqCV62BBCUTfK4ZmY9JIcdo4YQTJxXUrjt24gTDicQmvFR6KdiV1cUtGgYl+p+G0St6D5MAyMhT2We+T" +
+	"691GQ5/0L0AQHvb8tye50vPGDa55NmkFh1ltQlyCvxMesnoPepb/xs46nNrxzwCtZE/azbBnh7sHh4QEdz+8s3Pjxo0bdyZP" +
+	"IaC4ZWqAf8uMHusWkst8ELmo16e1CwiA7IqGDZMSfRt2N5v6tg0NcXUbmTF8vKiLRuISLkbq27lnQ6X5+mzuvo4dOnTcZ33+" +
+	"z8iCglGvnwvTrIXp7XbuSE/v3q/fzVv9+yFgY1guPLTbSPbpGIk1ldNgHzxCgFrjEjxUhBffHbPDnwkPsT6AvWQoLCM5wsOK" +
+	"Yz0hrOe/PRGgJDyfCxBesWyVJlreRbKlxSASjYdGrTfoHSos0owPfAEhzdq363Zu+PDhw891at06MTG1ZXpJenp6eklcT879" +
+	"UXeyJkxTb2TBhe8LRqpUhnGmBg0yMnftzMxQIWk8/0rjEZOFMeYdWQAbR40ivQqEh2QnZFUcg4VkpASPngkPxbxhFK6Aki+e" +
+	"c09DOftdLfY7NqcsY9rIgtiYKcH+bRKFhxVvVjVPye08BEm4ODe2JhQejPRcy7ouWk5y4NQqMoh2vxF6kfEkmU6uRO9U4SF7" +
+	"48sEc2QBlFj/FB5aG/S04xxnp6EBLRmt5HScUjdy163bu4bdLrgzTHhoPYebVBzD962ncbzVD9lpwX8LD5mP30j3C0bKWhg7" +
+	"bB2ZQ79JwV9UHMNfWFM+gKfyTutJKQH/bxiZvXYYjBEeirBcc/qdmMttPtdBeIXqMkl3rJ/Ihf6+Ig/6GvQK+GZC61atWk+Y" +
+	"2KpVq1bkCvQdPHjwYFIyeLCwrFvOxg053brlbNiY0y2nsPDs14WFowthvhg3gefj5wx/0of4OVnVnw0mu4xVmu2UwSxSYWUj" +
+	"rBgQHx8fPyAvIS4ubm7riHCzX0FPsyUooXVx5/YR4Zm9zJYuWWXb9lssvXrpI1onL+UbBwW1bRMcFBTcpm1QEJiwThesb+zp" +
+	"H9C2S70gPjrGPLBlZKSbuyW6/bimTYvHtY+2uLsZIiNahQaKsolXsLl0yKZDEzAuUuqeLW4fFBwc1J6VHaMzs3BxgTCNnG3c" +
+	"eGXjxt0Tk94kJZL9djmXCQ+RhsXeedCnQjpaL1oKxBWH6zh7YT7AT6cbNX5ooKFR8wkFwC1JbRmgJ+sWd8zI6Mj712rcqOXT" +
+	"G529GpCNXPO4uHHtoqKgDbnZtlXr1giBrQKX4G+Eh853Djz+nlwtIFeFhz2tDfAzkVf64vn4d+GhUzy1qObFFTf1K+iTPywi" +
+	"oqBjR3KyY0fhIeFnFsbGzoQK65stnTt16ryF4tDjEj7Lia+pk4s6uAwFn3069bPPRt9Y/NmhxcJDazw+Tf9UHMM3rHRuU/B8" +
+	"3EPwQbVQkJ0vWP/xYFHqTDJp6c2xokHuOKFpPGDhpIiI1glZUaa8PH+TuW2fgsWLlvbMNEcZw2PMJuPc5s25m17eKZak+Pi+" +
+	"miCytmeTBg2GdBs4pGbL9Nq16+eqXV1btKzSfwhCwPhP8gPIlUa11JfdfSDa2ZXuA0pBYmaUaeBASkHvmCapLStpMEfObZ7C" +
+	"XRVpiOunCYL+lAazuesgs9lORa2aLVo65GOs4IPcWYUVk9E+eINeIdLAqzQeIuP5yXHdgQO1EcYWBa1SU1sVtDBGkBNhYWXh" +
+	"YWHhZWH8/p7WBT2iGzTo2g0adevaoMHmp02TkprSvxDi6Hj5aMEHGVEcokzi8JvYjxrSkDX2g3AVxwmF1gdIB45EcDo5ewNu" +
+	"FTiuzeQ2beGhLCYmL2ZkkyaxsbG5LVoEN46P7zh4DJ2asPAMy4Cw6CFR7ceSlhAalpZlinL5fnJ4RIB/YpYpCv/PGNWh4/zI" +
+	"wdHRPt696tWq5adt1sxPm9eDTZjBUL9+rtrDfLtzpDEiIttMJ9bXl84sQhgNxPNxqYxDrsgbIcRmScZmDEQPkEI8+1P/AzbR" +
+	"yQoNDS8LI9/79ksZlZqamjoqpZ/voEFcn6dJTZsm0b9gQbS5azdyq1tXc3QPboBjjWSctEbK/+MapbZqlfrBGkGnijVVF8ne" +
+	"r9TPAsEH1Uc6ZtN4aKoynn2vlUtbrRzPJ1p5TExeXBNLdHRsbPzq7C6dOy+a0qZ1HoSFpmdFmVy4V5GDoqPr1mHTmpW1Ym23" +
+	"rh06Du1sjIwIz0bIqU+VaJ0zN5r94CkGl1jY8zpLEjyUjx4QPzy6SWHc6k93LJqyIg96pGUPlHNfRA4e5u3dq169vSvWHtoy" +
+	"tHO/3tmifmmD5+MAoSGKRMhd72wqhDqdrtQ6p01BGioDfZjkp22aOCwoItxi6daoWaNGBn1yduf0xsHh4RmmiLDGxvDw0FlL" +
+	"9I24l3X8/aPaBQfL5bV7KOt41vc3eHpmeDeMMDb0da2pzlXIXfr3imvcWKSpFZ6P/YWGzDcknRn8DHbf0MddQ5WuRFiU2Lxp" +
+	"Un5QRHi0JSS0cbN2ekpSWuOQ8IiMqIjwRsaeoTNbtzIYuGO1U6PahQTJ5HW6K+QuUYwiL9+ISN+GNV1VuXUVRqNIEUaJsIfJ" +
+	"f20UIfKvTmRgWl+/KnFUN1ZlO43JiOuGhmV0CgsLC+uUEbYqNXWFJSIiNDQkJHSA0egbaWwxumVqasvRLYyRvgO5oQ/jExLi" +
+	"6V8tNqW3q+Gi6OdZyzXKNNho8WqQnQ1+2dkNvCw9EUZDYQe6KdSt9FepTUZ1fl5enlDXuuDv4mJ2149L8HoZV81GGjgILDPI" +
+	"Pug5m5wYJOOsBGie7c8gklwUv+FrCD7IT7RNHEHJRrXo52B/O9JT4aF5TZu37z5okK5vSvNe9QxDW/UdnNe0WfueHCEbYEy3" +
+	"rME5ZAMu6NXEkpKckjxnEpkBY7pmD6b9wB48SfBBPrSfSnPPuUNJ2+GJYaH9F0+PC2rRsnOvQYMCIo3NhkZH8xoyfqzZnG3O" +
+	"hOIuXaRuor28kpsjh8zMFnyQK9L8Z04VM/Tt3LtXRqdevTrNKimZNWfdOv7c1BEjphYPHzYta++6tfv2rV23FwHDZRF8kC9C" +
+	"7k67TpV1t3NiUlBoaMTyjIzOnRdHhYcGB4cEh/aLjo6O7hcaHBLM/6OqWSM7e82qLl1q1lT1rFerVmLST0mJtWrVE+OeuOuM" +
+	"ZgVCIGpJtS5K7C4c1wuP2B4Rkb9uzqx2adz1YclNmyaNWLc3K2ud9GaYjpdj8TruoqYzsVRMBr0pfJ/06ezZ6elVP8VsHUoF" +
+	"H6SkutndsdB2pezvpK2vDhrkpJEh2aGp8TtygOvd06GTnRQmo60hLoHdwldIhZA7i06SIpsNehMLKLp261Zw0pAhY0ND27YV" +
+	"vvqsPdmdFpwZElwIndt/xmoH4vl4jFAXeYh5T+3GaKWS1/fJ0/m36BwRMXCgPjKy2dAR3HIoIrO7BIeYTTnQx7ogt4lXAzEn" +
+	"Rh98Dr/kryEPMUfxR/wP8IRMgQndQkLM5u5kNS7qHRPdvDk+N0Dn16JTRPiAAQF9mw6JjhblawFfQ1CwyENJJ1Yl7yOUYg+D" +
+	"2dK6z5BBvr7JHcLD8ny1zdqHhefl+UdGNm/Ri1OnaP3mTobxZE63oGBzVA70Ef9l7kqH0Tu2gVcLcU79cAleINRFMvv7PJ3f" +
+	"sHNn839fKNS1/oXrWBfQWHnYg5fKXFEd5CWurYLtTB4aN63eQOvcRwkmD1aO7nren28A+mwAIL+PiUocsYX8e2nGCnya/Mb1" +
+	"AHRrTbRxBXkDR3rt31CrJ3+4aJ9IgxvsYTTUQagBNtJQJU7UzBfmn823obztnmavRsP4PWQC/GRd8OoVXGuZXSmbr1ADZlfS" +
+	"ikvUTWE/xYiHCVMUfQ8kk/SsePLQ45jYWYtXFiZ3SGtH/kxPT/cJzH43MYX8pm8YER6R0amX0ZLqL4xslBxVX53YpmQ5zTyz" +
+	"dmR3f1Vwu9UG8q+yUdLTxKbFg4abm8h4u14/JqhQTdSY6gi10n7EsQu4WmeStnS26bEdUBkFNwIspojIRj7tWqWltRqVN6JN" +
+	"WlpM9wBteHhwIn+sA5kcY5o4KtGY3brdttVdMx4/ysheu6ljvCKnVnDTQSOSYmDqTgRIy/SfgmknA6dzZzNHTxzuRs6diodB" +
+	"Dxsz9kz4J88ry6vRMv/Oe4te9/fK8jIsw4qb5CwcA3nLbOC734QY0pz826ILFt9yDEUI3eQGuMld2yFk7cvaBiIE93CxmxyP" +
+	"drT1sf2Bx+ACN7nLMUebH0J4AWv70tE2xKbES7lhbnKXk442N5tSgvtKbKO8ZlPirbhYjEEXtaQHuxgx0L1CqExibt7kl6nN" +
+	"K1i8uKBlH788+K5nx465uR079sRck0jVIXKr3BJNDmC/qUUjiotHjJw6lcXcK/ExPNZN7nLaQYPWpsST8Cg3ucsZBw3bSSYf" +
+	"whchLyqR7tTFLdkxMm9mabqxCrvU0HS37wY6P9n2Zy+y1rVqtbD1llLjpuGfP35ydPgmY1nZipkzli+fMXMFbgE+oPH2ORAc" +
+	"ePzLbn16k7PESirI2d59dmHd+bt3z5+7e9dms/tCFDKX1Uw28hDCzflc6T0nq09C/2jZ6cGozIO7q1evXk38N27cyB3bupXs" +
+	"3koebt0KvluhM2L3HyST38wXIU/kR6UkgOVBNzq0s8ao1okKBewmqHRMkMsgffv48dujNg47XJDjPmZUzbyCw8M2ArFekjdr" +
+	"M31a22Trbdy4jqXttOltondY93J7F8OFXX17lRafXvzbitJecHJv/1Gj+kPC9MxRo8T8d8UkE/6VqcSYVZVMMr4MFua3+GdZ" +
+	"aVJwTz89B9u2rd1742RJuHm5SqZ6NxN9eHZyVzvt69JNifMOsyMvzy8y0m6WR0ZCcnjYtvDw8PBtYfhdT1wgWuXklmiVVx6d" +
+	"nPZob4TA7uZyGKxOTww9sDk4OCSkP92T+4eEBAcH9TAt2vnJ8vDQUP6f3Hq1aiUl/pSYVKtWvZ4q1Wer1uyvUdM+jjYMv5zp" +
+	"LKo8WRdqeZTFnjjEycFG9Rlr0fmBxS+oE7SOCeMz5b5enT9ZHhYWHBIYFsxIwLKwYTsBjofil66+gWt99Tg2zN2760rarypX" +
+	"U5vZB1joPXegQ2/KOKREoeIdhc5+Jmdqy+LskBHpMcgNLLxDI4c73vU8NT2jTH06dehTt67QUuvboX3x9E0lk2rWrePZ9ZD7" +
+	"xgHFqcfct/MlNXkhPm7ooAkTBjU3RW1r36F9r0VZWXsXzd+LgesXF20c2SHBbKlydqhyh2v4yCXuRwx1GSxuqW/UqvWskPDw" +
+	"yEbh4aaMiLDgxmmds5LTGhmaNQ4NsVh6BQ1Latqce1m7ceO4Xkaji1yRq67p6tvQGNHQOyPS4F/fUy5X9qhdJzi4XVQqQoAa" +
+	"4BLYL+OQWszRRw++VOqY5IiuqX19oz08du8e2KdPZMRgGZeh8YiJbm+9h3XtgweGhSMEtuewB2KEusybpLbbpdSsZ9KnNKpv" +
+	"hIUMaOQVp/YYMCCvDy5xpUZoA68M1/ZYZ73XHlWeF+qiWiiYeWIrWdFd+Z/nBUYgrvuZ/aAQOvC/TgrcvefiESEeSq13NqW3" +
+	"c6lR9ZiwpDg7m9k6sOe/7CblB3aTMeIDu6liA7QTDSeMmuISyGdn+LrshkgpFjfTmej/kvsPzMkZOHAgtyYH6pOfcqxWPKhY" +
+	"tBGG4hLIZnOB3JX2w6TepLyhVsc3aNQ/R6hLhmd4NcgaMgYWU3jWj1AXuVbqTaozkyGoZ09yvS9/ICeHpOTkwBdOsHYdK4UK" +
+	"aaVQIvYN+6ov++5EzuacnM05JxBCnG0A7IF4GYc45CbWPlLrOD9TFLvuE3WrQecnTlh+Xl633Wvy8wYMrdkwxG1Ac4xbxKlr" +
+	"yrjiiuAJBbjAuiArUWda7e3foDYdL+yBbBnH8v0ojUp15ZDz86Qh46dcw4pHnTIbNMgeMpbd40i0sNySKnk1BRsfGta/kVes" +
+	"Rz0O5+Xhv3oxhvPp5MoNqHgl3dPiEtxM8Kn0a/cfCYOJTvCx3mN+7YGwh53pohAC+/lFqbIfufSWKMmvxo51Bsc/K09gOQaL" +
+	"uVVfkzmn/5LpcUEATZnNqvVt3j4sdNAgXWRkSvOIcH5Vilab0nwSGT92SLY5M/yX+JCu2SZzDjsIBgeZzNJR0KuBnw6hSrr+" +
+	"j/az8v9oP/tp506CImY1m0wO+9nUDfpUrIcOMaIBzeQcFws+bFdyOn7a91fnqZAkPihP3y85P7rJQJ1/i4zwCLxEPH7iSb2i" +
+	"o5ObT6VH2y7BISZzjn1sHBLPjPCxOVU7HYKwWqdr2Tki3HG4DYrzatQ/NIx3FxFWnm2nkvGZ5myzeaxj3ekZusq9Q/+1UGfY" +
+	"WvJnvuBD5PDWOgneEZl4z2s/E9eVPNxO51iqfTyogtbqDRqHRdF3Vru0tHaz5qSlp6fd3R4RKIOBssBgsqGmX0RGpwg/wScr" +
+	"a91aZmWvy8rKSmqS0y36VUpI06ahUn+wl+1X3kjPdiwdE1Jpp5BLkio5nSwapVm6cFCKVj+Ezoaxs/slpqUl9ptNZs/uG5ee" +
+	"Htc3L9wvPNxvdZhvRLhW2J6TM79OC0pDyzozcnJm1mlBKUmp240E1gluOnxY0xDSBK7VCWz6OCmI2giwBwcIPijGng9M7pwQ" +
+	"jE5EGKgdyxKPK/1NYfYIhEgPjINzI9P1jby8Ovvnh4WltCgyRtcKjp2+uH9oWEx0tKV1Oy/vXgG5mYmJo2MG8NbeGg9NvTB/" +
+	"tcrNpV5QYFqW0ViDk4tr6DfIu27dyJBAjYfaRdW5VXZ4uALZeROo30aDkDvmoixmuweuklPzASJD8vv0jVZrsMSeeAl0h7R+" +
+	"xnC6q1lbM+6Uzt+wh52/G1Y5fztwxUP1o3hY6ABDg7ggrsqJvJfI7672gzlGnfF8ziRQbtLa9wStY18QxTUeGoPdcZjRJw+a" +
+	"DRw4cKAhMjJ56AjYRU7odC0yIoKlbUPcOthRvVjAdBMKs/s25uHFQl1272syqj+4kDKqddWOj0nvE/wDIiM7dIww6htFv5mR" +
+	"5NuwQ3sbat/Bx6cZfyCnyBCT2KaLxWy2dOkYnaAryolOTGg7rWPHDh2ndIyLjxHP9nNxB3FvgUj7jYs4T/QaBrq3ajowPmHs" +
+	"2MT4QUn4cfuuMI0UFCUkJiYUwQIysWt7ae/D44S6zMdl9LBfVTi0nMNHb9BDs8NNGzZs32FKo/45eWpNrHeHyAj/EFMQf0ai" +
+	"jG6PdKP0AjBbunSIbtaw3gd+AJahx92I5+efPTfs1UJcgutY/8IFIlwELsHhQt2q75CS4A8yBuYQDLPJTKFuDxLfsQecFuFh" +
+	"D/5Ush0YvK5yK4sHp49BrY6vbxiQQ4ZUInEV9zZXhgyjCNyD9c3810CdWhadiXpf1GA0GyPVKnqTborSySATZ+KLFA/F3Uc3" +
+	"YepxV9c+jSJxibdW690DTnfsQeJL5w7q2NAIjMYdOFeoi7SiLnO2QH3AyQB1Z101j2sVHRGalbnR1CQ6LmxkwhBLk6ioHn3+" +
+	"JjPhFbfdVxMfmzahWfOarqqhDbwCA9u3DmzcHep17MH05iI8W1YLuSAFO184nP56dnSRuzvcZQqLGdcjq4RAqh8DhRr6iO0R" +
+	"BpeDkhpdl5bOB05Jy8pOuz+0ZZfsFsNgWuEY0mPMmJdQOqaQrVPFEf6lbZ+sPxJE/5TFSH6w/gSPV03kXy5/Wu33nFGjVVtX" +
+	"3Ju4Gh7xL2Hs0+Uf4nDXGbQm8sPdiauIj6w/mf0xGK1aK9dZV2BP0nD1RFl/1s9m/qXta1l/dkdtZO/EOKOG9Jlxb2Jp6cy7" +
+	"E0sprtevYezrarAai5Gj0O6ELy2deG9GaenEu7L+r1+L0FI9QuJO89AoDPgG3wyBmwz5s/Z84s6d5otoO/eMtTd3vD/Pl7dn" +
+	"70+b0VxkKIC1b7M94UPlHRUGfASfgwQ3GUoR4ckcPp/VpwpASCHH1/hQ1r6dzOFDZSo3PUqR2v0l+JNV4Beh2RL8ySrwi9BU" +
+	"1j6AjOa3yocoDBodfwYK3WRIzI/bj4zmpsv6u+nRToQUMlQYh5xq8on05zP6DY4Y3+fyOZR+oR9rbynRM1esiYiv8fkIKuHJ" +
+	"XO65TEXbudcInOC/kuAfV4P/SoJ/XA1+DIV301M4hQyN74gd9D9n420ptv8j0U/UEv771fCrJfz3q+JHiNe4dHXTU8tOIdN0" +
+	"+8MR08zXkvdQGDTdbPdofmWU+lH4ri+rwLvpKZxCrulqu2PjGT1teI1wXYKX4/t8E2Rh9LTha/FFdnh8n49m7QdJYRX4/Wiz" +
+	"RH9hFfj9aL403tG8Rj5UYdA05gNhtP07NB59Yp8nvpYsVfpOj9eOZ7PVXBoL97mUo4Gnb19RY/ZNIEJ4t7jW3ES21mI+iQG2" +
+	"JzyR13PTUwiFHM8Dd/BjfWRyz4U3bnrUms4JnpdcWaOnnOXlC+SXQoEjJ8M2hLi/q8hCG4cslEu8VIyQA347mcP9LfISXx+h" +
+	"SniGv72bnsIp5GgQeglJNpvtJcOfrjDgo1Xw1yWjKH4H/HhbDZvNZkNARlH8bnrURmwnFaz9IGniDI8f8HMkepo4w+MHvFgz" +
+	"BZFC3JHBB4trZGP1DGw3SSEOZPTvt0Ux+tsyeBlC2Nulqx1ek01YLmfba4RwLbmHmx79T2qfS45J430oyXI/JsuhlfHaYjvH" +
+	"s/Z0x3w+ZPSESjok16Fb9Iz+dKndy6FbnOEXob8cuqUSXoYXDbLL2hxaF4Ktiz/9jb0+BJkDPcT1wjdYe7uatB2Tudxb+WCF" +
+	"AR/CP8Jo9p2OySL7zmYlc3A9eYTCgEbYEiBWIathstNF+3Gj8cwiXVPEeSInsYL1s8hmZvPaToJXSXTNRrPf6h34HxEV9mXw" +
+	"s21Rb/WV8AgJ06R5/YXNX7h9XmUDmI5sT/tFhTDECV6Um+cwqhq8iunIjoye9vb5EKZJ8/QLm49w+zrIBrA95JrQjrW3d/D1" +
+	"XkkHFzL5i7Djl+TgCK9m+Ds41nmvhH8SwxNRKTd8kV1uFJXwJym8mx5FSPN5377OFN5NjzqI6zzJvs4qCf9s9IzNmx2/SsI/" +
+	"G81k7R3sekJozvg6kunI3JxKHcnJa7npUUfG17m2z8lnDvhMB3yPXs7wdSV4AxqE+iKbQq7x5xFy+0g/3Xo6f9dDYe+nm+2Y" +
+	"jf8IfNceVeENmq62kzbe8R5BzFMo8kUjxhdGadyZfCjji07i3hmCquc5FXMHVoEX1y2MrVsnB/wFST8qmH402dcZvxHhub0M" +
+	"PsOxzhekda7JejbZ1xm/EdcZs3qhzvCiPKHhTJ6yK+GF1wo9GqG2KWQ1uiDk4IsLjC9MEl+IOUrbkJMUv5seZYh8MV+EH2d7" +
+	"wgdL401k4zVL9zVPOEkOuOOMfrF+yzgyhw8W/mD0sxqadngyl5PkgPsCQSU8wz/Uru9oP1xLWFqln8lsHTLZ3rZudSaVUlSM" +
+	"nOhzyVLoNT1/RuJXlfTJv2H0nYJDbjLQVdInjSeAjqdGbiV9wn1xPLZv3WQ1etF22zuE+CESPKLwyMLgIxDC28X9E3/H5DdL" +
+	"hCdz+CHi+nE2Nn4JnszB24UNrH06a7fDn6TwbnpkEeddyoMbQU7iy8IGhR4veo1E7BK8SsI/Gw1g8mjHr8I3GP7Ztt9YO8OP" +
+	"NpE5tA6nwoCv8yPZOon1OEvIHO5Xth7XeRfWni3BF1J4Nz2KFvc3dFuCL6TwbnqULbVPleDbVIG/z/dhNk4JaVMF/j7PIYs9" +
+	"t5tLV4Vek9tYokbEb3vC/Sp/odCDuG8Xolkw0wEfpdBrMkM+gJf9rTBoMm0XyUkH/WK924n2WrB4A7oOLewx7jKLmx51YfO8" +
+	"obfd9p/DbH87/DVerAdgJnNYTLwI//+h7U/gmjq6x3F4ztx7EwGXhBCCgEAIEBRFJIQIqCAC4oagiIIICrjjLop1Q1zqLtYV" +
+	"99qKGq1aa9XW1rZqrd1r1Wpbu7i2ta2lrbUquZP3M3NvQkDa7/M8//dXCwlnZs6cObOdOXPmHCW+zN1n8K4IKW4pp6qMulAh" +
+	"H+Z4KlB8vU2/YrzKiHqg+0jwdNj21+c3NJF/gsqIZkAhUjbKn0FXQjgL3RrlVzF4CEJP59e1E/JhWqP80nr0tmt+skxxi/VX" +
+	"vNQuoUjKT5bRNwmeYShPgvNnnW8Djsvjvz/Dn8DgZoR4pTz//2D4h7rkz/AMQwmyvHYcujnzqzzDaD6VAo2T9gu7DSF+nLwP" +
+	"IjaPusj8z+Gby/hrGX4mZ9FVm9/G1oswPpXl71r/hoKtE8NY/y7VSf07gCzjt7H2dpXbNUzKT5bRNxdyftq/5+X8bzfIvwa9" +
+	"Iud/2yW/Aq/xQfX7qVNOVOA1UrsQx9YHyofBEh/sydDN0S9KhZPP2yC4vr0Mzjws423TJTwT7bf4awx/Cstfwc1sWn6H7o53" +
+	"fPiggr6ypn6GlUaLdNnkVNmpLToBJtUsTn5v585t1u078gzB7/QgNuw1Ztx2CFiTcepAdXjb0WT65rGfSu9lZHw6hk9+FR3r" +
+	"VAEqTVoDTKrZuXPn882TMhg+P+H2XS51zco1y9JyqsODUtZIcX5ZTLk/kEWysGjJMY12N84UzevoTXyAwKygnBEQIpXmmFhu" +
+	"W9G2+PxB8cEnYvIWbMg7C+ro13/sWjh9+vAu90/vfrVoZ8mQpaGJA/LyBiSGvs8/yPNJGrN3km0pt3bhpyvyw999c25hhx4d" +
+	"OnTLXpn/4vuFn6WmzRhizjS1DQqJTh7dY82perlAqFUpuF4N1gO6f3Vj486hC1gm6wIucycQcuoCpPWm1jMMsRiT+HWqEZD6" +
+	"jTurmO3st9c5l/jczeLl/ArdCFTfzxbFuyqjboT9ebJC1QC/S/6i+vzcWcV5lYxfV2TfSVY68w915h/jgt+ouKsy6sY0gb/M" +
+	"mb/YFb8SqYy64ob5KX+UEzzD0CJ5Plnq+cPm34sSnDvpgj/PiX9kA/y8yqgbad9GrI3oqc8/6qn8o+zbn8q/wpl/dIP8ZpVR" +
+	"N9q+86n8uc78JQ3yc05+ltBanO19zjMMnZPbm+bS3kDPMPSu3N4LTnlMx/aTcDl/uHxGp/vJbJXjbH+Z19XrGtj4CZfHTziU" +
+	"yeOyuaJeF/A67+OSn8qxITSnpwKF1+smFBaVEe9ASxFqoMvY2Cxexl8/3mj+8fJ4u8L611X3UZ+/yFVXojivMuqK7FfIygb5" +
+	"ZzYb6sw/xiV/vuKuo726MbQWJ/4yZ/7iX1zwy+PtC1d6KD/ZeFv5ND8Vo9g81SGkCnM7Ia37cyV8WQjBHWWt49yNX8eXGF+7" +
+	"IgQXFXMc5278On4LgVQPjGf9JuW/jL9heA6QZZCt8FKFob4y/GNZ57ZMSBFqVdI5g9L1gpMujp/tOJfgyzzUn5cYPdFyf77g" +
+	"7GeO9fMAGe7ucr6Kd55jRriclwbK/fYmWeE8xzTOX+R6TpL77Q2yslH+oc78Y1zyp8jrxNP4y5z5i4e64Jf7rUF+yh/Wbxuf" +
+	"5o/cb8D67UOp38ZI+PLtt4QFVH+gM1AOeSpAkk/G2W/xQxSjVGFoxhjkqYBi1Dh/6D/k79FE/lrPMCiS+S31g5R/tmcYFMtw" +
+	"d5f80nx7Gr9FFYZ3NMRPlgkL6H4NRQ3aPY4s44fwPzvxO8aFQz53yM+X+RkN5HOH/HyZbyblZ76Ra53y9uv8ZChzysP18raD" +
+	"/q32v4U3ZH5OZvRPYfAd9lv8dxI/J1H6pyLUKH/oP+Tv0UR+ys8pDeiR8lN+Tm2CHomfT+Nn/JzWAD9ZJryh8HLid/BnB1nG" +
+	"f8f/7MQv80eWJ6d6hiEWG1cXyiOY45QnxzvkK9QjQJDyk2X8OHm/5xFqKH/ywx36HIf8KeP/24Efv94Af6WMn+73tUjpzC+1" +
+	"92n5lrV3vIzdER9BqBCuSHfoDo9Drx6/+CpeU2cWrjy5IkQ8uWKV8vojxGUrvKS3WA7/NA6fRGbToT0f7Fm7lv1SeK1bt27u" +
+	"E5vA05+5bN7+wI9WjFCFcWmUH+7WkY753BB+s7srfJQMV7qfQIubgCvcz81ETcJPzmwaz7uoqh6unODM/9o41CT8fFdUv48z" +
+	"Oo0Mj9Ue1yT8pr0lg/MIKZLpusMZoYRhOrGMwoWn4OfWy3pbYbYER9sZppN2h6zAIyQcl9OuSa2wz5PTaDl3Rq+xPU15zV7k" +
+	"OA8IvSV4KYWft0t6h5n2W9wYRm9vqR+6oibhN02u8FFO+Ik2TcPP6evhFS7wkz3r4dtd4O/2dtLP71eMUBm5CPScxFmyF/1T" +
+	"2k1nGuXvfMaTCDymEX/XyPDyhvxVcBIcfn6av4o4uYy+EX/3khzuAeNLX4k6tKFJ+E30nAt8lAxXuJ+YhpqEnxvZNPzkzKbg" +
+	"dNxur4crJzjhr6GVTcAV7uefqR8fAxgPO6DtT/NX6C+nbXmav8o2jCcdGvNXaZDhExrx94gEh7om+HtdLmN8evwqeHq+5jpA" +
+	"f+QcwY4xrGgjp41GzlHM0lYghKMY/zPk+Sj5Sa5sBL9pZ+cp+0mE8HTFKCf8hJ2d4+3vIIQrXODn7JkOmvFWF/hJaV7ZryKE" +
+	"X3CBvyvlt39G61VOcMJfk+ixf9gIft4e7rynacZ437GpfgFRTmti3HPfMl52xFMb9ksjuLNfuNESHGxP9wu3XS4T/XS/4M8Z" +
+	"7zvC6Kf7BX8pp01o1C+S/Ef5ny2tL6VNw28Wu8JHOeEnhjYNP9e3afjJZ5qC0/lSKbXDfksoZvWag5xcluHHJPhkJ4cpT+y3" +
+	"lNI6bMYWV/7abykXyfAkV/7abymGSnDQNeav/ZZig5w2oBF/l9hvcW+z+vMkPlmaht/0cYWPcsJPGJqGnwtoGn4yp2n4uxku" +
+	"cOUEJ/y16Kbh573r1w5pbY6HxEZjlK4RJtbuePy+C6/ompIhw2834ged67PZmIrH6kZjapb9lrCc8aRAmimQ0CT8JkS7wEc5" +
+	"4Scgtkn4OUhoEn4SnmkS/i6U1MOVE5zw1yCpSfh5SHDyagGjs1tagzGIkPAx42G3pua58jnGq254cKP1d7sML2q0/i6X4NC/" +
+	"8ThESPG6nDa/ifV3GON7NwhuYv3dIKflPTXPG8pbX4xpCFdw3ZuUE77o21B+UHDJTe53X6CjDeAKjunJ7ZvZ+l6//n5hl/Bt" +
+	"QAjnsHwpTa4TX0xquE4ouBFNzocvIhrOEwVX1ORY+ALaN4AruGL5vJkjybNuTyg+zrs7agSvawI+ShXmZvsHuPgPcPIPcPvT" +
+	"cDom3dE/wKEBvCXJkeRZd8zauR/tbQTnGsB5ksPk2TB3XoLj5gwuOOFCA7iC5DA5N8xdIcEhxYGHybhh7koZPt+Zn8m37s3k" +
+	"ei8zOEdyJPnW3U3Or2kol7l7Uzjnbe/bCK5rAk7r9fkHeOt/gPv+A9zvaTij0/8f4G0awGl7BzA6A+R2lTnh/Rk8UIZPdfCN" +
+	"yWlh7kESnEty8J/JaWHuehme6sDD5LQw92C5Xy45+/E6gxtkuN2Zn2d0hshwo4P/TDYLcw+V4YnyfWeOtCa7Z0jtgthG8P5N" +
+	"wGm9mf8Az/oH+IB/gA98Gs7ozP4H+KAGcMpntla758h8jnPCP2bwwTJ8sZP/z7F6h8h87u3kPzvvuOfK8EFOfkp05sl8S3by" +
+	"/3UGHyrDpzrzD2N05stwrZP/Gxh8mAzPcFl/0x3rMt4y0WVdFh464GjKhEbrhnvB0+sDP8uR/+l1huveEM7qtTj3gwPFrvkt" +
+	"qjD35xuuM+weY4Lj3Kxry3eHMie8kwOOpiArJEh6DaE1q9eYIK37xfV42PobUL9OCVcc5R3rFBTyZ3CcYgSVtNjfy0kOzrK/" +
+	"SClCCIEjnfmoVRnM3vTaCcd9Nav8S+Fj8tdobvsOie4XhDb4ocJCb3Tlv9PwQ3Y/GMJtgUWeCjSu0XpQ9PS852c79rmn1w+u" +
+	"RxNwi3NfPIAqG8Ldj7jmZ3zKkc+5icjJKZb2E0L8PcarIGdeu3xm69Uo7wNqj8Ty6mW9eA6zowvjOsh8lWMf8Wc4C8XhbrX7" +
+	"SzjQfQkH2s2f4eLktB5sVNxIYDzjz7jyzNEn9l/Z3p7u2NvxFju7W7bfoXDhoQOOptjDGPw6hSs/d8DdL9t7OM9uScrPVQpO" +
+	"yncXIRynsDjzHZDy2X9ECCe4nM0O2tkaaX+IEG7H4B2lsYm/YWMTU9s6ZScHHE2x93CMTXxCPmP1bcTH+wjhboyP0r3rFP4M" +
+	"14nx5KbdU8qLfnScCfgz3EA5zSjxK6dpft10kVHSHbIL3uJ6JhAeOuBoSpALXPm5A+5+2achXMGlutgWUXk1R9Z57GsSfhPt" +
+	"dKHD4sR7wND02eGgzvUOnsLj5bnPQ5kT3skBR1PQdAd/Fb7SuQGONOLvbYSEnxh/Q5w6oyNMpragTY1k6mohDX+p/NwzDI2T" +
+	"sCAEpXY7ekdIwyJrfxspH1+Le1GcqEejvWXc03sIP9whEz69F3HFDeFMVrQ4ZcgDEOmSn87lr1zz2232W4pC+fzo36jdf9pv" +
+	"CZL8bHDwVFEgy/ghjfJ+i5AwnuWV7MbTSI6QIPxAzyPyXP6TwqEffx+LrN0znloT6SMTL4s2GAq/LJ/1lfDx+h3budEIUB58" +
+	"h5PwQubTTq/V58Ed4ocXvsjqkdM8Fe5/UnwoD37iE/AsZ17uhi0Qz6pypHFJLmn4rujrkqb4yiVNMf/xAkcagp+UMVIa1Vwr" +
+	"TY8+lpLsdnSPtMEHFW1UYaiQjadCaCvNSdKGO2i/rwpDyQyejBAkI0DbuJ9gj3CFvQkwmDUmDvLeXDe9N/fTS5BI3pF04Ls4" +
+	"AvukPBqTljNo7p1eP723cGUzeQcSKQ67J+xBhKZbWPrd0+um9yIHyduQ1Kg8aDUGzgwFvaevl8tvlt5ab+N+4rxZHg8HJYIL" +
+	"PTCVopSpeslJW6NyEnWh9TTAFPpbuMJIOch+0zJ2T86b0euBUKhLGTyYEb6mnvz6Mg3oY63Qs9+Q13v6Otyx1/R1wpWXaINe" +
+	"kn4jQCZ7AjeaxS9C4IwOYdbDvB3QmxzfQV6FPsIVkl9d9wI/rNolP/PrKuVlPybZLfsouRD9YSig93c0pMEWHFhNgxtUizfY" +
+	"GPaCi1wEr2f1xtQHBQSvbVMmb9k6ecpW/GTOgQNz5h48iOT8C7hp/BSEEQI1gBcuhAW4lixFCOjJAzYggjjWt4bLb79NCOX7" +
+	"ZYS4JwyuZDw00B8u/u23xS00C6EplxFSFMp5POpzSTnfeguPfPvtOrOUmxAE6DweD4u4sZKvSK3h/OnT3FiClPT86pYq1DK/" +
+	"PgZkRCaUiPr8i4cfl+96Exg0Lvlc03Qu8Gat+ufl9e+bm9t39d59q6r27X/it2b//jVravZyp6psJ6r8++UOyeifm8cNXL1v" +
+	"/6qqvfvWZOTmZvTLzcVr1y+q3LChcuGG+ddPnfr661NvfI2///rUm199/cbr1x//KazeULlo/fpFCzaKOx3p8x0lEHKjbWvu" +
+	"y9rmjYJRBxYpIRVlovyn2qeSyRaaBqMg2rKgMHNMqAkMns4os1CfxbXB7rcz8vJoA/qt3rdvddXefeLrjTjweBW02b+f3Kzh" +
+	"iygHxm3NHbI9Fz/+l/aT5Q3b54Q0yRFifqoAAhTCD4eZwhXEIeQHJgjZdpzY+OE0ZIK0voXww/FiKpN5aBFSKT200NIB59bR" +
+	"O0YJUp+O/RFqiBdohIQZGcR2giJugJOdhz18GuGU4EYPH+xfn44AuZE2eLT9rLR2GpUGwKMX79+/BKJIm8ePoYblWY1WYTNv" +
+	"YPptLQs9jWPI79CK5PGGt8gD8uAt2D0AAXoJrcLZUj4NzeUGh6CG5EEr8jtvIAUDSMFb0BwkfcJhxAvZwkeeYaga9VEZkdp+" +
+	"D1NrRjXcQDz95D7DGSol/Zv/xG5HixDCdZQ3iLdPpC1APLDnuoijadwAoRbpkB/zIcveXNf7mWsFLUHZErScSWvQqJkfdmMY" +
+	"Vh/0b5M5ZNCh/Jr3u2f1jzAoBcXLJ0683NYUmaCHtdxLzVoYerwR1/nMzr7LcnI6mltru8SQP4iQTAZ0MBd0yrUEynKiMIc7" +
+	"qPCQdiNlIUII7IeJn8LXvpj5H48OsWgVvCE4xGj25vHtz2DTp5fJ6PffJ2MvfwobyKtjxsybMaNs5ryxo6U3tIcVvMJXOVEq" +
+	"y+u0YSGGYF5pjg3Bt9+H6sufkrGffUbGf3oZNiknzpgxb8yY0WPnzSyTyh4lbXAruIKUdK12Y88q4fgQYgcYcuVKLlwhISQE" +
+	"lpMZ7F7Z/q5CVCxUGWlN4mz6yW0RZ6uUqAV8jNaxfj+PF+CbXKFj/34P+4p3ucJvaRo5ZL+Flwi1NI0Fff+ryvZalVD7+E8E" +
+	"aAf5A/srvCT/vybmLJi+IIeUa6QS5l27hgtEwxpl8BrR0Buhhvk1zM8M3TJM6h3XrsE8UnkNF/TG36x59O0aampDaU9GSFHE" +
+	"bGqCT9OREMypGHwRQvxZCS6NEPywAbx+7ARTKwFJRrELXAI/jNVNH0cqwaBRBBnzVnx+83klEZfdH0duHeOHqVQVdeH8oVat" +
+	"2H5iT+C9hCtSH9ERppQv0/GYd+5dOlNxYL5w5cfTJ79R244qAh/fUGxBGJ2BM/ga74k4eSdVsge1MbEmHL99+zg8Zeu2qbyn" +
+	"+DIcnDvnAHoqv1Eth1KOABi+Y8e4qVu3TsZwBnB/ugXK/ckVc+M9lVJ/IoQwILsnduMNiGMvHLUGs0ba7U+fPt17+nreQIjt" +
+	"a0lCkMYyG7fKiaowD8o/hYdBlr9j7X9wxcpJntJY2adidch6vkp7Mq5l89KTnnDZDB7FeKy2f8Ony3LgJcVXXCfJx43kHNTo" +
+	"GoWAPoFlT9YVX67+dOGSyy2buXl9Pm/m+cWrP120480WSg/NxXllZ7hOR/unJPAYK7r1G3i0f2oXHnNCt76Mbi+Fr32eSkkp" +
+	"fqSidYIPusDXCi2QTvIHqDRa9Ga90aJTs6AVeq1ep+T6Vn0/6ntyFrp+P/JG1Y1RN6AbOXNjFG5LdsLwx1Mf73oytQ6KyI4n" +
+	"U57sejwVcegGRHOD+eMOWYC+6QbZ5/yNY9Dy2LFjuOrCBbL7wgX8xoULzrWef/6pNd3ooeXauaztPCQD4t/jf5Rxt5Ikd71L" +
+	"DZB8DPsfE2/LtVy4QCZfuMAFsXqQAMnoIf8e/62zvEbC4PCJ78CCrcfof7bVx84fw0vqkdgeXLjANWfIMHoXIVgoXHH4aJSc" +
+	"QL17+vTp08IVYjMShHiUR+4IJYrf6unVyFIRyPnzTp/m+54+LX5Fy3FjCSH8IvL4DyqxliAkvChccZalkTEb0Qlqg1Ns5D0d" +
+	"FENOlbgfD67CQx42JJssEkfhLbvwVnHkLmlPeBdlwkKhm7OvTE5XVlJLTp8WuhGxJcEPCeU/ehepYaEws2F71E+XOn0aLyPc" +
+	"L8S2kXC1RJLfM/Aq/IJQi1qg1ggh2TsDe8bd0VVWg8vHO0RGdqC/lq6trl77XHW1sCkn5+BLOTk5OS8dzDn87aXL3357+dK3" +
+	"FOcEvA1dVXCOPmAuOAwTx8iOMrnRlQijzngbpvGzMZ2PbqDnHD4M9GZ4idwDb82GWbMqyUphFBmG/cXbE15/fRZCgIbiVXiF" +
+	"8B3zC0m9uTfwDen0VyoFK6mAD8mu1r6tfasGDRqSsyN2aoKpc+duJWVlJR0LooTvCj9y4/l+fRc+26e3p+cUv5YtRh0a5aVF" +
+	"GA3Aq/A+oRa1Qh2f9s2tcfibUjas2uHFFZZmRkZGRmZm0N9bqCf86V27ugWbzF0r+/bNzKjctGPEiBfbd+jAT4gxD82LMZtj" +
+	"8oaaY6BotJe7R5++1Z18ffv2WzS/f8a3l8+XzWhGFz80gvkb+I7JJlL01hE4grz3DBjJCOG7KNuv9GYTcSgTr8K7hVrUHLVD" +
+	"8Y7eZITHdATJY1ZQYz/isvMGlwbStsHH+zpGFWYPjIqKetS3bbt1gHunt23XJ8vUsW/G6jV9M6I6hbdr17dX+4iIdn16t2/H" +
+	"t2sf0advTvuIjRHDPDtFp5dMj48v6R3VydMz3kIdiHfvPisjrvP5xLDQ0JDu7Dcbe73xKrxTqEWBKJKuSI4X/ooG/HSQqan3" +
+	"aEW7o7JDhw4R07t2cQuMNXWr3JTRf+HGjP7DGWdP9s+PjOzfLyoyMhIfGu3l4d6nz5aOfr6bFy3olzFpksRWmDomX2J+fkyM" +
+	"HDOWGyCMl/3+O46KauwNXci5QnIOugjjyYVCGnKlEDpL++9s7jI3gDc5ZIzZ+KrYljcVSjGyuRHcAH5PfZwyrSMHy7Wn4NlC" +
+	"2TemAGMUbBZAS1B56wxhxjDaIzCmb69efYcOateuXbtBCrzARgipWFgx/8n8eYsQoHwswAgFlma7N/O7rjTEsoA3w+RCQ/uE" +
+	"hoWFKvCiefOfzKdF5wOeN0/2UTuP+Ydr0t8snjtGdvs6sseEybyOedlxunvtvITtDRn23/ELXLan0iPD6R9wNkJ4PtfDU4lm" +
+	"O2HZCOFJNB+a7oQNsf+OlzBYvW/Ezgjh/bSsR6YTNtT+O17BYFlO2AD773gfq3eAEzaC+lBk+QY6YZn23/Fuli/bCett/x3v" +
+	"ZLBBrjRzA/CnnkqPnHoYdOYGwCZPpcdgl3zvcgOwm6fSY4iLP8exMIYb5an0yHXC8u1jYQSD5TlhqfZaXEB9QTbb64Ql2mvx" +
+	"KgZ7qQFsKYMdd/UjSWMHeyrxnibKHmqQbx71QYmnNoBtp7BmrzhhTl+V+AXXfLwbw3esIT4K8xhaD7Or8W6W7/UG+frjdZ5K" +
+	"/KITFogQHORCPZXNTkkwBKgfQgpO+AIZZB/rSIXqXUWqmDd1tUsMM+6Znrty75BH0OzO0J1pKduGvHTy5EtDtqXgucQDbpBA" +
+	"DOJMOBkbCxbsDxZz7M6eafdIyr20nti9grxaQcZXIA6NttfwqYo4ZESRzA+Xt85iUjKHgeD03k6DMoZJv8LUKurE3aRUKBVq" +
+	"Lx2EGcFbx6njO/utjAgJNseRG6rxp7OHnJng46Na4R0fE7wiXt1q166qXzWrPq7sP6U32Dp3JTei4oWfm2nG7vH2v9Klbd2m" +
+	"gsJL6ek4cbBnwLN3PbWfwmgY89lrXn1sgZO3k2v313Mfavi6DeFaxqMc+20+QahF7REKjZV9KEohsB2cwmqVzlvnmKiBwLin" +
+	"xd8M7l+YPfjsBPITOQ3JoJt4blD26fGXvp+cvXxZxPLilsFa8IkNmjZ3udLYsfBSek/YQemAnT17XiksIO+Ra+TLbWUB3LDt" +
+	"+bm8YFW1On6A0TMTIT5LuCJFlHJ0jsO7H/TlePEo1oo/37hx/DLM4wdbSbqVvJpK3oO4VFZ+OkLcH0It63NaVvLDyfzEqWJd" +
+	"HYVKyyPY4KGt8vKBA6+88s5Fy9Keqc9YFo+F4WQXjIFfyGmrFZLXrFlTCMKfgW2Ot2279XL6mjVrpDhOyYrRQgCKQEgwOH00" +
+	"unr+0mlMylhXJ9r0C15UDb2EyJjx83v32bu3c2b/CRP7dtKXV/cfULrjwN5R4yo21pzmylf17Fc4Yrf4LD/80KjoTt3CukHq" +
+	"F6aHN8R8vOP5badfFJ+FNggJKAAhfoZwBWGkQMEojHm3NWo1RoOWM0lGzpKvPLWBc3hHNJhNgs5k5nRm2J++xzT15b/e+vTI" +
+	"c88999yDtz59ee3atWvhW9PU9HTu8CefkD/WreNiaLRH8avZ62ZLX3DY7HWzZ69bR/745BNHvPZml1gc9UyXeIWgUCqc0TR0" +
+	"jviu1B2V4x2a7GrLpUu0Xt6BIMXzoUIV8xIHw6+WV1aWw0vCqImXrx6AyssT+2t7bYfu5K3Eoi05+wZMnJmzNrZqwelDgzd3" +
+	"77G63/Tp2XPNe1d8frzfnqFbXl0mdBQ/fe7ZJc+Rjy6MmyLuE65MGbCUHlrWiLuWZRjM5tysPpmhrUI2VO44GBBwKDw8Ozup" +
+	"l75lu+pl244bDLDzczzc0UZlkUD1BKlyG1VhBtAZuEiQG8kOtQEQCA5XYv/ePGMYjL+9aMmSRXgWf+5g8tzS88dhyw83y6Yk" +
+	"TOw8YMjg3MFl84Zuiduy7IPX8nenpG3KmjN7yJLOL6//9mzW4HCho3j/WVAsfnJNCfM3FonLhCtfXFv1eoh31bxjptZ+/9Ik" +
+	"z2aIvXpX3BOuoEB5xHRAnRDSGA1mk9Gk01vYD318SH84+Z9Z8APQ6S3gh5Umo16nt7zolx3T/mQEfBvxUXv4pv3J9qaBvhNW" +
+	"f3Dn3JHztz9Yw/dcv55s94WxvnXb12/4xHdya3GtL4z141uVrTnD/ltTtsZWwVXQnzVrxNG4GviyMmJzfCsrY3wfZL8l5DK9" +
+	"AfXcGBrt2RrYVNKrDW0hGLO4V94mPWfKgUt3fqlZtYN8voe3k053f6lZtRM68TXC5xz5glwnayGn9tKTo9AOQmASsdZeEjKk" +
+	"O/u3+RnsDdIl6Y3pHEnGQvZbvJ9Qizo5/McplHqlXuGl9QedXqkPqF/JEWewmGKokGs2WEyedHvhfQdszl913B8ro4k/xvCc" +
+	"iRzxP74qf/OAzJrBV8i9kNPRcwEOR68MAd3l3Bqh6MrYvAunZvtEGoIifWaf+iBv7KX0dAgGbrauY5Cho242AAT3lPSZCPHr" +
+	"pbWR+px0hh1mc/ugFcd+Lb4N88lCPP57cbd1KZeNF4jL8Exx/jQxEH8qdsI3pjE8ngjx9Lyqk+NNafX09arWohPYyT/UrDTC" +
+	"b8TATyGGT9LJvU/TyU8nPksF7cVUbhXssFrJ789PurZ096QvxZPw+vMTv1y6eyJCSECnSFyzQ0It8kYdUBGaiRbS9UhDIznS" +
+	"aSAdAiS/XRp6lnGEp7LEOtyjCbEWDVVFspiPdBXldZKvdmVLkMtGAvXS5/SlGmuKBk6h1Hk3wgQu8a8U2TVrAzu3f3Zwj4nB" +
+	"8RHtew3IiLZE+H2b0d6jpZvWv7M+VuupVvl6eHs+yfNw392uTaC3ptV2n7wu43cch1aD+vZuresSNNjSLSWnR+e2kUPaxLdo" +
+	"qdKkDRybmjqzIBmO7fLWhPSJNEWNWB5j9NX6+UfPFFeFRAQ3b671atOWxLXFn20aO2BCvGnIczNLRlsSg3TR8Gicr8XQ1Rwe" +
+	"GxLkFxsdl93Rq7nHifBorp+7l7e+Tbx54NyQpNkTiv5aMGVl5bzUaR1jfNq4uXfQhLm7B+uyuycPH7aYe862dU6SX2fv1ksm" +
+	"DqgYX1wybESPbXW7glu2bOXTqiVCGLW13+LpHUNbOuM1RrYjmaItrkcc6Zhjlo5gjJ+G4FCXA0/N3MUlk+L7B0Z0+ig9c/aY" +
+	"wX1HvDCl8Fb5gOTxs4tG9egQ1QEedfUJ6tAxwBvzw2szxie/TA4OGT584Mu3Drzw1pklU3b37rJuyNR5Y9K+LD2c0ykxMWpw" +
+	"DUII8agrQnx74Qpqg0JQBFt/5DC6kr9rby+l5Jk1QhrZgl7r9HsdAYJeq4+NWdq7N2y5OT3Vf0ASQGKmf9r0m7eP98Efk5jb" +
+	"81O1pAd81EqbOv82/EK84BfM/ZIzHQKnpIybrm2vnT4+ZYq4jyufPv2ZXt6Q66ft9WuOtj3l2UD7UD5OUY5CUAe6q5tl8cxi" +
+	"NlFnlszps5F6r7So5aBrSiP9TeUj/NK892dv2jT7/XkFg0dmDxoxZHhaXHdTKQi5pQVj55X3LCnpuWdN4N6PYAJM/LgmcM0y" +
+	"PjAk7WphVHu+LhGrPY2wtkacP7BdqHCU3xtUWk0uks+rSxFi/mX5xWyu+tH9XmPWa0wavdZAGaVhoZ81BrPJYlLqO4KJiwTP" +
+	"Fz55ATx/TjqwaPcbW0hc9dsvLLQmv9QF5ne78WYBN2A2biY+mv3pbPER/YKbzf70009ngxy/Yaz9ltBOqEWxkv9YqgGMlYMx" +
+	"1V8PsL5pRVceR0RZthKGmJmzvzjeK3k6KeIsHh6xu9fnnBgz+lzh9Trv7T/ln+2CU2xHO+zfNvrypGnXCkXyRL0ZP6/07t1+" +
+	"djO3iPEzKmIt74aF3T4/KHl2frsWsxMK5i1NS/2sY9T3X/ZJnC3pjdaTSzwovFAgaievzGo5kjRVKNPeMtd3F10WFEoFXRl4" +
+	"NHDT0KGbBmYN7D8gr2bs6bcm7Bqa3X8gOQIDuoxr2by5bkQX/sUrY/PqfqeLb0gwJrWg9Z7xBcSpIfnD6bofb+LgkLoXucfe" +
+	"Pcqsz486VtpDosexjnINIv3RqPX8lLo1dPk8QQzCFWu5te5v3k3icSFCfGfhCvNxadEr9d46vdKgkLQjOo2BC3MGvbHoLczT" +
+	"e2E0V9OJvNNTA96dvhViEybHd45JOHn2YErXxNLzdxb26KoQ/46Gj1ThPRYKebNnZyyYPW/QsBDv9JRKMgbmb4rqVNOj29TZ" +
+	"PWKGSPdXCPEjhSvIFyFQIb1jvQ9Vm5x7QDRS8yPJWnJ266dpoL2YAtrjdAu4mE5++AQw/oXMIxtxwPMTry3bPfFLuE2suyd9" +
+	"ufSFSV+JdeADkQijLgjxOcIV5M08keplRbzMHEkKTcByBOo/YMvNH3+8eRv+IC2lEO63569YMf+2cOXW19/crKkhD6B5TU0N" +
+	"leKYP8MZgtZ+UeEl+Tw0KfVa28xzU2birYIW2beWSD4Ptwta+99SHo5qsU1uthq8deZUQVuylaXzzzAcLOa6zmCksydKHDlz" +
+	"yo4dEqqtH3+8tWE+oGh0SoPFtp1i2r596kyKjebjbTP52fb7CpWsC22OELjceuts5ceP47dOnCC7TpzIV6g+oP99iBAS6i7w" +
+	"s9FOZ7lWSIUQ51KS/gg+x4/XfX/iBH77+HGKADxkDBKOehqpLkduikSoaJbbwznIpdTSH7YGd7F/qlQpeOSOQlA4swxxXBRL" +
+	"Ud+crvR1rtOfXjODU56tD0cmSbrwVVVJ7+75U/fv55YVzd/42tHNJcO6Ts8duDjp8Eu49IztxbOCT3aJacLs2RNMJdndZ3Q/" +
+	"fvmLN7pM4x4mD+jRa32lez/SopvQf8eCjVsVg/ODLEdDAj548vhDbtHRmIBt69dtD4x5OdjvycXLj/1k+qMVPApCESgV9WKx" +
+	"g2NieZ3WyOKMMFnbS2EIM5p1LAJKmPE/bqBw7JmpNdBhor5k+m5zQcTy2a9W76jUL5q7yzKpru7/aiHM9Z/z22qIecMzriSk" +
+	"vY+7fv6fGxTmDzR9R7Xv5IcX/WtLEcLMT+CLQi3yYd5OGauVaienHSdzcOw7/AuZF5c+N2HCc0svZhZ+MYN8QnZDAUSXfyHm" +
+	"BPqvWuUvFM3onhdT1yEmL7ksPR12ymfwOg0/1E9/kLxOTh2U7B4P228pN7K7QPbuF4XhPxBC2P4V9XPAzncRkvduFsNF/dSW" +
+	"yFZhOsUduyYMSI7MzY1MftUU1Su1Z1onk6lTWs+03pEmvMb2xtoRS5YW8q+0iZ/68cdTEtpwl5+ZyHt4Gg5GeLfibS/yKm2E" +
+	"NcTTg29n/TZo6k5oCZ7bJyIexdo/VfopeNQatUHhKNopnTcxFNlAdaSowkKchhL4tUEl0XQERpcMSi5LpiOw6zS8nnYd92Tw" +
+	"uHGDc8aPJzbIP3eOvHhW2OQy9NrY6NDr8/hDjt/x3NqduyBo1/ZHVy4/enSZ7gCUtlkKHmmkExWopSCOckwgRtm/U8tf2t8r" +
+	"PXfs/v1CZc7qteLdfyQT+mKV+NfUsQvKoNZWleKW+Q5vb4pM6T5tnf0W70b9sMAA2R/XF/wu/pZKyZ1BiPwp9T1Cikkufd8W" +
+	"H2d7RXf7LcWHQh2ahBC0ArWeydeu+nF2JSFprhyKdJ1DxKeSpt4p8TsCVTiyxbYG5xEgELxcyrAqvLlCPALOh5gSUnInWTyD" +
+	"W+s9vXz1EZEBITovXYguwOgV7Rtp8td38h08Iskc7du6OUnpEDVwW01Bl0ijIaqlyt2rmSFhQK/EoODZA/MMSW7QzE2tD9D6" +
+	"eropp/QYPWWxcZNwL7CT+MbFlZt7jfZq26adr94/TB+ekjDzC/Lmnsq57b0t5gR9oXGYJX7W3P1Dj32644X8Q0kx3QztW3nq" +
+	"23sYs9Kse5Edj/P2De/dMzBGFRLSXtWujWfbjnGLZvUxhCoY/4A85onwJcpESLDI7XYcUOiS463zVmIlcy7MBHQ5iTHBW+fN" +
+	"tHGYMUbiVKyF8l6lpLHZvywM6a/3D2ytUzX3ad0hsaz3zm7t3Jt7H+/ZrmNU4lgva3rfAb0SB0REjCo6PWWlT6BQ2SN5coA5" +
+	"OXG02d+/eO2Vr1aZB9n6DPp4ZVZgoCVu7syjGwtKI3sumzU1f2gMedI/LtQw1vBtQb8hCRV7Zs1IKl61aN1cJfjikZG9UvJ9" +
+	"vS29ErpF5/ywd1nZpSNk9yVCpDtE+sZ+nHxPqZHmAL2RDNWrnf/43OM2dJzUwjj4HsaRjSSIbBSmPPlTaEF/SsSluPxJBP5a" +
+	"DEUIUBJCvJfCi94SAmfQdANZFOUMYOIc1io8N2PvjGbuwwctOdtmwh5Q7xmfeK508bpm+EUxH2/vmK7NLrhQI65TeInbCibs" +
+	"WhsyADliajtxm5ShOoGjw44GnPeUqtAojRYYURMOI1uQ2R2rPzu0tO2MPWWW75a80Wbi3vffaPGNwuvenBFLyV/v//YjVcxB" +
+	"V6qY4+cnF/el+M32G5y/UIvipLmjahSh2TkPzGrHPPBqeDvGZoG/X8Ghsori2OhWusDW/kp3dx9Pc49enQP8J2cNDu+o8PT3" +
+	"0vp4hWSmzl3yQsBk4VZ7bxshS85UdrGkxLXyMygtkX6afl2XLDrNqX3bRHZK9PPmk9yMgZ7e4f3mD+kVbmwhybGF9lv8W0It" +
+	"0qNohBLBcVZkw9EckwhsltbPeY0jAwXQNaBwXsk408wFARPykjqnp7+dnt7x1X1fRU0vjx5UXDR/0tSJ0yZPOTa3ZMHbCyZO" +
+	"y9tjzMxZNqLPvMnPTSjZtXLFrPHri94o+OPb/plCbOdOHeOt8UXxrH+62m/xt4Q/WHxUXX2cGYuTDmOYwUxZZ4yRppXmqdVJ" +
+	"mmay6kCpUOpM0XDwx+Ks3rHD+nVpZvQunjCh49DMip97d43u2DwqWdc+OKKTuxAYEJ/7xqikLi1C/Y2+zd0VoZGmlLZBQTzn" +
+	"6RUcnYBbdobCiVE+rXOStwwIaLmo5e2JswyB6cuaJScu8nlniD7EJy4Z5u9fVNFvZjdLqa8q0s8jwRLaPiH80t43y2a1T4ni" +
+	"ELbftd/Cy4Va5I/CpBhDFpO0TNLbNNr7nrQhWoUSg6cmljpM5xTgGdI/pkd5bGJK4qrOKStHpLv5jy3oPnEKqLqTL/eZ/LyL" +
+	"hvhA8wvTRpnjVkxctyxj0ObNOKEs66WMYWQh+RvywXtdWOpg8clahJG//ZYwn52jjE4PF1Q4Z7GzHKGzHN4ukCosSKHyDjq0" +
+	"p6YGTxDXmXdOOnH37olJO801NXvWruVDN5EnmzaBQnKFQY6WjCBviLXkjREl+2SvGHW3YOMPP5BxPyCE0VGEBE+2RrRAKBQM" +
+	"bqBn6wSoDfw2segLvMP2K8zBS/PIIrE8r4StC1PqAvkbTyL4G3WBTHaNQkhRx2xmA1EE6oiQyRxtVptAHRNr0gZraXgML4Wr" +
+	"FazFJQwYteHttOgvnL1q76K/xIOr+FGV+cMWLBiWXznP1KGDif7Mgzes4/m36wK5jyo2WMfXJfE3bDEVG/DE5W+9tZz+ZIwe" +
+	"nZExerSYMns2pYcgpDALH6DmLIpXB9qnVEwLQhz9rTAEIzVVDSK1yig4NOShjmOR2iBMPkdmkFryK5krwFLQgCb0/i/kIA+Z" +
+	"v/wC2fuJx67cESNyyQ0I3Ll7985d8/nfvyafkM9IXzgGURAFx0eSxeQxqSPPwiwQwO3UTHJ4SFbmkJJlCxc/S2Rb62KSwL/J" +
+	"PURR9HQG0m7iOltiLS6X8eDpXIpUbEByktjHMoDtGf8BW96dOSOx+8oJ2/NSVc1CR03M7mbw72ElZ57pljljVEzc++0uLp7u" +
+	"H9E2oXUb7nzl6X7jR65/s2/mga+u7u0S0SZ85Zizc9K7klnk2znbJ67umrwEPGw75t9/0fbb+dllkWnQMaYwg/KV+XiVz9fe" +
+	"jni/VE9kUbsyUA+OkCLQ8ngeqLRarVZcgvvfoOdKspHDsW2zB4ZLu4w4DuPncMnXl658JS4V8sRq77Y5O3fktGXjajR5RH1P" +
+	"ITVqjfSoLUKh1CG+Qpa66W8c6k0rD1ay4CUqnTerWmUM46789vV7ka1akTvkJRgIfuAHA94Qp4/4a8SIv0YUBrVZsaINXh/k" +
+	"v2KFvx/55jcOYA+MgCLYQ4aSHcRGVntER/NXo6PrxvHZbUKPi9teDeXNdYfbhBzHxcdDaP8l2O8LFYpo1BwVUj44O8sYpuGM" +
+	"CqXiX/cXrZesR5Wz6GMTQeuMpKB8SnjDv/lZFmQX9G49MPPZ1/r5R0YkrtlbHG328Q7SefmoNK10ifl9TAFtPi2LdYNWvkFR" +
+	"vsZhVhD8zLEvzJkR2b942Iqs3pN04b7+gSr/IFNnc0qbXsLzYi/liNFnXhjo59Wqzhvuur29dFnKC+YkSztfH2/fQKPKd2D8" +
+	"rLk7uHaVlvTWUW7mVgZ9oE/7XmtLT7Vo45/kH2gasvetiWOivv14dmBgeLTP1Piugf2ZPYf9lnBB+B31QkMQEsKk8dvKGUzY" +
+	"7K2TbYvULvF8da6sqp8GSpklOosOvLVqh7BmCFbylb29QnqGDSzNHzZvfn5+eLu4+AVx8fFxA3olxMXF9+60ND8/P3/pc0P9" +
+	"Wp7eN7Kdfkp8l8kx7fU+bcIrw2xPPFdUpvbo4e0fl9/mxM6VQ2siOr/3yYyyshlHmjd/Jmfw4MEb8dYNQwYPzvkgZ3B+2Yxt" +
+	"28vKygxBRYNbdnp58Ow5BZ1CxsxeWDGjx1B+YJ98sqFnjzGjZm0Kcu+IBCZvrWc+jQJY5J1oKVpcBNC7Ry4AdBq9dEWll66o" +
+	"aFB7afLo5GFL1T78nNSu3Urbpg2teOXXjqM6nyTTD/SYW+pJNuLiH26ObS9eghBvrdZ7WUJYVmaYqDzOdEod3yd3cP/Wi+aR" +
+	"MfW3UJ8VtcV7pBmmYzMLug1q24FOO2kN2oyQ8A07SwY2uCusv0RT6iwOQ1zIYIog4Mdsu32cKonSth24SH6B4IunTl+EcMfV" +
+	"nlgYSy/2bn19cPzX98gSMh54YoMNbD4fs4fyHyneQwLTM0eyOjVhBk29qs0INPiWPlpnCbUIkoGSwgNYv4PGAOPfOBY38FaC" +
+	"JSbhtXMHe3SL6kc+K0nMhi1TB7mTr42wPLq115SyyRv7RPYgnhUV9GYOHvmKq+p1b2FutiPtDwtXfP0qJx1aqdKMv7bqdfiB" +
+	"DGvVIWVhke1ZGM348hO3QlghXENGZjHQ5G3o07emFhoNVm1QcwZObcDb+mUYEhLbdYseWVg5r+PopAF57bqlRMdFj8ydMzmy" +
+	"4FTN2uf2zJk3a/4c4URusKZdx/AgDzfPnO79h2q0w9v5tI9u387dzbNf1/T8VgI5CNniBVhPrkE4KUUIcXYbtdTmhzOpvjVC" +
+	"nKzlFFxsJ6S3Ljw3iFQvJVvwJtyJpMFekgvnxHfxizPhiPglP7zCFsR9P3wU+XsUOTWKq7DdHcXGBbhx64Qa4QpqjrwQApdm" +
+	"qQ3yxT93ffu66pFHOp0YPfLE6GPCFTsCJJ6G2+QidCT+OLmA+MPtAoQAtbQH898qziEV1Q+GWgTQUHNrDzC6gQn2/UC7bQU5" +
+	"txEOvEnQp6tvlUAr8u5prh9eUjnpkK2KpPE/PYmAHeKi9vgv7jlql4eQ4O4irwDnjKoDW/DdS6LfcH6V6Ivr6nrhO2KULLCU" +
+	"PPmzpERogQD1J325c0zWQjrvIEnnaWZxdcwMEUQHgFY6HfSvhlmH9q7/C94lCfCuKOLLJAesJGfL+xvn7/1x31t7i1/4aMm6" +
+	"Ghi5Dkau20eO7CNHRlxeTi6NWiL7mEpDiL13U3DdpL/tt4QE4QOVAqR7Py35hA9SpKBBCIHL4iYtlBaTgu0C0bH1l3VSbEut" +
+	"ilPog43ysVVpdAoOzj3GkQSjciZPKAxs38t72rO6gUHeywIDA4PKjH1bJbVKGugdWzZzBE5qf3OofueX5MHoIcC39A6K6Kbt" +
+	"0b9XbKrK3dsvftnYHsGGVp4Bw3aMyMb7lrvNGrcq0hDp4WForb/UxaAXFN4+CT1fWLAomWu5eWYF+Zk8ueDzrH97lU+XkK7t" +
+	"DQbvmYNaB0zvM2vW5pzEJP24SHaOQAi+4IfT3tOrHXEqaVg26BAYVNS/+MX03vjK2siobcOLLoux9b7ovxeuqBwxIhxvuBXo" +
+	"WYQQx8ZELwVdbxPpd9DJ+0dbsDTYcxue6BJZPimn4Ny9DWovTmjIRYVSwdmVmwflTDhPLovHYpfMMvn615SlunuoA4y6VsEj" +
+	"q+4va3Z0YUX77vkp5PDJUb27hQ0dMcsGcbNHDGvfP6Nt39RxKd392/XnslUjCioOzf2qbEnCikWX8MLKXv08ugrtW5g0em9D" +
+	"zpLpO4296M6aXrplTG/T5AELytLa+fmIZ9qI4di3TdSQ16bmtPfxUfwykcaLL7P/yB8Vahk/dbGhAexQRrV+DuWEt+Oq1ii3" +
+	"xUKZoNJ5h9JcihCHZgOrkpaB2j3EvVVLr8J5BeaxoX5ad65Fi5aJo1/RZSUHtg1QT04u3HkB0n48M/QlcqxsYLuIQQcFs6F0" +
+	"qv/MwPBW3vrIlJYhp1oE+8cl9/Q2RfcJXZU2MdDg3csw3fTa9AHDlnxFXjtyI2x+3NI+4TmV+WvWvnrxzXXS/jPZ/oNwQuiA" +
+	"fJls563zDrLEsqNXUAg2x3iGmKJ5T60+WGv2MumjzWpHb8CYLtnPQttlhWnFgyqeJ8+Tt155FXoctEKPV+vOqtWc2TMxZ+ma" +
+	"qmU5XW/3M2Vndxle3jPvFeh++AikHjpETh3R9NJoYHKmaUBWTH/J/tl+i6vlU5A/CpVlcWkiMVIkLiFTEA1ERjlJzyxB0JIP" +
+	"NK8ea8rXPhOypICsKklr2R6irsHwk2+OKPmofJ8J9LA5LLig20RDYtb83G7V47em9BMhhvz83uix3/ebcuAj8itbA9bab/Ea" +
+	"oZZZsjTQ1cUmQpjxKQtYc4zUmY7bdJ23Setyd2+JtXBvDvJvuevmgoKiboPKi56HEZaeuZO+fKZvZNsOAaHa5r46vTF/cPzg" +
+	"9HZZOZO3TBofUzQ469D0cZ09oNeY7uqDwaFJhfsPLS/10YZ0zVy/oviZgdE5GR2ivVvq/UMiAkNDIpJGbpqbEaXzjQ1oEzex" +
+	"umbE0LJuhSwmrf07YYbCA/Wj7ZCbodYrDVqvf5aFu4LeolfLbXFqHZlAHK2zaKV8Qk5QpiHG0OnDOGhjuRsalnb092k5kX4+" +
+	"bf2bq7zUPl2yR0zq7BtAThkgeHDnQ8VJCk/vIF1wkH/pRtAGdVm+v6K9pn0b4Q/NC328urYWr6cUb15U0eeDwpjk8EBfD68o" +
+	"k2/y7G7FIytfS8G9fCamZXcN7NXeNzAwMO3YzL3NLH0ivd2iHP4qudeZbIfaguNNKPeazYYzxJ+x9rgVTlqp0ynE3pC+p5yk" +
+	"wGg8QtBIFct6Vx2DLQoOc0ojZkKP+ikeMdsJs8SaVuDQpJi8NZ4anafFs+GYcCxTinAvL6OPb8eQdoYwQ2LOGlO0SlAK2ydM" +
+	"jB7sac6PNndOjGw7KD0vrWuYr3dgtG/reOPwgNbdC5JiLZ7tWmpb63NKFxQq1o8cGeneZ8q0VUt3dTH4qvzCg5ReCnfe28dX" +
+	"Fya0Ec3XKxZ09G7fPkmfkDIizrIjG0MArA18hZwj75Ocfq3gLiTsnzgzjbT69dQrBf0TO7YP1fskdBs3a/pO/MWQLglzuVGJ" +
+	"qcbCiXvIE/KAvOwB6Q8Ab9psbNPGqDK4ebdow/t4RyKE0TD7D/gh15a9N0VANQWYKeo9OSqXYqaq98QjM3oWz89IL5p/eNmJ" +
+	"955bdvw8/D19LiGfTp8NPIjXXjhP7NdeOA8g9Qv1casXalEMfW1A2RdjBKUiELylkIzsvUNYTCKwfvDSgUb6Eijl7QhcCRTn" +
+	"Luggpq+0JGzNs2R0u36k3ZCoeSu+zF3QAUeKY3IXdHgxd0EHNf6MlBV3quw7MJoTtiUmHyz5on23zonD2nQsjn1j1dbfpSRx" +
+	"I7SeLn09Ln14IgT2Ovst/pjwO4pi0rG31ou+/jPog6mYmUijqdNdM5JBOHMMe30UgE36aG8+tafRb1Yrz0DbUI23NqC8laee" +
+	"a+fpGRwnvmWO6u7rxbf01Hm4h/m3xqszB/DHbQY+xMvvWU8F9vJUBS9RKbjy6BYqBe/TaWjP8CCvoIBAfjBCgHzsJ/F9yR4V" +
+	"qEGIV0tQBoCnFHavo0SbkzI9u0BhcxyiIbLIT+0REYyxxc073EulaJ6nCicbEgPLW3kGQQH5uijJs627tl1LYY3F0C68o7tv" +
+	"pRhC6oIHBgSOGNkyXKV/VqXgPp4yL7l1QIICM/th+0m+u1DLtHSsqvrmB2CtRJnO0/EMJMzMvSD2LUpiDfcI9W+N3+yY5CN4" +
+	"ROgx8CY3bVutysOdr5kyLzzQKzAgiA+M8vVQMTLCxN7rpukHGlOyeklxpRHCa9j7Pi1CGtfX3I7A8vr9I3tRm5JeI2HYUvHl" +
+	"pUvhPSE7a9KkrP6TJtaVzZrFmWchjLLsVxSbhATkhvwQ8qTGz0iFDMECaJVU6JRUGkgfzd8BIyEQTq4Rwi0GxVXAZDE5Q86Q" +
+	"xTAbut2DDG4kSScicHAS4Ay4P/hGb1CRd5nCKQHiofwiO289i1CzAn44cmOSvPxaRnrYAJyeM4HBqFcagB5c5EPeUtyDpMIa" +
+	"MmUp1/YFSLv7LAyYk0UmZ83jpi+4/u0CcguiKh/XVUJXfngFOT5f/IlrRnpgs63i7l2ugrwC/cgAEglzyUKgL7mC7LX8fX44" +
+	"aiXdwYWapb1NTQOh6ryjObosauXgnga1Xk2PWYaYWBN9bulFTx38+jlGXbMWs7Sepg8zlSNCuo+dcTtMs5FcsmR1yBu9gdv7" +
+	"ojhu1qy8mCSjvvNu3Fz8ouaZ1qmQfNj9d9UHRSLcsvGcre+0fbNLiGcuOcxtRhghMo2/yM9GzdhrPm+Np9qk4jCn4rAhWGmg" +
+	"y2+QxlNjwhxWw75t/bqVdRvRMXHey5u985J6XYdls4uryk50SeTbvEPyyV5SDjshGcQnp7EftMODdxb1zxO7whZoSdaQ98lp" +
+	"UZTsVRHiD7Izi5ukMwul3aCGlsfxD+I0x4VLScmTNcIVx73NGPneplX9vQ047myGvGrDx7ndZBPRk4380fr7midrhCkMCUbe" +
+	"ZKZwVHgHCUiNkBs2uGGt3o0dlNzADHPwO+LAe/glMVFoZptLP7lDT0bi18Ui8hWXy7WzfWHbq/ihhH4UF3MDOe18hAA9Z78l" +
+	"tBVqGefUsoJaGrKOI7GnmqqrFdAT2hLDWFIFCVBMtpN3F/1SMvLm3L8fPfp7+CaekEk1eMHAQWQ0WUeeI6PT064WDodooGfp" +
+	"6FAESIkQ11fyVaFnT3C4Pq+KU7gUIcLK1nEFQlwfKZ3ZS6q53rY38BrhypMIqxAhvSVmPl2YbgPpDW7UKNOk5sedIyvJ6nNw" +
+	"/TgeJW7hWuL3xDjEowzqU5L5iNAgHQpGCKQnUhi1BRA4U7RFE2bQcyaNwLGLDRYt2QBLNo/R9iADyGwSBQLk3sz9ZutHi78h" +
+	"m24ev4nHnfTRnYr3zFcJV16d813xDGqjI1wpefJnd35Fd9pV5XW3VJrtkDlq0JkJGsSjLPstgb4hdkeByICiEdJI8h21vwS9" +
+	"9B4KS5Ios2XXKzmTBjiXE58h+DP/sDZRO7pEj+cCyaWAtCFDJp9YHRgIAScm9+xpGAcd7twv/0185oC3bsP4Un9/U6cNOjx9" +
+	"R59p/bsGcUuCulbaft1XEtUJmq/PPHoUUjpFleCCkicHhWxSYovlKit37ug9fcxYhDhKKx/Az0buKLwRpRqJHhdxximrtKLC" +
+	"nkN2PefRU+O3NjiwalPSoAlTswZqh48oGv7H5KwWUS88f+vj/MSWavETT/jYPSZtc48uOKqLZdWYNt5cvNa/UuytDfQOL2vn" +
+	"7b1tUk1KP9Dsfn5t8bq0zLySztEzBkxTU/p6IoS7K7wcbyfBRIOoagwavUYPW2va1rw1a9csPbl0DjoovH6qi+M223h+OOLs" +
+	"HUiOXK458pXLaQwce+UnGCysaRo9tWNjaPKPzzoVGNW9Qw/y+Lxnh4KICN+L0OFCaN5QhddPT97iPes+ezW7VVT6g5KoTtX8" +
+	"wbqVXwwfLp17zPZbQhjbx6KoJNIStIYYTwtn0tH9DEmm640VTZb629ahJNactG9Mdt2tBcM+nvEleM2a1b7DhZo+6/tmrO5a" +
+	"OCM2MqvfW1WDNibjJNws98OpiwDPg8ANuYPJr1crbhQnzu2y+92QkOMdO00aYhr9SdbzQ547k5jE5lU6Qlwk2y/keUV/uEjR" +
+	"DyeSeDjPD6/btQ4mrYMP7XY0235L8SJ9k+5hxLcQUoWh2ew98EBeJ5bI6cebSB9Un+62/al0I5pN01VKjwzFEyeebUKtI13O" +
+	"rURlMp5sZ/r0JtNp+d1Pl/fIcim/++nyzvQhzvSyJtM7O9I9MptMp/W/1UT9A+T0oY50j6wm02n5k02Uz3ah72QT9GW74D/Z" +
+	"BH5H+ghn+sAm02fbbwljnfUrpXRJJ8PiiChQe8ff+Gp9XBHUvj6+JJ/shBslOE3HVx3pio0u5eLAJZ4Ow+/0dy/U1scvQ+35" +
+	"RQ64cqgTbpTgNF2odaQ3e++pdKOUTvMp8510FLnQkQAH6+N8MToSHH8rOJYvQaZjswOunOSEGyU4TVfwDvwsjqcjrqhsV6ZS" +
+	"oLD6WMUs3ej8eyH7W4qv+RJCLD6LI97qSwjhnfXxWlBf6OOAc/eccKMEp+l4oyNdeN6lXC5scLaTxoFQoFzH30IEy5crleeu" +
+	"Ots/wwk3SnCaLgQ7+fDOU+lGKZ3mU0xw0rHfhY6hsLs+3hqjY6jjbyGR5Rsq0/GLA65Y7oQbJTijw9KIX/2dMZ8X8bNVCpRW" +
+	"HwOapfesj3fK0jMRQhwqRajZCvbWRbrpZHJOW+lD7xB7qE5c+iZshGdhAllLZosfktlkLUyAZ6HsOngDJoTcu/7qdXKPEMDg" +
+	"LVwhm0gFlQdgHFRCBYx9cpHa6UJ70NXN5JeRn8hl8jn5UZYDA9n7El/2fk7rqFYrn0A4x50Np5fpg/FfgzdwRCT3vu5+99qX" +
+	"d7qnrVm0aI0Nyhz0kRWQ+4qjwqNnPv3sLN4h/rhg5fIF8K2DMiQ420/rDkedUA9Wv8N0WCaEM8lWwxq9K39ALZvVacwye0xm" +
+	"2baO/6L7D9e+vNu9njM9KX09365nGzZLpIoKKcnJuevd73557QehIyPa2QTbEUq7zc3BTSd3v8M+C5avXIB3HHWw9JWzn316" +
+	"xnXugRR7aar9FldF+x57s31wNMmh6cjfcfuD1SpP9jqVeeZxWn3yL+R/Vnb3r7/uln2W/6q7x3gXc0+hyPmqkrxXUCiq4DWe" +
+	"D3HaeyL5/bS0dzlWXaO0a6kUHhmS3xH0kbCL78xipJhB9vqRB0Wln/O96k58XooH5cGy8p+sP5VLPsRmIcSvFq5LseNBaWSv" +
+	"h/D1LBhRerH6Uqlwncwov1fXhT93r1x6X4A+Eg5L+EELsocR4RDZWXqx7gTf62IpPpxHZjoqAJhlf8yvlnRgggFkXyRchyyy" +
+	"vfRS9cVSJSwvv8efq+tyrxw56af4WznoN1qkeuRWcPkXS2EErc72orM1P5WTmazWn8qlOn9jbWrlaJNFB6xuZ8sulpKdjARH" +
+	"C2097pWTGZQY7s175QiAQzGCin+FtdNCL8eUQE/AcfCAXOWaldoebeanJ+637S7nCsjxRvl1aoMajKA381/EbbY9KuWakav8" +
+	"K4niZejNCuxvmD9USZ3LWUBtElrFkebQlhXZPC1xP1dQbtsNvVGj/EZ6sNPRi6cucZsZMdA2JhG3J8dZgf2u40CNEHBGjV6n" +
+	"BMZOjY7Ta7krxxYvJdGfl5IdlKfYeGzxUpiCO5A9g0DxUzksY1yFoYPIE6tLn1NctLyjSyheMzYdW7IUPnb0ivjVsSVLyRp8" +
+	"lZV2dgzDTHEZ0SXhLy5TfldNRf9uYHGafem8dcqw+isUpdMooiO9XrbotQK8ERgRYuoYHpGoCYw1p/XoPyCpV3Rs4GpNYECH" +
+	"0OgoY0RQkMYvrkNG0oD+PdLMVZs7w1/kKjzQazUabVpU/y7xwUFBoZYu/btHGfQ6jcYrxBDVK9lsDAoKjt9FjuP2ifuBjUMN" +
+	"uiTckOm0hJr1tE+VinrlqQuRRlfy6aUKFfr5flW03rjNVeaeyf0HJGVEdvbXBOrbhXc0hXZoE6RZFRAb3TtpQP/knubYAE1i" +
+	"RHhUdGg7eLAL9rNxsovSaTQn94oyhHhpNDq9Iap7/y6WUEpnl/5RabQ5qFE/a0CnNDmmPBh0SmAz5hp0K/0cV4ujPy8lZ67R" +
+	"PseHWfeOYLMURtAOZ3P1GYT4KuE6O/2D0qh1LAdAHxNyHSgyiqv6Yil0pf0tTZ1asqP8nuiPb98rh6Lae+WwHDUcM270gt6x" +
+	"VIDJaAFpvbhGzpZepM9FKb5rFCEexMbKTkYY2UlHD6PL/pivYvsKEsCiMzuWEdBadIC/pfOYvFN6afPFUnKWzmy2qNRCUfk9" +
+	"fFv0v1dOdtTS2d2AXy0d6wvo3EAJjuUF74RupeQmBJSSM+II5wIDI8qpLo0xCzVYB1tK7dPTy2kL6J2NE0eQM6UQSG6UQje8" +
+	"s0HjoBt0c7bOlSb6/sM5UanNuaCwZTomKXekuhp3qJ+cubYXHX1G1zp6s027iq13VGWDT9JK2WoHFtsTK+9Lu4at5net1gZt" +
+	"YPXSZ+9qeWrzR22Z1dXcEXlS4w5kL5dP9jpms6NPVsv+wBzPT9kii0+SDzgFeV9aYneWXhQirNa6u47l1ZVmDZ1bOqZg0kvL" +
+	"MyOfy6JlYajtCeRRBPXtOEOxWK1smcaO5tTzcCe/hN3GmaPlm0xp+XCylF4VsiMxnbz82s4dO7Qzhoe1NcS0b2Pb6+AzWGG/" +
+	"l7da5alWq729uOtWb22HtpO6dhkXGe7r7WS/u7unupOvX6SXp7s7q38c4+US9n5fXrukuzadt8m5YMprh1k2dzXBTB8vrafK" +
+	"zc3No6Wn1suHS3Bubu/GtzcbwjVeXtqwiA4dLdx1WqOvzs9X59/K093dubB6+4ZHxsR26Rob17aD1lvSFTh44YO60R2wgc26" +
+	"McxBndJJhnzBJl270WsN6k5JZhrvX+ZD2eHm5uamUnt7RXl5q1t6uG328jJGtO/YOaF9jKGtp5eXl7Fdh46dyzaLnzs3lasS" +
+	"xb5+Ol+1p7u7u7tnK3+dX2WlTKpEeNcu0l/XrztZK7XBwU+pDY1pd3C2LThYqzWYDY3Z62gX/OLm0VLtrekkM9tNRZkNLXCk" +
+	"c9f6fHOZpWOHiDCtl5cm3GBuH88Gh1aDr1b6SRx38t9X7cr/69fp+IijPRBDR4j0V9cGsswQhMAg89MkjU3pnbMrlU5ggqNN" +
+	"jj3QZcdx2RVlKBS6OTjuFqEPDHLX+ni7uQkt2wQEG8Ij9IY2bVrybm7ePt5uhjaGcDdHg92auzd38w8xZAWH+AfQHB7urd2b" +
+	"uzUTWgT6hwRnGUL83TzcXZYbT88Q9+bN3Zu36GAIVXt6egWHtWvRnEKMrTydvFAo3IO9nIkKhULh7qZqFybB3BUKiSenhcN8" +
+	"GtJROaahWOLoSnymqoS87ZyP5bOqRkKSg3Cy9HQ8mOuXwUnx5EMnAQigud0mtBSuSd7BNDoOOIsOjGDRcLvFcas3DsIbD/0x" +
+	"hIzOJDfmHuCbkSFceRvxPuyrO3l6BXlbBReKyO9sL7QgxC8T/pD8OhnUzumwtJqkOEjj19ZN4n5wHbgACxDiP5fLOVukNsAC" +
+	"POjzUupZr/RzklLNr3XSbGst1We/xS+TfQ677AH8UvGAkxNvVEOKszbuB6k++y3+c7lc/QJugQXV8IajQkjh19pa13OJlnvJ" +
+	"/liYxV+WyjnkY72ZT5DWbUqmB3/Z1pWWYtW1tjrGNP+awD1F52u27c6Z/4HrRoXbs3LhsEs4Iwxh93BydY556pimhpgGcxwj" +
+	"p5Tetu3ArOIRd4uKswa0bVudkBCbkXwwrXdcfGAAPunYWKLnTx5fmNMpMrJTTuH4yfOH7U5OMOr1hsSkgVnDEKCesEv4Xq7f" +
+	"dTVhitQGdLSR6eObtQmKi++ddqBHv9iELtUNaLhUSrZnkV2l+GT0sKyBSYkGvd6YkLx7WEMaomXa6Hr2HooRDjps0DRmvVZw" +
+	"niou8LfrBrscLISpxcWk/myBUYn9eeFFoVj2ocGkKCodqGk/0z5gWzfth5Gc9VIpFFdXU6bBBlsO7ZLqaiY+WAGsP5aTcsgQ" +
+	"m0EmKS//EQ/5sRyWkiP4ETkKS8t/ZP30JpovzBUms/fjbKVlPImWd856QVzvumM0WHnh027+AQldYvv1OJDWO65LQkBAl8Dq" +
+	"OCqGB2ZFtG0bkdVnbH75tBEj+9O/2g7kI5KzsoblOboreXfesKysZAOVxZOshrzJk+fPmTwld2BMZGTU4Nwpk+dQFiNAq9B8" +
+	"oZ9Mp+UfSEkEfb3g/lRL+C6lA9q2bRuRmVlSNG3msLF9MiPatWuXFUiuwl9x1YFdAgISusT36mnt0S+2S0KbNnxEeeF4iZjB" +
+	"UZGRMQMlYibnGaxJVGI3yM1wjAXaDDpfcAcm2ymc53H5MI47VLKDuEP+w1dlP320R/OEVHpYJzvwVassniPAJ2S5SeE8q+OT" +
+	"Zeyg7jimO8/0XJacTxLguaxqOjCE609C+HOSiM78uXVg+5NCkuGY7PrkFD3EwwjcwSoLqLKcKNEnn/kdB358tZId9p+iTy8J" +
+	"6PhkNZ3EwnV6wCczGtKml/QBXFYZUwZImgDmi9++SzgslKiMzf0QIpdVyub09lDSGY+3Pxa2CddVxuYBzLeosrk/+UP22Vpf" +
+	"ro1czk8qx3ze7hJ2CSXSedipGGG/wAxJdx0akjNnJD0J2YErkV3SlVyVOiGP4ilFSNgunZFA41SYsF9g4vg7WRRR9TtnqpnU" +
+	"yl8H5FCgXLtW18XBfEDj7LuEIzI9Oqcihf0CLf/BHYdC5Z13JLUKjMCVdkmzwsghM/NYu0rtj4Xt0tkolHMqWNgvMHD8XSaC" +
+	"V595p5o2KeuxHTkULteuSWoXIsUMzkMthcP8Q5WxOdUVJ6uUzanC6XSjtHQ5rY+UJsuadHzz7PZLr9TpQylXlUaLc3dgvme5" +
+	"58QvCgrgTWg2Ab4Tb40tSyb3s6UNIxtsY8uSwYR/Hjv288/LP+gzf5i8eQz7oM/8culdYh56RzjM90A65I/0VHawGFzwmxw7" +
+	"rUaptxjbglKnx6/Pt+xw1hDda37nHQMlGWIg+aQ0BWYUFpJuZHlBAUS/E3PQWeP39Lu0UQ6D3p+PHTt2LGun46wkt9Ni1Au0" +
+	"tywuGiraZjOeidsWFpIU8vcEosdtxk3vDmpnvcLYGcnkY9y6vp1yTY52NjifgmN0snGqhDG8xWZ2tIj7sO79Aty63El4+dgG" +
+	"Z/iWzrkvD1IjrikdyE5SRaXZpQX8kbpMvnOBdIYqqLvAHxnT+FwLFqPe7Gydmd9VWFj3PvehozU2M21IubMN5chxLqRndVpe" +
+	"Z5HHIRuWuKawoJSST4fkwFIIHTOGP1J3oUDaIwv4zs7xROsXkFbms1JwckGiBcZwnQsLbRcKuHbiLgc/8HCZLkrU2BtOtgxz" +
+	"nlkxPGOv5dcI12Xcap1FbeScHJLoxDUFhYUfZJdCET1xDhwoC0P8EVvp2LHc+rG22fhFips2WMwfJglHTD9nvyiM41+R9AFK" +
+	"0LJN0gBKMMBWXNdXzNocBw9m4MN9RX4JN8N2ZBCuW0S1TaIwiMtEgPbbLzL9HuWb2QBGMLCtywxGfuYSUeiHD8+g2iQxqy83" +
+	"Yz0rThVFi0RhkDRu9lP9oOAt1W8Bs0mt1JtNYAETP1MU+uLDm+NI8xliVj9ctwSmcJmDRGGReDlxP64bZDsi6xfHSeVBawId" +
+	"NVMyUgfmOti6BNv6ilkzSPO4zfhwX5iynhXfn4jbL8J1gxqO21byuGUj1gz0E5L4wrrn53C3bMukzuJm2trg0+XLy+VOKncZ" +
+	"d63kvYjOLdrZerOef79uFz98js2fK5e62LaUu43TaHmpb8td6veRfPqzf84zl87C/gk7QxJ30/+Kcx2y6lwZgDukMSfRE51y" +
+	"q/y365zwoXu4hMriWHEMUlXKscUMUeJcx0ElVwbgDtaJDFWaUwCX/26gf2/t2NNdXNCY2b96rTW4k4f0p5r8DW70x6G9Jqut" +
+	"Vit99GG1WmHqvXIXvXtrh97dgc3krMGi4yLY9iBhBfdqCSv5+5Kkl4epFF82OUixr75XLq3DjvuFFkzn6NxKwSw9QmXiDfQl" +
+	"xz4vhfl3q+/UXzjACKvVakUczJLXKBlH/b0DMGeHagO+Lm2jl0ohsbq6utrRSto2kkOsdmlPqL+LaOHQM0oLFvW6YtQaZDGF" +
+	"HIO+tFds8XfuVN+V1HBsBw130COvWTIewalfpF65ObVJja8zCYUOmrLq6mqZOZQlZAYXQwjsA2SX5sFw9KGwi49jejGtq3Au" +
+	"7LTl0GNDdTUUl17CV61MfzWPyycVsl4MjUUfCvulshpXIV8/1lWut+Xgq7RiqLC9CJWSBgyjTmiJsIufhxSsZk5DLTE0buzZ" +
+	"GDxfW8u9aMvPIxZ4n37hVpKH5OFCaCnOBRvYyG+kHXvDk4AQ/4mimYzDA+hDTcENqJkd96D2txl/im/C+/D+XySXn0xHTN0D" +
+	"vnkJ2AhPvyCMktAS4bCTBmY2qGEUqIFPtOVzL9aS4/QD3udWLiR/LAR3woMavgA14Vn99o/5T4Qrcnn2akgDzI4NuAcz/pzx" +
+	"G7E8hL1/EQuM5JvXPSAPYSL75BHfgHa1tHeoqQ0XpzbRhlAyBIPWxF0juQ9ZI/Jm1P42A/qRXOj35584BH6kDSFz4EfaMuJD" +
+	"LpMXIb9kvStf3CnetqxbKFdMgkGrNxtYBfxK2+/HJMTHRPsxPPQYQ5+Pj1C0YmbJ+vVcOcWMEAdBCPE/KZqxF7GtEQqV0Rj1" +
+	"ShOwCepGP8BkxCsoprV5ZGfeWgy234+R3+A23Ca1x4jq4thH69dzeSUldYcgHz9H41SIEznrdMS58EKNfCT8ahm1RDi11DGo" +
+	"TXhuDaAzY3Ar2+81P1Laf9xL9gDsHftoPX/7Wl1VPfncL3X+67nyRripDkdir6BXGzQUt8bAUYCFm+dg70ObjaK22f4aMbf2" +
+	"tznTSvADygexOf3iw5j+I8yhoArkgt8dtUaBEu0yiyX65Vooe2g9EodoXYw/9ZUdIz+z6h6tX4+HU+y2fZAPmx01kjGcl1wn" +
+	"le2WCFf5eVL/Su6E6czRcrRmGi6K046FOFwK7xOLuD5v8WIu6LffPuVGXaADlw7hCzj+glh0YSG4g/tCSX85ASF23hfYjsbW" +
+	"8/p7ROFgnV8uCK5n/ivFT1YLV8hO13N/AxwmtnaD865wIn8nl9S53BcWC1OfRMAIl1tD55xSNENusjcP2lsGPzCa3EAaDVJP" +
+	"zfit1v8vYiGWh9IghpGcB+PaX3RjKCE82HAPyjrXdZf6Jewur7sNtZLUF2lsA62Kji5oIZJuh7pEM2r0zvN4rLxOnxPcfDq0" +
+	"j7csiunatoOPm+LAAYUD0KVdBx83AbqwdfyN3oGh3roW0LOVt2/QMPJGC2+/oMDeQUG+Omlx9/FJiu7k5+sbEBmd5ONj9W1d" +
+	"/2drX7rwb2/l6esfYND5q0htG19/VatWKn9f1Oh86S+fLwOk8yVdn8l04bCiiu3VykaHNllSVuqEQ3caHd7qj3B37uBB9vpT" +
+	"nOMgZ7c7ZKi3hV18Sv2+qnZGH5Ovp6CuGl4lfaqJUH81NYGss5J1MIHdfaOx6G3hsITDTVI9OmJKOK+miKKa9Ibj1fDE5Vpq" +
+	"HUywUlTynRvKk/HoGuoV9S66UHyyGkY49bI7q8mOet2/A1WeA3m9lrS+na1d2mlyttXg2t651dXVAMReXV1NFjZos7PdT7e9" +
+	"dX3bDc72mxryYBHFSABT/HMa8sHJi6b44fRg0JAnBle+5M7LmweckzPivLx5RGzIm6f405BHdD8/J+ziaQQ7ZlmpFKQlCWbf" +
+	"gLZkEqzlrsNSePCkO1kIp+E0WVjJ1rFzwlVnGYuROs5TuwG/+vvv020G7htYSybB0idPyLuVMJckk2TEoU7OMtJKZTHSyqQF" +
+	"UC5r+/UGd1CcS2vFC504mrvWLqND2GGDhzByp74X9BowafQaE+AZj4i6SrS//QwumP0mQe/hH2wDhCtkAcx/EoGAxpvkRMlO" +
+	"XEMNZ3RSRAOHHY38ss4QjJ+5f/NmeULAcVXrnqtWrl69clXP1qrjAQnlN2/exz/BM7DqfHFVL0s1+Zh8RD4iH1dbelUVvwsr" +
+	"ZV/EXInQFwVR+zjB9XmZw8bY7HgkhZUKcL6NtYSx2BbL5g8oysndNXZgJ33zNkn7k4rHFPw+OkoX99x0ciO8Xff4yKSocK+s" +
+	"5TNj9G0GjTxxev3mXhHpWfnPZrzUfl/gw+kvTVlITm57BgaWxMZ16DZyYO7VQ6XSGzFe59DfO+xn9WZeZ9uBD4rZ9IfZqMMv" +
+	"VvjZKvtosu/mdQozszz3Y+WUBovBbNLozXr6YzG1As6kBk6v5nVilW+1b+6ypeQ3SFpGLPDIr9qv47JLxL4MllPMBQUy7uHD" +
+	"rUQLP4vjqbtVVs8K+y/CKeEQ8kPhkp6VesH3VmGeKVkl3/cq+uRS5+0GlH+85APTTbLsCKGcM4bxz2YOn1bQLe8YOVuzf0d0" +
+	"+ykF1XHtco9Cl/01O1WiH97fYuGyFeRJ73SAdD2nErMMbStXLANF794AvWOEhInbBz5zlFSQ7acLA4zbPk/OfhkqoPh0oXvd" +
+	"T9yeZhnb163dunVtEpdiGxDo01/6i8UVw/YnCPFXhXeYb3XkBnoqQ+Ax5Fn8Owl5H7vVhfL3684JrZ4kNs4bClQEAzwGysVW" +
+	"oBX/5r8W/77Ed3nyu/DOk0S2Vm+3/yLsFw4xey95g9Pqg+U3qNSxqrTTacGsiOsxd85iPE1c9RJ522qFpJfo98Vz5vaIb8bP" +
+	"wNN6l6/I6As7z8PwU6/B8As7oV//5eXDdiEObSd3hP2Kw8wzREf2RpJGOKA/3rSKYCNn1IQZw1idCQDSpajGopEuDrV8/rPJ" +
+	"oCTv1sAN8d6OID2tNqFz3rM54cZdjI7PxcjEvtN9fati9+xYPGdyZrLisOWDnYTfRdoIOz3IfQs00/gUy3TZ3u1dvjZd1QJO" +
+	"7gJbRs/nyocxPpxFW/kx/GjkjVBoUIg5JqQtaAWp6Xp2963FX5GH5E9oAe6wmky9WJDmxwXYbuqTRl+A1Vsf1tY+XA3QP37x" +
+	"6rVRWavRU7yVxAm9WU/f9Xph3iA5tGWebQW1Iq7HvNmLxVV42kuQeOAAeecl+n3x7Lkp8c3qlnNGB2/JrlOvkV3/xlujRZ48" +
+	"9NmGWR+t0+g4b503qzMCQLox4pSc/HYC7j3bnTyB+L0kEGt36oNotfFxec/mGMN3Mjou4UuJfct8favMNTsXzZmSmUzuWD7Y" +
+	"CbZdcEvY6QGeFvJI41Ms08UlSLwl6bsI3yRvIYjXevFtwRwaLTU9zBim1JtxFrhDC/IneUimwmrKW9tNLiA4ccx7ZCo/+uHv" +
+	"vz1cDZnxi1fD2k4DYDVCQGUa7lnuJuIQkt4T4VncTVsAq28lmcnfFd6RIs9Ir6tW8nnXxJxrwjuPjivmPl6InsonvzNaeQ1b" +
+	"r5GZyt6PFyrmPjou5fMmM/njwjsOz7qOp0TRfKnteZwvVnHL60bgVeIa4Z13RJPIv72B0+NbtN24g5CGrzri45zlK+vm4atW" +
+	"JlefRUhIE3gpXpW+PmCWHsx6LZe5ibjDw03wF/EQp/GVsNAKC8lcK75qtTrLXpHKukTraU31DlwmLSWV38TvIoKVCFBnhTow" +
+	"f0QFNHsynsBsqz1Z/OEi+3PcZ4pQlRIlQjGz/wX0M0JCgMAznUaoRaCPtnQtQBmqNqgFIy4l8/vBgk2woC+Zz35tIvP74cq/" +
+	"1/0NvxH13+v+/nvd30QNv/29juI3QE+ukDujUqKpaLr4OavTDLlcP26/SuEh6JciQLH2q/wmxVy609IWgAGUxlAN94t1tPhT" +
+	"ArkxxRvZ7yrmDn/8OjetQ2aRmCDFtbvKb1LO8Axrvkd684DGwBInfKQD7pFJ4Qij8+gOX8jdQR7MI2a0BTtekQta+art6afa" +
+	"zIhBawhWeprgqw25U+fkRUZFmfBXad07dYr0b9/V3H3srMxBg3ovSI6KM4aEdDEnj0mJXzxm6mwYXwuK9H6jJqem4UUBfokh" +
+	"2eXmpJHjDu+bOjJAH2dKLTcnLawlT+jedB7dEeK5O8iAOlLrefgHOlzfPIe6UA+UONmBA5dOaUoeQ2nqU+GgqcfoZzKzs/tW" +
+	"dO/UiZRIjejUyYR3LRk9hX2NhmFNkCf/5dfP2RB4UEueSF/pGCHoQ8Fd6IUEFivYpNYL7nV/VXNTP+Tn2drTtU9+V0bTqUsW" +
+	"EPY9ecjlfiW2+eAD4cqTv+gc2G5fL+ehUeRoHzAPKfImRC/SjXoIM1q8uVpDj/gM8stH0PUrUj6yd3YYxJIf+XmCbRVc8/NP" +
+	"9/1gxZJpBSQA38Lh4oBp6/Ye+cBssZi5WK1XmUY6x16xPxHShItIh9pImmWKVxeqFbyVWoVS0IZS+0eNG31vzvmKpzDup/Od" +
+	"66uDPeRwokKRBAUku7VfuX8p8YLbHXkFxMCAt2GwPqCvPuT+56dfOe/2/Lq3Pv89TN83CP9EfiC1sxfsYHrRRaQ31ioipFib" +
+	"agMsrqpSRDyivuMADSW9uURFhMPmwRHzABZX3UwnX1TdTsU53BXbtiOTfrC15Ua/PJG24wHpjfcwfHJsOYr1r6oqCJIQK288" +
+	"CnTFrZHWNukGg7omZz/Gv6qqqgakb6mqqspOhbt8qi1iClciNk+aVF73Ond1sq0a/92d6Yx/RYgfwu+m0oQJTNRH3n1IuQkp" +
+	"R0gdecLvrivgDtqyG+bT0DM5GO4fBgUIR8gbN/ndtmzuYF2BKz61LB874miwkDT3r3957ZutizdsWLIFZ0I4tCNfkGviEWx5" +
+	"cOnSA/H9xuVd/drSt5f4/pYlGzYs3vrNtS+v4wE4U3yflsMW8Qi5Rr6AdtLZtx83WNGSada6QTS10zUEK7mWEEwHhCnaAq8o" +
+	"n1s6xXOxypSkjG+2dulU6St/c+/iuStbVR5+ckT+Ip2zx5J+QpqipSqs+XF2yj4O9x1wZYwTbpTgNJ27Lul8ZTo6oFj6xhoc" +
+	"FlQSOQ5DAM5hCcBI0zhMEzQOMzhOI1uRwSvKPj2T05I6SdQ2z00oLu43pkWz3j17pCZFS8CW2cnFJX3GzrGEd0vSh1u9LOFJ" +
+	"PfT8zb0p3dMstEHkC58+c57bNmmQhpx2AqGTT69JW7dNy9GIW1Ud00eP6xniSWLgC3Vk7zGj00PYW2uZB6gfykJ5qPip1ug1" +
+	"/1Hr9P/UWr1GX99QTm574xbD4JZOQKuYp1mwpJUpSdmC7GuSJ7NhiDfZd0BiCX5LE0tZ5Mqam2D5P/gUQs7W/xHV2sG05+B3" +
+	"oneyC14nETIXkeAYI2gCmoLK0Dz07P/Juf+Rk//EWdDoubbwP3HXCYhWL24Vk9jMwW5lH8Zd9eLG7P5X/nPveJN9WqJ27Qb+" +
+	"pf+jG/7LPvmXDmr1j50k68v6cmcVrZCJ7s8y26l1ldJgtOikecgcaMvcpbxktlgaKdgM/lwVk6hsmdm5NLv7gU77uw/sGRlu" +
+	"bLame8/pnotV5m5KY3y7fvlFGSNUyqqk9Gme/MPKw+QVn8SybQHD0t98M31YwLR+Jg/b6D3PPrOmVeVhbqkqMmfq1gk5aiik" +
+	"IHktOaZoiZohNfOo6ejN0Bgj1w0sRovGQbXWomPnOZf1rn6aseUQVkjch7xlXpZezWZ1H0FJbJ6ZUtS9+yZdLJ1Qa5ZNkwiX" +
+	"O6+lkkKgN2Pg6DHpIXerE0iEaqRmaToeRrvGu9+kOenpe3sk5mnIabkZ9esKdHlx6TNrHGvicdYOT5Tg0g6IBNYQpVFqCA1u" +
+	"pTR6/UfN6BUUFe5lKVT0SdrFGpI7V7W+e8qh/6QZEJsc5UkiVJVJ2en4NzbGtie+mN5nztv/1gy7HZWij/lw/orK2CLTvo8s" +
+	"pp94D1msUrbIhDB4Kg+EOfLAGprHvk/OU4U+hv78FwzPR1DF0j4iixuXZ+WMUjnXuuz75LzsHMKPY3V9ByEqRfPtbA86i3ZS" +
+	"eP1bca2BRs6lvvT4Z0+fhtOnbQkcZxNhO+E+JBusFNcZqYwqjOJgtYpkMPskEEI/uTNkMK2dOwshtG5n/vek/PCtlE4poWck" +
+	"uCSkcdH/d3xpIbXg+SHFC92UQouV2ZlbcgufH1y82E0QWq4Y1G8zF/1KRmoCz2FF177Z9KuAsaJrn4b4NQFOG3JnKDSHsV2Y" +
+	"8eywzRnZK1sIgvui4iHPFwzZ2n/QipaCwm1R8eDnC7noQX26KjAWElIzXsnu21WBOT4hNYP6sIJOwkzuMrOXl5wlBoBJy1zW" +
+	"SLNfesQeCQYL9a7nxRYK/EOvNUm986AgrXDlwLyK4tLDlvgd3Xvn4YI0KFjFIC/jyOnTS9O75m7tvyW97+78uUOGrnL9UzpX" +
+	"DxbS+JfYOQ0bgjlsDOYMnqZojacuWiOkTrr5aW5+/pjM6kk3P80bmj8mE18VK+ALuA7EgCtIOxIC8A3FcwoheCRgyiO9gVLu" +
+	"MLQPM5pkR2qS4SE82p3WNYbjsRCb0nd3WjezgLEQ22PMseETn3Nvpmi5rWDIwaJxR4smPtecV6i2FORY5fP/diGNL2U6Ppee" +
+	"pZqJ+jiUnBFvy+sidWx26bYVm4Z267d5WMHzQ3ImbFmBBwaxfo2AeWlkZVCfQa9kpLYni9Mk2f4sqIQ0bjfyR0GOUdQRmhxE" +
+	"9OQupBbsHly8UNXUOKr7mp3RJ9EhpGw0mGDpPlbXcCGNe4B8UUDTdQXif6uohVd9NdyDo/3lagYezUhNULh17UPGW+U2oSIh" +
+	"jb+EQpmlr+zrQuKUbJdmMOstLtWCBviFJ/z13Qdu9yh4fkhCeiXOF7vtnT3CDpNrFmY6COhwVTzNTyI/eXQYEny0f6r361ZS" +
+	"ssX6Z2eJBG/oZVslrw0nhTT+HdbO4P+rpdr/oLXs551GLVYm0hZ/6Gyzj1xn5L/WSFsu2ayZtHqJG/Lq0ETttgOfwYQ3xKv4" +
+	"g3kl6h/IzFM4RfTbUNIUKba+1g+ty6fprB9ad02j49b+UEgTkhk1T3VAfSdQUlyJBIuO+8OtWY/ByaMKnh9iMA6AP8VTRXiv" +
+	"2KxgoeV7iC4ipXB3XId6QnPLF/HBEBow7Gj/VOUB64fWMxnTrR9av/KSaQt9kuEyzh+gNmx3/NfVUvO/r6XDmkhrJihYGp7U" +
+	"5DoLXx3t7wAzinnM0eWXzX1BSOPt0jvJ+tmurP/ekb7rdCHSOajpmNZx+01Giaa41ORFWdLXGZPV89fPMRkl8uJTuy/MYl8P" +
+	"rVXNX8eNaNFn0NH+qV6nOrN1IsGNbE6CghaMYK9T8khXwqik+nH+PvJFZhYf4R9HneZ/nOvDCnYPHrmgUcLRSOci0PfpcQif" +
+	"NzE271vleTlLSOMvMnoz/zd6/znl31vx3zUPrv5nDWsK9vT6w/buROy6cbcCtm8D9X+r1Zul/VvT0nX3bsU27/rVZ1CfRKUi" +
+	"ITXj6EC6hSsTUvuTC1YrWWGVdLJn0FUhjY+QbQyCQs28EBSq5YUgQRsSygvmEE4ghLwFPQCBG8SSd4kISuhMzpM6IpK3IQVf" +
+	"qjm6QV1zdNMrNUc3tcIvHt0kr2uxQhr/CfJHcXJk41hqVOliaGAwS1YEcsRJvWx1IM1Yk5YZGoRxQpe20X4+PEz9rYVvp36Z" +
+	"r2X16+TnUQvTeB+/6LZdu7JEsqrWw69Tv6zXMvt18m3xG1nNEr/kA9r3SB26r0vU0GiTKXqoKW5ffkpKRACXwQdEpKTk74sz" +
+	"SQlRXfYNTe3RPkBeb4bLdAdJryj+kTQ6BTjh3ygQK1kXRPxDfeItq6RPkeq0M0nxe3hNpUBBEhxhJ/xb8XVVGIXLcuRrLF0Q" +
+	"0qV0O4HX6tO5d8U35fSejnTxTZqOkSyn0vKvQ6ziGe5Tlv4XuS99omUqJQoCs2yXfxJiFbO5j2jZv5gsyz9CSygO/hG575QN" +
+	"dks+7pgvVVOsOcyoYw9DWQwiM7tN8sLbOkZ+1REi58GAunkwxNuH1PCV5LAO8JOoXj2jonr2jK61WhM7d0vYt8+3sDNCvIRb" +
+	"oWWyutrhz47+kyJLRcfGhBmj5deikr+Js45xj1O75+UtzBva3Tw8JDRs84RJ6zZyu/fVWvmUorTU4cNXFE2JCA8viZ/2/I4Z" +
+	"M15gawytS+gj11VfU7ROG2ZQKM0uqJN/+OCDH+588jlDyP286egmvOHoJgcOxXyGo6ULDo1JY/AAJwJQ3tv905oDe1h5XIm3" +
+	"i8XwJTFK98P/hIM67eMMLjg27jmw5qfdDIcQSIzwpVhcX/6Dxu3Q6P1Ar9QYlRonihvQInsQNP9q0PWvsyXeZIA/uX2E3D5y" +
+	"BPzr+X+Z4XJvQA3zJqh3YckGaH+hBHhxvYTpbZKBR4mBSCnj8Gc4/FAUsqCeqL8LJi0LBu4IMG6iPkM4rf7pEF1KSV9qNDvD" +
+	"eTGwQhoGwU5S+A6d9T5FRV3i4uK6fkHupyUYugcG9TAMHjl8QK9BXWLbd+r+SpcunvHh3QcO7B4e/1psYWxsoUTzG5aAkBAT" +
+	"2QXDTf4pXYk1KCVd49mtlWry8OLpY3vGJifGjf1K/KxriGH66TLD6HUDB64b6OS35HusnUu7ukHj0OPOL3Ik93ru5Qdkd+43" +
+	"IDPa1K1XZnRM1/SsLL9+ZnNviayuXePLJ5FXIb1/v2FZ5ANIz+xbkEk+wKGDoqO6SvFcJRq+ks+07V1HjZYaF8TEUn+djeKI" +
+	"KqijUfqErn5M2YYOHZLjG962tS4hKiSzfbvBkQNH6sPDtZ4cx0mDHeZfyIjuHtHer6NPB4tBX+DvP6J/WGpEhM6vhXuAryT7" +
+	"GIU0/humg9drdA6xkWN3tBb9WXzi7pTtoCbTD+MEseePk7eRP2HFYfE8txsSRl3ZV2sl7426ZK11kcf7yu2q92bpuFd0/OCr" +
+	"VhxoFd+2Mvn1EyFNiGGrkfzGQxZc5ZctkvDKxYnL8fvTlvcCgewdBW+JtcV4mth5+vLe5AkMLSHdsZrbbf0xe731Q+uH1nvZ" +
+	"GyWBnZfvId2cMSMbUPUUZfSHW0+ps/Whv1H9PSi/Dyno7Z8b1oLZTZKnbLu44S4FH5Gfyf2jMNkKk2y4qbIaM7DCVBRgJUaA" +
+	"npY7QtZaSdVR8BTfs8p2J+fsOUKa4Ic4aSZTG4T6ms9JVTas3ipon6r/KTx0mVfXUyGkyaQPh+C6eVYrP74hKRidIy+z8hr6" +
+	"FkfQO081zh5iVAHUkstwIEe04wWz54RAJBmQg3lx7pw53GwHlVbrNOuL0zpZp1n3TBfS6kmV+PR/1gNm+NeKyGOpIQ2rcTaH" +
+	"1rEYRSp5/jvJ5lmiW3BjWJW82Br/YIvlPhD7ij74R/qNV5PJZOq7uNlO7P5J/dfGeKQ+BU76WMJ9YIvFP5ikD179ifhwp/jo" +
+	"XVgNVc6vrL07yB/4NYUnao2MTG5xaMm9dXpmycMUHGE0GjiNO2KUoufgLmGBmR179OiYGRhGvg91fA+t0rRKiFdpvFTxCa0U" +
+	"nj3TXthx87vvb+3Y3bNnz567d9z6/rubO15I6/kkOiHhJnxyI6FLl4QbJPomPaChM8hXSON/lKQBpZl6NQ818xaNTkljVLYC" +
+	"b0EbouSM2G/3q/c/GFpLrsOSuy8vnvXiq798nPcXBJJZd48sgWFthx2dFHa85aajh92haMCDtsOPTgl9tcXmo4c9yM4B8jwQ" +
+	"hDShBLWid/ogaEM5oybUDBqdMlQrKFsAl4QF8dTyZxaTZPzR1tlFgMnzx8hUaAPffXj8HnkzCWKP1UAQTEq6O+b4ip7bJ1Oc" +
+	"9l3s3CbhDDULGh0naIEzWgRzqKUD4AtiHU5b9swieFM0b3umiIhQeAxWk9tc6IfH70GPJPLBsRryPVnbAGdHIU3IQZiOQ2gB" +
+	"1K5PE2oOlQjlKOFsOHIWcgjU18l+WFtKOHx83Pru0IocHg0niWc+7i3OTV3H9S8dJE61Qo/d1m8gqet7OUdKycWVR3eQX60X" +
+	"u6Kn6rKwd0iMdEdTBLmu2Ptvk373wZhBVsCJAeLfOHHm+mlwQDxchF8RvdLW4019u9m+s/4y8+iKv/YeOQEju5J9S61vkmu0" +
+	"IlpPFVuDaPxwFPrvZ3JpPghp9JS0yE1Bj9v9tw6hx+1F7ryixcrsjM35jhldeywjJZ7nsaJL32z6VYGxoktvruCpuW0/wur3" +
+	"q9fSsLj09dVLnnxiweysnR7vPeTKd+cUL3bzaLFyUL9NddfldbPl0Yy0Ljyv6NY32/mN6+Yy3Vm72wppQibqRE/2oa5avYYd" +
+	"6my9xcmbjqA0QgvAHfyaS80PNg4X7fjU2A2J0IwcGw2vEN1whYMjw5zcCkzfAFnECpoOHOOJEtq8YP0W4rqfyj4yjny8/Og2" +
+	"cgtLfMpxcK/FtS7cLxMHI+Q6JhjNIGhZlJ2nyKPXOUbqdFZpjqVvr+sHjcXIPQ8visezI6Kepq57TMH6jOjofkMKslL7xJLl" +
+	"cHKQ+CfuMW39xR9f495aYj31dwtvHmMnUzFWtGlFfu/6nZtPWPbRDEMA//zMoyse7DtyEkZ1FSt6J0g0n7JnCGnCXNf10I2j" +
+	"HXlO3ltaO0YMNjp7iDvlMkwa4ZB3Onk0dHXuc89K+LCxvig8dO1zDp0iE4Q0xXCEUQDSI9QBc6yvObmvlXRSGTWCOVRD+dUC" +
+	"8CNoT54MFh/gOVkbukEBeSYe3iZtfLFCXJm1sRvZCqviSDz8VEQ+gRavFGbD4L3WD2BpGLk86chccnv10S0L9lgvkJlh0GHq" +
+	"0Xngs/bIVvi2aFAjWgRKCyh1YOnoXKcEtvI5VsJQOvHxM9uXQdsNC9eLJ3DpkA15sJDoA+CBeCcc9xC3Dt6YS2bC3QAi4GBI" +
+	"iW6PX+jYruMbR96FfWGk/2zr5vU7j7x05uTRc2RoGByaa92yfvfRlyTdtv0omwfUdgkJPGhRqDkEhFSyhRjhCowjm2w/cH4w" +
+	"mh9BFowoIPN+w2hOzRzcuKzGjAQtD2YUGnIWRnN+th/IJhgHV4iRH/EboUUI+g0qC0bUz73BSEAqKnWgUDPfsO4q230OYAHZ" +
+	"S7LhIOSSvbarXFvIvQqn1x5ajL8jd4YNI3euQvmimjlQgdBTOE0oVMuD2pWmBRzY7pMqyOXa2q6SvZALB0k2PL/40FqSLAy+" +
+	"Sirm1CwiS6+C37BhMr4BDB+Njvx/rYuutP+bYrJxe15uUhnJhzTdvv+YHqhv97/SU/d1Q278EzmNmENpWSikKdogLdubwkLN" +
+	"IaGg41jcao7XaXEoZ2RSC37hGnkEGyEUYMbe1WP3vjMXFN99RPZdmgE9l66dMg6ODGpbk7gHj+xDppdt+O2VMnJ5/q+/QXAN" +
+	"cevDuccF6Ixy28kdIU2YL9fnLWh5AYwatr5pQoxmT0GjAyoQcSOvQTMyjlwnhNa37+155PF3H8Pgy2XktaVrJ4/jBrD6xK19" +
+	"YCWrD9rP//U38m0N/N3H9tBRH6AT6AUhiy+k555QveS5m9n5SoFa9EKWqD3+aM6cY/hXUfPMgezBz+BfXwDvC+dXrdj49tLl" +
+	"BcMb4bCY9czCTTZoZSEwTuJfZucMOjBb9MK/HJs75/GrL2wcXrB86dsbV6w6f4Hck9p9wp4gZAmfsph0SApkpvNOoEG9OIcB" +
+	"skEj08el3mCkRHw3uno09CarjuO24qGR1SOPPZkz5xj3PiOMC4PJpGqjOHkj2QxjJJJZPSYhS3hProf5BaXBnTzA4LBk15i4" +
+	"aCk4Az57Y/aBQTmzWT3kBEw9Ll7DA0qqS449mTP3GMSxemxfkSqYvBFXbYQxZDO5J9dzgJ13qHdUH4TYIyy9w1DQhb1f8ePr" +
+	"NvDjRa9X58ydKzF59oGcnGfwrxs3QvsVK1z5LJ2hClxxqp/itlJhOMuX1q3Hvz4zmLJcg389RlG/SvG5sH0Fo9GuEdKEq8iN" +
+	"yd4yUQm44Y2JI7iPfAjhJpGfGImGVbiruMKCD4r+Q2al1kF0571ltA3Qf8isFFCTE/wARvjGjRt/NZ/cuLHZQtaa3ywnN0rz" +
+	"SiMUOOt2mEV2lM++8ikgAhqdfpvte2bwoEEJGcvEbHx7yKy+ozJo277tjDeK0UNmpZL70KszmQ8n4Qxt6vAeXm4bf7N8GDSE" +
+	"tVgmZCMbbwfs44Q0/ifkgYKkSDnyGcvYZD/huWQYbjaqNBxeE2+MLMW1TfZYXpeNG/NgbMNu+9e6muq/RnWRnCb6Uq6pYYey" +
+	"uupt7vyasrqzmC3y22kX+7svjsSOgUTyzpjYpizxPujwbpeyQdO7SHpVGb8qrPmrsk53nt3E6hX4IfxepEZtmqoXtPSZtcFM" +
+	"VWUuNcPN6uqh1dV48NPVwo0DJQcOlBxguhk6l1ZINuJObYVNx/1UN49fYbW9L/mCcc3neNcipNbN436y6fgVdWVWziLbczNd" +
+	"gEdD7Yy2AdbKunmCB8VcYUVPl3FgB61LDayIVMuTv6xWiR7+awFL9DDTdjX/dbXoWy3gJ4QfUrdPwAg1zGeSbdvPVuO71QKu" +
+	"28cPeUKoDLXPfksYIYSg1shEbRCR7EeqoYMhukmGRNc71mMmN07vdEK99bmaK3k7e2BKampqasrAbPo1LSUlLWUg+ezR1SG5" +
+	"7du3b5875OqjL4bk0a95Q2AnJJG3q8lb0J1fbTT27XdywriT/foajez7uAn0+5slRcUzZ6xcNnNGcVFJSVHxjJnLVs6YWVy0" +
+	"iGy1kq0w0gojXdvBoTbIIvlt+F/aAjM5X9td+PW/aQVVt434L6lH/3/iPSh11GWDxWjRWf473uPdt2+V3ro1gf3+r7lPTtts" +
+	"5TZxFvvdBP/dQAf/U3texdGl4ifQ478aRkvw5HKxiqv4rzvh6XFj0ho8/we68Z7Tp9/6b2jmgOAV/zW9vJNeN+SDolFfhP7p" +
+	"7qcb/A/N4Pj04uKq4pKe8eOMbdvtnTVr955r/1Wruk/K7D9hwo7JCzpFdpiavOjEsWeXvP7R/5e5oUYRLDag8+1JB5Beo+D/" +
+	"oXn8VPINGDqS3/JJbUcwkG86giof1N/9V03sdPjIkBM0qvaJIUcO556AgeSlE8D/923kXcaeEumZPYe8X/xPM+c2ThLfpj9Q" +
+	"8V+1Z9zSt5fiB/9f1y/L/7Z+0V3yv1u3onF38a3/fr3SWVEDmjkWPZjuuOpQPQ3ZXr+HQUfOWvcpf7IuHe+v35+W1dXyqq1P" +
+	"3uJzXTaehvg8pB7kHDjZXpLuRHbVivNlJA1pofcmGqqVUbox18tuECqj4E+Q16DnBPI6eb0U0tgvSLPdcaDkfiEbycZyGEc2" +
+	"zIJxMG4W2QDjQ5qsw8W+09FmumaR5U5cQPASR1HH250tTtlBq1fvmMpX140Wtohl88XJqGEex+s1Yf/UutF89VRcNR+vaJQH" +
+	"ZA4L+8Rj08RXhS3zn9wR/OYj1CifSX4GtwP3mYb7zhf8ntyZ78jDGRrThDXirw1pYnk8GE1OB0GgmspySrlJyPzbjeptIj9t" +
+	"rdRiZ37sQqeH5BmQiXnUh8c3U7k8Ww15Z+pUmRxhC+Kc+TnnKz8TuJaidDWfRj2ekLdpySej6ku71sc5SzeiEhKnsorpj1Rc" +
+	"ppa+PT0s7Bd+Rq1kfjE/+3owAuUb/AbRo8kd3F7cST4eA21IGq0YXzeLufPFwFh8QJwsvc+ScGiY9wQd57y/1MhetjmLXst3" +
+	"Gv053BJ/LMQzxewfx3xO1k6dijuvLxRF4WczaTb/9nzxUiz8TUIocWT77dsIueJmo9+FOIaXtpP/azS5A2NIMfl4NLShDIIy" +
+	"se/UqcLPLlRSsm2/M3YhzolTfk8u9anGlW4DxUz9l+D+U6fCmZ9Gfw6+5OVCOETa/Djm88e0A/GEQjKN0oo7NCLf9s7t27Le" +
+	"bq9gEYaxO25zSKhkKsuiUZuk0PYCr5OiJDLbdOmpTshZmApBx4ZaUjunR4/Oqnpm5+KPyWHI+njxzmeqskZHp3dOtQw9Rr7H" +
+	"kfvCcy39o7pExXebN27XrJ1DV3bpsnLozlm7xs3rFh/VJaq/JbchDcALknmuKdqikwQAS2xoiFGyFmAvRSRP07wgWMhq8n1D" +
+	"IiCLHG5IBATBVBz5f1IRLukPAoQ0/q703hzUbtgM/EHbI+55euCBIHyO7BC3YXUNViP0VH43zKlBC/lcM9ujapodiqDIFs9f" +
+	"EH+roXfe9h3sXouTbnh19GUsq+EsX1ntqKPIasXItZomy0k1SWcxqTJWzrU+jEphtOI9bjfSMe8YsdHeXsw8i76BpyYWVEOo" +
+	"j+WDJTsLoVWfS9Ubv+oVqRY3ekb2/fK5DZczOqrxOE/bO6N79hzTk+s/cN9vL2aZeiSbBuz+Y++gmB4pLTOn9u8/ReJDw7pc" +
+	"8Ftc6g1h9hwKLxziqAuPq69L3OjJJY7u2XN0Orz+r3UNgdHCy9xu1ILF8LS41hESJjVnCHDD1e0HXF7/3Jd9OqphSs8x6elj" +
+	"euLIfSkdc/f+sXuAKXl1ZtaU/v2Z7FJq3yVsY/yV7/3pG0SBqdPUplC9WY9f27QJ3Kkhz7RNm8hfJByuCSXinxVWcRpuQU+/" +
+	"gL5ACN8Ttsr7h8Gs1xpw66kXseYzwX++vN5SnxLPCb+w+3W6M2hBell4jPvGln9Eel146obVKvzieF/YqAyVMDTSq0VaxhAH" +
+	"qTcglT1dFH6xPjntfL/YRDldfV2GOGdlwi/WXXJlGBEaj5+fh5o7/WlJcTN01DFVGLuGY69is/pt+CJRr3psW409WvpYXq+I" +
+	"4+eRnz1D0+6/mJOHU8Q3uieu+aSrH0IuONnJ3Q30ghlMan6KaMRf2lZzU6u5y7YvrFbucl2lFOvxCZkp3BfeYbMJWLBHbgje" +
+	"cFx8U3zzOF7H3rvPfLxM2fupvKx5uEocfxz3wD2OixPYq/dlipn01TugeWSmME54h94GQDBPTXVUKAixx5oh+MG75N5WqIKP" +
+	"oGor+UVoc8tWF8d/D8ksbOvpuiD+vSd3GuIQokOY3obhMKm9ePzgXfDeSiaTGDJ5K3jduWXj36sLIqfJNQiHZP77urgndyg/" +
+	"ylAl34KvYGMNgR9wbhz0++vPv4gZPoSPiRlXklpoCSryBxCCCIuV+y5Oh4VcGnt3b9Ia3j19mksjCND30InbzbdmMYWcNmDf" +
+	"y6Zf2V9/nY3FBlZfkt0NXa9+YPW3lmQoNX1lz9xXuckbF5Xd8MZvvvnmm97UkSY5UgrbYFspeRmWknLoX0pKSEkpaP7i339I" +
+	"ulihrJzEwgflZIWVrCiHD0is7Bv2rP2MYrKQjAREfaohHXVeA0agH2fxVfyF2G4lycDvw9FuQoV4BGc+qcAZU6BATIECshuf" +
+	"l3VKEg6GIVTrRktrQQ9qxWSxndiWoXmV/EQydn+PM8SjEqJviD9F81R5jZmVN4PeSYHYFvcBHRzli74Xj+KMJxU4UzzyDdwm" +
+	"u/Eb0t4olVfSdVe6ZjyL+4ivCi+J7bp9Dzfg5jd1w77BmY3yCiyrG0hUCi+Jr+I+3Rhq/oVvSAAJRI3fXvy7RfC/WwNzDwb1" +
+	"6er2tCUwApSLEP8rv5utAiak8QQT4gw49wz0j0uGtDNk5NB9Q/ndpIqEwlcwhSywfcMZUMNyJqQxeYIBcRhyYevQfUNh6xny" +
+	"enIceRnmcwbbN2QBTIGvSCipYnpc+i7dHWnYCyJ9sLSRM68obP+mgaWNYWqVrDtWOu5QUsWXYkJC0mPwQPHgtKzMXdNxtvjS" +
+	"g/1W694HeJB4UFLq4oFc8/C2cfGZ1rwhlRXHbClvnoYW0PytN61Off1Z+xOhwFG/xqyXBBimO5acCTn0yUaHQllN41+fxdnT" +
+	"d2VmTRMP4oEx6SEhMeJLeKCkvhYP4kEP9lqt+x9wza3HKiqH5Fkz4+PahtvuWyUttvXNt8gD8ufpN2mfvomQ0FfIQGpqP6FX" +
+	"0+2OGQiyN1patZ4uGTpvtu4Yw4KUCq2aq0gfk16d1b9s2m7bi5ADN1/aXz1nHtk8Zx4+H5+ZGS/G4wsVeUOOVoid8XlQQfkb" +
+	"r5NasTO+cGH58uXLPxDj2Tx5035L6Ct0kuoFNd0D6QIlxc82S/Uaw9hapfMOogHp8VJnvVw+sZIAVi+MmTNP6CTG05rxebEz" +
+	"qxlfEONJLVn6xuugwhfEzlLN+DwCyEGIf0W4wmyCqFgohVWTm2xhWwg1leRbZ8X0qE6JyaqeN2Jh9aIRc6rxzorceC9bPHde" +
+	"E59XIY7A2y+sLNVR11y60uUXxBGsL2OYXxA/KlMITqdhZhfHYdLZwWg2aLl71HPYi5BPf6j3sKFkD/2prv4Itxcv4/bW2n3Y" +
+	"JH5Mf/bVWq21tbL8ViCk8TXSPklXQ8nWi+9w+jT42AolmYxDBO6TtWTdcbhYAxebLCcbdUGb06fz2JzcyUodJx1rSMfjMAFx" +
+	"1BbM7aRsC+bVyKpM/nA7yUzKzNyHYl+nmVkfx7d/MDNz+YrQ0/U0tDqTPxYzq7MfJeOzH83chzYz/rFpG7SG5mh0XSeRzHaY" +
+	"SqPUDlle6ySvZhT5WW6EbWe9naTDJoN8C1rwPEqqrGQtR6xcRgPjn6fwMlRaN+pAxmHekcrQ7STfOZBzw8m3R2CSFSYfJfdx" +
+	"nNXqYiD5tE1laD1GGdUu8i1feaQBhiZsMS31LWzUtCMNWtTY7gCQYA4BtWQLoEUCL6SS+TY75wmTHXf+kg0AX0EKFx9aC6ef" +
+	"7L8K9HYdyq/SW/8mbBmYZUUIoNAQ+V7/LEzmPG12Ml+6tSd7Hbf4fMVVkrz20GJRTy/qwe8qWbqoZg6pkHCSdezOXo/CENJr" +
+	"cShQn1Vmb52F3d9rpPv7VsDrtGGhSh4sCqW3kPoHybWjGQ/2vn33XNw18hcsB79vyzZYy459VADwzTlSEweTydKIUeMHXYXQ" +
+	"F8Xn++DusYZD4d2fTYuo6fIMbt6H7CypvLp++Hejj1/94tml6+HmKIPO8yl6NGZvgfl50vKtIIQ+WeGk+/1E4JXm2FBLiKCM" +
+	"tYSdvQn7HpfdO3iBfHkvEzwgh4wk176YsWDF2I2f5JF7+/6G4GjyDpxrrg/y4yuu1q3E/fqIX0QG1kRlvTk94VDfHUToA51G" +
+	"z7+6e8aVuSeufjh25tgqv2ZKhUTPd0KawiB7cnN6+HK1d1brOY1ezX1X1R9CyYvvYYNY0w4vEtOKqvqTryG/LZmNXyfvEW+F" +
+	"If4vq7jZWmElF+P/slZwb9ju4zHiZtRkPeoGdUjX7TrOUc9TVcAb74lz8eul78FyWo8txVGLFY+xWsXNeIx872dPFNL4B2w9" +
+	"kG/zdK4Xx/K1HqnwSYWXxB8WzU0jA7BvlriBf0Bp10ywflo0wVrRGFeo69WzdOOnUwqprgikiz+K+KSEg1JmS9FMqLflcNj7" +
+	"udrd0Rtul/d1su0w3pbbVTKyyy4tXlEwtKtksjZ4woiVnNKxzqgDmT1duz/TfgpipnMRtWn8x66LzX9Rr7z0/EvFZLG0EjVd" +
+	"retyxOqtYXPZj45z4d/qrbcicn1IXLyysP4hcdEKTtnYiChSfllMqZDeFf+eJqxvZEKE0Qdkt9DvP6Kj3noIv0B5wOiYUETp" +
+	"yNicz+igPHCxHXqJDDTJVDxIuydTUZsmbG5sVsXW/IdCmqI58pAtc3WcRe2w91UrqfkdP271jLPig/OrzxMf/Oj3uadWV5Iu" +
+	"iuZJ5J3cus614o9JuPX3NZBv+w2fiyN8Eoz4pCm8Ro1S7bD5VVuokR/sXF12Frd4d/V5+FF0+2PuG6sr4Sx5mASJufyFWuyT" +
+	"JP7wfQ15kVOLXeLAlkR2ynjnCmmKxYhDOmZ9pFZywKx+QSORLk1e0Aj8wfmnxCzIqSK++K9Dq6e/K/5RjpeICeNWDwD3BSQR" +
+	"duBz8UQQp1hhyXc1MBSuJpF38+o6/05OWcl3STfwZ1D4MePRW2SukKxYzPSAvghZ1CA3RKrLYDZZNKFqC3Chb+MRn6/+HNaJ" +
+	"FTPwQ9uH41cP3Hs3+xcy7QEYh0PfbfwF8hPunCS+91oN+Zq8zaqx3sYLXhW7TIYCYtvM7Be4eHsy91g4yXwvlNmeSJ94KrVk" +
+	"wGX24zQPugxEUYBtqrAWma2OSznsx2kO/mv7cZpuT1YUSDhQKwkH/3VjHPV5+K+lPKiVax4EqL/9F8Vt4RCziZRcDDZw46h3" +
+	"8fFoDIPvyGRY3SItG7q/hKdSZ45zUuKVfFndKqezx9Uvkbeyu+Npu9avOeL0O+hwR3hkcQM/h3RF9vaUsWOV5ECyJWApCKQa" +
+	"zIrZT552IPl4bjPublUVntabiFuY/0iYzvxHbiNkGKjEJFC5+BBszfwzqqh7Rn2w9B5RbTA36aLx0+E9ffGOigqxKDhx1PvU" +
+	"SSN8/3B1VsKig8T3IPPSaLdLtCvTVWHN18m2J3YeM/30L8J+xefy28d/84xJV/H/yztmXST/+T96yOTe2uWQobbyY4S5yBv5" +
+	"/qMXSlrbv3mitM3jKht7o+RzVko6d/KV7I/y/3mbbKN3NfSBGSjH0/jnOvVg5qfVvaH6pzpbePB961bhabuCn67Rz3dX47HP" +
+	"EDdws6l38cGp8xayoYpMbZGWTd46yJxtsrFft4KfrmTNprCD0D27O2f8T8d+GJaxe6okB5/dwNMiXWkIasWcx087+Hw0r5nN" +
+	"t6qKM/YGbgvz70lWMv+e2wAPI7X4bVLbcOxDEK/i20pncvqGWC29wDWENnKh+enwND+xqKIC76DDgjnRJEEPV2fFLz4Idw+u" +
+	"jcqUziaNx/m/eSmly8n/5amUv1jXkb/4j/5KOY9dT43zf/AIytTI/+IVlKukI/1p36DLV9bbbS0Uzkj3svVv0oTKuq/4sLru" +
+	"/Fv0E1eScKtwxkq82L6RbL/Oa3l/pEF+LOa8tLlrvRDb2C1h5hhp59d6BYJ8r4Pb7asYlzuumNx6b8/8cbljS8Af1udNX/t5" +
+	"Um7ulHWfd+N1U2YUz1j1+jfk3CT25TvociX9pz0VxUU9f3qhoojROgr6cre5lZJNF5gFNWhxIWkGi+ARfoZ8Dr3PPIFIcvwM" +
+	"5d1ChPhW/DnEUy2miaoGQ+kpciGch/OiOx5OPiOf4V04RLwuDrfCFZhFnpXis3BfsfgsShZHG0yg5PD1v0uhmEZk+Yu/R2ql" +
+	"EEqgcomnjpkWOtSkkWIKXc/6S45k+AeoHCEB7XYpL5eoCtNVsDV0NUK2MAec91MZKRxdZ+lXHekIwM/+Lh6ryGDjWqfw0nl7" +
+	"KaXfxtgYY1iMhf2GFRNTUydNoj+OL4qMGf0nZy54tCBzUuaCxwscf9DfjJ/0/Ze/wgsJTHY3qA07YN61GoXXmscTWPor5A/c" +
+	"SUoH9tQWd6q5pvB69K1i3ZrG5anaRLuj5hr5Qxn8eMKap8rT/ceEO5HKazXkjzWKdQhhvM9eJJTjSllnTYM9mPVavK+y8hj2" +
+	"h9fFfbiqqorFiLIXce2c+ajdH40ZM0O8jTMqK3FlVdUFcR/DB38I5YKnnM+s14Ya1CYuH/uLRysr4Y+qqgt4CMMHf3DtnPm0" +
+	"ejPNx/B9JuHrTPMBOguBQhpXgATHvbyQatNu4gqsZDxORBgJgLmXBE+kZG86QwSs4UM9OU+OD8WakHmQB0PnzCF7SM2cv2pq" +
+	"4DJc2rOHtIfly5ZBBVQuX07miY/gAfZ/+WXSXLzzMlKgbxEoBik0zEt7AkpD/VGuNCvVWi8Fe9ScAGrpdXO0dxvQS6+ctV6K" +
+	"CFBLXwzBYV1BfrXr5W3SsEnIpiA1t5aUhswSimbhvMCD/FVzr6ZmanX11JqaezXgUVM2Y8+eadXV0/bsmVFWQyYP6dZ1yJBu" +
+	"GzZ0G0K/Ye3GbkOGzGH/d8MzIJykWCtOnFxY8TqEv76g8uSJCiv9u3LB6+Ta6xUL6d84c2/qiBHLi8QjRSmpRUUp+O+i5SNG" +
+	"pIpue1OKilJTihrzWfKRmlo3bxP3MwTiRDJeWqd2cKPxj46xRPV1+AcSV4l7CYvEW/glKU85NxqfkcejmWaahXtVCouqxIG4" +
+	"DcNhn4x/xG1oOl3kTGr8g3iisgq/JN6Sytsn4zNSuonRMQveqxRP4DbiQJoOCwniNihL2TxUx1h8wcF0I73Eoo6ltFFqWMS5" +
+	"HzienhcXbWytbSk0azVMpSRIaBayAHPwRnb32ESNKqxVuriBXMJPNrN6Q4kvtiiXSXi9lO7OF+w6yS2WxtMcpcaxT+NVLnvy" +
+	"V8gCUSQpTrx4PHTAwni2Tq+Ez5Rv81sct49uwNYzCNWb22KtniNkUCnWZWJtKRlMXhFX4DLcC88Ql3P7SHg5HiAeKodr6Q69" +
+	"1UqEnLiQRs+uc/Sheq1+JS7DW3CouEIcJX7Nn6YI+C02lZWrtVqRQMu5Ryq82DrcHLVCWroa692w2uQGbcEN9G6cyQ1WKu7g" +
+	"N8Uej5+fhzuLF/hJtv70UpR7mYTj9eL72DIfW8T3FV62h7aHQkWdF56BZ4h/inrcAn8nLheX4+m4XKpL+TZ7V09vZVoiDatL" +
+	"Da51qVcqYx4P+KdqFF6Pf2mqAv4XhCJQKUJuj5k/aZ3sh7gnGogK0Xg0Ey1Ea9BWtBe9gt5CH6Kr6Bb6DdWBEjQQCBFggR7Q" +
+	"H4bCaJgG82EFbIIX4DCcgvfgEtyGWrDhZnRcR2vVXgZ9sFkdo1N7mfTRZnWMQR+s0XpFQHBXiEmgniXqv4e6fBf+Ic8/wf+T" +
+	"78I/1KX7f/Dd6PJd+f/gu/H/Mf3/xDcl7VLpLwP9ZfqnzhRYqj66DXgZwKS0nz//CN5b1ENp7979EfT4SZ8cHJysf1+fEhSU" +
+	"ooe2+h56fQ89mSp9vid9wKoGfzo+gpP1+uRg+QNG6W8GB9/UP3ZrKtP5BnnfC6aVBp+X6n5P+ghqsg7p47zjL9cC5yUs+v+k" +
+	"nIMWWqDhB4B+np7+r9d/Gxak1y+W8soMeZIeHMxt1+uFK9/U3fnmG97vm2/q7vTuzfv1tvn3Cg3tFRbaOzS0d+gJ9vu//f/E" +
+	"/+P8x/4nqqT/vw4l34aGbggK7RUqprvA10sfSEAIIbfdDddfthQqDdS0AavdgKPfwG33Yz8xBb+huLN4PlsK6zzhS7hGwonx" +
+	"VAUFVGAL5865r5eWQ/FP3KLOC7cQ/+R/EZeKK/EMJKBn7WnCWcGGBNlaU8fucGgV7Iaes4BSraObkNpwH1voki6+PxrvoM5s" +
+	"DDFwyGQiA2LIgNGjwUxtNPE1GE822O5y+VTxZ3uRDBS6F5Nfikk+/Y2fiNeoXmIDQjiKH65ScBlM97OX5PCR/GyVgsthf8+y" +
+	"3xKWs/QC9vdI+w/8aH6WSsGxeIxIRyKFYegz5/mHPRUx62Elzofp123Tv4Eycg0eWUkzeGSV98E7Qp0cydAZEogd+uj1Tx/Y" +
+	"7gwE9Io4SlyBQ3E1nsFvqQ8Ar2JhCBGmZ31FO+Ezxi8UqmcblN4N4Bu8BtLJSXEKOQktyDyo5ARYSOZNZbvScnqRh38UfUQf" +
+	"JtOINmxTPsfoN5hVGk+NCXNYDRlHqvZtqLqXNVW03eM2wU0cBZz4J1ki3rf9KpeDDOUyyfZDbdhx5Ihy2cNpsqxFvLBNuYzh" +
+	"1FKUBorSDBlTF52oOlVZpVx2z7aHDCZeZCSkwn0cxkXfa0yLWcVhzuSp8VRrcd9pWfeqNu6toqRosCfMwi2JKH5GAmxjaTnc" +
+	"h5VbhnjpnBGF6w5TYtxWNaLFpKVIDRQp7nu4asEbVScWTaPEfCx+TTzJKdgKv8B+bmg9LROYvGhg8SIyjuBhZKBoc5ty9j9s" +
+	"v+CIGgEZ8JL4gnLZ3xln3coQaoyf3U/gvmQAHibamh06+3ANQvXtesbh0x73PXxY+czDSpc6nmHlTRQB7ntYfAEOKZ95uOUs" +
+	"Alwo3uM/U06QIyPGOCIsKLSccm/xhH1/i0O7msR7yrLv1u44MmpwNcO5SbRxSuUSFhPVWzIGUNF3fWb6xI9e0auUCjwgJmzo" +
+	"3pIeP1ssP6WW7B0agt8J6rqO9BB/xM3hRGF3Hk6lFsMruLn4I0nf3IW1o5B48Z8plzBa1OxpKIsFYVZzSlPXoeLfNZOKlUse" +
+	"rd+SM+rIjjU3lCWsjPiAUypXsrbTU5hy717xgdtmmc57nFJum5o9ATWzuA1qPDym61Dx0d4JJeI95dzqwaOO7Fj73aMVEj4b" +
+	"/5nctjDJ4EDFKKFaMdZSSyznFRuSt7c49SeL5ecexXvzwvA7+i6b4ST2ER+QfsWpp4DvXkh6iQ+wD7y5rqtEC/FiPGvB+oG9" +
+	"e2TsMuPhe0sm7XkkDu0ao1zyaNuNNTuOjMrZ4jgHMHmdk+IRwKKjBLktezjTGZdTuMJ8TKNQKSAnp+fMNEKnxsBBHQ3KCQT2" +
+	"gi0/j8bphD9hiRSVE4+pOwNTF7JYnf8/3t4DPqpiexyfM7ftZuvdmp4t2d30sjUJaUAIkJAQEgi9g/RepIQOiqiISC+CUkVE" +
+	"QLFh772Cgv0hKtan71lJdvh/5t7dFMDyvu/9/oG7t8yZmTNnzsycOXPmDDM3so53ACHewz4bsRuVToq08+6Ly/AT+MmLy7gl" +
+	"VIfRXAy/IcR26H9TUGbH3heZWo/fkw63oUphrC8FbzLo6X6Dy3tksikvv6HhuYaG/Lzow+am91rebWp6t+W9P+qhmRffHzok" +
+	"GAwGhwyNPpBDEPv9mjXfkwvfr1kj72Pk0DZuFbNWz2tvkOYpdeRXti8ehDQoDtnkPRLUekcyp8mF6MQ24A+CKARCPpFZ9UB2" +
+	"Tk42/Vl969att67fupUse+mNhRgaGw/f09jY2HjP4cZ7P37n1Mcfn3rn45bD+K302Z6Wx6QzotZe+oyl8xHZnpSuTBnoJkm3" +
+	"x0yPe3AFfGaXlUN6t43XW2xQ69817aHPP39o2i7/gYvJEHsA+GTyIfZtIr9v2gQC88CYkeTR8A/k0ZFjDrJfDB09/skVI0dO" +
+	"aSmHTV9+SSZ+iQCtIDmsDr0paRrs8gZDSMBDtrTM3kLHlfAwfKdEg9WXvuW+5S4gjbxyBnpktyGRqn69rIGLvOFUp4PF4JPJ" +
+	"4DPbuW/JZPIPcp5Mfhh63X0Eej188QKMQ5cAwbi95OyOHeTsHmh85vSFW58Nn8Zfk6dIZ3gCyp6Hmc8/T9a9SM6T6bAOEu+D" +
+	"7seOkYfDl/DizC25LV+sRVfgVPAXOFGnTqUQutJlmKCFv4MlNsIN4V1V3yaHMhsrA9eO678ieNuxzALd7r+B9dplNT86yvql" +
+	"ldW6i+5OTdm8qGhCpeHAlWWo+PMycNGNYZ6A7IzaL/dMwcuL9HfKwwxUT3z6aOHC3vXTSyeMGzFw8Yq6uob63Ckj+i0M3XrP" +
+	"0L8s1SZm2dCbd1gTDuYl9u7Vvfam4qLiMrNtc6zphll9ZiK2Q7mSJZu4PymZi/ExPlHeBWwP/K3qmPevf5kfXrJkL5n+l6j2" +
+	"Jstxj/BDN7/zzlX4pvCv+cbMB1u1V22kpxT/W+w9DTNkC1OYsOuRJ+8unNe73+SSoRMnDs0eXPeXiEPc2rsh5ujGLYdNsYdz" +
+	"E0b2axxpcl1GWw8q/wuuaVWx+d1GycCSF9oVKOA3+LyWv0XyoZUNDZWVDQ1PVA/ImDSTU8yqXHfw3nX773n7/rwRf1mWXaMG" +
+	"Dxo1atDgUbBzh9OyoU5VcmzDrfeFv3wfVMbL68Txl3UCTvh7fUslHkceugdyyfC/Qe3qlj1MPOIuo28Zqv77FHZRt+sR6grt" +
+	"nHiGogBOx99qnnhir379elU3NpIv2OF71968dzijmXhox467J/buUdm1qqprZY+/LNAXdT171vXp0bOuJ8xtmL169eyGucCs" +
+	"GLJm8+Y1Q1Y8VdKlS0lply7oMn7y/xU/gdwCQkG+1Y8ldeThcBuj5TOb/hY/wfhe/SkfKWd3v+XAkXUHDlM+eqZ7fX33yoaG" +
+	"v+5Vb9yRar6tt6b43g233h++8D75xQj3jxo8eBS9LqtDN/Ki0j8vlbFtzIJ21elpN7j/nTI9tHb7oQm3kEn4waLS0qLC0tLC" +
+	"OQsWzJm7cOFfloeU4lLPDRnh4FL88eY9d27efOeezQvPv/Xm+fNvvnUewaXv8UR2PGNBDEJMwG42sAvwxMOH5b0D32MTO56Z" +
+	"LIWJdpGGmWAAOYgQwDmC8CxhWasseG4dQcplvyyhYdsIwiejYXYRtoEtEsigIJ7I2qT85D2HAcn5rXQx8cCHV8sXRYL+Sf1q" +
+	"EJtYGzO5NY501Lr8r0McCbnohRg4R6bgWYKxLZ5PZCIXnFtH/8gUPDG8iUlu+0WIgW1kCj7ZPp5dbL1oWT6NXFdGZi8rH10f" +
+	"CfjMvnZX8K7Jd00+NPlQtIRSGdnLyqiLrCy1+9car0MpQaoOxMI5MhXPEgxt8duXtl2J163jpsOdZNhvhP6yh+lv+BD9RVI6" +
+	"28hUfLJjOu1KfzkFPgXbHyYn8YjEW23zyXP4OhJDEGc53LxJXkeUYSZHYMR2MJPJweZvUYSfLktnG36d1HdIZ9sV6URhWtO5" +
+	"9D3l2dZ06G7ec0SNVxLETjx88WvUDqYDPhEYEwy4uKEV5mSHdLaRvvjVK9I52RGfCExrOnCOtj1hmQxDja/h3Dr4BU/krM0b" +
+	"D0vhpg7hohxu4qY0f0MORsrdPg2qyKH1A0eiiURgTB1gxAhMW0JSPyC1Z07qB0SfaICf1uGJh9lJF7+K9AWt4WJruAkGsOaL" +
+	"t0Xin2yLbxcNcBhs0QTk+Cfb4kfDowm08YKwDAlRekRKTNlBuax5o1zZV8KJHeG+kSs8whPCMvlkaadoj6TYgxaewrIykZo3" +
+	"XQ4b3UEM23rQZCVOYyfJoO1ghSjNoxQl9e0QvVr+Ysf8IxUgsedVcRA74DCZNUfAr8RBvAyHCJzMz61wUs1Sjoaf1knsyk76" +
+	"ZQlCV8CJHeGkWvplSRTuZBRHu1TTThnyMNh6RNK8+FXzpqvB0jLRdgA/rYuCNm+kLaYNVmhLV4I8DLYI5NXyF6/MP8JREZpe" +
+	"CX8ZDhJ48zcXN1yBg3gZDlEiSHBSfxTF1Sz3EuwCmakOR/g0Aje5NW9zFBKvlJmVptl4uHnTFbCBCAWkGpD7Q3bi4ca2OpVg" +
+	"2+PZlr80PjR/+wd4biN92QWk/m/gKfVXMj/9JZ4SleT+9i/wbMu/HZ6RPkyI9j12M+1daFcoccjhqCwi16Pc/9jNUSgSI3cj" +
+	"zRsPNyJ0GaycnmgPyLDhBXKa35CDHWGF1nTFSN4RzmjrH9vjaJdwPCx3tREkr8hbLrO5FZLUt0f0CjztETwl2LC3PaJX4Nna" +
+	"g8KRSJoSohGaC8tkuaXt2GA8cx1RR2javIltR9f28PYoZaNtv41NJazb80trnCiFL4sTxb7526vEEa8ep7Ukzd9eGSdCdXtr" +
+	"a4cjbRn9KW4Rbpbzqb8syp/kE2n7cOSKSG1xNO3kUTNdRmmHXX2PdrRrbP72injOSPuJjAukb7timRrbEfAq+UlziraS9Whr" +
+	"qZSEjVfHMyCZI7Xr2Xq0kaSxjfRXq6+2fqY9ku0q7I/ruAPt/7SOxT+gfXsGvHp9iVevrz/NR/yDfORIuIMsTy1rzHSPeRAe" +
+	"nwJPyNI7wh3k9taU8Uh4osUCj7fJ6gghDOfIk3gW/1QUtp1czkxm+rXc3dyX6dsi9SMYtpEn8ck22I6ydwdwfOl7eJwdzzwt" +
+	"wzLS9i5pJtd8HbuAsRw+TA7SSQaGeoIYRlgWTVOeV0A9nQdJ07Po/E2WyZ+O9PHm6LhFxyG5oXJPHz58uKULha2PjDHKtpPG" +
+	"nSLUMxaybd26iITOTmzeFMlfSpeL1IK5tXXWM5bwAsoh26TEL37NTpTTj8jjT7cbbwLRXkOWy5nHDx8+fLE0AsswjCXan8ob" +
+	"HA2Mad06KsnSRCkuEn0jsG24yFxE17NmhpsYE6kjO+AnCf+Lpczjsox4Dh6P9tfQulkyInnCL/A490xLZ3k+B1CPJ7aHlSVK" +
+	"idZwjSycRwcNDPWRdLnoLNVuhmivDde0fL0OX8dY2EktnQ+Tg9wzF7+S+m14XJLX5LJGtnAaKFFoU6M0YZ64WBIdkyYyTCts" +
+	"BBsDjJHpIrsDvfhVhC7wOMNEcZGhIZL2mHWU4usYy2HmieaN5OBhznqxpJVfom3L145jIpSR5XKJNi1donwg8aGqPXXsYoQ+" +
+	"lAtkfoz2t0xrHA4pka49leSYUUpJPBQh1sWvaSIRTmov46ra85KvlZskWZeRSffLkiiPSGOuqr0ES9sM2SGRr1U0lZsOYlrj" +
+	"yLYAEQoyrXElqlPWWhel48WvJfaSE8ARmenpdnlSutA2HZHwwk3sAhnNli6c5fDhdnKeXGPmVs5nF5Btch1HGiE7MYpjpL9Q" +
+	"RnA0tx8rDeyClq8lHGViHj4cwVEmZaS/idZfpL+RpTba50jxKAfCgMPMEy2dD3eQn1TteZBKRmMiTSLCh3KbYNrl0YZj+3hS" +
+	"TnLc1rxo/Nb8Ivxi7FjXbbzZWudtPBqp+yijXp5Oe7xbaRzBIUro1kK0EZxtTYPyhIis0b5P7m/alwrPlLpAue9RR5NGEt2l" +
+	"GuAsHYrZTscicYvfEHEgK1Inp9CXHK6jff70fQCHFze1rGunA5Hh5T37FgpfX0eOQP2WbRR+cdNhcrBlXXu9gFxvkU3wvNMA" +
+	"9XX167ZtwRMPL25iZrTccpCgDjoNleStwB2QvbLa2fHk8Obt6+rroC+eSA7SGE2Lafr3EhO7X5gi6RjBB3Vs4+7mQ8SkHPjL" +
+	"AXRZODjBx+7f3XxoNw1vF0bzckreTSSnJez+3SSVuHfjzPDp3fA+fLg7fJqYWNSC2MPsPS2IRc0NrTqPzHa6T1zcqvuEc/jJ" +
+	"y/Sb+En2qeYyhABntOlMacvEGbg0EjGiF8VPdtCLsk82l8v5GXFmVJ/KiTQ/EwwAclDS0Tx5uc40kiHNr1UPS8Ok/GRFbETX" +
+	"ip9sHy+SYZv+VoiMEXR2gWul3fvSJK2xna62vUSwrYc0NZayp5ijy9KKtKJoWtLk8Iq0nJGZeQ+wdUjs0qVL3+MnuR3CUr2g" +
+	"HYIQ+2S0nXE7LpW16pq5IwRR/8CtYWhihzBZVxsJg40dwpiNLZNaw/CTHcJa64KGMd92COO+vWhqDeOsHcIE629ftYbxXTqE" +
+	"Kbr8+nhrmLCsQ1hEpqJh7BftwtjfOobltQ/r0iGMWdgujLmpQxh+rl0YfrdjmK59mK1DGAxsFwaTOoShPdEwn2g4EQ26dAk/" +
+	"SxC3XVimF7Rj8LuI/ukRIib2rNQ+hVbrN+pfwok8KBPlSt4fOyHabAI+sz3gM19+N/7Nd/zs8ZY1R5lrj7WsOYZN9PdY24fL" +
+	"H/l11NkOefnjjz/++LdH6DMEpd+Q9Bug3yEk/UrfEUIojZaFWyNM/suydEXdUTXqjRpQfzQYDUej0Tg0CU1Ds9C1aCFaglag" +
+	"69GN6BZ0G9qMtqNdCBmdAZ+13RqA3Wd2utpdnE92Uy2anQGrz+zMv+weCvjMTLsrny5pt7v+2/CjR48eP3bs+LFjzWeOH4fR" +
+	"x45J16jjx+n7URp6PHodP3bZ33H57w8+H+cXfx/5+0H+++cPP/yT2P8p//0QucMn//wnfCzff2j9/re/ophI3XXkQ3piawnq" +
+	"jBDnCzhdvoAzeo8+g+gLONtd0gxPdAacZnvAaQazLxCKQOJnZcKEO8mEiT6T249Kf8ew8Tg2HT8W/pYGH5MDH/ho0EcffTTg" +
+	"44Eff/zxxZ4Q/Ahu+Rhuob8fffTLAcp3/LcffTTo448/RnK/yHeh/Yde0A5ESNGFfmM/b21z49jfUJsc+3y7fg2/F+nXIm10" +
+	"B35SL2inym1U7m/x81J/O7VjfyssjewroTvfdlysx+/RpJRLo/20DKP3yPHa0rwsPo0ucjvC6eF0YWlzeXO5cunlMIyUh4E7" +
+	"Ek4LZwhLf1kqoUHxbR0LpuJ32Sc75Oum+baGdExP1oDhd/EZaXB58pelEl7owCWW90g+TZFVtIsHuCUtvO33T2WbstYwASGO" +
+	"GqjREVPLHm+uHcctWcMVX6y/ApbueUCutk0JZkbUp4MtDugvaF9jrK+/3vLVa+PAz1jBT15t+Yq8Ouj8eWb2+fMtNzGzoZEc" +
+	"armJHJJtdOU09R7tcvQLQnpeOx8hxHfAK8q7EvauiODa/t8BbsnFCxTlyy+pnDOPzjwuX3+ark90wmXXAW4JIbffTn7fvZs0" +
+	"R++7dkmpzuYKLr7Y8UIo/qrp/93+UmqT0Qs6NkOneJVv/+m79I1KS+Lu3SDcfjtwu3e3/Liz9Q+Y3ZE/Gir/ASd/od92tP41" +
+	"r+CWrD5C/5gPj5Cc4yTrKMk6TnI6vOKH/vqZ5BwhH0scpmhHO146HdCEYlEiSpHrB8w+xgw+MAM1sw4FwAeRyxqQ6v+VV2Do" +
+	"ufBT+K1zLTVstynNJ9cz94Vzb8Xl5M71r0pVBn3P4SUtZBGb2NLyCXDFS5q7wBL2sebzLYwtvKwFVpw7h9Bf4eHyBTiarVW+" +
+	"nBCAyBWS+OX1V8NPnoNhLTXn8FvNtVMkTJj7zoVzcdk5sueVV2SWPHcOVrSElwFjb2k+D+zjS5q73sEmtnx6B15CFt1B0WjH" +
+	"SyxSIi0yIKu04zgVpaFslN/ak1e28tEQNBKNRROlUReBNeRxitaQR5CeGGvIw3R4g7YrcPkH6WJ8MnjgshCfHKftjbP6Ah5J" +
+	"0tZmT8+bkj1jRjbkzJiRu/LCjOkzPqev+I6ZM/JWfDVjxgzy7ozp9GfmTCZ3evaUr6bNmEZOTZ8xjbw7ffp08i59OjVj+vQv" +
+	"puZOz2ZNM7JzyLtSrJnTZpD3ZsycSU7nrsqZgedMz8kh70lJ5a7MmwE4d2pOs5lbwvrym/zh897Fi/Oh9+LFfnJpcdPillfz" +
+	"Fy/Kx9/S9/CiRYvJicWLFpEHFi9eDN825S8h4abFTeT+RYubyANNTU3khPTatGgRWeZvysffLfZuzSf3L15M4zXRn8WLyf1+" +
+	"/2JIXpy/NZ+cWEQ/+v2LF/uX5Ed4GdABZGGLWANiEArRPpe5zcK0tLByP9oaJiDkau1z8XPhTuOY21YzsS1zI7A0nW28h1mL" +
+	"GBmSmvtyS7bhn+R0omERfSjtv7knm2u5WAoFaqwP/zAT/ySPATzTm3O0jgH44EU+8ffzkX49GtZhDID3iXscPriaK7jYGIGF" +
+	"qL2ujA/YWUfzZzCaTZQwomm1hVOcjHaGs4Od+dfPLcd+YZbKsB3w4i6x3CouFWGErErgqClzZHDC7cI4hKx2JbOLrQ6/H+5M" +
+	"gdawL198NkJrDlmYLayBphFSAgcvw0sRYuN2YRxCIZoGHk7eor4k4aXVeH/LBQmwIx4icNIQ2fJDpEwd8KBG1DCZrW4+MY5b" +
+	"Uo+vwddcXBOF7YiLCJxU7QRHadMeFzkdPDx8xzjmtqegARpa7o3CSulwq9rS4ZZ0TIdbdVk6bGzzhXHckivTaSuX0U7TCZdz" +
+	"qZHxvEO5jEpw2WEXdmF3cwIFY483B9mXZ/7+aRSfaLloOsxtJMQaIvzcoVyRdCAPcsMCBWN2hwfh/TMj/NweHxA5bgk2Mfqr" +
+	"4iP58HyHrb74JQUKb2NfDW+LgF6GD4gccxs2AbkqPnI6eFjLKQpE7sH7yT0R0I50juLTPp02Okvm8yLwbKyMUIeE2srFUApg" +
+	"iY/D5bRsLT+0/NCubPK6iZJfi4OSiX3nKGR4NZ6H54VXR2IgxHSIo0JIqYJk2A/7yaDwy5fHhvdIOkmH9y5LhUFjyCXuPJcu" +
+	"2ealI5RKnWJ6rRbqj9LhQXqOOsBO1VPnVALSu2gYq+doGAz+fMasWTM+l37JWsjasGnTBsgip+idTIIR9x49ehRGkN1Hjx69" +
+	"95+4an1V1frwA+urqphgGD85YsSTmDw5YkTzJWbluRkzzrU0nZsxQ6IpcTJr+DkyHZQCvED2083xZB88TUpJKbes5Y2WF5hC" +
+	"1tX8QfM7bLZUD+3LgAx/gvPrf44lc9sfY0bH/WsuAXeO8yAlMqIk5EG5KITKUHeEXNQDi0DdfHDUr4eVOl8x0tcQDXDRVw8N" +
+	"MFqoN3GHB4J+T8hiEsBNHZx7rcCbrILbH4JCTyDdl1Xm75rHzPOm52YPCpT6ym/yBNK9meW+rnktN/jSA55BWd39Zacrbqxw" +
+	"Nt7TiH/RakcbDOGPIu9htU432mC4pTjfbZuYlldSNcfmzupcUluUl8Z62n/MLy7t1iUv7eIpi2VqQgIe3nN3T8/glwczVdJ7" +
+	"+I7IO0J8O/pSWdUlSako2IGyRupj00rdbtKDf0GkVSC6nQ6rSMstuj1uqxjUj+1I7xiTtahTpyLrwm3bYEqaJy0tLc3zUafC" +
+	"WLM5tvDVrfXt6M8+1/y4Tueysr9YXbopx8IfHmMHX3xTELg8IbU5xurS6dlyvc51Z0vTMexACLGX8XUIIaSnBxFKpxM7HQL+" +
+	"23yOG8f5/H7fOOn39O29e/fufbv0S3r8OTfhLeQ3ujcWFF3sdjINNo31+caSiWN9Pnzvn/EZoDGXWO48Z5P2REqnesgHKUrH" +
+	"fcgeBNxjhw3bu+exR/fsGTZ8+LA9ex59bM/eYcOw4a0HTkyYMGHCiQfean1CuDU9yVe5QS/b3FJ3Oo5ULlLqsY9C98cehcrH" +
+	"HiOPPPoYeRgz0YJBZ7gTJr39NtlIhpGNb789UsYb/Xm64BBYStSrpUtOQdaV6bIfjXiSkqVDupYr0pWriBUvT5gdGKmFU5cn" +
+	"jEthCaU2WYb+PG0mwgVXpN0QregrkybLaNKwBLVPW4Vi5bRtUtK2VmrQxO00dZgvp06ub08SmkX40B8RJpJNh3zEK/P5owxw" +
+	"49USJssov5FLTCqXjoRIqxYdHrml3iu1wd/fjfDlAXKJGSfDIdo4RK+VuYbspoDMu5R/+YwZsrz4Lafi06S5EYKoCxEHL00B" +
+	"Xt92btu2aTO3k7PsGHxx0eHDi5ruvrtlLl/8+7MIMegAQXw/Pk1qtyl0PGrfFju2Uym5tD9vhGQ1d2Sj1M5kGrIgtbNzUpv7" +
+	"vYci7dczMk0PYJOUr+R33kj18FFOS4cIDge4I9ZIqrs5c3NfyNq4ceNGyMIm1tT8LTxN0yWlsPipESOektOkZcGmaJq+KAv4" +
+	"RLDLBUo9EBvF13rxHfYwOUWTJKeoWQUppQnC083fsiayXE4UEIdN+DkuXfK3TvEyUxQ5GHH03o0byWlsomjMjdRre1ivlZWL" +
+	"xB29F0ZMoNTi0iV8lzw5gsKSS/g5bJJgAxKSeo7nKGEnkN33kktzKftRlDrCgsOTKhcMP3vvUbJ7ImRu3EguUbxp04j0Z9gk" +
+	"9cViBOdWXKSGHCXAxGgDlgsRxa2NR7nz2IQMCBnz2igZIePYCVE2mBitIWyKtk2ZkJfJ8JIsF1Vz/YF8f9Uwuxx2hexM05Mk" +
+	"ZwwmeIvJZO0oBpk78L8Q3Y3icEPPRfc3NQ0cvCiwY8b0bdv/sR1fHLNmzZjRN9wwuunuu5sWSXYyHXRv1sgpQweo7I0HhffL" +
+	"igKuGCH0J7AXL+AB4YN/BMu3wkZUZO0ArwYHbaq0VkjmMp1Wa+4QwXY2doffD8/GrvAH0UgXn5VxaZNhBRSDNAghv3yMBt3e" +
+	"jstqp9fUzCDGtlkdZDU1NjY1kt2ts7sr5gUcjfksdkejrGkHeuWcUVTiXdh1BSSVKZFwiE+TdZkGPVhsIaQHt01AegjarEgP" +
+	"vM1zFra8AptfgS1k/Ctkwivhn+Ctr6T/JPcrkvsVm9z8LWlesQI41gTcihUXd7IHIWPTJvJu8wDyLj3gtI12HFIijTy7jsyv" +
+	"KQW17HFKunFSPbrCH6zhiqnGmCLJ9MRjr4wPrfGlGtDim7C7ubY1Pq4Jp0dVtjPx2D/LPxLfhW+W4su1uAa/h8e2Rf9b+M8K" +
+	"f9Au/lj83sX6dvi3jcdGZLtitOcFlrFzhtZe33PF2AxvLFxIvjFjW/hTM5jlDpmcWth0xWD3vrb3cXJ4Jjl8fMSIp3DLU721" +
+	"4cwO+cdfmT8XjI41ETSsV+SPg00LaY5Sv/2NGV8fnm8G88KFl+c/TNub5jpiRASJ3lo8Gv1V/lhPs7RjebgLhlKN9qvIJgsX" +
+	"4tUm8rVU9qFNC8FiCn9yefbMo2H2qd5afBr6Hh8xotml7X0c+v6t/CnljXaRFwBfjf4NkVHQYgrPW7iQWWwiXy9sumruI0Yc" +
+	"h774tLZ3+CL0Pd5bG955RXsMAWWdqK7Cxq6nrHKVdit76IvOtdnjzVPZ9TOvqhNqr/tYw66/2Ko7OnBpPtfA3nu5Tov5uMUx" +
+	"jj1sZuubi7ngxZcj6yNRWFk3xh6+PhJG0/mI3cVWX66rYzJa3h3H/NabWdPCslOb10fSicLK6TC/OSNhV/Rh7XUJ7fBWoq0I" +
+	"ce9zp5GA1MiIHCgDeVER6ox6IgQ+qyg4PSJnNQsel1Vwuj0BzpsCJnnSQE+wArvVLNg9AVfkzvgsFDjg8kVvUgpMQjeO68bD" +
+	"Jf8noXDVZyHm/nT47dE69eGyjJ0DbPuKw+sgn+fJGxzLQFAQyMt8GZ5/TeiT8A9M4OMQfC7F5k7z5E6eh2HbyxhS9j0pfyHE" +
+	"nvjHiMfGWA+UDTkxLoF8x/fmo//JheXzmdIwm1HMkDJ2biQmAjT0UjN/Gv9D2ofq86ZA0EfnQZYkoOdpeKmrC4+/DKz0x2sN" +
+	"snNNz0wwOvMXpAwVe/x+zjc/PTcpZp3TzT1UnJkxGf/jQqMzYUq+39ojfqzyiCd/cj7Lqm8bpMgcI9XPIIT4BO60tPLus5p9" +
+	"NqMlCZxu6nTCIZhNgsftFE06cORCIOSzmi2dwFsGokPgnZ5AyOn2OB06cAaCoaA9wOT5eQw3tbjBJNp8adePUSStXjg8od6O" +
+	"OQ+nLFB6DdzjNXo1z7PFx0oGJhsY/z3vQRx3ml9E4hs1Cl1O7FifDncpCTeMjcUjEuN5Xj22MAPvLSnk1b7yhBIDXvf+J4tQ" +
+	"PNp66RL3oTBJmoMlIRfqikagm9CD6DPQQhGMgXVwEr7AOtwJj8VT8By8CN+F38FhxsM0IEQ9boZ8vOD2+OiT2xMMWay8ELkz" +
+	"kbundQ+mx+3x+iInYUeO3OaNwZA3BaibSfk0cJ762A3Ro++pQsPqcfu8OqBTWLNJBxTA6cgFq8XpCIQoXwb8ZUB50+elsH8T" +
+	"lPsPkjX+/WT/EFvT38fWdDVk/T6vWfg7GDD/GQ3+JmgKXAZ6WalaAUMSqn8v0dAf5X9lDUT4iIvcrZG7TO6/R5j/iNx/Ey/4" +
+	"jyD/Ll2YTYJQbLFys2YozPagMNUNjNvJML+7MPakMkyRAzNOO8AD0z++fcen0wcvwkwwrdOMvvWLitO8y43WVddm4aoKg2VM" +
+	"7dJLZP0ltLRhosFQURH+3MWBRo+5VIbVCpkZCi3LpHKgU7OpmFMr0rN4DQcxdg4nYM7OMho+zc2rWCaFAbWatTGMWnC5BQ3D" +
+	"6Owc1miBtzOcive4hBge2zjQqiiMRiHBDLHzkIB5B8OqKYSKBzsHGjVnY1gVTUXNtoREDFQgMAAoGIuZVQAdrTgsYoZjzBYs" +
+	"AH7RgEEPjBFjBWu1sALGRkaLDRgLjNXKKnBYa2B5BSsyWOBMJpbHjMiAgmcNGPOMycQKuMHAgg4YkcE8Y45CCAIjYixwRiMn" +
+	"YEAilkCAEViTkRMYkBLBIgaBNZkZAcaIGOuAMQAWGLOZETCImOclRFmjieGYj42YVwBjAlbgrBZeYLEB0ySMGAusxcoIuN5I" +
+	"S8KaJNSlkpgY0GIjzdQayyjwDjvGrhSGIWcidfuZQ/4AJTYONBreRontdgkqjrGxWCalRuH2CBq25T6JTqyRidKJMbJROtHE" +
+	"SZOJAT1gI2ABx1J8wAigZUyAFZyFUpJ5zsRESB2BwEYsQTCCDNGy10FrHXPta50FjYq1Y0YjuN0KLcPcAO5unNDV3Ygzshgm" +
+	"PZvF6dkMk5FFDNjlxtjlvv2a2259881bb7umn83XY/w1Y8deM76HNxnOTo+dYxx2oU+sPrFy1r49X3yxZ9+s6nidpc+F7uHx" +
+	"9kKdrtDOiirGbGHUOmwP6fUhO9apGYuZUYnwVopfo/GnMHqBMxkZpRYne3U6bzLWKhmjiRP01Sl+rVYKZ8xGVgrXaqVw1mhm" +
+	"BD0ktPtgYpQ6Jilfq81PYnRKxiRF2KZP5PlEPQg8Vqowq8DaOEGI02IFi1VKzAvkMzEpJiZJZGI40MQwvABisiAkiyDwTIwG" +
+	"uJiD+kRBSNRjOQFOgXXxCkW8Dis4OQGIlXPACpaJicGcALo4hSJOBwKHY2IYVhGrSxCEBBoBYlS02egTFIoEihGoYoAX7pDD" +
+	"QWBBHQNqiLyqIUYNrBAypAhCigEUHFarMacAA0W37f1sW7hKjbkYRp+oVCbqmRgOq1WYFy5+Gqm+c9jlwYz0LN1hWRIlnUQq" +
+	"o4mJ0bIJuTpdbgKrjWFoXejwOEOyQpFsgBiWJs0rsJikVCaJWMHTpNmY5tasGZq1EgyJMTGJBlDSrBlOActFmoCIFQLIACLF" +
+	"TZQBQFCEl7RWL2syMjFaJoKSNoYxmlhBj3RoDkLcJYHOwrNRKeqNRqAZaDm6De1FJ9Dz6D10Af0OMQiBpB3XAZ8J0lnY0iF2" +
+	"PovsHEryLF4GkocoOpMyeq2WJFkszgUqrPx3Hzwl4A8Fmcsh4PIPob9M9K+juP4SAsekWw3ZxsZpplxHRtClGrSQYQq+7a7g" +
+	"1JoET27nnCo2KTErv+KTeI0QG6Oyq8Cq3kyflWrpuSlOy1uVmhQNWNU3xUnfbWqwqrEEo0nRWNV3J2h4awz9HKuGUe3g722X" +
+	"5pF4bRRIFd7WLuCXeE0kQqwKuLakVF/H0QC1TQOxamwL9Smp7l4cP0vILqgbNL9Qu0C9dPhYh8OiNzhzvWNmxyfkub0wMUWt" +
+	"41MMQpaWSTEpoChZrROSjYocNZdsUoA/Ra0Vkk1C5LUkCqxhU0yKMG6LazMqSEuKWsvbDEK2hk0xKrAgJyVkq7lks3Cxwxuw" +
+	"NrWWTzEoJFhls7pDtu8kt8tV+DKSrJzLy62Z0DeEEKBrL/3KhbmXWs/PBKMSjPNwwoGDOIH0OkCqSPV+Zh0uDL9AdoVfpGpt" +
+	"XIAQbhfP2D5miFECA3YV2C9Lo2Qx1MGgG2AcqV6xlPS8PEkzqQU1+QKehlh4kPSU9Z5bEctcz51GouQj0CQoIWR0hYDOJCHI" +
+	"1PuwIvwYPFrrIGNfIiaxE+6j5E7jYKo6vCr80xekwDMM+63WhDJ8XUw0PcR9wJ1FcRRjr7ljglTF4PaIwdPs00Vx4WZ4oM5O" +
+	"RrxJUrW5cH9LLHQuA+DONjgSws+RhNOkxDEZW/TZiV745GJLBUBXbnAhQoiR8viQOyP5h7uabzTZzYyNxVzEl4zk1Y77gMwl" +
+	"F8jXZO4JqDhwCCrub6bnGP8Go+8kH5LXyUd3MsKoym6js/v0rc/C35KXSDWcgNDTMOGpp8jWZ8kFekQpxB6DLkeOkMeP1U6Z" +
+	"Ups/KC9vkKzjnI4Q9wv3FopBFtQVIdTm1MbV3l2Rxdrq4CYknX+dSo9xoCt/evpVTydVAT8tj9nEpawaOrRr52Et6s7DhnXu" +
+	"OnQoc8Rqqt87Z/bePj3HTCFvkzry9pQZtX32zpm1r14Up8JtYIdUuA2+GlfVc9y4nlXjxlX1HD++Z9W4fqJlyPJlDz64bPmQ" +
+	"PspZW+8Ph+/fOpPtP4S0PPggsENE3doHfqNnof52rF1ZXpLK0hkhiHoZ8gZd0UefNwgmimbAT2eBeqr01gvScdosnRx62n+0" +
+	"cE0S/quGthymBaIvzMNkCvmQfEKmTBXF+n2z5uztUztjCuTAUciZMqZnn72z5+ytN1nHs7fKRRhXJRVn3Dii/pXsgaG/3n+z" +
+	"zjAY8AMPEDJ4ADNz8/3NzfdvmaHsM0T+NsQi3ogQRtMvhdmx3PNIJZ000q4q6LK9HjkdSI98XqvRirstPXFi6dIT990t6m8g" +
+	"3z37FPkWxGf7V//7Rmb4i+vWvvTS2nUvdtIbt5I68gbkw1HwJsE7AyP0Av4D7iXJx2O+lIt00rUb0QfqZx9kl01Ycv5lpWff" +
+	"SU8G6avH7YHmyVu2nNmymen7/ubNUyZvCYvOC3v3XPhi3/5ZGD8f9/m+/V98uW/P7JgY/P5dP9x11w934UHSPXy+75gT5BLA" +
+	"iQcBljL4hp79HgQglx48QS4tU6kR0qLFCCkWcKeREwVQZ1SDBqKxaCZaim5G29ABdAI9h84iBD568Dod5lwSYwb8Hrs0qppN" +
+	"grT4QsetgI9+iQM7da1ZAj4z097HlqnNPZWn3bPY7hlbeIF3e9zSYjdYpHVu+dUCyaAFD/XlFSoFlvrxEqT3UDA1aLFG4gX8" +
+	"rqDcfuRkoBSsySBI54fRqJL3RmskcuQbO0+BU778yTaU58n6oKokpUWTUqIKkfUCN8T+8xf4t2Vn37yI3zqzfNupjZvfeXvL" +
+	"pnewf26/vnPn9u03d12ixZyUZLYkRu+PxvKimK5RC6JGz8JMDW/Q6NlYrVoQxXRSajFo3EaTHitcONGjw4pUk8nIK8Jfe9wC" +
+	"bzCZXQqMy0ypCqwzGT08bySVmnRRVGjVcaxeI3LQXxvL6jUGVqWO5fQakeVOi9v3HtoKNxkyjXHdbVaGsdq6x5oyDTfB1kN7" +
+	"t5K51VuqC4eTc8QCX0Py8MLqLdXhjw58/fWB/d9+u2/oypVDh69Y8bHF4bDEOhzhWbF2e6zV4cD93TkanWA0Zetiwt8ac3Qx" +
+	"rMmYrdXwMFKRnmXmBYdGqbSYn9E6NAqlxawQ0q0WXmuyxKYreLNFqdB8K0g3u0IwWyzpZINB0GhzRRMbo0vkRKw2sjG6BNag" +
+	"N7JqTSInIj1ajpBiiTS+UM1VdisXjkHTURNajTag/ehl9ANCEHCapRNd7dQFLD1pVWjnDMwSaVP4sjujpTwQKqXMAu2feYvV" +
+	"EgwF3R43z9HnUpAeo+GCFlztniEKK/CW9s/GK5je1dounA5q7+OyeEOs1WLlM6G17YRoOIXjZI1bqstjdrIcTw+8C7koBIVk" +
+	"VmdpH7yX0xx9QPB9s/H1Nzbc9uYbX+ybPXvf7LvSk5Ki//+tF1TaNFHk1Kp0CImCWpMmirxanUZyeZPRlCrwBrMpFat5g8no" +
+	"UhtMBlf4CyHVbDLwQqrJaMJxvNtgMvC8y2gykEliukoriGKaViXAADFNreZFMU2jFmrmv/z0xfHPvDyfvGLtGh8g9yqndFJ/" +
+	"8ilWmjMtWPHpJ6qiyUpyJBDf1Wp15iUmgkuj7VKgPEW+IH0z80kD+eKUItRVowVXYmKeg3m6fG15fucfyVjY/mPn/PK15T2+" +
+	"3LP3iy/27vnynmUPLV36EJhsOTZbTviEdMPdNNlGg0KjyzaKQviSNttoFHSaLKNRgHxLuiCYrPTXctqcLgjmrDSFYCq0mgQh" +
+	"3WyxCIq0u8wWQUi3SL9kjk4wGrM1WsFozIIvNAqDMVujo79g674jFOpPfpaMPlX9Q6Ed3T/nDbYKd7xbte+VF8beKAKIN459" +
+	"4ZV9Kne8u8Jm4BMNjrQSi9lkXM1v3jl9WRxA3LLpOzfzq40ms6UkzRE5Z3EfQtyb3OnoORhWUbB7RKr5v5tLFoSL57jR7Gju" +
+	"tHCxgOe5F8c3K+TNKgzaixD3ViSeo2NMwcwLiOGdvBNnQiDktfqko6QOcXaev/gJN4YdvaDCRd4v4HQT1IMfMq9f3ZnPKRh4" +
+	"Y3/uNH+xQBAi2dzOdy4ileSxTrmAhZ4r9s++7+S8y/KN75gvpwSrEswCOMHpUUK7/FqGwUyY2khuhWn1ZC1L1rN9YCpZ3zHD" +
+	"68gE2DyYjCXXMINhM5k8GLbBdoQQoDVoN1PFlUtngvogaPSGfG0ZAQqcpbPiMMuxNeQH0Pcm/8LkR6YWDOR7rpyoFPCxrh9h" +
+	"icA0wK9E1Qi/w8+CZKtHdfldhbnSuBuPnCgLBVApHfHAR+eIJeBvfXBFH6xXBHFXBLn+y4UBOMAkNPB+HI/jG3gfXMAJDZz/" +
+	"YzahgQvABSa+LxcAJY7ry/vrGRoS/uL/so7AwxsxTlwAY95QOJnQZ28oUpngw28oUnEBdHpdSGVCE96gv9+8oXTiwm3cdFLX" +
+	"Sy1o0i2DcjS4UzC8a5AZN8ZaeT5mkM+FBwZ9nCqnIC6kx0uffn46UqLVCHHfcGcl+6NE5EMVqAbNR0sQPejJZDZZ7cGQ32nn" +
+	"jRarPVgGvCAJswG308MLVH8qnU3nk7xL0wAn+Ey8YLJYKUE9fuq6WTLUC9ktHJ3emk2cYDUJdncJhAKpuRAI+ixWt+j32S3m" +
+	"aHKZ4AGaWwho2k7Js3DAlwxm4DVUoZHQoo8r4LTMLWpFZ4W/NiseknwY8gOlaT2TsgW/QxyZH2+ZHJ6nGAALWIU4Klkxmvy+" +
+	"79DOXSkNLbfb8b/OD9Hz5NEZWEfcOoaszWIYsddTJ/KYX5Pyl9WmjM0r5vUtN/NK5t0YhjzKiYUxHO7h45SF+H5eCPXwD7Cw" +
+	"5LV4g8XywpTroCZUz3NiY6Itd3lNGpT0KNKkZM3o1a+CSS9Y3X/GpGPrx2bXcfzYeRf/rVHpN9f2j42JidGXpMboFIKex7if" +
+	"QcOqlCYCuXk4dvT2remWHiyUxRuMcfCzN7F88NSKyoK+qTlVZXIftA2BNOei64VICUbB7jEqgbEzwHTGtdX44otcb9yPcBXs" +
+	"nHIicGfJtc33MzzbC64jAXr0OFTLc7dt0tztTaSUUrHSZFCIKcPVtZim0QeXGrg3ybXNTzAMz1bAUh4B5QfuC+4UoitNjDQA" +
+	"+rwWr2TNaDYJjKvNrlELZlN7+0bGYtYLzqDo9zDRT3Skp15AI7Mjn9fKpDzdtbfD0buTp9jf4FXX3wepleX+XFtKGa8faVfF" +
+	"JPsKk23DK04qxjSuFB6uHGZLLvSmqJT2kSJfZkvJ83auZBTgHz9tUrmlvn5mhmvJbPLN2ERRndCli9lc6EkSQxkpqa6EdLXj" +
+	"GrAMaCAfffkvCHTrSb6+xqFOT3A5k7NCYpKn0Gzq2iVBLSbK9N4KydwH7BZUirpFZs28YDH6rIKTFxweP11nk8Z9p0OwmHku" +
+	"lfGH6BKsyeoNGVySJicY8gakhuFmqJwQcHOWMYYuuwxf5seK6YzuhgEWg9aHoZv6W56ZWzJfQd4Jv5fWKUdQja1T54H6qKth" +
+	"UKhzpd8xH15PYrdws/rHpWYlkB+qNEeUQ/tm19T8OKV87C96BsYUKZksq2eQugY+NvG7FZ7RNSZ33/TUVE0x2adQruzJnJDq" +
+	"/UvuA/Zm5KWr27L73GDIRzs1ae7cCULO6Fd7yC5PqH30fEMf9ZHAbQ5v9ITirHoxn1mQYU2Mz469Ey6UFCRYDCbIw+He7oL4" +
+	"bCv+QNAluqGPO/RlaWaCVxPDqHF8claCNzGmJCveq1XhmJKsBF+8RUwpzeqIk9FPM6LnX3plD792q90iyfl8Jph90kfaI1h9" +
+	"ka9O+/YCF/TxJOgE/IE1Oz7kCdcB5BsN1rhQyZd3xmbHJViy5rN5ot4SH3KHN7DOrNIU0RLvS8gqicEqrTc+qyQm0ZuQlRyP" +
+	"1UyMxpuQWYoQRrvRKe5d9mbkQBUIuYzUS4RDsOc5HSafXbA7zPTmdJhNnUC6+aRFVomO9Gan8qI9ZHd7gpR4lHQMN7VzZjJ5" +
+	"Jt8czmB6VOPjKvJUdTUkKF6ort4hFlRVxTUANHRltvYF6PsakC/oHSrYcB39jj9I6gR9XAVdf2TMLl/h+l7VPXtX9+hV3TMJ" +
+	"xo8DLf0xjh8HOhg/rhsuy265j5ZhJULMx9zLyE7LEOKo4wq/Rww6HWbRI/qlW8DvdCSBdDNLq3hma0C+iZSRRWrvbTGLFiud" +
+	"3LmMu/Dz9twA7tKlolvn7l07d+vaJR6GDQENDB0K+uFDQAtDh5bggrQwD7Oq8m0wKmhhPA0NYayB0fX15FnlJw0NRwxlDQ2J" +
+	"QwCGVLOH6e08A53onWxicXgowNCWU/bOZE1GaTXeLrXBXegdqS7sqCtCLm/Anwken3yzewN+p91j9/ozwU7riDcbBYYyEWUh" +
+	"Ky9I/EPZyGexRviGF5iR1dWF+p1VVS8pILGqijylxsequ7PhDFMeeSopq2tqRYEb+hQl4/dpJYTrWOhGK4F8zrxG79vZrvT2" +
+	"Y8/qXj2qe/es7rW+0Odq+SaZqc0uw93GjQcdjBtvhHHjQQvjJoBUhpWXfmc+5p6Wy2A0+bwlEDLLN9Hk8wZE6eYRaR25nZzH" +
+	"RT2S8IJooko0gerSApKw6PYE5NuuLl27de7avXO3ii5dcCDXHi5KwBfTCnDJ0KGghSHD9bRONDBkGOD7GhpKTEcaGj5Skucb" +
+	"GmCUJgwNDYzHGoSR9ryq9OrSDLKmi63lFK0E3MKSLbQSoAifp/e72Sp6QygJTUeIZ7kzqDdaim5EG9FedB96BD2F3kUfos/Q" +
+	"l+gb9E/0b/QrakaXgAEBVBAPbsiAHPBCEIqgFCGwyeptq9kk+IKBYCfwmiXjDw8vmK3SZIqei5ULdJDgzXwmOAJ0hLCGAi7B" +
+	"4QlIh6F4UyBAjxoNhoJgsvoCbpqOVbCaBTpfS6J7FOnkzBMKeEKWJBCswRKQjhqxCTwnDUuZ4PD4JcZwUAnF6Av6rMGAO+B2" +
+	"0mmpi3fyFBmrNHtzU/sbigON6QkwFp+ktwtJYgoVcSxmU8hC0eDM3jJwCJzZGnCZQwF695lDHkG6ZL8HgtkasEp3X4DzCGaL" +
+	"WZaN3AHeRY9RtXrLwO0UXIJVAvVI4KFAKHIxVWBYERjMWbh1aTahsCy2oO7T0Ntljd2KuJzSwxXbd3Z+NCdN7auoq3idfD+y" +
+	"VChnnMmZwXlW1YiU74aSx0uFxBiVWlQlimtKB9fNPJZkTVYnWhO58pdnjXWQN4YZoFc69vbhK22w+0He0dip5BTXYhmSWMYb" +
+	"4nI/bVml0+Rm9Cs8O/hBC/ZochxTy1Kzf0h/8lk3xNvjKsZ7D6v4dJPWYOUUMEKLrWozeUQPgpX8ZHkoFpeZ8Pgkg2WDZbyl" +
+	"WxLJHseXchqx7KZk8QB+Uv9qjkFVwCU2hj9l4i2WsdYH4zRWg5U789Tkvt1mXjOMD+SUlhQ2BRyx2cLkdMMYjSHLXTp37tDR" +
+	"o0fH9Z/eNTtToxlj2CgUaBK6TJ9eyvcfOu3MFyOTyLy5c+qmF3iNWWsrDqqHJRdkxWrih3VJrT65w5BpUjnyj8TWD858e2zG" +
+	"j53e1HhVmu3b+8f02bydwXXEsJxTxPR0lynHTTd+M80Vp+IM12qGxTV0TtUIu7zqwgnZgal5Cu+d5LoXtE/EqDtPcB/paRk4" +
+	"rG5ohXG7a/WQsrIhvft31WRxWVx+TK7Oy+XnK3L5EtyJD4VCoRIf59XmFwj5+Qs5zliYVzC+NsmVPGpIxfgsXfr4MgP3aj4f" +
+	"6unl8+35gjfWy3tZL+eL8Qr5bXLbGUkXLstdIJisLipWOzmHh05pQkjSTNDXgD/kY04vG5s7LXwkpj9jqVQMa/n11pGxi3Cu" +
+	"IWZATFjpixmg4c7cXNN7zajw7tKB8W+NZAY+fyRQELtmMg4lJFjqbK/WJZerEUKIb9XFiygV5aFiVIkG0BZttJj1HJ8JNsZd" +
+	"AnoIcm6njeHNdH+OzRgsoVs8qIrOSKdRISMPPD2+VO8Khoy8y2KVTpw2WiKFkVqmW16Ay4GB319DPjvyRBI59O1AyDsAFzZt" +
+	"Iv86NaN590vge7uOvM6Sf8VtXvJopleRnf7EEhJuJMsB68XHeNytkSFLITHZ89gMMqenz1LiShd6+AydndwZcvO975Kj4dtg" +
+	"xZHjidAAeOXhoeTssafJs+fWwKBN+3Uw8FO2ucdAmJaSRNYPYNJ6Duf0YPtAoybr2VtuPdopxxsHk3uTN+pcOd2DKfUuN9ez" +
+	"IJHSR7Y3O4N0KAmlo1o0Gd0snTMT8NOhleoy3QE/I1mOU8HAS21mTHQ8kpShFpAF44jQbJEOApA0TNQ4h4qO9HAAkAVsn8Vq" +
+	"EemJE3QnWsDviUiY0tILtaFzewwhLciLpTSqQKe2TgfPvFrSGew2PKVGqS6sEOpDycbaCfy8LhbSv76M0Q4bg93MyFGb0rM3" +
+	"1ENoUkP/abN69fDu82fGlaYkJcYXxXoL1pX2IC+tb9CVMsMn36t6bOy+OWVQuKdXnqX5d3bKqDv12IN1v+9Odgw+DMW4aOwm" +
+	"NcPO2g23pt3YWa3WckbD8GdcsXFarHGymZbELlzITr6uvcXmduS80luv48PHG/jUQ3hAt9zqtM4jh47pVZcRa/VUdh05cGYJ" +
+	"t7vrWb9/yrSYrsvJpZidD8TcliYIlXHVKTv6D4xf21IZG7fxjrl41A2gnT7+fk+/ii2e+qRiZUzq6tuQFQ261MJey72FBJSE" +
+	"nCgTlaFGNAWtQHeie9Bx9CB6FD2FXkJvotPoffQJ+hx9h34CJ0KUeFS+tzqkSgl55DmOVIEBP/CC0x1iLFQY8biNkladjkbU" +
+	"GspsYqnFptkTiJhHmU2s2STXlp8xewKp0c9WnrXK1Si0gUaz8Jg9RgocCIZ8XqsgjVhup6MVwhMEUzL43P6QQwscXW7wtPsR" +
+	"Qm6PyWwS6EjGC9ZgwM9ZgyFeaP1JoZzHS4sUAd5cBhK4lSLtdAgOT5AuYvkCbkm/Lq2sSRp5Id+Ubw6GWCvvdNOjb9kYlUXZ" +
+	"nReK862m+pG2UIz6IRUsKVv7KznSmKiBmqGxaiWXciYmNpnZyRalp4XAirXW8V1bWDwrt3YnrBmbVQo9upIHMXtNt+GPgBKH" +
+	"MHv9sNvO/4uDsZ273AafYmZnsv/2Q127diUH1A8pQjmjGsxmXydBcOEbeH5n4ZjvsIrcrk8StVp9sh5unuCJ6796vMPdOKOH" +
+	"Lis0Jw9CzqHGYCg7N+gJD41LG1+f5naMH2TI0vWo1hbkdC0KmZxDg7nZr3dNL7e5f6jrBcWmOgwMNphMLrfLbDIwuLcZOvWq" +
+	"+8FtL2FtClYZYyrDsZw1rhSzi0ptotKUkdcy21Hcw8nsVyk4pbIluyC+ID05mB+r4c3m9OA1BfcM0gzJ7FFhMyrjPRmlVTla" +
+	"lZjEcVbYepPmBveogMUk6JIw9nft182W4In37LWPKExWC/astJq+3cw6VXKGPWmVGrNLFIvMMxazJbFWLhbnZqZfM9ik1yX6" +
+	"U/A1Bpto7+IQbcXlee5C8+gyR2Pm0u7GaTW3VSsWjxR1K8tU6kWjbiiMGztEU+fOKxcnOcpGZ9xg7L602w0KvmrtgJtVZSt1" +
+	"4thFbFzhDRrNkLtf55/2q/cN4YPJEAs4xmMxeywWj9niicEAluQgP2SfRpojI8S+wJ1FCpQmndBGLUeDgYi5Ke2s6N7RTCxS" +
+	"TVJApDo20eJjbHjKFBDGTTs2ScWceICZe9ueHVvu4DYMJC8N3MzfsWXnnezHxzA+tnbGgF3kx7EP/Rvjf2sfHDCa/Bok6hNH" +
+	"jpyAn4KglGzut14KsybuLGKQDWUhRH0WeQI+2bETZ7aLVrOHcYqegFVygugMGH3UMUrAScPh5wf2bZsxQwGTyU3LFsDu+fPJ" +
+	"bTBl4obyH38s/+EHrry42HfNNXcOgHvDF27csYp8D49et305zDg64M6WN+Bk99Gju0f0BAhxaqEKCcgmeZHzBvVu6nslekZ3" +
+	"yKcEJyM6RenITfzeoxNmzpxAjkLdI0888cijzz5P1sGK597b+PlzcCPZ9Dx/IHxmHSjWtbDsrk/OnP2YvPYd6ULSgCcXwUq+" +
+	"gn/ACXn9nenLvSedZkbVmFaz4DRLluCS1bfdEwjZA1Rl6QvAwW3cRpbdCALHkd+5bTxp4Thg13Pceu49bju/gZ/Bb+FalnMc" +
+	"s5jbws/gN/CyPnfrpTD3IXcaZSIkGZtTd9oh2aycOm2Uzc5D1HEjzZJqS+2i0xPAc2ANz6/DmB/OccP5MFnH82Qux20Nh7nx" +
+	"HDeew8xWjjvNX8uH37qD50lPnr89/CI/kecn8rjgdp6HB3n+DpzLXyvNxRH3LncGJUi16/NanR5JwOAsvpBZEsNtjJtKFDTM" +
+	"ZxYsZo/gDIQ8AR9TbnjhMe498sqj/cC/8KYv1zX1zIsjJ969FoZvx2UMV/Do5qJdW5ji7U9yZ9YHycu3P0zWv9vdy0+KV0Hp" +
+	"k1/Boe8HEt9LzOKTYlMT89JiBiGEWKmuP+LOIgvqjUZJvlfoEE2vgM9K7UkdHmliQSebXjPjSxX9noBFNNP1capMlqY1gocO" +
+	"FjSOpAzTl4GZFinABEJej7Q6ZrKaJbUM3drsd1nkiRMv+EOSTIV3xRVmJDk8xUYWlyakpSSn9dTtxtkpghKDMPqFl7pl9Nho" +
+	"ndB5tqm0ZLl3YXBKbd1026D7kyaAQs+kfNGv07wVeG1fx49TbLjTtXNu31iXmF9PfuLOVuflVQhpXKcENitrrOP6chXXYjsP" +
+	"059+g3mPfJNKfvyoi4VL4+yjRydyGUz5zKpjxwC+SOGePEcO/IwZt+WuDL7b6Ff/+dW7uOqrSeNA/Voli5OnP3gm99QDfXih" +
+	"/t4nMEz5SbbrYFdxZ9FgdA2aKsmyuohoY3WavbLg47OIekYa7GiAmXfKApKZDlHU2DlYAvIU0UNViXQXuOgP+aQh1WINOKWJ" +
+	"Yy4EGZPVF5QGQF5a0QsG/PiW4p6iZmaO9yGYPGXp/BlG7u3rJnf3Tk5Qu2MUaXygb49hY3qsEXycS3N9etX4G1/jjDOeKpgy" +
+	"6cF3Ldrah+ezZfF1OalQndJpGXe2WteUU9en3wujquct2/n80ZnGfYSQT8lLR7ITb3NnYKYTdnyfv4lhK2d0Kp5ds37oE85O" +
+	"5XsdmfeAHWq+2m+cf6R5T65hXvWQVx8f12ToEb60vmrCjQ2L46rK7pw1+oZrSg5uaWdr8x7ytsr9VsEpsZmZoUonupHC5w15" +
+	"qMxhZM2CxRqIznodQpBZefrR66cfWYwPbHhm29KvmQdZK4TMwthbDl//zEjuLsXb6NLMN+KLzzVNsg+YvJV777Ht7720ZsOK" +
+	"Q1MHMCT/ZiEOtiT1G//8rNlqwFpu171aPKgplYztFroFIcS1zgtUyIAsKB7Z6b5QyU5H+jWarMDwyGixJkAwpKR2e8gjboU1" +
+	"YIVYuIFcSy6Qr8jcG6bAEnyUPL/j9nug8aFt5AjcMpY8+xj+GkLUhIm8RF4mveB+CH4+D36Gp1myJzzqGI/Hhzcp9sIDZPP0" +
+	"n3+HoSzp0tZGKU4GlIzSkB8hMMuny0koMdJxfu0NGTi/O2r30g51pkh5bPnK+8lNlxBpJncaLLuuW3YcdoQc7mDQnRoI3xio" +
+	"8vurAsyyaCGihcK/xa996o1tYIEtZCF56fDxJ29PLypKzyguzqBXelGxJ1ocuXjo0qUIzqcNbt0ohPQe+ouv0wv0zmki4R9x" +
+	"p9qFA16lF3Sj6dhzlfijEcKrLot/tfDRV4SPbs3/6vE7hv9R/NF/Eb9D/igGzb/0NdfM/QOpUTmqRL1QPRqAhqExaCKajhA9" +
+	"w5Ta10juHji3P+QLhjzBkDUYCgS9VrOF6msEi9VnMVHVPBWoecFMF+zcHsHtCbk9EAwxvCCLyBAMeXjBJT+7PYLk1MMaDHG8" +
+	"YAyGXNIXfDAro7i4b2ZGp+KM8BNxcWNjR/TSDqyadq+zMnVvU/fB+prhcWPj4sYarqnRD+6+aE+qs3JPU8/++p5DY+HFZJtq" +
+	"6QAY5IrfO2gxeUswPVhhxndbuF/FnuF7DMqfTRV4qmB6qEpHdiTbVYsHkX3xzv2DljDulbm5w1bm5eU946n1PNhUN1xfd03y" +
+	"9MTE6ZZpfXQj+sx/2FPrObqiboiqz7gk+tk6pY9qSN38RyDLGAMaS82hGOPztWZS6o6/e8TyULIjZskoUp2cId7U+JPbcXjc" +
+	"whsMKvJDcs0+penpmmS57V6ayH/FT5X2NttRNipBCKQGIbUNF92+KxtmcO1bdPvnYChoi7hLsdFzZbv0DPirqvyBnlhRNb66" +
+	"2h/o2dIbzkJvqJOc1hwlx4hnPtwGiZAEt5Jp5DNynkw5bcvZC2/uzbaPi3cuJRuWOuOZcKc+febWNxR16tOnU1FDw1mYg3Xh" +
+	"H8mN5KbwT1gNs8mzpCs8BsVQAk+S8rCrwHfihK+gR5Zn7c1pmf+jslHDMVvEA4zN4/4/lo2WCSYvdcaPs2fvJXl7c/7Tsh3q" +
+	"nulZu9aT1UMqo9y/dShbvrQvnTYP2UArYqzl4KFdaVyRwnjkwnjal6ZnwF9dPb7qytJA7VVqpqihfq6MOS0BOd4R/cFtNbHW" +
+	"k4liWvviK/c4Dvif7nKUd7BR4YF2EkxCJcdV8hjRTY89zhcw96fBb083qI52ztwz2H6oLLwOvBxHXudYDkI8T17iyxlp0+M/" +
+	"mcAnQfhcim2bYymdnpQ1X1G40smd4ckdPA/Dd5Qyl6IbIM+NeGxM7MHyIfePpxsg6/jof/Ll8vlMWZjNLGZIKXttJCZu7L6w" +
+	"LGum2HV5523SvAHfJvXp7yEryozq0kASaahGR6RbpKn228yKek4wy4vsVMnmdjIuGP3zcLLjjl1ZGT1/n3EjObWw4bpU2E7e" +
+	"8nC1a9+uiicvrL+Fe49cf8/zZB65NErH9z72Mqhh3ad1ekalLWWeIyYy9UIs27Dlp6FDlVAC3DXyPIY5x52R8bFLcjVQlbuV" +
+	"iqQi3b8sacFTRb0rFKCHkgt8EujB4mP6kB0/D4cxd+zOSu/x24wbIXtB3+tSyRjI9XC1t7xZHQehDWth/j3Pw2qA0Tqh97FX" +
+	"yM9k+j9669kYig18B7deiGUaNv08ZJiSPE1arpHmVTsv5TGvcx+gfOmUyajXHHPILJidHmfA54muILup2lCy4JJsaKRnulJI" +
+	"HRjR5qD3uBlDqm3etSOXPfP8bDhxAlaNuvZae2qq/dol9TUTHBN61S+dZ/unY0JN/b45M/fV92Ib41NXPPZo0xjtW3gMvPzK" +
+	"qLc0Y5sefWxFanyca8WjipjlgxoEoWHQ8hjFoytcw/m+A0nLoUPADuwr400usXdx7yMVcna0RaUqOuTzIjoZoMfyUhf6AZ/o" +
+	"xns2vPHGhlvffpvgLZDz3inI37Lgzp9/vvPnn/dwnvN795w5s2fv+Zbvdn7/T+hF7ieOHYsN5LcmahDaBEoxQqvP2Lv4x+U8" +
+	"Xe2ODKU2qZL5K/Xd5JSOv6E5W1rzBLKFvP3eKfLGlgV3/vQTzXNTNEvGuPP7f5L7oRd8vGOxARRN1Oi0ifwqyvPeWy99xjVz" +
+	"zyAlSpbKKdPabeRpdshqsYI9ZBcFkaKAN9/25pu33frOO+c2f/gOeR2Cb7w2FrqR44vhsWXk8bHMpvPkoTNnoMf5lh/3Qgl8" +
+	"CP2UinEwZC0x3QJzyN71T12geQLkI8R1keaDCAKRPi8JnBEzW4EWj05T8RNTt26ZNmXb9Rf2H7ww6rXVq1uuxUa4+1933z0f" +
+	"PwwsaXn44ELmuYtp0LRZTvc2hNhPuTPSfNoZ8MkqBDEopSplEKWo6IR/jxix+o47xtyw+73F1y++cczoG1ezN2++//7NZNEU" +
+	"Qk4O+nw+Xvl9j4V3HVpAKiU6fYIQt4E7g2JQfgeLcY/FKg9HlIel4xB89Eik6MFBZic94ki0M5PHdu/u9Xb3hzIrbhg1Yk23" +
+	"jAISJt/edBOYgFkNDBhuuIF8T8JwU+9QYW1tYehpQ16vM1u3numVL8JNpHozfmcLqYIH6LUlnL1Z0h98jRC3hjuDihFyyc63" +
+	"A1QZHxA8dB7voaWXvIBF3kW5WbULpqpAs8/MLFm9a9fGqdfDMlh+w/LlGMOwyqErYOXq5csHdxse+Qpw/dQNO0+ePLn5RsuC" +
+	"Te/1XXvT1q03re2LN4/vbcIv33zjtm033hwOmWonboKBcthA9p1N86030srB8AhC7MOSPJ+EENhtVmpRwjvtojwmUKM22jlS" +
+	"8RCehumgH5R0LpBPXsWdBCFcJSwH95xynbDe3pM782bNcPLLEDF8j2K5QsG9sIKkLo+v21usl+ZZDQjxNu59lIqQnZqM0zHF" +
+	"Kc0RbEaLNRgySBodwSmzAhIZIGTL7JRe8xvWr4TecxQsK1wLtSvX953XK2XOZnIJD2C45xxLl668Y+XJlub8tLR8hju58o6V" +
+	"S5c5nm1pDh+kvLFXsis8gxQoJ9KGbEE91Z6VYp/ZgaM7sWkLjnZ12BnwJoPVwhSufPhhUD7y8HVzu9X0v/XAtE1zjeYM90ry" +
+	"y+MnyS+rXJkW4+xtU/YfLK/pxgx5g7z/+uvgfmMTV9Ll/iOTyYuTjbrk9I1vv0XOguettzdmJGuNk6Fg8pH7u5RQvA4jxD3P" +
+	"vY+MUktzewKszWXmBdFiNafauECwDII+q8g7PfDR5q1QgA9DYNfGjbvIK+G+5MWtm28YP/Gt8RPeZlss/g2DBm3OjW0+Z83d" +
+	"MnjwhoBZrdGwgkaDWPQGKuf2cdOQAiUhN+XENkP6LPAELKzP64lM0fTUeJc2dwoBarCa3alOR7tNFqXAnrx77tzGvvPIavIl" +
+	"eXjNeegemCPMfXjldQ8P7fJR+It+8+bdNX8eAMRBjxs+I4/45wlzH1p13cPDOn+EH1r5+KpVjxM1Tsts6muJn3zgtjfeIO+P" +
+	"q+mFH1j5+MqVj6/E83B65sK+5vjJ+2mvuX5cTQ3CAAhxs7gzyIhqoms99BxwyQJKWrAxRpwEIbPFStdxJFRRa9dsjVi0yPbx" +
+	"rbZQ3oDfjesrstTx1zhttq5Z6oQxTlvmS3due5a0MPPLBxXNHDNwOTAbrp15fbrVrkjKGe/1BbdnmuJyJuR7K7oK3eCmpBke" +
+	"p7Paq02e5nGm9srHP9zx0kdHT5KY+5uYAT2unT/tBfjH09ft276s+NbSUUvF/hWzsi3V84IFxcUzc8zpmviH5P59FLmFN3Bn" +
+	"UJF0gr7XYpZmzkmS/b1F1INk5scLgWDII/oDeo8WBNEp6t2Z2MHTJ7/HZ7GWAohBIz1S38HjMYWTUo9XhKoy55YEB5G7YOXm" +
+	"jC6bdx6epkj3zytcnZgC4LpDvO6GpRNt5n140QVLehyTsIw78/zSa9848MHLi669QP5JxpMnhj2VfsdYYXZi/7NMf4MIcQBn" +
+	"X2wZyWyDzhPPvdcym+38+BO9jw59tGV9+M2LUP/Uja9H9bSTuA+4QsTQMdJoN9vBHrC3btEQ6CnMTnktTXaIYN3GlV98En4m" +
+	"KigtvrD/wFclMDT8Deh1tVs/3FqjFYHZArdsGb/iYWBI+OEV47ds0Yj95i84dGjB/H6iRra1K71UzBdwZ1CBJKVbfQGfVfAE" +
+	"ZMO4P6GZr43aRnbp0Lo65sEHmboHS9IE41VIVRohK4Bu2iKYe8+97Nx5VXMZy5/QJkLS8EoEaAv1O8GdQSaEqONTQQm5WAll" +
+	"WEnpI3IN4VWQNJJ8RpbDcIDF5ByMAEjCC0gI34fPQEu4jDwzGUrIAJgIcBBKYCKQZ/BTW8Jt/cgZlC7NU7yGUOsR+bTozkAo" +
+	"aHTTLTNmk1nwY0/AazEGQ6UAskW9A5MRPa2j7p2yZtnSG6emzWjavGbqsKwiv33NLYuvXXlranq6ayr4+865VcHfcgN3puV2" +
+	"9qPHx91696rd77FzgYV5esZMsp/RaXEYfuBHfky+e2nA4E0t7luf22lEGN0Ohdy77J3Ig/ogZKTbgyIWjV6KpVGg2gZeWnIT" +
+	"IktvvNMjSnBUGo/cWiGd7UAp5O3gGpeuNo/d7s03x15T63ZNH9WvoDEnWDPWEh/Ir671eq3m/PwLXB6kjk/TmH35tbWjzbFe" +
+	"f40ndYYMWZvvs8YF8nvV+PKt5tFfcnmwE3Rp3dd27949lTzrezjQq7q2a/celadHwVnQeCore/SorHSS57wPB2t61VZ079Hj" +
+	"9EgEYEOIa+DOoESEQMCMgIz2gB2D1WD0GYwhxNgFuwE8mGH2n026uAFMseQXiIkj3268mPR+YvNG8k0sxAD5OR7Mm5oT8VaG" +
+	"HCFPMDAcQochCCMY8iS5BxqgnCG7ycuHyUvkDgY6Qz2S5uH3I8Q9Ka2LU2/mfdEYhEAaxRySnSi19qNWcrTzlPpOOt7Jx/1F" +
+	"Fx59XqtskxholcvMtOtMgqCvdVFSlFZCvdFktICbAdtyuty0qrpnV5MJAkvAbzF27Vm9sntll9wUDGQyviuscTtKR44+PqxH" +
+	"hS1JrR05cvjIkVp1or1b96HHx4wqcbqIxppusqRZLGkWU7qVeYFhMhPT+2ys67M+TatQaNPW96nb2Cc9MZNhwg+RtyCXLIMl" +
+	"Gkvw2UmTn5uYglPLDAIbrOzfvzLICoayVJwy8bnJk54NWjRkGbsoLjMuLjMuNttqzUZIgV5BiLuLO4N6oFpUj8ajJrQO7UMn" +
+	"pbFXIkIymHnByDjavSV0CEsAd6DtjTG2e3NKjowCgt2ThO0BsDJtBKe1QCkXinyNfIx8CxktPm/bR1pd4GO8HWJLHyOAl33N" +
+	"alQl5MXH5ccx/vdyqvXxeQnxufGQcchTjBPyYuPzEphix/70+PzYhPz4mNr6Lz3xeXEJefHqgetafsen9pCH9+K14V7d3uQF" +
+	"vcGQYhBTjAabyCvAuuAgx+lE0aanX20GXoDl4xs5VrQbjDZRbzMY9AJPHh+UKWr1RlOKqHeIokGtBdWwjTExYorBkCIabKKo" +
+	"ZxmmGQ9P9CUm+hLLLYTHjw9N9CYmehOzHWET40/OS/THx/sTg7EtL+PzuRkJFDChnz5chRlHZqIvIcGXOIzkh9/CueSTf1SX" +
+	"vJO3dy/0zcBaho1xGk1Ok8lpMjpNEP7Nhn/HTIzTnWo0prrpF2MCfgtM0Q8xHA7HMF1iYiR4E40bo8At72fA14zZYTJSKKPJ" +
+	"ocKYtinwXiJcZ+5dlITsyIOypXUB+WAdp1kQrWbpwCC6MOf00DZj9knn54UCdrNVFMz2gCcghuwifuTitn+Pb2gY3/yvcdTU" +
+	"cdz3W3G/lkb8S1U4s2dn/FxXCD/Ovbt9+53rNoUf3HjLnj23bMHdNq+7c/vF5lCI+XrF8OErwnNnL10624/tOPwRYmENQuw3" +
+	"3BkUQl1RHzSM8m5UZpVUGvJjK1tG3AxZLZJ+QYjO7+kG6EDrGq3ZGKRMJfGUlbEjUzJEGZLHKxMTFTFBuwvA5amMS7DqTGmV" +
+	"bldlulFnjYuv9LgB3HZ/jJCYhDFp2f3Tj9u3T9y+fX0wvVt1ZVrBC7gnmRuf58+PT8iPi8+FDYHC4oCodZf1qGPrelZnaGMM" +
+	"SZ70zOqMjOrMdE+SUanNqO5Zx9b1KHXrxEBxUZBhyRzYALVNk5uaJjeR49k9M2vT02szy4PkMBdXFEhMDBQFEhKkNQnIRYir" +
+	"lMZBundlGu0FA/6oZSEyUjEElUg7HjwR449gSDYNsjLBTtQURVIMMVaT4HSUQNDollw5yUSkXrSsIbo3IyRJmyarC4M3IE1U" +
+	"omD4ddZUNlWAh9NaNqthdB9vxlpF2qLE7jizs1FIHpZ3q72R95QaBZbjOyvjXE+ndLPGxwQ8TBfL0P4p8+OH94whd8UwJifp" +
+	"CmyiqjJBr2AvKFTmTG3+sPHj4hPIYy3TCop2Q1o5P4tZa7Ak59T9/mRy4pQFNiOXMDVhHvmmLMeZn6wj051+XbI1Hx8Y4S51" +
+	"JdgNet3I0VlZzz3ZK7ZHVcw69p7k5Hnk20VBPzAhaIKYTHXvqqp0o90o6iQ6ipcucaO495AGJaE6NBghFOn4ZZYwXjYMgNke" +
+	"oJZXhijvRZgwwoMyC7bxokHaACRJ8bQq8Hz/4Hz/YK9viDdvcKMuTqOL02rjtep4HU5p6VoVs2GlIzXB6O5VGRtv1Zo8la7U" +
+	"7mmU9xK61rpMCQ7Hyk2qBqao9KNZsz8sKWKZp6aPKSgYM31cMAhafYJOl6DXJer1iRdPAkBh0W5w1Zp1Se7q+obNGdoYY5Kn" +
+	"b01GRk1fT5JJqc3Y3FBf7U7Sm2rJB7uLClcumjF/xYr5MxZJ+6OuHGPHo/lozf9PIy0lccjZLg1qxOSj/NsulvP/wXgMC8In" +
+	"Hm7R60LFpZMKC0KueHwWx7tCoU6TSjqFRH3z/7PxuuWBF198XVDb53ftsrCr3ZrKYMykWu1dF3bpOt+uFqR9f23jeS9U9z8c" +
+	"xbnI8cb/5dganonXhmf+r0a/V2prybu9e9P2GR2bGCQgF0qXT4ikl/EvRyh2UPhJvCLc6dxVxyV25bBhLbnTpnHOPxiS5HOj" +
+	"o2OQH9FjDv/T0cdoN7rbDz54/N8cYC627P7JAoI0wGD8n40jZCpsCECcPIxQGmYgxFVxZ5APlUnyYOtYIaHtMzkj4wW1a8ik" +
+	"lqPOUOuYER1VrJK1iTfgDzkdAkP3qgQDHgdYkdGH8Qv2hEAvbQoDcC6djgaTodMEZZ+hDJM5Ylxsb5w5kEkzDPVtZQRjmZvr" +
+	"bx8Xp+wsFMCOXOjDd0tnv7B64gotOcl9Bmpy1UAOyx1/wgyFcGD9+LrEhs8fT4ifMj5GmRjvdHVJWHBJn5zvyCsn6bFJuoAD" +
+	"lw8k/w6zTD2C1r48ByHuP+ujIfHv9L3/Yacq6YEdCHF9uFOS5t5iNLQ5BnKa9VZnICh6nK3+eVAZMMX9nj7NO9fnFd7Yz131" +
+	"VA3jyOw1864KxUFI6IO5UwfIt+Trwn51aQdGWD5Sk8838ZshiRmw6q2eOQ++Asv3MZJeYj8C7nXuLRQrW3gYLWZvSHIgkQR6" +
+	"AQlOWYxizCYr+EC27HA6GN7s9MsTTvj3s+PjePWYU49OdbxAJga58IeT7+/x+qNwN4PZYYfK/d/XKPnywYd2jnBwb5Hv6mIr" +
+	"IfBObZ2GkC/DZ7vg744/8HZTNrlF/8H58KP45B2b3YenJ5LO7vjatz49VokwBBDiSrhTSIE0CCnBjAS6kqFkmSLMkLhEDelR" +
+	"hoEE4HV4nazmToXjhXAnhwovbdTgTeFx4TF4B6YLBOg1hLgD3CkUku1YrCYd+KT1JVmMcTCUxmanJygGnDi6AEjDJd20bBje" +
+	"6anBKmZykOnSS3y3v+nR1PL3BzFVJ/kaZtBHdw+xftwpR1HTHVfHB58q50595i+Ys7s48eUuxa+NV/NgUJK7pvD8UqhhyDcv" +
+	"VRR91aBOZLp3m9pH+9J4I0IM2BFwDdxp5EDFiB6+bgG59qnkFa1+p/zqDoQoA4R44M0Wq2Td7vPKd6mCMONzexwC02kGDKzd" +
+	"k5JpWj7QVfVUjdvba7hpXU8LfgS4ftPImz2Vq0wu7aTGfUJC9VBxYSflg28sLVRreC13+h8keON1zFu1+vc15MJ2fjM4x845" +
+	"0qVMw7x4Csb1fIFMW77qZED5fuWGA2nJKUffgJTm6dZB9ZLOaQ9C3NvcKWSN0NkXOXaCNpwIM8GPD453Y2yrHzX/0ZlJj5JU" +
+	"jvCb76vmTn0zNP/OSZM/beh58bPwKnzstfffacqm7aKNB5ASLGZwgz/EFMFzpeGwighjuFPhWf004QQF/ki7ho5/tK4Pch8i" +
+	"LxqBxsl4UOu4v1Xd0rpnkDGlgJmqDXUgLe0agyGfRVa5lIA/l4owsuW71AiMJoFuBmLKHhhmHWdKDLk+adA+ltzl7BCm6lGu" +
+	"Fx708T1DrJ8U5zhCiQPjnyk/s0U1cqLigxC/MNj9sWt0RsFe3Wft5pqYz/K5SSNmJ1kP5rn2V38XF4zjPny9snDlfiv/XkHR" +
+	"O2PUApiU5IDERL1ZcuGVbkXf1Kv56cfcr0wwkvu2v6pmlKe6bhjqBk9C53nXrXo8J5P/OI9RMjdMrcl6oL/+xebXE0cwoy2I" +
+	"RU9fItxx7hTSSyv4VH/cfud0XsAfdMkfjK1bqbn2EAF/0CgJV6wtVeoyfbzAmyysLZWayXhwSL0CP8SrEtZnajeCTb0CP8yp" +
+	"49dnaTZCSCW9xN2WpdmYtvnu/eRt8hB558ChLXhu15pNoId00G+qrZjLFZZtH718/kv7Z/sGaWeu/m7Ed5ufO0lebz5ee6NC" +
+	"eeytwb2fXXTHtvCbt3aZqb31HpKcf2zkiKOjRh0dMfJYfnq3moNb+vXbcrCmWzrdt3LpFzwz4nvKZ6W762kHKnn59Yk4o7Nw" +
+	"LNP2eDzzeNKgOpY7rbgY3pPNrkpZHsuVs6eQHJ8h3Glp9YduyZcYyCkNeG6PSCdEdK+L1yrixFpF7I4zU9mE0GsNlpd97z9h" +
+	"6l/HnRY8wbLKNetH2PL7VnbPiG9+d2v2wRXWcvZdBFB/KZdzcKelFUzOKsqzKwk10SVjGn3FR/FU5p68J3c5a3sS4+pi6zNb" +
+	"PXU9uNMvsxe/256xl11sYYpY1V3NH9xQoNguLHCwXdl3ZL3zXZd+4V7hTksnCyKgmVBPO7Tw1FGWhL3XKlrN9gD3AvPNW/q3" +
+	"RnVnWpqF99kLpwf17s1w3Gm22XDavHeNthPeGB70RuqxRfpy9h9v7Nghp3/npYv4be5s5GwKn1X0BX0WI+Y8olFMFf0Bv8dx" +
+	"MJ1ltq541RAf/p7xwvzrJnFn+Zay7xhyifQfwHDvNp8iO0dDvka9QRHda47gN+6s5MtdStMVMdVwikbR5xKdu1w8ZGE2lSVv" +
+	"4VwAwNxZjuTegZtHcxx8vptdE95KFq2DJQij7Qjhc9xZlCbb8FrFEJW5RGq/K6cp/QoBf0h0CoEQtSQWXSKILhFXZrCTGGbS" +
+	"VJjCZrAkzLnZ2bPxzNmsm6M6d2Bopm8W45uFYnJj6Hq2K3mPZUG8iexnWehcvJYvhoUFN3aGFJYlF6+DmvAmcv2NMFveS8W+" +
+	"yJ1FPRACZ57TIVCL3MhuGp+lE0SfvLTTMfNJ4Is8UdNcuvlPsmk3000gDsluJRjAM7Ywd/TwmWMGDsSJ/qo9m+fiBH/a4nR/" +
+	"PDN3/Z5qbzIeODDG4u1xB3vbXCben744zZ+A5zLiIGen/FBldlESs+LeEw0Fdjx6NLYXNJy4dwWTVJRdGcrv5BxE6k80FNpo" +
+	"iK2w4QTi0VxqN8edRTzSIBNKQJ3o2VVABSRp82FQNm7jBauPcVKT/BDjk/xB03Mi6eYRSmq6zEiPc6Sm+jqgAq8oW8dDoikr" +
+	"09wP39nPlJlpIofTMtLSStIgrTgtLT1deO1R5ZQpykdfmzli2LChM157VDl1qvLR12YMHT50xMzR48fnjx/P3J+dk5XdNQdy" +
+	"uuZk5WTDVGturnV4S/1wese/Lp4wcfz4xaT2TdX8+ao34fji8RMmTFj87luqBQtUb83alUOWwtKcXZQP1ZE9b4eRCTlRDgqi" +
+	"rqgGjUfXopvQZrQf3YsQ2L0pEPJ5zYyd1lgAU0lBkIY7LDhTPQFDKGg1GwWzLyS4cwGsRsksFixGq7znzM0ErEbqU9KfC7xL" +
+	"iig4PVgH1B6JbmvzlwE1pLeardSsxGyxGn3WgIf2RHbq4cTMM3R/uuDEJeChXlZk4ZATrL6AX/IeQt1dcLxgNqWAT8TvwRh/" +
+	"fLcBCnYHeYHVV92lTb/GPMabcLsOkx/Ztbq0X08YTzTGXaM1hMedWReqjKtOY8r017E9TPYVqjvzb5qXZ8eZja+FhxkUE3JY" +
+	"btCdGoblGdN2Qy8DDGJn6ZnxcNP9OK4Rl7Ic1LrsmOWOWVLu5YTtVQqO/DQ+i+uNE/v144Q3TRnDyAYh/K8Q1yfh39zh7tcV" +
+	"COQUx4kZHFSaLCynWZofQ54XDyswxCtyrJjs1DH3aJ7TcOStNWBddw35WST/TDfVdDWooITTka/HxRd2u3PZpPd6a7hC8ord" +
+	"nMYlQHZ8ipC4Ir0Tx7k5DVY8HxNPYKiCOUxOcbPtHDaTY0nzOXBomPsEso+7zq3xNqkPH1IkjroPTIqkkakx/TJ5MMt2vTch" +
+	"G3eBO4tikBMVyGOmKNBZpM9spxOIMvCEjB4hagpmN9sdHreLF5xmT8jsk2UFJmS0mE1gSYEQ4wnJ8rPAeKglI75p24EHZm4j" +
+	"1SQtzVldcMoSGiiYU/pqtTCF1FT40tlwzc7Njz12n98iZJ9Y8IE9HNja2KvA8k5wYEqOwBfmPrTgrNObAV/DtviRyQSGPFTT" +
+	"u0f1+tRJ5FLqYZPRacuCXfaEkbfF9Ny8sVIVEo3+HjCiNxnapXOQvR3W35pKLk1yHk4D5SRyRy3s7WxPaF/mRBRAVWg45Xez" +
+	"PVAKkh5H2k4YkFcLTTxdIv/vqcAI4CXuwoctWfauwYyhdRVjM2etcWRqFoUb/xuqvD20X/7t5vSC+DRfrHtqnGl0H3dFtmYK" +
+	"eeS/JlIbjdJQZ9SIJiEEsnsIh9sTkA2/6fJzoNWJXRvJ/hf0+kbZuOPmnJFlnQfn9muo7T5mfOfyrl1cjbUVo7Jm31jd8vx/" +
+	"QTX8OG4oHjJWa5icbPD6sr2DPW5PutY0SqcZWO2vIrH/Ne24VtphpEFu1EnSVTE+RjISMzsD9sD/gkT933jDsGvKlJvJQXLi" +
+	"vyAHU08uwRoyd/jatSPIyv++dbVvX0koiKrRCLl9mfnWVep2LEQ5539ADTwHNpHPcZZ50fpN12cPK+vWN7eqb98qZ48yUvbf" +
+	"EOfN4dNBmD16zEytbmaKobygsFwTRxr+eyq18YgSOSQOGRvxfSnZHBvNEW15G8lkk53/BeNkeUtKvP5OndaEylJqGxi+r3fC" +
+	"giUT5i3estLRraX5v2lZj3aeW14+tzNor7FoRwSEtFkjh8/et+mWI+qW8P+Asdr4ikcpyC+d6GI3M06j/X/BQMPh9Z9Xfk6e" +
+	"J/3+G355xBt+GMeHF/4v2hHTWt5YlE11J6H/vpj3/xeFe/d/UChekj3PcncgPYpHHlSIeqD6VnnTjAV7IBhi3E7BmwIM3XMf" +
+	"BIPZGhEpgyHOYJWESCcjaWU9AR/4JIHRJImLTiYqK/pD+CwM9yd3GcTdrCOv9mePp6iHDHdnHfkh7jpTFsNtxAM0qTPUt1tO" +
+	"tLyzDY+9h2FY9oTA9Igd9Bq+BcPopATmhDnxIXZLX5YlzRMy2Grujr6LQph8Z+YCShgVzw1pJA9sMwKbmEsayddWzzVFCh0U" +
+	"WacnkE81ZeQBfXoSDLGlLE1XFTJk36x4JdlrnQWuozy5i1ual7ccISS07ns0IhfyomJUhYZI/YDsP6+dDwhX0Gr2ptDFC1rH" +
+	"dJLhdoLFFzS6nXLtcmajzxvy54KDs/IClYn9ZeCzeoDu4AmEqCQecPJOuyfA8UlAw0UmJw5Gf9CfbF6/jyEL36yGxLubHDlT" +
+	"4vwtymuZqu6i0Rp+4X0Fe12xKT67Ma9ShcvXTBwQO9ilbinoUaFcFI/jlioqFsHz87nZDL/NHhrOGFPIeZ7YOo2wfsadJeuP" +
+	"vMGT3t/0gusO7gnA8B9X3MZMep2xpDCKH7JusKjJJzfqABYb5i9K+ZghP63J3VlT7F+Yb7nQqWvcb1k/ks+bmW8H80uPMXp+" +
+	"+NgJHvXgdBZSkaKVZnRdLhcVoS6oHo1AE9v8Zjije1f1nKUT2IzBAD2hzMbwMpPR2WUg5M4FJ2Uxyb1UkKok+UxgXNQNmceJ" +
+	"BbOb8fhDHpm5zIzgpMbxLurukSpZgkwxTH13CDl026Pk+jf7wcitJBtK127NhIrXG8gj6/aTO1+qx8mQkGf29xwV+3PlSmtc" +
+	"t/qEtDXMq/pJcWl4+ELjyEE3hdN/W8EwlcxGTnUzrq3ER3lwGyw7zFs4fj65FzQ8/2FPJw/cWbL5+Cky4KuBMPvOD+Cub/qG" +
+	"DWT6mQEwcN9zcNOnDWT/LpjSbVQW86qT8ROPurKRkCX60/E2cuCc3boclAN/YvLJ1+Z4FRjExJvzybfDTTz5UMn3+/4mjnzD" +
+	"jcvKb91z+JHkB8+KUlBnqVVSA0u3h7+CstQZazDkBrOTzwS6REn/UVeEQepS0ET/URWMx+m6og424n3L+teu3wn7LiMhHFtf" +
+	"23/ZPozJIGbibs3iKTctuHnmrLULbpqyWLN7Eg6vuJLCP72GX3maZbD7Cgp9xD79Cn7tCfLvxMd0D9cJCpabvRUOHYKtszlW" +
+	"IdQ9rHts5GU0lNa5DAhzI6X+Ngch8JrpTN9rFZxWu8VItyy5GbuD8dPFoRz5bAHa1pLBSx9w/zUwy/KrSsv8poJbY5bMz+R+" +
+	"0V5PGlS94Lmx06a/MqMoOSPVgqtu2LTaVZrOnV27B3+xnh3Lk5lpNysGxsI9TOqqi+PLFv1jUdPM4pyzxV0btjQ09LrLS8eB" +
+	"6xHiHuNek/aCJkdW4DqceSz5/4PIoYR4r2hIjPW44xKMomhIjOtFH8KfccHm4ay6+Sf8VUZistloNKUkZLY+hbtwr1307WNX" +
+	"SONsW37ZKB8FojleccjyXyACN7dm34YRzrkKdi2vRrGDI1fBDu+4yscWdRvKiOuAc4W0QvyfYv1/KAxYr1IYsP7nJYRrrvZx" +
+	"5J8Wm7+szIPRcDT6Py216/9xzYbf/s95gE25Goc+cpWPmf8XZhGuoNtMNBct+H9Muf//CUq++tN+4P8BlcnPf9G1IEUr7a0o" +
+	"F1WioWg2uhHdgm67Wq/mbEfnK7+L/z/wOfNIBxJGnsLvXO3r/8sWcdU++2pPcNvVaqDxb8Zm0FU+Nr/frv6onwngPpTWRRIk" +
+	"SxRv5Gh1rDekgsnA2lINAT9OdTpYzEaNDeiJAm6m6C7o8sAx8gTZQx4/dgI6k/47IRGCkLjzDvLZps3ks91MQXIwOSmYYgsm" +
+	"Jge4s0+RLWQB2fn88zAaroPxT4VN95DnjhyBTvcchrK77yZPvdal2G4v7lJis0V9FLTHLfifYJcafcoBf/A/RTW8qiiYkhJM" +
+	"ou//Edof2koiz5G9FFH84y/H3dveivgK/DAkBm0pwaTkYHLKVQjXsqk1H1vHOqRzsD/LKbXNENDB/3m2Lc0yBVKCRX+Bwq62" +
+	"apP9cHyIXkQMQnQ2u5XrcvHxF19s8wEkhwlyqItCYGf4IyaF/r744osvtvp5YGoifhgE2Y/CVb6P7vC9NuI3QYa/7irfKfx1" +
+	"kq0Gz5VwZ5GIMhCidnss9cAU3ZuQS/c2mp3mYGQPAw1IdToE0Qnv5AZnQfbBss65eZt2LTqcnW8YWPHvbnk5R7ZtubV71+FD" +
+	"tpHPZvcoa8S7xD1qPCpm+GIlHhoIxCvmDTGHNYnbksPPI9lOQM4/FqE/zmvB1VLm9l6RHAI0HAFv5t5HKQgpQfCEPCFryCoo" +
+	"Qb5bjEqwgsB4BMYD+Dw5tf++gxmL3AeO7YcsyNp/fH/agrSyFPIjOb0bUg78+47d/94PyXgx9Dn5wsmsNVmPvPgIOQq9T754" +
+	"MntN1gwyGwLk3vvIDw9D+f33kycfBr1ct8cvfcs9zX2AdNJM4zLzR6NZck1DDcYcqdSFH8MJeZJdmeRxitpFRluvtGXSbraL" +
+	"sjWax8XQ7fgCj592dnaklqe6yp2O8tRXBYcTM7ywhXxdo1P1jgdTLd/52lETyRGoj7UUlJRO79RpenlZMM4KPchDg/pe2xXj" +
+	"WjAn1Kp0tTeOY9/19PB4enjS6O/FfwEDGI8YIOo7Q3VTOT8gP03Ap5b5FFpH0+pFFRWLVjc5tErvsnBujCN/AMOUN5ETnfXi" +
+	"gHwnpf2/EOJWcO+iXITAKFkYWC1WLdBlNOqzLqQCyfUDteVwe4LUgZzPYjWCRwkCs+Ri17g+lr43hzAX9JQza4fUO4d0+vBs" +
+	"p8GOhmE3QWFqVxYKb+prbYituEjiknB33DMJP0be7FQ8p+cDTHmGA9jeBwsUXcR8cJCP88UuioKDPVRJqb2YB3rOKe4EeXjl" +
+	"xnARfn4jlaenX/qWmcV9gAQUj3Kkc+Ukr0wd/PL5Q1G3fAw909Yc8bMnLfmKHqPoEalPPu8Vfva4QJAaX7g8TNS+VTZpBW+c" +
+	"OoazfaqKteE9t0EROEAbd4yQf2+Dnxn2tz3HunXrRnay6idZ3Cl3TD9zrK9EwafhvRxPHvkFLqFunNFsdpvMLovZyO5VK/kY" +
+	"VfOGLknleY7y+vjc2Njc0ms7w4PuaaNzK9y+nIEj++QGHPkeB7nfyABZpoc5xjXruG7JcXwCDnpz56zRl9hKANunCJCtyomN" +
+	"y4mNzYmLzVFJ4+DkS9/yAvcBYpEShRByqcDilX7A500CUyYYeUEeT0rAaDeaTVpwSJ45nXT1sxOErL4E8IW4R3/7fdHv4am/" +
+	"/db0G370SZ/r3t9xZub2xr7b+v0cXv9L3+137cjKgF8fTPI9nZvz2ezZn+V+xxS3POt2S7/MWxlxaS0vVJSWde++oIuCCba8" +
+	"zHdd2L17WWkFUygm6nK3b4eu5LHt9CwnhHiG+wDFS55OL5OnZOtsgWc8BjA6GbvoZOyM04jX7LslL/tERYrOM6OiLPPmqmSf" +
+	"M7Ny/f2T32Cn9ZvZi1xqAnKpZlYjd31fpWd/bZ/CTjcXOQvu69HL0ZV8UgFbw03h/dTzBb2wdNYXBhVC3HjuA6RBgSvszM2d" +
+	"6FzaxFLbZncJuFOZZMlEINrgI+0d78iuz8yuz87tk5VRf5Bjrn9lOxngzMVuD0vKe993arZazXcKVtb2vnnT2j69u/vYZ8cM" +
+	"9HoHjhmcl0eydzzh/nLb5tU/H9xmWnkL5OOvYLvgzNxx+PZ+/W4/vCNTGh+PXPqIe557E5Wh7qg3Qoie3xxxhyP9miLucKRf" +
+	"HfCOXIg6xZF+/RFHOtJvGRhsWG+wGfSsiXe4/UEvY+ztji0umtGtsDojeYNdt4phVunsG5Izqgu7zSgqjnX39sQWF86sKOqV" +
+	"mbTRrl3Fsqu09o1Jmb2KKmYWFsf+izx04QL0uHAJ1c+qr5/Fvcmd1CSvzC7f4S1O6Tukm7PCWTm4b0qxb3t5zsok7SM8/4g2" +
+	"aWVO+XZvSUrfwZXOCme3IXJw9ookzUnyLAmTjYQAhklzlg4evJSa/yMd+ubSd9xq7iOkRFpkRLHSCURZKB8FUSdUjnqg3mgw" +
+	"2hWpQeosk/+/+Mp0R1xlhmRXmbzZxLXz7OC5oqP3majxvMUacZXpEGQOZsyS9Sgjb/ht87RJVztAl6jXaHRJ8NRot7V++Wi7" +
+	"s8/kbtrM4LQcCDgGGoPBzGx/asvrse5RtS6nfUx/Q5a2ooc2ANllwYAJHAP92RkAyFtR4c2t7NYHY9FodBoNDpNRxAxArovB" +
+	"PUyQ3aPmfGppHJubmgsxJqsAOCc1ByempqR+3qsHZBmreGUqt9uRYrB1cYhzS/PcBaaRpfZ+GYu7Gaf0urVasWiEqF9WFqNe" +
+	"OHJ1KH70YE1vd16pfqK9dGT6dcZui7uuVlTf2v/GmJKFJvOYhUx8aLVWMzizJhDq1SsUqMkeRF1JZnjMZk+G5EhyoDKNYZcG" +
+	"+MF71GqTuSqQpmx5d4glcQyTqEwLVBlEUb1nMO+/KUbpofUs+yx4B+lQCspFIdQbDUe70N3oPnQSvYLeQR+gz9A/qYxIu2i5" +
+	"Q6NdtkDtYCKrEFQX6KJWMpGzzz1uD0+deAuynbhbHpDlk/qskUNNjJHniKUhz4Wk5WOrWTqWNNIztR5+QXWJ0Tlbu43d0bvX" +
+	"5+XccjrULMcqG6FTnCJWz3KYhEEwFJSg3AG/9Ob2uKW7hKMQsYUMuZki0W4w2EWDzWCwvdy9fFaOXTDXKBQDb9DAcU6zZqBC" +
+	"qDEL9tyZ5RUAvtzcUZlZ2dlZmdkZGKfnZOZkZWVnMBYt171sRp6DRowZHP4nJE8uKJiYcttYhh2fpLJ5F0KTN1mVOJEffRuk" +
+	"+ANFRUF/yvrR3OTEmOS8pgX5KTFJ41g8Fr6PTc/MyslIj6up6dUQLEi2Jyex5GEFl5ySklIw4rpBSmWNSeHIn17WnVP4cnJ8" +
+	"4OMUwQJbsu2pgqCCTUq296muiU3PyM3JSLfWMAFjqlneRWdONZ4NFI7r28lt0HrYwkHDhg0qZD1ag6eo77jCA540j8edlt4z" +
+	"OTExPj4xMbmgqLAgOTEpPj4pMbmgsAjUg4YHCsf26+QxatOAU+L7nnzyPlyEC0wJOaVj58Dc0aXZCaYQU3R01OjRo452YkOm" +
+	"+Oyy0dfOGVOWG28qwNV39h80qP+dtowMfVySwWQ2VZSXV5jMJkNSZgEHaTqjp6jv2MLAsJ3u9DS3x5P2bkVnCmcqSorrXGE2" +
+	"mXIy7LcPHDx44O12JEg8fAP3LlIhk+SXah66Dm2gUqVUv/TiOb/bTK2Kg7LHwlAO0N7G5Zd8q9IryMj8IztZDcprbWbKfslg" +
+	"jR77T0GofxGJU3inzNlOqaOip5dEDvUP8h2GNVe7qWUpMFOc+Wk5CibbnpFJfnelCV4j3Au6EMO4wEZ+znR7chhNena285/e" +
+	"JLUysc9gjPdurU7Lcm6ADc7UDLZm837AQ+qSOE18QazB5zMnpWSDulN2oKzRkpTUu7ggu0QFOcmJZp/fkDvs0NChdw0feffA" +
+	"wYeAq76lvvcttbW39O5zC16554DOOfbmQxMTddNfqxkNtX3nVlXPTIgblN84uVd/XNNTKd41XZM88dCqSS7dAZjaWdOI8cJr" +
+	"MX9tnDg4//qfAH66Pn+wJp65lsfXLsS4UVOe7LzmwzHZzqoadahLrtlxww0OMOV1Dalrqx1ZYz68xrn69cfGj3/s9SfGjevT" +
+	"b/eRnQMG7Dyyu5+sUziNGrg+3O3SPE8lzUmAsZvtRrACBNmEtOYvitiy5qeK2Pi05s+528mLeyFE+h4i/SC0l7x41TTACh66" +
+	"iQoECLE/eJq/SGObmp9iOU/z52nc7fvIS3vJl4cOhe/eBwV7Jd2B7Gv8FBKQBpmRCyHwgdnucSIrTQYJXjN2Opig8bJ+kOki" +
+	"4Lcu9hYU+FVT+G72vvCjhspcqynfmi06jAanaLAZjTbu1MWAUskd2t7cW1SyO5+uCG/HUzuFNaZUsynVZEo1mVNNkfNk2bmS" +
+	"7wvJJhdMPtZsBU8ABfzO1FxgG9XG8Gv1MIRvOarBlZPIQzqeO2MWwue6N2i7h/uZBdxf071K7E7l953kEn879z5KRhkoH5XQ" +
+	"UzLALR3XGezoLMkedZhklJ0Nhdz6kNvIC6JddIdk50OU6/VcUO/h9RCkzpTWTJ9x42nI27yQOlQih6l/o5+oD49CX9Mi31O9" +
+	"B0PfIZ19heveeWddUWAx+XnChPHYtBM+gl6VlR1dLUXdLTG3nicPnxE3wpGdhpYN2THkN1DG7NOfge7nDTtJXcFk6D5zJnkY" +
+	"cehWMlgYzj2DVCgVZaECyYuV28braSeOQm4wIZs05rj1iJV9JcnekhiQdk87GR7RJmyj/qvAGww4eGb9RPLhm+Mz8nVgfSgO" +
+	"V5B3SZ+tE2zzR26FIOwcE3GoBKOXksfHXEceL4q9pgS6/faxhvzzxfjc8eB6e0J6+JVb39nwKpM68zx5aHJW/OvgE4LhdRfI" +
+	"Y7smr3eNvZ16XhIlx0vzoq6XmLlkb8PohDIYQkKvkvdUJfmTz0CPmdnkpTN7P6X8uJOsEFr4s4hD2aiQnpWEsDWo9wD1WuPp" +
+	"6OnKE6nX9s6hXO0qE9rVIhOCcRMmMJic+mDNzNpBsj+s+bROV0+bveb1MVBJji2Gx5aSx8e2sHKVLlzkhzpfwfp33llf5F/M" +
+	"vEgemkyrg3zzJXm0W1ZMO6dZi6U6rqjiO3iZej5StYd3GcIaPXVHZdhJekd8evGC0A8JKA0hRGvRYgtycmnaubm6zLEXy79N" +
+	"PnjjDXC9Hc6jiN9zHVREfV6NafXxxXY9T/M9c0HCaSoMbsXn6X9e4eoLYfSvS59xxfxPKFPyyyLvYqOiR1RTTDv2UpB0xVRf" +
+	"YhQ8LqPgYawCWLxSgF/a4+qQokiiDvN4WXZKSYrDbps+oLaxT59+gZIUh8M+t09dQyCn7Lzv83kv+2HXPYv6zjvvD98UmhEK" +
+	"9om1WMwZ7plTCoN1cfQxlUWH7Kk9GobcP7hxRYbL5Snt0TD0vmEDlrtd6rsnrJkAFcNufIJ4C/9BHptwY0tLfFzckJLipcWF" +
+	"3uz8uLgBZUUriwvzM+TyPUL2cP0FAyqRfAQGva0liJz7JER8TznbOa6iXZykVKE6IsFj5AQPVRBR3/1CdP8r9esh201SGuE9" +
+	"uWPz7njFB7vuWdz3jozU0vLy0qI+acFff73+t998WTUlpeVlZc6sxM99n/fqu/ge2O17tdf5wOdN5bkFnvSsrPSMgK8pr8Cd" +
+	"kZWVkeH3s9er1XD7kDVPEG/ROTIyKa3b3aOG392tPM0U89CmH37Y9JDS7Ora7e7ho+7qnpbcY8Kaia+cK4LXn1gz5JUJaya+" +
+	"E5uYmXNtl87X5uTGxkrPnbtIz/I8dOClz9h7pfqulPyLm+WZRSstnI52BJB374qCpIXpUOAOpGB6/PprMK1PES11SW3VgCGV" +
+	"fQql55os32+/bR8BpVA24pNivz+DFtBdkNfkC2TQYnsKcrnT5MtNt8aY0sppge7u1tXldKaVywXu6jIrb90EcfdMgpFk18Tm" +
+	"utjYXLlomYmx0jMtWmZiLMJIAXruN/bZSLmkRsMLsirC3erbT5a16T/JOt0TUoLHZZKKL52SEeVmecc2z/78/PW9YgyphaXb" +
+	"GgdsKy2222z0eUAjfTYqe13//I6RUEKeGdESsJgz0yd16jQpwy0xcAZ9TvfEWfAu8h0Yi7MHlXfr3r1bRWP9qGtqo8/5BWAk" +
+	"3x2eRG4nt0/8rWthUW5Ofn5OZmlgTdujVGcDQM8eZZ9DKmkXVtsp0DjU7jRlGNh89Fhz87GjzTccmjfvEL3wN8+ChXz97HPk" +
+	"a7A8veqxx1Zd99hjCNql1yG1thQ6RJT55gW4me3MVkdxaDPQ64DDCyeXr3jkkRXLT94wsap6woTqqon42/Wvvbb+1tdfX9cw" +
+	"Z05Dw9y5iIVyuJl9ka1GRmRH6SgUSc/amqanNV1fZGLb6nBbcnHgjWYI5SeXV5WUVNE86X35yRsmVPNsYQHLVU+ATtnZ2X0K" +
+	"CjmOYsJSRGw2iordTpEZMGN6f5jrLajpVVDQq2Zh/+kzBlD8qAxRDYO4x9gFiEFJqADVoymSdbgT/VH7zwVv+ybUoQWx/5dI" +
+	"+Knrr4cvq2wuc3xCYly8zVaVYo+PT0iKjbdXdPWXpGfn5WVnFQVX+YszsnNzs7OKQuEJHaBtCRQ6wdYOOrSyDZpd0PIpHqQz" +
+	"xKdUpqdVJSTqdTp9YnL3tPTq+ET9vvjkHO+SnpVLvPkJ8dJzZU/pedN/GoHKp0q4mUdsFQqhPpI1NR0yIiuPl5XdY9dC21uE" +
+	"EhFAa+of9r1/HMKs51Pys4qCnSvaSr7SX5KeZ+fIec6Zk1UYWhkolslTGKJQ6fk2Hs+SiZ0QH29PqbLZ5Edby7CqFJdFInD0" +
+	"c1JsvI0JpAwORIvdRgL/iNikoZeTIz45JzA4ZYlOn0ip2D25jYqVKfT56tRFGD0GX3Cz2HrESS024BLMVnBxIudivw0nP/88" +
+	"uQ9qmAktm8l9+L01Y2seqYFhy+eRlfOW03a7CjWyK7hNyCytWkbUFIFQwGm2yocDpGCnfAZnyBOwmyN715mEfhN72iv99/sr" +
+	"bVUTG9f/tmFq/16e3pjvnVY9YBqI93/iHzUkPf9OV0mJ68789CGj/J/cfxKKvh7x+JDquIqLFyviqoc8PuLrdv5LBWTuKOvY" +
+	"ZGHceYVgA3dLLkvvvEyUiQjQ4YX4OhEBBMkl9iD3Pl0xjErysrhnEzxBW1TQxz/v+fnn1Nxb33yTfDBj4ha4G3I3L7zjJ1kE" +
+	"Oi5QOXvi7P3kfmLbJslGssxweyRtI+3rjEosen1mh1OU/ge8PjGkxLdjDnOkbsGCBQv69Fm4YMHCGsgkp8lpZhfuFH4u/BX5" +
+	"KSJnqQ/CNKgiDyAEremm0Jqwij4xZKUW4kbB007OEzzUoxagaefv+GzaJQSjyN6LU89Lwt35qRfJXhjF/r4gDGPIzpYF5HwT" +
+	"+WZhOHxEzgzXhxeCuSmip9dxxzkXUiKN1GOb7ZIHNiXQs0PAZw35zE4lOLnj5EcymUwmP8I8yITMs9vJ7V8/9dTXS2lhlkJV" +
+	"E+kMTzSRB6CK+SC8Hw8Kzz59WnqlvHXNpSy2J3cvElAiQqnS2epuB3ZL3mF9osuqAcElWqwWPQ//nLBpwoRNBY8M69ywHRQ1" +
+	"5DHoWkN+qysb9shH++fM2T83fPZ1cI7rbmFeJ6/fs+GttzccIW9buo0jH72OAJzkEvOVNKdrrWvpPAtXMESVXtIRYVaeOhLF" +
+	"1OfsTUMqQdV3R+fAgwPG/6O2puvon+Wq8PXaMucOyHm4SC9YO63a+Sx57c4Cnct4qYnSqwYpuJPsFOnMLR9CRqo0s9HdwbKT" +
+	"a2ST9Wg2+USJZDCLtO+y22hXJdK+ym47sO0RbHx4W7rvTPjI6UKmc/i7R7bJn7aljRs7duwb5Bz54s3x9AmSIR4n3Taicfz4" +
+	"xhHrHYnz5tmwanzjaPnD6MaBhav7kDtrVxfOmlW4uhaG9VldOEte65fw5F6RfNZkS/OjDpjBZXgzl73T3Sxx0IZ6HMi4s9p2" +
+	"2DZ/2qEk7g5vrOMqZeHGtUOeNHUoGHmqwyszSCpb+9K1lY9rrQeVtJe5DPWTdiLKYqnFbkM2STkqDRvShFeSUpn/Q22x3yZ6" +
+	"9//0y55AwikwgzH8O3y9158Yh62J/v3EsieQkAAQl+h/9j+rVBiTkDh0aHL8aLxnaFJinFZrSUwaOjQ5IVati01MIqf/wyoX" +
+	"Wutb9jzfCVWjwfSkmKvToT2lrO2e8f+ER7D+6iRilnWg5OkOb8r/jq+Yq1ExMULjlsidHfu/4D6mnd9r+fyn6O4Ts4meWd7O" +
+	"97UKAkowq6Cda2y8p6h/v+Ky+n7mPfPbfGDDd1AGZd/3ibrHXjS4onRAv85dhuKT4uIO/rDHkYsvwFCy5wXgLv4a9ZmNGGSE" +
+	"VdyLnAnVoYloFUJAT/txC/zVp+gpkqtL+ix9zoV2od52U3t6mp98OrLVFY0RnflEJvLJAG5n26w+GdqBuZnJT43bGedmQKnK" +
+	"ssV5bHab1WjWq1RZtnh3irJuQB+7xujADK9WmSqrs7OscVpRpc5NtHUuj01U61WKBIZNdo2qgjFvdh+sD+97omWm0WDRGuM5" +
+	"VqXQ6tJ0BoPRKnBqRSKIenOcypjAcCqlVuMxmPTGOJZXKzUxsOo1XqFRpFttORqVThtnTk2qSqbPjMvDcImCIsdoNFY4TKbY" +
+	"hGR3lyRbfowqOTY5MbVrsjVJ0Fjip9Tb0qvfmK/khFi9mGI1aPR6nreKos1iSMQnlJwQpxPtZoNWY+CEOK3BZjFoldI4AXZu" +
+	"H3sCmdEYajtp8EblTWopFJVDgaNV44oeX36lgE99e5Rifw5I5+q0D2kVjM0mSwpIR6GntiacDK3Zuc+nO3Q6rVanc9AH+ofv" +
+	"xzmeUT2fIyeGX99DFxdnSk3qnpQSF5sQTx+V/WuGxDosFkfsgD55eUmpprj4hNi4lKTu9DFO1+P64VD9XM9RnuYJ0fQc0Rxg" +
+	"hlajTTEaUrQaLX0yGFO0GpjcUtZ/fVapVqtwxzmL4816jUZrNXd2OqRnhzI+TZ8Wr3Ro9Ob4Yoezs9mq1UjPzji3QqstzVrf" +
+	"v2zxVdKlPI+e5F5ke6PeEZ73CXbamdnl5bTIQoQWHHTSIPk7px6kJcFf+uJ06KQjMOXQiPKKOrk2WS2U9C6pPkzSp9ZpvxzR" +
+	"2OGtAxwz+ckn8GD94O5vkh1Vg7LjkxUqvToxtryzLdGrVImqRKuiT3WlSaXmGRyfoHD3GZCVneKJs2WpVHp9rs1uk56VwDst" +
+	"u8eF/23QKrRqTjBZdGajS6tVqDnBGKszG7410gCejxP1JqNbo1WqOD5BbzBo2N5vVKfb6sc4TTo+2WovdScmxyarYnzJjsKM" +
+	"hORYNtVRYTQacxSCRcV4TCaVNttmq7DbvFqdSpNjs2ZxWiXfV6FVxBpdOtGs4ClrW5xag1nBhYuUGkWs0a3Tx3GcQaM1GNPU" +
+	"YhzHIaREndFP3A/sBTQIzUOb0P3obUQgG/zQI+oRLsrB7khVRM1KpXlElIXps0bWaUoNg4+sYPNtfVjAH2ptGkHsDUUWTD2t" +
+	"6qa2tVTDn4delmAbNhQK/2ko1xZ49cQtks8Zq8UaCjLZUg/J8TIAdQTQAbIdkjQ9F0d7Wpe7zdq21TQXa2MEIUZh6dlQnJ5m" +
+	"T00R9XptalyGOzM9zZOcqtZzGUt7Q9Gr9Qt9QgIJwCsJQnBe/avk+d5LMzi9mGJzZbpznK4Ul1qv1zristyZxVX15otxMSqF" +
+	"kmEYRqlQxcTFxCgV9EWhjIn5w5DsbKNBLyoUCoWoNxizjcboi9H4hyEw3H6d0xSjjeHUqjiDI7FLYlKsRatSqWMNzkR7ccOg" +
+	"BL2Zt8Udgkexw923+zPkgev0OrMhbljvYnui0xCrVqm0ltikxC6JDkOcSs3FaFUwCfAz3fu6ielZllcoTAoFx3Gc9MDjswqN" +
+	"VuBjbHqNIzHdPcLt8MQb9Hp9Sqoj15NkUBa7bhzUoBFT1G+8oXZpVA2DbnQVK2OtuZ6MJJtWrxcTHa6AIzst1Zlg1NuU5G2W" +
+	"UwgCqxA4XiFwrPwmKKQ33KnDK9sBNLxcUGj1osFiNoh6rUKQ38wW6Q0XdHgVOoAO0ZUILK/QxsRqrfbEhDijXq3WWoy2RLtN" +
+	"fnPEaEVFrEj+9fSvuY3ruyYn67QxDrXeGJeQaLMn2owWrVp+s1s1cTEmjAfPacz9R6ZFqeQ5jlcqow/SPLDm0mfcSe4HNBLN" +
+	"oTbo0KopsbVX5zrblChmN5bdp0XaMVUOylJmDqZjFGVmQ6RJ805HlOOjJlWyoQM976iteUN763OpScDHjMooGixj0KUpkwcM" +
+	"6t9vSOPUyU3zpk8aMGhApWfEuNETRy5ZfNONS+eNHztu9ITRi5aQL5rcJfakOGbkni09OZ09WZHvTiwp1OlNyXlOva7CnJpt" +
+	"NTHpnB5ixNiUJJFhYmFOyKQzqhOUcUq/Lj4BQnxyQqLXYFT5fPmjx3y3+uYLE0b45eebr3+0dMsU0O/YCfprpxZ36lQwcxr5" +
+	"9o4t5Idrpxd16mTo0piXW5Oi1732T1inMBUUVZRnpDTNGmmzJCQrsyvTEkNqR0ZJQZxbYdbl2b1JicCzNouozs8OpLrVMYZg" +
+	"URc/QnxrXWhQPEpDXdAQdC1CkAMeFx+pFKsx0uMYKfWNnBYEVzBaUX+oLzS21V4ydFDTey6rQrentXZhcYGTA7XgsCdlGYQP" +
+	"7GmeYe50W1lOvi97Aaixq5A8oNFkJdkc4YLYWJPRZDKaYmMLrLEm6S/WJ1fWwIGN7//SrgrbV9nXS+ePGzteqkq4tudwXTjZ" +
+	"4R2carMoAfY4TEajyQErlEqiCgvjeq5geYstdbDXPlelNlsDKckBq1mtkp6TU+gzNss1terH0SOvaV+BYy6rrIKZ08AUrUjZ" +
+	"7ncyyuLu4A6hbISMgZDHZw26MOOz+sxO+fJKEkUmCD6zU7qcBmOqR3CGVtmLBq1YCZC3der4VTWD1jSs/r4sMykps+z71Q1r" +
+	"BtWsGj91ax65tHLFoCL8Xhr3/kuaGDDBxE11y+uejxezxPjn65bXbYIRkKxSv/S+PL+diz7k1rO/S552HCid4qQEO7Qbi0Ba" +
+	"/OPADq0V7faASDd70/0ZzK1wU/MbcBMvSIpIt0nIhBVkESbPkJlsPpnJ8xF9MZ9FFsNyhni4BGY8ZJFTSgXVLBrjlIfIx/hm" +
+	"8pFoME4i70C2QpA1jsIhcIRngd1oNIQ/YZOaP0OIhesRYmO4f6I69Cb6DP1b3q8iiVs+a+tYKfcU1Fow2HHQazW3ZEzUYw19" +
+	"oh0DPbjdL0f2uEES2Ew64HXQOmeJ2i2lYEu0p2md0UTsneR/0ilOptZBXIpcBkHZYEpOKYIbPd5FOvPUE0EvYKTurCCaVKsR" +
+	"l7vNIIq+5wKstpt1uep0wGxsaoIhxRxr0+kzdV0rHBqNnueUrIoDSIx1eeNVoAQFxgwwYMLEzCrtmbF6XMk4bQwXk8IZ8y0K" +
+	"jy69Itag0+gEIUElNi5o7MsaOMak55jMcbm8O0FU8RwWslLVQno2GDWQZ7bkKXHc9AxOUGMeC7wmPlWtNeuzFAwoBUbZAABL" +
+	"QaXGjIJTYeLV2/xeHQN6ZQyDVSw/cpYWVzk1MVoFoxJiJuNvQvlCytBYK0Du4sHO4tTEBBunjp92crjLrC/PStFfV8SrYng+" +
+	"1ZHcKd4wt95sIKcEZWySJXVAamZT3A3Gm294wGAPVAxw1w3shB8orJiWHHw6idepNKM8Uzd6dWoVr0opiPeRsZVD1QojS9UI" +
+	"/r5uJsUSI6gtbptBnRvISy3LtuH88gTRLfKcXumbrIuxWqt1qhgujuE0XVlHXb7lXaVq14NFeb5iRUVRYEDPnHRegApWMLrJ" +
+	"YFNmdn6RRi3qlUqzVcnNMJhyOxcoDTExBlHFj0MK5EZ3cc9zkxGPClEvNB0tREvQDfQMfaNFqlKIHK5ABVcdGCWTp2Cr7BqS" +
+	"BVZB4gCf5B4uwEu9MMjRdBIfB8GRCwI19ouOhPTuDUW3KNCMeCnMLJ0aywVDOojY4gX8nBByPx1n0GiZ2IxRmfGO67smDar6" +
+	"/5j7D/AmjudxHN7Zu5MsV3W527Isy73JkgwGm2qqTe/NYAMGTCdgeu+9d0KHmGIgxCGEEkjjkwSSQAikkBA6JAHSE6xbv8/e" +
+	"SbbcwPD9Pc//DTnryuzszOzsbJudDWv/6ziJV7qfrvOI+g1CQhNSF0ZZ9AGeB06YwpO4T9un/jiA9wkOkHIQG+oT2P6rxBBl" +
+	"tCmcyUrQfZPfpl47b33bcanaEOXgpvWahneLik6YEhGnbRoXtSwmlefaeK7qMDAm0KQh78d2lsBTxhQSEionH3tzcre03LHg" +
+	"qQz1T3jQN2xKjwR/r5QDyal+Qd/NDwqeffzztQXGh8aRQT4wLtj7oWVk44amHuEy6C/fOndXZxztpVXKTWBqERPn5eEpMfYb" +
+	"NnSaNGjM0qlD2+Z8MW/Kk96D32/j3yghWM3Ep1rW5UaGC/sQd6FNnI0rRirkh0JRJEpGbVEnx6k3CSAxUHGqBflbIQN8sMoG" +
+	"KiOoOBD88AEJZSgFrRnEwZ3oLOmYEREP8KH+CeZkE7tD6gFWdUBSYhP1ValUprN/u9Bz9iLP8U9ww738G/wHPrASruN6mC+F" +
+	"SUkSkjAILvt48Uc8A709ZV4+HKfw8vSXe7p5eTOcMnIMZt1lKQCN3ALdObfHupZWW6BvGvbA/21Y5bVhFUxS8rP5/BN4CsjU" +
+	"/B+p7p443V5i1fsosGQ0/0yCH3lpOFbh5e4tD/HyVnOst9zDSx78pzd/HjehckmAddx9Nh0pkA4FIyOKQRl0NRuJy4Vq6oCt" +
+	"1VAt5SwmqUbH2Oicvgn0VB56lGLSa/ThKbT1T6YdASGIE1381kkFCE4llbCy06r4eNVpGSuxa7t07rx1K+ZxIH+HZ7ZCJB6p" +
+	"DeE74r/j+E14kP1jiZtErpRLpRKJVCpXyiVux1riwyFa/IOvRiaVTNOrU1ekqvXTJFJZm+ujRl0fBfkXyEFy8ALE+ukC/MgH" +
+	"o3y3N4g0+HjL5d4+BufNkDy/AJ3fc3SATkHRCkarIK2L9KQ7Uwg2SU0MGBkw6Wj84hgBTAr0gfafxHk6OlCnY3VxDTkeVGCl" +
+	"QOwON51MKr2qbpKYFKC2gof90zmei2Z7rj/mgxvgbnv59+WemMP1sD4p2MuHxA+CLyV8f08lx3h7uXnK/T29FBzn4yXz9A6U" +
+	"w3VIkbnzdk4m88BpvoE2a0vdYzfOPZD39Vq1wWvV1Y9Ahqec4PP52b9Mx+me7qkLrBL+2WgsUfjww72D5V4ecm+WU3t7hci9" +
+	"3b0ULKcBH+zZBPPnEUbtYDI3lu2FjDT+nlJuSjaHGkFjpAyKPTznGmc62IwaCNeHGZTCajhn5Mq7hBXTSJyRNYQZpsRJpSCF" +
+	"WziO/4/zVHgEeHrJ3N093WRengEeCk8OS/krJJj8J5Uu0rDsdvgWFpP31H66iq6eLlXnp4ZG5DUSsZ1lcStoljOC/Nop0E3u" +
+	"JpFI3GVBoSFSd4lE4iZ3C+xEfh0BLRb1GPGOr7P/lu7syvm+M0Lsj02BQ9xYNko45Z/yYQgzWFNMyeZkym119xJhIM3MgSf8" +
+	"FaL/USKJi5NIfoQfcRxRSLw9FT4RPnTqic4u+UT4KDy98YeBevLriJyblEx9kMLHmw5VvX0MGrXBeU/pADX6iP2ccxciaYpG" +
+	"W6lymBMEju6DEOB2g0EZ7uPpyV9LnRSoDiK7yTV1o8aeIVEJGSa8rFGhGs6DtqWnzDuKJPL2cInPoo2maJOvwOtVdIxL5jxR" +
+	"cEUe5QF0kdQlE3NFLr26jdG5q/nTIyMWxCVrzI0zusUw/jSbrcWt3RitMoAP/qGtv+fSY/OSQmMRoIdoDRfKNXb4kMropimb" +
+	"DHTMbNg8nQwkA2fAZuEPbJmOHxHbUvif8Af+t5Skwv+WCnRuRRncMG6dgMODDYR25AE5So6Sh5BNjoEO2kE78MWPyBLyPxhH" +
+	"loANxsE4sJEl8Br5HwJ0vewx58V9L8Q6psrn5FHCCB0zH6joxUmY8hNZbUoVLu8pOoec1GtiosxbLvf38dZ5q04daTXTPSCQ" +
+	"Ues8dMqCsZsvBDXTM/HurN/bF4eHyxQKb281raaBsqR2M05ouO/lcn9zgEIToLtFfvvoidpT56f1VAfLlf6bvrhJFlmjAxu2" +
+	"D/GOhnRwh7CtBndvf/8ghac8eP+GE5/5ivp5CyFuBPdI2KEnEKTTuvJSPrB1zl5hRxxnk5Ovckd9pipvSpuV/czfVyn1lQV6" +
+	"efl8c2XuXB9/ZRj21AaFBki9Qn01vu2Hf97OR4XlPt5YKdf66wPc3OSa146O6KHWeKt1sSDT+Jg8+83/4qYH9yhYWx84T28v" +
+	"TaT8O/LxZzuVvkn+Cqww1PdVegQE9jtLSuf7h4d4eQYbFAFxjJevrybkyFenwaNhoH9SqMnbL9n/g5Pg9q2n6EuchxC3m7sq" +
+	"+PgYEKKjDec/Y8WtnvooCwdj6hVm5hMyjp58AEtgBrkAq8gI/g4ZCStx4HH6cJzeH2c+ggOkC2lJukIRnGjP7ydN4AzuDmf5" +
+	"iRfo7QU4K8i9In8ErvmdgF+ImmwgGvgZ8pljZBT/Aw6DFdiAcHkaBvkgBGaLweZCHd749dfXcAr/2XX+IjZfx2Mfw1N+GR7z" +
+	"FI9F1dLqFXpXLnEHGEQ2wWDXvLEBloFL9gggESH2GyfNLnIZBflkw3WyAfKvs82f2g8wXZ7CCoTRbwixt7iryEc4C9Gso1mZ" +
+	"FAYT/dVRdwiKxKzQW5gr8+eT38mb8+fPB2/Imn/8I/I7eH8E3uwb68gyvt867LEOxkDsumd0bPc93w/v+B4xECycWVCpDA0U" +
+	"n8KsMJkdbs9mhZ6pcDgwM3vJKbsdmtmP2+0k1m6Hr1bY6f1xdjj4kgdkDsUNU9rzd+gNDgRf0lLMD3wrlVsbhJyuc/RkBtGv" +
+	"MK18jskkTOBLvSHI6W1nsSlMosNljNPYm3V6m2PGA58b2XNHk7TI0DitLjSiYfqOviObN2rQpbVfS1aWlNi6a4MmTUb03ZHe" +
+	"MEIfp9PqTQ0ydvcc0axRg66tfVuV/pOY1LpLgyZN8fY2rZrktAvozE5JtbXrndmizaS8khZNYozJfn7GmCatjudNatMis3e7" +
+	"gM6lc1Jt7XKatGw7uQqAGL9hf9ldrj/3B/JACqRGOuSPglCoIGGVnlHpPUCvYvQ6RqVXeYDUTEOQSc2cQceYpCqbgWE/gVWf" +
+	"fEJGfPI/MvyTT2B1yrp1ELN27br+57cM6N9/wPtkx/n+vHDH/UGmkKlkKpkCc2A2zM4jP5GbEAohEPJu3rvvkqmnck9ByKlT" +
+	"MOddQfZtym5zp7mngp+7c9TtsLxUzKLZcVjeGHDO1DnMb0NwnjHnGKub8bVmkUnJBeZEU/OgfSMyhvaMjYmJ7Tk0Y8S+oOam" +
+	"RHNBclJkM/FDTExMjPCBCQw9OSlzfF5iYmJi3vjMSSdD28ZaUwtTrbFtQ9+dSD8kJdEPE9+t+CDa2ayy+8J82GzhTJPK/ppV" +
+	"3DUFX9SIGhfKRA/ecqjyRGpXdCZDOVTNLnc2SwR+y1t2FsuU/kGto6JaBQXK5XJ5YFCrqKjWQcHebsxZmbePylsZERAcHBwc" +
+	"FB7eKTw8MDgkJDhEr/FU+fjIzmI372CatHWQOJ8i3vsrZXBW5uOj8tToQyh8oJA0iGIJiFB6q/BqhUaujg7Wh4XpQyMje0dG" +
+	"htJbg1HnpVHI3a9id3VQaMf4uI6hIUqFQhkS2jEuvmNomI+MueouV2i8dEZDlaTB0Wo5TfoVI/MJq540SO2Ov3JHgFqX3ebO" +
+	"OOTvmC+sRbzeoBFrbG3ijQeDYwazFvGmgyXMiavGIg4GfM2k9/TRyMLDjYZAS9TgmER9WEREmMxXqjQaGvYK9vSQyww9k1N6" +
+	"hIVqVSptaFiPlOSeBpncw9PYrX5MkKe3TmYwRoTpE6MHRyeFGozh4TKtj4fBWK+HgSYN62lO6W4I8qNJDd1TbF3DZHJOGd6d" +
+	"CWyaG+btqXSPyEutPyAiNFCr9Q2PGFC/Yb8Id6VEHdk/I0HvJfdzN0VFR4RbE8YkWMMjoqNM7n5yL1NUs4F6L0+lu0lIGu6r" +
+	"0QhJU/NM7kpPr6j+jaJM3nJf98jI6IjQ+vFjEmnS6Ah3fzd1ZCRy1N+73Gnud0h09DWcPYzK62gui/0KvWParepaW9U0Fatr" +
+	"wnNNi22O6AG1J5K6TEGKiaojsYolqNBXx1FDItc1wko4KpFAY9mLicTRXc2JGDZA4ytXustk7kp3pc4vQKtTCE/hWp1/gEar" +
+	"9JKBzMdL4e6uVih1Oj9/nU6pcHd3l7optTq/ILVOqfCQyTwUao3O30+nVbpJ3dUKH3+dv9ZfBHRXePmQf928fPw1Af5O5Eq5" +
+	"ryZA56d0FyDok1WggoK7KzX+gWpfB6DCVxPgm6D0dCP/+Xiq3NzdZUq1RiBTqfBwc3OTqTVOomQydyFnP51OKXNzc/NQKHXa" +
+	"APqklFHEnj4glXkqdRr/AI1vuEwmkymVvupAf43S3ZmXmvvdw1Op9vcLCvTzV7u7ubuLTyE6v0gPd3dPlZeCfOfurfbTBQcl" +
+	"q9Ue7u4eapW/b3Cgn9pDfFIG+AYF+wYotTL65KH2C/S3qhReHh7uarW/b1Cwzk/t7U6+VXrJvT08PFRqf7/AID9/tdLTw8NN" +
+	"pqJP/n5qlSfNS+3nT8lQydw8XIhSeXhoVV5KMMq8dVqas79KzEsZoAsO8lN5lj810AU4cvZQ05wDlGoPDw+B4KBgnb/K2x2M" +
+	"Ci+al0ekn3+gXxAlw93dzd2FKKF+ZZfd5k5xf9HelkoYSCotaqXQ0RCWrrAmBVO1zF4yXVy4GDB92pIl06YPGJI7eGB+4XT8" +
+	"i7igsGMreSqsDDUYMYE83boD1CPHpKYh7ILfeRJNMGgcddNhAcWqp39O7tC4V6fspk2aNmqe0bFj794dO2Y0b9S0SdPsTuRY" +
+	"rXRFGFu3fHPIoKNZLU0REaaWWUcHDXmzZWtjxAvojS77hfuR+wPJhLMYjDLgQGXkVMDogZEBA/po+AYAMFwn/9OTBqRBGOw8" +
+	"yF+B8wfhfXj/IJznrzB7ZsOnxDKb9OfvffIJ9md+4d8ka/jjUEDW4LZQIPjQOte6ZCgcoQrsTO0LUgy7mXjA2s2wDtZthrXE" +
+	"Y3Mti0ncI/4xOcE/oSFLsAJaYt/nLgFhFFP2K/cD9zsKQiaEUO3L9NRnXKUHlQxUoGcaFZpTYmI0Go0mJibFXJhijolVa7Xq" +
+	"2BjzqIM4jqQfJA1Jw4MkHccxd3z945JT05o0TktNjvP3FZ8aNxGecIRDOsPIWkE6jEsZmFCSaymgKoa4sqFVPaeMBrnSl+JK" +
+	"+cgXlB788DLEC+NEpzypDgUJPWCpyiRVmfQVFLrIke0G2p+6/PRT159A23UG6QMdZ0JH6DQLOpK+M6tIkm1Fvikh35aUQEQJ" +
+	"lJIW5AlpAQryBN4BBV5WTc1qoEVnlupMUpvJHABOCmyMnmOMHGPUM2zYqJ5hPXuG9RwVViXng4NJMSiGgFz4Q44wrca3Gt+q" +
+	"1fhWTEjlbEvJ1u/I1u++g9zvEJKU548Rh9yQB/JGvggZwWIEGzAmo17qATpFuTDgKHTkb0DHL6HLrC+xgfSdCTNmVKFkIyj+" +
+	"+Jg8acOXwjttPv4IzyAtPmI6Vecdozjky/3IvS16eMtAZTKCSQV6lU6lB8ozcAzouR/JsM2ryBWyetVmMgz+2rxqMYzlR00k" +
+	"kyFtIjSABhMhjUxmRtMalfIH+SZFqFLKFBIG+SSvjOShsjLYgsoQCOeErHKcvUPnBFRewBjpOYJMHKjwOAiFESvIKuEPuUlW" +
+	"r6DUrmARXCXRP5OfH/1Mfv6ZRMPXP4Pm50fiOQwYLUCIm8FdRRzlAaQ6s83EgQ0UZgXo6IZo6q1uwhsLCr4uKCCnSZ8C2HUd" +
+	"dheQ3sKf66RPAfPOpS++uHQJvL4gv+MA/i54f0H+IL9/AV78PexP/vii0hhVh1A5WuoQ78yLhtQeA0sbkTE9yZgMWMpfwmZ6" +
+	"s6wnLGtERuMUfIJktoB3+WV4NL05mQfvtiCZeDS/HE62IM3zEKB6Zbe5A9xTYTZBJQMvkAFdEIwDGeDfW8Aecg578n9CBunV" +
+	"ogXpBRn8X9iDnIM93FP+Hcgjse3bkVjI49/BLcgW+Kpde/iKbEFIVj7Gq9AxcQxqQgg4hjNywBgZFQNGlZGj6yQqRqVQGRkv" +
+	"UBk9gFPFAcOx48mzYdid/EsIjB5JCAwbRpaBZBj/N7gBJstH0jgow5ZDn9WQQ7avJruWLye71pDtkLMG+rBzJ5ObUFhIBoJh" +
+	"An8KjBBCBk2GEJgwAbaQG4W4OfmO3GQkZOJuMmX762TKbjIR5u+GOa9vhzm7xX7u/rLbXH/uqTBPQg0epUwGHMMOhH/ICFhF" +
+	"ZAMHEhmsJsPhH+4pf+ocHQSf49/Fzc/RYfA517GuIF+g/IEHAOUPGHYQzPsLzpP0v8ikQYPIpL9JOpz/G+ZxT4kHKEn2mNEk" +
+	"G5TEA/4ij+HY6DFwjDyuhrOyzBh2I3SaTFuZyeTQxo3k0GTyDrSYDJ24p2TNQjL/QBGZv5CsgYKFMLHoAExc6IqParPKxogY" +
+	"VSYPkKocWHUMO+SPDMi4Binks2vkXMYfQ/L/bETeu0YugvkaNGr0J/eUvPGEbG1Bfhs7jvzWgmx9Qt6Abk8gtwX4jBsLPi0g" +
+	"94mrTicIOzkVKh0DOjDqOL1Gz0mNIAWVlO72YEwqMAFnMuoteqON1i7Ghi8VbMzbCAFtyV0YE02WwCoyBkZFk2X01b0NeRvp" +
+	"Z3IvCwLIsmgYQ0bDSrIkGkaR+1ngtyGP1cEZ0hw2xpNBUL8L+fDAyAOFRSMPkg+6QD0yOB42kEw4RQbFw2byYReoXzSyqPDA" +
+	"yDegXhfyMWyMp7SvK7vNjeWeos01zVsI/nVVI1TGQJi4NCQuDaaByzend4DEp/oUB21CXcckYmuaBsk0Ojh9oqu6MeDyzenO" +
+	"QHfifd3UOR/iE6iRDapvaGiNV6saBShZr+zU5u7usvE9PVjOo/dr7jL35qnZXqwyoJFKHW9taGiQ6+Wr9XHOmjR3k/prvHIb" +
+	"GBpYE4T0nGe71GYeYnrGved4mbtHs9R2npyQPsHawJDe393XX8qs8ZwxITNbnETJzpw4zVPirZM0m9TKZJD6phhitb4tksPr" +
+	"S1U04lBaeHILX22sIcVXajC1mpbh5ieT+Eyf6JJ6ug/DeGncMqZVSa7WqlXS+pWSz0qT+HmIZ0o6y8oPveXoP9W8ofH/j8uR" +
+	"0VVdJfLy8vZUtP//unTbV12YMqg19L7t/7elDmhF2W1uAvcU5SMEuuQM6vogDXOO35MFsdLiFktbKGxBE5KdkYtMYT7UbdqW" +
+	"7JgqkjhKlharWKpCoTocucVFe1i4vFVwdHRg1vwOq+Tu2ohcZeYiU6PkmH71k1qvb51YLycmpmXsnEzldnfFyrbLWobGxARm" +
+	"z++wUuGui+yvalGvXUQjc0xOvcTWmzOT6veLiW8WMy9Tud1DsRJ/4K4ODFBK+7SMzYltGSrpkbtTH2izbez9SUq7dilfdF3e" +
+	"2BYYuG9wF4l5kym2ZV93dUCgA7htoCTC7AptuyhAB+8Z1FWSsikitmVfxJbLyhttREfR/wTPaGFA4XBiUokOIeYaFN2p5YZy" +
+	"Na+u4qJDSYbD+8RQg4JXq3jVa50jaCfnvCkfAZmFAZBRW3EvDoXEvBjWz6wPiG0bGxCWvF6e2CtIL/fpkNFR4a4zDVCqzGlt" +
+	"ous1lWfXS2zdtl1mUv0seaO02FYNktXK3Aitu7xTRgdveVhgr0S5PL5XkEHu3SGjg9JDF5GrVCc3aB2T2lSe5SjZbHmjBrGt" +
+	"0swq5QCTzl3RMaODjzwsuFc8OdWwfoC/m3uC1SJlPVU2mUegKUOrh4ONQ3UZEYEeslSVJyexWBNlbv4BacyV3NbJHZr5x8T4" +
+	"N+uQ3Joc9whlINLSMtZUL7Z1sCQicdDCKHd15ym9c4bSohyc03tKZ7W7afHghAhJaMvYeqbYVimRwIR6eIQwEGluHRtRL65l" +
+	"aNV0KUNd0wW3pulaWiKBCZmhZCEszOSr0evCNaxfZP0urVt3qR/px2rCdXqNryksDFhqT3MR4vZyh5AfmvAce2qqaaN09V3T" +
+	"1TdN17CDuup+ao1awjSfZbPFJ8THJ8TbbLNsqfHCf6kFqfrkxKSkmCijKTxSb47u6BsTHBkeGR4VEzc82qyP8efYMOdDVHi0" +
+	"ISxUnR2TEhIVHmmMjImzpEZbjFJfAdySGm3WR4b7sh7+gYnWya0yJ1sTaUytROvkzFb0XmWR63TqGNPg9Pq58RF+Gk4XFp+b" +
+	"1nCwKUat0fhFJGUbI7o57uNz66cPM4RpWE1AOL13wMR6Nym/j6BpM4SzVpzy7Y3Go6VoLzqNvkbI+HKCqpB7XUoBJwtNXZXg" +
+	"GLTlUQlvTFW8GWyMMIFbxfWa1kDQVdaDBNELVGWzVvXX9hFOJmNY1j9WnxxTEB8TaYwMjwqK9esQk6yn5RETaY21xIXZJL7h" +
+	"kWHJ0aPE4pC8qIAzZ9mkEi9Pna8uMKBxQJDWT4u1EmnD0KC06ADfPlo/rZ82SPigZd5joiMKpJLQwFD6KHxRMRiFhGp0Qf6N" +
+	"A4J0vlo/TR/fgJi0oBstWyqDdL46f/pJHxgilRZERP9rzE6K8NNoqBI0TMuND9NxjsIebIpX6HRyi1cGfU9hHAXs9SKlYVL9" +
+	"GbZFC3V4eoAvbeR9A9LD1Z6zWIbPlXgHxwfHDtA1CTc4P/rK4KRW12pMYlOWEZ7TTcI3GTnfNsvXicAQ3kQ3IDY4Ptgbwt3d" +
+	"ZcIrU7rwmWGbJo5ppUMIuZf3/aNQZ3Qa3QMF1IeBMB3egCvCCpcYctRl5YMqiMtGI8c2O6NE6rIxSdyV50MjcTpm2kWLTpdR" +
+	"DBAPLpPwlTSX4nfOJkmklRMGQ/maQoSp0moNxSpunLLaXGkVqKdJIhwz8sKmK8dLjVYXjEWCBTKpnkZUBFLxcb60OZ12harI" +
+	"UDapw5aAVyKNF7x/HS9pGDmb1SZouyCkiqgsUrWjU+eovCL/Nur5pas12oZJJZGKknCxASIDVYBNTC01uXzNxWkMpBg4fFHu" +
+	"JtPJpBKGkUhlGg83+UXMvaNX+iWoxXl5dYKfUo8/wdywYfKABLWSLjgo1QkBcrdLHB5eIPFRy4StJVIPrYeEtVv0IXLvoOBw" +
+	"Y6Sprd5lmTGsTUSUMTzIX+kTZEhuHhzq5eMjD/LLitMH+fjUDzdGRbUOdVlZDG8RFWkMT/OWh4TGtQwJ8fb2lgeFNIc1Su8Z" +
+	"7pxU6+Xp7q7gWJkb57XTy31PsJdKBTJZRIJ/UJzKUwEnvWU5/T3U9J1KleAfGK/y5IYofZo1Y2UaT093D5kbywK4Szlmrbvn" +
+	"Tk+JxB2AZRUe7p6eGjfOfYZc0U/hpYwP9E+IkMlApfIM5i/IFTabhzouyD9BpaIv1Z5u78i8unZlZTKWo3uSPH3UUg5DxxqD" +
+	"b2CVyhfL3GTuHr50t5IM+6pVyTKVSuWrtep0KrVK1kOtCgpWqVU6nVXrq1KpcKpa7eVFk7j7erjL3KWAN2m1gZiu0nj6e3q6" +
+	"u7ljf6061V2t1fr7NvD3V2u17nm+ar1eq1X7+zfw9ddq1Thdp/H2dndz9/T09KbLGm4QZuzSNToqVC+X68PNFcuq5ogQuVwf" +
+	"GBcZZ+wZERpmMEbGJ4dHhgZHRHSKiY2KalwV3BQslzeOiureOTyMAueER4aGhZJAD6/jXhI3Kk5O6enp5eUrk7gvU6iGqLzV" +
+	"5pCgAL8YtUbtrceFSlXDhl4a4ZVWo9ZovGQXPb375XAebjSpTObp6SXXyjhMglSKZe4SNz8vL09PJccCyGQSr+Pe7u/ovdUa" +
+	"dYxfQFCIWe2tgp99PIYN89Jo1Bqt8E7jxb2mUrZpzXnoaFKZjKZ1l3HMj7VELyHhEnxH4ebh5y6je7Vk7jovN8UdLLloVAWZ" +
+	"tWrq/6fWmoNURsYfSyZMUAZXvAxWyu5KcOFEqY/WkdhT5ynlEFu+huCHzCgb9X3u2LOGzTFibVXVvuKgY8QpzAAQZjA5Jm1E" +
+	"fIKJLqeYEuJHxCdGmEwmU0TiwOz05knmejZLSpPGGxtmJtJbc9PGrWtZnliylOxZTXZD79XQa+ky6LmabuteTfayKq1vZHx+" +
+	"g7T8+EhfrXCf1oDezwsJs6au65y9Lq1eSIhwv1O4heHPW9dwI1N3kenbtpLpu8hUmL0LZm7dBjN3ISQtH4+4odVoC9qHjqFz" +
+	"6As6R/T/d+MRH1A4dlmojM6+kAS48qGIyugySjEne0GVwUnE/0fjkmURsQF681borkqVeQRGZOhCSSfyRvn4pBF5w5OVWqwJ" +
+	"7m7+AfXhDeheaZQC7/x/NED5i7QQc4ZzNxwjFHLReQfmG+WjlNKKWwRoF0LcQO4qaowQmMRzEyxmjU4h/tp0UoVN/NVJTVKd" +
+	"+KsxWEwK8Vdqsimk4q/JpsMbrh+2Fh6xHimgvwUTrcXXC45aj9Lf68XWiUetRwvob0Gh9fD1giPWI4XWw/jnRaPys8bkj9l8" +
+	"czFoR+Zn3b6dlT+S/Lzo5uYx+WOy8kctWlz+nTxyfgftYud3JEVLEOKmCOf+65ERRZWf8GVWGBU2ndQLdFKTwqgw0APkTbY4" +
+	"MNl0Ck6q0dFoS/T8bZtRqrNxUk0GaHQWfKkAJE+sT0FKnj2xPiHPCsizx9bH5BlIn1gfg2TTJnqRmM2bNsHojZs2kehNmwo2" +
+	"cVfJZ2+QI5vyN+3fvzF/Eyl+g874vgEdNuVv3L9/U/4maP+G6cPcD3NzP8zNzx1AAwgPGJz7Ue5HH+UK52Gg1xDLrXKUg0Pu" +
+	"iupyV1SSu8Ipd1t5ySmEksOXCqb1vP7b8fbWjtN6Xn/62/We09pbOx3/9XrPafTD4+Mdre3oh8fXe07rZG1//PfrPadxV/M7" +
+	"HFpMLm0Gzc6UfEuHQ4s2bl58qENKvmUHaDaQLxYf6pA/sMOhxWDZSH7eYck3dzi8eNPGxYc6WPLNO8jPm8G6+FAHcT1gRdld" +
+	"rpB7KuxJdbVBUl2Npx7R6T2FsKvBG8Icpl0hrQri6JzZRM/c+CqnHOkUzlahPLC6wuZiubh+fn6+UTEJSVFKn2++8VFGJSXE" +
+	"RPn6+flFxiTEx8b46phvVIroxMRO/TslJUUpVN+wOr/o6MSkqCg/X1+/qOicaIXPN994K2Pjkzom0ReJ8bHRfjr2G5UiKikp" +
+	"KjoxqVNiYrRC9Q2j08VS5HHscGu8JSE+ICDAw2fxEh+PgICA+ARLSkJCQECAf0K8VatZQh5KPQJ6F/S2WnsX9A7wkIJuiUZr" +
+	"jU/wFyGcqZcs9vEIsPYuKP+g1SwBnZg0IMCRljwU0sYFBgQE03WlbmV3uWPcNSFysI8Q7SoeNUe9hfgyjpj91JMc22TYJMMm" +
+	"UEVUlrND/Iy2yjFSIgTj0nC4FATn0hS4lB6zuN2odu1GwVSyKwt68z3InizoiXuR3VnQh/+N7M2CXnvN3moWMOOlGaz2xhgY" +
+	"jVdKipeGnkrirR6s8WIwsGrv5VatvqF/6/SAtKDY2KC0gPTW/g31WnjXqgkTXtenr+sLr8M0TOyU7t2ndOfjYU4XMgW3hpld" +
+	"yDSII1O6wBzei0zrAjMhvoGvsVlQx+bBjUOTkkIbBzfvGNTM6EvUNb4GdT0fHSXSRzdG50Mp0vnUq/4KoZrlT3f8dEKDXeWv" +
+	"k2GdDEtlWAq4htgByhreoQrhln8Ir+Ed8/mE/ePH7+cPgEcW+Qv3Ac+25C++q/j0D3i0JX9KFSpDVG60QaVQqAzRuVHiTeU3" +
+	"fO7A2OSQ8PCQ5NiBsUmh4eGhScyP1V+1OTlnzsk5fCpEdSHXmLbCD0wj17pAFN9X+GFajUqyGSIjDbYk503pm9Ve4R4qjSl+" +
+	"RLxJo6r9BonrpoJvHfJEvsJJsxmoDeqG+glxCIUNv0aLUaj4KdY04DScuFedblYAk4rqqHjAMugYobchBNhRSYXhr3D4BWMT" +
+	"dhtRcQcBY2DoznhHudEwEw1BZVZp1FLutJ9/uIwsgvEyQ4Dfs+XJ4TIYTxbJwgL82D0BfhJyvdEDib/RaG/k7yuFyEYPJX7G" +
+	"8ED/iHB/ycNG5LrUl/kzoJW/5EEjiJT48VdaB/jH9Yzz/1ioLyrHE/4lOjgwOS85ODgyskFyXnJgMM4KijYFSQnJhFbSwNLN" +
+	"whPgTPK2NJAdGBwoJW9nApYGREWXfh0cKIVWmYTQJ84rKDDSnfSH191NQXwXoX7Yc+k7eJ30dzcFVfJbRAWi1xPd4gvW8p0t" +
+	"Eqkj7ocj4Ae47uJlVC4LOypGUtHbtImBcxzeyBw4t8YI26LFvRQMa4oO5TIBKXFCXIOAgHiZjuyEYp178+Do6NCmEj3pBrl+" +
+	"OD4goIHJIpOXZnKh0VkxIWxmqVyWEJcWGJCA/WAA6R4maRoaFRXSzEMLxWSnVpYQEJimT8MqVJbJhkRzvycYWktDSBgsDAJL" +
+	"SHBTW4JMSR5ms2Hx3ePDJVnkrhYsSU2DQywyP5hLwkKkrQ0JCYbW3sFwnUz2k9Ekbf1BCwFZkvD47vFhbDbolDIhiVoPQWQC" +
+	"XA/2bm1IENbXepX9IvHjHiAvZEBIJUf6UKRwnNYGEgN9UIaL8VFZpUaO9GF7IR+VAYLBu8k3WzaT67vxaq2O3CEF70DbA7Se" +
+	"H4a275Bh5JYWPyJ3yChYAYFvQoujR8k79g4YzkLGRzDmI4w/IisukHOkMcJoeNkWiZT7CjF0hZ46dejoVgSzQs8NKO0q78ll" +
+	"9Hx2rhMX/+xyJ+6rvGexAt3DEZJIJakVaYx074JFr6Bp2L+enevJZQhpFHmORK75yMQ0UoVeJ+bSmmbyvTOTWc9aV87DAc/p" +
+	"FbaKHF7jwiuoak3Pwisr5b7nrjji4fvQ+I3eGyEJksjnUAzJkEi+4HPEZ/K5+IsfQidyiByCTtCRHCYH8CPoQg6QwyRMfE/1" +
+	"Hb9N1EwHiRoxdBxlUDAdNkrU/yI34dswoma2Ob/pFcxWcsnxEeAyUbO2im+sld/i/Ia2MJFwj7uKJMLuE5WZMcM98vbqca2X" +
+	"MJEwAjLIOUf8AiYSSkQ4lV7BGFSGAmhFwbirZBU5BxllZRQXM467Ko/wuSuc4XYXliFUVkbTMs2E9/eE9/foe4QhuKwJt1zy" +
+	"uXBmLKo8ejZU3mwoDLIVejEYmt5i0+ilBkUNLt5ULHhNoM5XrdJoVGpfna+fQuHj463wVvpouqY2jkpKIL9cuPPoPKinQ/K0" +
+	"4y1TG7TM3N6wUWRcdGxMWEMzTDsAX3h4atSpIcGpao2nh8xNLjfFR3srJbIAfVzqzGbQ7/GJMw8uJD+bXPrd0LcbZZqMfv7x" +
+	"5jGNsvJsMUG+kIK78kWUt3FlN9g13FOkQQkuvAmHXDjn8JS1BtNg2BXDI0wGw+TtrxVunWQI08d2Hj0qISEyKiIiKjIhYVR8" +
+	"YmSkyRQZmcg9jfSOieifMiVv9qVLswdNSukfEa6JtGdqfaPjhzZsMDQ+mo7yo+OHNmhI7xGG2LIb7PfcU6RHacJ8cJUJxSqz" +
+	"ixL8HBpHPBFIi+ofFROmDw8PC4uOGhAZqzcYJj/ZWyO1+NAHOzrNFmhUq9VGY47VmmM0qtVqTXhE/5Q5HXeQD2sjXNDBXHSA" +
+	"28tFIj1Cxgjj8ylnGXYyWViclJyYXn9x/fTERHNKQlLDtEVp6QnJSYfJwgNPfh99rM0ia0pwYGCQJWVhdvbCFEtQYGBwinVR" +
+	"m+MjfxfO/isjXAb3tbDDRtgwWCmKd+WnZJ2Sy/j628Hbm05JTAjw8/NLip+cmTk5PsnPzy8gIXFK050Dv8WPFpGc89bUlMxG" +
+	"2W2bNLOm1E+1WpuJ99bU86Qvwmg0iuZs3DbkhYYgBOVn9AgzEBKpo23jJBq1OBuhUetpuyUXtveVx6yoNH1Cewwp5a1ieUgJ" +
+	"S8U72rFIk/lrEwPUClOTPFVU5+yR1kjyaFG/fJ+4pHrkztv7j/3cu1Vz716fpzdpUy88QqdXBmm98jsP7RPXzBiR2Sw23tua" +
+	"1dwYpYpQBSanRShndi2ozw5OjM2I0it9AuqH1VvSul3TDul9vx1fPys3ek7PLRt3ERi4dH3TxCJzttlijAjSqQPj/NqM693G" +
+	"WK9TSGh6k27ZDRX68JAMZbgmNTAyU2+Z3DdDsPcroCdXyM5BMtRBjMopFYP5hIpbHmOAxvyhTGo1anp0kcVcfg6RJcUsylFw" +
+	"4Rf5VznO1bOkUFlKJVBcL7KBb4tUG3xKjgyLTQwLY6Bpt566BqZw6az+ylBDQK/m9bz0IbbG3T1mprE2oyk50BY14H2ztld3" +
+	"D/eWDX7cxqR7h5sHB9VPTWGJnjRvYgtUd2s/yKdFTHBSmwMtl7VvgJVKpbcic1CQIaKzyU+lTmwf09gURFZvahCsVAQ06HBo" +
+	"QCYCyCd32AMSEwpFCKruddCESWkQDqf3sJkt2rdh5ZSJGQ0b1p82fv2GfQP6dFsbHh4e3iazV49B5E6Lpgvn7tv97uk3ti6f" +
+	"l9kioWk6ebZ5y4mjWzbPntqsKXLJKwwhSLS4OCNjU4Qh0VLFIRiGDO7RK7M1zWBt174D9m1YP2FavYYNMwqnrtxA7iQ0bTZ1" +
+	"9uYtR09s2QyS9KYJLTLnLd/6xul3d++bu7BpC6Eewz3yG2uQKJAnLUEsV+r04nQoG1b42vgJ5PL8eXMXSBT3SDpJJml3f4aP" +
+	"bv4IH/xSOa0XpVUvzqnqrUo5hvsQv2DuvPnkcuFr4ySKX0iDH2+S+j/fhQ/hEpwX0i4harafRI042tbRiDdsP/IfDWYGUmIm" +
+	"ahaxyE7hDhA1O1WEk2G9DOAg9VYGjv51gtmRSA9H1OyTSjifkCH8YdwR1vO7XHD6ETXHuOL0x7353bAOd+QPV8WZTNTsO5Vw" +
+	"vkOaC3S+W4nODkTNXnPF2VGg850a6MRTiYr5TqJGUoQ4nZRRqHRShv26HyH9vl77eCj4cxfBf+hjETaeqJglIiyYbCoFY7I5" +
+	"YdlTj4eSe8/M5J4ADNCEHGNXSdTUw57TGMLoAYsRMWAxJ1sbAh3SaAxhkiA60REMQaDWmtmVZ29u6D/DZpuZs+Hm2YbTszrm" +
+	"5nXImn5mxZobT9aPWz527dMbq5d3X/rv1mV+Acu2/Lu0OwKYQI6xHzvycCC2xUN5TlqKuCKnGIAJDadnd8jL7Zg9vaEjt+kD" +
+	"Ntw8e6b70n+3LAvwW7b136Xdl6++8XTt2OXj1j+5sYb6AJf9wv0i9H99EQJH/5d2eVkl6C0GhUGqsSkM3C+kgPzk7OXSHq49" +
+	"Hcd8sPLB1ffxI9qLdfZrPyIr+N7LGP+EDTH8LFQJf3RV/JywgEBXFoUFU2GF0BukGr1Fnw7VcyQBqTFHV1tndxs8wZLZNcYW" +
+	"/Etr3J9MGL29GgUPM4fUn7o+JPxA/YjsjMguGWG/Zc1cNm4fqkyPtSo9xirExICm/KQFk0Y4poIueCRba6Bt56GVtsldcoYn" +
+	"dOzUvv3saT1yBg9JH9Wx3eR6R84P9WT7VCPRd+xCte/6UE1Gg/oNlmS3aNsuMHF/gG7L0j4zmTGCb30FnXIUUJVSMKhAo9eY" +
+	"LWZaQtXJ4XN+/52OPHZPn/5OtazJKZhGZi+7cmUpbomqyCSmhjKqQQaGMInNorfoa1CLbu17xfUZOrRPw4Iu7QrrHXjv5OsB" +
+	"9RjI5/lqdPxhVPfv2qV/QMJBX/XBDWuPuMOBZagK734ouRrvajHQDF11Z8KwxuUIDMeORkt8DdrD/9ApM7MTvQYdv3xo74ri" +
+	"/Ssyx7pxY4ZFd2/TJKe6kJb3HDCgZ68BA3A0+evb+/ybK9ccbejRfo3WsMVXVVlm6mp6pAcz1CiceNhBvjwELfmN1TIcb7/H" +
+	"9Gkjxhl35Z+eo/UcCXBO9k3OykQX6LwBnIVmSalBXfk/urZp26VL2zZdie+BLVuKhnox/XYvXba7H+s9FFo0bd26aWbLltVl" +
+	"UtCyQ/tWrdq3b7Vo/fpFvWczML7TuAULxnUaD8xs+KBJesMmTRqmN6lahhE1lGGyspxYc7LVsS1VEwyMy+EmNZAddvzywX0r" +
+	"Du9b3mKcjBZdt7ZNcqydMlt07Ngis1N1Q9QG3L99wB9fuaa4gVe71ZrwLb4qPKfXgAG9eg0YUFnOvigcJT7H/tUockOYpDqN" +
+	"pdth7fIhRZuXMfHp9eqnp9evl042TB7/2qRJr42fXJ3EN2bgT6IXmvjzEL5z/fqdu9av3/XFnTtffHmHxp92K1snAUlD5IfC" +
+	"UboYbZ7uW1MrnTEkrfRkeeqlYVZwFqNZYdbolaG1Lbkyy8nPEycMyBswIG/AhImgKXTe87d+JQRg5WN+7XSot3D+yDEjRowZ" +
+	"OX8h+Xjh/FGjR44cPWo+900968iR5J8t68k/I0da69EnkK3fArKRI62lU5nl9VbXL72xgNvfKH3qNPLV0YPkq2lT6TFEU6dB" +
+	"7MGjEDttarqoFxU8WVH75/Kk1+gtVmQrbyQsLo0EfnkuSQ8o5bt2giBjpq0wp+Xart02Zuz/OLmJ8ty+V2J6xoIF/aBxfPfC" +
+	"tH6FFssuU+TJza3m9NT9wHZ9CRnQOJTPkQEjjEBp3bA4Ta/Yx6gqEvTy8sjPH//tJ82WZzVckb1w+dwJU2cXjH5tRNrCTq03" +
+	"Ndr33iAy9FWEwk/02zLuyPngoC2myMIxQyfM7dez94Cg4BW6wH3L8hYwsudKhnWRSyAyocbPk4wU9IzZeZqg5RXY/5isgFER" +
+	"JZs2rSD+4P0qzP7Kz2I87H/NI/fAfwvz+3N5cy1zehrPczijO0gUrq2uxaXVlb5K9b6J00jr0EYhxy9f/7DpkjbN5mVPWbVq" +
+	"Stq4BjNfhe3cBeQ7T8/v3j33tb/frijjqqlTVgUFM92fr/SuZWtE6aj78yQALucxqzSi71mFQLBCrjQna1+hyMmf/ceO7T9g" +
+	"7NiztpHWFRs8omdk7Tz1wa6TH/7+qW0UWfBKCr947awZ69bNmLUWnq0OCr7+bfM235068QOkQxwEQTtfQM+Xi6tehKL6L9AL" +
+	"MMAr2fft+E0yaQOM4kvPvQqTOc1LN7EGmPsCu8a58GIQzo/s94JSVmvFroswtRYqmHbB4IXSllbl0sy+AtPEPnjChM8njH/W" +
+	"N/9taPx2ft8h48+QzWcnmIf07Tt0aN++Q2JfqbxjJ1+fPGVYweTm/ssGL9+7d/ngZf4B28YeOnny0Nht4N87P793n/x8+OJ5" +
+	"onKtC8kvrAti7bd5g7P/oVTIsSEsQuV6/sUr6MRt2yixDmTvfPeDnac++O0z26g7uWPG5PYfO7bJKzWI3quDgq9916z1N6dO" +
+	"/EDeJ1+Se6TYFyvXzpq5du3MWWuZtXXWnyAUg1JRU9TfKZmKOZ/QSjvGpeKsn6AnpngI1WkVcqkkFCvkNjArpBabS5eoYiIn" +
+	"tNK0klNEicnJiRVCcjz5t4k0ZbfNzjJFXANVq5YtW5FfV7Qk/afPnzduPNnwsygna2qqtUJSVCzlspo9b8v6ebOdspo9b/0W" +
+	"4enTyZP++evvv//6Y9rkGa9vI1+SK6+/LtiM2B32wcxy4+qI8s7U2vVHD65f6xTY2vUHjwpPwrjNKS93YZ+/RuiuchqjonZ9" +
+	"gId42OOVpAzwiFrKGIIWLGAN9VfXs4/mQp9bYhX5Bwpj6XSw1TCglyA6oK/dWuOc4nPKJskf78/Y2K3rxmY5hbZMI7ndCRcR" +
+	"r9ooDP34B13POa02n4w07bJYCvulFXaPJ2f7LVjABT2P4Ap6I4VIedWJrXnA/5yW5vV+g87tbbSyU6eFaSNeG10we+qEucsX" +
+	"Zq9omLW82Sffjif9a+GA8QtYkLdsn5//iuCgAb179ps7YeiYwkjTlqDg80fGbWEa1rXNwMhL2Ies0MtAiFxGpwPCaye3EVkB" +
+	"rUkjuLdi0yayoRbi2Ddpv4b32EL7NfMYD75uMg0SYnAoapsukD63lwb3G4xLo52S7IWN2yxp+uH1y8dDGoVCCcmszRI15PcH" +
+	"B62aMnWVybTLz//rc+9+5+kJxgV1b289xDGV6zhZCGwqdi+cVAvs6J6jvaUDxo4d0H/sWJjVYJTt098/PLnrg1M7s2ZEe2xY" +
+	"YR1pI21r04CYin6Dry8pJnfJFXL+hxOnvmvT/NvrwUFw4Pl8VMhdIsyUa4Qph9qLHvZhjqzYAPNIrV3dRgtKb7CDmvN17KPL" +
+	"UAiKQc2dEqSOFybBOzi0YjYilBpnm8uQ/jntODNk/ITPJ0yIHjLhLAw8M35I3/y3ydm38yNf1FzjzyYXDJsy+frk5uXtcEB5" +
+	"23y3T35+n975+XhhncdkvqJeGIPB2dQ6WhixCTa5Hkz1HIV+1HCU7bPfPji184NTO7JFdRhlAz3tgOaOGUOe1cLNYV9f6AD+" +
+	"kAANfzhx6pvWzb67FhwEg+vchrIu+q0Re5QuGp4OtGlUhioVctCUT27UXiisoVfPnr169uz5M/lhwwYI22hnYO3KcTt2zq/N" +
+	"dlz9S2jX/vlrhtADuELOC81aAs/RViVitfGFrQpCgIagTVwxO5Qu1NNVABopQs8dJq3ITWhjb8G8Q96CUDyL+MKopzBKiBk6" +
+	"in3IPkQSCm+DADAyq/gOG/CoMxn8aliMjxfxHKTw2S0E3J9xxWwqhQXOwjEyYKX2Acy2hnCHBOIGyU+TYRRpTVqLsUgD2Ifs" +
+	"GhGvCeKAYX7mV2zAxeQhHp7BaHmuCB//qgWfTfGCG1fM2CnNKj3Dciyn0g/Br62Hejiev0w+Xs8vxmcg3T5tmh3SBTpe42ay" +
+	"y+mMK1JLpHRFL8zRE84AfVg8TknHZr34QtzbqwMtN0PpQzZ4a9XxkXK5Qumzz/6tr0mvUOhNvkzEPh+lQi6PjFdrvSFfgTcn" +
+	"xyvIKLmHu1qhivXzj1ecVIfFJ8eHqU8q4v39YlUKtbuHnIxSVZEJjaApAzbc3px5FxaR8bhne1gmCOQFcOrvoaUAx5bDiX4g" +
+	"SuFMaDO9GPqrV+gtMsBjr18nNwsKjlutzFyyHoZQJHASVpBRFddjsSSQC0435InkwpyrRamTMBbqk1GBnA3fe+fOXrK+ZQXy" +
+	"w4vIpTlzyKVFuDu/v+IS9Wwed42dJpQvpkFAWW8antfei9nDTiMsYUEBXyOMLqL3uGK2GcIi56CSYXwJCO8znwxjfyoNwbPA" +
+	"h/xGA2CQdFQNngE9hf8D/8avc8KT38DHCQ/oYtm/XDHniRiEZAx2Ix+KQHgRP54fXy73AZRrlcKxV5HK0KIPFRwThuhDQiMi" +
+	"PBiWypBMJIUREaEhejwLbiZ2MepVyo+oGD9SqvTGLo76tVOQJcXn2H/pQCdu8agNXzVsieK63cmy39g+nMrhS0P1gnGjB+NS" +
+	"Vx7oirvDQH4kP9JZt53lSEuQY4US3DoLlpPRs+yfMZu2iaW1DSeKaxPUFswSbIEPQkYLVR6zDBiN0OPBD/kZeMZP8DZptRFG" +
+	"kpX8jE2P4c/HxJf4Qvpj2AAbHpODL4dH+QI8EciXO8EeEfWaBjgyqzRgsNj0IAVGqmdMwOC/pvQ8z4+cr+bHMSOn4KabpvKe" +
+	"R+ES/PmYJxdI8oUI8H0eToveZDGDhjGY9KBX6XUqMOEl6vl45fmeU3gPXMB7HMV/Tt3En8bPLkRQfDx5DLvJQQGngO8/hLg1" +
+	"7AbkjhQI6U16E2UWdE5umYktiL4njsiFt3uSVq8JDH+77QJes434XiC+ULKNYtpGPKvhAjNIwWChXmAqvUKvkQEzUcSSy3/b" +
+	"E3608N/iCIqP3SCg2obXXCAPiOc2B2kIozEojzvHbkCegvYxImEGk0VvUkn1An3/zWekufB2HGmVG8dHxH07CH8nkGjER6D1" +
+	"NuKbR3xz0/Kg9TORzOo4bXqLzUFoBc44/G2cA6v9PxecbaF1XlqugHVbBU6AwLLrXBJbLMQOt9Z8bHaESUAOgeENOgzIGz18" +
+	"SN8uHTq265w9MO/H/H6d6xlxIGwhecyIFk17xickxPTpcWPGpG/7dU+k9+mttsEn8Mk2wVf2NWjCFTPny/3mglEYikDRKB4h" +
+	"kJptBtfLwlDRU58YGgwazCaDzuXC61dYV+x0uQr2w739kLOluHgL2b6f+OMw6wpruMuFez51/NeeBhwG7alZs06BljyAfMd7" +
+	"sX+0hraxXHq59bXRITl1u7HRk/wNYFSAgo3ZJjFOX5OWsfVOMsmC6WVkJphhGkw+ktl01/Jo6RFSD0aS7rC/PgSQbuQexTsE" +
+	"BnDFzE6HXUdgoaxZ9BZOL9WrTHodO23xnj2LySn+Ng48zt85fhwHHsdF/57Kyzv1b/uOF8D/wgWBvnVlHwpttZM+E/WFoTSa" +
+	"pCYZKIAxgJ75d2tG2prpRsm2u8nkLZhGZqWUwTRUxpw7Io1esbNp5pGyeiQI9pO7pDt0gf1CrBwH39HU98oQJk0HYed4mEQK" +
+	"4i5im+CPJZztRdvodEEy7IMZa9PSG43TrF0XEpbZzI/M0mdHR8aoOKU+GKYFaT29JRDOJDaWjIqWtrAye/d6+wQHwv7wMKWb" +
+	"PjE2sUcY6abPpG5NUvKubmaLFk13CbYT+nPFzC5hzCeRplhtzv3renFLsOilpne2EPFg0XKHdy9J9LxyJUTv7xfI3wkLDoxW" +
+	"qaIDg8NwoHCaugy2eiYuwbMGnho4U+UjDwxoHZnYQx+ikQVoQsK6J4onoqtmDjwlyKLsI0HG0TR/jVoXDybnsZAg7uk1CQM/" +
+	"EE/CoqdDaM3J7INwSeNEZv06D09/PzIrWK+UhsRExrQLgWl+zTLDQqQQrhnXKD1tLbtm2a6mLVrM1O3fHxJhimgTSLqF9UiM" +
+	"TdSwyvAw2B8Y7OMtheaMtYUUMWgwOsgVs92RGplQA1pHjRKmqq9euNM3jnbHxCoLVcLsanVKXTjzwQ3w+myEu8px9ILgQ2k/" +
+	"JZzn3yoU0hkf+3fkemirUKMmgGlS7mNJj2aQexVcIu/ApQEdXiN8kFo8bMHhRbm+Iz1D3sd7rjV1tjc9YR4M9FtwSKoDThFK" +
+	"yPhc0S7OhxBuK3MX+VPpqqyum/Y4VlggFWetjCL5tJYId/PT/IOUhvCI6MQEa/wqGELWrzIPiI8zpKj8Nf7M63q9Xt/j1q0e" +
+	"er0ez4qon6EKV9IYvIPath1kiFAF+RkS6keYuiebu5t+GzPmN1N3c3J3sZ1eCV25NcxhGs8BqSU+UGnrtnOLtuPcReErPSSU" +
+	"dlszwJqSABHOfkX56VTi+Y2ObaMJ4OLFGAJabjXHXWfdJD4SiYSTML4MJ2GlbhIilUgkLNuBwxIpJ+W/kXp5cNhigSGYk3AS" +
+	"qRSbpJwbh7kOLCuRSKRE4iZlJRzjy0g4iUTiI3Fjr3N4sdJb8pTlGIblJN4y1iPXg5P6SDiGZViOczvuznAsI/H0Vkpee02N" +
+	"H0iU3hKOZRhGwrgfd+M4lmEZTuIj5TxyPViZ+I1jn0oEGaHfuDWsCuW69OHrLCMTU1lGOtXzZKQTZUQ2cFKnkLIdQupEz85k" +
+	"2QKHkA5SITFNmsBSp5A6UyEx7Gt0p7tE2skhpGynkKQc5FMp+VDsrENMUrfv3NxcxPS0QkyrV6sxpLrKidhdBOXm9p2b1CEo" +
+	"lqIU248haC9XzPYVIkWaHB6MBpvDRzEB6DYDMKSDTW/RewMTDDq9RR8PJu7wpDbTmvSPiloR1qhR2Aplo/6Np7chbF9FUgRv" +
+	"YmblNvP0bJZrn4a/iUhS4Fntuix/Y8Tw3Sar1bQ7qWj3mi781YZzW+Yte5tZHvX2sryWcxuK/rTkGF4giUB+CEE0mMKZCCR4" +
+	"kNLzETVqJJVwWqsNL3Drm0TGYYBWLNM5JWpS3HsXyGWpB6DW6UpyzKMTdDzM/NWh3cKk6wCf7bC3Xf59Sw1gir8FOYaHi/hV" +
+	"lmCwhZsikCVFp9VJaS4SJMWcJKyFMr01IA8J+fLCe3GTIi2dGRZaASbjkvqSY8sxaFp+v5w5vuMzgOtJC9t1sHscJoc7eQiy" +
+	"5IiBWSR5DTFIgmQIyTjBWe4jeJ80JBlwjpzCi8hJyOTHS14r/a70ChvHJPFu9mUMtTPbyO/4R8En3oOOJswKo15joLPk/x4n" +
+	"v+NG16/z73FH/tsr6ZmX99/ePNE2bSW/4x+caehQS0U7lAqDBc85frwn/97160KSM//+kCfpmSfaj93kd9xAokQcQgFgZlSG" +
+	"PfwmQjbhWcwK+yj7KAFmNfkdx4gwwBhUjBlH8xt5fiOeZR/FrGBWUB/63eR3ZoREKY+Qt0RILpW3hGTBt341+Z3pI7xvJbxv" +
+	"Rd8joGur3Dvc90grxJo0KeiWTIPCRE93EC4TMPF4UUHHgjMF/foVnC7oVIAXcd/zsYW9eTUz8ErpBfZIfn5pezb1in0z/qV3" +
+	"If5K5Kd92X/c29xVipcDm05Bd3KaFTqbwqwQLh3gb/nxBZ0KThf0yyk4XdCxgJ8gxV8V9sa/2DdfYVNL2+fns0dKL1xhBvLq" +
+	"3oV8LNUTVMD9wDUTPHwSba5xMFxPv5XadGAAm85qqzVgOI4cVq915oGmWdYGacEh9Z33QRrJlwXk9Q5ka8FlaWhs+zZDeheO" +
+	"7T8ou2N0VFR0x9ZD6VMO6AszDHq9Ka3Jzj49OnRq5HjoUC/jUSEZD4sKH+Z0H92jU0p8fGK3HqNHTZ4+SnyKE+XSAk3hfuA6" +
+	"C21wjfHJRWp1DiYiqhzsW77PgeG79BswtrD3kDbtY6OiYtu3G9h/bGGf/LbtY0Ldviwg2zqQ1wu+lKqD06zZTQ5mtq5XPyS4" +
+	"QZo1q+mBzNb1IalnXHx8Sqceo0dNnzxqdI9uieJT95yHIguPMup1aJJm0usNGY06dejRZ6fjgdqlstvSUi4cGVE9lIUQaMBk" +
+	"04uh8p2nF1gcZxs4guXrTbZwZ9EIx4WIYCCcP6KtFNhYWkq2FVwm77sHxfXo/vW/17r3iA12LyqShcT26H7t36+794gLlkHD" +
+	"ywV88rlOnZplhmncYPZGmO2mNjRv1gkCyNSNZKpMHZbZrFOnc507NWtuUOHinmRC4cOeHcbnDsjLG5A7vkPPol4dJ4gPEzr2" +
+	"eljY1WRqm9Xb1hAGks0Nbb2z2pr4j8hmGJhmpfcm4atVLLue6DPZIzaV2hCVBXRSvUavk/aEAQWX3Vb/M/xyAe7SExYWPix6" +
+	"WFg+dpf9J8KLcrLoTTbZv7Rk/hnutvrLAgd1YgIRP4X3ceI32cR0jlyke78sgP40+b89y3N7WEgmCFgeFjpxUBq9xTNedFJw" +
+	"UCqFfLeZ//zcuYBsgwEFnd00/0zvi/0K+xTCQoqnT+GQSjTT9DaT3lJOg8XtUb9+/0x303QSaej0z8/Yb8iQwj6O/PsUCrbP" +
+	"yQOHNJQCm0kv5cqpEHFBvnRWv37/TusrHfdfVyc9kiIHXop0yE/lZDnRu/JGfbrLcSrMCoOs/b+SyyKiy9JnGzfiuIeO9A+h" +
+	"h323K19e4p4os8LJGOCNG6XPnILFcWQf05vsK5eqkycxX6VrztSkKyx6qU7W/t85Tj6kUzZu/Ne3b1/YUc7CRftuaH55yBDH" +
+	"vIqTDgGXU7q0TVFo9DYTYOkUp4T/nbNxo/RBv35MbrmQLzK9ybsirgqejAipdDbXf2YHXoPU5PpvSMZOl/8ypjq57pFb6T2+" +
+	"lnnc5b/McmGMqPSativgzalxoKSRXKobSu/Fd/gGDmRl4jt8o+KdpJHcJMJV/ob24UC4Ir5D+yreVYV3/cbKxG/4xnPT1QDD" +
+	"RHNqNlWkmd47+RDeueRX8Q3fqPiGb9T+jVPXDIP2VcCgfbV/49QvhsE36o6nZlgmmtIslg+9r5GPat8qy7vWdDXCoH0V6dG+" +
+	"6t9q47+m9PhG7TAv4l+EFfkXYalMXsTP82HwjRfDcOrnw1bRjxfCcOq6w+IbdYfl1HVJw0TTNGJ9pfc14qn2rar+1JKuVhi4" +
+	"Urksn5ce7Xtu/nXGUzMsE10BWyGb2vE8HwbfeDEMp647LNr3YhhOXXdYfKPusJy6LmmY6IryoDr1YvnVBFO5POuC5/mwLvap" +
+	"zvjQvhfjwzdeDPsi+VVOU03/6izHusDiG3WH5dQvnwbtqzssp375NPjGy6fh1C+XlozCgWVbxD4FGVXxrmp/xfVb1f5KLelq" +
+	"hals/56fHu17bv51xlM7bFX7V9O3qvavZpjKNu15eOoGW9nmPB9fXWBrtmV1ofP5aSrbv+fLD9+oLX1Vm1Y3+T0PX2WbUzf5" +
+	"vZi+l8Nb9zRV6+gL9fCFsDXbsjrr5QvT1Gxb6qyndaT/1fJ5mbSiPaI28cVyR/tepB91wfN8WBf7WGd8aF9d6Xs5vHVPUyHj" +
+	"usixLrD4Rt1hOfXLp0H76g7LqV8+Db7x8mk49cundbG/dZY/vvEifJVs7EvLvy740b4Xwz7HTr+0vr88Hy+TtpKNeel6UJc0" +
+	"+MbLp+HUr54W7Xv5NJz61dPiG6+ellO/Cg4mmuIQ+79MtPiuGt5q36qO/19ATzWYquP/F8jxefnXGU/NsEx0BWzF2PbF/d+a" +
+	"YfCNF8Nw6rrDon0vhuHUdYfFN+oOy6nrkoaJriiPirHVi/u/zyvPuuB5Pmxt4/+6939fqG8vSWdtaarpX53lWBdYfKPusJz6" +
+	"5dOgfXWH5dQvnwbfePk0nPpl0jLRFfbIaRufL/cX26O64Hk+bM3937rJtY728iXprC1NzfpbFznWBRbfqDssp375NGhf3WE5" +
+	"9cunwTdePg2nfpm01exvneX/PNjn93/rbJdfiB/tezFs3fq/dbbXryz/mtPWar9fuh7UJQ2+8fJpOPWrp0X7Xj4Np371tPjG" +
+	"q6fl1K+Oo2yLmIaMejF+MurF+lYXPM+DrWz/64YP7as7fS+Dt+5pnLKuC966wVbW2Zeht25pKuvIy8j5Zeh/tXxeJm1l+183" +
+	"+eMbL8JX1Za/nPzrgr+ybX05+ded/lfL5+XTVrU1L1MP6pamZhv+KvWibmlrtqGvUk9ehr//W76vgsOl/1/ncqu7vX0ZvHVL" +
+	"U8P44KXL5eXbi1fh42XSVurjvnT9qUuaGsYGr1x/6pK2hr77K9efuvP3f8v3VXDUMP54yXbo5erT/6VevUx+NfT9X7GderX6" +
+	"9up8vgqOGvvKr9iOvVp9/L+U66vgeM4Y4f/Yzv3f6uv/i3KvCy7RH5rb/hx/aE5iv+T0h2aSN260D3iuPzRXXIGrBn9oJtnp" +
+	"D22/tHEjs612f+h7CHFh3PdIgZCKbr127mYRNrLcg787wF/ChpPTBZ2YwzTMRGFv+wjW/Uqpgb1xpfRvZlVvJOIp+48L464K" +
+	"eMq3rjh2rRjuOVEUEI8O2FTYm1lV+vcV9kap4Qrrbh/Ru5C/TPlqgWawuyVvoWDUECGjY5eHXNjmADqpY9uDXNwWwSTqpFD5" +
+	"XN3yA3VpdJEJYwbmdM2Ki4qKy+o+GPoXfNm1Xf8u2XTbR3aX/nlNLheQb9un1wsJDjZY0lZ1bt+qTT3HQ6uUGfFxvXuOKABU" +
+	"MKJnv3jqEO8WLrwpo296x8WzUQ8KIVOf2ahj9pnWXRs0aaI3NHLe63UIVealQVVerDaTDSrxIpGWc6KtdDRwhAlMeOyIcla6" +
+	"5rTrdrkA+g/uXs7K9tSUVmkWQ3BwSL36rVu177zK8ZDejnxT4MpK77hwN+qVHt+vEitSnb5Jg66tz2R3bNTIoG/ivM/Uk5OF" +
+	"CKN75BibK1EjubBTnG6mibAoUmzeEARyqVYIDK5ROEKEVwlQbsY9mm2Li9nWZPNmLEuYlpU1LeGXm+v7z0hNndF//U1QPtkw" +
+	"XNpiK/vFjibSYRueksfpzZg/87otK01c2j3PJWK5KM/KdMhtEQq691GrUail6WCBGiKVUzIsCkoQfrp5c5PXY+O2NeP//vXm" +
+	"+v7TU1On919/89dESlMiKMG7WToon24YJm2yozRxawvp8A3cby4hzPO6L2W/WNYNIcSi90kyl8n9h7CwL84LIU4hwxbgZMAo" +
+	"QMMe5BGzsXQaO8uuY8BetpGd9RnOI5f4z+CnvfCTFJIh2b6JzSchexGD3kfBklHsPWGPnTtCRhpkRMAmGbVxI2lg/5fZQVEF" +
+	"sxfs9fEHZBu/BSv2YgVC1dPSmibDlIT3N0IM42b/d6OQsDQVMAyAAfb67AX+yV5Rlu+XnZeM4pogDrkhpFPQres2ULyPr+Gv" +
+	"+ejFcCydm8Efwe2fzcDZo8lO/FEtaUzlaZaQbNc00JdvhhC4pHGkcIC7wCJXODeEjBoZKGjAA8koPpqPEuDf2nkTZ/PHxEQ3" +
+	"SGDVNCqLkMbiRM9H4TbsgJv8MZz9bAZuzx+5AXeENBV7GpGMgY/F4CjO/YsUJ0JcJsciFUJAd7vQSyNsuaKXBa6vh5j1oCa/" +
+	"rCdX15Of8TUcx1+hV5H4g+OKEHLBEyDiKf8nRJIt/2eBaet3r9+9/uRJ4efdCnSVUDrRIgalkGGSMq4UMY5IaHqFvvaojuGh" +
+	"bMUWPjY03OXgPgUoyBPwIzenTRk0ZNCgIYOmTIPQaZMH5w8alD94MkmHwWCeMaNRRkajGTPIRbKRXHQ+4QdkFXMhrf6YseSX" +
+	"Xa+TX8aOqZ9Gn0D9+i5Qjx1T/88VS5cdLPrfhweLli1dsWLpsqKDH/6v6OCypZXop+f9ZiD0KrRzzhKx6BVs9MtzMBZn4Lb8" +
+	"8fX8cdyWa/LyjMxow48t4sfipUX4/xlPOnoIIEhlYJLBK/HUCbbD9gKSA9uHkRzSfzhsJzmvxNwDYoaLhSSZmCfARWIuhEtw" +
+	"sawMDSFZsjCJt9zkWcJ9gpA8wrMEIfoEjxGSSz1LmO/FvVUki+km8UZBCAkn+qoMFrNK2NMobEpmzBoDI2x5fCy3pEuD/iE6" +
+	"6fKFY5VzfazpUu0/JMiNPm2eVYw3FWHPPfMnLfeZVUy8ivgseu+C3+iCn24QrJIH3bnnko/68V8eZQik3mJm9JXnr3+5ozLy" +
+	"n9qZI1wqerofGjnz/Gb/0yLiR5+q8gVCJkYNJ2ZgE8jgLEaRBHyZYtdBGLkW6Faemy/5AaIChdzZv2cV8/dLtp/khzvywn4l" +
+	"20/i1Y68cHle0cjszI2yZmKsNp1E6pqrU3/ErEHliGAq0uC5sMmg8JbqIFVqUkOvClK8OzfJzWszRBQFSVHbIhs11VOiyNdR" +
+	"Cxq31JMYiWJUuIyccVAHSb6tRm7eMrarChrsXjBpub05fK2Ibz04v2W4Qy7vS7xRspNSq3CgtMlmpgfUqqXV6TXTLo5KDDUm" +
+	"EmqqH53duXFR0huNOw3IGuDjtmKBQGpDN5+OqcN6t4w3RUpXLGiSqKJE2gvlCV3GBPdpefp0yz7BWwq6KsgOJ6FZfukTNo3J" +
+	"NnswG/fMz0xZ7ipLBumFE3UNDI0o5KoteoW+gkSYf/q0jxw6kAtK6aoFo5Vz5eYMN0/wIx94uG2YJTw3wnsJ/wjG4EQ4vG/u" +
+	"1CVUWf6ynyJlh6bTh0p5xoh5qiRS1xwdvT5XwdAOX0oEzb2HMVDtzLqR1LNHWm5uVr6X28oFY0Ri1OFJuZPm4r0EpmEO3t83" +
+	"d8pSSsHXvm2mkMsju6jIGQdRUCJffX26UwZlAj3xIj0g5qnT0kwNYZWIozEH5XR/tUgezj59mmwXVDgqeeCx+VMGvtdGy61a" +
+	"4FAmL0vDDe98tDi7iVhq+DDh35pVjM3pgdaVXy7fgftQjaH0fap6SC5/pye7BC1nXGTkgZJEqrTWlAgarJlxqoq8XLNrkBvM" +
+	"OnPm7blTzs2csaqaVosV3Ck6+gYfIZNWfLl85ecrSJxDpcFWrtJVBChWRMalzuupvbFV1huzq97YAoC+MCjMCyHAp6L8vOzk" +
+	"tqfbhhljHcV5VLOJ3N00ZzMm0MqpPF/wmPxwaPrkZbTQZHgc0cIjokVQ9gHJYuwSbxTnrFvmZK1aYrDQWBYGV+0pH0pWqlba" +
+	"P3pOndWlq1Ta9VmQs/o3dAsi/+3vSu5CQNf94OknXb7QUbH4J8s/WrKAHThAwrdy2qVtJ3Ogfkuon3MSRztqlKstNDjpEo+l" +
+	"dqVIPIfa1SIqlxbculXwurbCEGmWj7h7u2C7ptwqku5bJ9rthUf4P501+tZ2+uJNuF7NDkc77T81eQqDIqWaqghvTS6NQFRa" +
+	"UPzwxcO9XFSkQ2r28FGRzhaA2StPbr+FXIBWn59SQIyzJbjn27z/1+RtSP0j2d5aJKSCDlO53fMDs0JezdopzAp1JTlEzBn+" +
+	"t0+FEHw61csaPtpULgP70giQQip5mywxkZ1OObTVZfb/GlqRC38lMXMrtRM5kv+QTuhlijFkRfbFGKICFTQCJ7wpXdW0uUMl" +
+	"67utbN50jHgPD/h09pZDEZ8dcdxIB2/fTvHn8AMEHp+P36LX1IqfPMDvV8fPf7F9OyorQ3aUwXmxH8olaKMQa+N9uMJlskPl" +
+	"EZ4f0z6FV3v4EcLpe/S6y3uTV3v0BelGv+NfIVwYl7BcJktHU+4IcRqwyLAGLMA1FwZh/cQRVGYJfFmCB5KVZHUJfLkXvnSO" +
+	"g1zS6kADCgvQxO+zs2iq0mnMjkz4soQksL+UkIS9JKEEhgu0lrFcC3YvpYb5oOxduUQxDznfZ7J75RFe7Ql9Ox+hivecSaD+" +
+	"T/ipMrz4Hl8U3s8vf99CohTx488qw0u86Psygj925MCg98lvXCaXjQJRfdSYjqEqQjmVn+ChCwZhZkFV40fnvINKJgiAy+y3" +
+	"o3vubJmE817cpd3m7n13ds2dK/PwWtIla33fmj6xUuFbH/t2JoeKrv2b2c3TWAZLGrbtTG85VtKwDWw+1r5ZfZbFkgZtOx7L" +
+	"blafxaykQWumL/mZPD4Go4pgJPp/zA9Y/k/8lH4naEHOy7FzhKwsIiuOgVLUMTcuk93viFEqRpUQolqIespkz+8A5/hfC+d3" +
+	"IBlYhUc6JQg72+8v+rf9/iIm20U8z8HnYLUKQv5XkQMXdC7kUXyknyBrqxAhRhSmVJiCEu4TaHfORbCOezpq0YaATSetqjUN" +
+	"28RPyxalOmCOtNOa9vViRKmmt4mfmk1vOwxoPidP2nF1+/qxLhoD6461b6abndKmCxUw87DRLbkga92sFCrdsGg371jmYaPb" +
+	"PtX0pYIHixAhpq48OELriSyA5WVYoDri5MCpIy9goFl9J/Wu8mfR+/wcLlPSWZjFUSANpZ/SwgkRiePorYzeOuzZHSbQYZmC" +
+	"7LedVoqdxZQegYQiSDx2me9dVNr36uVj5Isi8iUzrajmPFQCYlBY9BqFIzuLXvN+ZaT2O2QTRe3ERn4qKrK7OTOC/4qKhLpa" +
+	"hgXZ+6NgZHRKPwFca2MIFiSs1zh03lEbfVxrnJdaqG/fC1nPrKQU9d2wpEGbTlSEEvcGrUlBEbfFVQNekgbGAnUhAlxKtSYK" +
+	"KkqR2isllynxRP5IjyKfl7/KIWxR/FzzvpQEOee1pHP7TT367ehGSVjcJWtDH6f8nSWCzxxr1zxNSg1Qp2PZzdMksoZtoLWz" +
+	"LJ7Vd5aR037WkR6ngolk1U5P6XdVVaNmgpxklJ51kuaYM43lMrlrr6R/YHTVPzbSifi/b4qKasT9KvUHjC71h5lWzkZfR/0h" +
+	"Vi6Te1wtDxlYZOBkBTS158GsLcffFRKOkC/5veVcfXEEEo6gmvNRVSBXlGcJ1eQkZsisLcf5HcXJm8ozbSNk6ujzzOEyuQix" +
+	"z0OxiYgdHSax08SOol2dL0vVYmephKxG1dOqqGgxTfq+2FWy9xPSjyohCSX8ZhgJw8VOk7MPNIdrwUWIfRryWC5RbCt/nym+" +
+	"LyPkjlyieF3Q4Q/KSrhMbrKQlzfNzakqNIa05gMHqdvJD+ws9suiotK1R2BkEYw6Rh7jekVFqCYcILS65RqR6SilHPv2CmGy" +
+	"XxY9+xk0oDxGVhSRlQwpEuzLe1wmdxExSCrSIpa/Q3LvuxTFdubAMRh1jM+mf+3ry2ly1MvKeATpg0XGVJZ/BUnMAZp4BfEU" +
+	"fvguFXQ5ygMpuEwuEgWgMISEkyXo2aYVB3rZ0oFGR9PqGEcZZ/Zdn9VliZeHbG5u1519u29u12Wxt4dsdm73Hf3AQf8nndum" +
+	"S1i2QWb2sfI7e3NXRmi+iXXLV4ZflG3pVKa//XWB2RpzzqvMM60jG7hM7itBhuEoBiEdHYNY9BpVDXQ4wsOVC8BRVFADPZyk" +
+	"khzSi4rI10VF3PddWzeQsJL6zbKPd27bQIJZtn6zbHszV3m8Kk0yXEeSSqc6FeIFVFWWFV0jGsRlspcQg0JROEIqvUYPjphy" +
+	"FnN51E+tzqwR48vR5lGgBzdpEJ0c4MuSpU89/JOyO7zTPivZz+sJWcb4BSRH8bMEmJ5FeDIbHNOsWe/99cy9ks3m5F6JDfb3" +
+	"at40Ntj+sEi0F59zmVw3xFBtt9HcEceCBhkt4U6+yT7SGQ5CD7LPfo2Jgh4Ti9ij5G6fPuTuNSics3cKzKiEh8ZV1+gtCgvi" +
+	"EjUsWJAxnFYc6MFE2a+RfdADDpLOE4uKWOk1MmPK3jlkwTUI6NOH4iDXBBweSFul31xBU7XOeFXqYLSjF81KqxCJ0HPzsCDO" +
+	"QW61/nll2l1yqMyBaD9kXCbXRtAzJUI2h5YpEMdylaX6C/GBRxBAPrKfYFpCfbNDb4aRu3Ed/rkG47rv7Qrjn4fTSXG4Mbzc" +
+	"vkF9pqX9BPkIAuARkZfjvEYWdd3bnSy59k+HOKccRgs4VSgYIU5v0VMZ2ER50MsEFRTDE/IVHOjKl+GZk6caIZ507IpZfuqU" +
+	"Kczkqlw8KhpbtHtsUtHYoj3juOVVWalTvhVc1Zov+a8yn5VzrcIs7WtN5DK5xSgI1UfNEeKqD0fpUMk5vK59sJoOqgqhOHpi" +
+	"MqmzK7a9e7eVMo72VbM29KnyjXbT3DiJ98Zl2/pUFVlkpYFrPU9JYw5jOnbddKyd84PQf2MxI2nq2Zz5pbJY/1/y5yL8V+Wv" +
+	"9LvKZfPy7FUpP4ze538V+LOixs6e8suPf11K7lXGwFVLDcLrPBa+V7m8/t/w41pSr8BP1VJ6GXZqqF87uEz2sLPPRF2dFKCR" +
+	"MZXm6dhZzhHj+3RMNgr+FH7wgSrjxMq4jGKnwOKY9avcA3v/GFlxDB+jf5khrkN2hNHOsv9xcvZNJBEiY+roP4XKJMMmPTOg" +
+	"M19wEK/u1OswP5xcxWuK8Xw2uzdvOItv9IFCft153gBt8Y1zFA8wZf/jRpXj0StsYJOBQi+VYXyEHO6E1xzgh5O9eP5hvJp8" +
+	"zRew2WRBHwEV/zv+/BxvIMfxDYEelMLJuWAHHin9p+dsMmxTsMc78cMP4jWdoSP/VTFfADF49WGs6INvnOUNvQeewzegLW84" +
+	"zyeJ9KAUbpQTj9EEJjApGJ0M6/T4SCe8+gBf0KnXYbwGovnhh/mJG3sLWPqQBXjoeXyDHOcN50T5JKF5XDE7DcmQCiEjZYl6" +
+	"f+jpbDRDO/IqKCU2+B88oifhEC1uxg94+hRvezoP7GAnT0DhOBfnr9mzyd/k79mzwR0hBALe7eJZOiJWvYiI2W3v7UhLohFA" +
+	"GkLsJYmbeOYO9d6ANPgf/O8v0oP1BDthS/9kPRGFK7vIXuKuUjgQ3D0A0v6GfX9RnKxn6Z+EFdc8KsobgVDMnE9F8cIdlzIF" +
+	"lzJFYlECU16AcMel2BC4lBsyCsWFFzjLKKVS0SBwKRsEQpEAU1EUDONSAOAif1fpV5J5FVEjVC4PiRtNB2YZ0CCxYGCu/0Vs" +
+	"xPb334II4X+EBTtuSsUorsmU/ccdxdeQB0IcDb4seGbYdGy2EEzZvoKVXS6Q0vDIEFhE6j8SYtIOwXHcUc4DeTjiZ9MkUhOw" +
+	"2TQWbek/zCgaY5XzIOMLH5H6RRD4qBAW0XSNyv7jFjjyErLS0KwAdtEIyKzMvoJGohYz+6iI3KGxpQUaG+E4boGYn+jiSBNK" +
+	"TbCrA3UMZEaV/nO5wJHdnSL46BH1e9tXxkpMXLhgK5BOoVcY9Qr9Pm76swfssdJsuyT0v5tj8DZR36vBQjVYRo63VYLFtCQ5" +
+	"PTAU3JvCDeamL+IaPOtIwVENsAo9heXeqwqJEVfGcnPL4WQc/gDb+P/xjfFZ/B7fKA8X4kJ+ASO3P7U/RdXgVaCQMcuw1QmO" +
+	"1fwC9ulz4GX0rKll2Iptpe4VSXChM0FVeL2MXcYOr6AHqyuRgzzL+eQEjzoF0qAAFIIMKArFoSRkQ4jTGCxmi0pjEC7QGCwG" +
+	"569wJz6ZNYZyGJWGvpNEfDaI/kc+fvtKCfPjoEFX3r5SQj4efGnw4MH0j/beuDFQ8tlncPyTT+zh48aNG8cdvDeG/o579tGY" +
+	"cfec3+CLcWPuibYgtOwTSZjEgFgqCawPZYAB8q29NVOCh/Or7WqEsAuMDKEA0KtkrH7jP0zYP3yhvaw8wWL7UaYd9iQhjoQY" +
+	"zS/L5N7n7IgT4uYLTm0MrbvwGNuEEv3fYLwNb+VzwULWwjB8HYaRtfZ7TG84RDrZd5NOCFxwIJuQdr6Y5A8XKIQABZe9I4nm" +
+	"FlI4jpYowE4yFWYzHMwmU1vgB/gB78v7ijznESQtkkRSqrRysIbqkBwkoaaB8OVD4X+S8JAkPGzE7ofodevI16Xdydfr1iFA" +
+	"zcu2c7wki8pKxiiYiziB/9I+UdLWXmQvgkw2FCHsAkNPGQCFjFMw+3gOl8I10k5MwH1EHtvnkV/wF0zXiqQIaArmP+6qMFMm" +
+	"uOrRf6KDmMVsMVjMb5VAKrlAL7zMfurgwYMHuatEumLFCjKtCMeuWLECOfHAHxK14PfncNIT04tOe9Bp+/Y9wn/bt+NTK/iv" +
+	"hKQ4lb8gpi/7zkGHQaBD6ArbrDaz0PcSyBG6w/QwCqHP9VYJgNzDIJfjUdC7o1JBaeM4H88wuQJG494dtWruKh8LIDOGd5y3" +
+	"hiUJRQxmGfFJghCm9LJ+3FUkR0G0fiWLXmUxIBKu0zhP8dfoNW/Bpltjx40be+tOycGDJXemL148/Q6kcldHj/nuxujR/H7m" +
+	"8xUrvlw1f96qL1aINuc4GcR+xl1FAcJ5v8GgUxmYeIiBch9fP9ALpz2CQszGwCSnjUh954ODTaYWfJRbWK/5xROw6f6t1/gL" +
+	"d+bMmzcHn9KumEbyYfq6Afxv59cV/Qne3PCvry89idvxj+fPnj0XsahR2U42gj2FDCgSJYpnvBosYs/VJBx9lAa2SiMK2k/1" +
+	"AbMlRUq9WawNweH9xJzfm/rG5Ekd+3TNtdy+FbHU3bPNgq672/Y60OHLYXO7rjfvT0zeSm7Yeizay5dd7BGXjafzM4sG9W8w" +
+	"NXtu18mn3zvr6dk8Pr7IZBrzZrtPx8Y0VvqeJIXx3xyPIrdA4xlsnhwqlvejsib4A8lqFEgpNavMgmObxiEgU4SJMahoQSig" +
+	"Y8yhjYdWnt7QdPCBA2dbebj33nhu4kRuGzmPzfzF5cRn5MLsTe095eRrPAYHLYf0sjJ0nJzlhnJX5RLWRvNC+8hddpNEjfwQ" +
+	"0hsU4mncQix2x8EHGhrLnflz76iR8zbv25fWvsPQQz167tmDt72+7cxufr5EzW8bmJw8ofAB/xdpi4DiZ29yV4X+L917YBAj" +
+	"u4MZ2BYbjqbzk0tKcK9H/MAiooHG+HM+ibvKL8QT+JYOWiIkappWZVY4uggChn374PFX/EPYtnxvMkH7JGr7ELjN/0mak7YQ" +
+	"gA282N/pSM5yHbirgnXTa8DhVavPZ0PsxWzAW6VvMx3sH3BXN5WOpJlvYlciQO3IXTaYzamaZhCj5Fcx6r32a3gkv5/N2W//" +
+	"jnSEgP2MEWE0uOw2+wv3G5ILJWQxO7QJKeTInKwFudJ57Cpm044cyfxq7R/kc0j6Y+1XfMKVEye++urEiSvsrw+exdyfMwMO" +
+	"QG/oDQdm8IXkNnkXmkMQBEFz8i4SZDmIm81dFU6Eo8ejKOTlbudApfIW+EA36A4+Jz7LbDgxl+wpIXuOcFftbsy/z1aT3/8s" +
+	"WneefduOWPTsJ6HueSPE/sBdRVjwBlfpwQBmlV5lxuP/IQro9zlBpyfjvpPe+xjft3fkrpKZMB0hF359kC8d1dCjVmg1QXJk" +
+	"CItAAqNyZSj4HjnS/OqaPyCZXPpjzVX85eUT71y5AqPvP+Cu3p8zg3ThW5IuM2AYrk/54z8SebQixLVmJ1MeIZESBCqr4Gxv" +
+	"sKTQIzaYk+T6T2ReMaT91+bC+H07Wg0yqpjh9tVsjr0zvvfT2XljxtTvHK0Ryr8hQm4h3FNqtWRA+5aCCoHKocoiTmooJW+Q" +
+	"B3fJXfLTHVJ8AvzB/zMI+FVuzZoyqW3WxlZREeH+9PRKTv7sKXe1VMk+LlWyES0a9e7da8Tsidb2kYEIUBZCkl/ZyYL3ijjh" +
+	"Wk6ylDF40LwDwKxiz2rMHSeM37u91eBwreTS+998+z75d+/nl97/nFnQtWGOg3w/tX01s84+lM2xr2OG2tchjPogxHqybyIV" +
+	"zUElN1rNyRq1D9A9NAZGarHSw5kiDGEa9XFIVsrX7xmzEdKOkHk/nVofa13zPkRtZbIgOYn5d2OfRiSBOVi6HSd3+UAd2H5E" +
+	"Ssp2I9UthFjEXUVSYWQoHL7AlPEFJSV4Df6Yr8dd5bcIMj2EEDOSzakEN4K/u2cPDsAsb2dziFnQkbyy+2xXNh+5IxNCEKZR" +
+	"JDtOuE8WD7Q3SMNMEQrqdadxOJKaFXL8qH6Xrm2zMpKmjp0yZVzJnuOFkyb1WpeWkp3dfCDz6Uq+Y36fH78Y1WvrnKXkG/Lb" +
+	"jg2wcQuoIHjV8MBduuyxZz4ZOxgXQ7xAJyq7i9tJ1EiHkN4ioRMR2vL9GRbRkMH+wb+EJYO13dJ1zft9cXd3y1aH/+d+2vDp" +
+	"GVywsf+WnAFflc4S57UHlt1mGXYykiIfRE2y4DmYbEXKUKUch2IkHAqtc54MY7Xgj7tM7Nx5Umf+9b/g6D//kHZ/4V83kb83" +
+	"K9XJHQsndYjTyKF/v77ze/fG+bSvwH9DPoZ68O3D+//LyWqR2qdfcpNWvRAC1B0hfEu0RSAMWsQL/8RPgFvCqUjRbE7p9tVQ" +
+	"uAbi1gjrXmU/lynQNWawUorHIcTnif0NvIVRcDfFM3+FKgBtyelC8ASvCeQ03sI04xvh9+zCeXgTiZzN5p4Kpyxr6HKCUDbC" +
+	"YWeCh60uGIRmJx4LC7wmxzIDbZkThAPzqOoz3w4+0WXtDmvSpuFf9MmZPfitiaPmZGjrBbfoM8L6v1ivuLCCsSO/Gry5aNLi" +
+	"EZ+9Vfp9vw+43R/arDPG55rjDX4JQwpKvhnVQzlhRKsiuald6qU4d73UN+GL5pkLpo2YEuIb996Bj+5EiPZdzm7hriJfsR8V" +
+	"Fo9NRlq8dLlNA9oQCAY2rf8cQ4eh09LJ7y0GjaL2ng2xvzl4yO1JK8hJAf0fGZvYlUWbSkd69bs4opUgrxFl05hf2BwUghAY" +
+	"bFZDGMdIzMlWczJDj/cyUNseAGZpuDlZynx2SKHLmUn+TPfLH/tRhz0m+UG5IqH99/MPReQf1EKX2ae1+NDMgwcJe3gTuTVr" +
+	"1hyYDUlMwtGjRxGgOBLMAtuP6hRItMKBeOE2s0RrppOcETbWEGZTmRmJWUt/1nj10fn+fvo+eabe5z73/ZxDOkWOG9k294x2" +
+	"4RvjtYOW/TXwIMQVfrlt/o4D65pNgZbjc650OFLMTOO5w/gILkUIUEsSzHzH9kMGIT+NmjWEhZss5vIz7W0qK3W4M6gcvLUO" +
+	"PEh+PP06+dtjv3T53Hl73gztoO0z+1bKgZ5FakiceUYDG5eWHDn96esHe7TvNXHm7DkPTsCgw4f520z7I0eOOM5GLfuZDZG8" +
+	"hmSCP7BVykoZiTk0QzhXKTQArEYVZTsDzFIJ7cWak6nZjPABlS2cYdr63iS/G9J9l0FeYLH2ww83k/fMKYHQl+1QGLUGy47K" +
+	"ZI0bvHW628ohePgaaR+QJjAdjvuGfkIiT13z3J6XC8tg+k89YaBaM21B+8YplhaFDY0qE1kjCyCzEQLUlwQzP7D9kIKWM6MS" +
+	"CrqceebCqdZ9df0mkicZR4ce1EH3mae1Q0gwHoT3Hz7Mz2aOHDlyxH4ViXINkljYfiiwBjwCLoMoWKbo7bb9tX3mkLJWB/IP" +
+	"KaHTtBI1tJh7VrPs0GRt3qx/+5MgPJiit3/F9jsiZNCBiT18mO8uyhKVPWGNkvnCOf8IRL200a6/TsJJDGG2ANqFtZg12jSw" +
+	"mRkh53xdv1nknxaqLmdH5igPGsgvjyed0eT03f26zzG3nGzvbSOgy6wzOibm8GH+0jxIbTKN+adJ42L8NTS795AfZiU9jh4V" +
+	"8+6PkGQK2w/JUFh53matEVz5MzhUljLNtPHrOZN818p38HQIAHdoPv2sZsmBSZo+s/59bW8A2bnpg9BZB/1g1MxzTOzhY8Sd" +
+	"KulUJ8P2ry5cKGaOHD8ujpemI8Q8YPshH9onrVJMfQ9O1vaZS/5LOND5kAZ6TX4/iCIgK/HOI0feo+0mCWaxZD6SIf8KupNt" +
+	"dJghEMuYVSKiZoF5s8j9TO2grc825r7TmRzZfEY7s/HpBH8YOv2MViiGvw6f3covxCvfK2ZuYwn/5GNByTFqiZBkZlXZJBtV" +
+	"DnlYDRJXfbBKuXO6vNfI1Uzf3EkQOeuwH9m+f4+2o7bXcHK9y8FJh7WQP/24GoZvKw5gYg9v5f86fJhcOnu2GE89jA+KqvHm" +
+	"m+AolwFlT9jWAn9RCIFQu6hGgLDHM9ymshoZ2oc3hDUEs0YtWDGdyqxhmpNwZbr/rvzhBZ6Ae2gP7T9Nrvtna/H8MGXTTu+N" +
+	"GFec3Gb74E2hse2Y7+Jmk4C/0hoOx7v5tO2NGkkkpbjP3mEAY2au3TkhY8p75Mo8sZxaIsT8IpmPAlzLySTXh+o0BqFzohLY" +
+	"Z7odmqPtO4v8M/jgohCYAH3hDbz5bpAPdJvxYaBY/GzqFZiyY3L/xrvIO+OOiLwC7QNJ8th+tCSN5cYx2eVHEDj+jpQsOa1d" +
+	"e2CO7+Clv048NEXVaSnpe2jOYR3ZO+VAcXExc8R+5fBhJk78W7qjuFjELdhin2q4DQ6Eiw5N9h289O/Bh5YUuyAp3eHQURLM" +
+	"zZPMF85WNjBiwUsq8DiwMWYVw+WfbuZFfphV4tfgvzEb1J3eyQzIjzt3oJVngzGlfYe/3XcMCca+2XPOwckF6+EaObl8LH+7" +
+	"70T8PT7Mm+zLnHLoJpkv7A+qUOAqlZBmiG8PaPtOfQ/Imn5Ws1SofGTwIQ05u+S0dsGRKb6Dl0Iyg3AXfsfEiprn5E3Mh3ks" +
+	"mS/ssaaUu0rZdrX5R8kDffsu/bXLW5NKRJxsfxzBX7N/5RRrMcKoFwlmbrD9kFSwjoJOhAv2ik5a6CT6MCw2pVaGiffNmUH+" +
+	"7gWfqN8I4hf5DV04/WSPYsL/siZuSpEKOs06FyXYqXpttgX8krn5o5NzSyATUpiPjx4F8qkgFxIs6cu1RkGOMqxBR0xmFaMy" +
+	"cC3J3inH1QLRqw9V0hP928qCAe8WF1fVk9hfyH37EH4OQgwaQYIlBUJ9Vwn7JazSCLHegVDVJTq6VczGGkxSlYGxSSp6C9Qu" +
+	"Mo288pbxjXQDtz7BH2tHdSBfNtZMn/dw3h5f8ueCfLe279TTPfzzbCTtPSSdPaSFhHNHopjhIzAcIdJDh+FZMRQTyWEoJp8X" +
+	"F8elkVj47MNiSGLijx49epRcFM+oI8HcHsl8pKOjOgOj4qQGlZkxCmVotJkZg4pjNH3eaZVI5vgfGflmC5jcIKs4PR4m+JWM" +
+	"ONGSzBvJF+OOMGfyr8xI/t958C7uxE8mBwrfs7+OA6c56vmvYl1xsf+CjYPh0GXGe9rFb03SdJlJ/h54eNlBprhCudh+jnJi" +
+	"gc1B8mp1TWXA75EvFp/Wzj0wznfw0u8mvbVk/AcVRRFvb8RPc9gCEsz8y/ZD2mo4MNIbLObkcPw5eW/Rae2CYqHa9j00yEJ6" +
+	"k3/bNvkCrHv2VBTuStKNXFv8o7N/dE8yX5iXY6jgKtct6NqvxwfNFjQ9avOGLtPf004tytf2ncnE8v78daxhU3Eg/+ZiB68C" +
+	"jVkkmLnK5ghnslJlN0iQo3X0AEdtlWhxyLCS9qc+uEXKQucU+YL752dNrx0Yo109+EmvgJVk66FJxzCY/2YDjh4hF3l/fO/w" +
+	"Yd7v0reC7R9e9phtI5mPpEjjtP2hNjAwmAsNAKWRoVNF9UmitkHgCfDTweB+PU1EST6NSDXgyf1ONx3IPDW9RZK/Pobf5nuT" +
+	"e3HQHU5CQFMcxX/jsOckWNKMzaE1Vy+WMBVK9X4N3p/v22U24bt2/KDhpENKaD/zLRU0W3BKveTwZN+Bs/4bIhT+XzwPt9gc" +
+	"qqdHnfog5mMlwcyvjn6wQ5WsulCF3Cih2z5CFRH6MKnQfuqsDHPikFHVZyb5r/OVBTCff/LlVwEfX4LxEvL3jmNHl74ZAN7/" +
+	"e9eIDeB2+Ci/fiyQpaQvhg5nJoH6xxFs5NFj4LAVXUkw8xvbT/DTY+lprqGC7nBYj2fy07vOOzAVvgxqe6hLKD8jGd4mwYCb" +
+	"MmV241P8s/0KKQsiixGgDBLMPGUXCjZfqwtXsXSYadZpzaE2MwZhG6VEZVDaIkyQqB8Gs4A8+tbuw51341ZC+qyVKhJ1bpvl" +
+	"/r6S4MP43yUmPPvA9e/hct6vHYd3WMOPIWcON1g46cgZsg6unf30X0f7Ksli+4ntK7VuAVC5MyT0Oszc5cMa8u6Kt0xNNP3H" +
+	"E77XkRkH1cBcPKXroO3TD8K6HCwuhmT47/Bh+9ds36NHj/JzaEUQ7YbQfuuclhoMoFLq6dBKyoTbtFLGwMT79htJ7naCSzdI" +
+	"vx0R0GbrlVl+cdBp0dvaLoeY2MNF/HR+JB7EvgdNF71DCncBLuZvIgRoOELMTQ4LYzWsD6USp9qqsrI2i1kjoT1/KbMxkn9A" +
+	"nkSEvB3mewx8/Vqo+VPwLp5YWPhNKJ1FgmRwI/8cegcnbzp//6vd49Ma2AX9GUmCGbtYb6m9EyUjDJxEmzdjwEctMHlryYnI" +
+	"KQemaSb1Ac/8zDOWOfwmbCwQbKf98iFczOixhb/otE/d2H4CPoONGvGKltyoMjPXi6RnB7zd5Gj9g9qZxROVM8dAVt6RzH7N" +
+	"+TNYY6aW5fjyjUwT5KijTyQ+kgVIiowVdZQzqKxSjhHkYDUqsJ4VekjSMJ3CGMow9Uiyb5p2E0T44wtFg/16kfd7G/k/yJ8R" +
+	"TfzwVAwDwQIlsOEQhjRQG5lvYk6TxGPH3sSnP5Fp+NZUTNjvXD3oCBifycroBevISRO5ibAwj9GEoeuStITpyF4rDvxMyaw4" +
+	"GrSkhOPOC/fuXbhwz56FN8Gb/H7zJvkdvPHgqWPHTqXX7ivnzl2hF2LoPCDHc0+RCoWgGIRUKUpruDmZ1So1aiyh6CKwUV8R" +
+	"LMWop+eICwsTkvzRZ8Ft1SpwOztmzFny76pV5N+zo9kvSv/0nZJ5btiw/uc7B5TyrKf4lHO+cwB+tBWCv5w06Utya+s2cove" +
+	"QXCrT1Z2yknsf6f1f/9b1TEnsf9dOn7nXOjyRUEoDJlqps2mVeoUUaCwKlUR2ETvJLhGuj7wfboL9ydddj319X26i38dDux6" +
+	"WjM9/yP39JjlS/XgD+P+B/563o5ZPbkn6kI9hNgj3FXkjrxED2PHrKQN9EPZUNINPr9OtpKt1+HScEjmN3MxRZv46Xwm1vEP" +
+	"8cldcIniaIAQ+6YTB/VNdMzoSEHPLi29CdtI0nXIgwHXSXIBuYgHbtpUhEfjk/xDrOMzd5FkhBiBjp7CvC8ntOHCUdvC5j3B" +
+	"2ZkZz28BC7mKB5JPh8HO0p9gJ+nLhnAxpBsfCW+s488VbcdtcUbRdsSg+Qgxc7irwlq0h2PHr3ApDPizEyf4d0+cgPC33uKu" +
+	"lqGyMlT2LKaszDnvLJcwSQi5PlPK6JoEQqxOeI4Unl9DiLlOn6G9UL7zy26zedxTQY8NKAGZafsqrA0JOq0T9Ngs6DRTy3vq" +
+	"eopfW7R37yJ6CTp+U9T3Gt7x05mm9tPs2HopKampKSn1di+bPWvZslmzl9lPVHvF9BZjcnyIEHeNu4pUdK5eIa4SCpc4ifch" +
+	"XINcuEZ+BD2JIltJFOjJj3SSFecVlU4volOuRexMhFFHhNhPuKvIjUpXb1AISgMc6EFWwqaQbPjrGplMNuPN/EDu6iZ+PN8O" +
+	"664w9xFylI2xetnQNUbc6O5d0uLu3bt3mQ4XSauL3NWLoo5Wyo+qlob6qRgVeuaPa8QDjpV+VkJPYcabuat8O378Ju4q//CK" +
+	"3U/Irw72wCbGHFLQs6hBUXEyeI317mx05/PW+rvGZXbCg+jfqM7nbTXWu21TR9/pH+DJvqlf0OknuFGapV/Y8aepU0bd7R8g" +
+	"0rWW4yUZyA8ZUFw5XcKqnpM0YeWPUkc32HpjLsVE54vTHfbgYvHK15bmjhUou3hk5biluWPOzsLbZ64bdm5JJ1Y7m8+ZuZ7e" +
+	"Oogb/8GQ80PJDyJ9Ez4cdm4o6LfdO8xs6Juz56cpd4vtg+mN6F8+HyEoKC8jeqq0WWPQuFzz7zr+465e/PHiTxd/Evv44Qix" +
+	"eeJ8vXNtAw+Hfy6T+eS7zxnjl/gkn4mD+VvcVbGPRetUibgWJvgeOYLM4J4lsKeELyiBPVT77Mup5jGjxTQ2Rxph/Y2eq68y" +
+	"O/J67YN/3/rtPGkPF8+TeWTWB3CTu0ryYAsZC0t5s/1fPJmfywQ5+GOau/JndGhguSbmUOaIif69SPWw9MOLgjaKurhAsFPC" +
+	"Wr2eurloqOZTbWemlX7Gptj/YLygGXP/2VVhHU+cr5+P89kgJr9C7xUG58UMPHGCrBQvJr8MARKvynaHSRX4z0WISStfPxTq" +
+	"L627efgq+Q3kfAz4kKfcVVpfaV0FlFt2SzKRe4oSxHPj9WEmi9a59Ce1WM2hdHXAZtUn6zQRhjCsoAuDNo3EEGqKYEboEw6T" +
+	"S+MGDRh+76+/7g0fMGgcxL2ZoA+LL4bksYOdbwePJV+9FQ/RTaxf6IujoT6YIBJSo4pDP7c2bmL9PLQ4ilwg18k35KPoYv0X" +
+	"VoQpD1yqsL4e4vQ6UzgkXx5oSLBJgh/RkBIYUvLs2bNnJWR9CSkFjl4lMIS7SgphASksIsuKyESYT68iGFNURJbBmHLZHRZk" +
+	"d0iQ3dsIsWpRP80O43eC6c33wPtEiTnSwDCaBsU65S3QqhIpdfpWOCnEE9/C7vzf9HqL/xlr6MVdrSCKEiT6FCAEwx06h2w0" +
+	"JoZZYXjr7bfxW29RDSvdiwAtL7vNUfoCBXtgE5cMvMHZJTKZ6fy4PjR8+YH98L39GZvCv7Pn6Idvffre2wc/Jd+Rb8kv5Ft4" +
+	"/3VgJ0B2UREZOO7OhHW7Fyx489LNHZfu/SfW7R5lt1mrSzv10q0UblHeF3NpoWp4x/q3b9WqPb1279mwfu/e9Rv28IryV/SR" +
+	"vnas3TpsdG29InUwppbPkhKPqSms2TJ3WnJuGLV4zt9azPJPe3Jy9vw0dYr4K+a/Ushfi2Kr5E/DOkhcSLCBDaw1UDG2/6px" +
+	"645eqqBj3SxSMh6az6qgZRsEfzFp0hfk1rZt5OaQj4d9MmHSFxC8bcqt3Tl94cxQ0urwXXqfs/tWVduocsbhUhgUb5XgNSWk" +
+	"VwnOY0bz9fDH9uW0eUYMhYdNFe2qylmfzBrDW3fvVm1UOTRf7N+U20Cq3wbBTcDs2iazP9Ck/ABny8y5XXwWcxHPqUBVqY01" +
+	"1d7GVi5Bzqwx1K0UYeOdO3UqSc1FsSzvcLwkvVJZVmpVKxFD/XsjKsry4tEV45fnjq2Bmlnkndegzax1LmVJW9Rh5Oa2beTW" +
+	"F5PKyzJn960pdw+TjAL4qK9YloJ82PvcE2RESaghXSlH+lBdMGjUdEuUJcUmmt0IcZ1So6ZmOhRxzoGQIUyiclZEc7I1H/pC" +
+	"MgztGqRcNKFwoebBzz8/0CwsnLBIGdSVrCOXyE6mQaSfXyS9Zjlv8EMYDK+PNbVLm7ly5cyWVnKG3CK3yBlrS/qc1s40Fl6f" +
+	"1CApqUFacjIzX7hJSBDb254IcXEVbbTT9uH8EuzD/1bC/469uav80CJ+GF5bVFaGrKLeyiUorRLvNDJYm5p4l0o01PrTnoVB" +
+	"YaBr7uWCEDaNvUgSwzoHi5L4ev7Y/W9fufL2/rHzvxYlkmYp/EuQSEOTKAjTTPrHVINEdi76EZWVFZWhsh8X7XSKpZ0upVa5" +
+	"ONplsS+t0StceKBSeovpwA/8m5LzNz5o38ZdLSKZjgz420WVxhvRleq7FKEoR+A99i07otWdu/rsKhfjgJEK/WCkNwjDIj3A" +
+	"vC+hmHRgOvAzoBi/h4cVFfGbEKDBQvw9wR+Poku2WsxyZFAkI4UhJYISGSbRGOTIrAhDCrNaiyeXTPn2DDl07hx0OvPtlJIp" +
+	"356GTu+9Rw6d/paNgSLS9dYF8picJN+Rk+TxhVukKxTdugBKyAQjZILywi0HD8xK0ZdcZRa9fwj8cIFsOcJd5eNwkODi40Jb" +
+	"jDDitIgUmCuoFJwYq1FquQ9rBIKmOEiF1WR4JXLx2qJ0kZoKitOLGlYluSFCgBLZOGZuuW+JIG9mrv3gDNxpJhsH+2kfQ9Df" +
+	"GWQEk832FWLAhAt1gG4aFPaRUD3NgHSgAwYaho1RSGljrdPCuYNBQe27dTnUZ++Fxu3bRxukEu5oScnRyJT4BqH4or2Tm5eh" +
+	"6al6qedfb7uwa9cEi5+mQQp4k2dn4HCcpW9SD1uIY78wjfvCJLvEg/mBdEOAtjH1caBEjThB9wwKwzaYdn2vRL2cf0uQ75tM" +
+	"fZwkfgeDRa8wwKJrMG0fN3c5L/gobCO/40D4jH7nzMJYqtl1Mmvv9OX4WzE9+R0nid+BRuc14yQy69o+8vty3IbSRRccOnJP" +
+	"5RIuW6BzIznFfc9dlZt0dxCyW+US3Z0q7392vP+5yvv7jvcPq7x/6Hj/qBb8leEj6Hu5VExVI56fq8A/FOB/rgbvxP+gHP6G" +
+	"C/67VeAjKAa5VHenyvsKfu/USOe9WvO9h1AN+B9Vy/d+NfpvVJLn3Spye1BNniL83Vrk86AW+dyvhc5HNfLlfF87ntr04U6N" +
+	"+O/Ugv9+FTlEUL7kUt0Dl/eu5f6w2vt71eh3xVOb/B9U4+tRLfTff4Ge1Fzu1eX5oBY679Winw9rpL+iXtyvouePasHz4Lly" +
+	"rl4ud6rJxxX+51rq0YNq+vywRn128lUb/nu1lO+DWsrxfi169agWOTyqlu/9WuR2/7n01Ia/Nr5qK5eH1eAfVoO/4QJfG52P" +
+	"atGfe7Xqz73ycfYI7qpSgozlz37Cs+h3mYEQs0vot9DVC4WjA8fpw0S/doUZ/3p3+qJF02EzGXjr/v1bd0v24iTqkL/39nc3" +
+	"buHuDpxciIDTRPMoe4YQWyA8Cz7izmex/2QRWnR2WOkKuFECN4q4mGdXy8qEvijhniolKLqczpsCDsE/s+wbhFhWmMems5p0" +
+	"VskMCjPLlvB7HuHNvOUpnluCJ/AL8QkmiE/Cn1McqOw2LqU4dMMQcuSxW8gjovIztBSe6fzRFiHPhk4amJPCc1TlZ49PnM/U" +
+	"v1opQYmucxpKCUoqh/9PeE4QnieW3WZjhfyt5fCfCd8tQllYEGLbCv3WACFuhYkxq6j7JBWYUB5Ay0euNcPnaSNt77x/sOnU" +
+	"go9KyMB7t8fj1Luz582bzfhpV0yDDWTmugF4wrMYuo+BP4qV88mzuRX9WqUES13n0ZWu8+glwnOM8Lyq7DYXRen1+MbZD2aO" +
+	"cL8LawAOX0+FQSEXPDiLOx7uO3NgyZkfuhVxG37p0GHtp3Yl8xj8H8WK/aWGCEluC7jrI1QxFlEKY5GysjI7QuxQ4bkBQohB" +
+	"sxBiGwh6qRDWeRV6UTPLbxwqahN9SPXMPPL9qmXLVkE4+WPu7Nlz4X2S8MVP40bOW/vXLbjNeGLD/MVL5vKb4PzIMaNH7X3v" +
+	"6MKd6tBPN1z4tpIcwivLRfGz6zyfUlhfQOBcX0AeDn12zDcx1+2H8RZ+Bp7Bd2YGFMHJIhzkOm+lFOatRL3jmgl6kCw8by+7" +
+	"ze4RvovziNsRYvdwvyMFQmaFHOmTkR+U763YDiwqI8C+vaW4eCuzG8IhmnxNvrf3ZhY/vXLlqf01Ud5ShJi2gq6Ka/+DEWK/" +
+	"dMgTgSEUUcRmlUIv3rEB35FHhAcGtN+VkMVkMlkJw2E+l0Aekq/IZfKAS3gWA0NhFsyAIQgB2ooQLIF0xAh7LQzbfv0V0sl5" +
+	"cQwxm7wnfBPGGgrGoDJs+3X1a2smUgjyHjSi9G1FiDkM6XKT8mMchpBcovxYoHsrec/x/kLFe4RFnKxBjCbvwKowKxyYf/3V" +
+	"iZs1UCqwSB9rEOf0KIUi8K8ipAjlgGNex1+LcMKsL13pOfL4Mf+YQTyiwPjr/Q5YyGFeZ3Y6cDr2c22jYMK1c//TIoGHQWW3" +
+	"WT/aB6eiKitDPctuM33YyXIJDHXqE54urE91F57XIoQT2Ry5BMcI8htadttNw0WhFIRATWOziPEKfEC4FYIqm6kTvFxcJxVD" +
+	"GJiTFXRlxKzVad3UvfOvdMjr1nVEwYiRh3M7Xsnv3aBR3s3RHdvem9K5WbPeSxf3bNa005T7WR1H/5SX0QDI74u3NWvUqFmz" +
+	"Gc22Lv79o1u/bBj2x60SctJqNlvpBZklt/4YtuGXWwjQgrLbkh+YD5EeIVAq5Pqw8DDWEKqQq8zYSU85OYxkwBSyqS354zr5" +
+	"vTXZNOXY7HdTGxeoet7uqRzepN67c5gPyW3yM2l/6xYcBTUEkXeekdLUXp42m2evVJD+Cy2Q01ZIjtM2jzlL2zwUy7US3tsQ" +
+	"4m6zk+URzI/C+z/Y5c79JlI37imS0dlaFEpncEKVCrmOtjx0DQM5lD4UMZvIuPv3Ycmj06AoKSFPvMgpcofcI4ehI/hDIDTj" +
+	"2pIT5C65R05AS/CHgA/xumcxeC15QAbBHugPA2APGSzuX7jNDWAnI3cU6LJWLo8As5CpQYLFGfxQJTbP2bx5zvyNm6DZ2b17" +
+	"33X79Zvrf/8NR35ifnz48YUH92HVfXIezpZux7J/yWWoBypQQyq5gzA6jZCE7uPzEWIr0o0cesagcuyqAEbPRn+O0enJ/K7J" +
+	"Z2+TwSUlcAVa/wNPuKvPYmA6mYnX8sPofBB8zvsJcjpUdpsbyk5GYYJXmmMXEtUvg4jSmgaWlIptbdpythoCN3RvatGkzSVR" +
+	"v+7ZA7v7zXuwzrGR7bvrpOR/m8flLdrr2Lx2bufY9vw/bA5pHKacvljYvmYnxwdNHjD2m+NRYr3qSc5K6bqJEvkjZLPalH4g" +
+	"TtsYFAbKHeg1ckYi1W8uNHTjDvFjNm4ssZONzGbSdqAmHHcsyiiEFBwB/kWl0+nGLHIWfmZnfkW8yFvNYoRYseSuxMbmICXy" +
+	"E/BbFZZq+H1Av61jeHf2E/7mnDn7+PFzGR+ye6g/3Fwd2eUKvPHVavtHdAMXuQuHmfpLSN73VtHWtUFI2lQ8V8ZsKUdN55nk" +
+	"jKTtxynZlOAS0rZ396LEXX/jPFJEJ4xO8J+Qf3mavhlCEoNAm2v6GKAIpJJm+xMaUpJgD9nRrvPGoKl74Yu3V9vNzMWRpP35" +
+	"z8U22U0h6IS4x0tM7Lq9i9nGjz7KkaKKzV2UBKXdy7GvC9A+hCQP2Bxx3U7EYRBR7PsDgiGEUfA/7iUP95CH+9gcXokfUwqO" +
+	"8Cb8jb29S3smN6GJcJTabEe75tgHI4+gPSu5FE0EedkcxArw57mngh1VCV6pZo2BrvMpQ4XA2qE0ljYS6ij9A/+dOQOTzpz5" +
+	"hl/57smT7+KR7548Se6TNkJz9RYwTCYhhN98+TL+4Mrly1f4BpfZ9ZVrLWIFWk6zkx1xhIIr8mSEHpXo1uI0Cwo5ghVnzkCz" +
+	"M2eaFl/89GjxZ58VAwOR5DrhiZ18Ayb8CSEEq+6TEfcfXPj44RZygfxCfiUfO+urs52X5XBP5RGqCISodLiLDjkcdcpH8hs7" +
+	"mX5hSNkcuUmUkFyiMiGEfFAK6SYJ5X5zWQfQoRBkQgnIhjJQC9QOzUBzxBVSo2NdQODI8YvEbUcpSsdveC3PqMqzSpjojgH6" +
+	"N9QUYZJIJaHCdLDBMSigfx1rk6F0lw/NmPl+4kQSPnEiOH4fT5zIvFNYaL+6s1+/nX1W7Ozbd2dfmC48rBQe+A/pQ19y3PVT" +
+	"o+mbNk1nEzaVoSyVt7cyi5RtiMcwc9OmmThuA0CW0ttblQVoUwL/c2GhdsuWLVyrLVu2kMwtW7ZAn2X/LFv2D76z/O9ly/5e" +
+	"Lvwl9YR34v/E5vLAdPzs4Buf9Z574NO8gKAgv0GfHJzb+5MDBz/rOffAZwP9g4L8B352YG5PcnmLMH4hg7jXuKtUUxlhlVHY" +
+	"DubYTGkTNokJdkTPsaOIAQr3DRpw4mIz28Tk5FEjyaESYhCmmCeMeUwu0V2Vbdqch7DXt/H1uBinP2NX9py4f0icrxbwq6Uu" +
+	"GZgihO3TeivzERnx068ZrVu32nVoU25wWJhp2qNdZCSbs5oUtehwl/x+tX/OlkTbanDbGx5F/JiLLuMvHwf9LlulBYoHTKyX" +
+	"+dkJgUz7NXEPNBOFhL2VjJ3NEfy5hXQ65xZii0jJmJDQAe1yd7VqTUazOav5MyvjE+k+OGwT+dqKEEvtug4h0NBd2QqDU1Qp" +
+	"VvEoJOgMhfsuvCXIadWuJ5/0zWQzLj18TAbBIiohNuPr97b0p3VlH0LMnxK1XIIjXeuWUqKKcK1LSrHuYPQeQvgS193Z/9MY" +
+	"6Cjq3MaNsGoS131BaSLX/dn+F8Cx4QvseXWAw08X8BvqAAenFpDPaoSzOTygRLhJCxaIUNXghIpmUJhxysaN/01yMvJCOAcj" +
+	"L4RzMPJCOAcj1eGMZk0MLgejbAh64IThHB5JekibhD3JCK77gkrfpcJ3swNiEqyiIOSzBS+E4Te8GMaet6AKLUahrkEarOL/" +
+	"dBALqBfegrO4O2KMGZ1w3JzUBGnmH5vP7j+nGXfnRtPZfBd8YHZTAXYDzuJuCrAGk3CqnE0HI8w/NpvTf3ZzvOFG09n4AN9l" +
+	"dlOEUTHeAgbuJmKQTNQLhVkhNZiOjB071rO3BxO9jGlGVl64gFBVWHHlV2Ez68AwduxY995eXMdl9lMw8sIFqvfFFIJpJpeq" +
+	"cPn+zckI4XncV0hO+xShWC9eKq1Uq1KehCgluQZRAeRDuO/ltnUvJ9uFTbvgw51kyd3c4aSU9CzH0Z7NqQGHTrkfvJXUlyCA" +
+	"/A5HvaQTZnKyjTBlI/hvIA+2ZfU4RU441pLgU/y30A9HINdJsUWu14SCz5DVU/EyapoIuRwPSV/h0O8c8Kgr/pudXAs87l4O" +
+	"f9qxzkcGsSWOdT5xGb5ig7teYRDW409cbF6vMJcvKBGd5Zz72nE/vp4jfkFXaleEXrtL9AKhv6tRqMtNMJPtiGCwY+ihN1fv" +
+	"yojNDQ6rFMQg78kDvuXX721JTF0lthltuXvcVRQt2lyNXiOcfiFsihUGdBZHHA6LYNstUNGAMG5kWb9xPdq2WrjAnj/utddy" +
+	"xoyefKhHz46d126Bnvx3Ynvy6SaiTY0pyF/0/aSJKlX4+0l+fm3aLJ/XqvUGeGRvTVsWQEmkLVvMbaOzU87MWTHvYKictbNF" +
+	"sCjEqCJ6DX7cd1yPrFbksb1zj545bY8PONCjZ6eO67bCbP6QEC5i1cSJ+xhv+F4g4sbvSpXx/aTkNm2Wz2nTei/8we9emL3p" +
+	"9cs4ZzkdQe8Xx3Gry26zMmFc3FGQ/QQymMlnpwhnrDhOC6KBRzWOcCQaZ3sj3pqTHZu/xW4I5A20WgcNOtJ8Y/duLVs1GmfJ" +
+	"aNhgyOK5kxtntsla0K1V20Yd2mS1/PrBFDgY2rXL5JP5+f4RJXFqTXrL4cMzm2/I6jJ3QZcugYGpZ4wq5dO7y885+sGMkc0R" +
+	"ami5N9E+PI1Ew17Scz+bs4ZcWcP/JfCSW3abGUzH9KgrQmIEUbenjrGZxhGfShigxQAdpQFDB2jY53PYAbsuOUZp+PRkfuek" +
+	"93D+P/CE8f8bHnNXS9PZ8+JojV6cN++H7/N+iEUjym5LRgnjSwtqhLJcxpgNwegMR6IpH8JVGbrpEnXVhnCg1TmhpBJcKA7a" +
+	"mM50XJfbisYp2Vxy+1bFuK5AXml0x19IgOFv0s8d07DZMZxrK4z4TO7CsO/0e87x3jR1M9dBH+SP2NtAOX2xTk7lVnbbrang" +
+	"n6IV5vnMNNqCc3xuijCJsqsYxGOfz/dE5RVBAARAR3KY3CV3i1pE8284xRhHxj18CEvuc1dJ8OGpl0kvso28Tnpd7j5GFCdj" +
+	"rDI0oPuzym5LTjnGwam0rtB8aObeuAZhhuqsrlKsGD6wv16nm/cnJwXLu07bvv1tF+mB56ApZLIoOfhy7uZN8+Zs3sxOdo4a" +
+	"tqZ3bD6/kshOn+ZPOMV10DnKEGJwlN2WbhR8HLUIUa0yOwIV0JlCQyhDBYbH/wNPyI7PYXmTB8Vb3iZ2QjYNCaOqJkjgcOmY" +
+	"9648ZRbbe5PvydeQkk9min0NKoch7GQUicwIGWvWJK1Co3ZmKGqbizAYW+OV2193Zfy766NGztuyd2+D14cS8q8ogB+XjnRl" +
+	"1V6Gzrz++pndeHLp9oODcnH7SnMD3ghJngj8egn8qoTaJNQugc03xSoEn8NB2H+aIMoh/41Qea6WhrA/PYsp5407JNSfyEp1" +
+	"R1FLjdH6Ac3CUS2q1YbrQrSMcsXnL1ZReXsZIhYaNsNhGxw8iLaBicIaPWMQORH+GXQCMzgdmpLZsy+V89T0S2h0mv+kXyJl" +
+	"a7/DKFy1d2QOlxYtRg7d5Q45dDcRIX1tBkCrcwYCcmFeb1AwgdVreh+vY4uPLFly+jRp7lBZ08yqVXr4RdhKclfwF8tlQLUI" +
+	"I2/SVfKNwKuvqJ+S8mFMBpSXncAttGia2WTU+U3NIiLiFeUsb4etp4VCHP1RRsOicOPcZEdhNmLp/uwRpKvArwkl186tWHje" +
+	"IHYGMoBxUdHAmsuyeea8kXMHNGsbr/tN5LmGAp3ADC1NvTBpU8t5Tcq5dllHkkuYNULbMKbsNrOCtg2MnzN+lxDTSCn0/Gjf" +
+	"jwrB4IiH9VbJwYNCSCP8yRN+cgmNAfZOEfmFxjSy36YRjljBTRUB8kSIjZeoHXho6+SKp3iv4H5L4xzhuOsE7d25U6I+uJt/" +
+	"h0Y64g/SKEfsB7ud/kzfc1eFdk44BZmieqvkNg30hQ8xkXzfLD66CD9zxG/BNKaS1AlrUBzec2Uv3sIU8lwr+zZ8V6StNUJs" +
+	"BHcVeZXz6Ihzdqtk/XqKeP167urN3fYeRWyH3QhQAUJ4n0Tt8F0rj2oG1r2ffrr3k08kanJrd+lhtuNu2gsuu819xD1FGhQk" +
+	"7IoK1WlpDxpTXzxTBGOwmJU2K0tPttRHmFR68WBL/AAC3lipGTfGfdsbtJV4Y7v76HGaVW/AT2TcuH5Kpoui31j7HtmwcbBk" +
+	"3DDu6eW3yO0V5MwHVy5/AE1XQNBbl3fvnvf11/OI+tn63bvXI+SgA7GTkQYFI5OjT2XWGCSOHp1BCFquCxPpiBAIoXqP516f" +
+	"kqs6ekg2esr1byaNlhUfU+VN+mZuVj0fnOJTL2sTf17aPGve3Kzm7OQt0y6u+HPF1q0r/lxxcdqWLWO7zJ7Z5TViPDB47LjB" +
+	"5f6cdNyL/FG4ECHD6SNe0WKKLopyxJa3mS6t59cQ8EZ0aEh05QaUvhGERA5BJ/hJbEIfMCmOG+7pZUvDhpaKxjSlYYOUK7Ab" +
+	"fwB7dlduTqFl5WdnPBw6NkfuSCP09SqaTH3Fren/x957wDd1a4/jOnfY2bHjlZ04ju1Adhzb2SEkAQIh7LAhIWHvvWcpUHbL" +
+	"HgVKyyirtKx00b13C92b0tJF6aYQK/+PdK8dO3Em0PK+v//rw/HVlaWzdHTOkXSUImBi3wAvYCIEzfkoccqsXku+3Ll9+50f" +
+	"zguQyxRzP/rw778//GiuQiYPmPfhR3/+yX5hnyztf3fuDAwJCXQE6O4lT/fWhumc89V50FikPW+cvjZxnapWPsfSHHV4hCNv" +
+	"3fnzZMgm2t6ii+JC3rrn1+Nq4NYjxNRcx6VcAs0FFIEQkhkiJTJ1JMn5JeTACiCoGoxyuj3RzC7ejP/ZvBmkNvnhA6m7J1Zd" +
+	"vFg1cXfqN98cgErY/N13eMx3eOR67qdDleX4CdsV/ER5JX59/dq1iEHxCPFe/HlRMrViCI8ATcxncoZGKprYmayYfg/2w/av" +
+	"L136+pvTMb1izRCoMmd3zMmd0u/ucDERH3+erPHbDjL9AiOyzcbIwI2aoi7zNxRnElRJn7iUT+TmIh/KVbs7Q7IbsQ4D3tGt" +
+	"KUXjjLNRrmO6ea69Y1G3LlvwtS37E/QxCeYgEYQuQ5KS95t3Tzzz7benj2zdDyneh6ZOeuu17757DV8ICgzJS40RYVnWP1PD" +
+	"7HeQY6/tHbr+gzeSeDTyRDrn9R8wWo1006o9yMtIye5jjc7I7hQlHe/P21aWIPMTRwIdI088kvNsuwf7feJbdy3o2vr5w6ax" +
+	"4FgHIqOjGq/t12Xe/HFj/cn5SVzKbeHm0qitYE8x5AiHWmM2iQzXmU1Ipo60yAyRbGHq7klnLl48M2l36v7Dh/dfvGh7fTNI" +
+	"t2zB/xysLIcCRgYF5ZWHrivXr127nl2Gx3z3HWz+zkV+peKNzmTdiUSDSR/0xsxYcJLelYvwiNPnz39DdnOQrIuc0fbOPeBz" +
+	"NxyLX9/lK9zpa3yMD6yVXYnSVXb1QlNmQcnXyrBDdnHFgcOHD3zzjUN+HbIbazsm2bF+w4b1+AWHADcCv1yEXeWgVS38AvTn" +
+	"z7vAj68S8Iesr6xcjxqEn449eWvHnmSPbW/dwefI9VnCn7fHuMxatuS0bQpbwMcKZ0yO4lLozc1FCHEIgZQsNKOe+/Zxc0P+" +
+	"+UuIZZTyU6leRySXpUlFwrO1AQmVoBm5zZWzP6patfK+Z06fLho4cO0b77Dz8Z1CyBbmf3C+5vPrHtKFvy7Pb4+fQfb4BreV" +
+	"m4uCats1OqVppANSzf7RvvzUBnCKcDAP4O/Wlu985jx4kBjH9c8kXseGV/4i+PEfIgSbOV2AhOhLxKD1UMToWW8xVqg10/8Y" +
+	"PR4Lm8g/KNq8eTNCAGr4Ct4QaARkBZ8F9ZmAexT8edtkvAOGU3gfZg9BF3r2gaxIyqHzNv687TwTK+4ZIPHkAAn7BO23EJcy" +
+	"NRIlkhD+0psulSareF7JEdFIYnVRhHYwRpXcAXp12v/VV9ZxcblHVjKWaoDPktiApV3SrzEe1XiPbdK8aTtff5VZ9uRfYnzp" +
+	"Kb6j3aYRbkoX0kme+pPpfXzbtj9tR46LXsZ5svBIkv7Zc2LOouOe7u/WyXVuKL506X4Xett+IeuL9pASV0bJDYitucBv568Q" +
+	"r0VPN5r4gY7czS01WlPNZG+IWqMSDkmzoAHhmgOuCH8An43G6/ELjyyMLjZUQgVk3vFz5fAL8/+cP5vlio3XN6qHzv+zfMt5" +
+	"uHu/bWGvPvD6PV+HLtSHR8AE2NapwwdDy7ZtwR/BpoV46Fszt+kRi1hcyq3j5iIDSkIZCOmFsSPkcssG8VoFmg7VoeKsGn/g" +
+	"BYiEQ5tCcjj2x4s/9d1RVLS28/17TXsmPTY5K3RI2CjJ149N2mPavyzs/gO2TwPadL5z2SYfeXzn5XduhFX4a/x9WPiJuLZP" +
+	"PjWwYti9vSbpKoxpOcBBxrCKQ/jhlIrs7Nf6Zk349CXVgmF5Ez59OSxUmNdZ0e4msQUhIyho9BoedCClYV2r3qg6JaQTHc+o" +
+	"jTgK8C84+9nTqwwPQ1CFPbHonBcWHre9BH93wj1gnGlh3qgJG8S2YyRK922bhbbFrKN94IlwPAVWf4gPHz6wLOx+4IfYE5AO" +
+	"fGDYBtwPxuXi966kVGSVD1+BCK0R4stEuIOo7OlpaFkq1wEvQs7K9Qr5qT8hE4KOrzQ+/Cd+BV84TgDfcAEe/ZI/bxs7kknA" +
+	"CtPCvOp9zNrRtk/gG9PCvE3wie0dNY5jDHb6rKNyGimMNrFxQUjJtRlWjVRkXBiwf+zHiMBPJbZ/vvyOsO0HPRLSJi7bwTxg" +
+	"G8SMOYt7w8SUimzmjmPDK2FW/23JFelJ09t1O/vAUMF2Jf1huo5GbyFyzt4r9KtNEfpUqpSSeJouUCdXmdRWi9U5iS/lz/bv" +
+	"JrcPfcd4cbrtZbggi2l/5zff3Nne6uWczvcuFkwL2zMdxuZUlizs4+u35rHfTuTGtAesjoGCtApBP7IIMX+J+pFuaQsDvcaF" +
+	"DuR23L9C2xvXbIZl4XgQpUDqwZnytkHsyQD5a2dT1w/bKWCexSw9NqKC55Ewbrl14tlOe15EdQRp2x6HdcmEyBv11E5yyoY4" +
+	"dnMX+MmAn+yWVI6v28o+sKdA7IsDDZBXGDfAORHi8Aj5qYUzjWnjTn/0ZZIj96Hi5MKZUQll9vyHbM0FlqyBu4VJo5Q0BlNS" +
+	"hy095s7rf2cMrA3HvxfEFMHnz/Y4Xj7okb7TFwzcHonnhIEkLarw1UcH7eVyj8XE9O7drkjrtaGiIjKm+K6dp3W6s2Zz/x5d" +
+	"uus91leUhxg637HrCFmEr7kPIV4m5KgFP6A5UMm2U3GrjlwH/jPHrD7yDAnlk2PHfBIMGZ3c4dT9NJgfS04fi3kDmZ3saf5L" +
+	"ukZOvWPRQKGNCGmnteZ3psKeKWVlU3DZ1KFDp8Ie/sv58+cPsm2CtXgKM3bQoAULqJzmMqu5kfQuAyO1bCmppLxKS2kjHjAx" +
+	"2kMPREComND1c0o8FsPgkr6jpfApjpROG5k1xZo2I2vgXQkxM7vdtWPHXd1mGuKW9li4xdb9wLDyPr3KOb8+leX9w1PGTAoL" +
+	"Kw8MzM7OyXpo+45jOVlZOfcXL/568ZJJE5G4Bspf4coCJKyQr3gsQuwkwY8Hx7FXdqJw2PVhsu2HeeUQVB2qt89XZH0mKKiD" +
+	"QLEQ9/lePH36IrGt+MR7li2/h/ngWizT7+vPPrkgxg1oPmEfhExCTmayVOAJp47DwNMw8GH8Kz6IH+TPX/uKQ9WIj7gWy16t" +
+	"9nCcyYKx0vH2/YKnTp+Wjr+6ATny7o4U1qf1YuDcfkadXQfhr0MIBFfhhy7ib/DXr+OL+LtHoec3L/Ayklr3WixJs0tS7JKT" +
+	"64COcW8zveh6JLVEmF54EDzAvU3X/hawO5mZfD+aG98IJtUz7eFcNLtzKhQJ65AVzChmu7A2CDowgonZ7ofNQ+BKR+5t29a+" +
+	"zDYEqBRSmQMudQ74YuNYsPXm3q6uLGC/RIB6IMQcdalz1AuHT2KYAdzb15OsvLYOLKDSwTNwOhrH0eVOKKqpQcfYnUwvLlom" +
+	"VfRACL9O+b+AGcXMpGU9HWUVkMpsp2W9HGWlJLZCy3oLZXVwU5EUIsz2jlg2BF5jrqxgtvW1bXVpv9TR1jFmFNOLuSLAYdsq" +
+	"wAGpzExa1tNRVoEQs52W9RLK6tBK6PNAb8yOhY/giRXslwXVlS7wD6zF09F+qaP9Y5DK9IInBDiqK4V6CDEzaVlPocyV9nqh" +
+	"z6MDbHgSfL1iBa+1Xk9ypU9ZLR0d8A+sxdPRfqmjz2MIMb3QCjmF43qSff0aMgWZI+PimTl0+d4PLSd5HvlPXfbvCDdHkLO4" +
+	"icgk5JI3yVkymuw3m8lNcpX4t7F3EuOAAQNsZ+AXLIdfbGcG0A+81U0hq2dKbI/YCsgn719ZeW1FZaVQNrqy0naUfGGeaLLK" +
+	"bYRPCNPPdtBWQD75IBFYWja0stJ2P/nCPNFklf+fP7c5f2h+DPY1yW7hRluZNlJuVphY4UsqkpvUiH0Vl+J888kcCAAPnHc/" +
+	"wJPMfbttXzL6VeCJP56KF8HKqn/gNdC8gKWCnWBvk+4NhgA9JzRoVcki5WaZ3mQhe6iVaqmJY2MAX8IYwBPke6u3wdiAhFmz" +
+	"mMef1wZAFnMvjPT2wEv64BI42sVq282UZ8GP2ff36PnIuF2/Fwr9cCGSE8gTaREC0oW9H629I4suSqWTaSNZiZRjJ+EP8Ytg" +
+	"gbhD2IB18F6fQgYOP7ABUiEDvLPBg1kAD+BhQ/FguL+8r+0EkzML3i8ZVL79eajEz6zqM4XihpCEk5wQIsNWixXJzTJtisZi" +
+	"Ip3z0XJzKrlOTa6SgYbT6AxGgxRk5JnRWBS/9Z+K98GaresGXiIHdPEwXZedP8KCp2Co6bLP+5c+5eQ1f8HfGJ4r0UIpvDge" +
+	"rp540FazyfZueCiTNHPo6u34TAWOxw8H9nq/Z7t2J7nBsA7v2o5nhoXCOOTg5QnqE8uEfG4yLRcEqboouUbHEqqwr+KOuCf0" +
+	"eLwCvCC45pMuh3NhOayCl3EwfA+J+B18BqAbO/UdXPMX+MJdeJVTu1qaQ0UdBrJIucLECkJCQ/Jsqk5CgnyE0vu5R7+q3pZ0" +
+	"3AJeID+8reeLzNffM9qDalgBa/mQb+dNxfOxhgHQ4C/xQSiZ+kbMg93B62P4c/suWE9tQ8LXHpSvCmQgEiSNorncTfV4nEp8" +
+	"YW2kXCk1ccxdzDNPDBl4VAbh+Dt8HgwQfgybcNLAwzAERnt7gZH7HCzwxcmhFZ62i7ACz+iCB8OOfl1sVUzPDmue2PgmrMUJ" +
+	"+nYL7TLM/y05IeYPlumigkBmSpHrGVZnUchluihWLtNGBoFMayHMVzB//nb16m8seJ755bLq42fleDAeDXteWTbqcxgM3D2w" +
+	"BC+wbSB20g7cAV+7CJ/AuzAb/2yLtz3J5DPjGS3z7ce275Ejx829khN0TMpYXUAQyHSkK4WOCaIkYHeuemTDp/jqvSc3wglY" +
+	"xlzHK/FcyIcq2A4L8BI8AvfCj8BsWALbSb6hWtnYQ0ckoxX4x5NRQtbvxOQqMqlazr4KuAQXGx+l7HvyxV0XT1YP+OoreBGH" +
+	"wu8QjT/FxwAKZkHWwcUPbXr07Hj8d2UF3uWA20MqOYHMCOkt1mhWGBmRwuBQsrqAbAjQRdHzTDoaqKEjJYC3aDiF1qCPBsgY" +
+	"tQSPiYVV7wwfANOG9fkE7nil6mu48N2O11/YyVz6IgaWT1gMH/+Ff4nGT+Kz+Pv9s/J/hytz4cdHHn/8ERwx8jFcCQ+/nAsd" +
+	"v8MDYD8gBpO/3+Ezs3He9xpvHZ6xb9BA/Jyoo16h/CUuktxgpOM3UiGqjhSNOVVLD94TcicN3Y8vQAhIt+JynAEn1q7BeOXE" +
+	"+2At7IOP4bVcwM9U4U0Aw2yYYY7BZWXnd/6ZPA7m4QoqS9NrvmY/k+xBnjT2KtNGSeUOGQ4CWRtQaaPCwGKKZDPwpS3TQA2+" +
+	"e3EH3AsS4W1s3rF1AVQxh6FbF2zAR6EAX8OBcIntDUv2fPtSH5Hu/BeSEzTCIAMdChLkVScXxFYoEZUU/wX+9hXI/P3Nfb+8" +
+	"B+HP4L/h7pfHTT8AO5hn8Qj8IUyAPvAW3IHfsi1hlkMMno+T8QG8GWJgB7zFaK9csX2BkNO8EW6fN6wmqZNCMKdGm01KTqWU" +
+	"qtlX8VCcEXAiGNTA7Xp+5Pd4+YvQ572L8IEeJ8EXJ07gZyA3/+n407nH75/yx+a1nz0yewJy9PGq5Bm6C0Omj5SbKL14TiXT" +
+	"mzmjRW/Ws6/gItzjMHCgxP6QbfsNZgGDl7PD4R3szzCdcSl04zJTZr2P5zOPMG8eH3pgiUAvSbLkBNUtKkIeuVlmjGKpXEbJ" +
+	"7SVWi1YVzVop2STJ+IUPoOzHK3AU/wDjzuEnr15jltjydk/+Zd7oF+EIGz4PD8PVMAyKVkMXBn+A98IQiMEnN+Fn8L3Awa6Z" +
+	"cH/19T2AP0z94w96vo3KRO28GwQmuUA/xmg1SZUB7KdEBPaCL6gvMDEn2//MSOOxB9OTG3t9PJ4BRV1OgGHbR9/Ow9fttOK+" +
+	"pPMS8fGlcjW54MYsT2WMOoVOQRx1LkAqV5NFGbOcJFd6f2Y3Bp746INHn7lDHnAwYKkc/L8CwH/i1/Bn+NujL/IHEq+cwE9u" +
+	"xStg5jZodxoKmOqfWfl3BYPw9Ifx6XewAd4/D0XHYU1PhADF13zFPik5QeUhQBvJCvNDEIkYa8hIJ1IhSCD7JOBhOIMzncmB" +
+	"KAB4aDOzLmDd0gWrCoZsg2K4bPsdfoAE/AF+BMzsyQd741HQdbVi9+7deJmoly/ScWsV9LKcHImSgxLFQhSROXUmyehE1JrV" +
+	"YpbpoqRqqUqm52qnaZlUzfz21+VrPzBf4i+uD3gcv3/m+en5j7wzdaL5qV9mVkaxeDoeAJu/GbbgICw89yy3C2bgdatIMivb" +
+	"0+fw5x8tzlz0xl0AW5i7vgDzokkbp9g+AGDarGJkLz2DB+CL98ytld3dNOZVOzyCgDFKpAqdUcanKOQm9hXcEfdKriJx1ojH" +
+	"9x6HzPssULT9pa3wKhnjn32Kz0AJEw2PMHAQ1/wDQxh8CPba+S3xl5xAbZ2sEEWAKVKhklBFa9cAkQoVSXsr2CL8hXEr8UrY" +
+	"9tzccW/BNmB2vfnM939sx1oGYMWYLjDHdJm98BeufnhYFIQykV3gb/wPSHFk+Y94I5T91ht64ynk/B+swQfH4674KBcw6aue" +
+	"FYVnRXxfkdyHwkR87fyXSDkyTZskYSDTWKxyE/sy7ox7hDwUQXDeuARXLHn1vu83rtoCL+AweBw88e/4eyiAqxXeDDwa8+Ar" +
+	"5zbG4I/BaxqTjpCT3vYQMrsYdJFyFSOq7QCtWiNP1UXRCJfOLEw8bPLIE/gqMLotuAxnApyYs2fnfRttgJK6noW28Bq8WITX" +
+	"T8ErYTBmGZ/18A+TDWXzTmZknNz99NP4hP0s6meSExQ3KzGmVBYNsWkFQvMGEulmtJEKKVPit+n4vatgFxx98R18ddqB+wBM" +
+	"0B8CeL97puNvvxrFAc4egm34AEw/fgwUAMOq53FX4Dn88z0IUGXN11xnyR6KmVrKkQ1yKAgYcuxPHgyalACzQZyto60mJceF" +
+	"Ml+AVw0242+Zkc/Mhqrt9+5gjzzZ43INgn0g5aXHVs/Ff+Gv6VrqJLgX4iAbr/oDV+C/8G8A/kev3z/82cV3r+69wkFXYrOr" +
+	"HHSVae3zodaikZvY5AmnahAg0G3B3XAxnNx05xZGCvd3x5P648kwzvYHk9OZyYaeTBFyzHufSk7QfRRIQcefViVyJ0olt1gj" +
+	"FbQPk8xEO4mSzoC0k321ALYNW+7dNB8eBpyFhzD3XYcYfHneJn42ZDz55O49BQV7duBdfQDLZ9o+YULwnTB5FfQsc8jHz9TO" +
+	"0dHM1STTnNP0xJJzlFqT0aQxgTITWF0U+9OLaWsnl5CBaK7KI4dze7+G18PU3tqTER/bsj5+OmfhZ/xo/OgabK4dk/ACxIPl" +
+	"4EH81j7o6MD1Mwf9ZFqJyxxvkltMHJuBf7hnDgSDx17iUt1/91yoYtbAQrYP7odnwKIJQ23vMHpuq+2JEkCCTv2aXSXa3VI9" +
+	"y2kknNacYjEbEkGht/JWS6pBJ6UXJyn9gV1ZiF9ZAglAPKonr5+CH0e9i/d0goFD5fnp01fPzM8PGc6+vvIw/nHCpy9+u2fh" +
+	"nKdjCmHT2dUvPBvaK02xo0vnR6zPO/SWMDfKtMKcK0+lm7yEqT3FnEpyuVObW61SS9W1c7D/qrfPrlr19tsz8OaxYxnVCmYr" +
+	"zFgO23ARw+GdoHnhxNLZcGpb1S58aEd5yfKNHScgQONqvmbflTxC+uMdk6DQkdUu7VpzpFwi1ak1EqlaJWMicfH9IAevGVu3" +
+	"lvP8jE2bKvGfcBrWMQ8A7DgSDt9yXa+fwI9BwJfMw3fMPwMnF+/C7+7rNXLBokGlX+ESu486lPo0nnRdU8ZHi5Z1ilU0soNA" +
+	"xpKlXKNOYiQzKvjgP/HVw8+lM9fgETiB23zwkE3x98PMu3jUrFmwFfbCHlx2/cFewMEQ2M9k2p7BQ/BefLXb2W+/g1p75hXJ" +
+	"KWqD03FlEj1Vgme0nFfp5HoZz8nZl3En3OcQeIDXx29MhDTblWPscPwJ6GEzLoZz732G3wflDgh+4CjM0vwE61S2p3ZNxu86" +
+	"+jgvOSnYgrV9CF2wEh0nNym1KVaTiuE5gXNHgAX/a0crIJ/ZPIMy8uVJq7zw7+BNvcJv167Fj0EHKDsaDo9PZGDmiy98hRft" +
+	"3yn6aZ0kJ+gdXHqEQBtNuGcmFq08VRsp2IUyq85AmaoSUuqxbfG3GIMnKGzfAAPB+Gf8N/M4GX6/whU4CY8sWLCA+ZzBb+Jz" +
+	"f7DD8GOw+8L1j2fhcfA6aMeUQS58ghdsOYtfrXLEGiQLJCeRF4pDCMgacKRCLhjyUUaVTGtOMZMT1GT60aoiWbPMQi06LkDF" +
+	"vz9tM64GYMHrAC6augoPuYqvzl8JHrgrVJ/5Ye6Y78DrAHsK2Oe244/wWHwOYKnts+W78Lg9f7CzwLjjWRt+aB/8ZQO4Bh9+" +
+	"CPj5pxCLxuNwSUea/9+faiDh9he9Jppe5MSDhBduuSFJxlmTgt4+kYMTAzNVw0eAbDu87ZsXuKhnDWKMYbaVvR/vhb/detaQ" +
+	"F8nM6Hg4D9THzhrY6pKDOO7YsWMdRsG1Y0W2+76Msf3B3E3Tx1/LYGJsYybgN+xnfmP4WZL3UBLKQgiUSKWUcnKGj2ZTrWTn" +
+	"l1zGE7NAL5EaLdZouUxvMJN1Qbq8YkBmkAlpwVUBGgurhNQQ6KLA2/DqAEtuWfFLkAd/cC944fuXj5pQtgY/AyHaqPWqFX0r" +
+	"pwJ+kxn2YWhgUvcsz+/elLz3AP728IhCJY7DkUol/DhirZfnICt+FB85+vTnB/uzW8eNTPwe2sNTaV2nP5w2e+36u1bNgZBz" +
+	"1/2gJ58aPPg+vMnLi+BS8zVbIglHLF0LJqeO7FrdlCI3RJvJmfznmBDbRWYVvhMv1+2O/PgDwPcdZA4fkYRvrI7EaXD27bfx" +
+	"m5D1Gf7sKFmEYsU202gsL7j2tKqblhn5mTN4z5kzzL31Gk979dVXq7PqN484oX0pQgFIjYKpZVy/ZXIS2N4xW1K3dVs/ghIM" +
+	"E3qHQ3W7YU5vZPSvvvqqyG+Kz2qBRmBi3WECV967tIPxq4fGany12lAPC5FG0sBaGrE6qXvqw5X9b9y1grJgc93mpYH46uyN" +
+	"1QfckMkB92zkQf1jJpI1kW1uCjds+EBu+0U+Rr19u+qrehjMxl9BxObN15PddELGQhibReUnpJ78UH9ZJYSv5FeYYNu3TDHe" +
+	"iZeEb1d/+tprJ1N2xEERVErCN16z4kGw/6sL+BKoWQUwf3z9KSzDE2vbrxTar0N7l/aZxPcu7YSzbtqvxFevDXffASu0L7VQ" +
+	"PkS45YNrL2HPvLFvGUWltH5XUgthx7U9DWBTiw/hSaR7nrh2d93BmMNuMBNZ80+nhjpk0ACE2DJJOJI7ol7UGScxLzJ7kGHC" +
+	"lq3cccdnF5fsXAHLYaFtAcWuAP+BXwYt6GA9Ho+/xF9ABvgyQXB2I3Jqt7LBdk2szrVd/OGrl+6hzb4CkXWbZTh81bnd2UiO" +
+	"gt21y9op5tr4cAeZGuwhSKCVMC5q+G6ScBRKeRDA6pCcId6Hjvi1cgZoV9SpJeThu8HVJ0AOT7696e2zAEFPYCh/tVu/F2FI" +
+	"9SxREN7BH4Ea2sECfODdd6E/XoLfwJ9CNJgYrwsXCMlYsc802md0U72Keqvhnm0finqzqb4/FJQYJ/QvjRH7j2kG3k1DUT3D" +
+	"WYs2CYvVVacSelQ2hwcmtjFKnHn10oamuqbiZe9zNgpF+qb6tItZwx3j41TmNFt3qJrs3kn2LDiMXUr1ZbQbfUkujmN1bGos" +
+	"GK0aMa5znqr+h/BmPFWzR/XjO8zsiZ6LPVd3HhZQ0mlxx6z1EEA0qIWsrly4gL8HDTvy9Gq8DwbOirkv5fHH8UsIIVbsV5iT" +
+	"Y9zOye57Z8widxsFgEzW13o2CgLiBBikWeIaX5yIf3MhOUcGG5Q3Axz+sCBp/5Q0BpGdF5UCL+rNLW6pEfbMFxuYwMZIQWab" +
+	"yub0S+aANg3NAW47/80hcdWNQWCfFTo2zg87HO/QvUIIFDqjVKdomgShn87fu3f+Z/BzYzC8g1f8+SfMuvpLUzDQPCsSJfKk" +
+	"eoAcqYgkO7hAZk+OptJG0fNP9Pphkn9oO3hv3247+lDHjGNr4VXc98HRd7z/z1Xc3X5+4qWXv8fdoeuOxDXJ8zZuuKsoFb9s" +
+	"+xi/CMKZ6bYI8UESJeW4im6ZE3PeiFvDxeQOUvKC9M8H7erfAeT4l/04ff/u3TsP3btrQJQOXtp5SKLc1n1UxbrqRPYd8m9d" +
+	"yeOHt8XEjKooefwwYkg/7GcSJVIJu5frdSAJAyH1iNDujr37dw0ohJf27ye3HNtbPPMQ6QIeEdevatsU7qPW1oIvtMz4wxv7" +
+	"8YcDCinAu3fvlCj/+YmfaweTgIgE2CSdJUrUhuZOqsWWpBeyA2enSW3jJpJbnTkioF8SpOs4/d5d/Tsw4wkCIm3Yjfv3S5Qi" +
+	"FfqEKowEfDs6dgiY70VcKAwGFwgspjp4OXVv73rnIYqkK1ccvR52RtiBNO2Pe0GipBgLZ8fJIQ976wRvekt63VQhOjPzilY7" +
+	"MHyALmr/7t0lJYdCDnUrhj74cFTUyEOHnioqmsOVVYyKiYmJGXU9m3bWp7S015Ml62x77jIat3fvRo6RC/GDCxIpfwX1J/g6" +
+	"HRInFze7PVefWHusXEP/WhsA0ERqS2lzTHXX9sW5o9PNcfF3ph3fNGHSs3179S0f1L9/3+m5ufExe9K3FxQOGn5/uw58r573" +
+	"LOjXhyCijSIn4ouKZs+OTc7OSU7kco/FypVtjX3b6aO1frFb79o5cKA8QHfWHBwSH9ejS4yurX905y5L9peUeodFLB86GMoG" +
+	"rSh2QbckLzYFkpPa0XzY22oueCCJEnVBCEgSFfHUo9wkF4/iphq1YmI4ipNKadK6PEaAlq1zatdglrNfeZBzuUdP7ps0cfkO" +
+	"Di9Y2aNTj7Eju3efMQM6KpM9kp8893KpjxmOwcRJ9AzvntHHTrKn9drq9UdGVFz5Dkbg+8jBXbg7OnRIr7vvaN/3obkr0+7o" +
+	"EqGa81Bsm8i7beV7djmO9V75DqoRArRTxCUDIdAZCGjuMUoEg5VIspCvhjLUCXbuxG+lPh3inMD3K+wQlvwkPMAcsPWfOOmu" +
+	"LiUivPxwiDNGPTGrqwg0s99WLgCda3zi8Y5zcPEh7L1799Bh90Fm9Ze0CqJw4lIKp7mZcIoJI2p3+jcI4j664V/Y/N8UdGJe" +
+	"iUHCsYDrnwn6vhY2EjshrKdpAMllUfW5TOJaWrn4YLLTMgjIlGBi8/E/y/p0YdmSkWPhKExyYvSrT+EdDti7luB/ZsxgTwts" +
+	"xsUClE6svd5+L1NaKxRDhz7AzAV+7ko7Lb2mSJSoUzNpSc+6qMNAqXHFxmjIhqbJ20/XvfzoI2mmigU7yLnz8l39BkyaOK45" +
+	"tF6sTvnl0oUt/bo5UJs195tvRMrX1KA5aCBEcWUBBj4JIZmUV+HZsAcBmHjEjpJEIB+EwCTsETb5QaQBUsd49e3WtdRnjOda" +
+	"fH2tJKJLl87F69avJ221RwOZobStAtpWYA1L2kIdEOK8uTIaJVHQdIrZYJIr/Xid1mxiod3Rr3plSJkDvlHpA+cfHofv+ecY" +
+	"V1bd4/7Jv2XN+Bu/P+308cUTC40Psw9RuiPEr+PKUCS98wn8WCqfOYwVTJDDmuUmldKP5TKh93nb+jb9epr35Y2dkI6Pnmcm" +
+	"xvTrZd4f32FIKYzGW5gtkrQhW/rhP8Cr8ujMEjWMsY2mJeDzV+n2CQOspK9uCPGxXBmSEh4Lm5H5tqcPVC/hPhHnEEF2yR7m" +
+	"xVyZsE+bzOmpCZxOa9amhHPkZBm/aL+PLq1iwRDbVGbNrON39jGrrn91AH7GCvh5zKpp7eL81wXnDr77kUJbb6bM3m8brkyI" +
+	"nKSEs0o/ljaXw6YmsDptN2bdsncW94qI6LX47eW2ycy6EVsGWZVK66AtI2yTubK80Zu2Hd+2aXTe2radJkzfPn1Cp7b2NiVK" +
+	"epeDcAGBCpjBTMT19x7kkmxfse8w+bh4aXW0Mz50X3UbkGtTwhkV2b9O8OIXXTcyFzacnZF4/S12pXdYQrdhHbmyrPUfHYAn" +
+	"q8/CnUOWjMpu44+c8fC3U4URqMLwMQeCixeMsgUzF+85Oz2RUdp+YpTL7hvfZl3WevHe1dKaq3wMV4bC7b9ldVo52c5P75Oy" +
+	"kAmOtmQ84BmZMbwT7j3xrsSOmVHt2uxgfll8rNzIDLHtZco6VwzP0NveeP9t2Tq/KfczpnX5K8X71J9j4vkOPGc/3UUIwhde" +
+	"XwB/bYE/mfhDTLztPURkuhtC3Gv8ZpmBjzqEAiR8OyScF+vgKA/u4Fxur2/kg5nQan/yu72NvTfyUdefR2INsh8eP8614cpk" +
+	"Bj6QlhrF3x3A08XyoDrl9voql/JuCLGfcGUyIx+GMX0j5BjrVvMX85xY3oOWt3HUf0gsP0DLhZzVBxDifGn7YbQ0Vmy/O0Jw" +
+	"SayfTUd7HNpW+4651+mdhI+fQMr311zgOnBjHW0l2uvDXvjWUX+PTMonoefteOBS1iq+e5f+KtkB72iX8hRH+WqSM5wPw/No" +
+	"uclB92xuEuk7lpSaa+nEpNfSQybhragW1lq80xz0LmX/EMpHkPIMezlCXLBQPomUZzrxYTdX5gRPtr0dbj03lNR/n5TmINQA" +
+	"Xrm1ckPLgxlU7euQl4Caq5zSCc68WnigG5WzqOoo+iauVi5oeWTNQme5IP2K5b2d6EnxonIeiRx4CWObC5MoUZyohzky+6Xm" +
+	"8FaSU01ukkIOJyhjng0G9BxeGRyREh64LyA0PibTGHpg/4PjGJgWFJESodkfEBrfJsNIkrEz/fxU5rwJhfiPR3usLOuREqfy" +
+	"BV+mPS5mbYd9VZa8CYXg82jPu8p7JMepyJ2Y2EeSwdwrM2qSmW9rJDIJv064KxP7SKOdyzVUBmouYx8oY/rJDJpUmmc+Gb1a" +
+	"IxHKmVymn8wolMgkGjNCLu0nMTPcti+WO7X/rFP7Sai/S/sGjdm53Kn9lAbaT2m0/ZQG2k+xt1/zkySDCxbowC6tbb/mJ2m0" +
+	"c7m9fYSgjPvamT5csVDO5HJfO9oXy53aT2FmuGnfoDEJ8JC3YvvPOrWfgvq7bV8sF9vfJjPyxTDYdrBO+9vs7Qtvxfav8uX2" +
+	"9vnimnZ4kr19vtzevr0cTa95QPKJxE9m1CRyP3ELZBL+cbH9fdJlfAeZQWOh8CRyP7GnxXZ+kvjJDBqrUA5+3AKhnD3Dz5IZ" +
+	"NGlieTh7yaX9FO4nflaD7adwP3HH3bSfAn78LDftp0A493VNDVqDfbgH2BGUj4+hh2QSfiBCYnmVWF6G5sskyp2i/OSwP7OJ" +
+	"MoPyXoGP8GFNmlDOVdHyXc7lTu0nMEUoyE37CfBLzXe17dekubSfAKOE9mvSXNoXy53aT2J6o81u2k+CCyilQfiTYJRb+JPq" +
+	"tZ/C9HZLn5RG209poH2xHE3C55jX2Z6UDr74nEzCD6btT8Ln2I+dy5UPCOU1HzOvc8dJOfqKC66tX/Mx+7FzuXN9/hCRz/r1" +
+	"nctd6v9NxkvNyzWSOvWdyoX6NSewD9uW9psIz7KRMgk/1F7OeXPHZQblQVGen2UjxfrlfKLMwA8VxhF6EOfY6/OJ9vr2cjS9" +
+	"5oBkCslvyj8n3DFQc0AqlyiRFSG9kGAmWk62qjky4ZEABwhBDVDxEhoJIee/xahGIkh4n/5bq/YdnYo/SN+XcWbwiv3H1kb6" +
+	"JeHv4z0jBjC7bZfaKfhQxlehM2R0m5mROji8r2ZWcVaRYRh4rxw0ZMWC9lHd86zBCYXtYV52D512ZluwPHYtPMY7KFJvyw32" +
+	"k1UFxraZ1U7UhewEArvyvF03cj70+X1xDB+QTJHGyoyaHuxoajc8Zy+XyqWxMoMyhtKOvrW3R8vbkNqaHsNRbbu0vK1zOZpu" +
+	"Oyi2n8ysxntlEv4Ve7nYfpwwVslb0o7tY7H9eKEcovE5oVxsP8G5XIQ/g8gc21byQG37FP4MR/v0rQP+DEf7CajAXs75SDMc" +
+	"7YvlaCk+xT3DXJZJ+HOU90uxD7+WKaRxPbXGwVOJtEFB4CpiApXxSZb86e3C2e7jPujXc/Rw/GHsvrhTPQq6dzxWEDaAKSwv" +
+	"657Vripp/IaX2ofox0LFQ2vuOvGTOVCZdGH4pKrXkB0OXk1kH53EegEeWu7Dr+VCZQZlmiizJ22xrZhDFtc8yW1jL8ok/IcI" +
+	"IRYtxr7cbuYw8kSxyIKQnlzuTGN6egFBtSnJRPY6kzw8ErKirFZKJVEGc5TRwFPsSdogCTMu/fHi/PworPbb7zspIGT+qB+y" +
+	"2yr6gDd+dUPbnF8/Th1xFH6I6pRTfMQg3x3yQOqkNZ2mB3e25MQUGqzguXBsRf6U2cOqxn3dvuLzLyLMiaPz10xK3eIjpbr4" +
+	"SXYIgVd5UdR5vmwlc1gmUQp36yyueYVPlbxPdAKTwneQSfhx9nJJtOR9mUF5TtAJ5K1DLsbJDMrzQjkq4D91yAUpf9+l/AT2" +
+	"YX7mzssM/GRKx1k1R20fCuXsa6RcealuOfMzN0Vm5BfV/IlzZBLyO0d9p3LlJXs58zOf6mj/7prLOMRen5QrL9UtZ37mjtvr" +
+	"kzndoete447b69vL0fRqzMeznWUGfoo4/opsV4VyiYJh6Hgtsl2VSZQ/uNictbZlKkJubDb6FoFggzH96M329iRz2WBSKcE3" +
+	"b02PyP7GuP2lpVVxyWM++uhxJNQn7SBl3friCAPfwruEn8XF9E+uWG7/8ZjkuCrB1vyd4iPhp4i25xWKRy385wSbU9lDHN/9" +
+	"Bb2CzwnwK3vWliOofk3CISSdgKQIyaM4qdkSbXobj3nrPGx5RXJu5OgFM6cL/gOScKxZrGdO4TQqQ/QrsOX8W3iM5Nz26TMX" +
+	"jKa5wcA2ReKLkNSIFAjJaeibbpLS2a8I4RYHdTJqByTl8nezI4af7JmW0k3SibF6B3hFJ3Vc3rnIzzfS3p8vs1ZsJwLEeDq9" +
+	"coRG2IV2+pracbXt/CS0E9fF0U5NDZJyjzEqyXaZlJ9WI/palAcJku0yAz8aIfsbR12bTMpPF+oiQEMQgj9hLOEv1Mbrs8EE" +
+	"XFxM//zpC3t12w/F1uS4qkF918SKfWI1o6r5SyblZ9j7FNrmD8mk/ExXOIhdIJTJJPxose6jIhyz6sBskxn5Wa518SVGVfOh" +
+	"TMrPrq2LLzEJqEpm5Gc71635k3uNMUr+kEn5OXXa/UNm5Oc410Uq7iuGlUpkUn6ua12pxEG3uSLd7kF9mLfYz2USvhut9wRC" +
+	"TKH0DpmRn1eTCs/KJHwfl3KDcgX9PX1bU4PKEYLNHukyIz/fuT4pZwo80h315wv1RR1witZ/uZ6OOeXQGfQtaQf7wGaJUmbk" +
+	"F9SkIlTbPvZhCiRKR/v0rX2Mie0LOsxprLGPywzKH4T2UTdbgTgH3c99Zx9z/HznOYiW93QuR0cRgt7UzuqD7PkNCyRKmuOH" +
+	"hKIdYnZsX1xM/4LpqzqVwFTuvutjiRIYNFyEZa/oi8Yz3+K9tb4W3kt9UbsvR9+KesHZl45Hr7rqBY3ZuRzNr3mRLaC+YgL6" +
+	"ivqKk2j7SxBifyG+ovIb0TfIZJcKPp4kg40k9ZkZzEpX34+NdMBD39p9S/aSA54E1J/taacZe8kBj1ju5Lsm1m/fyXdNdG7f" +
+	"yXdNdG7fyXcVy518j3jmMTe+k0G5U6APM6PmTYFuLr5HPHxop6eL7yGWO9qn7SKwt4uShLsz6PVKRsGCVmvtN+wTw4NceSGV" +
+	"CFfxq6KkxBTjNkbHTZvY2cq3H9nvlfbToM3Rp5Z/hVdsnfFU6Vwvn9IFs0v/PAYeJ4eFBifk9aiq2ly50/+RwO5nqu8a8sCM" +
+	"qopnN38JzBfDUxWKNlW5lu2+A/cMnYmQODcRnGgMl4BjkJrpaqVGRc0+RxJVq4XBXQYX7+18d17B3TsuvVjQbXP7O/Jf2jB1" +
+	"wOvThozqw4RpNAqZVFIlBV3qsO2BqcPaLw7pXtUpY0ypox9CI7prgjZe6zIYXfux1oLB5Fx6MaN9fMiQEVklT0yONufonboc" +
+	"6ACH9Ukdtl3ZpiRqZtVBS3mC3t6zzgETcvD1AepLxTMSLtiV39xxJ35LuGDRbvqZK3bmtz3GwFVxxc78Zk8LPhz1NalPh0Dw" +
+	"6diedHeEcEOWY8oXckzSOLlgSsOPpgfe6Nu5qHNM/4IZS/v2fqxNm0GDRxWHReyIkDK5c7aV3LVselGvuKoli7RSQ1Vbz+RJ" +
+	"IW0LXPzVeIh2468alA+IMEZzwaK+9GEMgh+JQPALGQPNmmtyMvoZKac1RwqwMen7QljP9oaB+dOnx2d/XYPwFyA/83ykB2OY" +
+	"FD6ya1xVDv7hzi1Vi1a+ndFpgb0P9jSlc0pdn5U97fBZ6VshluaRTP2QcsEHxT6e7ZjLqANCerXVYjZRo5schKhDPio6dgEi" +
+	"h7moh6KxWw4W4o6ayCNv6BCr3S/PqFi4v4uxf8H0O8ZkD9OGtY+dJ4vrk5EyN1pVPPNYp3FTK/4euq8cYGlpp/59xvdJB/DL" +
+	"2B/pH5R6ipk39NU3Uh6EQDDgQ+W94qrKBgZ5BnWYuztcyTBZJ8YeygmG0I7Q6a+Tb356whCoT9o1bu387B92ds5aQGS/5gI/" +
+	"ibmMkgWf2tUCNKWIGFotFEOpE2rkLwwwmgr6Ty/ob+y032gcMswYtU+eUbFoX8+CQdrItJS5sti+2Rn98ywrM7UJVXHdK5/e" +
+	"2a6yYs7IZ99IOQQMqAnAL00eFOgZlL10pzYwOUaAR7KQuYzSEOJd+jI2CZ2D/tx8l96j3QPZhZhLS8bkKB3dFzUMJqXrALs8" +
+	"CDEPbjiVn3J7zNWzHZXpI2LMbzj1Ayh9aflRoZwpspdLFtLyY87ltP1YhviD4llk7ONxkbmIslARQlq6N0PZgESRQ+ESIWGs" +
+	"1SLeq2AfM4kgYYnaJjWJCuPf/icqMSa/2/sukjWo5z3GMN128PWMHjmx/M523Sf1PWjw9bN2zp8+Y3DQGVnh5K1LDr4/7SAw" +
+	"c7XhwbLHFu3o6ipbBSvHLdyw0t8ak3LPuCEdooDRd/y+awcf77iqQcEzGGuPcc/mDuywXMEjghvRVfxG5iLyoVl7BeDIphNd" +
+	"FCtw3JnfIl4mMQEtO2HLkoPvTz04XxtXcPBqfocM3aAdAo9lMQNL71pSUDyq7MC+0/CtvUvbK2112SsJe6eUBHZPWdb7rlyt" +
+	"seCnB8fPssMi6cFcRLEoTaRzY907k9UF7qxqd6CMGHZYJOP0wUFVr9ZC7hakLp1EgtUCj+wy5hEr6C5uHpW9Ckf5Raq7Tgq6" +
+	"i5snyBihLy0/JZTDEXu5pActP+1cjhYjJImnduBwKnvk+RK9B6QNQm0YusoZ4I4GertY0ZyL79n+waeh8/7nrvbKHj30QYp5" +
+	"ARGgKhxx8Py0B4GZGxXbacLuKn7QdT/IrdAaC390CElV7uCC5Qq+xyxRPnIlSuRDc8U696HQCndy1EIjYZBdMmM7Tdgzxnbt" +
+	"ypX9z/3TM2f0kIP7Tztaxfc490qZL/RzzYEn1G26IUSZb21XHZ0cMvj4W4sommdwuDMoTaDpRHejJp6dQOfo4chefon6A08I" +
+	"fCJvRb7m0vInhXJmkaP8Gi0/61yORmMfLoNrK5PwIylf6TPrh/Q04yC17IRtkUJYieiXcBB1qWj/gSU1SqXkMu76Dt+5pv+D" +
+	"1uxJfT/oM6WnqXf2/V3XL/nli1X6Msg69pRt9pxH8cSKJ+bAPatTprWd0C4pqd2kgfmxke26Vn2zeOy47F0zmM1pZaZoh323" +
+	"gWtLbzhMsRoE+9KkipIIyl2v4rlYMFvuPl2Q2K1q0tfPH7B2yU1pDyxehl/Y061qcGBm5WrwmN/1wbtqUjtoF1e98ECVfSyz" +
+	"q1k/FI0SnaIaVnHfm3gZJ0XTzlpG2DVH48dPPnR6TGl5pjm6b3aIt//gHu93H+zvE1rYLTTbOrx0zOktiSFtBw9q++ijVTHR" +
+	"xj4DjPERbayp8fGp1jYR8cZhXSPiYqqYPlKfyLChQ8MifSgfKb35DtQO6skdF/jg4Aux1Z4Xx+ECuw3HbiDrO8oXxPKe9vUd" +
+	"djWt/6JYPps9bV8n4qn88IPqrkNxxY51onh+UO06FK90rBPFw4LadShugWOdKB7W0XWoMtyJ96HtJzA22v562n4Z9pEoOZAZ" +
+	"NOn2WC871qX9DKEc/Oq0nymWB7GXHDFDpRArRIwYK1QiLSpACFSiF+K4J8js2MsobPJSEDMrmh5aVzkmQ1eLgJ3QPqqTj3f2" +
+	"oG6dO2SlZLexmCwd8mVhwOR3nTfsjscG4n/89vsy68dPWdD9rn6F+2gk5a4Ra7mJPTm/cG1ogMzLv9+Etkbvfl7BweEGuSw0" +
+	"MaGHIfb6JCXDhPd7ros5I25Qgi4kcUgRAPuw4BfPXeCQ8QkSJYoQ7kVOcbtGoaCogQZ/AJo72/gl4x8SvEKG2Ncj/h6U1qdq" +
+	"VHS0fdVhRmztqgM+PXfu5OE7av0YH7r/tpG+XNdDGu6V8VFGGckqiHlQeF+FrEEIatc9EkJ2CPPUYvwyt5nyczxCCMgzv0zY" +
+	"G62nW9eU9cP3BqfwPXuow/44YgoP7yALGv/D7K79c/A/kL4/c3N0XqeKnUULLnBjBCKbEmIebh+lHggBKwdNnbqgvSzKLNAB" +
+	"lgrxC3C+MdAES0s6rSJTc9x+mPrI8EEklMltstOOmS5RusRBnUGF5MK7hF9S4RDjoNwmAQ4B7wVYyf7C/C6T8BOoHC/ASu4z" +
+	"ZhqS0ww7TlIrbNLlRctW2C9MduTa9+XW+jqMfkJBjCKRV5ij25iDsmGDr8+s/PsXxheoT47L6MncU9irXfG2aN9o3ZCC6bsO" +
+	"tlWnZwQq+8l9QgoKYh708+heEloS3A1vgPFHR1jbxmxcmhvd8UR+XFyVaH/VvMEWMeORinKGwCJg7mTp1EJN0n1KWN++dz5a" +
+	"kT5k/ajEblzAnN5Fu3r2w9Fp62LlCdGGkrY9faWpI4YlVXVMPtU9rXf/qnUlhQHmwi6GNlnletrfm1wWMx6FoUSX/qwWq6UO" +
+	"3nWpRbue/4zQdYyR9N07L/OYPx+vLSuYvhsXUhg4ZapOl6SPF8GI1hE4wnodSM6Jq3qegKIIIMTRluuRY21H9AUlbKTr2g7z" +
+	"hH1th7xlDPb9DewlUh+9SuZpeyxejBHVlgv7P2jMivlciFUhRohVMZeRF4oWIxoSqUQTDgrBAXcKOtjvtGbKfH28vcd1rmRg" +
+	"7yiO2QdaRUro8PzpPdrHRM1bk5J6uGoYBLdNThnuk98/p00KvGOMPXhseLe4KlNEZpF/0vajCwsQa1+zQf4oHmUjBBY7RwXF" +
+	"Kk2xT40aYau4Q9MS9oA4U9r5AeMiI3R+ytQMlVIa8GVihxg/WZvYwphOLz/3bYZPdPtdM1aOWzqXGcn38/ZPjJvkLw0PBt+Q" +
+	"dp2yE/Sp8bG6cP2MFd6jC0va9e41wNPo92jBiXc+T00fmT15XJsqKNAbN4z2UyTkh2uzSgoRQoi1ry9RmiWKVqCwIiattdDI" +
+	"MpgIMrn6xL4QZjToIFluKB1JlsL2eUwrnzYvp+0GsNBFsHnTyqd57IL8qPz84pGlhqriAXQVDLwWkNWvieOqxk2ckl8xdhF4" +
+	"VFVN77RmUuqAYsf6ELNMiPEiRojxMsuEc0d6Kq9EWjVqcqpB0G9CrIJGjoQJS5Bzpthf1dZiyfLxz2gLJZ8dy9VM6zLkKK6C" +
+	"gplb0sbELEgY6sl4Bu0yZ5bkzFJ6VxWndw9vExyW1sUSw1XBzsW9EkurioduZKWBsf2XR6Tv6BIETPKgNb3qrskgsK/J0LvB" +
+	"LWaDUeS2QD5R5hx3U6jdrqiyn/eEtsEhMYmduoQYRnebGhYRMXJ4uUbr2yazPcOOmPp89yEPjsZX0/dlbMq6r2fhMq1JG+vr" +
+	"EZOZnZc1xE+y7PmqI/skcl1SSPjcYaVlET5tRoJk24AB26aURHj4230YCjPZ8/As9nGKcfuI62JieWvWlWpqavJrfmLLJD/L" +
+	"DMo/hVg/fgzihHJuCC3/y6X8O1Jfer+j/vzacm6I9H5HfbEcKbEPWyZROuovwN9R+JXYhxsiUTrq03IEqAuFRynkypI6RdzA" +
+	"RMen3MQOnZ9SWLVuaH5p8Yi5uON9sDA4IGof2x6f+7hPx5ORVZFbuQ7VebHWu6qYY8jeJjdEsDXAXYtOkXzma3P703fXaTsi" +
+	"vdPQabsG+UPWwLw6HVQXHOu9q+r3NgiRE9M1vaUdpcX0vF1bhDw5lclsNKdSLZ4raBc6XQiZwlVKiT9NY80+zG6uHlM9hs04" +
+	"I5Hw0gBlTLde4dpX9g0+1rvvfR3LZySYevRIkRZXc9UcW207hvGGualZqqDgiNjSAe0Ki3c/m5b+RH6HCf2Th5j7ly4Zvl08" +
+	"73mBF+7J8EKIoCpltaxCyw6Aq2/B1S3HbK8d+wke/VTHP3KtpB38hb2YQ8w8xKKTNT+zR/hLyFPIdkNu2BDgBofbRzSKNsqo" +
+	"qH1Vmvj1WOuJe/H1OQM+vOexZ58Zsw1/NmDm3sp3+8ScP8IswLopme37b2L7Ksrnvn1+zpxv1k56QMGnYtWi7LRR4p0YA2ou" +
+	"sMv43xGPEO8JGk9glbYOzGNTbI8yHcNYD9ZjoW2mjca/GXSS7P3k30U+SEWz6JJ7jdQyqYTTWnMYmTEBjCd7wOC169WeuhT8" +
+	"Ef66x6MnMj1lD17k350155/heNjCqqNPe1ScgzmDjtztJeSbZtvxv1G7iOwrl+rFf1C6l1kdjJPgB+GDqEj+t+mP9MLP9RM+" +
+	"EHL6vafz751+y7atft/xMwRoOUIMya/tTU9k0kMX5N7W5Uzok0/avnnySf78yesbTp7kSAZs0vbr9I4LqVBfriU7xnmtXHuS" +
+	"Day+xMzAcXCO/CM5vNlC+BRHn8Q6hBCPltdc4K/xvyN/pERBKByRUkbCRUYbGFmAJTqSUweAJcCqZeVadQBo5RIG5FoDw1+b" +
+	"8Di24YvY9viECY8DCyHAPj7BtvXY3yG43V6cG/I3vgzPPPQ30wmeDvmbKcFfvj137tsQCRkQSb7hL23qcAh4DvvC78/hy8xd" +
+	"8Hs4vgxJ2Pc5CBDpBaX8eXt+75N79xLYxdzfNRc4Gf878hLv5SWiIAUo3buX6TjONoO5e5ztEf687Ssme5ltsW3uMiYfOXiw" +
+	"mT+PJAh5ggZ0chOUwutTbfdM3QsmBi2ydRVyfp9EyPMp/nfkQ/r2ZDWerFx3chSTDzXwN/w91bYZe2FP2+W9/HnbRSbd9g6T" +
+	"uMjWi0mwvQuXrxcLv+ff5X9HHvT3BDr776fZNjt+t8jWi9bnaf3f+fNOeXYR4R+IfCT/KchzKRyxPcLnXnuGuQJHq3k4sheO" +
+	"MutxNHzKFl6L5bXkG/lnm0g+qQ3gxF9y0ztCltQEJsqPUYYzKZa6jOYkUYZUS4qaZ/v0Wffyhy+t69Nn3UsfvgzL3PL6+aLR" +
+	"nTuPLoKNX1z5YtfQobuEP9CvPqs/6NlzWs+eDh5wY/hLSI4QkOReJEGkTm4xkfuTeOaDX/GvGCem7L1nMuTCNHyA6T/i3Ol/" +
+	"JNee588p8VP4J3z8Hkc7/BphfJjkJMe7XCf3BPnJvfAqvLp3L7ZgC3/etpcZci2We9J2HzP0ej7VC8sRYh/mf0PeKAwhlJoA" +
+	"UX6gDIeUHKL1pTR7XwKk5kBKOLD+eZOWTsojHxcmDErP29suY8i48Y99+th49rsN8zp1mrdhfqdOxe9k6v3IxR5++qy3/3hr" +
+	"9uy3avXPB/z3yAdpEPIAlZKRSnQUU9bhFEiZddDp5Ldj5u+9Z3L55Vmz5488bGjLf48vVq/B75RE40H8eWX1xKlTNu8a148j" +
+	"OqHmN+YA/wPRIZ6s1JPVwDjIxC8AMxWvpl+mTeWK8V68F3+9CHbBEBgCuxYRvYkQN5//DUlpbi25Tq63cvNScNle3DOFW3lk" +
+	"0/U13NFtR2r5k8X/QOtqgN5dzL48zRa8dy//w6LqV2xv8OdtgygsF7gxlI7IE6xUyKWeYGTUePJyGANjVuDJsG4F3ow3L2d+" +
+	"Zu4fRlgwzDbY1m8YU2nbOUyQTYS44fRekhAyj2h1xL4iy6Rmk0rQ1tIgMiXKjAZWLkYymJf2bpt2991Tt4t/SguGDSu4Sj9f" +
+	"D150H7y0d1Hwa68FL7oPp+9dFMwutyVpisdByrgutk7M25riCVs3TyhGAk34uQL8Wp0crDRdtQbYzL22Z6bC63uJXniG+YNZ" +
+	"sAgjuIDDiHpYQsfTr2wh/yiSCncHolQDuaUhJVoSSSEnCFgiKejETBXfMvdP3Dlx4k6Qv4k/f3Nk2+TktiPfhCjx217hXeSx" +
+	"uXOPzU2+eBHaT00MCU2YAvkXL+Kz4vfn6FtRrubzl5AX0RIk1VoKR27/CALhkrko6cmZ0z4BFr/22+m39t5jiOXPK7598u1P" +
+	"bSPZkoUzKnZKqGzW/MzO588jL6Sk2lVoIsqoSJKLl+ed3PsbmLHtk2kz98Ya7tn7Fn/eNvLTt5/8VsEsxwGSnRUzFoo0ZGZQ" +
+	"+wEpzMINGwNgzt69zN0nmfXXYpm7hdwhfBD/G5IgP+G0gtUTkF3PBGGPt7DHFngFXsanO48uKhrdmdga/CPtrn3MG2wf2vVG" +
+	"TY19PgiQqCR2W4DaLhLRcvEE0WrBadgqWCy84drH9LeirAZIVEpk5/1KUT+TmYPcCzWAWcw8NAEH7MV5bBzzki1zKeDr/bh7" +
+	"sb+gR7fBl/ynzEH7HEvOLfFmrYrEiuBV/BYkM19Csu1v/DYkwZcvk/8JcG+DbP5Tzltm9B+GEJsvk6oiEaoZLL6z8p9yfjIj" +
+	"Lesnk/pXIMR2EN9l8J9yXsI7GCaTkt9DhvguU3hH6kOW0CYMc+pP/F3NYNd3CPGf8p0EWLhFMgP5KzzBIqEH1l09ew0BByST" +
+	"+Fe4tkf6wJH16ostNtU3iwTMYZFLvYraPrlG+nauxyJHfakAk0tdoU+DKhLtqa3nBkaDf0Wz6rng7dS3hPyyLoxsLaUFOKRC" +
+	"7YbqOdGxUXo70UiQH+QG51oqVYj0rtu3gHNtzxUN8cXRt9sWXft2oaC9poQ8u6nnCoFbXNy2J3K6LowQ2SS96+IsSq3wa3c4" +
+	"12nXHc515Vbs232bEOkO6/r0hsh6Y0ysiZC7uva+Kb3q07uhehUN1KszEurT27+iloJOck57dlPPCWe75MCiZrTnbkzX1q2o" +
+	"o6Oa13dFQ33XGdtu6V0Hzgbp3UC9igbqOdHbvXz7VzjJVq00VtTT3/VwbrReXT3VJL3ZWs3SEL3r6gm3+ttNe4317aTTSIuE" +
+	"Wo22KeDfSD1nnWZvsWl61x/Tzu056YmGcRbe2+s3Sm+HvhB0WYNyK7wRcW4ERjtfRNzFmo3QW5DKxutVNKuevU+XmnX1DrOn" +
+	"aftErNcs+4TZI/zFkY3bJ/X7bngepH1XNK/vOvXc8tqlb5E6jcJY0ax6dXVZPfl2hrEx/d1gvebSu6JJelc0pKNccG7CPnHp" +
+	"u6LpvkV925B94lpPHFnNbq8B+4TZ40ZPDEON4dyofeKMs12fCL9oVB4btU+cYHTCGhY1hotdnzRknzi32Zh94qZeRbPq2UcW" +
+	"SzxOvgONq3mT7+T+P0/QslpWBxrQstJH4clH8WIG8LK7bE/fNY5B6hoENfzka7/zk6/Fwn14KH/+peq/EUISGhd5id5VqKT5" +
+	"EfUkeq+g96JL1SQ5hpaGweUGHSs3WUxyg444xCTElUg+jKeYNm99deSbP7+yvQCj3jPHx5vfeyYyLCwSJ5+Gnx5/PO3xx/nz" +
+	"Tz98cXA5/urlj5nvbXOlzEwps8L2KctEMNd+532vyws+vViw2fLG8xaEOPQ2Qvwmev+ijEZukUK4sr8NgEKAQys3sWCRy4zv" +
+	"wFuwHMbju/FcnAxjoP+n69d/hreeBmbhyy/z5/EWvAgvwJsJ3nncqjza2dhNMBcm73TEsrjXKB39hfu+9CSLkvgP/E/jKxxv" +
+	"G84ssi1ijlz/gdLPt7LS9k2lbWql/fcT6L2SvkJ+QeG3JAB7in3U9jCjsv2IN4Ps9Gn+PP2ZP2lD6HtkzQUO81dQGDKIN2dK" +
+	"5cI2FfvtxRRtcm8xkBiRXKZRcx9P+WHWwTlzDi6ZOrArni9eXYzvFa8trj4epNm6VcN9U96x3fXMdoYIM3xXSW8t3gbjhQuL" +
+	"r1dwRergh/Bj+PGHgikcAxFiZtWhgSMqD7LTeDMTOmCA7ZsBA+z4X/u9spL3Jeuce/AIJozySkl+q5TSJSW9Vi4T9wyYwb/r" +
+	"4vSO+DcY9ejrf6fNruAnX3v4t0qvzkN+4D0rr23Av/1RuflZOy/4e2jsxo9G+olI60CuJYdNTsEo5vS66n9Y60qbCm8dcFq8" +
+	"crhd9Y/MW7ZkATCRH0fpvdmetA2tSvz5GGZttQf52bV1lfxEWpuosOMISR6msk9kDWnloANWS27q1NIr3oik8x3xXe3wKsCb" +
+	"4UHoC/3hIL5y+tqJ0/zk68e4ntfW8eerPdir12IFIMSmEY9G1FzgC/kryJ/eam+keTAjNWq5TCrRRhoNcpnVolATeY6S0hig" +
+	"TKMGuRATBOY8hDx4+NAhfBFffPDQoQfblf9ZXv5n+dDIsFWrwphNEaGrVoVWp/FX3j2P9+D7zr333rvkZv333r22NiWF+yAl" +
+	"5foYrneY/rRt5yk9Z77+UFj0aabidLTtD0LnOTWfcm34v5CcZqeTyGhSOJnUIjMkQgKk6lWGSIlSKOQijWZ1pCWVvpFZIiFh" +
+	"wNwdlVu3RE1bs61i11rm6UfxxXuG3Vlx+hS24YIfX4H8rRPmVZ7edx228vED8avb2wQbH8U1Jf2g8nFjiPHeCycnTb/r+9HR" +
+	"ISk/4n6XR8zbdI9tWWRw4luw+g9h78k1hPgIUa85jUe5ljGdtCl5HdbhrTjKIYfr6HI7YtHumgv8QBoz86X3e8hl0ugg0Mpl" +
+	"vEFBxFFJ8jzKtXIZikRyrrgbcNevAtcd8K8weipwU2E0ftN2EL/JT67ehr9dMgzK2XbVT+NdZfMggh1ZWW2+8t57V9jXqgfh" +
+	"T/H70BaiEUN1sJ7C6oWQAkyg15ILWbWr4ImTuAC/BaPgEBwegBG2K47rGu57stLDkv0W0rfFdREliR6aQCu2IDTiCVquM0Sf" +
+	"xJ9ePw66k/gz/AmMIre7wigIhuAB+Bi+H1/EX+EDtWJXSW55rb3plfC6HCF+nH1se9phpJ3oALxYyYnqa/gBWA09YHA/PIsB" +
+	"PA0/hO8fACtFkG1vMim2pdUHmBTbm8xcyqNqhPgQOub9hDbpgg4dqyZWTTDW4604GsbBQlx+EnadYhXMs9W/2dKZl1i/6t+Y" +
+	"Q7ZS272IQxNqLnDh3FwkRVEoDpkQglSDUa2SmxRyrdl+ZXAmiKvG5hwwRarBTLRkDlgtvNwPVEo1+/xya8GcAfjd9AdLdpy+" +
+	"8PUTY1aUPlA87mUTAMbvPpEU26ef2YzXZc0Zzyx8b1ng4BW2/kyIbfGhEeXP7H3y6U4LVyUkTDMaq/GqPpztZ66kU8dPwRYw" +
+	"ctXMaUIO2TtrLnD3SJTIn2Z+j6c6hSxfBwiXuqu0QPZ2yOkaN42zi9uSxA0Sd0J86u5JZy5ePDNpdyp+jz3Ux2ixGFOVvtXH" +
+	"Z8OL6sCNBYVKnz69NwaqJUrb3spy/ITtCn6ivPIgM233kuzI6jmR2d2mdmE/vnaE740r2dGjpnXetXvJEtaey5Rdys1FwWRl" +
+	"U0+vWibzBblkWUz5KEwnevsCA3tH4aZe3+A/wP/HrvcU5Axrl98tL7uoKK8EdzJqJ0yIXFcZHQ054AcBkKHTVUYE+iur+ypk" +
+	"ihC2V7B+0Q/f3mFwTxO6kkc5xtAb7YFuGNGQbilN5C67tthKiMfvpe6edPrbb09P2m0746c0xVgsMX2YD2bbnlEHbuxd6qss" +
+	"LNgYyJ5mhvzz08HKcihgZFBQjgfs6jK1W3Ykuywyewlzr0CTagu7ZMnuXZ2njRot3Dl4gfPm5iIFCqT7HbQK0rcTbQJUIJEH" +
+	"aBRaOt9yXrZXIb/0uePDjpf9ir8F7wtv/mAb9wg8PBTeZKu2ts9gODxMNXh6fBtIAy9QQD7++G+YPAd/gn9fC++d/U4u5l5d" +
+	"JFEiL3pqitBabiL79vT2RKNUg4mJP03w6eA2ptlKGTx9752pqTAY34/f2727pNuD/DGv+LS7r5/mOg8tSFtVmW69p7KyOpj9" +
+	"trLyid6lhO7DcSk3hY4XHUqgO7bkSqnaEgQg3ESeDbUs0JiJrtNGGVilyAmpBGoPhBDhYHcP5c5Vv1S0tvP9e2uFFOLx7/in" +
+	"kafG9yY8MSl9QabRbMrvQMR0k0bDXqvOfnU1fy8EsBOvbVkU1/bJpyY7GFR5sDoef42/D2Nn7l4i8Knb1C62Z13klqW5n56h" +
+	"9PKjtzKqNWpKMaOBSIvRkSBSa08QqWW3e0jbLeiSZFPNOQcJ7R+Z9tQL+M3ysqnL8Lucr7fPqLO2jUy7ZwZmwOlrJ/niSkcq" +
+	"yLdJQkJbH8Sit8ihb4nSscZB1v5MKp2U2GptZq8/cCCfCCVbXT2K3coEfv/a9T3CPrNPcCl8SPPbqVEwQuS2e2rjSHXiVlAt" +
+	"Of5EmoIFc7Ttoq2fvhRXrtVCQlHnvvv2qbmA62+f6u2f1OmPyqTkbdyR66vfLyuTnv3ent+POyrSoa69cwASmPwFtrXMXctt" +
+	"D+F3B8yRKKtHwwXbX7ZDjM72GX+cIIoYghezR6IU7S/Ca5LHUkFztdIUlypoY41uFzX7swc6F0F84RDYuKdTkn/vU1zStQFl" +
+	"Ze9zC6533jmkko7rAwhJj3JzURQyoGSSL1OhFS/1r8cQxxcCq76WgU5fDwiMWhNY/HItu0SmDZjDRAgcZUTOcmXHhlce6bxo" +
+	"hoNvh2v5J7BF7sRohdP3Wpni5rqRKT2VKVWrZYohfVceqyNRdDySXNLcXORNV3nJLTEpAXIyLzByWUBDlh2T+uHff3/40Z9/" +
+	"Tk8Yl5AwLmFAQnRlZTQcSogePjyam4tfxj/hn/FLkA4KUELa9Q+8vdnHvL2rx7KTwuIX4ehF8WzP6ntC4xfDJ4viEXLWCw59" +
+	"rCf6WFU7TdE5Spil6IKg3GlbnykF7sHnIC51z8Qz3357ZuIeprNdH9tiZjG5Gs2mXkQf52/SHLQdk3gcdExSsF9Qx2SSWmKb" +
+	"yHe6VgXr2Ved1LFb2Fx1lBac9BNxN+roqEIXzfSe7Q67WmI+mD0bpzurpoNOeuifq+xGJxVUncJ3qu7kooaE8cfO4+YiVrgr" +
+	"zSEmEaBKAEFIAGa/B/GpB2cG+SaGtjeu2WzXEZXHRlSw0jsD5K+dtc2h9xddYEdyc1EYKhTnAJpsWdgza3FJscxSZW0WbGqS" +
+	"aZrM10KHSjE/s4TYFlCoCyqI0o1I3GjNiooKLja0nRm/xNTubJf1HYeOKFySuHjMq48O2lvQvldpxfBO4xLunvH5sz2Ol7P/" +
+	"+GuyZ4xIMkXJTJnt5vbMlWnaTx1nshr921rzJ/auxta0IX1KusXJ4ras2H8qMmJOcnT/fgXFOv/EXesfeFqvRww588km8LuQ" +
+	"FIUgpDdY5Tq5RK0Q0wtnkltOhUTQcthrYGZDr8VQig8VjDqE33zqntmzx/SZx9ZUW4+W8DH4SKWtz10llbvfZaZXQm/BTr/I" +
+	"neXK7ONUb6BJpckORKJaNXa7rzY9rxZeFDXFL7Pfw2/mnZj21AuQQsYppPQiw5QZZ3uaDFN2a/UoYZgyD9iSyDBlDgt8JkGF" +
+	"dK6s1k43CiZ2FVhn4Bf/gBTIhMwBeBn+TeRtdW/2CJ0AWNQVIUkeV2a300FhYrUKE2vUhoA2hDTiDVp29YdzPq4+++Gcj3+G" +
+	"lLfehPi33hyAr+IfPvoYfyu0KLS6mR1D/l3fQ/Y7IoacYuG207b9nfwH0Ta/yoxYYduGX4BRAIAm4ZfhJN5ag3DNREgTwcSL" +
+	"YSGOt02FhXgxvCfiiku5zrTNANKmVcTVD8Qd/E/Ccit+DEMmZEBWOS7sdMekpUu7FRnZ6uufbGNXV1ZvYUdfT3tpbmV+f9oe" +
+	"yd/4G23P1+4/qIhBYwYtK7GdWcAUVX/NrrZVMZ2qBzOdbG+JoFVWj6qsZLcKMS0/hLjP+fMyCYq273cgMROZBAn77Ukc5AH+" +
+	"ikyCDPb3xAeU0XsCxPoa+hxjf2Yfo89tHL/H9PdtHfVP0/exwh4JhJjD5FmzkDyTmAWMpe/jHfW/pM8Jjvb/oc+JDnjuoM9J" +
+	"jvrH6HOyvX++gPaf4ngfRN8LOUJH4qvi+1TH+9fpe+E8wOyaC1wcfW+h8F1DiBtH3ws5Q/fUXOD20ee0OvRMp8/ZCEku0Gdh" +
+	"D4XFgX8mba+a7ksjz1n0fU+EuJ30WcgZOqHmAvscN1cmQQVCbs6aC1wCOadDdFlNjd3PkElQB8fzj/R9R/sz50Pfd6LPbRFi" +
+	"jtD3RcJ7XMr+SN93FtpHiP2Dvu+CkHOeEVRM4f0cl4rPXe31OQN9LrHXZ4A8a6bb30tG0va717ZPn3s44GPpc09HjtQE+tyr" +
+	"Dny97b9n/qLPQv4VUbfLJKiUPicjxBzgd8kkqC993llzgf2evu/noL8PVyaTkOz7NTUEC34LfR5An80IcVL6LORyIGNWqD/I" +
+	"kTN1Nn0eTOfQOTUXuFD+CpLSCG0QQiiS3k4hIyHQRDAQPy/FChK5TAOj4PlffsFZl5n1Z84MSJiYmDgxAX98+jR/BU+1+eKp" +
+	"sIb5vVq3Ds7B+XVJ/v7XPvL35/5ej+Nw/HpBf5QhxJlpvMBPmBOtRO+btXLerAfb6X7QproDtMFPQr4YH7i2joQCO1V2Eu8K" +
+	"RYjT81eQjIxVq0nc4mYk06AfeIOwp8uP7MZWaa0WZLXIyDT/9bfgUxpRUJ7dn+2RM/0J2/f3fwJMbFHvn+DX6h7AwDTr+NkV" +
+	"K45Vwhub2q2D9EHpgdykg5WPvYU/49JH/fH6sCOb78ajKyt5Jm/YUxB0cJMQE1pdc4Fj+CsoGrUlI5ePMpqpUy43CMeLiBnk" +
+	"D2aLsBldSiZezkwmZmMQkPxq8MeDT4Uf3z6gU3L6mbPmBHPBmucC37r3rd8WTl14D/7lzOiSnAFhncf4FMiYTV6VHkykV5bH" +
+	"qHbvPDLvkDbihfWf/XZPn0vj1kQzm3Fw2Ox+RxBLpJFrR2kroXtEdQohZi8nNyoSD5rtfIY9iX/D82ApC2N37MBbsYqQmXum" +
+	"+gL7RrWpklw8v5DiRnzwQP484pCHPZe1BSwyI0ttOzpXk/CdVtjrD6+fHAwrz5wZEFe291Hmbzz5r2r8G/7swOOHXpzG3g1f" +
+	"2mwh1d8Ogk/LbEXMB15dmF8qsc7zT9zhn6wVlchh65r4K4hDnkhO9LaehN+JzEWAaOkqUjQqoyFSKrcIdmckI0Os7vRp3Keq" +
+	"akDq9jbR29M/3bwlfCX+o0/W4M9+/PNzXP0XJEyqAB8IHASvnsB3Y92mFJkHGyVRMKuzxvhY4vEINf4FJ+IfYA3zCexDDNFw" +
+	"3EB7zM0xH4GWTce5z8Az1e24SByGF8A3tvI6QUIpml9zgdNQHHxQNGqDklE6yiOYGA1GJBPMUIWZ2mSRVCJU1BaLJGEoBTFR" +
+	"eRHTIBFljWjTs8wTEslx2599wo3GsIKpiy/C3d8snnbHPb/ggl82LH5IIgV1fGVcXGV83IjY2BE/xoSV9gmv7PzDNyWMEV/t" +
+	"GhIA1QEhtk+ZyIcXnXj77ROLHo4E3QvrPrp06aN1L9jGMSNKvvmhXCJhNkulzF7b21IpkyTVYi4gpCt4dA1BHBpac4GLd+aN" +
+	"oAqclQNLZT/SKpcIxndkgAzBxNOn4bArb8BH5A1wf+F3+YoK/Ae+NAhbTsBE+Iwwp/pzicI2jTIHtqtBDu+AGk+16fFA5JjL" +
+	"QuhcpqDPryLE/kjnGqV9LmY/o+9V9FkcozIJUtNnsp83gdbX0Oe1NRfYP+hzIH1eVHOB09L6QfR5C0LsVfo+WDxbR3kskxDb" +
+	"taYGTau5wCnpcyhCdXNaOeWnkvClCLnkPxpQm/vUnh+n7jkvp7wxEr6szu8HIlQ3d4LTeSYJP9UpL6KyNh+i8xnZumdHnc4m" +
+	"SviJCNXNQ1P3TL/TGXAJPwahunlEnM5wS/ixtfjYc+045TWR8MMQcsqfJeH71T7THD79XfIVSfhBLrlpJfwQl1wVEr6yDn1G" +
+	"uJyJl/Cjattn7nXk0XLJ/2nHj/3Icbad4sN+ZD+LTs995TH9AgyBncSz64cd+a86sokBBrWfPa8PPieeibzYqjOROm39M5G/" +
+	"O85EShSOM5HGohde/NFsPxOJMXNZOBQ52V/S+KHI/JPvf+R8KHLlFOdDkcI5747NyovF9nOXF+uNk83IiyXS9H6aezIBMvC5" +
+	"AElgJ0f/3Zp5pv2q7S+4urStXxK+FOcROoTZZfu+/pn2cIPrmfY585zPtDPDa89y0wsg6GE2ZnhJp1X4EEQPqHOam54zZt+m" +
+	"55r19nPGOm3D55rp6eKNfZc+VpE+BH//S2IJHzC7T+3J5sRoQ/eYNgZ5A0ebw0Mq9eIZ4Dz2krP8sT3F/Eqvtjq/0kmSuuit" +
+	"XTcrv5I9twTRGyS3hFOuBqOQBUIm0VgduXkutzY3z8tblhw8P+3g/KhYR26et3bZE+KsXNyi3DxOMEqU7wjPhKbc8QCDWinm" +
+	"TrrfnteGud+JBwmQ4cixdz/3Nc1TncH2FORYKOc6csXOusGeH4O/XHsuPECi1iDklJdDohRyHR/F57ggMW/LEjI+1Kn2ckm4" +
+	"UM550fIoassdrXmSXydRIiOyEo/KkVuxsfPXRArqaBu+jq6iWRkXJXZo4yuLie0QU/TKs9+l+0bn3ztj1bg7Xl/VqYT9UNLP" +
+	"W0b1Tlgw/lgbrvNVpmYqlRKFI4tjA0eyBw2HTJcz2Q+6KC0xVyTBFcW44OOaKs9FM9nTSM5xSZSXfw/RT9035S8peHHD884J" +
+	"Jl2T5TkpKpJ4kiH9SyfTHEExdXIE6QVwTK5ZiyRtCARciiMRUZexu6sZAhDIxIxFB/adrkHVj63qVOLI0sOLwLjmDho0HDnk" +
+	"YA7ldwIbYJcDgTb8HiEfh8IOTGPKUqe1EwesS9v4J5O05HaVKQm9Oiit9GW8zJk2jSvQQcMJfWre5OfSNbxE5xwUgtbja4XM" +
+	"cTzYWUuyu9PWtSUKsFtMW4NcAltI9oeMwV+t6lRCoFzhUJV9X3LSho+MGJY0aPgjIowOhYnsdlntmGF71tKq5oL0PokSZTpo" +
+	"lQl29dK0ojS6iFZF/5hO+2KMg4fFiHn1euUP0kamJ8+Vx5ZmZ/bLs+iNKQX9X3SmZDOUJ8kON2i4na/PC/lM6oxhN/mL7ECN" +
+	"Wn86P7HbmclfP38wrUtucr6YxmiPMxRuMho5ZAwhXtQp7HFuQYBEbUZ2+Sf3D9K8ZfUoV0cxO/KaEa3irNNrySaPGdhn5ZL8" +
+	"riPLD+w/VYOu5nfI1A3aTmjF/bbljgfPTzswXxtb6Ey3uiqbqPJBw0HvmrusFv4Etp0d/poaO99pPr25VNfGOOTEMabscuJS" +
+	"nli3vFbnsqcFnVtTY6cPpdtntP1YR/lksf4jtB2dA87nxfp3cMcDJOo29rFM5BZpqEWhdRkxIrHZF/PW4A/EsfFi/5i4faWl" +
+	"tePAnnIbMXZaoLYow5VnVouVJo2szc4g8E1UDXQTHk0vIaUSRrl2tXhFR0uob1q/aR37SVXLOxT2yCxtq+rYftKwO/A6ov13" +
+	"6bNCjYnR0ZASrc9zME7jMzgpxcowhX0Xx5s2FkandBphWv32uhVxxkHDoV9s10xTEEDbNqbuhiBxz1YHz3A+k8bHEV3AkWvl" +
+	"3IMLF1Z7e4yBocxz1dHcn9e96TznXNcbIatcKzfKtXKikE96jFl4dSM7Y2EHUnsN+0m1fjXznC2bxh7svxNu3yere/XoQPPS" +
+	"glODkll+Mo0qKlKtkfn5yTTqPLVa7ncty94N81y0JjhAJpOFaqL1gUEBMpk8ONB2sG7nvFPfcSgJpZLRBLSzeiDUKxZKnWCS" +
+	"vhup0sj8/f2CVJFRKgKYf6DKhiPVgTJfX1mgShup1sj9/GSBqmv32QGFXJksJLBTYJBCJpOHaPTRgcFyGSOXyUM1nQIJBvKQ" +
+	"wI6BwQpZ9V91gJc4wR6L8lAhKiKRyKahdwK+dSjhnyJVGrm/v3+QKk8VSMqClPhKy9EET395iKazRkBT0ykoOMAfPJqBOpI6" +
+	"cFdR3PuigWgoqrBLTi0uQkJj5FaeouskhRMqQ11y1BM9fiUhQp7ahS7wqb9/oEqrpdQSX2NV/YrwZv16/v+U2OnEvk4pI5MR" +
+	"uojEsn3fWeNCN5e3jiKmV/2X1bvrDTfkUY92E9AUNAPNuXXUkzY9nm8+US83qiBuMqG/aZa6QZ4O2pNZoB3qg0ajhegOtByt" +
+	"chm5tfRjo4yu+QtpaWTdgashxYiWGl3rMqQwAVzKUJRrLiBNOEglUhf1+mX9IR7lZtT/2J6QVaC0KtDfD/z9A5mg9kqN3A/8" +
+	"ZYG0pq+vf5CqfpHtrJvfXjvhYFBPuyLwp1pCHayQ2X4RGKEJVsjsb2vVBCmJjg4MDmAYF35RtWP73KVepwaqVV9oZH5SoSAU" +
+	"hrQu46QBcWbu8PMPVOar1UQgifAF+vvh5BbOTs7zk8ppfnLbu77pMcYs9fcLUuar1ISLgeo8VaC/P/tHy+dRfbOgl7jA3h51" +
+	"QJ1RSUPQt3rOdUdovlvL52G3iOL7Wjs5I9c5Kq6BOapFFGjJvN1ssrRiLm8+qVo9wdefo8ajyWg6mt0g9f6Fed8dTVnprbMF" +
+	"3NIZEm61geDphvZL0Qq0Gq3/t6nfDLvh32HK5ZYrSUj4DwwMLyfeEfuiFI1FC9EmtA3di+5rcOa4jewOd+zkBv/XtohbBp+8" +
+	"TewT3mW8JiITsiKE3M4m4JapzvTnUHuVqPC1dnG3XYpUa/z9/f0CVfmEcpTYmxyeXTtCDDumAn0YxvFV5nhb/Uf9OVJSz/dP" +
+	"q7URGh33XAsQbCJWgD9sHs7NstoimkkNZ/ugNvZQgipvIP7QEpq0JlbRXEK1OqbRbOJ5uI19VFD74KbaUTdM0lbYVzdE5taa" +
+	"XM2XW2cftg3KQb1Ey3Yauqt+BMHJEGjKDqg1AxqxwTh3HHPL2zoBhfa1NkD7RkyA9g4LoH0DVlkT3BFe+gcpnQIMRYGO+b8o" +
+	"sN78XxTYyPxfFNiAoebKryIHu4rqcMvVJnCN96xH+//TmE9LBte/HR9q7iD8D+JIzR+qyLuReNN96MztFXNq0ZT+n8enmi0g" +
+	"t0UcqwUyI6mjL8R1BfcWpd79DNoqOxN/ECnyTRWpFUmvxlfqk7Rx4/MfmTxY05kSQx4cSPUheDbTHq27rtAPDUJlqPJmYM83" +
+	"Qx/eCFG4/o3qsxuh1Lpm+Z4e9WjnWFe4CbLTapv4RmjKn2jFgt4NENr28w3EGz3r0X+8qOnX/TscaJFdfdPZ0ppFyZvNqlbH" +
+	"O73cxNzuEKM2+24G9/T/Qnz0hnjKjr11gdQb4fOcW74e690A74+jk6gKPXE7c196i+c0duy/a/TfPoLSzPVknzqyU4rGoSVo" +
+	"CzqMnkEvoFfQGzdDerS3k49wQybSM/+103AjAnabhJuRtIH4zy2P/cBGOmTtvKZjFz5zBHcIzakuUNarBm/Vq+Vvy3ezFeRW" +
+	"RGrq7rkR9hBYUBdURk5TNj2v3gq6NhESrx53M0ntFDgvakiZMeNvKhNc7GHX+Pocuo7ZWn/iloXLWhGPr8OlSG0tmyKjnDmA" +
+	"lfUrwlv16/m3OnLPjK8/h93A9Ocat6wb419HbOKb64/8q2xthavyL7G6tQ7MzWa/dwP7OwXeP/7v7PO8VX3cyv2jN19ObvGO" +
+	"1JuvOHwaXe94Ar37n655/O8I1eWWWAi3n6A1x4O66cLn28R6yxvowu215nKrxPG/X5+5BQJ6W6zk3HSZ9ahzbkeLrPa9FNZ/" +
+	"w0Jyuyft5K1j4+X6O8w63FISu9NEdfyT2r1Pdv/kv5tJ3DOkZQ7jrWFTszZg/SvM82rEt9x/O3qXbnl6e/icbhh9u7ihbpnv" +
+	"3ahf+vj/jGfafJG4LfzV5orJbeLCutcbPk34te/8b3u27mXqf8vfdSNn/1sucAPmBvKtJ3t1/eJL/yc9439LKC//9wbS/74L" +
+	"3YDw+jXDr/7n/w3PugHz/P+gv+1OwP8vuuBN+oeCXx6FMlB3NALNQ/fU8czrymJ9uXcWOPeSD+4kX8LWEX2hBffC73DZbc+7" +
+	"WY/XuinDP+UrnWTV3x9kfoFwVUsKwd8/SCloXapq65fZ3nPz6zqePPzmRH15sEBzIjDBGkO0xnkVXtbMDbK2y/UrypiA+jWR" +
+	"Vz3/Ph31QCPRfLQBPdg8D//2YGwTrv9/ye5mRQT+SyFw2d9VGyvo5pCDp24kWnB7yEdrwgj/pdC0Orrw3wqSj9u4QyWdDw6i" +
+	"s+jcTY883Mbi1YqQxG0ncq2NVPzH+sy3gRjGHIcc/vAvRTFuD/m8leGN/1Jmb3HU4z+VYr8GYyF30zn5PPoR4L+NhvxfFe7L" +
+	"t60x+R9ET/7LQeDfREzldfQV+gu8b7OoittumweM0JibbiWsu0Lk7ufNjbP8kOcuzpKnqhdnqV9kezKvsTiL0wjJqz9AHEX4" +
+	"x/Y3Mj7edfPrOsGXIs1/EnypO2Tolt+6Q6ao3ohp6IR8nfFSVDtciuoYPgw6iZV8rHS8mAuV3pEl18LDzLzq5dyM6ytZ6Zo1" +
+	"3Ii/Dgi5UJ3qegs3VVu1ci25QfwkqbyQnVW9YgEzz7ZUOv6vA2s8cquNa2iuOfF3tblQ604pgkiCU4PMr5SFfn7BSq3WHi+z" +
+	"fePaDfACiVwOGkhG1fbNO/p2yiPDu50BG8gC5QQSbPSjGeaEkxfiWQ0yb9gHiSxQ1U6tkfvbClzBnFd/qziTGW2XOcJ2gVH8" +
+	"1VrI6ZllB92czizzbudkg8LdjnhF63D6xdefpNRzzHCBMt+fW4JlrVKGlHqjSAMJjaMudeGZc465OtgJuhG5laWAuuyllaEu" +
+	"KeqJHftlnSM2skAljnCctomqfwDHUcQE1v9p9V+uNGL8akemg1C2y/VnMiaqfkUbrl9PJp3sLDMe9WhXmwf1FlGPbXos32Si" +
+	"vtEMzXDzCQ2eTegaTwft3eRB1bsbnnq3hrSs7qCVkmLBQLe61qX2eQ64lNWz5P2A2OzO7JjiJwuiY5jGFdorNQF+OFo4fqbS" +
+	"BPgJb4Whrs5ViyWRJBABbzmdUhMVB1a51GvXQDWbyZVDd9fXDPWnXA2YOotcoDOhXAZyebDtcGc14YicRBic1k3qFDFL3PyW" +
+	"/8Z5vHBO48WeB7Wu7LduauLHCkfg7CIUROJxjHPfznNTbR5Ut70rWjlfapsD6GhnC5/Iulx2fWcTsi5xgb02D6p72rmfsZqe" +
+	"cN3ihJ9v5SzsjiPXgtxMWs2Ym6Uu+Lufo5qmQCvn7OaTpXUTebMJ1ZrZ3d0cVZsH1T31/oV53y1NQXZLjQF3dK6+/5YaCJ5u" +
+	"aF+bB/VfpT7bSp0Gsn/dmPh3GNWUgeHlxDt3eVDdzxy3kd3hlp17bwdbxB2DrxtuAwPFrf+KWrK24OzrbfKTadR2ato3qTi+" +
+	"+glvNXI/W3tX8rDP1Y0OyoPdRQdhQ0P+q3Me1GaM+xYg2CxjLLSZiDcRT4Ci5tHB2T6okweVb7Ut1BKmtzZQ0Vw6zWu51dR8" +
+	"2nm4jXvQszs3N/ZxwyRtnXl1I0RuhcXVbMJ7NhB3Ifu4/5XYS0v4cYvjNM3l0a2M5zR/xCDkVY93rvlQ/0Mb7r9j6hs3cWb4" +
+	"1+NJzee+dyNxJ5oP9XaKPbVIHG6LOFVzJeQ/j2e1RF9I6ugLez5Ut9xxP5MqWmVvfiXsfyZD1V+jpil1LrfSAoXOzbyhwsks" +
+	"rbu2UJsL9YYxb4YuvAGCLG6GLrshIl2PbSLkVy9u45wL9cblprV28Q3QFEe10ky+IUL/c6gVIUfPerR3zoN6q6jf2jXFm8yS" +
+	"1pnZN59JrQt3urHNXPOg3jD3FP+CbX4DPJ1wSy31G2JzdeotjLZ6N8D32hyoty3nb+1cNuFft/JvMyFpcikZ+dSRHXc5UG9Y" +
+	"euS3kV9wA+J0OzgJNyRg187855FmqZMP6Zr/tI4gOCmYpvRLrXpp3n4bexJcJY5or6o78lXtVY0og/aqBmYHybT6O+Fsl112" +
+	"YtUduWI1G65XS8Z6N7TXpm7+06bn01tAV6dQePsG98Uk3lR6NxEwZ6/dTPK7rF+6yX/a6tj6LQtutjYOzyTWn+duYIpsRbi+" +
+	"Ducck55LG254Z5/06tWTIa8G4/o09+lN3tf4b7K0dQ7Kv8DkVngst4DxCLmxhevmPv03fJhbZS3f4j2jN1dObuWSxS0RHp96" +
+	"tsloccfQSrQbnUSv3Borhf1P5vCbbA+98a9bBHXEq6hWuooaEa4ih2wVtcRTuun2hW8Tayk01+nttJ5yy3TabbH2cpNV33++" +
+	"RHNL9KNHnXM5TrlOFf+GpLjdd3byljLSXcxHOv7WUVlQQ677w5Cn2/1N9lynzYig/asMadY+qX+BTS3zGG8V77zc7KsrRuVo" +
+	"BlqF9tzACbFbZofcAs/yFtkXLT/sdnNncbf89m7U93z8f8X7dD+0b1+f1J0CuG39VLeC4yYW7y6/6f+y9+pWpv63fFp3cva/" +
+	"5ec2IHy+je7rs+c3/Q9XAf+XhfKN28Mi+te3Fv5L0uvXDF/6n/9HvGn34vx/0cd2J+H/B/3uBvS1Zx1f3CW/qYs37j5bkr5e" +
+	"sa4RyQd3km/Q1xH9BhI8UeGv46bD047kRjRXS3uVWu6Po+1pW5zW5v2auSsW6+pX9IPP6tesdd8Zdxai3k0ZmBx55vQi02Uh" +
+	"trejNc7S4Xhdt4wZ6+bXrvu13Oc4bcaseHswt3kpVP47ljcRCviv5cDbzVqzS47T1q833x7y0eogwn8oM61Yyv6v5cjHbezB" +
+	"JcfpTY4+3Mbi1bqwxG0mcK0IV/zHQujbQAzDNb/pvxHFuD1k8xaHN/5Deb2VYY//Voj9GtwHQPzJ0+g19CX68z/bC+DWYW2e" +
+	"GytoSzcOq0HvrhC5+/m/v2+groznqd3IeF49Ec9zSHheYwKeVyvfeY2I963dXsC4WcvQ1y+C1KIbkfZxRe7tBv8m4ieOXKa3" +
+	"VQTlvx4HrjGVPNV/ElP5b8ZGM8MsqUXuwixFmnphlvpFzB1FjYVZ/tvxQvOTSg5JxyNvpEEhJMevSq40aVPM8lSdXpuikit1" +
+	"2iizPJXk3oXV48ZxHcaNu/4Y1+HDD68/9iH4sR9L/px1dfmsWdLZs2Zdubr8yhXp7Ct/vyeFqzWId7QdiKJREjKhTIRAjYjo" +
+	"6aKMOqVGZzHrJLooYgY13DFrYk2gM2qlOm5gJoR4BB0vzwqx3GUOySp/KNADQm1j60DFyGb9+uRK4GZm4w+yZ7KBs/ECg77k" +
+	"mx2vjx37+o5vSvQGWMKV1gMaH8F9GP/qJz7+2JHjzgn2otZArlVpzRYrMqcadFESqTkHTClqlRIkzcQDpsN827cRaWAZUpw4" +
+	"Ia/DzNQl9+L3Tc1FqDp3yoSINEge0r6HTjc3NHTpuFX4myQ3uPVvDW7hoFJKdFEGozkcTCkWc6ohFszCF2dkm4sr07fXwMO7" +
+	"TZNzE8dmDRtXWd61d5++A/pEDypqPzV5ydYezcXZdnfA+J7zlqmUs0OCu3XoXDwuNysjO0AzVR4wo6KwzJ4byEUmc1qBvSdo" +
+	"WZPcpNKpdGatubkodsXfg0a5ac6cxXhVs6VyCx7MhNq+Gf/YY7PdyWWPVkCv0aq0KnFqIZMA5aJaozalWATmNROhangN7wkK" +
+	"Dg66u3efvn13WaZkmtLSciqnT69MHJzcXASvDH3dk+O6Ft+xvEvngIDJIX6+I46NUKqQG161Q2WtkdQoqUSlJNhZLeZUo0Gh" +
+	"MhgNuiipROpKAnOq8Nhcbvp2S0hISOhWQj63x8XHx03NyfaMSk3NvqNLcbduS7bsKi9/IC4+vtmSuyvVPHBAqtmcOmCgORWG" +
+	"jVR6eXcp3pYcHFzcdenCbiWfn3tx+gwPT3cyYG2dDIAOtM1E9kMmFr80B4y4vNlsTar+mflGzJngBGs2GojGtAJeRLlImZia" +
+	"CNR1tkSKNozazkPRoXZidpS02Qqoz8HEpKG9eyUlJV0tbtN2AzCdO7Vp26VHSlJxydp1XbsmJbVp26ZrUVxsbNvNsW2bzdWB" +
+	"cbFdikvjYjfHDg5ITulUOS0jo7JzUnJAQIa1++y8vLy82SXpaS/mGvT66Dz66Ubuu6OhraAYCEPaarFKXOTbTipFCqWQThwe" +
+	"zZ2TZHHx8bHTsrM9Iy2mnCVbSrov2dytW9kwIupV3QYlJHQrTkpMTGgufa5/MVLp7dWly/bEkOCtSxd3LZk4UZBzmDJqkDAa" +
+	"BqWmovpylIM6ox6tliONmspHItApihLHAnKp2WqSN5MO7LJF8QkJ8eRj/Ixt22bcs2AB3jlrwdxm6/WXS0uPHC0tLS09eqT0" +
+	"oc/fO/f55+fe+7z6CPNOm2nG6ifrjfHsVuDKm+RKqUSclpvL3+VTMtMXx0UX5zZ7pNs+3dShsKz3uHowd26N1PqBwA5Tilqj" +
+	"SgBdlDDsLdbmjuPv4waa7j95aq9pUGzbocmTJk6YnFzWptny+KNGfeHNNy5qNNOiog8dOXw4Osp5PMpRCEpF7VAxsZMlDkBV" +
+	"SolUIw2QkclTpjal8C3FmnmI8+fXrlq9dt2akm6T+3gW9yvt2rW0H4xtnizGwQcff/LBB5/seyVmTBosgkm7duH1uyY0D+X6" +
+	"80lrrHSNSQ4mT9CBvLlW+odToMNGfDes3ox3T2m2sOHdUI7n4PawAY93tRFUSIviURLNB107s3uCQ/E1x8HooUxOK56fJp+M" +
+	"v8FfTg5Im1diSbZ1a9S/gNMzcjpuis/ctRtPgHvu3ZMZv6ljHvd6s/0LAe4OLYa6QeeiWTg04Fs0F5lm+BYCXqUtxqvZjkXz" +
+	"eNW4X9FcfFvgV9jlMLOlmNdxKpqHnrNP0WxJbNCnEGAvaSnkTTsUzUKmUX+iucg1y58Q8MxGg1ssnS11JprHxWb6Es2W1tb4" +
+	"EgJNUlvBe+pINAvRWj+i2eys50cIcGagfmhkS2G9ASeieXxskQ/RbG7emA8h0KsrGtRiWW+hA9G8ead5/kNzadMK/0GgRybq" +
+	"iEpaJz+NOQ/NokFDvkOz9XezfQf7WGkhnq6OQ/P46vAbmj2y3fgNArwdWyypDTsNzRu3bn2GZsuge5/hOayUPCr6DMkoGxU1" +
+	"7jO0DOOGHIZyd+gOaZ6/gJOah289frXY4nZ2Fpo3bzj7Cs0WsIZ9BQkKQOFIi9ogBEmekARJzXEOusEkvB6vw8ca9QeYf2y/" +
+	"2a4xEolXYy4AVwcWa9OQNGjwNwRXAzZ+IwA2aNbXg7dj0/A225BvkK6N2+6N4NGkue5GFuKaxKiOgd4g2M42eWPS4GyG16Vv" +
+	"bpPQNG10NwRgo3Z2IwA3ZFrXpWUCKmkS+hYb0g1Su5m2c2PS0kxzuS6P9M3hETWOGwK+1h5ujOyiCYwkdfqPRUWoX5Mw3IDR" +
+	"2yDNW2TnNkb51pi29eQtB3VtkgotNWYb1KnNs18bwbnZJms9fsehNJTbTH43ZqQ2hFtDdmljOqwJU7TemIltEn5X47NBPjjs" +
+	"zcZGjsPErAdHWtMS07BR2eC4cGtHNiYL7kxHF/kORFEoAWU4oGUbMyEbwMQOb6JbmxHvrIuFCC+T59ZMZCe6CSHXo2/TlpWz" +
+	"EdigfnS2+xpjtIupV7unxZNaqAgUWlB4ggK0DQHDPrQI7sfHFuNj+OgSfAz24rpGM5+MO+JfcEeQ41/gUZCz892RwYl3OpSI" +
+	"MlEOiezyFrPEvt3XlKIxmFMjVUqpQ/9IJUalphkGKPutqd22JM0dfunb8+MnwYOT4gq2p/kvVSdtbZ9qC2nUKK0uvv4cCz75" +
+	"m1Kj1yiWjPnhhzFLFGuiU+/p4AP8C9eLpb82z1YVcOp9gxg1ZMi2CL8GjNuWI9ocm1fAu/IG8W6uQdwyPjduJLecHi2xne0y" +
+	"XnRjlHE1rFuGvrOx3Qopd2+DC3gNujGsmjTQW4Roo0Z7yxFv2pYXaNAFjb1BqW+hod8y7jfT+G/FKGixTyDQq/0NywxxGFpE" +
+	"hFonohViUN+3EPDohEag6TeGS+sdj5bJQIuckVZIwg36KAI96a6nGxpHLXNgWjaXNs+paTntWuPrCPQqQn3QoJshf404Qi2i" +
+	"UUPOUSvmnOb7TPaxeEN0cHGoWiYXDierFZrFne8l4NPnBkdCg45Zy/SGW2etFTLehA8n4NwV9b3RebRhx69lTL3u1g1sBeJP" +
+	"ufUP6/P7Bj0gJ0exZfOis/PYCgF28Sk5J59SgSJoHIPValirhrVqWQUPCj2vAH3D/uUA/NmPA3/+sf+P+JN+O2E5/rAv8TX7" +
+	"4Q9hBZ5VB3JuAn7zLH7j7FkwnYU/8cQY/DXu/cUXcBCiYtg+bv1OQNtqxki+l0xA3ggpZEgbieT0cxt8BN2gO3yA2+Dj+GFs" +
+	"ZH6A6Yy/7Ve8Cq+2/cH4IMQ4fkv8ZcQLP+XkMnBqh30GwuBuPBFfgOzrO+o26g9PQhZkw9O/2q5hDv6q24VTH94oACHnlkH4" +
+	"IrmEjfhh/DCOgQ+hG3SDj6o/hDC4B0/AF5gfhIZgmtDw9Xvs/aE6bavcts3JZY01j59ruAOKkGsfGlcKN0rt2bABQu2Uw9/g" +
+	"8XXJD9Pw8zjf3htuVx8f5/YByWV6+lCvJzYGsvFzpAvYULcTPBdYqGYkv+J2+DnSn0sfijp9JMkb6qGh1vm/HA2j+vLkgNlF" +
+	"np7Gz0E2voAnwt1cYV3e+NeBl/nHlT/IA91VM0byj7QUSZEcBSEdikUmtJDc3IhSLSlqpSTKSuYF6l5HKyVRhlRLisZRYq9j" +
+	"Ed9IUnJYq0IjzeHMqQajwZgD1Cn3A5VSIyVayxAlJZrKaDGn2mccDTk9p1KGsxqjVRHOBqRoRAee1PRjpLxUL5WQTz+IMhh5" +
+	"o96QCLxRn8CS5sTpi9mZ2Sszs9doWYyPfxtYmtkrK6uX0j/GRxaDtyjDlMqwcwryeXd5hzZBHozfbG/ziJElSVkpsRl5/fp1" +
+	"7DItWzZdCYYtBlBNl2VN79KxX7/2mbHJ2cldR40we8/yZzwC25juKjCeWjx/Z+81BTPu6g4zOBwFDAevKHFu2NavtobhXCW8" +
+	"zDKAtRzM6H7XjPzVvXfOX3zawKatHzly/cgl2YEBOYHy6fThU+HBFmUxGi3GwfTzy+j0wX1zIENyPaJ47LQNxZ23Wk0xujv6" +
+	"9Rg99oFB7XMNvXqH+LGsX0jvXobc9oMeGDu6R787dDEm69bOxRumjS2OuC7BL+b0HZwuYSTLzp5ZvXLKkZfHvXzfAlNwgQ4i" +
+	"Io61OzJ+5crxR9odi4gAXUGwacF9L497+ciUlavPnF1G843k14zxyJesRJ5ISaOlMSgOrUXb0G5kAylCQPhrIsw2EsaL9rFJ" +
+	"pat9AVaN1GgFlc6s10joaxKfIeckiUVttKo1OZxVbUrJ4axELowJnC7Kj/EHiTQBIiVSQyIksLooP1ZKpSKcN6VYwzmNRV/b" +
+	"H0hpD6lWo8Goi0qQGBPAnJoDViIw4YxGHUHWzyM1ag05sckSwQhnyCTvJ40FiT/Yj50SgYsi4kn/A0mkUQgnsJHxadaE+DRr" +
+	"iKyNr79KmVRRwfgnWK0J8da0668lPGxKN7DpFdgzK92fT03YM39IzrRME+efnqmWcaHeAZ7QWReWoo1VJnnHtU1kokKMRpl/" +
+	"WKSfXJ7e3XoZf/6LtXuaXO4fEeYvNxhDopjEtnFeycq2WlOYrgg8A3xCeBlTJY/x9Vcp4PFthnTTw9d7eAek+nmGm42JQcny" +
+	"dM/M1OiYyPg5GSHx8RqV1j8zOnkjzNmQEp0h06rU8QnBmbMTIo361CyPdHlKYJLBEubpb1L4+ESUeijDMpPnHJ+blBWm8CiN" +
+	"9Ga+7os/7usfmrKmbDLzzoQlEyYsmRCSp5JHhAUFnHsXVgklzKn4jx8L3Gjr+u67G2ZEF8iDumRaozx8CzK7BMkLomf4+itG" +
+	"mft2NSq9fSLlyV5ti60JqUxMsE7hFyUPCiq5Y/j48cPvKAkKkkf5KXTBMUxqgrW4rVeyPNLHW2ns2tc8SuFfIvbJbH98Y+Bj" +
+	"H1//pW1c98+KPD1CfOP80z0zxvZMHWqOSukVYAwMMqp6hoWUlISE9VQZgwKNAb1SosxDU3uOzfBM94/zDfHwLPqse1zbpJip" +
+	"7QuSOiUYQkIMCZ2SCtpPjcFfqtXRKqMBeSBLzRhpiVRJNV0MSkF5qAQtRFUIAZFL+k9DvECJLgo0kiijVZ3CSmrda1OKWkpU" +
+	"liYHrAY+B+xmF3lf63jroiQ8UW+M0WCUSIknSf76sSqlRq/h1cKHOtJqseqtPP0wmFNzWKslFzThoLEQ/ZdLjhuHcyolEXhi" +
+	"pBoZqUQbaTSwUqPVYJQamWG9cnqmFncx98jtWb44Pzun/RJYnTbh2IS0HrN+MBSFRecmByTGZuQO7KCN5Q05ciWs5gbkZrVN" +
+	"UiTnRocVjfcOjI32Y719jBkwVubpqeQCDR+vHL1qzei7PjEE8gpPLzlovfAXPiqAQGwbvPF+ePT+DYMx1gAo/PCXntc7+Evk" +
+	"3qGcKsrPsuib3kNeH9L7m0UWvygVF+otl/iXew7zDavQVIx+6Hlsw9XPHRszLHBYiN8wT1g9rF1cXLthlaGhcHBWdvasBJAU" +
+	"h3rrouM7JsWVG5Ki/fylqXp5WH55XFLH+GiddyjHqgND/XzYzANLpiWMyJkRdN+BjOdVquczDtwXMiVveMK0JbHt4wrbzc6Z" +
+	"893MpUtnfjcnZ3Z6cVz77Hnr0sfMzNsQOH11hqf6rnJf3/K71J4Zq6cHbsibOSZ93Tz/9n49+ibkJOC/1+7atRq8E3ISevT1" +
+	"a0914baaMdLHpd50TgxDepRM97mNQlvRIeHuvLozIxslyAeRBiTOhWyqIB9EOsBRk0qahYqZISCSSkwOI4qNNAEEPRcOGiJm" +
+	"AZGcRq0RPBWqtGKBfEolkcYEoCrQIDgDxAEgmSKIsNG5Vq1RZ4LFatCTEJFdB9OAIfNq51GdO49aokz2U6b4qWO0IeoYLXxY" +
+	"NLpz59GX1KHaGPIPGGWKvzLFZho6/dG+e81Dt/w17d15sGHTxC4hwaElPaa3Gx4aNLzd9B4locGhxZMeh0II7DfEXx1ctljq" +
+	"ExQ+u7vUN9W0fdid2HbnsO2mVF9p91kRQT7SJUODNX5D+z6jCvNRRBulqtxQHSu1JHKX/JIsUi4qtJ1SaoxW+IRyHtN69pzW" +
+	"c1Sywi9Z4Yd7qiKjI8g/xoeWY14VER2pioiGsUIN28HeD/Qr7v3+FPz5a9urlm2KSxqSvHL0xEKzuXDi6JXJQ5LiNi2r2i5B" +
+	"D8xbsUPywPI4y8DcLqPnTppw1FpcbD06YdLc0V1yB1rilj8g2bFinu11ldS/Z0a4aUuiavncvmVlfecuVyVuMYVn9PSXIuTp" +
+	"ZO+p6ZpiKkpHOag9PXlg9yp1UQZ9qsX+lXc215y+K7QyFIl4i1WuY+U6uVpvsep4s07NtC8yp3bunGouYjw6j+rSJdVcVP1y" +
+	"Xft3dl2r8io3FD+Kk3AVM757wcY5Gwvhg7ygAbYxA4PYmswePTIzevXKEP9+4moq4+fqmNJjMMabYcwX3m1Bj/fDAPxJ2209" +
+	"K6uqRrjir6F+qRlloFyUT3cyNYAoUFroUg1REpXW6bu0AQJAQJPYsoMWPLxgjrWL1TZlwfEFs63F1lcaxJ/5oXF8GfWqF15Y" +
+	"1WvmzF72v0caJIAn6lMzxkMpmUBzLPoguUiF/mgBQmDWqXmLlXjYcrWGoKWnGCoawF8UDKVUSCNBMkskAiNltJGsgaWpJui0" +
+	"kwsGq1Az1UjnmkQyOxiMBm0kCf/nAq1qlDDfDwxmPmwXPADP3FDwareCDbYN3BD8GE7Ej3YTiQQ7xS/VbeFjKIHu8CHT26wK" +
+	"SSoMVcu8DE/8hI/jR35+wugp04QWJAWrLL2YOXAPhEI4rIdAbVSoRtZmrl7VdtVL+Cv8zasr26r0c2JI3UCA+6pGdO9ZKZLL" +
+	"m5BuuUBGOFaXvnvtDAgLiVAGRugNloig9Ej8/Tv4GkjeBnV4elCExaCPCFKEh4bZmadUBEcEpa+I9/N/afuXX+54UeYbvyI9" +
+	"KCJEKcQ964/LrGaNSEW9EanXqjXqaKvF3Tjs1qRjutAuh+z56lPhvRbCuEW96o/Axp1X/KhAubnTB62FtYPq4GcfdzmowZHV" +
+	"DAStKVYLfGWXix3icKpu22IET59e1Itd3SCLm4ehtB5+mSiPnnzUqKVKcSTwN4YtjzOCIDCwMCmwOvwm4c3FLUqLig0OscTp" +
+	"zJE3SAInHkuQLwpCeuqLI9BrpBqp3qjijVajFZolupwneBU+8kgh/ms9/rvDiRMdcJtWCS5nfHPCqpUT3uzT580JK1dNsP3Z" +
+	"UrmVokU1Y6QgrUD+KAK1RWZUhPrSc9hWtUbNWYklROwZg32+SARi50olfO1o1Yv+pS7KAKnR2khiFqvlYn2rQW8xi6UaizZS" +
+	"Q2wgsonnu5HB0Ytg7CJd8Kio+Adw0gPxf9kxftnnLfwn/ust31fYNHFg267axzrOhe+gPwyAb795zU6r17/hCx+00+nwJ9AG" +
+	"2mbtevmlXbteehne6RhnWANrjXGd0lNOnTJBj1qSfOmXnu73ZVunsT+jZy9m0LKzZ5ctGVSN99mJCAOrBzGWzj2mTOnR2fLW" +
+	"nxMmhNrHgzQJsciP3vAZj9LpeOClenBPGw1vBKvoWtShKvkES6RGL68lnJxZCbJC/AsjqUcD5kwh/qXQVjMyOJqIgRsS2okB" +
+	"j8wEn5mwboedVPfyI89NPI+fcEUafppwfqItp2OcYa1bShHhYcLatv3J9vZ3+B0HXRK/Y5IQ4h3jwh+FICNKQekINYZpHdvD" +
+	"TqB4oAMJvmsarXr2Bjxopw28ZB9a8E6j+NQzM5YLtKgUhlR9vEwo1w1eLdR5brAztkLZ1cXt9xYqN4Sc8SM63YryaaaBRvBJ" +
+	"sRqIgYPkJiWKRGaTUqoOA0t9nvJ04Zu6NalGA/tnXVMQVxF05o4ZmGlocwlXzQEE4wDNnpjyRIP85dZ27Bmiiwz09w4HYNxM" +
+	"ZwS9NknjeBgDzKv4IoS+cn5PZQX3MZjcc9uSHx0TqPFQpPOSBNRMXgPxHM0mpTrFYtY6fYfm85rdLhIDDxeJYbuzpcxmfqmL" +
+	"PR7WFLdr8dPQubsjWacj6QxVEn/QGQ1mg9ViUmvUGlOq1aIl5SYx65tUSSsYU62WBue2OnTiVYFqC8RvYdZZwTMqTR8W5Ak+" +
+	"EbZ11ggf8AwK06dHeULaOmZLPFg0OL9J9M/XoyL3tJ8+Ny/nrbMFxaqgAoiMTPYL69gxzC85MhIKglTFBWffysnL1fvhto3T" +
+	"xXbIhbB0L1wtnXTIRG1Uu22vU1Gek49s0EmkThTQ150ttaL5X+sqFVdsOrd50/lNFV3rK6+SenNhKRehVL+Mv8bfvKqq9Y+e" +
+	"29Otcu3aSvKv2546Aj3QdZ5jM4ODvvwyyHWcs2LEhOxaNZPtiVqVIkWEWtcQd7koWFSdCwvLT4vwXar+sEmesauXL1++3HZE" +
+	"hIdJb0JAnWH0o7ZVsgCj0/zQDAeBkbNl1e+0xi2AycuXM6uFGcDuBXzUEh0q5PQnUuOgLfnbFFXxw2zZ9Zjm0vPi8uUwu/lj" +
+	"Xch114aeumpg/uUFOGt3jZi1Lk/N8PVhFltWPQp6ifNvKvRMpVRvyq/nly9n4pcLZgjLOL4gDwf8HsgHyZCSWqYWsucMNGoa" +
+	"vbMS0ir0Vl8w6jVsi0SEP5YZmER8jHvZMi/8WiGYqzsWgqXwemukhj2yyNzNYulmxoXLlzP7rkz8dcKEXyfih1zl6O6WyZF9" +
+	"BqJypGjhnENGQHJr5pjJy5ezmhZPLG7Hrd4ImhaO214mVmaq/qI1PDgyFzbPhcdbNnTr0juCSpdRoWkwRsZaFWyUPZMocWKM" +
+	"9gSjQvyWfJUamCWlv5fa7m1yzHCjfu/z+8pEvZc693hukHdEoilsnJ+6nfA9Mdpblftwrsp/fCg7aCj4DcWLmogIfksq/Yb7" +
+	"jg6LGgYVMdqhQ9NjooYNi4kaOjo8atiwqJj0oa56QS368A1FYFir0apRSTUqqdFqbFDW6gdgHu20JPN7i+X7zCWdbB5ND5+6" +
+	"cRccnVMyY02/hIR+a2aU5ICuyYEjcZqvo1AbqiVKGrZs6nsbzUKUf1ETaGHitzBr0xjPqIzosCBPxiui+mR9n2NWi/BnHnJj" +
+	"x0CJix+CNS0kSR39TzIdZTVs1zcL//pxqM9bxud69joe3DKsanHyQYEOnFxgb6GiZO+uRYGRt0Zjfu+MAvNMi3Vn/ZhoGspp" +
+	"cEQ2tEqhN2tVOoqvVkcQbt3qBJnDP15wfAGYHrMWW1u6IlGxfLmItWus10u0Nk0o3Y6X89hrOUq1A83m2VKcbC7D6lLzMXKN" +
+	"7ZLzsCS62/BqihAI5IWwINTBpRn2FPOlEBOEbXZksYczJk2ulzwsRAPfFBWI1AkXqYu9GkC5Y6ZrY60yphTifg4pmRINZBvQ" +
+	"3Bs1qc5r4x+Atx9I0I4Kil50+vTC6GBO3Tq7yqZPM506ZUrrFGdcSxwjRO/DrvXzFXTOIHG7QpK/S290zOJ0d/5Nogg7dbwf" +
+	"meTVfuOk0pDiECnD3HwS7abTfVRMukKWlSVjK28awaR17LtaedHwRt4tZWgEtLnU4f4hkUw8oB4ZcDsa/MR9WkENfuSE8xM/" +
+	"d0Udfjo38bzt15YSoFZeZCiU7rmy0rVkMd+bCy4NKoRGQ8JsXbH7rh4++Pmmdd2vjceKp9cRQih2xRQuNq5T2F8bihpX+7jI" +
+	"Xj37Q4dSUbdGtKXzVF2PomRbRe0CUy49XSPWbYYe/cMeb3yvHknZ3rmpHcLE5aawQnMumf2bUqxsgH1ur37elXzsHauH9fDL" +
+	"terEtaZc357DVpOKrvaYjM4fxJd1Iz3NWkZyIx3weMvXkuqyn41q0TqS85xI5pFAmh8nXfSgWKuiPnYaNdnTl2ptaM6njhOs" +
+	"/r3P79V31+fWrMwgSC5IDrq+rBk7D6jH9Bv9sN1dB0+d6K+fbZzTN2m9GrSpZAMFCQZGSiWtW6/mpldPA0+YDV7VmJmpyi3D" +
+	"V4bmtHTBuvqBS9ABP1ZeVjxterHrPOhsB2Si/NZaAq6o3rgZ4Bbt1loCruj7uewJD0IlaAAagcahWWgpWonWo81oJ9qLENDD" +
+	"NsKhZbDjRbF0RIRVOrnBKNeZ/SDKqDMbUq30US6RytVKqUoniTKQKhKpXGd2CRe7iJHFapZIFakGx/keo7L2CAyo1Boz2UWm" +
+	"IttMjCq1Ri+Rku8SqUqt4bSj8ouHVnYsHIErHURifrJT7voqO1WTskO02SHDFpmyjcbw8OyIcKMxO2VRRXB2VEh2cv0hVBOh" +
+	"UoWHapSRtk/CIiPDfhlZ0LFySNf2o2dFKANDwtSqcPg+MjRsUHhEqPb38AijuvpoRJja8HtEWGQoF9xz3PSibhMnVn9Pqf0t" +
+	"pTxY7QzZcedjkJnQBk/ob+kY3S5rZxZkc2Abw2wGDj+XtTOrXXQHa3/Y0DYBv/DEsp11DNsHArXaQLUustoL9voH4Lu7TZzY" +
+	"rWj6uJ5BkTp1oFYLs+8Hr9WKGC+P35SehvDApdr4d4PCdsZrbVLv5zyV9eXeW4y1xSOzk+4S/zZzUDv0li3O/u16fivFW+Gk" +
+	"unA/p4fclsh3rc0mF8d1HupI5+B696ixLV3Rch3lSV21oRqFj0+kzJPz1oWaZa1wo91Sgnl5Woq/N+cTpVT5+UcrZfB0S73r" +
+	"OhrvhvZuuGLdmr0b7tndss0b9XQ479jrxiMfpEDZaBhCekFDufigN3kfG3/NjoztN/w3IUWtp1q9/mbvW3uNYs1Mc/FpL93s" +
+	"DWq1NpoUKew2mn0fcQtnfeaT5U8tX/4U/rJV61ADzm3efG4zm9myCR4hd769nmZkbGJGZ+xYNhT8bGIuLxianz/UJm16DDQ0" +
+	"eWPlmC5dxnSB/U2g6LwuJKOnYHQ0Z1AHp3UhB8tuaG3INpJykAm6eetDCQJbe7ZyiUiKImqypBVUPoXIRQQaizYihAwCxpHi" +
+	"30TQa3i9lXcQwpwARkaeSlbzAyIVak04qGSsH0hVOuEKTwUJA5OR7Q9+EAY6syTKSN4ZaC4DYwLZGh9AFEcY6NUpVuFH9MY1" +
+	"+kfQiVJykodUIv+puA1FhES2dUVk2/rPhHpMDiWlLYQS1vZ5Xolq3mCr+d6fmN0/7TRbh8xTd82bo5sWPAF0qVqJz1B9Sh9d" +
+	"4JyKH8fPDdT1SdEP8eWjUnXMhOBpURA79vKwwNg7UvwtMvDw8k7w849f/LLtxVcXx8t8E9RSxt/ib1oSF8jG9Jreo8f0XuRz" +
+	"Rs/LE36deOXKxF+r36d8wLNeyxmYDBow9hrr9cVx8MBXH/rSa2wv/BH+PnlgzmvfRUdMSL8CAaExuvG/T47TtwvNyr1eHB5Z" +
+	"cj03K7SdPm7y7+N1MaH48pX0CRHR331i0Gv7Zw4vjVIUzPJflmZJ8vM6tf7LL9ed8vZLmrDMf1aBIqp0eGZ/hNztz86kc/NA" +
+	"muXZyfDTOOkcq5MlqnHRRe6NxqZGNLvmjl5lM+b377N41OLS/vNnDu21NM5uSM60m3741XpWn7/APGvTQz14xIoNAytWraog" +
+	"/wZuWDFCNAq7iQZcUB3LzRZD2cIYmtJyXg76dUalaAgahkagsWgSmoZm0x3e9vlLTixzlYZY3jqV45/cJDfJjVaN2RApVVk1" +
+	"UvrsWsNIrHFqwzsRLFokqEJukitMZqPGZJYa5SqN2QBmqYqVSFVWuVlqVNUGJTKyQ6J4PjsxOzsnOzs7u0fh8s141eblhT2z" +
+	"6f9yEnJ4XhuanVk/aHGK6lN59p/Z2XMS2bhEja1jdk6gOiGOqQ1PHFqH74Tf42JGcp44fgX9H5OGPwBZ7sTRc8vK5o6emIuv" +
+	"QBvby8I7eM+TGxUTh31g/vrDrhQOElRvJZNmexn8H078soKLAt3C6DlqPPHLxIfhAYVmdjT+ZHEUVyHoX2+TdBw9G26fXUgG" +
+	"CwRaFWtSac0Knd7NN4cuaqG16fkZ9PniC7z3yw9wp7rfvni/+itKKaY1Rqd03Cj8x6BR4DOozt9rCylB2IKWb7GqF/eKpfsu" +
+	"iB2msRI7zNhQ7IOz08epLtOrdMQI+/9x/6aDXe3p4AToM3x4nxEj+4wY0WckGzN08pShkycPnTJ56OSHGo94hAoDsNLpF8hl" +
+	"P5SXmAUZtXBlv1VBD9bmOj1+1Hy94C3Oj2RNP1qM2LpARFZkTGatSm8UZkpyQylo6Sq+QgpyYwAwgsnLarT1zoZDJ1j4LCyq" +
+	"Hu40qVVvZ960pTA11e8c/+7SP6eVr1bnvq7k8wIPC/u3Dge9yj5T7zj8Y2Q/z7f2WWk5BOLyDR+9AvJd+HnI2oV/gRPAPPXl" +
+	"l0/hmnuFeSO1Zoy0VKpEHigM9UTr0L3oMDqFHkfPIITEY5FWuheB+HZ+EClkoSA7rV3ONQob9WrPNro7CSltzoFHidR+5NFg" +
+	"FA896lMTgJwl11Gx0Lo8AWlapTal5ILKYpWQc0RRRjW7YnrPtHH9B45JWz9i9No7+ODkv/FTV82R7LL14217pBDL4/NSX1Ap" +
+	"8Xcy5aAN5JTj+sEaf/yTAmS+UvwJBwaJu9ORU7lGTj9yQ9gyD8UQ5QB54OgjwvnHo6NC/Poo+gV4DOSYLxXxmXGK2WS5bYci" +
+	"LjNOMWfBwwvwyMjowHijNjpsbmlCXiqeqkhPS2CqE8gRyWp9+8iggo6LN6yb36WXRtMeXooepspXd84bnTsuI/nrmUuXzriQ" +
+	"Zh6TOzKnWJWv6pnq5pTkk10LGzsNWdBVWiAt7RvdLiorC9tWk/OQbDtLZLuobmXSgh6xAwoL+8etfv751bXfMCv1lnrdzXNL" +
+	"K4wdvMrLPfOJDJXWjPFQSJUoF3VCg9FBdBo9j95E59DHCIGTIETWnoglXh/JQ2N0Kz9k4BMnkWtUAHlql+RCKpWTJkXLnj4w" +
+	"EaiIiRImgRYLl+QPxtNTyQYaPlo1Cn83esWnhiBO4ekJ6k5zjP4JMbFB9Cwm/k6uGLTxfqh64O5BgX74RyX4+0uDYmMS/drO" +
+	"L7KFTO9lHddv0Ji09SNHrV3KhyT/hZ+6aolglq8fV/2+NiUtsYd0EDeAl8q8wzhVlK9l8YU+Q14bKkibkhw6l3BDuKEStkeC" +
+	"JUlrTJT5qmJGHX3Odu35o6MMgX6RzHZlbFZ8wBwibDsVcZnCVyJsGiJsoXNLE/NS8ZSAtPREdl5MnLvztW2NcUFxK0xZ+r7F" +
+	"deWtoCwmy7QiLught2KaZ9HHpcrD2M7SAkkzxC81KEyeGqe3ZJnajhpmF8LBk+InwvPNFEBXHdYWDUU70SF0Ej2H3m5Kh9FX" +
+	"4ZBCRe7fVGjUFBePmFtrz5aLh8qNFuHQufPZ8sZU2vCRY9PTEo++dyg+L3V09fv/iX6DR/pnpfWWxBaER2eZpN8Ix88VzCNS" +
+	"U5ZWW9hWGh9jyeq/0TuwjUE4f96AkhsV4VlY3LXQiyn5V5Xd7g5D+2YWBnprNUWxkb6+kkStf3yYRusTlN++rWHoHFapCvLx" +
+	"Zs1I4pJLoDO6A21FRxvLI6C/5SkBQEybIm6nF5JC6VOEjWmmFMd+tVRLY8kDmOn/ZjoAZptnaps2aWSzfhyT0ZZs4F8Qk0p2" +
+	"81tj04Ud/tb6KQPS/pVMALApqX12cufOZ9/Kbt+2U8cQ2ZT2SbKQjp3ats9+62znzsnZCBG70C4HLI2chKMYZEUFaDo6RvNK" +
+	"8NJ4sgivuL2zSYBjk779TNgpsqg/8n8goQS78vSi6KBREUn3f09Pnk2YcH4izr4dM0vghWtjYjtZzKeEdSK73PihQGREqWg0" +
+	"OohOobMIwc2UEbVDSsSZLBdEOUEukiFRSRzC4Zin7NOU1WB0limF87ZIxj6vssqbISWM1MNZThj7nMXWSgr2cpUNYHzs0sHW" +
+	"zkesKB/lPqyzXG2o3ZTpNbNH2tgBA8eksQebKyd3THUnJ/7RS/LsksILMxQvygqrcBUOaah0ZV+7fHjYZx8PUUL8yxa3dRGt" +
+	"6xnZJTPX9E+I779mZkk2fExnSuRdT3aGo11oP3oIVaEn0NM3WYJuopaxpGikhiip3hKpoX0E2GWHqEg9L9WzyttY0zC/5x7P" +
+	"LQ0pDsGn7od37y9ddPrk4ut2GYLn6T6zvVR7sgdvL8WjqKgYmpX156lTZWvXwjUqREz2hPMTz52beJ7GlezyFIniUCqqRGtp" +
+	"rFgqLHqYU83C5GUl3loCuAmwsZSjkVIwyCy5UFcoIiAcnIQCRSK7RPAB2pRoZ8s6EWodRH+QOInf3qkdwsjaScVDY9J6zuyR" +
+	"IPUNK5zmEpb7QuAK3rwY+/iHKQLq8TfA6FnLXbzG9g9eZ+cthDwLxz4Di5OYgFYWGOJn/HjlaHyJipZ/qJNgcStWnfTLJWsv" +
+	"cSxrzp6VkFARGJbrd9Ilplf9sUDyF+eB5Pkx5XXZVv76npedeIbPQVsHz77F4dftfI9d0mPFmmPDHDLS++CEsXYJEfZD2/mX" +
+	"iqaiuegOtBytcvW4b/WUwNbuXyEqB3mDVUGtnqn/iZaf7bJZZenSJ58A72X2LRTMx/+6Gu9Y3mXa9C7ffffA/fgxWEB3XAwl" +
+	"uy8aOtvutLuNLH41uI/dKVJqpkvx9u178VGjgnWL8MZmbu6sd7idk5J9bSnpZF/bWkPcu/g53A6ehmzIgidxPn4epjE+tj/w" +
+	"arzK9ivjPyyjV88ZPXrIhSV0BDVPc9vRSUkAYhGSm1jdidErJQF4Bc1paTPyfjW5/EfUXkZgUun0JpUOrzh9Gu85c4b/6LXX" +
+	"XiP1wHaSu1zjw/9F2gATq8PD97+xkv8LXyXvvHi/msH89ygMITDSPJJhoKPEILrGlGLJBhPNboyTUtWh3pHRkwd31VpjTapw" +
+	"v7DoScyg4nALn671806JlwyfIQsJ9/UxtZFUzpSRtvtyl/HDYr8KHYvR8bveIv2ChwBXJHe5pgN/FUkIXAqdUaqzffHg2fmb" +
+	"N83nr+K/Hnmkbh3WpLGaWNsXZ+dv2jz/LHcZvB55BP8ltBXES2q68leRL0KsjuIh1VEv7sQYn/5dOvf1G+OxfvGSdbzEkm5N" +
+	"mzl3Tp3fgIkiaxVwtQWP8SY/8h/juX7x4vX8VfuPwPYB74fX8Z8ItFTpbB9UVfGfvCHww8xdrnmM4itFiDWxOvLv5wNvrJwv" +
+	"EpwsXiKm+gh3uSbZXo9QRqvQsbZeD698A84dX/mGvSZ4ICANo+VoM+nPbFLpli/fvJn0RZEeRculCFlNKp3RpNJ9tXz5V6QG" +
+	"rcMKdWC9uH8KKUwqnUasOWnFism0MqzfXOmoXyNxtOmNEKmrqFu/cjOs32yHoUYiwiZFSGUii3YEQrGSsCbh2qa/0CqpJxX/" +
+	"fkV+8JXjV8JPAU2v+UkazdyLDGT+JCnYVDSKI5hj0azBahLucLCISTFNaklOTnq6ZdCodp3Uq979/uzxSfhDgIx9GWcGr/jh" +
+	"8ImJ5uB9AXD17UxzVVGv9neYMwzDwWPloCEr5udHMdaM8akzc6xdampqLiMEZUw/mUTZAyHhmcmlzz0pztNr9kmXsXIUR87/" +
+	"6Ak40U7gaFP0VjGntNP2LRF6IXencNMErc2/O7ii+k/8swPSTuD3NkBQRHARq3wwNbFz0VwZ18lD8vOvQyuHcL6J4dFqpVXO" +
+	"6+OiYwOzwDgSJASBqV0jLFxI3LzZEdE7umhSem3Wh68fA1Vf5M1RhnQ0BnkFZSkC0tqXIIbi8hP7EfJHScKcZiT7fSVSQscU" +
+	"Mb8oyeVfDwGCppYJ1meGxiRGR0NKtCHP9v1HXVZ0soT4pfed3qGfVLm8Y0GPzL5tVB3yJ1YswV8wQbFdM01BAG3bmLobgvAZ" +
+	"jc/gpBQrwxT2XZwat7EwOqXTCNPqt9etiDPSsUNgY8+wHkhL9/fZuxUpJ6SKd6as1EFEjT0pqoFp84BaqgiO1C2d8tycA/vn" +
+	"PjflTl2wVuGhPBCSkZ7uK09Re6hCs9MjNV4qk5y51n1zStui5PwUeVV6epU8pmtCcVzSur5+VV6RZlVYrLlArSpMSQgMTtV6" +
+	"Eb6XYR+JkvkGJaB2COkNxjpcphJKcgoS8kXTdybHJSMKiyvfNWouN7PtuNSlfPSd0d2Gp/SPD5Bo2J9/jY1Jm7JuVxU+0Scn" +
+	"bX9aoOWnBcufLueU8thwXYQiQeGZWjCgrRfvq4sd/2h28L5CRUq/uzPeh6o5He4uKQXm7Mr5+8vG7ijOWFIwDAbM6dtFF+QV" +
+	"khEelBWXqfR0yPJP7EcyicZqfyY0l0k0aQg5y74m1VX2NWaHzlkF/x97/wJeRZH9f6Of1bfsAGIgkCAgBAjhficQYhRU1KCM" +
+	"XATkEhUFBAQUHVBGQDE3cAZnIkQuioKCBJEoaoCoyCXGiIqKCA6jqKjoMMowODIjmmT3eWrtvUMI4Dj/3+99n/P8z4Fnfat2" +
+	"ddVaVdXfrqquXt3ZEvredGvzhzG0Od0vMc7/Ldsk9TRvR0jTCy64uruR21NS16akpqasTT3YtumFKe3aN22SklPcr1+/fsX9" +
+	"+vb1fWYG86IS7YSYNvGdrbut38e0ib/EmhDMi/Hie4TsB0/Ih/aRmCRTH5OLUfbQmKj4S0gPnggdt/rq8eSzHQ+NIfbmmDbx" +
+	"Xa277YRTekFKnC/DeqPiuzLKGRhpr/NlWF9VOg8F6zjF7vMxSQ1WmHR3LnP8lHD//d29JiapwePh9EHBfaF0p1jTn6ieHtaz" +
+	"PqInvpvluQ2q9FwZ0RPfTQ44G6v0XBnRE0k/Q08XecRpfBY9XWSSvfksesLpIT3OxrCeNvGdLM9pbNotm7lA+/cS++/OwLC+" +
+	"NvFdZZKfEhMV30kOhPQGL3GKnYFhveb4b08dR8DtYUdHdTAzS7Qkix1d8aMd7T0SfGKJ3ALC9uAIZ427X7/23UPqnfqjEL17" +
+	"JUu9VjuetpY/ffWAp77ee2PLVpUTn3b3l2c5c3+O/2js2BVduxU6c8uzlJI6f//NGRua48ycUq9VvVbJPTY+nZ//9FNPOWOD" +
+	"gTXBNCldY8aZ84Ij5EdnLHX1m+kJyT0vMoZ7NGzlRXl2q5ahC7ZZ8B8/PPf06g0xXQdcuHqK5dxxY6vzgyM+fdcZW77qsel9" +
+	"x0pMj0u7xhsuyWRvnJ0d6BHThjtZb10cExXf0rlchoeP3WxnB3qGjuGHjlljwsduDJWLb+9cLoNjokLl9dht3jg7MzA6pk18" +
+	"K+cua/xpOk8da+00ssafxV61ciG7NY6Fy1U7Nti7zV6tdUl0rpSM0+oyzhtnP6jl2jiXW7ecZi9UbkRMG23X3JioUPlq5a4P" +
+	"lZOZMVGmndacqnJvhOv5ijW+ZjlnSSA1pk18UrhcK/f3Vv8qnRV6rLV7jdU/JiqUJ6xznL06MLNauUTnSmth+NgIe3VgVEyb" +
+	"+HahvtZj0/VYhjfCzguMrnZM++4cx7Tvqh37Q0wb6xWnpxUTExXKU9WG+YEx5pg7Uw5Vr2fF985s/xbv9pgk60VzLVrFfn1G" +
+	"h9Z5Ulc66jrfeDnUDf5TOgb3mWTwv7LK3f1m9XRxaFGeXK9HvRhzszL0uRsfmLB522cjnr346JAhj7xjH6uoH/zm2446zr0U" +
+	"rOMsspJikuKn6Tg0T/b+1AzR9HusJBqZNWxk8mrVso0V5bRITggtqKw+Tzexoy9LGvP8FzM7XfylT/BzqbelNCFgJU1vNvE3" +
+	"HYsvCX6bs7R43u/3pKbf5/s8ENzl5kaVxrRx51l3S16M1+BA1RySGEr3x2v6X6rSZ56W/rFJZ2bwuBdrX2nSeaayfozX4NtQ" +
+	"/uA+C6ef5u8XrBMa7YP7Tq3Tgvusvmc9PrSqL2o7d4X0Bi+J8Rqs074tBEu8BuGxo0GoJ5KSn3v6ihtrXbh6kdegPHP5zSt2" +
+	"fGTqlgn2P6xjMV6Dw1rXCf5XzlPu8RhPTv3GPT/Gk6/19/zgTPmzMzsmiR/0XD/uZ+g8ehVYKc7YyNo9tlVsi9gWL/1u1e9a" +
+	"BD8slU7j7WUVjjM2tC7qFByheQPEgRvbyta/Uua26q3LtdgW5jGIxG3+3avNu17a6fLgT2X1O93YoUPjD6TTrtajx8Q59cv3" +
+	"bBp2ftf0E+O7dlvubChf+NHYsaY//hy822rvzI5pY230zSzomTr6Ps8GH5Rh1eq8z3pX63yAA7LEKQ/VOTa8dj+wbdu2bfJR" +
+	"0GkVNH05H6xn3f3aly00R4vkHvVaLbBabNpc+fmmze7+9eX3r1/vPIBIpvWI86DdV++jGrZIdhZU/Gz3Xb9e61DkvGN9E/hn" +
+	"TBt3Bpj1h3vvqfTo4TFt3Jk10l+xvgkciWljfsuJGM+dEU43eqyYNu4959Az6yx6EsJ6OsZ4ppzvU+Zst/ZFPRHSo/rnnkoP" +
+	"fB/SE0kPcyrW3Y8LPXRyee7pHe7+n7ctRvxj4WNR0DrUPz3kuh2Vn+x4evHixe5lEMojw0Ll20koR/CxHU+HDmMpz7rYi4mi" +
+	"IdSPieveKyappXitWlox9RPqN2wQ1aPhPwu+Kyj4TupcOeT5n36Sm366Yb91SfDnl18W7+XK6AuloeRKw+B3rz9z/2Kz9q7S" +
+	"d0F1fS3btEjukZTc8wydT14ZvHv/DWeqvXDx/c+8frpeu7hmPe2QxhirldEf0vmRKvtp4+Arg3efUrgirC14b/C7Cxcj9AI7" +
+	"wf0LtUy/1LN7NJEedr0WdvNgw8rnn3zyuUq50m5vPR+8QVZXDqmcTKQvtUwdaG2+9BQpZjeveF+uDJVbvHixtfS0gqHz9OeQ" +
+	"rVanStWvKnKqQMVHhOrmDLfvJAAtWpk/DdRDejjDfwq+EXzjebn3C0mfbb1Webl9Z2XLqnpp/lrQo1VyD5O9YSv55uS9MsBk" +
+	"/in4xmLrs8qWi63XTuWvE9Ifzl+V+96NmrniT+G8FICzy76TaO2nHvVaGBI6b5YfKrAuKHjZ1MGoPqXX5K0d4VpV7jYva3at" +
+	"xun560Z0R/I7dcsPae5w5tPr0CO5RT3T8z0aFlg7KvvZE19ebF2zuLLl4ur2o0P9HMpX3/7d2fIZu1EgmqtFsrGqGe+s+JPJ" +
+	"Gbo2wBnlfql/EYkW9YxXfM9LpHszswnvRdVr0ZAGzaT7Jeqi5MmeAZdPvOee975//+67J11+tXxZ8ejQhWsfGjLkobUL7bq1" +
+	"E7qte+OZW2555o113RJqV+Ysdgqnj01JGWsgcs+qtvQv+rbWD4nVNFjdmnW3fFm+RL48zWZwTpVFQ8PZpxuVlacshu6Rv4i0" +
+	"rdWZxupVt1b/FwzVNFPxz9ObhhU6f85a/a4XEtOjYUuzYSMtklvIwY/vsWb9xfqu/JCTYK+QWrc++kTFycXh/vhPZdo4fzmj" +
+	"TN1fLNO9ph1zjr1a7jH1bKZFvRZmud8yKcZs9F4ohj+if7XRaxDXw3kmOP2hz595YqVcNnyu1fvliqzrerx4y8Rh1mfGefMj" +
+	"Gf71rcs2v1XZcrF951tZN6QsK8sK1Un1NzT93Pos+lskn9vCT19bT4RsLLauqWFksfVZxEqYP+7gSDvOtPMLVn5+4hxGKv50" +
+	"mg2di+zU0Jzco16Lhi3C8px1WXCcPB4cJ9+4+xcHuyyqLFpcNV6a/OcZhlUvUX/HqSKL3ctqlLG+D9nQMSFcSGetSKFwEcJj" +
+	"ZaROLcLXsp61faGs1uXbrc8XyQeLf95Wpf9UnaqXqC/fVBVZbA2sUca6t6pOVYWseytnnCoULhPheyN3P7XMfaHp/PDjXB0/" +
+	"Qw96ezS0RgX/IXUHdGnbtncwpkB63PjbQVdtsz6r3HTDoHvnDripsqW7v3LF/Jv2rslcHBkfjE7zNQ7tzWqqqpmo/3JEVfDS" +
+	"Kv2L3csiqk4ZCI8BT2o9Lzi9ntXVe62q1bTy+rD+QYNvrl7XiIXC+Tkhnjj3hu7FW/Q0j7rs5BYN3djQW0ItGlq3Nup65ezn" +
+	"7TqVH/3x3i4XeZZMXy43LTl4UPqMWCzBf3xb/M2Kfn0Wn5ojjK44PV+hvw10urr61kw7qnZNjYtH3rDxTJWh8/9KqG5n6qsf" +
+	"daayWnKGquBbd0a4Zy1z9+s8Xc9O1v0C6V7+07rtzufBN/8tfZRAIf4s034Ozf+hnPWlv8m62BlYI68cCOmsyhvKWZWxA9im" +
+	"j72fncbUozGtzPXYs77xWQx7EpiKhN4SqtciFLlQWjz3yUvTp3ZtMX/ba8Pv3RFctnjIjLvk7co/5g++JUfekWNr8a/p1Gnv" +
+	"yHmTKl9ebM3JnPfGI8HbZVzW/QV7/2nOhe0fC9uMpSmtQyz8z1br7/gls4tlzK+zm3qqrWfatX/Z6rpfsplU02RoTLk3NEaE" +
+	"R4fwMCQ3Bz+S9k9L++BH8lv7gcWyM9h3cWX9CK/uDd1Xt0o+vVR9+W21YoutYzXLdQvZaifVC1pe5ZFqBauKYZn6uZvd/dg0" +
+	"DI31UZFiVTbtx4O3rTWF18qStdIhuF8NV9ZfHK51VQ0ic8dmvSbiT6t9lTZjI9yKU/oq/xq8LVKriDq1UaXzdnc/9Wh4estC" +
+	"Su16Leo5l5c/e7rStWdojOirWgO6ugqsp2N8vRb1CuyJL1fMty4wC1Ed3Kxrqp4BaH59+tQq+VSJ+i+Hi4TXxDXK1I3YCM0+" +
+	"rqmnWZHaEyv7aZnFVfl1nb7I3Y/5dinSQqKkhXkjakLlZmk9KXiTUz/4sBS7l5XfF75ji9gIlamlZdzkFtVKVT4nxcGHqxVc" +
+	"7F5mSobKXV3NVqhcjVI3VZWJjBHuGB136prVh0TcijqI6QgjOsZ7UV4XMZxpuHR4iyZNmtyYH+wrTYOXSIlk35N6iVx83X3b" +
+	"rSvtO4dccXHa+BsrV5gOqGxp32mNz8mb8/CYOSKVmxaHr9Mqey3OtJfc45ctVt6345dNLrY+O5tN56tztjG2RQ2jp1t8uuIv" +
+	"p5n8RYNUrYG+qhob6rWK3OQ0tO4LfrR2rbRfu81+IFhhPRaskJ2nxnT7q2pjQ7VS9a28SLHF1rGzlEustlapKneqVPVCHQwn" +
+	"x4M7QPvjwtCcHv4z0VKvRcT1LLIKkw1SP/jPq7u0adNbrg6+e/P0QYMG3Sw/69X9nfWjdU14do+2xoem9sqWi4NdFlddK2E7" +
+	"zU+3k9ziFy0F51lv/5KtxfJBDWMh7j94tjadaesXLZ3LTuUKqtaSZgyPDo/7VWO+9ZMZud3LFi8uH3JqXWKH14Stkk/l1lEy" +
+	"lH2x83yN/N1CuiOj4akx3hTQ7GcZV1pHS5Te0dsTgp8EPzksT+6RO2YvdjLL71scGVZqjiuto8VN7lGtVPDD2XLHqXLhUYWa" +
+	"40pVudNKPXmqUIdq98X79ctokftifd5rPiJdLy4+fAvZ8Gw3yGmXjL98QPAj2TPokon33DPxsmtOu1GW3NrNu60tu25El+a1" +
+	"f1gcnH1es+5ry9Z2S6j9z8V2y+k3paTcFLqrjNwv79dvNpgzkPwraxG+lf3laiz+4ddVo+o+OtwXv1SPer+mFsE5/7kOwdmn" +
+	"1+HUmBQdGZNaRMYj6+5t7mXBN+S9n7dV4+FX1Xgbzh0aUay7ty12nj8jf2JI96n8kdyRzB2qjY3ON+F7schoFRkZ92yzB4XG" +
+	"qooXTt2Lmfw1x7eqUXHPtsXW1jPLJIZsVC9TVeJUgWaApXXar2ul86Bq2gvdIw4LzXLBS6yJO6zPItNa5MYvdG5DZc8Pl03u" +
+	"cVppnbFOK77Y+uz08nGn2a4qf1rpGoU7VL/GapME9atRJ7ba9Vavx2k8/2cVeRZWsWvtKYJXo03FfdWvNMk9jeJUv75q0/Z0" +
+	"+2aHvUXyOepwqgZ2g8j21OnVqFaJynqLF/9wrlpUv7bO7INz2K/XIvkX+6By1mnmz2U8OHvxaeOcjjE1x7mGLRr+qpHO+jn4" +
+	"0a8Y6xb/8z+PdcYPOLTznPwLdaleE2fkaWfh9Nqcvl34w7lqY/3pVGVOnRPtk3byi1U5fcOyctavqsm56iGPn6qG71em2uV+" +
+	"Wy8hJspaUTHBbwy+z3Ir1z3o7q/f5vxbwMqN0dB+OybKhO55VXk+rZFH7Ldios4fd1qe0/WMA/utM/ScPc/Z9IyrVp9z6amZ" +
+	"55f0jPsVemrUB5Hb3UbOcvcQtUGiWrVpFRZn2ehOnR7v1KnTE506jXYP7Ro/fvz4XSAUBUe443TPiRat6okZaUX3OFo5z22s" +
+	"3PCvjdawf20Kbp7lfFHe3N1f3tz54uf964MjQnNBcITzkjP2bGXPezrYeN8a+Wb/08Hy2+2sirnO2Ir77Qcq+izXshYrgiPs" +
+	"vzmzaU4CtE5WN0rzlLpBXORxtWX+xmqyPr4W+/K0lbcVFj09/Y75j639+uj1jw0Y8MerVz8V/Pm2y24dd/yv1trKm1eu3LbG" +
+	"ml3RIfhl8G8XNnupY/vXnD2+z/Rgnv2xPdT4ClFpfIUarNG+nB58KpzeSe4LPnV6uvtjTBt3oL/L98487n9sf+zOMt4rfOF8" +
+	"WSN9fUyb+O584TaIpIeeI1tJxr7VNFgn9Bz5tPRO1pfB9LOl8xRtz35cfZQ6S4mdcHq6s9HYlxKncSSdmcE60f3szTFJDTaE" +
+	"fHacj+wE43PjZgSf0ufgT7nTjU9Ug8LQcesV9YnqZPeLHPfu1+PPne14SL91LKw/Kr6rc9T3tFwdd7p1LKw3Kr6rtSeS7t1v" +
+	"HQvrq0oP6XE2hvW0ie/iTLATaupzNob1tYnvYg2wE06VD+l1Nob1nnHc6A98bX0dk9SgSO12do5WtT9f0zeF0q2mVe0eoumb" +
+	"q6eH9Dgbq/R0cWrbCb76J7j5zsYqPcZnKpzuDXE2VukJp/MAeEe8BjFJDbaG0u329tCwnr6a/loo3UqrSv9Z07dVT2dysI6T" +
+	"qr5apWH9dSM+XPZir19MUoM3IukRHzF7oeYvq56fmcGnjJ9qTFJ8L03vZDcI98M+9QFMiu8dSpf7Qj5rwX3qC5gUn1I9PaTH" +
+	"GRjW08bwzN5cU5/bIKxPry7nvpp6nfvCes3xP9lHquuvXHdaPbtaq0N6Kz8+rZ5dpW5IX+XHp9UznB7yz3QkJim+T7i9j9pT" +
+	"Ij6PWr/USLuc+yK+j1qvi8Lpf7KPVOkpr9JjzmOVHq9ulR7T/1V63FlVerpIM/uI+i8XRD3jNaA3tO6e2Oti6dmmdeLFOulK" +
+	"C+MnGh8X78ZdWOXbnNTGc1q1aNkmsV6V56hzz1q5suO0ii3BndH18qypklZROvy8aMd93J78eYfzGrQbcl2PWtcEH3k4eEnh" +
+	"b4N/7qOuziOXTHWjp9R/uPe2KO+ScWPlscojvVvVapXcZEnSySturOcWd+/aJzPpFqltXIfvu6xleH3ufuaZd5bM+rx7b+Ou" +
+	"epbK6oOF+s78nHb17NqjtVoxD9eoVsrwt4ondWx6+/kP994WcC+55ebTKnDnhMfC9rzrvAa0i9hL/s+dYwzP7lF3wtnNam8M" +
+	"6V5rxNLiSRc2ur+ese70rWH9ipti3OIuba8PjaN+QVQ9vf7a6nm7xNluZ4fP5+2avj+ULiWRdKeOpn9UPZ0H/Le8RE3fF+KR" +
+	"tdq9skrPXVV6OlHkHqzSc1eVnnA6D1SuO01P18i4VfnxafXpSlHkOjitPuH0ULuiOsQkNeh4Zrs0vdOZ7dL0ztXT9bqvrufU" +
+	"OLrvND2dI/UJ7jtNT+fq9Qn0rNLTxW7vrYnUJ9CzSk8X+kfSnTqBnlV6wulh/7InYpIaHAj1m/1TVCCUX7I1/S+hdP4aSbdm" +
+	"avrH1dNDegI9q/R0sbMjduWTQM8qPV24K5JuzQz0rNITTueBikNurtegSk9X+Uflx6F5S0q8BlV6ujIqMp9ZM70GVXq6MsOk" +
+	"k13xpvtHa2tMUoOUiA+ulaQ8urvizVC/ylj7iDnC2/bQKp/lTrwd6Xer72nHk6sfJ7viubPqv0T+UfFcNR/rmvpr+ljX1B8+" +
+	"TnawjvtHe3NYvxn/SqykSD9YI6v7WFf1Q19rZJUeTceSOJB39b47GsRs/reqF9sjVt7dsmVL7KL6zp+DP3ew/iQTgo8BWMzz" +
+	"v7Kv1furRH0Tp4F+FDH0gb3eDUPLT/Mlm0uk9yWSHGtcZkqe7TZ0cHLnu2+56qkRwzfcsPatSwcP7tAyKsrduGmT03/FhMzr" +
+	"Rybf2G3U7VdevrVPSsnKgQ+OGNEl+YKGab2kblDHjJDND+tH1R8JMrPqOfOl1kjOM2+DtGqhw9nFpj5y7NKHgu8Jo9t0XDti" +
+	"RHHHbrf95S+vAghllfu8vwdaqSed2Q5pUa/Fm15S8P2Ki4J7Aq3+9UJ0p39/WDNfKFu9N6Wr/YZ0q9xXa9C/P4zu9K8XQGhe" +
+	"uc97JtAq5HdXr0W0+g5Ey5XepeVp9vUVD7nWT/fYj1X8IdAq+I+KWuW7gsc/dKY5DQEhv3K580ogD9P/l0j3uNDrxLZ2YBv9" +
+	"/IY1sfa7O55s/Fpc+uja10XvuWnsk423NUwfXds7cnTrxjcbFp88OTcU+dH0UX7l8qgZgbyYpEYDzfltNNB6hXB6ra6BvJg2" +
+	"jQa6dxsmho7EeCYfdlU9OtGLvqH39np0j49UJ/I+SOSjD6GaxUZewjB/q6KNeQfEjjWTR4P4OGti7ckTbrhl9FWhete/b9jC" +
+	"hbcvio2eYhKvbKINiL0nY+FDk5c8f23KKBndpc/xptemZMhNXbwjR8dmjPuNaVlwTMvbnn//0xWzmgb7RBJ/lI0tJz7+yadP" +
+	"3duk4vH4y25dtGR8jwsq77aWNbp08qJFt/bAifQBv2EIoxl3RmtaxJ7RuqiztK7FuVrbIrZFVUPFDre9Zovlm9jakyfcOG70" +
+	"lRdsjRswulb9+4Y9JAtvf7hBrckTbrpFU9NH164XbHzWTnlOvmkebPzPcJfYXzb9TZ+RMvq0vpkqxf+hox4P9jv147lWk8K9" +
+	"lmdtrxxT1V/WnZWTw90IrvKkSyCPadzJTO5j/n/svRaxv4orLX5l70psC7ud1Ozh5F/Tw/LX+iZBO7dhqMuVdbVum3DDuNFX" +
+	"XRAqFmz8606BO6hZsHGzyitOOxPe401/kzLyF07Ef3lWfuEUXXru0xQaN9yhgTx6gES6/ULpHW+3snvH6/tY1ccQ05cNWyl5" +
+	"9aXMeLsy7pqRtRrcOXjprIx/XvGPjJkTLk1Jid6dMX51k20NrxlZq/fQi27P/MO0P8TVeidjwurGUU7xyWC7liMLDrbPmhA8" +
+	"IXUmZLVfPS095ue//W37hncaFv/o+PGXzXrqk8fujZfv/7a98O3ImOJeHMgjQD0uAak6oz3b2JeYv0gWG6m5+ZKj2f06rc5V" +
+	"11uoylaX8DmQv5U0vXZinQ1jfm/aUP+usb+XMRn7Wg4048s7N48NtyB8FmNrvX3zzasby4fakw8vvrWHXHFgWOXk+D822TnB" +
+	"HmlOUvNpjz8/YcLfbxp5f9Ngn3B7Tg0ysv3Ijmrt6avtqc/F1dvTWbRBUW1CDWomzSWqTQPrVzZnT8crUppemxt9W8ZX2qD7" +
+	"Xoz7IOOmHxf/utZsuaH/BZWT47eMuedWJ0k599moI7fe9nzweJNzt0b8k5X7ArcEWuFBtJgpS5xX7HXByor3Kt4LBu01Og+2" +
+	"/vcntQaFnmmcmd9eVTFKLLu73V3sijE6Ix6MTjQzIggvVu6LMnNiDEgCF0irejFEQqdT8NvgPQetXtbvrV6fBO+Nuii4I/jY" +
+	"T5d4X8sueSp4YzDlpyZeidwil59dV4+wrh6qS+Lk959Uvl15d+XbByU3uFUulQnezp+aBFOCN8pqedP7+qe+wSeCWwFsBlS+" +
+	"Hv11YCY2F9I79GQU82K6ZzVsUL/qE2n1k9UHiwTHJNWLMRmchETj+VQvxmRwRkhWcK48FJz84vMP5+fl5T/8/Iuy9MXnHs5f" +
+	"tCj/4ecqc6RcXtq0afSoUaM3bQr+JugGfxP55TStLHdHDRv65OrgvX/9InjvU09ed911Q59aLbmH/iq5q58cKqPe2fXWD8eD" +
+	"XwX/9s/jb7+5e/ebb33/g8RJ0+9/eOvN6m24IPS3t/9P6u/qk99W+lzF/eq/b8Vqu5Pdt2LnRxU77b5R0/8PG7PptooJ31dM" +
+	"sB/73n7sf6ldsdESHy1R0dImWv6P2nW3dY01cHllkTVwaWVRZdFya2Bl0f95A4PFlb+1Hlpf+dvK366zHqr87XrrIeuhyHqs" +
+	"zrdV67Rqq7JA11Prtmrrw+bghubN5BbhESQ8/TZsER5mZKgZKdoG18jEhNA48VrDgSNrNZOM4KMdQmPJJ8U/2s99b31thuiG" +
+	"xScr+35f0cWMEZxmq011W2ezV9Nm0+BGGXN+cInMCA9Qr+lAJoODa8+XycGFF1ZZt/54XIb9Xd4IVyB409+DG45XDjqzDs0j" +
+	"85o0lNOGUUmW0+awFvJYcEzbakNli+BEWd02VA8zb1VGi3wZ/KkiIzwYWieDwa8kyl4TnqesKpsdST41m0YltbH1S2K/NIhX" +
+	"TQGhutR//Ya8Hrc2bddkUP+R5x69K2eGR36dVcek7si4tXPl5ECjJ3qcd84R++dXqkb8qn5yhwTy6BmpcS9z3xPVJrZHbFSb" +
+	"0PqrZr17mFefY0P+f+HO6z00bdrdGd/3P54xc+HUP8RFv3tTqMoj6jScOWhp5oRL+6TU2n3TzRlXNDV1LbfiL5v1ZIfsW6VO" +
+	"8MSt2R0+XXZvo2BMpMZ/bjVy3cdP3p4e48b/bfu4q9/mtL61aREabW1zixRb7VbHpNWVcE2tdkZ3wzj5NHjVBbXeC933jKpT" +
+	"X/4UvLRe9IGXnzBLtDH29uDyyjpWHbuRNfbo1hfKDJPml98SzP/XS+bH6XY7huzGelHVrcbFx8XU7CRz7xqTFKrCfcltmkbs" +
+	"V7t/2XPT2FWmCqPqNO1xxUMbtpqaWE3tKOu+o1uf3xVZ8wWHP65rPq3aj9bU+D3S/aWq/nALtF7dQvWSkOn4kPFWCVGnXW3m" +
+	"CzQxtGoZWc3Zl8p5wR+C9QyrWqZe9cf779u+MS/4/W3No96/KXLdxV4z4oB4wSNlt98QPqP228HlFSXFP9oXjmw78F1p+/Zh" +
+	"e6RhlanvNU2CW4LDgu90CdYPXYV2tb6ro/xqUa+F0ysmKcF8P9+O0Cnm1FVwth61WppeFHvrxuDxLZvfO/MqCA0RkX7Va8V+" +
+	"N7i8cLe0ffvdxYt2V04NXwVSXHUVVPXvMF1T16xvM1qSBL3Pxq+qs9xE7B71WrSq16NE8hqeOsOxMiE4rX70gTFjngzdBdT7" +
+	"udnHwekfv/qJc5HsjZBsaPkbwVv/9dJzbxnC32PPqrzG2lR5DYi/oXK5+1Qgjy6R67FF97gYr1Wy+bMPp9mPbREbJcnmO0On" +
+	"X4oJMvK+F7f87t5ate4NrupQNXiMqNM2+NCxe4N3yMP3HpPZrUznhS/HygZvB4+U7fD+9IdARbvwVWgXBX9aIFtvla0Lgj/Z" +
+	"MZEL8dS42jpSv95mnjz9JiGqTbT0jj9tdL1g17Lgdrl02ZcJ1YazZu+skMuDO5Z/dWG1UfaZz54NPhZcsf6nyiaRgXb9l8/K" +
+	"eJnwTKW1IjzWSrWxFrfqzqReq3ox9c9gky4W25w20aRe1+6yR994tH41CtW7a/C0Rx9PrZph3HbxV975afAq+UBaS61GUhCZ" +
+	"au5qecuDwQ+CXeRlGXnVzztMJavVp13VOKrrSrfm6Kmpp/dNr1ceDT4dV61fGs4cfPujq3qf6pTyhF7yW3kl2Lnyn72D9SID" +
+	"5UctbnlQusje4JXBtVc4P4e7JjxmvRl9kDgan3M3yLiSWBNP8fa66D1jb1oVoqz1YkUj70iYqyfnhiI/1pr15Zch/fMrBob3" +
+	"mn5Bf3KLhufSH5xh//VM/RVHvvoKQADrxuIx9S/5+03np/2L5gHMvw9LOvwUCU8WVAys8230QRIIhEsoRk0LXgjn3Xey4Oev" +
+	"6nwbTq/61+hGZyhjtAnrwRoA1pcSZw/iBS/AH518lnt/ZoL7ODPkZ16w3ucJ630W28vp5QV43RrAH63ljLYG8IY1DZx8fufk" +
+	"87aTz3wnn4VOPqOdfNUzz8lnqZPPXCefGdY0DljT+KPRERF7L9lRPfidc5S6znGK3Cgmun+gyFlIkZNIkZvPRPc2iqzmRvwn" +
+	"nWKKHIsirxdF7i0UueuZ6FwfCl0ochbxO2eCX+5ewSqjM6oZFzvH6OVM8CudYwzVtkwD631eUvvL/WP2cpY7k7ndWUSB/S8m" +
+	"OIuY4NzOBGsB7TWeR4EFhRb+506HUDzKpsCkOxM1f4HJZ/2LAnsH46y9dHPyWOFcRi/3K37jXEayidtvMsjJ5xtrGt+aMNyX" +
+	"2vdOPo+aPnLyGebkM8DksZcz1F7OzqgeBO3xTHQmhvrO9L2mLWe7vZznNe1LhllfMtJeLr9x8llh+t+cH3u5uNaXzLbHM8KZ" +
+	"SKH3M7PDMtLeyzzt97NIlE2UORd6HqqJ1ZxkqzkPW81932qO6+YTHTkPNcULUOGuZ4w5F9VFz8UYipwHKdB+P4tE1eN2PRe3" +
+	"ny4W/rsWPGDhf2nh/1v7P3IeaojpF2ci/fRcVJfL6KXnzISmvcZmjdC03dg/Z/gH5doE034Tav/c/p9Dw2dn0S+Et1Hk5PlH" +
+	"vGUUuTDVau7/1cI/YDXHsvBPWM39Hy38v1rNecKC+XpdQKHTlyLHcLS5/6Ty1FwnHShQscJ5QuG9Gr4U/n03hU5t/4RVGzHn" +
+	"0fTlGeEnFFfF1ysPJ9QMo15iYtQNTHChyFwH4XBm5Le5Ls21cc5wgl+u102NUPmSx4pfG5rrXa85w7HLTl33eu3VCMPXd1P3" +
+	"av8pM/YoJ46DswjcGCZWnWvD8a+r8oT4tZCZ7nomO1NZ7CT6T1oL/IV6Dr8nKqohBdYf9FqJdm/U87DWgmhTnyibVe4t/pOR" +
+	"vvQWk+FM5DZvHxPde5jgvOA/5SxibXgsu9XewWi97qb454X6hYaR/vE68ZiTxxPuPK50u1LkjvYrXdPmSDvfIc95h6FOW26w" +
+	"32SCjs/vcJ3+3sAg017DH/sPFNnXUt9KoKhWGkXRoykKDGKCZ1PkPkORPYBCr4giby1F7msURK4RHQPwD1dx4VeeI70ealxv" +
+	"Zrwx13zN6yHEY/9oTb5p29oyyHC9ep0j5QI2Rc7fQnOEjg817axnjI4NNa//Gter1dzfaOG/bTXHsfC/OYP/8FJ1np/R1pr8" +
+	"zmKhvZO+4ev8n+41FHkBHVcmOBOYUHXuatTnXNddJIzw3b6NOV49Cuw76Gb6JqoLE4w4R/2gG0Ut+18EHItoZ4L/iqmX2rqe" +
+	"dPsu0p3jRLtXUEttn2q/jifuqvB5gWjrX9RxJvg/OpcR7QzVNkbmwAL7BHdF5lDXPjVuu99wh32Ye+x93OM+wj3OLh3LP3et" +
+	"UF5nAROcqxmhEhqnC8Lzbh3rDh6xNjDJ+guX25lcYQmFbsPQHBwRZ63/ubNSyzypY38nJrgXk+NsZ7xVDio/gzlH1gkK7A+4" +
+	"2/6ASc5ICozovD3F/9SIPZRukestPJfj3MUE51oKq83pvZwJjDH9Z8Rw0WnLLea6qi56fjpSYNrrXsAEZxMFVmP/POddCuy1" +
+	"FLhpTIjkjfoNE6IeZ0LU1Uxwf6abM55u7qN085rQ3m1AobOZQnsTXY04JeC8CdbfmGx9zmS5zD8qnzLZSqCf1YJ+1is0Nfms" +
+	"52hpCXn2IPKsAaywBrDKGsBGqxeTVZ4Jib2c42EprC7yMztC6wz5jVknnfrNcpNmTWOcSlifvVwsI9Z7uj4cbS/nJXu53Gkv" +
+	"5yN7ubS3l7PaXs4B6wqwl/NnO4bJ0p1p1vNMtruH6/IQO86QUH2226+wIqSL1VARhMqXoLIhVEZDZXuoGA6VsVD5OVS2hcr7" +
+	"ofIiqLwMKhtB5b+hsi9UvBrOFwvBwVD5fkj8+dX03g6VQQjOA78OVO6AyuvDcns4NHqKw/baQOWdUFkIldOgclE1ezvC9mOr" +
+	"SbU6Gyk3dl+G8jug/DP4eT1UHoHKflA5JaxjBgT7hMtE6hkdrn9vqPTD9ZgMlWPDYe1Tojpmo/8qXw7XY0Yo7l8OFUegYvfp" +
+	"UvnbcHyvs5kiJ45CrydFzjMUGW4Z0XG2kAJ7ufw+MgaY0Kz3zRgS5s9Op4K6Zl3q3uR/613h/8s67v/D/Yt/wj3uf+1+739u" +
+	"3YETuRcw65OqsegYQ82Yaa4nM2fpvHCUupH7AGdh6JjJ41wf+m3GXDMumnRzD6DH53GlWbeadGc7E8yYpOPMHTzitCfXpDnL" +
+	"2OBcRlNnYui4/S1jI2tMzXcJz+r1b9bZLpc5NmPNMWc4l+k42EfXpOb36LDO8U5bkk3oRfE7baNJN2Xak2vStD1TWeLM1Lng" +
+	"Wi/G/9qE4TKpzpuMrxrrn2Wu2kikyP6NP8zZzpPOdp71UrnVyWaKV8xEo9OdyUTTHvdFipxptHW+5yrnRka7uUx05tHVDUTm" +
+	"DtrYB+lmbETugXRsNvZC4/dGXa8vosCsiUyfO89zl7OIgZF1kgkj9wjuM365Mz50L2fmv9C9G67hQdQhronKDeVxm4bP3Tia" +
+	"u7eFz7U570ZM+VWhtU/UThKdx/mdyatiyt3GNXrubzv9vKtNc97H0dzrzlQ970spcv7B41EvURR1iCI3SC9vdMiOG8W4qDd4" +
+	"zYV7db6dznjnEgrsXRQ4PzPWtN+bQ18zbxl+q4yiwHmU/u7tFJjxXPtwU3j+M3NfN3oZfnhtGK/3ft9Q4FzKfG8JBd4uCtw4" +
+	"ervPU6B2fmCCdz6FzjAmVd3nzKO9vSt0TOel0L3nv8058e6nvzeLAucF2jujwvUxXNxEQdV5X0gT5yOaeG/xmjueQm8Ydd3V" +
+	"dAqMoigqmcIwj8ZGmT7+B4VOPhPtwwzx2lPXG0SnqrXFKuo6b9EpEkYlMtHLZIJ3kInuTCYE7uO2qFtZUGU3vF62nyAZKq6A" +
+	"ymFQeSwkP0+2rZMFtvVTU/dPFDmfU+gc50LnElrq9Wqum/EUab9/Ql+nH92d+yiy91Bgzr85B4YDun5oSpFpe7XwQmcUvbz2" +
+	"dHIraK1c2Elh1G8o8gZTGLWLIm8phd5QJjqVp+7hlCfdwvcIl1ULj+n5S/Ze9svd93AMd8z5Oy3Mo8Aro757MedFQsPzqroW" +
+	"a92edZfQ1fmQdpE6KRe/oTCiyzPnYLE5vxSea01cbQ04uXpYs18ia+LIWFwVnmvtGMU4wzFzrWi7a4aR9obPi7lm3OernZ9w" +
+	"P1WFx8l0LmFC1CVkejOZEOVQ5F3Gcucripw0lkdtoat3mHZmbe79m0I3Pzw+5fnlbjMccy9lxltzbZvrK3Ab10S1ob93B0Ve" +
+	"HwqipnFv1AVM0vovYkLkXi/Cg6g3ucbLpr+e74cpcDdR5HxLgZsd3nuYynXuVIZo/I+sch7jGRP3uoaOOT/xjP0Rz3irecbr" +
+	"zd81Xxv6ulksdacy2s3hRvdFVrkXk+X2Bfe35Gmelkx1X2eIO5+lejxka7Qby3wnyCp3NHn2ZzzjfsRU97eh68vdGF4zDWCV" +
+	"bATrURzZDNZ3RNnLGRJlh9fAW5nglOm6VtfZdpHGC9zB4WN5FMgJCqLqUuDM1t+hcmO5yXmYAmc+95k0OeH/xcnzP7WXgM53" +
+	"fyNabQQY5zwb1m/un61w+T8xzm5Bgf7OJ9EZxzh3N33dP7NC9/GWMxqsWsBy4OuQmN+yPCx9QxL5JzFgxZo8obLS12rOVnCW" +
+	"AC+YuN2JMSbd2UOB045B1o8Mso8xyCmiQP5NgXVfKO48y1pZwb32Zwyy32OQE88g500KnK8YpPm+ZJCzgbXOAwxyHqPAuZ8C" +
+	"26RdwSC3CQVODIPcCxjkvEp9exwFdm3utW7mXquSAmcNBaZGRtzfck9UgHusobwBfKn7aXvJtm9hkYX/mgU32/f6RSZ0n2Km" +
+	"uYa9N5lpv8FcExpxnuahiNg9ma5yr19kJPA2M1WKQuKt4wEjzjVMNmI19Y9F3c1Mdwlj3X/wgHsRDzgzuc8dQLaxYXQYu0aA" +
+	"snNIEbAPWADSKSSU2cv52P5MFtqf8bP9GQPsz+hvf8bj9mf8zv6MgfZn9ArLaPsz7rFG6D7xKvso13pvhuoE8pjVmGmRtp5L" +
+	"qvdBTanqk1+ScH/ZS/wie49v1nUaVqVX78sa/Wn60fTh2UT7dQljvTuZGbWEmSbUfg6L+X02MefhNBlA9hkSxQPavntPr2fk" +
+	"fBnRc3jmOTDhTfZnvFr9HFTbYz+b7AjLQzqPXMgE+zx6RdYFKleG1ijuj0yw21Bot6GX4+rapdDKoZf3VwrtifRyV4fLzKOX" +
+	"m06hvYdCtxfjzTGTZvSYNJXXKVS9VzIBeB24CORe4FlgSOi3CeVOYLt1KUVGnACjjVjL/WNOPk84HzLTOcpc5wSz7d9xi5PD" +
+	"Xfr7ByY6H1DgPMEMZw04W7jW2cZwZx6XGnFvYLCbQ4HzPTcYcc+jj5YxZQu5ynlI16VXuV9zl/PHULr7LJudhxgH5X+2v+B2" +
+	"+4uK76H8ldPFPICsqDR5oPxRp4wZ1ktMc15lmj3IP2AfZ6j9IM84H5MNlTdA+Q9QXgwVhSY/VHyv52sFo1Q+JNaZxygjbgyD" +
+	"tb0fM9/5nBujJnKXEe8aprpXMc75inGexTjndl2np7tT6eXlM9W+nF7O3Uz16unadapzPzO9Nox2V9HJWUC6l8c45x5mOveE" +
+	"0r2LGO0mM8R5UNPGOfOY6hjOf8VU72qmOrcz05nDaGceM93e9PIa0Mu5mpnOvxjnXK3neZw7mHR7HaPtVxjtdGGI+xWj9fcL" +
+	"jLYfJdEtDR2zNzAkaj2j7ddVZrrPhPO9wmj31VCas5kh9utEu9+HyrvPhMu+Qi89fqEeN79n2ttCZewrqB/WM1XDsE67ktHu" +
+	"vxht/8QQk+ZcGK5nH8ZZwxhd81mG+yBFNWSKEc+sQyzqnhH+m6KovhRFQvfBqviUSGjKy58pso8w267DMCPWu8xW2RcK7dH0" +
+	"s8qYbfJVHXuX2VEHqOv9nrreQYoCtZhyRn2N/rOIqZ85fo76TjlXaMra65gtNzO75rMY91Km1JCpRrwnKfJuZdUZ4XMUebOZ" +
+	"UhXu1XBqtfCAncBsbwirVC5iijeJqU4ufZ1ciqpJ32qiaZ7Zw15IX/czitzPKYqEkfRzHXc/44D1d2Zbf6e7O5G+bj5FkfD/" +
+	"abt2C2YbccYzRfcrImJ+RySc5pq1/gKmuDdR5N5PUVUYST/X8Zs4YL3JbHMOTei1YIrXgr5hiQ7/nlI9Xe91f8uUqFUU6e9w" +
+	"aMQZymz7MmabsIauvv9JV9Rt9K0KI+mRcHc4PRwa/YZ7zvX01Xu8U9K3mmiaZ/pnGn3dlyhyH6IoEkbSz3XcfYkDts1s22O2" +
+	"Cd0E+kbEm1JDFlLkXXVmGEimKOrbc4fOCmY7WUxxsiiqJlOqiaZ5DShyVzJFuXE4zJHDp9LPddz9PNQGHTPmMNtbqverU8JS" +
+	"FJFz9WGkb2w73N9HmV9d3FSuCMsUI97a0Lhg+HTGeHBqXLg6LKHxIHx9a5mf6ev+zJSw9K3+26nLbHOva9oR9eC5xf3+ND0a" +
+	"RtdjdkSieoUk8tuNZnbUy8yOcLImRw3XnCeZ4jzJhWGZUv23bRNtrnXz3NbNYaJ9E7NVujPZ1Cd6GrNr7whJ9Dchify27/CP" +
+	"2Qv9Y26Cf8wr8o8F0v1j0QdrpF0VTlviH3M7+Me8nf6xwPX+sei/V8v3gn8scGW1fLf5x9xC/1hgQ6i8851/zC5ggr3WP6bP" +
+	"GdoTrff/KfTW/YoxFDhjSLAXUGAfDt1vWH8GfcbbHXFvD+8HmX3Lf+kaakJYOplns5Hn4nYZ851hdFIx+x8tKXCvZYW3lxXO" +
+	"UtpHnnHos423mOp050mT5pzPfC3bjyHm/ty+gGjnNhy7KXfZc7nL+lTlajuLfvZk7pIRRvxS6yfusnK5y/4bd9njw/Iw/ez2" +
+	"9LW2cpedxF3WG36lPYTxzmySrA+4QuU7+jrrucJuyGQTtwmJtYxrrCUMMGkygGnmGYIzh8nW90y2fqavtZ6+Gv5MXy3bnObW" +
+	"t4yzb2acyWdDS+swU63nqG+V0deqpK/1BC2MLn2GYvJWz/Nvpkby6B7fZO4yz6Ocd+lkQab1XdhmxK6pX0uaSKF/VI99yzj5" +
+	"gkvlHabJIS7V+EZ/ov7eyBBrExNMvbQNJ/yj9m+1LRMibbCW0deK8Y+adtjrGGbb9LUeZbJ1lGlaz9V0sxPpZq0OPa8yZaDi" +
+	"5RrylJGqPabQs+ynqz33rtrfPc2/4T+Ev9bvwexVm+ftp/s58HQ4LAiF/j/c2zjfyUMi6VZzCPs/eOrvEfFrqBkaX4b6Os9a" +
+	"Vn0/qL9N/lD4pdkrNs8Ja4bn9Hn4D/4OkbBq7zIc1vB/qBle8x/9IP6DP8R/6xeh5zvi52L8D8J7Zv8pPGNv79Te6Fl9KfRc" +
+	"7cWuejZq/AMW0N5agJjQXcAq7a9z+Nv8r4W/lo/nCK3mDLHg4rBfzbP/6fyfK6zy6/gPYc3zFfHp+E/hGXvWNUJzz2LfQVN9" +
+	"nvVLEr7n8IbgeENw3Qfx3FIc9wI8dyS2Pv86i7jN8Ix4J3CiHFxvFp73HE7kuf25xPPwPBsv6gOcqD/jRo3Hi8rD8YrxvA+w" +
+	"rTv4g3UHmdYd/svWHX6JeVZm3eEfsO7wP7Du8HdbW/GsF/CcRTjOIlynCZ4zBMfOwbMXYp/ND8yI+zae1wQv6gucqG9xvZ14" +
+	"XkX4OdwviLsGL6ozbtQAHO9ubPOc45fELcPz6uNF/Rkn6ktc7308z8fxnsLzPsGO9Lv24z9Dzx6dzeb5o79cny1G6hy2H9H7" +
+	"Pz2P/9Pz8r/V7l+qu9vWr3QqQnF7udykz5I1VJ+B1VX13Xyq3u47fqU+S37JrzTPk3XtuZzZ9nKeMmWsO/xj1h3+N9Yd/sEw" +
+	"l/5q3eH/Tf3ommPZU/xK8wzaXo7xUchVOzV5EPZtqfptrrEJfqXX0K80z62dY3ghfwh2mvCsfVTmV5rn3N4av9I85zbPoUPP" +
+	"xLV9RPWASGjdBLIbpAc4+SH/XJkObOGs/9QXd7nuEY62Q76ho8P6vjX+rdX8PjRUH4rqspweRpy+0sCINYl9znvsi+rBPmsA" +
+	"ZfzCP1Ov6GeYUOc+JshTJFobSbS3kVg9bk0n2vqCP1pfUOgFeN6+j2zdR1/uH3Pf8jcakd3+S1E96GUvp0x6BJ8P+xw/4a3m" +
+	"MvdKso3YIb/iffZySsLSy5ommPImbqeRadpufnsBaeSk8IVTRqIXkMuMqN/Ba4z2Aow3cSOynP/qn/Ul15p1ovUlKdaXjLG+" +
+	"ZKj1JTdbXzLY+pKr7d3MjoiUMk5KybA+Y7IpZ/xwI366/1X5D7jCJrye7a7r177G7yfiO6Prxs+YrGvd50PrXW8wxg94opPP" +
+	"CCefe4xvsfpJ5dMsuh1FUa9SFNWMZPdphjv56huIPufJp350O1518mnn5HOxk891Tj513UVMdvLJN8edfG5yjN9rPmlQcQ9U" +
+	"PFFNZkH5LhOPGk6aEbOmDMTxvLPS/yRqOL2inqOXedZnnj96H/N81IVc6t6OeM10zWX8dYfYN5PsutzkduFi6yf/r+5XNHVL" +
+	"eTFqLl2N77PrMs6tE372v42L3INcbfYEvBksc45SFHU9f4va6ldG9ZBoHVsy1A9ykPpKzuNK5w4aWndwccSPxH2GoW6M7p1O" +
+	"dy7jWieDh91nuMasW6Neood9HTe4N5DhfOxX2KNp5KzhN9ZW+nrrGRJYxny3LgnuxWD8Yzzjn/ARcW4+ixyLKMfCUz+Ur7nW" +
+	"vYAhzu0MseZzlTXf7+w+TrL9NukRv+zoT6v8s4dVC0d6P5MS/j0mHI4Mx2924pgd8eN0/k2R93SoTd4a6gbOp270bOp6j6kP" +
+	"TS9vC70Creml+1FhX3uzFjb3kvY1FDpLKHT/HPZz+4heUS3pFZhGL/drTUt2vyM5qhfJmj/s52d4FfiRqUaXhjFMNL4/Rr/3" +
+	"M9d6AXPNye+8AJPDYfi32KcLo0N5aOMFiA3nuzdc7t5qx2ukVx27rUZo8tXxAtLbC8gD1cLC8PG2XoCrvABvmjHBC/CaF+Ch" +
+	"U2WtLafqzeRqv83xKafyMTVsc2q1tNHnrm/kt7b5mZCc1v7Rp/qqen9F0hgbitPNC8hFXoB+obBKEk7/rXmnhaTq95TTbdWs" +
+	"L93C8W46P+fzO/tlhkd8fLwr+IPzgV9uxLuCx424HXi9etzJl7rub1loxPmYuOrvfeh7I4/ILV6A75wHpJXzgCQ7S+gVETeR" +
+	"MtenzAsQ9GbwuEqA/fZyybaXM8ZezgkTulfw9+pi72Cy+xyTo1YwuWY8Ik4+U43I5+SZsFq9SqrX0QvwsNb1JV6tkf5rpOSM" +
+	"tFohierBK1E9KP4/0FlTSqtL1IPkGLGv54mz2j9VJhJ/tYb8J3tbasiz4fTq4bPVz0dNHe4TIfECrPuvJcytiNjbQ1Iz/Qw5" +
+	"R3uiejDViBdgpLl27e185F5BUUSUewGC7hG/PCTcZ8R5gJlm7eA8wKGwnjNE23olo4xU65/XQiIjqtej1sXkGPkfcuHtGr+3" +
+	"R/WwU80arpoMOtt1GonXPB4OX1e/xuHc6iwi69SYFBI7W5qE12ovRUJ3r7XO3cvoSGjqY7+AG9WDzzQ+lifsscyqCndIlr2D" +
+	"1oGBLDRSq3M4HM/UWp3BG8B8vTdaREHkXsj9LfEmjzeA5vZynjhdrGvOTDuV7t5q3eTeytJQWD3OUlMXHfvepcDYdR/EdfIZ" +
+	"7Czy/6Ttn8GCXyPOcP9YSORLZ7g86gynV0jO9Vu+DMujIYmUP03Pf3G8ptTM//9tUrM/tE1Dw7+HhuSM9v4X6b9G5MtQWBXf" +
+	"WE3CaVb7U/nk0fDvR0/9DoXug/6x/5lYpe6DcEpMmvP1qWPnKhOKK39/hThfhCTy2/53SAzvq4v9eEjO9fu/zWfES2e8/cdQ" +
+	"6KVzazj8RbHfUSlwX6TAqzgl1lu41SWSv6ZNc397tn6onidQl/Fn5KlRj9P0/kyBypVhMW2bwfKoHtzgzWC0iXszmOnN4B4j" +
+	"TnOWe7/VtOXeBKYbcfYzPepqpgd+5L5oyPRm8LQ3gzVG7P38PqzHjC2PhuVPYb2R36ucW8j2ZrAyHE4Pp5s8o50PGO3exHKn" +
+	"kOXuGpbbR8P2Z7DKhO6FVb9/rfwu6gGWRySUZi02oTOFx90rQ+LN4GFvhnTzZog59rk3g2+9GfKKN4Prwu3b4M3gfW+GiDeD" +
+	"W8K6LzH3ceFjT3gzJMGbofd273gzpLs3Q37vzZAu3gypd5b09qF0aenNYK03Q5K9GbzrzZAW3gxWn/pNiT2BR51lpo9kqDeD" +
+	"Z+wJPGUt4lF7No+5t/Com8Xd4T5c+B/k0dP7Rup7lzH/rPKr+1eSQ8JN3gxe9GbwT/trPadTQ+dWanszeM6bwXehPlXZH5bR" +
+	"bl2WR13E41F/5uHATB735uk66J9egFe8gJg1ScALyMiwvOkFpJ8X4BovQLQXq2uUnNA5lF7ulTxhxGvGVPdKudW9Ulp5AX5T" +
+	"XfRch+7ZfhMOL6123Kyx7vYCMt8LkOQFWO0F6BIOB3kB5ngBaWDW916Av3kB1XXAC/Bl+L6smkhXL8D3XkCahdPWhett7Fwd" +
+	"jl8blvbh9A7heIdq0ilUJxaE9fQJl43oi4g5tiQseWEZF5aI3bxwWxZ6AWaGf18flpCeGYzxZjCthiwP3VNaU7yA7LWGssIa" +
+	"ylT7c1bYnzM11EaZ4QWkY8ieJHsB7gzpl0lh+SYsC72APOsFxPUCcoEXkO5eQIZ4AWuuF7A6h/fA7vm165cQP/5XZXL4fFYP" +
+	"zyXvVpNwmrxSI09SWH4Ky10hkabhc5Afzhc5d5G4eS99fvjezshk633GWe9zlZmL7DyesPN43HqfNeabB46wxhEWewGGeAEG" +
+	"h/cLrgoMZ3KtoYyuNbQqPFfchJPdK8G9UuqeW+z2/3cf//91+b/9/P7/z/8vSbW9NfPdj2+c77jKiJvON0bOtY8QEV0nn9q3" +
+	"6BmR6N+fvrf1y+K/cZZ0s5dY8b+wF/U/3cP6f0L3U+F91UjY7dSeqVxULb3acbnIc5gcln4qv3CvYETTLyNBZQDzw9JM7yMu" +
+	"4woj5p17/QaE5R90rifahSJnMv2svf53EV+XKl+WsE+Feefe6xV+PrGKie4AegWac7F3VH0sboi8ixt5x119uxYw0rwLrr5Z" +
+	"5ls4xxjqXMrtTjqdnBjSnWHc5aRyo/dH0p1M8D7mZmcA9zs3coP3KenO3dziDCDde5YbnHRucN/lfq+IG5xkbnAuZ4z3NDd4" +
+	"n3C78b92ribd5HH6coNzGenOZfzGuYdp3irSnUvo5aQwwulCXy+fdOdqRjvpTHMu5w7nCW7w5jDNKWCCN56JRpy/0cc5Spqz" +
+	"iD5ObfVbrFv17u9CZjrfMt+7hje0bxYw34SRslY75jtZJGo/FtPb3h067qxhvilvP8E47xPGuY8yTv167mGLeV5ifmt4G39y" +
+	"/sioiD6jx7pW/XLnV6WZZyW5jNb3SaOY6NwbPi+3hd953cBEp0Eo1Hf09zJP945r7I2Z557/5X3W/3WivmZhH1b1Iwu9p12u" +
+	"vmHmGij2P7afh6r3is25TAx9D6rqfW8r9K0nF4pcSK7yIbhNvxm1yH6eotO+/WTeTazBKat5+Plh5LtEFlHGN9Rqy+NWW7Kc" +
+	"q3ncuToUN2nORWEJf5dFv/FyB49E3oONmkQvL5feUW8w0Tuf8VFmr7cehVFPM9rLY2xUIdd4c+gfmE1R1KWnfMu8tkx0WzAh" +
+	"uoyJUcOZ4A2jp/ukX+78nV5V76iG3zmN5DnrNxJ+pVjrGGNEPuD5iJj3FKw8ZtsTmGgkMiZ5Q/1ytwndIu9mqu/pDsYFfOpG" +
+	"xXN74BnqRiVwe6APdaNiuN0LUtf9qlr411AYeSboFBPtdKeO8zVF1l4KnS5cbddnqvse0W49oiPtM2Oks8ivcNPp5C4Ov5O8" +
+	"SH1gNb0qDI2rhe41ofrqu4mbyDPf1DLfLjP9Zr4Zou8c76bAzcd2FmE7xdhOB2z3FvWNsV2wrX+Fjtk7sN1S/yknibuc1fQ1" +
+	"7207iSE/dn1W2pyiwFNcLA/ynGzmPvkL4+QbRshRhujvmukm/DXppnzN9J/ZERVgfnVxOjFOZS7jvFsZ5/QK/36Jcd4oxtl+" +
+	"6HdgMuOcixnn9GWmd1k4zyWM8xaH0tzLGed0p5N3VTj9znB+I/2Y6WxgvMYvYaYzKFTG3kInpyPjnJ5MrapHZ8Y5yxjnHWKc" +
+	"V8Q094Pwuzq/Rl45Je4zZ4r9D3qdTcx14/yL9s6DIfH+HRLnOO297owLrGV5YC0rAitYEfg9K2pbrDDvjIL0sG7mMmcxV3rt" +
+	"WOEuYpCXQ4H7RwZ59RnktaHAfZ9B3m/QZzTmPVH3Aq4Mh4P03dSI1HhH1foTg63vGGzeT7XGMvi091O/YpB1KPxeavid1LO9" +
+	"jyr/DunVcvfr+7uDzDuUUfWYaYl/zOroH/vf/q3vQ85gpn2nf8xec+bvqvc1f2vi//1vfYfzTaafMzTvRv4UfkfyJ78o8p6l" +
+	"Pc0/Zv/kH/tvf+v7l7PD72HOJjvSTn0ncyEP2Lv9Y/YQ/5i+41mPByLl1H6xX3Su0Lyz6XGq36r01kjnf/yvYncoNN9dAasp" +
+	"UeZdYBPKl9wY+n0q7fTQ/3f4d0MTVr2r3C30vrLVjZsj7Yn8PqNdUGh1o7AmT/R91YNk/ic+/Y/5UYMP5p3e6E5mT8c/5k31" +
+	"j53x27zvu5eZ3oX+Me+SM3/re9X7ecB91T/mHjnzt3lH2N5v3rn2j9m/O/Xbfj30u+b1oO8MC2Orftfgkb4T/FsesLr5x6xb" +
+	"/GPmnW3nbe6zP/GPOe1O8bNmv0Z4WZNvNc+P08S/3LnGv9xp4h9xrvGPOE1o4FxDA6cJ1zjXcM3ZGBV1C03c3zLauYkipw6j" +
+	"nesosn8IS3vmO/Mo8h5gvlWLImcJRfY/KKplnoGPDH33zRwz6zavH0VOCvOdGyiyOzHftRntPKLfUgm9a7SP0br+tikyYrdn" +
+	"tLFr9JrQ5DPvkf7/+hr4/0lxh1PXvZwi92v2uOZ8/SX0HVXrQ8ZYH/hP6tpuM0VRN7PRXa/P9e91L/DL3Z9Z6fakbtTPXOy+" +
+	"y81uvF/pvM7t7gXk6DshF5Dj3M44exTtzbfM3GspsObzvjXfP+g8H4oHUvRdIn0HSfNcwAR7dmjNaI+imzuUlc5N9PIe4jfO" +
+	"p/R37qeX0zf8vbtf+P6r3Y35/xvff/1/8Tuv9zr5jHXymRn+Ju4QJ5+csK9lqn4TdxE3VXuv/5e/nRv+tkTVNxPC3xMwv814" +
+	"pt8UqPGNCDNORr5/YMZEM25FvpVQ/dsMRl/17yWYdFM+8o0E60v/mPOuf8y5LhTq72v9Y3Z9/5hzS+i3Z8Y5M37e6R/z7gmH" +
+	"4WOR8pr+un/MeZlC72kK3cpQGPUdhSbNXUmhVycUD6RS6M2l0H2KwsC14TA1dCxSPnBtqKw3N5Rm8kR/QNH/DeJ+9n+HRN3w" +
+	"/6JM5fX/V+3VlGrPw88qmTwYyOHyqAfoFbjuVHpgMsPPkn/ef9T3/6KcpY7NI/HaC8/I3zMwmREmNG09hywHf2f4G42bwt8n" +
+	"HAmVLcLSOCwHoLKXfltjPsikUxL5fdq9TLV1WvU1WuS4/L5G/prr1upr1P8QtwPg3sJ2C86zVvvnOUdlcjW5LSyR34OdozLu" +
+	"LGEkbiSjmowz3xGRumD2wsw3b4xUX1dWXxOadbL6ZB8kM/ItWf0+dNjPXN+hhGfN/oiZS72AZAJF5xD9dpAlFKp+8Y+Zb8o4" +
+	"F5rQP2a+LWOfZ8LQe8rOZSb0j4W+NeMfC0m1uH4jxoSnp5tv0bg/mtA/FvomjX8sJPSKxPUbNa4J/WPmWzXORBP6x8w3a6wc" +
+	"E/rHzLdrvL+a0D9mvlNjTzShf8x8y8ZdbcIa9THfsZlnQv+Y+caNm25C/1jouzYm9I+Zb964vUx4us7qZau3q3rZ0+Kvm+/k" +
+	"hOe96v1QLW6+T/of13VRcnvVd/PyWKH3STXlp/B9UzWpfp90rvhp907V4tXvkarfH522V3COePVrr3pcv7PUzz+mUv0evtr9" +
+	"02nxGvf5Z4tXvybOGa++D3COuP4NhL3MM2LWLFFFlBnxPqG5+xvyAwHya5WQXzMekcAo/6eQ8KKRWp0ZEJHz7gvlOfO/X1gV" +
+	"n4/5J9D3JFKroCvUPv9bYkj0PySG1v4WYmjPNcTQibuIoTt9iKGnpvT2+xNDH38BMaT6hcRwkf8oMaT55xHDUJKIYZT/FjFk" +
+	"+AeJIVN1ZilmK+Yo5irmq+ZHFJcoLlVcprhSdRapzk2KmxW3KBYrblcrOxVLVGepYpniIVNnSTJ1llT/PBKJ8T8kkXr+QRK5" +
+	"UDGRPiSSpPH2it0Veyr29m82Ofy5JJLq55FImqZcqUevUkxXHOBvIZGhGh+meL3iKH8BiYz2C0kkw59JItM0Z6bazVLMVsxR" +
+	"zFV8UMvmKz6iuERxqeIyxZVak2c0vl7xWcUNioWKRYqbFDcrblEsVtyu9dmpWKJ2SxXLFHdpnrcU31Z8R3G34ruK7ym+r/iB" +
+	"4oeK+xX/rPgXxU8UP1X8TPGQ4pcGpa7i+YoxinqOpL5irGKiaa+01niSxttovJc5L9Lb9LCkaHof0yJJ1fgCzaN9Iton8pzi" +
+	"84oHNY/WSo4r/qB4QtP/ZfRE32f0R99v9EfP0/gDGs/UeJbGj5v80d+b/LX84EkSa+PbJNYWutBa2dVa2dVa2dWaXop9/J20" +
+	"JtXfQmvS/DxaM01T5urRBxXzFR9RXKK4VHGZ4kottUvjbyvuVnxP8TPFQ4pfKh42qL3dWvu5tfZwa2mq2EwxQbGlYi9TN0kx" +
+	"ViRVcYOmP6f4saLpt9bR95mc0fMUMxWPm/y1fIO1URS/kCTS/IMkMU1xruJKg5KimKp4wmC00ZxUG5JoQ6J/kDb0UTR91UY1" +
+	"tFENbchUzFLMVsxRzFVcqflLNF6qWGZQ29VG29VGThjUOrep5fsf0qY2/lu0qS1+f9pRL7iFdlzo30w7EjXFnMF29Fbsrynp" +
+	"fh7tGOhPoR1DfZN/uMZHanqG5slUzFLMVsxRzFV8SnG14hrFpxXXKhYorldtzypuUCxULFKLmxQ3K25RLFZ8VfNsVXxNcZvi" +
+	"dtW5U7FE8XXFUsU3FMsU31Tcr6X+rPgXxU8UP1X8Xtv7L4NyvrEr9RRjFeM1/QLFJoqGde2Ude2Ude2Ude0kUTFJsa1iB6Nf" +
+	"Oil2Ueym2EMxWbGPqaEUqq3nFT/WsgcVv9eUf5k80d8b1LPcrrYEF9Ce3v4W2pPmP0p75VV75VV7VpoUSTJHJdXEtVT72iiK" +
+	"/xYddDbpUMv3t9ChNv5OOtQWfwudiFE0bemkOjupzk7K1U7K1U7K1U7K1U7K1U6qp5Pq6VRb/Dw6E+O3o7Pq6UySpnRXNHNT" +
+	"Z1L8t+isY0hn0hT7a850/1E6M1RxpGKGP4XOTPPn0lnr0Fnr0Fnr0Fnr0Fnr0JkHVX++4iOKSxSXKi5TfEztrlB8XPEJxZVa" +
+	"h6dUz2rFNYpPK65VLFBcr7V6VnGDYqFikeImxc2KWxSLFV9V3Kr4muI2xe3aup2Ku7SG7yl+ao7qfNFZupoaSjdN0R6r5fs7" +
+	"6ay93QUzHnYhkS50obu/hS70Dn5DF1L8R+lCH785XUj1+9OFtOBxuui830Vn/C6MVMzQo5mqIUsxWzFHMVcxXzU/orhEcani" +
+	"MsXH1NYKxccVn1BcqRbXq5VnFTcoFioWKW5S3Ky4RbFY8VXFrYqvKW5T3K613aloZsAukmTaK12NRemmKanGbi0/eDNdalP5" +
+	"Dd3N6o/u1PML6a4rqO7KzO46MnfnIn8u3XV87q6c765M665M665M665M665M664jc3cdgbvrldW9NiZdR90eulrrQb3gTnqQ" +
+	"6M+lB2ma0t9fQw/S/S30YKCmDPEP0kNH3R4M1/gIxZGK0xQzVUOWYrZijmKu4krV85RqXq24RvFpxbWKBYrr1cqzihsUCxVf" +
+	"UitFGt+kuFlxi2Kx4quaZ6via4rbFD81R6WWiUu8YhPFFoqtFLspppp61vJJoofOjD1qS3ALPfW89NQ+7Kl92LO2+HNJJsYv" +
+	"JBkzDidzob+TZBI1Jcl/lGR6+1NIpo+fR7Ku55NJ01L9/Q9JJt1fQzJDFUcqZmjOaYpmNEsmU0tlKWYr5ijmKj6oVlaqzqdU" +
+	"52rFNYpPK65VLFBcr1aeVdygWKhYpLhJcbPiFsVixVcVtyq+prhNcbvWc6fiLq3Je4qfmqOSZNou3TSeampYyzcptTHx2uIf" +
+	"pJe5M6AXmYpZitmKOYq5JNGbGL8/vannn0dvLvSb0xuzUu1Nkr+A3rpC6E3/YCG9uTK4ht6kKw70d9KboRofHvyQ3ozQPCOD" +
+	"b9Gb0RrPCJo801T/XD+P3mSq5izFbMUcxVzFB9XiU349erNaNazR+NOKaxULFJ9Ru+uDh+jNsxrfoPFCxaLgCXqzSdM3a3yL" +
+	"YrHiq8G/0putWs/XNL5NcbvWdqfiLq3JW6rhPY2/r/FPDUq8aa80UWxh6imtFM2M31u6Gc06w/auLcGTpHCxf5AU6esfNPeF" +
+	"/gL6UC94kj5c6I8ydyr+XDMm6dH+/s30IV3RjAx9GKrxkf5M+pDhv0UfpvnX0kf7s4+OCX10TOijY0IfHRP66JjQR9fefXTt" +
+	"3UfX3n107d1H1959eEo1r1Zco/i04lrFAsX1is8qblAsVCxS3KS4WXGLYrHiq1rbrYqvKW5T3K7136n4qckp3Ux6bQl+Q6pe" +
+	"6anU8xuSyoX+AlJJ9PNIpb8/l1TSNcX0SSpDNT7cX0MqI/0tpOrdeqpe16l6XaeSqfEsxWzFHMVcxadU52rFNYpPK65VLFBc" +
+	"r1aeVdygWKhYpLhJcbPiFsVixVe1PlsVX1Pcprhda7VT8VOTU+JN/aWJYjeTp5ZvjipnLiLGf5SLMNf4RXT353KRXokX6Zh2" +
+	"kbb0Ih7Uo/l69BHFJYpLFZcprtf8zypuUCxU3KVl31M048lFyt6Lavn+zVxUG7+Qi2qL/yFpxPjnkUa94M2kcWHwOGk6PqTp" +
+	"Wi5N13Jp9A9+QxrpenSgv4U0hmp8pN+QNKb5zUljrj+FNB0B0nQESNMRIE1HgDQdAdJ05ZamK7c0Xbml6cotTVduabpyS+Mp" +
+	"tbVacY3i04prFQsU16v1ZxU3KBYqFiluUtysuEWxWPFVre1WxdcUtynuUrvvKX5qcko3k673hmk6W6XpWbtY12AX63m8WNMv" +
+	"1nE4nTH+QQYQ4+cxgHr+FAZwob+FAbrHMoDRihmK0zR9rsa3KxrODNC9ggG1fC5lQG3xG3KtrpOv1VXEtbpyuJaR/k6uJUPj" +
+	"T+nR1YprFJ9WXKtYoLhecz6ruEGxULFIcZPiZsUtisWKr6qVrYqvKW5T3K5Hdyqa9cC10s3fyRCdlYboHfSQ2uKvYSgxJDFU" +
+	"7zuGMsbfyVDNM7Q2/haG6rw/nBjFen5/hus6bThX+h8yXPeshpOu8as1fo3iYMUhikP16DCND1cc47/FcO3b4TyjR9crPqu4" +
+	"QbFQ8SXNX6TxTYqbFbcoFiu+pfi+4gekM5xPNf6FKauroOG6Chquq6DhtWEEw2tL8C1GEeMbrOffzCgu9PMYpau7UXrGRyln" +
+	"RtWW4HFGa9tHa57Rmme05hldW/x2jNEdvDGk+zsZw1DFZzRlvcafVdygWKhYpLhJcbPiFsVixbe07PuKn/o7yVAmZ1AvaPBC" +
+	"fwoZWocMrUNGbQl+yDTMXtY03R2aRhvF9oqdFHv7eUzTtdk0UjUlzZ/CNEZpfLRihuICljJN94umsVLzbNf4TsVdiu8p7lE0" +
+	"O0LTxIw/0yTVn8JcEv0tzNW937lan7lan7lak7k6t87Vvd+59PGvZa7uhc7lIsU0vzlztVZztVZztVZzyVRtWYrZijmKuYoP" +
+	"ap58xUcUlyguVVymuFI1b9f4TsVdiu8pmrbM1d3duZLqNydT7xcy9brIVM5n6u5uprYoU/s2U9uSqbu7mXoHnam7u5naokzd" +
+	"3c1UbmTqlZKpd3mZurubqfd6mXpdZOrIk6m7u5m6u5upu7uZeo1kGq1k6u5upu7uZurubqbu7mZq2zO17Zna9kxte6a2PVPb" +
+	"nqm7u5nKyUy978vU+75Mve/L1Pu+TL3vy9T7vky978vU+75Mve/L1N3dTN3dzdTd3Uzd3c3U3d1M7clM5W2m7hxm6s5hpu7r" +
+	"ZmoPZyqfM3VfN1N3dDN1RzdTd3QzdUc3U+8cM3WnMVN5lak7jZm6x5ipO7qZutOYqTu6mbrfmKk7upm6o5upO7qZeoeeqaN0" +
+	"pu7oZuqObqbu6Gbqjm6m7uhm6n5jpnxvUPdmM3U/NlP3YzN1PzZT92MzdT82U/djM3U/NlP3Y7OUJ1nKkyzlSZbyJEt5kqU8" +
+	"yVKeZClPspQnWcqTLOVJlvIkS3mSpTzJUp5kKU+ylCdZypMs5UmW8iRLeZKlPMlSnmQpT7KMbrKUJ1nKkyzlSZbyJEt5kqU8" +
+	"yVKeZClPspQnWcqTLOVJlvIkS3mSpTzJUp5kKU+ylCdZypMs5UmW8iRLeZKlPMlSnmQpT7KUJ1nKkyzlSZbyJEt5kqU8yVKe" +
+	"ZClPspQnWcqTLOVJlvIkS3mSpTzJUp5kKU+ylCdZypMs5UmW8iRLeZKlPMlSnmQpT7KUJ1nKkyzlSZbyJEt5kqU8yVKeZClP" +
+	"spQnWcqTLOVJlu7eZylbspQtWcqWLGVLlrIlS9mSpWzJUrZkK1uylS3ZypZsZUu2siVb2ZKtbMlWtmQrW7KVLdnKlmxlS7ay" +
+	"JVvZkq1syVa2ZCtbspUt2cqWbGVLtrIlW9mSrWzJVrZkK1uyjQWylS3ZypZsZUu2siVb2ZKtbMlWtmQrW7KVLdnKlmxlS7ay" +
+	"JVvZkq1syVa2ZCtbspUt2cqWbGVLtrIlW9mSrWzJVrZkK1uylS3ZypZsZUu2siVb2ZKtbMlWtmQrW7KVLdnKlmxlS7ayJVvZ" +
+	"kq1syVa2ZCtbspUt2cqWbGVLtrIlW9mSrWzJVrZkK1uylS3ZypZsZUu2siVb2ZKtbMlWtmQrW7KVLdnKlmxlS7ayJVvZkq1s" +
+	"yVa2ZCtbspUt2cqWbGVLjrIlR9mSo2zJUbbkKFtylC05ypYcZUuOsiVH2ZKjbMlRtuQoW3KULTnKlhxlS46yJUfZkqNsyVG2" +
+	"5ChbcpQtOcqWHGVLjrIlR9mSY+yQo2zJUbbkKFtylC05ypYcZUuOsiVH2ZKjbMlRtuQoW3KULTnKlhxlS46yJUfZkqNsyVG2" +
+	"5ChbcpQtOcqWHGVLjrIlR9mSo2zJUbbkKFtylC05ypYcZUuOsiVH2ZKjbMlRtuQoW3KULTnKlhxlS46yJUfZkqNsyVG25Chb" +
+	"cpQtOcqWHGVLjrIlR9mSo2zJUbbkKFtylC05ypYcZUuOsiVHeZKjPMlRnuQoT3KUJznKkxzlSY7yJEd5kqM8yVWe5CpPcpUn" +
+	"ucqTXOVJrvIkV3mSqzzJVZ7kKk9ylSe5ypNc5Umu8iRXeZKrPMlVnuQqT3KVJ7nKk1zlSa7yJFd5kqs8yVWe5CpPcpUnucYa" +
+	"ucqTXOVJrvIkV3mSqzzJVZ7kKk9ylSe5ypNc5Umu8iRXeZKrPMlVnuQqT3KVJ7nKk1zlSa7yJFd5kqs8yVWe5CpPcpUnucqT" +
+	"XOVJrvIkV3mSqzzJVZ7kKk9ylSe5ypNc5Umu8iRXeZKrPMlVnuQqT3KVJ7nKk1zlSa7yJFd5kqs8yVWe5CpPcpUnucqTXOVJ" +
+	"rvIkV3mSqzzJVZ7k6qiSq2zJVbbkKltylS25ypZcZUuusiVX2ZKrbMlVtiyo5fuFLKiNv4YF+lToQX2K+qDeUzyo9xQP6lPU" +
+	"B/X56YO6M7xId+YX6c78It2TX6T5F2n+Rbozv0h35hfpzvwi3ZlfpDvzi3RnfpE+M12kz0wX6TPTRfrMdJHu2C/SZ6aL9Jnp" +
+	"It15XqTPTBfp7n2+7kjn65OCfGV4vtYnX+uTr08K8rVW+VqrfK1PvtYnX+uTr/XJ1/rka33y1W6+2srXe718tfWI2npEbT2i" +
+	"th5RW4+orUfU1iNq6xG19YjaekRtPaK2HlFbj6itR9TWI2rrEbX1iNp6RG0tUVtL1NYStbVEbS1RW0vU1hK1tURtLVFbS9TW" +
+	"ErW1RG0tUVtL1NYStbVEbS1RW0vU1lK1tVRtLVVbS9XWUrW1VG0tVVtL1dZStbVUbS1VW0vV1lK1tVRtLVVbS9XWUrW1VG0t" +
+	"U1vL1NYytbVMbS1TW8vU1jK1tUxtLVNby9TWMrW1TG0tU1vL1NYytbVMbS1TW8vU1mO6o/uY7uiu0PgKjT+u8cc1/oTGn9D4" +
+	"St1DW6l7aCt1D22l7qGt1D20lbqHtlL30FbqHtpK3UNbqXtoK3UPbaXuoa3UPbSVuoe2UvfQVuoe2krdQ1upe2grdQ9tpe6h" +
+	"rdQ9tJW6h7ZS99BW6h7aSt1DW6l7aCt1D22l7qGt1D20lbqHtlL30FbqHtpK3UNbqXtoK3UPbaXuoa3UPbSVuoe2UvfQVuoe" +
+	"2krdQ1upe2grdQ9tpe6hrdQ9tJW6h7ZS99BW6h7aSu3blbqHtkr3x1bp/tgq3UlbpX3+pO4aPan5n9TdpCfVH2O97q09q7hB" +
+	"sVDxxVq+v4YXNeeL+kT7Jd1leklTXtKdqCLVWaSzWJHuRBVpniLdiSrSnahNmmeT5tmkeTZpnk2aZ5Pm2ax5NmuezZpns+bZ" +
+	"rHk2a54tmmeL5tmiebZoni2aZ4vmKdY8xZqnWPMUa55izVOsebbrzsx23ZnZrjsz25XP23VU3K47Mzs1z07Ns1Pz7NQ8OzXP" +
+	"Ts1TojN4idoq0eulRGfwEp3BS3QGL9EZvERn8BKdwUt0Bi/RGbxEZ/ASncFLdAYv0Rm8RGfwEp3BS3QGL9EZvERn8BKdwUt0" +
+	"Bi/RGbxEZ/ASncFLdAYv0Rm8RGfwEp27S3TuLtG5u0Tn7hKdu0t07i7RubtE5+4SnbtLdO4u0bm7ROfuEp27S3TuLtG5u0Tn" +
+	"7hKdu0t07i4x7aNE5+4SnbtLdO4u0Vm7RGftEp21S3TWLtH5ukR9xkp01i7RWbtEZ+0SnbVLdNYu0Vm7ROfrEp2vS3S+LtH5" +
+	"ukTn6xKdr0t0vi7R+bpE5+sSna9LdL4u0fm6ROfrEp2vS3S+LtH5ukTn6xKdr0t0vi7R+bpE5+sSna9LdL4u0fm6ROfrEp2v" +
+	"S2rjW5TofF2q3ChVbpQqN0qVG6XKjVLlRqlyo1S5UarcKFVulCo3SpUbpcqNUuVGqXKjVLlRqtwoVW6UKjdKlRulyo1S5Uap" +
+	"cqNUuVGq3ChVbpQqN0qVG6XKjVLlRqlyo1S5UarcKFVulCo3SpUbpcqNUuVGqXKjVLlRqtwoVW6UKjdKlRulyo1S5UapcqPU" +
+	"tJJS5UapcqNUuVGq3ChVbpQqN0qVG6XKjVLlRqlyo1S5UarcKFVulCo3SpUbpcqNUuVGqXKjVLlRqtwoVW6UKjdKlRulyo1S" +
+	"5UapcqNUuVGq3ChVbpQqN0qVG6XKjVLlRqlyo1S5UarcKFVulCo3SpUbpcqNUuVGqXKjTLlRptwoU26UKTfKlBtlyo0y5UaZ" +
+	"cqNMuVGm3ChTbpQpN8qUG2XKjTLlRplyo0y5UabcKFNulCk3ypQbZcqKMmVFmbKiTFlRpqwoU1aUKSvKlBVlyooyZUWZsqJM" +
+	"WVGmrChTVpQpK8qUFWXKijJlRZmyokxZUaasKFNWlCkTypQJZaZllCkTypQJZcqEMmVCmTKhTJlQpkwoUyaUKRPKlAllyoQy" +
+	"ZUKZMqFMmVCmTChTJpQpE8qUCWXKgTLlQJlyoEw5UKYcKFMOlCkHypQDZXr2y/Tsl+nZL9OzX6Znv0zPfpme/TI9+2V69sv0" +
+	"vJfpeS/T875LV+y7dB7ZpfPILl2x79IV+y5dsb+ned7TPO9pnvc0z3ua5z3Ns0dXZXt0VbZH12N7NP8ezb9HV2V7dFW2R1dl" +
+	"e3RVtkdXZXt0VbZHV/V7dFW/R1f1e3RVv0dXa3t0Vb9HV/V7dOW2R1f1e3QVsVet71Xre9X6XrW+V63vVet71fpetb5Xre9V" +
+	"63vV+l61slc171XNe1Xzp7rqOKSeeIfUE++Qaj6kmg+pJ94h9cQ7pJ54h1TDIfUTOKSeeOU6a5erB1e5enCVqwdXuXpwlasH" +
+	"V7l6cJWrB1e5XjXler2Uq6dQuXpwlasHV7l6cJWrB1e5enCVqwdXuXpwlasHV7l6cJWrB1e5enCVqwdXuXpwlasHV7l6cJWr" +
+	"B1e5Xi/ler2U6/VSrtdLuV4v5Xq9lOv1Uq7XS7leL+XqNVSuXkPl6jVUrl5D5erBVa4eXOXK/3L14CpXD65y9RcqVw+ucvXg" +
+	"KlcPLl97ydde8rWXfO0lX3vJ117ytZd87SVfe8nXXvK1l3ztJV97ydde8rWXfO0lX3vJ117ytZd87SVfe8nXXvK1l3ztJV97" +
+	"ydde8rWXfO0lX3vJ117ytZd87SVfe8nXXvK1l3ztJV97ydde8rWXfO0lX3vJ117ytZd87SVfe8nXXvK1l3ztJd/0klgM8vtK" +
+	"LbN6lFpmxSu1zIpX4oyXhcQZfyqJM/5UEmf8qSTO+FNJnPGnkjjzzE7ijD+VxBl/Kokz/lQSZ3wPJM74U0mc8aeSOONPJXHG" +
+	"G0HiDM8lzvhTSZzxp5I4408lccafSuKMP5XEGX8qiTP+VBJn/KkkzvhTSZzxp5I4408lccafSuKMP5XEGe8FiTPeCxJnvBck" +
+	"zngvSJzxp5I4408lccafSuKMP5XEGX8qiTP+VBJn/KkkzvhTSZzxp5I4408lccafSuKMP5XEGX8qiTP+DxJn/KkkzvhTSZzx" +
+	"p5I47b04408lceY5vsSbp9ISb57MSryZ+yTePJWWeDOLSbx5Ki3x5qm0xJun0hJvnkpLvHkqLfHmqbTEm3lN4s1TaYk3T6Ul" +
+	"3sxoEm+eSku8eSot8eaptMSbp9ISb55KS7x5Ki3x5qm0xJun0hJvnkpLvHkqLfHmqbTEm6fSEm+eSku8eSot8eaptMSbp9IS" +
+	"b55KS7x5Ki3x5qm0xJun0hJvnkpLY2VFY2VFY2VFY2VFY2VFY2VFY2VFY2VFY2VFY2VFY2VFY2VFY2VFY2VFY2VFY2VFY2VF" +
+	"Y2VFY2VFY2VFY2VFY2VFY2VFY2VFY2VFY2VFY2VFY2VFY2VFY2VFY2VFY2VFY2VFY2VFY2VFY2VFY2VFY2VFY2VFY2VFY2VF" +
+	"Y2VFY2VFY2VFY2VFY2VFY2VFY2VFY2VFY2VFY2VFY2VFY2VFE2VFE2VFE2VFE2VFE2VFE2VFE2VFE2VFE2VFE2VFE2VFE2VF" +
+	"E2VFE2VFE2VFE2VFE2VFE2VFE2VFE2VFE2VFE2VFE2VFE2VFE2VFE2VFE2VFE2VFE7MOkSbKiibKiibKiibKiibKiibKiibK" +
+	"igQSSZIE8/xZEszTRUkgWzFHMZckaal5WmqelpqnpeZpqXlaap4k42EoScbDUJKMh6Ekmd0PSTIehpJEb/+gJBkPQ0kyHoaS" +
+	"ZDwMJcl4GEqS8TCUJONhKEnGw1CSjIehJBkPQ0kyHoZi3sUw+uf6eZJk9kYkyeyNSJLZG5EkszciSWZvRJKMh6Ek8ZSWXa24" +
+	"RvFpxbWKBYrPqN31is8qblAsVCxS3KS4WXGLYrHiq1rDrYqvKW5T3K613am4S2vyluZ/T+Pva/xTg8bDUJKMh6EkGQ9DSTIe" +
+	"hpJkfLQkyXgYSpJZOUiS2TORrmavSbqavSYxfnTnSarZa5JUs9ckqdrbqWavSVLNXpOkmr0mSTV7TWJ86rZIqtlrEuNN11CM" +
+	"H11zMX50UyRV+zNV+zNV+zNV+zNV+zPV7DVJqtlrklSz1ySpZq9JUs1ek6SavSZJNXtNkmr2miTV7DVJqtlrklSz1ySpZq9J" +
+	"Us1ek6SavSZJNXtNkmr2miTV7DVJqtlrklSz1ySpZq9JUs1ekxhfO1PbrYqvKW5T3KV231P81OQ0e01i/OvyJLU2ppe03/qa" +
+	"tYT0NbtM0lfT+5orutYP5l3CWj8Yf5JaP5h3CWv9YN4lrPWD8ROv9YN5l7DWD8afpNYP5l3CWj+YUbHWD+Zdwlo/mHcJa/1g" +
+	"3iWs9YN5l7DWD2SozkzVmaWYrZijmKuYr5ofUVyiuFRxmeJK1VmkOjcpblbcolisuF2t7FQ8ZGpofEtq/WDeHKzlkxg8Wcun" +
+	"tb/T+F77W2r5tNF4O8X2mtJB450UOyt2UeyuR3tovKfGkzV+kb+glm+4V8s39261fIb4ebXMWmtKLZ/hGh+l6aMVMxQztSZZ" +
+	"itmKOYq5iguCc2v5PKg58xUfUVyiuFRxmeIqrcOTii9qyktqsUitb1LcrLhFsVhxu+bcqbhL8T3FParnkMbLNe4blFpGp8Rp" +
+	"PF7jjTXeRON9Tdtjm/k7a4sZCWuL4UxtMddabaGNxtsptlfsoNiJLrWFzhrvothd8/fQeE+NJ2u8d/Cb2kKf4ILaQqpv1xYu" +
+	"0vQ0TRngH6wtDNGUoYrDFUf5DWsLo/202mLm4tpixufaYsbn2mLG59pixufaYsbn2sICxQc1Z77iI4pLFJcqLlNcqXZXqZUn" +
+	"FV9UfEmxSHGT4mbFLYrFittVw07FXYrvKe7Ro4cUyxV9g1JLMU4xXrGxYhPFJNMzkmrqE9vM3xLrmVkm1jOzTKxnZplYz4x7" +
+	"sZ6ZZWI9M8vEemaWifXMLBPrmVkm1jOzTKxnZplYz8wysZ6ZZWI9M8vEemaWifXMLBPrmVkm1jOzTKxnRsVYz4yKsZ4ZFWM9" +
+	"MyrGemZUjPXMLBPrGT/2WM/MMrGe8WOP9Ywfe6xn/NhjPePHHuuZWSbWM37ssZ6ZZWI948ce6xk/9ljP+LHHemaWifWMH3us" +
+	"Z/zYYz3jxx7rGT/2WM/MMrGe8WOP9Ywfe6xnZplYz8wysZ6ZZWI9M8vEemaWifXMLBPrmVkm1jOzTKxnZplYz8wysZ6ZZWI9" +
+	"M8vEemaWifXMLBPrmdEytpl5kyu2mbmDjm1mGB7bzPA2tpnZ4YltZt7kim1m3uSKbWbe5IptRn/Nme4/GtvMvMkV28y8yRXb" +
+	"zLzJFdvMvMkV28zcccc2M3fcsc3MHXdsM3PHHdvM3HHHNjOcjG1mOBnbzHAytpnhZGwzw8nYZoaTsc3Mm1yxzcybXLHNzJtc" +
+	"sc3Mm1yxzcybXLHNjB9sbDPjBxvbjDWKTyuuVSxQXK+1elZxg2KhYpHiJsXNilsUixVfVdyq+JriNsXt2rqdiru0hu8pfmqO" +
+	"mr2a2GbmTa7YZuZNrthmoj1m3uSKbWbe5Iptrv3W3Hj2xjY313Vsc+PZG9vcjJ+xzbVFzbVFzbVFzbVFzbVFzbVFzY1nb2xz" +
+	"49kb29x49sY2N569sc3NFRrb3Fyhsc3NFRrb3Fyhsc3NFRrb3Hj2xjY3nr2xzY1nb2xz49kb29yMmbHNzZgZ29x49sY2N569" +
+	"sUNjb/MPxF4Xe5v/Quyw2Nv81bHDY2/zV8SO0PSRiqP06Gg9OkbjGZp+o+JNmjJWj96s8Vs0fbziBNV2qx6dqEcnmXRC/zrW" +
+	"kJHAPOAkSB5YHcHaBdZhsBPAHgn2GrAPgH0SnAbgdAQnHZw14OwDtye4G8A9Al46eDvAOwJRMyDqKARiIJAGgUkQyINAMUR3" +
+	"hFoNoNZAqFUMtRtA7fFQOx9q74A6baHOSKgzA+qsgjr7oM4JOK8rnDcWziuFugGoOwfqHoLzR8L58+D8Ujj/OMR0hZjpELMK" +
+	"YvZAzAmo1wDqpUC98VBvHtRbBfVjoP50qL8LYttC7EKI3Q0N0qHBQmiwERocgYZNoWEKNFwFDQ9DXArELYO4kxCfAvEbIf4E" +
+	"NEqDRuOh0SpodAguSIALBsMFRXDBHmjcExrnh6UUmqRBk3xo2haaZkPTjXDhJLgwD5pNhWZHoHlHaD4Qmk+ChMGQMBUSSqGF" +
+	"Ay1GQovd0OIItBwILbOh5QpoeQha1YFWk6DVRmi1B1odh8QYSMyGxBWQWASJeyDxKLQOQOs50Ho3tD4MrSsgqQEkdYWkgZA0" +
+	"HpLmQNIySCqCNo2gTU9oMxDajIc286DNCmhTDG0qoG0+tN0AbUuh7SFoWwHtGkC7DGh3BNoD7RtB+67QPh3aj4X2s6BDV+iQ" +
+	"Dh3GQgfzOw86rIMOO6DDAehwAjrGQMdl0HEjdNwFHQ9Bx5PQKQY6tYVOGdDpKHR2oHNT6NwTOg+EzuOhcyl0PgidT0KXGOjS" +
+	"Ebr0hy4Z0GUGdFkIXdZAlx3Q5QB0OQ5dA9A1EbqmQddh0HU6dJ0HXU9AtzrQrS106w/dxkK3WdAtD7qtg247oNtB6HYSujeA" +
+	"7h2he3/ongHdZ0H3POi+DrrvgO4HoftJ6NEfeqyAHsXQYx/0OA4960DPttCzP/TMgJ4zoGce9NwIPXdDzyOQ7EByAiSnQPJg" +
+	"SM6G5BWQXAzJeyD5KPRyoFcC9EqBXsOgVx70KoVeh6DXSegdA73bQu/+0DsDes+A3nsgpSukDISU8ZAyD1KWQcpGSNkFfTpC" +
+	"n93Q5zD0qYDUBpDaEVLTIXUspM6C1DxI3QCppZB6EFJPwEUxcFFHuKg/XJQBF82Ci/Lhot2Qlghp/SBtJKTNgLSFkLYO0nZA" +
+	"2gFIOw4XB+DiBLg4BS4eDBdPhYsXwMVr4OKtcPEBuPg4XBKASxLgkjS4ZBhcMhUuWQN9O0LfjdB3B/RLg3750K8CLh0Jl+6B" +
+	"y9LgsoFwWRFcDlw+Hi4/DP0bQf950P8kXJECVyyEKwNw5QK4KgBX9YSrxsJVxZAOpHeE9BmQfhQGdIQBaTBgAwwohQEH4erB" +
+	"cPUOuKYpXLMQrjkOAzNg4D4YeBh+kw6/WQW/OQDXdoRrx8O1c+DafBjkwKDxMGgrDE6EwVNh8EYYkgBD8mBIBQydBEMPwnUp" +
+	"cF0eDAOGDYNh02HYAhi2G4YdhGEnYHgdGJ4Iw9Ng+DAYPhWGZ8PwFTC8CIbvgeFHYIQDIxJgRAqMGAwjpsKIbBixAkYUwYjd" +
+	"MOIwjKiA6xvA9R3h+v5w/TC4fipcnw3Xr4Dri+D63XD9YRiZASNPwqgGMKojjOoPo8bCqFkwKg9GrYNRO2DUARh1HEbXgdGJ" +
+	"MDoNRg+D0VNhdDaMPgxj0mDMMBgzFcZkw5gVMKYIxuyGMYdhTAVkNICMjpDRHzIyIGMGZCyEjDWQsRUy9kHGUbjBgRuawg09" +
+	"4YaBcMN4uGEO3JAPN2yAG0rhhoNwwwm4sQ7cmAg3psGNw+DGqXBjNty4Am5KhJuK4KbdcNMRGNsRxu6Bm7PhlrZwywy4ZQfc" +
+	"cgBuOQ7jAjAuAcalwLjBMG4SjJsH45bBuI0wbheMOwTjTsL4GBg/HsYfgQmDYcIcuLUp3LoVJraFiVthUjpMmgOT9sDkBTD5" +
+	"ENzWEW6bA7dVwJQMmDIVpuyDqfNg6g6YFoBp2TDtJNw+Hm4vgjuAO8bCHXtgeleYngd3OnBnBtx5FO4aC3ftgd/2h9+ugRkD" +
+	"YUYGzJgKM5vCzA0w8yjcPQfuPg73DIZ7dsOsdJi1EGYdgt/1h9/Ngd8thN8Vw71t4d5ZcO86mN0UZufBHAfmmPAwzO0Hc6fD" +
+	"3H1wXwrcVwz37YL7gftT4P55cP8OmBcD89Jh3jyYdxge6A8P7IHMGMicCpkbIKsOZA2GrHzIOgHZIyF7K2QfhpymkDMecvIh" +
+	"5wDkpkPuRpjfD+avgPkVsCADFuyGB4fBg+PhwZPw++nw+3nw+33whzrwh47wh1WwMAYWzoOHYuChA/DHfPhTCvypFPLy4eG2" +
+	"8PAqePggLOoHi+bBoj2wuCcsngWLd0N+APLTIX8B5B+ER9LhkXnwSCk8sg8eOQqPVMCSOrCkKywZD0tWwJKTsHQkLJ0HS9fB" +
+	"0j2w9CQsawrL+sOysbBsOixbCMs2wLJdsOwgLKuA5Y1geT9YngHLp8PybFi+DpbvhuUn4dEG8Gg6PDoeHt0Ijx6HxwbCY7Pg" +
+	"sY3w2GFY0RVWDIQVh+HxpvB4R3h8IDw+HR7Ph8eL4fED8PhReMKBJxrAE23hiXx4Yis8cQhW1oGVCbCyJ6xMh5UZsHIOrFwH" +
+	"KytgVQysSoRVg2HVVFi1AFYVwao98KQDTybAk+nw5FR4cgE8uQKe3AhP7oMnT8BTDeCpkfDUQnhqIzy1D1YDq5vC6oGweg6s" +
+	"zofV62D1CVgTA2sSYU0KrBkGa2bBmh2wZh+sOQJrKuDprvB0f3h6JDw9FZ6eB08fhKdPwtoGsLYnrB0Ga7Nh7TpYuwcKGkHB" +
+	"QCjYAAW7oOAgFByHdQ6sawDr2sK6NFg3FtYtgHUbYd0eeGYgPLMDnjkA62NgfUpYZsH6PFi/BtYfhvUn4dkMeHY6PJsNz66C" +
+	"Z7fChq6woT9smAob5sCGhbDBpB+FwgAUJkJhTyjsD4WzoHABFK6CwmIo3AWFB6DwBDwXA8+1hedS4Llh8Nx0eG4hPLcGntsB" +
+	"zx2A547D88DzMfB8V3h+LDw/HZ6fB8/nwcY6sLEpbOwIG4fBxvGwcQZs3AAbt8LG3bDxILzQAF5IhBd6wgvF8MIueLErvLgB" +
+	"XjTxw/BiBbzUAF7qCC/1h5cy4KXDUJQORQtgUyPYtAE2x8DmkbB5H2wJwJZ+sGUebMmDLYegOAOKp0JxMbzcFF7Og5dPwCtd" +
+	"4ZU8eLUBvDodXt0NW9vC1n6wdSRsnQ5bF8DWVbD1ILw2Hl7bB9v6w7axsG0WbAe2T4IdDWDHAthxAnZOgp17YOchKBkJJUVQ" +
+	"cgheHwavb4TS6VB6HN4YCW8UQ1lPeLMpvJkHb1bArg3wVga8dRzeXgBv74C3T8I7+bA7BnYnwO6usLsf7N4A7zaCd9vCuynw" +
+	"bjq8mw3vboX30uC9gfDeeHhvDryXD+9thPcD8H4CvD8L3l8A7y+D99fB+8WwpynsmQV7tsKefbDnKHzgwAc94YP+8EE+fLAG" +
+	"PiiFvU1hb0fYmw17K+DDjvBhOnw4Dz7cCh/uhg8PwodHYV8D2JcI+3rCvv6wbwHsB/bHwP5+sH8W7F8H+w/DRynw0WD4aBV8" +
+	"dBL+PA/+vA/+fBgOTIcDB+FABfxlMPxlLPylGD7uCB9nwMez4OON8PFR+KQtfDISPpkEn+TBJ6vgk43wyQH45AgczIODq+Dg" +
+	"Hvg0Bj7tB5/OgU93waeH4LO28Nkc+OwwfJ4Cnw+Gz6fC53vg8yNwqB8cGgyHxsKh6XBoHhw6DF/0gy+WwRcb4Ytd8MUh+DIG" +
+	"vtwIX+6ArxLgq1lw2IHDM+BwERw+AV8PhK/XwDd14JsZ8M1x+OskODIM/tYV/pYPfzsJ3wbg20bw7Xj4tgi+3Q3fHobvFsDR" +
+	"OvB3B/5+FI7VgWMd4Vh/OJYBx3bAP5rCPybBceB4TzieAcez4Xgp/DMA/zThQfjnCfhhGPxQDCcawYkVcGIHnDgM/2oE/xoI" +
+	"/1oD/wb+PR7+XQr/Pgj/PgE/psCPc+DHXXAyBk5mwMl1cPII/NQWfhoPP62Cnw7AzzHw83T4eR+U74KKNVCxFSr2QaUDlT2h" +
+	"sj9UzoDKYqg8CcF+EFwAwVLwAT8d/DzwDyF0RJiEMAuRACLLEKsrYu1CrAOIdRyxA4g9HrFLEScGcTIQZw3iHEXctog7HnE3" +
+	"IO4JxOuHeAsQbxniFSHebsQ7gkSBRMUgUQlIVBoSNRKJmoREzUKi8pCodUjUDiTqABJ1HAkEkEAjJNAWCfRDAiORwHQksAAJ" +
+	"rEECW5HAbiRwEAmcQKLrINGJSHQaEj0MiZ6KRGcj0SuQ6CIkejcSfRiJrkBqJSK1BiK1xiO15iC1diO1myK15yC1DyJ1OiJ1" +
+	"5iB1diPnJSDnFSF1+yN1DyPnz0POr0BiZiExR5B6w5B645F6O5D6CUj9WUj93UhsAhLbFYnth8TmIbGHkAb9kQYbkIZ1kIbZ" +
+	"SMOjSFw2EpePxK1B4oqQuFIkvhESPx6JP4A0Gog0KkIuSEAuGIxcMA+54ATSOB1pXIw0qYM0GY80OYg0DSBNRyJNK5ALJyEX" +
+	"FiEXHkCapSDNDiPNuyLNFyIJA5GEFUiLpkiL6UjLOkjLDKTlYaRVV6TVKqTVSSRxDZJ4EmkdQFpnIEkBJGkw0iaAtOmKtNmI" +
+	"tE1B2qYjbQ8j7Zoi7dKQdiORdquQdkVI+45I+4FI+11IhwZIhzykwwGkY0ekYxHS8RDSKRHptBvp3A/pnI10XoN0SUC6rEO6" +
+	"HEW6VCBddyDdAki3YqS7g3TvinSfg/RwkB4NkB5rkJ4JSM+DSHI6kjwSSV6D9GqE9BqL9JqO9MpDegeQ3guR3iuQlP5ISh6S" +
+	"UoSkHEBSKpA+jZA+6UifeUifZUifjUifQ0ifCiQ1AUlNQVLTkdSpSOoCJHUNkroDST2IpJ5ELmqAXNQRuWgwclE2ctE6JC0B" +
+	"SZuEpO1C0o4jF3dFLh6IXDwPubgIuaQRcklP5JLpyCU7kEuOI327In3XIX1Lkb4nkX7pSL98pN8h5NI05NKFyKWHkcuaIpdl" +
+	"IJftQy7vilyejlw+Frl8FnJ5PnL5DqT/WOSKOsgVA5ErliFXFCNXNkCu7I9cWYRclYhclYZcNQy5aipyVTZy1QrkqmLkqj3I" +
+	"VUeQdJD0Rkh6VyQ9H0nfgAwAGdAIGZCBDJiBXN0TuXogck0Mck1b5Jp85JoNyMCOyMD+yMAMZOAMZOBC5DfpyG/WINd2Ra5N" +
+	"R67diAzqigw6iAw6gQxOQAbvQAYfQYY4yJA8ZMgGZGhbZOhUZGgRMnQXMvQgct0k5Lo85LoNyHUHkWE9kWEbkeE9keErkOGH" +
+	"kRE9kRHZyIgK5PquyPXTkevzkOtLkZFtkZEHkJEnkFHpyKixyKhZyKg8ZNRGZNQuZNQhZNRJZHQMMrotMrofMnokMno6MnoB" +
+	"MnoVMroYGb0HGX0EGQMyJgEZk4KMGYyMmYSMmYeMWYaM2YiM2YWMOYSMOYlkxCAZXZGMdCRjLJIxC8lYhmRsRDL2IBlHkBtA" +
+	"bmiE3NAVueEIciPIjQnIjSnIjYORGychN85DblyG3NQWuWkHctMB5KbjyNgAMjYBGZuCjB2MjJ2EjJ2HjF2GjN2IjN2FjD2E" +
+	"jD2J3ByD3NwVuTkPuXkdcvMu5OZDyC2rkFt2ILccQG45jowLIOMSkHFdkXHpyLixyLjpyLh5yLg8ZNwqZNxGZHxXZPxGZEID" +
+	"ZEIiMqEnMqE/MmEVMmEPMqECubUtcusw5NY5yK2rkFt3IxNBJk5CJmYjE3chE08gk5oik/ohkyYhkw4gk+sgk6cik5chk0uR" +
+	"2xoht2Ugt81DbjuKTGmKTBmPTMlDpuxAprZFpo5EphYjUw8h0+og0xYi04qQaYeQ2xsht+cjdzRF7khB7piH3LECuWMHcsdh" +
+	"ZHpHZPpgZPokZHoeMr0YmX4QudNB7kxB7hyG3HkCuWsqctdJ5LerkBkxyIx8ZGYaMnM3cncicvcG5J6uyD3FyKxEZNZCZNYJ" +
+	"5HelyL09kXuLkdlpyOwFyJxGyJxVyNx+yNwNyH0zkPs7IvdvQOYNRObtQ+YdRx4YhjywBnlgK5IJkpmOZC5DMo8iWT2RrIVI" +
+	"1lEkuz+SfRDJ6Y/kZCA5B5HcdCR3KzJ/KjJ/ATL/CLIgG1mwEVlQiiw4iTyYgTx4EPn9MOT3h5A/jEcWOsjCHchDXZE/dkX+" +
+	"uAL50zwkbyGSdwJ5uB/ycB6yyEEWDUQWrUIWgywejyxeiCw+iuSPRPJ3I48kII+sQR45iixJQZbkIUsOIku7IkuLkGU9kWUz" +
+	"kGXFyLKTyPIMZPkK5FGQR6cij5Yij3VFHpuFPLYVWVEHWZGOrChGVpxEHk9HHj+MPNEReSIPWVkHWZmPrDyMrBqMrNqFPNkT" +
+	"eXIW8uQ+5KkZyFMHkNU9kdUrkNUnkTXDkDX7kKebIk8vQJ4+jqxdgaw9gRRMRwp2I+sGIuvWIc80Qp4ZjzxzCFk/DFm/Cnm2" +
+	"AfLsAuTZCmTDSGTDVqSwJ1K4AXkuEXkuD3nuKPL8JOT5XcjzJ5GN/ZGNM5CNC5GNK5CNRcjGQ8jGk8gLMcgLbZEXRiIvTEde" +
+	"WIC8sAp5oRh5YQ/ywhHkRZAXGyEvdkVeTEdeHIu8OAt5MQ95cR3y4g7kxQPIi8eRlwLISz2Rl4YhL81AXtqIvHQUKUpEiiYh" +
+	"RSuQolKk6CBSdBLZlIhsSkc2jUU2zUI25SGb1iGbipFNe5BNh5BNJ5HNicjmNGTzMGTzVGRzPrJ5A7K5FNl8ENl8AtlSB9mS" +
+	"iGxJQ7YMQ7ZMRbZkI1tWIFuKkC27kS2HkS0VSHEDpDgNKR6JFE9CiuchxaVI8UHk5bbIy/2Ql0ciL09HXt6KvLwPefko8oqD" +
+	"vNIUeaUn8spA5JXxyCtzkFfykVc2IK+UIq8cRF45gbxaB3k1EXk1DXl1FvJqHvLqOuTVHcirB5CtHZGt/ZGtK5CtRchrAeS1" +
+	"BOS1FOS1hchra5DXtiKv7UNeO4psc5BtTZFtPZFtA5Ft45Ftc5Bt+ci2Dci2UmTbQWTbCWR7HWT7WGR7EbJ9N7L9MLK9AtnR" +
+	"ANkxENkxHtmxAtlRhOw4iux0kJ1NkZ3DkJ1TkZ3ZyM4VyM4iZOduZOdhZGcFUtIAKemIlPRHSjKQkhlIyUKkZA1SshUp2Ye8" +
+	"Xgd5vT/y+njk9TnI6yuQ14uQ1w8grx9HSgNIaSOktCtSmo6UjkVKZyGleUjpOqR0B1J6ACk9jrwRQN5IQN5IQd4YjLwxCXlj" +
+	"HvLGMuSNYuSNE0hZA6RsElI2Dynbh5QdRd7sh7w5EnlzOvLmDuTNA8ibx5FdAWRXArIrBdk1GNk1Cdk1D9m1B3krBnlrPPLW" +
+	"CuStIuSto8jbDvJ2GvL2MOTtqcjba5C3tyJv70PePoq84yDvNEXe6Ym8MxB5ZzzyzhzknXzknQ3IO6XIOweRd04gu+sgu/sj" +
+	"u+chu4uR3XuQdwPIuwnIu8OQd6ci72Yj725F3t2HvHsUec9B3muKvNcTeW8g8t545L05yHv5yHsbkPdKkfcOIu+dQN6vg7yf" +
+	"iLyfhrw/FXn/OLInEdkzB9mzD9lzFPlgEvLBPOSDCmRvA2RvR2Rvf2RvBrJ3BrJ3IbJ3DbJ3K7J3H7L3KPKhg3zYFPmwJ/Lh" +
+	"QOTD8ciHB5B9DrKvKbIvHdk3FtmXh+xbh+zbgew7iux3kP1Nkf09kf0Dkf3jkf1zkP35yP4NyP5SZP9BZP8J5KM6yEeJyEdp" +
+	"yEfDkI+mIh8tQz5ah3x0CPlzIvLnScif1yF/3oEcADnQCDkwEjkwHTmwETlwAvlLHeQvA5G/jEf+Mgf5SynyMcjH45GP5yAf" +
+	"H0I+Pol8koF8MgP55ADyyXHkYAA5mIAcXIh8GkA+zUY+A/lsDvJZPvLZBuTztsjn/ZDPdyCfH0A+P44cCiCH5iCHDiFf9Ee+" +
+	"yEC+2IF8cQD5Mg35chjyZRHyZSny5T7ky6PIVw7yVVPkq57IVwORr8YjX81BvspHvjqAHG6KHB6LHF6HHN6BHD6AHD6OfB1A" +
+	"vk5Avk5Bvh6MfF2KfDMd+aYI+WY38tc6yF8Tkb9OQv46D/lrKXIE5EgacmQYciQPObIOOXIYOVKB/C0F+Vse8rdVyN+OI98m" +
+	"IN/OQ74tRr7dg3x7BPkO5LtGyHddke/Ske/GIt/NQr7LR77binx3FDnaFjk6FTm6FTl6GPl7A+Tvg5G/5yN/P4wca4QcG48c" +
+	"24X8oz/yjwPI8QByfCByfA3yfQzyfR7y/QHkn12Rf05C/nkC+WE38sMJ5ERb5EQGciIfOXEY+ddW5N9tkX+nIP+eh/z7APJj" +
+	"APlxGfLjVuTHk8jJAHKyH3JyMHJyLHJyOnJyHnJyH/JTW+SnYuTnGcjPG5CfDyPlbZHyZUhFAKlohFQMRirykYrjSOV4pHIG" +
+	"UpmNVOYjlYeRoIMEGyDBNCQ4EAmuQ4LFSPAo4qcgfhHiV2DREYsMLHZjUYEljbAkHUvmYMkKLNmHZTlYVk8sKx/LHoxlr8By" +
+	"AlhOVyxnBpazAcvtiOUWYXlNsbwTWFEjsaJ2YAV6YgXysKITsaKLsGqlY9WailVrFVbtAFbtSVi1i7DqNMWqMxKrTh5WnRNY" +
+	"5/XEOm8e1nm7seqmY9WdilV3N9b5Dtb5C7FiAlgxY7FiZmDFLMCKWYEVsxErphQr5gBWvZ5Y9dKx6mVg1TuAVe8IVv22WPWL" +
+	"sOofx4odiRVbjNUgDavBbqyGGVgNV2E1PIwVNxUrrhgr7jhWfAJW/ECs+AVY8YewGjXAajQMq9EMrAvqYF2wAOuCI1iNB2I1" +
+	"noPV+ChWkzlYTROxms7Aarob68KeWBeuw7rwKFazwVjNZmE1W4fV7ARW84FYzYuwEhKwEvphJYzHSliAlbAOK2EfVgsHq0VT" +
+	"rBY9sVoMxGoxHqvFHKwW+VgtNmC1KMVqcRCrxQmslnWwWiZitUzDajkMq+VUrJbZWC1XYLUswmq5G6vlYayWFVitGmC16ojV" +
+	"qj9WqwysVjOwWi3EarUGq1UpVqtDWK1OYiXGYCW2xUrsh5U4EitxOlbiAqzEVViJxViJe7ASj2C1Bqt1I6zWXbFap2O1HovV" +
+	"ehZW6zys1uuwWu/Aan0Aq/VxrKQAVlICVlIKVtJgrKTpWEkLsJJWYSUVYyXtwUo6gtUGrDaNsNp0xWqTjtVmLFabWVht8rDa" +
+	"rMNqswOrzQGsNsex2gaw2iZgtU3BajsYq+0krLbzsNquwmpbjNV2D1bbI1jtwGrXCKtdV6x26VjtxmK1m4XVLg+r3Tqsdjuw" +
+	"2h3Aanccq30Aq30CVvsUrPaDsdpPwmo/D6v9Kqz2O7DaH8BqfxyrQwCrQwJWhxSsDoOxOkzC6jAPq8MyrA4bsTrswupwCKvD" +
+	"SayOMVgd22J17IfVcSRWx+lYHRdgdVyF1bEYq+MerI5HsDqB1akRVqeuWJ3SsTqNxeo0C6tTHlandViddmB1OoDV6ThW5wBW" +
+	"5wSszilYnQdjdZ6E1XkeVudlWJ03YnXehdX5EFbnk1hdYrC6tMXq0g+ry0isLtOxuizA6rIKq0sxVpc9WF0TsLpOwuoGVrcY" +
+	"rG5tsbr1w+o2EqvbdKxuC7C6rcLqVozVbQ9WtyNY3cHq3gire1es7ulY3cdidZ+F1T0Pq/s6rO47sLofwOp+HKtHAKtHAlaP" +
+	"FKweg7F6TMLqMQ+rxzKsHhuxeuzC6nEIq8dJrJ4xWD3bYvXsh9VzJFbP6Vg9F2D1XIXVsxir5wGsnsexkgNYyQlYySlYyYOx" +
+	"kidhJc/DSl6GlbwRK3kXVvIhrOSTWL1isHq1xerVD6vXSKxe07F65WH1WofVawdWrwNYvY5j9Q5g9U7A6p2C1XswVu9JWL3n" +
+	"YfVehtV7I1bvXVi9D2H1PomVEoOV0hYrpR9WykislOlYKQuwUlZhpRRjpezBSjmC1QesPo2w+nTF6pOO1WcsVp9ZWH3ysPps" +
+	"xepzEis1Biu1LVZqP6zUkVip07FSF2ClrsJKLcZK3YOVegTrIrAuaoR1UU+siwbz/2Hvf8Ciqtb+f/w968/ee/bsmdnzfyqq" +
+	"yajISJHZo6ioIKCgKKSoaFQioKAICINiUVlRUVlZUaFSYlFRUVlZUVlhUWJZWVFZWVFZUVlRUVlZ/S6G5Z86nfM8zznn85zn" +
+	"d13fzoVrzcze633f91p7ve57OXjI2GKQsatAxjaCjN0EMrYLZGwPyNh9IIk6SGIsSGI6SOJ8kMQakMQGkMRNIIldIIk9IIn7" +
+	"QMbpIONiQMYlgYzLBRlXATJuNci4VpBxHSDjdoGM6wcZr4OMjwUZnw4yfj7I+BqQ8WtAxreCjO8AGb8LZHwfyAQFZEI0yIQk" +
+	"kAm5IBMqQCasBpnQCjKhE2RCD8iEfSBJOkhSDEhSEkhSLkhSBUhSPUhSM0hSO0jSTpCkvSDJCkhyACQ5ASQ5GyS5GCR5FUhy" +
+	"I0jyJpDkLpDkPSDJ+0Em+kEmGiATM0EmFoJMrAWZ2AAysQ1kYifIxN0gE/tBUjSQlBiQlCSQlFyQlDBIyhqQlDaQlC6QlB6Q" +
+	"lD6QVAUk1Q+SGgeSmgSSmguSWgySugokdQ1IaitIajtI6k6Q1B6Q1H0gaTpIWgxIWhJIWi5IWgVIWj1IWjNIWjtI2k6QtF6Q" +
+	"SQCZ5AeZFAcyKR1k0nyQSTUgk9aATGoFmdQBMmkXyKQ+kMkKyOQAyOQEkMnZIJOLQSavApncCDJ5E8jkLpDJPSCT94Gk6yDp" +
+	"MSDpSSDpuSDpFSDp9SDpzSDp7SDpO0HSe0EyAJLhB8mIA8lIB8mYD5JRA5KxBiSjFSSjAyRjF0hGH8gUBWRKAGRKAsiUbJAp" +
+	"80Gm1IBMqQeZ0ggypQNkyk6QKb0gUwEy1Q8yNQ5kajrI1PkgU2tBpq4GmdoKMrUdZOpOkKl7QTIZSGYUSKYBkpkJklkIkhkG" +
+	"yVwNktkCkrkFJLMbJLMPZBpApkWBTDNApmWCTCsEmVYLMq0BZFoLyLQtINO6QabtBZnOQKZHgUw3QKZng0yfDzK9FmR6A8j0" +
+	"FpDpm0Gm7wCZvgdk+n6QLDdIVixIVjpIVi5IVhgkqw4kqwkkqw0kaydI1k6QrJ3/ws9ekKz9INl+kGwDJDsbJLsUJLsOJLsJ" +
+	"JLsDJLsH5DSAnBYNcloeyGlrQE7rBDltH8iMGJAZFSAzmkFmbAGZ0Q0yYx/IzBiQmUn/9U9OFMisWJBZmSCzCkFm1YHMagaZ" +
+	"1Q4yaxfIrP0gs6NBZmeDzF4FMrsJZI4fZE4syJxikDktIHN2gszpB8nVQXJjQXLzQHJXg+S2gORuAcndBTJXA5mbBDK3AmRu" +
+	"M8jczSBz94DM3Q8yzw8yLxFkXhPIvE0gpzOQ0xNATp8PcnozyOl7QfJ0kLxMkLxNIHk9IGdE/RM/FSBnNICcsQPkzDiQMztA" +
+	"zuwFOasRZH40yPxckPlhkPlNIPO7QfJ1kPwckPxikPxakPw1IPktIPntIPk7QPJ7QPL7QRYoIAuiQBbEgSxIBVmQC7KgFGTB" +
+	"KpAFDSALWkEWbAFZsBNkwR6QBftACjSQggBIgQFSkA5SkAdSUAFSUAdSsA+ksBWkKAGkqAakqAdkYSHIwl6QRTtAihtBSlJB" +
+	"SlpASraALM4BWdwEsrgfpHQVyNIokKWbQcrSQco2g5TngJTvAanwg1Q0glTsB1mWC7KsFmRZE8iyTpBle0Eqo0Eqi0Eqm0Gq" +
+	"5oOEE0DCm0DCXSDVOSDVfSDL60GWN4IsbwNZ3glSw0Bq+kFWpoKsbAY5GyBnt4GckwRSGwVS2wRyrh/k3DyQc/eCnLcG5PxU" +
+	"kPM3g6zKBrmgGOTCQpCL3CAX1YHUxYLU7QC5OBbk4hqQi7tALlFALgmDXFIHckkDyKX1IJduAbl0B8ilu0Hqd4Jc1gByeRTI" +
+	"5Y0gV8wHWZ0EsroU5MpNIFcxkKtaQa5OALm6E2RNIsiaOpA1/SDXhEGuVUCurQW5tg3k2j6Q61JBrmsDuW4HyHV9IA1RIA3N" +
+	"INdngly/F+SGaJAbVoPcGA1yYzbIjRUgN64BubEd5MZdIDfuA2n0gzQmgDTmgjTWgDQ2gjS2gzTuAmncB7LWD7I2AWRtNsja" +
+	"+SBra0DWNoKs3QSytgNk7S6QtftA1gVA1iWArEsHWTcfZN0qkHVrQNY1g6zbBLKuA2TdTpB1vSDrFZD1AZD1cSDr00HWF4Os" +
+	"XwOyvhVkfTvI+p0g6/tAmgDSpIM0BUCa4kCaNoPcFAdyswZycxTIzbEgNzeA3LwZ5OY9IBvcIBtSQTaEQTa0g2zYD9IcC9Kc" +
+	"B9K8GqR5C8hGBWRjDsjG/SC3tIDc0gdyayLIrfUgt3aDtESBtBSDtLSAtPSA3BYFclsuyG1tILfHgtzeDnJHLUhrEsidOSB3" +
+	"bga5cz/IXQbIXaUgd7WA3LUb5G43yN2ZIHfvAGnLBGmrB2nrB7mnCeTeVJB7i0HuXQNybzvIvT0g9ykg98WB3JcDcl8NyH0N" +
+	"IPdtArlvB8gmN8imPJBNLSCb9oLcr4DcHw1y/2qQBzSQB8IgD2wGeaAf5EED5MEwyIM7QTbHgmwuBdncBrJ5L8hDsSAPlYI8" +
+	"1AbysALycCHIw1tAHnGDPJIL8kgzyCO9IO0GSHsFSHsbSHsfyKNxII9WgDzaBvKYAvJYLshj7SCPu0EeD4M83guyJRFkyyqQ" +
+	"LZ0gT/hBnugHeVIHebIC5MnNIE/uB3kqF+SpTSAdGkhHLUjHJpCOPSBbDZCtpSBbd4I8XQjydCPIMwB5pgPkmb0gnVEgnatA" +
+	"no0GebYG5NndIM+tAnmuG+S5PpBtfpBtSSDbakC2NYBs2wnSpYF0RYF0xYF01YJ0rQHpagbp6gbp2guynYFsbwJ5Pgfk+TDI" +
+	"840gLzCQF2pBXmgEeWEHyAv9IDsSQXYUguxYDbJjB8iLGsiLeSAvhkFebAB5aT7Iy40grySBvNID8moryGsVIK81gLzWDvJa" +
+	"N0g3QLr9IN2ZIN21IN1NIN37QV7PA3l9F8gbAZA3VoG80QPyZibIm5tBdhkgu1pB3koAeasL5O1akHeiQN7ZAbJ7M8i7MSDv" +
+	"rgJ5LwHkvTaQ9zpB3tsD8j4DeT8a5P10kPeLQd7vBOmJA+kJg/S0g/TsA/kgFeSDepAPi0E+3AvyUQ3IR50gH+0D2ZMLsqcJ" +
+	"ZM8ukI91kI9zQT5uBPkEIJ8UgnzSBvJJH8inNSCf9oD0GiC99SC9/SCf5YF8thvk8xyQLxSQL7aA7AXI3nSQvd0gX+aBfNkI" +
+	"8uVOkK+iQb4Kg3zNQL5eA9IHkL4KkL7NIH37QL6ZD/JNF8i3USDfloJ82wvyXTHId90g/Ukg/c0g/e0g37tBvk8E+b4Q5PtG" +
+	"kO93gHzfD/KDH+SHHpAfG0D2zQf5yQ/yUxfIz5tAfmkA2Z8D8qsC8msuyK8NIL/uA/ktDuS3HJDfakB+awb5rRPkt16Q3/0g" +
+	"v6eD/F4D8ns7yO/9oEgARQMoekFN2aCmblCSB0pWg5J2UNIHSmNAaQMoAyjLBmUNoKwdlO0F5dGgPBeUt4Hy3aCSAioZoFIN" +
+	"qLQFVOoDlRVQORFUbgWV94IqsaBKKajSCKp0gZpjQc2FoOYmUDUKVG0CtbhBLatBLb2gWiaoVgeqtYNaA6DWVaDWXaC2WFBb" +
+	"IaitCdTWB2pPBbV3g+qFoPoOUIcB6lgF6tgB6kwFdW4BdaWCunaAuqNB3bWg7j5Qz3xQTx2opwXU0wXq2QvqnQ/q7QT1RYH6" +
+	"DFBfK6hfAfXXgvp7QY/IAz2iA/TIKNAj14Ae2Q96VAzoUc2gR+0AjWKgUQZo1HzQqBrQqEbQqD7Qo3XQo+NAj84GPboC9OhG" +
+	"0KM7QI/uBT1GBz0mDvSYVaDH1oAe2wR6bAfosT2Dv1YWCIAGakADO0GPiwI9Lgf0uA7QIQHQE7tBT0oCPWkz6EmdoCcNvN4D" +
+	"elI/aEw0aEwFaMxm0Ji9oCcroCcngZ5cA3pyA+jJO0BP3gs6VAEdGgs6NBt0aCno0NWgQztAT/GDnpIJekox6ClNoKf0g8bG" +
+	"gMamgsY2gMZ2gJ5aDHpqDeipe0CH5YAOqwUdroMO7waNawMdkQ4ar4DG7wYNFoMauaChWNDQftCRftCRBujIbNCRpaAjm0FH" +
+	"7gIdpYOOygQdVQc6qhM0QQNNyAVNqAVNaAZNGHivF3S0Bjo6DnR0DujoGtDRjaCjt4CO7gEdw0DHRIOOSQUdUwg6ph50zCbQ" +
+	"MbtAxzLQsXGgY3NBx9aDjt0MOnY3aCIDTYwFTcwFTawDTWwDTdwNOk4BHRcHOi4XdFwd6LhNoON2gY4H6PhY0PG5oONXgY5v" +
+	"AR2/E3T8ftAJ0aATMkEn1IBOaAGdsAN0wn7QpDjQpELQpEbQpB2gyQBNNkCTS0GTW0CTd4NOdINOTAedWAs6cRPoxD2gKX7Q" +
+	"lETQlGLQlAbQlA7QlF7QVB00NRE0tRg0tQk0tQs0tR80LQY0LQc0bRVo2mbQtB7QSRropCTQSRWgk1pAJ/WCTvaDTs4Enbwa" +
+	"dHIH6OQ+0PQ40PRC0PQG0PRu0Iwo0Iz5oBlbQDP2gk6JAp2SCTqlFnRKE+iUdtAp+0CnukGnxoFOzQSdWgw6tQ50ajPo1C2g" +
+	"U3eBTt0PmhkNmpkOmlkBmlkHmtkMmrkFNHMXaGY/6DQddFos6LR00GmFoNNWgU5rAp3WDjqtG3RaH+h0DXR6DOj0RNAsBTS7" +
+	"E/S0etDTmkBP2wR62g7Q03pAT+sHneEHnWGAzsgFnVELOmMN6IxW0Bk7QGf0gs50g84sBp25CnTmLtCcBNBZYdBZ+0Fn14Hm" +
+	"JoHm5oHm1oPmtoDm7gTN3Qs61w86NwF0bjHo3DrQuW2gc7tA5/aBztNA5yWAzssBnbcKdF4T6Lwu0Hl7QE/XQU+PBT09F/T0" +
+	"MOjpzaCnbwE9vRc0j4HmxYHmZYPm1YHmdYGekQt6xmrQM/pBz9wLelYz6Pw80PltoPkMND8PNL8ddIEbdEEt6IIdoAv6QQui" +
+	"QAtyQQt2ghZGgxbWgBa2gBYOvN4PWhQLWlQBWrQZtGgnaNFe0IUMdGEU6MJC0IVrQBduAV24B3SRBrooHXTRatBF7aCLekCL" +
+	"00GL60GLu0BLFNCSdNCSLtDF6aCLu0CXpIMuqQBd0gi6pAN0yV7QUgW01AAtzQYtrQFdWgy6tBu0rB50RTHo2emgZ68CPXsP" +
+	"6DkG6DmNoLWxoLX7QM/tBD0vBvS8TtALUkHrokHr8kDrukEvTge9uBn0kv2g9a2g9X2gl0WDXu4HvbwX9Ipa0NU66Opu0Cub" +
+	"QK+aD3p1NOi1raDXRYM2pIJe3wO6NhF07RbQdUmg6zaDrveDrm8CbYoFvakC9OZm0A2JoBt2gjbXgTa3gDZ3gjbvAd3IQDdG" +
+	"g25MBd1YCLqxDvSWfaC3doO2bAK9LQ/09hrQOxTQOxpBW7NBWztB70wAvbMN9C4Getd80Lv6Qe9uBm1TQNtqQe9xg95TA3pv" +
+	"Auh9ftD7ekE35YHeD9D7B9p9oA/0gD6YB7q5DvThTaCPpIO2F4I+2gb6WAfolhzQJzJBn9gB+uQa0KdqQDs00I5VoFsDoFuL" +
+	"QZ9WQJ/eCfpMNOgzOaCdYdBn54M+2wz67H7Q5+aDPtcBus0Num0+6LZ+0K7NoNubQF9oBd3RBPpiB+hL+0Ff3g/6SgD01RzQ" +
+	"11JBu/2gr0eDvpEL+uYm0F2bQd/KBH1rC+jbFaDvFILubgJ9zw/6/i7QnjDoBwboB02gH8aAfpgK+mEp6Id1oB8OvLcZ9MMd" +
+	"oB9lgn7UBLonAPqxH/TjDtBPekA/XQPa6wft3QL6WQfoF62gX5WC9sWC9lWA9m0C/cYA/WYV6Le5oN8lgfZHg/ZvAv1+DegP" +
+	"zaA/1oDuywHd1wK6bxfoT9GgP3WC/qKD7s8B3b8G9Fcd9Ndi0F87QX+LAv2tHvS3vaC/54D+3g6G+WCmJDCSDUa3gLFsMN4D" +
+	"JqeCKTvBtDVgtigwewOYHgDTd4K56sA80WDeRDBfDJg/EeyIaLAj2sCO1MGODIMd2Q525D6wozrBoraAHZ0IdnQF2NFtYEf3" +
+	"gR0TADtmFdgx/WDH7gILVIAdFws2pA0sOg7spDVgJ/WBxTSDnayAndwANjQabOgesFMqwE7pA4stBYvdDXZqHNipjWCn7gcb" +
+	"lgM23A82PA9s+CawOIDFlYKNiAGL7wYzusBC/WAJ0WCj68DG7gdLzgSbWAeW2g2W1gg2KQ9scgxYehvYlFiwqavBMnvBphtg" +
+	"09vBpu8FywqAZWWDZa0Cy9oFltUPlq2DZceCZeeAZdeAZa8By24FO60ObEYU2IxdYDPTwWZuAcvZDTarE2x2HNjsZrDZu8Hm" +
+	"+MHmJIHNKQWb0wA2pwNsTi9YrhssNwkstxQsdyfYXANsbgPYvGiweTvATk8AO70LLK8ULK8L7IzVYGfGgZ25E+ysFrD5rWD5" +
+	"HWALmsAKmsAKN4MVrQJb2Aq2qAOsuA+spAJscQBscS/YkjBYaTRY6V6wpYVgZYlg5QysvAusfB9YRTRYRTZYRS1YRRvYsgSw" +
+	"ZfPBltWDLdsNVhkHVpkLVlkPVtkOVrkLrCoGrKoGrKoFrKobLBwFFi4EC7eDVStg1Tlg1S1g1fvAlmeCLW8HW5EHtmIz2Ir9" +
+	"YDU5YDU9YCvng63cC3b2FrBzMsFqK8DObQI7LwbsvHqw83rBzo8GOz8X7PzVYOdvAju/G+z8vWCrGNiqPLBVm8AuCINdaIBd" +
+	"FAd20Xywi9aAXdQNVucGq8sBq9sEVrcX7OIYsIvDYBd3gF2SKn5ywS4pBbukHuySJrBL2sEu6Qa7ZB/YpRrYpTFgl6aCXVoM" +
+	"dmkd2KWtYJfuALt0H1i9BlYfA1afClZfDFZfB1bfCla/A6x+H9hlbrDLEsAuywO7rB7ssjawy3aBXc7ALk8AuzwT7PJisMvr" +
+	"wC5vBbu8E+zyXrArNLArEsCuyAG7ogbsiiawK7rArtgLttoPtjoVbHUN2OoGsNXtYKt3g12pgF0ZC3ZlLtiVdWBXtoNduRvs" +
+	"KgXsKgPsqmKwqxrAruoCu2o/2NUJYFdngl1dDHZ1HdjVrWBXd4Jd3Qu2RgNbkwC2JgdsTQ3YmiawNV1ga/aCXeMHuyYV7Joa" +
+	"sGsawK5pB7tmN9i1Cti1sWDX5oJdWwd2bTvYtbvBrlPArjPArisGu64B7LousOv2gzUkgDXkgDXUgDU0gTV0gTXsBbveD3Z9" +
+	"Ktj1NWDXN4Fd3wV2fT/YDTFgN+SA3VAPdsMWsBv2gd0YBXZjOtiNYbAbW8Fu7AZrVMAaE8Eaa8AaW8Aad4Gt1cDWpoOtrQVb" +
+	"2w62th9sXQLYukywdcVg6+rA1rWCresEW9cLtl4DW58Atj4HbH0N2PomsPVdYOv3gjX5wZpSwZpqwJoawJrawZp2g92kgN0U" +
+	"C3ZTLthNdWA3tYPdtBvsZgXsZgPs5mKwmxvAbu4Cu3k/2IYEsA05YBtqwDY0gW3oAtuwF6zZD9acCtZcA9bcBNbcBdbcD7Yx" +
+	"BmxjDtjGerCNW8A27gO7JQrslnSwW8Jgt7SC3dINdqsCdmsi2K01YLe2gN26C6xFA2tJB2upBWtpB2vpB7stAey2HLDbasBu" +
+	"awK7rQvstr1gt/vBbk8Fu70G7PYmsNu7wG7vB7sjBuyOHLA76sHu2AJ2xz6w1iiw1nSw1jBYaytYazfYnQrYnYlgd9aA3dkC" +
+	"ducusLs0sLvSwe6qBburHeyufrC7E8DuzgO7ux7s7s1gd/eCtbnB2tLB2mrB2trB2vaA3eMHuycT7J56sHu2gN2zD+xeA+ze" +
+	"GrB7W8Du3QV2nwZ2XzrYfbVg97WD3dcPtikBbFMp2KZWsE17wO6PAbu/EOz+VrD794I9kAD2QB3YAzvBHvSDPZgHtjkGbHMf" +
+	"2EM1YA8D7OFMsIfrwR7uBHt4H9gjsWCP5IA9Ug/2yBawR3aDtUeDtdeCPZoI9pgO9lg/2ONRYI/Xgj3eD7ZlPtgTGtgTbWBP" +
+	"BsCe3AT2VCrYU71gHcVgWwG2tRbsaQb2dD3Y071gz6SDPZML9kwF2DNrwJ7ZAdbJwDqjwDoNsM5MsM5CsM5asM4GsGcLwZ6L" +
+	"A3tuFdhzO8G2NYB1ZYN1bQbbroNtbwB73g32fAvYCwbYC2vAXugH25EHtqMDbMd+sBcTwF7MBnuxGOzFNrAX+8FeSgR7aTXY" +
+	"ywzs5VVgO91gO9vAXokFeyUM9ko72KtRYK/Wgr26E+y1aLDXmsC6dbDuPrDXd4K90Qz2ZgXYm1vAdjGwXZlguxrAdu0Geysa" +
+	"7K1asLdawN7aAfZWP9jbAbC3M8HergF7uwXs7d1g7+hg76SCvVML9s5msHd6wXa7wXYnge0uBdvdALa7E2x3P9i70WDv5oC9" +
+	"B7D3osHeywN7rxnsvS6w9/rB3o8Fez8b7P3VYO93g/W4wXrqwHp6wD7IAfuQgX04H+zDCrAPO8A+3A/2USLYRxVgH7WA7dHA" +
+	"9uSB7WkA29MH9nEi2MetYJ8oYJ8Ug32yBuyTdrBPE8A+rQP7dD9YbxxYbypYbwXYZ1Fgn4XBPtsN9nkm2OcdYF/kgX3RArZX" +
+	"A9tbD/YlA/syCezLFrCvAmBftYF9rYF9XQz2dStYnxusrxasrwPsm1iwbxrAvjXAvh1ou8G+84N9Vwr23Raw/iiw/lqw/j1g" +
+	"3+eCfd8E9oMG9kMp2A9dYD9Gg/0YBvuxA2xfJti+JrCfDLCfdoD9rIP9nAP2czPYz91gP+8B+7kf7BcG9osb7Jd2sP0A258I" +
+	"tr8CbH8b2K+pYL/2gP3mB/stHey3WrDfOsB+2w/2uwH2eynY77Vgv7eBIwkcreBoB8ducOwFNxngplxw0xZwkg1OdoLTQnDa" +
+	"AE47wZkOzprAeRw43wsuRYFLseAyA5dTweUccLkGXG4BV/zgShK40gmu7AU3F4ObV4Gbm8HNHeDmHnAV4KoOrsaAq0ngai64" +
+	"WgGu1oOrzeBqO7i6E1ztBbcA3OIHt8SBW9LBLfPBLTXgljXgllZwSwe4ZRe4pQ9cU8C1ALiWAK5lg2vF4NoqcK0RXNsErnWB" +
+	"az3g2j5wqw5ujQG3JoFbc8GtFeDWenBrM7i1Hdy6E9zaC24DuM0PbosDt6WD2+aD22rAbWvAba3gtg5w2y5wWx+4XQG3B8Dt" +
+	"CeD2bHB7Mbh9Fbi9Edy+CdzeBW7vAbfvA9d1cD0ArseB62FwvQ5cbwDX28H1LnB9F7hDA3dEgTtiwR2J4I5McEceuKMU3FEL" +
+	"7lgN7mgCd7SBO7aAO6PAnbHgzkRwZya4Mw/c2QjubAV3toM7u8BdBrgrFdyVA+4qBHc1gLtawF2bwV2d4G4F3O0HdxeDu+vA" +
+	"3a3g7g5w9y5wjwLuiQP35IF76sA9DeCeFnDPZnBPJ7h3Pri3G9y7F9zHwH014L7d4P5YcP9m8CPc4EdUgB+xCfyIPvAjDfAj" +
+	"w+BHrgY/sgX8KD/4UavBj2oDP2oH+NE54EdvBj/GDX5MJvgxq8GP2QV+TC/4MfvAj1XAj40DPzYX/NhV4Me2gh+7E/zYfeCB" +
+	"AHggHTxQAR5oAA9sBg/sAg/sBz8uAH5cEvhxpeDHNYIf1wl+XB/4EAY+JB18yGrwIVvAh+wFPz4O/Ph68GgNPLoJPLoN/IRE" +
+	"8BOKwU+oAT9hF/iJm8BPMsBP6gGPSQSP2Ql+sh/85Abwk/vAh64CH9oDfkoY/JQ68FMawE/ZDR4bDR6bDR5bAx7bCh7bDX5q" +
+	"HvipPeDDGsCHG+DD14AP3wceVwo+goGPWA0+oh88PhM8fjN4UAMP1oAHG8GD7eCGDm4UghthcKMJ3OgAN3aDG3vBQ5ngoTrw" +
+	"0E7wkbngozTwUcXgozrBE2LBE5rAR8eBj64HHxMFPmYT+Jh94GNTwcfuBh+7FzzRD56YB57YCJ64GXwcwMdFgY9LBB83H3xc" +
+	"Hfi4VvBxXeDjdoOP6wMfz8DH+8HHJ4KPzwYfXwg+vgZ8/Grw8ZvAx3eCj98FPn4v+ASAT4gGn5AAPiETfMJ88Alh8AmrwZPy" +
+	"wJNjwZP3g0/cAZ4SAE+pB0/ZD57aCJ7aB542HzxtD/ik+eCTNoNP9oNP7gRPjwNPXwOewcAzisEzOsCnpIJPaQCf6gafWgue" +
+	"GQ2eWQc+zQ0+rRZ8Wh/49Fzw6d3gWTHgWU3g2W7w7GLw7N3gp2WCn9YJPiMBfEYT+EwFfOZq8Jwo8JxW8Flh8Fld4LNjwGc3" +
+	"gs/eCz4nG3zOZvBcN3huGDx3D/jcHPC5beDzGPi8YvB5u8FPzwM/fS943nzwvD3gZ+SBn9EDfmYF+Jm94GdVgJ/VDz6/EHz+" +
+	"DvD8AHh+MXj+XvAFeeALdoMXdIMXNoMXpYMX7QFf2AC+KA98URh8USv4og7wYg28uAa8uBm8uA+8JBW8pAK8pB28pB98cQ74" +
+	"4lrwxQ3gi9vAF+8AX7wXfAkDXxIFvsQAX5IJvqQQfEkt+JIG8CWbwJd0gS/pAV+yH7zUDV4aC16aCl6aB14aBi9dDV7aAl66" +
+	"Bby0G7x0L/hSBr40CnypAb40E3xpIfjSWvClDeBlfvCyOPCydPCy+eBlNeBla8DLWsHLOsDLdoGX9YGXK+DlAfDyBPDybPDy" +
+	"YvDyVeDljeDlm8DLu8DLe8DL94FX6OAVMeAVSeAVueAVFeAV9eAVzeAV7eAVO8EresGXAXyZH3xZHPiydPBl88GX1YAvWwO+" +
+	"rBV8WQf4sl3gy/rAKxXwygB4ZQJ4ZTZ4ZTF45Srwykbwyk3glV3glT3glfvAq3TwqhjwqiTwqlzwqgrwqnrwqmbwqnbwqi7w" +
+	"cBg8XAcebgAPt4GHO8HDu8HD/eDVGnh1NHh1Inh1Dnh1KXh1HXh1E3j1ZvDqTvDlieDLc8CXl4IvrwNf3gS+vA18RSb4ijzw" +
+	"FaXgK+rAVzSBr9gMvmIH+Io94Cv2g9e4wWtiwWtSwWvywGvC4DWrwWtawGu2gNd0g9fsBV8ZBb4yD3xlKfjKWvCVDeAr28D/" +
+	"5Z9u8JX7wc9OBT97NfjZzeBnt4OfvQP8HICf4wY/Jwb8nCTwc3LAzykFP2cV+DmN4Oe0gZ/TCX7OLvBz+sBrAV7rB6+NA69N" +
+	"B6+dD15bA167Bry2Fby2A7x2F3htH/i5Cvi5AfBz48DPLQQ/txb83Abwc9vAz+0EP3c3+Ln94Odp4OdFg5+XCH5eDvh5peDn" +
+	"1YGf1wR+3mbw83aAn7cH/Lz94Oe7wc+PBj+/HXxVAHxVC/iqLeCrusFX7QW/gIFf4Aa/oAb8gjXgF7SCX9AOfqEGfmE0+IWJ" +
+	"4Bdmgl8UBX5RG3hdJnhdIXhdLXhdA3jdwHtbwC9uBL8kGvySLvBLesAv2Qd+qQ5+aQz4pQngl7aD1wfA65vB6wf6O8Hre8Ev" +
+	"A/hlOvhljeCX9YFfvgr88kbwyzeBX94FfnkP+OX7wK/Qwa+IAb8iCfyKXPArKsCvqAe/ohn8ik3gq2vBV/eCX1kBfuUq8Cv7" +
+	"wK+KBr+qGPyqGvCr/eBX14Ov8YOviQNfkw6+Zj74mhrwNfXg1wD8mi3g12aCX5sHfm0f+HUAv64F/Lot4Nd1g1+3F7yBgTdE" +
+	"gTcY4A2Z4A2F4A214A0N4A294Ndn/52f3eA3uMFvWA1+Qzv4jQr4jengN9aA39gCfmMPeGMNeGMDeOMO8MZ+8LUG+NpC8LUd" +
+	"4Ouywdc1gq/bCb4+AXz9avD1neDr+8Cb/OBNeeBNu8Bv0sFvWgN+Uzf4zVHgNyeB31wPfnMj+M2d4Dd3g29QwDckgW/IA99Q" +
+	"A76hAXzDJvANO8A39IM3R4E3p4M3V4A3N4I3d4I37wXf6AbfmAi+sRB842rwje3gG3vAb1HAbzHAb8kDv6UO/JY28Fu6wW/Z" +
+	"D35rDPit2eC31oDf2gJ+6w7wW/vBW6LAW1LBW0rBWxrBWzrAW3rBb3OD35YIflsh+G2rwW/bDH7bbvDbFfDb48BvzwW/vQ78" +
+	"9jbw27vBb98Pfkc0+B2Z4HfUgN/RDH5HF/gd/eCtUeCtqeCtpeCtDeCtW8Bbe8Hv1MHvTAC/sxD8ztXgd24Gv3M3+F0M/K5Y" +
+	"8Ltywe9aBX5XK/hd3eB37Qe/Oxr87kzwu8PgdzeB390FfncfeJsfvC0VvK0UvK0BvG0L+D0K+D2N4Pcy8HtTwe9dBX6fAn5f" +
+	"Kvh9a8Dv6wbfxMA3zQfftA/8/gbw+3eAP5AE/kAt+AN7wB9MAH9wFfjmaPDNHeAPJYA/1A3+cDr4w5vAH6kBf2QveHs0ePsq" +
+	"8PZO8Ed18Ed3gD+WBP5YF/jjheBbAL4lE/wJP/gTYfAnesGfjAZ/chX4k93gT0WDP1UM/lQN+FP14E81gj/VCv5UO/hTXeBP" +
+	"7QJ/qhf8qX3gHQp4hx+8Iwa8IwG8Ix28Ixe8oxi8owa8ox68oxG8oxW8ox28owu8Yxd4Ry94xz7wrQr4Vj/41hjwrQngW9PB" +
+	"t+aCby0G31oDvrUefGsj+NZW8K3t4Fu7wLfuAt/aC751H/jTCvjTfvCnY8CfTgB/Oh386Vzwp4vBn64Bf7oe/OlG8KdbwZ9x" +
+	"gz+TA/5MD3hnBXjnFvBnY8Gf3Qz+bB/4c13g23LAtxWCbwuDb6sD39YAvq0FfNtm8G2d4Nu6wbftAd/WD97FwLvc4F3R4F0G" +
+	"eFcqeFcOeFcheFcYvKsOvKsBvKsFvGszeFcneFc3eNce8K5+8O0MfLsbfHs0+HYDfHsq+PYc8O2F4NvD4NvrwLc3gG9vAd++" +
+	"GXx7J/j2bvDte8C37wd/3g3+/E7wFxLAX8gGf2EL+At94DsKwXfUgr+ogb8YBf6iAf5iB/iLO8Ff7AV/CeAv+cFfigN/KR38" +
+	"pVzwlyrAX6oHfzkP/OXd4Dvng++sAd+5H/yV1eCvtIC/mg3+aif4q7vBX6sDf60J/LXN4K/tAH9tD/hr+8G73eDdseDdqeDd" +
+	"a8BfTwV/vQL89VbwN6LB3+gBf3M3+Ft+8LcbwHeng+/uBH93Ffh7q8Df3wL+QQL4h/3ge3rBP24B/6QZ/NPd4J/lgn+hgH/J" +
+	"wL9OAO8zwL+pAP+2D7y/DfyHVvB9e8B/yQXfvxr8N4D/3gHJtAUS3QdJCkMyx0BSmyFpeZBsuZD0BkguHZJnByR/B6QjV0GK" +
+	"qod0zE5Ix+VCio6CdFIA0tBsSENrIQ3dDOmUOEin7IUUuwfSsACk4U2QRmRDGrEDUnw9pGA9JKMT0sgkSKP2QxrdB2lMG6Sx" +
+	"rZAS90AanwcpyQ9pohtSajqktCRIk2ohTd4HKWMzpKltkKb1QsrOhXTaakgzASlnC6TZ7ZBy+yGdPh/SmW5IZ62GlJ8OqSAB" +
+	"UlEY0qI+SIvbIC1thlReCGlZKaSqzZCWJ0Cq2Q/pnL2Qzo+CdH4NpFUGpAt0SBdqkC7cAemidkh1OyFdXAvpkk2QLu2EVL8Z" +
+	"0mXFkC5PgnRFANIVuyGtboB0ZSmkqwohXR2AtEaBdE0UpGs6IV3bD6mBQWrog3R9M6QbwpBuzIbUqEBqbIC0NhXSOjekdS2Q" +
+	"1s+H1JQOqakL0k07Id3cDmlDLaRmBql5E6SNhZA29kG6pRTSrUmQWhIg3ZYN6fYYSHfEQmrNg3RnNKS7wpDuboHUtgnSPasg" +
+	"3RuGdF8FpE2pkDbtgXR/ANL9dZDu74H0QCqkBzZBetAN6cEKSA92Q3poN6T2DkiP9UJ6gkF6kkF6ikHqaIK0tRbS03mQnpkP" +
+	"qdOA9GwppOdqIG3LhtQVB2l7IqTnoyC9kApphx/Sji2QXgxDekmB9FIXpJe3QNoZhvRKLKRXA5Be7YfUrUDq3g3p9U2Q3qiH" +
+	"9GYupF3RkN6KhvR2DqR3ciDtrof0biOk90ohvZ8JqScW0gcxkD6KhrRHgfRxFKSP+yB9mhj5p7yl3h5In+2G9EULpL17IH01" +
+	"H9LXqyD15UDq2w/pm92Q+gOQfmiG9JMG6Rc3pF8zIf1eD9m0CzJthMxzIEsxkKVUyFIpZKkesrQHsswgyzGQ5WzIch1kuR2y" +
+	"3A1Z3g9ZiYGsJEFWCiErDZCVTshKL2RzALK5EbK5FbK5HbIahmzRIVs2QdbCkLU6yFoDZK0FsrYZspVBtkZDtqZCthZCttZC" +
+	"tjZAtrZBtnZCtu6GbO2HbNMg26Ih2xIh23Ig20oh2+og21og2zoh2/ZAtjPI9kzI9hrI9i7I9j2QdUDWoyDrCZD1HMh6BWR9" +
+	"NWS9FbLeCVnvgazvh+zwQ3YYkB3ZkB2lkB31kB0tkB0dkB27ITv2QXbqkJ2xkJ2FkJ21kJ07ITt7IbsA2eWH7IqD7MqG7KqA" +
+	"7KqH7NoM2dUJ2dUN2bUHsms/ZHcMZPcqyO5GyO5NkN3dkD2A7ImC7DEgezIhewohe2ohexoge9ogezohe3ZD9uyD7I2C7E2E" +
+	"7F0N2bsHss+A7CuG7GuG7NsJ2Q/I/jjI/jzI/nrI/nbI/h7IRyiQj4iDfEQu5CM6IR9pQD6yGfKR3ZCPUiAflQj5qCbIUQxy" +
+	"VDHkqEbIUTsgR+2HfHQs5KPnQz56DeSj90A+JgHyMWsgH7Mb8rGxkI+tgXxsM+Rjd0A+th9yoBhyoAfycdmQj6uDfNwWyMf1" +
+	"QR6SBHlIA+QheyAf74Z8fDrk42sgH98G+fg9kKP9kKPrIUfvh3xCKeQTmiGfsAvyiRrkE8OQT+yBfFI65JPCkE9qgXzSTsgx" +
+	"DHJMIuSYCsgx/ZBProU8FJCHxkIemgd5aD3koe2Qh+6FfEoU5FNyIZ/SCPmUbsixOZBjd0E+NRfyqT2Qh5VCHtYPebgCeXgs" +
+	"5OHZkIeHIQ9vhDx8C+ThPZDjGOS4GMhxuZDjGiDH7YQ8IgHyiGzII4ohj6iHPKIZ8oh2yCMGPuuFHK9Ajg9Ajk+AHJ8LOb4G" +
+	"cnwj5PhNkOO7IMf3QA4CcjAAOZgEOZgLOVgBOVgPOdgMOdgOObgTcrAXsgHIhh+yEQfZSIdszIds1EA21kA2WiEbXZCNXsgh" +
+	"BXIoADmUADmUDTlUDDm0CnKoEXJoE+RQF+RQD+SRqyGP3A951CrIoxohj9oEeVQX5FE9kEftg5ygQ06IgZyQBDkhF3JCBeSE" +
+	"esgJzZAT2iEn7ISc0At5NCCP9kMeHQd5dDrk0fMhj66BPHoN5NGtkEd3QB69C/LoPshjFMhjYiCPSYc8phjymHrIY1ohj+mC" +
+	"PKYH8ph9kMfqkMcmQR67GfLYLshj90NOjIacmAk5sR5y4i7IiX2Qx+mQx+2GPMEPOakDcnIh5IkG5BRATomDnDIfckob5JR9" +
+	"kFO3QE5rgDxpFeRJrZAnuyFPboScrkFO74CcUQw5owfylBrIU6MgT90BOTMbcmYf5Gk1kKcrkKc3QJ7eDzkrBybAv5Tm4BQU" +
+	"QwOBjrVQAOYBoIABONI0HqbB/3sHNJriRd8Ej+lH0SdgxCf6FB6SLvoMHnK56HNo5DHRl+AiX4u+Agc9SfQtOJpWi77VeVPM" +
+	"y6JvgzH2TdHXYUmMFn0HzImpoDAxM2CKA0TfhKGmF0SfQCFW0acYSqJEn2EoOVP0OfykWfQlnEi2ib6CIZSKvgVj6GjRt54w" +
+	"hl4n+jYUj10q+jq8iZroO+BMjEMKylGBlahECRahGGEEEIMCnIwA4hGHOAQRwAKsRAATUYIwqhBGJYqQj6WIRQAZKEMBhiGA" +
+	"ZJSiFAHMODhWVeRVEapQhEosRxEKMQzqf0N15EHVHORjOZZiMcpRhkURO/JR/D9UTEURFiMfs1GNAApQjPzIaEWRO/IjHgVQ" +
+	"gjIEUIFqLEApSlCAAApRjqXIj3ymAinlFSsrSxYVhwMxBScH4uPigoEFKwMTS8JV4cqi/KWxgYyygmGB5NLSwIyBq6oCM4qq" +
+	"iiqXFxUOU//m1pEDt+bkL1+6uLxsUWBifvHfuTG1aHH+7OpAQXF+2aKiqkB+ZVGgpCxQUb2gtKQgUFi+NL+kTMWfXJwZcXDA" +
+	"uZRI4ApRFHk9EBCIAWfml1UFUsrLCovKqooKgYkoRzmWABPLy5f8Owb810eYHZnHKpRE7hhYHcMQxChgdlFlVUl5WSB+WHDU" +
+	"H4UOyPxdkQGNv2vkwshdgwspLJb5AeMWlpeFqwLhovyliCy2MCowBsMxHIVijOWoxjBUoRzVqEQBiiLjVUYW2jCUoQhhoDgc" +
+	"rhgzfHhh0eL85dXDqsqrKwuKFpZXLioaVlYUBiYdZsGBhXng4fjbR3DgswHviiIPTBFKUY4VODnyePx7Fv3ASJNRipWoQHHk" +
+	"zhIsRUXEr3AkrgNRq4zcEUByZNTlf4rkn/049FBX/+Gh/nveqFD/0vfB5ZGPwB+i9rfbi4pT/4X/qVD/Ixtl4L/wuQRVUCO9" +
+	"cOSdgVW4NBLrJQigHAv/S1sGPMuOjLc0MtqhB60kYlNx5LMi4deiiEqZmPVYMe+DszWoNrjGBtd7bMSu8sjsl0XurxAP86BC" +
+	"ORYgLNZYiVgF+ZExBiOtijHDESv+vJ4KItcNrMPB0Q+MMHD1oO2Da/nA0z8wW0MOWyVDIjOXH9khBtqqiF0FKEG+8E+NPAUF" +
+	"qMbSyCjhyCcH4rMQJSgVT1LMQRsPKQQwM2J/GCvE6h9QPBSTgXcqIk9NIaojdh6yphAlYsZKsADVEXsOxEH9Bwqx4mkuQCmq" +
+	"UXgwJisia6A4siuFRWSWouRPHh3wofIPq3LQ2mpUifEPzM5AfymKDs61etgOUoXiyOd/5UfsQT+HR3aQQGTkwedhcOwSEdU/" +
+	"zv4/9vpA5AatrTi4osNQxavBVXfIoxWReCz9bykceBoWRnb1MuFh0WGKhZE/BzRiI+1AJBajCAUIH7zmwPwNrONSsbMdmKEC" +
+	"waqSg/NRhTGRpzNHWJePBSiP7AyH5uDwvehQBP52JyhDeWTcgoM7/4FrDzwrFX+5Bxx+XyDic37EchULRFQOrbXBaJRE7sv/" +
+	"B/NZHqFgQMz90khb9D+Y7YFrVkbsXRjZBQbGHvaHSP2jewdislKwZVB9IOYLUXJwRxuwPSx2vcF3Bi0diGnhYXN++Ko7QNB8" +
+	"VIp4VaM08ko96FFhxNKB+So7LBqL/sDVQaUDe2g+CoQlVQc1/hyfqv/SpwNWqsKDQyssH6X/Iwv+qPPnePyVbbFivksj95X8" +
+	"nd1cPTg7lZF9Nh9L/zDugXeqDq7IA8/Ln+lRJPa5oogXB5RWRLwqjNw/5C94OOSg33++Q8WQg7QdctgqG3xmMv/ElwWR5738" +
+	"MFurxXNwYJ0sRzlK/iJiRaiJxLlMPMkVqBD0yo/sqEUH7zh83gdtPvCO+pdPSnFkhw9E2iphYxHK/sE6ObDX/dXeXRghwWBO" +
+	"fXi8/iqq6mGRO3wO/9lntSqyax5g9aGn7cCTlB/h2oHco1Lc8ccRKyIregnyI9cEhF+Dq0o9uKv+v9yp/r5XC8QzEhY8XHgw" +
+	"UulIi+hkYTpyIjpZmIQczEEyZkQ+y8BMBJCNGcjCbGQgFWlIjcxLMmaKz4dEnsY5yIiMmIVZkbEGx5iB5MjYcxGIjB2IvB54" +
+	"NRUZmI7UyL1pyI1opGEmZkJFFmZExp6GbGQiA2niuoE7UpCJWUiN9CdHstBBvenIirSZkfsGbBm0NAdZh6n+0aqMiOIBy6Yh" +
+	"DTOQgnTxaTImIiMy3oD9sZH8aKA/Xdg5GLkZkdEHYjQw8sCYKZiFzMirgXdnYQaykYWZkXgmR3wetHZ6xIdJmCF8SYtYMDgT" +
+	"gxalIAvZmBu5YjLSkYNYoZQjroyNzOOAP6mR+wdUp0auGrQsS8zyQP/QKMNELAftGIj/7IPKMyP+ZyIzEtsBf2dGFNKQjGkH" +
+	"xz2wdiZHRhiwW41EY1bEv+RIHLIiChMj1w1EcSCemQdX3IzDZiUlEq+BeYuN1JbJEU8mH5ydP3tyYLTDZ+evVod6UGFyxL+0" +
+	"SKQyI1fPRDbSkIKMg+8MrseMiK8pyDn4XopY94NrIvOw6KZEfByY2dMwS1wxON6fvRiYpzkR+w95MTgDyeLPlMNidmj2p4vZ" +
+	"PWBPTkQ55y+iMifyLKZFrkqOzPXMg8/IpMjzO01YPuvgCju0B8wS6zProGV/jO+B5+jAdf+dvWNwrAPaf5zB1Mh6yhQWzjwY" +
+	"jcEr1H8w7uDelYaayL5XIbhW9TfkPjxrPJSNHp53xh621x6eCQzuwpMj1y7903WH3h2slgaZdajWOTx3+6sK+0B1HPuHrPdQ" +
+	"9jG4d1ej6g/256Mwkp8P5oBVB7OS8kgeWH4wM1kR+bTosGpl8Oyk/A913oByfoT9sQe1DrDo0FiDeWU+SoVa1V9E8+8TSv2b" +
+	"yrAiwvtBlRWRflhkJgP+VYtrB94/+0/VcOWfqqlDcxD4yzk44MtfZQ6Hx78yMt8VopYqiUR4IJ8cJsatPFiXHYrJQAQGz92W" +
+	"/mnWD62+gdHG/M2pQjiSER+yvDASa1Wc4Q1oqpH96sAZ13/+1OnffVD+f+k8SP3DeVDgf+08SP3L86DA//J5kPrfOg/6YyZf" +
+	"cJhNVX9TZ/z3TlD/6oRF/Y+dKwX+5lxJ/f/OlQ47V1L/8Dz8/9+5kvoHwv7nzpXU/6PnSupfnisF/pfPldR/cF7wv3OupP6P" +
+	"z5UC/0/OldS/c6709+j790+XBuvzwUzi/9rpkvqn06XAf/B0Sf0H0Q0cFsH/26dMamSNBf4PnDKp/4dPmdQ/nTIF/iOnTOp/" +
+	"ecoU+F87ZVL/B6dMgf9np0xqJAazMQ1TkHUw2slI/188O1L/cs7/U2dH6t+cHQX+Y2dH6t89Owr8L54dqf+Ds6PAf+zs6MDO" +
+	"+veJ8rcnPuo/ceIT+H904qP+Syc+gX/TiY962InPPzp3+Hec0IT/Zvykw04aVAwTr4YBkyJf0Br4ftzAN+wOfikvEFNVVBRY" +
+	"UFRavuLkYYH/xrfphgUml66sKK4KlCytKK8MFxUGFlaWLw0kVxYtF18CO6AR+fZe9eC39w6XUdVD6rOLKvMDg6Yd/Aqgeuo/" +
+	"/E9V//nvGQb+pFxSpeYHwpX5hUVL8yuXBMoX/nkUVc0uqlxaUhX5Pl1JVaC4qLJowcrAosr8snBRYWxgYWVR0cBtBcX5lYuK" +
+	"YgPh8kB+2cpARVFlVXlZoHxBOL+krKRsUSA/UFBesVItXxgIFxcdiFNBQfnSivyylQMXhItLqgKlJQUDX7wLxAyJhGTIyYH8" +
+	"ssJAflVVeUFJfrioUC0sL6heWlQWzg8P2LOwpLSoKhAzMGLkhsDM8oXhFfmVRUNOjlhSWVRRWV5YXVAUGaawpCpcWbKgOlw0" +
+	"YIP6hxtiAyVlBaXVhQOWrCgJF5dXhwOlJUtLhNCAQuVgKMPlgeqqotiIO7GBpUUDXquRBVJVHHuYRuyA5vDyykBVUWnpwNUl" +
+	"RVUB4f6fpAeMC5cPxGxpSVgdDF1EaEVx+dK/vWFgGhZWV5aVVBUXRW4sLA9UlccGqqoXLC4qCA+8M+DfwvLS0vIVAw4VlJcV" +
+	"lgz4UTVGVXOKiwL5C8qXF0U8GFxFEQMOLoKy8nBJQVHV4LsDs1JxaAUMfhaoKs4vLVUXFImoFRUOPCX5f/CzvKwoUF4ZWFpe" +
+	"WfSXbgfCKyuKFuYXFFUNGzTqj58uzV8ZWFAUWFpeWLKwZGCh5ZeGiyoHOuWVgfzCwojng6EbeEDzK8MlBdWl+ZXqgFBhUVXJ" +
+	"orKIGYsGn9XyysgKzS8IF1VWDdxxwJ6qPysNDKnmFw4GLL/0rwcQ9xyw49BosYHystKVgZLDlrk64E5lUVn+0sFrBzpVA4Ec" +
+	"mJcDj0dRSbi4qDJy04ryysKqwJCDz+GQQPmhD9QhA4/tkEjISqoCmeJ5WVBUUB4Ztbq0NBKT5eUlBw0rqgkXlYUD+RUVpSUF" +
+	"+QtKiwY+GPS9vDLSUQ9NSnF+OFCcXxVYUFRU9oeYDKy6Q6u7MFBdVigMPmSqGjFu0MN/NKtV5aUDT3Vk2gYmKT9QOrB7VAaq" +
+	"DlxYkV+wJH9RUWBBdThQVq4OLNX/2aL6g9SClYGScFVR6cIBo9LTApOypucEZmZNypmTPCMtkDEzkD0ja3ZGalpqYEjyzEDG" +
+	"zCGxgTkZOelZs3ICc5JnzEienjM3kDUpkDx9bmBqxvTU2EBabvaMtJkz1awZgYxp2ZkZaamxgYzpKZmzUjOmTw5MnJUTmJ6V" +
+	"E8jMmJaRk5YayMmK3CqGykibOTDYtLQZKenJ03OSJ2ZkZuTMjVUnZeRMT5s5MzApa0YgOZCdPCMnI2VWZvKMQPasGdlZM9MC" +
+	"ydNTA9OzpmdMnzQjY/rktGlp03MGBkrJyp47I2Nyek5sIDs5J216TqyaMyM5NW1a8oypsYGsGYGsnPS0GYHIJcMCGdMD07MC" +
+	"abMHbp6ZnpyZGZiYkTMzZ0Za8rSBaweiM3l61rQ0dVLWrOmpyTkZWdMDE9MCmRnJEzPTBm2bPjeQkpmcMS02kJo8LXly2sxD" +
+	"IpkZB9w5FA514IbJadPTZiRnxgZmZqelZAx0MqanZsxIS8mJXJmRmjY9Z+DdrBmBlKzpM9NOm5U2PScjOfOARKw6Jz0tIpEx" +
+	"PZA8PZCcErEs4v70nBnJA+PkZM3IOWjKnIyZabGB5BkZMwdmZNKMrGmxgYH5zJoUWQGzZqYNXJoxXdg7MEcD7/3t6siaEblb" +
+	"OJialpyZMX3yzAEzctLT1D9cO0xV02oKiirCA2tbPNyDW2NkGx3cO2Mjq3ZwEyhfGJhcVr5UvBfpqpPKq8sKI9QZ3N0OAXsA" +
+	"x7GDW+/AWAOru7pKbL2Fy4sqwyVVA1tJeaVaPrCZrCipijzpFZXlS8sHmReoyi8tih24a+ApilwVKCzKLy0pW1R10Mw/PFDq" +
+	"ARhWVJaUVwZWVJaEw0VlgfzqcHF5ZcnZAsOV5UsPeRA45MGAyqHNYdD+yqKqiqKCcMnyotKVwwKTyisHWBaxpKRsYXnlUuF6" +
+	"JHwF4TEHUoVwYFFk8MLysFpeuWhYQFUjGde/nDr9d3/P4t+TB6mDeVDgn8mD1EN5UOCfzIPUv82DxCZfEBmp6gAz/iJBPZSw" +
+	"qP9KrhQ4kCup/zdyJXVwHv6f5Urq4AP7L+VK6r8xV1IP5UqBfzJXUv+QF/wTuZL693KlwH8/V1IPy5UOf3z/kC4FhgxsEv+u" +
+	"dEktr/xT5P+ZdEn9g7mRuvHfnTKpZeWBfzllUv+tKZMqUqbAP58yqX9OmQL/TMqk/mXKFPifpExqTvLsaVOyBsxOTv+nsiP1" +
+	"kOf/SnakHsiOAv9KdqQenh0F/qnsSP3L7Cjwr2RHA4v1Dw/KwcRH/buJT+B/kPio/zjxCfw3Eh81kvj8MXf4rxOa8IHrkyJJ" +
+	"gzossLBy2L/yO4PDI+d2S1CC4SgRf0dWg2GRv1+twPA//W3hP/4Nw+ErSpaUDC8pKyyqGVZRXDFc7Jh/77c1D//NTPzVL1Ue" +
+	"9quUBJH/fj8P8/AX//mXAjCBgIKBQ4IMBWaosECDFTbYoQ/8XjJccMMDL3zw4wgciaMQhaNxDI5FAMdhCI5HNE7AiTgJMTgZ" +
+	"Q3EKYnEqhmE44jAC8QjCQAgjMQoJGI0xGItEjMN4TEBS5Ng7JXI8PSnyVyAZmIKpyMS0yF+AZOM0zIh8mXYWZmMOcjEX83A6" +
+	"8nAGzsRZmI98tOFW1OFiPIEb0ItLcBWuwE24Ey0mgsvxNi7CdfgW3+FK3Ih6PIP38A1uxl34Hv34AbfgHmzHNtyLBSjAGhTi" +
+	"BRShC8/jZezAi3gJn2EhXsNOvIL7sAh9uAZvoBuvoxhf4EtchsWRpbAUpShDM8qxLHKIWxU5rF2OFfgcNTgbK3EOzkUt2rER" +
+	"5+M8rMIF2Iuv8JiJmpiJmySTbFLwK34zmU2qyWLS8LsJJqvJZrKbTCbd5DA5TS6T2+QxeU0+k990hOlI01HYh59MUaajTceY" +
+	"jjUFTMeZhpiON0WbTjCdaDrJFGM62TTUdAp+xpumWNOppmGm4aY40whTvCloMkwh00jTKFOCabRpDD7ER6axpkTTONN40wRT" +
+	"kinZNNGUYko1pZkmmSab0k0Z2IT7TVNMU02Zpmmm6aYsU7bpNNMM00z8gv3Yg49NOaZZptmmOaZc01zTPNPppjzTGaYzTWeZ" +
+	"5pvyTQtMBaZCU5FpoWmRqdhUgsdNi01LTKWmpfgEn5rKTOWmCtMyU6WpyhQ2VZuWm1aYakwrTWebzjHVms41nWc637QKt5su" +
+	"MF1oushUZ7rYdInpUlO96TLT5aYrTKtNV5quMl1tWmO6xnSt6TpTg+l60w2mG02NprWmdab1pibTTaabTRtMzaaNpltMt5pa" +
+	"TLeZbjfdYWo13Wm6y3S3qc10j+le032mTab7TQ+YHjRtNj1ketj0iKnd9KjpMdPjpi2mJ0xPmp4ydZi2mp42PWPqND1res60" +
+	"zdRl2m563vSCaYfpRdNLppdNO02vmF41vWbqNr1uesP0pmmX6S3T26Z3TLtN75reM71v6jF9YPrQ9JFpj+lj0yemT029ps9M" +
+	"n5u+MO01fWn6yvS1qc/0jelb03emftP3ph9MP5r2mX4y/Wz6xbTf9KvpN9PvBMRECKGEEU4kIhOFmIlKLEQjVmIjdqITB3ES" +
+	"F3ETD/ESH/GTI8iR5CgSRY4mx5BjSYAcR4aQ40k0OYGcSE4iMeRkMpScQmLJqWQYGU7iyAgST4LEICEykowiCWQ0GUPGkkQy" +
+	"jownE0gSSSYTSQpJJWlkEplM0kkGmUKmkkwyjUwnWSSbnEZmkJkkh8wis8kckkvmknnkdJJHziBnkrPIfJJPFpACUkiKyEKy" +
+	"iBSTErKYLCGlZCkpI+WkgiwjlaSKhEk1WU5WkBqykpxNziG15FxyHjmfrCIXkAvJRaSOXEwuIZeSenIZuZxcQVaTK8lV5Gqy" +
+	"hlxDriXXkQZyPbmB3EgayVqyjqwnTeQmcjPZQJrJRnILuZW0kNvI7eQO0kruJHeRu0kbuYfcS+4jm8j95AHyIHbhA7KZPEQe" +
+	"Jo+QdvIoeYw8TraQJ8iT5CnSQbaSp8kzeAe78S568BbeJ53kWfIc2Ua6yHbyPHmB7CAvkpfIy2QneYW8Sl4j3eR18gZ5k+wi" +
+	"b5G3yTtkN3mXvEfeJz3kA/Ih+YjsIR+TT8inpJd8Rj4nX5C95EvyFfma9JFvyLfkO9JPvic/kB/JPvIT+Zn8QvaTX8lv5HcK" +
+	"aqKEUsoopxKVqULNVKUWqlErtVE71amDOqmLuqmHeqmP+ukR9Eh6FI2iR9Nj6LE0QI+jQ+jxNJqeQE+kJ9EYejIdSk+hsfRU" +
+	"OowOp3F0BI2nQWrQEB1JR9EEOpqOoWNpIh1Hx9MJNIkm04k0habSNDqJTqbpNINOoVNpJp1Gp9Msmk1PozPoTJpDZ9HZdA7N" +
+	"pXPpPHo6zaNn0DPpWXQ+zccGuoAW0EJaRBfSRbSYltDFdAktpUtpGS2nFXQZraRVNEyr6XK6AutpDV1Jz6bn0Fp6Lj2Pnk9X" +
+	"0QvohfQiWkcvppfQS2k9vYxeTq+gq+mV9Cp6NV1Dr6HX0utoA72e3kBvpI10LV1H19MmehO9mW6gzXQjvYXeSlvobfR2egdt" +
+	"pXfSu+jdtI3eQ++l99FN9H76AH2QbqYP0YfpI7SdPkofo4/TLfQJ+iR9inbQrfRp+gztpM/S5+g22kW30+fpC3QHfZG+RF+m" +
+	"O+kr9FX6Gu2mr9M36Jt0F32Lvk3fobvpu/Q9+j7toR/QD+lHdA/9mH5CP6W99DP6Of2C7qVf0q/o17SPfkO/pd/Rfvo9/YH+" +
+	"SPfRn+jP9Be6n/5Kf6O/MzATI4wyxjiTmMwUZmYqszCNWZmN2ZnOHMzJXMzNPMzLfMzPjmBHsqNYFDuaHcOOZQF2HBvCjmfR" +
+	"7AR2IjuJxbCT2VB2Cotlp7JhbDiLYyNYPAsyg4XYSDaKJbDRbAwbyxLZODaeTWBJLJlNZCkslaWxSWwyS2cZbAqbyjLZNDad" +
+	"ZbFsdhqbwWayHDaLzWZzWC6by+ax01keO4Odyc5i81k+W8AKWCErYgvZIlbMSthitoSVsqWsjJWzCraMVbIqFmbVbDlbwWrY" +
+	"SnY2O4fVsnPZeex8topdwC5kF7E6djG7hF3K6tll7HJ2BVvNrmRXsavZGnYNu5ZdxxrY9ewGdiNrZGvZOraeNbGb2M1sA2tm" +
+	"G9kt7FbWwm5jt7M7WCu7k93F7mZt7B52L7uPbWL3swfYg2wze4g9zB5h7exR9hh7nG1hT7An2VOsg21lT7NnWCd7lj3HtrEu" +
+	"tp09z15gO9iL7CX2MtvJXmGvstdYN3udvcHeZLvYW+xt9g7bzd5l77H3WQ/7gH3IPmJ72MfsE/Yp62Wfsc/ZF2wv+5J9xb5m" +
+	"fewb9i37jvWz79kP7Ee2j/3Efma/sP3sV/Yb+52DmzjhlDPOucRlrnAzV7mFa9zKbdzOde7gTu7ibu7hXu7jfn4EP5IfxaP4" +
+	"0fwYfiwP8OP4EH48j+Yn8BP5STyGn8yH8lN4LD+VD+PDeRwfweN5kBs8xEfyUTyBj+Zj+FieyMfx8XwCT+LJfCJP4ak8jU/i" +
+	"k3k6z+BT+FSeyafx6TyLZ/PT+Aw+k+fwWXw2n8Nz+Vw+j5/O8/gZ/Ex+Fp/P8/kCXsALeRFfyBfxYl7CF/MlvJQv5WW8nFfw" +
+	"ZbySV/Ewr+bL+Qpew1fys/k5vJafy8/j5/NV/AJ+Ib+I1/GL+SX8Ul7PL+OX8yv4an4lv4pfzdfwa/i1/DrewK/nN/AbeSNf" +
+	"y9fx9byJ38Rv5ht4M9/Ib+G38hZ+G7+d38Fb+Z38Ln43b+P38Hv5fXwTv58/wB/km/lD/GH+CG/nj/LH+ON8C3+CP8mf4h18" +
+	"K3+aP8M7+bP8Ob6Nd/Ht/Hn+At/BX+Qv8Zf5Tv4Kf5W/xrv56/wN/ibfxd/ib/N3+G7+Ln+Pv897+Af8Q/4R38M/5p/wT3kv" +
+	"/4x/zr/ge/mX/Cv+Ne/j3/Bv+Xe8n3/Pf+A/8n38J/4z/4Xv57/y3/jvEiSTRCQqMYlLkiRLimSWVMkiaZJVskl2SZccklNy" +
+	"SW7JI3kln+SXjpCOlI6SoqSjpWOkY6WAdJw0RDpeipZOkE6UTpJipJOlodIpUqx0qjRMGi7FSSOkeCkoGVJIGimNkhKk0dIY" +
+	"aayUKI2TxksTpCQpWZoopUipUpo0SZospUsZ0hRpqpQpTZOmS1lStnSaNEOaKeVIs6TZ0hwpV5orzZNOl/KkM6QzpbOk+VK+" +
+	"tEAqkAqlImmhtEgqlkqkxdISqVRaKpVJ5VKFtEyqlKqksFQtLZdWSDXSSuls6RypVjpXOk86X1olXSBdKF0k1UkXS5dIl0r1" +
+	"0mXS5dIV0mrpSukq6WppjXSNdK10ndQgXS/dIN0oNUprpXXSeqlJukm6WdogNUsbpVukW6UW6TbpdukOqVW6U7pLultqk+6R" +
+	"7pXukzZJ90sPSA9Km6WHpIelR6R26VHpMelxaYv0hPSk9JTUIW2VnpaekTqlZ6XnpG1Sl7Rdel56QdohvSi9JL0s7ZRekV6V" +
+	"XpO6pdelN6Q3pV3SW9Lb0jvSbuld6T3pfalH+kD6UPpI2iN9LH0ifSr1Sp9Jn0tfSHulL6WvpK+lPukb6VvpO6lf+l76QfpR" +
+	"2if9JP0s/SLtl36VfpN+lyGbZCJTmclclmRZVmSzrMoWWZOtsk22y7rskJ2yS3bLHtkr+2S/fIR8pHyUHCUfLR8jHysH5OPk" +
+	"IfLxcrR8gnyifJIcI58sD5VPkWPlU+Vh8nA5Th4hx8tB2ZBD8kh5lJwgj5bHyGPlRHmcPF6eICfJyfJEOUVOldPkSfJkOV3O" +
+	"kKfIU+VMeZo8Xc6Ss+XT5BnyTDlHniXPlufIufJceZ58upwnnyGfKZ8lz5fz5QVygVwoF8kL5UVysVwiL5aXyKXyUrlMLpcr" +
+	"5GVypVwlh+Vqebm8Qq6RV8pny+fItfK58nny+fIq+QL5QvkiuU6+WL5EvlSuly+TL5evkFfLV8pXyVfLa+Rr5Gvl6+QG+Xr5" +
+	"BvlGuVFeK6+T18tN8k3yzfIGuVneKN8i3yq3yLfJt8t3yK3ynfJd8t1ym3yPfK98n7xJvl9+QH5Q3iw/JD8sPyK3y4/Kj8mP" +
+	"y1vkJ+Qn5afkDnmr/LT8jNwpPys/J2+Tu+Tt8vPyC/IO+UX5Jflleaf8ivyq/JrcLb8uvyG/Ke+S35Lflt+Rd8vvyu/J78s9" +
+	"8gfyh/JH8h75Y/kT+VO5V/5M/lz+Qt4rfyl/JX8t98nfyN/K38n98vfyD/KP8j75J/ln+Rd5v/yr/Jv8uwLFpBCFKkzhiqTI" +
+	"iqKYFVWxKJpiVWyKXdEVh+JUXIpb8Shexaf4lSOUI5WjlCjlaOUY5VgloBynDFGOV6KVE5QTlZOUGOVkZahyihKrnKoMU4Yr" +
+	"ccoIJV4JKoYSUkYqo5QEZbQyRhmrJCrjlPHKBCVJSVYmKilKqpKmTFImK+lKhjJFmapkKtOU6UqWkq2cpsxQZio5yixltjJH" +
+	"yVXmKvOU05U85QzlTOUsZb6SryxQCpRCpUhZqCxSipUSZbGyRClVliplSrlSoSxTKpUqJaxUK8uVFUqNslI5WzlHqVXOVc5T" +
+	"zldWKRcoFyoXKXXKxcolyqVKvXKZcrlyhbJauVK5SrlaWaNco1yrXKc0KNcrNyg3Ko3KWmWdsl5pUm5SblY2KM3KRuUW5Val" +
+	"RblNuV25Q2lV7lTuUu5W2pR7lHuV+5RNyv3KA8qDymblIeVh5RGlXXlUeUx5XNmiPKE8qTyldChblaeVZ5RO5VnlOWWb0qVs" +
+	"V55XXlB2KC8qLykvKzuVV5RXldeUbuV15Q3lTWWX8pbytvKOslt5V3lPeV/pUT5QPlQ+UvYoHyufKJ8qvcpnyufKF8pe5Uvl" +
+	"K+VrpU/5RvlW+U7pV75XflB+VPYpPyk/K78o+5Vfld+U380wm8zETM3MzM2SWTYrZrNZNVvMmtlqtpntZt3sMDvNLrPb7DF7" +
+	"zT6z33yE+UjzUeYo89HmY8zHmgPm48xDzMebo80nmE80n2SOMZ9sHmo+xRxrPtU8zDzcHGceYY43B82GOWQeaR5lTjCPNo8x" +
+	"jzUnmseZx5snmJPMyeaJ5hRzqjnNPMk82ZxuzjBPMU81Z5qnmaebs8zZ5tPMM8wzzTnmWebZ5jnmXPNc8zzz6eY88xnmM81n" +
+	"meeb880LzAXmQnOReaF5kbnYXGJebF5iLjUvNZeZy80V5mXmSnOVOWyuNi83rzDXmFeazzafY641n2s+z3y+eZX5AvOF5ovM" +
+	"deaLzZeYLzXXmy8zX26+wrzafKX5KvPV5jXma8zXmq8zN5ivN99gvtHcaF5rXmdeb24y32S+2bzB3GzeaL7FfKu5xXyb+Xbz" +
+	"HeZW853mu8x3m9vM95jvNd9n3mS+3/yA+UHzZvND5ofNj5jbzY+aHzM/bt5ifsL8pPkpc4d5q/lp8zPmTvOz5ufM28xd5u3m" +
+	"580vmHeYXzS/ZH7ZvNP8ivlV82vmbvPr5jfMb5p3md8yv21+x7zb/K75PfP75h7zB+YPzR+Z95g/Nn9i/tTca/7M/Ln5C/Ne" +
+	"85fmr8xfm/vM35i/NX9n7jd/b/7B/KN5n/kn88/mX8z7zb+afzP/rkI1qUSlKlO5KqmyqqhmVVUtqqZaVZtqV3XVoTpVl+pW" +
+	"PapX9al+9Qj1SPUoNUo9Wj1GPVYNqMepQ9Tj1Wj1BPVE9SQ1Rj1ZHaqeosaqp6rD1OFqnDpCjVeDqqGG1JHqKDVBHa2OUceq" +
+	"ieo4dbw6QU1Sk9WJaoqaqqapk9TJarqaoU5Rp6qZ6jR1upqlZqunqTPUmWqOOkudrc5Rc9W56jz1dDVPPUM9Uz1Lna/mqwvU" +
+	"ArVQLVIXqovUYrVEXawuUUvVpWqZWq5WqMvUSrVKDavV6nJ1hVqjrlTPVs9Ra9Vz1fPU89VV6gXqhepFap16sXqJeqlar16m" +
+	"Xq5eoa5Wr1SvUq9W16jXqNeq16kN6vXqDeqNaqO6Vl2nrleb1JvUm9UNarO6Ub1FvVVtUW9Tb1fvUFvVO9W71LvVNvUe9V71" +
+	"PnWTer/6gPqgull9SH1YfURtVx9VH1MfV7eoT6hPqk+pHepW9Wn1GbVTfVZ9Tt2mdqnb1efVF9Qd6ovqS+rL6k71FfVV9TW1" +
+	"W31dfUN9U92lvqW+rb6j7lbfVd9T31d71A/UD9WP1D3qx+on6qdqr/qZ+rn6hbpX/VL9Sv1a7VO/Ub9Vv1P71e/VH9Qf1X3q" +
+	"T+rP6i/qfvVX9Tf1dwssJguxUAuzcItkkS2KxWxRLRaLZrFabBa7Rbc4LE6Ly+K2eCxeiw8P4EGL33KE5Ug8jEfQaTkKm/EQ" +
+	"nrVE4UI8jUstR1uOsRyLuy0By3GWIZbjLdGWEywnWk6yxOA5y8mWoZZTLLGWUy3DLMMtcXgST1lGWOItQYthCVlGWkZZEiyj" +
+	"scUyxjLWkmgZZxlvmWBJsiRbJlpSLKmWNMsky2RLuiXDMsUy1ZJpmWaZbsmyZFtOs8ywzLTkWGZZZlvmWHItcy3zLKdb8ixn" +
+	"WM60nGWZb8m3LLAUWAotRZaFlkWWYkuJZbFliaXUstRSZim3VFiWWSotVZawpdqy3LLCUmNZaTnbco6l1nKu5TzL+ZZVlgss" +
+	"F1oustRZLrZcYrnUUm+5zHK55QrLavxoudJyleVqyxrLNZZrLddZGizXW26w3GhptKy1rLOstzRZbrLcbNlgabZstNxiudXS" +
+	"YrnNcrvlDkur5U7LXZa7LW2Weyz3Wu6zbLLcb3nA8qBls+Uhy8OWRyztlkctj1ket2yxPGF50vKUpcOy1fK05RlLp+VZy3OW" +
+	"bZYuy3bL81htecGyw/Ki5SXLy5adllcsr1pes3RbXre8YXnTssvyluVtyzuW3ZZ3Le9Z3rf0WD6wfGj5yLLH8rHlE8unll7L" +
+	"Z5bPLV9Y9lq+tHxl+drSZ/nG8q3lO0u/5XvLD5YfLfssP1l+tvxi2W/51fKb5XcNmkkjGtWYxjVJkzVFM2uqZtE0zarZNLum" +
+	"aw7Nqbk0t+bRvJpP82tHaEdqR2lR2tHaMdqxWkA7ThuiHa9FaydoJ2onaTHaydpQ7RQtVjtVG6YN1+K0EVq8FtQMLaSN1EZp" +
+	"CdpobYw2VkvUxmnjtQlakpasTdRStFQtTZukTdbStQxtijZVy9SmadO1LC1bO02boc3UcrRZ2mxtjparzdXmaadredoZ2pna" +
+	"Wdp8LV9boBVohVqRtlBbpBVrJdpibYlWqi3VyrRyrUJbplVqVVpYq9aWayu0Gm2ldrZ2jlarnaudp52vrdIu0C7ULtLqtIu1" +
+	"S7RLtXrtMu1y7QpttXaldpV2tbZGu0a7VrtOa9Cu127QbtQatbXaOm291oRG7SbtZm0DWrVmbaN2i3ar1qLdpt2u3YF1WivW" +
+	"4mvtTu0u7W6tTbtHu1e7D7dpm7T7tQdwrfagtll7SHtYe0Rr1x7VHtMe17ZoT2hPokl7SuvQtmpPa89ondqz2nPaNq1L2649" +
+	"r72g7dBe1F7SXtZ2aq9or2qvad3a69ob2pvaLu0t7W3cob2j7dbe1d7T3td6tA+0D7WPtD3ax9on2qdar/aZ9rn2hbZX+1L7" +
+	"Svta69O+wdXat9p3Wj8acL32vfaD9qO2T/tJ+1n7Rduv/ar9pv1uhdVkJVZqZVZulayyVbGararVYtWsVqvNarfqVofVaXVZ" +
+	"3VaP1Wv1Wf3WI6xHWo+yRlmPth5jPdYasB5nHWI93hptPcF6ovUka4z1ZOtQ6ynWWOup1mHW4dY46whrvDVoNawh60jrKGuC" +
+	"dbR1jHWsNdE6zjreOsGaZE22TrSmWFOtadZJ1snWdGuGdYp1qjXTOs063ZplzbaeZp1hnWnNsc6yzrbOseZa51rnWU+35lnP" +
+	"sJ5pPcs635pvXWAtsBZai6wLrYusxdYS62LrEmupdam1zFpurbAus1Zaq6xha7V1uXWFtca60nq29RxrrfVc63nW862rrBdY" +
+	"L7ReZK2zXmy9xHqptd56mfVy6xXW1dYrrVdZr7ausV5jvdZ6nbXBer31BuuN1kbrWus663prk/Um683WDdZm60brLdZbrS3W" +
+	"26y3W++wtlrvtN5lvdvaZr3Heq/1Pusm6/3WB6wPWjdbH7I+bH3E2m591PqY9XHrFusT1ietT1k7rFutT1ufsXZan7U+Z91m" +
+	"7bJutz5vfcG6w/qi9SXry9ad1lesr1pfs3ZbX7e+YX3Tusv6lvVt6zvW3dZ3re9Z37f2WD+wfmj9yLrH+rH1E+un1l7rZ9bP" +
+	"rV9Y91q/tH5l/draZ/3G+q31O2u/9XvrD9YfrfusP1l/tv5i3W/91fqb9XcbbCYbsVEbs3GbZJNtis1sU20Wm2az2mw2u023" +
+	"OWxOm8vmtnlsXpvP5rcdYTvSdpQtyna07RjbsbaA7TjbENvxtmjbCbYTbSfZYmwn24baTrHF2k61DbMNt8XZRtjibUGbYQvZ" +
+	"RtpG2RJso21jbGNtibZxtvG2CbYkW7Jtoi3FlmpLs02yTbal2zJsU2xTbZm2abbptixbtu002wzbTFuObZZttm2OLdc21zbP" +
+	"drotz3aG7UzbWbb5tnzbAluBrdBWZFtoW2QrtpXYFtuW2EptS21ltnJbhW2ZrdJWZQvbqm3LbStsNbaVtrNt59hqbefazrOd" +
+	"b1tlu8B2oe0iW53tYtsltktt9bbLbJfbrrCttl1pu8p2tW2N7RrbtbbrbA2262032G60NdrW2tbZ1tuabDfZbrZtsDXbNtpu" +
+	"sd1qa7Hdhkdtt9vusLXa7rTdZbvb1ma7x3av7T7bJtv9tgdsD9o22x6yPWx7xNZue9T2mO1x2xbbE7YnbU/ZOmxbbU/bnrF1" +
+	"2p61PWfbZuuybbc9b3vBtsP2ou0l28u2nbZXbK/aXrN12163vWF707bL9pbtbds7tt22d23v2d639dg+sH1o+8i2x/ax7RPb" +
+	"p7Ze22e2z21f2PbavrR9Zfva1mf7xvat7Ttbv+172w+2H237bD/Zfrb9Yttv+9X2m+13O+wmO7FTO7Nzu2SX7YrdbFftFrtm" +
+	"t9ptdrtdtzvsTrvL7rZ77F67z+63H2E/0n6UPcp+tP0Y+7H2gP04+xD78fZo+wn2E+0n2WPsJ9uH2k+xx9pPtQ+zD7fH2UfY" +
+	"4+1Bu2EP2UfaR9kT7KPtY+xj7Yn2cfbx9gn2JHuyfaI9xZ5qT7NPsk+2p9sz7FPsU+2Z9mn26fYse7b9NPsM+0x7jn2WfbZ9" +
+	"jj3XPtc+z366Pc9+hv1M+1n2+fZ8+wJ7gb3QXmRfaF9kL7aX2Bfbl9hL7UvtZfZye4V9mb3SXmUP26vty+0r7DX2lfaz7efY" +
+	"a+3n2s+zn29fZb/AfqH9Inud/WL7JfZL7fX2y+yX26+wr7Zfab/KfrV9jf0a+7X26+wN9uvtN9hvtDfa19rX2dfbm+w32W+2" +
+	"b7A32zfab7Hfam+x32a/3X6HvdV+p/0u+932Nvs99nvt99k32e+3P2B/0L7Z/pD9Yfsj9nb7o/bH7I/bt9ifsD9pf8reYd9q" +
+	"f9r+jL3T/qz9Ofs2e5d9u/15+wv2HfYX7S/ZX7bvtL9if9X+mr3b/rr9Dfub9l32t+xv29+x77a/a3/P/r69x/6B/UP7R/Y9" +
+	"9o/tn9g/tffaP7N/bv/Cvtf+pf0r+9f2Pvs39m/t39n77d/bf7D/aN9n/8n+s/0X+377r/bf7L/r0E060anOdK5LuqwrullX" +
+	"dYuu6Vbdptt1XXfoTt2lu3WP7tV9ul8/Qj9SP0qP0o/Wj9GP1QP6cfoQ/Xg9Wj9BP1E/SY/RT9aH6qfosfqp+jB9uB6nj9Dj" +
+	"9aBu6CF9pD5KT9BH62P0sXqiPk4fr0/Qk/RkfaKeoqfqafokfbKermfoU/SpeqY+TZ+uZ+nZ+mn6DH2mnqPP0mfrc/Rcfa4+" +
+	"Tz9dz9PP0M/Uz9Ln6/n6Ar1AL9SL9IX6Ir1YL9EX60v0Un2pXqaX6xX6Mr1Sr9LDerW+XF+h1+gr9bP1c/Ra/Vz9PP18fZV+" +
+	"gX6hfpFep1+sX6Jfqtfrl+mX61foq/Ur9av0q/U1+jX6tfp1eoN+vX6DfqPeqK/V1+nr9Sb9Jv1mfYPerG/Ub9Fv1Vv02/Tb" +
+	"9Tv0Vv1O/S79br1Nv0e/V79P36Tfrz+gP6hv1h/SH9Yf0dv1R/XH9Mf1LfoT+pP6U3qHvlV/Wn9G79Sf1Z/Tt+ld+nb9ef0F" +
+	"fYf+ov6S/rK+U39Ff1V/Te/WX9ff0N/Ud+lv6W/r7+i79Xf19/T39R79A/1D/SN9j/6x/on+qd6rf6Z/rn+h79W/1L/Sv9b7" +
+	"9G/0b/Xv9H79e/0H/Ud9n/6T/rP+i75f/1X/Tf/dAYfJQRzUwRzcITlkh+IwO1SHxaE5rA6bw+7QHQ6H0+FyuB0eh9fhc/gd" +
+	"RziOdBzliHIc7TjGcawj4DjOMcRxvCPacYLjRMdJjhjHyY6hjlMcsY5THcMcwx1xjhGOeEfQYThCjpGOUY4Ex2jHGMdYR6Jj" +
+	"nGO8Y4IjyZHsmOhIcaQ60hyTHJMd6Y4MxxTHVEemY5pjuiPLke04zTHDMdOR45jlmO2Y48h1zHXMc5zuyHOc4TjTcZZjviPf" +
+	"scBR4Ch0FDkWOhY5ih0ljsWOJY5Sx1JHmaPcUeFY5qh0VDnCjmrHcscKR41jpeNsxzmOWse5jvMc5ztWOS5wXOi4yFHnuNhx" +
+	"ieNSR73jMsfljiscqx1XOq5yXO1Y47jGca3jOkeD43rHDY4bHY2OtY51jvWOJsdNjpsdGxzNjo2OWxy3Oloctzlud9zhaHXc" +
+	"6bjLcbejzXGP417HfY5NjvsdDzgedGx2POR42PGIo93xqOMxx+OOLY4nHE86nnJ0OLY6nnY84+h0POt4zrHN0eXY7nje8YJj" +
+	"h+NFx0uOlx07Ha84XnW85uh2vO54w/GmY5fjLcfbjnccux3vOt5zvO/ocXzg+NDxkWOP42PHJ45PHb2OzxyfO75w7HV86fjK" +
+	"8bWjz/GN41vHd45+x/eOHxw/OvY5fnL87PjFsd/xq+M3x+9OOE1O4qRO5uROySk7FafZqTotTs1pddqcdqfudDidTpfT7fQ4" +
+	"vU6f0+88wnmk8yhnlPNo5zHOY50B53HOIc7jndHOE5wnOk9yxjhPdg51nuKMdZ7qHOYc7oxzjnDGO4NOwxlyjnSOciY4RzvH" +
+	"OMc6E53jnOOdE5xJzmTnRGeKM9WZ5pzknOxMd2Y4pzinOjOd05zTnVnObOdpzhnOmc4c5yznbOccZ65zrnOe83RnnvMM55nO" +
+	"s5zznfnOBc4CZ6GzyLnQuchZ7CxxLnYucZY6lzrLnOXOCucyZ6Wzyhl2VjuXO1c4a5wrnWc7z3HWOs91nuc837nKeYHzQudF" +
+	"zjrnxc5LnJc6652XOS93XuFc7bzSeZXzauca5zXOa53XORuc1ztvcN7obHSuda5zrnc2OW9y3uzc4Gx2bnTe4rzV2eK8zXm7" +
+	"8w5nq/NO513Ou51tznuc9zrvc25y3u98wPmgc7PzIefDzkec7c5HnY85H3ducT7hfNL5lLPDudX5tPMZZ6fzWedzzm3OLud2" +
+	"5/POF5w7nC86X3K+7NzpfMX5qvM1Z7fzdecbzjedu5xvOd92vuPc7XzX+Z7zfWeP8wPnh86PnHucHzs/cX7q7HV+5vzc+YVz" +
+	"r/NL51fOr519zm+c3zq/c/Y7v3f+4PzRuc/5k/Nn5y/O/c5fnb85f3fBZXIRF3UxF3dJLtmluMwu1WVxaS6ry+ayu3SXw+V0" +
+	"uVxul8fldflcftcRriNdR7miXEe7jnEd6wq4jnMNcR3vinad4DrRdZIrxnWya6jrFFes61TXMNdwV5xrhCveFXQZrpBrpGuU" +
+	"K8E12jXGNdaV6BrnGu+a4EpyJbsmulJcqa401yTXZFe6K8M1xTXVlema5pruynJlu05zzXDNdOW4Zrlmu+a4cl1zXfNcp7vy" +
+	"XGe4znSd5ZrvynctcBW4Cl1FroWuRa5iV4lrsWuJq9S11FXmKndVuJa5Kl1VrrCr2rXctcJV41rpOtt1jqvWda7rPNf5rlWu" +
+	"C1wXui5y1bkudl3iutRV77rMdbnrCtdq15Wuq1xXu9a4rnFd67rO1eC63nWD60ZXo2uta51rvavJdZPrZtcGV7Nro+sW162u" +
+	"Ftdtrttdd7haXXe67nLd7Wpz3eO613Wfa5PrftcDrgddm9GBra6HXA+7HnG1ux51PeZ63LXF9YTrSddTrg7XVtfTrmdcna5n" +
+	"Xc+5trm6XNtdz7tecO1wveh6yfWya6frFderrtdc3a7XXW+43nTtcr3letv1jmu3613Xe673XT2uD1wfuj5y7XF97PrE9amr" +
+	"1/WZ63PXF669ri9dX7m+dvW5vnF96/rO1e/63vWD60fXPtdPrp9dv7j2u351/eb63Q23yU3c1M3c3C25ZbfiNrtVt8Wtua1u" +
+	"m9vu1t0Ot9PtcrvdHrfX7XP73Ue4j3Qf5Y5yH+0+xn2sO+A+zj3Efbw72n2C+0T3Se4Y98nuoe5T3LHuU93D3MPdce4R7nh3" +
+	"0G24Q+6R7lHuBPdo9xj3WHeie5x7vHuCO8md7J7oTnGnutPck9yT3enuDPcU91R3pnuae7o7y53tPs09wz3TneOe5Z7tnuPO" +
+	"dc91z3Of7s5zn+E+032We747373AXeAudBe5F7oXuYvdJe7F7iXuUvdSd5m73F3hXuaudFe5w+5q93L3CneNe6X7bPc57lr3" +
+	"ue7z3Oe7V7kvcF/ovshd577YfYn7Une9+zL35e4r3KvdV7qvcl/tXuO+xn2t+zp3g/t69w3uG92N7rXude717ib3Te6b3Rvc" +
+	"ze6N7lvct7pb3Le5b3ff4W513+m+y323u819j/te933uTe773Q+4H3Rvdj/kftj9iLvd/aj7Mffj7i3uJ9xPup9yd7i3up92" +
+	"P+PudD/rfs69zd3l3u5+3v2Ce4f7RfdL7pfdO92vuF91v+budr/ufsP9pnuX+y332+533Lvd77rfc7/v7nF/4P7Q/ZF7j/tj" +
+	"9yfuT9297s/cn7u/cO91f+n+yv21u8/9jftb93fufvf37h/cP7r3uX9y/+z+xb3f/av7N/fvHnhMHuKhHubhHskjexSP2aN6" +
+	"LB7NY/XYPHaP7nF4nB6Xx+3xeLwen8fvOcJzpOcoT5TnaM8xnmM9Ac9xniGe4z3RnhM8J3pO8sR4TvYM9ZziifWc6hnmGe6J" +
+	"84zwxHuCHsMT8oz0jPIkeEZ7xnjGehI94zzjPRM8SZ5kz0RPiifVk+aZ5JnsSfdkeKZ4pnoyPdM80z1ZnmzPaZ4ZnpmeHM8s" +
+	"z2zPHE+uZ65nnud0T57nDM+ZnrM88z35ngWeAk+hp8iz0LPIU+wp8Sz2LPGUepZ6yjzlngrPMk+lp8oT9lR7lntWeGo8Kz1n" +
+	"e87x1HrO9ZznOd+zynOB50LPRZ46z8WeSzyXeuo9l3ku91zhWe250nOV52rPGs81nms913kaPNd7bvDc6Gn0rPWs86z3NHlu" +
+	"8tzs2eBp9mz03OK51dPiuc1zu+cOT6vnTs9dnrs9bZ57PPd67vNs8tzvecDzoGez5yHPw55HPO2eRz2PeR73bPE84XnS85Sn" +
+	"w7PV87TnGU+n51nPc55tni7Pds/znhc8Ozwvel7yvOzZ6XnF86rnNU+353XPG543Pbs8b3ne9rzj2e151/Oe531Pj+cDz4ee" +
+	"jzx7PB97PvF86un1fOb53POFZ6/nS89Xnq89fZ5vPN96vvP0e773/OD50bPP85PnZ88vnv2eXz2/eX73wmvyEi/1Mi/3Sl7Z" +
+	"q3jNXtVr8Wpeq9fmtXt1r8Pr9Lq8bq/H6/X6vH7vEd4jvUd5o7xHe4/xHusNeI/zDvEe7432nuA90XuSN8Z7sneo9xRvrPdU" +
+	"7zDvcG+cd4Q33hv0Gt6Qd6R3lDfBO9o7xjvWm+gd5x3vneBN8iZ7J3pTvKneNO8k72RvujfDO8U71Zvpnead7s3yZntP887w" +
+	"zvTmeGd5Z3vneHO9c73zvKd787xneM/0nuWd7833LvAWeAu9Rd6F3kXeYm+Jd7F3ibfUu9Rb5i33VniXeSu9Vd6wt9q73LvC" +
+	"W+Nd6T3be4631nuu9zzv+d5V3gu8F3ov8tZ5L/Ze4r3UW++9zHu59wrvau+V3qu8V3vXeK/xXuu9ztvgvd57g/dGb6N3rXed" +
+	"d723yXuT92bvBm+zd6P3Fu+t3hbvbd7bvXd4W713eu/y3u1t897jvdd7n3eT937vA94HvZu9D3kf9j7ibfc+6n3M+7h3i/cJ" +
+	"75Pep7wd3q3ep73PeDu9z3qf827zdnm3e5/3vuDd4X3R+5L3Ze9O7yveV72vebu9r3vf8L7p3eV9y/u29x3vbu+73ve873t7" +
+	"vB94P/R+5N3j/dj7ifdTb6/3M+/n3i+8e71fer/yfu3t837j/db7nbff+733B++P3n3en7w/e3/x7vf+6v3N+7sPPpOP+KiP" +
+	"+bhP8sk+xWf2qT6LT/NZfTaf3af7HD6nz+Vz+zw+r8/n8/uO8B3pO8oX5Tvad4zvWF/Ad5xviO94X7TvBN+JvpN8Mb6TfUN9" +
+	"p/hifaf6hvmG++J8I3zxvqDP8IV8I32jfAm+0b4xvrG+RN8433jfBF+SL9k30ZfiS/Wl+Sb5JvvSfRm+Kb6pvkzfNN90X5Yv" +
+	"23eab4Zvpi/HN8s32zfHl+ub65vnO92X5zvDd6bvLN98X75vga/AV+gr8i30LfIV+0p8i31LfKW+pb4yX7mvwrfMV+mr8oV9" +
+	"1b7lvhW+Gt9K39m+c3y1vnN95/nO963yXeC70HeRr853se8S36W+et9lvst9V/hW+670XeW72rfGd43vWt91vgbf9b4bfDf6" +
+	"Gn1rfet8631Nvpt8N/s2+Jp9G323+G71tfhu893uu8PX6rvTd5fvbl+b7x7fvb77fJt89/se8D3o2+x7yPew7xFfu+9R32O+" +
+	"x31bfE/4nvQ95evwbfU97XvG1+l71vecb5uvy7fd97zvBd8O34u+l3wv+3b6XvG96nvN1+173feG703fLt9bvrd97/h2+971" +
+	"ved739fj+8D3oe8j3x7fx75PfJ/6en2f+T73feHb6/vS95Xva1+f7xvft77vfP2+730/+H707fP95PvZ94tvv+9X32++3/3w" +
+	"m/zET/3Mz/2SX/YrfrNf9Vv8mt/qt/ntft3v8Dv9Lr/b7/F7/T6/33+E/0j/Uf4o/9H+Y/zH+gP+4/xD/Mf7o/0n+E/0n+SP" +
+	"8Z/sH+o/xR/rP9U/zD/cH+cf4Y/3B/2GP+Qf6R/lT/CP9o/xj/Un+sf5x/sn+JP8yf6J/hR/qj/NP8k/2Z/uz/BP8U/1Z/qn" +
+	"+af7s/zZ/tP8M/wz/Tn+Wf7Z/jn+XP9c/zz/6f48/xn+M/1n+ef78/0L/AX+Qn+Rf6F/kb/YX+Jf7F/iL/UvNVctDBevrCgu" +
+	"KpOTl+YXVJaXyfmDrZS8oLJoeZGUH2nk5PJF5WVFS+T8wdaSUlBSWVC9dGFpUY2l4FBfTSksD+cXFBSVhdWCg10ptSB/YMjC" +
+	"wSa1oLI8PyynCcEiIZg2KFgUadS0QwMVHezKacKMosFWShscsSjSWCYfZtSiw4yafGisRQe72uSC8qVL88WLRYe9sKQfNk7x" +
+	"oT5LX5BfyYoX5FdKGeGS0sIiqSTSyBnCkxLhScagJyWRRs4QNpcMtiRjCilZbJlymMbiQ31t6uFWLfnDi0WVRUVlpfllhSUF" +
+	"UmZ+QXW4SCqNNFrm4deVHvZCyhwMUGmkYZmF5WFWWlgelqYP3l82eP/0w+8vO/z+6YP3lw0GuCy/orwqXFleUVxE08oW0aKy" +
+	"RXKWcL5cOJ816Hx5pLFmFVeXLcqvrF5aml8dtpYf/kqaMWhD5aANMw63ofJwG2YM2lA52MwcvKsq0lhmHhbGqsPCmHP4aOHD" +
+	"R8sZHCY8GJGcgSkND0zprMEprY408izhVbXwatagV9WRhs+qLClbxKsH/rTO+oOH1Ye/kmeJqa8WT82cw6xdcVh/7mH9lYf6" +
+	"0rxBX8+ONOq8Q8v47INdXlpetqhKri4riRuRECfaEaKNF21QtIZoQ6IdKdpRok0Q7WjRJot2omhTRJsq2jTRThpsRwv90UJ/" +
+	"tNAdLXRHC93RQne00B0tdEcL3dFCd7TQHS10Rwvd0UJ39CSeVVxeWcbLB/4cfC9Z+JwstJOFdrLQThbayUI7WWgnC+1koZ0s" +
+	"tJOFdrLQTk7jsyKa1Yc0Jwp/JwrtiUJ7otCeKLQnCu2JQnui0J4otCcK7YlCe6LQnii0Jwq/J4p4p4h4pwj9FKGfIvRThH6K" +
+	"0E8R+ilCP0Xopwj9FKGfIvRThH6K0E8R+ilCP1Xopwr9VKGfKvRThX6q0E8V+qlCP1Xopwr9VKGfKvRThX6q0E8V+qlCP03o" +
+	"pwn9NKGfJvTThH5aSJo8+NQvijTiXaGeJtTThHqaUE8T6mlCPU2opwn1SUJ9klCfJNQnCfVJQn2S8H6S8H6S8H6S0J80Wk0e" +
+	"2EkGH/L8g105OW2wzS+KtJasqtL8quLBfvmhfmSU+Lg40Y4Qbbxog6I1RBsS7UjRjhJtgmhHizZZtBNFmyLaVNGmiXYwGvEj" +
+	"hP4IoT9C6I8Q+iOE/gihP0LojxilzTx8r6467IW4QlgyQlgyQlgyQlgyQlgyQlgSLyyJF5bEC0vihSXxwpJ4YUm8sCReRCJe" +
+	"RCJeRCJe6McL/XihHy/044V+vNAPCv2g0A8K/aDQDwr9oNAPjlQKy8OlRVVVi8UbwoCgMCAoDAgKA4LCgKAwICgMCAoDDGGA" +
+	"IQwwhAGGMMAQBhjCAEMEwBABMIS+IfQNoW8IfUPoG0LfEPqG0A8J/ZDQDwn9kNAPCf2Q0A8J/ZDQDwn9kNAPCf2Q0A8J/ZDQ" +
+	"Dwn9kNAfKfRHCv2RQn+k0B8p9EcK/ZFCf6TQHyn0Rwr9kUJ/pNAfKfRHCv2RQn+k0B8l9EcJ/VFCf5TQHyX0Rwn9UUJ/lNAf" +
+	"JfRHCf1RQn+U0B8l9EcJ/VFCf5TQF2lAvEgD4kUaEC/SgHiRBsSLNCBepAHxIg2IF2lAvEgD4kUaEC/SgHiRBsSLNCBepAHx" +
+	"Ig2IF2lAvEgD4kcLfZEOxIt0IF6kA/EiHYgX6UC8SAfiRToQL9KBeJEOxIt0IF6kA/EiHYgfLfSThX6y0BcpQbxICeJFShAv" +
+	"UoJ4kRLEi5QgXqQE8SIliBcpQbxICeJFShAvUoL4ZKGfLPQnCn2RFsSLtCBepAXxIi2IF2lBvEgL4kVaEC/SgniRFsSLtCBe" +
+	"pAXxIi2IF2lBvEgL4kVaEC/SgniRFsSLtCBepAXxIi2IF2lBvEgD4kUaEC/SgHiRBsSLNCBepAHxIg2IF2lAvEgD4kUaEC/S" +
+	"gHiRBsSLNCBepAHxIg2IF2lAvMB6vMB6vMB6vMB6vMB6vMB6vMB6fJoYL02MlybGE2CPF2CPFyCPFyCPFyCPF6COnzTKvKgy" +
+	"f/nAP72ywBxB6kAv8lkwLt4cKQgOe8cQbUi0I0U7SrQJ1uLy8iWRf53nsLuSRTtRtCmiTRVtmmgHYxAUOA0KnAYFToMCp0GB" +
+	"06DAaVDgNDhCWDEiQbSjRSv0BUSDAqJBAdGggGhQQDQoIBoUEA3Gx2uF5eHIvxJ6yClB0qAgaVCQNChIGhQkDQqSBgVJg4Kk" +
+	"QUHSoCBpUJA0KEgaFCQNCpIGBUmDgqRBQdKgIGkwKPSDQl+ANChAGhQgDQqQBgVIgwKkQQHSoABpUIA0KEAaFCANCpAGBUiD" +
+	"AqRBAdKgAGlQgDQoQBoUIA0KkAYFSIMCpEEB0qAAaVAANCgAGhQADQpQBgUogwKQQQHGoABjUIAxKMAYFGAMCjAGBRiDAlhB" +
+	"AazgqAPvCz0BrKAAVlAAKyiAFRSACgpABQWgggJQQQGo4KhJPFxeVl5lLSwpqiyqKqmKvFKTSyuK8yNdc35ZebiotKgkX0ur" +
+	"qCopLS+LvK2khcXnGeWip2UtLRkozAdfzDrsYjVradGiwYscJeXh/D9o8YgWm1gUzueT85cuzR80bLQhCz02ryicT9PC+Tyn" +
+	"uCiczwYE+dT8iop8KTN/6YLCfDKtmkyvJrklsrCAZJfQGcXlfGbJoqX5NCe/WhbW0OziEppSXEKzq0q0jMMssYsLDrxW8w8G" +
+	"QCs63O2iA26XHHDbXf3HWweditzPFgw4tWjAKV5YVBrOl8VY7OwBlwY+DEdcGhiML4m4VBpxaTAGE1NIWTWpKZHLB/2ilcXl" +
+	"UtWAUyN4pKHh/GpZ6NOK4hJaUFxCK6pKePlAwLXDY23/k5la+eGzVX34bJUfnK1BMwRUgqlxUsTcEYqIllibAirBVINVFJeM" +
+	"kCL3H/hMrEdRVwZFXRkUdWVQ1JVBUVcGRV0ZFAAKCgAFBYCCAkBBAaCgAFBQACgoABQUAAoKAAUFgIICQEFRWQZFZRkUQAoK" +
+	"IAUFkIKisgyKyjIoKsugqCyDAlhBUVkGRWUZFJVlUFSWQVFZBicJ/UlCf5LQnyT0Jwn9SUJ/0qC+IWpJQ9SShqglDVFLGgKB" +
+	"hkCgIRBoCAQaopY0RC1pCPgZAn6GgJ8h4GcI+BkCfoaAnyHgZwj4GQJ+hoCfIeBnCPgZAn6GgJ8h4GcI+BkCfoaAnyHgZwj4" +
+	"GQJ+hoCfIeBniArSEBWkIbhnCO4ZgnuG4J4huGcI7hmCe4bgniG4ZwjuGYJ7huCeIbhnCO4ZgnuG4J4huGcI7hmCe4bgniG4" +
+	"ZwjuGYJ7huCeIbhnCO4ZgnuG4J4huGcI7hmCe4bgniG4ZwjuGYJ7huCeIbhnCO4ZgnuG4J4huGcI7hmCe4bgniEKSEPwzxD8" +
+	"MwT/DFFAGqKANEQBaQguGoKLhiggDcFHQxSQhuCkIThpCE4agpOG4KQhOGkIThqigDREAWmIAtIQBaQhCkhDFJCGKCANUUAa" +
+	"ooA0RAFpiALSEAWkIQpIQ/DYEDw2BI8NwWND8NgQPDYEjw3BY0MUkIYoIA3BZ0Pw2RB8NgSfDcFnQxSQhiggDVFAGqKANEQB" +
+	"aYgC0hAFpCEKSEMUkIYoIA1RQBqigDREAWmIAtIQBaQhCkhDFJCGKCANUUAaooA0RAFpiALSEAWkIQpIQxSQhiggDVFAGqKA" +
+	"NEQBaYgC0hAFpCEKSEMUkIYoIA1RQBqigDREAWmIAtIQBaQhCkhDFJCGKCANUUAaooA0RAFpiALSEAWkIQpIQxSQhiggDVFA" +
+	"GqKANEQBaYgC0hAFpCEKSEMUkIYoIA1RQBqigDREAWmIAtIQBaQhCkhDFJCGKCANUUAaooA0RAFpiALSEAWkIc6VDXGubIiC" +
+	"0hAFpSEKSkMUlIYoKA1RUBqioDQE+w1RUBqioDREQWkI9huioDREQWmIgtIQ/DcE/w3Bf0Pw3xD8NwT/DcF/Q/DfEPw3BP8N" +
+	"wX9D8N8Q/DcE/w3Bf0Pw3xD8NwT/DcF/Q/DfEPw3BP8NwX9D8N8Q/DcE/w3Bf0Pw3xD8NwT/DcF/Q/DfEPw3BP8NwX9D8N8Q" +
+	"/DcE/w3Bf0Pw3xD8Dwn+hwT/Q4L/IcH/kOB/SPA/JPgfEvwPCf6HBP9Dgv8hwf+Q4H9I8D8k+B8S/A8J/ocE/0OC/yHB/5Dg" +
+	"f0jwPyT4HxL8Dwn+hwT/Q4L/IcH/kOB/SPA/JPgfEvwPCf6HBP9Dgv8hwf+Q4H9I8D8kOB0SnA4JTocEp0PBA9cJOwWnQ4LT" +
+	"IcHpkOB0SHA6JDgdEpwOCU6HBKdDgtMhwemQ4HRIcDokOB0SnA4JTocEp0OC0yHB6ZDgdEhwOiQ4HRKcDglOhwSnQ4LTIcHp" +
+	"kOB0SHA6JDgdEpwOCU6HBJdDgsshweWQ4HJIcDkkuBwSXA4JHocEj0OCxyHB45DgcUjwOCR4HBI8DgkehwSPQ4LHIcHjkOBx" +
+	"SPA4JHgcEjwOCR6HBI9DgschweOQ4HFI8DgkeBwSPA4JHocEj0OCxyHB45DgcUjwOCR4HBI8DgkehwSPQ4LHIcHjkOBxSPA4" +
+	"JHgcEhwOCQ6HBF9Cgi8hwZeQ4EtI8CUk+BISfAkJvoQEX0KCL6GJB8YV/gi+hARfQoIvIcGXkOBLSPAlJPgSEnwJCZ6EBE9C" +
+	"ggchwYOQ4EFI8CAkePD/o+7NvyVL7mq/14rO7q6xq1qtfk8GycZ2vccDq5Tf7zknIpIHzx0nI74aeGBDCwvsJcu36mZXXXTr" +
+	"3tIduiXjxjJYAppBwkBjMxmDJwbPRhKW54G1bPDEtDwPDJ5tPA/ltVJemd9P3j5df4GfflDkyYo8O+IMe0fE7th3QA8G9GCo" +
+	"u9/TXvRgQA8G9GBADwb0YEAPBvRgQA8G9GBADwb0YEAPBvRgQA8G9GBADwb0YEAPBnh+gOcHeH6A5wd4PsKvEX6N8GqEVyN8" +
+	"GuHDCM9F+CzCYxEei/BYhMciPBaZx0TmMZF5TGQeE5nHROYxkXlMZB4TmcdE5jGReUyEHyP8GOHHCD9G+DHCjxF+jPBjhB8j" +
+	"/BjhvQjvRXgvwnsR3ovwXoT3IrwX4b0I78V+d176Be9FeC/CexHei/BehPcivBfhvQjvRXgvMi+J8F1kfhHhswifRfgswmcR" +
+	"PovwWYTPInwW4bMYd+el/fBZhM8ifBbhswifRXgrwksRXorwUoSXIrwU4aUIL0V4KcJLEV6K8FKElyK8FOGlCC9F5gkRforw" +
+	"U2SeEJknROYJkXlCZJ4QmSdE5gmReUJknhCZJ0TmCZF5QmSeEJknROYJkXlCZJ4QmSdE5gmReUJknhCZJ0TmCZF5QmSeEJkn" +
+	"ROYJkXlCZJ4QmSdE5gmReUJknhCZJ0TmCZF5QmSeEOHxCI9HeDzC4xEej/B4hMcjPB7h8QiPR3g8wuMRHo/weITHIzwe4ekI" +
+	"T0fG/ZFxfmScHxnfR3g8wtMR/ovwX4T/IvwXd/xnu/rgMc6NjHMj49yEriR0JaErCV1JzFsS85ZE+xPtT7Q/LXfnK5Qj5ZKy" +
+	"UjZKvx6J/iV0KqFTCZ1K6FSi/wmdSuhUQqcSOpXQqYROJXQqoVMJnUroVEKnEjqV0KmETiV0KqFTCZ1K6FRifpKYnyTmJ4n5" +
+	"SWJ+krhvifuWuG+J+5a4b4n7lrhPaXefmI80xrEN/mjwR4M3GjzR4IkGPzT4oPF+N97vxvvdeL8b73XjvW681433ui1252mU" +
+	"tIf3ufE+N97nxnvceH8b72vjfW28p433tPGeNt7TxnvaeE8b72njPW28p433tPGeNt7TxnvaeD8b72fj/Ww8/43nv/H8N57/" +
+	"xvPfeO4bz3vjeW88743nvfG8N573xvPdeL4bz3fj+W48343nu/F8N57vxvPdeL4bz3Pz51nmzqcy9+svc7/+MvfrL3PnU5n7" +
+	"fZC586nM/X7I3PlU5s6nMvf7I3O/PzJ3PpV52eE1SvNyBH8EfwR/BH8EfwR/BH8EfwR/BH8EfwR/BH8EfwR/BH8J/hL8JfhL" +
+	"8JfgL8Gv1K/Ur9Sv1K/Ur7v6tLfS3kp7K+2ttLfS3kp7d/en0t5Kexv4DfwGfgO/gd/Ab+A38Bv4DfwGfgO/gd/Ab+A38A18" +
+	"A9/AN/ANfAPfwDfwDXwD38A38H2dRPBBBB9E8EEEH0TwQQQfRPBBBP9D8D8E/0PwPwT/Q/A/BP9D8D8E/0PwPwT/Q/A/BP9D" +
+	"8D8E/0PwPwT/Q/A/BP9D8D0E30PwPQTfQ/A9BN9D8D0E30PwPQTfQ/A9BN9D8D0E30PwPQTfQ/A9BN9D8D0E30PwPQTfQ/A9" +
+	"BN9D8D0Ev0PwOwS/Q/A7BL9D8DsEv0PwOwS/Q/A7BL9D8DsEv0PwOwS/Q/A7BL9D8DsEv0PwOwSfQ/A5BJ9D8DkEn0PwOQSf" +
+	"Q/A3BH9D8DcEf0PwNwR/Q/A3BH9D8DcEf0PwNwR/Q/A3BH9D8DcEf0PwNwR/Q/A3BH9D8DcEf0PwNwR/Q/A3BH9D8DcEf0Pw" +
+	"NwR/Q/A3BH9D8DcEf0PwNwR/Q/A3BH9D8DcEf0PwNwR/Q/A3BH9D8DcEf0PwNwR/Q/A3BH9D8DcEf0PwNwR/Q/A3BH9D8DcE" +
+	"f0PwNwR/Q/A3BH9D8DcEf0PwNwR/Q/A3BH9D8DcEf0PwNwR/Q/A3BH9D8DcEf0PwNwR/Q/A3BH9D8DcEf0PwNwR/Q/A3BH9D" +
+	"8DcEf0PwNwR/Q/A3BH9D8DcEf0PwNwR/Q/A3BH9D8DcEf0PwNwR/Q/A3BH9D8DcEf0PwMwQ/Q/AzBD9D8DMEP0PwMwQ/Q/Az" +
+	"BD9D8DMEP0PwMwQ/Q/AzBD9D8DMEP0PwMwQ/Q/AzBD9D8DMEP0PwMwQ/Q/AzBD9D8DMEP0PwMwQ/Q/AzBD9D8DMEP0PwMwQ/" +
+	"Q/AzBD9D8DMEP0PwMwQfQ/AxBB9D8DEEH0PwMQQfQ/AxBB9D8DEEH0PwMQQfQ/AxBB9D8DGk3+mzgW/gu48h+BiCjyH4GIKP" +
+	"IfgYgo8h+BiCjyH4EIIPIfgQgg8h+BCCDyH4EIIPIfgQgg8h+BCCDyH4EIIPIfgQgg8h+BCCDyH4EIIPIfgQgg8hA3o8oMcD" +
+	"ejygxwN6PKDHA3o8oMcDejygxwN6jL8h+BuCvyH4G4K/Ifgbgr8h+BuCvyH4G4K/Ifgbgr8h+BuCryH4GoKvIfgagq8h+BqC" +
+	"ryH4GoKvIfgagq8h+BqCryH4GoKvIfgagq8h+BmCnyH4GYKfIfgZMqDLA7qMvyH4G4K/Ifgbgr8h+BuCvyEDuozPIfgcgs8h" +
+	"+ByCzyH4HILPIfgcgs8h+ByCPyH4E4I/IfgTgj8h+BOCPyH4E4I/IfgTgj8h+BOCPyH4E4I/IfgTgj8h+BOCDyEDejmglwN6" +
+	"OaCXA3o5oJcDejmglwN6OaCXA3o5oJcDOjegcwM6N6BzAzo3oHND2f2O64LODejcgM4N6NyAzg3o3IDODegcPoLgGwjr0MI6" +
+	"tLAuK6zLCuuyEhkXRcZFkXER67USuY+R+8g6rLAOK6zDCuuwwjqssA4rrMMK67DCOqywDiuswwrrsMI6rLAOK6zDCuuwwjqs" +
+	"sA4rrMMK67DCOqywDiuswwrrsMI6rLAOK6zDCuuwwjqsVPi8wucVPq/weYXPK3xe4fMKn1f3paUyL6vMyyrzsjrf4VTKRun9" +
+	"rOhBRQ8qelDRg4oeVHSgogMVHajoQEUHKjpQ0YGKDlR0oKIDFR2o6EBFByo6UOH9Cu9XeL/C+xXer/B+hfcrvF/h/QrfV/i+" +
+	"wvcVvq/wfYXvK3xf4fsK31f4vsL3Fb6v8H2F72u3w6Wf8H6F9yu8X+H9Cu9XeL/yPlXepwrvV3i/wvsV3q/wfoX3K7xf4f0K" +
+	"71fmYxX+r/B/hf8r/F/h/wr/V/i/wv8V/q/wf4X3K7xf4f0K71d4v8L7Fd6v8H6F9yu8X+H9Cu9XeL/C+xV+r/B7hc8rPF7h" +
+	"g8p7VnfvGfOGyryhMm+o8GmFTyt8WuHTCp9W+LTCpxU+rfBphU8rfFrh0wqfVvi0wqcVPq3MGyrzhsq8oTJvqMwbKvOGyryh" +
+	"Mm+ozBsq84bKvKEyb6jMGyrzhsr6XGV9rjJPqMwTKvOEyjyhMk+ozBMafNXgqwZfNfiqwVcNvmrwVYOvGnzV5rvzFcqRcklZ" +
+	"KRult7fBVw2+avBVg68afNUYvzZ4q8FbDd5q8FaDtxq81eCtBm81eKvBWw3eavBWg7cavNUYvzbGrw0ea/BYg8caPNbgsQaP" +
+	"NXiswWMNHmuMXxt81uCzBp81+KzBZw0+a/BZg88afNbgswafNfiswWcNPmvwGb6GNPiswWcNPmvwWYPPGnzW4LMGnzX4rMFn" +
+	"DT5r8FmDzxp81uCzBp81+KzBZw0+a/BZg88afNbgswafNfiswWcNPmvwWWM82+C1Bq81eK3Baw1ea/Bag9cavNbgtQavNXit" +
+	"wWsNXmvwWmOdqbHO1FhnaoynGuOpxniqMZ5qjKca46nGeKoxnmqMpxq82eDNxri4MS5u8GhjXNzg08a4uCV76sPbHWVPverF" +
+	"hz3H4dVtcenDu20Rl17dffLfMS7D9xJ8L2mMy/C/pDEua4zL8MOkMS5rjMsa47LGuKwxLmuMy/DPBP9M8M8E/0wa4zJ8NMFH" +
+	"E3w0wUcTfDTBRxN8NGnoBX6a4KcJfpo09AJfTRp6gb8mDb1o6AV+m+C3SUMv8N0E303w3QTfTfDdBN9N8N0E303w3QTfTfDd" +
+	"BN9N8N2koRf4b4L/Jvhv0tCLhl7gxwl+nODHCX6c4MdJQ0/w5aShJ/hz0nZ6wroT/pzgzwn+nDTWnRrrTvh1gl8n+HWCXyf4" +
+	"dYJfJ/h1gl8n+HWCXyeNdafGuhP+nez8u8Z8p7Hu1Fh3asx/GutOjXWnxrpTY92pse7UWHdqrDs11p0a604Nf6ix/tRYf2qs" +
+	"PzXWnxrrT83kqe/wF/GT24JvQWf1qbH61Fh9aqw+NVafGqtPjdUnQ90NdTfU3VB3Q90NdTfU3VB3Q90NdTfU3ea78y8pK2Wj" +
+	"9N4Z6m6ou6Huhrob6m6ou6Hmhpobam6ouaHmhpob6m2ot6Hehnob6m2ot6Hehnob6m2ot6Hehnob6m26w6N/qLeh3oZ6G+pt" +
+	"qLeh3oZ6G+ptqLeh3oZ6G+ptqLeh3oZ6G+ptqLeh3oZ6G+ptqLeh3oZ6G2ptqLWh1oZaG2ptqLWhzoY6G+psqLOhzoY6G+ps" +
+	"qLOhyoYa27A7L+1HfQ31NdTXUF9DfQ31NdTXUF9DfQ31NdTXUF9DfQ31NdTXUF9DfQ31NdTXUF9DfQ31NdTXUF9DfQ31NdTX" +
+	"UF9DfQ31NVZBjFUQYxXEWAUxVkGMWY+htobaGmprqK2htobaGmpreYdHf1FbQ20NtTXU1lBbQ20NtTXU1lBbQ20NtTXU1lBb" +
+	"Q20NtTXU1lBbQ20NtTXU1lBbQ20NtTXU1lBbQ20NtTXU1lBbQ20NtTXU1lBbQ20NtTXU1lBbQ20NlTVU1lBZQ2UNlTVU1lBZ" +
+	"Q2UNlTVU1lBZQ2UNlTVU1lBZQ10NdTXU1VBXQ10NdTXU1VBXQ10NdTXU1VBXQ10NdTXU1FBTQ00NNTXU1FBTQ0UNFTVU1FBR" +
+	"Q0UNFTVU1FBRQ0UNFTVU1FBRQ0UNFTVU1FBRQ0UNFTVU1HBtDNfG0E1DLw29NPTS0EtDL22nl7g1hltj7tbo3HVU566jOncd" +
+	"1bnrqM5dR3XuOqpz11Gdu47q3HVU566jOncd1bnrqM5dR3XuOqrz+Q7XvBTwRS6/fHDv/GS1v3d6n6+AlHjt/Gh/dXJ69/hk" +
+	"tX/n8NrHz4/PVpsouJPT1T51OJ1214+PNpGFq6PD1d7+6uT62avHk0Nq0Xil8UrjlcYrjVcarzReabyC1slTDw6OtoF4q7vH" +
+	"R7Sl45J1tL/jknWgdqB246XVJ+4e7j3Yv3PIN+B04HTg9FyknpvUc5N6blIPUg9SD1IPUk//evrX07+e/vXg9uD24A7gDuAO" +
+	"4A7gDvR0AH8AfwB/AH8AfwB/AH8AfwB/AH8AP4IfwY/gR/Aj+JHzRs4bOW/kvJHzRs6bOG/ivInzJPqR6EeiH4l+JPqRwEvg" +
+	"JfASeAm8BF4GL4OX6UemHxn8DH4GP4Ofwc/gZ/Az+Bn8DH4GfwHuAtwFuAtwF+AuwF2AuwB3Ae4C3AW4C3AX4Jb5tbvHh8dH" +
+	"D46PVmd7J5/kW33y8OBkjwMgSnzq4ep0dbb7GoQCQilP7h8f3XuynZ8c8w19KvSpcE1H+jbSt5G+jfRtpG8jwCNII0gjfRk5" +
+	"f+V8lfNVrkXlWlT6XOmzC4IKBCrz3bFSdpQ95UAZKRNlplxQjpRLykrZKL3/IvNnPvDyyd7Hzs5P+AJgAVgAhkZFAJZ05dXV" +
+	"werk9Oxk7/SU7wD3WYmKjM9861tPXq8+PFmd3j05eHh24Bl+KpCuKJdAuQRKC5QWKC1QWgD9CvQr0K9AvwL9CvQrWi+tTs8O" +
+	"HuydQfcCAUsHcgdyxzXoaEHXz/YOVw/vcwQ+RCwQsXTgd+B34HfgQ8sCLQu0LNCyQMsCLQu0LD39h54FehboWaBngZ4FWhZo" +
+	"WKBfgX5l0Gc2L9j9g5P9S2evHm8/cANhYIGBBQYWGFhgYBnKpeOj1erg3v2z+1fP7p+s+Hx65eWDV3afr56uXlkdccDv6DKM" +
+	"LDCywMgCIwuMLJH2RNoTaU+kPZH2wNwCcwvMLTC3wNwCcwvMLTC3JPAT+DC5wOQCkwtMLjC5wOQCkwtMLjC5wOQCkwtMTsir" +
+	"EvKqhLwqIa9KyKsS8qqSF5f2Tk6OXz1cvXz29PbT+cPL2/Jkc3n9H/ePXz3yT3eOz+5foto+bxmkTF6rkteq5LUqea1KXquS" +
+	"16rktSp5rSoLulDoQqELhS4UulDoAoxNZqtKSVcvWnbndMWXNKLQiEIjCo2AvQX2FthbYG/CW5XwViW8VWXsr9/dOzk52Lu3" +
+	"OlmdnZ9wLUbaMnItIHUyXJUMVyXDVclwVTJclQxXJcNVyXBVMlyVDFclw1XJcFUyXJUMVyXDVclwVTJclQxXJcNVyXBVMlyV" +
+	"DFeVpfm13L9zuHkyLu8Ozh9e233cPh8XtTaPyMXB5inx81TahViR7apkuyrZrkq2q5LtqmS7KtmuSrarku2qZLuq7ESucV0a" +
+	"16VxXRr4DfwGfgO/gd/Ab+A38Bv4DfwGvoFv4Bv4Br6Bb+Ab+Aa+gW/gG/gGvoFv4DMbErNL50cHm4nFng/JdS5XVp84OD1b" +
+	"HZ0dXHzXP7N68PDsk6ers2funWxyl47Onl4drh5swqE9Q2vzkcrlmdPzu/fP7u/tvlhSVkrHJsNVyWxVMluVzFZViVf3Ts9W" +
+	"JwenH3uwxwOgvqyoioAT06oqy6sPT44fHp9s1Hrv8PLxydn9TQz23uFs7+jeob+9hLQqIa1KSKsS0qqEtKpqvHx4fO/g7t4m" +
+	"//wSH49Prh4cnW3mYHc3ILPzo93AgJBWJaRVCWlVQlqVkFYlpFUJaVXtuktn91cnq5ePT2ghcyhFuhXpJqVVSWlVUlp1k9J6" +
+	"evDg4HDvhGNagHST0qqktCoprUpKq5LSqtr3l+4eH907Ob+4j4g3Oa1KPquSz6rksyr5rEo+q5LPquSzKvmsSj6rks+q5LMq" +
+	"+axKPquSz6rksyr5rEo+q5LPquSzKvmsSj6rks+qOtiV1cfPD17ZO1wd3eXqot5ksypZrEoWq5LFqmSxKlmsSharksWqZLEq" +
+	"WaxKFquSxapksSpZrEoWq5LFqmSxKlmsSharksWqZLEqWaxKFquSxapksSpZrEoWq5LFqppl+zasTk7P75yuzq7vDjb/tzq7" +
+	"dHR85v9A7eHqyWqTAE/t3YHXpg4tY0ZGSquS0qqktCoprUpKq5LSqqS0KimtSkqrktKquugvb5LoD1cPD89P+Spe968enB+e" +
+	"HTw8/CRf0xKGA+S1KnmtSl6rkteq5LUqea1KXquS16rktSp5raqlv/ZwdfJwtfnDB+cX7xWDAlJbldRWJbVVSW1VUluV1FYl" +
+	"tVVJbVVSW5XUViW1VUltVVJbldRWJbVVSW1VUluV1FYltVVJbVVSW5XUViW1VUltVVJbldRWJbVVSW1VUlt1k9q6f3z2Ju8y" +
+	"EFAGAqS4KimuSoqrkuKqpLgqKa5KiquS4qqkuCoprkqKq5LiqqS4KimuSoqrkuKqiuArgq8IviL4iuArgk/qq5L6qqS+Kqmv" +
+	"SuqrkvqqpL4qqa9K6quS+qqkviqpr6oIviL4pMAqKbBKCqwqgq8IviL4iuCTEquK4CuCrwi+IviK4CuCrwi+IviK4CuCrwi+" +
+	"+n+srh2z+G4us/vH54xuO+bwpM4qqbNK6qySOqsdc/iOOTx5s0rerJI3q+TNKnmz2s3t2snqFfT06Ni5hdRZJUVWSZFVUmOV" +
+	"1FglNVY7scsbIb53snd49vDi4x3OhpYTE6vExCoxsUpMrBILq8TCKvGjSvyoEj+qxI0q8aLasUbVsZbUwTsdvNPBOx2808E7" +
+	"HbzTwTsdvNPBOx3TkI5pSAfjdDBOB+N0ME4H43QwTgfjdDBOB+N0y11JO3jCiZNU4iOV7XfK9jtl25aybUvZtqVs21K2bSnb" +
+	"tpRtW8q2LWXblvZx8cxLJvP5buV9mMvmC5l80VH2lANlpEyUmXJBWSjHZ16y+fSElbJR2qZC92YFnji2WOgg3aaCTirQBNaQ" +
+	"BkmbCv2kAm1gAWmQbRvypAJt4KFll4Wyy0LZZaHsstBBt01YvHkCnl+2WSjbLJRtFso2C2WbhQ66bUGcnIAWMBhln4Wyz0LZ" +
+	"Z6Hss9Ch27YgvXkCxqJstFA2WigbLZSNFspGCx26bQuGyQloAYNRkqSUHRfKjgtlx4Wy40LZcaHsuFB2XCg7LpQdF8qOC2XH" +
+	"hbLjQtlxoey4UHZc6NBvnoN++xw885Jpz4dBdh+UD91iV2f3je7q6LCrPOdDv6vc5V2diw9p9yHu6lx82P1Tv4OQC9DdmXXX" +
+	"1P6i8u7M/a49/a49/e7M/e7nw0UHL84zeYIZwxI/pcRPKfFTSvyUEj+lxE8p2zuU7R3K9g5le4eyvUPZ3qFs71C2dyjbO5Tt" +
+	"Hcr2Dt1u73h45/D47sf8mBUntnfokLun94+m/w4+3sGQ02z6r6AzTt1s/jh8efrvoDNOHbI9fXLm/354dnp/b3812/7/0/sf" +
+	"83JbC85n64ey9UMHlqoGxqYDY9OBsemARgxoxIBGDGjEsLBLLx8cHq727xx/4qn3q853FxuRYA+JsodE2UOi7CFR9pAoe0h0" +
+	"KIun3i956LttMchlP/3J6u4ZFWgBKsEmEiWsS4dRnj7brEadP+SYBjAuJaxLCetSwrqUsC7dhHVtf38C3jj6MYt8hHUpYV1K" +
+	"WJcS1qWEdSlhXTosO//94cscg8+4lPAuHRiXDsvFUz554JALz3B0WLbthWHZnMgvJfJLifxSIr+UyC8l8kuJ/NKhpksHR6/c" +
+	"OT88XJ1tPk0hGZES+6XEfimxX8p2HSX2S4n9UrbvKLFfSuyXEvulQxsuHz9cHTkoX9F5pJzkLyX5SwcGpQOD0oFB6cCgdGBQ" +
+	"SkKYkhCmJIQpCWFKQpgODEoHBqUDg9KBQenAoHRgUDowKB0YlA4MSgcGpQOD0gg1RayliLUUGSBEBgiRAQJJZUpSmUYGCCSW" +
+	"KYllGhmWklymkfFBZHwQsZgiK1KR4UFkeBBZoYqsUJF8ppHRQRTwGcBGBgeRwQEJaRoZ0EbGBpGxAclpGhkbkKCmJKgpCWpK" +
+	"gpqSoKYkqCkJakqCmpKgpiSoKQlqSoKakqCmJKgpCWpKgpqSoKYkqCkJakqCmpKgpiSoKQlqSoKaxm5x6fTBweHq5b27q6sH" +
+	"R69cHITT8yOq0ASGBrGzp15ePdjj5Ym9PDk5AJ5hAUFrygY3ZYObErSmBK0pQWtK0JoStKYErSlBa0rQmhK0pgStKUFrStCa" +
+	"ErSmBK1pZKWKwDWNrFRFVqoIYNPISlVkpSqyUhVZqYqDzU4f7jSGmDaNUZ+8e3h+h4N+dn+1d3L29P7B3oPdf3hBQpuS0KYx" +
+	"Lq48OD9lbrW6Pvm8+y8uCGtTwtqUTYHKpkBlU6CyKVDZFKhsClQ2BSqbApVNgRpR/4j6E/KmhLwpIW9KyJsS8qaEvCkhb8rm" +
+	"QmVzobK5UNlcqGwuVDYXKpsLlc2FyuZCZXOhsrlQ2VyobC5UNhcqmwuVzYXK5kJlc6GyuVDZXKhsLlQ2FyqbC5XNhcrmQmVz" +
+	"obK5UNlcqGwuVMLdlHA3JdxNCXdTwt2UcDcl3E0Jd1PC3ZRwNyXcTQl3U8LdlHA3JdxNCXdTwt2UcDcl3E0Jd1PC3ZRwNyXc" +
+	"TQl3U8LdlHA3JdxNI/od0e+IfkfWlSKqlqD4NN8dd5Q9ZaRMlJlyQbmkrJSN0vuToPIElSeoPEHlCSpPUHmCyhNUnqDyBJUn" +
+	"qDxB5QkqT1B5gsoTVJ6g8gSVJ6g8QeUJKk9QeYLKE1SeoPAEhScoPEHhCQpPUHiCwhMUnqDwBIUnKDxB4QkKT1B4gsITFJ6Y" +
+	"2yXmdon/RCDxnwgk+DvB34mpXWJql5jaJaZ2CQ5PcHiCwxMcnuDwBIcnODzB4QkOT3B3grMTnJ3g7ARnJ7g5wckJTk5wcoKT" +
+	"E5yc4OQEJydYOLHqkVj1SKx6JFY9EqseCSpOUHHCQUg4CAkHIcG/Cf5NcYdHf+DfBP8m+DfBvwn+TfBvgn8T/Jvg3wT/Jvg3" +
+	"wb8J/k3wb4J/E/yb4N8E/yb4N8G/Cf5N8G+CfxP8m+DfBP8m+DfBvwn+TfBvgn8T/Jvg3wT/Jvg3wb8J/k3wbIJnEzyb4NkE" +
+	"zyZmWgm+TfBtgm8TfJvg2wTfJvg2wbcJvk3wbYJvE3yb4NsE3yb4NsG3Cb5N8G2CZxM8m+DZBM8meDbBswmeTfBsYt0/se6f" +
+	"WPdPrPsn5leJ+RUhlEoIpRLeqIQyKqGMmphCJKYQiSkEYY1KGKMSxqiEMSphjEoYoxLGqIkpQmKKQDijEs6ohDNqst35aT9T" +
+	"hMQUITFFyEwRMvqR0Y+MfmT0IzNFyOhIRkcyOpLRkcwUITNFyOhKRlcyupLRlYyuZHQloysZXcnoSkZXMrqS0ZWMrmR0JaMr" +
+	"GV3J6EpGVzK6ktGVjK5kdCWjKxldyehKRlcyupLRlcwUIaMvGX3J6EtGXzL6ktGXjL5k9CWjLxl9yehLRl8y+pLRl4y+ZPQl" +
+	"oy8ZfcnoS0ZfMvqS0ZeMvmT0JaMvGX3J6EtGXzL6ktGXjL5k9CWjLxl9yehLZo6Q0ZnMHCGjNxm9yehNRm8yc4TMHCEzR8jo" +
+	"UGaOkNGjjB5l9CijRxk9yuhRRo8y/z1aZlU+o08ZfcroU0afMvqU0aeMPmX0KaNPGX3K6FNGnzL6lNGnjD5l9CmjTxl9yuhT" +
+	"Rp8y+pTRp4w+ZfQpo08ZfcroU0afMvqU0aeMPmX0KaNPGX3K6FNGnzL6lNGnjD5l9CmjTxl9yuhTRp8y+pTRp4w+ZfQpo08Z" +
+	"fcroU0afMvODzPwgMz/I6FZGtzK6ldGtjG5ldCujWxndyuhWRrcyupXRrYxuZXQro1sZ3croVka3MrqV0a2MbmV0K6NbGd3K" +
+	"zBMy+pXRr4x+ZfQro18Z/croV0a/MvqV0a+MfmX0K6NfGf3KrA9m5heZ+UVmfpGZX2T+A7aM3mX0LrM+mFkfzPjWGd8641tn" +
+	"FgozC4UZ9yzjnmVWCjMrhZmVwsxKYWalMLNSmFkpzPjWGd8641tnVgkzq4SZVcLMKmFmlTCzSpjR7cwqYWY+lVklzKwSZty9" +
+	"jL5n9D2j7xl9z+h7Rt8zS4SZJcLMEmFmiTCj/xn9z+h/Rv8z+p/R/4z+Z/Q/o/8Z/c/of0b/M/qf0f+M/mf0f4GeL9DzBfq9" +
+	"QL8X6MMCfVjACwt4YcH9XHA/F9zPBfdzwf1ccD8X3M8F93PBdVrQ7gXtLoxLCuOSwrikMJ4ojCcK44nCeKIwniiMJwrjicJ4" +
+	"ojCeKIwnCuOJwniiMJ4ojCcK44nCeKIwnijod4HvC3xf4NkCzxZ4tsCzBZ4t8GzhehauZ4FnCzxb4NkCzxZ4tsCzBZ4t8GyB" +
+	"Zws8W+DZAs8WeLbAswWeLfBsgWcLPFvg2QLPFni2wLMFni3wbIFnCzxb4NkCzxb4r8B/Bf4r8F+B/wr8V+C/Av8V+K/AfwX+" +
+	"K/Bfgf8K70PhuRp5nkaep5HnaWScOzLOHRnnjrwXI+/FyDh3ZJw7znfnHSmXlJWyUXo/R57Lkedy5LkceS5HnsuR53LkuRx5" +
+	"Lkeey5HncuS5HBnHjoxjR8axI+PYkXHsyDh2ZFw1Mq5aMv5ZMv5ZMv5ZMv5Zxl29gTJSJspMuaAslCPlkrJSNkpv95Lxz5Lx" +
+	"z5Lxz5Lxz5Lxz5Lxz5Lxz5Lxz5Jxz5Jxz5Jxz5Jxz5L3ccn7uOR9JIxMCSNTwsiUMDIljEwJI1PCyJQwMiWMTAkjU8LIlDAy" +
+	"JYxMCSNTwsiUMDIljEwJI1PCyJQwMiWMTCvPA6FkSiiZEkqmhJIpoWRKKJkSSqaEkimhZEoomRJKpoSSKaFkSiiZVp6XyryH" +
+	"cDElXEwJF1PCxZRwMSVcTAkXU8LFlHAxJVxMCRdTwsWUcDElXEwJF1PCxZRwMSVcTAkXU8LFlHAxJVxMCRdTwsWUcDElXEwJ" +
+	"F1PCxZRwMSVcTAkXU8LFlHAxJVxMCRdTwsWUcDElXEwJF1PCxZRwMSVcTAkXU8LFtDJPIWRMCRlTQsaUkDElZEwJGVNCxpSQ" +
+	"MSVkTCvvFyFQSqiTEuKkhDgpIU5KiJMSwtRXHxf21ceFffVxYV99XNgTptUTptUTptUTptUTptUTptVXHxf21ceFffVxYV99" +
+	"XNjX5Q7XvKzgV/Ar+BX8Cn4Fv4Jfwa/gV/Ar+BX8Cn4Fv4JfwW/gN/Ab+A38Bn4Dv4HfwG/gN/Ab+A38Bn4Dv4HfwDfwDXwD" +
+	"38A38A18A9/AN/ANfAPfwDfwDXwD38eFhdDjQuhxIfS4EHpcCD0uhB4XQo8LoceF0ONC6HEh9LgQelwIPS6EHhdCjwuhx4XQ" +
+	"40LocSH0uBB6XAg9LoQeF0KPC6HHhdDjQuhxIfS48EccC+HHhfDjQvhxIfy4EH5cCD8uhB8Xwo8L4ceF8ONC+HEh/LgQflwI" +
+	"Py6EHxfCjwvhx4Xw40L4cSH8uODNFrzZgjdb8GYL3mvBey14rQWvteCtFrzVgrda8FYLf7Sq8EerCn+0qvBHqwp/tKpgiRYs" +
+	"0cIfrSr80arCH60q+KAFH7Tggxb8woJfWPALC35hwf8r+H8Fv6/g9xV8qoJPVZLrbEmuswXfquBbFXyrgm9V8K0KvlXBtyr4" +
+	"VgXfquBbFXyrgi9V8KUKvlTBlyr4UgUfquBDFXyogg9V8KEKPlRJSn+U/ij9UfA62tvR3o7zd5y/4/wd5+84f8f5O87fcf6O" +
+	"83e789Ofjv509KejPz34Pfg8d/hJBT+p4CcV/KSCn1RSz/l6zsdziZ9U8JNKGugPz2XiucQPKvhBBT+o4AcV/JSCn1LwUwp+" +
+	"SsEnKfgkBZ+k4JMUfJKCT1LwRwr+SMHHKPgYBR+j4GMUfIyCj1HwMQo+RsHHKPgYBR+jsE5fWKcvrNMX1ukL6/SFdfrCOn1J" +
+	"vqFsrm24PfApXnxKF5/yxafFxafhdn/xi/7iF/3FL/qLX/QXv+guftFd/KK7+EV38Yvu4hd68Qu9+IVe/EIvfqEXv5CLX8jF" +
+	"L+TiF3LxC3ny9Gz14OnzowPjP7I0UkaMlBEjZcT4m/fG33ox/taL9Rf/3lMOlJEyUWbKBWWhHCmXlJWyUZqXAr6AL+AL+AK+" +
+	"gC/gC/gCvoAv4Av4Ar6AL+AL+Aq+gq/gK/gKvoKv4Cu4Cq6Cq+AquAqugqvgduB24HbgduB24HbgduB29LsDvwO/A78DvwO/" +
+	"A78DvwO/B9/pyqIPR43pvjG9N6b3xvTemN4b029j+m1Mv43ptzH9tpHrPnLdmXYb025j2m1Mu41ptzHttpHrPnLdR+W8XP+R" +
+	"6z9y/Ueu/8j1H7n+I9d/5PqPXP+R6z9y/Ueu/8j1H7n+I9d/5LqPXPeR6z5y3Ueu+8j1HrnOI9d57Dl/z/l7ztvTr57z95y/" +
+	"5/w95+85f0+/+h0O/RroxwDOAM5APwbwBvAG8AbwBvAG8AbwBvAG8AbwIv2L9C+CH8GP4EfwI/gR/Ah+BD+CH8GP4EfwI/gR" +
+	"/AR+Aj+Bn8BP4CfwE/gJ/AR+Aj+Bn8BP4CfwE/gJ/Ax+Bj+Dn8HP4GfwM/gZ/Ax+Bj+Dn8HP4GfwM/gZ/AX4C/AX4C/AX4C/" +
+	"AH8B/gL8BfgL8BfgL8BfgL8AfwH+AvwCfgG/gF/AL5yvcL7C+Qrnq9SrtLPSzko7K+2stLPSzsp5K+etnLfSvkr7GudtnLdx" +
+	"3sZ5G+dtnNc4j9E+43w+vTCyrY1sayPb2si2NrKtjWxrI9vayLY2sq2NbGsj29rItjayrY1sayPb2si2NrKljWxpY1nCWJYw" +
+	"sn+N7F8j+9fI/jWyf43MXyPz18j8NTJ/jcxfI/PXyPw1Mn+NzF9rvAeN94C/aWn8TUtrvAf8bUtrvAdk/hqZv0bmr5H5a2T+" +
+	"Gpm/RuavkflrZP4amb9G5q+R+Wtk/hqZv0bmr5H5a2T+Gpm/RuavkflrZP4amb9G5q+R+Wtk/hqZv0bmr5H5a2T+Gpm/Ruav" +
+	"kflrZP4amb9G5q+R+Wtk/hqZv0bmr5H5a2T+Gpm/RuavkflrZP4amb9G5q+R+Wtk/hqZv0bmr5H5a2T+Gpm/RuavkflrZP4a" +
+	"mb9G5q+R+Wtk/hqZv0bmr5H5a2T+Gpm/RuavkflrZP4amb9G5q+R+Wtk/hqZv0bmr5H5a2T+Gpm/RuavkflrZP4amb9G5q+R" +
+	"+Wtk/hqZv0bmr5H5a2T+Gpm/RuavkflrZP4amb9G5q+R+Wtk/hqZv0bmr5H5a2T+Gpm/RuavkflrZP4amb9G5q81A9/AN/AN" +
+	"fAPfwDfwDXwD38A38OHFBg+SdmikHRpph0baoZnVp85ls9PRC/FCvei86L0YvIheJC+yFwsvihejF0svQGhe2LYQxxPHE8cT" +
+	"xxPHE8cTxxPHE8cTxxPHE8cTxxPHE8dTB1IHUgfSDVD1TlfvdPVOV+909U5X73T1TlfvdPVOV+909U5X73T1TlfvdPVOV+90" +
+	"9U5X73T1TlfvdPVOV+909U5X73T1TlfvdPVOV+909U5X73T1TtdOHE8dTx1PHY++q+Op46njqeOp46njqeOp46njqeOp46nj" +
+	"dY7XOV7neJ3jdY7XOV7neJ3jdY7XOV7neJ3jdY7XOV7neJ3j9Y7XO17veL3j9Y7XO17veL3j9Y7XO17veL3j9Y7XO17veL3j" +
+	"DY43ON7geIPjDY43ON6wxRu8Y4N3bPAeDd6jwXs0eI8G78rgXRm8K4N3ZfCuDN6HwZs7eHMHb+7gzR16TrZt7uDNHbydg7dz" +
+	"8HYO3s6Bdg7JC2/u4M0dHGhwoMGBBgcaHGhwoOhA0RsfHS86XnS86HjR8aLjRceLjhcdLzpe8XMWP2fxcxY/Z/FzFj9n8XMW" +
+	"P2fxcxY/Z/FzFs7pfSjeh+J9KN6H0fFGxxsdb3S80fFGxxsdb3S80fFGxxsdb3S80fFGxxsdb3S8peMtHW/peEvHWzre0vGW" +
+	"jrd0vKXjLR1v6XhLx1s63tLxlo63dLzqeNXxquPVLV6q2YuFF8WL0YulF9WL5sX2nKnNvRAv1As/Z+u9GLyIXiQvHK85XnO8" +
+	"tsFrbcvJrW05ubUtJ7e25eTWtmTc2paMW9uScWtbMm5tS8atbcm4tTknW3pRvWhe2LYQBxIHEgcSB9qScWvieOJ44njieOJ4" +
+	"4njieOJ44njieOJ46kDqQOoI6udUP5n6ydRPpn4y9ZOpn0z9ZJ03vvNzdn7Ozs/Zeas7b3XnCJ0jdH7q6L+L/rvov4teM3r/" +
+	"ov8gepOityV6W6K3JXpbkrcl+TmTnzP5OZO3JXlbkiMkP3XyUydvUnKEtDm1zbf9s/m2fzbf9s/mWyWx+babNt920+bbbtp8" +
+	"202bbwnX5tve2nyrJDbfdtrmW961+ZZ3bd4BZNuid7ze8XrH6x2vd7ze8XrH6x2vd7ze8XrH6x2vd7ze8XrH6x1vcLzB8QbH" +
+	"GxxvcLzB8QbHGxxvcLzB8QbHGxxvcLzB8QbHGxwvOl50vOh40fGi40XHi44XHS86XnS86HjR8aLjRceLjhcdLzlecrzkeMnx" +
+	"kuMlx0uOlxwvOV5yvOR4yfGS4yXH40FJjpcdLztedrzseNnxsuNlx8uOlx0vO152vOx42fGy42XHy463cLyF4y0cb+F4xf+t" +
+	"+L8V/7fCv3lbireleFuKt6V4W4q3pXhbireleFuKt6V4W0ZHGB1hdITREUZHGB1hdITREUZHGB1hdITREUZHGB1h6QhLR1g6" +
+	"wtIRlo6wdISlIywdYekIS0dYOsLSEZaOsATBr2d1oOpA1YGqA1UHqg5UHag6UHWg6kDVgaoDVQeqDrSVLPOxt/nY23zsbT72" +
+	"Nh97m4+9zcfe5mPvjT3gxfZkvfNS7xQUt5JlcStZFreSZXErWRa30wiLc2pGL5IX2YuFF8WL0YulF9WL5sW2D1Ecz7sSvSvR" +
+	"uxK9K9G7Er0r0bsSvStRHE8cTxxPHE8cTxxPHE8dTx1PHU8dTx1IHUgdSB1IHUgdSB3IL2T0CxnVEZzuo1/W6HQfne6j033k" +
+	"WjvdR6f76HQfne6j0310uo9O99HpPjrdR6f76HQf++7S/uFfOru7d3J89EzlT13NyuaPYM0+dHC4v5q9b/PneC4vD07unj/Y" +
+	"RNzNlpu6V8+PDjZz19t3905Xs3GTs3+pHp/t3b27Ojq7+v7zo3t7J+cPDvfOz67U4/M7h6vtX/W5vHeyd+fg7kf3j8+u8lH3" +
+	"j89Odwfd5uD69OCje9enFT+6t6vab3+3aUWM7fbLB0d7FwcHRwdnFwcPVvsHflBkUq3IpFqRSbVo07PZ9Gw2qTaWyb+MZfsv" +
+	"V2jbycHRPf+HtJycLC0nP0nLyclSnVar02p1Ui1Pe5CnPcjTHmSdVtNpNZ1WG6bVhmm1YdrR6fUYp9dj9Ouxu0H39l7+6J29" +
+	"k6fb0b3be4dnz27qaMy394/PDlenp368qLvjK5vjbp43iyt87jfHfE6T7zd1ZPJ5Pqkvk8+777vHfrucfL87/3KDMfl+dx59" +
+	"7PN88rnjc3zss0w+zyefF5Pf7j5v140u3znZ22bLHJ/NPri5WH5BF35HXmDVdHtw+/D46N7p2d7J7qJP79o4vWvj9K4tp+/E" +
+	"cvpOLKfvxMIfldnhm42oDnBzc9CnfPvB8dHxvZO9h/f5ZvHmN88ebNJiVx+b3mKVPj92vJgeS9P61uPluDsO+3ePZx/YNMWz" +
+	"jm/f2Ttdba9bHGQe+3Hy2e9p7MfN8eT7Nvls1GmTOv2kzuazbXudluXN67U5uLhEm4OLi5eW47TaOK02Tqstp9WW02rLabU6" +
+	"rVan1eq0WptWa9NqbVrNptVsWm3y1qY6n1Sr80m1Op9Wk2k1mVaTaTWdVtNpNZ1W66bVumm1blqtn1brp9X6abVhWm2YVhum" +
+	"1eK0WpxWi9NqaVotTaulabU8rZan1fK02mJabTGttphWmz5vdfq81enzVqfPW50+b3X6vNXp81anz1udPm91+rzV6fNWp89b" +
+	"nT5vdfq81enzVqfPW50+b3X6vLXp89amz1ubPm9t+ry16fPWps9bmz5vbfq8tenz1qbPW5s+b236vLXp89amz1ubPm9t+ry1" +
+	"6fPWps9bmz5vbfq8tenz1qbPW5s+b82ft0vfenB0b+/O8SurKyQMbSiRzzL5rJPP3eRzP/k8TD7Hyed0wfh9arf3D/buOr/n" +
+	"cvvOZjS34WO+Gd/8ZvbJi5/FvJiOH8r0YJweTMcPi/n0oJse9NODOD1I04Mp6KK89eBNkVuUqchNm7MYp9XGabXpWG0xHast" +
+	"pmO1xXSstpiO1RbTsdpiKsCLqQAv3iLAb7k609HVYjraLNPrVibvzeZgUm068ivTkV+ZjvzK9MKXblqtm1abDjXKdKhRpkON" +
+	"Mr0/JU2rpWm1PK2Wp9XytNr0npbpPS3Te1qm97RM72mZ3tMyvadlek/L9J6W6c0q05tVpjdrnN6FcXoXxuldGKd3YZzehXF6" +
+	"F8bpEz/202r9tNr0XRjjtFqcVpvehXF6F8bpXRind2Gc3oVxehfG6Ws2LqbVFtNq08s7Ti/vOL284/SVGaevzOivzLP8ibPj" +
+	"V4+2E8qbHz/f/C2f46OLb66y0cX/eTrTu73J23724cnx/vnds9v7B6cPD/c+uR15qsx3xzdPzx882NuccffNjV0E8lt/osvH" +
+	"jutjx+2xY3vrcTd/7FgeO9bHjru3Hi8f+/3ysd8vH/v98q2/L/P5Y8fy2LE+drx87Lg+dtweO35rf4s8hieP4cljePJYe6V/" +
+	"7Hh47Dg+dpweO86PHS8eOy6PHY+PHV/0/4tv+9SLX/5/1+HR8+H/uRX+bw3/1xvh/7we/o91+N/X4X+7Ff7X6+F/eSP8z7fC" +
+	"n7xeZn+yDv/TG+F/fCP8D4/Cf/8o/Hfr8N9+XfhvxvBfr8N/peGP/+il2R+/Ef7o9TL7o5fCH/7Be2d/+Cj8wXvDf7kO/8U6" +
+	"/Oca/rPnw3/6RvhP1uE/fi78R6+F//BL4T9Yh99/vcx+/7Xwe7/7vtnvvRZ+933hd377K2a/sw6//RXh31+Hf28d/t11+HfW" +
+	"4d9+I/zWb7579lvr8JvvDv+Whn9zHX7j0zdnv/GV4d94Ifzr6/CvrcO/ug7/yjr8y+vwL63Dv7gO/8I6/PPr8KV1+Oduhl//" +
+	"zK3Zr6/DF7/wpdkX1+ELn//I7AtfCl/41JOf/7Vbs89/5MUvh8+/+OSv3Qr/7Dr8M2+Ef3od/ql1+CfX4Z9Yh398P/xj18Ov" +
+	"/sqt2a/uh1/55edmv3Ir/PJz4ZdeL7NfehT+0XX4R9bhH16Hf+i58A+uwy/+wvXZL2r4hevhH9gPP/96mf38G+HvX4ef+9mr" +
+	"s59bh5+9Gn7mp985+5n98NM/dWP20+8MP3Uj/H2Xw9+7Dj/5xrXZT67DG9fCT7xeZj/xRvjxH7s++/GvDj92Pfw9j8KPfu5L" +
+	"sx9dh8999iOzz30pfO5TT372R27NPvuR8NkXn/yRW+GH1+GHfvD27IfW4Qdvh9c/c2v2egk/8P1XZj/wfPj+K+H7PnNr9n37" +
+	"4TOfvjn7zK3w6Zvh716H7/2em7PvXYfvuRn+rnX41Dr8nevw4pe/+7XXZt+9Dq+9Fv6O/fBdH3rH7Ltuhb99HT65Dp+4Hl69" +
+	"Gl65HM7X4exROH0UTh6Fjz8KD9fheB2O1uHwq8LH1uE7b46z73wpHKzD/dfCvZvj7OV1WK3D/jrcXYc767D3deFvexT+1qvh" +
+	"I+vwt6zD37wO3/Htl2ff8Sh8++Xw4RfeOfuwhr9pHb7tQ++YfdsYPvSO8NITN2Yv/Znwrc+Hb/ng22ffsg5/45XwN6zDN3/T" +
+	"jdk3r8M33Qh/aR2+8YNvn33jOnzwAzdmH3x7+MC7rs0+cCO8/1p43zrYG6G9Eeo6LN/2ntnyURi/FMo3hhfX4a9fh7/4Dc/N" +
+	"/uLz4Ru+/tnZNzwXvv4vXJt9/Ytffjb8hWvh69ZhsQ45PT/Lj0KKN2bp+RCHK7N4IwxXQv/u0F0LKldmug5yJczfe2U2vxbe" +
+	"eyXcfs+l2e0b4T2Xwl+n4Wu/5tbsa/fD1/z552Zfcyv8+efCV/+5W7OvLuHP3Qp/9taV2Z99Nty6Ev7adfhr1uGvfjZ81RM3" +
+	"Zl/1XPir9sNf+Si8+13XZu/eD++6Fr7y5jj7ynX4ikfhrxjDO2+Os3euw5/ZD3/6hXfO/vQ6vPDEjdkL7wzvWIfn1+Ht6/Dc" +
+	"zXH23DrcfNt7ZjfHcOO18Ox+uL4O166+MLu2DlefuDG7+kK4sg6Xb4RL6/DM294ze2Ydnn4+PLUfnnzixuzJdXjxHeFt75mF" +
+	"dXjbEzdmb3tPeOJG+FPr8MQXn9j/9A8/8bV/OfzvT/3/unXv+v8GAHu0YhVIYQoA"
+
+// certificateBoldFont is DejaVuSansCondensed-Bold.ttf, gzipped and base64 encoded
+const certificateBoldFont = "" +
+	"H4sIAAAAAAAC/+z9CXwURdcHjP5P9TKTmclktmQikI2EBMKehATZZAlh30LYAyQBAgQSMARkEwERARUREREREBEQEZAHEQER" +
+	"EREVEYUoIuICIgbEiAhIkp776+rZsuDj4/u83++7976Z9L9rTq1dderUqVNVPSAAdgJENEtLy+g15d0bpQC1AVCnS2rntCcO" +
+	"PfobQIMB1rJL3z79H711T11AWAYsSu7Sf0DHtx9qNBB47BgQfKZP/6YJQwetigReXA9g4Kj87Mni7i5/Ai/eBkJGjJpWFNnu" +
+	"reZ6YK+aXkbu5LH5w9iEUmDfNgDbxmZPmQwddMD+wQBMYyfOyE374fu6wP7R0PVZP25M9ujAdvQO9EYRQPK4cWOyA2+G74He" +
+	"qJY3Zlx+0fTErob90BuzgKwTE8YUFrxIB6ZCP7EZEBM+cdKo7J6fxZ2GfllfIOt0fvb0yeEzRBP0v8UAiCzIzh8z7VxuXeh/" +
+	"SwXaHps8aUrRgdpZbyGgqQn4NXBy4ZjJs7M3/ARDeB1AtxkAAcDxths+BYBThxtYvHcCYAZYfdYMAMMQCGwniL3O3ofAjrKj" +
+	"IPYB+wgC+5gdB7FP2GcQ2OfsFIidZhchsB/ZzyBWwv6AwG6ymyB2i7kgCBAAEkgQIQiSIIEEWZAhCDpBBxL0gh6CECAYQIJR" +
+	"MEEQAkUnSAwV60AQw6RAkGSWzGBSkGQBSVapFgSptlQbJNWRwiFIEVIESIqUIiFIUVI9kBQrxUKQ4qQeIKmn1BOC1EvqA5L6" +
+	"Shlg0gBpCkgqkuZCkOZJy0DSU9JTEKTlsh0kO+Q6EOQw+T6Q3F5uD0HuIHcEyZ3k7hDkHnI/kJwuD4IgD9bfAulv6ysg6BVj" +
+	"OMgYYYwAM0YaG4CM8cZGYMbGxodAxrnGuWDGecanQMblxlUQjM8ZnwMzrja+ADKuNa4DM643vgky7jW+BWbcZ/wcZDxlPAVm" +
+	"PG3fBrK/Zn8LzL7Pvg+Cfb99P5j9gP1HkP2S/RKY/SfHKJBjtGM0BMcYxxiQI9eRC8Ex1jEezJHnyAM5JjimgjmmOUpAjiuO" +
+	"62CO352BIKfZ6YTgDHW2ATnbOttCcLZzTgQ5851FEJxTnSdAzk+dn0JwngxNBEEEwACQ32WgM6w7HKlpPTMwM7swOwcLswvz" +
+	"C7AlpzB7PHaPyi6YgkOjxo0pxPFRMwon4szYMZMKcWNs4ZgJhHHZBePJMm5MTiHVmZBdkE3xE7MnRVLSxOyiAkrNzy4aRwsK" +
+	"JkyKpGWTxmbn09rCqQWFtLUot2As7Skalz2eDkMEAJcLBCAEQo8B6ZEI6d87NRIhA9JV5L4CAAapUui/47aA9eqRGglL//SO" +
+	"kbB40xIg1xjnr/wYAOb9VjmOCB2SoO/Wv1ckknpw7MmxV5+ekUjqzd3pfVTs36O/ipzijk9/M8+/+9R6VRohgWPL/OzCCWjP" +
+	"sTfHjPwJ+ROQyXE0xzwABDMAhkB+lyBBhg56BHA/E/czwFiJgzR+YhAQhEjURzO0RHt0RzqGIgd5KMRMzMcKrIEMAmGB+/4F" +
+	"ZADE1rrvJ/mdCSPkLEggkNECUb2b5mj3qAyNXjdD+x4TyfOmlhe1e6tQfpdb7+uwvmNkascBzQr6auWctEC7LzgNQjPEgtAK" +
+	"JgjQwwwHiI4rJo6nVaw4C4IgTZNmSHP8XIImX+EAUEsNB1RGnMV2EMkwwYF4NEMy2qAjuqI3MjAUWcjFRBRiOuZgAZZgGVZi" +
+	"jRpX10pFeaPPLZ3zuXX6qnT5Cy/lY7nQ56svVzHgiM+tT/KjcLfuJKdkgeCQekpF0lTpAWm6NFOaJc2WHpQekuZKTznznQXO" +
+	"Sc7Jzvudhc4pzqL/KKwDQDqAwQBGABgNcM6aDGAagNkA5gNYBGApgBUAVgNYD2ATgG0AdgHYC+AgAF52ceffxPfFnf9R+L9E" +
+	"1EEICDEwgSCCKqZUbAGB5O4gMLmL3BUMgAGABVSxHfAgeqMdCINhgsw5rBYiEYtGoIondLVAFeN1q0EVk3S7QBVTdYs5rvH4" +
+	"Qpbby53kVLmznCZ3A3OPV4DMOQ+IBBALoBGABAAt1bKJH3PM4rhPTVGczd1zOKr8MI6agGgDTIhBd/TFQGQiB+NQgCLMxFws" +
+	"xONYjlVYi43Yip3YgwM4jGM4gdM4i+9wCVdxHbehkEgGslAI1QEpSRXnfag4QEqi4vC6a8JNHHtVieVxF/mFrFU1jH/ISvk+" +
+	"Wo1+1ptCkVKv5lg1pCO7c2EI4NoSuJ4kstPsDALYWXYRZlbCfsE97Da7gzCmMBciBUEQUJdrRdE1xPzSHTOQxwzlMevwmBE8" +
+	"ZhSPWRfRf7tPAB8BOAngCwDnAPwA4DKAawBuALgDEACSATIBZAMoFKBwgGIAigeoGUDJmu5NHQHqClBvgDIAGqrWA/Af4rF/" +
+	"FOufYNE/icWSSdWCF8GEFEzGNMzGfCzCUqzAaqzHJmzDLuzFQRzBRziJL3AOP+AyruEG7hBIJhPZKJTCKYbiqRklUxvqSF2p" +
+	"N2XQUMqiXJpIhTSd5tACWkLLaCWtoQ20hbbTbrUE8jgvJsi5HB3VsF01ynEVdXX8Ymm4xQ85RWfxppAkP+VNIUluq6LupNfX" +
+	"g8e8mMTTT9LZPW5Al1cZayyto0qpkmRHjegf3k2R7vwTlEPBYGOvs8MAu8auwcZKWSns7Hf2OxzsD/YHgtktdgshrIxVwCmQ" +
+	"wFBLEAURdfhMJYzPUcK51h/JdflYp9lpRmOnxWlBE6fN6URTrke34FpzMhgCq+T3O+w8JyfPKZTndA/PqQ7PSUs9gqdej+vn" +
+	"jXiKyTzFFLT8X+mvoCyAcgGaCFAhQNMBmgPQAoCWALQMoJUArQFoA0BbANoO0G6A9gF0CKCjAB0H6HOAzgB0HqCLAJUAVArQ" +
+	"TYDKAcYApgeYGWAOgNUCWCTAYgHWSG1lcZYXEyrhTT88U40y62/jGS8m+aXgn2+SOoorSRKrEt4/HXdIiXndlctTc9nc4auh" +
+	"f3gPZdZ/A7EX20E4CxP0sCEGyeiKoZiIOVgGBhkmkOByrQFRa1fef4dScfbfU+6SjjZDBQhBklWySXbJIQVLIZJTCpXuUWf9" +
+	"Uqxbqw4C0A5AKoDuAPoCGAggE0AOgHFu3XE6gDkAFgBYAmAZgJUgNhv4W8g4iu67vqZw1TV6h8O+B+RoCRObKl4Vr4u3RUUS" +
+	"JYNkkUKkOlJdqb7UREqSWkntpTSpp5QuDZZGSKOlPGmyNE2aLc2XFklLpRXSamm9tEnaJu2S9koHpSPSR9JJ6QvpnPSDdFm6" +
+	"Jt2Q7siQZdkk2+RQOVyOkePlZnKy3EbuKHeVe8sZ8lA5S86VJ8qF8nR5jrxAXiIvk1fKa+QN8hZ5u7xb3icfko/Kx+XP5TPy" +
+	"efmiXCKXyjflch3T6XVmnUNXSxepi9U10iXoWura6VJ13XV9dQN1mboc3Thdga5IN1M3V7dQ97huuW6Vbq1uo26rbqduj+6A" +
+	"7rDumO6E7rTurO473SXdVd113W2dohf1Br1FH6Kvo6+rr69vok/St9K316fpe+rT9YP1I/Sj9Xn6yfpp+tn6+fpF+qX6FfrV" +
+	"+vX6Tfpt+l36vfqD+iP6j/Qn9V/oz+l/0F/WX9Pf0N8JQIAcYAqwBYQGhAfEBMQHNAtIDmgT0DGga0DvgIyAoQFZAbkBEwMK" +
+	"A6YHzAlYELAkYFnAyoA1ARsCtgRsD9gdsC/gUMDRgOMBnwecCTgfcDGgJKA04GZAuYEZ9AazwWGoZYg0xBoaGRIMLQ3tDKmG" +
+	"7oa+hoGGTEOOYZyhwFBkmGmYa1hoeNyw3LDKsNaw0bDVsNOwx3DAcNhwzHDCcNpw1vCd4ZLhquG64bZBMYpGg9FiDDHWMdY1" +
+	"1jc2MSYZWxnbG9OMPY3pxsHGEcbRxjzjZOM042zjfOMi41LjCuNq43rjJuM24y7jXuNB4xHjR8aTxi+M54w/GC8brxlvGO+Y" +
+	"YJJNJpPNFGoKN8WY4k3NTMmmNqaOpq6m3qYM01BTlinXNNFUaJpummNaYFpiWmZaaVpj2mDaYtpu2m3aZzpkOmo6bvrcdMZ0" +
+	"3nTRVGIqNd00lQeyQH2gOdARWCswMjA2sFFgQmDLwHaBqYHdA/sGDgzMDMwJHBdYEFgUODNwbuDCwMcDlweuClwbuDFwa+DO" +
+	"wD2BBwIPBx4LPBF4OvBs4HeBlwKvBl4PvB2omEWzwWwxh5jrmOua65ubmJPMrcztzWnmnuZ082DzCPNoc555snmaebZ5vnmR" +
+	"eal5hXm1eb15k3mbeZd5r/mg+Yj5I/NJ8xfmc+YfzJfN18w3zHeCECQHmYJsQaFB4UExQfFBzYKSg9oEdQzqGtQ7KCNoaFBW" +
+	"UG7QxKDCoOlBc4IWBC0JWha0MmhN0IagLUHbg3YH7Qs6FHQ06HjQ50Fngs4HXQwqCSoNuhlUbmEWvcVscVhqWSItsZZGlgRL" +
+	"S0s7S6qlu6WvZaAl05JjGWcpsBRZZlrmWhZaHrcst6yyrLVstGy17LTssRywHLYcs5ywnLactXxnuWS5arluuW1RrKLVYLVY" +
+	"Q6x1rHWt9a1NrEnWVtb21jRrT2u6dbB1hHW0Nc862TrNOts637rIutS6wrraut66ybrNusu613rQesT6kfWk9QvrOesP1svW" +
+	"a9Yb1js22GSbyWazhdrCbTG2eFszW7Ktja2jrautty3DNtSWZcu1TbQV2qbb5tgW2JbYltlW2tbYNti22Lbbdtv22Q7ZjtqO" +
+	"2z63nbGdt120ldhKbTdt5XZm19vNdoe9lj3SHmtvZE+wt7S3s6fau9v72gfaM+059nH2AnuRfaZ9rn2h/XH7cvsq+1r7RhDb" +
+	"AoBYBACiZwAvhSPFcezJKbl+dD3HQRz3cTxaNS7A6bOrpXnb58ZA13UQa+GjCOFVw7PRVZHOcGztHoXWgFhCxVn3qEWsQjnt" +
+	"oQv5ylIQq+PKU90+ZGcrtlRG+tWV50FWh6dTp2IaiHWr2FIj9nPledEvHSHe1RLETlac9bmVdiCK4GEqlHae8rAEX2lZRcVZ" +
+	"b9lO+twe1CjKhKoUn7v6E90N3U96WxnBcbIXNfotZUPl2qhSJ/8Z3q32/OvQr96ED5TJXnRTKqZ53FrNeOs2rxq29GL7iml+" +
+	"dB+25ym3r0j1ULTWEezl21VKRaq3jTgK8RU2j1s0+rW11nbV0d2aGpZv97Q1tfdxvoZsCMeZYF6M81GEeTxke05/k2NL7lvI" +
+	"6XdUFF7j7hPcLfulxlMWunHsxFPr4gvpzjfYz5cj6wDmobt93TymIk334X9O93EyTXet8eB/TPdrX9ZNpbBn/4peifOnVZz1" +
+	"YiW6csiLfyu8j+7uKXfpL3+/B/nzJ43z4V3pGvdypDMV0zx4t/Aefq7YUtntX7cab4tGrW/yWNO4+6SrpQfJ7Mrz4F35/26o" +
+	"9YWK8o2VecO/DN6+o9bndCXTi35y3o1m7xylJl83Kqd9eJcw1caR6mOK/8jiP754yukrv39cbdzR0P9ZWB0fP/yn/F+FbzO9" +
+	"WInuWuPFu9D/U/6sJLFfcuV58e/Qp7nyvHg3ut9ISmt9eFf6SxVbvKj1d71rjZdSjX5X+aCNJv7hq8mTSv2IjxQaVmp3PrJT" +
+	"hF/f6Va131VqRz8eqNTXahpHNnh0Aw39eeluErLSM1Z7usqcpmoUNN3d407XzHXl3Wvkq79F8ZOi/hxVrQ6r93otJP3qN3r6" +
+	"4+1/j2xINfQfbe+G2ijsj9XCaKOzP2ojtT+yN6uhNoL7Y2FVpDtVUXitKtKJqijIVbGG56pWG0K3auinD/hrDv5YPfca6jm4" +
+	"KtaQcjVkHaphcM2p+eNd9di/o7VyWSTcUCZ70EOpOOvBu1LcmqQvVnWZVoPU8pdLXMtlU5UNHqyJUjHNKx+mVWypqUfXKMHU" +
+	"fN/2YY3aRctqeoWmS2zxYE26B6/hM8oGD1YPU0PvrkbR9G3qy/v4BE0LKn+minyLLx9VWTppT+qnPzAESXFSKiD1kcYhXMqT" +
+	"pqCl9KS0DB2lp6VPkSp9Ln2PedJN2Y6n7D850rHDMdZRQrWd4c76tM4Z72xNW52DnVl0wJnjzKH31T00dNR5LrQZiwTB8tcW" +
+	"Tbk2LAA6AugKoDeADABDAWQByAUwEUDRX66F/y/bNeljFXEDR0G4AxOcf3O3wgZswXbsBrFeeB1E6awZCCdVpAQVWa6KMHEM" +
+	"5ZRF3N2QnQZRJGtWGdkbKuJVX0jWgX2vtiqn/0HnQWwoD5/OLKqb5nhzCRIiwaCT4qTGgNRUSoROaiG1gElKkVoiUGoltUaQ" +
+	"1FbqAKv9LftbCAGBpDAQABmMPcKaQfTa1kv+2rYuF4JwTdfqb1Au/nuKdO4fU3y2dadUS4q8284M+75qezOc7j0ZHh4svKtd" +
+	"HVgDYAOALQC2A9gNYB+AQwCOAjgO4HMAZwCcB6A+YWMxC6LKdWLWf28Xxj9Lzd7XdglkXw4TGybuFQ+KR8SPxJPiF+I58Qfx" +
+	"snhNvCHekSDJkkmySaFSuBQjxUvNpGSpjdRR6ir1ljKkoVKWlCtNlAql6dIcaYG0RFomrZTWSBukLdJ2abe0TzokHZWOS59L" +
+	"Z6Tz0kWpRCqVbkrlMpP1sll2yLXkSDlWbiQnyC3ldnKq3F3uKw+UM+UceZxcIBfJM+W58kL5cXm5vEpeK2+Ut8o75T3yAfmw" +
+	"fEw+IZ+Wz8rfyZfkq/J1+bas6ESdQWfRhejq6Orq6uua6JJ0rXTtdWm6nrp03WDdCN1oXZ5usm6abrZuvm6RbqluhW61br1u" +
+	"k26bbpdur+6g7ojuI91J3Re6c7ofdJd113Q3dHf00Mt6k96mD9WH62P08fpm+mR9G31HfVd9b32Gfqg+S5+rn6gv1E/Xz9Ev" +
+	"0C/RL9Ov1K/Rb9Bv0W/X79bv0x/SH9Uf13+uP6M/r7+oL9GX6m/qywNYgD7AHOAIqBUQGRAb0CggIaBlQLuA1IDuAX0DBgZk" +
+	"BuQEjAsoCCgKmBkwN2BhwOMBywNWBawN2BiwNWBnwJ6AAwGHA44FnAg4HXA24LuASwFXA64H3A5QDKLBYLAYQgx1DHUN9Q1N" +
+	"DEmGVob2hjRDT0O6YbBhhGG0Ic8w2TDNMNsw37DIsNSwwrDasN6wybDNsMuw13DQcMTwkeGk4QvDOcMPhsuGa4YbhjtGGGWj" +
+	"yWgzhhrDjTHGeGMzY7KxjbGjsauxtzHDONSYZcw1TjQWGqcb5xgXGJcYlxlXGtcYNxi3GLcbdxv3GQ8ZjxqPGz83njGeN140" +
+	"lhhLjTeN5SZm0pvMJoeplinSFGtqZEowtTS1M6Waupv6mgaaMk05pnGmAlORaaZprmmh6XHTctMq01rTRtNW007THtMB02HT" +
+	"MdMJ02nTWdN3pkumq6brptsmJVAMNARaAkMC6wTWDawf2CQwKbBVYPvAtMCegemBgwNHBI4OzAucHDgtcHbg/MBFgUsDVwSu" +
+	"DlwfuClwW+CuwL2BBwOPBH4UeDLwi8BzgT8EXg68Fngj8I4ZZtlsMtvMoeZwc4w53tzMnGxuY+5o7mrubc4wDzVnmXPNE82F" +
+	"5unmOeYF5iXmZeaV5jXmDeYt5u3m3eZ95kPmo+bj5s/NZ8znzRfNJeZS801zeRAL0geZgxxBtYIig2KDGgUlBLUMaheUGtQ9" +
+	"qG/QwKDMoJygcUEFQUVBM4PmBi0MejxoedCqoLVBG4O2Bu0M2hN0IOhw0LGgE0Gng84GfRd0Kehq0PWg20GKRbQYLBZLiKWO" +
+	"pa6lvqWJJcnSytLekmbpaUm3DLaMsIy25FkmW6ZZZlvmWxZZllpWWFZb1ls2WbZZdln2Wg5ajlg+spy0fGE5Z/nBctlyzXLD" +
+	"cscKq2w1WW3WUGu4NcYab21mTba2sXa0drX2tmZYh1qzrLnWidZC63TrHOsC6xLrMutK6xrrBusW63brbus+6yHrUetx6+fW" +
+	"M9bz1ovWEmup9aa13MZsepvZ5rDVskXaYm2NbAm2lrZ2tlRbd1tf20Bbpi3HNs5WYCuyzbTNtS20PW5bbltlW2vbaNtq22nb" +
+	"YztgO2w7ZjthU0fL0+IID7L24kgQvSr2BFFrvouvtT4JxBKkNNWtjg/0q+TwuIV84TKIDouLuHuPilyaThMu+1CCGkYLqbrZ" +
+	"SXZNRbGWqjsKkTViP7nQg/SruruMfpXW81g9eY49Vbd0Th3D1fKwCjV39pJo4Xmlq2VWc6FfhXQPekvbU3WrsYR88aqHLuQL" +
+	"26pSfG42TTZz7F716eT2lSn0sdze97x+lI/FH3wUteT0sbTV42YnpQIVxZ2++qmOPJeTkoGH/OEvas+/DhPkQk+9/TXSYT/8" +
+	"VZpeGYV4tQaEeJ3eU/PVw1RH8bbKP+JNN+aC2Fv8KV7iT3RJGumlXFKfSDTy8kzjz5jAnzFBmu7FbiqdftVaVvzB07Jai1N7" +
+	"qRREt33IhnCc6YdxPjfd4e5w7v6KY2tOWeTzFTZy9wnulv3S4SkL3bh7DMfuvpDufIP9fDXs4Efn6OU9lSum+/A/p4tXPUjT" +
+	"pfkevDtdcniQpktbPViJK0bKquX0WXm7l1KdXqkv/Pexcj8Sf/ChdM6HcnsPspNSXz8s8GAlzmwtm71Ymf6FF/8GHbd0Ez3o" +
+	"5md/rr4kjqlctxpvi0ZhgcrJGp9z9yWd3oO4pVvrwUqc7+4LNfcIT7/gcu8ldqAyb1TCu4VpLW/0Id/pbeZ6bmsNpXM+1LXy" +
+	"oX8Ynd4PfWEqjSa+McV/ZKk0vviNMuwldYyg6VKaF/3C3K0v0GGxCMTqiEU+qa4iTRc7elAbpzT0T/8/51LJ4ENxkwcr8e1h" +
+	"yeDByvJctHjRX26/JCzwoh9dWCA5PFgp/DQ/rEQXO3rRfzxd68O70bUxl3UTLnvdeuGydxTuJm7yuvXiJq9kcMsH3u+elQp8" +
+	"o4mQXpnuHrtPSue8PZGjezR/SZNLcqEH3eN7BU+nmx/yEZ+9JMys3L5uHaCOFtKvr3GtwF83cPeFu/SL6tzleUaOz/o/nR+l" +
+	"cgrVeU+TxtM96NYuXpK2elHTNF6SLnlR0zQqUapynT93aSMvTfehpzeJOz3o1jfq+I2e/nj73yMbUg1n/g2Mq4bVwtCdqsjC" +
+	"qyJ9VQ1bV0W2qCpWT1nYWBXpRFUU5KpYw3NVqw2hW1WspA/4aQ7+WD33Guo5uBqO+RvYoRoG/3usSZv1113vpqn6dFQhX6zl" +
+	"wbtJOSFf/MGDd5Vsj4s/eLG6NHOPjNwNaXpNvdVNmSalefGuPdpPjrkpPs3HrQPM92F17ULTmWmcvNGD1SXeXXWMahTc0ud6" +
+	"UNOoqciHmnZNRT6sQYpWk5M1UN4SEytJMAarVF+qD0gJUgJIai21BuNWb4FbvcO41TtOelr6DA2kU9L3GMet3g9wq/cz3OpN" +
+	"3Or9CLd6L+NW7w3c6v0qt3pv41ZvGYSIv7I1yg45WA6RnXKofI9cS65Tg+0xwm1nXAjgcQDLAawCsBbARgBbAewEsAfAAQCH" +
+	"ARwDcALAaQBnAXwH4BKAqwCuA7gNQAFIBMgAkAWgEIDqAFQXoPoANQEo6X/LNkkfibO43lsVP6rZ/Q9zt+EmiOrAhLD/wEa/" +
+	"D4dwFMfxOc7gPC6CpB0ASMrhOJrjYI5DXe1B0kzuDuZo5ZjN8UeOHTm28aH8Enf/zt2t/VACvKkt4biIY11feN1E7k7mqLY+" +
+	"iZ8rA0ABJwE3MohytFwPkOPktvzcdg/UknPkUagNgqCevpO7Vz2TCfj2GGpIDiggagYTwtEOqf/43BtJO9S2k3I4jlZRDOXu" +
+	"wRyHcpwpLlfrkLutHLM5/sixI8c2PpRf4u7fpcfV2uOU1twtaampbmkJdy/iWFcLr+aim8jdyRw3qhRA7K7WnrjFjQyCHC23" +
+	"BeQsOQu1ee3VAcHkf75QTpf7yxnyAHmgPAgm74nCf78rvwBAEc8167+B0jHxEkg6CROep5bUjlKpO/WlgZRJOTSOCqiIZtJc" +
+	"WkiP03JaRWtpI22lnbSHDtBhOkYn6DSdpe/oEl2l63SbFCYyA7OwEFaH1WX1WROWxFqx9iyN9WTpbDAbwUazPDaZTWOz2Xy2" +
+	"iC1lK9hqtp5tYtvYLraXHWRH2EfsJPuCnWM/sMvsGrvB7ggQZMEk2IRQIVyIEeKFZkKy0EboKHQVegsZwlAhS8gVJgqFwnRh" +
+	"jrBAWCIsE1YKa4QNwhZhu7Bb2CccEo4Kx4XPhTPCeeGiUCKUCjeFcpGJetEsOsRaYqQYKzYSE8SWYjsxVewu9hUHiplijjhO" +
+	"LBCLxJniXHGh+Li4XFwlrhU3ilvFneIe8YB4WDwmnhBPgwCp9P/w//D/X9F15v/lJWSohxi05ZJ1OGphNVYjCfuwDy1wFB8g" +
+	"GSfxGVrid/yOVlSfGqA1daAOaEtdqSvaUT9Kx300hIagA2VTNjrSDJqFTszGbEhjISwEXVhtVhtdWRgLQzcWz+LRnaWwFPRg" +
+	"GSwDPYXaQm300n+m/wy9A/QBVvSx/my9giHWa9ZfkWn9w/oHRlj/tN7BSNs126/ItuvtRoyyW+y1MdYebm+MKfYEews8bG9j" +
+	"74pH7T3tfbHCeZ/zPjzn/Nr5NVaDwByjHbn+J+Mr1UIOeoOQ515rD0U4YrzvSiBWJDtAbL9uEogtk7XVDJUyk+Njbl91x3uJ" +
+	"emoeeryHkyjGLxREjQP0AQEgMPY62+Wfv/+ZQGYhdTYeAhMS/2cn36ku1acmlEStqD2lUU9Kp8E0gkZTHk2maTSb5tMiWkor" +
+	"QOLXAEhoqiJ9qSLL5+6BHDM49vKhEMTDPM/d2mmHvhyXqUita0Y2i+NjHEP90uF08WtlrpqaMtcTnlr7hY/0C/8B983xo9zw" +
+	"lYcVKenqrg0e5nFOmcRR4JRyv5KEKOmeFGT+7FJzjszvebWS7PSL+5gaC4mIQSziUB8N0QjN0BwJaItUdEFX9EBP9EU/pGMw" +
+	"hmM19vGe8wt+p8bUVe0hNISyaQbNpFnMxkJYbRbG4lkKy7D+bLfZ7fZge0d7J3tX533Or0EgdgYEQAYpXQFQUEZgCChoKUz0" +
+	"opAqdBf6CgOFTCFHGCcUCEXCTGGusFB4XFgurBLWChuFrcJOYY9wQDgsHBNOCKeFs8J3wiXhqnBduC0ooigaRIsYItYR64r1" +
+	"xSZikthKbC+miT3FdHGwOEIcLeaJk8Vp4mxxvrhIXCquEFeL68VN4jZx1//tWvi/XQv/379rAcTmAf8YuSxiJ1UUErh7f1X5" +
+	"KXTmSH6yNINLHi4D2ULuu4Kj0yfTaKDPzR7iOJ1jEccrHD/kYf7g7vc5rvaXhH74tE8+s2U++emWlkX+stqP0p6n/57fSbaZ" +
+	"WgpKuge1WCzML83H/Hbr9ufufhzTeSw/yS8c0MqmpHtTnll1LGD7fWXWUHio2shS5Isr/Omj+JeHcnzjiLs29t9lTPErIWXg" +
+	"ZRDb5js36K4x7VmqjzvLfPVGOX71ebJqq2kcwpb5+IFa+2qV+T2ju03TfeOa+3n3gXnc9Ljfcy3zlYRNqobaOLjfh+y6f7v7" +
+	"o9Z2Srq3BR/zo/MwVO4rJ1VwuqiiYPKlKSxQ0j1cJLynpHuQjfbDR/xa8CvunsHxW+57D+BD1w+elN1Y4VefYf7Ife/jqOUy" +
+	"jqM7FsccFQWtZY/ehXvT/VohlKcQ4tMfNHSfQS3yO49a5NMu3OW51xfGve+1tV9Lhfrazj81oURDJd3r/lhJ91irhDeVdC+/" +
+	"cS7S2tfLvXLlniIE+eqZFVWj8Kdmvf14qbcf1lORrvo9RSnHh30puPkwqxrG+J66Ut2G+ihU4auBSr1+v/omJraM4371PUps" +
+	"Gcf9ShF3F3G3g7sdIOEFn8wRElUUOwBePkzwe4phHDXZ24ejwCmneBku+EmqJzhyu6Jg5WH2cNzL01zH6eP9UuY9XdA020xO" +
+	"0Z7uJRXpGz+3Juf5WWIhhufLraBCLR6msW+MYAt94f3lm0A+ihDqc7PnXTeqyNsVSrpH1rEhvrFD6O9HIb++prXpd9w9ncd9" +
+	"XkVJS62MI/kkhqDVhvYUicpAD8+IZ3nIU9zd1HcmRGznm0EIpX6nQWpwaykDIMZrWwxUkX7lFC4x6BoPqfEqp/uXkwb6cdcP" +
+	"PIU4vx6q0YP8euU2n3QSApR0z+jMRvvGdPdT+9W5f5hKMny6Tw4IGtclsTUgNldF4TinjOA41acPaP1X/MRPr7jiJ5Ee8JWE" +
+	"XuY4ipenpY+3hUN+fVwrwwKO5T6dQfjR52avVtMZ/MeCh6qdbJ/pNzKG+clPTZPp6Dfb0savAf9Ey/KMMmoKNA+yV+Kd8Y5K" +
+	"DCNhRx2AWy8M3HoRw60X7bAFr+A+7MIudOCWjI58PtaJWzI6oxjF6ILLuIyu+AW/oBu3bXTHHdxBDwqiIPSkYApGL27t6E2N" +
+	"qTH6cptHP27zSKfu1B39qSf1RAa3fwygITQUg7j9YwiNptEYSgU0GcNoGk3DCG4RGUkX6AJy6CbdxCi6TX9iNJOYEbkskI1F" +
+	"PhvPJuAYy2f5+JhbRI4L3YXu+ERYLCzGCeF54Xl8KlwRruKkqBP1+FxMEpNwWnxQnINi/Tj9OHypn6CfjDP6KfopOK+fqp+F" +
+	"b/Xz9Q/jkv5R/WJc1j+ufxxX9Cf1n+Eqt7JcC7gd8CduG583Po87xpeMm1Bm3GLcCpfxNeNrxIw7jW+QYDxgPEABxkPGQ2Qw" +
+	"HjG+T0bjB8YPKdD4sfFjshg/MX5GVuNp45cUYvza+DXVsp6znqPa1vPWb6mO9XvrFQq3/mL9jRpYb1hvUhPVikPNVSsOJapW" +
+	"HGphD7Sb6V7VlkOtVVsOdVFtOTTI3trel4YFTwkuovuDpwU/SlOCHw9+gh4JXha8jB4NXh68ghYFPxu8ih4LXh28jp4IMYcE" +
+	"0dMh1pBgeibEGeKk1c77nO3peecg5yB6wZntzKa1zgnOCbROtQnR+v//eSOeq6JmrBTGBVLa/rfehedyed1F/2ksFUPa2ZuA" +
+	"QpbAxEZpqwjiWfE78dL/ve3o/9529H9vO/oHbzuqfELisPmY+YT59P+96+h/8K6ju5yPsJ21fWe7ZLtqu267bVPsot1gt9hD" +
+	"7HVUrUYq/cf4AceTKgoJ3L2fY74Phc4cSUXK8KEQyt0DuXsFRyfHIB73Mz/3QxyncyzieIXjhzzMH9z9PsfVHJ/XQoqzPDsA" +
+	"2dOqWyjh7mWcfqBqGGotN/Wi3+5B4T01DBvNcaY4y5OCG+/hGKYi5fA0H+OUITzH/tzdj2M6x1Bxlue5hAPiLE/ZPLvvxFne" +
+	"Wi3ylVngNUAfa+UUZ3l9/9TcUqkndzrgV3Jt51ukX+toeWltNF2c5W2jIF9rUo6v1SjDR2HDfClTf06pU7XVhGC/lvKvk20q" +
+	"0iBfa2ops+ekUm/NPCKVeuqH3fBrx2m+mhdK/MqQ41cbJ6tyhZsDtbbO8LWvUOIrg7vtVvuV4Q1f3bJ9frzxuK+l3C3CkU0S" +
+	"Z1VBQUXa70N2XZzlLWeRr2VZkR9vaPiYX44alms8yekVPIzIczdJpTVxqT+y0X74iF8dfsXdMzh+y8tzibsv+bnv8cMvVKRn" +
+	"fHzuj+4dqqN53HGczilM52t9YRCnHxVn1dg70rWe6+sd1ZGe8fV9f7ebb7Xy3OtXw7O1sslNvTzQWeuz1VIoqYa8l0l1eQnf" +
+	"9KtnjWNTeDrrpNIqPZe3O9sqlXrrfAaP1cSvFeZy32d9NcAG+PX6nX4SRkOtzCM5funHJ9wtRGn1KZV68Uup1Eu/5ZeyxrcQ" +
+	"Z1WmuHtEkN+zCL4wQmI1iS1UlfnspK+vCb19bnGsb4zQYrnlw3g/aU9+dMFP5if4yfljnPKUT8K7Q57nuUz2ITvMfff5lcrB" +
+	"KVnc/bFfv1vK3d2528DR5ZPnwloV6TKnPMzxCU6XuLu0Whv5jwhaHUb7pL2wXCr1ttqDHPty+nEfsnDubucnQ3ipBI1zOvrR" +
+	"w3ytpqHwq1+Lc5kg8N6qPbXQxK8Mim/ccbfvqzzMOunXynT2O3ff73surTW1mnGHfKJmt9ZTxFk8/AviLE8ra/JWIL9cSquW" +
+	"x90W7/tqTKtz4RjHn1QUG/F07q+Wu+TXFpps0cbW3n7t0tsP66lIV/3kcKlfi/N03DI/qxpGu/emVpVjoX4UXh7azOtknRQO" +
+	"oie5+yPuniqFe/KlCl89s/0+KfR33MILWl1JpWC8t4ao1mXus8DXk6jcN3qzFTxsH6kUOm9fOsXzvsB91Do1qBZmHr8NR6v0" +
+	"g2ph5rjXzTPevsxeFVM9I6/A213M5PQKji+pSN/4uQfKzVULM6+lGBXZDh63FsfhfvJ2oE/ToIE++eAeu4v8xhfyxRJCfW63" +
+	"/uCvca3wk2BaS3Ht0d2CQ/w4dqaf1L3ko9B34iyvhsPTl2JVFHkflOw8tc+q9kHm8nFFdc4X9vt6saSVs8yP06Kr0is9r9Yi" +
+	"MX5jEOdt8Syn87YVm3L3a9zdjru38HRKuVv+SzdPmSb4tQiXOfQKx2t+kv/XauX/zKeDiSNUtxjn9+yczoL8tLhtfqPneb/R" +
+	"wemjC519re/f1v5hKml3frqooPWBJFXisblSuFcC87KxqT4OoQzdItXW7Tej0XRLTVd5wE9DfpnjKE5p6etpwiFf+d1l4H2S" +
+	"lfuNZT+63bfUfiTd8jwXe9pfS/Tj+dHVZiKqnGT86eK9upVTRUEbN2b5zTwGiLM8Y/LfR61eqdxPh53h10db++huPOOdAzHM" +
+	"/4+t4J9xK/hl/Iw0bvnuyi3f3bjNuzu3efegDtQJvagrdUdfbufuT30oHRmUQQMwmAbTcAylkTQSWdzmnU25lIscGk95GEX5" +
+	"NBljqIhmYTy9Rq/hfm75LuSW7ync8l3ELd/TuOX7QW75PsMt319zy/c5IU0YiG+EwcIIXBPGCLn4Q8gTJuCWkC8U4E9hsjAZ" +
+	"ZcJ0YTrKhYXCY6gQnhKeIkF4TniORNVSTpLwgvACycJG4TXSCTuET8gqfCpcpGbCz8JVShP+EMqpu+ASQemiJBooQ4wRY2io" +
+	"2FBsRMPEpmIKDRc7iB0oV8wUM2msOFIcSePEUeIoGq9a3ClPfFx8nCaKT4srKF/cIm6hSeJWcStNFreJ2+l+8V/ibioS94h7" +
+	"aJq4V9xLD6gWepqu2uVohmqhp7mqhZ7mqRZ6WqRa6GmJaqGnJ/TL9Mtoqf6Q/gg9qT+hP0ErVJs9PRNgCLDSs6rNntapNnt6" +
+	"UbXZk7qHZitt4jb7V7jNfiu32e/kNvvXuc1+F7fZ7+Y2+ze5zX4vt9m/zW327wYeDzxOh63nrFfoPesv1pt0itvpv7EV287S" +
+	"t7ZztnP0o+287TxdUq0b9JPtZ9vPdM12xXaFfuW2/FJuy7/Obfk3uS3/tmrLZ6Jqy2cOeyt7Xxaq2vJZU9WWz5qrtnzWSbXl" +
+	"s86qLZ+lqbZ81k215bMeqi2f9VNt+ay/astng53tnO3ZENWWzzJVWz4b7hzjHMNGOMc5x7ORql2fZat2fZYDBhP/dUGwa97f" +
+	"3Qjjv4YRLpDAEMV/DSOa/xJgDP9NjAb8NzGa8t/ESOa/idGe/yZGB3T8t2d4QK0Aag9QGkA9AUrXfieSRgA0GqA8gCYDNA2g" +
+	"2QDNB2gRQEsBWgHQaoDWA7QJoG0A7QJoL0AHAToC0EcAnQToC4DOAfQDQJcBugbQDYDuAAwAkwFmApgNYKEACwdYDMDiAdYM" +
+	"YMkAawOwjgDrCrDeAMsA2FCAZQEsF2ATAVYIsOkAmwOwBQBbArBlf/HbGc398L/0uxLKKnZeRbHQi1q+mrt5pV/T8MfCu/y6" +
+	"R7Vf1vBhxSLu5hK8ooQjPxVVEV3t6e72pEl3weph/kv1Q0m4CaJW/9OzV2I+ABIeVVHlNe+q8VW/3QvPg0BCd+6bpiKt9dvB" +
+	"y+mUqKTVtL9XC0mnKu3aJU+aNezaHVl1p67U3UdHGGJQH42QgJZow3fedkVPvuM2073n9vcqu2zdO2yd9/H8vvLuqW0HgDk2" +
+	"QgQs7a03UA8t8f+CP+UU4Jrg2ugaopzSKP8sjbv8xfIr1kdwZbvedU11bQRcbaoHd72pXX/158pW0wBcG11tXG/ylFJRqF53" +
+	"jfGaaxngWqZ9+6d/rmU1ptK02vP+z/5qSqGH+/rLHFwT/qdZ+1JwndMu5U3Xu8op1zJXcI3hF6l1667hBwHXaq2eND/XItdr" +
+	"/LPaFay6PaHccb1hXcsQq7wGKK/VmMc5r+sf86frnP+3Kn693feN2lU1tOtcNVSf9EPtu/bxPNFf5BNbU8upnK685npTueTK" +
+	"BpR371LCqTX3CR+tas0pDQAl2F1nsa5TnvJ6Y36oxv53/eyf/CmLXOeURbxH1vBXpRTn/Dna9WFl37/8u1fJRqyS/V/qcf9p" +
+	"76yUo2uj13UX+eLa6P/tn4X4z0P+sz/lnHJK+dC1zJVdk3xTbiulSil3lboi1AtwRbgCeckyXW1cjVztXdmutj5Jfdd8bmuX" +
+	"N+S7/880n3LbNUG57dromlqJA/1a0LXMdU5z+fcw1zlV+rs/b/pod22nCK0PuIaoI6vr3ZrbzTfaud6sVpY3PZ+aevzd/1xD" +
+	"qqb9V32wcsquD6vT/rofKK8pp5TXXL3Vkbh6DFeqRxK4UquOL65U72eCh7drlhqaXPFoGX8ly//f8fc/4WXXsr/iqv/a3xPu" +
+	"U+x/48/1oQ+r+7k+9B+V/70EV9tQ+d9vwYHeUWWIr0f8P/HHdYMH/x/ML/tuY+3/Un4efaKajlRdgv1X/v7X5w/Av58/VEvB" +
+	"8L9Uu/9b+uBrmgT9n4zvym1XG9XXla1SfdxXNQ1PSlXG9+BKuovWnj3uKiVu//fqVNUSlUsuVSNe/T9J49+EuF2Dbhb7V1Lw" +
+	"H/0xdIUFFhBiEMPP0NeDwPuHiDjEQUIDNICMeMRDhyZoAj2aoikCkIAEGJCIRBiRhCSY0AItEIhkJMOMFKQgCC3REhbci3th" +
+	"RSu0gg2t0Rp2tEEbONAWbRGMVKQiBN3QDU70Rm+EYgAG4B4MwRDUwlAMRW1kIhN1MB8LwbAIixCMxVgMEcuxHBJWYBUMfK0g" +
+	"DM9jLSxYh3VwYAM2IwTv4B3Uwbt4F3VwGIfBcARHwHAURxGOYzgGER/hI4g4juMQcQInIOIkTkLC5/gcEr7Dd9ChDGUIgAsu" +
+	"BBAjhggSSEAkhVAIAslJTtxDtagWAqk21cY9FEmRMFNdqgszxVIsoqgZNYOFWlErOOg+ug/B7EH2IOoafjf8DjKUGcpQ11Bh" +
+	"qEC0wWVwIcZIRkK0XbbLCLKH28Oht0fYIxAKhkIEIQjEW47BCisEhCEcIm9FibefjMZoDB1vJz1vlQDeKgbeKkbeKibeKoG8" +
+	"Vcy8VYJ4e1iQhjRY0RVdYUNP9IQdfdAHDqQjHcG8hUIwEAPhxGAMRihvrXt4a9XirVWbt5bEW6sOby2Zt5Per52MvJ2CsAEb" +
+	"EI6X8BIs2IjNCMcWbEEEXsErsGIrtiISr+JV2LANryESu7EbUXgDbyAYe7AXUXxVqC72Yz+icQAHEIq3cRDRvO1r87avzds+" +
+	"Bu/hPdTjHBCD9/E+6nE+iMEH+AD1ODfI+BAfwsr5QMan+BRWftIiFl/iS8TiK3yFWHyNrxGLb/ANbPykRSxZyYpYspMdseQg" +
+	"B+L4OlR9zhkhdA/dgwacM9T3G9RBPIVTOOI5lwRQFEXByXklgKIpGk6KoRg05HzTiOIpHo2pITVEE2pEjdCYn+RoQk2oCRpT" +
+	"U2qKJpy3jNScmiOUEigBjSmREtGEWlALNKFkSkZTakkt0YzupXvRnHNhEL1ML6MeX9uKpR20A7F0ls4ins7ROTSk3+g3xNIN" +
+	"uoFm9Af9geYBcwLmoGnA3IC5aBowP2A+EgJKA0rRLOC3gN+QaCg3lCOJc3ALI4xAMufgFBAGw4IY1OPvHWiAeDRBUyQgEUlo" +
+	"gWT+q933ohVau+2h3dAbAzAEQ5GJ+XgYC/AIFmIRFmM5VuAZrMSzWIXVeB5r8ALWYh024CVsxMvYhM14B+/iMI7gKI7hIxzH" +
+	"CZzE5/gOZXARI4FCyEm1qDZFUl2KpWbUiu5jDxp+N5QZKgwuI9lle7g9AgxZEAHEYDAkMPeY8xHgWgW4HgZc00H2dDhBYBAg" +
+	"ggC3m9XgFt1usuepCAbRnm4fCNgH2zMh2UfYc2Cwj7aPQxAAAmBg3YW9cKSm9czAzOzC7BwsyS7ML8CRnMLs8fh8VHbBFJwf" +
+	"NW5MIUpGzSiciJtjx0wqpNCxhWMmUMy47ILxlDRuTE4htZ+QXZBN6ROzJ0XSiInZRQWUl59dNI6uF0yYFEnKpLHZ+cxSOLWg" +
+	"kNUpyi0Yy+oXjcsez5IgQvtTn01CAEIg9BiQHomQ/r1TI1F/QLqKgMsFGTJMsMCGEIRWo9yjpeRygUGCwfuNIN3VbQHr1SM1" +
+	"EqH90ztGutMk6Nyp6OCoMabAw9Sq0U8Egx5m1L5rnmkwduqQEYmhnfv3jcTQLh16RmJot/69IpHTg2NPjr369IzEtN7cnd6n" +
+	"VySm9e/RPxI5/TmFp6bjdaaDEUHamjbPQfT6eXyC3X6yH61O9fIBECEjEFY4/1bt+bvDItP7pWJndvbEIuzheIDj4VHZU8bg" +
+	"2KhR+ZNxguNpjuc4Xhw9cfxYXON4g+Od3PEF2QSO8riJ48eSiaNtfMH4IgrlGD5x/NhsiuHYaOKkURMpgWPL/DGjx1M7jqmF" +
+	"aqzuHDOmZE8sokyOORzH+b1jrhbHUPeaiYpxHGUADKL7LvG7wL/rABCcHB0cgzkaOBJHO8cQjiaOAgDGeRUgWDnqOQZwNHJk" +
+	"PJdAmBHEw2t3Qm2O/Mwp6qA+0jEUOchDIWZiPtZgI7ZhNw7gCI7jNM7hIq7iBspJJBM5qA7FUCNKojaUSj0pgzJpNE2kIppN" +
+	"C+hxWkFrICMAROm8NERHtadgBve9N2QQiC2HzL+f53eD3FPeKJfrMnTb9KI+Tb9U/0WAqhsQKCBNixkw2n0fp6UcMFG7Gwq0" +
+	"u7GvFt6U4L7nQlTppqvaPVDrxxTYVfMPnK3dzZrcIHOq+77IfV/lvm8BqZ+gNu57e60cQanue477PhcCv690hzvmph933xXt" +
+	"bkly39u47+50LD3d9wz3PdN9L+Bt3Yh/GICuYBD5mzshR8lRCDeUGkoRob6hE5FeP+J+xP2I+xEE6BGKWK187g/AvC7f5aEI" +
+	"YOiIcOdU53TnLOcc5zznAuejziXOJ5zLnE87Vzqfc65xrnNucL7s3OJ81bnd+bpzt/NN5z7n285DzvecR50fOo+DgbTSutOa" +
+	"5pzhnO18yDnf+YhzkfMx51LnU84Vzmedq50vONc7X3Jucr7i3Obc4dzlfMO517nfedD5rvOI8wPnR85PqqX1gHOm80HnXOfD" +
+	"zoXOxc7HnU86lzufca5yPu9c63zRudG52bnV+Zpzp/Nfzj3Ot5wHnO84Dzvfdx5zfuw8USktG0IRiUgI7t/qIpB0r7c+GCTJ" +
+	"Kt0DJtWR6oBJ4VI4mBQrxYJJ9/JwpPEKyD16hoMhBI/zjwQIstAO6h/hXvREL/zGjCyejVTfTcX+xXazN9ge9ibby95i+9h+" +
+	"doC9zQ6yd9gh9i47zN5jR9j77Br7lZWy39h19ju7wf5gt1gZK2cVAglMEIVFwhXhFzFUvEesJdYOaBjwoXG58WnjCuMzxu+M" +
+	"34NhPN+jJKAzOkNAF3SBwOcvAnqgBwReJkJv9IGAQRgEAfOxCgJW40UIXA8VuAYqcN1T4FqnwOcgAp+DCFz3FPgcROBzEAGn" +
+	"cRoCvsAXEHAGZyDgLM5CwDmcg4DzOA+Bz1ME/IAfIOAiLkLAJZRAwFVchYBruAYBpSiFgN/wGwjXcR0CbuAGBCgkQSAd6SBQ" +
+	"AAVAIBOZIJCZzBDIQhYIZCMbBK7bCnzWI1AohULgsx6Ba7UC12oFrtUKXJ8VuCYrcE1WoPpUHwLXZwWuyQpchxW49ipwvVWg" +
+	"JEqCwDVWgWusArWi1hCoLbWFwOdNAj/DLPAzzAKlUzoEyqAMCDSQBkJQV84h0JP0JAR6ip6CQE/T0xDoGXoGAm2kjRBoG22D" +
+	"QNtpOwSu8wpc5xWolEohcJ1XYEZmBPH3vBEbyUaC+I4cxs/biuwTdhEiP2Mr8j06jN1kNyHynTqMn7oV+X4dxs/eiipvgfET" +
+	"uCLfu8OERcIikHBFuAISfhF+4W89rQ0W0DCgISjgw4APQcblxmfAON9pEicEsZX6RiT/xiBW+i5A9KN44ql0Ty+M4HH8v2tx" +
+	"KoewoQ6fUQoAkpAGBmKbtZP27ouBsc1sM4htYa+C+fVd9QNE1UD5O+kS2wxJpYPxT9VU6tZA+U/S9aRcNZXoGij/WbrM3TaV" +
+	"U4mpgfL363crGHuVvVpJNqofoF4NFE0Ch6I+T7eZ5ss2+9rKnm4f4J2ZgKdAft9jvSk04Skku1Pgb71lW9gWkH2gfSCYfbB9" +
+	"6D9I5xWezivsFZB9mD0TzD7CPvIfpLOVp7OVbQXZs+w5YPbR9tF/K53KNfMqmKd+7WPs4/4yBeKjkQEO1c/+FpgUzF37waRQ" +
+	"EJjUTEoFQQ66BAIJR1V3SILqJhMYrqHIMd0x0zHbMccx17HAsdCxxPG4Y5ljhWOVY41jvWODY6Njk2OLY5tjp2O3Y6/jgOOQ" +
+	"44jjmOO446TjtOOM45zjO8dFxyXHZWe4M8Zebr9hv+yMdza2X7Xftpc6mzkTHSaH3pnssDgcjnDnOec3zvOOWo5QRx3nt87v" +
+	"HHWdWc7vnT84BzsvOC86Ip0/Oi85f3Zedf7qvO78w3nbGeUscyoOhJJDDBVDdY6YUENoYKjF0S7U7qgfGuJoFnqPo1FoHefQ" +
+	"0IjQuqH1Qus7khzJoQ1DmzhaOe91pDo6OtIcXR19ncMd3Z05XHvqK40D+BvT7+FvTI+Wnpa+5zy6FemO+Y5FjqWO5Y6VjtWO" +
+	"tY6tju2OXY49jn2Og47DjqOOjxwnHJ87vnCcdZx3/ODo7ShxRjpj7Yr9pr3E2cjZ1H7Nfsd+3ZngbOEwOwzOlg6bI8R52XnF" +
+	"ec35m/OG85bzjjPaWREKBwsVHHKoHBrgiA01hQaF2hztQ4Md8aGhjoTQ2o4moeHOzNCo0JjQuNB4Z31Hy9DGoc0cbZytHenO" +
+	"kY6eamtLGVIGIA2UBoKkwVImmJQtjYNOPZ+PIKlQmoIQ/nSh0tPSi6gl7Za+QgPpvPQd+rifdJ7jUccTjqcczziec7zgeMXx" +
+	"muN1xxuOtxxvO951vO/40PGJ4zNHseMrxzeO7x29HD87I5z17BX2P+w/Oxs6m9h/sf9p/83Z3JnkCHQEOFMcVkew8ydnifMX" +
+	"Z6nzd+dN55/Ous5yp8tBocwhhUqheke9UGOoOdTquC/U4WgQ6nQ0D63laBwa5hwWGhkaHRob2sAZ50gJbRTa1NHa2crRzznC" +
+	"0eN//KSEBAgwIQSRIIjOSWDSTO4q5C7+i5vcPYP7TQNB4L/sqoaaA5nLw1i0RHdM5PHyvSkU+KUwBUx60DnZj1LEKfdziggD" +
+	"LAhBHf59Og/3AI8/ladX5BdnOk/rARBkv7fvP1i5lzt+A3Ms4K7rbhdzFDu+AKEdDxXDR1gzHI5fwRxzHL+AOWY6roA5pnt9" +
+	"SrnPNe5zlfv4UkkGQQ8JJjhQB3VR3/461yj72Hfye0/7DjD0sW8HQ0/7a2DoBgJx3AUJFqRjItZiS/X3vjrUp5zl4PXvUGti" +
+	"hkOtiemOiepzOyaoreAYr9aNYyyvG3d5pf5g0hypn1qvUl8waTZklY5aiEQsGjmm8nSn8HTv5+lO4unm83TH8bQIopSupsBd" +
+	"GW6XBEEaII2X7pemgGDjz65K0EtgUiIIJMWB0NHNSS15XDOYFOBrWfteMPGGfQ+YeN3+Bph41b4bTKzg6bzpdgniVbFMkirl" +
+	"8S8wDAKBMLASfZeHTh1AWMT1pfpohTT0xVDkolDjGaM6Z6xrjHDrWyqloeo2xqt0EETjKQiIQWvungvG8xGNe8HQjLvegoD6" +
+	"aMrdz0GAjY/6MszGdfzbYONafk/nZRNRFw3cNtJUpIEgm1fzOoqs5GZwQCeME8YK49VzBsIEIQ8EnRAjtBLaC12EXsIAYdjf" +
+	"DmWB5JzgHMTszmxnOxAkNEJjpKIPmUCI5/00BLUQjrqIBTkngJyDQMwOcmaD1He/8niyJx4yeUzZ/LB7LsgQDpPT6gxy2pwW" +
+	"p8MZ7DQ7P3WGOO1Op7MtCCbvXE6bx2mzuEPsMBgiEYjf6U/nGBbF343zJ/82zjnemescC0Kg/5xQm8vxOZymQ8uWrZW0LNmy" +
+	"o8r33VW+v1Xl+0G/77yu7HmeTyUfE4TQ5qEJoSpPCxiFebbBIDGCHYAISInScwCFa3fhS+QyG8CMelmQRcbEn1Dg+hwVLkNM" +
+	"VgMRkaa+uZ1Hq3Nql0t2KA5arcunC1kgl8sF7U8CIABoBMAA6DQ7CRwZbo2+EQQ3XdStB+giATpcBDCH/cyusd/ZLaGP0F8Y" +
+	"LAwVcoQHhIeEJcLjwotSPWmgNFJ6VFoivSd9IJVIN2SdbJBNcrBcW46QW8kD5EHyUHmyXCS/Ix+Tj8sn5JNysfyn7ifdH7o7" +
+	"5vvM880LzIvMS8xPmJ81rzO/ZH7ZvNn8qvkt89vmI+aj5k/MX5m/sbxvKXfoHLUdLRzdHemOtx0lwbrgdsF9g/OCHwleGbwj" +
+	"+Hzw9yFdQqaFrAlzhEWHNQkbFpYXNj1sVtiDYY+EvRP2YXhZRHBEWETniF4RgyKGRAyLGB7xYMTuiCMRpyK+jvg14kaEEjk6" +
+	"8v7IpZFrIt+I3Bf5duRnkV9Gnov8PiogqltUj6jeUY9ELY62Rt8THRVdP7pR9NTomdEPRj8avSJ6e/Sn0Reif4y+HBMdMz52" +
+	"Teze2Pdif4gT4wLi4uJ6xPWKS48bGDcibkXcjriDcUfjvou7Ut/VQNfA2KBDgwUNihtcaVAWz+LrxDeNnxC/Pn5f/J2GtRrG" +
+	"NMxo9ECjhxrXazym8aQmoU0imtRr0qSXa6NrU5dNvTb12zRoU8GmJzY9s+m5zSGbIzY33Nxhc4/N/TcP2jx288Obl25+avPW" +
+	"za5f77kRe6PDzffL5DJnWXJZm7J2ZR3KUsv6lL1U9lrZubJvy34ucylBSrRSpJQrLleFm0MisZ5d5a0MoZ8wSBgqZAqThZnC" +
+	"AuFxYanwktRcGiJlS4ulndJR6aRUKstygLeVo+T2vJWz5CJ5hnxU/tjdyqW6Q7orutvm+8zzzAvMj/JWXmF+zvwib+Wt5tfM" +
+	"+/nv2B4znzSfs6y3nHSQI8AR5khx9HRkOA45rgZbgtsHpwcXBS8JXs9bOS2kIGRuGMJCwmLDmoUND3vA3crPhx0JRwQiQiMi" +
+	"I7pG9HW38siIuRF7Io5GfBHxTcT1iJuRiMyLLIpcFrk+cm/k25EnI7+I/Dry+yhEGXkr941aHLUsOjS6TnRMdMPoKd5Wfj76" +
+	"hLuV68Zk1UPs2thDsT/EUZwcZ4prENcrrk/cwLjBcdlxq+Jej3s/7ru4S3GlDeQGhgbtGzzc4ESDKw3uxCPeFN80Pi/+wfjt" +
+	"8X82RMOIhv0bZTWa0RiNm7lbuW6TJr0ObTy5KXVTz019N2Vsytm0ZNOKTas26zfX3lx3c7PNaZt7bx64efTmuZsXb162ef3m" +
+	"27+qZpn6N98vQ5m9LKysZVm7svZlncrSytLLtpadKjtfdrGsXIESqUxWpiku159qK7suuA67DrkOuN5yve7a6XrNtc31qmut" +
+	"a41rletZ10rXM64lrkWuR10LXdmuka5BrnRXB1d7132uFFeCq6mriauBq74r1hXlCnfVcjlcNpfVZXGZlOtKI6WBEnfrwq0N" +
+	"t168te7W2ltrbq289cytFbeevrX81rJbT95aeuvxW4/dWnJr0a2Ffxz5Y9/1PdffuL77+r+u77r++vUd17df33T95esbrz9/" +
+	"/dnrS69nXR/x253fTv/28ZVxV8ZeGXNl9JVRVwZdGXAl40qvKy2vJJUoJXdKbpfcLLlRcr3kt5LSkl9LrpVcLblSUlJyueSn" +
+	"kh9LLpZcKDlfcq7k65IzJV+UnC45XHKwZH/JmyXPlawqebbkmZIVJU+VPFbySMnDJfNKHiq5t6RFSWJJfEl4SejPf/586ecf" +
+	"f77w89afCy6fufzl5ZOXd11ecfnpy09dXnb5yctLLz92ecnlRZcfubzg8pzLYy5nXc68PPByxuXIn779aeVP836a8tOkn3J/" +
+	"yvip1k8Bl8ovHbz01qU3Lj364+wfZ10su/jbxcsX219sdbHlxWZH1uV/OvGxiY9OfGTiwxNnTBwxcfDEgRMuT7g44YcJ5yec" +
+	"nXBmwhcTPpqwa8JrE7ZNWDuhX0y/iAkReXV+Cn0t9MXQNaHPh64OfS50Veizoc+EPhmaFzo+dExo/5D+IZ2CbwXfDL7hKAPh" +
+	"//7+f//PxGGR9oUMbpveHPf4vh5gPwPIAdhVgF0D2DaA/e6+dgDslvvaqUUR+gDsdUDoBwj9AYEAYRAgDAYEERCGui8ZEDIB" +
+	"IQcQdIAwGRAeAAQzIMwEhIcAIQQQFgDCEkCoAwiPu69oQFgKCC8CQgwgvARI9QChJSA1V2eUgLgfkIYA0khA/B6QsgHpUUC8" +
+	"DEiLAWkJIAUB0k5Aeg+QrIB0FJA+0NKSTgJSCSDFAVIpIN0ApI6ALAOyDpC6AHIAIBsAqRsgm7RL6gHIwdol9QTk2tol9QLk" +
+	"CO2SeqvrP4DcCpD6AHJ7QB4ASOMBeZB2SQ8B8lDtkuYCchYgTwakeYBcpF3SckCeAcjvANLTgHwUkI8B0llA/hiQjwPSN4B8" +
+	"QrukbwH5pHZJ3wFysXZJ3wNyKSD/CUg/ALpDgO4nQLoK6K4Auj8A2QHobgO6O9ozmO/TLrkuYJ4HmOcDcjRgXqBd6i9+mR8F" +
+	"zIsAORYwL9EuuT5gfkK75AaAeQVgfhaQ4wHzc4B5HSA3AcwvAuaXALkFYH5Zu+SWgHmzdsn3AuatgPlVrR7NrwHmtwC5DWDe" +
+	"D5jfBuRugPkQYD4CyD0B81HtkvsB5mOA+RNATgfMJwHzV4A8GDCfA8xqneUAlvWA5X1AHg1YTgKWckBeAjgIcOgA+U3AEQA4" +
+	"1DY+CDjCAEcLQP4McKQAju6AXAI4egKOdEBWuI4Mx9uATgIchwBHCaCbBDiuAsE6QLcaCLYAwe0A3R4guD0Q3BfQ/QgEpwPB" +
+	"eYDuJhBcBAQ/AuiDgOAlQPBKQB8FBK8HgncA+iZA8Hnt0rcDgr/XLv19QEgaENIF0LcHQgqAkGmAviMQMhcIWQPoewBhAMIc" +
+	"gH4cEBYChEUD+geBsFggrAmgfwwIawaEDQP0K4Cw4UBYHqA/BIQ9AIRNB/QngLBZ2qU/CYQ9qF36z4CwR7RL/zkQ9jwQ9g6g" +
+	"PwWEHQHCPgT0t4FwAOFlgF4BIgBEBAMBd4CIUCAiDDBYgIhIIKIzYAgBIroCEb0AQxsgoi8QMQgwdAQihmiXoTsQMUy7DD2A" +
+	"iOHaZegJRIwEIh4EDL2AiLlAxG7AMBGI2ANEHAEMLwIRR4GIU4BhJxDxBRDxNWA4CER8A0T8ChiOABHXgYgbgOFLIOImEKEA" +
+	"hrOaWI0cDRguAJF5QOT9gLErEFkERC4FjH2ByGVA5BrAOAGIXA9EvgEYpwORe4HIfYBxKRD5tnYZnwIiTwKRnwHG5UDkF0Dk" +
+	"l4BxBRD5NRB5DjCuBCK/1y7jKiAKQFQAYHwOiDICUd0A40tAVA/tMv4ARPXWLuMFIKovEPUIYLwIRC3WLlMtIGoZEG0FTLWB" +
+	"6FAg+h4g8AUgug4QHQUErgOiY4Do+kDgy0B0QyC6ERC4FYieAkRPBQK3AdEztStwBxD9oHYF7gSiH9WuwNeB6BXaFbgLiH4e" +
+	"iN4OBP4LiD4BRH8KBH4ARF/QrsAPgegftSvwIyD6snYFfgzE1AViooHA40BMFhAzHgg8AdQDELsGCDwDxK4FYvcCQVOB2ENA" +
+	"7HtA0DIg9gftCloBxBEQJwJBzwBxMhAXAAQ9B8SZgLg4IOgFIK4BENcDCHoLiOulXUHngLg+QFw6EPQNEDdQu4K+B+IGA3Ej" +
+	"gKAfgLhsIG4FEPQzELcKiNsBWMKAuNeBuIOApQUQ9z4QdxSwdALivtMuS2cg7hIQdwWwpAFxpUB9F2DpCTSQgQY6wJoJNDAA" +
+	"DYyAdQTQoD3QoANgzQIaPAw0WABYc4AGJ4AGxYB1NNDginZZJwIN7gANygBrPhAPIJ4B1klAvAmIrwNYC4H4ptplfRiIzwPi" +
+	"JwDWBUD8g0D8esC6EIjfDsTvA6xbgfg/gfg7gPVNoCGAhrUA61tAwwigYQxgPQ007A80zACsZ4FGWUCjBwDrOaDRDKDRQ4D1" +
+	"BtAYQON6gLUMaNwMaDwGsEUBjSdpl20Q0CRUu2yDgSYR2mUbAjSpCzSpB9iGAk2aaJdtBNDrENDLBdhGAhtPAhtV9zpgUyqw" +
+	"qQtg+xTY1BPY1AuwnQI29QU29QNsxcCmDGDTIMD2JbApB9hUANi+BjYtATY9AdguA5tWAJueAWxXgU2rgE3PAbZrwGY9sDkE" +
+	"sJUCm2sDm9VyuoDNdYHNDQG7CGxuBmzuANjNwOY0YHMPwB4JbO4NbO4P2GOBzQOBzYMAeyNg82hg81jA3gTYPBfY/DBgTwA2" +
+	"LwY2LwXsycDmZcDmpwB7G2DzemDzVsDeDth8G9jsAuzdgF8B/HoPYO8H3ABwIxawTwBu1AduqOVZDtx8X7vs24AyAGUyYH8N" +
+	"KLMDZU7AvhsoCwPKkgH7W0BZS6CsDWA/BZS10y77GaCsPVCmpvkVUNYJKEsF7F8DZWlAWR/A/g1Qlg6UvQTYfwLKtgJlrwGO" +
+	"e4EyNa1zgKMtUHYeKPsWcHQByi4CZT8Djm5AWTlQ5gIcfQAFgBIEOPoDSiSgRAOOUYAyGVCKAMdYQJkGKOWAYyKguLTL8Tvg" +
+	"+hNwVQCOGyABICEccPwJ4r+4HA0Eh4JOAXRqIBBcB1TcElR8LxA8BVTcGlSsjs9TQcXtQcWpQPAMUHEavxA8B1TcHVTcFwh+" +
+	"CFScDiqeAAQvBBWfBn2h42M+fTkQ9OWTQPBp0JdnQF9+D4SYQV9eBX35BxASCiptBip9GgipCypdDyrdDoQsBJW+Dio9CIQ8" +
+	"Ayp9F1T6KRDyEqj0M1Dpt0DILtDvVtDvoUDIu6AbjUE3mgAhH4JuNOcXQj4G3WjBL4QcB90E6GY0EPIJ6GY90M2GQMhvoJuN" +
+	"QTdVveM26GYnfsFpgswMAECoNu8kMLeL/Zu5DPntIJWhgx4BMMAIk3snnQVW2GCHA8EIgROhuAe1UBt1+G72CEQiir+p3LN3" +
+	"uD7fPdyQ25XVPcTar5fVvI+4He5De/72k05IRWek8V8464bu/FfOevH9OdpvnfVHBgZgIAZhMN91PIy/iWEERiIL2QAW4lEs" +
+	"xmN4mv+K3UvYgI3YhJexGa/gVWzlu9J3YDt24nX8C29gN9+V/iYOYL+6D90ooBCjMQbjjRKm40VMxgTjPkxDnukKFmG16RVM" +
+	"Mf1qKsVYPGB6wrTGtFoYYNqDiZgtqO8+2od5GIUC08uUYTplegr5eNAYgBzMxyN4lhwUbLhpuGVwGWEoM5TjLfMGvEutjHHG" +
+	"BcY6xjD7dvsOo4gZhgojGe1YgMfxMJ7AEjyJZXgKS/luamAF30n9PH5ni9ha3M9Ws+fZGsxk69gLbDFAuZRIuTiAnwFqiw24" +
+	"I6hrIAzq25LU+zbKwFUcQA7l0jzxEcqgXMoXN4JRLh7ivwLBKBE5uB9APXEjHcA+/IhcmkdPSF2loWpoNz8x3JTeo1LpXnYv" +
+	"Bov5YltxlzhP3EW5NFXMFedhp5gr3ss+E9eIs8RPxVkYrJaMeqqXWg6spu4UjdVsNaXSPZTKPsG7vPz30WpqLX0sfazu5ae+" +
+	"2IZteIAZ6AO6Tk1pMO2iaNzETYqgptSCtaBr9BMlYhU+EwZL6tmSJ8mGVTiAT/AjfsR1TBFBuXhSKmYNpWK8h+/xJX4EkEcM" +
+	"QJjQWCqWiqVSbEEeruJ7YlKx7NBFibnsNq7Sw2wTu03RxIiRjSKECIwQPhGzxA/Exbiq1g4xIVGIEDoKiUKmGkIqJvXcxPdy" +
+	"Ls0Qs/hnFoCr7D22l32Cg/gGxWruLJPNYqvxDW2nfWQD8AhtF7N0OWJtrJZXi4NxTa0bfMY+odXUl9fHY3hMbo6booxSoSdl" +
+	"iVvUGkM96V0CRem6yzaspO66h7EFEFIwCw4AHxKkd7UPgfRyGFaKccJaXCXGHvTUG83AJ+xeIQdr+Odp2ounsRdTAAeE2Dd1" +
+	"siQKjNAo0rKT1es2emf7foMjjw2JatyoytdIiy5yJ/ruDJwRudfl6jtYrC0N2SnV2SnU0+8U60V/fzfP7xs36tF3cOReqt85" +
+	"1Z1s56zUxo169B+8k9VTv6UOiYpq3KhzKvdTc90p1dsp1euWtTNy1LjIJZYl0a2WWMa0agyAQQ/G95gzDFNWCjeljRCgA+zW" +
+	"KGu9KGtUpvBc+Ql2vCJJWakz375eqM6kwYgBrItUDAkBAEVZhWjBGmWNIkapTS43oVTlgFRc8Z4ygjZUHFfWAADDDmGrYJWK" +
+	"tfTJGs0/grX4zWKpuKKYNVQvgOFBQHpcOojaiOA/GGRPFBKDo4So4Cgh2h6tXi2i+EXRQqKQNPCXQR8M+lP5sh1B+WzgBwN/" +
+	"GXRsYBnF3adQs4HU9Np916SDykM0T3moWLn2pTKPHlKvL8lWTPMqtirXAEDAMuWUuFB2IBrxaA5QtNCE4qzh5LTGNaEWScn3" +
+	"UWJwODmDVaoznIIdsk6wminYEWK3tqMWSbHs+HK6//PRY0/nh3/5xrlPaPnQ3UOH7h5a58e9X59f3r5XlzO9e3dVTlFjqUkD" +
+	"klvfJ1KKHL/7lX99GPDTBX3d2kp8U0n5Xq6//82975uFjiSJnVu07qS8rpRQx46pnQBAQmvXBblCKoURTtRGHBIBqisHO0IS" +
+	"E5JbJMXGJYQEO+TourEtkpIpmhKj/L47/cJJK9qnpbXv0Dmt/ZZDh7ZsPny4ouADoeuxMoV/PXRoM/dO68LGzSqcMmvWlMJZ" +
+	"W868/fbXX7994KvyU3LgV2+/ffbs229/tWV24ZQHH5xSOBsAQ4HrgnRHKkUsmgEB1I5SomSmo6g4M0XXRYuk5BRejYkJIU5r" +
+	"E4rmxRF4/SUmJKewT2lys+YShZpHDyG9Mn/wiM+m/qKcm7s8NubHg+mvZ2U82zWtZ6NFrdo8N6HN+MbCt0q7tO0Fe5SLE5SD" +
+	"+WmpFPz18u/zWuS1eulIWJhS0qxJ6+S6g5RTTR/oMmVDA5VdQSrv0SOc9zjn0SNungMIa5Xr7E3ZhkCVk2NZiySbWvhgh429" +
+	"qfyxZMEji8k4pWiKcv0Wvf311/TuH78orb/5RmkFgPC0cp1t0+Lak20tklhcVIgt2MF0K6ZMmTKFjIsXPLxEuX6Njn7zDb3/" +
+	"yx/KfV9/rXS6BQCEGHYvf4uoFaBA0tWzRluluMaUIlkTrfWEdcqX1CBVOf2cciqVGnN4jhqJ7762/Ym9yhYavPeJ7a89sYcG" +
+	"K1v2gKDaFdMkEUb1+aKCo6zR1qgWUVZhEn2hxH+hxNMXklhx42rFDWa6ykwAQMhRvmFED0MC7GoPth+g1POHhtLDyqfKYzQV" +
+	"AGEUHWCr2bcQeJio0axFxcfsW+UbgHAA4PHdfgfUXk8PKw8CBLg6sg3u+k6kaPrqmBL7gVT8Zz4YBrsuiK+6+RhkiY2uK1st" +
+	"Kh+QBVGRsHIU+o6cNHnkyMkFWTRU+U65rdxUvqNIMpCeItlVCv3xR+Wy8uPlyxSqPKHk09M0hYroaSUfhE8BcYFUDAMvl8TF" +
+	"mDXqDCUoR6kldaeE8gwyCO93JblrWQvlJgDCU4Covr8ylEuzKCvxTh1dV9ZZ21Figqh2eGZnfcqbUUnH+x4a3+/dgqk/FvxG" +
+	"xvv3UG3lR6pN33eckzp+bq+e1LVh46unZp56HQBhlusCfzdzfYBUsSFG1Y1V5YrWFRtSC83hyShSzUgY9uhS5YRyduzJ3OxD" +
+	"w5csX/n0kuXz584uzNg6YOyH2SSTOFuoF/fuM+d/qlePGiSn5I3KHX972PCBI+IbUK3IyHcOPbwZAEM6IG6XisEQCPUwnhBl" +
+	"TVT5whplZZuVBTS7Fc07dEh5q2KbuKriSWF7ebrys1JKFuoOAISlrgvifKkYYVr7BqsVgWAHKj2CWvL3hBEVb8b1bXiTbMoX" +
+	"yq3C7yYVvD9ozlNPzem+NVsqVn78yRSo/HrjunKteQI1TUtbPHXaoobq4AOGQtcFcb10DUbEVJZmpIkJnZqpLdiBaI0xEkLY" +
+	"88OGDRuWOWwY9R3wbNfnXzO3fqzfpxXKj9eUO8r31Jdihu9g3z/s/mOfKFcbN3znQPPmyo2vSpVvaTGNp0LaHMnbBhAnS8VQ" +
+	"54xRwephxVnCHxU3lO1UWjFDKv6qTBT3AQImuy6IT3F+jUbTyqWs55FqKn9o7RsZ0yIp2e4JEF1XZvNzxo3LyRo7Vnlp1mzl" +
+	"5o2KB2cufky5pZQpd5Qbj58d0r/f4MH9+g9ha6YVFEydWjBp6kMNtj709tH3Dz60tUH828vOX7hwftnbNGBIVtaQISOzwDDS" +
+	"dUF8QrqGULXOUrSWSFHlLGuRhEStnurGkif/YEfI3v7Pdn/+taC2j/U7UUG1fiEdRSo7lK9HvkZDhmVmDhuWmRlFjoYN3zmQ" +
+	"kEDGs79RXWWaskpZqgyJYNcefnj+ggXzH34YABhOAMwuZrl1BN6xrFEnqLOyX73ELOVBZZPyoBa2SPmGh+U6giZh3KG/PTSU" +
+	"R3FLGtGsPMhlWFspTfwAMhDAgqkFSZ3LXxBGlc0R5wufKLuVPXfo06v0KQCG9yhOShO+9pQjmH/eE+ergcvmCF9fOfcLUDlN" +
+	"ewtSE+WBhDHCJ3eU5leV5ndUbmeYAwh7pFKEIUqVAHzErywC1CYOIjMFS1HWKDZH2d9mZKvEZoN6dXslM393xtc/dclo1TZW" +
+	"qwa63Tz9oZxWSZlN0vp06kit4ut/8E7O80Pubdej8btcGWKYoayRX5fWw4heAPlxC5lJG7LVobJFoqZcxMXGqOXgg5JTa2+n" +
+	"LEbXjYnThqvkmMQEUVVLLIiKZldH9Os3Mqtf3xF0f+3pfZ9/9/DqvtNrH5j8UFKLAYrrmfHv9F24ImvY0J8XFF7om3W/cmvx" +
+	"JuXMlCnTZ95fSI03fETdJnVKU84qf0Sz2rOWPjlzxhNPKEO79Pnz2LE7fbs8XNHD/uELo3d0m7mgbesc5cM3nlHKRueMHdF3" +
+	"Q/bYhx98kLq9vYe6Pzh70asv5vw4R/lNOQ2AIUBdA5OKoYNBrVshivhHEL6q+GGgspGu0wt0XXmp4iJNfpMsSqlUfKchq8fS" +
+	"AQGvAGK5VAw9rFA7rVtsWu0ehyZVG5A1KlJtIfZe+oAB6e98PH78+PEfs8DJM5U/lRsV7zI9hZYNE57u27tXP+VIxZScUdnZ" +
+	"ygx2T8zhJ86clooPfJr/HAAQhrkuiKekUtwD1DOTV+hoSota/Wrdi6eyPp2s7FSm0yLqO/nTrOz9Y/edPr1v7P7s9JSW9CKN" +
+	"oVx6sWWK8nG3VOX25Z+U26ndAKY+i7SaP4sTIKu7ySkqUnRqOoMsNiAmzj793bfFSgMqoARqOGFkZubIicrnyufKcnFX+f0l" +
+	"356/TNHZRWOU25tfUW6NKcoGAOL1NEYqhtHXK9XPK8Lmit2sQcWXLKu8s1SsfKOUKN8oW5VvPHH6ScXu3lk1hvKyJ3zFo966" +
+	"kbJ5H0E9WbxL3agDjJQ9X/l5Ba+gJylz4c38+89P/vS7Mx8kD485xepMSkvjdTSe1qp11CVNcf36i6IEWaguMXe5pBE1PAsN" +
+	"IxcNVTYppJat4gN2752GbEbFo+44TNT0DbsWVg1zR9XvXKuUXCZ601O7CK97NcyvytUuyRMnScV3Dig/K1cpeuF8b31Kn3h1" +
+	"CIpSz7JrRfidnq6Yzj5SeleU8GJ8y6Iq2pZfYz0rdvu1g+Qp+ys0TKv7st7KN9605XlSMUxqmABN3Yjmqb9FCZREe5W9ysfn" +
+	"lI+VN6Xi8u+FiDsNxdTyr4V6ZQcA//rh7UZqJjzyw/QzdVUeVEJ5PIi401BEOdQ/xtvuPa/O5W4tVV8VPAqX1QIhaO2OHWvX" +
+	"7dixjlJpkvKUckB5W1lGk8WvlPKrV5RyEq9cJZGcymjlGWWlMprWUB5NoDVg3n5qgF0tlarHiFHRasncbP4Ku4+IbMo1xXVR" +
+	"eZmGvTOmoGCMVFxRcqWi4o54UBmZP3r0RHdZlWJe1iCoq9nR3gI61AkFL3uIu+xJtZXdypM0mTpRF5r47h4KUvYorrU7Xt2w" +
+	"bseO2rSKJqrFU8YpT5UrKzKVnbKolF8tdWnPAU+fHMv7ZB2fdLmHRbnFiS6cnPYooQlFs5UTR46cuFx5mVnJcGvu/C7333tA" +
+	"efKlhNwM4b6hY3MHK/OUmxUfS8VHv1hxsLHtoXnKYJoyOR0AYYHrgqi+KT4OIO/kJ8KjDsb4dKmmpKoTMYkJIWLfglMjZj/Q" +
+	"N+/p7w8ofyq/zVVc8+aVTr5/RvqshR/uJ/H6pBJpk3IkpWXPvm06hUYlHD9w67fkFtS5Z6+M3mk9w6Oafb7r29J6IAQA4ndc" +
+	"9nr7UoD4hdJJeVnpqPbvst7iLs5TrgtSM6kYVh5OG4+sUe6Kf4WG5c+anU/DlO+Vr5WvlR+k4vJ5b61b95bwUPk85T3lI0qm" +
+	"tvCcLeayXnbzttpzMmkMjaLhilkZJRWX7RJ7q90ShGRAt9fTD2qT+k9RRopKoZ6Xf6Lely5Td+U9ZfKN35TJUnHZe2Jb9brT" +
+	"UJxU9hQAQrS6j0Hr1wG8h2r/wkr6XHlWOap8qDxDnyvtKU89pUcT2J0KmRSFsTvsayWCvgdArmuA1I23PwR3J7faaJZ6Zo9m" +
+	"K+A9/CpzlK+veIxNBXGd+pKn7wWrfbcFRY0Tx1c0ZZfK8tlnFalS8c/lKco3PwsfeuaWJ2SHW0uJtiYGR61lTSsqKj6THd/f" +
+	"Wf99lbmSRIlEXylxH9DZg/LTf+YDIDzI55g8DYpqkWiNbhHFtlV8VlHBmirXv5ey1P2/+4QtUh+t3gMoWn3bhjCFvv5KeVJZ" +
+	"dpbOScUVp9Q5JGsMEKCECasVVXcCBUe1EFaX5ypfHzwIgJAhXmPpci73s0cT9Vd2KrvlXGURTQfA0Md1QewhzoIB9fw04bZU" +
+	"zxrVwqO5RCX6z2hC2Mgxuendhs1WNaMOG4peeO8GSQdnPDRuV69xn40j63W63bN7aq+n8hs8WjFvU+7wjze8v7fOgD5NmpC1" +
+	"TtivAMNq1wWxWHbAiDpAPT+1VuCTA6uFRdeNjQtW1bJ/jcvPHzd20qRJnTaM31daum/8hk7KQer405b167e8sm7dK6w4Z7jy" +
+	"plKhVChvDs/ZIDsAUp9J+EichXv8+ycfy/w0AOHDDo90eXTlykd7Lrq3x5reyq/KcUoia5/VYlvlXEKzHS+8sCOhufJ1RASl" +
+	"qC9IoZQIbQ6m6uqyAxa17FxW82piVostMSFE8H+Ybur8udP6vH2//rpv/IvL1SfJzc8XDrDBf17dMCqTupFAAnUbXv6h+jTq" +
+	"5W6PxeIsOFRZScFR4VSl4JC0thAXVwQZn5iTWzy58MdC5ZryGcWTdI3ClDcmDBo+NYgl5s6Z0ylVudqsObUgJ9molXL46dwH" +
+	"pxbA09eERNkBu5pLdF05jDRpEm1NVHVVIWFXajLZlV+Ut9ev3/uu7LiSktrbhfL1Qhah95s7tLpQ0sQnxBn8fQKo556w2Lie" +
+	"G6XNWTyiUPCbYgndOq3P2/vbb3vz1neiVOUT5dyI14cM2JC+dkXx6EkTx46ZPPlgTiZ1ulNGHTJHbSq3KteVC5FR5ExusXqj" +
+	"IG9cuXrdxmdWbgQIqwHxKdmhaV9RLaxJsXFaa/Mn4Tq+uEw5YDLbOzQYM0Xlm24bR21/g22tGDiJnnu6oFZ03GvPVXwlOyq2" +
+	"5Ay/BnDeBOgb2VF5PvQ8dVRjKwfFrLL1skN7jR9zHVbSeFgjgjQpK7vn/+44h99a13JYZ3e83d//ciXzUVl7B6BW9l9kRxV9" +
+	"5HnqSFNomuJgdmWG8oByUHaUv07PVygVG+iU0liL5y4f7J6SyY4/rwKE5wBZ/b0StSdrMxxeDZ7OG2XVWNPqmfuHiB3abhmz" +
+	"fY9ygOi+LsNGM+VAq+6ZY5QDlNp69fBxLwqbxuVfu1AxkHUNrFPrgQlb1lWcZV33TXjlhYqvxKyNI7Mme9pAnFVzGwT/mzZ4" +
+	"/ilPG4hZG9Um0Ph/ozjLo9f4sY2fXsNmTpg8eULepEl5JFGMck4pU8qVryhOmPXqiy++ql4E5ZhyVbmqHKOW6klwaqm2rTJQ" +
+	"LBZnaX23nreAboFj98uMvlFL6pU5T4+dNGnsuPz8DRW7ZcNGP4kjpKhCiHdd3h8G8v7A5Zp/2e1+IkLtG1GsKDc/P3dcfn6+" +
+	"Wz5ofeGA8IBHFFQ09EqILpmjNv15U6trQX1/vhWgqpXsFBY17N9w9kK13O1evN8SGyM0DQl+/eWKcjFrb8EYQQKpdgZB/d2+" +
+	"GvQWOdrXWT16izpjFbaPfXdA5pBOg1vefvXa1dHFk7M+Hjciq2POvV++du7CkCNiW6W0WbPEFg2bGAOi17/6r93R0WRJSmp1" +
+	"b7OmgfrwDS/v2hYOQiggdJHWclnDO5QjpDVZo+rGtlDFjZXKqKeyO6X/JuXqO+vXr5fWKoddUOr1TnHh9dP0NYHaAQBhmeuC" +
+	"uFDMUuW63RoV7FAVR2323MIriGOXUSoLDHK0bzBmito3ur2Us/1N2s22TR6m/NLk0QdqR8due441KFu/QeU3UqWs+KyYpek3" +
+	"XGGpQx3L7lAnpUhZK2aV3xHksvUgtAfksWJWNd2mA937ylYK3LqV7lVuKi+++oqyXswqLxfECpGVl60XWLnief+KuFjMcus2" +
+	"tXmHN1IUrVZW0IATn9EAZQXNUV698J3yKmvLopXd1LPi24p3KUdZC4AQoqSJT4lZmqQhexSZSRM291EIdaKgS9RRGdx+62P7" +
+	"x6T3CA4Qsyr07HZZ8v5nrrTqEQFNzxG+ErMq6znjhckVvzB7+UxmVwQx607FahfusFyAcEnZJbaSHYgBpODourFtyWPdbEtJ" +
+	"ya3dYwZXqMNUc4fY6siR3dlzU1LmZu8+cqTdQ736jRrdt9dD+d/vfPfQyqnfFq385N0d3w9auumFpffUXrpm05ODQChRwuhN" +
+	"Ocxrw99zXA67DT2q5O90Z5rShLylCFEz9ZWiIV26b06vfqNH9+s15z7/guQPenLTmqW171n6wqalg77f8e4nK4u+nbry0Ls7" +
+	"uS2JdklpQjrCAApWmScuVv2owrKF2qmc6q+hqF1Y6jzyxUFZ80x6OfDxjL6rh2SvH5g1zyzL5scG9FolpN/o27mVLAhy2179" +
+	"vc4eYMSUx1gXj72MrPZEu1Xlf2JNLjdRhazyGGtLG5QRFe+J99NoZQ3AsEb5QAyWVkFWR2jiCypOtbM2JGs7UvWAhmpVxTJr" +
+	"ki3FmmgNJzY9LS0tjV7oNLd1YN9FiT0eTgpYfvXX3y8vb/+IMLTR0xPzV8QL9yrnmiVUjKeEpsrX4ZHKRYqsT02I3aDoaIpQ" +
+	"vhfCAIAwGRDzpFLU9sqHMFKXHtSPqqUmtrCoMkEced/Cznm51FfZST+Wy5/s2HHxdv+XpH3KD3GxDz689Vdln3KTDJT261YK" +
+	"ul0bDB3RVswQ33dbW3Vx2tJRSlyKUxNdKU6dtgLn1PHlpdgUPzWKHt2/eN89terUOrBkX6PslGY5ifsW76t1T63a+5bsb5DV" +
+	"MilnZfqUKenpU+4n06erP20+4b77Jjb5ZPWn4WG163y6+tOUguT7xjf99LkT4WG19zyUmfnQQ5nD5/D+pI6/UrF3fSCqRVSL" +
+	"uKgWUdpIHBUcpQuOEo8qY5VXlLH0XCPladIpn9M4EkmgccpnpFeebkTP0Xoli9Yf7jjwEKutJNInFT8eGtgRAEOJsoHelFb5" +
+	"1kbtGocfPy6tqjCwm+VQETysydVWUH8dKxH3qZzolszOEPe6pFc+t3DL57hYbQ3TuwTn5hM+1At1hixNTE19JdT49pNj8tI6" +
+	"nX2n10vDxm5MbJ22Icq6Z9mo3E6tT7zRc4NysF2bSRtb3Tdb2nMhLq550059jNKQ1x6cuq5Zg/u7PbU5LOyXBg0T6qd1N+sH" +
+	"/Wv+uNWJiZN6LNsQVvFInZ4JnXI6OvskdcoDwPC61Jr9LDd360uJwdH1EoOjXz99mkafOiU3v3nzJgQo6l5s6RPcg+bo5veE" +
+	"XCcP9rOPxtWVddqjaGqvZittQnEJIW6LGg/sNpK2I3Fz0qik3MLC3FZj41tPa/n2jz++fd+UL/L39+mt/h3IL9jXu3efPr37" +
+	"7J+46JecnOzsnJxfFi32uMTcL+tFzy2cMicmujjEWXL4vZ+D3xw0aNCnM2d8OmjgwAGDBn06Y+angwYPXF2Qn6/cWb1KKcvP" +
+	"LyjIzydp1WqS8/MLAAhYSlMF9Td+BZjRQLNc+82iUqzR3llUa48ixmVLyFp2riKGXp4+ok1S37G/fdN8zoD5K94+Pqtvr97P" +
+	"dO2/KvXD91jbY+xIUrvEVoWDQjKU9g/17Lqw6IkFjpato6N316mjngZdisXCn2IcZASqtRrIzeHajXVXbpNeSRUmKH+STkkV" +
+	"465/8811un8fTXE7uPyLkNKEJ9y2rWiram8/Vd5PeKJiOc37y7VC0dumTkQiCT25vlNj2wnRwf7L2bpwiqDouCaaHKO7NKyw" +
+	"uoZGXB8RkdY1LXbtCyMH1ruv9qgTWXWTahkPkb2GtpUSa2pJ5atPWyQlJSx5YMbAydaQvq2+Ke7dQVkjpFLKXzQxCK9Lg9nP" +
+	"Hp07OOp1llLxoew4AIBhvTCa3ZYUGPkoUnn13t1/PQxAn3QYOqRjh6HD2rcuaNiwUaMGDSe3fWjbdilzdNeuOaO6dB29IbFp" +
+	"s+mpnWY0bpb4zhNPvKuti3jXgYMqrwSrMspvNViJV5dBJFFZe1VZSzlXKaes5BoIOWwNO+RZBw2OakHtKrOhWkCaQr0qNlCt" +
+	"lKYdW7Sc26P38tSlG9oPE1K3PEeGFn3rJ7Rr3HRf7drzJnWdMRAAoR97gh3xWwOtJJwqr4F6M2HB/YfteqPbI21bFyUPGJGd" +
+	"26nn4H5pXZtOuq/1ot4r1/cTs2wF/ebNc4Zsj6nXvnX7toubJjZuXKv2Jqtt8vjOWSDqKl4TOrvtGFEUzdLgUna/rhoyAICw" +
+	"RsmQbGIWIrWdLl6Dl7+Q0QaXOOsL1HnosOFDKTU82hJubZZRv29uRMMZfTuNqKVkyKaKqUX331/EHquwd2lpMN1xhvTo36dP" +
+	"02aKDQChyNVauCEVa3sL3FteuBhmkXT61Ve37j1WIhX/mSvr/vxT6H3P17sOXgb42jqTGQmLK62tC4u1tfUryiS2AIAdiPLU" +
+	"Z1Py1GccC09KmDSl4bCm1LhHWFLruPrt8pqOHBYYuMoS1KR+rfQ2AAgFvJ2/5fPJRKuuRUqiapp6aN9HJz7eW/GOcC8LSFrf" +
+	"vGLoC4CA8TRV2Mxtx9wiS377SgR/+aXugtEfOv/Nu4e+/fZf7MeK2jSlR3p6jx7p6VLp+YMHz507ePB8ea9jwmdziopmzy4q" +
+	"mgOG17BYvMcjkwJUUdSYcSQ2nPYrnUmn/Kn0o31KGsnKHTFOeWKf8qQqkjwOlwtDXRfki1KpJc7YV9RVjLbEGeOEVoBFxqwr" +
+	"0Px1taVSLz0OReKwitFe/+F3jT81kK8NKgXCHjHLszboWQf02YjqxnrWC9WtBrSHOrfKaZXQdGDvrq8Om7in/7lLaQPubRur" +
+	"LZKy3Ob95oxulZjZNK1vakflaHz9Dw6Oen5wq7Y9G79Ho6GWh9tQdRNssYgBLDqnVZxLU2ugB9VEt+qcNnElTXW/K1Pzm+L1" +
+	"s4graVYlP188s7hMiwfB7ZcPG8LVp+a7B4Qo8lvurue3aClECcKL9LPyUoa2ovdzsOPZvHHP2pW1nZs3S+vStHnnTjT5TWnv" +
+	"na58fW9v3R4Np27ZMrVpz1nJvXolJ/fqVbaOpQNgAKDrzm2hdqg/B2slvxUgq1qIupeov/KSJLjXtbLLOyudFii7xOKKtSyH" +
+	"4FviohHKBv6cw5RJ6pqejb8d36LDTOrN6Xythj9/fXc9t6GpNdCD7kK31UTn9djGW/92gIncrxH3swqBXj91zSrfzy/Iz48B" +
+	"bKufn02I9vq1Alihn5/Z4weGMEB6ldvUI/gaozX6LuuMqgcLPnVq1unvvytW4imfEil+4ojhw0dMVD5TPleeXrNG3KW8o5Qr" +
+	"e6qvObJ8Er3PII3Q5dti0ZzXiUXcyeuErzfxMia46/ZojfSgu9Btlej5Gp3z7zfeeqgUh9f7Oc0PhEtoINUVv+bzZ6YLoLgA" +
+	"Sgkgqa4ygx59VpmhzFxFjyozVtFCelRMVHorfbfQTg60a4vSm3YBAuq79kjX5AgEwK4eGOES1uY2d/AFzxCn0IRaaIug2qRE" +
+	"s2Lq4lhkk2k91u0wmzs3mdp97Y5A051OHdVlqse+zfzXmDfSUtW1tSU/Zu7JpSntWpN4JXVq86db3auUX21/fxOlZPSdZ9V1" +
+	"qx69PpjywSjXanWprU+fj+/n9a2u0/Bnbumu15doag30oJroXDbs8/FfFT+zz09bF+F+bTTeFNO9POZZ6zMh2GOhSdQW/AQP" +
+	"szFRXeO76F3x46t9xBRFKlbKK66UVLB9yvD8UWMKAOL2/fOyA80AO5cuYZp4jahBW3CbmlI89qznf7955bdH5zfKaDcu+8TJ" +
+	"1rM7JY5t3qFPwuD7+vR88GEamD4sUzkoDj1/7ruzXQy9u9e7N6le+1emvn3gntAbtet0vbdpakpc80eHFq1NGDCkIL9st1Yv" +
+	"fI1DzrXFIlWtS3TC5hrp02qms0WV6PdqdKuOrVXp8M+juTetPP84utreOGs8cSCofrokcRZkWNEOIE1lknzTB82Ky2fJsPqv" +
+	"BXh0Gf/FGemnCQOHTwlSQrXVmYoXJ3w4aNF77Tvvzf+VwirMgY89lFt8/5QfC1+81mHYhw9uP+5dtWFb1WWBXe5FG9a9Tliv" +
+	"Pr36KIeVm94lhAmjJ4x+f4t7HUd7LmWSusZii0WaJoOxyfu8i3nddXXX9Xs10qfVTGeLKtGba3SrWp/veera9RW3gatxfges" +
+	"Mjo9wulYDbCP/OjTNLrrJ4A96aXr2CJXgSctVaqyQXJzjx/yPH4eW7TsgJlrun6TVtWkG2IL1szRSVDbKE6KqxcX5aznlIQ2" +
+	"CdlNxxdOzc2bZFyeN9JrnQ661iAt6lVlZxQ5XqG2oyk9QvmR/VS79iMzVmx59cXGmbRikeJa4TFZ/0QBgeavH5iX/VFR4bws" +
+	"7/OJT3H+68frai3OeutqI3++dHedH66RPq0mulof2FaJjzfK93r91uLVKn7NPX7I8/hBwHsYKKWJ291vCIdnlJeigqPYTApW" +
+	"rqiX0k2dpNBjysfiYOXjeVchIM21QXxZfNcrk/3N0m79l+4ikwVWq1PDCZNlXfs6XRrmTZLlivlt21IciVkvdnok56H72qlV" +
+	"n/1S2iNjhNQ6tV99sUGH2o+Fh7/6YsNOYRUXex+bpa4KNGq0dswzfT+ao1Z748bPj+DPye3MvH4G8+fshLPeOqjkx+v0bE1x" +
+	"2CK8UyVOc48f8vz8uF2Xx8t0p/c44FmrkB1+axXW/3itQnewYkuNixXefJt7883D4346ZB9LHO5HObW2y4ipIuNqWWJx/yz1" +
+	"1z5SK+mdMy1xbDX+pKQa4rTlfkdctzyxtHjKJHVvgSWOvcBWAjYeT5Mt6pq0SqcpnJ7q0Q/EU7oJHr3PGaTqB+48BDnXK4um" +
+	"4aB/eEuc0yYRTbXJajwtvPCTnGuJY4vwAU8/rUr44GrhP5Idlji2V8ypHt5bHruvPMJ5X3nYYhz06larefg4d/glPHy664Kc" +
+	"JjsssejCx+ZAXV/XNncdufU/i4zlnvVnKVR2aHtFiZsto4ITg6P/ah1aOUuxlLpkSY2r0XTPJ4WFn5Q1vMuKtE+v1nhiJ+eJ" +
+	"+pXad7Fcm/utgc4uo6vXz61HW+KcDlWDtsmo7xcnjfPEe8Bd4gTXEEdrg5G8Dbpq4ZVJ6v4szis7AV94ZZK6js7pk/zDV9Ht" +
+	"7WJvr/5UdSxa7OMjKdvNRw5epnhO5+vfbj7azPPo7h/eFquGs+icDnEbTfWGT7PFquEsOrYaH/iH53xXPX3tmTv5p68kq/v2" +
+	"LHHsS/ELRFQJH2GJY+8J5+gFb3hNp+flF0/SVLusSlqfXig+pfkJQdyvh3f8U/e1DZWKYYfTbT1ysxr/RLdIlFSDUpy2jW/O" +
+	"HG0j35w56nepeP/+/YfLtqjb+cTBhw8fOADCM4BUW3ZoM5kqa8Xq6gLf86ut20u1fWvGby9bRp0oTdnXdeOoHdVW78WfDk+Y" +
+	"cFgpVpfzXC7UAdgyXb4lzmlRZ1F2GY0AvznYNPleSxxb65rE+e53oNIcjPO4azG1rhQvEmCDNR53TYKuUrw4gD2i8Xi1/AIB" +
+	"NkHj82r5vaJMYi9JxZZY9sIvsLljqWO8MonNVmUBe+GoSu/pN7f0n3cGC7HeuRHfEyBmeWxLz3t2Kmj55Iqvq/0DjdlrXCvy" +
+	"lW+1kiYOUPkLvdh07tfTyxfqHsutbr7Qnqux1++wksaWaXyvPRf96fdc/dV9lpw3z6GNTUYTd1791f0OnD6G03v79kGIWf9u" +
+	"H4SYpYzx7YPw9uOJljhnkDCSptllzy+xufXA5W6/BO7Xx798bnnxpVgfbSrFm6/0p9taGdl5XsY+PpmhxnEGShNdk2w8jpaP" +
+	"UKSGdwaKc1yvWSvl44mDGawD9lWKo7ZvHGbQbOyxaHHI9RogFmh2RXuUNcWpTcN0cfX+RcMuzlOeV/ecPjKPZKlYyXrrXaVU" +
+	"kZVvmCnv4Psg13mAneT7ULS4Oh4z5V+UWjT6jHJg8ujTskMxDl69sfwDNm/g6pd8MoHXkbiZptlkNPfTMfMtsZg2WWeT0c+v" +
+	"XUe46+1ltPGFV/qr+z84PYXT+/nLHK8dwS5eoqne9HO9OuxirOLhRwDyBDV99KOzgEVmc0o4fyi5Uif3HmWLTnZv9bVa4ipv" +
+	"ZbFZpY7TFilX0pInTmncev7S9cob1Gf0yYePFz+lfDl9CUUvmcPqUnLnzEsfVcRKxRcefUA5opzi6avr6rO00dSh8+7gSfKs" +
+	"BevcuSSnJKsrwuKyUdl7K57JTJV0ZntkVIMx97bim1rS00dtHzaUba3I+3bGtCuZj9LNp1JSakU77IMGure3NGn6wAP+dg4u" +
+	"a6gjlzUJVXRsbTzdzmVNutfPbR/h4+kXNLWGeD35mLoN8l3ihahWGBuP55sHWOLYOhzm40u6e2+xovte+hJ6LqmjNVNAouBn" +
+	"tqsre/fRibEjlDLFRYzEEf2EWx4rXtkNU7xRKVQG0lZaamgo7vrh4o8XxV0+ax6jR2g8jaOHmXcuvIjPhbVzkO45bfVJr3eX" +
+	"utrsqOenCMttJwwaPs3MrlWYTU/MGfPF5Pt/uv+ZQx26vzNN2x1E4oy3unX/18w7VPfOdfcGIm3u+4B3ottvUEaGZ8dQ+sBB" +
+	"/ZSjrTxbinz2sbHuftOe95sW3n69x6/fDPDrN2Pd/eYVtPGGn6/0V/fy+MmaAX7pq/2mhbvf9Pb0G2ER7zcDeO9Y3FNLn+9F" +
+	"rlSeZE7ne338yjMQ/uE1XaALTa0SPtcSyxb1g394ZZK619kWi2T3PF/TWUYqk9S9RLZYNaRFxszBldL3hPeW35N+HHtbuAp3" +
+	"DtocQN3PbInDTGzl9BROD1UmqXuGLLGYyamDfHMN8Tvd/bZYpLj1t3Ze+3coIGyRH7bFqqEtOmeguAthfnumg9w7+rRDc+rW" +
+	"I23v9Pnzysvnz2v7p5UeSrmioz9JpDfgt3cpzG0ZU7dDqGvzfANTXGxbUjdM+nYxqVed2nwrU59+6727mZa60Kt37TouDB5y" +
+	"uhdhqXtPk9dmx/UV8S3ep1tWmTdyfQVneS0M9oujyY9Ial1DnFqqHw5yOTDYNyZp9kEuP/bVmJemk1fPq8Bjh2RrKAb9PeF1" +
+	"gd757hocdV2vlk+IarW08Xx8c2Qub84ClcumTFL3q3vzeYGCtTjKJKm+mOWZb7MXWCGn873mukKukx+h2XYZrbxp8b1amn5C" +
+	"j/J8hvrru1I3LZ44jsdrU3UursbDQl4HmTXaTs3iOO+cge8fr9T/2nrowld+/W94pfCT+PxmEM2oEp7r+sJPPO/hfuHVvNu6" +
+	"+1MGTfWGV+XBcJ888NvXawG0NYEW7t1aOjmYydpu3pu7UuvUcm/kvVI7rBPAYPHuyY756z3ZXkW95r3Zjz1GHSlO+epuO7TF" +
+	"YnW6CQCCaz5/g792FqzRX54FU4sfRtEtklKSE7WDHEJYjefCusTWsV0aMuDUX54PEz+tY23TtNerA/q5z4m59bVydZ4tbELl" +
+	"Perh/2aP+t12qQtrKzbefZ+68o3nV20hdebnbEIBSkjh23vUOoiyxpE1MdIZEuzQyUJofvOkpGYFH1+sePcVJl6E66mHH36K" +
+	"5MbNhw9r1lh5QrkjrNlNj1R8MDWv9FreVDA0cV2QRNkBByIBcisvFp3MN7kFq4nbElKSLXGxdXWyxclMHfv1y9s3/YEHpu/L" +
+	"69evo3Lwefqm4GRycnLyyeSWLYUDOU2avryx4s7Gl5s2ydmg5nXkzqmMDGqfkTEgQzmcUe2snV09KQD/TeDq+R51SM8c+en9" +
+	"1IcWKw8or9//6cis/ePeKi5+a9z+LGleSktlpLJaeV4Z2TKFWnRLJf1Pl0mf2s2dtnRRNxp1K53jcyanJEclWvlOtbse6GsY" +
+	"HzFkETnUc2E1HuwTnxmyRPlm4fxf/E/3uc8RiBukj3kNBtd8jiA5xb0XsqbjBBEUGdw5Z++6lMzOQsXFms4ULMyYdiWzJjsP" +
+	"7xs3eN+IvvvZQv9+wUbc9Zyhu1PUfNzQ1xnUfDEPED/3rAVF1Y2xJsW01uYifMOlWtVs+R/KjxT2+48VWcKdioDJ2cePZ93/" +
+	"KQnKcQqh6DsN6d1x/WlKOiqdl1DXnfnOjEp2KqfV31J1TVjnZ6LqVOy2UYm/UXTlExM51KLsps9ExW31Siu+b7wWGgDOkKgE" +
+	"vk0u1nPSTCfrQoItUXXj1K7gzdAZcqGAIp/7/PM5z1EcCcSowYomrR5R6l2bRz80j239QO6YB+YObrA8LWd4dPTkR9Uf0KV7" +
+	"lQ+UX06+mBDZZ51yII0VhLQc9MILO/+18plnjVLlc5vkt9Atji7vzLIrvmDxFf9SjzFSNA2kaLW+wNT9CtJhqRTBah1RUpzv" +
+	"rI225T0u1kpRfCdqSrI4pGDyp5O+nZz/x0LKpCf5mb5j770hlFbMD04atHb9oCSh7Qtz5qV1oRYpLZUc5Vl1sfHEITquKIW9" +
+	"5s/vVfUdBvyAWpT/Wzw0fvbndPUAPC2bMZtEkrM/ys14NeP+B+c+/NSSp1cuXzL8UHbuybHKWeXEUqFe3c0PH3onMlK51CB+" +
+	"xMDhw26Pzx2Vl5KsfFmv3k/nn3kX7rObYj/vuTTt4zvB6T2r6j3H6Z4whPpOc5JrpTKQj3G1K51d8Z2+qHqG5Z19N3elJq9f" +
+	"/84+2XGlZSf1HEvmga1XWnYSNhJ6H9jqli9yB91oxFY6C3s3+VL9UGy/XuFDF5MjLXliwd0OxxqeG6bJmaonZPnvhCp9pT3S" +
+	"GQQiWpVwzDOraUKaTSRc8OzRacdS2NdhCVGdGjfuGNW8wkTZVEhzKLuiuPGgTvGHvrj0XlyfTkk7U4aMiG3aokXT2BFDpMyK" +
+	"kWxjeZ2+W1asSd2w8vGN3da9+uBgr+1IlyQ7kAC4N+xVPkATRlzZdQ9McS34puhgPlGuGxcr9Kx6sCPUnGCb0C01Mbbnwbzu" +
+	"97VY/NjXs94cNYpOtKp63ObCuRVJYdmDl04szOi6Z3iHhyauVV5YO226+zysEOw936uJHe3UYsHE5DTlqvKrVFw+cP5C5Rty" +
+	"kNN7TpG9743j1fDfoKFvvKFs2rZNKq5Yo5RXPK3tdPCcB+b74gJYlFvC8UGSy1O1D4r9lNc954JpGP1rwLQZ6f3PBN0TUk9c" +
+	"oCzVTgir54Xp2+HDR8w/c3+4LRyVzvbYPecY1eSTPCNFMH1T2bY1Kvut8meGdmXv+wxcYppmRfA92zLZUfXZtlPHdeuUgy+/" +
+	"LDsqhvs9m+sqIJpkhzrfSSTfwqR64CCFWnVWfqhLIZ2oY12KU/eCH1QOvrZRKP9szLzsj8aMmpd95yRjFQo/T+T6Uf5VKkZj" +
+	"tU9oqoO6pU7WOYIdmjRKUfud5tSOYPBgsUKXtgMyxrw0ZhilEkVGpGUmNmeUGhbWbUTzRHXjW5tePYePX79vXHLKAxN+FSPK" +
+	"OwWazbUeSG6RkbFOeL68077kFgMGrBHW3Gm4cWSzZpM9ckMa4ZEb7jPSPrnhd1b6V+VqCy45vCemn/LKDm6vGsjtVbKgKsyC" +
+	"9xx1IEK4tcMnci0pyVRXJ+uiYuNiyS19U4Qg30nqt9588y1KXTc4MYTNCU4crDgDuwxet3Zwl0Cp1HOg+sQJ9UD1iRPKz/N7" +
+	"FRb2ml/x9s68+fPzdmo6Z7brghwrOy1x7LikULJFRoKk0ge4Lkj3iN+pdPE6n12ny+4z37r2Uik8FnT1PJdVjvLIqqhIVYG0" +
+	"xmpiStdeeblg4l7PkW/qNHUUJZGDrGWfrhs8eN3aHTuEreUDha3zF5a5TngOftdWrpbf21NI76CdD+fjapr0uzjrrnkmhLjz" +
+	"VEcM6XflYOfMpzzHsUicOJRa7n2v/NG8li3zJkyezJaX7RZ7PppZYVjjMbLU/qVicmOWkqRZWJh3TuDQ3lLjPXeuyeBodSTy" +
+	"KENnWJ7fCfSIrkmXtrKEysfQP03sXrvX1s3Kz+7j6L51Vb5+pq6r+g6z/MX66pvv3vpXanKN58EGv7njSkrq9ZoPhTG8ohRL" +
+	"M7geF17lPLo18W4n0mnYHs+R9BPKk28k5Gawtp4j6conUrGieA+lN6U16qF0QlPXBTFDKlX7qp1r+6IqpINI1mlPovZVdVyL" +
+	"07Y2erUg3aIHp06d9DsJw4d/tHBmgwbTCyfNmD53rqLMVX57eMHb3z2dV7/+A7NHfD5J2lTPbiv9dtf48c2iwmNj03pnxMb1" +
+	"VPa3SJ4x/daB4wlRoXWj2vTt2TIFhNquC8IOcRYaafMA95tWKpVEJ0fX9SuJpvKMLDoy5MLX2860zImKGjly3JjcrPsn5I2+" +
+	"cu3VW/cOio5q1DhzwLvjxLaR4dt2vbwhXB/oDG12b6tatZKU0mibdfe/Xl0fHWB0Ohu2qHVPs2aVbPCy+nZ/MNdRJVU0yA7c" +
+	"o7a9XZX1fDmLD3DBmnSLjrRaKFadgdUNdiQ2K8/dcv/I1c/Wrp266+a7mZkHlMt0Uh9SNzM2Y6TsmD56zemyH1Lr1L4yeNCb" +
+	"YriqzyuL2oc16dBdtRspadxuFOE986bmoj4411JSklNi4yqdf1OtRlImny3UjRSe79zP7yScajn6vHDalSYNHzD0GlLpTFxt" +
+	"QArU5KLnBK9vTkCLhAXqqwHYkoaNbg4c5Hk/QGzs+Oavv5Hez2vf8uhS1c7meYwHcpUzetyGkCxu7135oJ5qT0hxj1lKbiWb" +
+	"m2cUv4c0m9ukicld1JHc/daCbz2juRilycYtrgsy3LLxKy4bW7pU+hLXBamLJhulSC4bB5ep+TV3XZB+kU6p9S2pm3JsKWqu" +
+	"ZvK8FyM2Tq0cvmlHCFJF4qhHlatsXG7hjrVrdxTmsnHK1UdHKcvCaA0lryQxm6KVY93KnjukviTj0HNl3ail8k22Ur5S+UgZ" +
+	"7dEfnpSK1V4dpfaqe8jdxF5NKTHErs3dHWnK1UWLaVhKysTkF4fltRn77O+vzkzZe3TJEnKqMnh+p05TFz7y8YgNI59arPw6" +
+	"a3fFkdPwvstgv1SKEIDUtzFSFNmrqChdXcrLCtT3G7z/4CG3epIg1lP6qq83ELIqrtLAr1TlpHO4LcxzjlB6XpyFuoCaSBM+" +
+	"xMk68jHmfeTOJ5yCpee/vqdBgsNpkGTlXIf+A9XjhbExIQFKO/XM4av/H9r+Ay6qo/sDh+fctogKu+wuS4dlYVdEEFiWBaRJ" +
+	"E6mKFAVEsGDF3nvvvXfR2GNJjLHFSkw0mphqmppmTNMYE1ORnX0/M/fusiDJ8//93/d98qBy9+7MmXbmzJnv+Z6038u3lTML" +
+	"E7RxWoWbt6tr46vns7Mf+vrk+nPLLE5M9JcDj9Q4cCwoxNhDI4H9kvhDnUKrJFwL+LvjePWfhG8BtLuh/y+UcwEvIFBVfO9H" +
+	"mA8cdHWMYRTLkRnVWhkpx6wzac0klvGFUfiDl0k44+lh0GEnjWg8f+8dK2r48N4p8v0F1gt8In+b+hDsnhYTWTZqcLfZtcSr" +
+	"a1C4a9yZ+pXZWdm5aw+B0lLN5eJ9k7PH5Gdu2YLPLlu+bMny5cteN5kG1ESb/oBK0DXg/LZq7ejBq74YP87PPxECs/Vi/uMc" +
+	"6wWea6pTVH1UC5tjzDFmINHAkntOpXFnri1ctmzFkuXLluNzW7Zk5o3tPhnv53Is/cHt4LqcnO7Zr+v12fiLRH+/ceO/WDV4" +
+	"tFbdFk404Ht4zx+m6JoBJhOtMx1ncXFcFR1je9HGf6udHS0WvaRvv+rqmiFDBuPPt2zpljcmfy1exI7GLHz5R7SJlO3jTY5y" +
+	"Srfx475YVTNGq2kDJxqspL6huISOSxRCIPWipO6laiPUNvMx2tyk8kOBdj1zZWDmkF49bsVU+gWEh5UNqK4qH9u3pt8X48YU" +
+	"ZvwVU+YR0DHsy/vwGmbZ0UygIiJr/dK0rt6ydm7KDhEeHu7q0G/827vkZG9ckRYX7NTexU0fplIzCFADasYDGCTpRzLp7FuO" +
+	"TJApJNcYEVpmVGglTsB2N+G9YHf/5JShPauqR04YN3bkrwsWxjq7elqRRA8oA65TaHL60JQUg55wBGrcJ078cOpQpRo32sad" +
+	"zDUdQkqTCKm1H52IeWnS2uxm2hOH2NcsdW882FyTQ2cYPrR4C5R1z14iTsFTTL8/mDlkmBM6TB4374vx48bN/WKAySROwJZj" +
+	"bjbRawpj1HNT2lafbhvTiDP5O/3Xdu22ZQu+M2TIkJr+/ar6Lu3ePSdn3U9/MHPu4YrOmUPGzf1i3Hg3JSEl9PYhlZmiSV2p" +
+	"1m/Y6/xOuotK/mCjWqeWatM9V9sfK1ZA6g8/HKpYPun8Zy90756emdkta3efaGPeVVYNxvr16+vT/CvXfP/Jgg/cFDroGKp2" +
+	"H5ta1S13rEPMvgvSIMQ73sSq5TyShlGEwOXnVx473a3Q4gQvRkeNLZg/H38uOO8bFdxp9KxluOJtyN87JrXrTaK7cUkr3FJ/" +
+	"zxHvIUo4FcFlwFyE5DKYy8il/ayEvUuxMHQeifx0UtwoCRvNgDJIwFfJD94v/g0J/G3Lew/v/mwZxGz7+e5Duq+RS6UG6ufj" +
+	"Tko2ivNX5K4EkvkGup8ZbLZLmw/JXQSkNHteaL3fBknPDfR5NyThPIRtIs6D20ifd5aeZwrjRYxHs+ctsB9yO9YB1zi1FcsR" +
+	"ZPR5pK0c2bfi+82eb8WZAhafS/txz1ZjUZQktgW1isdmlqDd9DlPckQ5YFyUNowL5TNGzItN2F5mydLWYwaU3BvN6nHEuS6h" +
+	"jNGtY+WVXJ39e033VEvQJy3vqcj3nArF78kFTdcxDt+RFbjpEcm0IUNj0CXoYn//J7ke7ZQwi2eY3+3vF8uley1NOnLHX7Xy" +
+	"vrLZ+yX297v/y/uKfyk/Q3pf4pdxExjvpnFyKpRikARN1wEOY0Tbs19qzwHoYn+/2P5+WgXr8H4PuR5+lN6fBgliTkdZtnhX" +
+	"yXwPXdwEtNj2vixaUJDn8JXV4iagg3ZOulf4J6gjQiAdWMSTprtGYcNjEJPOHGM2EdVDjoZGtc0Xd/UqJaibNq12zOiRo98d" +
+	"PfrTazHhnYMWLvyQ8SmE+fhhZub774neOB8fwlWn082ZTVxxTjL58OxaYPCabGTntrnDTUHtUIwU16mxIb9jEsEFbLuZxOih" +
+	"khnVOoGoWrvu403M+IGjYmIIo02xN+G8mTJ58hSR9UbRb1pwt60i803PnoVrsvHH7KS9G7cW9CDcNo2lqdV5/Sv27G54Vreb" +
+	"cuD0yenTN3UVZcHx8k6FPqhVnKGSoFab4RKXoKsOuEHq86Lvh0tz6yZ53/on8VfR5/nic9ZdjNXBo4hvhGIoDQg14UbwKOJr" +
+	"J8/RXhtuxPZ+E67FTYAjTe9Ld9LHkOAmwFH6nNpj9J6Y3mq6CTCFPu+Bs7gMoY9czxSJd5tFT+24s00EowD/iOvZOtERb+Us" +
+	"4ggSn9ej9HnfVvSoij531KNin4r3ubtgYgtsZY0Nu4kmotlI4llzIvxoEhuUgzNMjrScQa8wSRcALJTFmKdPjzFDGf4G38F3" +
+	"V68icEkoZ7czcY1zzhb06FFwlnG2/En517Zvg0SdZRFhRZSwmLiEL+CfiPtg8L/sg0w7vAMGFJUOqr+RV9Z4AW7HmWeUrhlj" +
+	"eSCbdXZyaOT0lRtx1Ncw+uT07llfNcOFKvhI0v/NMGB2jBZKgxTH2EPSN0IwzJALaJFtLfOesnFyPTog6jj+LMywr/3h9P1O" +
+	"MKHF2iflHxT7EvrT5zQ+S3yf20vf30qf0xgB+v45OlITtY648xH2+EkNtwcmtrK/XObSHN7vb38/kdsKI+zvZ9rfr+fSmvwH" +
+	"shH2+EANNw8mtoITvsz1c3i/v/39RG6YvfwlQqb9/XrxfeurCDFnaPmdxPLZLFI+yQnDXKLr9zI7ka4vOv/JTGQWy/rb309k" +
+	"9TCiGc60nkUO74t74wh7DJ6G+wEmttwXqfzdHd7vb38/kbtjl3+vkGl/v156X8JC2bFKGq6Slj+8GVZJxlxmf3B4v7/9/USu" +
+	"C4ywY5sy7e/X296ne/QIewycTf4WMRjMZa7M4f3+9vcTuVdghP39TPv79dL787GGYJsoNssZoSYsFNYQbBN5zl5shlXSiFgl" +
+	"5hP2m2ZYJQ3FKpHnvexoJUDTcCG9Z4uS4uRdGE6mSBLIqVsX6AomcxJnig5ndIEyBTlus2o5W7hwc8HmZ/jNz9zT585YNig9" +
+	"uSQ8Kr7+w6zhJj8+rHtW0LIPP/1w+LGROX7MnqBbU4YcW7toYgp486pwU36v4eaUviGexbiftiA1P8wnpsDgth3Q3tjeY4ZF" +
+	"4q8RoDJcwD7kpqEIIg+rlvMmIoy+hSwmURaVwPyYN8Lo3jhalTRx1PSKxBHpnaOOX0zvF+HFdUxNCZhx8ewXfddXJnvNh3fc" +
+	"u5fk6PGQyw94eXBocnpJWHaJKuNWnneqKUWr6ZQS4DLz3QUd08oqIsacao4NDW22D/0kYtHd9ChHfM46wwSK0SyhGFAVPfdT" +
+	"Ny1xt9gxoNskqCc+L0E/7QDPhne5vjbUpxinVGit4R8KKpo5vws5kxuiSfvdg0U0hI7CIdzFmyAZORMGGgSZgXjrpCOsXNS6" +
+	"GoWWezAZPNP0WqXl4BBzTIx5SE2M2RzDzErvVxweBwkZGwpTtN7r3EJc2nZIKbgvgiuG9uiZBunf9fE0dpaDCKAoKsKXi4rA" +
+	"uyI2Y2td6VDwyu6RZR7j4pau67RvLyNQyAXTGyGG8JbzA/hfkAtKQYg4bhyOkKxJOn7JHA+Z0ZKfMdocE6zWN53IyTeYh2sI" +
+	"r8aatbl5ebkVlqVbcnLXvlBUtKsOX8Nv1u0uKtq7OTN78xDwGRSZnR2/aXJp9+7dmO1dutyN79IlfuSoLvHM/qNOfQZcnFVc" +
+	"HNKxX+XqL8aOGTP2i9WV/Tr6lVT0GDEwo81WJjg2ISNCHx8xaXhkRFNM3Sg+FBmQCSEIbl1kcilt5ptsLjbQsanM95ASmpw0" +
+	"dVG3zAULCOv6goUZ3ZZMmx4KybrwToU9+xyuHhIdXV1tNBqNXP3QglEzS4pDQqocJQw5PGtUwdDOeX0iIqr65OE2E+2Non4m" +
+	"nEn9VSqEtEyTw1YruYq03HfC+j5LLRvLdf5s48uEI1TkC8W/13Z9GB468Uf2LdD92GiWyqK+kX8ri/2MlrWBOH0tFwkPl8jJ" +
+	"ZSurgakB1GDZ6nCmotixjTChKV6K7l+OcSLp0pltIvF/S/uRgKZOkznGxtj2IzQVzW3S/06Fdv3fFS1pOkfRs8AZybavhy6t" +
+	"vJ+FNjm8HyGX9gtNFmLxF81wyGoSKdUChyzK393RnqTlF9vLT0NHEWsvv4ebHn6V5DlGzhoSTk+MU3qXnjUSHHB9ov25CAki" +
+	"ro9ivGrYBKoL/Bw0AWtf+zrb2vcELdN1CkMWuyo3vSo/PIXpkrmhMCXAe71bx0GQKq1nn4qI9Bd29x4iruDRMJR9YKuH/4Xe" +
+	"h5A5LxYvOBSvjPkXsLmafKLTu4I7tzqjX/ewTIZJzVgh1TuMZWStYdAHTmFAk67XqhimomPqi0d7V4jyTILEhanPQ9Lv4wSu" +
+	"2JMxdZZTWT2sA9kqfidyQgaEgm3V01xAou+ZcRDcdoPBlJA60/SBqjQKgs2uKgxP4GLTN1NRlYHt2vSsq3siVcMdo7ca26ui" +
+	"M/DV0hFEuKSyNm3t1xog8mpyVbb7fxuuw86oaWfTpPr8vmyeoELtkTfRis3VeIAd4iZTu2tYo6IlDk4nopEctDoz3lGXP7pF" +
+	"frllDndyCo9luvZowsj16Mrgi2l10u9ErX/vqM7FfxdnTBo5KYP71PJiVZgNPxdWtWvPgIpmmp3uS7hENo+bRtuRLGp3uajy" +
+	"5AZ9gNQCd/orKHRahYPY5ABqVDdvGjOeNqCGNoZ5JLbATFqDVzoKTk6jqc0bxk56vgnF9BfLdMtpJlcS/oWKAft3OzQLsdbv" +
+	"rKf4I4I/UiCRk1XL6mQGnclgBq0SNAQHYTawWgNNE/BxWioE5sBbsKM/5NbghdAbv4A1b6bkAptKOEWys0Hfiy/Hn8GYcbji" +
+	"2d/MtXsfWo59TO/xrlhP8YsEf6SlWC+DUm+Sm2Ooi8Cskan1BvG2XgIvdQYliFfiHWoaqq5ehR6jh/TInVJzMjTUMk/pOYyA" +
+	"ASlY8Krzq4x/do9zH2WPAfcTDVDz/ns+7vpF465v1npYEoP956d3xzfNse87wxGmXIX//iGdyPLUuocr5K6gQBRKZDG3Lov9" +
+	"ztNAhSEbce9Br5Ru3QqKgtyoyMrqxZ3CcFVb15xFuenpcTkFefl4425IdJMvysmprLy97F1Qb9/m6qwYPWjP7kB37KpyK/X0" +
+	"JEhCuetC2ARTobT3Dg96Z2SR8GftHbAiFC1yCMpffZVEEdmwMORyReL9QIx1rfUU31vwR67Ijd4aUKJCA/lTa9aAWcd9NQwf" +
+	"wftwZA1jwmncvtQay81Fi/gOE/GixmtwxoLHMOMZDeiyx+HRGxGgfljFZXPTqMaj0DLR+BApIGSCTLpz7Qz6ziA6TOje3pJ5" +
+	"tFNYoJZwj04a9cGpnt2unNu07N3HZueqQTVDKqu0/gOb0ZB6eplCNRqRiLSsSNjR7eHkk7c8WJBHe3nFx0V4uLvYKEnpvkzk" +
+	"qxLZ7dXg7g92gQwSM+aC8sHvSXXemGNjyDTEl71CCj6uIESZQPG7BK+gp2uWXqapddL1CsEqS5YNHYYocwzBcN7IBIOrDwMP" +
+	"QuV+6dPD42EywJIlS5Zgqy/eB70Gmc0jB3EXQRXc2UWbt62rh29ye37WzJ8ezpppaWB9zTHXRyBAeQhxWm4avRsUyw1Ua914" +
+	"qT6ZWquAHweazbWDJuE7HGyBwGVLl1Ztw/vgdVLAZngJaJG9vU/D6wixyNmel0NNcYEtsdjUw9+ExzYZW8vP8f77TUjsnTtb" +
+	"w2APqO8vgbBhSD37oi1PB4NCrff547R+ysZpI8CQyCfVOolsgfj8GI6Q1zd+BOXwPpTPnGkjsZ85kznTn7DY92d9LfuYcsu+" +
+	"+v42Mvv+9Tb7ieIBIqnOPWRN5QYJ62h8qzdCYJQZFTpWup6U6cgNMih1Cm+SDu/gtuiuq3PO4H8qYCvWRG+NxteZt/G5vos/" +
+	"ycFfCOsuNgU2XbxIckNACT7MTAF3/BNCCHGowJrKLeH9UAAyoFDKg6nWypoIpwwynVlvc3WajcQi5s0UFKE069zBGG3glmB+" +
+	"cFy+yPc9vl/x1lGdDZsJ53dpdq9tI6NCVllu9ffR9p3g6h9XLoPCPA/GWPOthxjV5HPhT78OW0UWcPWZp9rwXes+bzNhJN47" +
+	"Kwc6pEXZcmpsFu83JL1hR1dJ2mPdOhsq89Ah/rZlGP4TV9iQVbAXnO2c2MwWin3TiCWpdU07uDhvaHjna6+Je/lrr4nRqtij" +
+	"HlfSTR321LPb8V3EoD64RjjK//x8TAJPugwp5MjmfbVBBFnYtm/ftm379sGd3h8t/ZPcwfy55HZv6D5yRBYFDjbgX3/D+Ldf" +
+	"sc/cKfAyFEExvDR1zv2G801oQur75V+ne3FIS4sCAli9oYXNIAmgbm46nMY/53syzSwESE+v2NDMTLD0Q9ZeQxztgb3PTi6q" +
+	"eCiuCWd7LouA5tghncK2/FpBD+3c+Z8ZLRgTcMz2FlktwPq5xC3tYePgkImR3nTEHCmmB03yPfIqvnjliiPP9IiJ9TgbTtXD" +
+	"DyLXNGPPw0BsAcRqNVqzlpRItxUSOa7TuMG0EbB8BEwfNehT/A3eh7/5rIbZcKxUDB3HvgTBAPfr6zfRefUXzuTOclXIS8SF" +
+	"KY1KLU0i6YhbYLW0YHdI23Z7AaRuuowtt1KKRNBCsLrNLfz4OtwqCueqiKw4u95yjMIVfHP8GWV9/Wza58RXtNyeD8E253yB" +
+	"t/WCWiVodY7oaD0zXWTcIWxwXfeM3371d/zMng8B//IbU2PLh8DMcsyHgH+R8iFQjLyNl5zeyf+fcwrsfVb3bwQOUiwL5VT/" +
+	"/3W+Ba6qeazM/4gdsqGJ1K3XU38Wb6pI/9e6MkWbvym/g8YWZ+KYa4CmH5QV5G0tAAUY8Tv4l4JtufGLcxZt2rSo2/xULtHf" +
+	"H79l+Ri/5e8PwZFRxyH+eESUzTcxjpbrh/Q2q4UYLTFGTi0PDjSoDdF2He0O7sYoohWkuI/wio3A4RspwkkYkLVVTCSBP46O" +
+	"XgAwMceWUWJaj5f4H/F5YMZCabq++3Ypr0Tjcs+4HJveGezA7aGQwPj6RPiP+ZBeEbf7zOupe1rJM9GAFlU8/Pnrlf8yM2x8" +
+	"IlftdRLTS6sWvWr/PgfrzwLaVNH6RFxKB+nZuT3/Uactx4UnCkRISdRogMZdIW8GVIgxa4O10pm4YOWqx8Bv2LBpM348duy4" +
+	"saOHDx80c0VbiyvkwAuu8fGVJTExjBGcd+3asQM/xj9u3ebpEQGq9EDtzJn3RsZ3SU5qXi9FuylNWlHPNQVaBCKRA9FcwPza" +
+	"lkYQj30wFtygM/4YP3uM70POiJLK8a5MKA0XBlVEJL6Jf8SP8ZuQJKbWYNAI632hHzcNtUHRCAFvs/7BXW1rH+gdoScBGndN" +
+	"MqgdMIhsIp7j5BxTUhkf7wrxbhs24GePV+UGMSdVft1mDho+fPTYcWPHgttmd9WbrwTqKg2le7t1YXz+aNc2uUv8SOjUswo/" +
+	"3rFjV8lEeC3EJ1kbSOT08Nym8Z7LvPhSrPnkqPL9yGpFA6z32RUUB50t5gUVf6e5ciWWNgJic3cEqZhs/yTdZnIAzjDHa8f8" +
+	"8bBXr149U4pzM4bGJySaJwwbPTTMGBVVbNoSU9izR/77Q4YaI5kKv9Wjjx5zcfFpDHJXGTrEJxiCv/IL7NQxSBfg/YtHu3ZZ" +
+	"3TZVpyRL8vBLuWko+f8qT/PeNPOm/yVdz8Ie+e8NTfE89EpgYD9D6b5uXQA/8PvfooZ2H2Pv0ROsK2KIzNxbNAapczOEmV1M" +
+	"yQOrCTDopVMiNdZp+5g3iGSx422STeuTnV2wAx/bunXrVphEu6+6dswfbzUTBQYWvnK4b9WDbduZjtu3ib3nSQVHoh+F2SL6" +
+	"HllqeFFjrInjIOA1YodVV4tpSsjuLVphkyiBwR7bPTjVD0EoomWOlyZd8f8o14tNa/x3wpflVH80hv5b1pemvDOEvzVEkkm6" +
+	"iw8BUYvYlYgojPhPBdvdfgkPeZC+pen+nUpCb+ov0mv3b3bXkbwzq1ZKN+6SCOR2HuLtsYHsz1w/iuuTbgYUkjdGbTvuikvf" +
+	"oDNp2Ud9Bw4a/uvKFatXN1S/VNCzruf6nj22ruqgDfSCf+C7rCgvz00bQQHKjRs6dHgUGdGn94mXTwh824MNpL1huAc3gfrD" +
+	"fGl7m0jobHyKhEGRZpVgerh7eKo1Hp57inofe/XVY3160fwSt6DYpE8OCevYMSwkGYpX/Ja37ejRbXm/rWAacQf4lNRh/YjW" +
+	"0Q6pEOICFNFieeCu5kkonaBWsSct8UdspeH1R/D2zuGdO0HW9KsMhz+FDpbGq9OzSrtkZcf1QoBW4+z/ytWh+D/k6vjnJ6a3" +
+	"lJ+nKedPsI1DqLnr1B5l4wt2wg583pGrg0bbVKRn9bQRdlA2IUeuDin2ZnF1GGXskDhCBBXVjTatp/C1Q2ZjEpl/FUMNX0o1" +
+	"cf2e5w2RZGEv0qru48y7qvfGk7rL54BHazLZcxSxvSgfuasNjaawW+tQDmn4AqSdPIkvXL5MkhWtJquaWOdSjiBmTZNvVTIw" +
+	"TFsgNaPcfcbZeq7KMpIaDWJfs73Ed4PVUqIytU7BFlq6X7nCnD758OFDZunDh5aJ0rvDKbaeSBRjjkkEI9FtNF6I4NGJSwQG" +
+	"QqqqvXqVKboCX/Ror1ptMvUTVBbOSRUQ57Q4O4uxLGIaZO7+XZwWZ3cHW1u5gTSWSczUILUzQCHXRmlkxOuh6gJ6ZjiknjqF" +
+	"L57BP4CpX0JExx61VSfdBJXF87blINObmFnmTwJTc4okrvBMVkN9xrQPmrbeHZAeqO27wXKmeoCgakALO4c/nDjlpjjvcBZ/" +
+	"WlAhvZQ7uRXAsLE5pngbpLK1ePHa/NF53TZvxXcGDx1cU11V1XdJ9+zc7HUHIQ/zJHUTzm3A+W002jE1q54DW/4BcxrrJByr" +
+	"9b7wA1eJOtn0zf/TUKyUpKKiQUdP2SKxoqJIJJZPdt8oKRIrr2Jo3ZkhMebH95nDljNNgViM3nKGBGIV7WAMz+qkQCxAa3DR" +
+	"/00Oxf8P5fjnTyafCgJoC84UfuWmNa1Jd7WyOX2PaGNpFdFNy1K6WCF5r4p7DTp2ihnQn6zJjAD/buXGSAaf9/XNrjBG0QRY" +
+	"+bliBiwikUTqM45ZJspE82BRmcRMWGLfkP2Wcgk9z2/mkBnsuZxYtixhreXF+pnuhlzu3ib9hzP5N7lpSIcQ/MulkeOMZrux" +
+	"bsqAwA59u9IropwePQcerSgLDKzYaDlbPYT5IMo/UK0szdlL2zGwU+dJk7+xDKPzfvZVu76dbssbZMtNug3mgRq64+n4IT7D" +
+	"VVkmMMue1THLLBPsvLZSDi8axQUOmbvsEXVKW0AzM7V5Di9ghpm17WG3iza2Fhc6h5qHDY8N5aa1TOdlRcVRublRxXh4bdfi" +
+	"4q6SfY/5p1w1aiNGN4hbfxdg7WhdtTZQZmcu0kSxHQZu25aXv21gEtOAF0Nf/AIsavyrjavcCX+ML0FX6CiTy9mFh188UlzC" +
+	"Lvz8/c+x9XMACAJCFx8o6qe+CHFLuc3IjZ6fSMxQZFSMSW4IpJkHDCYUoHR3E2yEvcTuYz4O0/hdfcNPHcnif5h3l3SA7Qv6" +
+	"zPfwmFMxP7+gI1M04qs3hkI14O11y78ZxqTjvYNxR+v87ZuWzJoDN8YS230kLuFTBJVcYIeJY2R9wC7mqpAaIaX7cyrAoDds" +
+	"69ixY8fp6alkZ0jq0XO0XKkMgkj3ts69++xjuGd1Z0ZGGYHh7WUJ6v83ZfXez57956FjWTiTu8RVIb/mZalsszMRni80o4Ko" +
+	"34FJBc9JSfxiE6d8uWeEQ/kl1GelFtfCcyuBOKw6hoROyaQ+jKQePceQItnOpMiSg42FgrNNWKpPaFmeTbnV7JujskWOtfSK" +
+	"jdaz9UzzRGtYTrdNLlf0gElrh31fjFeh+ydrMwtkglpJdtELQC2AvtpAePjwIRwgWiY8cj7ug577vlFhO0uQFW5Ua8HzdGBA" +
+	"Jd2rzlu6P4QD8yPJbvUl7vMQkZzyCHFdKa9eYDNeEdpBUU1QEeI9JH0HPxbqgoJ0hd3wBbj40YABRuOQuLCw8I347FytISSN" +
+	"mRgQ0D0rwA//eg8P5qqyc+pGZXXTukR0rsb14N7N1//5OonzWKzWQP5hVlJnnQ3roSOa5Mdu3WitW7RpIQbtXOi2MTwsLG6I" +
+	"0ThgwEc4FdLgJ/yrX0BW94CAe/6+3cAd11d3jnDRdssaVZdDLm/oPVMmzWlnbu2eiUwud7syTGT/9z3T40eRRt4nkE7CAdI1" +
+	"k7b/v14zneT662mvq691l26ZNPbEd0jaE1gvMRY8uGkXsB+d4J6o/uvPArejIl0l6vso+hf9/kOcSbkOiKeEwp2NJodZRM0h" +
+	"oWnba3Ourq6iQiT4OXeO71t9pi62Ih1O1f9AgvNKexO2H8uNepz9wVj7GY+uU/Yb8S5JKe0gTRGRdNpsI9GP6RWxdWeq+5Ng" +
+	"SG5a79LTjVayIie9w6rTfbyfj68kJppR0UJYMvLKCNFqizLHNOIb4HFelNjbe9PWfmMORp/uV6SvCHTH6WAGNedHpfZJf/bN" +
+	"R9sHTobJ2V3DfWncIq5nu4k6T4yvFKe3VlweJLXQQMLGRaIqQXWpR4+6OlwPSYDgTr63DxAeLhxsRbgeOcRqNstPSFe+Q3wm" +
+	"bf3rtqDMxpN0tbO5YiQm9YEKApXHn46Uwn5zZU+kGwLiCcgcs+0ypMNoSH31VXroOX6aOYDreKUypePAeH/pIC4exrP39O/7" +
+	"5H6ZVqcvCbfZgRzmqkgtfCAi5z6dSYxztOUdUmjVOjL5EXP9D3xzWq/P4efM3EkDB07KzYSf7xRNwzd9IejBxnsJb+AH3TcP" +
+	"n7516/Thm7uD9xsJ9zY+wHfFMwI3W6wDHNkeTIqWMY5RbH12smnp0jvTzvQfAOke7aOUw7LTo4NzL3KsndLhK2atZaQD2QNC" +
+	"zfMuhgBJhKjlNuKLzxrwBVgB1azQ2CCub4e8i/Q9+qo3aIXB+Nrhw/jpocP4GrSBqsOHoR/LNTYyjRaOq2rELIOQLc/bGsEL" +
+	"Kcj3gdVCjH1CugK3Gl/Ev36HL8DBlJKi5ecG9QzWq9swf1ucuBuv5YhXAwjQRIS4y1wVcrLliFRoFZOg4PaHUIhr8EOuCh+A" +
+	"PpbJ9CQtYb54OVdF1xPBeFGfj21FGZoSL6raO7VvM2GD5Uz/Ss5ywZ6D8dHMADc+/OHE8e/RXIzERzuOG0v9OL5iicYYc4yG" +
+	"07jxOrWBQskgyhStC5S5a2jBa2TOozYBsCz+c8JJwHJSMgvzJhX7c560htAOrzPsQ3y/zQpY3sDUWHYFRfRggMpOsfdiHFkb" +
+	"+J8HHfCEPIJYw4vW5o/JI7Fknw8eMqSmurpf3yXZJJbsIHwJcwD9+xGHtG+oVGcbFIYQrzfoZQK9P9c/H5/pCu5qmUkm6My6" +
+	"IKWbNorZbTi8gIENvSN0/dol5Kw72CTP6PxuW9pxW/jJuxkGr2Q5yN87o2sboTiOY5QuH2d3jP6jhWRtgjsI7UDHuEEEKGmc" +
+	"G0LsdUGJfKhebBk0GCNtogrtH72u5hmj++zO6paZmd69+wufXpi0vOIQvsh8NTa3W1XqWHd1KHTUKdzgwwWffL+m0h/LEWpR" +
+	"PplSElBT0zzWReNuZNX44qGKZRMvfPpC9+4Z3ezBc8xXlgB4IkbOfQhuCh3+uCl0roX8Cq1CGkbD8/F5kEpi82jxjrF5RuYr" +
+	"LHco3jEyj5aPS2j5OrK3OcJcBLpxtGwKzBT7qFsG7aOJzdszX+wo/LHUUWx1s0al9UMt+Do8qQ8o2uaFFgINeqVE0EO4xprl" +
+	"C96iC4yM0AWm9w/UTdx5/PjOXceP72oi8iD8GMyPJoPBYDDF5Or1jF6kvwDu4SPgQGNj87CxZNhiJJK4KrnAXkPoOd+vu9iz" +
+	"MmkEuzSF4WpEZ5001sT9L5cJzOAp+Tk5faYWR0UZw4aOHjbBnJgQn/HwjzG11ZHGoUPeh0nEC/wWVPU9/ErhQNAFdewU6PdV" +
+	"sCEhvoPhu2NHR6/280xOqd7ULWvbdsvH27dR/yRCHBZUKLSlf9JopyxTK//bUeniIlT0Jw6qDGjpsBwkOiwZ4DdueIeaEvge" +
+	"8Vru3UO9lvea8lX0o330ARk7/CbOZCZQvxVlE1HaPFeCQdxhiMEgazIOpKzbm6KGVI45tKsRPM7zp6qKDOU6NfVqNUZ8CLBz" +
+	"wESIAdWHOSnhPpItk2vjk4YmPmnuZ3wRr8BL4ZHlZ1gISyEV57IFeBDDMJU4DD5ssrc30XytEjfVNsiEU3Q/ed3G11eIS6g/" +
+	"3NvB92xD4Tl0Lclr5YjgY7vbkQAEcif0FR0PgM/fdgDq7XHA1r1Nu7VBzmsd4XjN9EZQq3rJZtxTxI6WAGZa6qfPzhP99MMP" +
+	"+OKKFS111AeiitpKgnJb1AUKe4km2/xtRVetXIkv/vBD6/oKTyTlbm0K9m2msZCE53xGfXMG1PE59IVCBE0rtC2Bm7wCmsEv" +
+	"oAuks7U0ufE6T3zbEYYBi6ChGQiDuc30xrk0+bEDEuOi5RRjjwXMchpMZUr7F4n+pzOwubStiIoXr80bk2eLxh5UTaOxs3Nz" +
+	"1h6CPCzg844taE36//YhOrQLcajQulr2D21PNOqCkv6lTUY6mZRuoeBObr6f63JqyRr00FrPD7f8vEjtXx13imM/92VPszjJ" +
+	"G3/i2ASO02k5zifUMhP+aX0wJphfxI/whdhYMMc6iN94P64bJ8Btyxkmi+KVERKO8TtRMIoV82OKHj6NQ35zOjSCrinfOM0K" +
+	"qXU8CBIT36vDyPt9ro6j+c7TS+Mae5Q8fmhJJWwnzBB8x9yLievXLyBgYOynR8gRYHFYQIlfQFP28w45PV58ZZMtX7mlntCi" +
+	"+Mf5SKBDnYitxpn8FnqX1kn0EDoejlQOwQGBdmoYyZkKz6BzRcXlv05keHvT+7qKQH8W/0w5hpmx+H0f715EqHzbYanwnUmE" +
+	"LWZibxuq+ll/pooSySDEIg/rOKEPvxNFoXiUYe+1FjG2mhgjOcEFGtzVuhiy3g1qB7ncjdTitJGe6MUudPcmgNa8vNyuaand" +
+	"FuXk+FVsAQ2+WSljTzNFKWEBEisyp+yUMG9iDu6Av/Dx7rUpiYj+mr/PNle5P74b5eFR2nvHgG6vktFn4uIqs4JTwuMeNLWk" +
+	"n2dcDvxNGzPrpZcRAqTDmTLCdxZv47vwBa3R0R/677TuIjaWqjJCF+jjBXIWkgmS/nh5meQdFgQXpTag48D4eHwRPyEUuhUV" +
+	"VwQV6eh8CUPfOus7W9VYJ9LslvaW9hf+S0GFDOL+EkW7tckT0dqc3QapjKJfPwgIGBD76ZE79/tcHT/ENkF7Fj9+2LhRUDU8" +
+	"appoJ448Pylt9yh8pqBCztKth02NggLIRcUQqio3++G7kAp/CCpJH7JVlvMMwTmT3Y7z5ANQO3qjZ/QG8n9W1xZ0rOMvIW+N" +
+	"qD1SW3vt0De1I7560fEXZhzuAS/hIjhiWQHHcU8+oPnvtI6XmRl8AJIhV4TMIsud/efwHKbXbHxM/PNlqMdJEALzxb8RWCtw" +
+	"NreYri2kjG5ylKlsOdyb3UYY9Ka1GeXEBT6g6TqO3kCKIRRcJvU1fovVTZdwjOGfR0xvcbhpfZn8BUHVnBvv3ytucqiwGckV" +
+	"tJJWBMgo3/jsbPWAuj4mUguztjUpnp0S/aAI0ABmO/NIYMV87bpEEBODi3ObrFJpATCP3uPYNu1DvLoGB9+61TnalDw6rgvn" +
+	"hecmPejl5++qdI+ILMY32cH4JbOXV2qaaAvZyg5utewmxeULrdWSnDxxT1UXd/fOxuiU1ipjBs1IS53n5ZWTG+NJqmSsn8Je" +
+	"iBJY1J6y+xgd1KGJmB0DbiUlTRwc1MXd/dYt3tWyeWZq2nwvrxy24gVJ1jTer7kfWKewxUREaZg0Hy9v74JOobdudYg2Zjm3" +
+	"b+vBDWwv8JFRFfhLZtzgJB8fYG3tXsGk8a401tA+fkYpjbdBbxjg7e3tXRAa+u67HWqy2rZr7852a88LkVEVoLWsGJwUC8CI" +
+	"5cBetg3vivyblaNUaVo6vwMNA7y9vL17dOx0C9TuXar2TExK6RAVneXcvp2G7SYKCVrL5lxPr/mp6TPKE22yMsiT2c6s511R" +
+	"G6RFCEiebXOMzd2aDEZZc3erEVRhYdEajUZTrQ718lQXvV/l6+sblKoNSE5ejO/fYo9tkrsVKtymu8k7bDsR4+3lpDSEJJV1" +
+	"DGHGIUAhzHbOk3dF7Vqs/mYLnndtsaYBucBe5hHvKo6qNxibEU253Dq2/hZ+0sEc02dy12gPj/YC74pL4ZClcGjHjvPlio4K" +
+	"kVuDGw9rhRq5wLrZfmf1hAeA/C6XsW6wnj6/wPZmsgm+2/kZ/X0825sB+nsjHZNvuceMTvgc8QiBWqljTfBp9uF1wudH8GVI" +
+	"OSKO/8fs5/CJyJ2pFLtTRzhPSRd+HBUYqAuMHJabk5M7jBtv0I/QG7b2KnpU1Mvhe3KEIEIdaNCTLUUtxmjCJ8NzcnJyhkcG" +
+	"6gIDufHid7bS79M6OzLbmRHiOm5uz9Ps2TY3g25HzPQobWDskPDwsLBOlUOWTs3pE197i4tNCTXEBKe0a+dxQKmamD1lTk+T" +
+	"Av/UolxCBe9os0sYFRk1Lv65NaJLWd7kZUM6h3cKK+tcEx8A2qgZXCweCO4KU885U7InOjsrD3h4pATHGEJTqMxPuEDmsHAJ" +
+	"CQgpg0nO+eAncBbfhSDcjb/xx6G9+178o+V7oOZNYWBiDuFuEIzv8Dde+ePFfXsPif1ex01nGoQa+p6STCwl43dn07qNW+4K" +
+	"NdidXBHDTy3fozsPC9Pvblq/Ycsdbjr8hM9BJnYn703hHrNa6T2jUmeQ6aDq8+/qXnmljuQM//rrlu+wRo3ZyEIVfeU77jFM" +
+	"vn/fllv8DfZ7GC24IVbsRxh1WHCz3GZCyRyTcsPLBTQGof8HecqLuMdMofS5UgfQC7+ET5KPYbJYl7UcRsNCh7pgob0uvIj5" +
+	"0ZpGIx7RP53F8rAvU2jdQN7n7eUNl4oj8mBfNkP6vEme4XgxYlAvhOANrgoxdEWwWlaphXZnYMzZs3gV8y1Mt/jg+aSOXsy3" +
+	"0nvkLfoK+ZiusY8ZPXzCVcllzGyEcG6LZ3PEZwiQBX5g1rGHkYxGPpiMJGpwHR4+ZQ+z/eRJcMG/IQAWfmC2SO9QpIlOASxs" +
+	"mLKH+fPkSfwbuBC9in5ntrHDKL+2yUjPuDqTzgThe27e3HODWfL11ye//vqkpMvhNvzDLBHHwqSFf/Bw2AC3T5LPdvK+TKOQ" +
+	"Sa0ho5hjxkjd9DvnOPfJyenTZg6/Y/mK7UJmZnZ25uotWxAC6Ml3YboLkbQ8gmUv/PNPIfJPURe/zX3N3JJ5o7ZIgxA4pNKW" +
+	"2fJr6wL1UJoRGZmRGRGVvmzTsGEbNw4btonH5lyaMTtm4oEDEyceOIAAjHgU86qUD95oT0yutmV8hc2zwnSmMF1GUGRx8JCR" +
+	"EWndvQyRrvLtbdtWlJhqEaB1vDcrCHGUcVS0dikAh8ghpdUk4Bwq0LtRrNBRExA4o6IoIDXYrNK21wbN6NtLm8AXBTOcf1IX" +
+	"p8Xrvf1CXeUpcU6L13uIbT3KPWbH0TlMRsrI6uhPxdWv1sIf7/2xXZzNdMIDQnCS2cxFEX2qCDY3Q2aCN0Fc6kv2ZxLEJTvu" +
+	"QhOcUhy/d7jHJJ8rHWsSIGMga1SmYdyzc06+8MLJnOyTe/dyj0tLX7tQUnLhNVE2HzjMvMvLRRwbKI2su1oVYIo2s0alhGOD" +
+	"iXM+eTACd6397pM5fr5+nAyccNHh0jNnSg/DEUvHSENIR32kzQaiNglLLRLdgFu3BLZxiyhbIrOcWcT7UfyJ2n5oslnusufh" +
+	"qiSwTE8NfY07U56WmGju4uMbGhu002zOyduUkpaRldi1a2qSn59alRy0NNa8YnV8UjLXuMXbpb2Lq6+Hl8CrwjpVDTRGObdR" +
+	"bfZ1cZUr/L3bu8g4RXhYxcAqJycEyI3ZzvzAu6K2dn0mKj7hh8/nL7jz4++fr1n9OXsPos6dw+/ii2+++f/vPeJlhJgf+eWI" +
+	"R4gSpSmYZDyWMXPvnW8Y7/i5zPa5kb4BKw+x289DdMP4/3yHGXKeGfI/3oHo8+z2Zu/wCAWT6BkTk3zI8tZ57r2G8eeJztpj" +
+	"ecQ0Wj+Wy5hFiPlnNNVjL3OfMj8K6930aAya7Ua1LppN4urZ3izmb7sJbUU/59tYxdxC+SQ7DkL/GGnb1+FRrGAtQkHN16DY" +
+	"tf62qFARJEfmStNKDA8vCtBqg80qhby91s1tRufwXlo/P7zDl2E9ozoLI7oktG3rEdhOkHl6hQu1Xbq0cUZWa+NZ7jE+JdQQ" +
+	"tp7GQSInkuUA99i6jz6b2NhPfNb4BffYuoA8Yxbb3mv8jfe21ghxJKOw7T2Lmu9tzRNUZK+xv1fD5yEktJXL2moba6T32vO+" +
+	"VsI9I2O2No4izxBYVnNP8IfUz0vRiZbV5GhFfLZWq8Wb72LtJUSSjLONw6T3S7jHVn/hko2TVKKINNuA3VLYjY1UEs9bq3KN" +
+	"9HVzVUOHwHhtQX5WRdiEuUr3Tj7ebWTc40jfYK9QT7nSy12vcHXTjXd3q+wTHmQI8nB3addelDmC+9oaJfMm42XvKw332HqP" +
+	"9suuxmHP9dUS8T0EljvcI7xQOGjbn/HCw8JBnA5kDlkiuUfWSOGg3MBcRIcp08xFK82jhZjGedxja3e7zlTqWJ1Sx+Ktx9Z+" +
+	"Na9+xx82lQmT7X0qc5UbmG2Nw9AXzfpW6m+yxlldOAQKMl0SBLhvmOPcZ0OZ82x+B3hu531tmxbgtmxv6xxuLpGX1SqN7+N7" +
+	"146yvaEjQoDduXpre+EKcqL7mVxmijLpsPtff9XWClf+xH/DqqkItXiPVevcjWq9wvS0Fkb8JVz5c+oqACe6B4K1gnuM9tI2" +
+	"ItbI6srxxpdEw4fMT8sNfMqaKs7Pv++K89Nyw7qPPpvY2I88Q2BJwyo8zfqU9hJxNBsVOstX27dv3YqPvbgVa+k7X2GVVSO+" +
+	"w0smgOWrrTt2bG2/9cWtCHB/Pg2VykYinrTZpFXoivBpeHpYePmC5TZpzwU2Ghm4r+h9vz0RvcFoA1PjiwmpGl2n9q4rnJ17" +
+	"5Xfuz76wIkrfJdKQ18FcGTJuWgyVYSdW4XHWn5ALQlLC9gg13Z2NeGxgWFhgn6lT+xyMCtZHbVgyoL9o+1nisMoaZl0s2jdG" +
+	"ar1Y4vYd3otVF06fviC+Y8QqawfpHQq3Vessn+6Fp/sO45sXLpym7xRjlZW3PkVtbX1k64OlS7cuW0Z7inSEVF5/awerP52v" +
+	"Jq3aEg1Pcf8LpDJo/B1nWkehJ+RMZ1DbnWSJsFZKcfjkrujVuoug8RnOtE4U3w3WqpvcGY2NNKqRwGWe3JU8E1QH4Dz8oXWM" +
+	"aHfadMAqfJeMuzfOs/aiduywxmH/uCGEGEs/rLLKrD+htkiNEG+LNSJ2j0qg/RrljueGa0nXasMXTDsxdeqJadYgI+lhY9CA" +
+	"JaSTF5N692GN1cvaVayX1Vn+hKDrRy/hj0m9hXgU/klkPLHrr614lNVHzCzWWGNb41iFF1rraBniGq/C42E5/UyNva151jVk" +
+	"fGQKnUmn1ileOfTg0Ci89Nw5oHR00Pg61lh3W1OQF0I2ng9qDhjdNcS9K/3VT6gNja8ym6teCHFm5igys9oDzFZkpihZuPxe" +
+	"imdm5qWI+CWy2U5OS2S1bgqqj7DKusC6XEH10T961PzZYtszS3ussQ63poh64+8GUW9glXW4NfE/9QZWSXqD7AlYa62xJot7" +
+	"wj+CuCfgRdY8Mm7MqqY9AfsihO/IZSi1scbxmfWxXObcYHv2bDacQCxbKJeh6oaLYj83boNT1iJmsm1uNm5nTsOp+nry2T9/" +
+	"wylrddNn//zND7V99izLusd6gLtCx8cAmmdHB7GW/pWV3BUqFDS4Wk9ZPxL8bZ83TKjh+9Vkj+H/HieO729Yhd+0/kRsf140" +
+	"zU2itW75rWzq1LLA8PBA98X9ByzZYAwOMtIx97TmWVfRNakwqolSsrgfenAIe8LEc+fwUjJ/t2OlNdf6VNTvBHYMJp36JO4B" +
+	"USfb4wD4Ch+5fr3F3JA8Z6KxpdMbiK0l/dX4+r9PDqxpZXbQdqVzx63+wgWqF2QGs8FMOCg0Mhw5fvL48ZPHTyB/8pFzF86Z" +
+	"u2DOnAVz5yxEgFVcvdVd+IV6kCSns8mOkZMsVrUEpdXg+/5t2i6Y5mSMkzm1M/i0bbdgqswU68MB/4lB7eVdWDjVz7uti6tr" +
+	"uNbT27uw11Qfb6WOZ8U5wcVT28Eg2g6iDYF/Rv9muxzlHlsPS7aLba79zXtbh0t2ivTMtjfJBeaquI/zXazbZR/IDWiYWA/r" +
+	"01gDI2j/PMEl+LR1L2pD0cGJYCQXzfj0pIKF4W645PSmvUPEfvTgfa35Qncykqy4xLUn9vy6hzHxvpMnQyeqr4bgKIytZ6T9" +
+	"XGIBxpicMOlP1MayjeS9dKyyBlJdQncrhQ7IjpZx4QIEXLhA1QomtrClHKus7awfI57qS51CaymHl/bg3zriJHLubWzgHltr" +
+	"hRoUSsZWZ7af6ezWukgaQE8bRpVgO4LgLtEzSzUK36AZTEREfqDWx6fLjEoZ5+qmVbjNgIjI/MAAHz67YnPbhCiBV/HDunZt" +
+	"7+w2ZD/HOPn5xQkqflhK17bOCLGNDdwSa63wNtJR/oLm50qT49FSrJ3soyTeW2lU67ApihVCPIkMVfmBqTqTUueq1c2A6vzA" +
+	"5M/27LmwZw+fr2M4L0mEuZ4eBhfXBFr7HHfVRiZto9Xa2MBttNYKx+Q6jRwhdErOaOToIILGE7iD9VVrPVnLYNZqdAazUaY1" +
+	"GDUyvHQEnj58xc6dK4bD/BErduzAHZYvf+cd8kP6uxarcIN1L7V9dCaZyaxRG/DICcvffHMZVp2fv/38+e3E92F5mfsan5J5" +
+	"U7+eQ34sPQllxqc4cprnOHKU54Q5eTHm3K0HHh0QbTh/uw03yvotYR1ECL1F51cF3eseP79Oh9QOGVI7ZGjt0KG1eMmEKeKK" +
+	"nTB+Sgs5TMYodzuWlKitE+ywTZuGsWxkRnqUMIeIsDXXHJNHOCZ1fBfkTextZpuFoDDIWL6NVdaN1p+QDKmRL0IoJlofKKjc" +
+	"kTsB0QikbVGaGDfpqeVKeHh6WBjsnDbtWELC8alTjyckTKKP8CFjd2NUThR0XfzGkuTkJW8sTk7Gl8RndL1+jb3ZXGs/uaxt" +
+	"oMVbWsNfC8PZXNlx8ZlTD7oP3BSqWJPMRzwvqLWNN4Vh/2yU+Zynn+EFrMm2n9s+u3Se9uMgoT2zTWagOS9tt3oyXUyLM5bZ" +
+	"KN3PcHOK2hu0eREp7FFu0KCXBsZ6jqBPskxdGdsTIUXnxToHRYQsyu7uIvjTXzqF0V9E+W8KetYkGyaXtdVZeGkfu4o7sBnW" +
+	"ejqXTHTy6cyNV/n64St27FgxHJ+cS+adxA/al/ijFb/acwZPIvgYxW9IyikhvGLPYWW/+ToktMH7LeT/+/jbjb/DjySHB5by" +
+	"Xh1CiK9o5TvcK/jdRfjCYvze89+R/OmkT4kDdXX8tQHcePgNu5DPcAmsRVnks2BWp4Q18dcGZGEX+M3G20jkZ4/a5OfGEPnZ" +
+	"d0i5MB6XMINa6ldm0O6Cl0T9umWInVuaxNnLBca92e96lEmtsykwwlrj+JxxF59bn9Dn4/E9RslVuQnIz56rniPncZqjHiCW" +
+	"e8yaHHywMY4+WOqz7cKayB7B+iAEI9wENIw+74wQ/yq9VzCE0p0D/0Wfn2cEBtglbgKi/MTWDxHiVxJ+VjYUQsQ9Bm+X8st+" +
+	"gZCwiX4WBnHSZ/ulzz5DiE2kn3W2f7Zb+uwewQ/Tuk3p9m9ZrdZbCMmC6Hfi4Y70nY3Sd95CSEii30nqKX6yRuSJtX7GHJN9" +
+	"4KZn813Ic1/rlCb+W9pPQY45T90EFOyYL8dNYA8ihBjb+4gVUboEeQv80oZZ8BvswCGMC35GmG7YombfRR2a8tbyt+35baW5" +
+	"4yag0GY4vrYS986/IPfInGZdW4fsVcJNbOI++2+snuUIvifVL82RTk1c2PT3cFuONdpWASGKGdMCF4Z34G14L2kt/MZNeraE" +
+	"JG6UvivMod+NaNE2kVfoJELsb7TfZFTHa0VGIQXlE2JmW2ZXsomNr9Of7fgeW4HvNY7B9xz47Pkndm5Ee9nsyRZjZrStf3u+" +
+	"SVJDGzFGCx4wsxoz4CjuxR6w7IXzoMP3oCt8BosgGt/DI5pyadOyzE15junvCeIYIcS/LuXnNSBkS+mhsSXQoFQGBFVoNmkJ" +
+	"Ox0JvpaptUz/kIDSUv+OUN7Rv7TULxTvu7Hg9OkFN6D8xvzTp+ffwPs4TunXa9PWAj+l0q985bpSP2XjzoCxO0EDHjvHBuzd" +
+	"GzB2J/4B/7hzjHYPkVOHED+UytWFtnkwQvw5/jbyREirCERS6h0CubdTb+sUzID9+EXoY8iOhLLI7A7QBx89BDE0F+MWvOf3" +
+	"HpvYuMZrm3r8DpV4MN6JK/ANWnYkQvzP/BN7LjiSYUZB28tQEjnK/KrQEhYQ1hXSYPRPoLJsDvTaTvIIbfcKtGwG1U8wmqaA" +
+	"u4H37sL3IFbte7zxMnC48XLjcV81vg66XVABMbDdakXJCDETCect25lyXLqwBphgGwv6PF58zo0iz1Ef63eEC5vqrrkIuQls" +
+	"JrLzORBOZDYbIbnA+mQhZM93R5/nitoDnaDPV1o/Y5aJ5RAflZvA5tPnq6yPuCVUd5TR931JLYgR6+WmSDwhZi3bBGh3AYOe" +
+	"odMghmzDUmZVM9s/ADRHPbwSsytWdZdznUq+/PJqzey/3gvw9pqYOLAsLTOU/X2E5dKWfV8XKZ0TCxgGwsEA+slbWdby/gub" +
+	"4ejxl3YyzBZ7zrYIpCbrDWxMJAqVLZjHYIoBhdycBEY7Dk+892Z0gQYF811sZq/+maMGj+szPG7XesvN6YOvZWa0PTOkW2RC" +
+	"2YJec7+5cWMu+3ZpUvG4Q7tw/q5Di0NWFYdy33o2HOMng+eqSWnABMzpfbKiV41Th6I5N07gfy5ZTooxDLiEe4erQm4IgRQW" +
+	"IOYOoxgQsKxtgDQ8EF/Ef/l7R2oT1yUu6cLKcQ8WPavDJXCYedJb7vswO4D2bw/KgRok5q8FvTZQDBqQFCHl8TWRkGd7GjXq" +
+	"s9Jz8lr89dYP3p+5BX+Gyf8+WR8etwDu/DIb+0Xou0ysqZk0u08I90TKYIt/xb/iaxAHSjGDLaRnWtY2y2DbkguklQuBFohm" +
+	"amOp7RndoKuIWc7v0bMwZoupGaR5aEZucUrPXr16PfxjDFMhYZbbtfP4xTugGaZZ5R7U6OPiQpDNYkxCCfs95aixcYFI53WS" +
+	"rI2sT8SqmNtj6nZsSg6NjrHsZBIsH7MTLBcYQNz5nTuOf91Fr0+Fv+ErSABkRfgz3OaxFD9I43U1FAPnCc3Snz4Xp2tPccoc" +
+	"fnaE8WwK0qVUqn0f22Jff6TjSHSmr3im1rgTXLzNPlUGuNl2NzfYLUT27FFQFCG07ZRRUZneyflTCLPgRvw5/gw3Wpitbxet" +
+	"AVhX+hagvZVr11bvZpbiXLwGryEgCRgFo4DeZa62fsbM4aro2YBiudWiv4kE7MAHnYxG39hLCV36erWDVBg2O4U776n0Lw/t" +
+	"zU6S4ntPizH+UhJUuv2JGXUgGWrwDDiD5+DP4Rgu5KpIWCyeYAmFbxGgGIS43iJWAgzhQOe9u0aM0bkQ65VWNOjc3pcTYQe+" +
+	"cP0jfJFtPJgX+3DrqWcJJHEq0TXbcRHvRu24SfaYoLXSeooxx4geM3E5a0HLDPlJYNyDe/010aifWjcS78TjSar5n780l29K" +
+	"j90xNv/q8W/Hc1UO8yWQlKNxdPAEKeTBeoKwVGgVAWpVsDpI5s7cri0usE0dGPwnwCf4OjsBq2Aw7goACAb2tU8iOAqDevDf" +
+	"7gglE+n6JeZbwMebOIxJXhVUSNuSZ/2Mz+SqyJxVEou85bgQMz2P+/ulTsYoX/OlxPhKz/b4Mb74MldlRdh5TrJtkJhJBEMo" +
+	"8T7QXEgzm+d017hL/Fpk3wt2zFupnTJqTG1g4PF5kyV+LcZDylvJXp3t48e6blyOH4jkWpaT7JvgmWrPWwmoAJdIXFgIbNmy" +
+	"m689G1sh+9bspUs3rpw5Y+fOjcmhJhP+aAYlwNq+hfXsf3zH5hVtnM7a+m+mxHmVZONj4hPo3POScqxIovM6d4nvUW6OCQFm" +
+	"6vDRo4cPGzWKKf2wiy1SfN1U8GOusNNI4lDy4z3vWFN8+PY5CAHSWz/jhoj8N0oprq/ZIBi4rni2w/IgWE3S+7YlElbCUP4P" +
+	"uieKXCYQbZuVcobSlYuluptj2HxVePZfw5L1P+I38T+VYyA1rWRorw7M7KWFF7r121W5EF/Fh3tU32D+afwnOxUWpYhjOhGX" +
+	"8LHcMKShFkcUGU2S5SqItNyoJpf/GoUcBDWxQfTszsUz1+NkfBmnrJu6SZ07A8ZETuHYMG7Y7A34c6xIIdtKCvxCOuABc2jG" +
+	"LwNhMXyD/xQ+wJOrf1u4DAEKxyXcJK6K2txKLSj9QPRndCbIDta+iJnBr0Exnr3Sp2vEoI17X0yXn8MvwsyVfqmhg85NfKkb" +
+	"8zXMavwFTyqIebjhtTA8j3WFZYXGhytep3N/Ei7hzSK3Koj2GG2SaKcptOykGZCGU/Bl3BVSZ0AKoIG/MO0tjyy/i/Iz7RiV" +
+	"5ekvA9kqyrPzgH9Kef8QMDynkCu1gkKucWe1jDlGITdo3RTy4CBW64c/x2NfBtWEmYM5SOUGz5wAqpfxWPw58PhrAKYIEHR4" +
+	"hK/jawwDb2Ezw0AcxD7Cn1qR5QgAXcM662fMKiGS+A6tY0WbyMVuE4nP0Wr6vKx5fim2SLS5RqMmG0qy0TY0e/8B/1R8DjRP" +
+	"BFsp2vC4hJxFKLaOKmBGR8z4Q1AOT2GdZTJzA+f8AN6Wc8QBYPmS0VoSGx/jEpjAzLGcRCwaaP2RCxaCUBvkiXTEbiW6k9w8" +
+	"8BxLSfVZxhyjDVC6Q4TNRwibgfNOUyiGD45MZtTgsqEIX8GXZg9wkk1eAwKMCvfEIxvYqNwpfTsls1vDamfOEriqqb+/i4v/" +
+	"/OkkzIbxl3/Ec9/Bb3Jv9V1x4CliUKX1R24IlYFIECNudhp3jWAgOjdG484yYnSEkndzJ6EFbKag65WxhoHsTf1eewH/Dhd/" +
+	"nTbOyWn8NPzbs5VzeOjMr0yrMaawW2YX1r+yTdfts/apI6AAVjuxn9zAc/CMN2/fvgjFgL+6DnN2i36NYIT4jvxtOrcFiYiH" +
+	"rHSZIkbDKd2IFDLBoOeyXjAH4316/cEhI4xboDYhHwYPidk5QF+dx23Nurlin+VFpnjyuUtF0V6gdVNH4HkwIVYeuer9Qnc3" +
+	"2/lf+FIcR3Lul8vYwfCqeCYXZaAc9y7cUMJxzw6mdoHE2YBkyFfE1EppG8S4P2o9iyF65BAFCtG2YifF5uf7eeHLl0dvnlb2" +
+	"FFKelk3fPAYjT/+8PHaA5UB411Pba0MbF+CRsNZ/yh5oD3B8hifswtWeM45jK366ZwqwTJ/Q2u2nuhJ8tfWBoKR2gh+9vXZX" +
+	"y7UBLKMlbE86BWgFtdwYpdCSP6IFt+IYPBbf8ffHd/DYmAquryXGd+YASB0wk2xRW1dajkIA/orptXIrICtasg2/AddwHHTZ" +
+	"Jo7FQFzB/c4NQCaECN1HtFnG6AJFXuRovZjxJEDprpKZ3YwxGrLDC1Q5i+zyJndjFHvxjq+s/YKyH3fFGPb7dKiILoztAyM9" +
+	"+bYLyn7cCbZn3je7Zk1aMSV/eoe7sX01PfC6ix1G9dicvnj2CAgKx49jq5U9oJartz207B0WWP5zAmJQOS6R/A++zb0PyqYR" +
+	"oRuQTLD7H1R457szSdLvNOKIOD2jue/hmT+uhD1hy20pv6kj4tZymkO7hNgGqD3t92ZxYVJttDIxFHDqcHNcnHn4sFizOTYX" +
+	"71pbtn79OuCAX7NuXRk7rbgEXywpLi6B1JLixjm4DPbrqrYzHbZv377d8un2KrHvy3EJ942YcxRsYYc2WJJJArVKGc6DtQq2" +
+	"x2c5w4YNmj06r6gwd2OySqPxM+E7h65eXQ+z4Sh3ou/8Hx7OnspxZf0Prisv+trXL9dHf5IkUcv89STge5Kt8B1XhbT/UZ8Y" +
+	"NxQCCub8zuicnJ7BY3KLCnM2Jas1Gn/TJzO2bp0FnaAnW5XQe0ddWg40q20mYbebbbX7P3pSX0CIlBe+hA0WeZnMihYHFV/i" +
+	"TVYILcBYB8pLDnVJmmjq1DuwZ6AeWGyB4uIj8cmJXV19/Dr30AWlc+brw2ucld4/+/jEJS05+NjS71ZNjbOz+hfX9i7xCWIf" +
+	"j0Icd4C/Tc8OLLAC8T8EGPRmJdCcDYoAjTuTUMJcuRIc6DsNf1o1OTrPMn6zj7f/ZDBUjeJW4R2QYBk3o7MBN2aWvgzt8VPm" +
+	"pfn6EECZ1CeONiLE+QpKJKNx2EotaFnOFzfgX1613AEluLzObm8cyExht1qW0PdrcIngxj+hY6DQKYjBoA1QupEDEtkmxXiX" +
+	"CEV0MjlbMoG/X3sNyuHwX0/xE/zt3Wyubzw+tX93H2zx5W5Z/oZ3h/mB6l18C79vuYfT8f5HTEfYO7NH1AeWNxnTX6uWb6F1" +
+	"dsCr2S+4ycgdoWCZDgygo5REpgCNu9KN1ZFULW9P+h5cDPjc93AydEX/WtB9fGPc0tiPXV1PnoTtpbg9s66wVxf5H+dfxu/j" +
+	"Ddh/6aJVdP7myObxt1ECQjwZOWkEFdHKGLNWYAW13Iae0kqxq4poA2PQumvcCMumTOBpeooYfsz4SSNCO9aO3PXT7AUjUgZC" +
+	"QHzfzlHGvpXhkVBcXV7dOSq8Mh78h3UZEZNy7RD+e+HSoUvmn/KtLD51tLLy5TOw/m1ou2tWJr606zT+fnjt0NoDv/764ge1" +
+	"40Bxehd0zVy4GT96Hf9y84e9S/tHub6gNnZDgEbgYn4jV0V0fLDeQCyWaKWWUVOLRU4EZbVuapWMIWJSIdn717288G943Rjw" +
+	"qyLGS5sBM6vAb0xU7f43N8ydMnBt7xt8wFe4EYBJ/RJS8OVvqQmzFQ/keIj5ljz58rP5+Lvp6yJDnZfK9aV2HcAX80/E2HJp" +
+	"UQa0CIIhCoD5YnF2dnZ3UGRnQxnenzaucsmPy5cvX80/wT/MmvnbL3NmzZr5G/Po7znM4ondEz79DIqh90d3kMRzUEJ5i72k" +
+	"OuSOgTb2WEGHVBQ28uLJkyZN5abhRltkax0T+c+f9swS+0SbKEfoxd+meyVhh6VbhNRlZocEvmIUF+nHC2B46WKX9MP1v57r" +
+	"3n9c7fkFE/WdRw448dLA/iTLXv6QYdy0V+qx5b0dJ2avyzC1+9YtNHtw394jXlg0c0zt8As4ib9t2bqIxKKgYOt97i9uK3JH" +
+	"2eLpR6yburUkf0eMxqZW9PZsyvQy36wRZPZIUF2gzEA1kggCZV3LhyjCVF7qds4KpcJb46XXdQrRHSxcM7gopEd8anSnAH9t" +
+	"VNc+o/t/tSwx+HJiz5mLJ+M3Ng/vGZ9ZWr62ZmFV977b8pLZwEGuMneF2s1d7ePajufay/00+9JjO7RrE+RbEJ/VtWtadLo+" +
+	"YmVe1cCN4y6fOrF1cbiu67u54bo+3XtXIkD5uISz8H8iLUI8EUshaAMAKZtiDrQx4l5EJinjNnr83xYrfoTr8UmGe1aYkz5m" +
+	"RE+lGr8Y3WsthICybs+yd9oOuoCvXYTDsAByRyybXNJjun/6pZH1q9f8+uFr+GcRo17CVXPT7HWqBd3zdUqBDgzcLp/8xduf" +
+	"/YQ/wb/A+YeZKfFVg+MUbvh2p27TG39avGT0l+lTVz5cBSOhCNx6FveMj8lUh6/qtWbEiEvrV+AfEWJQNUJ8Hv8EMZRnX2vg" +
+	"o8zANo+Q1njbz8+SZ4YFmvhewaRA582WG9F+oPrML9PN099fpzNEz+/h73Hjpod/yXSTPjDIR+vhlun3Gaj8os3PgkH3zz+R" +
+	"cBX6R3AcD0nOvJDvr8evQk4H33yBd8b1HM9F4J04IRLfAx1iUBlC3DRumihfsJHIp5TkM9lvL802+SR0MaskTjetCRrvjsRt" +
+	"O4X8ftqri6vaV+Pvow3bGO+hWbxE4xG/MSzA19/DVyWP9zr9e0gn72dfvrVzZxz0AS6Y5dhfnTjO6O5/5Yq/u5HjnH5lOTYY" +
+	"N+IDcW+Re2mG3j3oBTkKbMZ0KRcTc4kOXm2Ts08mKJvChol6gfrOE6pHfjN2wYIF+B6u3/uCPnDrATCfmvFK0Ij5s6YcGVzx" +
+	"zbjEhITEceMSk5KS2ffSYzuvOfQ7/nLvvu3bIQrMJ+omz1P5urzj396lX0njkqIiKCoqKi45cKioWDwrb7He5z6i8kX8X+Sz" +
+	"iXe347DSATcHT548GTfgb9atC/RfuglZl48emjWyT031vIqit2kY87vm2Ni4f5FO6UGlK2pc1Fw6QEZcwp3ib1NfGMGFEf8O" +
+	"parUKrhTPx/A+w78DOuhnKvC5XOfeXLfz4V9MAEvE+8ccAnn+i/fdd1+Al88sQ3yIJWrwqtHN/zJO4+G0fbvDrT+Su3sTgjB" +
+	"v1rYUQ4WtkLQEXuf6daaZf3LZmh6hse3harWLWr7M3w3HKZGKm3+qUzqn3JHfghpFBLFsY3+XWXUqrUBKFCQu0fFyLct3A3B" +
+	"368SXVMjmDjLNVzIzH531Kh3a3lGC+23nTto907tefchH3kI1x86CCkO98VuAsq05YWFe4LKTUB5ze/QoJr20XTrffYvUf8E" +
+	"i/nvJdF87VzcwWpepGYys38WTRyFf9myadMWUEwYXaSIG109O72bMxNhec95xcyhQzI5FzfPbVuZ8K3bPJWMxdlNm5wyBu7C" +
+	"2Jla9/a0vvXW+5yrWJ/ZxmYebOL1Boc1Llr72kDDej5zyNCZy50t7zERzpnps6tHx7lB0aiJoNi8efNm/MuoiS4ga++unTkW" +
+	"7sKYlGStm7OFUXpu22r5YOs2zxZ3gnX2O5/btD8u2/qD+5D0B9LbcQZzbP6GNlJafOpwOAtREA1n8Bl88y6+iU/zt4mnuSGU" +
+	"S8c94fiz8+K+vwiXcEdFTiYgbkfCiaTQKmRM9hx8EeasXQtz8MWZTKe7z/IFZ/w3OD2rY/AH4hxJlHyYMhSAkG0yBDtOFDG5" +
+	"OmGZUMhZbzojLFt2QMi3a8XJAj3xcXyxspKdJE2JPxymCn5wZfToK8wGaIMAJVrvc6/yT5A3GQc/EM93dsp3G+W8PrH6tcHn" +
+	"PvpISgQFPa5evXoVvzT63UIwdU8Hp+9/AKf07mAyx773Pt6Kt733fqxDn+rZlRJmQYw5lOqU69m1NOfelD7IITcszbXLnaB+" +
+	"gcMtnrtwMbbnNN98DX+Jv025deQ0gZBo8doC2KX7ZBubH39pyWKanCXWTMLVA7Qh+Azej7/g4nEqfHy0onwN/nHpUtClpc2d" +
+	"J0UTWxZxJ8i1MP5p0oQHjvluaf7dFJL7mLXn5CZtle5fJB0ikWgqKEZfq4D3XE/VX3ntyhf4JTwZFkOPL668dqX+lCvTl+k9" +
+	"+bsV+OYo2A01MAh2j8I3V3w32XbfPd96n+RgtedebXlPH48QM1Y2XC6gNa1gPRhUhJDsiT1vkD1rEbnAkTO8IoZmCjUGcGoV" +
+	"x79eO2jgyDFLsTf+5zhWfVfGXliKrKDCj0Co7FveF6/ADdwrFZY7WIgFXWfowHQFL8u1L588/lLKwYyQbDetyxshoBUZo2Js" +
+	"OYpoHeK4CJkDRo4dWzuwYQCUwz4oWyZWY8XeuBzvgyukrkpujOUaEyeWz0yxLEJ2HAIZdy/RG6xWaFsbc678aEU53vfcULPb" +
+	"J014gE2j9j03xjYswXWST5c9LuWifwUmSs9pPlb2qJRD/gG9azYhxJfS5zTvo0bFbaH5WA/hSwSTgNoiZBOI/Mcuwv/gECgH" +
+	"K5SReyICDuFONIQ+jz1BjE1nIWfqVW/ip1TaelPKLPBubWR0dMTIm99arrB/WwZ8i6xr5s1bA0JYZN/yiDAyZPxt0r4Jw548" +
+	"HjYBPY9rccBI8AiJvHyH2NcsdeR7z/KJLCPxJf4Z/Vwl8WnL+SSgE0dqGjMCJuIvsKpvNPvpRdzQOBs3gI5dVW6ZfTLjJrMY" +
+	"vJ7lW7ZDV3yJGdgSCyPeazmdEnMrGdtI98T0lo3ghA3gzeixGoZDA5RBA4zAKkaP5+GZu/H+OjyTncWUWDYzQ+jP4cY5zITX" +
+	"cT6ceN2yTJwvU4gPmn+COkh3o5wDE79ER2ALRFTEBNFJKrCrFq/At/DlBR9UV19aum7dpqP7j+6YPX3arC+SEsC5CAwQOp0N" +
+	"NlzZ8MX3+iAIiTEPG1Az9O/yviWVkYHgpQm5dHneARv2jMyDNuLdobiR8H3xGazBM6A7/EAAMxxqRPztRsQhhFqZayqiDaWy" +
+	"rttxbPbuOQTljB8stvzEROO5eMQJ6gEnXfFC42Jm+g2cT88XCPGFYs47o7TixfVf/G0FewHvw/8cB50P5DCR4NUQyp0ot9zF" +
+	"/H/ieEKfx+I4tFdmuyvVKvi+eD9J405beuJZPn+7JUan5f7bEneDQFxn/G0kF7kkJF1vpp3AZgaZRuBbyf1m+skE7AvDoCME" +
+	"wvC/i2NqQZfh6soVWHozB5ENryNcpxim9vQ2SeWuUbgATzghkwC0ao7obF6tNQWRfwRD/fJNm5azdeRPyzwogxxIxK/jV/F+" +
+	"/Coly8xh/tm40IUpdlm0ceMiF8uLLgvZsev98GP8A/4B/+J34oQfKMADPMDND7WG0zmELwkp4piG2DrMqNDycc9Gi1riG9wg" +
+	"KgmyfMT5vB4hPkS0hbUKbaDtgo3ql1u444tnoTytCLKgjIn/6Xu4gX9+NIWZ3BAq1oeQUxcHLJT0H+8PV6D875FQDlegDHRE" +
+	"J4nVijK28RXrA6NC6/ifU5dvcMPfa6WvXYFy0JFFbpOYO4HEs5wwRMqb17T/gOOOwCWMGDSw9m28H6ez7g+krcByxbbpsEdA" +
+	"J+0y0px8h78t17NX6M3CNu6tFvpS00xfBktculRj2rUl89e8NaSKcqovm7TltcfDJvC3LdcmDEMI0ATrfY5gnUgeJTHljoLm" +
+	"xKFHB5pUyTDxXH39KVfLC0xf11P19ecufwkFsARPwi9/eRkWrfhuMugmf7cCTKNwP4sJ9xsl7ZPW+04u/JOme2qthOgz6A3i" +
+	"scbR4c685HngIKQ1YfsOHvA8Qlw2bOmuY8d27Tp+nJ327MIFG7DvwoVnloP8bct+qHT0vpN6JyDE3adj4U5nP0kKFyi0EQl2" +
+	"6AzUAePar/bo0dp+eDAUZNz8C5yZTnj/RHybQ4PLYFbvZ1uZqaGfbPjq24ZQxmS5iZryK9PzRro9j9AZoRT5oY4IKW3+n2Di" +
+	"++GJpyjGaEcwRjteaw8QXKKrZw+Hs+HGrAy8e0vKEKf514CDYHqn/Qx/znQcNnr0sGGjRzO1B9LSrs5+FBFi4Np8oQp0jSjY" +
+	"UO5Iga4Hve3iW1z7Eh8hxZ04ZItT2/M3gjZKShhHiYWYCbFxcbGbX+iWldXtBXwR3vn6evfMPv0/+BgLzDljVFTUW1ifnJyU" +
+	"1MDOXrGtcJyP95phe3Y158gzUvq+bUwjzuSq7jXOoH0zGD/gh3FVyIXeuieBwmTLQqpTgE4tl2l31pSwQw5813jzO9bjfg6E" +
+	"A9JbYOhHjXWWB+CNHzDeTJU/XvOB1O9LaL9n2fZRmZyr+td9FK7jcqiGYkiFYqjG5XAdv4Lf6Ikv9sRvMFvgGl4FY+hPnGUw" +
+	"3CjFK2BcKY4R9U6Z9T57jptG+46OlpSVn1ghpN+0gQaFOIxaHbDr51d99PLIvVfuv//GF3/ghtf33Jg0fyJuXMmcS+k0OAPY" +
+	"iFWb3jQmfn30WCyoKguiljXlLhkjcuk37ZfcGHwBn8cvQyqQxMZ1TJHlCFdlOcIUNWGsBT83PcFYk4gudNb6qVTWdXseD3s3" +
+	"bINUGAzVuBDex6/gC+U0teICmEab/G4VAQgDGoQQd4SrIjqPkgSRMSIuB7mMqdkV5M0OxhfvJ80EFDShD5w+/ayOrQrG3Wfj" +
+	"RqkNfDfx7KeUzn2sjiUVj/vmPozHlw5/9CKplBxnn9Ux03AF7LUsaGp/P7vM9v+2UaBRGr6Ar1BxS+Dwszr4DBtaw9XYynDY" +
+	"g7l+tq8SURvruCrbGZh+rxd6zmeAgHyfCxbLUZJpTDJ+cr54Hb6E19DZzM5ryh3OVUk5w1k0CJcIlymPVhD1LkrL26zQK7WK" +
+	"GFu2O3IqFmzZ0zR8oEyaO3qmLL62lgR2mSGt39zXfvnltbn98IV+80gatXn94PPqmJjq+NpadhKB4WRDYzazA/t1t2XB6447" +
+	"wsfdbXnVur+Wjbls8qLVSrFTS6ic5WI/4Qe8zBHXJW6+XEDjNLG7D+AH4M1RhjKy9JDEc6zlqhz3XhrvQgzgL/CrizZAanTG" +
+	"EUiDl18+Bj2unegDHzyrE8dEsEp1OYwrNxIKIPWf7ZBK/gbEVj2rEysU5ZMdEOsKaRKQ6grh8j93pO8UQOqB72xCSoIyKAgh" +
+	"PoDqOpUt32AoqHkx9D/KXS7o2AHdsrplvYAv4v3M9lPv3P3i5ldUmX3FzruHSw/g9XultcU/5qrkBvSUk1MEx9f2nDOJXJW0" +
+	"19J9lnIrkQNBlI1PiXUN0mW98EK3oCDLckg9/fbIkSNHvo1lUJns79cQ4M92xaW9ik6dKiwU5/406332T4ljXRsOAQ57rELc" +
+	"e3+FF9vNwT/P2bLDlrhw16bxq5cvaod7MZtytvSHoCQxcWESBA/YWCxxjh+j+ewCxFyrz221jrvPNkid57ZoEfD2fBvPFi5y" +
+	"m48vsj9J8CquCidfXrnSts+sWn0ZJzUBrMS9PRYhzomran2PpWaajoUz3QrHjy/shueALHds/WfwE7445msGZ6WAa1LjWfgy" +
+	"8sVhu7Y/q4MteLBtjS8Rat307A3qS0lDC5DM/jzS/nwYepn2ZRDO5N4XVMjPzrSutq09ROgCbDk7tYqgOkiFS7jrqiOv3sHH" +
+	"Px09omhE9nh8kZ13D07i3Hv4s0v4Z/wDfgOf1H2sfP/jszOPbrnGzrPF5lykMl0nMwNNnCyz5/26IeVJ1f6LM4TthYvaLVq2" +
+	"evymnfgX/LYIhpu9dOmctnAYbhVvHIDvJIFZQsLhu/235Ih2Rj/rfZLLwE1AJTbdL/k1RV4df4SYUiFSLqCXn/d7Igb1REh4" +
+	"i3LQ+4m5kuhakDQ7vSRT2O0C6MwOXvr2vXtv4+hvkmfi7UTv38vKysqayFWJK8Sm9aV9wGKmK0haH8JaOge8HNafxtF4plVz" +
+	"t7tl5aRPXIKjiXoW1c6Sd+7eldYiUfGiuhcrFMcWIe6cIGVotI2tyABBCDNbDmz3vQOOv4rP5+bWjHUc1v59H9/Hp85/Pdpx" +
+	"PLnrdDzfpXNpInoJyaTnY4Ra2/6K0tATJLPpfbrvfirtu4vEfRc/sO1hoGtqMPPkO7xG2sfWNGnWZ3WS/4zeZ0chBP91cU28" +
+	"n8/fdcPfrd1YL1zw7a67IS3utmF87dAxL9BL6knQ3nZJ/f1FXHPi5fNHW7nURk24NBrHVYmkMw1/gr+N3MgogNrRs2Uiw8Ha" +
+	"jjnMuW/txxn8DHgoBy6OONlucooJ5ETDKSCorg7fbfxDPOuIOiSInmU+k8qX2JEcZo/dZqW6++472Ikm8U4lobWiwcqcPvjq" +
+	"KwfYTYAIrYAVWbqLkxPI2UP4iH9C41Uk1zvN0mvQs+JwUSVpI9skd48K9oaqYMSZweerqt4djV9+Y59XJTl91BhWX4Ueo9+t" +
+	"qjo/+NywRC+mNzNlS//56d3xTXPs+8fB3bKInKR+2vperBnf7J4+P7dW5IgmOXTu81O5aeR0QBAo7o7U6E1nIKOdc1WUhK2E" +
+	"GrzZrVPOotz09Licgrx8/MvWyd4hZOWE+kwgzKq5+bldF2Yuyglhjj7NjS719IzEd/zlrtumvE5McJx3fpLEoRpRGokY5IKQ" +
+	"TEPPwy5kl6UdoGO1oG3LyNzx8V/r8Pldv+JjzKuw0/XyJaaN5S/yw9+25MIOPEAcK8LI/x5X5VCGTixD2Rb49/C3r837ed5r" +
+	"+D6TC8780qUwEG8nP1yV5TNwwn/b/aZtzlH/l3tzOcThJvK0OdskD+hxGBkBphxkzSRjpoh9zsTZJKS6SPY9V+VQNpXPZl8q" +
+	"iZyy7/CD1+b9PP8c/uZnfIRqoms/gzO/bIlNXkIjylXhRHjdUW5b/JOG6LkQ6oYVwRNqAuUwg5bMMFBq+Xp8viq2CD/Ji67C" +
+	"r52uWYMn8AF44uqa05a8L3HXHxfk+WM9fByQteBHGH5rrJH5y9LGOPYWbIbj4nkSIW4v5VfzQgi0SnAoXqGl1dIsL6wPvrAb" +
+	"/4W/WN99IP6U0+OPB2atw1+kGeJvxOrT2Xn4huUVUG3PMzAJlquGvO340e7iSDW+CvHqzsXiODhF8LcRg7QU/WsPSnOsqamB" +
+	"/PQvcddPcQodCzn+VGoTaWsx/lVsawDLwnHLNfu4kHbhDc1aPOJtnbRn/EbbGEDbyEt1s8+1ktTNDyctnYq30sGq/5HT409o" +
+	"W9P1XW6Y9Rn4y3Wkucvso/Zcc3eDz3oay4JLuNGyOWSHBBoTT1MGUsVgjJLu1gVbAlF7ZhOdKcrdGCAdAgl3mHQbr9brAl2B" +
+	"U8hlHDd6y9Ir4fMDu/eJTOh4MGT0xLLikGS/+brPhq5PTp/1bVFs8ktd6116eoDv44Mvzpm5fPeM3AB/fe9QzyCnENcSmPQH" +
+	"qOHUO9NGupR282iviP7A4Myzptxrq0v6hhv7LAhu/zdz7mQv8OrgCsvxjB8H+umdpvChTyPxa/ifjB6insnC49kVQjtU9B9t" +
+	"09kbZ4wyRxkd2xcl4S9MzVpoEJtIjr3sipGVm4LG+narDO7kPyugYlBuVock906d3C/3ntUlqSggJSXQ2ClyWectgtntp26m" +
+	"xJUTe5fa2tnJw6udEOJaCvI3voCkuuLuzhU9VE5tDSd8nTjonHG+X0ERBHUwD/Ru8xUzMt7fo8svXu1gha2lHIT+HvbLm8ZE" +
+	"2k45QrI90p0IlRikaaukSW2VouSyPdGa9j398Vd4Jz6Ad+JxkPbsZejz8qauSTnHe0dyL8nYksYPyE7CVTU2sBmNr8EH1xOz" +
+	"pVwcclwiWyIo/1cdS0zuLj3EOi7b60ghdWS/1DuSnSrWUQKHSR3c2Wfd7HW0vJd2PNsyZvvdz2/8E9JeAs13IzWKmwM531fA" +
+	"oLsDth0bbvKOV9bo8VY8gL/97ARzFKIXq/Fl1nnssxtiX3Hf01zy9HyeBLYxlYFWDmnPNmWt3t070qRp39OPtIKICR98MUb+" +
+	"K5Uc2e9qfqP3gBruCLkH5NrQ57RsgntmLnNXEXITOGd6Ji7BmU5B/BPkioJQF4RATrC+LEFkuBmjlO58tJ0eT62iGGNzjKmN" +
+	"RAQbpYkSAVVm0CpjlFzvqav/+mvVtGmryJ94h1pXU6NTM+yuGp2a74x7J3Qv23TOzc+vXWFQJ12gHz4GaXtMI5mf8J17+Blw" +
+	"X0AQBN8DHjd+sXxt1ozp3dZ51XgpagPxy9Oz1jLyZzEv5pQ9BC/tsIGl7HuWHw7DSBqzhTNl/Sk+1xdFI2LuCU0892y0Q3aD" +
+	"KHPMvwjO/jl38VtvLZ43j/z5jptfUZGfm/gn9xru3SW7z6bX3Pz92hbqwnQ6X3wcUveYRrE+WH/klVeOwOdHXnkFD96QOWZM" +
+	"5oaNGWPGZDDXWhVVxOjIqvknKBClIoSI+RBhv8rWCTKSBcVgA/dqjTFmt0C9oSloL8YcQ77SlJxAYM9m+/j594SM06dPn8UX" +
+	"egb4+mX6+Qb0xBfOnj59GjJ6+vsNyooP6Lmzd2lp6a5Cf1/vXG9f/8JdpaWlvXf2DOCfuLhqL1zEJ/DL5y8Euri4uAReOA8F" +
+	"kH/xgtYVr/EJnDT5s08nT9SST7QTJ3/62eRJgfbYnx+4aciA8ilbgIOEooyOUsqkhplt14Jane2R2Fqi37RG21eZwxEjYgiI" +
+	"OlyQuWg9u3gFuDgJEQRRbR5ubNte69XFM7D9x0Zwn71u3Sz8o6kNfeTrxvOR+Kc569fPAfeItu0DPbt4aduzk4pKdu4uKW7T" +
+	"xk3Rrp3CrU2b4pLdO0uKXMRfby/d/uOj7Uvakd9cVbxsyfZHP25fKn1I1swQ6/02r8pq5Xq3DxGSGzhvmYlMMM5TWA4GqxWV" +
+	"WB84+Qi75Hq3j8TPhX7WKPI5fwr1EXWGbJ6sm1yv+ZR6KP5ADjY7/5fcoPnU2gm/JBfYP+3Yb3JX7yn5YuxzwWbyEsc72wOG" +
+	"4P0d8c4TV6+KRq4IgQCdxQiHL74H5VDzvmTX4r+/uE4NoqaYMB+EgmkEna3oFklAmCPQFl/Af65YudVmtYr5AFLTGywVcHjt" +
+	"JhBAvd2R739VYZGohyOtF9jL7Pc0dkimkRnoj8iRQn7YwuL6EfXkp+rzfq+L/yphy1+Y+d6MvTPem/7q8lMz3ptBf0Pw7Aj3" +
+	"mXWAsI766UT0qNASsamV4KRRTZB18p+u8Rzj1Fap9NTEajxUyrbOzm2VKg+ND6Mlj1UeHmYP8rhNG/qLN1/jqfFVqdu1bdtO" +
+	"rfL19vP3pr95uTs89PcTHyKwCLw3QiL3rELniAmao9qyIPVgRXH2hH1g4n1XB5Ur97SPyEUILJVcPS4RulHfcYTJKNLwWfr9" +
+	"OR/m/cl/M2j+oqmAHN9zonrYFGXWmYy4+M95MP9P/hv8NUxdNJ+815DBXUBthDwiA7hpo3guWB1kCDLoZQ0Z82DqFAgGJajx" +
+	"+I+wZcLFxdwDGDJtPOzRVIyRtXsXIeT0dx5ey9TLRlD+Bh3qjLqgbqgX6kfWchO7mOO/+f8vngc7/BufIpRlfZznUMqyZw2O" +
+	"vzn5zXHus5Fy94Ln9n98pc+E7eC5g784m3xGqc48djTk/vtnlhDHMvFaiRSN7S79Y7X0d+OZFg+4r1o8sP2NnP7+yPI6E+0U" +
+	"hnjUFimQBvkiHQqhM1NnUhrbglFN2FYM5mANDxoZbwgGmYE1K8GsUcpYUOiCTTwojLw62GL5ff3h7KfkD+aHoyPBONmKGs4c" +
+	"HYnfngwIvI7WDpyyiX1ydMTAyZsa7m7DX0GAsGArCdURuh4hYPG/6yndOf/D5SmjFh58Vkf/4gMuT4WSBfjbBu3lqfjwAvBh" +
+	"X8G/7r1QiH8D170XCqW7e1winJYNl+s1nzXd3Yu+dn6P4CfXaz6XfCUvWUlO+VDpzpJmeyJ+BDsXPWEt1KqNamXTRSbDSdgO" +
+	"q+XKzJlQPjNPusvkep8XwR1c7/rXXqtvPGa7zKT7CEKIc+d3SnUYFYQFv6kact3cVIca7u/evfTm+GHDxr2No+vqIHVit6Cg" +
+	"IB3xeVnRod4Fp14p6E2CQCHpWWqAf3IXbUCDdH7lXSlezkfiH6DIH7PBDEIodV6TlgkyjYxdy40cdOlSRuTQfoBmvvUt3sck" +
+	"AyxZAiXD+rHn3QIGjsD9vAbMxPG5lgb+9qyZP52fOGCmQ2wvsdtCxDoobpUg6t3NBrNSkAWKhzN7WhMS6KCRsWPdRcSqebBe" +
+	"O3nhkaScoT170nS3aT17Dj03efJkuWzQQpifNaCuqKi4CNcXFTMudTMsP3tC5/D+lpOCM8lgsm+vpWGv2mnRDNSEl5HJkMyG" +
+	"l9EqDjGvgkAwMxBlefFZvvgevQvkt9reIz/bGNM14ouAeMuPjTMQoGiEuC0iNsN+N0CHhdtsqYM1eBTed+gQ+xp3An+J71nW" +
+	"MiNBx6SLsXzsBa5K/J6YvZnMGoWWvYAzIQrfwhe3bWMa2XkP7uFkuHIP6iXZcQ2fyN+mJ1wxGeh/oOcYDj4+UlFOoHOZMSNi" +
+	"YwTe1U0b0BGfxfsIQkhEU73ZCnKOYIfs+bhpzlvRk0UgpQ5EmnSiUBcm3PvxbHV1dfXZ3bEV6ekVs/FFcm3KbJ446f7JrycR" +
+	"ks1TiypwL67qHuWVuETxQh7N7zmNBNii+zfQEIx7BR8Iah02ZNmL7xFEVjPwEKnnAb1PbVmPWuFQz3OXqpGb8MW2rV+r4vR7" +
+	"xPfZ7G7VakVT8CiCUZIb0FQ0h8a9HqHPy/Aocucq16OpOvL0bWQbw0sUCyS33202Nd0OCIJpZ/H+oGaQIMuLUjMpMEj01V7n" +
+	"qhzLsTet6aI0Zhtpj+NVqa0ZtvtSGzbJjZZjVhjVUl45nUKUaOQiCLGDlI4vGimKNBPSDkNPilRyw+dgwAzctG6oXEqxPJNR" +
+	"bSblsWqdwiCKVjHgoO0S98sBIIlW/cPsE/QeN+UJzARJtlBJNloW4YF1uBLXmYyHoHzmzBYIqpkz+du96vFRBxQVd7keAWpH" +
+	"feQqqSyy5pqVRbosGN9pcb+8cqWgGvsOljvcMXO3KR8YCkNIZuJvIxeHXHwyEU/nDmVUqoeMUZRqP9a0QHYR5CKy3Qnwn1JM" +
+	"AQKtKQSaioIX8RRYJA1lT/hAkonc8jW79BaxSDZ8vrY5zoevgD74AD5AcFwiLkkE+jS5G6X71L3ifWVwi+9zVSSuCZ9vuuS4" +
+	"h53Au+l6Q5pDsouSHmz2dRuCjNRM9OCz/KZ68T3bfBE8JV3o+F217eab1MpY2HmNM5oqvWfXh06bRBzx/9aHCi3fl6KFJY1o" +
+	"bgYmBryPO/nvOlGEv0k6UXidq0K+/6UTyX9cvx/P9K+u7n9mt7kiI6Niltga9upzepE0jaui+BLrfeEg/ytKptkrwllTdBJn" +
+	"jPLj1CoXRuYHNn+/lG8/HBhTtFsSmBUurFrlx5CPk1hTdDijC3RhyBP2RlTvlGAvY96gmdmZY0qTQ5TukXlx3ukdY8vDO44w" +
+	"Z+0tzH19/BZ8alDPHffXRldlh0WUTs/PrHjgE+Tu1M4zxMcr2LOt0j8EQiPGzN/SO3XpvPGlUVFFo6fNis9Yv35lkpfM3Qt/" +
+	"rFGHJsSFj38XfA/WgebdaTy+1y5j0uExA16al6ta1qFfzVCjeXRZXFzvEcb06UN7knYOst4XeG4qSibnY2V0EuPYBNpsxhjl" +
+	"x4iIHjE9BKsLDGcM4l+8rZWCWlWTtfTGnOi8KC99+oBEn1SNLqmTV0S/VZWlKwfF9B0e1FsfURDoGtjBqDWWlRSGdiiqHJY0" +
+	"9PKaErbOO9jd2c1bKw+MZDrM+3R7b9aKeFPphLSc6aURru19svsM6dJ317iucUPW9pl2OUfevr3qN2dFO5mzu97HO9jDuWjL" +
+	"x9neqZnd/CLSO6qqSkWelHI8ivtQ3Asgn+4Fp8TzLB5Fuf7IHrGfPv9YwsFfIljJltgC1p9EK+KDOJX7GKeJ61WEOiNAMvyA" +
+	"YjvaNq1Vcm/FnKYgEwLw8MXr6Bpl590TcegtuM/AhvtAbURfnBYiFDqFO6SB63eQipP/4qosTszfzybgMbAKIbB9H8kRagNN" +
+	"qTdNRFXx3TG6dg3vu3YNI5gGURDF326su3z5jz8uX2arLI8YFWpWZ1MZOqkMUHKrcTLGfz19ipOoEFzVswnJl1evvpzMLSOS" +
+	"iHnwLhH8pR3LqGtDFSXRldypE7hhAH4Tv4U3wAc4BYaBHvQwHKeIfQcYM0wDcwf7w9eWYNE24JZI+o6W1ZYWRGiu2D/X4YvK" +
+	"+18RRhC8HopvvQ/FeL2o8/rjnUwioyOp1y1fSuMn+7h1vcsGghXKie59lmMbRxEK2nwsZbLn9a40njchlVwAPjshjSnREw7j" +
+	"SvCk+JIwV/IdS98VM5n7gi1FG1F7Heh02o87vlhZDuWS1iMwU9tWMGnc97Bs1KClKVTbMXEUL4Mf8ANFbpLg1ssm2BlOS6fd" +
+	"BfzqotzukMrzbV28vbRH7LtEeckx6FCUMyQi1M3T1YXsFo44WD/qHXuuVKMETdepdczO/Y5Ceyw6RNpyaFFLmSEDv8bENYQy" +
+	"U152wPr4tlK+hjDK24vvuKlJbrVf/0LSmsL+LeRux3zzjQjbOt9cfne6Z7UWyQC/wMcv9i3H+8X8imSPIWbTtUnjvicRDJLU" +
+	"ZCu03d+voViHHIQQg9pb78uc+CcoiFgZIMWsslpVi7tWlmZaoxnYg7WSiwqOdlh4BtIh7fRZtpEbOebdUaNGj66dNm0h3nf9" +
+	"ZVXd9OnAJ4bDFmamOrp0Z11pNLv56aZbt97GKTtmzdbpuuGbZh+fd09B+OZN7+aXDuiVzIzNmzs3DzGojfU+P52bhnwpR14M" +
+	"0kYhhVrbtCkFIEOgntKiumtFSD508wUZ8I/B1+LabvnMmtujxz4Yi3/BD9VDb3+Yw7YZXtp3gitz+DyocT3+s2bmzLR0/Cgi" +
+	"Ejzf//SdiMy+xTUzJowU7yrb40W0P5xobKStqXyrHSMTSScc+obV2FuL54i9k3qmWe+kZeKGv3bau4fdTBs9lvTMO7hrU8+4" +
+	"yvBOqNHtOQ1htv4RuUTxado3lLcBxNZrW3QRFY3moLH3ExdBuwCfe66fxqalfnd88DIV6SjmMOmIFr3k4SLD70GM95DlUme1" +
+	"Gl9EYzNo/JVKSKDxVy/ansvkQqbcwGyFO3Q/umk/Xy6VuDWM/8OeAi07u+xfzpcit89/nTAJ0U/TGXMuV4V8pDpbt6dAy1xl" +
+	"WhwyRQq3Vo6Z5JhEy47AJcIR6qOhNrpODH9hSeiUOcYTtHwh9ME530MaCaMYPRz7fJ6Qz16gMUXklutEOTbjo/i8EtzDjt5n" +
+	"OPBCgPS4hK8TebeCtWpapk4tZwVeAptyFZCGaz+FDvji+v4luM+JTsmsxz1aIDsvFZ/Dj54qAAVOOgw1H9n6nMRdqJvFwpkc" +
+	"LPi+ixfjh6SLzU1GfFPEG/xgN+Tt/VktyeeQG9bBoK+uPrMntiK9yaJXib0HbzSz6Yk/TNzzQ5ob9aBzNOuhDMpwzg9Ndv2z" +
+	"fNJ9kiwl/B6u6vky1KBzNO8hTewzu33fOEMsQtzj+BBpj2t1e1PYwyda7G5kzwMdlLTc3RpCSSiTtMdxWmmPM7W2vSm0Nnho" +
+	"892NbHn3Gltubs/q8AP4W+w72ef8baSg7abhpwp7LKow2x6BagtJhTKc/T2X3niHDX52nr/d+DXrL/Ui7UNhKVcllUXhyFJM" +
+	"qhp0fDcJkywClGkv2mDJIkxZ7MlmeLuCppg12VSiA9A/EK0U2H0IIYeYACGRfnbV+pdSYN+wfyZ+j8Q47UNIQbhbV8OEZt8j" +
+	"mMY3JEzjAfocISTLpnppse09WTTFAh5sESeq4hIc4kdFjCTVU+h1hJQCwUpKdfW13ufr+SdyAXo2vUvKZLybPpdiT/eTMrla" +
+	"hzJFvrN59J53ZAtd6SJEP6cr6ftw06Yrid+H3G9L5ZtgQpPfh2DthQi5Hg3rYLX5faxWtMB6gfju3AQQ+ap74Cwug2CaJSy8" +
+	"GDtWIDegMeAJXdya8ZALXuQ5uowEN8pH3hRrRusXY24d3xfbdxkh+/v0fp6O2yvSuN1tNm576bh9SD5Dw9CL9n7+z3v9pnKp" +
+	"HHdpP89wLJPKcYz280z6nMblSO8HUrnr6XOKIRYiSb+lE6m/keoX4zTFfkG0X840YdelfllI++VTh/fF8rfAhBbvi/IsRKiV" +
+	"991beZ/gAXY1e5/aflL5V+n75+lzanM249G7a/P30fjMNo5nBIajXhnqiLUFXtn8IfgBjUFp02R3Ew8r1TnUr0ota67qni2e" +
+	"io7pZZFHmZ8AE2zYbzqeX4nrUMq/GY0zuC2SLrXJ4pCLuIXzGlL7DTi7K7YyyM/RjY3XWNG74yY/NHUa1eTPxmnsBclPTH1r" +
+	"6mblGlp4tyGtqr9YMDQ5uq82FSt5vMmZr4YfIupRLaPVKaLMDqc+fnBXbMi81Ylp7eCHa0A3qZVzXyblh9QgpBXlI1Zj0+HP" +
+	"Hdy5SX37n9893xDKfNPiDGiCEJd3xk19GGhYBNWOJ8EeuJu7uFdJMYJEXklIirIR/wFH8BK7gEvAhD+F6XiTJPx0fIcdwwyk" +
+	"km7HjZZ1VPZa4OzcsUvEPUCSlGTopFS0OhP0xPckGe9+fQ+i8Iv09Gr8kjkMp4iEOPseNpLDK7xzT7Sn5yDEfWSPtzMqbHfb" +
+	"wRRpIB31mGM3b46MiI6OxF3nrUHWb6HccoXG28G4sIjyvpFh154Mm0B20wnDJHw2+yb1S3ggFBxhNDkW2gVshbpMnJgVFBQU" +
+	"hJcNnXBzKaTi6LfHD/2qIUDbJdk/YOqpgt5w6Fld7wJJTut92Vv0vqOzyJalsAmoamJjkgICBJmgdISymJjkiwumipKXxZir" +
+	"qmPMUI6/xn/iP1cZfHP7VV6irTMOroqL8R03DNrT1jTOOVvQo0ePM4yz5U/8Or6xLdQ8LzGR2RgWUd6vc3hRzxdELLr1vuBP" +
+	"fZYRDvgOWis4cHqZbC13kJaEfc8XuyA5QRsIb1+fqvVMSOtac7C4ivZIRERQ96zgSIhYMgY+F7slNm44NkwICKnuHN574Gu0" +
+	"jyxrh/dKTOxRCwp84u2VkxCgk9b7shr+CcVhR0s83WZ7dLTICdFEHt6s+wINeladnbtu6dq5KSnTxxYdLh78VjUIc+cmJU1Z" +
+	"lJVpTimp6CJ13m8HVsGpmmjT32HhfUsqO4aAV0BAVfXFeUW9Ag1V/TalRsT2yD1v77yFlL+Z+PqmIRNC0LwfxC5yEFVKidjE" +
+	"l0SFZVd06dQ1W+yR27eWTkw0Txg2emiYMSqq2LQlprBnj/z3hww1RlaPj4yD0SaDb1OvTB2yIsEQ/JVfYKeOQboA71882rXL" +
+	"6rapOiXZ0zc5C5E+wyX8DRFPHQKK/9VrBJqv5Ubjff/eVUSr/1f/LLAYkZiHhHufm4Y6PFdvK13gB7RidgW+829Nrx1Dtgco" +
+	"/JfW+q0eXW2ZQs5D1vtOWfxtepvc7CDUcj0hBfuXw4koxlzVN96+fPA3rZyMxIXTNPYQAyK+UW+9LxAO7gCEoMVCoBTDjocn" +
+	"LjsyOKu7ONS4dMwS2wGKq7Ksqe3hMN8nrXwb3m1xmCL7ofW+E+VhgDM2rLrdV694fvIl2jdBrl+EIZPMMcOSsR/dG7vEdjBp" +
+	"reLgplOSFP/A9+ef0D1VLrE6awNotAwj4VVNCi3f33IeX8Yz378GvaD93a++/PDu4BFvx46EcqbEsgJegAEw/tYbhD0EP3v4" +
+	"3ZzFB16FYmSPyXnE9aOYJxJWoTBpxfxXtCYRthsAhVsLF0KHJeM2/I5/WLviWf3Ouj34JqN9+QQYl791c9UkiAAXUG1a1+NR" +
+	"NQLiyxE8xDvYZv1C2aaQSDHABtqU5qpV+A/8F/6auDG5E5a9ZKB7nqYDfWXLNjLQTAV1aFJ5Zdb7vLfEJ91Kn5uoe5M5be/v" +
+	"MbfvjV1EPJzsPDxydF7LQb53T7QNudHUBi1sygdBbFCSD4JysNVQjgR1E0eCHNGTdIzJgSvhF0oiM6oZY8J5/CN+BLqFczkt" +
+	"ZU6g5Q3CmdwR6e7YFkOqspszjsGk9Wd3xVYEBjpGlJ78+ueH4cGr2dymuNIihNr4NpOvjcP/JfkugR6CYQROgQ+oWXAdb4QP" +
+	"8G+4qEla0ZCxCKJhA2dEiWnMq2yDaNfY5PWmRRMLgdXaxc2mVsIGmCHZCDPr8QtNkovWjOWK6OcGnU1+kRtmEeWGoDPGGEVI" +
+	"uID43jS0GhEpwnCXBo0cOcjyHYWFjFKD0t6a3CX/fIv3cRdxv9rrI0Azq+YYrCMNYWZMsjTY+d+F37hpSIZ8mriTlEKAhAsh" +
+	"dVE+J1aQeJNuT8ZT55JlOhcWTo71+wRK8HqYiQ9/62cnT7Kc3XeJwkAu7cvrD3G0cd1rxfomICRLo/H0fg6xfrwUS2/7Twda" +
+	"CG+Kq9//FzhzUyyvMB0tHzNVjZkT8W28CgpmrJMi7Iu/+pbwteB7+DC+R2LsmanhH4kx7LHW+8JTbhryRx2INWO0BRXGGFs6" +
+	"Ugnsk1U2SUS3hDgx2pDh+9T+bHcUjhn37Vj8ePMI/zFfZ4hRiewvw0sqJ7hIUYjezRyr20YexYOlQMUdonOV3lndJuc7uYCi" +
+	"6e8i7+UUuYDEc2kMQrIzJC8Wiqe/pyAkDCbxVKgMOeIHVAiBUWPWgkZnkNnvwsHA1PzTbTosxvUXcVKZ/ZoeyiHoDX5zwXK8" +
+	"+cP0GulSnNyRQ96d5jgCW7lss3LNBsbnbFgqVP+1/FLnYvt9PaS+vojLjcrEz7ZFl9su7KvwglsbbXrCaRl/G4U06Yn/cW1s" +
+	"0x3/CqVxVCaWa/9+fSwpF6pbKC4l2EG3/Jvf00HRtETYOGgcHNvyQtke0u5wR25oikv/XzflhyS/3n9dlkshMf/aYon0qem+" +
+	"XGuv/z9uzaVo+dYuzqVgypZtpaH0Nj6W063wo/SVLr/oBb/tLpVe5ou4hz0t48iJNSBdatFqpUs3GnuPAG22nuDT+duURdIP" +
+	"NEks3dyImaZhw0G6oabPyHV2EmMOZw1sF/+sjsACA3FqH68YjwnmMRHeXbMLO83/cH0BxBoyKmMFN6VC0ER0ClEwpzuP6NjG" +
+	"y9u7LTurcU7xriSFazt8zis9wNNPwedtf7iLPO47r1cwMAwowqKiPWj7NyPEneF/Ixo6BCQjTq0l9+SBLgy1IOE9fB0YkAEL" +
+	"An5LE10wuiDKg/nB4sHOgkSIIWnzVSpSsL7XvIrzVoSonoxAiE/if0OuZMaCDV1AGqekLY1OYqL8GF+gPBisStm5MCm5Z4Sb" +
+	"R2SPuA8+mHp1affuS68+rV+Y9fQpfot5/cvUMb06h+dUD+yXHWZFafPqZy+4tTwra90Xm760IpyIgNjEfIbYDq3CLr3J1iBi" +
+	"mbCaqB6jekRr8HXaGBkw0Ab/xc6qmNdLH9xrXl+VClIhEt/Ar5+nhYr9Q3hNHHwfKj8mKomhV0QujI5aPfAe6Q2PqILRBdEa" +
+	"MHedXz8X/4MxxNK8JX82Fb/75605xO5hZ0k8VURX8b+SNQYEqiGqjiTe1lukAdFJTIAfw103FQ8zgfzZA4gJqR7QN6uTd3xZ" +
+	"clKfWC/8Vvrim+Dy/spsdlLtxV2zwq0INm9mQ7KHLzs4oGTFIHN0xbTMcZOm395RVrH/J2Qf8zktfUvwHtO7cTTEMi9bCgBZ" +
+	"EX8bL7OiZnPEneieFlOEgMc2tzI9WpscljlWOjc2Wx8Ic2hMTwKJlSbdaYeeSEWKUA5OTcCrpKMDw5noJKYLhLNcR/fOBo/5" +
+	"HpEGD68OEQ8yZ1fH4utDTv62uOzYtrmV0du3Z02pK+tdNzkre9rO4nlMxoiFX6ojCxO/VEYUpnQtjlazf/vnza9pnMVu3vD1" +
+	"xtzAtIEL9w/Azx6MenF8l4TJr/7+0hhT5bTuvuIctt7nk/jb0hyWpm9UEmMSadB0CpULI83lLsCkkvnrFtEzOamwsxLMT59m" +
+	"Lax/enVp9+ylr0/9gK2K6zN8YG1ZfEL1jFQQmAmkc3HDpoe7i4q2fbZw9rtrCpr6+kWRQ11Jg7ztnU26SKbQbobY8CFd7L0d" +
+	"a86P8gR8nb+Nx7h7NPV3cP70UsvP9jLZ30WuN1GXboZYJqhxL2Ftstnfm633hUHSGc+mg6g6EjvfpBADdNlnrrouoUFxBjXE" +
+	"pk97cXDNi1MznhL6Ha7K8mfOzAojGWdi4+96tD03d9ujOnBuJKxyyxBi0BjrD7yR/xnpkVlE5iWxRlsIBkEqsWSoA0iHxph1" +
+	"IK400nKDC4yBWFX8wtWbsotf6F71WqmiQ4dg+fALq4ryNnyMf/5gVffQDrtgkU9C1YL+Xf2DK/fOCg3nbz9IqOwaGB6BN47o" +
+	"x8qcBVW/rZ+tnP7gpaEDjj5aMuL1Ujy338bB5pj+iwv7rB2W4tTzisjjiRC3TcyjFwIUNdVcLZu03Fb8VviQLnNfn5cKsR5R" +
+	"PWJj8o0eDJhhi693ztafdzNgsZIRCCqYXrqTlulk3c0V2O6iwEgtOMKqqtWYNS48odDmkhtfgFEjZs85//AcDBjKLik6Urd8" +
+	"cIKPD/jjuVBmnJtffH7g+oWQdZb5q0NZRZ+8RDcXTVuEGDTFuoPqEBNKJ1qE4L00MrKhEMk5CgRz1yTB8yrFPp9jzOHAXa/q" +
+	"acgznA3tGVL4Uqm+tCg/QaeNy+1VbCh5oSA45HllY0lw9vdRKb3823cqCg7vyU6qw/emdOwd8chYE5Ga5RpgzO4/vX92tNY1" +
+	"KbbkSpVND8UnSXrIMl1w08ZoFbxeFyryyAUgxOtFHfOc/vYEo4J5pjEWjOppdNDeT1tT3TYdE4IQd4X/BnkiPWWkkZFbQHFq" +
+	"JQHp+iTWCCSVkB9j1IILcN2z9pXC+caKqD6phuSs2ElJj6ByeMrMCSMiLFPiw101vu3hdfymXxz3bnnPxl/xo4DUQRmFd5aP" +
+	"OJUHuy9G50V5NC4es0zv5ePKMiFh/Wxrit8p4j2UWsdFpVPIUQBSbIZYD2N+bFyBUcN0fIqtll8x5m+LuzRZR+wsK7LMcXCL" +
+	"IEBDrfe5ffxvKF7cM8hq0dpWCjHE6D9ociqtProJEUeXF7cvfdE7+MmNRWkQ6xFTOrO3SXPf8hvj0jGhg7KwIL8nfguyZh0Z" +
+	"OOTYrEy13ujfOc/sD+8Nf3VedvH66+MSantGdEjvHXEBeN+odENeZeVYJ4VTza4RsXG12weEFeUke3pllAxBCBCy3ucFu5/g" +
+	"uQ1Tp2A6ekT1GF1g9JB2y7+x9Slp+PNbJTMLbO3+lu7DnSXkH8lKR5rrruEVweH2/cPYVFdAODM0cdTWSk8vsze2wLmUOV1g" +
+	"IN4bVhEY2ztJK05pszfEZix6G//2/spsZuL4NzdUtG3XXg5I6fcqrj3u0S5hzN4hJRtqk6MrpnYTN9EBL/3qoKd/o7xbdDQ9" +
+	"wTZv1WRDZFwB8FsaYw+yHUqb4Q38euMcdpZteBvnIEBZ1p3cZv5XylWpcmFCQaMAhYYc60Td18IO2JQ4fE2R8xLLyt2dKnQp" +
+	"FV18HZfm2+Dy3qru7KSxb64tysarIAPGl3goutRuqWzNEBDX3DH+N6LzqezkzOzCirVxZPdVcBP1XfsY8XVjWYoeXzf1nb/j" +
+	"5JWTO+b3jWZnpQ3rEeNqYRjsaioYnq5SDX952eB0bWDGkGXHhzXOoWtwKELcKf5XFIwSxFELkKaiZOiAOSZabGM4xzvgT8mU" +
+	"1STB0PRFN6iysds+ZuPMJAhO7hHaa2JBJ3eBbR+Q2CcuqXeslyD39ciYWJHYTuXBvjXy+mbSxJVN5hCXHN9W7RyRHe2tT8rN" +
+	"y+hCuiM0q2+kNtJfrkvpGxc7wzam/M7mNqTa7tkkpoiCHacxFozuEeVh10Lw1EENkdFtaUOK/kU+gp6FYx10Hd0PtNIWQ+es" +
+	"uIxp1AjzLHxwwpzX56dCrMbYc1SBUQOxT2GVuwfZYZosKgaehVppmdXW+9wOm16gap3unA6oWK1DD2ua9lqo80vsm1xV2ym9" +
+	"OKTq0PQs89iXp05/dXwsvqLQJ4Tq4zqowjMKAocdm5aeMu8GfnRrcQYjS60tCB1UFpEW5iWTp47dP75s1+SMjKn7qg3ZsYEe" +
+	"ofHa2FS9Wxuv/NEbq4n2KFl/fWyzNaNq1Y583opszYakUwsxdj0YgVJa14TGcLaF+gNRNxL4rx+jaV0ZDndyc22TMf3o4KHH" +
+	"ZtqVoOUrfUKIe9GWHsqg2OBWNaJLUHicbsD2FroQFhFNWVJcUWBIjfIDex+4i3ypNo8RPWDFMpmWc3abm6XnmU+oja5EoQiB" +
+	"C0uE1iaxZFQhnJVTW0Ttx8pdGJJfeGBQaW6UMbckCGKDS3OMxpzeQfj63I/WFxau/2guxM74YFNx0cYPZuHrnAfnkzb25Ng0" +
+	"H5bzSR17clyaD9u4tE3B6g/A64PVPdrs3dumYPWH+LsPVhU47xH7Wo4Q7WtvyqhPeOvp7bKJXPtKXaxyYZDY+eIsA2ZXXP9O" +
+	"zFlLN3x99erUORdnzLgyPyNjwWV8QRWePyYvXKUOz48z54XDV+4+a/AzgqBjojd8uiIte8mVx5cXdWcyZ1Z1DdSmVs0c1E2v" +
+	"72bDSIjYDRRlJX1E7tOAv418UUd6TyQeEoxaBekV4AgFlVbqNVN0OGcAcfZDlJFZXvvq3G7d5r5aC3EzPthcVHYK/3IWr8bb" +
+	"IJX2YVxwaW6UpoMxNtYDX9NlxumC4jMC2VKf7LlvT05W4sVt0uffwN/e21QAHcGnbFSyJ0s6tSyhNNGgcWZgN9Pe38fXtx0C" +
+	"dJn9HA6K8SOglgRUi6YXHIzslRwUlNwr0l3vq1D46rnxW8Pyh4wfkh+2VR3UOSZCpxb3viFsb3jHbr+zOuVZUJ052pO/je/j" +
+	"Oqii7yDuMZMg5ncnuZGYBHwbP3lA8rvDZPHzeuakLBip6VxS68gemsSajCoXBqq84iMCzkf1mZHrE9/Z73xo8Sx+LhsQnabP" +
+	"XT0u14nVGtP0RWtHUY5A61PuJ0YjXCP1EAQ4ZL+Dn+BHwjWsg3tiPS+xPWSUZVrhsPwoDN9o29b0VA91n//amLipA1MCM4d3" +
+	"N5UkhwjrOX8/L28XNim6ZFJ2AHd78jsbS4JzJxbG1PSIDEqtjPNxd/PwahMXlTJwRiKpK4v7ldXKghEv5uzXqrsv3VfJCNyv" +
+	"TDTe8o7Yd/OsD4RYKfcQjYGwG/Mxzf+lNRGyEObn2E3lO16AIF4T0bGoHHTtfcICyN/43oiD/VadGzri0QPmsOVMzLBC44E6" +
+	"Rm85UzU+UXVwJ2N4Vrf30iixzmW4hPOlmFekbUqbS/fVcMYgNp44OY+T3Cepa8t27sF3QWgj02eHZ0V5MyNxieC8t7ry0YMf" +
+	"snL6Jepcs8OKpuTiz6wIMSgLl/DO3BTkLGYyE+T2zKbSTiInne6uESlGGWNFdXVFZf/+sBDfTVjdr9fKQXFxA1cW9VudAEFP" +
+	"n7KTDuLLB/fv2X2g4UuSaqj39k8BfbK9tGLA/sY6q60tvFzMeUraQvcUsLVAapmakCj6im1wiwsmjaAtW9dn1+6nuIQ9mdMv" +
+	"MchFZyDNkDID//wtLrGK43OfU4vcZjb/imTDKbQm24DBAWakZS3vZY4Kz4rygkD8Rdc1Zbt3QxD1sPxetqB3aHjR5Jwtlog9" +
+	"1ZWP73NSblhbX7VB/k19JdcrW+kpe0f1J720oiYubtDKXmIviZ10AFKlTird/ikwn2wjndTwJ23DMoTY24KqhT/lOPzQ+Afo" +
+	"mGDLHUBWJKhwrrVpfoRy0yifm70LtYoECGftPbsctGlry3bV4Xuci1+Unk6MGY39aef9+IOlhHEO6pnfPUZL+7SzeOYhYzWW" +
+	"cmwRK4AYUUa1TvQsSs4Th4FjlQ4ms5ntUrxzRg/ZqElupaPnJKTP7p+Q0G9iXJ0Q37M6NKe2m5aMp7agakJvmVrV3tXTz9VZ" +
+	"FxTozE0rWP3W1B+GbKwK1+dP3DmxwNB9aJp/WPGUnMbOgvPeyKzOmsY/26gC3N38Ne1dgrtGS+Nivc87CyqkEjOzUfXAKETV" +
+	"TQZDK5opdGZzmuSpJ8Zaf+vUd80gCIWgp5YfLydkh7iQwWKr8O0NX67PBgMEj39nc29CdGdF4YWjUusOHtjtsBYFlYQDbTl/" +
+	"RRa9f5u7zGFLCeOUW5WkkyZvY2fZRcvB/n1/tpfN/C7y/vK2MU+3bAHdYSsSnO3vCMsEFfX7UsMLbIdAaXonQqsyqTlfhici" +
+	"6cOyorwJOWXXdWW7drcqZklOVSIRsVPRlDyqiAZUPHpQ2Jrc39r6nyukucM7Es2pZTVahY5wINvdWWbWwfuiCxS+eR167XkD" +
+	"YvoGeKXMPPvHuZldQ3pMKlDozN2yupkD23qF+PYpH8a9A93ww11WlLGzZuqtTcXFm25NHfHqqoFelg96TcgODMye0CuhMr9r" +
+	"wC667nEJXfdeYh7iFl0jhqvNg6BW++AUV9mY2qzN//zJ5A+oeCSuxf9X4722zDbeOdX2frN8JqgsB0nBkj/wAXtPUKEwlExL" +
+	"N0nuoWg3c6Ag/ismGbRSMnoSnibI/FjedvCP1htcWPYuvpe6rix7VkX0Gyc5nuuYE6dVqfUQVerSKditY6dwtadv5siCEMt8" +
+	"pT42OKx7lE9RH11yhDfDW57tHVBhHr695jj0OQJByaXG4IKOeYe8fPRx6oAgV/yRwsN7yLwNSzrlxmk7FY7LKtvcOaR8wDAH" +
+	"HU7z3v2rBmf++T/p78YjVtGn94DzEYJQMIpFSGnz6OltDj2HTpJ8eraDbSi4wLjX41cdujRUHeQW0jF+cFlewLC9Y7vQ/smf" +
+	"3ScyRJ8HndyCYw1hWUZv9y5DC9sEBXBVVfumZDIc/tHXz5Qd5lKy6ORwy0t7B1QkTDw6tubTEXhYp/x4XXjJ1LyU4Xkdh31o" +
+	"swF4J0Eltl/nsK80m3a801PbBHOcdoLKihoe0SXFHLb86TDt2DsIrG/hTDgmxqEq7bf9SYxpOWiPHlxl7BCrdeWqnp385tFD" +
+	"lbF3OqI21GrrA05tk6dVpUDcrXCgtcn/VFA1pkrjYV/vZDzYSkAIkAcuYbO4aSiC2jskwZTk9xLtPbsfOYm1mWZw3WQyw1LL" +
+	"u2EFcVr/5H6pXaoz9B6dkjsEZmQXdOqYn9czfPDe8UnmgSuDzMXeBivyMBaY4wYNHpORNGbU6KTY/Eh3F605BBLc/VXO5lEH" +
+	"Rpe9sEvkLaVrkZv2L2tR/b/XorPU2/aJJ67xNriEeZWrojyMTa0Tz3tmrQsrbh324FDmJE7JqTAqOuWP6BqSGxfY1issEKKN" +
+	"qcEuMRFhYW7GomQd18bF+bAVdepW3rnrS5fO58mMuf3N+h4FuUEh3dNTAoxd3RX4UtL8pSsy23mp2kv6S4jlKluzK83N7UqF" +
+	"aFfGbSrbuQeC2vt00hb1YUDXzidMW1xGDMuR+/utPDN0xMPviGFZNCbNVzQs+41PUh3YxRj++ZvJvTSS4gdxCfsJNw2FowyE" +
+	"gqPDOYcDLj3UNhtsAMnOVpFIYEGWxEJb75DOId4ZU/ZW99s7OSOq5+ARQ3pGtpWrnOAry5TOBXH+2ugUH56D3dm5+niDqr27" +
+	"xr09cF2LIlQ/RJQU9izqE9V7zZB4Msa5K+aMH9q3R7pPOxcZa0U+ptzOKcNLM339ouFBZT/PTonBnp06hkbFB7ft6J1WU5hp" +
+	"nw+EAzQQIS2hJG5mj4D9pG5UML1KqsE1ML6TgyrKmVUR5RHWtcPT3TXfzY2syAx10EYJE4+NMU0YO9hgta13Ts1V/cveYvrv" +
+	"vaXFIrftp9KY2/TI8+Ua/23Xemr5sfWi2Tv/pFqRuHE5nFWmoTAR3yeGKosHk3Cu2WlFHUNvxwQzvtfeNyygqIwcT4Jz0rt0" +
+	"8pKmWDnohpMnCZ08mSzxjGL5bO8lRafcCS/UMrVN5xbbM4dzy/91vdptJWfbRmGzAPv3/dlqRT1xJreMYkOysc1eHi7aTRKj" +
+	"4FG4aNGCFpAVcVXIvr4EVcu+UDzfF4r/N33R2JnJf64z2DuWg9JDhBBD8ihzZdRO74CQxj5J5S5STjbJCQJNfOK6wOWgTVxd" +
+	"VbS8Ji6uZnlR1epEcnLBX/7OZBkH9q3s37+y73juo739+5Zu/xTjT7eX9u2Pf7KimBhA7MbinXX0MIZInxH/FjdNLqBChuKA" +
+	"8FR+naBCochErQ+VC0cgLeG85DnUKJq8H7ztbgdRp24Sw68NyR40ZVEmvps2f9Kg7I4QFJpR2ql656iELqN2DICgzEVTBmWH" +
+	"NF4Mj9e5uOjiwxlNcGofY6eSTCZp0M4ppV10TngCLGujSyidvHPg/Pl9J6T7CUz/4MK55+cWBuNs8IopmryLmdLOXdtR5+li" +
+	"mXlmVJKGt2wTArpJ8/o+/37TGa/59itZSlpOCRctAV3XlO16AYKAc3YyZHfqFuUDEEhmhXSua0zJrkwMkncP7TEui4lFiEFn" +
+	"EYIvuCrEilkLxLPXWcrJEYzvcFV4Bt6PJQ6fMhjNlDJf0lwLND8BFcRQNnv23KnJs7omz0xhviwpwff0+mADQgwTiDsyn1gf" +
+	"iWXzJPbcqNYxgSdPnjypuh51HSGrFVxxR3aH9ZHcwGuslQjJZbwCvYnI/1hkwR3ZZdL32yKkMWnVZqkUDBvSSDl3r1+/Tosi" +
+	"73/v8L5rU40GGvKuVTMfn6T/WwT1pPqGr7++Tr4ITCDew3xiHYZY8TtEwo3XrVbYhvewldZhcgOvtk6hsqnRIlE2eBl3ZLs1" +
+	"yUYiA1ijWmeWvj/65MmvT57Ev12H+uvXryMGuuI9JMIFsYgjpx21FqArM9GyFLpsvA6VCCHEQClOY8mxS0aZ1iSpgfn45A2m" +
+	"O76Dfzt+/Pp1cKHvWj/kqtAi/rpYHqlZE3byZBl//XqdyD0gfS4XeC/yO5Rid/aW9U/alhi0XK7n1e5ii/62jkcMEwpvM18x" +
+	"68XySGvMTOjJk2XM+usDpT5KYz6xfij2kYK2MQyPFufFADaf2cqNQaw4gwYSBB43htyMM1DKVbG7+eNSuVq1lofeTHfLaQji" +
+	"j1+X8tOdtMbC33wH2/dfJeH3fIcGOULAD+e+FwLo96lU/IiTJ/nj18XvNXLfw8viZ0qjWmcRP2LQSYTg75ZzmpZKfrgqPAvv" +
+	"wRKGZDVCbJEYKwkR9E25OYac50hQ9wcwx5JYUXYW9JOmREYxTvhvK2IqwWXqdMaKcrIRAijFHdnd1kd0hjfNsxtM97sNDdev" +
+	"N2Hq9op4aIrapKzzSSxhY6abkcqFZzeAC34ZvxGJP/MNyTB2wL9DPnSJhA6umg66mGB4BefB3ymr8af3KtaX5Qa7wwnslLIa" +
+	"OtxLG1uYFdZJxCIRzmc15epHwYrocFanNWmjyCHCqNaamIGCpkO3aPwiFE84PCrVa1Fj/GMYEZeT28nncXjJsv1j8auPH9uw" +
+	"z49ZVpQXVJpgPVU4tlQ15ORhokmE3ZmjxfjTFF3ZgPDYjJiaXvKOXWMXXv6lb1pbDnY+fgmOuU4pbQdy1Z76PkO6uj/eOvq3" +
+	"bGSTk/1ZyreiE5kX2Ed1+GMcxu5gxzauZMcSQRh0ACEuh46jQtxRebKr6sVmMfAN/phdhz/JGYEToH7xqbFRzAeWcCZbYbnG" +
+	"lL8w/nHCorcRkDLgA1oGLQHexx9zNGeIKAezTuSBALVtnybnLBOzLicowl2GP05OucdVPU4ZNyTN8gjuLn0U8BiBXS6JO8eG" +
+	"owtw53LwJ7nDt+FPoB7afMaUWfYzY9fVNq7kqn5FCNB+63fcLK4Waei5xV3a8WQmKcVLAOMJ8O6AywO65oamV+ecvfwrdv6V" +
+	"q7KcPrZy5bGLL3j/7oPfx1/hj/Dv0A46Mam0L6dCD9hnax+vgL2kfZZJi1iJg7gAq9i5jrJKvcfOwR/3W47vg/fOGzOi4PfG" +
+	"APare7sfJ274rmmMjotzSUkmkcpFnE4xcr1OWwDpo05PK9Lri6adHoXPQ/rkqVMn4/NcVcrwNbsO71ozPOXnExB4wlbO2/zL" +
+	"RKOJ5Oy8GiAUsix3oSNTiNezY5lkfBzqGrsi+5hXcFU03xy94QlwV0iCcxWNOfABtPncMh765w7nqn7FD8HDMh3mr6u1z/9V" +
+	"3Egxc4kfEHMjwBO0JqOCWvK8jNUqY8zR+gKIaRvjc3YgrLI03O2c49NZ5692b+dcij8pbM905aqcXg+t3WR5ZNn/M9Tum+EZ" +
+	"lBbfL9WM3eFBTpfRN8n4Y9Xzc+qfk0KuNKe2i/0drBCTJQbQNBMwNjt+6VJgX7S88hguLuj6+NtzliRxnovzooreVSElaTGZ" +
+	"DArQKeQBxHTbD7fB5Y8/sOJ3uH0EFnrH53FVz/BH+AOySUM4ROC38CGo7DM53Vtav5GUq8ZNlI+YOrzi/0Pae4A3dXuNwzq6" +
+	"wwkjseNFth0nNmQnjm0SyA4QdtgBAoQR9t57Q9kbygh7t4WySsumpYvRQstqoYyyoUAppVAglr9HutfOgL7v/32+3++BEkX3" +
+	"6Eg6S0dH5zg4ozoN8EuIVUU3GbVzCOlx+w0kXcivx9UABzk5cPu2yaN7xm6Gyu25kyV7sx5L8+RtUny7m861GlZrPczMnhQY" +
+	"dHwSudyyy7TcKj5NBrQ3N4adoPgFt3t7kK+3evRXnbkvq4SYcg/C8jcML7q3baScA2pjIlIiiaJY7kOFRucBvBU2vYBq5P7f" +
+	"pAB6rPhgzpJUCIqtVnNIx8h8vpD85nxAfnuydNfu+XPsFnzMJ9BY75hEsxqi4R7yXZEOIVDTqB1m28nJKWiwLQdtTyTqakZA" +
+	"9YzGjUaSS4EBub/xXcmK9pFh0/tevNj78w4FhpIpnO+wwsKJIOWzq40Qr5TWMsIjr2k6V4uaCtX2zt+hKfhnQw1Hgj292i8l" +
+	"A59wQ0ii/1yofm1V9/YhJiArJj6hdE00/Jd8J8ShSsyKi1ADfZEBcJ1chhpjQOtM5gPIGaLh7TOcsdzgJ2tLMsryoU95/uW4" +
+	"neRS1xFkM7QbsW9YbjCNCcbJi7c8tXWcu96d36yH9P7HaGJb77A7jFghKnzApNalcQ67mv0owoQrk6CGrUV21RAFRJnjgptk" +
+	"Lsv2XzajbeOcoJQaEJUxb+j69Xxb0pVPj+t6lvw2Nc8eq3MhQR+bN7nDMchx3nrxdZ06dEw/1ys+R36PKq14FEgPjmzSexyT" +
+	"jT5e4HPIpZjIVhPycsnfNfMrx5g+xfmQ2imFycv2u2yJJNHZoYX296pNh8GPT78aye4uKe2E0bpKQjTOQ0gpClKujdHS+0fa" +
+	"Di9Yu1R3czTkcStYe3xpO9XF+A5eLvOuFUywgpB9u/hCMpW+hcB3+Mluu4H+lqN/phLy2e4n7m4wlkxFCLtKXJf5mYowZEKx" +
+	"UlUQrYlSmFWn1yXa7Dar+wmOVXqAI4ZFgFpQWMAEJhs+6Ovld1IT1PbnYE2lWFgoiAEab8VCXWKr9kFBqc6/4ehJyDFOIY/7" +
+	"OJ+0EXUJib1dT8ds+CG2ZmhUUFqBUqvXtN4RmmM2r3vzJR4zYZKjZI9Yd6kHp1j5NpdWrKcvoRzaMg8l5Tr2crZiGn6e5LAL" +
+	"pahx2zsZGkeG5DSypvuGpNRMrJcQWTel79T05NSIxBoBIVDczrmvHHqK2PkK33CdWusrzA8OjwnQRFb1qzZfo6xaN61D47qR" +
+	"Pn4BRlPHbMugcqgiHo1ASLgkaGT7WcouZSr9Ayb+SkkJ8SopcW4uKcE7DjjrHIRq5AH9I2jof9/QQ79A4Sh+LANHXRGSBE38" +
+	"WYL2fojloAK1H3FzvAUJTAOprRz8Sw5fP94OEuQS+Qij6/w9fEj8C1Vip33pFSHlJj2V+Cqryi6/I4S9wTHhpkB1tWj16t72" +
+	"MdlL9Km1Y4V+VX20EZirrNv/AUwlD1qqExsWJFmQZLviFsIUJMi3YWoryzwBr8mhG8fbkUNQB1+T8Hj7HKZIuIxHiNsvPEPB" +
+	"7D7IlmSOk1+5uIND6QE7FEIgGFN1td+cmtyq7r3fWu7rV/BRbpPW8dbkwlrkEPwbE9MwLbntqi7HvqseSb7Nym5aN7YgKaXL" +
+	"hOYJS2hJE8Y/01F1zsz9hUIQcqjsEg15CllYzDLJ0bQ7H8THZav7GtO0oUW9OsycViu9foJ1Ue8hA74prg6IV+p8gjLHDdnU" +
+	"tFnt19E+qsjYtAJTQB0Xatg0nZ1ffqN6VLFJaRHGugbAV0pRkHLtzEYIrimeKy3CONcA6Ohud+0lVbhn/M+0HXUnaUpRGMT6" +
+	"zyGVaf4NpUUY7xqAkLs/GuYKF2vK/cNY/8FuW532d59FVtETg6h5/Zh9Q86KG/BqpUVfjYskZ5WiMJ/Byned4O7wAUqL3h98" +
+	"uKlKURgoj/GPuIFrTtvxQXzY05+1842UFn1Aufa5pIoQx3Vn8DOQSSkKUt09uZ2uOYTLvGxwqERjuJuPqYqzWw0CP4Kc3/V5" +
+	"h2YXwffgEnKOjNg3d3zBnobTR7X+Zy+kgoAz1Ks3fj+qd8EK8mQ6fLvy+t6PxzXhNOoagNLNpTjw6ykOOJGv7MFhoOt3Lo5r" +
+	"jlQIRdgddouZVpjSKURBtKh0ULhaXy2z5hxoNCxgSL0ssn/HtHnXY5u1mT1q5SbrndNHYqs5kCuVwWdwJPgwnsHvQOGz/duO" +
+	"zRS+UYJOq1cpRKPK4LDj6pkAsZAV03TI92dJEejmzOzx2cJRy4aSV1OOdCkClJPppoHt3FaG+xxOpRQFKY50GKni3QY/RekI" +
+	"CXZLUhwYpVQzNPcCk4vyQ0lJMsrPyN0/h6uSHEZRLxdc4jbbwDcZsgy+/tUaZmjDIEtlqD0QsppnTqjdvX7SdO/QcZnpjVPr" +
+	"9T3fevrejuTfTpDVBWBSWHzXunnVew4dnfGouEHtWgD2lhd/SOz0I5jJR98NalutSnBu52nhfu0+H/5ZRkB4EbT6et+PH62t" +
+	"UTklW6K7Kt5t+F2UXoQwzqAUhc7SWzpSxTsQ30XxKJX6s9mO6Mu8YTay6bx/Ig57hOfpMy0D9GssROLKVQ0ddyyeu6XPuS3F" +
+	"ZNlNR6q/33tnYrLhtqnzBvW2xUdnd6u9r92+kOoxMTfG9LJ2/fJ8YfuQKiHvziOn5/HEOEdQoA43TXTPKVDaK/4S26uu7hwJ" +
+	"okvUoCpS7u0yKKrleK8yE+R1pVhUcn5GxlAiGTdPngEgz4hkDSBB87b1jiIZR0aL8liM3wiXrRSFIkYv6xkPRLKYUo+AE1Ru" +
+	"4WZ3qHj5n6VcCD5RkTHReQBnesPgn+q2jKoxePoTcqBvcSkztl48Fzo7AviQmuuXbWildCHRHJOXEBQU5/BzIWuz4TN7tG0C" +
+	"jDHXr/zikssl4SE4GK98wq9XikIPJOeFxDMAIQ4henz2wysBueS8LcNcPypOCwL9RviBH6AUhUOsvZDkCn8JdK7+3FR+vFIU" +
+	"Fkjr7fpeuC5qkJHSEGjlObLU45RobDr3FkirAO4HywrREm4Jt1GSctsW3DdJ8blhoZoCaNpgZO3E1BqNgu11U/yDAWc3gPgs" +
+	"yOr6wbfjd5I7BeS1D2RVWWgb2LNdP37BEFBUDo3SaURDq/6RlsqjvH0CaujVyhpxsXmWqJKTnXdwu0uakgNjtoWoAYfkn2ho" +
+	"CwuMraU1Su/PJpHrwkpRg/RULrEXyApRwUeEc2aZ2B12XlUPsvJ7/Um+JlfJG4BkyEoX+IXDxi+o3xkQ3/vtMnJiwUf1C1s2" +
+	"AuOsgu4TB4cFxiQxvUqq8MfxC6SS8gFKq6AQ3daUQBeL2rkOu8OSJFGlnCY+jBsVoqkcVCk82DfAW283kc2aMCU52nd6c1hY" +
+	"d9683G1asU3fPW+hvT6ohr6KxgtjdWhSUIDPEb8cQzKpAi9u1GlVRb9kanp4q822+Hl1JJ01iOwUVsvyrZDxzAW2h0z/4OvI" +
+	"V4rTUXiYnGGcSMW0Qkr2bnbYMTSu6gNgC0oMj0iJzGn+zdFJGE/pe37V+E8wmJrDzajoJjlGv4DImMiEPLKu76aPerWGGskl" +
+	"nzU5gBCW9CxegoJRtJTpjIpoHSt4EVamCkaiTq+LKLNatSCJe5bX8ttrpPLllesbtNcHtT3ZMl/hP6XLrG+PxFYje4SoAJM5" +
+	"INBgCO1ffGbBhw1aAPIKDLZlNMVCs5ZTk6LX14yEwcubV61hqQYQHBIUo1ZbpHrj4WJNbg+yIhQhCqKJt5RuvSTnLGaTWZo6" +
+	"2yAjw0lOSqkTvs4jS3ePI08aktcAKZCVznODYwdNHXkdw8D1kBmXAvDJ+ImrOi2uRm5pejTX6gH3P5GXA5C5unUNCOkN3KyC" +
+	"oklNolSmrI6FkamG2OQh22rWiK23vw5AsLFSeIhtYGFXuRbnMFKF4srsgfqkimxzYPQtfxBfEFfK/kpbhBZsEdpvsQ99WyDM" +
+	"mTN5DvlkzuQ5qLSvoioy0RmDQ15xmfBCQRsWB24L330GcSe4kHYCvg/0MTaslZiQE29TBlWL84lW5jTp0Cux8vjKap/E0V68" +
+	"t7dCV6mSMqSKMCmKr5bSvlukCXBizbyYEIwnYyGufquVxlGdA2IuVKqkr1rZz8e7qtpf68VwIzp8wfWydB4RpfMgujmT5+TR" +
+	"aYA0B+a7Zr2+xT78QWmCdH4H2PyCGc+9Oz1JP1tYBqGKM/tZXbl0YobgCG31pDWrx3er3FfwTMpbOMR71+xQfk6YS6o+xntO" +
+	"U6/JCm/3lKrppNqs35IH8pzcuJIHEq6ANvCn8SvxBdMTIhOJFFurLHbo4U+k/7AlWawSC4oWM1z19U/KaFM9xGdEZQBdFUMN" +
+	"Ho8G3ttmrzKyKuZ0hm4ZQdWEqc1MOYAzEwf06zKuZkJ8oC5gcfO24fZPAZYPSa4/dJq9lY+3MQQhjC7wv+OvFCKqzE4+0ssV" +
+	"Fj0DGjHMnGRPxDHJNSJr1oyskbx0wY8LFvwovIjJSI+NS89Yf2/dunsslqgvv4OLEW4x75ODsoX7oMhkhqLf0k5Rde0Ztl71" +
+	"avZuVLtdXFL6SqHNmLaVVKCtUe+jpPAA0FWzjXG5IJD/EfcWlygVwiJ01MXyokEwqYz7un5ibcf/fSTv8R/4giJd3mNZZsrC" +
+	"I45WwWJWjF4bJi2aJ21OksUM32kqNaqdkJidYFMGVgsNNlfcY29ViLeY+n/b4/38U3xbTEIiQsBZ9Q4rB90/XT9s2Ppd/FOo" +
+	"e+IEOYhoP1gEC/B5/JT5DuiZbBFkkmP4KdmAAAAhLkBgMYw1QGnBnNFPreMCyJfG6LnQuFfdYNj+GxihP3lAZq5CgHQI8U0F" +
+	"DXvjw4oVsm9ks9RhFBXYGAaPlbpgCUJeDV/L2s7mEAEyqugKyd/LuEZNR24lYTLULjEDMfRvlb4tjTyT8z4jxDcWNCi8InzZ" +
+	"K+H+h1J2T8CfuqryWE1iDKrwcIB7ikqRBp/K903Npi+MienM2dq0PUqS5RGbF9kbJQ7YFGSJNK0kp+yjsqXYHDTD9Zj7WYhk" +
+	"dUrpKVDPjoMyh+o9aXQreCC49suSxkBWfL+RzYv0Ce3b5g1qnDmw/Y5zRe0KazXNrJ4A/84VIl2oamCGJSwiqJILWTOaxYY3" +
+	"AL0xyoUigvTjBm6eOa5RNUN8SvrWUXWYbex6zD0TwlFVFIhQuEw9EhrYoGaHCwNLbAWkV3BMnKmtKS52FVye1KzjQGIc2LHZ" +
+	"m6xek/Ycnj378J5JvbgHZN314+THkQsWjITE49cRApRN/VGCBqkQAqXaT23EHLaI0jWJgZtnDGhL9uQaAqD1xPXLNiyFtoKG" +
+	"/EbWkj/IdLBCAQS1CEoElIg8ecYaCBrmr7U7/FiNXs6IWRVftQ4n6mNaWqHx4On1beSIrcG0wdDY2jIGzxi9DPRFL0nKG7IB" +
+	"Or6Bb18WkYfLKsITFVhhtKvtaqOf3k9vNHNmnKiLaWklewdPa2CDbFv96YPJXgpvzuhl5GHRS/j2DXQkG96QlJdFoF+GEEYp" +
+	"CPGZQgjSIBNCIJe7ctj1PNOhbO60RRHm1qx48YGah5q3xdBm2hao3nxoFW+lsRnZ2yJUwYXEN4owBgMEqcPq4Qi/2q07zlrd" +
+	"YjxAy+FbP1hGfiCboBhmZiYmtYDqphiLOVChlO52RyDEPfX4eVBZ3w6+VdGnU8aXg1y+eAqejVQIad0awyrX5BLWdegRrtf8" +
+	"rsvpMqojeRvCd+49Jq9pYWGTAYfnneyYK1KdPZTLx0DfHlWS8jo0QT9ya/lGUg73QDAK6ghB3QRyuhVBNnnbjbwmb7rhV9CK" +
+	"fHyBfHz+PLRiNso/1G/AcvP4sYFF2UrxuOXsFnF9TLfupwnZen4Q+YWZIrEfZTY8MOdKTEooXu1Cc+alNcYQ3BgMswo6tmuf" +
+	"5R9XL39QDyZPmW3P3US+qKbbTlV4fH6Q6AhXlTKc3ZFYWpRMdiAY2bbxY40x/pbIAH2oJjrW+fjEXFBn9/g6P1+hm95l1oUO" +
+	"uY4Qe8cLP5L55MKIOvUhqxENh8JNg8y1gpUQHJBQPdjP6dvla/BLbE3ttXXJkZAcs6ntjh9n/blxDRzrv3PfLwdWJaZtRABz" +
+	"+TO4ikIj3Z9YVTBvPH8G5zq/QAhQR8EIn4j36Vqpy/mW3Fo+HbRCUd0EH1/A8VHDa9VMTzKrApK1gV3JAWG9XxVjWPXeUdV1" +
+	"4zTePvFJSlHQTGpcxudyWmkRGsF655UKvpgZSos+iDNzz0vbSRXZ1xPEmV1imfYN5Xw6Fn0NvI1seA+8QHzwvfAC8UGX+J7+" +
+	"wf/RP7hcf+afEc4wv9V2V03JP+Npv0fxxbFCXvl25lPyx+dQ4nvag7ja6Oh74ATAV3zr9/QPxOfQnPe0B+NzaLOnnfl5aC1B" +
+	"oZGLMPw7SO1kAfUj0XUDK1lQpn2D3F4N9pEN5dvLwDHTdqVC6uUeh/lG/EHPB5T57oIMzx+yyYXSdobXR2x+5fqz9pF0fqDn" +
+	"b72nfzDoBY27nZ2DjgpxjJ445j/s5Gm/zw9WWoSJFdv5WtjM6GUXqVK2nTvB2qtxtUlu2XahH20XJuJZr0Pe1x+VoOpKs9BJ" +
+	"Wg/6tccHtp/tdxBnKPfddsmH9B/twTiID3C3Sz4nbr/STH1NdDShLmeg4wg/kA3u3+On8u8V+kChwCWW8VW5vwvgL3GGCr8P" +
+	"xHeVZurvUSr0/kKBB14gv8vTHsCP5QxlfDRmoUhq51Zyzd2+ETFDaRZ6SO0wnt/F9P6PitP/JQv1osn8HjnIG/kK3jGxjYHK" +
+	"Qv9qBk1UnDqx9vet8hX6aVQM1ncEF7XaT+6TNJIZRi4nQ1aWpsakUbt++vdceWF4WcM3LycID47rZa4F3o1BN3D4Bwnd/f3j" +
+	"LJI8ofgqzdRPw/ZxqrweGxSnBY3cLnlzxpf9PZ2n2ID5BAv4gx4/zzDntnLwAjlNhf7BQoGw7x2/kJn6gxj86/x4d7vo42kP" +
+	"4Prz45ku26UolOIKBVoNoaIesyVZQFphUAmS8a6WfHXPzj8kT6Z4dFvjhRuWF1TzqUv+ifE25nOtnCRVKwadm9N+e1R4UDrE" +
+	"Ui3XNcXfpE0NDw/qBmPTm5qMkwxgd6HvqsVW9g+MJCd/bnc0sQPDabcX9QHF0lfSbmyMBqo4KD4R4cxFA0Z6SNPpBZ76enmT" +
+	"0WAJH5ZMUam/FAdB1ZhelbGywVsneeJtao0n4sSSH4sCvPQduZvAtf+I7HnpvEOeTiWXIWV2ewm15gWqxj4n7VDZZBwRCXbS" +
+	"zZqmaul3MIEsO5rYITUoXZLvbL2UZuEEW8cawjquj8vl9rspzUJfad3xPaGup/2Spz0AD5fandvK9Q/Ec+R93aUoVNRTWvS1" +
+	"uU3sfuMEQmXazcLJcuNWbPfnVoqbZHor1+6G79xWrj0IO0vH9UrytAfIcCaR66Kv4msqt7hooPJdyv/N/HSKNUqz0E+aL79D" +
+	"4SW3r/NK8rQH8DUlOCU3qV/P0x6IC51XqA/MOVxYzeTSBYkv8HBJLuF7zuEIJB8Zfspil3TlPN/laNSoE4qh/cDza8fvmEm+" +
+	"CYds82cJda2OY9aQ9pBVADV37PktJiy6K/SZs2vdtD0NNEr7+k4jNl3fVOqHE3QyDgqhEYSTCIZbh/fiVgMXOju4v+N3yb+n" +
+	"e5vIGRCHJrmOiM35qkjBolOZ1DKFiRrOB8Jk+0zGPRakQ5NJxetpNXXRAKKCTSrJzO1qmx4dUelOwbzBO6h7Ndaozagbl9o3" +
+	"qm4TG8xyQnTLgaQ7aKt3D1D3VynCoUHtyF4NWpBtLXzMKb9Tn2qLALUpaVbO4EKznx5Q8s+v76xvPQgejOspZPvXzX3f3ZBZ" +
+	"aC/vzTk0x3MHx3Rf2Ts4qV1IVFqEkSiRRFRoz1ZahIWoK4kr3y7pKljKGdztbl+f5Ddz/yyP57nDYzTrJ/vZgvBRt5/NfU+H" +
+	"r7vv59zneaUoTGI/y2d4pShMZv3lM7pSFKZ4fm7Mfp7qOWMbBCuyIARyOXy91uPyZEddU5il3OkbnvOdW+xsmNcsvZk2SG+I" +
+	"ntZpV4MtUytXdh/zg4063uKr7l4v1f97E0BAWGWzzgBY5UIBzZrW20Ni5ON3bn5NyR/ocD3jY4VwpEZmSvHWcidd+iM97cpZ" +
+	"QKVTgNKB1yjMam+xZs2lNWuK3mqzYgX2Xt8qLvXWB+aID27Vjm8FY8c2tzf4c8/u3Xv+bGBvPpbbRmZ9Ta7snrixqGjjxN1g" +
+	"/prNfzVC3D6hBjIgZJUdwJJuZQ+YyqyCQlSsCZmYPqpTwqj8YfuPNg9ekVKjVkJcq7ZjbzQbH3nmweCG0f0+/CJ/OMZGFwqt" +
+	"ZspsbE3w8kpwoZzhn59B7noA9EyrFIUZFX6eyX6Wz6hKUZgl+8Rv8x8Lz1AlukugNIeJKvZ8FaS8CexvLq/TjU6dBvYvhHbk" +
+	"JvmXvKSVbaESeIEBP4Zqd++SB+TuAxodMJf0g8UwBIbCYiLFmo1HiLeyXN7uHHaCKkLFR5a0gkSoD8nkG6hEXkIl/nQueZNb" +
+	"Uot+swghfqCcN8yT9Y6rDrnO3uQA1OPDYK/TWvKvcJE0RhX6l2bH5AeWmPBSZx+8FHIhAHwhAC/6L9hSXwofAsg9LhsvKmlJ" +
+	"/ioHW1H65pYfKOGBl+G1zs7CReed8v3cOPADyRfOPqxfDe4TZ38phhchPk+Gp2IQgW/qvEcmQC4O4r8h+0umCRff9uRmIOzp" +
+	"i5G3FBHL4AKUwBgyjXwBubiF8x6k4kElzYWL5Bn91pM/faLwDKnk7FJmSywkpYEhBFiQF6IhlUnmMC6CfBEfM3zGjMegfTxz" +
+	"5vCYOLK365yuXefgb6rqHMdvHR8w4Pit4w5dVfi1uH//4v6l9/7CxXL3/sLFN1FyXXluPXdAiip3j4KUfgY/JTbA2qQGSUkN" +
+	"yNjXUPj6NVmHsx/Nnv1oNomET0BL/iAtyR+yT4Y7y/KeIm+pKGogcGfJd+QIOQn2jRuFiyWfOsfiqVwz4g2vSv1CH3LFSETI" +
+	"CkaaVjYI5kAXmEOOvHnLFZd0w1nOoxLufCeuuNwedSqpLSV15Iqd3XAxQuX7gSfnYkltPMk5gUIDBMVA59sSIe658AxVRkY6" +
+	"3xBITIMkWi1b+kpe9kR52VWwOzQpPSmU/oXDSrpCjNHQY9Sos/+cGzGipyGMn9uuYUxMQ/pXSXUXAgSPqijtX/zyRd++X/zy" +
+	"hV1VmehYHlqKm9C6bNwLS79difx45ng7aFVSm/sa/wrBUEjWlVQCqd4/QsID4RlSs8gIqZwv/RPGvAZGgx/YPA5xbjd2kOOv" +
+	"yJXFv17g+QbNoBJELCFX4rrZIqy9+JQ1Jd1wMY3Ee/b1hlHTyE1ypv/4cSPSmnWV143rKPGW2vM+fRX+ikyEiWQipRMXIt+5" +
+	"kLMbQhX6l+HzDuSo9AElK1wMCJJBzsGLEG9jvOPHIqJZol91ok6rwSw3KA7Mzhhc0Dlj0tMvdzovQTYuJt0G7h8O6P794+Q5" +
+	"TQnqGZdvK9UOApVR7ccOOEZ6Bwg2Pt+5a/psU3XfYHLYv3JN8jFD+mfy3dAp2bM+cnzceTpn/VWiObxcuIhECXdQAf6QHCXf" +
+	"QU2KNr+G7IcYaCn55FYiJH7FZyEfpEdhCFlVUsiR/CJWvoxQGSPkO5pgMK56+HxGQfXYDctuvCVHI4bk1YcE59zQ1FojNVUh" +
+	"Hp5eejUkps/hZyOdZhw2+KvDw8mtPyeG1prfidWzlnho1ru5RvFQxmhHySPQQRaf40Ilh7kcQLBQ+oblgVG/WyuQ/p+bSF64" +
+	"v4QqkCVB4nLcEMgA+odCdNMoVyzHB9IbALpC1yBdqjBK+cfZjXISwhLvMhlHV9KopmKO05Mpx3AqGUrWkKH8xZLzfMybKOGi" +
+	"Zy2zmPypIuXwNqlADo1l8c/GRF6r5GlN822ncaNu3Qd1yCjZj6vCjR3gQ66vWEUHJ91wcfMm9ZqT70q6ccU4ecMb4lzp7Oah" +
+	"yYfy2sl8Qt/ccQ/J0VP0XcQpur1cDnnJachLWOjmL1ZrXYF0cr0KUaNjq0jfHyX50eeSuGdOxuCCQkzIE3LkwcQ/v9qBo0DN" +
+	"JUPxwP3DXYhGpd6/fxx83Lzh3sMyMoibKe0epci3UfxFQO+sX2Wg8o/THz2GS95OZ0IQcTEl52maTeTGlaO1NQLljLvsJkWj" +
+	"kIN/mciiJorNWFaetcP5YaG9atWube9vCiFHgyJ6jBrVI9BMHkEdj0ijOYWqKB3tC34paJGu8SJzYKi3msovu4/PMJLlEW5l" +
+	"1lghvW+kq7yKa0KOnuJO40pvoviL5GXpXvBbZL1ukinRvRO4Ci6WtkGWDTLMyqXSh360CldxvuCalOzBVU5xxVSWvImSiKDc" +
+	"elRGEeVluOM/VkZlhLWeSb+QliW5T9llgSxO75kukdckP6XCmsCwcuMHs9p82v/cDpVRVRY7vOf9G8L1d172YMdfHPafOwLI" +
+	"hcruCkKAPhXy8X13TJ/WuAvbnadEzWH2uyFCIGcRHYzCmfPHngpW+ToyDhQmtRWHVQpU+hjCx8UmNDOE6iuP6Xvo2HLhYqWq" +
+	"XhnJXjMaNBVwJS/v7pMggMKDVkIt3EhMcL9XglavXokJLyXaH8E/5ULF7oyirWqTRWGCjlfurdu7dx3NM/T77yzenH/K1RD7" +
+	"slxFERar2lgbTnQ8fNqpET7Cx8nkd3B2MEDu22cru/t12GFE2+VHD/UZU0VnCG2WEDcu3FhVGShc7ELuTeru7VUJC00bzPBK" +
+	"zvCqiiqMCVqj2qrgqjs1Zw53Ej46DOOPM9yhGWmM67uy3W/koPnLl0deIox2C7XwQzZfhfQKK8KqNe2+cAG6nT8vJrx8+RKB" +
+	"+w6lNP/Sj5B45ni7cvmXjnD5uIHUR9b+z5jm5/Ilne+xEfgJ7vhsLSssiPHLl7gDNQ/4CS9LRgDy6Ca+Ez+17Ps8bZkv3N/g" +
+	"gy9/fMlNBXdcFecrxVVR2cT5ltyg5AQICWgwOc+vYHFGgSgcVWf5SKS3S1Yt8yPKFV4UosKiMqnUKjk4odwPeNsiWiRu0a+9" +
+	"R+a37FM7ZfyYV4u6vMgwJyZGnO/yIlxfvbqenIcYIez535VyW+wvnG40tGo19mjH9vga5JNt0Irs9DJEtWkdaXgOOvIIfMkr" +
+	"L62pTna41l2zQtDyY1A1hASDKslhC3MorYl6O3sFo0oyhSlUSuhCnty7DxeX1F+wDJ6Qo5+1bxgMwfe0854DatyYzCl5zSmG" +
+	"dCfJZJmnDoaQJNVMiVBQhW4yqJJimIvCoNBpNaYwFhzHq5RVQdTqjIl2a6KQtCmuduGFls49cA8Ch10iPxnqfHcO4PP9kIgj" +
+	"SG9y92cefOpeG7Cm68ju/UH4cWBm4NyJeUEFp059NWY0J5AV5PsOmfFh1QaV4uAn5fuBcL3OqDUo7NZErUbgVUkOswUb2fyU" +
+	"eCt5hTEYyKtm1UcfIE/JmvFjMY+LcBdylBsqjOn5e+eYo9c4HnTbR7ZdBYOco0hV+BtGszEiiJr7ih9L1w4M/kDTtNOLzggN" +
+	"jQ5VWvwEUYXhPDxt2Is8LylsDVB78rAe4eQBAX6s8ysnIfcGrp0z5nPf0wfwQAi0ksMYgwhSbtbGCAkafgzSU9iIvf6ziBaz" +
+	"msa1JBkNnFGJ8DaoBQnkSHTOpL8E4cWEInKBPAZyBNL5MWTXduf4IbMa9gQAvz8FLvZL+u4JKjHYuc5fhQ/4MUzKRoQ7zMYw" +
+	"aVdUSeFWg0op8FoNjdfV67RKvcLMiZZws8MO0yEI2gpG8mOjNn5P78Kcy7ins5j8AMrxuT06Hth/5aBtxWpy4K6owJXjU2Yt" +
+	"jIir9ghg2iEykcyvGZTbVOOd1qaS5jL5bc+Or2FpM8x12vSc4uL6UQjjb6FghCKkLTFSMjTbzH5WA2UNrcahpX/j7yATApzr" +
+	"nB9MmAB/fQze2zc+XDTnHEzDqc4TzlEwflaHUcsWgJJcx7h2jvAPxh0607tX1x3+I34M0sjvSwQ+GHiObX9SOH2MtJ3cJ7fI" +
+	"P+DPYXKDvAA16CDzL05R8ho/+RDjxc9U9AUn9IRD59fvk2iriesK/xE/FoWxu/dwN6OIljAadRoMvEoj8P6gpNn7rYl4l+4b" +
+	"CCH3K4Ox5kcXNT66qqAjb8klCDkRElTNn3uwCHNkiNMH/7Ku9068ZSCsvaMir10cD/1hJ8xaMo/JmctEwz/mxzA7z67nWfIq" +
+	"+nhAq6Mr5g+s3hYsG/wDpP9Cjj743v9r8tqFvn02nY9uhvk/pr2tyv/9AYYlb/zIG0JAD2O9gPsXA3v347rN6MHo9n/qNdZE" +
+	"C5dk1/Py3LR0EDULvQD/Jxi3aAmz5gGsewwzybFGdUl1kiNwTRpjgR8D38xatOjD2Wug8PpBwKSPMxjfXrqUNCc7odfdlfWn" +
+	"3JXWMNt1i78vzcfBChuE25R00XQOFlmtjDBbRKHTxHYG8gcIUKNJh10QQGqt7reW3DueHd7/bOANCMWYLCfZA3FPzq/kJ7Ld" +
+	"i2/ZiqOw67hu8d/zY1kOYLONEhSjI2M4g0wncrQnaCFw+SKoDXrwd55zfowBj878ECLJyw09O0/YCn/i+c5BRAVTliLkgSlq" +
+	"KMwICU4EW5BS8Hh5KayRMvzfhT/47LdHSFUepiydWAY+g9nf9UCcJ2CWdzLMIlrC/UEp8LTEkkaOL6clbMIdygi73hJBQ+dV" +
+	"St5/Z/3Uq4Tm5fIWRfLX6kHg328ZeQjAT28SAmI8xt9Uy23ICdAbq0EnCOTOTT150bs36MmDARzfC3yD70A1BZ85uN68IT+N" +
+	"I5vIQlZfldaMFjWUDwVzRVxSaYIlCSUl92px0aAtZD5Eg8jz5M8hAyAQQ/ch4B/M187mROiFq4FelMb9s1evR3/06ct/9TaN" +
+	"SDUmZrtuCwv5MVKNKaN7omy7LeFsQbF7QRPxDPDvNgT8yZiFPQZtJfMwjOiJQQ+Bnw2+DS2gO1nRfwBXveQXMoeH9CwsQJ/f" +
+	"u6cvBBV5u6B/1u0+peOJGjpexLsj2MqgYAzjNO+MIo+/wFQO/KyhDa726cMQaM1feZtOFrCxYly3hXnut2wesCY6NeqFtyZK" +
+	"7wa4gE3zIZNUh2FD2939+LMD20Uu2MQXOu27P8bnSgiZxjVvDTB+2LmljVdywGTkT0KYlAPFIyPVSaW0p9DptTQawyMip8OE" +
+	"CRAIOYMcnbx+P9gdc7/CBO5wSQ4Vk7MLRn4PA8izqSNr8YmV//GrlJiJEKB81x9CPf4xi/0K58wRLH5SrzBHhFOdSQWmxeyg" +
+	"FKFzhDOz5IDzc99KUGX+uZPLDvTbu4WcJr9DKs7FnPN70nxYTwAMOa0qzQefhqE4LA3sV5pHY5yYvuboNzyf3CC6OVlBehW2" +
+	"XAxVfNXkVxXmZnU1KxGghq4jPGG1d1CEzhKuVgrUwtbZqHeYi3D4GWkgFvNNG3A/3kkuA7S8k9C12dC8X09lk/tmB5yCW61V" +
+	"oY2d5K+DLsxBNCRiGPwHjICih+RF6DdgJL/Uqkf0+bU/ZHas6whfzI9lUUoarU6hs9ptVLD6gqQC9dJoEeEWs8NP4HEHXKWa" +
+	"/vXrG9og8iRw7qQ3n4+/u3A6cZKSHSt4L/CByhDAQ2QU5tfAjGUYOozN+a1LO4wjG951vtiV35zjOrXcBpVEASGMOrouCfeF" +
+	"U4hDZoSQxpDEqURLOFXAervFwfidTdSiY63sPARrcHvvYbud+3AjGDgPRjk/xPiyoZGT/OXPVqPVL5mTMrpyr5o0cbaGzpWw" +
+	"CBFgxDC1V/cF387a/Knzan7qMmfputypopbkWzPXJfEQ/w+VRZJYk6489Toq7ViQqqDTlyoEBaeMCB8AnSdSOTd5TnBlUEGA" +
+	"b+opQKtyh0+HaHI/tEUzcJ51fgkZr3blLIN4nidPt06bfyEf494wS1818u8SDI1bCFXI8pIJ3Ja8ls7DZCjPI4RRZ9cv/Df8" +
+	"r0hLpZHaj6oFHBGuTjIa6MU7RSccpIdzus4crosDOOdF5w8YqgBoRAxNOq9zHuIyS95gcJZwPWEI5sha5zzyE89B+8tXa1US" +
+	"e4JXCBahYxZAxzoAEv3fEf4SlrG8v+aIcJXSaKfcqhY4UY7g19HYNQWmlGBTCgZOVNB3AqIX+dh5DJ4u39TZmjIDvFf0XD5q" +
+	"wvTWTebk3UOu2ad5hfMz8vXCg6CCSjyo23QpgY/nJrQAb6gDRbUhehV5vl/l06w1QHxkWES7KVdIbYHrNX0BqIC8cMRVwjy1" +
+	"ZZ1PxeFCHDIi5A12i9kXqASjzK80hXEifV+dDtSNptUoAMfiC91iw/v0OUeuEhGWwcLHj8Dvy8n7u4/8cvzPHzmTyGHu8FRr" +
+	"jbnzySO4hS3kKnkGqrD7v/41Y+2lXiNvldRldyOZrpv8EX6W0iJm8P+8IUoF/a+zI0II3jbib7m+V0Qz/1gYDbcSeLoxFrPF" +
+	"7tDpsXPglSPzOR65wAeDL3TnvJu07ryPB/5Wx9ytbzH/xFbDt25ibFGb4iHkG2aPIsT78kdlHyQjL50CGw1063lfcn9UXcdA" +
+	"TiR/k4dCJXgNX+OXzkr4t4uV0uaSf6AyPPJSsPuekjkUjgKUZrENQkpRZOkBELxtwL92zVesRCrK4w5tuNpPoGauLdFiFviS" +
+	"qD9JHOZwCDaRhL8FTI6TY2L8eDjIQzIUASkmF1QHx+1XKGRYghJVU+xBmjKwOI2CZstItCWVBRcOqhXO7U/JUXJMrCfD6w5k" +
+	"VZ714MFx+3iMEEK4DG7+78NO0GsVJovN8T4sYWtY2CmT6b3IKk0HTaaDJnkMD85B/4H1u8OUwd49zvsmoSozTsmfJX+Qc64F" +
+	"su/PrBcdOotdL7K4z0T2PMhid+6NwTgmhsMJZutJ4A4PaN4Z/zh8MnmJo2M5HBNzRQNw+Hto2WXpxCuTu0twlztHkuOub5EX" +
+	"1QqCBNdm5+wOMzukMEFAFatVpYkwOCOUHKfyBbwIYlIAz5gN2bVwlQHT55HH5N+DnErF4apYRX7pnhHUciHgEd1yUuJrNYO6" +
+	"5OAVqEDbBrWooDRtp6JXYPNwDsStgd/XuXUTb46s9AVMnrt4bv4RRTT5Zkhxm+4xifWUNZKe8vjttnod6drrnFNQoGu1VCsP" +
+	"EumDX71OoNGwOup9cfgJUvS2Hr+9HxwUBBh/emJQz7ZRwJ38CTDk3+sxOL/l2nWnSUpImMD5hy7mls9RRbe4geFraA2wcC3A" +
+	"sZlyjtt6JI1/K9pRIj07KkT6liYVlEKiShMHGoG+KuDMEWaVUnp0lA56hVu2iXpey9ceP5S8+anPlzD82bOxwG0JSOaApO57" +
+	"dazgg8SUpC6r+49ouXjE/LTAqmFX4KsmZ9s0BYhqcmMddALiLMH+nQUuKL0GHrLjuzzgIa7PaoXC0fO7fsMAj/9QwQW3GPTg" +
+	"CwRoheseZ+XH0vUVDGabSmlMVCvMljCtyq6363W4FyE/QHNn+l1DvL+p6CxAwPIBeBToyMuORZBDqtfG8ONdl4vCEe7yY5UW" +
+	"sRHew7i9ETsnHXLd5pooxjEbTEfNFpUtibPIN+2BoODMYij4aXGNv3iOEHJxYVHSjpadziw6//JS+/aF7TaBUzog9X71EEK/" +
+	"7XVx5Oha5B7pswWW7GhxeBc5/hMCFOW6zddXeLFX3PRwpNErsGSLoWAIV/uxwyXihJ+/LbxzFFfFSrIYtADkDbkE2ULBJ1+l" +
+	"f3AMFKPhW9Lkd7Li8PRY8oi4oBfE3oRddA53XV/wNcWR0vnF4uBV2gi9QlRpqRlmClepFZZPfA7sBxPEnDYOhWqYIy5MLuFt" +
+	"n/H5X839aRE5NfTvVdUXkw/XqGhNLC/oAn8sacHevWn4f/htKBahCFY/lDordEaDjaZdkGjBFBYMBi07USole5Wum4r/p2EP" +
+	"MovMGp+nAH9/CAFvS4AlcOtGf32kHvR0Yr9/lxkfYTZbD71OJQ+HrJ3T9lffveu5pM48ntwBoE/PDp/eJSXQG3Jh9vzeveaR" +
+	"EQicCqGO85r4pZTX3KRy/gYXxS9pugQEb8fwb1y/KPLZ7ZbRRlk9ibnKwh12h1XpbEMazBQ/g4+2JkCdfpur/Mq/ORcRizd+" +
+	"CLBrSjsuveN+5xVJXifw/6JcxQGUJPlqWWiwjhMVNHyavrAxmmgFX8kVSv+v0FiZVGEt4WplSb/adRwhQVl5eS95aN63WhA+" +
+	"CYPjyW9XT3N8TZW9UddhkdWTUsIbVQJu2GReyKndn7wGxQHITQaoVYcjn3ZL4/xD6m3rErmjR5tFvkJcJoDdIXjfnhESOQzD" +
+	"tKGOWACIhlUIStL4u2S7opXke7RZqe+RpocqSf/1819//fxXcfTVqwevXmW67q2OP48CxSdKhdgAIUUek5NzhLakrQJQJbZq" +
+	"Olq1z2xLstklQawwO4feA3wP3xY/wo5UwLVSFAAnr3OXOThzxsbxdjvPIam+0ddCEj+x9G1g2dc0FlpmWaYe6nPA11c0sCfV" +
+	"XbuhcbS97loYPLttt+3kczLjg6KuO7mpY/IXTCicvLLNwGmF40vuLHpI/hr789dLQPh3yh1JZsWSO0ICvwuFI2R02FXytZ9C" +
+	"Q1/zUJ+U+30gTQJC/YW4zpUjRRkfdU5vC+ZK0V/9sK1rLWvtCUUwENaOWkoek4sfDIOa3wzMjcvOKopSA771c+ukUYs2fDRv" +
+	"8LCFZBGp/PEX0rjJrgI+X9iNQhAKtybSMBRrIp0WrelpU6YDsgkaYDPHsYNaJQ7zrvTXyRFj95AFQ5ceXAocNCEHVg0sys34" +
+	"7Jc75H5B4bAP70KNHzZD0A74hyzYOe58YznPWYzrtthOcQU5EIL3HQbl82eSFHCoZ60VJLMwr8JZEbI2zScfkEetuq6YvaBH" +
+	"rYyYrkf67+i2OHt2VmDV8N/gO26r8/Nyp0hsIPrdH8P0q18vhLB+o+bhIc3je97u9xHg+su9uODmgx8zXNuS4wIv7GZ+oHBT" +
+	"uMWsYgthVIInGNZsw9SLqtDpBY4suUR2TFz6+RFQQAr54sTAorzOdfKmwp9/jW07lxxfBZOXgw8EfXYI+NXkF/Ll0X5TNhXk" +
+	"Kr/cAfjFYd35xuXGjHLXHC8zhEpCgoWv2ZR6nULKRkMf88HicoNJmNQfKk45/5LrXRlSvBZtmxDQvAkUlxuRPJeQOl20LqXj" +
+	"eFABnGy5gzzY8S0/tKC1tF/DXbfFgYqVLJt7vNsv4faFaMv6Qtxbpnvflm0iLd7vJiEzyKNWXVbOm9sjJTOmy9EBO7otypmT" +
+	"GVgl/Cp8x3mRBmR6ZdmNAhfcbpQfevXasrVPX5jq2T886N0NZHm7Kvjybe/35d/7T1c+dRPKrnyMpjqvCEv4bagWymar4dAr" +
+	"VHb3OxCLfH/Eonb/F/3hDexRo4P/x9BvrjYh31Q/52hocPf2U07wUMM0B8yWAEvQlvdqE3KPXAr3DewxLoMLJmuD5k8gz7Ay" +
+	"ZE6huRnglt559TD+rF5S1ASohOv9l655ayEvjOrw1j0sCFBv120xjp/C6gGxGtfUl+KnoDtri+fM5VyeeOSbFWOgChg47tWR" +
+	"A6XuzuBm6U/gEPR0IfKv6tnS97g6PWdq7078PygZIXZeVSkjwv+XwzVzP8j+sAj6RtomhqxK6cMO0u8/bQ+dAdtrZy4Af9K+" +
+	"74AV5Pe5jQzcpFYC7uh8Qs/T0P1/On4356uQ5c5braAp16BkP5lUmWvVRw1BygRTaxn/Iv4flPJ/wb+M1yuKOXX/XyZQq+U0" +
+	"8Cc98Yg+9RdyxAna/+sUbrfK49qXbCZzuIZNKt0GiwohQK1dt4V/xHlSvXcWwpfk56DZFo28SuPnSPSzJVkcOuEf0oV8T46T" +
+	"EfPn/cq/ds4ihY8OfflHkyczuCpkPbGDExochKQxb0u+Iu3+JsUAnX8a+f1Ep2xzu1xCrDgPaRESaOgmsiWpjVgbAlYdzUlt" +
+	"1XLqQT3vkb/nwuznioH9eraDECfgweHQ/gqhURi/Fe+DWpzmTfd/JHg55C7/g7iuNH8ai0XSK8wOGijjR93+3E7yfXr9zOw2" +
+	"5z+G1wdOn4ea5C4ECJDTp1n7Vn2+v/Dpp3+Tp+tKBOdzhFFD1y3hc3Eeqsws4iRkCkNajZ810S9CDmqMoCnFjDo/fiF5O2uB" +
+	"C70qXvsEIsgw8iu5SXrCC9KF/5J0f/0CX3sMCvLvo/vkJpjukK9IFhyALIz3lpR0IFsY7jaE+BBxHVIjVBZzVRKyJiJ/4NCc" +
+	"hm2Hj/voX+D+ugtKPv3BB+NHf/HBdQK6TyGHfEd+fXtc0pkIhHRxnTumhcKyyLBoBAkr66LijavI7y2S0pq2PHLk1cFjD+EA" +
+	"3IPjJe35kgFZkNc3feA3Z9d/AsKbnc2dGQijQNdtIUichypJUEPAmsYivcCPLgnN+GdUWQ0I5+HuAxtNWjoa24G8ePAH+Yc8" +
+	"wLZlAJDEfUHG/D4Bb3h+eSTZRO6QjaCGWG7E36QG+VGKJ2nnui32FNchL5ZZQmUyYJXSzxpBp8AjtVIKtOPWVHehl6+dcGk5" +
+	"OUMmkbYAa+ADSIJ9F/ir5Atyg7jI/Ffwa0k6hnswAQbDAyI4zaymJQLsFO8hBYXOMiGr4AapAcFwGYS2MGfl688YHvUQ4uPE" +
+	"eagqi3FjG6FQaXTWNHD4gw+YzFhO4Yy0Ks5vTEFcn9TRHcJ716/d1/qiBEIm570C4D7uv12lJh/O/QT0Ac6fIVGnAWhZwtmI" +
+	"M4pse1gyG2FkcN0WDonzUWUULI/DhtHZOZGVHDTKo6iUiF+6qmnLwmFtW4w9dZ7cWkVu4cv9mj0DHTmPsx7ONZG9D2fDRLIA" +
+	"wB+acs2JD77rdFUnp8lecp7QtE6oreu2qGD7J9WV0Ys6h85uU1ECUeKIMDpILbAZWXQIHl6wCmB8syHd4KuN98mz0+QymN58" +
+	"6jzAdYcT3OczH2w+DXB5BQwq6cBtJPPJK7IZIA3CIBRa/lZCqr9lfi6Uh4CvKc5D/lJeRjo9muvTatQaw8Rg6nHz8wdOPbZL" +
+	"x17k1u9P4RAJzc6dX//Nv4CH/Di8dsk0/s6l8yVZeQPN5EvicrJ6rDnkmHBbXI8qs9vDJGQyI60SWXV+oDSGmS0Kd5yxSomY" +
+	"L5o/7EI7NhIXVF659w2YyY6FW6tZyFekF+yEEIiGreRKkZBWi8t/Rc/hW5zkIVkGMMV5j0xcvkjAQZAPneARUZON5CrJSSwW" +
+	"FklyRu26LdQRlzA/h4aygI2mVFXRMtlgUxmFHPLy3u/kPjmIi2Bym+aTIRhX+Y3shaZ3uLl/vR2/aiPGG1fxUmxJOFunZexu" +
+	"TJaBTMzSSts05bNRxQUM6v4XcfZagjUnAA9t23QGBAOe7IC0W4vWvPlx2PMNE1O4oNd9JdzyXLfEmuJ8ad1p3U6FGdmUDr07" +
+	"5zcjLCHwEXn+213y9MG23jPqd+8OIVgczaWR6eQqOY0xpP4M+m/3j9o5dfnHh/lmxPL2azdsPkWcz/x3PlgRLhWa1TFpYHHw" +
+	"fAo0uPAdefUWghfk54z+4CPgsG3KZRojMPo23+Ttp19ux9u/+XzOTRfCKM/l4pMZv2sRgliIApU1DSI0yGhizEnDxdWwrPDT" +
+	"nd0XgoXcdqFPcfOShXAGEATz/beucC7E07f+QvaTrS/E9WS/RB8bhdviOrnWVBI2YEoeiQiURluiw2wRTR4KYYVn+cOEfOKC" +
+	"qqu2AAcWYv4Sg3HfLfIN6QODoTO0g8HNmjZvwrX5FzqRtf+Qp2QejHR+RxY8n5hhWIwt0AwCcZTzIrk1lzyakzUDIcShHATC" +
+	"K3El8kIqhoVKSrRuMZp8QCvqagEokVT0PgwpkdWYiLjdPgPWrhhGflUO+hh2DFBxHcl3xIfcV7nIYch9CzWdkCLsLvnp/r6D" +
+	"lzm48sWeR1BMzpOtpBd8CO1wPuTR2SMEKAmBgMX5LLufO8yN5fk3qrhr+8ltEgnmXRAMV3Ea1CqZwY3A1chtHOS85zwp2VwI" +
+	"2J6oEIpwKyEaPUbXUM8nk1tTW+R9QO4A+P/+G/gB8B8unTxlydtefJ+70JTs+IXCSEPAVxfnI5VMz4xGRJ1MypUGjvj3CYTg" +
+	"jt2Gj4QQwMMjwXwR13MenDOuJqd/PdhtF9zm48X57J5TgwUzYnlFpVgxHygPk49/QJ78QG5AxIhm7aalDKj16i8IgT59uw6D" +
+	"YLixlxzAAPhGsyYjx46dv0yjgYaf0SDuiXOTOV05OcXwtctyShK9/sAFQtH0qOXkFgSvtV4CE+Adk2YGl3QX5xJff/KMXHRO" +
+	"pzBauG4LK8U1lOcEm2gKRyo/ZAzX0/AdSm8OZs3wo1eOJfNJZwE2QQ+o+mjj1t1fkJ/AfGLUWjBAmyEYV4JO0B9u4WP/fEVO" +
+	"k4vkTyqNNsHJkmMIo1auW8JjcT6qxPRFmIedJRluMmAbRrWkCE88e+K8Oe0a9IXPV7gukDvPAeo/mQch8Bm35eSUr48tPQZj" +
+	"SvpyS8g08ju5BDP/hBCIgUdE97afhy/nIW+m62n6U5UxDSzhSmxibGljdJXIqVPiyTdQK6w+gPnHr8g38A25s53cwm2/5B93" +
+	"akYWcs17fUJ2kb8XEscvpJ44HyFAdtctvp64VtpXxModiiwrMz0NUrtKa2TZE/Uagefrkn9ukJfkDlhg8dnO5BQZ8eLy/dvk" +
+	"OnRu3Lg5BjvMhN/PknMwFCJ+vDJz9OZZuyFiPZjI2benB3Xvvn3BF255KM1HzexYEy2DQKeh0umZMOSTyZOnyxNxu5IZEBK3" +
+	"KW8WhPC7yEXyvSiQzUScX3JEEIffg+dIipEFoZY4D2GW3dmoBiMHRm8w2YzAzSeHF8MxcnQ1+Z18DjfJz4+FEBIpLCLnSprA" +
+	"IOdNrjWuVVIi2XpU9x8W5zPJp0MWEbmrlYvUAKAa5DCQW2ffkJJi8gifgwldW46GYOx/i3wMAK1ucVPIetz87bji1bB+Od7g" +
+	"oeNkie/A5s6QrWL2nlWLhyXUWUhuQciS47+sxX4nPmhf0kCcRyw/7Y/6W44n2SuuY/KKFXo2qz36wh4RLmW21Bopc+gEnt9D" +
+	"dhMjOY6vfD18Q685s4DcIQtPzti1hzwEWy0MEAD9cDp5QvZDAwi6DXBu9uyTH+3f/9fMnWT6Z1eKp9ZdMnEbw1mFgHvjlhUm" +
+	"M7ZQxafQ6Bgd42vTr33ltRa+rFKvYCjVeA0x+Kx7vjR28CiuKZUUgFIR8JXkdVSy8hJmFlBEITBNzHuT1znkt2bkBYT0jusX" +
+	"1QlC+N7kzq8a5zrcSf8zGHGE89dPR+Mh+7AFcSjadVuIENcib6RBiZKlpGVvA2g9bln+gA9EgdaopYmOTSzrp2Q52cx0kbhF" +
+	"kcnQMqluhtMZmovrhpoyuUr42lctab2WM9s/u3LhUCE5BplwcOex28LOtg3GNFmTWNI9uagoJb3xbi5o7cPtf+ye+zf+be+S" +
+	"V9sfjDn06zmpDoTrNreRP8X2lrrKHUaFnzXRloRURh3iNpJ2pEMIBITgWfQxjwkgCw4TC9y9csOFXn8BLQEfJjtkG5v7TFyK" +
+	"FEyGKC0ivc5gEJP8HHZHksVsYxlxRAWPl81ZtGwGhJK2pNHHH/nirOVgFFp1BlCDiKtDH42GLCWroIfzAK7zAsLiYxztbaMO" +
+	"FhWMGJ5Zd4lU+yeCj+THSfHQEbSUGEgUFaHB1MpRRtB3lWFanV5hURrphRrPVS7oMajX2Fb1p0DoixcPyb+weSrGXZuB14tq" +
+	"0Ao64+FQI2vaoeCA45NWbtz04XlKYOdh7bI+fds1NsbkLoDq5PtBs/fK5wliFbbzw5CCReopTWFsrpgFyNktYTRQQE2lDb1X" +
+	"c5jpFYSOOnv4WdPWrJkGIZh0Ik110GMuALgEckHYMLnl8C8795g2DWYta9f/BGzEjaEeOUg+gQbOX3DinNy+QK6TzDVnyfO8" +
+	"tfDt8UHWCZB3Afvyw6W3FO1IBLeYH4r0CAl0xdVGTkNDM6i3RG2kS6DiFpGBJN8I+oDZo0cXWch9A4yA+WGkHhwCIOc+hk+5" +
+	"vAWTyPV7sJq0d8PcyA9FYTJMh5HTOHSpYKc5cXUUtEIvgdYodBy3kfQhjTRQzW/GTNxy5+SRU7qBL7mnhImwfudnEEpy4Iuf" +
+	"yL/rAdYcOPkHhqA+4xdPhb5k9ucrWrmYbRPrus2tEdeyd1lUhttNYXpRb1crFWYPJSkdZocygleJCp0f/AWdumHo3HnujBkz" +
+	"p0MoaU/azfoUQw9ojRtNGSsYt86+yGHnLhgEQBaQj6Gl8wxOgrqTxj6C+n4a8vHMQXZS4jkfCqv5oUiBQsvsp5IWVLdbDSrM" +
+	"GZHKz2RW6PTYaMDTZkybOtN5ETYXLknqMhEWwzWyBQJgQmaMEgeSIaQj1sEEMonsgBa4Y63kgc7r5DQ5D6lggHrc9P4znGfY" +
+	"+iLEfcYPZXfrSs7oR9PDK9VGSi0G7rNu5GpvcqsXuRqfyLXHjYmLfAZp4AP1yH7yNzmxDcyTcbhn77fwrVh8mE40GTiHUaGx" +
+	"6lIhiW6U9DyaOm213GY4eYY0AANo9auGTjw25Ktz0HPiul3G67/BzmePfsZXFh99kLSo+OrXe3f3GnBbgm1VdOHHszsaESuM" +
+	"fjYWYWLkKGFJ/1FpkETsFjOjdhqgkain/nK71aDoAp26kF3/DBsClQmp0qNLJeKqPGIY2MEMy+qTe9oDxWOONfxo8CTSAxYv" +
+	"79F5/vq27dfAYvzJdEIWQdSfD7uRGTCidy9at7cbeXiJ/Nq5H5k4cC+GMYVXe0ffgU/heb/R3Mj+svzZKK5D3uxsbaYWsdKa" +
+	"qGK0S/mPok6rztKf8dykjKYtVo4FHaVaOJJSu+8QchMWw2SuYwDevIWsAE0losX1cTSo7y6bY7VCPzJDopVhrtvcUXEe8mI2" +
+	"jNISppCJhXpWa4DWSG9MrQa8bm5y3tRFEEJakG5QABtJvzYdG8EsHAF5XHVCn6d1cR4FF1cThj5rTf40SrLYJUzhh7IbaT9K" +
+	"E5i6O5nXU4XVRiytrdUgTIbPyM4H0G8ieTaxP9wln+6GuZ+2K9wGs3FHcpV8B+kQCo7F5CeIW0xOkmdkN9ggEuvGjCmV+eJs" +
+	"Nk6pzFcpWTEQW1K4zahhNCOJfz8IUsG0GUUn1owgd6GGc8Jm7OO8CH/efUxeQwOwPBw88PjpgYd2LG+1VqbHDbKv288ok2Oi" +
+	"TaXQaVnshN1ii+A2AOlCWkNVCPGZOedoN5KxD/BnXDwVR/u3/l68lttn7t+FHINXHfMyd3yPZH4RlogbWRyckjIkNoXRiul+" +
+	"lFvBiKma8YtQibxC8qwLi/eTxSDAuBHkCQyfCCKZtXPQkN/g8lLyz2D4okOHT2ApbkHukFNghyDIJHfu3oUgcozcJqdo9Olx" +
+	"6LQZaucR4l084wO5to2093Mke08OVKcLZrY4jAqdH3eUdCUtQfvhhMkggjkEL4S/nM9xED/3bSZ5WfLyxLwfzgwir9w6JILf" +
+	"LXRAPkxfYqq9qDtNbVT7gKCM4Iwc76fSVAKjAcFIWD+w6OP4+enk97SlkeQWXM+C8CwQz+f+Sl6Tl+QqpJElp7+CYc9vNnX+" +
+	"iVVNrv6LF0+AquRZGhlB9qwkf5MlcFWag51EcJf4S8zPLst15htmLG3ikqLAotZLS6jiLpHepIE/BOnhw0npjbxve2ckLwpZ" +
+	"mZ1at94uiDKRXDhCyLntO7k12U3JPBicXQtUXXr2JDuk+Qmf8cORiBwIIUmmgqwIOY1Cp9CKvNEQngp2hy4YZANIkllqFmhE" +
+	"LyvwitXLlpGOsOGzkaOmwzw4tIUDcpO8IX+Tp7D0w/Yfbu7Wuln4SujfPEtJCkhbyMItyS/kAkRjLxg2mKT/s3bYpNYH/j4/" +
+	"LnA0wOjAsCYDOjTv9weA4Z+R/Ws5/3LrTnEO8pfXQ23kdPpEFtaoYLpTp1cZuUVkCGllBIMJ5oxsVJDBh5HbAdC8ayPQGkld" +
+	"OAhALn2K93AfJ80ib6/CYkUIrs1gJwlT+aHIgpBeNgQS6YtKg0rDGf1s1ERRGC105anj3mi3iIpbLfts2AwLtnWMHtMMVm0m" +
+	"X38LY4Zh8g8/eijMgvbkNhyYvXBh/epzofM+eD4uI5VE/ESeQOQvNy7fvXv5JYn4ei+5d8OAPy7V4d1QoDw3SqV0bqKFWipG" +
+	"rNXo6TGX20i6kRYaCPPHC6YmNyP9G0Hu8H6ta4EunNSHfUdufor3Qk4ohuO/PCEvD13jIvFoRsPhrtvcdHEt8pKyJpiYzWeL" +
+	"oEP50XVjIldnCksFu0WhkokKD6rXaOriqaAlY0ktgA15ddI6Qf8hELNtRcs6PYugK1dYTSSryb5LYaQ6jsa5gh8ktepYu2fP" +
+	"tNRKzaD26NHkZ8qLCHFHxXV0buBg10IUuKRJpYM2RUOBfaq1qtc2C1Z0J8dmTM+tR/6AAmim4l8uTZtAngcR+upjNQwGAVBI" +
+	"yRahNnQj9xfKa8cb+S7SeVLBgvlVNiUVwkyvSIWTLHazxRgCvOLLqySEBDaC6m1OnoIhK5Z0BZhXFcKqTsBfzO8xjNxxvuBq" +
+	"rQAttCQ7yfV5zs1QHQJ+W5gx9dHKYll3bRDnIC+kLl1HVYSfrLpURjy3Uf1J0xeAFqhAhi3ZDUDLDTGJZDFZB4EW53WcgVtA" +
+	"NRwnyZbhrlvcL0wXVkeI3vcxR70CMFUftUBlEfVaGkFlK103o0EFc0avyslObUb+wNByYptGE/ptiwEY1Idr1KhFTdhGrGQa" +
+	"nCRvodNH23EYDoLYcePI180qpab1AOjRzW4nW0Kcp53HsLfHLjkozkZm9gaMRkzo9H6Upzk1kziOJC4WLDYapWPV8iql0QpH" +
+	"Qf3gAfQaTQpIezBCtUD4YBoU1awFYofa5AR0BXG284/L+tu/kAvfkPqwH0RyasueY4CXvx1bbWzfqwB1oVWpjnavp9JiLqOj" +
+	"qfuFx+sm185fPAVCSGfSGrT1mkExtkJbb4ggU8g5GODchVP4Ac4fgkC69yAR3Of8JkYLCgt9G8ZbbIl2+qhQHeEQ7LYkuYyW" +
+	"VqPg9o++0+gScGTYzf2LAU+cuZd8NQyikxLHj1/QuEdneH5x7d5xJ57vBZLbZ5qiACbtvLmvWWL0bwkZy9ocYLr5FrdRXMY4" +
+	"SuZZWqbHbDEx3SypaHO4xajhmVknMjVdSNqoIFQ9fUYgzT8QE7NxVqoX2UFPVDldh+IRU+BD0hBOfn7o9uli4XKrNlfa1f/4" +
+	"ULt7nSc3aDR8YKcBpTbBUve4Ht2mYOMyqz/RkWR2mwal5gFoZ84NWbXcBvblH0bjXWCa13foqMnwIWkEhJ/zdii5fXo790On" +
+	"Nuemtlq0Ydb0cUP6tR46unF/SQde5NYzu5vdBOrtLG420WFm9Kij6XBU4RZRASNmTp3+wduBGT/DFlgP/fqmDiUvh858hVPJ" +
+	"g57dIQDyS0bbD0ItnOL8dvWbgprk28IFS9sjVCoDhzP72C0DabAAm5hOZVNojaoIpUKn4DaStqSjDiwGmDFt+M5ux5+R+5zl" +
+	"2nV2eDn4+t+rNz+9Cr8AVE6vsh4GhxGvhb3+cds74joUUQpfdCTKqkynFhXUItaGaTUOXYRKSe3wDaQjaaeHUH+YNXXmJwun" +
+	"hNarSdrAH5cXrQP84AHVqkf/eXON/+WXozcA4rwwhmKY8+veIwA7yZfk3Mq+VxHi6H7xgriOnZfonjFGSrSBphZQtgYtM3wj" +
+	"rDaTxN1a6dEkXrx8ypz55O6U5fPn4GjSlnSA9cXFpBNshY2dO3fGvts2f8rt+HTXupJmu8gUGAdz8UaYC5PIhF3XNt6Q9fpJ" +
+	"fijykiwxKuGVjkTwM4ZZtNQVziwJldKqDXdbq/zHc8aMnwnBmLStm0z8z82dcZUoYMuuTh33w1acCN1e/0KcAAOce4JrkMY3" +
+	"f8JnXpDVZOcNHsfgzIkT2RqH88APRUYaH8emZWJplRQ6vYkeQdUaQWRSmR52eERCSS0ww7+tRkEV/rkK+kFHIFfvfHRcB3w8" +
+	"JmFwHWpeXjNo2MDoWu2hPfns1rfbfyBvoNLg4g8RqqA36etzeoriaA0Lo2hRaax2h8Wop2i0A23t1v2GQ25DMqBZyrQF4A8m" +
+	"DWlOiviuzumR3LVDUOnJLyQDh5I7V/HlL46QRvA5esd/ARqdXrryDBMF6qlEWqXAMkHZzBaHXnpSEg4DuncY3HNsy/pTya0X" +
+	"Lx6CF2k3FeNueaB4oScfk7V4BLmMu007FBx+ZPTKdes/PE8DZ8+Tzsv69O3YjDowyAWoLTkwKK8HcZv4YczmoYpB8lJQ/4XC" +
+	"6GDbp9fp8cbmzWpnXEtrO2gwwE4orkx+Dhyz+ObSSXcWcB2WDLJ1g0rb4WeS+fjhLRciF4dbD7BzfDsE+CaPyr7VpW+MqQbA" +
+	"Pj/9RBpduHDpEp762+nfOBelpxguCYeIa5Evu1lP5JSKMH9IqkVr+AWDnm6yxpoINV7Cm1c3bv1b6/yArtCrt7CG/EYOUXnf" +
+	"9avxNdee+/zICSRloBFmufMMePNGgONQCbzhc+JDuhIffk/JJ1xLMEl5Glwuqb/XYKUFFwOGNn6ieAC9A4d6YY1ghONYTV7h" +
+	"428mwHNYDSau5duZFIwHjqKv0qLX8sUw3ANnO0LCQw8cVQSoQIV5/NHbt0IGd5v4/uvUCRedb4nN5ZL6loMh5fST2vsoLXof" +
+	"vhiGlW8XLiot4lVYj5DULr2rFq56xqwK3qACfqKzNf4I2r35Ukjnbr/+l/hgAU5jgcJh/aVxhfFs3GNu+IpGDP7n8BWL473q" +
+	"nqu4XRpX8Gbtn1fof+C9/c10TZQK6St3fzquePDd/oq+SrN48J3+HzP4h8r1p+0rZXzesPZDpf0Z/MPv9mfwD8vw35RZZ7YO" +
+	"4o9I7yeKh93titUM/rFycFYipFgrj0tYe+m6rWbjHn+3Pxv3uDwuQRXWn4tg40qxFtv532Cd8LO7Hur2V6+En+kb/f64mOsl" +
+	"3JD3lzPqjWCFPz6G1j87FRC2T2ji/GM1ly3HUuJ9+JDwHYUBerDgoDUkZI3w3RLnpCUVf692gCJlDdxaI1jlXyNA/TkTHijs" +
+	"Yj4qmoGeRfJLV0NWPPDDC8tm1UlIqDObzxi+detwGEgW2Bs3tsNA6Vt8Dg8UOrBvVSb3hZ9JlWRP1OGBs+mHs5Zd+JDPIAtg" +
+	"IP2O/nf41q2l43Zgd420Qp5OI0rVBZPMMMCFAPX+8MPe9L+JOXX4Eyu3Pt66snHfxpLt6/6WxdqqGbZ0bB+gA2vEsNd14xNz" +
+	"3KN/eIHPcDRq3Ldx408eb936eCvCKBmv4Q4JRM5LoAcLR1fu3xPEdeLwV8T1lUCalvzZlFPRv5G0H7hEuCH3lzNZ4+izZ0n2" +
+	"2bPCjZIjXHbJEbqfuD+s47qV2U+u20s217/xQH4ua7cZtQMwcH+vXFl2/TuwbCG20sDjAYdmHT48i/t75blzK8+d8/SbI1XI" +
+	"1ZpUJpVpAIbDsw4Jc1aSBDgr85VXT0avF4SdZfiW8tu3UjtfmbUfcNOxV3+5/94yfLUSIW9Oahdz3XSPwCMzmcRk/+djoIMk" +
+	"eKEAimhKGuHi25nvl7t3K8KQhS4fQ1aTVWQTk7nP+RFU5r6Jer/MlWB8gJDwnQRDTWWfCj7gBSpw3xyHgTAahsBIKnGdb10u" +
+	"qW85GPcR8rSXlbll2iWeR8VM5t6vOCYbUQXCd2+OCxlv31KmkGQtmUHmovJjyvL2jwry8/Z/yM/b78pP1v/uf/S/+x/y9t5/" +
+	"yNt77/SfyeDfL9f/A1nemsX7cv83pf0Z/Afv9mfwH7zbn8H/453+axn8P8rJyVL4j9/tz+A/Ltf/3T38Q5ZLxdxR4Se2V1qw" +
+	"gVbgjpbs5po6R3I9TkOQE4LOnpH7QRrja6S2qkwDJGaW+HK767YQL1xEKmYfemJfpOiP7dC+35ix/aA9+Z1cJVfJLeFiyaQD" +
+	"a9ce4CaUTCInmL8xFXl0TrzMB+GUDxQyfNouPKfwrRJUFvsqidDtEAIREAGhZHO/sWP6cRMgFezkFDlRMombQMcpmeSBLdP2" +
+	"QRjugU3XZaRwEekpbKlgK72GN/B6nZ9WgxUib/wALl+4eeMiqQH9IBGi+3QqKOjUl/xMfgLToxvXH4Cp89Ai8u/W7eRV0dDO" +
+	"7rUeWW4sjErbpT04CMPKt8t8tAEhqR0B6oGQkC/hVgMM4RYzttHLw3AanydFJRiFfLKY/ER+7tupfftOfehjWehHaly8cfMC" +
+	"XO48tAi8t28Fr6Khncm1B9dvPHK5JJjlcBOltXfdFp8zHEh5+8B1W/xL1uvNWLuH/sTnwnOlRYHK90dI/Et4rjQrkEx/zUr7" +
+	"03EV8G5/Sq8KqNhfkULHVeCK9C06ZXwKaLsCl/Zn8Ll3+zP4nAy/wNM/gMEXy/XvgZD4rww/n8EXS/sz+Ip3+zP4Chl+fjn4" +
+	"Zn1v2i6NIvObWrjGTvimMDN7Y8FqNzAT311QilP3P3osp3Zycu3sTkM/7r9rXIsGDep/sAsX2zJqLuzQZWHNjADj6tyMSSOz" +
+	"s+U9uijcUFpEp/BhhT26WGYv2G/lNXkl9z9QZg3pXP6Q23e4544waogQv1t4jqq6o31FkyHcpkTGRMS7o2OS+N1kc8FwcoU8" +
+	"hUjweQappMZHE/r37Nyfe1rih32rOd8AAp78U2X0kL5D+91z86SvzO8Gxu8hnnOCr3s8dRKy0iAy3hhmURlRmCjJl6TtUPsZ" +
+	"+EIkeUp+HVZANuNJH43t37lnf+7pa6hMSlzojbOa8y/+6uh7/Yb2HeKRL77laF8ar6HrNr9buCjPT4qWSQo3JupVRiSZS6JW" +
+	"w+8mJ56R5+QS+IF5eAG0d06QBoT7r8k/wAMdEPu+jZAHdLkkuLJuO8XGMyC5XfCX5IBwiskBgzRv123B142HmkVGGXitEhnD" +
+	"ULhcUF3UbIf2BcPAAn7kEvnrGfkaLkvLDPe5p86/qjnfuBApgcruZZZ52D1vzsDwMHno4zjj4SDsU4amGyIk9qbt4lX+FG1X" +
+	"BHn6n2D9Q2BfBR7uzegsROIB/pSn/QTjmdB3+zOeCS3f33VbPM540lAeH9dtsTflJYXh3f4MvvHd/gy+8Z3+Jxh8U3l8PPBN" +
+	"7/ZnuvQqbVeEwz5PuwQ//L3w3TzPRmE28VwuSbiBqrLKH7SALnsDL5rCzIJ7X7Ua3YBz3aIO7Dj80fLvoGNC+xYpaXkF3HZs" +
+	"J18Fz541d/5iqJednpORnVH/XZjAYLHYIb3aCmmluUG5pO8//OjwjgORRXCWbLAW5KWltGzPbZ+5eP7cWbODIc2ZAfUzsjNy" +
+	"0rPrIc/et2V77xQCK+x92zJ7zH4rr3Vb4YZnb9zt2z3tptJ2BB5Zws4yTIRo3c8HYAvZ4pYdm8e2ajeNeyqJjNclftzTIaMn" +
+	"vF9m1PbYCL4yXE80pSQ6uH3T2rUaSzbLAoN72n/C6CElfh5B8R+yQYLrlg2+zDMlWzaSiIAtFC60d8uF+xQulXayPEAVZcAl" +
+	"BjfN3S5EyDLgEpMBaR5byle46FkfFvAs2VTcPmjnZvr2dH3gvsTrr+mg7vUpz+tKUZFZgddT3svrZkVKRd5VBLP+tcv1L+X1" +
+	"2u/2Z7yY+m5/xiup/8Hraf/B62n/wevp/8Hr6RX7K4IZ/Mzy+HjgZ77bn8HPerc/g5/1XvhuXmejIPDwJd0/q8SWbi8BLjpL" +
+	"mftsx4TkTtz2ufMXz6TJGjMaNKj4ndunoJK+h7ROyfGdzlJG5rZnNGiQQT+jHEy/S0SIuyLlFpRikPEE515uG7+H+vWk9eeb" +
+	"SfyCLkEbpaho7eYXvpn0nZRXGfNkM7fVuU+4SK65v5P5YSCj29aownhSzlk8AdqX5OPG9CAKkl5hfWSaR+zbfHc731SmecRo" +
+	"Pr8iLnJULY8blbQVLr5tQq7Jvsky+HDhjK7be/h2l3DRI6NbwiX3WMJcyY7jHjM51tJDjwJtV7SuqJMYHKrzlKx/69L+jC7a" +
+	"vNuf0UUbmS6U5eEo8uFSmf6JbjwV+e/2Z/DbvtufwW9bsb+Mf/v34m9WtH+3P4Nf8B/4F7wXvpuu2SiS3YqPCDfkPaIvetJg" +
+	"vHOvcMPZzuNfo7btnP+wbY3u59lGbva9MrbtPfLLhGYNGuZ+ANXfZ92+M7aVPv+AJ2ede2E8PYGupj5e123xZ+4oUiAtrYdH" +
+	"M5iLGjV9/63hDayOr4pPOtW//6l+P0O1ZbPIU+dn5ImJ6N/0/b5fv+9x/tRRrx45nY+GdgCTR6ZMFhtI/hhXnJ+oGOS2E8XJ" +
+	"74xjVBk9A9lx6Mn+/U/2P88RojOxgZ7OWO4eh1zrMJQO9GrU1NJxhFaSv8XVyTNOIkJiL+6qNA6zeBPtSZxRZTTbksINrDgG" +
+	"v0yaTwnAIxP44YagnrUMf80Gcm4DU+lAEi2JvaRxRA0bZwgq3+5T2i7Z++Lkd8Y3WDzDG1X4AZvnz+TB8hlseD8TPPKM71lQ" +
+	"cs2tY9zzbMvGGe6WoV5ruKMe3h0AP0g+Nddtr0LuKOVF5h0WFQM8PrhB3FWlRTEc/iyvw7xSabt4VVzN+g8v7U/HVYx4t7/Q" +
+	"SmlWjJBoX1ztbpfx9CnFk/lQf+LbCs+QL4ulq5BngGOVIeSsRkYtXKhdw2y2pKVXj4iITN3VqfWOLw7s0EGSUGAISakZYjCE" +
+	"1EwJMZBh9bNmTJo9e1K2dTXy6I1o4QZS0RFkncGeOFEtAD8u/+5sgdXa4ez3eK6sNuoD1Jc1wf+Oo9FmLEVSxH+nRoaHV09P" +
+	"s5jNNWo7x0KSbseBA5+07lgRydXWbIrkjKz67tzOjM982emEUgJvVEkG7CqOQDUwuBD5fkRB/yK8CkzOV1eegalNXrd+nrUt" +
+	"x1NT3fYg4ylm70iHSRVlpfAaIE4uwUX9C0aQ710IDFCNI3hVv255bci1Z1deOSvQlcQ/U906Suwl4ym7dFQM2xqQyLmK+rUf" +
+	"ATUBkZvkQQmAiYIEEwVZkVfCGczp7/CKp70C/iojw9yokuZB8ScPyE1AUJMuCpjINboo5BpdlAr4FzKYMz10u4jSv2IyHC9/" +
+	"Z8L4wqyYLNPtcU//jaz/VGx4hy9o/6nl6Jz1Z3wxDRveyxfT3uk/h8Gfjk3ldZRXOIM/Xe4/tbQ/g//Bu/1pu9RTKSo+qID/" +
+	"zP/Af+Z/4D/rP/CfVdofgcwbkm2lNUoHJa20R3y+c+yuz9Z/3Titfhbff/XsGSOmro6PdaSX82NXZbeVqhjqyq4KKq1A3dn4" +
+	"ptMgZJTc5AwlNyGI3PkXus2ZBd2Qi/w2ZQ+5Bl8gz3lnhXARVUEI/JCRvhATkcpmhG0iBEGVr8iWR85/oZ0wnHxJ7pXcxiIP" +
+	"y2DgDx4d3Viy3fAEdtZZ7bGXXsowHUatEllU7FEwtxKgvfPfR2TzCagCQcLwTj+QBaQ373zDBbMU3rId9VK+1/OH4R6YZfCM" +
+	"UBhtSqSn7+YMCLZhsgV7PYJ2X5EX5E6nTj/AQFjGY7HkNrknrTm/Qrb1djN4a917ISDZ1tvNbL21FXGPwOwBosqOVFojt1Ik" +
+	"d8iLE9D+EfYimztBOgRwwc43POlNFvzwDu6yH2ODu12czmyiVfCkwtmmkWzTfULbFas8/dndqWL1O36JaXL/u6z/6tL+dFzF" +
+	"mnf7MxtqjWxD3a2Az9r34mNWrJX7f1Lan8Ff925/Bn/dO/0l/De8F3+zYoPc/24F/Df+B/4b3+1fxgbcUGoDchHCDUZ37NmT" +
+	"VaVDwWDCeTt+7H72DARexOf2LoNvyWU1ebSw0K3X8EPhGbJQ3mMvzrQaXSiEgOT/sNjo01sqouOAmYvWRB3emzm+ZsO6cRn5" +
+	"0wat3tB4ZaNGC7Kzc+Pr540asGpB9mzhu92hoVHRYeaqfkFDes36ICRkf1RUXLQlSqkOHNZt3HRtOVwp78ZrTbYk5FCxKg+Q" +
+	"fQH8fzzb48cd+FxS4ULQqaEGSVkmn/XF9sJzFOg5gxmV0rNKyaNgVLLUBjzKi2wbA+3IL2/u0H+RLc5f39yB+2ubTOhPfr0G" +
+	"VV9hBf03WK6Rv19VgMse3+lURgmiij7QMauMbDS85c6bXwjZEtM2Mu/Om1+d0I7+C+5jxSuoeo382n9Ck7XO16/I39fA0n9C" +
+	"E49/QWwv818644nP3X4LxS3uKqtCTpddIatWWiPLTl9y6PTs0bNHO7Ks4GaLmZuqm9qjTuuaTO8uWAtex75tyjUxTOmUUWCX" +
+	"FOeCDeTNgpJcAa7U3pATM05SyCCOn1s8QfihxdK6UeMkhUpKxs9fNFPhwVNxS9J1wtdM1x14B09R4QMmlq5J1qGsZoHD7qAx" +
+	"wnqdym1u0PQtIr+9MZ9bsoC82bAAuSB5RIGjIKPjFEMTvsm3x0GxboELkZMj2jva5PScClcuKmYumj8eeEn5jouqu7TFD8KE" +
+	"4rnjyRvJSBkXk7PhP/A85LFVezF/yP6K/gevqpKvVOxL2xX7K/T//L39zYrPJZ4T+5b2Zzz6xbv9GY9+UbF/JY7ZwgfK60DX" +
+	"be+1ki2scDF8DpT2Zzrz4Lv9mc6U40sUrtL+DP6h98I3Kw6925/BP/wf8A+X9mc6+Uv+X+F16b6HWUr1cpl9Z1Gkosnm2Xeu" +
+	"UgwX9WHr4s6tVxysZzc4zMlN1HE4bvjgNZ3arDiUazdaI1Ob8ldG8I2b5dVdNHr6xviYutWqt4wdwtVr06TeYqnB39Jerin0" +
+	"RKpbRW/XJdfDcyhwbsOvyGant3DRmUtVMuhL9U+J7EtRM338o0enlZSpf0WrmOBNuI3Tm2zGr5zbKBzykGph/IUHjqzHPoPh" +
+	"Hjhl8DF64Dx3bsevoL3TG+fjL0BPFa8zF7ntQf6JrMv1DM45d7vQXdK9op7p3nMV8awB7vluIptwvtMb2uNX+AuqccnDijiG" +
+	"MdjnPbphAdM9Z2BDedtUlON/eInuznj6S/6QH9/RPQvl/s9Y/x9L+zM+OPtuf8YHZ2Vd9awCPufei49ZcU7u7yrtz+D/9G5/" +
+	"Bv+nd/pL+J9/L/5mxXm5/7MK+F/4D/wvvLe/W9deKKNrawg3GG3Syh5WlVELO8/CKKI/C49wMYyjelayT+S4GD9RvOb5to5w" +
+	"g8UbctZAsHJqU2UwQdeHV6ZOv/Lwn6sLF/zK14GVLgSI9CCtQUX+lH3P4kXuKDuDquihn2WwkHwbKsz3P9mfiyax5G/ncfI3" +
+	"d5RU6da3bzd4Qa799Mj575/wQqJh123xOxmGUUU/TWWUxsAJfaGd9DmJ46L7nepPofz5r/PRT2CSwHnObx25qxQGvRqQfPlG" +
+	"yfmgErh+p/qXXICfoSrOgKrwgn5GqoCJYUGquO89y501/3DTv3hRglsDVBRcMMONDUH9nzLIn0su9D/ZH14wzMg1aQg33HLn" +
+	"zT/K8ZWfHEPgDiGg52OTajsOKOrfv8h5D6cDgB95Slx3uOB+3/cFvfPRH07nG1SB3+T7hycVYaupkS8dY92jbIf2d9xAnV+6" +
+	"BxIuOt84/3jkBH3f7/vJtv0aCQatySQfr2kMAQ0h4NeQzXeIizwFPwCc7rxHgeAALFIQ5CEFIdv5a8rh9wyh8rA9EN3nYKuK" +
+	"X+MG5/zSPcQd4WLJ7X7f9yUP6fSxiCrw5V/wvIIN3Ji2i1d56U76rzL88ZNcVc4UZlbK+W5YWARX/eyqDTt2bIDeZEd2/bp1" +
+	"oQUuhuDZgGevTrE5apc/26ljwEYPdwI93Akf0AMd/SNkOEPx72+OQ9DULwBMe6ZAuIsUz5pDiv9FGHVgsVnPkQJVK62bKFAV" +
+	"ZmC1oOLVLGDLq9v8oqL5pKTS9xB4sqhRl/PQVYoCE6oX5uQU5uwOMvZYvOhcfYePs4AfQUsyulyog+t2+XgwxWuEEJbaWb0s" +
+	"fZkx2TgcLUElGsy2JM+QsJjGiJGNZPX5Lo2KTpK730NVNqaDP/Qmih/hLPBx1D+3aHEPo2fMsrFf8pg0VkArNEE+SIcQUJOd" +
+	"3TKyqC43CvjnogVzT56c27oet/LNcV4oyS+aV1Q0j9vccNG5RYFkNQ0vY8FmK9n4nhgEbbnx3rrjdcXqnvEENg49FNDTQjhz" +
+	"uOoSuWvO1zz/5ktuRW5rOu4CqC0PeIhMlwLMoGvgonOLTpWOx+CWG8/pubd6RNsV5P3xqQpSGkfF7l3Hcn8K3WX8qtKYqQg3" +
+	"fnYZPzyWHMQzSnbjnBh72wkT2rb7LqNtRkZbflyPc6cgqORrn7zBzQYmmc1JZuS5949ntGQo3Vd1hUAqf5BJC1qwyTYqH1V1" +
+	"EgJOFnWRqYosIvkVQ6zwhp6LFp2rn/vOeDLSCrPFTEfQlkZYSQjw07rN79ZtfqPZXbpRIia/k9/IFXIL2tNwK/57NuB0yK1/" +
+	"btGins6O7uHo0BSF/5yfw02xFSO63POb1aXoJLl3snx4F1QtNx7eUDHa653xPERTmmRHHpCOnuSwu+dH15NshhAwQziEUn7p" +
+	"1mUHGw36wzYpkqw0uszZUV5O+c61XGyZF4/e3y6w/e4qx5wpkBkhJN8j0ILyBjNNcVEm9kyS9NTyhcF0UYrgX12dbuTeyVMf" +
+	"ZJSPRSOLaZTagHS+Rw7F2Tcjsf6ic4vKRqaxaLWhmS6XNH45vLwYXjQ2bGxFvKSSz7JU94SgGRnJGyxm7gbDa12n9AE05qxi" +
+	"NFr6jFMnyb1uddxoBWcOvX7jEblWNjZt0blF9RPl+CNhbDm8Knl4NI22e1V+f+yVV+XS2Cs5Jny3cA0ZUCRCwDCl+XlF9w2U" +
+	"ttzllF4OKUoy483WthMm7EmPrZKVQOOtoHqZm6pr0RkZ0bEZGcK1vMHNqgeb/aKyJozMzl5d5s6qvtnN1NIdygDhOVJKp361" +
+	"HsqGTQmesCkzv/u7l+QbYuowzB079RfUdp5vyYJ6xnJPnUugwznnX+9GUI32yMujnnHKxUqBQw2ecCkzzqfxDz4QRQOmhnYg" +
+	"JvLNy+9wdCsWwzShfNQU9j1HNnJtpUim0R75eVQ+a/Rie6P03IkNEC7K45eLnQIFB57wKR2/m3z9lzt+algHuAa1Xn7nPN9a" +
+	"Gr98EJXzr3PQoWRr2fHZOGVjqbz8pPm7botHPeOrOAuUDZuK8IRN6XD+dy+hFlzrMJQFT10kz5+Rr3F0K2md4T7uSzaew77v" +
+	"hlCNdt9JuefPn2Xja9xxOHxl4QZSsuwBKoVF/V/hPVC3ycwdb6QQnw+/LxPiA+LaKdDofWE+sg+4o3DRT0SSbSDb9n6iQort" +
+	"6o+Q1xpqKyiCuQXMPvqntJ35Gf5h54oQbkFpu6Kvpz3U3e667ZUpXPS0G8q2l+lvLNO+pkx/U9n2Mv3D3e14rvg1jS1UJJXi" +
+	"icDDJ34IAWMSt6uNhQa5mYNGu3iCg2Ay4wgWEtRfjhDy3E8dlWHJsT9uP5sRHDgfUv9kEYMsbocxAEzGfWXCl4KCsO855PZL" +
+	"MbpmsOQEWm6FDIrSYMB2FBYjZr6Fc4lMxFIgkPMvGZabRiksRqBu5yIL73ETJp2jFODzJznBt2DUyMJ6+stRPu79q1yD7Xct" +
+	"MYCuo1d0absUr/NuuxSXU77dddvrI+EJjb/hXldsl+JsKrZXrsH6Z1aEI8PPKm1nfLFT2Cs8QZWZh8eTQy3JrLYZ3bX0pRfC" +
+	"tK4nq0yqlSr5m+FCRsPc7Jx69d6Qr3Z1at16SPEn3VrFXKozss7ZvLxdu/PyhNa1HLa02g5bKulor581o2vtPGhWp12L5COO" +
+	"WrUcR45Mys7KqYM4ioPXCYaDHtUtj4WxzL8F6/+ITiqUR9iDHj5D/5Fdr57z7mKDB8sRdWUs3+7xIF+KsPu/d5NbvBfrcWXn" +
+	"47lfFHYw2olQg6rcHalRMJNvD8OVCvekR6+BH9lAGpVelqIy99s7Ss+vXrHSeZuo2H2pH0JGgQNVuTtTI8yDlEMkQr44dQDN" +
+	"OPHgGnkKHWFfhbtTCke+O+3O4MdLvERU7I7UT4pjL3NFalQBJ5idHctek3KEmA+X3pOSRmQD+En4Uzhlz99eifJZ8Di739Ow" +
+	"O2TZjehR9oKFG09as0u+EIPZlIWnkkvDuXOzZ4ycutq/WqzDEPrk+Idu+SHMk2uM06dFRpvJZrXRevZg5J+sW0c2b9jg9MZt" +
+	"JA8hLPntiy9+Gwx6/IUz1/1eh53Vlex+nNX+11u1JgWFs506AqGAOgVh/Xogm9fBEphJPyUPB1NA0tuT8n5BL/s7eMlwJcy0" +
+	"Vi3/hHoGySbqJdywAdqv4zPJCGcudRUysG5/4LyyfkKvmu/gy+BJ2LL5bl+3DtpTTPEr53bJRchnsgmThxQ8qoAv8xF6pUh1" +
+	"sGXflWcdtdSFxVC2Qlrz5vCITGeerBYtzgoTehWupi+rnb0Ky8ZA+6IAhCK0Rk8UNGVGdRl1zpEt7kjopTt2ftRZVuR2u3CR" +
+	"6XDyatRk0JeaSnJc+Urm/9SXZgs1gbGcwY35sfJrj/6QCFEeC/tnsojfUzLoPS8+GOxChIRmHtiSxq8FUN5o5l6OpU8zaMzo" +
+	"IgqyLwXeB6Igkd9D32fwe95ElbWMZT9HpHBDqs+vBaPWaAPjGLyBNIdDzjqwk3QUbkxyXl09BZsQojIPHnOR4nykRjoWGWyy" +
+	"GW1S0jwr3QeaTU+ldXA2BbwcSB6CnlamGggW8iskkTPdL7WGx7umgO3p6l27JpHPf1uNtwwa5HzVqRPbVz+E8AM8RPYr6h3U" +
+	"r0hjqcUHV6ZOu/rwxZWFC65AJiQePEjOkqPffuu2NzKlGPOK9kml06w9paK+8kmW9Jh3JGuPKW2X9Fj5dtftygclPabwrtDe" +
+	"V9Jj4qHy7T7Jkh6rCEeGn1XaTusmw3GuBF9EHEI0AsuP93n7HF8kf7DfuYDfhul5if1OxX5/kOSRLSSP9nFm4mNyP/F3zOrT" +
+	"y/1YCko/8TlpRrbcKNtd+kT6xjsAUz4o+430nXeU/N07n8qfS99Xbo4vIvU730swKncjzcjm6/8Nhv6R4FSdjdkbpPfAkWBV" +
+	"XS3B+t/AedYDjvPLhCXl1g12QTtY8zYfX6T95PWF4+JZYYln3axl+t6AdrD6tUHq7v5E+sY7QFiCfMt8U+E7+umnLy+Wfur5" +
+	"XPq+ciNhiWfdrP8J4zq0h+0vNpQHI4NicKrOFpZ41s36v8Gi4D593vVdcGXWwwV8lLQeYKRQOSONPsSpMOFbSCLjvyWTvyVf" +
+	"w0xhCf2CfglaN/11d89JbeSsavfXJvqzH8z8FiZ8e/hbGP8t2Mg4CuawBKvs8r5DX17rhCX01OWBWR7uO7AP03+5Bzjy3lHK" +
+	"j1SGfypdFZYgU4Wx3h3v/WOyvyCJDjxFGvi/R38Xg3JzrqoVlrAaMOWxeD8mpjL7UwGbcot95L14Sfv4v+NWZp9RC34bn1VG" +
+	"zpThrV1uuQT9yGLyB1kM/eRvxN/5rHL0XlZGuXnt0zKiikIohSJBkmF5B/BZKLAMrPLwTO/wYXlJ5oZcHnoZXCs357MkOniX" +
+	"n96RS+V59dN3BF7Z0d4dsdy8qs7ms1DUe8Z9/9im/+Dv98nJili8HxPPGsgyQEVx4YyBkiBQGysDo3zbtzA+IUEi92++JZOj" +
+	"Ij00Tj6C1hL9QAY5LtE0x2TDBSZTfCSY0k04/Rs4IwSCH7QAPfYDHbQke8lD5y3ykOzlhqyHLPL5tm1ucU2JEH9MepM/SD5s" +
+	"Y3gaheOY3a9ItGhzU6Pwc8kKyCd/YntJJ3KA6gkyvBZonavwWiS4njpfef2DLyINqoYCUai81yb6/J0mWFUYHRaagaQGAE07" +
+	"4+D0DotDDyY/b/FPEgh3ySryQ8bfZGV6Bln5dwb5kayEuySQENwTEjP+hu4Z6RMy0tP3ZIB1Ihs5iARCYsbzjIznGeRHuAt3" +
+	"yI/0JxKUkZG+NwMSEUKVJJ2g6IeiUByyIgeqhdJRNqqHGqKmFDtm2Lr/GLXl/mhNnj90VU1aa4T8R/iPf/vBrv3sf2VoR27Y" +
+	"/mKDV7vr153f37jh/O7GDX7Q+/+NL257z//IH3yHdxoRQgi7nqIgoQezcVjGBBUjqU3U0tkvjHwzAxLIH/iApF9RkWsV/zH/" +
+	"ht1Lq4xg8QYH8B+RCcTvIiwjvS/CU6b5rz6Gr+HEY+dViWZX8VbpG84Iem9QgNEPJsHTS6Q3LLtE/MgEfBFHPCappPZjHCHn" +
+	"/0b8h3whUqBK9NRoMSooRVo5fhk5mkUWZJMjMBc6kxu3Bv/wA1/obAAFbC70L2EQX4g4KtHAxBlVNqPCRO1m+f94Y94RqErW" +
+	"N/oX1nPHSV1YRwrhJLeRKEhrCurKT1desZpvHGrquq1I4segaGRFaQhF+LBXDfRvGsHJgtkUKhqjQiO1JZ+f0SS5FRwR8n/c" +
+	"J5ZgEK/2OdnmgxMZdT/v/ye5jZ9XmTOh6NLAwfcGrf8zo/3JcZ+efkHeHh01sceexm+O92nTYYgPPCwqap7bflxQUHDjpo2b" +
+	"QhpU6j5+fHYOaOIT+nTr0+2bbd98EdSqaWzsB93HDeu/p1GDnMYL+9VAHApHiFfxhchbqowXkZgUBYwE1exf6kSdUhECWiW9" +
+	"DLZZVbjF/PnDu3fvM3hwn043v5/ftgvJJ9YRI26+8fvKDFdOnoQtc8nRlMCFvckxEL/Cy5m93NR1mzvFj1GKqK7shyV8D76z" +
+	"dB/KzlZKsylCkOLMtBqpCFcEXAvd8PHHG8haqPG498Bm9erUqdds8Ihr5DI3xtnnI8jafo/DYRFtGn43bsJ3jdqEmzGW8ipK" +
+	"sJmPVS2EAHPU+IAlguIvaNlgbIqroMbjXxsvnNP8wTVyecQIyGLDhXbnaf3l5sWvS5a1aYAx/HLlynM62skr7jt2bjhfyPKK" +
+	"lBKKcTs+SurCWtIZTjqtpYQBqKvrNjeXH4MSpFqNbkeRxeaOqLOYpfxwcsySQlTQ4o0SheBPOydYe/X8qUles+b2FbZWiYnW" +
+	"mF4Dew9zpNZO6VWnUauMZi1atPjjn0EY+6dndP6wXm6VKtX+DDSYwiOjw0JuRlhqp1S3aHThJUE+Pjt3DFzA1p40gmt8YSn/" +
+	"Ugm+CrLIUekPX/h2HWkE4VKtrzvYxRdK8fa0mkGY2aKSYjDhEOT89lP2+IyE9nHtW/KFzk/Jc3LbYAQIMy5a6Y434guVInrD" +
+	"9OJ1hDjKo1XZqLTqGpXwCotjFdSd1e0p7Hfy+d0O84Xkm14rPyN3yDWYlr1ygSd2SahHYXE/uX/mB7Gff3DTF7+J0Vfz8vSG" +
+	"dYzemrq+5wfzy1i+N3qHaRA1OgNSIvaHm72S3Fi5EowwidwnvuQ++MNf8MXj8eMfj8Ua52FyGHJwDtRFgJqiDH4wH8IqIRnZ" +
+	"w1kDPbrrjbFgo9BUGpFPIa9jolaTu6tjosm/QUFQDf6GakEZDRblLduyZVnL5Rktd7aGeGhNPiLn8j+SZEc113f8Z/wG5I3U" +
+	"zF50v8n1BXdydgdQoUEHUOgVslRJsjssDq6Dv//KhREp/uMCA1cutNQOIAF19w34F/wt1ee1n567f9C/5H71GnNawfLghnEj" +
+	"xikUuaFN44eNVSjwN9k55AC53ntvw8X95tatA3XA2Hd/4yUDZVk2gx+DzCgOpbLcOlJUqELFKFOvo4UsaZSlHAcqUppGEfIj" +
+	"EoeQ6LArJVpW6nVN8V+V544v6tNn4KChQwYtLSzMbNCp81AQFy1Zsoi8GXkwt0G7tqPfkOvQ0G5v3TElxReH9nUkJzv69qrp" +
+	"cNTEUd3HjzeGUTlWzb9G9TYtIyOLi52/FBc3b92meg1I+bB7WnqtlP4/tWoNWa1btWpNjrZuxfiuKY6jNIGqet74GqUcI1yL" +
+	"Ab37DCS7wAI8CBCO49Z//PF60EBN8j15LNe4u81vwXHs21L3O33Fu2FAr74DIY9cIW9ICflN+pY8Jt9DTZDueDqw3OSFqBLV" +
+	"KWojY2Na9MHjQuoIn/5w9eoP/Wm9geZ1GjWqwxce3bXnSzKJm0auNK5br5Gkm8wI8V5MzpgQgngajix5KfX0HwrOqKYXknIh" +
+	"UXrhisfXq9fcFB5uWmHMqW4xTiEHl8XGxCT3tFq7dLsAR8hRPBx8Qwy59Q2Ga6HB9cgjSOscF+9jrJc7YF3DBm/XlRlTqtPj" +
+	"STChYqFdbr82rSjLGdW0QiQezwZsXg+ySPaFbl2s1p7JMTGxy6DuFKOleg48Mhjq5xpCwPcarHi7rkHDdQNy6xl94uM6Qxp5" +
+	"VC84lPKqAiE+gvHyZff9B1/IFzI/Y5n8MOz2fxVkNWzZugHk/EmqPeULnYXDu3Ubjtc5C/96/PgvBKgYafit/BhWY4nV2KCc" +
+	"IzETM7qZcuF454puzf+tMbpPNUPYqlnEl3u2KL/lZQ30xD5+urqxAydCd7Iyb3/fr84gxKHxSCtG82OZvDSyXFJazmbVwn/C" +
+	"n3Dt2jUuxrm8qPm/NUb1rRZqWjWL+HDPFua3vAw+jx/DnEePnGPpYEpdrjxY8339vzqFAJlINX4XPwaFl+oKhU6KaaW1XRz2" +
+	"JIvnH3RAPj/AL9pqtNYMTrz0g1OHf+yTEB/NlyzDi7YmxMeXLOOHcmqjuVWPoW1rVa9Zs8PAL09Bd5Op2Ao9DYZNSdCTLJfk" +
+	"q2w/KUXUlv2cgZDYg/3cjv3cHCHuV/ZzB7ZHPV13+Di+EAUj+dI0CmwOGv1HsWX4GiVNgRMHDxs5fPqXBHMDf2ma0Mga9w95" +
+	"O+BG/4HXH0TXiIz651dAb956Kau1aNRr08cxMRCaUkv2YTNdIbAYOZVRtQqXkFy+kFwrOVEOX9zEEwvbidl/TKPR/6/iviZH" +
+	"yJfkKNVn3JSScSxuSeJNpeg1wf2doOAL5VyVlLeoB9khEZxCVGiNNspgqyArubIPZPlWToas2V22kaNbu8zmC0nnYYmVvHC+" +
+	"c5tXpcRhVPf/Nby5/59/+jcf/pe0rkUI8Z8w2j7HeFrAxdw44aZkpwIzNcEEVg73JR/bf4NawEPyb+STB0O4pOVcTslhsEJj" +
+	"+h3Cxfxy4aZsp3JWlaWM5cFM1tcLL13lqpO5UEA2wQTnVnK/+XmhGT3Fzd9YvPHYxq+/RohDvXExFy7cRJWQFpkRAo/9oVWX" +
+	"/pM9t1BIFMf2Eo4mBQQGBiSNmpgZZjSEZU2EhnWbtGixnSyF+rua5KXwxXpddJRet5ssDgxy2IMCr0K0pm/TUbVrcTm2pKGp" +
+	"rdnc6dgzhJvIm734VblfE0RYE2WaUdDI7iiAOk2m16s7dzq5fP/4kZxawSEhwbVyjh29L6QSnJk1buzkjfbM5AYNOnRq0CA5" +
+	"0y7dmfbGxfgb4WZFm6w3buJZkS3CTbYQFfvT6xH3//HXzi0wkWyEDmSucLPk8MZjG4s3Srn+5nDdhD8ZX4qS/Sblz9DptWZJ" +
+	"0blfUDpsJpuR69Y8P7/DhTFjS660atwkp16duvUm16ubkz3OFBxcbTq0wlcsGvWwod+feuCrDN1RXa3JzZ0+LTeX5703LpDx" +
+	"4zrL85H8BsxP0PsqZF25Qo5eFW6SLyG95DB0IWvk/lCH0Qftb+p99SpFX/JHHIP97HdV2e80CtFtbfa+2rr1zmhjHVOYcNNZ" +
+	"tbhxk43miPqe9eS2MTqh34CVHqjZ8LWcpCd8Rf4kn1IczkICGUpyoT75vPw+CBLeWmPvq9LSOzttlH/P+ws3URW6T4FAXR+c" +
+	"iVOZeoPuy69A++OJ2SfOCjdJJhwrOYy7kVQ44Swuux70XsNKXxKZKDovIOnsC3LmrHDTeQKnlhzGqc4TCFXoT/MngkllVQHX" +
+	"+Sw58+IsJAk3pb5cDv0OsbyFc7j1wp+oMjuPlLnttSQyG4dGjDrs8KRbv37daITqD0OGDh3yw+kHQ4cKLRZNnbJo0ZSpi4qH" +
+	"DCW9hwy5B8uGDmH1KvEcrkj4E1VF6QghmUIkLuNs5YS7m6BK1blDay7/Jhcnfp6QmJjweUJ8fO+1+Z3i4gt62JK6Fn3zbVFR" +
+	"kq1nYXRcYdae9NC4uPDCho7Y2Bjez2hMSzUajcbUdCMUdxYSHX3qxcXr9fakZmMz0tMzxjZLsutV8UnRqTUtQhusNRgtgVpj" +
+	"QHbtwEA3r4az9ZNtGCU1YUxqrZWpHrOpN/ShEbMLrl4dlW4ymUzpws155M08ch1vexQaMjA0BHl4viejI2NZaRNMN0UWLnQJ" +
+	"QsHEyizD+pyQ4OCQnIKr0GRb4ybNmseYwsNHPOxuMBhsfL8A/+SUav6Xl5KvuRxHzZEtevtXCjfVf3IuTa9HCJhs9WOylckA" +
+	"OoLAVSX3r5B7ws2Nzk54vZs25nCUHzQSP8hrrGKsQV+t974aFta0sSnsSrt2ZH679sJNUrlndExMdE/4h1Te07pNm9Z7GJww" +
+	"XMxfk2mZhs3TyHkaOB/286JFPy1adO77khXLS4SbznW4kP4pOYzHOqcijLrgOfiuEIKqoCiZ1iQUUsGhMtnEUmKoJR0C6HmQ" +
+	"OQxgZ3pmQGBMcnXLDz/UsFrrd01Lbdx408hGzTJr1ozPnJL1yQpuf2SkWaurUT1V34is7JAcEJBcs33/pCTf+OiqVf2XK5XT" +
+	"y4xfFcWV7gkdJhgUKqtWNvC1TFB4hJpWxgtWpBsMAQGxyZbqZ85EJibldk1La9Jo80iLOS/LkRyfmZWV9UnDxtwLrdaDCHT3" +
+	"IGJVxut0VasGrFB6eWVnS7hwVYQQpGK3jzarewFofHaiXoflqEKcfuZMDWti9rAPPxyWlZi0enJOzuQcfm+Bc277mv4BX0DY" +
+	"FwEBxNpxX8eO+6TzVBc8hz8ohCA7SkONEALpxRA7NtEIDXO5c7Z8mnl30hHy06KIcovET84ZX7Nlalr16CF1l+2Dq17R0U0z" +
+	"s7Pr5dbPrde9YcMayekZTfpnZpRZlZLdYUZHrdxKkJRmDPVni4c/USq1uuio6D1Lk+uGm9R+llWBPlVDQmrWDgpyODqWLlXD" +
+	"ZJMpK7Vb6Xoi5F43kZNr1EphcwpRo9dx0iMoSWRZaOZo2JdZp05mpikszFSnRo/M4Q0bNmw4PLNHjTNnuOCO+zrGx+2Ji4dl" +
+	"jqSCAnK9oCDJ0Z7rgcqNIe2N6v+4N5BXsuJ9m1O65wHsXKM16iqQGDvOJOp1Crc3hKtMzIro6Gbp2Tn1cnNzc3d0KuzYcdOy" +
+	"tu3OhYXZa0WEV+IuszVUm+katm5TvCI/v23b5skmk9GQhsqNWQ0Z2Gm2IgFI8XcOeb+7wBWFe1PZeJ0ObVq27ydjmL12A2/u" +
+	"fJkNo4Pt2tOc7RFCwHirsxCCEhBSmyvaCCI7S2nLGQtlJ/00NdQwdUpiojXJFJdgtKcbgrLrZtc3mSMisqISbNWtSUm2JY2a" +
+	"hJu4pz7hJkfLPFHhM83X27t6vFo1XqMLM+i1lato5ioVXvEJndOrV6+AD8jnCOZ6Ymtu/0/Pk8QJcCHVEDplakJikjUsPj7M" +
+	"nm4MyqqbVd9kMUdkRsfbalhtSUlLGjcON3GHfUzh70NIV6UcQhh1gU1cFSEYhaFYD1cqZR60MQlcBj+H3YZLxWMSTq9RlD20" +
+	"UaNGjYZmJ1prnCns/EWtmo6a9kSrtVtcbN3xdcOMWekmEyfak9q1h2rt2gX41+zgPPNhXp63t7o4xNc3KqoA9hS0bB0THRuz" +
+	"TaYL2Aaxgq/sC7OqTHqTzdrlDP2f4Ouc+2IjW8NiLlDkZFvIYfUGarF0OfPwD3Iamrx8dUbkiPkeuUl2gEjeSDqmCy7mC4UQ" +
+	"FlHsXlGVSWW3yf5dhZzUWcGyOstCV4d3mW22jH4pyT8AWGKT0+FMeFytUMOZMxEJ8bWyivitKaEhWdkTyGSop/Dy8s2q3bEt" +
+	"OQP1etaOi29DTuMhBfaAQCkvWBfYhH8XQhiHeUw+u02VRNWspczAkrjAN9NSh67tUCuK572raNWB6eHhZ87E9Ejvn1yTDyBz" +
+	"x2VnT01qCP7NQ0J91ZUrJ8S3YqM5krLcMjyY6RN/yWfg3jBLWevJDsezw02m8Ows6jDI2lRQUFCwadPBg/z8+Ph98fHsr/lt" +
+	"2pBTbdrsBPtOmXYXCyEoFCG1vIbKCvThptouCXEJiZ907ty58ycJifHx4VFRca3j4uLiWkdH1ojkS1TeXhJkL2/V5iAfn4SE" +
+	"XQkJVX2C5L3iLjL8lQiBDFBrkhhXi+uHm7Kyw00XDm7aVFDAXWxHMW23amebNqvkfZ7DBXNSrJVGIlmrxGO2eLDXzcoxhZ8/" +
+	"sHljB/ppoedLSnc7ZL0XLFmo7p2QVkvtXkWaszv2zJkycrsDk+SZmXXq4FdkP9elwCO54Qcmyzvu6+ihQdxN+BJpEWL+fJVb" +
+	"/FjMNuoJt8Gin35S+dl6fjrCmlhUJHy5Zz55Ge2rnJ+YMAIqzd/jlh+/C74US7XKqtXQ/7PTmttmMtkkJu5yRlGpikYbkB4R" +
+	"fupUbI+0/skp3AKYkJ7YuHFAoyylunKl+PjWYHPObc+oRoK9FT8VQtgKGG3WRKskEe3SsZDW7zdJIgvukbEZ1qaNAxqHGX01" +
+	"lSt3ziff4bEFjsCAD/DWU8Dz3lU16oD03O+/j020pvYbLOHNFwo+rIKPW8KqrKogtzNVTrJWdg5m7Gex2dL710w5Y4lOYbyX" +
+	"Ygj94YeIzilZRRwnsR6MJwcUSp/s2h3bQhI5QDkvH5Kccwts9jR5zTmb4MvyZ0sGYZczq1f/8Oam4Euawm7nXIRRZ7r3ohr5" +
+	"IxuVDqWGRRlz32KzgypJftH8zlFBBefDdXprml5rCsvKDQrMCu/WgaxqmjE+OloxJCsm1Jzc0tasXr2JE5rxhxNMcUZDXHzb" +
+	"v9vGxZsturz4aniij1PgVKvzsszYLzehKCk2mdfH5Q7q1qB+H3e9aj/YwYmCL4sjpTkitSYbZ5a1hV4HmQVrTpOS01s1qhCt" +
+	"ucDcJoJv8Rx2Ouf++SdsTvBWTYlUIYwS8Rz8RKyCApmdS8t1Uf+KTlmq8BxmW5IxUQ9iqUJMMlvMeH6dzwqnTsnr3LHDhg0d" +
+	"O3YICW8JUcuakZ9C9eYIc0ZmuLlZzQCh0OJI0qjTG5Jz+W3a5K8c1i7IL7rtZxayqWpoQn6bxITExG0tRQ5h1AB2cA0EraT7" +
+	"gXK1UtCabOG03KhVK41tU+pp6XuH3Yzrtc7rQO6eIvcKGneq4l+t6cPQhjwOFrS5LTaTP8L//DMctKva5E6EuPi605J/A5+l" +
+	"3PDf7DMaSn6oYNiBzwlSViWHldPrZJYzx4HCpLaULqEepgy//FdbP40mNL1jz76RlYZd/qudWqsJSh/WwFEzmk++RHKvxvj6" +
+	"Tm3Zy/8SfH4t1sd3iqU6e1vZBSGI5Xr4KSo3RcjZjfmZuiKEs/FkPwUe5mnr4nqGf8dD/BReh0vbEOJsrO2Yp62zS8VV4Ub4" +
+	"KbyOe9r8XCpOZP2+lNoQlvrhKUhTViK7TScLVWgRpe9H9jeqm5uj0/Zv0fDDYVndI89Ai6w6dbIy6tbFgbm+KvBu2WTtwi+S" +
+	"HORzHLw6otOejh3btuvocqEGLhXXAI/yU3id8OAS7FLhcwyXrz24rCR1+Yv8KBTEOJyyCY3IZCYvfbBFXUoqKX2IuuybihtZ" +
+	"63t98fz5F73WZ5HDYL26dVfblhuar118odvAvj2LBgzA0KWAHHnzlhwr6LqF+xN8INhgJA/ttpWbSt5sWrZy7aalyzZRnGT/" +
+	"glL0Wu72I3It+EKkZlGongt+o3yZzzV31v/yyy+/xJ/v27fvjz/+OP2V848/nF+dZmcVb9JayOfHIC8UxjiFXn5SA5WVordR" +
+	"L0oaWKnQtWpN9HdhZnDbzHhv95oGH/jQJ8ROPZ2LFkFW9oZeB0e2082eoS4cebDXBuhMuviE1+zR0x7OjYCW8bn141s6o5z7" +
+	"uBHXYP+mLh1g09hd176cugk6wNy2NVu1rCm/QSB1OaOokd8RG8t6jSxaelkI2Z07H1hbs70pDMhRUfPmsQv9MGL4H7GxQzFX" +
+	"xt5WM+muNZWeK2X292Ov7KgGp/otMjHJreCs1uJJdepMMplM+FUBHtK+ZoC/pN8CAuCHjvs6xsXti3PrN499AG4nXEWzWrYt" +
+	"8eLIGpHRkkkQFxUVHp+QkCCZC4nxCXzJpiCfqswm8PEJ2syMBbBTY8F9dmjMxvGSrOhakEhrD6rL+P3sdHuiIN4UbwozhVm0" +
+	"liSb2TM2bDWEci2gQdxPkZE1olvHxwaldNqAub1VMV8dAA9Oi5Hx4EJ8DJGwQUImv05MV27yZ5AB8WZdjTZgz29dis8cLpid" +
+	"y+j5XTpZaDUe1eHw6AnPSUpvUVgcFqveoVfglNDQ0OCs3PqZWaZwgeuQFRLC7LDWrZYGavWavue1O3t/l35Rs4ev7eulaJDT" +
+	"omN8fDv/gIBbjXJzW6xq02bnqkkNG/Cc1+isXPugzIxcO0LvPWdUNORLjxpJFa646Tmjjim8ceMlSUk2aw1bXExmhNliqp9V" +
+	"NyvImG6nJw9rUmLC1CmhBu6pqnr19M4J8V4K5VxNlcpavSFMpxmvUsdX9/b2neajEPNaOkzhsu2TJ3KS7SNpV6tU2sNmZauC" +
+	"mw6MUql27DhdVJRca5TITVH6mqPnkyC4Mz+6e0KC24YOFHyZB8yqkphPnoPDZLM+Ol1UhGulDi0ypWg1eORIwZcEzR+enj01" +
+	"oFrWfOQ569CYimjPWYe5dfC75xyurBuozEHnpwqnnGnp5U45c8a3a/dfJ5yWu9gctuLfBR8UwOw3WinUnceHuZRlLulyxsu7" +
+	"cqRsuyUlpfVPTuYWwPi0u83TfNW6+ITWkFSyAvIcAQHMdGM0WIz9RQ5xLGsei1lSSeVVTDbrb6dOnRo8+NTo0SK3sRn5s98/" +
+	"//STaGQVrBN83X5ovS4dtArRYrZ1OaPzt1dtMjgjTfAl8xsFhPB4KuCc7PGldqy/4Mt89VS2qphcVeFqpMHo0bB/EGeYOhX6" +
+	"TplClpTvr5b7V5DF0nfsy0Hs20H95k2ZMq/fIOY3gk14H5tXAKsu5p4ZpVgxCuITwqLAZnbYrQ4FI2M9XUuYefr06U6hWV7W" +
+	"tKEZvlW912G8cwTXNLwWgM0/RVeVLoPz+nDId2SDz9qVMAIijXyAUqYxeC5ypWviNtC7nNFpUzqtG5aaJnIlmxoGBEzNzh6M" +
+	"3Gc7eC3KK6+yat1kmQ6mLmdOQ+20od1MyTrdGZFzVp8/JCt7akA7z7rkCL6Sb54zao1dzwDoSIjgS+I3SnD5LkIIii9zZlWX" +
+	"Efyq/5eTK6SnDl3bMUWn/Z/Or+xAGeCfCf3+8wjL8Pnk/2DDq/5/2vAla6AhNeLZOnCbhRBkYSezVChzZg7m3IttTwXPmY3b" +
+	"dFrgvKtoNAEZ4eF8rdRhazvVjDkT210+Pk9OuycfnOMTWn06pvKwDHqaHgNPCuSjkDzmJiGE3bpXOKF73AcaD3FgFa5cRav1" +
+	"T6kRfuZMrDUxo19KzahaHdcNTc3i44P86BE9sj1bzeRqAWzBGyZNza7Xv5Q/Lgi+0l0KzS/P3BkvPj374tOzgi9ZAn2dc2kE" +
+	"qexHZWfjykjL9GyZ87ye8kQtiq0+CmQ5Bsc95/pN2eGBircaMdCU+VuUl5ae+7VCSOkBf358eGpqeDyJDIqPD5J8trCDmyhy" +
+	"KBCZUJSbdyU96pD5Vzo+KLQWKrk0ep2a3tXS25FTpP6YWXU6dKgzawx8PviDOgUFdT44Fa4ymVRPw3xNJl+cMHVqoSqT6vVM" +
+	"VY8pU3oqMykmmcrOr6BdFX38vng9NCbbquji98XrZNm/UQhBqWVsCyqgQ8toN52HW1O5MmouDkodf3hkVu3a9lpBQTqtPbzY" +
+	"bm/YYGmokVfpUuhepdXOyMiqFRKq1aaZZjjss+clp6XyJSsCfar6+AZXq1pVFDQx0YXdrInYoaXsP67KgBrBPr5KVWhgVR8F" +
+	"r4qNKehWyN7iS/gOETmkc+s7bTmvD1V4gwdG+qlSmb6KSkkZLXJTfJVmZ2cqIJIa4iVU6UlwqM8gtJzPQFFG85kreg8Ap6QO" +
+	"oxJHg7tEl/MjSMowW4h2+xMk2+UM8ydEMky17OzNKD4VqLFL31FqFFaVVaUwMUeDtLDcmcGnY7untQw06ZJHjz4D8PD0+esA" +
+	"XKWqasp1Go70+6e9I8mvso8+ewL0A1V6YmN7MM1U1K+yt5G5H/wl2u/CVRd8mWwXy97ImS2l/gbP8dRkM8KKaHWwJWJ8hCVY" +
+	"GwUJMxPjIk6sWtGlS3ikbRY4+EFRMZm1myYkJCQ0rZ0ZV31tf2PD2m3n7crPn9U+rV5Ef7Y3nfEc/JV0llaXVoBz3zZ1PhNr" +
+	"s8f90Kgh+aFhI8GXzMiqWTMLRpIZH2RlZWV9IOlN/AuzI0ptK6Y3VbIW9RCeDhdGRxpbt5lFdekZUR9QJTs22qiPh5H8VWOD" +
+	"1Pz5+flMr8IgMr+TIhiDIyGvdkZc9e5cBd8F0HsstZWznVmz+sybG7gYdpOmeEip/Fgp+JZ/YzYQR5I90MT5PTQlxwTfOWTb" +
+	"/DmQ75FtnQRfpHP31+hLWcdS+nFTL8FLbNBx7bDU2pgs9sCZWtevMg6Ymp0xjAGU7PG3gi/SMK+UUQtWmpZBp9cpTFoLfb9m" +
+	"lCx0hU7PALcXxLodN2BuW/3BhKEHd1tAA7uG82Xwq+n7AZ6yTmgKTeZAPvlYExgDIOG9hfm+6I2Bg7qjFJ44BZaKUvKhS5bt" +
+	"zfPkGI58UpBQLz6msPBg5pSM1NTaKfkFcfEdenPbfpk/52+hql96St7YzAyNdkuIr7K2Izkk2H1XNR9/JFZBXkjF/AaeCxH3" +
+	"JYW67B009iavsSE8rVaEAWODKbWWOYSfMqNxkyaNZ8xc1aQx71svPSen9vWatXNy7GnQa/gI0nHEiKewYcRwhKBkA//U9aXY" +
+	"XcrH77ByxpKNcH/ZunvOk8JHh8kMVLGPVW/UGks2rlsKD5wnxe6HD1eEoTZZjCUbsePeumXEX+xOZhx+B4ZRa1SYnDOxg1Rb" +
+	"uk6G0YR/irRid+TtfhtInwY6k+6t27v3yv31e/fyT2Hk7dtkxu+/M5or25+jjwjV9Jufr+zdu+7elb171/NPf/+dfiDVZCNa" +
+	"mvdIacGX+CUI/EQUztqbEi3fkB/D2jNZe847ubRs9F0qipBiTF23+YuKJn5mVAchpQJ/wflCCu1PGtN3sEoLvsA3Qai0P2nM" +
+	"XxQ1rH0la68jwScfyP3noyXl+n8g95+Plpfp354M4M+zfHy+vIvm40MzZfwHcKfE7kozGu5Fe29HpTkfVrL+Wn4W629h7c1d" +
+	"t/m5iplKC/5COMDa68n4NBZWyvjPQKi0P2nMz5Xx74BQmf4fyP1vVej/gdz/VoX+I6T+aDT6CnmV6T+A9Tej0a/k3nJ/jQz/" +
+	"egX4Ghn+9fLwEeKLvIb5mallqBT19UzIEwM8U9HHz4xyEVJKfyv09Vx/kZfv/a6uotx3ni/qul6QlwyvznyRjFc91NhPRNWl" +
+	"/qQzP5PR0XW+DWvPlecxUO6/C32LoEz/gXL/XWgPgv+PtT+Bb6roHsfhOXPvTdoCbdI0SaFrmjahdG+apntpactSCgVaaMtS" +
+	"oOz7vu8gi+yoKCAICIqACIgsIioFEVFQkE1EERAXNlFQoc2dvJ+Ze5OmUJ7n93zfv5i2OXPmzJkz25mZM+e44Y/k+7N28+Mz" +
+	"YLwsL+SWbyS/SFFA5YWQyozXoldZahuEno5xwfdi/TaSwY0IcRap3bnFrN3bSv3KcVPoo0xWmfFiaANhvgrUQpZzgSznxehD" +
+	"VkK7Ol8iMv3fYILLpwi1tVvJxkXh0+OC+h5h/ecnKmEnPiniV8r9qg+DF7rRH6oyoyEQBmku+i0Y/aEu+odk+p3JSIk+muIY" +
+	"iJALv4CMlOijKY4KBpfpk0CZn2v850io458Eyvxc48uQ4MIPJKMw9Q1pwrsX0VaKluUMZBQez/B3O0aw9mvP4G0Rwh+y/hTN" +
+	"+k0e+UM6r0QIL1N2VZn1eeQYua5SoIeu+p6Wx/UvrF1i3fxyfu9rQh1YffdyTWC8LM/Tsjy/RagOnxTx92R5DmbwDq5xKuEv" +
+	"hzb18BfI+MvRwXr4RXJ/Pc+nsnaJY/A1pAh+kOhz7I006sjg6RRfuciX2oQjlqsL4/cDzsT4DSVFuEzZUWVGox0jIV2l8LS6" +
+	"5j+pnOXoPCtfKiecLMA9JL4c4xi8o3M+oPhyOUq8EG14YnI4HL8SP7xc4edrQh0luGPEE5MkV8VsSa5CHybXeKkeCCn+UAxQ" +
+	"mdEEkObjYmc7KGbLvoEqYcJT+H5svlzljk+KFLMlOQlS/413yknxBxvX54WVDF7sPm58TSiB9Qst/w1McI4biT6fxOh3cs3H" +
+	"tJ/K+NLocY4bfqrbuOnkaudecjsnMzoJrnaW8Jejk/Xo+8n4CyHxickN34/i+5pQJ0me6IsnJnmeOMb6dSLjvz16te6NhbKL" +
+	"rwl1luEq8qMLv9yF3w5td8O3qJz47RBHrjRAvzVa5YY/1IXfGvHknwbwC9CaBvELZHxpPZfG2RusP1jk+nblL0j9Ac1i8uny" +
+	"zPo/FCY8hS+1V2V9fGGgjD+P4Vud45hbKOFzvzH8Ulf7DqzTF1RO/DWkiFvIT3UbZ2740jhC77NxVFaHr9CoTGh0e4dK4Vnu" +
+	"Wj8XyPSXQwQrV6KfSRYw+ia8fInMDYPPc9zk35H5H834T2bwSsdN7i+Z/7uMjhTTaB4p4t8RHqhMKFnun9L8UUmKuL9k/u+4" +
+	"47vo+/CErmt6Lbf9mXIWsHagnuRNeN2hrlLuuvwew32l8hT6QoTq8nnwEn/wwFcBxjr+3GNPefau40/RVK6P4Kvw7OOM2cv/" +
+	"JOtLrVj/sDG4P0Jca3ndTGDwbhI+KeJ/ktsvh7WTjE+KuNbCBlb/Dxjcib9Axl+OljK5OPEXMHwTXs6g3eR6eRI/iu9rQjZ5" +
+	"HL7NxqE/8aP4vibUTYYHSPMdKaLxc1VmfIGX5vMURmcFKeLn870Z/GMGL3PqGTL+bmSvhz9Kxt+NtiJww+8j4//I70FFbvh9" +
+	"ZPwf+WaoqA6f+i1k4zOFrgt6FX8CuqiUeh9+AZ5J8zlu8vPZvFEmpw9ECSw9Enq68pfL+ZX6XPQiQq58TZ350Gj0huO4SomG" +
+	"oI3oN1cM6+GM4mrWXql1bxkUaSoz3oBWsXqVO9tR3hec51ez9pLxSRF7S0DhJgaX8JMRUh5UjvE1IRbrTq8WjsM059sIBe2f" +
+	"FRSOWkEFUjaA7/Mc/Anwvhv+UCc+GoJbQJoLP8GFPwQWuPEzVPI1z+qbVvdWQ163wlj7Sm82kkmR8qC8bp1DqA6fFLG3HRQe" +
+	"44ZvREgYLI8j5k8IpbvkuUieB0MZfnc3fEl/84W0p/ATVCY0ZIKMTeGO+wgJbWX64xn9DIavIwWudVHiv4fzzQn/C6uvLz+Y" +
+	"1Tez7i2KokplwguHiL7SixTn/uUXuX17IFSHT4rY2xU6Tk8zeE/X/uUXeZzerYe/gOGb8PIU5Eafra+yHrXNTc+U5g0mB8dS" +
+	"Jodude2iDGB+eWe5yZnVV4KjV93q29FxU5D0zFncBoRc+9Nn9HnIcb2ntCj8pHjP9B2l1ai2WJNYaGCTQktfWGIFOTKr388b" +
+	"Nx48+s/evOQtZXa403Xta2sAdTjw3h1IySN/jNi8DQEy1qPlfJ3upMUuzyitjRvXrJFIXbuG/17+4nK1REa9XI5FeU/4i9Yb" +
+	"YjnqLjMW6lyoJgZz+jBvrPUL5kMgmNf6eXNuj0mTTGZusblNXvPXemuGZc4aRLZCKBhHHZzRPd4zvKwiXeXbXJMgpDa3dU4y" +
+	"ZVctntVm7sZunY72Se3Vvyeu1FjyusYMWe1nSti2mzldzQJrYumEWW19w3Mi/SObBnrpcKAmJKf1iJZ9VpVY1XEZkN+6c5u0" +
+	"7IpYKt9iqkcowlUKrq37POFrQuHyerdb3o8V0fMDXxPKk+EqVBePP0llxh/K81C4Sz8pVHzC4K3c2lPCHynTV+iLutbtQ/cq" +
+	"ap309UWO02RJA/gdcurwixSiC7+D4xZZ5sL/xIVfUl6Hf0lZ6MIvcdx3o7/Ehd8xrw6/UOnvwu/o+JssccqHzVvzZDm8Uicf" +
+	"xQBfE9oiw5Vu9R3vot+pjzt9g4t+J8dZsrMB/M718E0u/M6Oi2SPC7/Whd+lzB1/pwu/i+MK2dNAfYvr0c904Rc7bpI9rvpO" +
+	"YfPKK6wdj7vVl+J/JtWX++eZfVUGLcG1ny+SzwHY+YrKdQ7AziWk/pPB+k/zunMJuf9UOnf+Tnx53Ut7Bj9NPv9Q4g1oCwIZ" +
+	"/57HSPncg+6SluLJKqXely+E8XK+vxW1znz6IuRDlsj5JrnyKfQdVG7nJQrRhd/B8StZJuP/6fGJC7/Eow7/V2WhC7/EQVz0" +
+	"+3ssceF3fOBGX+nvwnf2OyZX5VCnXH0VaEmdXBUDnHL1VSAp7tFkhHCuJFfOyOQk+QzrjRCOVBxhcC8H8XXtz0kR5qV241gc" +
+	"MhT9nP1we/mcrkg4Jjxg5V5l8EQXP1vkdm6NkOtNurSPkdr5R8ZPYt0+Rm7ntox/d/yRrn1PEdrowv+ZtZe07ylyOMiSBvA7" +
+	"oM1u9EUXfgeHgyxz4X/iwi9Ba134fygLXfglSOFGf4kLvyNaXUdf6e/C74gQWeKUj9xeV1m9VtfJR26v1gy+X7ZDu6kwyfu2" +
+	"E2yd68vgpTT+ubxvY+djUPUUvvo5+K2exXeXfz18N/nXoy+Nsx+ewU/zNUGVPM42snHWhxQpTAp9XX2d+KRIaMr/VFdfmX6d" +
+	"Hn+eZ3H1n9Hjz/NlDO6uZ0v8f8j6T0qdnizzX+Gm929z3FQgWZ5vMv5HM/iLjptCa1meVxg/Y57CVz8Hv9Wz+O781MN346ce" +
+	"fUmeh57Bp/IcI8vzEybPbaRIgWR5HkKoDp8UCa1leZbV0Zf1yzGM/0Ew7Sn9crjKhFpVKCVtS8InRUJbts5nyOtVZR0+39vX" +
+	"hHpIcGFOHX2PdFavIc/Ql+rrpr/K/Ej1HQTjn8JPc9HfgFYjkOONbBcuSPdqTk9R70D3O1CB+zxx0PjG/F4a4xgBUiHEr1L4" +
+	"Sa/znL6DnN6krJZ1kPsb5C5eTD7+jXy8eLHCb8yYMadro/gL9HPa4UDljl/4rYrWKhNXQHnw2o4kv8v14QqvG6OQG3yAC/8A" +
+	"+qBB+HH0YoPwg+itBuGfucOVw13wQ+jVBuAKrxOjUZ1Owfg0S/w7DA3CbxDJ7zWHkHIC7cOcGVh8Z68DzN+kAiHlVBn+J4Mf" +
+	"P0LhGCHF+zI8ksEPLpfpKH6R4T0Y/LPdsg8DoZbxaR7N+KcnmhJ9hZn2Ac6M1jOOTjjGyvsJ5heG8dqOUdpe0jD8RkodfLVi" +
+	"gAt+INkdvw5+PLQOft4NfrCqDn7QDf5ZInLyKkQrWqvMXBQ6KUmVfCDzSuuXKKedliW7HyGXbPsxmURx6CnZ9pLg+MlTsr0k" +
+	"w8fUl62yiQzf5iZbeg63ksmjvdxXv0UNwW+gj9zgA1zwA+jVBuHH0d4G4QefA/8MnaqDK4e74IeeAz+B3nX1AROTXUxDclXE" +
+	"y2nfPitXjzImjxhcU1+uHt1k+FNyVV6U4ZPqy9VDIcO31e+zinusb8bACuTqtS6eiZy28al+u8LxHZ7N5N5BHnt9G4TfcLDY" +
+	"c47Tju/wH4oBMlzhdUDy6eN42fEd3u+CK72OOyS9KtXxHZfshn/whIT/t+M7rrkb/LPNEtxOy1UOl+G0FoMZ/N+n4CccVc66" +
+	"4bNM5nHo9LPtga/KaZefbQ/+PpNjHL5dvz14IsOfag9+ogx/qp/zb8vwN+u3B7eHyTwOdj7bHk+l1bWHpN9RuZfI42NHg/Ab" +
+	"aJMbfIALfgDtbhB+HC1qEH4QVTcI/wwdk3h13FT4sHKt7V3SpfVz3FT0YLK1ojUSR3Wyddz0GMlkYsU73WXruOkxVobvdpet" +
+	"46YyRYLDBHfZOm4qR8jwnW6yXe64yy9iPFXIMlqCnoUrvG5MR27wAS78A2h9g/DjaEWD8IPo2wbhn6GTdXDlcBf8ENrcIPyE" +
+	"zCcdq58x2aXBtKdkR+eDNazOaVyxm4zouN8nw4e6yUKBkHIj60tpuOszfemW8JDJo5ckJ+jYIPwGtHODD3DBD0BBg/DjkNkg" +
+	"/CCMaBD+mTtcOdwFPwQ9GoSfgG6ueauc8Zk1rF7fQ0jxAZNfFjr5lPyonD5ncsrCV+r1vRqPszL8Z0dNvXl2qQSHje59DyHl" +
+	"MRl+sf64VlqZvLOgzbPjWrlUOdxFy70t6utKCq+L4+vDFVxOg+v/xez6eoGCy21wPbuIfq0HV3Ct6HfH12wer5uXLzqmMrxl" +
+	"ju/wYoaX1+D4v4j21YMruD4NjoGL6NV6cAXXt8E+cBG61IMrOGn+LiddJR3Vk62KnK4/egpe2wB8gMrkaX8OXHwOnDwH7ngW" +
+	"TvuiF3oOHOrB6XsfpqN6YVbPd5D9KThXD86Rrkx3NXnxEhz/xuAK0pXpriYvQYbfY3BMujLd1eSlkOERTjpMdzV5KWW4tE9U" +
+	"kq6S7urlIcFhuJM+011NXp4yfE19vctLJ9VrM3oKrm8ATsv1fw686XPgzZ4DD3gWzvgMfA48qB6c1svE+AyW6yU65cB0MZNX" +
+	"iCwfD6fcmB5m8gqV4Hy8kw7Tw0xeBhke55Q/08NMXmESnHvXRUfB4EYZ/qOr3HuMz3C53M9cfBIGj5DhF+Tzk67SXOzFxien" +
+	"g3ZPwTs2AKflFj8H3uk58M7PgXd5Fs74LHkOvLQenNaLzdFeXWX573LJ4QMG7ybX18slt89ZuWWynANd8j/L4OUy3OSSP5sP" +
+	"vSpkOjdc4+gYg3eX5e/vLJfNzyavHjL+i67xtZTBe9ajI82/Bc69Kl5bb+/s54Sj0WhO/XnDq9ez8wM/WcZvYJ7hcurDGf1U" +
+	"1x55B9rshp+qMnltqj/PsPvRoc69sN6P3k644B2dcDQa/QvpDoeDIKRoLe1t0RFpJdlO6cQhJHzA5t/gunlKuODM75ynoBNf" +
+	"jdsqWtOdLPs+jnTF/R1bKEcIIXCmM1/AFq1KaU20GaHzP//MhXmK1v3JdZgi3XO+JARwCkWqSoE3yN/TOYV0jsKthfEqBRry" +
+	"1HzQ99lxz0+V17kG5g+uVQPwVNe6uAMdrA/32u2Oz+TUQtqnwok6OTm+pXFTmJxCnXjKYGnfBZ+64f2IkOJVhmeQzwG7SvY/" +
+	"XIw8HiQ7iSy+mutN83ttJ/fkFdFb1g3G8dXcaCnNwc6qva5HMVnx1fVlJbUFEtiaXuBc0/Fax0DJBovB/ZxwNFo6t3AcoXDl" +
+	"OSfc67y0p0ItHN/hXcpzKgVnknV6mj/VhbdDzv/3U3uvnfIe0YwQfoH1yTipT9KTeYcDpSGExyg7OuFotGORs09yX0t7JfjS" +
+	"TYbfIcRlMhmGMbpt+GpuCJPHDfKvLKumsqy68NXcfCnNgSVZ5TQsqxtuekmBU1/Ba9EqN7ifE45Go0V1cOU5J9zrPJpbD67g" +
+	"8t3siahu2lXeg/zSIPwGOuNWXqqL7g60pg7utkfYiWa7nTdS2abJ470fjHfBOzrhaDQ665Stcqi0P8AGN9meQUgZzmQb7jr7" +
+	"+ZjpzjZULXHo0p1LhHT8O607GiJJHSEY5nCgPUI6Z2V1D5Lw+Pu4M6WJWj21lgx5ds3gezt1wGfXHq6qPpzphqkunXEHdHbD" +
+	"p2P3iju+gzhuKjvK+8MlbnX+wXFToWB1NrrG7npZl9/thvcFQooshifpVAmkq3CP2kZxWfLYZbo2tOHvc/msvhOemfu0Rj+L" +
+	"1qS24jbz5v2jaD1vwRSAkP5I8hUNH+G1+Brz42dQG/phq/glvkZ+YPq8lOar8Hoo4+7mN+K9TtwqPrP2GN5LiJzG2dzS8Hkx" +
+	"2i1NcdYtTTH8yUvONAS7lUlSGj19Vloen5aSHA50mwThnxRBKhOqZP2oEiR7Y0SCuLWO+yoTymXwXIRAgwAd4cpwO+GC9AZA" +
+	"rbFw8ICc+erTCq4MgqA32UjLG8eVYZBwNAY1Z9ScgcSvPq0QLpCbZCP0ZvWc51BgX5hbh3MNEr/+uDvMdcNxowNqjZFTw1sV" +
+	"n37lJAPUJJfyw/synEYujthP7gA5c/qTCpLqZC+AulUkt5xs0lhQXBlH3PJyRo38k4fE0592h5OQeOaT7nUFkutkM/Rieec5" +
+	"FNx3jP9GzhqAez2krDD3mazPlMtqZmQ/uTe7f3p6dfdPTz9dJIQgBCjOkc5VCRdkbg1aOaYCJEEkdVJELhE78MIFMvd+7Vk+" +
+	"7j6TYYycR1OXR+uKxVCXV85PfztpkIz7NCbXfTh+HwF4wkpuJd8b6RGCpGSb5PFe8uouucSPBfCKi40fn5szISYuPiE2dkJu" +
+	"7vjYmHhc025OosWanJg0u227uRZLcrLFMpeNC/CEHO47fjfCVAoAXvhryOFGkjYIAVqJEFyEuc7+vAryyEcwl0yn8luJEJ8B" +
+	"c2X/TrKvc4aBBzjxJFyO4io2y7iy3JwfoSdFrrU+m5F+HA60ASvgJrdIpUCTbyCkROmOm15JwgMWS9DI4kBko8KnPUvKrjOs" +
+	"ScnPhVPfsG7f9W54HqdzCgpyWubltXz706Nvbas++mRGy4KCljkFrVtu+/TTbW9/+in0/pxrc1LFvlVXv81S8wvwoKljxk6d" +
+	"OnbM1G2Xjxz5/vsjH32HU6aNGTt9+tgx07Z9d+TIlStHjnz35KGiiRjqRNjmzIKQJ61bk2GsbjoUhmJQCmqJ8lAR6vZM/VQy" +
+	"20LDYAmbQmMhVKdShJpUtMJY+tuaFO5Kb8w767rtk0/ffvtYtTi6ocrPLmj9K3T89FOy51McSut+QPpWDe/MKiiYne9edTK/" +
+	"fs1cEHdhkPlXrsCUmq+YLKT0BDmDNF+H873hQ4HF+woAC4S/XkPsfG8YTxZL62M435trTHW7Rlo6NzbSyutTON+b70nvfiVI" +
+	"XTp+82m6VCTw4Spir6GEZZoqRtOf5fGHrvVoSnCzBKfpMs0PSVN+Kcpgc6QxQjACv3Q/+Yqc3g+/k6a1tdiIAC1Di3A73owU" +
+	"CIGWhSvH7chj8CB5vPmvH374C0Z/yGi9ixbhIRKehmLFwC44TPLp3MCbydIPyfK/fvjB4UAvIKSYqtikMqM1aBQ6qjIjteML" +
+	"vJT+xkFCsPSbconU/FS8lNVjBkK8QGWDeMd0lsbDxwghyRc34vYLD5ASBSADQqCmzreTTRqDWukMrqH1k7zmg9pgURuxuXlK" +
+	"JOT7ksNeJT2G7yv5/tfWJWmZJnIY8gD4v8m8mKzShIJ8kcPfcmG5kNai+eef9F1XnppVGCN+D/3I61I7IqGIW6toJK1uShan" +
+	"0nGCBCjiHZV0rhC0Cj403GzV8aHhivgfyUgygQz74SK8CstgzaZNXYqLu2zKL8hHUj6FSRGvHELzgSk8lFdak8NDeb0i/iIZ" +
+	"SMaQwRd/gJfhRVj5o8JUkF+Qz3LTfG+QEGyEK0iJkJ4zUp+8xuufTcOKaUQEbtoE8gp3mBhJmKzX71OmKrapzKgJQuJU+ptb" +
+	"K05VKVETOEP3NwjQHjiGf8c/OOfNPdgmfoF/oBemQFY4buI3hQc0TWkEy1tfcG1OBSmasHwbyF/4jMJPWpEsbJmg79ThG1E8" +
+	"i+NEEZeIM68rw66LM3sx/OnkL7zTiW9kiwn14QBTRBwnnhVFvBfPvv74x+t4di/GezZCymhmbx52lLZ+GDfB2S+EyRJc6hVc" +
+	"Yj14XX8JA+YGGAGqcABXxlcyH630UaWWM2ro+96K3d9fe/naw2MVa1f35ys1mu9qp/KtfHxYnh2OdD5KYLGIgHUn6vKA/sMz" +
+	"j97+/OCO7TuEC799vPf7pva9CuWTJ4q1CKMXYS7nw3dBnBTFUq00SwudtP7hourqGZitcWzd4zuLiQB0dXuZLnjP5DerLTa2" +
+	"ZvrJHrFp/tzxcbHxCTExE3IwzAV8hr7HnvMyXSZpeyPEvcEd8FWy9v6c6Q6fkB9wJfOh7unSitQGNRYqPr0G+eQwzCVfk8Uw" +
+	"HhLwD3Qho/ot69PKISpTIypjRSMjQrLvp5+5Foo+SI2MCAGNQKE2UpNTs02vtqhtmmcgXIv7921/TH1ge/DA9mDqH7Z634SR" +
+	"ixePfoncA81LoxcvHrUSNOTeSnvjBoDMjtmRy33D5gNfdm9kRmr4xvGYzhmOqdwFqZ1hr+IK15lF1dbKzl4lzziS71w9jQJj" +
+	"SUxWfLfi69nrDmgaefqdmzHxxAsrz8xZdFLrqz07Y3w11/lRcX6aguMUmUVd2J8KRWahtOc/QfwU8Y4ylZJK5F+mL4E/Osk/" +
+	"EJpI0UFZ3Q1Wg1x9vdKgNeiVXK991TOPkj/At3pm9b5jM4+BhtyvnglPqOfsy8sunby87DIkkDPfLbt88tIyxKFT6BM+TIh0" +
+	"6iAatUFNP7TdTkHGT5BBjkMG7gjtyH4yiv7kQulP5/wvvCetKdwjtgI0tLbwaDGKEqYL2+UyfCj3kst8Z0n8SPCu9QHvm+BN" +
+	"/gJvXALR5Dz94Bb0Z+279CdCCAnoN7RKMAi5LloaiZqTkvODp4P3Aola7RXwJj85qZIs+pObR3/aV7C/R0nUMfoIIWqPVz/O" +
+	"1KdU+eLpD+ECmS4mUNUN8aiCbBHuKOPr6qRx097ovwra4/n29Kd4hf6kH247mQWzyCxBQWbVjCOzpDr1Q0jYIlxw0QpAKOKp" +
+	"+tD2pmoxdc7F+9avGEy5LJ7Glss4aVXDdSM9xGV4zAM8Vlz6ACGO1ROEia42d4vF9xGtqPPDTSfT6UdcTivNP5VPrrP2ebkl" +
+	"uZE7fcgd+sv+PrmDMErGS/GPwgPUhPnpSjK5u6b1q++OF3ukLmLOufOCgi6Wl2/eUlZWVv7m5vJyvuX6WS1brn8jJycnZ8O3" +
+	"I0eNGn32m1GjR4+S/QRBrIKT21DypmNk/lWZU5yliPkJfB3/IFxDmM24nIVzuhIyWGHVpUuqa9OmfU02CoHkCFaINes2b94m" +
+	"ze3d8FL8mXANhUv+vmVnLA26nmLP6CdhNVnjnxCwurRL9+5vts7Ly8/LyEhuHxsTE90hxmrJEK5tmKXwKGq/aFFh+ybegdub" +
+	"a/zMppxck6lx46aUzwK8FF8SHiAfKUZ5ff/sLndgSlkJqeeAC9amBAYGBqawn2eS4mKjK61Wz4gSy8y1Xbuu2rRn4MB9qdZk" +
+	"PjU8om278IiI8HZtI8LhpbVNGze2Jg8OCFw9dUFxp6tffDt+gqenHG8ev44/FK4x/UuKl9wejyAffwMJZKxwbbKYjLcyfSkf" +
+	"L8VnWPtGozR3rpkqbqpr3Wed0SfL3pBl8cGLGaGhoaEZ6dnZlwpNpo5Fr67uUBQR2a5rZFTnThs3de5cOj8wwJKYHBCQaAkI" +
+	"5DWS//nczKzcAv/4+NY0lI9tSPuouKb+SdZiyQV98ZBPEwMCkvs1CwgIQBil4aX4S+EBCkKR1Eez5KfK5h5twcWdRvYjRn3A" +
+	"jLb0jKm0Wr1MLaISZ7Xv8C4V56B9aVbr+RQq8VRbYCB+b52/vzV5cKC3d7vCaQs2Xz11dsIED094KzxiVbt2qyLCmUyn4te5" +
+	"zsJQOSaE5EDealDjFtCcXF5ALkNzYSj5/gXyPUS8ABFSO0zljnKd+QJJh9IapuJLYiRf8AJbM6dyOVxn/jPn/CVFpGAYDOuz" +
+	"eVNfkM5xsAD7FRh5I8RcoVDPIxbq30YHH2zr2iIyskXXbW0iwttg4cXp0/6ZPmPRvJkzHxOp/B5YgN0KzEa/5ArHTH3R0cbs" +
+	"2TY8PDy87bauLVpEtuiGhRdmznw8c+a8RTOm/zNt+ous3NfxXSEYBbj5OYqFZxwP4bvf8Jxnk8hmORERZ87EJVmzR6Wmu7k1" +
+	"0sUnyO6AqXswtl7/iX/kSnyVjTq4fDiOQAh/yrXyVaKp7r4o8acUD41zwTo5/sTHGazOj2UiQvgHmrdRsQvWzfEn/ozBOrlg" +
+	"BY4/8SVWbmcXrJC+0WZ4XVywfMef+AzDK3HB0hx/4i8ZrNQFm4oQ1xl/7ats1LUOBhFcZ9juq2zUzQ1vK9cZR/oqG5W5YFWO" +
+	"wbCfG+CrbFTugvVwDIbdDFZRz0fnLeq30+NtdxjXmMHerQcLYrD9LpgcX9tXibe6YHV5d9XzDXqX+gvFY1ww6gc0gMI83nfH" +
+	"g0SG96Y7Ht+b0dtXnx6FNeruVq5aLvdDFyzT8QAvoDC8pV6b9+MifJUehyUYApSPkGKYcJH55JFHCRt+UowzyZmnNhacXg6T" +
+	"bdwb+AhpzULtnRST2qwrsZMrYLKXrGvTZmnR4o0bFxctbcMC8BHHFdjYogWkQmNoDKktIn+zWU7sevdEog0hDnV3vMWvUqSi" +
+	"SBSPkhESTGalkfpcUatsyRE2C/P2wn6pTWaO+iVmHFHnI8xzEHUFpTGZcVm6LXhlYkzzgwd3QqjfSm1ivIdX4MooP02y9VFF" +
+	"5/KK8mODfX1VKwrbJVeZBsSn29MyvZrox34UHvMN9IcBZ481bles9ObwkM1ejcmX0UH2/jg0rC350pYCWYLOUDDvt+WDN0Xp" +
+	"uMPegAAlO37m6XweLfloYyyFIva+SvZ4irVSFCOtdO6mkQTHVUVHlnWvODaYHCPLYAxkDj5W0X13n/dOVsWkgq91YW/v0GCI" +
+	"KIgYPBo4TxUr3wYbKYOwKcVGvmybRx7/9uu8tFD81VtV3bFAftBo3nhFmoMWIcSfEC4gdZ0m6TJHtkIw97a4D0eKl9avJ1tf" +
+	"hil8d/IDuU1+cJSRHyGsDAEahRA/RHjAdhaSNzmpJtQNqtbVIdROP36QyY8Y87jLm8XZ8/OHJMYJUEx2s88tu+L0e++9xznI" +
+	"xGkBAeSG2ZSVNqZ6ffV58g94na9eX40ArXHkKv6QfNwJRo3a6e6bsez0aKuxKNlaozaobZLy4AMKpdqAf1h7LSioXc/ERKpN" +
+	"ZRQV9hrUqajFtrUdY6J37Scf4dCQ1t0t3uFAvvoYHoDukM1aWvq6+B3fe0vvuPgUaxWM3dXo/k2xK27TLHKCzVoS1HmeeAVx" +
+	"KBQh/ibTN1n8WrAa9QKrqUVtkIL/SJ4L1QaOyhS0FjPXvFu+6DVmzBiYO2cltBs1atQoMn3eKnIIWpcX4Em3blVX36qG/aQd" +
+	"/VTfqpb+gP3w9q1bTC94x/Gz12rhAlKiYFQsR+BMMhmBOfzSu1wbmeIgFqxJEQqlwkDLNoRSN7T0j2Q3F7t+uhAWMkrSuwxh" +
+	"Jqsa85/QMDl8/qTAZkXZudsH9eqd2zf10k7x95Aei4jjZ7IVZwMsGtCsB+RC+14Ly4+PHXi0tEd5q7KUxzvu360aGhxCaj95" +
+	"g/+YVA4/OYxsJhEdAgIgNUwfl5YaH9fEI3jzVmPo/NKZYo1wYUavNZBFqgOCQ8mD+HiLNSq2kadx447331cLij3n4Xt2ju64" +
+	"6VEqXEAeqIDFy2ARy9SgNXCxEMV6bSjb6QdDCDxV+efWFK8eVlk5bBXXlyx/M3FASV+yFavB699Zc1qPTk3qXtl/UGUlq/P3" +
+	"N8uPjxtUV7s7/YaEc9kVAweUkdn2YxjGjuosfilcOHHx5Y9jfGfOnhDn38xVyb07n67VPl8FUiB/hJSXhAsoBIUhE4pEMSzi" +
+	"hNlotZgNeoONfZQWrZF+OAPHPlYhADi9xQYBAEqKZ7FtbDqyOAHaJ8DwBOgQDyPioV1CxxFNF7z1ARl1YNJ+Mmr/NsGnsvKf" +
+	"pmLT2vuVldCiWVpT8XJTsSn324RVt9h/qyassm/nutLPqlUkFz4GjwkTyGPnXxMmMF2oteOmIp6dqbBIa6GoKVA3klHAGYQI" +
+	"+s3C3BuqDW2wBpqA8m6/dXaOVAu/i3fJQ/Lkbr/XCWQJF8gpcoy8DeWH3qhtAQBWyIRy8vahN/hLDgeykcW8yPwLfXuL3tsu" +
+	"RxsQj8Bx1XGTXyg8QAlyTF5kNtosrGVtRpsl2c1ZtNKgNLB4m3qD0sAeptr4BaWH+90lR/KOdSwDAHi747gCyLnb73Bp263l" +
+	"Yzc1h1sdSD6mKXOKycOY9WPK3yzsbEsB4e9j3iGBQaE+x/4BTGfQDh2nTjimCg9vEqI+NmVKx47S3EnXHSzNnWzPbKArIBgk" +
+	"189rIBLehXLiC13JWziaLCZWcqmK643Hi9ewQVz8mpiC24v78EnJB7UWIf6ucIGdR5ht8jxs0yu1UqhV+kAYzInXSC5/kbTa" +
+	"lnR1e9KPZOuOxB9wzORx8BoYyQ83uk0d81rZ1LHiIygvnzx2rbQffofYPGqEB8gfNUeVaByaRemz2JPJbt7ppcGvqbcpMoYJ" +
+	"yTYNC+arNNdlkMLLKb3Blc0UB/WCvwCnUDpd/8kBAcwmcAuzpnjp6Mowa0LrvN6tUlqb06OTC9r0Tok1hg0wawNMhbGJ+qa2" +
+	"zJrxnsrXvb2D/LX+n2hTLclD9x9asaikfbum+ozQbrasvNK8lMjo2AT/lEaN2y6e3rpdq/TxreDLjVpNeNvw2KiuWS1jw/z9" +
+	"EmKHiS8EhjZr3FjjExBKbFH4mzereqbaAoypZa9P6TMhMSlAlw3z2/dPNyXmt59cHBm1KcjMRSk8fIMCsnLatMsNbdkyc2jf" +
+	"v2ePXjJnRv7YuCT/IE8vXTNVhJ9PaU5u78rCXG6I/fOReQE2X31mzuDC6QN6dT/Y8uXaL4O9vX2aejdGGDV13OR3Cg9QJLLS" +
+	"Me5LZWhJtLlv3aSNkVUSO5OjMawusoDW78JHq18a2mqozuy/v03xhrVlhb03zJl3ZXhufNbgFSML08yxODjRr1lohM4Xbs8D" +
+	"77xhAXse7Szr3bvLnps73vzkp2HDb7aMHdI1d9ygYjAOm9smKjU1vtWrCPEoGyGBEy4gBWqMWtARBgYWIixCa0hOMgvSIpYo" +
+	"+S7X+SmdTpRZ944wMHf4XDT5YE9PC08Ow2iMxY59+2LNvZklgWP6A/QfE1gy89635NO+sBnaCbyl5x6uMtcmHCnWtNAUFw+E" +
+	"shmlLwz0a+43cH7JTLGGqxx4QCEkI4y6O7rzmxSTUDjbq9NDZ9oHtUarrN/ZrBbqTJQF5jFTJ6I22gHVKr1Oz50eDXkjJnSv" +
+	"XP/dorffWXh5fUXpoJ69OvTrZzAVppLXyjMGD8jsjku+EQsLjAGKF5cH76SK3OCvt4atWMTrA22PswNNUPsxKJokcz8ai5eR" +
+	"J6R2WWmYdH/hiRBfw8ZoAApmsUQ1BnosQuWjMdAQ/Rqj1WIzKA1xYDHHPjgEpQf/3BezoN1I8vlI0mskOTmy3fxYSImFFrGr" +
+	"urbAR47hUnHHsVeOkXXQn36OvfLKK8delOLfOm4K14UHKBkhiDdoDU71Wa3gjaFmE/VhTSseb7AaXFFpqNLIa/20UkPx3vCt" +
+	"fZIwaczIP2fNImQW+WveC3DgctPwhFLSn3vNc+K0XudGTr898iFwB37xCcnDS6q1TTuUFLUnh63Jkyf9U50SaTVX6wKL26fY" +
+	"ICMi4sG1Ro2qQ41yfNt55Ft+ocIPhdL9PlUx6Dm7yRyuVkW42kftah9BLfvr5BeU9rQkrTr8OnEArPh8VVJiz9K2nTNbtszo" +
+	"XEhKk1us39i8sy3QR0lug6/PmI9gBoz8ckyTw58ofQLpNGwOVtrXKYOad1zJdfIJm2J/uMCAsGvu5JwncM5zNB1/sbYFnTDJ" +
+	"VpIrXCA/9CM/2C9wUXTvjRD/jnABRTD/kEoDXTWo1NjGQSNrFmwGM9hYwKmeHTl7EbkZ7AW5RU9U5pZzWqempn5Elm9J7F+6" +
+	"4MDwOP9GpKwjdGusjRsu+B47ltLuWFFaZYxvy5azSBnVEsCrlaHFsYigltLaQfvSReEC84LJ5nvnXE+jFfthpYKPktxCpCde" +
+	"g+7ShF/0O/TsUTmMnNv2J535/548Dh6S6rKpY9aUTx1LOt6+9g75t/+4PtjApn+MUhHiDzA9MchNSzSE8ireYJSjjzK1XG3B" +
+	"vQxU5SPzYBMgenjvQAUwh8wgW7/44osv+I/Jex33dQD9BRJ9cdmtmzdvLQO95NtxiKBwnFX4sXtJjUVp0NqHfrNkKB4nKMB7" +
+	"yRwJZ72gQJyEo6Gn7BbOvhaPH7ZUUMxZwtL5KYyGB0KcQW802yxKQ7y4ZOiSDRskUktOn15SHw8oGb3SaLOvp5TWr186jFKj" +
+	"eJy9Skh3OBQJLlsAi9YYIX/s/b79FqrOn4d+336rSPiH/ocQQnztASEd7Xfl8UGIc8tFP8Hnz9defCbzP/+48UVPQGX2JebE" +
+	"HlIdYL+TRcoh/dAyUbjja+UZBY+8UASKRDluN/CZEKE2SIFtmB8P9xGu0xjBopF0HnlO8AY5sJsOPs/LjI9J7QwGcs1zXq9Z" +
+	"Gz77alJhebuZ5b02Fl88ZrRzvexiSfeE7v37d0/q1aHN5KxLDx9eyprEnYqNjY6pLGw0mTTJ92m/dfb6VyA5wZAM0Ez3ac1h" +
+	"hVW0kbstDRN+nGDMJb8H+f8Nu/7xd9bBI0HBoxDUAuWjtghFqAxhZqteF+rUwVWGRL3WbAqT9e//9zp6xJMl+9f6LwV9eJsW" +
+	"by2B3m+v1r/2jzEnunr+k1f/azX/IT+lPm7SPq9ZMy+F/5wFoLf826h1hta/kTJkOi78L9WV5rjujpv8t0x3MiAE7BRDqWYS" +
+	"17rt3EEtLz78t71PjX97+vS3B17s2/vrUWQ3mQQLoXjU12KMr+/mzb7C7JUtW2XXDs3OzltpS4FN8ga9NomrVfu+R86TC+/5" +
+	"snOWjY6bHq0EojIjEzeSvSEfw/gZj5BA2D7IWOcBWa+2GrRsrlXT1UHJ5j55ocStAvWFhfpAqGjRvHmLKHPzFriPPf/IhLVr" +
+	"J/DjfJuVrV/fLcCX65/VrbGaXA3RitU6A/le3VggP4BP4KR1oAXt6xORq69uV/Bs7TMzXeE5PZC2HNeAyYgqGd8v6RHPel7P" +
+	"jm2mZNKelzm5kZ2rtDfLKyzMyykszDn966+nv/79d+ENt94W7P8P7W01GoXVPm/0uLFjxowdN/q3f0nJP//Azn8R4lgfbKTg" +
+	"UVOUgKzS+a+fQitHS2DRoOV+9p945m9BKHh4NY5omt0eQsh11dziFS8Rz+exDK2xdlDe12H9StVa3Zjh8L59ZTtd208Ej4Y5" +
+	"l84aHTf5UcIDlUK2IxlPLvL3+JsqJVeNEJH8WGxEoEwRiMqE2Ht6FIl/RYAA5ThuKt8W/qVnsaBUG3zrDv7rrkuZqi51Ckn5" +
+	"1ivqlHSzSW1QuavpOncdvSmEOfPWi2ibbEnk1mbB7pgv57yUHxIZGhsc6afH3gpFhCHYGKxV6fyDW4Qltsg2N2vOa7WquVs7" +
+	"tAnR+HsHkO7n9hwdlBsdGB5nbq5VBjVtnpYVpNf5hlR2btcis2lAhCkhItgyqWjFy++YhZ/yxPeg/8mZoRER8YZovxBPg0IZ" +
+	"ENE+b9yts1/OXdwiJCsiyqwwRfjMaR2U0rLDFogjs75+t2VkUmrTcHMbVaA+LSgttXQz+OAFOk2L1IyQzExjujEi0NJ32fj2" +
+	"cdHSetvIAcJ54QnqhZAnOKXl2tYwbVuqsE2Sil6gQpCEKXuoprGnlGE8k0421NvguOSv0/rhL8gBS7IlLLS52abKb27OaWnu" +
+	"X25dPjk1Y6hlb5uc7PjW25XkrqXFgt1tDeE5M3/ZNjXDGO6V0x+T38MtLYaWjjP4RUQYo9LDfH0B6/1blA6P69KyajCkkB1W" +
+	"Y4i/X2x0UWTzxIRxeR1LHp1amlVRMcw6Nc8UMdP2yGdq24LUsj6zxs9vFKL28w/Yf5F8LR73N5StxRaDwRrSu7Jtm2kLh/Q3" +
+	"dgxp1vLrgv4IceggQsJO+b5UI0UKpTejEW43kfyGzbU/byZ/wRSMYAqZTxCZL/SsDeBv0c8kcS6eXhOFfxJDEQKUghC/X+HH" +
+	"oglyBk2Wc1rnDGDgnCY+/NZJ5MiARo1apvVbHtiHHAEfyBtcvq/vklc98HZ6xpbaUd+y4N014ncKP3Fbn16bXwrtgAD5IsTP" +
+	"UvixCOTJltBGYKAbWYm6Rmm2aQzKCL0A9t0HYVinieSj7k0Fr0U9Vwb0Jh9/ssHwnQ1ywyA2lLzGffQJiRO7Yo+XbOtoIXxe" +
+	"8uxCSDs+aPY01ldyHTe5S8IfyMbGmp/SNYpcIXnkxve1qsOeM2gu5ah9I7I6bj8+p2O2qWlOVKwyVKUMS89JSUk91D3CTxcW" +
+	"lR5piJnR6cWl+1OEa0Wk/NyGlwdv6bitOCsr1aSPUpQkKsJSkgEf4OLKTT294nLCMkOjQmN6rppcFEljcVY6bvKfCH8hFUpk" +
+	"0pC2leze0him5GxOiNyzs6Eem8YwSB8yceSY8cNGjd43vd/sT2ePGNt8R5c+UUNGN+vdMSUhO/uPNm3iPtj6Q9TkqXGlwsG0" +
+	"hIS0q2l902pn3AgraD+pNHdE31mVZUuOL5489OW+H3X/+8eOxQhQnuOmsF14gNohBGYTGxsNzlHSkYMyUeBdI8jmwooFt6FJ" +
+	"/8EADz7A0HZizdq2BaFB8dkBgTpNoDGxsGloEx+dX6R56UDwA8+qJXnBOq23d+rieYtTRs16se+m5iVmQ1CyR3GjPG24Wd0E" +
+	"V58ZOXl118WQndk9KTxU71+c0ywkpHnF0GXvT5qQEDdlYFVeSGp6SZmKe/nGzMnhkXnxibHN5zQ71KtJrl9kdI8chB2/OG7i" +
+	"n4UHKIDddugS2UUfu1RgwfBsbFLW+ikx+GqSrUlGBaeAYQFTEl5Pzs4bNjMp/Xzvtp6BA3tnbNygziE737IG6AaUBECTHaUD" +
+	"rKmz+730YoeS117DCTM7vdu6H5lL/oUyaLaiUX4p0a2X9BGV46biiMIPGVCcy3MI3evUjxrs8iOCEm3JKlf0i3WQ+xbk4lni" +
+	"rJwtgz+cNHHipA8Hb8khH79FfYxwa87YkpNtAwekpKRI/kbIr316bd0i1mzZ2qvPZtn1SG1+SQm0LCkpLXljfVeEJV+5bO5o" +
+	"QmdVC0QY2PwBaiPMxWVbxW1kNnyKFw4jmeLUYdKUManmA6GwJkpoVXOE6RVZCHkUy3sUqgUjxGKLJSfRc7SkZIOW+aADtZ/C" +
+	"YE1MtiLZ1JTu8ZRVA1NSBqY88Ya58PtW8O4QRKYT/7fIXx2CFABN1qwhD9fgbPEot3rkmdGjz+BddoS3n1z2Q1Z67WweiV3Z" +
+	"n/jSMbLz+HEoEXudpPwQhBRW4TTyQr7ITHdQYGBTljOAOt1C+TJXeJJ6kYXZ8VZ4aDDQIHbeEBoL2Jrkm4xLYNX7R4++v/Gv" +
+	"v+a3Lipqnfbd4fUdOnTo8NHwER++3rFjh46kGNT3jvfpc/we+YP95je/tmTpa+QLfLkmd1JLSO226+spk7/u1rVr6a6vJ0/5" +
+	"ulvZgxFXSM3a10jtlREjroDw2lpQXEEIoyqSzh/h/kHxKAMhNtDd4gArw8zJNrdDPcqmPFepWK/l3E79sPcg35itn4+fmJWz" +
+	"ZMTrFfkqtbGib/uUkGaWNeTG8KxOc8cmpX7R4uyEvvrw8AS9P7dnzjul+dmvH2lfvOPKpbchMyojfEaPPSNzbGQj+XfN+hHL" +
+	"MnPnQyP7hpn3t9g/2zaob2RG8+hOuVTOtO8EsL7jjXQsOgZbdUJ5rR9Pbyuo/YDaFAWCWl5gQbuZ/IUrnbvTW2Q+TLlli4+3" +
+	"3YJPsqP79o2W+5d4+44o1uAZCvytwigO1EdWvL+3IpL1te7kAvX5hXyQHoUgM0IRYOBkPT4Uqf1whC7UpjaFKjmq0qv0OqCF" +
+	"q8wmvhB8yP6rAWQfWQ6joBW0hmFHRZ+REDpyJPlp5Kim+tWr9fhf9iuC7AYVvAbDYCi8TgaRlXbysibJwr9rSardwDfVNdsp" +
+	"vrGzGc/V/qpr+i7us7OZNLZTHTcVcxXhyAt1p7JIdGoVGs6M3Ywo9G5aG1t8nl1+DDZtvbCjbrogReGGzp7Qq6MxP/+Lr7tE" +
+	"HN17YER+XLY5MyDIo7FOE2uN6RgVNa5zp8gkP6M+NiGpacvJWyDnxIfLF+W0HdhndJfcfFuwf5gxSxHRPq1X6/zmMcKbYtqo" +
+	"iq9fKzL6qWsVcFtF3j28Kzc21ZbVLMho0fm1KHr51cP4b3//FpY8gyHVUKiLbdaycvb4NxLjjWHZrZccrqq0/DxnYVwzY5aH" +
+	"YVFKRUQ0jfnpuCmcFP5E7VA5QoLJeY3nqqTeFfY/qS64j0XvvkC79X9ZGHqbHnRatVNfM4Yp+T4t1c3ydCXdO3XaNbNHjwGW" +
+	"mKzMmMTE6A4FMYmJcWnpC2k0pIU7uwR479xe1cIwOi1jVFK0wT8odE6s6KeeNDYjLU3jb+kU9NHa+X1WRcUeOTNh/PgJu4OH" +
+	"dC0q6rYEr1zdraioa/deXYpGjZ/w+vrx44caQ8u6eSfs6TZ1Wq+E8EFT547p1Wok3zy3mFRnpXcvHzIv0DNSOrPfh5BwgO3z" +
+	"6JwYI8fl01hoEFdbMLiOpwxqgxyDT22go8Wol/trhNqi5tpVFE5bPjWpc9aEuAEpB0nf3Slzh/mS+fiFX28MjhaPQrhOq9Wt" +
+	"Swpo0zpYhM14eObb5A9Qj22ZUNB03gwyCBZNGyS+JFy4ePmbvpF4pbgQ43E4UDyqD24PGUWGeDrYEEZ5CLG7IQ8UKp8xUYUP" +
+	"FG7sWfQ2gycYzEoDwJzdPfv37wnWkW9+S6qx+o49ghvQHzKJHdSkevQYUg1qdm3Yr98w8YVMekEo3p7Z+5VXyBwy8u23YSUb" +
+	"x684mvEOZVMkIBqLPIKVqzEZI3QqpXx2ZwazzUjPrm0RNoGdpYPNDC/u2NjFAp1fPVxR9g3554OW5VZyZkr6MNi+oNKDHIyG" +
+	"40QcKJCu3boJcaQ5fNJ0+Tjx7wGFx4gVVi0v8LJvT92p8NP5rZhwUoxOfD08RMRXG7Oxe5tbKnwgXEFmlOQ8J6h322gyW52O" +
+	"UutuJW00wjC1PDWa1Ua8s11JeExkoDl7QNfJ01PH5HSpjDG3CIpN7VE6cVTigJ0/jBl39b2qqX13C4fnG/z0oU39PBprO+WV" +
+	"99XpX4wKaBoapPdqoilpWdzHG8i/4CmehKLr18n7iGP+RURmR6xmOjk1ejSA62pe+ps2lWL+LVglLhJT8WAyFUaQVbBKfNHe" +
+	"httE9hCR7OFmi3HvcTPfe3vX6ff43lfsU7j5tO7gyb0k7BEuoMbIDyHQ22iVOPbQySiHbOS9hw79as3UvGnr+q9ft1a4cPOm" +
+	"+DF84ECASCHOXU6K4P3l0h7Nz+EvtFT6IRVCQNtNAwYay8jsCQYNXke+z4Lj5ONx8AqA+OuTKd+8AVpyGOBz4cac3Z/bp5F3" +
+	"+Hee3ILe4sF8zpP7QNzP2qYzQkJgfR3FGfWI2yaG38I/1F7h3xCj8O1aG74gtpRXkXm15knz+O8kvuJIJPezwoaMCOl1oc4I" +
+	"18D+Z9SkuMT0biQsDtSVK+GVoA4vLSJ3YTaZCbNJDudL1sIA8vKF1cVLdj3kth/XLurVJubS+NnfQ/xJiP/uB3LrB/LThTGr" +
+	"ydFRS9t7sMev9H0iQvR9oq+Cy5K+Mx+93/oqYCxCCBz/kA/588J51IHdGSa6TW2S/uyaArWhEn+GOjW8Lt1tQZGD5SnN8NA/" +
+	"tFN+y+D83LbT11a19QpfuG7s8KtV+cPnTAfPibMPXCF/bGjf0dfHLzg5qlXjgd1KUopjDb5+Acndi0vSQoIDAxJiJ49d68f5" +
+	"8y1U6oDcFyta5vVq2ci2eELTyqwRb/efrmoyZuK35Mz2s20L3pijCTAmtjWbWhiO5+Vm+Sd2svavmjOmc1lstNpbp0YIECAE" +
+	"D/kFzE6OWrlanVeXasPu6P5Btk8y03o1bUKO4H9mteQ/aqoJ6R5VZl9UF1+HxtVwxtNw+h1XoAUIIQ4VOogiQhGLMlAH1JP2" +
+	"4Lq1088lP/mf0WBz7lfUDSy8EZzgWraVChrZUalR+7mLlivG115ek5fes6wrv6J/+fCgiIiYWH1uWnaUfcHQQe0Lw6P8dUvL" +
+	"e/JewSFZMU3DJ70BzSbizBdy0tv1mN++V4wxLMpvhf/k+PFtbflJVVWKWJvRkJs2+ZWKsvAmvXpOfm1oUGCAweCbGBB4NKiZ" +
+	"NWvpwlO4WNembZlvcEJEbIDBv3nbCZPXx9ecxS1t8xe16dlEwSs9TmS00fEm8YCfp67QOm51rjU4hPX58Y7vhPnCA+YPWh8e" +
+	"oZO3l0rWQcxutZf7Gq2gTTp/Uul1hsRsoCcooWaTOsmGSxNADU1CW/lGxup8u74d7mVWJUcGq0KN4S3D+vdc0Dwlv22IVVto" +
+	"SQoJPPk5eJQbfvEgB3u2J2urf+4lpNg+iX+9pVqRaIjllc10bTsqfQwtjbPm2+ZnGWzRicG+LQuKuk6+YFEmxlaWTCXvHjns" +
+	"OzHjhm7fFSivPsnmgFGOX4UDQgxqxu6qdGwMs71baDhV1cMtibyv1hCmtfpZDIlWtXOfCoMyShZA5KLKgqrSWZvIJvLJ+x9A" +
+	"q53bodUHtcfUas7qm9114fIVi7pm/lxkKSnJ6D2pdcX7kPPebsjftYsc3q1pq9HAqGJL505JHRkfhY6bfA6fx+x2ECSbTWEK" +
+	"jhcSFFp2HmlR8MYw7Itor1NiDjXx9tvePxZM5DMFHBr4ivmVAlL79eNLvaDV/Z0j1oak4JrC1NL3ya+eI8Jafztl3AfbwYh1" +
+	"0Dyvz3vkI2neesFxk/9G+AO1RQhssrmwIVGvq99trW5KlF4nR0t12ZEnSpqjNHNQdGzEE1p2770AvAcWdDBlx0S1SDDqvFWB" +
+	"xi79CnuVRPbptXDPzMnZPUZ2f3lunzQfHKFY19S8hVx/e9yElBlTx3Ex2JBVsXBd73lanTm1U0nzqDD/0JDgIGNkcFib7q9M" +
+	"KwkP0GYHBaX23L5uVFnvlJd8YVVK+KagwOTpy76aPBIBauW4qUhUxKEuCIGgsyVnQ90hp1FtUBr+w3ENDc8r2Axqt+rWU5wT" +
+	"9TbuBvROaBKrahMdnNc8JKxpeO5jb2jfjpComA6l8+eO2dLJEh2cFh3uG6L1DrT1HZYQHES25EBAX/OS9hWNsFKIapaeoDNv" +
+	"egn0+am7hSudG2FP36pmuX1T/IN03Yh26IHVr7TvUpDdKbZderjBoGmX7RM0IG7Q4LlHGk3ApsD23brgNkqdtoklyt8/rPiV" +
+	"jSs820htyeJFSf5FI12GenyxvRwXiRdxC6gAI+jAiCcjQPmO7z26KDAajlCEEnPsFtavnlCS3XcRrtNQN3HpfW3JTx8xG8Ok" +
+	"e45nthRmkxlrFBzm+PHv65aYoy1R0THFA8YUxbUIMse2CGjk7RuWEJYcFBQUUlaeFNHKN25ox52Lx/bsVNSmTWSXiWNf3XOh" +
+	"W1TTppERgXq9Qhmt90vxo+WojUFxqVqjMaPX8Cqbuak/p/J+e8iIyMT2kACfz+nXP5kEf7L6peK+sdFpMSHhQohXbFJIyojJ" +
+	"q/HaMEPXsIIxyg+GdBhVsXbZhe/XQMzVn1Zvb940LFbbQufvn6K1kfWk98M+Odn+6vjk0LBW2eXJESNGvIVDgD9NLpKLZCVC" +
+	"GFkdv3LhXA/2Dpi+r2D2mTpLoi971YvZxZUvLs7N7Doup2W30W9u+fbSpi3fXsIBY6aRP86NmwQ+WPP7gS/JP78f+BK8ZJtv" +
+	"x01hqnSv7wlUuElmUCpCQJKzwZpsTYoDdmDHmspPDzbpDwklKQ64lbCi/PsYcXylLX1dha1DFjm1u0VZ/IzF35V/HwPnv0kv" +
+	"/z5mS/n3MWoOk1ZVCR1mdUnE3w7Jzt3Zb8iw6KyU7J5BcVXJA0uXrvtTShRrfs2tSiia3SVxv/TLFyFARsdN/qHwB4vfq6an" +
+	"WvRZptEQRjnLAouBbb0MYSbWZ4KxxZCo44f2MTVbpfINtnfPCl7RRBuKTyWp04jy1UzMB+t0psCmeGlxZ/4De45ZHbJVrTD7" +
+	"GLf6KLjQtp7c6J9UxsxQrkySUYrjENdCeIBaIIRovw0GX3a4FfcUCwZ2cyiVruda+HlFY7B5ansZPBqXq0zk9cyg1T5qA9jI" +
+	"T72zfc268EB/iKwMFPouNPs1aTpLTHQgQ6eMyipvsyb4VR8l99bItTn+GSG8j7T+7eSXCw/YmzQ/nVR+LFjrl6lT8h24ZE+/" +
+	"7CIfndi9d7Y60i8i0B/fn9TYM4J/IWiaVIR5ZRvuwsipwyntKT0CpP1+NkI0HhaLzYs08vsIulcAizNM9jv927bu16912/7Q" +
+	"c6G4Z+FC+Fwo6TRyZKeOI0fUjp88mbNORhh1cpxUHBBOSi+dQCudZNB7SV4PanBewhnDFMJRspIcJB+SpTAS2t2ENl0gtmb1" +
+	"7fOXf7l9/vxt/GcwWU4GwBoYCEPhlUunIIasJDd3V3+8b091NeLRDIQ8P+J7o0ZIg3QolPZeGmbUE+g2BgxKI9XVgTplcNr8" +
+	"eiwEy/2vz/wBSeTyH9VHH9QOI2tTfoE2oIa2v5B1Dyfb9U5LcGKHL4gNEskZ+AIOkGtgEI1vcrPss6At9Ie91Bg8AnEo23FT" +
+	"mMv3Rl7IW7rnk1Ysk1VtMKotkvkrm5i0brdeVuk4lM74xqRki4F71NgnMq59sHhr0ybOY7aPd7jKx1sdHR+fW55qTWjayHsz" +
+	"WZDQITxk2AtfcH9zv4knOrXvFm0JX4Bv2Fdwo0TP1oGBgtB4kr5RI5OxJKqwzwyssftzv2UmTj3xOsKOA6Sr0Iifzt44I9D5" +
+	"qi0qDgtZYAnV+GosmMNqg4rDxjAlnHj1hUXZI+01wad3w6lpVStm7c/MJuuzF38ypIh7vMv+M9kIX0EwPHpx+VdiOqwFb7KC" +
+	"nCQfiTdwFXLg9LOyjwmE+Hlsf+MpnaFJr1/YaRn3vfiGtJ+peVO4MKnmTWo7TP0cyfc9Pm73PfKSws/cXPtoM3eRzCeqerc8" +
+	"NVFCT0qFlqkjE4XfhKNsT4k8sdETa+kGX2uweoIV3sHvif1v43ViJ0FtX05/c6/XxOEPxb7kPpfP6ey37YcV306ivyZWcV04" +
+	"7UyEAMU6bgpJ7P0Q0iSHW6RO7Awx4mtNwsw2wKI2xM0G/StQDAvJKLJ1/j/DR/846uufLn+e3DPivPiz0K+ggFSSdeRV0jfF" +
+	"BtbWBQB/3APsoyI/Sj5SVAhxX0m+RqR1lPuSvCW+wR3m94IRAeqIEN/I6YuEScTLno/7CBdqosDI70WUBkJI6MHOPFAkeEoG" +
+	"n0IP8gI5Q86QeRhBd+wn3mWmnosR4lEEQgK1NfJDgSiURcdmptg6991FJABnSbRpTEYDZ9EI9MmCRjr3jILhkLhsSVmRzksV" +
+	"nFcwavYbb5Cz5CVccXXnjhdOjtlOul5ddRWv2d3U/6BVVa4WLiTEjxswN61ll17vvDCv/7iEeNqKyfwUK2tHexNf7SawVHQ4" +
+	"OFrLbAAdN4WhzHbFiMwoEaGIxLptJKcDjQEMNulNrvOGQ68DI2eJ0Lhum2Hv62ea+0RkvK7XvukH94fAJPIHWRhUunvIh90z" +
+	"fGJeefnwoDZtYeXGXzaKzVZbowc0V0U06/7g54p2I9tOwvYemOuDy57c3VzVY+vw19p3BW7L1rhYmFFztRFXFpw4ieElIsSh" +
+	"DMdNfhU/FXkhP3YmVnfdreEMMs+cNAPQFGOoxDMyMDXGBwzwdlCzF02NC1Q6MRdncTndD/a4XTRg1ORBy3RTJk4cQ+5Pq/CJ" +
+	"gz4g3B/bqYmXWMjt1uinX8lOebFM58tlkIVBATO7z7SPDWjqlzwl3r/pupHv5XWk0ZPeGP12XlEq208GIIRfVPjV+SHhDBrO" +
+	"ojEbOAMUQF4S+Sh87ea1NTDlGJmv8CNXyWM6rUyq3Yg48pgU4KUsb2PkL+VlT1c0Rk6hMXD0MbmN7hSgDeRZyEe3uqSUhuzY" +
+	"vN8wC6Z8ar536KI2pUeXMEr0yQVVauke7nztg0+E1rX7fro/tscxaf8h0DeQJhRP529vUBqSfW1g0NMLJCRZ0MsHVq7IPm43" +
+	"uBPIirhEnvzWpF85eQLTyyrPjr8LEbNXmcJ//rjz3t6lr7bJL4pemJaxdmjGoBh4BNUFu0bsh8ChkDu8II/c+X7V9SHWIWlv" +
+	"Hg8KAl18bHpyWDeIiZvYeuzmyEhp3acX0n353tJMJruuUXN9RB4OkMmwgO9duxGMU8EIb0h7+wrHTaWW+RSYeoe+mDZLPrIa" +
+	"deG7i/3kdJPwwJUi4Skbldale/V+Jt2MptJ0lbJRB+UcF52QZ+mg8TKdvs50CeLCU6BxLj5DG+Cjk1v++unjkHt6p6fSzVKK" +
+	"SoHGM/qJz0tvVOwqP7GB8jvL9Ls9lW6WUlSKRp1c+SMbyF/ixl/99PHIPb3b0+mNOtVLL3wmvUu99ArHTeFHt3QFLV8622Ex" +
+	"X5yxXuha6MdicbKYrigaq5xwRYQLbpbgNJ03ONOVP7nlS8Vd686KhAuuWHOeCClbMrxUKb+Q4YR7dnLBzRKcpivbO9Mb9Xkm" +
+	"3Sylu//27Cnl8+zp4uueG1/peF5d7DbGV7rzu5LFkETRQhVC7nDPiSx/OkKuVMrX2LpYRIxOnDNmDbV3UymQqS42NUs318Ve" +
+	"Zd/jnTEzWWwdZ0wdGkPzMxbLtz385hZrZy1CwrvsbYdUTylVidpz3zjTFWdd6UpUjtPqYgHyvV0x/QIQUnRheOVSfn68E+6R" +
+	"JpVLIfS3oqdbLMAAhLzCpHSPfLd0J74Tj/LxI8Prjkvd+Gcx8Rgf3V18VMrlzUHIHe7R2g1Oyxnill4nL6df7pvcKX6qSoEK" +
+	"6mJ+s/TWdTFtWXoxW1t6I+Txq3ABGVALZEEInlIiIiHU7HoWTv8Z5Dk8CkAtP8AU+kHi6peHVcbGxsZWDnv1VXKOzCTnXn3V" +
+	"CXnlFUiEEdPOX//pwjSyddqFaz+dlxWKeW/L6kR8wrj+81545+15cweMi0+o7XD72o+/2Udzy3/78dptyU5e7jdIj0JpzwKt" +
+	"kw1mFS45E1MoFc5jQzM1Sdbr6PW5UsFHAuannf/p2oVpfWztrHExMXHWwpTK8oQUW0IF2QojIBGihlb2YHbQ5BxZBVO6UQ66" +
+	"8r7+aYlzu5TMS0zz910iJKWNHJFmEbBnn3H9yeO3JctoJLhkSHnLRRXs3Wx9tpQyu0al8+qZMzwl2MinRQ/0aYJZepZE/1ll" +
+	"cWucCorQyb02knxZnVo3IP/Z9dtI7FeZUijlbWfrw9pkWkWCLSWhnN9bv9o8E8UvJEuuvj21fmPVb0gwdmO555V0mctyd6VN" +
+	"iN8RLGkjRqYlCfXnBGhTv89iHUIIUHfSlaajZgjR896nX7P6MktLAzc5zFhnD9u7z+GBH54//+HAw33IW8042PT0U1XyOK+t" +
+	"/Wsk+Y+pv/Y611hFow6S7xj0vrCR78Ti7lhB9txSAX3n7OU1tff2zsEZS2DRyhMPTqxEFB/6IcS/JVyl+GAB2e8NPjED+s7d" +
+	"e3nvXOEqmbjyRO0kfiHLAGgwel94T6IPWpC9xAi7yBtz99be4zV75+KTS8hEZwEA/RxP+LekczrBCLI/GS5vBtnA6CspM/zC" +
+	"2kkyP6hCpu/j5N9sk8qRa4Ef7J0LfWhxospVmxMryURWKqUCUMXKvIp8nHWy6YGVLdWsz5y9e+eSDYwFVsMJK07YP6M0KC0u" +
+	"/cQKBKgWLRTiBAuTo82gNYIS1Mbacvjbjm+sEYMP8d9UnbZ/tZ1LIr8hVB8f9GqjGsxgtPIXyw+JwWvwDbtgqRLPgz/Lcfop" +
+	"/AilwWoBG6gtQlw5aWRnOQ7dqTrNJW23fwX+T9OPMNNtrR4sWigpP8TYsS+swtHkN5bhdL1+oHbKUcP6AlcnSbi546PhkEyK" +
+	"dh4eTta6ZAntJp17QPZPOndipXt7q53tzbE21+iVrnYnQTsODydfwL4dh4fDAFcPoDQeUGonViJAevSuAnFj2fynpFNDWN01" +
+	"Uhh9Mqc2qqmc9cGQ6DJ1k05fBcfnqsDA1p0KggJ9gjOTOrcpLc/vkFJ2iDZGt6CCTgVBQd6GtNSigrKKVsXJ6bhbj8jgkLCw" +
+	"EIOpY+tMc1iYObM3+Q1HV52ulKBdW2aZwsKaZ+RI707eFf6U+HJ/BcgmwMTkbPraijanu92wk3nP0HRbx7yKsvyi1PRQ7yDG" +
+	"SZkdHpUfKkvpkFfetU3npMxgGR7og7uV5mQ0DwszZbXsajJQTipPsz7RW+KxdUcJGhzZYPtZnGMZjHL77QDrnL24Sly/dw75" +
+	"ckdd+3Vjgw+6SWOBvuvZIFxFanksaJ1jHKxKM/6YjXOwsrEIVud4/5i8s/KEWIa3UTofn1jZQD8wOsc+WJz9YAf5au5ecT2u" +
+	"2jsXknbU9YN3GEPkHUoH+jue8G9Lb0TZfGB1zgmgtem5fDYoyZeMH/KlPD98TOuEt4lllMrHJ1Y65bOf74S8nf0b9J6gBNc0" +
+	"MQasc8l1CJlLvhSXuQuHVJNqWTqUTme5Xt7O/k2v123g6tziMvLlHAgmN+aAFY9xrxRkQZZUK/f2aoIQZQIYT2qL2sh/bv9s" +
+	"7xyygXLFpV++jL1OrIRFEjfJ9i3uc3AThCJoy7A5ix4f4TV750JfqY309poH3Gs0Ly3fPujBg3rzMSvXOVdSjyeQw6U7p0r7" +
+	"Z5cv4xrXLElOcT2eKhdccyS9wudGuyZI8jun4O/aB7mmx9cePHDPq0EoQppaXVMt4/yENLvK3FNykGeveUBJ4DxnLcSPKNkH" +
+	"D1zye4GdrzvFV8+c3GwyWt3e29LxCEucgsX/eDdu3MhLwfMKr0aNG3tnxVnNkVFaf7PZGp/pJnGFolEjb28flbd3o0YKxQN9" +
+	"s6joSVktU7NaRDXTIzd5Snw4zQNkLUa+CnRelel1Flng/DSxUVactblZr4uKbG6NzfahzAiCIFBmfPDfcjtgL32zqBZZqS2z" +
+	"JkVHNdM/kLhR+UjcOJtHOsd0ysOf7lXA8pQonIyoqWMpp7hkNhOdqpgkJD6joJ5sgho1obxdJs2dwoPL0c2bW+OmxlvNZn9t" +
+	"VKTZGpeFa+qLSmaVHHvwwCXMIqkaUp1yMtkXxrtThhLvT/PsFJ5eNm92KpFS4wbV9WKtEb6SRBhUT575+dmx1uaRUTq9ubk1" +
+	"bhprarjs7Ouk+WVcU1SfbR/39s7Mkdpbavwi16h4UE//KGI2ZroGliN3oMyqQqlwrg11FXUtGVL7wPSm2pBQL62/ztNT8A4K" +
+	"DjM2jzKYmgX6CB6eOn+dpzFI518t1WGeh4exU1h4oKER7+nZyKupV2NPD6FJSGB4WPPoUA/PF6R2w7/zjX3DvRo39mrcJMYY" +
+	"ofb11YWGR6s8KcTs00i4JFdslcD7+vqFtTCpPL2oRLw8VS1MYX6+voLiZbkpWb33CO/xXZCe6hcao7NPafScxdkeXLPzqQ9d" +
+	"ncaQ+23qQ5fQf9qTCOa6ia08kXznEiyT6w3HYyFc+FHyimbTgxlseg1o9By/7r0zQ8iAaeT6qysrxeGvnW8jvFL7zqZt5GhT" +
+	"+Hz8LdyTdOOqI0kqArAhxG9TNEKNEKImsU4e+W2XyZr36QzTd+77OJs77uIDAfRBiD8n51HXTZDQF2ftnUM9Y8/ZS9by+1zM" +
+	"2jMkvdjmOMVvk31O1xWl5reJR51FwUDhQm17V2HccZqvj+MUf07OZ6krEPpehgHOAsVq4YI9o5584F3HE2E5/6/sq9k1GcO7" +
+	"+F1pLn5jzl4Sxf9r7+qcifGHcn/lvxPYS19wW3bcpsZx7ksO9hSVrD00sFr4SZjCrAWNT41QuXS3cUm1sJ8uBwSkJOfmrMlp" +
+	"lZzSLPD9OeSNGbQ2MTElxSP6Pe43org0Jhrf7tqudctUQ0iIIbVl63YuZudPmNSzPDk+Prm856QJCAGKhNXCP1L5mvoFuYqv" +
+	"z1RkTHRpXTmu9Yny1Kol4ykgAN+uV858Z+n1eWJz1GUUwN75eLIe6dLwOZtBC351an468Of4Kpeqz5knTpTnZ4dReE+4LvkA" +
+	"ATPV3pnWbzPQJqDaLNXRlQYtt4UOksuXpXUYamm7XL4saQMwhHE4yL4FBrEpCMezpfE1rgd5jW3TWFu9gXoKLwgLmTUlm0GZ" +
+	"nNyXBFl5dtuBh7nt0OFWclBAckpi68z1LfOTkoMCAgJTDpbDI3u3qOjoqG6lgwf+NXBwqfSFV6e3b9e1y8uZNmNISGhaevt2" +
+	"Xbq2a58uKdD9R02YP3fCqP4V1vh4a0X/URPmzp8wqj/jcS7qKQyRebQ9hw9Zl3ZbBGgdstgKwRtfK2sRHd2irHTQwIcDB3Xt" +
+	"Rr90s8Pf5YdsgQEBQclJeTnrM1snpCQHBPHq7Q1zIinUrALt2qenhYaEGG2ZL9MKSHM79mI6msK1N5Y3xtjrY0nash6Ca2Rf" +
+	"hHTgVwj5dONMNuAatvYhSguvkfUfhWvfjNfsYJtm55bZtb/mxsh4kt7NjaZrVV/hak04v1AmCGgw9mLrDsWT9GphV81huqGG" +
+	"PtiL6s8T6/QTiT95/+3cfOOaj53LWH3+DJJ+jddQnXqDcJVutsnE+rwZpL05N3oH25hLu3LprHuB8J4wXWVuHIQQRKuUjQMQ" +
+	"IufZWURXxxNhn3BVZW4cSP5CSKVsHOyK6VKXL0DOFyTlozJ2LBA2CtPZXsLsOqRgP8AKkV87Tys2b5bOLMgGPOcX6dhi3z5J" +
+	"/2B0ShES3pf3NjbX4QX7ARaO/1o6xNi8+fLeOdBnBv/PL86zjH37aie5y17ildHRuw412A/Q8te+dh5ubN4sHXFAH8oPmejk" +
+	"h0yU+XE8Ed6X9jYRStdhB/sBRsoPncQZP3T2uvaL8+xj3z7pBIRMdMrub+E9wUdlbtwaIfSxStmYnrvmPpVWKKe1kdJcOmMn" +
+	"pEXN6Cpuc63ikgdf+uEMSr2Bi1iYeqOdtD60g0sDF6ZBCFlaBJ+Kd/3Ee3l5cOJst5Fd5CWjy4luIzeu3gjJhYUIcS79iEeB" +
+	"yEDlbjZo1UarsyiL1aJ1KgxGs9Wg1PNbW7Ui775e7Syv+vVjhZLWUHjsdbIrLw/bCgtXk0WXXAVeutRFXhq7bFxNS63TKeW6" +
+	"0YKcK6vkqZh+NAab2YB/Xph201kAaT5gYSq5DmM7kGzs54f9WrUiqbyl20hXAVLlyKnCQrc9nLfku88pPa1BCf34dHupswrc" +
+	"jtrP8rBto4vjjYVsrFchxG8VrrL8ci+UOqUZH13Rju2F+swpXJHH76ttz/t1XgEvkgkrOtfe5fcV1tvLeUtytbq0BysVYu1x" +
+	"bqezYvYSKrWNdXJCznOvrcIFll9vk/sd64b4aKu8FYXSij233QowFBby+2rvdl5BJsCLKzrzfq7+Q8sXkFbiQKkXXFKQtzpb" +
+	"W7Wyf5KXh/eI15ziwAaZLcpTYWGdWLq4djQYqhwPmGwk2nqb2qzk3DeNRrBRJvNarSikgxX6zm3XTtZ7QF9YyG0oLBQx7tVF" +
+	"2jl2ETd3kdZ2hAA9dJwSRvGf0XrTMwCttBiCEoywE4v9xUGHyuHRELymvwiV3DL7u6PxjfE4quq0GDya64IAbXacEkL5E1J+" +
+	"s3zkYwUzWHmFyA/Erw2CvyoOiYP6Y5F7bN81Ct840088P0EMHsWVDKH5kUEIFYKk/DawWtTyiZ6FHyBy/fFrhypI4yE0P+kN" +
+	"5VznMWLwOPFivzP4xij7Lso/MgijpPwR7FzPYKYu6PWgxY2xOEAcNJg0qTiE1/QTuT+4zqPE4DP9cPQEmnvnEPezBx929sD6" +
+	"qxXYb4PwRu0XQ7jT9qm9aFv14l6wW4bwNpw4YeNg1kiDN05A9foe87xM+x5rbvrbMJi3DbFbuBcqaSNX2qdyp4fUfoHPTdg4" +
+	"mDUvJQGoE3pfeJXvxN5MK81Ks2tC0Nv0NotWiFty6+clXzqVUlL+863ytdhrHSHrXLppFSFVD9x5ofHgjVaJgnNOUZqVZwet" +
+	"Lb91q1x0bj6+XHzrFvZ6QLO7dOp1hNQ/327K9gxG2RWhs+PJzgi50Zdv375923VcQb9A9Lk55yDZeVRBTp2bc87tPFt6OyLn" +
+	"dw00mT5eI9OTlVT6RbhAzp+bc46cciqlkEwpOudU2n7URoKuQXQOtVq0Ea6lkUv/Zc2tmsNs36Ujt/fOwV4PHjx4kOg6yudc" +
+	"9XTSkL1Ta+rO9Ndcvnz5cozzYB9vI9sdSNJVyClaT+muyil7Jx2t0Uq9VwuuZRGfvrXmFyGf7fpug27vXFwj8eI89edcMnLS" +
+	"kH3qcHXn/6MlXuRLAC6GbAdUEy5JhZySeKl/plZPp8b93LVo7PWM0lz/XKyedl5fH8c1T2ngdB5MQL2EjfxbSMFyK/VWurh4" +
+	"MhMM2HTkCDXEm0E0cI/+wZWcO1f5izgYh+Nw8j3BdK7TI8T/pfCQ85uVFrVFLXgy5+3c0SNHun4t7oB7cI88IVZ+1Llz9kDu" +
+	"5yU4XLxK/6Dlt0S9hPdc5dvM1H6Qk8rns48csc/iZs2Ae0RD/4DdtHz8qnhVvAoRQFj5jtP8X8IFOb/eRt8dRUjl49ZHjpR+" +
+	"jUuIhmjAA76E/ufOcT/bA5eIV3E4/QMh3sU/bT9vNl9Tgy6j1qKpX5fbxAqVDx+2ql8l6hIBpizpy/187hx108mqRv9A7rLx" +
+	"kugaqcsltcWsBMnujtrN4fX7sG1f6ZEj8OY+++l95LFEmR9lXzhsGDfp3DnRj0yDebg3JYwQ56KprE/V9v9ClftZ9Bs2DN9d" +
+	"8jRZN7peSM3sjyRiRk8wRqjp6FLa1FLHtm+2n978DyX4N5m0+NaCh78siYF5ddSW2BcO46esXl07fxg3CT1DW0/nbWo3R6Vq" +
+	"iJCETGVt0+Ng8oQxSiNUPSFW8hhvp+IuPXKkdNBg7mcm26tiS/wprUBL/CmEnzs3btzTZTSlL4ojqJwtTDhmWS5ysRwrixtW" +
+	"euRI133YliwLSOlediQr8TEuOXdO3Dls2BJaO8iAcIkD2g3HjXPru1JLsMa1GrQ2M8h+kD1BzWfbr/fpw4UcOSJWkjehJzbS" +
+	"zoz/Pim+eLLy3DkSd5JbdhIipB5Nx8Nw2SeHJ1vhXHt1YE/7oS/dybLd+kG+XW3MUPft+oaJNZuFC8/ScN24AYt7wB+su3er" +
+	"3c9/OxTGuG7f7H0nCr1qolzjSuGBPGXPIEya+mwmRNbHJAl2PXIkEpTSCHN2M+jPNTp3zv73uXNspMFDue+65inqDzGH6R82" +
+	"o6Le1tksu7arOyHR09UwXDoYob7YzBpD3YmAtB08oVAGpqa0arWhVauU1AAPYdRIwTPABQj0EE7QWdB+rEt4eJC6CZQ1UQeG" +
+	"9yA7G6uCwsO7hIcHqnEGnQ61fm1z08JCQ8PSctv6aR/odHVfdTo6c65X+4b4abV+Ib5kv0YT4qtW+4Zo6u8Ng+W9YaC0N6Rz" +
+	"M7EJ7ylOo6bP7rdkpVep1/JfX3NdKrv/nAt9rl2DvMfyeiP9T5f9x4+XNHTvycJa0I7iuvfcehlWkyGXSXe3O0+y/wHZD+2e" +
+	"c+dZFyDDdefZ/TIZAqsvw1a3+05o94BSOrHS7RyZnqe6nVWq3c4EuVGXySPXeWrjy9DYdZz66FNo59KLJJquw8K6OjZ1qyP7" +
+	"J70wda+r+XLF5Rs3LldcJt/Vq62rxs5au9e7qVu92T+rTN+t/t85CYO5ngRcUpAl4X6mHsJkwf45tUMn5071jntvRsWM006h" +
+	"nGZfZKGc/tTJrZtcnpINXbuPCxv5lghLVpNKPZ1xDFrotuAhGQkruatwF6JXV5DX4WP4mLy+T9pbHhcuufLYzEra2J7A750/" +
+	"f5jdyP0AK8lIuLt69YN90I/kklw6bhNceRpLs5JZYCXRH4JaSfOKaloinkuzi+oFjIKzXIkQ9MuA6NVs7yXb1rE1DDErYzAE" +
+	"cNx3JdCTeJMq4g09unY9dID/DFQ1UcIF8oDcEXfhzky+ZbJPYANCGpUtmRlTG6SAQWqtSjIANtBHa/T5YdmKFeAFHivahpP3" +
+	"PBvHjxxVWTlqZHxjT+gU3nYFeUz+WbEC34axp774YsCqyJAxt8hvAvnt1piQyFUDvjj1BYxl74Buch8I/VEotXsDa7IlVEPP" +
+	"+/BTr8ycFsh+Ls9QUuwN37mVoJ6S3Cx7+7yX1vQc1a/PJ1W9jIkeQaWrC0eHR7RMik2NDvNrO218bDDf/2o4qR13YPRccmj9" +
+	"lO4jDn61cX1+s845bUem7bDvaRsTGx7dKbvtu0vK696E9Xed2bv+8f2oxbr4Bv1IduuQLH2Y7/BNfH9FEnvFQU8WLEoaKdRo" +
+	"M6otGqPaQL1najhLIzCo8XufNwMPsUvA5wGt15T9CTllZCDcmVBWtr0bgCKpsiaqsrKONPmBnKIfSJb06q6OG8IF4SBqisKZ" +
+	"hyLJJb8K8+yKSPLVr6JPLz2BWRdTVxWesvduKjmziR+W+9a0ftnlH5BdBw/tSMY9t27MCq/cB50PHRT344XTJ09+0KYdQDtO" +
+	"JQ4Ni5gwefIfbQsBCi1CecW2smnvkRfJui8HhsKsq23bHIYJ0P/L2je5yYUrlr28cePL+Ff7hGBda+lLP9ofEUKCIBxFGCno" +
+	"qsT6I55DruD2pAv4QE2Nne9q384frclGqCF8+lYdzwGTuO8NIgg8UcBQrmtttiBlADTfYRf+EC7Tl58RJqxi12sGrUFe1LQG" +
+	"+YJVawiD7zbt7d5j456deJ/Yc+XIoZnBPvyk2oXewRlDR6/Eb4rtqyuroB8s3Hewot+TnJxJWS1rsjMn5rZ6wuQ+37FJ+ENh" +
+	"Qc1QGH0/FWEym9gb13TaAuxATPoojWBkPpyZ71P6D88t67xp7wfQ6qPfmn3eDATywWDYR4QhuL9Y2uxzmLLy5UuZwT5gLyDt" +
+	"KC8ZOZqmlirod/w4DKru3v0JWVhDFj7pLmTBbCdLEWqpnx5DE/gt/GKqU0aESp4JI0FrCFUqqKbvp9dFghbXkrPTlywZA2mw" +
+	"jZQRMbEVF2r/qVU0eMK2CSOnQihoF4y8HJE47GpVhvkyekam9O2cfIFusBqk+3MK0Uu9bt0b1WJ7/OaqUUMzgn1qF/CTfUIy" +
+	"ho1YJfbE+3bu2dSjYm9l1ZNWuRMzs2taZk3KyXnSr+LgPjKJvN6QTEEnGSvyUaA0Sgdx0sdmAUsWuFzGmk1m7q9uXTbu/YAc" +
+	"+ei3piebkVooHEIKwT5YXId3NPuczF/5yqWMEB/CtYb9PkymwtGmliry+vHj5DUqVJhUA5OedK+pfkqo9WRKjRHVKmr/aTVQ" +
+	"7wRS/SPBiodC3IzFi8eSE6QMtgGX2Mr+E2eAVtHkX1LGLx45lfxE7khS/b4fkypQ3yPcdX4Y4hCiOvM+LoYfVvuyJO8FZKJg" +
+	"EI46I3rRfwv4NeRL8Rw5JRx9vF8R+eSShDdeMAifMDx5TloAyTgWrGS8st2TS4rIx/spXjiZyMaQ7LWXaZVWT0jn19p/x7Hi" +
+	"Wa51rQGPEzcLR8m/4lSxA/lnJ8dj6Q3OMdxYKMCPnbF7jvFzamfgx3fZ2DyGkFAg8JIfV0NdsC8DUFuPkRdJC7h4ES6SFmIJ" +
+	"Pwf63qW3lHfxvvv32Zmg5BeYk6zptEa9ezQh3cWLF+EjkneR5MFHwoWvcojvXeIL9+/CfRpn0pGLNzP7aF/KI8Q71nHnFLGU" +
+	"RwEswHkR0+dwpb/ipSfDWR2uICTEChzTuyJsAn2GpW8Cygi1US2YcRzZOQRKLkLpELITSoaQHRfJziF4zpXT38MHpPD701eu" +
+	"nP6ebjO/P01jVvtBMjeM+0alRGPQOPEco/8RVmDgFjljREpxyBbR+GPUV9sl/qxiL1t9LVojGEFpjtDwqqNdxXvDyN05YZD3" +
+	"hWLvK08+5DrYZs8UX6A6LsujnOBraryV2V+PR5kw3+FAOQze3wlvVIx8YD57m3aAH803QkbqeyEiTHYcUN8DZbItOR20xjCl" +
+	"Lh20EYk2+W22jm9e0D/Rqm1lLRg6u7S8vOOC/AEJydpWSa2Htm01te+UqlZin2l9pvbt1Dy6/EDLvFLL4uS2o8cdPzxzpPzl" +
+	"y1MPT50+9TC7oKLvNMkm+YDQjW+EDCia+fWr97q5Ic6gjhcIU8r+sUzYkWttPXR2SXl5x/kuBueUlJcXz88fkGAlv8tMleFt" +
+	"03pP6dspMroMOyhvE4/W8eb6QjrLHMKHLlbZ2F4kFAiLkYBQBOu5Qn7tjMvc9kUCrr2PAC1FSPhNOM/SWcAB4deat7iD4Ge/" +
+	"VVMjnK81IYyWOrYyHC0KkNYzKmx6Zao1JpkNYDLbdBqg8wTnA8ngR3pXtukcCY1/xXM4uwonBwQWhEwl57AuPCeVuw+/iBNG" +
+	"L936bk1MbGwMN0nrN1lLviKRC+aMZuPtgsMhFAhfIzXS0bKsYLbpI7SCDisFbYTSrGkCyb6ch3gap9jWJsNG8kEbz2woJB1i" +
+	"Z00n/WCT1QtyIeM8ZPWtHPr730deImsmH37wd3FnfOGX28UQIp1rTidluLMijvZl6jYHZpw/r4h7LNkOlpEybrIiTo5Rqlaa" +
+	"I9ipG+58/q8kMJ3/04rz7Rqu39r+H3F37a+v6095/pqUcZjRY7Ht1EZK9evz56HXhQuUsPLS40h32vRsllJllKmvc/Yxf33+" +
+	"xQs51gkXXjzfKgm+5XvYo8u5qWKTFv3b177JXSizz8V/R/Wn/OsQ4o/xm9ndI9UtGgHoIAL6gJl89NLL/ObaXpzCXoPQU7hg" +
+	"CKDYoIPcl1+CPPIdeYPfbK/hFLW96uNqEQKV5N6BRedwxsYxgq7fCOCfTOlXG5k4e6otshZHQfGSdetmkjfFCzjqVKuOHTO/" +
+	"ES88TUttUbu8bFnUKuk1HehqI21TZydG1vab8oTYR/TDE3GUeOGbzI4dW52if5E3Z65bt4TKLYDEcfTsIIjahcuGQcwNXbzG" +
+	"aVmkpCzG43ZefTPHLm+VoDqpSk5XlHv1zRyzwvlt+9puyR1SlupSewgDXH9Kby1InOIVhYfK1Hg/223vx2864Z4pLrhZgtN0" +
+	"oYCt5c1kvlJRPoudw+KtMUWIkx4lUDbpm7v6Fv82yViWcU1x9TrOeZNPl3kNDYjB7BQ4rTUoMDBwVKvAEE29ivkq81JsUhxB" +
+	"OW3M8rwE1Um1laa1mRDgHxri4xPqpaptFuiviQoJ8eLapRe3f7ew3fSQJkpy4fVSK0DH1CW6NIDuoOdCsrLbFXYtKyzMyg5p" +
+	"ogRzvXRyjwsTT6ibhXdJsDRvYWzUmMyFVeoAbd+EhC7hjRDiUQGJU1QrPFA56o8G0/dmEfXqnw2G/0EePmCo953KR++SrMZN" +
+	"VKAxcOY6aQnRdYKgQlKRlkxqy/L+i9QYMhz1fQq5jdUpfY9mgXpJoOSvEJId/FOzAH8/KtXZT4mylvz0P4n2MQQ9I+o0V0Ph" +
+	"EjKiTuykN3xPIuLqiR4JKIrEebZVeKDJaDqajZag156W/v8m7f8sfeV/bNdsiNAYuEjaLHWtAm4Nhoc/3VXrGiQouH4XdzbI" +
+	"8wHuLfTUGJCb3g2A36MNJ/aET0NINnG2n6JTs0D/ENayE/7/bLr/3JTK/9JRaj+kjQvfkxK39oUx7u0vzYP8KIUHymZ2Zw03" +
+	"p7T/suk5ZYRNL28c6ncH9g0s6wyh6bbOjbzU9YTaxBRenJ5uiJ06toT8YRmmDW+e0KRJTj1Zpmt8ItU6bOhZVlKQZRJ44OtV" +
+	"5WtPVUROTvnEFHLWPGNhYlpklFbB2/3r4XBrvX3jEzLo3NGMxPGdFB4oHbVCxfTtnG8y7UkNVUqntOll49CnqkRNYWm4TPbU" +
+	"nYYI0Uh4zFIKlDZ9MhXEtnEdjWbvenVp3KhRxzRrZGDj3u0vBLRILTZF1e9jag+PjuOMISHwSC0IVkMTL7ItjLMaIrR+fv5h" +
+	"TSGsSdtWHt5aUxDXfpZZ5Vm/jT/lBXPL9MpW89pN3mqJa2n29SQ36yFAY443zyor/rz4E/I3h5uZfLwLsS80M0UlW6YmpYcH" +
+	"woPPbUNzI1KbByPklFWmwgNloHzUme4ufE1mU7Lt+QILAaX5OQIzmSOUkrCUTFreIGUBzsZ6zVtZHOSnZBeGm56WWOPOtvRQ" +
+	"nXLpqJaGxNZ5zVs8I7EuybDcU1DEN2/mU0X+CeOiW0S0AND4NvcHo+YVXqmObAap23p3O5CZa1R5Xawnk6O8wpRVUJKR/rDj" +
+	"8JmWglBfT3KjvtCacLwp+/MVGGujA1sWFGAN1sSYR6empcTHBcJf5MoIX9/EuGC2fg9HG/nVQozK3HgLGGEL/Y1Xk7UqZeMt" +
+	"jr1k7dM4jr1OHNhCccAo4wxDm2CPjLNHSnPsIev+Y36zVIZKKZXJcNk+U2isMjVez/SILfAWzEUcOoZ6Csf5d+ve+UsRrqkd" +
+	"DT+ThWymP+z5XCf7e/y7ZDrZT6bjdXclmo7HjKa58RakFP+SfsNc+pvLF0XpN8xVKRqvl/EPCwVCG5Wp8edMp9kCb8MnEi/i" +
+	"B3S/AHuFAq4zi/LSYPx02WVTspBfualb79mNPRRNlpQUry2v3NSt6gUPQdlkcWnRa/UjqHfIT+cxR0Oo16OvCXbZ5rsiezpN" +
+	"v03mYz1f61CypLFS8JxXVbapV9m6jqWLvRXKJrN7d93Um+tcWpip4DCfnt/hUZeiTFpSWn4xAjQJGgsruCfUOxu4QoVqqbud" +
+	"JCkOptMZgdGWSJ9D0Cqa8IXiCS2TK3qWTdhTkvfOkHG7ctrMyEmu6FUG4/eU5r8zZOx7OOD1yoqKziW7C7ZXdTo4ZeGoKbPd" +
+	"vk53nk11Ewr4d9n9ia8lkX0s2BhGfbRwx6b+eq1j19TcDt9O+f3HjqXpOUW4Md5ECkkcnBUr4QM4S+IQoPUIYa2AqXwMxjoD" +
+	"f+oY2lL3fEPrp8PaHwoykwSMFcn5hVelP4Xk3EH7er8y1MtD4f16r7KdfYfs7btkXGNeoVrbq+t2mcd1QgE/WPIo4ww/Embm" +
+	"zBq3NtbouTmFyUWv9azc1K2oatCBwe2TpRbuUDXwID7lX1j6qDjfRHbmQ3lT1r4RUJqP5POS60IB9z1qxvzSMeJx4N6BQjAt" +
+	"lvZtuQdpBakD9dnYtc8sPz/afWq/Z0cws2kn8sKKzPYl9C9Pj6xCeO+OXA6YhQLeiAJQiLMc+hLOrRz5ANitpMYemiUlxevK" +
+	"WFdtomxSVxRvlPqrp9xdFbS3koJ7znalZ0D+zN6brTBScfKbQKPVYGNeGp310/DbPivpPyq11+Zu6fmVOFlM2fvahF9g8rzi" +
+	"IW1LCrtLpUde4veQj3Bqy/RHHfO1r98j3w3++N738RxubuzKOGgKAxGbGwqFAkHJ6mn87zXV/j/Vdk7tDEHZcI2/vSfL13FU" +
+	"Ljfuv5RqlCwIbXqlRbKTVJr/Awf2E3dg9gtiDV7b7R3+Lpn1AhbEgd22N8hO7Zp73957cZ6C/aTt4ChmZwMWehcmlxqHJYdz" +
+	"VjaLMKb0Gr0yWfbc5AMGJ1Mw6QMwDyRjMOqu9tPMryguKiqv7FRMWWuijEh9Z894naGwZ2Vxh+45sEb8ewAeLQZO3VN479t7" +
+	"Bz08PTPNpi6POhjD0hUKzs8XOg29r1CZSh8VGw0w/96392qHOfs+b0RBKL1OZg3Pnpr/+9zaU0rzrBs2vWd7K6Q03tjgvEve" +
+	"Z2DeNZQUHCdkFUp9WyUU8LepTKH+DOA2OyifO1Pg6rFtnQyWHOxsDpX4s+bFjm0r/dl3QemB0uahEqfJefjvWIkzCMgnv3qy" +
+	"mcRvQxz7nUm+zwejJ2NRU6/v21D6f+6Fmv/7DNDzv4wWrqCBrol1DQDt8++xc4Fjjl9cfHf5v/P9n9L+T/V5fgrMb6iO2v/X" +
+	"ij87T8lrezbULe0+IK/sQD0maw1W5wrvXbfA+8nre90sxdZ5hbzMe7JVnty6d4+0vHePzVPV6JJQwL8n25WERlh5ITRCywuh" +
+	"gjY8ghes4ZxACPkEWgECT0gmnxERlJBCTpBaIpJPIY/TvvXoFfVbj159/61Hr/rgLY9eldexZKFAaIkCWdwGnXQZpDW6GZcY" +
+	"rdJllxzW1CBbmkij2KKVbm04IaFFVDMdD/P/aNIsoaj4UKeihIBGD2Ahp2sWbUhLM0Q303Fk0oNGAQlFnQ4VFyU0a/IHmcLr" +
+	"mkW1AF8uwtwmbcD1jPjuiRZLYndL6vU+2W3MwVwHPtjcJrvP9VSLlBCfcX1AWhtzhLQu9pb5DnV6tXoOa3RYcMJ/4kBcytqh" +
+	"33OKE6/elGzu2VosPGLa5GY8VKVAoRLcoRYKhD8ZfBsepDJRuKxrNnI40FFHKyFf+FTWVTNpuoOlIyW2ORzoU0crIU9Oz8M2" +
+	"t3RezHQ40D50VvEX35zp2o/JlyozCoV3pRIcj9EbjIc96KzibwmHf0S+lH6jNyiPf7j0CO575Mk0InrtbqQvbIz0vIQ+5zXQ" +
+	"Y336KpebFh7+h9G4Ra0i3/NzyPcqFUTUzsB+iRlpSbEts+JtNkv6nTvxWVnx9+4hXqKrOMB0el82IuTVGeSYYVI4M9lViBw+" +
+	"7Jiz3+PcnIry3JyK7i3TR0S3iI6OjBqVOXPnLu77O1fv8ff7rehb1bpNv82WuPhJea0mx8RbPoFmR6W7cqktNjntR12lJuq1" +
+	"JiNdJt3K+PXUqV9vnTknUZ3y6qNX8SuPXnXSUBySbRjraGgsGmMjcBEA5e3Nvx+dNI7lx3/j9WIVFJIP3PhogAZ18cgZ3Wic" +
+	"HDfp6O+bGQ1hFfkACsUqV37lu0/XQ0PPsAVNhKBxkVgLuf0HQA6pHUAekyf9JUqJUEJ2nic7v/0WStjeWaK3gdHzqscRZ+As" +
+	"YHATy3zwPjoOosRkiVQ5mYK32PcgpVynpoyGHhlQMspEndwoMVebrCmZD06OBXihzR2hNUpBpLXOEIWWRJ2SJVKT+zBFEMj2" +
+	"R1YLTawTT6vBg3VJYRkdOmSEJcUZwsMNhvBwnPOIj21uyI+IaBdR3rd3aVGPocbAYJOAvdJ06vLywRZLRpbUpnHHiEdSaPDQ" +
+	"bUOCyT/Jg5KTByUPNURG6nTlKlW/7uX9B8YZmpuMENDeV9e0C/kN/Ls0TU91b792rK4xbnWUwm6pjeok2Rco+9v5h0XL0uuE" +
+	"OSOoc0JJ2XoICYtOWB8YHhO/fn1gYUxiB4m/wxbr+JFkLwQrmzRJs3bpQB5CcHdrlyLyF47oFBdnc7VbGuODxfRxcWK0JiVT" +
+	"563WpLrlkb7cps5ltTTAcB0XYzcHxQc100eZIkpjkitajBhrjAoM0WzeLHU7Xu/fIjshqFms3mg2GNZr/fpVJnSIDgyN0PeW" +
+	"74cd/9A9Nru3MWhceq6s+xuO4ZM358/5lixcj7Vi6s0F8y7AjA3iz9z3kDB9352r98jXM/feu8rWyCKhgP/Auc/XGGS7Tued" +
+	"s/OD/7mN37kjjrjDyh4rFAjL2exUX8umH5kDq4FLEFfhH0buSTlCzvWDVWLtQDxMNAzZZzkIsQPIMOzBnbpzZcC4O+dvn79z" +
+	"ZeDYO+dvU9k6BgsFwpq6OKXuHD3DFf1wV/7Au+7Ubqc/Ud39OL8NcfT2N4Ku7E1AC1ag14z8HPt3nJn+vn//Pj5x9uxDKL4D" +
+	"HZ7NZ6PlWWm+CFeRXPP797l9j8jeO2T3w7OsTx53mIQC/oFzTjHIeoSzxAInn85Sr1yB5CtX3At+hobFWVup9OP1Ktv8ClZd" +
+	"4Ta68YDRcTKf5dcwi6e6djA4d0EaiRs+BwTSJxeuiy+MeV1DHsHWHNIMzxqz7m8nd5B8pceVjQv9r/S48sZC/J6Lyf+HMkDi" +
+	"9rll2MdK/D9VRF1FMHrB8aGSF9pIdu6emAnQk3Gu5MWm+Fe7lftSLBSb4V/sydwpvgOxEEsN7nQXd6qp+/NpOhrGF3DSrxe4" +
+	"U/Zk/KuF+9Juxb/yHWrE9+6K79XAaTjt+pPJdAP5C9sVGmRk/kyN9P7cyMy2qBdxm8lsoVf7FjkAEbXu4hT0tt9swh986aP2" +
+	"bnIqMzPe0hN6WeOzM0+pVN5NvszKiEvqAQyyoyWH07MxR/5S67S+3Zb3X/xv9bnbc/u9Uq7SadVd677z279/bdeJ71/bxcZ8" +
+	"teOuUCCsZePOFGHlIzR6jm4pfUBHNUt28WRNtuHDt8hdyAHf1e8P3bT79sn82+BNviC3Xnl/yMa9dz/n/P2WP3qHg5VdyPXI" +
+	"Xo9Gmg9oVjzagsnILhDUovujESZ5r/2vUCBQKwdfhEDQKyOsoNErbVqBE7QRnJlTkKuXOpHueN/qd4qukveWwo/i1+PfwX+O" +
+	"6vzVO3dBBxO7HCurmU027a+5Tr4rkWnOEAqEJjLNCLNN0AJntimtEZoIq6DRcxgiLnWCLWL71e90+AE6LyFhOGHCNqxhNMlt" +
+	"sojRhMr9NdfB7KQZIBQINGJgM/a2AyK0AruWr+ueEdYIjR7//uUuaAMJZFYmHCFCOk4W5+QdTCCfw6x2JBmP6XKQ87fGwNqy" +
+	"R/2+HPvw5c/u38rfeN/+wp0PoGn+s+XYKO+C23QnWCNsUh1aHN2SCUfF17Pxm6I272DCV+AXSY7Bi+kkHfOlB/CexBZfz7mz" +
+	"92tWwNIeDwd8Pv7RK6DNZ2V0YfNPEAqn89Z/3Ks75xfnptx7cWlHaf80z1PelPdwjuqHDW7AOV3dHPQ/lS2P9P9Ydu0VabQ/" +
+	"r+h685dTtgkoCaEIVzk2Ny7MSuaVw3kSwJpUKTc1p9TjWqWn21HEwCmUK73OejC2besOlLNmnp1IEh5TcjAK4sgL2fAhUfY5" +
+	"vWszJ/FT6uTSy3NX/ktcOD1WyQdQzWfN/1LZo75nxjx8CQZYY57lF2hH0P1nptltSF0XsZm5eXBUXNfOkvos112ysw5GNGnc" +
+	"oayyODo2iVTDwkySjhVdDrxz9C3u6pw7e//xafoU1+E+Z/InCUp2GOTr+U7Ph/1Pjn+0GnT54plEGm/ssKOJUCAMc58PI+QF" +
+	"6rg8KXdyrQCbnU3Dve3eP+rRkDJ7OjtCpjNz7QKZ3GZnVrjhamkOHSYZQoEiA2EUjAwIRdjMGudoFbQCZ3aOImnEglLPef/4" +
+	"+TVIJaviYD2xhsBD8ZWOHyWTj2FiDOkB50KIAo/tcIRU3/rqtdQkvknFo8rLQx/OX7nizj7yksXe62Gf88MfLVj50p0DMNAi" +
+	"fpdurceDwHhQ6sEWB1K70JZ09ikz7WOUIVyanQL9Uy2fiKdwv5IjJdCHpBvhmng3CCeIm7t81JmsgdNG0hQ3u67ywRd9miS8" +
+	"9GgPzLaQqQPuzNuy+NGLC1Y+3E1mWuCFwXfmv7ns4WL5/NaxXCgQxjL7NCTwoEYR1nAQ8snbZBmMgXLyqv1XLgAG8plkdmYm" +
+	"mU1OYTTt7jT8VF6NGglaljv8GAzkAuy/klehHMaSpXwmJBOahSBIhpmZmbJuRFYLBcJkJCAVQgItlQfevfxhoi8HMJu8TbZD" +
+	"Vygnb9svcZFQHk9OwMf77r+A7zhKuxAHuQ6T5t2dBrOeoalHEVoemoIbX7M5EDVkKJRzkfZL5G0oh65kezxseuH+PpIrnIAg" +
+	"Mmva3XlkIYQAdCl18vknoxmEmv+3+Ujjzv9/OqR8uk4rG5yc+NCna/g/8gN1bfIf+an9vr5EnsdOw/JxtBMKFNny+3kWGZ3T" +
+	"6dWY9lxThDWcDiCqnfB95vTd9FbRmRMfkGuLD624QW7DCxC470OSVViYk8NPHrGEnHp/GHk0+io5BfAGCciFx92j72auwaNz" +
+	"7TuaNW6kbag8BfUdqjGZ1b503OgELS9wZqB6Ebw9p++mt9ufOfEBhNHyQEumkp/3HYKjhe1zW4LPiCWQ/P5waDTme0h2oDfg" +
+	"51zi2SP6bsYacVkuV+osD9AB9KLQmZ/Eoq5Rq3EpYg/9Sw7TMxz/LAZWLczL74t/FoOOTpg1ayY/6fraaTMKi64vW/v1dyuW" +
+	"yXRecNFx2kNKltRSlDNu3cEZs2bNOCQG4Z97FOYvqhID+UnLVnz344pl14vyF01be12q/wFHutBZ+Br5SRbuZpOKGnZqLE57" +
+	"c84YIN3s6XUW3HrjqFmzZh642//z/pBAVryMefFI1eebX9rJ+F3XZOYKeHnFMuhP1l0X/7pO3sRZ2Hvt8JGFRc6yLEJn4XOp" +
+	"LHq4ykKvcUanHbbG0ki6MDSbjFz1G6NmzZpx8G6/k/3J1zDqFbEW5/X9fNPLO6sW5ef35Qc2mbmCDFuxjKyD/tex93XoKVaL" +
+	"fzkLw+gAQkJnQYc4pJf2PM9IW2VL/oUfWvsKLKgn8UMzZs2aefasoLvOiF1fxurkRlMr0WT7Lzl8r7vghbDaV/DPdcLvm8+E" +
+	"f+7cOb7VMsby9aLCkcPr5M8JnRUaFIhMCEXUKV7OlpBcYtCW0MjNkA74Dh4g9mx7KNkOKWHrRtAmudfuUDK0IK+8hjnxcBOp" +
+	"PTRnz4q5h89OVE1jVRBzD9NWwX/IQqor25OWDc5Qs3Hy3tfZPvU3RFxRh775+fktkr8QN+A32x3KqCqiNV1mwDZxSdtDyeQC" +
+	"DAwjE+EUNtFqFsX7NrpOcs+GdWNVH3tOzD18bux1eSw4UlkbhbpqTgsxSm1jqddeeu4GjBR/f2N2KnkJa3vdYa10wK3hyPqY" +
+	"s2c/n9nm7HipweTWi6krR8tuL/X1q+NqNoPUlNzHsalSQWlkFdb2Epe6WlBq1FHQOrYNK2ic3I6sUWcihN3sMEOea4lptTk9" +
+	"yDxtkwnKPTnlIJDa8pyGzTMhpeCztlWTqtpSu3G5LJWp8QfyOS/ro248GJ7LA3UFQN/LWy3WZ7jAvjNmTJwxo2EOcOSSeUuW" +
+	"zFvivKfld0pvClwnGPbO3K7aGfzOO2KLO273uRIeSJEBrPS4gnvP3onfWVtyB1+8I8/DWqFAKH3qtMZq0NZRnSOUUrpk2J07" +
+	"6Nk8Tuq097pKmCNlk8p58mkdT/xjAUs8sSgzav7xeXHseQHXEF5f+7uAn8azyPFWjp3HS88LuPZ3Xl9DEOLQNsdNoY8Qjpoi" +
+	"C7UVQ2FKlzlendsouqCGJ9Z5nvaGMDc7QcHtpQLX47327bKzsrKystu1f4+aw2VmZmcVkuuPL5WVR0dHR5eXXXp8sayC/llR" +
+	"BjuhKfl1H/kVmvI9jMb8greqer9VkG80sr97V9G/7/TrWzVxwpJFEydU9e3Xr2/VhImLlkyYWNV3Mzl5kpyElJOQ8nQ9bP+3" +
+	"enD0lcb/xL8B//I/8y32Onny/xu5g1JPPYDYzDa97X/jG7/2+ecznf//zzUgb/zww1Ln/5Lsbwl9hDAUhDLp22p6purnFq7N" +
+	"Za7Mbt/Nbg4etW5B85z1AjPo4XJWVkhIdla7duVlhUWZWZnBoZnZhfRL+yNlw4ZOnzFkWHnXmOjo6G7l7NvQbuXRMdHRkDUT" +
+	"N5nJncvf1qeqS5eCVkZjmDFP+tKqgH5p2zc+ISGhqs/ECUtenDSxL/vWp2rCRPpNDFiGC5Yi977EoSBkk2ysfP8P7YP7UlOs" +
+	"mv+lYbh8Mh2v/R/bg91ZOHn2QgHISv38/4e7oyz4v4wOocFrpov/U/ViG76OWvK/1riujdQoCuWzEybZ/wodDvSxhOn/UEd+" +
+	"0L17yQ+mPrD9+aftwdQH/1vdvBcvHv0SuQeal0YvXjxqJQ0Xv/LH/70p3dqSnjsbmDWDfI/xf5ol9uGNYm/6gW3/U30G7By1" +
+	"C3/6v1fg/5O5WPM/z8UZOPr/OhcvdXwmTBNy2b0G8zhRt57R+GQR1Mayl2ulIq9y82tP8PdrffngujWoGw3GdrpmLnKj57TR" +
+	"pMGaZTqwEv9iP+UkgINPnsR1OdGzvHhi6m7bkzrLNjtJXIZoiJlJvmU/yPmZEE3O+7t46kn2QoelZC/ZuxQ6sB/QYauziGd5" +
+	"owRlwtJ8Ra45SbHJ6H0Xd8zeUHgsbJFfLjEhbajmX60dXC1sEddeFtfWxwFZhsK/1bWD+VeFLTU98IDLeAB6ihaLZm1QG/Hv" +
+	"4gdCufjBMf6ry5drk9DTeBHSY0or/v0YLqx5+zL/VW3S5csSDlf4NF9wm+jq88VwGjlHE/NyZODaER3DrIbbXNbly2SMsIWM" +
+	"cSv3KXzhX1qV2sHV1fyrbvjYhc85X4MZZE6gdzXMO8b52u8foxJwckTbui6PHBNP0uXccuLWx+CFY7CUjJUzuxFwL1PO7c4p" +
+	"9K4Wyu33WcHHOF9XVifLtI4Oi/BYMZrlZu7EwQxybr7LXnIV68SXyMG9EE52VlfDDsXoctF02X66HF8R1wpbZB5kGhrmXUfP" +
+	"Of0fmjVGWcI2g5bvsvcMfCre7oaHixl/7j1DqqsxvNuNlFKS5PLly+LWcowlxkjx5cuIc9FtgtTIr44/6i9AbWF11RgF0/vk" +
+	"e2hFBpIDe+NGHXsizqnGrd9808XnOMokHkArXhsFr7K51UlXjbTIn0XZ1HNud7RMchL1bDBohfy9Z86Qq91gKon7a2/ssmMw" +
+	"QmxSjVsPHXq0G/nNjftxlHVYxproGjS6zPrlMccqwSaMQdHSaYEc0damk88IzOH0PEgy2GWR0Z0PQ7noX19ZXla0YFbVvOZp" +
+	"0SnRrXaRn9jpY+iuVtEp0WnN51XNWlBUtvwVPCd92KAP5pd1GP5mQqw53pSWXN78dvPy5DRTvDk24c3hHcrmfzBoGBtHZx2r" +
+	"hAKZF5PLeYTSJG/K9Tw9K5KshS2JNr3rTSr+4beXl3eTeEmlvLwLoTCGLCM/vUt5SZV46bb85f9nXui+R2T2X5IPA6yhV6Bj" +
+	"xFF4xbd0u8NtJDfIDbIG+9zFPs/iA/X8AVp4Fa+wv06xxeMQLE4VvMQ/7zJ7up5CgbAVcUjhvC2WSmCXxdx7rmJa3+av1yvo" +
+	"mbz0hEQjlUY3ZOelAlvfvs39614mRiMgWfEZ940UxxDqKVtmk9mgdNnsSE+PdXoD3TXV6WfC2ozhkko1MmtmabyWJHE6Wb2a" +
+	"mT4iKkoHGL7SxXWzz0pq09qa1KYt902d/tQ7KdcrQ9ajLHHxGYKQm9z7ePHYjh1T04qlfaaTPy/G3/P0wv/A6tqkNm2Sktq0" +
+	"tfy/8ck1los//l/YpP6iIFlYx32D/OiZg8ZKi7Mx6wyV9D46DkCyy6cGMiPwrxjrWkQPz5j57s4ZWSOjovw48Le0pcy1wY3v" +
+	"CkJGfJzlKPnlE0t8XJoXoOK01I4dxxYjHhU7ZgmHhHl19gv0bEGgtlrUaZvVoMVrLuK2pMXYi+IBuMhNwo3FR7Pu3iUvi49w" +
+	"41l379IxNBYhbnz9NQteIj9xmPxUXbdmYXQEISFQoZF6kfySVjBo672mJXP4XrWbFRrpTe38O8/kc77WlfK5XuyS/jSf892u" +
+	"QnPnOeVBg+U53/BK+SRbdepZR8uiklrU1MMEbXRQG6R3UuwFeEHO23+2CVSDzt4VLnt5px59IVTA5JQmpCN5dKhde9xI/Dvc" +
+	"+PLFbJUbTXbq4AkGwQoWNT9cjMXn7F257Zd5X/uPDx7wvjWS30iEyESFr3BU9mZCH4xzK/EsYhftYi2x4znUv4NwrcagbPc8" +
+	"fDxbnAk8FjAPvDiTTFS2qzEI1x7vl/DLyUThkHCU+ToI46ndEQtVzN4qh3Oe28idhzAYWkMrGPaQPOB/uHm3djC/G9pAZ7KL" +
+	"HKwt5l+9efcpOkJiODuNkuhY1H485/k2aB+S18ghcoS89BDUW2/e5V+tLSYHyS7oDG343bWDb0o+IsajOXwTfhbrhwj0NrPg" +
+	"iWHj5o2biRW+hNPEiufs27tvLxCCCHY4nL4UVIrGW1h91qBfuN/5fixeksu+jZq19YdcUtufPKFmbT7uFm10XlsmFAgTWJlN" +
+	"JZ1Pzd4NWQ1g85SXVqpk4imgJXfoZyL5FLLJz6/BfFjwKvkZsslRCHyVTCZTXhMmkC/tc8iXeMZdSN9GOsDebeSzu+SzbbCb" +
+	"FG+T5tH1ilFCf4SRB9WGPEFNvTp6cmq+DZR9I7YQI/ElfNE+jezAs8ktnGwHXCruEE6KO3CpGAj+4jfO87ENilHCAIRlKq7/" +
+	"8V2yA3P4ongZN2fk5tBM8DYuJTdIGkkjNySCDdAQnDT4NlAiEnyR5Y8UL+E55DccvwiX1qTQzBAMJ+AEBEv3ehINpZMLUHNr" +
+	"pVzCu/CwDrm2K7nxDD7LQfGFdym/Ej6/3cnp0+9T/ps19H+zhDY+xwqa+QdDwgx+M7ufNPhqfDUGxBlwBXQ+O2rkWehEOleQ" +
+	"u+X8ZvIPOQKtwIvMtv/AGevns9CcYMAc5irgvXLwq4Bd5L2zI0edhZmc0f4DmQ1e0IocIf8gjHY4zgkFQiIKYa9Qw+SHl9RT" +
+	"jeSCiD4T9tMHAzsil9NVz0vndGnWpI46nU7XMcmahuPEs74qlb9SqVT6q1S+9PuMsSN3xiYkxO4cOXYG/V7asXB8qMEQOr6w" +
+	"YymOg7ym/mWp6XPSU8v8m171UAb4+jb39Q1Qelwly5KTPpk6C/xnTf0kKflqhHFOl7KPy7rMMUZcdfbDc0IvVz3YqTtlMokx" +
+	"LJ29B4Pz3TP33HQVS8d/iWdxnK9K7a9QKhX+apUv/Z5utUh1s1jT6feuHQvHhxgMIeMLO3al32nd4uLj43aOHAt59bh3qxVZ" +
+	"Vo95VivyG6sVq8cahIRRQiAKRBkIgdQSicGYWWUyXsOU3vSbM4lxXS8VJNcOv+h1HS3J4wd1jDqhFJqqVeEqlb/3CfJ+Quy7" +
+	"I8bax47aERd/IjR0fLuOO4oLx4eEncDDctPSyvz948vG5Yov42F+vr6BCg8PRWC4H/26ZNa0j5OSk5M+njZrCf3eu6zznPDw" +
+	"8PA5nct6iy9LbbDGcVMYxTtk3iVRh8ViK7shoHwm2rLoN2cS47heKrBLBD5Mr+uYmDx+MOVd0VSlClep9d4noCg+TuY9NuFE" +
+	"qIHy3rH9+OCwE/ilOt7xMPFlN97pV3fe6Xd33vEwtgZBV4R46q+e2ofJUSRYUCGjmp51SMuuVm3k7rYKDj8RHpR3Ynr5+BNj" +
+	"u08/gdcPKzRo7JO4hRpD+6FiFV7/+ZKqIOhP1gb1XfilWMX6ZpJQwGiHICTIXgmt7J/Tl6B0dmS2GrV8zIkTJ6jtLv2cOHFC" +
+	"+oP8duLEV/CQNIGH967egRqioJ87V+/du3pV6v8oUyjgP5f0C2b/x1wmCfn29VwV1aXJeeCJnTtN9pH9NfD1XfialJIvn80r" +
+	"2/zRwwZJHe9vrwaOiNzpGpJwlyTUQLt1LB9HbQU9D8m2gn5PWR3KvzwPOk0OxfbMDLHeX88xQ3T7s6Fy6lslyr/mM+PEXxLr" +
+	"/WrYRrG+uSJdC0mxUKDYy2xXma9MgxZiqIGOp2zpIwmiuf1yfXtU7HX/vph29uxDsvsO2asc4W6X+hRNOiOpKSmt4LT8ya8z" +
+	"byVbpQKw1317+0fQ4Q4UPzwrvONu31nPvtaNmjsZ7kO37E/nqatV/epwH7pX4lk7F0CCNZzapFAbETUSeCGfzLQ78D14xWkL" +
+	"ItmG8Nmk8oX7++DjmjRyE6jVBUwi14mjS+kzNJnlTTigiHDZ3uMYvILv2R1kpmTNQd52Wnfw2ZBGcvfdf0HUIkdpFwAIIQvn" +
+	"3Z1GZjGaw4UCxURkYHfLes5lyWGKUPJOew6zVae3MasOzqZQ6vhV74/OssycOfHDwzvIjQxIOf2ILP38/eq3jvx9JuMm+Rk+" +
+	"gvjXwjt3yVFMLCf3k1LJzV6tyfXyxeT64RkvLf2RnIAm75KgcriUFXO/RZtVRbF381aRFWUhfir53k+kb8WY/0nQczo9tTBN" +
+	"jmA2Hhpq+ZENvFIdHmELj1D7CnzF+6emLLx+pOM18Cavk9t3358ybeCW37qT0/sgFqLV5CvyxR9wRnhQTvSB2rsRtk87x93v" +
+	"ulL8rBya9x4NaXum/zDnOIRc79Gi+y8QRP5Z6rS3mSQUKF5EehTgPDtxP/Oht9JqA2gMaj7jkzEfkZuH4KL4RQGeLoat/XTM" +
+	"RxBUQNbja+TWh6Sr4sXe5O4d0vLOO3fIkt7gd+cdbrVYCp+Slg2Wo65XhmRwoefkcp4uAo5/KM7B1+YfAo4VYx/iKuQOfHrn" +
+	"DmkJn8oyJeeFAkHHxr58a+sK4MkM9qXrW3I6ohcMEx998k4v8jJuMlacJego7yEv3iG/LXvxzjtP0QJ1fTLyxfGzZOg1r6Cz" +
+	"D3ERovyx+m9j4ysAhSEU4f6+0mxzs22y6eVphJvrfITdoW/rA22KkiXLzw5VrQ/AVOekMlR+jf1b/j35Lfbv+bh7fVv7/+dy" +
+	"panmP5RrfyLNBQ2X6pqAaJkFbPwGINN/KbPOosz90XnrA23qHp23PgDTnjYo82yAA67/U+Zk/wsfdZZk/4EPe019O7KGuXjG" +
+	"iozO77fY3NMIeTOLbD1nU0uWj8DZmoBereQ7HZ+/X/z5s+PHSSU+8Fln8j2s+bdcMbGCvNql5p9/xT8rsM/HdyFT/PPqrL3D" +
+	"cIYJ1afrI9Nlht4apVqgwUFiwMxt+ew6HBHDdh+vXI6h8/cJ4AtFDxQTK8S3vrxL9pDmFciRI3jdvlopHtdOWjnIucZZhQKl" +
+	"yeXbVeLX9erFne9JxxesEMVJeKoYM+J4+y/AsJbk46vf9CT7Ye+PSUpTBXk1t+afO+TobfJdxenbsObUXeggXvh3xce9cYaO" +
+	"lZUsl6VHzVx1cJZlsSnVIKht1CKeF47/DaPFxZPxffuHI48Xdd3V7jEuIgvWLD3R8melqUIc+85dcp18JJX02WjBizysEI+3" +
+	"gsndtlfR94D4X8cuPkO4zvx0ZEgeMHAGLmN+OjLEbRQHrcQKxWZukcrUeMtF5MJUNt7Cb5LSHbsUm+vT4DdJNBwXxW3Pw3Fc" +
+	"lHD4TRQHAXrBYVeeFS4jo2TlZjZIR2XPc+bKfbS5LJN6Rr/nl9lj417m03XViGEZIT785NoF3iHpQ0evoj5dj1b22zNpds3s" +
+	"Kc86da3zcxog+b6zGpzUwzF1oSuXbBCaXL9uHxy3RfIS+yk5sucAdJK8yA7fmsi9yg8UPcg3/Vs9+RyqYCEM+/hJTt8L7r5E" +
+	"aRSl0HB376zsdZybh1byr7uHVtz/8GFxneyj9Ta2Sq5EVxB+heyj1eGQeFdeU5kavyS1B1zluyIswytQMAr77x5xqYncf/WK" +
+	"y02oufAcz7jKXjU1TFeawG8R7kv3Dc/1RKumV1v/wRutPfRZf7TCpKvyHnU+KZN90v73egn//9Zre02Ne/8Iqbuh1rnME80m" +
+	"dxbgfT69diE/qfaz1J5yP9m0uazcjYNePFyZcqVvaqsnVf327HUWmvpU35fdSRoks5GGXe/ya2nfp7EO/DJ7bNqzkzngZX2f" +
+	"OuD1DkkfNoo54D0qjH9e56/f9+lbRYOLui91Ji2XbICB169zr8VvkSlC7p79ZJdU4vAtFvsgGI4fS32frCeTyMsN9X0I5d29" +
+	"6GqdxcmedMHT3ZOuuO7wYTzA5Uv3tvil3PvBvlLypUvfWbn38f/soVijNVj/u5ficTUXnuOoWPEa7QtP9fHnegamI+o/egfm" +
+	"frKHNuAhGKqkfi7Z4+0Vrjrvrp3vD4U9tQ/5Juzjy9/nb5BFNWRRjXCVrU+pjnN8MG9CWhSMzMzemJqHytdxdG2nF12C7HU1" +
+	"BGSfq2bcYvO0oUOHdl+2/PMN9I+eS5fD+KLOq/v0Thha1Onlvr3jef2giRMnLXz/hw0bBkycOHHR+9c2bPjZNnToa0NLJ1qH" +
+	"Dl09tITxPABSuJ+5jdKdOFgFNWjxINEBUzHgKeRjaHexBnLJ/otUjhMQ4vfwxxFPT3gt9Ogwgvq6n4gDcbB9DX6J7CcH8Ou4" +
+	"uXhZ7HcPltGDXin+ElfN4hIpWVwoFnUJn7gmB1q6xn/4WIqv9NgVqwk3Y7gWOSbYiRnX5ChKEPbYGfLL4YB+jt/4t7i+KnOT" +
+	"ZQjZTSqlfhhCeExdGj/UlWZmaTkSDjeV8YUcu/E8RRUdRxHJSWxSoD+zQc9crqnknzAxM3Mi/bSNXa2KaTluYmbLcROLW/Zo" +
+	"uwy8lrQpbbsEOXqlb9SkduywmPy7pG2XDovJ3y9S2dL3gGcUfkhg+rtRbdiA40RyRuF3/cknNH06+QvvlNKpnYPRineSM6LC" +
+	"7/GPipzrT+WnFpLaDZAokr+UYU8+uf50frosGfBO8awIieSv64ochPFCR19hEn5LPs+nkaDoXdLC6urzOBA+FLfhYZcvS3FM" +
+	"+3KFLjytwSpY1EY8QfwZx1ZX47cuX75LF3qMF8IZYZLgK+NZDdoIGvimBw4Uz1VXw5nLl+/iMkYPznCFLjytwUrxGL0HEj0/" +
+	"igfoGIQIBdxSxj8zDRDy7Z3Pc0vvkZl4BHtt8xt3VYiWLBA04QLW8BG+4MvxEVgT/ha0g7YTJpADZP8E8vuuXXAH7rz7LtHB" +
+	"6/PmwQgYMWcOWSX+iBvjlA8/FB+Jpw7SMapA6xBSaBS+yIyiUDJqiQpRV4Ska8VQXRDQZ+uhOpUiCrTsni/UlAlGrZ8i1KRK" +
+	"TuesRrP8WNxPZzFY5HfxWrXWeWtpNBidN4JWPOL1Wfv3AxxYtx9g//5Zr9OvxLF/3QHiYF/XrXu9e07L7t1b4hGv53Tv0dL5" +
+	"EVe9TqE53bnepyD41Knl7767/NQpcuPUu1+SG86vEPzluzvfbT1gQOs2/QcsGzCgtVj0buv+/dvIn9ZPy9flM/k8twtC8Agy" +
+	"U5qzNnAD8W/OPkTP5/CvZOPvuK3gS0rxuxLOJG4grq7rh0b1ZNz2d8EXsNgFdrB+SvBvsIOmG5glL/5VPPA7Ifj/R9x/wEdZ" +
+	"bI/j8Jx52m6yu9ln25OeLdnd9LI1Cal0EhIggdB7770aOqIiIqJ0EKSLiIBgL+hV7GIBBRX1IvZyvfdakGSH9zPPs5sCWO69" +
+	"v+//DezTZubMmTNn2pkz5zxI+sjpCcHPK+FeGY/5MOzr0KPwQKhObodHCGILhHGy3fY8MeCPD5+Al00MUO8RvFmEo4z+9TND" +
+	"Z1cWFRXp9APNmCD219F+HA2vT+hd3qO60mUJjS5OuaJCgNKICR8RVsr7SXkiL0S1GCuQ68YYCOaJ+AgDMW+cGTKnsl1hO604" +
+	"0IRBWHnl6mh/6Gfii4DEW4tTeEz76dXwmHCS3RLZoaU+Ar0ggNPmT8dmG/MlmbwcWxZhy3IymWwL3Y5n4254TmgVM4+U34V7" +
+	"hR66C056ZHf9CKPVCDXDQkZlO9RGtfRX49n4BI4K3R6qCv3CPkMBsFua6n9kDv74I+Jouqgc3iT3v9TjiFm2R6/GolcN6UC3" +
+	"HBmvGlbzn+OnQx1+e2oSTgxdYgubDsIDpA9TT/rg20OXcOIi+pk3Nf3S9As3orEn7of7EVcoAz7A74UOhA7gejxAyUs4yZsQ" +
+	"I++T6ZBR3oW1cS15AfWCJZy8/Lrg+23y9Xldnkwz403HWmdBXOwRmgmlQyaahJD6N9lXh4QcKBe1Q11QHRqKJqK5aDm6E21F" +
+	"+9HD6Fn0GnoffYb+gRpBACOkQCYEoQP0gIEwFmbCYrgdNsJueAiehJfgXbgEP0ITVlF+9phFk8Nm94s+STR5bR6/6HPY7Eaz" +
+	"KRPsJeBrR62JtDw7Wz1zvxPn977/lWfud/KS/g+e3a2ehf+DZ/f/Mf6/RzeBVqny5qAX7+9VJieH2jxJYHKAV7h66tRleGlF" +
+	"B+FqRcVl6PC1rb3d3t72iq2j1drRBum2DjZbBxuZodxfUm5wR5vXyM3e3mZrbw/fYIztot1+0fab+kaRTrWJ+5KdZmo/peT9" +
+	"knKz3jAP5XYq8tY6wSkFiu2vpIvgQhO0vQHYFtnof5vtY5fVZrtZiRsmyJWudjuz3Wbjzl5o/PzCBTbhwoXGzysr2YTKpsRu" +
+	"Tmc3l7OSGm15VL7+p/8f/T+Of/y/wkr5/6GTfOx0rrc6uzlDXVt9v0e50REMIfWutn2w3B0KDjV41VhUA0OfQL3rt4RQR/wU" +
+	"//nOSbQjnNQ4EB6Ag6Se9Pn7QvphIU5kopioY7Q7ZI8QF3zQ2DPcQe4LHcT9EIduudqZ+xvXhLiwxq2EkKysAIy80c8EQRAl" +
+	"OhCJjh9wEAdDr4ReGYvvpQaMHD447PWSWh+pHTsW/GQ9TMTnYCJZ3/QFMwgeJHVNe0gdVzGSfDeSDKJXfCV0jsom1l49j5ey" +
+	"w/Q8UyPLKraQevYsu0jPM/Xye4+rn3P/lsOHyO/9r37B7mXn63mmszxXkEgOn4JepSMbR9c/stqo3wbv4LvhiS8bT30JT34O" +
+	"D31PesFD30fGwu+4f8q6lAhsdIcHwt7R6JkX/gnSFKoK3Y6j8AmYemwZdUuy7Bk8hzkoD4uK26+pm0OJkbE1BSE+g3tLphky" +
+	"ylo4yv/10BXfCV3JY6HppAMsY3hYRhY2NeKvQqvwHDrS4q9CsaFYWZcq1MS4hcnKOs6vNxqMXsxgEeaTK2sPrP9ix7YZoaZv" +
+	"mBlwEecBQzaHPiXTmtYq6WCBsDLiW2Qm8OSKsPKXOTJMYmLcwkoZppmCdLgYLPph/oxlj39x+7G1wspvmh4gvUk8Gfoj7gBn" +
+	"mKXfoGtx8esZzHgNRoNoxtYZ23Z8uX7/WorJNFiLrTCWhEJvkeSmNd8gBNgqp1uJWGVtkce4FFzUqxC6Fh+bWc9gwUEBYyvw" +
+	"a4+t+vKJpTMoRotJVuiZf8J98AUcYvq0xoeL6OpQquCHSV2oST2dXEDoL9KBi/jngvnwYKhaWPnrUXJBPQNdm4ccB1tJHX44" +
+	"1KSqAccvd6L/pHzNeNKSharhQWHlL2vAIcPwhb5h3xImy9YJ5P3udiDKC23GQ6ZOeenX0CBXcugbYfbPd6wmP+Y576NwNxKB" +
+	"8Qrz5bmqpdmvRC6IrQ4C4oVe90Ay0nc6GHy1cCQZmIqfs5asI/8IdcaHn26oYAESOs1+AR8KdSU/bypGCi7ExL4lzJdxEeWT" +
+	"qKKs5ioynmTXoNCvL0yfKsy/fN99zjzy4+rbfhP6yvj/JOPCKHIgxkNI6Cf1zXL5N4a+YbzhslEMMymGdCsf+5Lcg0KXX5o8" +
+	"NfSNsPA+Zx7oV9/x8+XbZRwE9q1w2Vxul6w/JmMihFUC9MEA/jqQOpCMLHolGDztG0kGuvFztuJNEI0fC/V+YXYn8jmwFQ1P" +
+	"h2rxEyCuK1HqYiMxyXhq5VWoiR58VciFfWTqtBcuhwa5k4T5l+//7bbVoFfoTNcE8hyeUeoXjqKrBKlX/rIg4puXO4tUspd9" +
+	"xSmuaHPK2gWC5McpVLYMJ+C1piUMPh3q8fTTQxTXvLh34+HVTFHo0NB33hmq9Bf7EeLd7Ath/V3F3xLvurIUP4tPXlnKLcY7" +
+	"cWpjMVxGiG3TH6egzLa9MTI1u9+UT5RR2SbWl4InGfT0/Mi1PTTZkJdfV/diXV1+XuRhY8P7Te81NLzX9P7v9djMyx8MHhQI" +
+	"BAKDBkceyEGI/WHVqh/IVz+sWqXIlzm0hbuZWaPndbfJMqau5Ff2VTwAacOWWnwuWZoUPgJnauuyD0TBH/SKzE+FtyUnJiZ3" +
+	"7Jic+F6/Abv29OvXb8CuXQP6NQUff/q1g9uXlJdv31FRUVFx77tTp02b/vZb06ZPnxa6A+/z7cxvelqm7dWJVz9jz/ImJMlS" +
+	"WLo/ZdBjqitmtomcmyq2BiXO4AnoXXZeDxc67Br/xI8/PjF+VwfyDPmXDXKh/fcp5KeppydPPj2VeWrEYPJo6Ap5dPCIXezL" +
+	"w5ZOeK6259KBjUcPQPmB+8nzlM/mkBz2KHoVCfLYIx8qhUp895nGU+9+Dg+FRuFtyp7QpKsXuQe5x5BW2U0DWVtS9FHPe6wB" +
+	"7Cw265HXg0QfTgWvQg6v2cY9SOaQr8g3ZO4J6LD/AehwPDRkPfnkPhj26xUYdh/5lNhefeuzf70aGovfIK+Q7nAcgs/DuOee" +
+	"I1vg/UPkmQfJt2QBrATzQ1B+qGkztmSeyg1ZX74GH98f48PIxsKuNxYn/Cl2d8DYUA1UZji7FOfPHz94a9X+Z3vc9Fcwveud" +
+	"U+B2dnQGK7I6/GZN2HxLzcpJ19Ox4x/jbZR1uWnd+yOGyzPBrzy0KciflmOqNOb8K53v6DNsflHv2t4DZszp2alje/+k+j63" +
+	"VRx/auBfKFDoEX7x0DvXSwmf5VkD+V7/09k56blxzvfjjA0L+0yj7bx1uZJR+h+XLBq8jE12Ak8Vm/4U/QeffjoJ7IsWkUYy" +
+	"5y8gS/qTL7A/9Npbu3d/ch3NC/8YM6rWbbYHmmUpLaSnFP9zdg7iMWQRLkx+4Pl3H+mxqf/4hf7K2trKnIlD/wLex98ZpHpo" +
+	"6+YjyalflaR1Li3rHJemnB9sjb8blf8JtzcL03wukNVNeaG0tXDI4PVY/pzzaQ+bn58PqO/QrEFjeDyyaN3xl/c/+srXr5fN" +
+	"/SsMM7u8W7dy+oO3zmbEjW0H1gdXbXr8t08+h2jTtXVi/9M6kTXu/5T4KvwK2UH+CV3IsL+A4hdbmv6F30OIu4a+Zajqr1PY" +
+	"Sc30h9lFiLRXvy8QjERw2P+8eS4LFBYE/cEg+dAy8NGDBx4dZLGOfub4I8+OOZ6TnZmTk5md81co3r2oXbvCwnbtioYL0+pu" +
+	"Wrr0prppgrph8Jq7714zuOHJ7Bzqcy37On7y/Rk/gdICgrrWAkE6Ihkj5TOb/pyfSPv6ITmDxvDMyMK7jp/a99ir37xePveb" +
+	"PI+HctlfKF3j2bPpsWPage3BVZufuPLJJfKzEb6u6Nq1ffuuXSuurUMX8qDSvzxGQbN02hMIRurvr7SR0FPLVt61dRmpxz86" +
+	"nC6Hw+V0HFk6d+5S+vsrg8QG+NR1LCM04TjsuXvy5Ls3TJlyz5tffPHmm19+ieDqD3gCO46xIAYhuqYzsAvwhEOH5Lnh1R+w" +
+	"iR3HyL41GdEm0jAT9CMHEAK4SBCeKSxV5oBeES6uJUi99JfFNGwLQfjJSJhNhC1gDQcyKIAnsFY5v+Yzrrbwj4kHPnSr8qNI" +
+	"0D+5Xw1gE2tlJjWnaeU7uE0aGbnIDzFwkUzGMwVjSzqvyIR/cHEt/SOT8YTQBia55YoQA1vIZPxk63Q2sflHy/Jp+Hd9Yvaa" +
+	"8tG9Eb/X7G31C9w/6f5JBycdjJRQLiN7TRljFO99rf81p2tTSqU6EAsXyRQ8UzC0PafEXF/itWu5abCLDLlM6JU9RK+hg/Qq" +
+	"jwGwhUzBT7aF06r011LgU7D+LjiZR2Te4iJno+EiXkmiCOIshxo3yDwWjjOp1ZozEmcSOdD4HQrz0zVwtuA3SW0bOFuugxOJ" +
+	"0wzn6g+UZ5vh+GleRINXEMROOHTlG9QqTht8wnFM0O/KPc1xnmwDZwvpjV+/Ds6TbfEJx2mGAxdp2xOWKnGowjVcXAu/4Amc" +
+	"1Lj+kBxuahMuKuEmbnLjt+RAuNytYVChDq0fOBwBEo5jahNHDMdpAST3A3J75uR+QPSKBvhpLZ5wiJ145etwX9AcLjaHm6Af" +
+	"a75ydzj9ky3pbaIBDoE1AkBJ/2RL+kh4BEALLwhLkRChR7jElB3USxvXK5V9fTyxbbxvlQoP84SwVPE07xBtYYhdaeFpXFYh" +
+	"UuOGa+N6I7lv6UrBypzGTlSitoorRGgeoSipbYXojfIX2+YfrgCZPW+Ig9gGh0msORz9ehzEa3AIx1P4uTmeXLOUo+GntTK7" +
+	"shNpl31dPLFtPLmWflkcifdkBEebXNMOJeYhsHYNw7zydeOGG8WlZaLtAH5aG4nauJ62mJa4QgtcOeYhsIZj3ih/8fr8wxwV" +
+	"pun18a/BQY7e+O2Ve67DQbwGhwgR5HhyfxTB1az0EuwChakOhfk0HG9Sc97mSEy8QmFWCrP+UOOG6+L6wxSQa0DpD9kJh+pb" +
+	"6lSO2xrPlvzl8aHxu9/BcwvpzS4gtX8BT7m/UvjpT/GUqaT0t3+CZ0v+rfAM92FCpO+xmWnvQrtCmUMOReYiSj0q/Y/NHIlF" +
+	"opRupHH9oXqEromrwBNtfiVuaIEC81tyoG1coRmuGM47zBkt/WNrHG0yjoeUrjaM5HV5K2U2N8ckta0RvQ5PWxhPOW7I0xrR" +
+	"6/Bs7kHhcBimjGiY5sLSZmsSYXriGWuJJkzTxg1sK7q2jm+LUDbS9lvYVMa6Nb80p4lQ+Jo0Eewbv7tBGvHGaZpL0vjd9WnC" +
+	"VLc1t3Y43JLRH+IW5mYln9prkvxBPuG2D4evS9SSRttqPmqmWyqtsKvt2op29Y3fXZfOEW4/4XGB9G5VLFN9KwLeID95TdFS" +
+	"sq4tLZWSsP7GePqpKpK3Vc/WtYUk9S2kv1F9tfQzrZFsVWG/X8dtaP+HdSz+Du1bM+CN60u8cX39YT7i7+SjJMJt5vLyWSWv" +
+	"32YOwDOT4Vll9o5wm3l7M2Q8HJ5tssAzLXN12UbhRXISz+Sfi8RtNS9nJjF9mh5o7M30bpL7EQxbyEn8ZEvctnPvNtHx1R/g" +
+	"GXYc87wSl67iQFnJNa5kFzCWQ4fIAbrIwFBLEMMISyMwlXUF1NJ1kLw8i6zflDn58+E+3hwZt+g4pDRU7vlDhw41tadxa8Nj" +
+	"jLrF/pFDhFrGQrasXRueobMTGjeE85fhcuFaMDe3zlrGElpAOWSLDPzKN+wEBX54Pv58q/HGH+k1lHk588yhQ4eulIbjMgxj" +
+	"ifSnyqFGA2Nau5bOZClQiosi/1bituCicJHfJuIZoQbGRHqSbfCTjP+VUuYZZY54EZ6J9NfQfEAyPPOEX+AZ7m9NFcp6DqAW" +
+	"T2gdV5lRyrSGMcrkPDJoYKgNw+Uiq1SbWbniGWthTNM3a/FKxsJObKo4RA5wf7vytdxvwzPyfE0pa/jYpoEShTY1ShPm2Ssl" +
+	"kTFpAsM0xw1jY4BRCl0oHsosHym8xDARXJTYEIY9ai2l+FrGcoh5tnE9OXCIk66UNPNLpG15W3FMmDLKvFymTVP7CB/IfBjd" +
+	"mjo2MUwfygUKP0b6W6Y5DYfUKKY1lZSUEUrJPBQm1pVvKJAwJ7We40a35iVvMzfJc11GId0viyM8Io+50a1nsLTNkG0y+Zqn" +
+	"pkrTQUxzGkUvIExBpjmtTHXKWmsjdLzyjcxeCgAcnjM93ypPShfapsMzvFADu0BBs6k9Zzl0qNU8T6kxczPnswvIFqWOw42Q" +
+	"nRDBMdxfqMM4mluPlQZ2QdM3Mo4KMQ8dCuOokDLc30TqL9zfKLM22ufI6SgHQr9DzLNNFYfazJ+iW/MgnRmNCjeJMB8qbYJp" +
+	"lUcLjq3TyTkpaZvzoumb8wvzi7FtXbfwZnOdt/BouO4jjHotnNZ4N9M4jEOE0M2FaCE42wyD8oQo27uSISj9TetS4RlyF6j0" +
+	"PZoIaCTTXa4BztKmmK1kLDK3+Axh68EiXNy8mep496R9/rS9AIcWNVA9iGYZiBJf0ei30Pi1PanNkU1baPxFDYfIgaa1reUC" +
+	"Sr155G10E+8wQG3P2rVbNuEJhxY1MNOb7jxAUBuZBoVvsysn6S1eGzuOHNq4dW1tT+iNJ5ADNEXDIgr/IWJi9wmTZRkjeKEn" +
+	"W7+z8SAxqfv/sh9dEw4O8LL7djYe3EnDW4XRvBxqkLV8wAvsvp0klbh2Uvu9O+ED+Ghn6CwxsagJsYfYB5sQixrrmmUema1k" +
+	"n7i4WfYJF/HJa+Sb+CT7XCM15IAzWmSmtGXiDFwaThiWi+KTbeSi7MnGciU/I86MyFM5keZngn5ADsgympPXykzDGdL8muWw" +
+	"NEzOTxHEhmWt+GTrdOEMW+S3QniMoKsLXIOzQmewvEirbyWrbT0j2NJVXhrL2VPM0TWwwq0oAkteHF4HyxFemXcFaxtgV69e" +
+	"/QGf5LYJS/SCbhBC7MlIO+O2XS1rljVzhwmi1mOaw9CENmGKrDYcBuvbhDHrmyY2h+GTbcKa64KGMd+1CeO+u2JqDuOkNmGC" +
+	"dPnr5jC+fZswVftfn2kOE5a2CQvPqWgY+0WrMPZy27C81mHt24QxN7UKY1a3CcMvtgrD77UNi2kdZm0TBv1bhcHENmFodyTM" +
+	"KxpORIKuXsUvEMRtFZbqBd0ouqGFENIjREzsebl9Cs2acIp/TqrFnytb8mwn2z/xe+n5PPO1d+NffMcvHGtadYSZe7Rp1VFs" +
+	"otejLR+ufeTXXrhw4QJ59eOPP/748hP0GQLyNShf/fQ7BOWr/J2WJY2WhVslTPrTsnRAXVAV6oHqUF80EA1FI9FYNBFNRTPR" +
+	"XHQTWoyWo1vQ7ehOdDfaiLZSH5FGh98rtdoDsHnNDmerH+dVzJKLZodf8pod+dfcg36vmWn1y6db2q1+/2v4kSNHjh09euzo" +
+	"0cZzx47ByKNH5d+IY8fo+xEaeizyO3b0mr9jyt/vfD7GL/oh/Pej8vePH3/8B7H9Q/n7MXyHT/7xD/hYuf/Y/P0vf0VR4bpr" +
+	"y4d+VKhYlua8fofT63dE7pFnEL1+R6ufvMITHX6qL+Awg9nrD4Zj4hcUwoTaKYSJPJN7j8h/R7HxGDYdOxr6jgYfVQIfuTDg" +
+	"woUL/T7u//HHH1/pBoELcOfHcCe9Xrjwy37Kd/x3Fy4M+Pjjj5HSL/Ltaf+hF3T9EVK1p9/Yz5vb3Fj2MmqZx55q1a/h98P9" +
+	"WriNbsMn9YJuitJGlf4Wn5L72ylt+1thSYsOHrftSi1+n4JSL4n000ocvVuB9bvpaXKR2xZKD6ULSxrLG8vVS66Nw8h5GLjD" +
+	"obRQhrDklyUyGIpv81gwBb/Hnrw+X/akntdNuQ6eIgHD7+Fz8uBy8pclMm3Q/qsM7+aclDaSaBP3c4ubeOtvnyr6ZM1hihaS" +
+	"jUIBHXussWYst3gVV3yl9rq40bLPi5YDCmZG1KeDNQ7oFXRvMNKbbzZ9/cZY8DES+MjrTV+T1wdcusTMunSpaTUzi57fb1pN" +
+	"DtJyRWDqXbr5MjWXoV/kc0+t8Yrwroy9Mzxxbf1vP7f4ylcU5Wt/cjlnHJlxTPn9IVyv6IBrfvu5xYTcey/5bedO0hi579gh" +
+	"Q53FFVx5ue0Pofgbwv+r/aXcJiM/aNsMHeINvv2n7/I3OlsSd+4E4d57gdu5s+mf25v/gNkZ/qOhyh9wyhf6bVvzX+NybvGt" +
+	"h+kf89FhknOMZB0hWcdITptX/NifP5Ocw+RjmcNUrWjHy75iTfIJ2BSlfsDsZczgBTNQZeugH7wQ/kl+uf5few0GXww9h9++" +
+	"2FTNdprc+OQ65uFQ7l24nOxa97pcZdD7Il7cRBayiU1NnwBXvLixPSxmn2681MRYQ0ubYPnFiwj9GR5Or5+j2UrKzwF+CP+C" +
+	"Mr+8+Xro5EUY0lR9Eb/dWDNZxoR5+GIoF5ddJLtfe01hyYsXYXlTaCkwtqbGS8A+s7ixw31sYtOn9+HFZOF9FI1WvER196n1" +
+	"Ckn2Lp2K0lA2ym/uyTs389EgNByNRhPkUVe2h+gQpaBbkJ8YKehm2rxBy89/7Qf5x3iV6P5rQrxKmpY3TvL63fJMW5c9LW9y" +
+	"9vTp2ZAzfXruiq+mT5v+OX3F982Ynrf86+nTp5P3pk+jlxkzmNxp2ZO/njp9KjkzbfpU8t60adPIe/TpzPRp076YkjstmzVN" +
+	"z84h78mpZkydTt6fPmMGOZt7c850PHtaTg55XwaVuyJvOuDcKTmNZm4x681v8IUueRYtyoceixb5yNVFDYuaXs9ftDAff0ff" +
+	"QwsXLiInFi1cSB5ZtGgRfNeQv5iEGhY1kOMLFzWQRxoaGsgJ+bVh4UKy1NeQj79f5NmcT44vWkTTNdDLokXkuM+3CJIX5W/O" +
+	"JycW0o8+36JFvsX5YV4GtB9Z2CKW6gygIO1zmbstTFMTG9bhjYQJ1NtNpM/FL4bajWXuvpWJbZoTjkvhbOHdzBrEKDGpqi+3" +
+	"eAv+SYETCQvLQ2n/zZ1srOFiaSzQYH3oxxn4J2UM4JkenL15DMAHrvCJv10K9+uRsDZjAD3dMRYfuJUruFIfjgsRXV0FH7Cx" +
+	"9sbPYCSbKGOEEG4VTnEy2hjOBjbmXz83Hf2FWaLEbYMXd5XhbuacCCMkqYGjaszhwQm3CuMQkmxqZgdbFfogVEEjrWJfvfJC" +
+	"mNYcsjCbWAOFEVQDB6/CK2Fi41ZhHEJBCgMPJW+TAI10K97X9JUcsS0eInDyENkUPqPRFg+qQA2T2KrGE2O5xbV4DB5zZVUk" +
+	"bltcRODkaic4QpvWuChw8NDQfWOZu5+DOqhreigSV4bD3dwCh1vcFg538zVw2NjGr8Zyi6+H01Iuo43CCZVzqeHxvE25jGpw" +
+	"2mAHdmJXYwKNxh5rDLCvzvjt0wg+kXJROMzdJMgawvzcplxhOJAHuSGBRmN2hgbgfTPC/NwaHxA5bjE2Mfob4iPb+nyXrbry" +
+	"JY0U2sK+HtoSjnoNPiByzN3YBOSG+Chw8JCmMzQSeRDvIw+Go7alcwSf1nBa6By2VsqzsQpCbQC1lIueIVFjmY9D5bRsTT82" +
+	"/diqbMq+iZpfgwOyen1FJGboVjwPzwvdGk6BENMmDbW4Gg3JsA/2kQGhV69NDe+TdJIO718DhUGjyFXuEpcu6+alI5SqV7Tm" +
+	"9bISPdJzVKc+Ve+kBhuQ3knDWD1Hw2Dg5zNmzJxx6dKMmTNmkDWQuWH9hvWQSc6u37B+A5kEwx46cuQIDCM7jxw58tA/cOW6" +
+	"ysp1oUfWVVYygRA+OWzYSUxODhvWeJVZcXH69ItNDRenT5dpShzMKn62Qge1AC+RfTAABpK98DwpJaXc0qbTTS8xhayz8cPG" +
+	"d1nFF2nrMiDDH+D85h9jydz9+5jRcX/MVeAucm6kRkaUhNwoFwVRGepC7VdJFkmg5j44avpDohZTjPQ1SAOc9JW64gkaLdTc" +
+	"u90NAZ87aDEJ4KKm6D0S8CZJcPmCUOj2p3uzynwd8ph5nvTc7AH+Um/5arc/3ZNZ7u2Q13SbN93vHpDVxVd2tuPtHR31D9bj" +
+	"X3S6kQZD6EL4PaSJiRlpMNxZnO+yTkjLK6mcbXVlVZTUFOWlse7WH/OLSzu1z0u7csZimZKQgId229nNPfDVgUyl/B66L/xO" +
+	"59ot9KVzVac8S0WBNpQ1UruaEjW1KfB6CURaBaLLYZdEWm7R5XZJYkA/GoYdeShC74eORJmkonbtiqSbtmyByWnutLS0NPeF" +
+	"doWxZnNs4euba1vRn32x8ZmYGKfE/iI5YyYfDX10lB145S1B4PKE1MYoyRmjZ8v1Mc5dTQ1HsV3WDWzL10Eqd1HOh+hl10j4" +
+	"L/M5rh/r8/q8Y8d6fV7f2Xt79OjRY8cOeiVd/5ib8CZymZ6TBVV7m41MhQ2jvd7RZMJorxc/9Ed8BmjUVZa7xFlpmzboDalW" +
+	"2QyONXX0U9AF5kPnp58mT5BbyONQAbtg4jvvkPVkCFn/zjtKW2hOa2ybmguX71oomIkU4Vp4wxUM0R/DBbvAUvJdD3f9BnKW" +
+	"kuhauOyFYScpAa6Ba7oGrlIZLHMtYLY/ObN+/fr15CxcCxmXwmJKWLL0j2EzBqW+jdfCrgPIXL9+wz2QeQPYZCmFDYuvgR17" +
+	"Y3p4gqmi7feJQokeOvh7pAln0yYf8fp8fi8DXH8jwGQp5S1ylUnl0pEQbsGi3T1a5tuH5Pb223thHtxPrjJjlXiINgTRIzFj" +
+	"yE4akXmP8iqfMV2ZG37HjeHT5DUQQibq5MDrKcXU40EO2HmBl+f9f8vPypndvsOcgfl5OTmzV8zOyybH2WeZjvcs93oDgTHL" +
+	"K7st9XkCfq+nycUX//YCYtB+gvg+fJrcflPouNS6TbZtr3IOaX/cGMmt3OH1cntT6MuC3N4uym3vt66qtF/Phc/PYZOcr2yn" +
+	"X7YOEuZDMR3COOznDktKB0Z2cubG3jTn9RsgE5tYU+N38DyFS0ph0XPDhj2nwKRlwaYITG+EPbwi2JQCpe6PjXSN0pV32UPk" +
+	"LAVJzlL1ClJKAcLzjd+xJrJMAQqIwyb8Ipcu26aneJkpihwFQlsGNlE05oTrvHVcj8QqReKOPATDxlNqcekyvotPDqNxyVX8" +
+	"IjbJcf0yknqO5yhhx5OdD5GrcyhrUpTaxgW7O1UpGH6B0mUCbUfkKsWbNpvwXAqbuJu5dCSGcW7GpRn3ozJOZ9ZvaClEBLdI" +
+	"WchV7mZsQgaEjHktlAyTkZPz3bAesii2Rykm2BRptwohr5nLy3O6iLjrd+b5NwyzKWHXzaEpPHkGjUENdzF3scOQCaXfsG3o" +
+	"wEcdcIQ9g+jko4QuqHSnOgf7/UO7uV2u1CHjh7icY3Jz8mYvn5Odm5c/aE779rNzshn/3HHp6ZmZteOKi8ZmpGVmpKd9f88y" +
+	"j9cf8PiWdqtcPiYQ8Hqvl99JYe9T++n8HQ8I7VOEDVwx+sO4V77C/UIHfi8u3xw3LGZrFfFG8aBFHNcck7lGLtacO4SxnYVd" +
+	"oQ9Cs7Az9GEk0ZUXFFxa5sFRSCtbOo4c+rG7qAkZXFZTGKyunl5NhrUsD2FCaX19aWl9PalsXihev8bgaPIXsCuSatUfxJVs" +
+	"ohrvwM7rYtL5KRIO8mmKXNSgB4s1iPTgsgpIDwGrhPTAW93nYdNrsPE12ETGvUbGvxb6Cd7+Gt7+Ct4hOV+R3K/Z5MbvSOPy" +
+	"5cCxJuCWL7+ynT0AGRs2kPca+5H3NmxArWhI9T20yko9vFanlNSxxygJx8r16Qx9uIorptJniiTTDY++Pj00p5drQodXY1dj" +
+	"TXN6XB1Kj4h/Z+DRf5R/OL0T3yGnV2pzFX4fj25J/pfwnxn6sFX60fj9K7Wt8G89ViZfM1ryAsvYOEPzuOEWr5tOnb6pgXxn" +
+	"xtbQp2YwKV06OdvQcN1Q+oGuxzFyaAY5dGzYsOdw03M9dKFMhNrkH3tt/lwgMlqF0ZCuyx8HGhpojnLP/50Z3xKabwZTw03X" +
+	"5j9E14PmOmxYGIkeOjwS/Vn+WE+ztGFlwAwEU4226+c+mQ034VvN5Du57EMaGsBkDn1ybfbMUyH2uR46fBZ6Hxs2rNGp63EM" +
+	"ev+l/OUZl03kBcA3on9deBw1mUPzbmpgFpvJdw0NN8x92LBj0Buf1fUIXYHex3roQttv0B6DQFknIvewsusoq9wgnmL1L7Ju" +
+	"Z481TmHXzbihfKm1HGUVu+5Ksxxq/9X5XB370LXyMebjJvtY9pCZrW0s5gJXXg3vtUTiKnI29tAt4TAK5wK7g626Vu7HZDS9" +
+	"N5a53INZ1cSyUxrXheFE4ipwmMuOcNh1fVhruUQrvNWyj4oPuLNIQBpkRHaUgTyoCFWgbgiBVxIFh1vkJLPgdkqCw+X2c54U" +
+	"aHW4HmySWbC5/c7wnfFaaGS/0xu5yRCYhE4c14mHq75PgqHKz4LM8XS4/FRPzaGyjO39rHuLQ2shn+fJaY5lICAI5FW+DM8f" +
+	"E/wk9CPj/zgIn8upubM82cXzMGRrGUPKfiDlLwXZE38f9vQoaX/ZoBNjE8j3fA8+8p98tWw+UxpiM4oZUsbOCadEgAZfbeTP" +
+	"4r/LZ1q9nhQIeKlhSksSULOdHmouw+0rA4lePFKAnWP623ijI39BymCx628XvfPTc5Oi1jpc3GPFmRmT8N+/qnckTM73SV3j" +
+	"R6sPu/Mn5bOs5u4BqsxRcv0MQIhP4M7Ku/heyey1Gi1J4HBRwxV2wWwS3C6HaIoBey74g17JbGkHnjIQ7QLvcPuDDpebeqJx" +
+	"+APBgM3P5Pl4DKubXGASrd60W0apkm69aWhCrQ1zbk5doPYYuGeq9RqeZ4uPlvRPNjC+B9+HOO4sv5DE12tVMTmxo70xuH1J" +
+	"qG50LB6WGM/zmtGFGXhPSSGv8ZYnlBjw2g8+WYji0earV7mPhIlIjUQUh2yoBA1AK9FRdAFU4IMhsApOwKdYjf14KB6Lp+K5" +
+	"eDd+A//K2JhqhMAS9PKCy+21BF3uQNAi8UL4zoTv7uajuW6X2xJsPqEqWby8MRD0pICFOieVvffx1lTZOamLerEBye3yemKo" +
+	"rxqP2RRDHaWYHPZckCwOuz9IOdLvK6MuSHxeD437F6Ny/wFY418H+7vYmv46tqYbIevzeszCX8GA+c9o8BejpsA1Ua8pVXPE" +
+	"oIzqXwMa/L38r6+BMBdx4bsUvivk/muE+Y/I/Rfxgv8o5l+lC7OhS5zEzZyuMjv3u4BxORjmNyfG7lSGKbJjxmEDeGTax/du" +
+	"+3TawIWFGe2m965dWJzmWWaUbp6bhSs7GiyjapZcJeuuoiV1EwyGjh1Dnzs50Ooxl8qwOiEzQ6VjmVQOYjRsKuY0qvQsXstB" +
+	"lI3DCZizsYyWT3Px0SyTwoBGw1oZRiM4XYKWYWJsHNbqgLcxXDTvdgpRPLZyoIumcbQqOc4gGw8JmLczrIbGiObBxoFWw1kZ" +
+	"NppC0bBNQREDnQYYAFSMxcyqgI5QHBYxwzFmCxYAv2zAoAfGiLGKlSysgLGR0WEDxgIjSawKh3QGllexIoMFzmRiecyIDKh4" +
+	"1oAxz5hMrIDrDCzEACMymGfMkRiCwIgYC5zRyAkYkIjlKMAIrMnICQzIQLCIQWBNZkaAUSLGMcAYAAuM2cwIGETM8zKirNHE" +
+	"cMzHRsyrgDEBK3CShRdYbMAUhBFjgbVIjIBrjbQkrElGXS6JiQEdNtJMpVhGhbfZMHamMAw5F67Zz+zKByixcqDV8lZKbJdT" +
+	"iOYYK4sVUmpVLregZZselunEGpkInRgjG6ETBU4aTAzoARsBCziW4gNGAB1jAqziLJSSzIsmJkzqcAxsxHIMRlBiNO2x01rH" +
+	"XOtaZ0EbzdowoxVcLpWOYW7jMjpxQoeMATgji2HSs1mcns0wGVnEgJ0ujJ2ue8fcfddbb91195g+VuzvOm7M6NFjxnX1JMP5" +
+	"abGzjUO+6hWrT+w8c+/uL77YvXdmVXyMpddXXULjbIUxMYU2VoxmzBZGE4NtQb0+aMMxGsZiZqJFeDvFp9X6Uhi9wJmMjFqH" +
+	"kz0xMZ5krFMzRhMn6KtSfDqdHM6YjawcrtPJ4azRzAh6SGj1wcSoY5ikfJ0uP4mJUTMmOcEWfSLPJ+pB4LE6GrMqrIsThDgd" +
+	"VrE4Wo15gXwmJkVFJYlMFAfaKIYXQEwWhGQRBJ6J0gIXdUCfKAiJeqwA4FQ4Jl6lio/BKk4BALFKDljFMlFRmBMgJk6liosB" +
+	"gcNRUQyrio1JEIQEmgCiomm70SeoVAkUI4iOAl64TwkHgQVNFGgg/KqBKA2wQtCQIggpBlBxWKPBnAoMFN2W9/Mt4dEazEUx" +
+	"+kS1OlHPRHFYE4154cqn4fq7iJ1uzMjP8h2WJlHSyaQympgoHZuQGxOTm8DqohhaFzF4rCFZpUo2QBRLQfMqLCap1UkiVvEU" +
+	"NBvV2Jw1Q7NWgyExKirRAGqaNcOpYJlIAYhYJYASQaS4iUoEEFShxc3Vy5qMTJSOCaOki2KMJlaQffnEoNkIcVcFKrm2IQ/q" +
+	"gHqj0WgOugVtQvejJ9Br6CP0PSJ0BiPwMcBnKj57y3BAsgi8ZEkBSxkEcsElGD2SJUmZAucCnZ78bx/cJeALBphrY8C1H4J/" +
+	"CvTPkzj/NAaO6hY0ZBvrp5py7RkBZ/SgxQXfDUnhNNqieTkDzUmliz+J1wqxUdG2aJA0G+mzWiM/N8TpeEmtTdGCpFkdJ3+3" +
+	"akDSYDmONkUraR5I0PJSFP0cq4ERreI/1Arm4XhdJFJ0aEurgF/iteEEsdHAtYCK/iaOBmisWojVYOvwh7oUx88Usgt6Dphf" +
+	"qFvIaJYsvs2iN1T19dy0Lq92CExI0cTwKQYhS8ekmFRQlKyJEZKNqhwNl2xSgS9FoxOSTUL4tSQSWcummFQh3JLWalSRphSN" +
+	"jrcahGwtm2JUYUEBJWRruGSzcKXNG7BWjY5PMajkuOpGTZts301ulavwZRisksurzZnQN4QAzb36KxfiXmn2RwpGNRjn4YT9" +
+	"B3AC6b6fVJKqfcxaXBh6iewIvUxF37gAIdwqnbF1yiCjBob6vbVdA6NkEfSEAbfBWFK1fAnpdi1IM6kBDfkCnodYeJR0Q2Ff" +
+	"qSxzC3dWtnppkUyCGoJGZxDoShECTK0Xq0JPw1M1djL6FWIS2+Feau4sDqRqQjeHfvqCFLiHYJ8kJZThlVEReIj7kDtPbV4b" +
+	"bR5zW4BUiOByi4Gz7PNFcaFGeKSnjQx7i6TqcuF4UyxUlAFw5+vsCaEXScJZUmKfhC367EQPfHKlqSNAB25goSyvoHl8xJ1D" +
+	"WhR3vd00To+ssjkaK4u5sOkZ2fod92HY9sycE9Bx/0HoeLwRwQi4DCN3kY/Im+TCLkYY0bnTyOxevWuz8HfkFVIFJ6itmfHP" +
+	"PUc2v0C+ogb/IfYotD98mDxztGby5Jr8AXl5AxQZyjSEuF+4t1EUsqAOCKEWe0bO1qaNlEWSjFRQ9mqeamVl3+KKL2fqDsTl" +
+	"99HymE1cys2DB3eoGNKkqRgypKLD4MHMYclUu2f2rD29uo2aTN4hPck7k6fX9Noze+beWlGcAneDDVLhbvh6bGW3sWO7VY4d" +
+	"W9lt3LhulWP7iJZBy5Y++ujSZYN6qWduPh4KHd88g+07iDQ9+iiwg8SYNY9chiFk1+WjrcryilyWCiqXDRvz8QScrez6gImi" +
+	"6ffRtZ7sC1svm2c0sXQJ6G790cI1yPjfPLjpEC0QfWEeJ5PJR+QTMnmKKNbunTl7T6+a6ZMhB45AzuRR3XrtmTV7T61JGsfe" +
+	"pRRhbKVcnLFjieZXshsG/3r8jhjDQMCPPELIwH7MjI3HGxuPb5qu7jVI+TbIIt5O29C0qyF2NHcKRcteSlpVBd3i1yOHHVH7" +
+	"RZJRwp2WnDixZMmJhx8Q9beR7194jnwH4gt9q/59OzP05bVrXnllzdqX2+mNm0lPchry4Qh4kuDd/mF6Af8h94psBzI/7D2c" +
+	"2uRHYTfiPlDMO2HZUJhE/eHJTwb5q9vlhsZJmzad27SR6f3Bxo2TJ20KiY6v9uz+6ou9+2ZifCru8737vvhy7+5ZUVH4g/t/" +
+	"vP/+H+/HA+R76FLvUSfIVYATjwIsYfBt3fo8CkCuPnqCXF0arUFIhxYhpFrAnUUSSkd+VIGqUX80Ck1DN6GV6C60Hd2PTiAE" +
+	"XlaymHm30xUMuG28ZBE43k3HJ7+XMmcc2KjJzRLwmpnWNrhaGXZyt3oWWz1jCy/wLrcrEAxAy6MFkkEH7hwIlgKbDILyHEgN" +
+	"WKRwJGfLYwBKQYpEav3MzlPhlC9/so8l63qkjqts0laNT+1J1o1x/PwFvrz0/FtX8Nvnlm05s37ju+9s2vAu9s3p03vOnN59" +
+	"5qxNtJiTksyWxMj9qVheFNO1GkEUYZmWN4hpOvqcTkotBq3LaDLxKidOdBt5VarJZORVoW/cLoE3mMxOFY87mFJVvNFkdPO8" +
+	"kXTWpouiSqdJF0UB+uvSRAOvlV+4s+LWPQd3Ra2O7zFfpZrfI3511K6DezaTOVWbqgqHkovEAt9A8tDCqk1VoQv7v/lm/77v" +
+	"vts7eMWKwUOXL//YYrdbYu320MxYmy1WsttxX1eONkYwmrJjtKGvjTkxWsFkzNZpeRiuSs8y80KGZDH/TZdhsZhVQrpk4XUm" +
+	"S2y6ijdbLD8JZoslXSVfyT0GQavLFU2CNiYH642CNibbZBRitDlIj5YhpFosjwtJyImy2/BOA7oV3YP2oZPoA4TA7zDTdi/a" +
+	"qHlX6kFVaLGLF7CE2wK+5s7oaD0GS+U6bf3MWyRLIBhwuV08R59LQX6MhAs6cLZ6hkhcgbe0fjZex7jOMFcHggGnxRNkJYsU" +
+	"5vcgnS1yVPqV6nSbHSzHC5agM+B2BZlbs3SPPsRpjzwieL9d/+bpe+5+6/QXe2fN2jvr/vSkpMj/f+uFaF2aKHKa6HQIioJG" +
+	"myaKvEaTRnJ5k9GUKvAGsykVa3iDyejUGEwGZ+gLIdVsMvBCqslownG8y2Ay8LzTaDKQiWJ6tE4QxTRdtAD9xDSNhhfFNK1G" +
+	"qJ7/6vNXxv3t1fnktbKJpf3JQzNLNZ98itXmTAtWffpJdMkMcrh/6cQyqXv/YBCcde3VZ8gXpHdmPqkjX5xRta8DZzDYv4p5" +
+	"vnxNeX7FP8lo2PrPivzyNeVdv9y954sv9uz+8sGljy1Z8hiYrDlWa07ohHzDnbTZRoNKG5NtFIXQVV22kfJHltEoQL4lXRCo" +
+	"Vy5BsJw1pwuCOStNJZgKJZMgpJstFkGVdr/ZIgjpFvlKZscIRmO2VicYjVnwhVZlMGZrY+gVrF22BYN9yc+y8mZ032BwW5fP" +
+	"9ZOGGPeqz7w0+nYRQLx99Etn1HuNQybpE9OmZ7luTT2xfdrSOIC4pdO2n0i91ZU1PY329XsR4t6S5eKyPwtJFGxukUrZH+CS" +
+	"BeHKRW4kO5I7K1wp4Hnu5XGNKuWQCYP2IMS9HU5nb5tSMPMCYngH78CZ4A96JK/sUe4gZ+P5K59wo9iRCzo6yQcFXMx4zcDH" +
+	"zOtureBzCvrf3pc7y18pEIRwNvfyFUWkM3m6XS5godvyfbMefnLeNfnGt82XU4OkBrMADnC41dAqv6YhMAOm1JO7YGotWcOS" +
+	"dWwvmELWtc1wJRkPGweS0WQMMxA2kkkDYQtslednq9BOppIrl315eiFg9AS9LRkB8p+ni9EQy7HV5EfQ9yD/wuSfTA0YyA9c" +
+	"OYlWwccxfQhLBKYOfiXR9fAb/CzIOnZUbt5BmCOPgfHIgbKQH5XS0Qe8dGVWAr7mB2fkQbouiLsuyPk/CuFhP5NQx/twPI6v" +
+	"473wFU6o43wfswl1nB++YuJ7c35Q47jevK+WoSGhL/4bmT0Pp6McuABGnVY5mOBnp1WpTODx06pUXADt3hRSmeD40/T67Wm1" +
+	"Axdu4aaRnt01gjbdMiBHi9sFQjsGmHF9rMTzUQO8Ttw/4OWicwrignq85PlT05Aa3YoQ9y13Xj4dHotyUSnqhKajuWglQsBL" +
+	"tkDQ57DxRotkC5QBLwSCAbfL4eYFKq2UHdt5Fc9mAb/PAV4TL5gskkP2cRYA1hkI2iwcXVaaTZwgmQSbqwSC/tRc8Ae8Fskl" +
+	"+rw2izkCKhPcQPMJUm/7gkO2/+v3JgPwOIbKDxKa9HEFnI65U6OqUPlqshIAkrwY8v2lad2SsgWfXRyeH2+ZFJqn6gcLWJU4" +
+	"IlnFjCG/QQnUpNQ13WvD/7o0SM+Tp6bjGOKKYciaLIYRuz93Io/5NSl/aU3K6LxiXt90B69m3otiyFOcWBjF4a5eTt0OH2/X" +
+	"y9fPwpI34g0Wy0uTV0J1u/5ifaI1d1l1GpR0LdKmZE3v3qcjk15wa9/pE4+uG53dc/JNV/4da9FvrOkbGxUVpS9JjYpRCXoe" +
+	"4z4GLRutNhHIzcOxI7duTrd0ZaEs3mCMg589ieUDp3TsXNA7Nac7wmgLAnm9Q/fikBqMgs1tVANjY4CpwDVV+MrLXA/ch3Ad" +
+	"2dnlRODOk7mNxxme7Q4rib9pKHMfVCnrpi3yuuktukZUg1GiYFCQKcNVNZjC6IVLDdxbZG7jswzDsx1hCY8A3YkQ9wV3BlHj" +
+	"IUzYcLZFVo80mwTGGZDk1YagowISZf/PzRgYhyEaIq+ugMsdXopITMrzHXrY7T1qevrqPJrahyG1YbI1pYzXz+0UF5XsLUy2" +
+	"Du34pHNp/X2pj3ceYk0u9KREx3aaI/Jl1pTJDYwKfOOmTiy3TJ48I8O5eBb5drTHrUlo395sLsyb1ceZkK6xjwELmUXehzS4" +
+	"g3wzxq5JT3D2mZVXaDZ1aJ+gcXvCPuwhmfuQ3YQKUWl4RcoLFqNXEhy8YHf7vJagJ0gbuGAx81wq4wvSzUuT5AkanBbBJASC" +
+	"HtruXQwv+V2cZZSh/Q7Dl/mxYjoTc1s/i0EX6KT5jmfmlMxXkXdD76e1yxGiR/fU5IHmBOesGxCs6NzZN1/3YRK7iZvZNy41" +
+	"K4H8WKk9rB7cOweqq/85uXz0L3oGRhWpmSzJPUBTDR+b+APukdUmV+90z1Cy965q5oRcl19yH7J3IA/dDVZM1waCXtoxyWvR" +
+	"dhB0RL7agjZlgeq1yY4gPRYztzG03h2Mk/RiPrMgQ0qMz47dBV+VFCRYDCbIw6EeroL4bAl/KMQkuqCXK/hlaWaCRxvFaHB8" +
+	"claCJzGqJCveo4vGUSVZCd54i5hSmtUWJ6OPZkR9RHoU67o2yaaoMinuxugTbd2SN/zVYdta4IRe7oQYAX8oZccH3aGeAPlG" +
+	"gxQXLPlyV2x2XIIlaz6bJ+ot8UFX6B7WkVWaIlrivQlZJVE4WueJzyqJSvQkZCXHYw0TpfUkZJbSut6JznDvsXcgG10xO43U" +
+	"QoNdsLnMJq9NsNnlm8NuNrUD+eaV9yVlMtKbze6WyedyByjtKOUYbkpFZjL5W745lFFVhY9Fk+eqqiBB9VJV1TaxoLIyrg6g" +
+	"rgOzuTdA7zeAfEHv0JEN9aTf8YdJ7aCXs6DDPxmz01uIq7p1r+rWo6pr96puSTBuLOjoxThuLMTAuLGdcFl208O0DCsQYj7m" +
+	"XkU2ank8yFGjET63GHDYzaJb9Mk3v89hTwL5Zpb3vsySX7mJlH1FqmttMYsWiS6cnMYd+JQt14/bt+/YqaJLh4pOHdrHw5BB" +
+	"oIXBg0E/dBDoYPDgElyQFuJhZmW+FUYELIy7ri6EtTCytpa8oP6kru6woayuLnEQwKAq9hC9XWKgHb2TDSwODQYY3HTGVkFW" +
+	"ZZRW4a1yu9uB3m1VFx6/LxPcXuVm8/h9Dpt8E2y0jnizUWAoE1EWknhB5h/KRl6LFOYbXmCGV1UV6rdXVr6igsTKSvKcBh+t" +
+	"qgplmPLIc0lZHVI7FrigV1Ey/oDWQqgnC51oLZDPmTfofSvbgd7+2a2qe9eqHt2qunerwoVeZ9O3yUxNdhnuNHYcxMDYcUYY" +
+	"Ow50MHY8yHVx9TfmY+55pQxGk9dTAkGzchNNXo9flG9ukdaRy8G5ndQaCC+IJiqUEqhsyi9P+Fxuv3Lb0b5Dp4oOXSo6dWzf" +
+	"HvtzbaGiBHwlrQCXDB4MOhg0VE/rRAuDhgB+uK6uxHS4ru6Cmpyqq4MR2hDU1TFuKQDDbXmV6VWlGWRVe2vTGVoJuIklm2gl" +
+	"QBG+RO8PsJX0hlASlb3wLHcO9UBL0K1oLdqBDqMT6Cn0BnoXfYA+RhfRF+gb9AP6F/oFXUEEMIiQBDZwQjpkQz74Fc+iVEQs" +
+	"mU2CN+APtAOPWVaWcPOCWQrSIYH6p8oFOgbwZj4T7H46CEhBv1Owu/2yAxJPCvip10+6cDdJXr+LwpEEySzQhVUSPR/oCwb8" +
+	"7qDfHbQkgSAFSkB272EVeM4i8G672ydzhd3tMnoDXingd/ldDrpSdPIOnuIhUTGR30VVVWj2FC+3n7F4A0FXUJ5ruGR9AwvN" +
+	"nTN7ysAucGbJ7zQH/fTuNQfdgvxTTA0IZskvyXevn3MLZotZ8Svh8vNO6slU8pSByyE4BUmO6pajB/3B8I+pBMNy/0DOwq1N" +
+	"swqFZbEFPT8NvlNW36mIyyk91HHr9oqnctI03o49O75JfhheKpQzjuTMwDwpeljK94PJM6VCYlS0RoxOFFeVDuw542iSlKxJ" +
+	"lBK58ldnjraT00MM0N2r8fXpbIWdj/L2+nYl+D2uyTIosYw3xOV+2nRzjDY3o0/h+YGPWrBbm2OfUpaa/WP6yRdcXLItruM4" +
+	"z6FoPj1ObZA4FQzTYUljJk/oQZDIT5bHYnGZCY9LMljusYyzdEoi2WP5Uk4rlq1OFvfjk/rXcwzRBVxifehTJt5iGS09GqeV" +
+	"DBJ37rlJvTvNGDOE9+eUlhQ2+O2x2cKkdMMorSHLVTpnzuCRI0fG9Z3WITtTqx1lWC8UaBPaT5tWyvcdPPXcF8OTyLw5s3tO" +
+	"K/AYs9Z0PKAZklyQFauNH9I+terJbYZMU7Q9/3Bs7cDMd0Zn/LPdW/ouO7mtfaN6bdzahxiWcaqobq4y9dhpxm+nOuOiOcNc" +
+	"7ZC4uopUrbDDoykcn+2fkqfy7CIrX9I927HBdbibpf+QnnOHOG8dVFY2qEffDtosLovLj8qN8XD5+apcvgS344PBYLDEy3l0" +
+	"+QVCfv5NHGcszCsYV5PkTB4xqOO4rJj0cWUG7vV8PtjNw+fb8gVPrIf3sB7OG+UR8lvmXudkWbIyeQLBJDnplNjB2d10GRJE" +
+	"srtb+ur3Bb3M2aWjc6eGDkf1ZSydVUOafr1reOxCnGuI6hcVUnuj+mm5c3dU91g1IrSztH/828OZ/qcO+wtiV03CwYQES0/r" +
+	"6z2TyzWKH9CILFtEqSgPFaPOiLoktRotZj3HZ4KVcZWAHqjnXSvDm+lZGKsxUEIPWVCn+0a69AkaeeCTqIcoZyBo5J0WSfZg" +
+	"bLSEC0MbuFKIAJMD/X8YQz47/GwSOfhdf8jbD19t2ED+dWZ6485XwPtOT/ImS/4Vt3HxU5keVXb6s4tJqJ4sA6wXn+Zxp3qG" +
+	"LIHEZPfT08nsbl5LiTNd6Oo1VDi4c+SOh94jR0J3w/LDxxKhDvCKQ4PJ+aPPkxcuroIBG/bFQP9P2cau/WFqShJZ149J6zaU" +
+	"04P1Q62GrGPvvOtIuxxPHEzqQU73dOZ0CaTUOl1ct4JESh9FH+tc+OxWAeqJxss+XOhAygsuxiWrK1F9EhMddXIgaAFlbhue" +
+	"81KpkDtoCUq8lAyMRbIIXotkEakXB3q6yy1PF+XtiYDkdrkNQR7TvUNeElyCi2deL+nM26yaudU6oXRTMNnY++/tLaRvbRmj" +
+	"GzI52sUMH7EhPfueWghOrOs7deaSb31VhaWlvqKi3gWnvlxXlz2IGTrpoeinR++dXcaX7u5e4mj8jZ089kHsxjG/7czxDDwE" +
+	"naKLJmyftwvuSttQo+NmnHW6sMslZFoSO6uDNvJNzZ1Wlz3nwiA+dKyOTz2I+3XKreowaPjuJzLyct2HNs6o4x7pcH7H3qgO" +
+	"y8jVqO2PRN2dVptQlbJt2ldNnWPj1t/XwOMRt4Fu2jjyj5Gb3LX2ytQ1PF6PkIQGXG1i53JvIwYZURxKQvmoMxqIpqPb0Xq0" +
+	"Be1Ae9D96DB6GD2GnkbPo5fQ6+ht1ETXm0GX5BJ4d9BNlxcy8YEXHK4gY3G7jGZKcTpWyHpkPEv1D81uf0SxjGclC6W5jzG7" +
+	"/anKR4lnJVoR/kBE78xsYhXAbprYSKPKCxRB4qncNRIYAFMyeF1Buw44KlJ3t7oI8kUKuHlBCjilQJAXmi8p4AryUlC5uHmJ" +
+	"4ifY3QG6N+P1UxX/8IYRdT/BC/mmfHMgyEo8dZSmD6onqzPq29cOd3V5LBoWl61pIvfXJ2qhenA7Y8q5qNhkZjtblJ4WAAbi" +
+	"sU4a16GJxTNzazazLNzGseOzukZ37UAexeyYTkNf1KhxELO3DLkbPv8XB6Mr2t8Nn2Jme7JvF4sPdOjQgewDzWPF+SPq2tdn" +
+	"qFl8B89vLxz1PY4m9+qTxIQEfbIe7hjvjut76zi7q35615is4Ow8CDoGM5YALszODWSF+sWljatNc9nHDTBkxXSt0hXgvA5F" +
+	"QYlxDg3kZvNnOhTP/LFndyg29cTAYIPJ5HQ5zSYDg3uYoV33nj9OZa2OWNPkYLQ9Roqr1LELS62i2tT0pr24q4PZF+9UN2UX" +
+	"xBekJwfzYrW82ZgRHFPw4ADtoMyuHa1GdZwrs7Rrvi7alCrB5tXa21wj/BaToLf6OvTpZE1wx6XtsQ0rTNYItqy06t6dzDHR" +
+	"KZkpSTdrMLtYtdA8YyHLVsRKMfboYFb6mIEmfUyiLwWPMVjFio6itbg8z1VoHllmr89c0sU4tfruKtWi4du6RK8Ye1th3Mxo" +
+	"V165ONFeNjLjNmOXJZ1uU1Xd3e+O6C7bJsyKK7xt3ONv8s/XuPYO4gPJEAs4ym0xuy0Wt9nijsIAluQAP2gv1cDdjBD7kryu" +
+	"T5W9kFH9xgiHWgT5lKTgEE2SLeAXHS5BtHgZK548GYSxM45PjGZOPMKwC+7evW3r7nv6k1f6b9yzdfsu9uOjGB9dM33AjNGP" +
+	"/Rvjfz8/cCT5NQhEc+Lw4RPwEwRBPVLZ37waYk3cecQgK8pCiNrmcfu9igEjzmwTJbObcYhuvyQb+3P4jV5qAMTvoOHw8yN7" +
+	"t0yfroJJZPXSBbBz/nxyN0yecE/5P/9Z/uOPXHlxsXfMmF394KHQV7dvu5n8AE+t3LoMph/pt6vpNDzZZeTILuF1OEKcRqhE" +
+	"ArLK1tKodyxqY0SW7WeCGPSqwcGIDlF2M4nff2r8jBnjyRHo+cSzzz7x1AunyFpY/uL76z9/EW4nG07x+0Pn1oJqbRPL7vjk" +
+	"3PmPyRvfk/YkjTrkA4l8DX+HE8reMdObe1/20kXFfpJZcJhlLWVZI9nm9gdt1PV10OuHA1u49Sy7HgSOI79xW3jSxHHAruO4" +
+	"ddz73Fb+Hn46v4lrWsZxzCJuEz+dv4dX5J+br4a4j7izKBMhWRGamo0OKirP1DihohIdpAYKaZZUumgTHW4/ng2reH4txvxQ" +
+	"jhvKh8hanidzOG5zKMSN47hxHGY2c9xZfi4fevs+nifdeP7e0Mv8BJ6fwOOCe3keHuX5+3AuP1de9yLuPe4cSpBr1+uRHG55" +
+	"cOcs3qBZnvlaGRcdzWmY1yxYzG7B4Q+6/V6m3PDS09z75LWn+oDvptVfrm3olhdHTrw3F4ZuxWUMV/DUxqIdm5jirSe5c+sC" +
+	"5NV7Hyfr3uvi4SfGR0Ppya/h4A/9ifcVZtGTYkMD88oiRvGFROv6AnceWVAPNEK2MUJnzvTn90pU49Hupv26jy7sPGbGmyr6" +
+	"3H6LaKZ7u1T4Ki8hBDft+mkaKlfy68vATIvkZ/xBj1veITJJZlkEQo/w+pwWZZHCC76gPJ/BO+IKM5Ls7mIji0sT0lKS07rF" +
+	"7MTZKYIagzDypVc6ZXRdL42vmGUqLVnmuSkwuabnNOuA40njQaVnUr7o027ecrymt/2fk6243dzZ967vmZhfS37izlfl5XUU" +
+	"0rh2CWxW1mj7LeXRXJP1Ekx7/jTzPvk2lfzzQnsLl8bZRo5M5DKY8hmVR48CfJHCnbxI9v+MGZfl/gy+08jX//H1e7jy64lj" +
+	"QfNGZxYnT3v0XO6ZR3rxQu1Dz2KY/JOik8DezJ1HvdFQavEHPFIMKOI3yWH2yJPHoNci6hkXlWVQDSUHT8c3XlbEDZQAb5bX" +
+	"TAG3jx5zFn1BryTrWEt+h7w6y4UAY5K8FBAv8E4aFvD78J3F3UTtjBzPYzBp8pL5043cOysndfFMSsIaV6Hg7812HzKq6yqh" +
+	"wKm9nU2vHHf7G5xx+nMFkyc++p5FV/P4fLYsvmdOKlSltFvKna+Kacjp2avPSyOq5i3dfurIDONeQsin5JXD2Yl3p2W3U6f/" +
+	"kL+h65x2xbOq1w1+1onblR+wpT0INqj+ep9x/uHG3bmGeVWDXn9mbIOha+jqusrxt9ctiqss2zVz5G1jSg5satYNeZ/KoMJT" +
+	"U0lwyKxlZqhUhyr2ez1BN501GFmzYJH8kVWlXQgwK84+dcu0w4vw/nv+tmXJN8yjrARBszD6zkO3/G04d7/qHXR1xun44osN" +
+	"E239Jm3m3n966/uvrLpn+cEp/RiSf4cQB5uS+ow7NXOWBrCO2/GQDg9oSCWjOwWpH0+ueR4ejQzIguKRjZ51lPVK5KvRJAHD" +
+	"I6NFSoBAUA0Bvw+5xc2wCiSIhdvIXPIV+ZrMuW0yLMZHyKlt9z4I9Y9tIYfhztHkhafxNxCkKjfkFfKq7MEo8Pk8+BmeZ8nu" +
+	"0IijPB4X2qDaA4+QjdN+/g0Gs6R9S7ukOBlQMkqTPfKZFc9pMkqM7IGs9SY853NF9DRaoc4UqY8uW3GcrL6KSCPZZbDsWLn0" +
+	"GGwL2l2BgCvVH7rdX+nzVfqZpZFCRAqFL8evee70FrDAJnITeeXQsZP3phcVpWcUF2fQX3pRsTtSHKV41O6XgvNZgytmBEJ6" +
+	"N73ilXqB3jltOPwCd6ZVOOCb9ULMSDre3CD9SITwzdekv1H4yOvCRzbnf+P0bcN/L/3IP0nfJn8UhdYhnrvIvYg0qBi1R11R" +
+	"DeqNBqBhaAz1Mo9kX5fUL6fDLnCuoDcQdAeCUiDoD0hmCxWJCBbJa6Gybjon5gUzTyfPgssddLkhEGR4QZnqQiDo5gWn8uxy" +
+	"C7KxCikQ5HjBGAg65S/4QFZGcXHvzIx2xRmhZ73e0bHDuuv6V059qHOXPQ1dBuqrh8aN9nhGG8ZU6wd2Wbi7S+fdDd366rsN" +
+	"joWXk63RS/rBAGf8ngGLyNuC6dGOZvyAhftV7BZ60KD+2dQRTxFMj1XGkG3JtuhFA8jeeMe+AYsZ14rc3CEr8vLy/lZT82hD" +
+	"z6H6nmOSpxUUTLNM7RUzrNf8x2tqjizvOSi619gk+lWa3Ct6UM/5T0CWMQq0luqDUcZTNWZS6op/YNiyYLI9avEIUpWcIa6u" +
+	"/8llPzT2ptsM0eTH5Oq9atPz1clKm706gf+an4KikBnZUDYqiZwhlduE0xeIKCJwrVty6+dAMGANmwCxUj+p7bv5fZWVPn83" +
+	"rKocV1Xl83dr6gHnoQf0lA2xHCFHiXs+3A2JkAR3kankM3KJTD5rzdkDb+3Jto2Ndywh9yxxxDOhdr16zamtK2rXq1e7orq6" +
+	"8zAbx4T+SW4nq0M/YQ3MIi+QDvA0FEMJnCTlIWeB98QJb0HXLPeaO9Iy/x+VjU5UrWGrJla3678sGy0TTFriiB9ry95D8vbk" +
+	"/KdlO9gl071mjTurq1xGpV9rUzYqglHUpBSlorCCkZ2HVqVxhgvjVgrjbl2abn5fVdW4yutLAzU3qJmiuto5Cua0BORYW/QH" +
+	"ttTEGncmimrug68/a9fv/+lpu2Y/uH7aOTAJnTmuM48RPXzX9VIBczwNLj9fF32kInP3QNvBstBa8HAceZNjOQjyPHmFL2fk" +
+	"w3f/YPyfBOBzObV1tqV0WlLWfFXhCgd3jif38TwM3VbKXI0cxLs47OlRsQfKBx0fRw/i9eQj/8mXy+YzZSE2s5ghpezccEpc" +
+	"3+WmsqwZYodlFVvkNQK+W+7L30cSyozIrEAWNlGZikgP61KpspkV9ZxgVjahqTDL5WCcMPLnoWTbfTuyMrr9Nv12cuamupWp" +
+	"sJW87eZq1rxTGU9eWncn9z655cFTZB65OiKG73H0VdDA2k976ploXSnzIjGRKV/FsnWbfho8WA0lwI1R1izMRe6cgo9NnkMD" +
+	"FWVLdPop0pO0sog5VdQ7g37qYFvgk0APFi/Ti2z7eSiMum9nVnrXy9Nvh+wFvVemklGQ6+Zq7nyrKg6C96yB+Q+eglsBRsYI" +
+	"PY6+Rn4m0/7eQ89GUWzge7jrq1imbsPPg4aoyfOkaYy8htp+NY95k/sQ5Yf3MmVhnGAOmgWzw+3we92RzVcXFc/JGkuyfon8" +
+	"THfgqFEe2hz0bhdjSLXOmzt86d9OzYITJ+DmEXPn2lJTbXMX11aPt4/vXrtknvUf9vHVtXtnz9hb252tj09d/vRTDaN0b+NR" +
+	"8OprI97Wjm546unlqfFxzuVPqaKWDagThLoBy6JUTy13DuV79ydNBw8C27+3gje5yt7PfYAElIQQcll5vcUa4FxUsZZO+amT" +
+	"WWoQ3u8VXSz/Dvnw9GlwvhPK2wQ58ADkb1qw6+efd/388262w6VL5PFz577aDhegOzlO7NsWGcjlBqqw2ABqMUyjz9j7+WfC" +
+	"eVmsAb3Lyjstcl7UApFDduJCc7RA+TvgPH2afPgOfmsTeYf0Iac3Ldj10080L1hw6RJ0oXkRBzkO3eHjbYsMoGqg2pAN5Fcl" +
+	"r7uufsY1cn9DAoprKVfQRLNCrAS2oE0URLAwje+Sj956C1Lf3UXeI73ImxCAbaOhEzm2CJ5eSp4ZjY9dukQeO3duz1fkaSiB" +
+	"j6CPOBYGrSGmO2E22SOf84V8hLj28hoPgT/ctyWBI6z2KdBC0aUnfnbK5k1TJ2+55at9B74a8cattzbNxUZ44F8PPDAfPw4s" +
+	"aXr8wE3Mi1fSoGGjYqv9boTYT7lz8hrZ4fcqYgExIEOVM4gczxQd8O9hw269775Rt+18f9Eti24fNfL2W9k7Nh4/vpEsnEzI" +
+	"kwM+n49X/ND1pvsPLiCdZfp8ghB3D3cORaH8NhrMboukDDuUV2VT/l7qzifi9MbsoO55RBszaXSXLh5PF18ws+NtI4at6pRR" +
+	"QELku9WrwQTMrcCA4bbbyA8kBKt7BAtragqDzxvyup/bvPlc93wRVpOqjfjdTaQSHqG/TaHsjbJM4BuEuFXcOVSMkFMxHO2n" +
+	"wm2/4KZrczctvWzBKvwuKs2nVTCV4Zm9ZmbxrTt2rJ9yCyyFZbctW4YxDOk8eDmsuHXZsoGdhoa/Atwy5Z7tTz755MbbLQs2" +
+	"vN97zerNm1ev6Y03juthwq/ecfuWLbffEQqaaiZsgP5KWH/23Q3zpdtp5WB4AiH2cXm+noQQ2KyShS6RHTZR6fupYhftBOn0" +
+	"D56HaaAfkHTRn09ex+0EIVQpLAPX7PIYYZ2tG3fureqh5JdBYuhB1TKVintpOUldFt9zT7FelmHUIcRbuQ+oZMZGVZix5JBX" +
+	"AFajRQoEDbKMRnAEsMOORAYI2TTLO2x+3boV0GO2imWFuVCzYl3vecO8szeSq7gfw5End624b8WTTY35aWn5DPfkivtW7IJO" +
+	"TY2hA4osaI+sV3cOqVCOrN2sl5upOxAsxV6zHUfO/lJl6kh3hh1+TzJIFqZwxeOPg/qJx1fO6VTd9679UzfMMZozXCvIL888" +
+	"SX652ZlpMc7aMnnfgfLqTsyg0+SDN98E1+kNXEn744cnkZcnGWOS09e/8zY5D+6331mfkawzToKCSYePty+heB1CiDvFfSBL" +
+	"sKmMzs9anWZeEC2SOdXK+QNlEJBE3g0XNm6GAnwI/DvWr99BXgv1Ji9v3njbO2+//Q7bZPHdM2DAxtzYxotS7qaBA+/xmzXx" +
+	"8awQH49YdBqVc3u5qUiFElAqKpDbRFihOwvcfgvrDi+89FQVlTZyGg4akMyu1FaK/qXAPvnAnDn1veeRW8mX5PFVl+Dn6DmP" +
+	"r1j5+OD2F0Jf9Jk37/758wAgDrre9hmJjprz2M0rHx9ScQE/tuKZm29+hmhwWmZDb5tz0v67T58mH4yt7o4fWfHMihXPrMDz" +
+	"cHrmTb1trkn77jl9+p51Y6urEQZAiJspt2NqaCWiKytZjGEDK8hskahBOxk71OxwmCpWKyrXYY2ggAvrbi2Kj7+5Y4ei+IR3" +
+	"Xtm15QXSxMwvH1A0Y1T/ZcDcM3fGLemSTWvPGTf+jUxTXM74uTPNdTC16J7Kqoqk5HVV3fGz971y4ciTJOp4A9Ov69z5U1+C" +
+	"vz+/cu/WpcV3lY74sNfM7JyR8wIFxcUzcrxd4GNapyPInbyBO4cC8gl8j8Usr3KTZK/9FlEPsuoaL/gDQbdocOtAEB2inu7U" +
+	"0Aef22uRSgHEgNHnEuw8HlU4MfVYx2Bl5pySwAByP6zYmNF+4/ZDU1VMlm9e4a2JVucuceVtSyZYzXvxwq8sGQkJS7lzp5bM" +
+	"Pb3/w1cXzv2K/IOMI88OeS79vtHCrMS+55m+UAxw/uWm4cwWqJgI8Nn7TbPYimee7XFk8FNN60JvXYHa59Zyb8pzkYnch1wh" +
+	"YpCDanaabWDz25rV/gXqBdghq+S5lKP00hau/MpJ+JlEQ2nxV/v2f10Cg0Pfgj6mZvNHm6t1IjCb4M5N45Y/DgwJPb583KZN" +
+	"WrHP/AUHDy6Y30fUKjpkpVeL+QLunCwpMNFtc68kuP1hva/foZW3hcRGdsngnj2ZRx9lej5akiYYr6dRaZicADFTF8KcBx9i" +
+	"58yrnMNY/oAqYWKGViBAm6idAu6cbOtHVIOgBrcagmpKGZGrC90MScPJZ2TZcFhELo6AJLyABPHD+Bw0hcrI3yZBCek3CQ5A" +
+	"ySTyN/zcphBCLX3AOdmqIwKPIdjsZ54W2OEPBowuevRCEnzY7fdYjNRykyzfdLntmAzrJo14aPKqpUtun5I2vWHjqilDsop8" +
+	"trX8nYvmrrgrNT3dOQV8vWffpeLvvI0713Qve+GZsXc9cPPO99k5wMI8PWMmiadxCH7kh39Mvn+l38ANTa67XtxuVHC7Fwq5" +
+	"99hdyEpP0RjpMRNLMBC5GgUs8Ha6oUUrCDncYjhC63BH6wj3gnNsuiZra40n35y3zeWcNqJP363Voy15W2s8HimTvM7lQeq4" +
+	"NG3W1pqR5ryt7tTpNEJNvlfK3VbtzQ/H2A4xaWvWdOmyZk0qecELiZu6V21c3aXrHeTSCDgPWvcdd3TtescdDvKiBxI3V3ff" +
+	"eEeXrneSS8Pp3MOKEFfHnUOJCIGAGQEZbX4bBslg9BqMQcTYBJsB3Jhh9p1PunIPmGLJLxAVR75bfyXpg8TG9eTbWIgC8nM8" +
+	"mDc0JuLNDDlMnmVgKAQPQQCGMeQkeRDqoJwhO8mrh8gr5D4GKqAWyevh4whxJ7lzKEa2lN0bjUII5JHGrgPad1kkG1UDoxqp" +
+	"1D+/PCYpruQiO3pej6To3Pmb501m2uclQcDbvNsnypuKnggYHeBGwNac9qtvrurWwWQC/2LwWYwdulWt6NK5fW4KBjIJ3x/S" +
+	"uuylw0ceG9K1ozVJoxs+fOjw4TpNoq1Tl8HHRo0ocTiJVko3WdIsljSLKV1iXmKYzMT0Xut79lqXplOpdGnrevVc3ys9MZNh" +
+	"Qo+RtyGXLIXFWkvghYmTXpyQglPLDAIb6Ny3b+cAKxjKUnHKhBcnTXwhYNGSpezCuMy4uMy42GxJyqZ62K8hxN3PnZMlT7Vo" +
+	"HGpAa9Fe9KQ8PspESAYzLxgZe6u3hDZhCeDyt7wxxlZvDtmwjV+wuZOwzQ8S00JwWguUcsHw1/DH8Leg0eL1tHyk1QVextMm" +
+	"tfwxHPGar1n10Ql58XH5cYzv/ZwqfXxeQnxuPGQcdBfjhLzY+LwEpti+Lz0+PzYhPz6qpvZLd3xeXEJevKb/2qbf8Jnd5PE9" +
+	"eE2oe6e3eEFvMKQYxBSjwSryKpAWHOC4GFG06ulXq4EXYNm4eo4VbQajVdRbDQa9wJNnBmSKOr3RlCLq7aJo0Oggesj6qCgx" +
+	"xWBIEQ1WUdSzDNOIhyZ6ExO9ieUWwuNnBid6EhM9idn2kInxJecl+uLjfYmB2KZX8aXcjAQaMaGPPlSJGXtmojchwZs4hOSH" +
+	"3sa55JO/V5W8m7dnD/TOwDqGjXIYTQ6TyWEyOkwQumzFv2EmyuFKNRpTXfSLMQG/DabIhygOh6KY9lFRcnwTTRulwk0fZMA3" +
+	"jNluMtJYRpM9GlMj0hx4rhKugnsPJSEbcqNsWS6vOG1xmAVRMsvOaOhmmMNN24zZK/tmC/ptZkkUzDa/2y8GbSJ+4sqWf4+r" +
+	"qxvX+K+xVJdv7A+bcZ+mevxLZSizWwV+sQOEnuHe27p119oNoUfX37l7952bcKeNa3dtvdIYDDLfLB86dHlozqwlS2b5sA2H" +
+	"LiAWViHEfsudQ0HUAfVCQyjvRuaVsmhBeWxmy7DxGckir/OFyDqbHpj1N++Lmo0BylQyT0mMDZmSIcKQPF6RmKiKCticAE53" +
+	"57gEKcaU1tnl7JxujJHi4ju7XQAumy9KSEzCmDTt/OmfW7dO2Lp1XSC9U1XntIKXcDcyJz7Plx+fkB8Xnwv3+AuL/aLOVda1" +
+	"J9uzW1WGLsqQ5E7PrMrIqMpMdycZ1bqMqm492Z5dS10xor+4KMCwZDbcAzUNkxoaJjWQY9ndMmvS02syywPkEBdX5E9M9Bf5" +
+	"ExIUn/W5CHGduXMoGWUgL6LucgOuoIV2xXQuR8VjApUXG9yBINWFkZhAO+DNJlkqw0gmwWEvgYDR5eapTrhFsEhBemAgaEGS" +
+	"SXJi8PgdATkQv6nJ6XV7Kjye1rRRAxNVvTwZa1RpCxN7aTIrjNH2IXl32ep5d6lRYDm+Qh3nfD6lkzso+d2GXpbBfVPmxw/t" +
+	"lk+W4CjG5CAdgE01dk53i+xXscPmLV9Knm6aWlC0E3LKZzJrDJbknJ5gfXfyAqt9ccI88m1ZjiM/OYZMc/hikqV8vH+Ya0JH" +
+	"+/CR//q2e2zXyqi17IPryeuLfD5ggtAA2uWVk7rbKV3Eq1e5Edz7SIuSUE80kMralY5cqWLjNd06mG1+qnVoiPBSmKnCPKWw" +
+	"VAtvGeRDJ24ZFJUTz/cNzPcN9HgHefIG1sfEaWPidLp4nSY+Bqc0daiMumeFPTXB6OreOTZe0pncnZ2pXdIoLyV0qHGaEuz2" +
+	"FRui65ii0gszZ31UUsQyz00bVVAwatrYQAB0+oSYmAR9TKJen3jlSQAoLNoJzhpzTJKrqrZuY4Yuypjk7l2dkVHd251kUusy" +
+	"NtbVVrmS9KYa8uHOosIVC6fPX758/vSF8pmc68fMcWg+WvX/0chJSRx0tIJBVXu8PnoCryWV4/9gfIUFoROPN+ljgsWlEwsL" +
+	"gs54fB7HO4PBdhNL2gVFfeP/2fjb9MjLL78paGzzO7S/qYNNSmUwZlIlW4eb2neYb9MIsm5Hy/jcHfX8fzgqc2FXuP/jWBma" +
+	"gdeEZvy/Gs1eq6kh7/XoQdtnZKyhNvKc1K4qhBE2/umIww4IncTLQ+0u3nCcYVcMGdKUO3Uq5/idIUbxMRwZU3yIusT7T0cT" +
+	"o83oaj2Y4HF/ccC40rTzJwsI8oCB8X82LpApcI8f4pRhgdIwAyGukjuH7CgHBeUdoYArmAyC1+Ro7v/dvDsY7v/pwCDJChoe" +
+	"vy/osAsMPUsR8LvtICGjF+OXgt2qu1vcDMDFdNrLz9OWTu09LHPY2NgB2sz+UeWOwd7NjGAsc3F9bWPj1BVCAWzLhV58p3T2" +
+	"C/eOrF7rYoAcUvry1LmHhY3cuJ6JdcC9O3lcRsbihAVX9cn59rxykh6bFOO34/L+5N8hlqlV5JiR/joHIe4/64ch8a/0r/9h" +
+	"xynjZEeI68WdoSt2sBgNLQZfHGa95PAHRLej2e4KKgOmuM/zZ3nHurzC2/u4Kp+rZuyZ3Wfc31F1ABJ6Ye7MfvId+aawT8+0" +
+	"/cMsFzTk8w38Rkhi+t38drecR1+DZXsZeY24DwH3Jvc2ilW0IowWsycoGwlIAr2ABIcy9WHMJgm8oGhDOOwMb3bIDwIP/35h" +
+	"XByvGXXmqSn2l8iEABf6aNLxrm8+BQ8wmB1ysNz3Q7WaLx94cPswO/c2+b5nbGfwv1vTU0vIl6Hz7fH3xx55pyGb3Kn/8FLo" +
+	"KfzkfRtdh6YlkgpXfM3bnx7tjDA9T8CVcGeQilp2VYMZCVRZVs0yRZghcYla0rUMA/HDm/AmuZU7E4oXQu3s0XhJvRZvCI0N" +
+	"jcLbcDKVTbyBELefO4OCiu6HZIoBr7w3o0zW7AylsdnhDoh+B45sntFwWd6rKC+3e25gNDMpwLTvLr7X1/RUavkHA5jKJ/lq" +
+	"ZsCFBwZJH7fLUVV3wVXxgefKuTOf+Qpm7yxOfLV98RvjNDwY1OT+yTy/BKoZ8u0rHYu+rtMkMl06Temle2UcdXcLNgRcHXcW" +
+	"2eV9ewQWUGqfTpwi1e9QXl3+IGWAIA+82SLJGthej3KXKwgzXpfbLjDtpkP/mt0pmaZl/Z2Vz1W7PN2HmtZ2s+AngOszlbzV" +
+	"TX2zyambWL9XSKgaLN7UTv3o6SWFGi2v487+nQRuX8m8XaP/QEu+2spvBMfo2Yfbl2mZl8/A2G4vkanLbn7Sr/6g8z3705JT" +
+	"jpyGlMZp0oBaWQa0GyHuHe4MksJ09gaCkROBEGYm+Oej41wYW2tHzH9qRtJTJJUj/MaHq7gz3w7O3zVx0qd13a58FroZH33j" +
+	"g3cbsmm7aOEBpAaLGVzgCzJF8GJpKBRNhFHcmdDMPtpQggpf0K2iYxyt6wPcR8iDhoV1oSxUi+wvVbe8ZxhgTClgpmo/MSBv" +
+	"ixoDQaq17VUonEunKX5ZeiY3AqNJoAdUmLJHhkhjTYlB5yd1uqeT258fxFQ+xXXHAz5+cJD0SXGOPZjYP/5v5ec2RQ+foPow" +
+	"yN8U6PL0mBijYKvqtWZjddRn+dzEYbOSpAN5zn1V38cF4riP3uxcuGKfxL9fUPTuKI0AJjXZLzNRD5Z89Vqnom9rNfy0o67X" +
+	"xhvJw1tf1zDqMx3uGewCd0LFvJU3P5OTyX+cx6iZ26ZUZz3SV/9y45uJw5iRFsSi568S7hh3BulQEspCXRG65lSuU3k1hl+5" +
+	"tqFGee7EWlPl3tLLC7zJwlpTqVaJGwc1y/FjfHTCukwduxmsmuX4cU4Tvy5Ly26GYLT8Fnc3fUvb+MA+8g55jLy7/+AmPKdD" +
+	"9QbQQzroN9R0nMMVlm0duWz+K/tmeQc0rP5+2PcbX3ySvNl4rOb2p98b2OOFhfdtCb11V/sZW4+S5Pyjw4cdGTHiyLDhR/PT" +
+	"O1Uf2NSnz6YD1Z3SlTOtV3/BM8K2hbwSPbFNO0/ZSqtXxBkVwtFM6zPxzDNJA3qy3FnVldDubPbmlGWxXDl7JpyeIdxZeTeF" +
+	"HvOWmcfhlqvdLdK1DD2L4ZFEnFijit12bgqbEHyjzvKq94NnTX17cmcFd6Cs86p1w6z5vTt3yYhvfG9z9oHlUjn7HvVZfzWX" +
+	"s3Nnqb1j4CRRWRjJqIlOBdPIKz6CpzAP5p3c4ajpRoy3Fkt/2+zu2ZU7+yp75futGXvYRRamiI2+v/HD2wpUW4UFdrYD+64i" +
+	"/7v/6i/ca9xZ2cscApqJ3e2jNgREaghJxt4jiZLZ5udeYr59W//2iC5MU6PwAfvV2QE9ejAcd5ZtNJw171mla4fXhwacTj26" +
+	"UF/O/v30tm0K/F1Xr+B3uPOyZgIyeiXRG/BajJhzi0YxVQy47QfSWWbz8tcN8aEfGA/Mj75rIneebyr7niFXSd9+DPde4xmy" +
+	"fSTkH1LL8LYgBJe580gbgecMqzc4RKPodYqOHU4esjCbypK3cS4AYO48R3Lvw40jOQ4+38muCm0mC9fCYoTRVoTwRe48SlN0" +
+	"XCUxSOdTItVvVWDKV8HvC4oOwR+kmraiUwTRKeLOGexEhpk4BSazGSwJcS521iw8Yxbr4qjsGxia6VvF+A6hmNwevIXtQN5n" +
+	"WRBXk30sCxXFa/hiuKng9gpIYVlyZSVUhzaQW26HWco5H/Zl7jzqRPdOXQLVV6W85KedSzuIPHloV2Pmk8AbfqKKq/Q0mqzv" +
+	"baaHHeyypkfAj6dv2t3Na47q3x8n+ip3b5yDE3xpi9J98cycdburPMm4f/8oi6fbnrvnMPG+9EVpvgQ8hxFhoKNdfrBzdlES" +
+	"s/yhE3UFNjxyJLYV1J14aDmTVJTdOZjfzjEQSO2JukIrDbIW1p2QzwrNoTpm3HnEIy0yoQTUjvowAjoxkk/EBRRlMF6QvIyD" +
+	"qqsHGa987IL6C6RnJiip6bYddetH9dhjgE5mRUV7HBJNWZnmPnhXH1NmpokcSstISytJg7TitLT0dOGNp9STJ6ufemPGsCFD" +
+	"Bk9/4yn1lCnqp96YPnjo4GEzRo4blz9uHHM8Oycru0MO5HTIycrJhilSbq40tKl2KL3jXxeNnzBu3CJS81b0/PnRb8GxRePG" +
+	"jx+/6L23oxcsiH575o4csgSW5OygfK0Jn8c6hEzIgXJQAHVA1WgcmotWo41oH3qI7q16UiDo9ZgZG60zP6YzBEEe5rDgSHX7" +
+	"DcGAZDYKZm9QcOUCSEZZhRQsRkk5D+Vi/JLR6ymjYwnvlBMKDjeOAarDQ49c+cqAKppLZomqYpgtktEr+d20F7JRixlmnqFn" +
+	"pQUHLgE3tdqhTAo5QfL6fbI1CmpGgeMFsykFvCJ+H0b54jv1U7HbyEusvvJ+XfoY8yhPwr0xmPyTXROT9usJ44n6uDE6Q2js" +
+	"ubXBznFVaUyZfiXb1WRbHr0rf/W8PBvOrH8jNMSgGp/DcgN2aRmWZ0xbDd0NMICdqWfGwerjOK4el7Ic1DhtmOWOWlIe4oSt" +
+	"lSqO/DQui+uBE/v04YS3TBlDyD1C6F9BrlfCv7lDXVYWCOQMx4kZHHQ2WVhOuyQ/ipwSD6kwxKtyJEy2xzAPal/UcuTtVSCt" +
+	"HUN+Fsk/0k3VHQzRUMLFkG/Gxhd22rV04vs9tFwhec1mTuMSIDs+RUhcnt6O41ycFqtORcUTGKxiDpEz3Cwbh83kaNJ8Duxa" +
+	"5mGB7OVWurSeBs2hg6rEEQ+DSZU0PDWqTyYPZkUHdjWycl9x51EUSkEeueV6RYGuEL1mG104lIE7aHRTe49Bm9lmd7ucvOAw" +
+	"u4NmOvFxM0GjxWwCSwoEGXeQ7qUIjJtq/eHVW/Y/MmMLqSJpaY6qgjOWYH+DNXUiTCbVHb3pbKh6+8ann37YZ5FKTiz40Bby" +
+	"b67vXmB5N9DfXlCY+9iC8w5PBnwDW+KHJxMY9Fh1j65V61Inkquphwo8XtUOW8Lwu6O6bVzfOTpYMhKG9SCD21cE2Hth3V2p" +
+	"5OpEx6FsXj2R3FcDeypsCa3LmIjyUUfUl/K32eYvBVkmQzdnBb+yUWeivkfgvy81I4CHuAoft2TZOgQyBvfsODpz5ip7pnZh" +
+	"qP6/pcI7g/vk32tOL4hP88a6psSZRvZydczWTiZP/E9EaaFJGipBPdFIhEAxRWB3uf2KErTPlQn+ZmNkLST6X+jzrbp+2x05" +
+	"w8sqBub2qavpMmpcRXmH9s76mo4jsmbdXtV06r+kEn4G1xUPGq0zTEo2eLzZnoFulztdZxoRo+1f5asksf8TrbhmWmGkRXbk" +
+	"l2eUjJeR1abMDr/N/7+QpO/p04YdkyffQQ6QE/9l8ZlachVWkTlD16wZRlb8b62ldXtJknuEfkp7MfPNO7+tWIRyxv9Qejwb" +
+	"NpDPcZZ54boNt2QPKevUO7eyd+9KR9cyUvbfEuOtodNAmDVy1AxdzIwUQ3lBYbk2jtT9b1Rp4QE1sqMgqkSDw3YIZb1aozks" +
+	"uW4hkaK48r8wRpanpMTja9duVbAspaaO4Xt7xi9YPH7eok0r7J2aGv/blvJUxZzy8jkVoBtj0Q3zC2kzhw+dtXfDnYc1TaH/" +
+	"kXFa+IZHCShHtmxpMzMOo+1/YZCh8ObPKz4np0if/5YfnvCEHsfxoZv+13bBNJfPhNyoCKHgf1+s4/9lYd77HwvBy3O/89x9" +
+	"SI/ikRsVoq6otnm+Z8aCzR8IMi6H4EkBhh7WDoDBLIWndIEgZ5DkSZyDoeJPemgSvPKEzSRP1xxMZK7mC+LzMNSX3H4Ad0cM" +
+	"eb0veyxFM2ioK+vwj3ErTVkMtx7306ZO19xrOdH07hY8+kGGYdkTAtM1dsAb+E4MI5MSmBPmxMfYTb1ZljSOz2CruPt6Lwxi" +
+	"8r2Z86thRDw3qJ48ssUIbGIuqSffSO4xRaoYKJKmJZBPtWXkEX16EgyypixJjy5kyN6Z8WqyR5oJziM8uZ9bkpe3DCEkNJ/L" +
+	"MyIn8qBiVIkGodF0BiTbQ2tlH8AZkMyeFLoxQOuVTvJdDrB4A0aXQxYSCpzZ6PUEfblg5yReoHNSXxl4JTfQ8yb+IJ0J+x28" +
+	"w+b2c3wS0HCRyYmDkR/2JRvX7WXITW9VQeIDDfacyXG+JvVcprKLaJRCL32gYlcWm+Kz6/M6R+PyVRP6xQ50apoKunZUL4zH" +
+	"cUtUHRfCqfncLIbfYgsOZYwp5BJPrO2GSZ9x58m6w6d50uPb7rDywG4/DP3n8ruZiW8ylhRG9WPWbRYN+eT2GIBFhvkLUz5m" +
+	"yE+rcrdXF/tuyrd81a5D3OWsf5LPG5nvBvJLjjJ6fujo8W7NwHQWUpGqmWZ0zysXFaH2qBYNQxNabCo4Imcr9ZylHViNAT/1" +
+	"72RleIXJ6PrOH3TlgoOymGxrKEBFgXwmME5qhsrtwILZxbh9QbfCXGZGcFCFbic130cFHAGmGKa8N4gcvPspcstbfWD4ZpIN" +
+	"pWs2Z0LHN+vIE2v3kV2v1OJkSMgz+7qNiP258woprlNtQtoq5nX9xLg0PPQm4/ABq0Ppl5czTGdmPRd9B67pjI/w4DJYtpk3" +
+	"cfx88hBoef6jbg4euPNk47EzpN/X/WHWrg/h/m97hwxk2rl+0H/vi7D60zqybwdM7jQii3ndwfiIW9O5npDF+rPxVrL/ok1a" +
+	"Bur+PzH55BtzfDQYxMQ78sl3Q008+UjN9/lhNUe+5cZm5Tefj7sgn4eWUAoKynMMqkXocvPXUZYXZCMuYHbw1K6ri1qXC3iT" +
+	"QaAWXd0O53WUX4/3Lu1bs2477L2GcHB0XU3fpXsxJgOYKfcvmsHhNQvumDFzzYI1mJux6P6pOLT8esr+9AZ+7XmWwa7rKHOB" +
+	"ff41/Maz5N+JL6pinuu7YBscPAjbFvR9Lkb14vBrqIYwGBDmhnPnUays9+ox05W1RxIcks1ipMdqXIzNzviouYkcxTY7bVvJ" +
+	"4KEPuO8qmGn5NVrHXI6Gu6IWz8/kftHdQuqiu8OLo6dOe216UXJGqgVX3rbhVmdpOnd+zW78xTp2NE9mpN2h6h8LDzKpN18Z" +
+	"V7bw7wsbZhTnnC/uULeprq77/R7az9+CEPc094bs1TclvJslC3nD0tWwbTcIe3DDe0RDYqzbFZdgFEVjQpxLfgp9xgUah7Ka" +
+	"xp/w1xmJyWaj0ZSSkNn8FGrPvXHFu5ddjthW+VEPwsE/yPFPMIE7RENiOP+WJ5xzI/yaXo/gB4dvgB/edoOPTZoI0jLPtsa7" +
+	"C6pCPf4LzP+rEoF0oxKB9F+UE8bc6OPwPyk8f03Zh6KRsiT+Pyyl8/+Deg6989/wBJtyI5594gYfM/8b5hGuo99cdBNa/P8B" +
+	"Bf//RFny9R/3Ef8H9CY//2G3g1Q3qIM70d1oI0L/KbXF/4/4nnniRhQPvXujr//XLeSGvfqNnuDuG9VD/V9MzaAbfGz8oFU/" +
+	"TG0jAPeRvE+RIGt+eJqdSxpSwWRgrakGvw+nOuwsZiMb/zpw2F1M0f3Q/pGj5Fmymzxz9ARUkL7bIRECkLj9PvLZho3ks51M" +
+	"QXIgOSmQYg0kJvu588+RTWQB2X7qFIyElTDuuZDpQfLi4cPQ7sFDUPbAA+S5N9oX22zF7Uus1sgZ+9a4Bf4T7FIjTzngC/yn" +
+	"qIZuLgqkpASS6Pt/hPZH1pLwc9hOSwT/+Gtx97TWwr0OPwyJAWtKICk5kJxyA8I1bWjOx9q2Dum67I9ySm1RvLPzf5xtU6NC" +
+	"gZRA0Z+gsKOl2hQ7Eh+hl6kPTbqq3cy1v/LMyy+32K1RwgQl1EljYEfoApNCry+//PLLzXYKmOqwHQFBsQNwg+8j23yvCZ/7" +
+	"V+KvvMF3Gn+lrDfBcyWyPIbWS4ANirzbJeTSM3tmhzkgWYIimyqIDnh3Pdz/j+ff2fGVoX/Hf3e69PzFt68i4t9Vj3f4v8jE" +
+	"I9IW7sjAg/3++LRNt7UPaSsv14ROKXvyCnw9QtfDXNAGErfn2uQI0FAEvJn7gM4f1SC4g+6gFJToiQz5bjGqQQKBcQuMG/Al" +
+	"cmbfwwcyFrr2H90HWZC179i+tAVpZSnkn+TsTkjZ/+/7dv57HyTjRdDryZeezFqV9cTLT5Aj0OPJl5/MXpU1ncwCP3noYfLj" +
+	"41B+/Dg5+Tjolbo6dvU77nnuQxQjr/GvUR80mh2yMTGH2WFPdbvcAsMJebJelmz1iOoVRlqjfMTPZraJijaX28nIerA8ft5R" +
+	"YU8tT3WWO+zlqa8LdgdmeGET+aY6JrpHPJhq+Iq5IyaQw1AbaykoKZ3Wrt208rJAnARdyWMDes/tgHENmBNqomNqbh/Lvufu" +
+	"6nZ3dafR65V/AQMYD+sn6iugqqGc75efJuAzS70qnb3h1oUdOy68tcGuU3uWhnKj7Pn9GKa8gZyo0Iv98qlqEfoXQtxy7j35" +
+	"xIpR3sKXLJIO6FYVtYQWjAbZJAHVk3C5A2VAnXNKRnpYRmAWX+kQ18vS+44g5gLucmbNoFrHoHYfnW830F43ZDUUpnZgoXB1" +
+	"b6kutuMVEpeEu+BuSfhp8la74tndHmHKM+zA9jhQoGov5oOdfJwvtlcVHOganZTanXmk2+zidpCHV6wPFeFT6+n8edrV75iZ" +
+	"3IeIQRaUIfvfQtDKylvYtgArb5m5XUFz2HKbnxpuE91G0S2mutsabuP8AXfA6WYiOqGKGih4SozWT6NjrXgP8HdAITDgBF3c" +
+	"0SYeftoCPzPs5QMcHOnUqRPZzmpOlnpG9enYPyuKxQc4njzxC0STnztxRrPZZTI7LWYjuyc5M7rxnvZJ5Xn2itq43FhLXtnc" +
+	"CnjUOXVUbkeXN6f/8F65foc31U6OGxkgS/Uw27hqLcd2S44zuLXl3tzZq/Ql1hLHFAGyo3Ni43JiY3PiYnOilbFs0tXveEGm" +
+	"iYC8CDmjwUJ/4PUkgSkTjLygjAhBo81o0YFdtsTooDuK7SAoeRPAG+Seuvzbb6FvL1++zBSc9Dof+g1nZm6t772lH/NzaN0v" +
+	"uH7r/duyMuDXR5O8z+fmfDZr1me53zPFTS907y5fmV8z4tKaXupYWtaly6LOTKDp1c6LunQpK+3IFIqJMblbt0IH8rRi05fa" +
+	"kmW4D5FF9pzbZt5Cm4/AM24DGB2MTXQwNsZhxKv23pmXfaJjSox7+oxXKpM7L113fNJpdmqfGd3J1QYgV6tn1nO39Fa799X0" +
+	"Kmx3R1G7ng93HV/WgXzSETaHGkL7qOUF+sOyTyQM0Qhx47gPkVY+T9xWH9vstQSCJlaQbQe6UplkCHojjTrcpvG27NrM7Nrs" +
+	"3F5ZGbUHuNte30r6OXKxKx2zpLzHw58s5dsFOtf0uGPDml49unjZF0b193j6jxqYl0eyt8FJ15dbNt7688O7V9wJ+fhn7VbB" +
+	"kbnt0L19+tx7aFumVanLw1cvcKe4t1AZ6kLXhoh6sg0bYZGvprAVFvkaA7w9FyLGWOSrL2y9Rb6WgcGK9QarQc9SD/a+gIcx" +
+	"9nDFFhdN71RYlZF8jy3mZoa5OcZ2T3JGVWGn6UXFsa4e7tjiwhkdi7pnJq236W5m2Zt1tvVJmd2LOs4oLI79F3nsq6+g61dX" +
+	"Ue3M2tqZ3Fvck9rkFdnl2zzFKb0HdXJ0dHQe2Dul2Lu1PGdFku4Jnn9Cl7Qip3yrpySl98DOjo6OToOU4OzlSdonyQskRNYT" +
+	"Ahgmzl4ycOCSgYqPvW+vfs/dyl2Q9fsMSEKJyIZcKBPlIT8qQmWoI+qJ7grXnito5/8XU4tcK/dL7ut6cK+JapVbpLCxRUHh" +
+	"VMYsa1wyyqnUiKFGuv8AMYn6+PiYJHhupEuqXTbS5ug1qZMuMzA1B/z2/owlAMHMbF9G08uxrhE1TodtVF9Dlq5jV50fsssC" +
+	"fguTOtCXncED5+nY0ZPbuVMvjEWj0WE02E1GETMAuU4GdzVBdtfqS908bG5qLkSZJAFwTmoO9vX4vHtXyDJW8upUbqc9xVDe" +
+	"UZxTmucqMA0vtfXJWNTJOLn7XVWqhcO2dIlaNubWYPyMKFdeqX6CrXR4+kpjp0UdblVV3dX39qguW8bPjA/eOrao2h/s3j3o" +
+	"r84eQG0SZrjNZneGbJGwvzqNYZf4+YG7Y2NN5kp/mrrpvUGWxFFMojrNX2kQrQm7B/K+1VFqt1Kfynn5d1EMikculIM6o1q0" +
+	"Cm1A96J96Bh6Aj2PXkXv0fkb7XqVrop2xQLVG+EtVPnWKWvaUicSbt5NK0FRlXYpY6rirEwK+5Mwhp9p984F5R1YyRxo7mbC" +
+	"DzpwOyJrJb6VzQv5HuBciiYr1ViR5LwlikNYEZhCVvKQY7j8PvnN5XbJdxknIawaGHQxRaLNYLCJBqvBYH21S/nMnLzsgf1v" +
+	"08IxTruq/0CLlJ87o7wjgDc3d0RmVnZ2VmZ2BsbpOZk5WVnZGYxFx3Upm57nkaTBA0P/gORJBQUTUjaNHZcUbfXcBA2e5OjE" +
+	"17dAis9fVBTwpWx5PTEqOa9hQX5KVNLYsfCv2PTMrJyM9Ljq6u51gYJkW3ISSx5XcckpKSkFw1YOGCTFevKnlXXhVN6cHC94" +
+	"OVWgwJpsfa4goGKTkm29qqpj0zNyczLSpWrGb0w1K+e+zKnG8/7Csb3r61m2cMCQIQMKWTYzs7732ML97jS325WW3i05MTE+" +
+	"PjExuaCosCA5MSk+PikxuaCwCDQDhvoLR/epz87iODV++OTJh3ExxgWmhJzS0bNhzsjS7ARTkC85MmLkyBFHSoWgKT67bOTc" +
+	"2aPKcuNNBRhX7+o7YEDfXdaMDH1cksFkNnUsL+9oMpsMSZkFHJeVXd97dKF/yHZXeprL7U57r2MFjWUqSoqr6Gg2mXIybPf2" +
+	"Hziw/702JMtTKF/exr2HopEJJaF0NA0tQqvprE+uzIDcPfhcZqpRG3DyEj29RL/IdjfpL8DQ3SnF/mZA2dkyU95KBinitNxF" +
+	"+UBRFOcdCrs65H6Geo4IuyQP8G2GIWerJVwpMJMd+Wk5KibblpFJfnOmCR4jPMQZgwzjBAe5nOly5zDa9Oxsxz+6JST2Gojx" +
+	"ns1VaVmOe+AeR2oGW71xH+BBPZOMvWINXq85KSUbNO2y/WX1lqSkHsUF2SXRkJOcaPb6DLlDDg4efP/Q4Q/0H3gQuKo7a3vc" +
+	"WVNzZ49ed+IVu/fHOEbfcXBCYsy0N6pHQk3vOZVVM5aU1U/q3hf3Dd4/TZs84eDNE50x+2HKkIx6jG+ai/m5ceLA/Ft+Avjp" +
+	"lvyB2nhmLo/n3oRxffqgZMeYj0ZlOyqrNcH2uWb7bbfZwZTXIaipqbJnjfpojOPWN58eN+7pN58dO7ZXn52Ht/frt/3wzj7K" +
+	"2HgW1XG9uHvleU60vEYAxma2GUECCLAJaY1fFLFljc8VsfFpjZ9z95KX90CQ9D5I+kBwD3n5hjBAAjc9FAQCBNkf3Y1fpLEN" +
+	"jc+xnLvx8zTu3r3klT3ky4MHQw/shYI98tpcsf18BglIi8zISXe+wGxzO5BEwSDBY8YOOxMwXtOXMe0F/PaVHoIKv24KPcA+" +
+	"HHrK0DlXMuVL2aLdaHCIBqvRaOXOXPGr1dzBrY09RDW7/fmOoa14SruQ1pRqNqWaTKkmc6op7B+TncOdoxqRVPsLTF7WLIHb" +
+	"j/w+R2ousPUaY+iNWhjENx3R4s4TyWMxPHfOLIQudqnTdQn1MQu4r7ZLpdiFzqe3k6v8vdwH8tm9fFRCvRSAS3Y/GPB6WpvW" +
+	"sUXM6xjtsgGgoEsfdBl5QbSJrqBFscVqFyx6LqB383oIbIKc91dNm377WcjbeBO1vkMOUaM4P1GbEIXehoXe53oMhN6DKryF" +
+	"a999d22RfxH5efz4cdgkG+bp3LmtaZ6IeR7mLmq9R1wPh7cbmu7JjiKXQR21V38OulwybCc9CyZBlxkzyOOIQ3eRgcJQ7m8o" +
+	"GqWiLFQgWzui1nVoD42CLjAhqzyAuJrN7IQN7YB8utfB8Ig2XSu1cwSegN/OM+smkI/eGpeRHwPSY3G4I7XDs3m8df7wzRCA" +
+	"7aPCtnhg5BLyzKiV5Jmi2DEl0Onyx1ryj5fjc8eB853x6aHX7nr3nteZ1BmXyGOTsuLfBK8QCK39ijy9Y9I65+h7W6z2zIvY" +
+	"7WHmkD11IxPKYBAJvk7ejy7Jn3QOus7IJq+c2/Mp5cftZLnQxJ9HHMpGhVQXDWEpoHcDtXzibmsXyR2uV8miFJgW19mqMqFV" +
+	"LTJBGDt+PIPJmQ9XzagZoBhPmk/r9Naps1a9OQo6k6OL4Okl5JnRTaxSpTct9EFPb8G6d99dV+RbxLxMHptEq4N8+yV5qlNW" +
+	"VCsjS4vkOu5Yyatamyg6Fa7aQzsMIa3+HHSlVdojbPuJF4Q+SEBpbW0/eT0tJZEH9z8wA/X+gyuhY8Rc0qjfMQg1BQY24/P8" +
+	"P64zDYUw+tfVz7hi/ieUi6oRAuVUVtilVVjwGplDyEJZKsMwCm6nUXAzkgCWZq9XSky70GoCwzxTlp1SkmK3WYt9/Wrqe/Xq" +
+	"4y9JsdttRcW9etb5c8oueT+f96oPdjy4sPe8S77Q6uD0YCDDHWuxmDNchYWTCwPp7jj6ksqig7bUrnWDBvYfWN+5JMPpdJd2" +
+	"rRs8oP+QfhUVLqfmgfGrxkPHIbc/SzyFfydPj7+9qSk+Li4np6S4rKK40JMdGxcXl5NRVtShorgwPwNh9ATZzfUVDKgCUZML" +
+	"AU9zidqUXjArql0Rc0gthHBTWY7gNnKCmwpypDalDk+TWhEF784dnXffa17Y8eCi3vdlpJZarVZraVGvtMCvv95y+bI3q7qk" +
+	"1JaSYi1zZCV+7v28e+9FD8JO7+vdL/k/byjPLXCnS2azlJ7h9xaX5xW4MiwWiyUjw+djb9Fo4N5Bq54lnqKLZHhSWqf6PiOG" +
+	"9qnvVJ5minpsw48/bnhMbXZ26FTfZ+iIPr27pCV3Hb9qwmsXi+DNZ1cNem38qgnvxiZm5hS3a1/RrjgnNzZWeator7zRtWX/" +
+	"q5+xD8m80UO2T21WFg9/wCHy6VVRkKUo11DBcQ2VmK6//hpI61WkUKOkprLfoM69CikhbKUl1Vney5e3DoNSKBv2SbHPl6GU" +
+	"2lWQV17s9WcoFHEX5HJnyZcb7ooypZUrxazv1MHpcKSVR0jRwWlW37UB4h6cCMPJjgmNPWNjcyNFzkyMVd7kImcmxsrtQQV6" +
+	"7jL7QrjMcuPjf7c1KNSQtbvdQTW4nb/DAZGysz+fuqV7lCG1sLSme32/7jWlxTarVX7rVy+/GdXdbzm1bTiUkL8Na/JbzJnp" +
+	"AX+7dv5AhktuCRnKG20XeAf5HozF2QPKO6U6HKmdOtbXjhhT0/KWXwBG8v2hieRecu+Eyx0Ki3Jz4mJj43IyS/1d2rzRegY9" +
+	"+xD7EkpC7egKvW0Vty21ZBd00IbVS6EVpx86UlldWp5stSaXl1ZXHqnsXlqekpKSUl7afVex118Xrra8smKfvy5co/nsSw5X" +
+	"+261tMJqu7V3OZS3oSPkt5mxcYNa1VfcoDbVhaAV7n+C+R/g9ocYIAyvwh1sGVsZps81fHxNVf8hDkx6ZYotPl6r02nj420p" +
+	"lVZrfLxOq9XFx1tXLgsW5ORIkiTl5BQElwWDObmW2FhLbk6QOR6jT0yxp6anpTqSE/UxMfrEZEdqWnqqPSVRvzc+MS9QXNat" +
+	"c1lxIC8xXnnr3E1+k20ZdIA72FNsJUpAlWg09fYPEXTsjuv6Len32Dwgm8y5tuUHr+dzRziKO/I1DB/fZtRJks7ICa5kp4VS" +
+	"QBcXb0txCksEVbCig68kPTtOkuKys4oCFR19KkFQB5YFC7OzYyUpNjuj2NexIqCepXFak5LiYrRafWxSktXJcwYKc41Rq9Vq" +
+	"jeYonU6DHSmOss7V7ezWGEO8QjNXQqI+ZhbLxscn53jKKW3KPfkJ8fEsS78l5HvKKfXKPTnJ8fHsvBhtSoKLEtcdn6KNSXHQ" +
+	"MIcz1ko/WWOT4ylEaqIHVcEA7ml2AWKQDVWgofK+tVd0/CF35ILnj7tP9n9KjZ+75Rb4stLqNFOmiomLt1oj7BYTG2/rKFM5" +
+	"NjY2Nkzl4gyFvFlFwdD4a5JZE5RkCdY2yYLlbZKxC5o+xQPakrqZPd3xlD2TcyIEpkRvUwUb/tuEdN2jhjt4xFbKtF8oW1to" +
+	"0ylfSyO37c968dQ/bNd/HMqs46NN+ddRVebrPLOGI5e46NicrMJgRQd/cYTTC4M0dnq+OZrHM3+3X2gaUpnSqsFEgvSx8VbG" +
+	"n5Jc4m+mTita+UrtsUlJN6BcfHKOvyQ5ZfEf9Cgxf1ArCKOn4QtuJluLOHm16XcKZgmcnMg52e9CyadOkYehmhnftJE8jN9f" +
+	"Nbr6iWoYsmweWTFvGZ1P3Izq2eXcBmSWd6jD1PMH/Q6zpDgvSMEOxcdm0O23mcN2AZiEPhO62Tr7jvs6Wysn1K+7fM+Uvt3d" +
+	"PTDfI62q31QQj3/iGzEoPX+Xs6TEuSs/fdAI3yfHn4Sib4Y9M6gqruOVKx3jqgY9M+ybNjZXzTe0uer4PVuru25sZzV0E14p" +
+	"IoAAucoe4D6gu5CRVaWy9LAK7kCzPVf88+6ff07Nveutt8iH0ydsggcgd+NN9/2kTMePCXTNN2HWPnKcWLfI8/SwfbMwbB0y" +
+	"IGRU41YrgqAa34s5zJGesn3WasgkZ8lZZgduF3ox9DX5KTzT1xyAqVBJHlHs10XgUZ22oCR6xaBENfuNgrsVZMFNrY4Bmnrp" +
+	"vs+mXkUwguy5MuWSvMC4NOUK2QMj2N8WhGAU2d60gFxqIN/eFAodVrLDtaGbwNwQ3seJ4Y5xTqRGWvmstNkm26FTA/VpAl4p" +
+	"6DU71ODgjpF/kklkEvknzINMyDy/ldz7zXPPfbOEFmcJVDaQCni2gTwClcyHoX14QGjW2bPyK+WpMVez2G7cQ0ig5z9TZb/n" +
+	"Ljt2yXZtvaJT0oLgFC2SRc/DP8ZvGD9+Q8ETQyrqtoKqmjwNHarJ5Z5lQ564sG/27H1zQuffBMfYLhbmTfLmg/e8/c49h8k7" +
+	"lk5jyYU3EYCDXGW+luUKzXUs+9lw0s03yrx62ds5tVtBK2P1oM4Q3Xtbhf/RfuP+XlPdYeTPSmV4u2+afR/kPF6kF6R2N29/" +
+	"gbyxqyDGabzaQOlVjVTck+xkFIOS6L6XkXY2VnoaWzHIjaxK/2OVW04wGcwiFerbrNT8i0itOtis+7c8gY2Pb0n3ngsdPlvI" +
+	"VIS+f2KL8mlL2tjRo0efJhfJF2+No0+QDPE46e5h9ePG1Q9bZ0+cN8+Ko8fVj1Q+jKzvX3hrL7Kr5tbCmTMLb62BIb1uLZyp" +
+	"6HPIeHKvyXaAsuU1ehvM4Bq8mWve6SmjOGhBPQ4U3FldK2wbP21TElebN9Z+g7JwY1shTxraFIw81+aVGSCXrXXpWsrHNddD" +
+	"tGw5vQz1kU+AKkOHxWZFVnmEkccDWeiizO3+i9piv0v07Pvpl93+hDNgBmPoN/hmjy8xDkuJvn3EstufkAAQl+h74T+rVBiV" +
+	"kDh4cHL8SLx7cFJinE5nSUwaPDg5IVYTE5uYRM7+h1UuNNe3YiW/HapCA6kHmxvToTWlpFbP+P8Jj2D9jUnELG1DybNt3tT/" +
+	"G18xN6JiYpjGTeE7O/r/Bfcxzba6o8N+qSKniMwm6n08bAGY9m7R4FeDORoiR7DMJgveXdS3T3FZbR/z7vmbyDvvn5GNd8P3" +
+	"UAZlP/SiUq273nkHFg7sWNqvT0X7wfhJcdH2H/7RYsp7LLnyEgwmu18C7sqvl/bsPndu955L1Hsd3My9zJnQADSf6uKAWzGZ" +
+	"+ftiohRoXgIrQbnQJoanzRyNugJUfCVLzt9dOptNUjKAy/GHshZm0nNjt8e5GFBHS2ZrnNtqs0omkz462mKxxrtS7MG+tTat" +
+	"URQxwzCaaFPnqgKbFKcTozWWuERrRXlsokYfrdLEMGyyc0QljHqry0B9aO+zTTOMBovOqIlh2WiVTq+XYgwGoyZa4DSqRBD1" +
+	"5rhoY3QMy0WrdVqjyWCOMUbrWF6j1kbBzW/wKq1KMkjWWIs2OkYXZ05NcqUmWyVJGx0T52Y4bYygirUYjcaO9riY2IRkV5Iz" +
+	"yRobFxWdHJucmGp1JEsaUdBa4ifXWtOrTs9Xc0K0Vi8atJJBqxfUPB+tEUWDzmJIxCdomC5GNMSYDTqtWn7TGWiYTi2PLWDj" +
+	"9rInUByaQfeEDJ4WslEdshaaAkcr1CnIRvx+Zx1LrYjIC3+fOwdk10Ftg1tNws0mSwo46TQ7tVUuydAqf9eldHtMjCAIQkyM" +
+	"XX5UqYSYGHwc57hHdHuRnBh6S1c1r9HGmVKTuiSlxMXqtFr5xVFSPSjWHqXRRNlj+/UqcSSlmuK0Wq0uNi4lqYv8ouHVXW8Z" +
+	"ClUvdhvhbhzfAtvekiNM12l1MXqjQR+joxNwXYzeYKTPMKmprO+6rFKdTmXRxzlSkuLNeq1WJ5ntKQ678mZXxxvNerMxXm3X" +
+	"6s3xSSl2R4rdLOm0ypsjTm9R6XSlWev6li36nVxo3RjRSe5ltgfqj+bJmrdega5WHLaWSpGrQdeWzGWRNU+kocRA66ZYCi2L" +
+	"GwqEVprzmrZzTUszXvN+TWxm0sln8UD9wC5vkW2VA7LjDRpVtF6TGFteYU2Mi1NHi9GJkq2gqrMpWsMwDBbVCSpXbd+gPcUd" +
+	"Z7VYoqP1+lyrzeqOs5qlaDVwqdKOsaF/G3QqnYYTotWWGLPRqdOpNJygEWJjzIbvjDohRsPzGq2oNxldWp06muO1Or3BoGV7" +
+	"nK5Kt9aOcphieINGsjkSXInJscnRUXHxyfaU+IyE5NiYOHtHo9FoiVUJMUI0446LidZZJKvVbrdZPbqYaK0l1ioZ4jmdmu+t" +
+	"0qlijaIpRtSoVbxBpzVYDKLOEB2l4kJFaq0q1qi3xOg1Wo4zaHUGo8GiEekLnSuoUQX6ifuR/QoNQPPQBnQcvYMIZIMPukYs" +
+	"ALZqHr7r1qiRtkGftYrMX258vPC7q3zZBBL+I7m7ZPjj0N9vrNTs4h+Gci2BNwZuaReWUgQDTLbcXXPhhTc1DvF7S2gKz8nR" +
+	"ztzpMjV3N75I/lgXJQhRKku3uuL0NFtqiqjX61LjMlyZ6Wnu5FSNnstY0gOKXq+9ySskED+8liAE5tW+Tk71WJLB6cUUqzPT" +
+	"leNwpjg1er3OHpflyiyurDVfiYuKVqkZhmHUquiouKgotYq+qNRRUb8bkp1tNOhFlUqlEvUGY7bRGHkxGn83BIbaVjpMUboo" +
+	"ThMdZ7Antk9MirXooqM1sQZHoq24bkCC3sxb4w7CU9ju6t3lb+SRlfoYsyFuSI9iW6LDEKuJjtZZYpMS2yfaDXHRGi5KFw0T" +
+	"Af+tS28XMb3A8iqVSaXiOI6TH3h8XqXVCXyUVa+1J6a7hrns7niDXq9PSbXnupMM6mLn7QPqtGKK5vRpjVMbXTfgdmexOlbK" +
+	"dWckWXV6vZhod/rt2WmpjgSj3qom77CcShBYlcDxKoFjlTdBJb/hdm1e2TZRQ8sElU4vGixmg6jXqQTlzWyR33BBm1ehTdRB" +
+	"MSUCy6t0UbE6yZaYEGfUazQ6i9GaaLMqb/YonaiKFcm/nv81t35dh+TkGF2UXaM3xiUkWm2JVqNFp1HebJI2Lsr0/2PuP8Cj" +
+	"KrrHcXzO3LLZ3ZTtm2RT9mazu+lts7vpAQIBAqH33nsX6VJFpDdFugg2BGmiCKIIKCpWRBQLvirqK4iA7VXJ3sn/mXt3k00B" +
+	"gp/v8/z+4mZ37p1z5pSZM+3MGYz73t8j89tUk1LJcxyvVAZ+SHPUyurL3CvcTTQBzUfr5HG+8S6r+NJ7YyNrSEFNNgNLL2kW" +
+	"nb9987aEAHTgXIPs4iPddioBOh0QfOpBKhu+ZrBSqdLqTMMenD1+XK8+qWlpyf16TBg3Z8aksb36pKanRzkHuXJzM8cMnjd3" +
+	"xfL5M0YNz3W5skYPfWDeilVzHMWCNjSKGbxrY1suQogLyXbEFOdHaAxxWYkRES2NielmA5PMaUCljYxP0EXC/V5DhD7UooxS" +
+	"5kZEW8DL85GWmMhonV7tcmUPHTZt+sMrp08ePSjXn1q5ZEbLko3jlyzdum3loukTigoL86ZMXL70iY0rF02fVFBYqGvRIysz" +
+	"NSFeE/H+DVgTYsgraNksJX7OfYOtJkucMr08KcYbmpBSnBflCDFGZAk5sVaOZ60mbWh2ujvREarSeQpa5EpnrQJ6ikAxKA1V" +
+	"oNFoqbyW7bTXKMys99sjPVWJXrI8dk9AiXdemtcHK1cKqF1H9867rL3C3Lw4joNQhVZnjU3ThnwpJDkHOJKtpRnZrvRZEArK" +
+	"2HzyUlhYapxVqxXzIiMNepVKpdIbIiPzzJEGA00YDJGugE7Tevf4YnZddddV7bL5M0cOz/WrHaa37RQRIcYl5KRnJFpNSoBd" +
+	"CQa93pAAi5RKohYV5k5tF7G8yZqYkZ4jTFOHGs3Rlvg4S7TZGKqWU3HxUgob/YpdPGv60MEj6up8WD3tSqmA5hGgcSiNe4J7" +
+	"DqUjpHd7nS6zx44Zl9lltMmfHMl1KBUULqNN+th0+kSnwuZdLBT0WfQgQNamCaMWV/ZZ1vXh66WpsbGppdcf7rqsT+XiURM2" +
+	"ZZHqBxf1KcCfJXFfvBOmAgOM2dBpYacz0do0bfSZTgs7bYBBEKcOfecLeQ4/DX3FrWP/kaI4JaBkSpMSBAjq00DaZOdAgKDq" +
+	"AFoaqICeNWLWwoqqD2EFr5CWXR0GRSosIg9gcppMYbPJFJ73r6/zaWQuLGSIk7MwoyCNfKIMoeum+ijlc+RrvJJc0ur0Y8l5" +
+	"SA9RyOupiucgQbwPBL1eJ/6Hja26TPdpliDEqrgbqB06gz5HV+TzV9IQz2WuqWmyoaEetJ7a6hl4rGD4eGkIYZaG3UZBrrt0" +
+	"fAjS6NAQAXwE1EzGbAlybY/HpoB9qpmq+X0F5X/ShVqGmvovAZeC5Lsog9gS/GTRG3hyvZ5MkAnTe5wQwOLKCTSmWl9Cms4E" +
+	"eFgwaDJ1NsBsZKJFF2+MtEZoUiPKWiaEhWk5TsmqOeCFSHtOtBrUTAgwmAFGj4mRVQppgKM1uJyxWRlOFc/ps00hzojklpG6" +
+	"iLAIhcKi1vaY1aMbq+MYg4ZjUkdm8g6LVs1zWJGWGKpITgd9GGQZTVlKHDUphVOEYh4r+LDoxNBwoyYthAGlglF2BYD5oA4D" +
+	"zITwoAaG5GisuTkRDGiUKgarWX7wfeG4whamCg9h1ArVOPyzN1sR39+uAsic29dWlBhjsXKh0RNfGWg3akvT4jUPFfBqVUpS" +
+	"XGG0bkNv8klMsimxV2LqnNjF+pXF7V/SCZ6yXo5OvfPw0fyWE+M8p2L5CHXYEOeER3MiQtW8Oj4v2kWGl/cPDdGzdIEkt5uD" +
+	"iTepFKEmh1UXmunOSixNt+LsZhatQ8tzGqVrXITKbG4XoVZxUQwXVsYmdMo2fapUP36kIMvbrGWBu1dbTwa01LpIX0NqenZB" +
+	"WKhWo1QazUpuss6Q2TxPqVOpdFo1PxJJ8a4daDd3hhuHeJSP2qNJ0onNpWglQnqTpFBIkGsPHfRGgF5yIqzdm/HKg12FpH+X" +
+	"FGrQzUsmGmSwCKkGeyAhExTUKzbQcdLvHBnaX3N46Z1RuqyX83gjQDbdHncup/A6TkXpwiOYyJQhqdEJS8pi+1QkdPplKh9W" +
+	"EmXuNqGgKN6ambc02Z0QHbrnZWdiNvdup7z/DBEj4iwKDtKsETGdPsmK16U4E5nKTPPno9rldwwX2k/NM8XrRpbll9l7JKdm" +
+	"zHGkm8rSk1el5olcu9B1nYenxjiN5HRaNx5uMs74eKuGvBXOaUIKh94HoTprdOZPAxLm9M6MDsvdk5MXFfvlkti4RYc/fHSc" +
+	"/Yp9YmwETI0Lv+Ke2LzY2TtRCYM12xbv6oZTwkw6jROcrVPTw9ShvH3g2DFzFbFTVj4wpv2gjx6ac6PfyNPtoptlxhmYjDz3" +
+	"hqFJiZIP6C60mfNy+5ERWSS750JdqY8fSMYiE3gbladBVoAHSiEC672gt4OeAxNVH5KUqACTC2rGNjUeMXztnYv0yAld5XGy" +
+	"Tyg4HjwGS3ZWC8MFhUIZpvJ9sTR00bLQaTdw8dPibvGNCFgLFwuxWAWzsnmSOQI+jggTD4SGh4eHKsNCFBwXogwLDQ/VhIaE" +
+	"hfAMp1QmTcGsSpkL0CwkVKPiQq6b23i8MZGFmOfwPxvXhW1cB7N04iJx1Mt4DigN4u+QrwrFJb6XPEKEFvOTxVs8vhqmVnFs" +
+	"iDJMFa4J14aFq2iK16jDNOGaP8LFU7iFJK9M2MD9ly2R7reySjc0tELtEUL+LVcDPaFgMkoVmHM7FUYz46W7GE4QqKAElOsU" +
+	"jEIiFUgJyMta/qk6NZNmhZSL07MMzypf1Wdk6F9Vsjzj03fv1m3bNiziGPF7kdkGSXiiKV7sgv+XLm7GI3xvsTiE1+g0CgWd" +
+	"eCgUGp2GD8GH2+B98Sb8daRapVTwcwVD3po8gzCXVyhV3S9OmnRxEox6m+wle9+GtCizJYq8MSlyR1GSThsRrtHQlaHan7OG" +
+	"RVnMUXesL/4VBZOR6j/HLBtrcMZjp8LJgJ0Bp5laeYUUzh7ob6jXTdUOmOReQg8empV9IkQVplQoLhhaZGVbDB7gOd+7D4Yu" +
+	"WxT62KEIXIR7Pi2e1oRiDhcK2XFhESRjBJzjxcGhSiXH0NW0UE1oeGiYMoTjFCFhSlqJNHARcpUq0ccplRyPCyNjvJ425ush" +
+	"nEoTKkaGrdsYtu7CGVDiOS+Lo8RF1+bhklBVPjzs4cVbkzGvjRDHh2vCNWFqDR/CcipVeJg2XBOuClPSlBoicGgLLJ6ie3Ed" +
+	"YTZ3H9sX2ahXqU7jZO1gtNMFMP/YsWZiWwJeuxESOZ1B4eHsXO1gM2hSzdlZ55x0UMJ1nC7+w4Vq1ZbQMKWKo3wpw0Itam0o" +
+	"hxXieaIjfy0zboH/wnLyuiHKHDxcNOeZowzQjNxPorbgttByEDOZ/NI1JkQTwvO8SqkJs4bpFCqe50M0ITFdyS+TGWi9rDcz" +
+	"+WhkzbivpGYQGHl0MiPvEc6B57n72GTkQAgoHwpPrtNEeW1swi8NlJkH4YZ4nhi+Tk//Gq7hdKLlw0O1EY4ITVgYXWYLC9NE" +
+	"OCK0oeH4zRiB0jHoG4lWIVYbEU4nyrR+Gg26mhSlAwzoDPshp5Iiu8qGX6eX18IVKLDWResbbLTpEiNCQ8XP8mbFGGLJk+Qz" +
+	"Q7PmofHJmaVOvKrZDAOcAlObUGV4MskSfYl8xLJNzhRnpMTrBXSIy+FCpfi39FaoHK+uxughRaOF9O05xawyiK9OdDycnmN0" +
+	"NS/txUQ3mwkG2La/IoQx6Sxi3Nfto0NXHnoo20pjO6Mr6BHOyjX3+3gHbuQyM4tgyzwynAyfD1ukP7B1Hr5KvCvhHekPvLOS" +
+	"5ME7KyU6t6FSbiy3QcKhZmOgI/mJHCQHyRXoQA6BGTpCR4jEV8kK8g5MJSvAC1NhKnjJCrifvIMAXay+zoVxX0lxtyWPnoBh" +
+	"Z6RGGgG1LZVnaq28To9r2nSQMxA7Uxmu0URHhJvD9ccPtF2gssQwBrParBt335a3Y1sKTIaKjTry/vhEpVYbHm4ICdVExyiz" +
+	"O85/2ch9pdFEuyxao8X8Hfn1zA1DqDnKFGqI0+iiN3/0DVnmSYkp7hQfngIloIKEbTZVeHR0rDZUE/fsxpffk32+0HcIcRO4" +
+	"q6hzgBezKZiXmo4qME+jl3zxgeEqPeHkZ87B1GdN5/Ww70VH6hSRypiwsIjPzy9eHBGtS8ChplirRRFmjTRGdhr/YccIvVYT" +
+	"jnUaU7RgCQnRGO8/OKG3wRhuMKeB0hjhDB245KNv1NzVOFMBcKHhYcYkzZfkrfd26iKzo7VYayuI1KktMQNPkKol0dgRHxYa" +
+	"Z9Na0pmwyEhj/IFPXgV1cUx0ttUZHpUT/cYxCPkiFDH0Li7uSe4CUiOzFDufXgrq/2ev/SnQ8wPSpaeC1sWcJVPpLRuwAuaT" +
+	"t2EdmSB+TybCWhxzmCYO09+HmTOwh3QnbUgPeA5e7iQ+S1rAa7gXnBBnvk1/vg0nJJnXlo8guLyX4RoxkI3ECD/DKOYQmSR+" +
+	"jRNgDbYhXAPDoAi6qu22eYOow5s+/fQznCu+d1F8H7su4vuuw01xFZ5yE9+HGsAKWiGYS9wZRpDNMDK4bGyDVRBUPALIQoj9" +
+	"PEBzkFwmwSiy8SLZCKMusq1u+vYw3W/CGoTRrwix33EXUIR0o6zLTItyam1O+m2m7iEUiUsruJnzS5aQ38gLS5YsgXCoXHL4" +
+	"DPkNws9AOLt7A1klDtyA1RtgCqRtuEXng1+JA/ETXyEG4qT7Mero0EbxaV1ap8t/JMGlFZhaRwwX8zQ57vNBS99hn4+k+Xzw" +
+	"yRof/X2YHQ+R5CfyIMUNczqJ39MfOAYiSRu5PIisozfaVmoWsGxaV81ybc3qllswJkhDl9ja1Q+X1uZ/nQo1y2JGwZ0jjXfc" +
+	"+OTEPp07tShMsqanWx3FJd069J+YYGtW1L1iMKscXNGjqEWLCQMqu5WU2IX0dMFZVNq1c58Jgq1ZUY+KwVV/Da7oXtSiDO9w" +
+	"2Nu2GNRxIjtnYsd+5a2dibOG9enXukWqPSfHntqi7eFhsxKdrcv7dZxY9eDEjoNatHE4ZtfLQOclz1b/wA3mfkdqpEUGZEbR" +
+	"KBZZJSnrBUYvqEHQM4KZ0Qt6NShcNBifwsXZzIxToffaGPYsrDt7lkw4+w4Zf/YsrM/dsAFSH310w+BTW4cMHjzkNHni1GBR" +
+	"+sX9TuaQB8gDZA48CItg0TDyLfkGrBAP8a8Me+UV8sDxocch/vhxePAVSf7tqi9zr3I3pXMogaVuv+WV5exfFbElBGRMX/nN" +
+	"b7GkMvrKP6py4c9aJmXnjHNlOVvFPjOhdEyftNTUtD5jSic8E9vKmeUal5Od1FJ+kZqamiq9YGKsx2aVTxuWlZWVNWxa+axj" +
+	"1vZpnrwZeZ609tZXZtIX2dn0xcxXal/Idray+r/SmtsiaTwo+UFLQTkCIxn5LKLJfwOCV/KSp8eDasY4gRuIeIWxJlcNkCEY" +
+	"ndNWk6sGKCEYndftwC+GK09gpS46tiI5uW1sjEaj0cTEtk1OroiNCw9hTijDI/ThOoclLi4uLjYxsWtiYkxcfHxcvGAM1UdE" +
+	"KE/gkPA4CloRK6/DyL+jdUo4oYyI0IcahXiaP0YCjaVYLA5duB6v1xo1hpQ4gW4dJSX1S0qy0p82uznMqNWoLmCVIdbaJSO9" +
+	"izVep9Xq4q1d0jO6WBMilMwFlUZrDDPbbfVA41IMGgr6CaOMSGgIGmtQ4U9UCFBF9WXuNe4mjfMOgXVIfxS0Rlad6T+jv+EG" +
+	"v6/vCUnbuqHuAL2RHV76z+23F5KabuM2aTKb8Gc6oxAaYVQmJur0thh3cq4rNUtIMOh0hgRlpEJn1+qKnblxoWqN0paenpOb" +
+	"mpFgNen1JmtCRmpuTnq6TalRh9rTUwp0UbGh4WalTU8hhayUkSnZVprQJSpNEWqbXpefmmGjaBL6uHJT022xURSLLT0115uU" +
+	"kaDUcLrE9FQmpizNmxAeqlM5huUVZOU4rDEmU2SiIyeroDg9x6HS8Yak7KxSg0UI00SpnEaDweRI9GROyfQkOkwGg9GpitKE" +
+	"OY2GlqkFQlioTuXMyckryHQ5EiONxshEhyuzIC8nx6nShYYl52Q2Mxid4ZpIVVKSweSwFmQU5GXJaEwOVXSIIclgkG3AD9yr" +
+	"3G+Q5R+vBKReV4nUr94vZq3gF3ZDRd/Ox1VKN7Y07Y/YcXughupv1KdDjgpy+0XwoHRwHbn9Ngm9n8G/WQL1MgUBMazFGKnR" +
+	"qZRKlU6lM0dZTGatlEo0maMtRpMuTAnKiDCtSmXQ6szmqGizWadVqVSKEJ3JHBVrMOu0aqVSrTUYzdFRZpMuRKEyaCOizdGm" +
+	"aDmjShsWQf4OCYuINlqiA8h1mkijxRylU0k5aMojUUGzq3TG6BhDpD+jNtJoiczUhYaQfyJC9SEqlVJnMEpk6rTqkJAQpcEY" +
+	"IIru4tCSo8xmnTIkJESt1ZlNFprSKSni0AhQKEN1ZmO0xRiZqFQqlTpdpCEm2qhTBcoycL+pQ3WG6KjYmKhogypEpZJT8eao" +
+	"JLVKFaoP05IvVeGGKHNcbI7BoFap1AZ9dGRcTJRBLad0lsjYuEiLzqSkKbUhKibao9eGqdUqgyE6MjbOHGUIV5EvdGGacLVa" +
+	"rTdER8XERkUbdKFqdYhST1PRUQZ9KC3LEBVNydArQ9RBROnVapM+TAd2ZbjZREuO1stl6SzmuNgofWhNqshs8ZesNtCSLTqD" +
+	"Wq2WCI6NM0frw1Vg14bRstRJUdExUbGUDJUqRBVElNRPdai+zB3n/qQ+rfrAyCUwWAkMefz2s8OKeYFdlCHz5q5YMXfekNFZ" +
+	"Llfu8FEz5uFr/v2NwF5W0YTpi1Zue2Lp8olT8goRDiqnNKglBypuoDH4G4dwF0qged+uHcpaJAhCfKvSLl369evSpbRVvCAk" +
+	"tCjr0JUcuiOdDntFmz69R4/o172yjdPhcLap7N5vxOjefdpU2B134SG1+hfua+43pJTuH7ErgQO9ndMDIwCjBAaEVLgIABg+" +
+	"I2etpJgUC7Bzr3geTu2F03B6L5wSzzNPLYKzxLOIDBb/+847OIq5Jr5AHhEPwzjyCG4P4yS/5cB+nVLy9a7BztxlH41htxA1" +
+	"PLoFNsCGLfAoUW+5w7YXd1W8Tl4Wb9BYQlgLbXDkHTeoEAriPxY573aWh9ELoFeCHgSm2QxXbmqq0Wg0pqbmumbkulLTDCaT" +
+	"IS3VNWkvTicle6mk9pISnM58HxmdnpNHjyzl5aRHR8qp5i2kFHbUkxQTpA8nyg7WyJ1PM+nvoK8RwfTlBlM+8S6ahK/vhXiE" +
+	"6tCvl9ZQ9IJC71TonUIthUFyZHuC6dvu337b41sw9ZhP+kOXBdAFui6ELmTAgnqSZNuSz18iX7z0EjhegirSmtwgrUFLbsBR" +
+	"0OJVTaHF7FKYnQqv02WBAAVeRuAYO8fYBYZNmNQnoU+fhD6TEuqVvHck2Q/a0aCR/pADTNtpbae1bTutLRNft9gqsu1Lsu3L" +
+	"L2Hol3SPOlA+RhwKQWoUjiIRsoPbDl5gnHZBoWXM2hphwCHoIl6CLueg68Jz2EYGLJg/vx4hm0H7+xlyo53og6PtzpzB80jr" +
+	"M0y3eqxjlI4iuf9wR2QveyXonXZw6kHQm/UCUH6BY0Dg/kPGbllHzpP167aQsfDnlnXL4T5x0kwyGwpnQhEUzYRCMpuZTBtU" +
+	"7u/k81ypRelySQKMIsOqyTBUXQ1bUTUC6Y6cdf47p+j6gz4MGDu9H5NJBz2eClaYsIask/6Qb8j6NZTUNSyCCyTlZ/Lz1Z/J" +
+	"zz+TFPj0ZzD+fFW+gwSjhxHi5nMXEEd5AIXZ5XVy4AWtWQtmJSiAnhhw4o3jxl0cN44cJ/3Hwa6L8OQ40k/6c5H0H8cc+/Dc" +
+	"uQ8/hLCPyG/YAuKPEP4R+Z389hGEiT/iaPL7R8HzYTNCNWjpoQRalksL9DAEMwVWNiNT+pAppbBS/AC76I9VfWBVMzIZ5+KX" +
+	"SXlreEVchSfTH8eGwSutSTmeLK6GY61Jq2EIUH71ZW6P5KuAqK7DQAlqUAJd7MW/tYanyEkcKv4BpaRv69akL5SKf2I1OQlP" +
+	"cTfFozCMpHXqSNJgmHgUtyZb4ZOOneATspX6mgXmkrX1S57rOhECjhHsHFWDngG7XuDojo6W0Wv1diYM9HY1cPp0YDj2fnJr" +
+	"LFaRvwmByRMJGTuWrAZ+rPg/CAFMVk8EPHbsaui/HgaRHevJrtWrya5HyA4Y9Aj0Zx+cTb6ZMYOMANt08TjYIZ6MmA3x06fD" +
+	"FnJpBm5FviTfMAoy80kyZ8fjZM6TZCYseRIefHwHPPikPJ98tvoyN5i7Ka3HUENHqVICx7DD4S8yAdYR5fDhRAnryXj4i7sp" +
+	"Hj9JJ9onxVdwq5N0qn0yeD4tyRYob6AGoLwBw46Ah/6EU6TkTzJrxAgy63+kBE79Dx7ibhI16EiHKZNJB9ARNfxJrsOhyVPg" +
+	"ELneAGddeTHsJug6m3Yws8nzmzaR52eTo9B6NnTlbpJHlpIle54jS5aSR2DcUpj53B6YuTQYH63Jei8jY9Q71aDQ+7GaGXb0" +
+	"76VQ+hnkkvc+IydLfx896o9m5PXPyPvg+gyaNfuDu0l23yDbWpNf75tKfm1Ntt0gu6HnDRjaGiKm3gcRrWHojeD6nCmd0Nbq" +
+	"zQyYwW7mBKPAKeygAL2CnrZhnHpwAue0C27B7qUti/HiD8ZtGrYJLO3JDzAlhayAdWQKTEohq+ijHzcO20Rfkx8rwUJWpcAU" +
+	"MhnWkhUpMIn8txKiNg5jzfAaaQWbMsgIKOhO3twzcc+M5ybuJW90h3wyMgM2knI4TkZkwBbyZncoeG7iczP2TNwN+d3JW7Ap" +
+	"g9K+ofoydx93Ez3e2NqI5EPYMBQs/ScvVtMNAVdO3bfyph0f0XAhhXaewUMOtz/MLP1XKt3ZKB1fTaj7VvZroGcrPy0LrLtE" +
+	"xBiVIwpsxZ4Mg76ZRceGdchrxXEq5bQ+apZT97uf7uG0yusQxuoszfSGDE+xrWhoWKQpIrA+0ypEEW0MG1pkK/LKGLjQjnkt" +
+	"OV6pohgYVd/7VUqea5nXMZSTMXiLbCWDVZHRCuaR0PnTyzvICzYdymfODeXDzXzLWW2dNkVkri3NFNk6J7FAoTcYDYrCxJzW" +
+	"kaY0W26kwuZsO7c0JErJR8ybGQQ9L4JhwowhpXPrgRtMBr2ioA74wkI+Si3fpRrQWSw6epfxU2YTdZoKdVRaCEHv7l2jhVBH" +
+	"oakQ9C5In4y54d4Ur1DQXatOd9S0qlbRKqWqaXr2ZNbqWS3DM6o+05Qqda2WMz01Wu7UcDtMqzMYpVT7/29rAKAt1Ze58dxN" +
+	"6bYH6ssTOPcjS7aBxr2ewEvZvzECzKbgNFVuQLO2GtUGvCEDqL14d0ra08MqumqiHY6hzbt2cDbLSR1YkF3xWEVW/qDU1DZp" +
+	"bbs0H+pwRGm7dBy0PTc1Ne3pYe26aKMcSYNbduzoaOZKHZSfVdG+Y3l2wcDUjJapFV1p7mhtlw6DnsjGVy0xGmWWu83Etm2s" +
+	"Rrdr6E4hxuvd1G/QmNyOHXPHjOixurk3JuaZkZluo7VN24lt3K5Qg9USE4BpH2PIrQvjHSnDxD01IovCtB8nw0hn59ZWX+am" +
+	"czdRONqEDqJ3Ap4Z0rYqFYRe9sxozKzdTk71TRat/7I3TWPG7DYqql+g5Lnt/1HTwuV9AXuQd6zc1OWyGDbKJVjS2qdZEnIe" +
+	"02T1jRU0EZ1Lu2hVZucQnd5V2C4lv0zTIaCISk2zwvQ2RTkG3VCHSaXpWtpJEyHE9svUaDL6xiVEaDqVdtapzY6hOkNOUUVq" +
+	"Xpmm0q/vDppmRWltC1163RCnWaXtUto5QpMQ2y+DHC8usEQrQ7LdbgUbqveqVDHOUpMAe5tbzaWOGLUyT69mebcnSxkSbSlg" +
+	"zg+tyOncMjo1Nbpl55wKclhtZSDJ3SbNmZ9WEcc7skYsTVYZus0JqHRQvzndDCrn8pGZDt7aJi3fmdY2NwkYq1odz0CSqyLN" +
+	"kZ/exlofLndMMFxcBYVr404CJn6+joWEBGekUTAnGtmopILuFRXdC5KiWGOiWTBGOhMSgKX2dihC3NPc8ygWrbyLvXXeZpmv" +
+	"8fPwjR+Hb/x4/G3W8JhWwWEqvMEBLMblCa7M7OzUZLtBr9cmCa6UvOjI1LgkrV5vTExOTXfnpbiE1Ag1x4bVppO1er3ZlmA1" +
+	"mL2pufE0ZbQnSe/cdgUXDJmk0+l4Vn2H2Bd6t8ZsNqQ6PdklBbmZGY4oI2dOyMjMLSzO9jhTDUZjlCM7LcHuEAKpjMzcgpLs" +
+	"AluCkTVaEuWU/11aeHhQyiFjCUd8jW4moGVoFzqBLqI/ELL/S8nefZG3UUXiHKlTbXAag/ZyelnVDWtM4JB9I2F5zNCgamXK" +
+	"DrJ6r6ehP3yE7LDOsGxoeJqQk+p1Z6Qm2Y16vTY5Ni0q2puaI8iKTE3ypOVmJHh5jlaIhJyUSQE98k2sK+ULvQpeGxKqjjTH" +
+	"WJpbYk1RanWIhlcUW2MLUyyR/U1hoaGqKFOs9M4UdpaJ1DnGKXhrjFVKyy/1YfC/7HCjOTa6uSXWHKlWq8ON/SMtqYWxlxaG" +
+	"6+iT0FDptRATr1CMc+jNf9sT0rIdUUajVJuKC3MzMxLMXE2N8TgztGazxh0WLr+jOWvqSFiTaiGTF82wAzMNidY4Cz3bFh5p" +
+	"ibMmGjIHsow4lA+Py4hLG2JOsCbaat5HCrDTZG5b6soqYxn5QaxTfq3E5I0ZQmQNHluiNcE8JC0uIy4cEnVYKT90xspZGLYs" +
+	"y1Xa1owQQqqaeUs66osuAAsuGAJrYT9cwmppB7Dxo54eb81ZsdqDE3ZeUXuAQj5kKA3yzI3WbKjfLupsIdZvDfXh67eKOruH" +
+	"9VtiPQYgMIZUOOqerQs8929J+lmBwADWv9cpt56ImsfewKaYjJPhFXXS/lJog6pzBM8rtyiQm2SNOBWGwHi13t6cjPOO0WKc" +
+	"el5RK5carmXGGkIwtzUijVkoDBx+XxOiDFUrFTzD8AqlWqUO0byPuaOCLirTIG9XGCLNUToBn8XcS1aNJdJs0NGdGJ0h06Kx" +
+	"vsThsxF8hFKtlA4OKdTKMDUf4WsmRGg04bFxiYnJTqddCNrHTbAlOZLtibFR+oiIcFuOLSHOGhYRoYmNcjrShfCIiIjY2ER7" +
+	"crLdbg3awU1MsCcn2RPjYsM1mghruiMhPp76C9JzmvCILny+ilOo1WGh9IQXx3JsCBe2M0z1VFyYXqkCpVKvz4yONUbqQ7Vw" +
+	"LFy5K05tqHkcY6KP++si5qpYpUodGqpSK0NYFoDjFFzoClXozlCe5zgAlg1RqlWhofQwoWq+RjtQG6aLNMVEZ+r1SiWolPrQ" +
+	"OPFtjba/Vm2INMbWPDaExu1Shj0exipZjuXombTQCKVawamg4raRYrBeH4mpx6tKHSlzhCMN+hylSqnXR5o8ZrPeoFQpe8uP" +
+	"DHqz2WOK1OuVKmW2wRCJ5VKoa6kKcxhvNpliMM+qVKrQ8NDQUFUIz+JokyFPZTCZoiOLoqMNJlWoalikIU8VqjIZoqOLIqNN" +
+	"JoPKYzZGY5YPUYWGhobTXSLMY7Dak9NSU1OSrYJGo9Emumq3u12OCI1GI8SkJ6Xbc5IcGk2CzZ6UkZOYZI0z6BzOtNS05Mag" +
+	"nOEUypqcnJaWmpyoq4VK0GhIjDrscBgfIkmfUypDQ8PCQkOVvGqVVj9aH26IjoqPtUSZjAaV2hAu4Bk6/Uh9mDHoqTFMeDE0" +
+	"/FAYp2ZqUWiUYUpORex67SoVHxIm41RyVOOskg87HK46KoQb1CqD0RRliY2PijaE6+HnCPWLQpgx6KkxTD9Sr1uu4tTqYBSc" +
+	"kgu7cIeYPCSRx99rQ2hVVdKjfkpVqDosRPs95t+362Ojo0wG6sFpMEVFx+rtTDTm37Hr4oIfx+ns7/D4G60iQhVAEaoKC1Vo" +
+	"5Xge/r2aWFSE+qFxd53X32YPx28m9HfZ4jEz8oKxBaT1Yo4pnJCR6XRSdwGnMzNjQkaWw0kPLzkdWcM7lLTKdsXGxFjcuS2a" +
+	"t+tQXJ4lp1xlzSvusDG0YiV5aj15Evqth74rV0Gf9TSqwXryNKs3RSZluNxFhW5XRlKkSU4VFkmph+ITPHmVbbt1aF9RmB8f" +
+	"L6d2ygkYf6edpRDywC4yb/s2Mm8XeQAW7YIF27bDgl2Sf3VgvheC1qOt6Bl0CJ1EdCFa+/93870I0PrP5OjtNQND4Gqmenp7" +
+	"0CzQlRMG9SZ/jv+P5n2rHGkWwbUNeunzlOoYR6nZSrqS3TXzv2ZkdyircLuzQ5TRlgLYDb3qzALh6P9HE8A/SWu5ZDh5yT8D" +
+	"JO8HfoHrUs0ssKr2JwK0CyFuOHdBulnXKd8p43YZzVr522tWaL3yt1nhVJjlb6PN7dTK3wqnV6uQv51eM954cZ9nxgHPgXH0" +
+	"e9xMz/6L4w56DtLvi/s9Mw96Do6j3+NmePZdHHfAc2CGZx/+edmkUZVTRk3Z8s1yME0cVXn5cuWoieTnZd9smTJqSuWoScuW" +
+	"17wnVwPvwbQ88B4paPwJbg53AcUiAdmls3nZyC3Fz7NrvWZFGJgVTq1da9NyWoXTmw5Or1nLKYxmGm2M3v/vtSvMXk5hLAWj" +
+	"2Y0/GAf8Dc9NUJBbNzw3yK1x5NZ1z3VyCxQ3PNeB37yZfkjqls2bYfKmzZtJyubN4zZzF8h7u8mBzaM2P/vsplGbyf7ddOV9" +
+	"N3TePGrTs89uHrUZOu12vjn0zaFD3xw6augQGr59yMihZ4aeOTNUvtvnfsRy6/x68Mtd21Du2jpy1wbk7q3RnFbSHP5g3Nw+" +
+	"F3893MnTZW6fizd/vdhnbidP18O/XOwzl764friLpyN9cf1in7ldPZ0O/3axz1zuwqjOzy8nH2wB487cUe7Ozy/btGX5851z" +
+	"R7mfAONG8tHy5zuPGt75+eXg3kR+fsI9ytV53/LNm5Y/39k9yvUE+XkLeJY/31nel1lT/QM3g7uJliAEwTZIYW70Jja6tKql" +
+	"g2JDOEj3ctFYW4r6WfwDRq/shZ1R71o3s1a6mkJ65L+bQusNslzcwKioyOTUzOxkXcTnn0fokrMzU5NpIN+k1MzM1NRIM/O5" +
+	"XpuSldV1cNfs7GSt/nPWHJWSkpWdnEzjmianDErRRnz+ebguLSO7SzZ9kJWRlhJlZj/Xa5Ozs5NTsrK7ZmWlaPWfM2ZzGkWe" +
+	"wY73ZLgzMywWizpi+YoItcViych052ZmWiyW6MwMj8m4glxRqC39xvXzePqN62dRK8C8wmjyZGRGyzkC0CuWR6gtnn7jal6Y" +
+	"jCvALINaLH5YckWCTY+xWOLo3l7P6h+4Q9xnUhT3CKRHZpSBWqF+Upwl/10n9NQA9iqxU4mdoHfUlbNf/MEhjIM0wQR1HEGK" +
+	"4IK6giDtMcs7TurYcRI8QHZVQj+xN3mqEvrgvuTJSugv/kqeroS+T7vCDSxgJsw40hCOMTDGsNzcMCO9sSncMNIYxmBgDeGr" +
+	"PSahOLqixFIYm5YWW2gpqYguFkzwiseYID0uoI8LpMcJRiZtTq9ec3qJGfBgdzIHV8CC7mQupJM53eFBMYzM7Q4LIKMo0t4y" +
+	"tkuruObW7Gxr87hWXWJb2iOJodHHYMiPMFMiI8xTzBGUInNEfsNHCDUu/1RUirqikcHyNyuxWYkVSqwA3EicCl0jz1CtcGte" +
+	"JDbyjPlw+rPTpj0r7gF1JfkT94fQ9uRPsYec+gvU7ckfCq3eljw0xUbvIrSlDE2Wf9R9Ig4dnpYTn5gYn5M2PC3bmphozWb+" +
+	"0/BRu2MPPnjsQTEPkruTz5j20hfMJZ91h2RxgPTFtJ2U7bUlJdm82YEfVS80eIR7643OjAkZTqP+9j+QvHct+UCiUBSFHMiF" +
+	"mqFK1BsNRuMRAulQuN1tlxp+Lt3D4YwcrYognUwBp55WUvkGeTAz0nBDWnrQKyTPQZCvr/ECrflU4LHA2BgaesGvORrUpBj0" +
+	"Lr3RoOBejYpOVJJlME1ps0TdWh0VlaiEaWSZMsESxT5lieLJxWY/8dF2u69ZdKQCkppd4aPsiTHRjsRo/kozclERyfwRbbdH" +
+	"8z81gyQ+SjxfYYlO75Me/ZbUZvT+FL6WEheTMywnLi4pKS4uZ1hOTByujE1xxioIKYe2ipiqLVIKcDk5oohhh8fFKMiRcsAK" +
+	"S3JK1adxMQpoW04ITXFhsTFJKjIYHlc5Y8XuUiPxDaXP4HEyWOWMRUE+pnT0DvJQj4Hg45v+ODP+ADMQfDKP0QdtrOmZ4EOe" +
+	"cjAov/c5F1imcUnrhPIiIsM6U6xcOSAdzkwvslgyVCayE/ab1K3iUlKsZbxAesLQKJxhsRQ53UpNVTlnTalMjWfLqzTKzPTC" +
+	"GEsmjoIhpFcCX2ZNTo5vqTbBfrLTpMy0xBRai7AeVZez8Sncb5m2CkU8SYClseCOjyvzZip15EoHNiGjV0YiX0l+MIE7uywu" +
+	"3q2MgsUkIV5RYcvMtFWEx8FFMjtKSUHaR4MJLJV8YkavjAS2A5h1SgnEIEAsmQ4X48IrbJnSHmff6mt8FPcTCkM2hPQaJFiR" +
+	"1n87JfA2mtAlyhdKsDqjBgkJT8MoVA0IRj5JPt+6hVx8Eq83mcn3ZNxRaL+HtvV90P4oGUu+M+Gr5HsyCdZAzAvQ+uBBctTX" +
+	"GcMJKD0DU85gfIaseZucJM0RRuOrt/IK7hPEUG8J6lxjpsdPXFqBG1LVQ9OHK+1z62RXLuPWx125T4bdSpPoHo8Qr+DzamHs" +
+	"9LyKW9BSGPbPWyf7cKUSjHaYHyi4HKUMo9AKZrmUClrIV4FCFt6qqFuGPz8naL21JdzPJdZSVUHv/qyu4r7izvvvJ4mgMUzD" +
+	"N0E2ZJMPYT/kQBb5SBwkp8mH8je+Al3J8+R56ApdyD6yB1+F7mQP2UcS5Oe0vuMjxMB05g2IoXMpm5bpvIk3/I1CpHdjiYHZ" +
+	"HngnaJlt5AP/S4CPiYH11r5jPeLWwDu0lUnCeu4C4qUTR3oX48J6smz91IqjTBJMgFJyUh4vjWOS4LKcTy9oGZveNg6mrZ/a" +
+	"9mXuAllHTkIpwhQX84GUJ6wGWz2k8ApZ+kgQcrJO/pZ0OY5JYrYH4P2lQJ3C4JW6hcIEf+EUHuKqW3Cr+Q9RR9S1ntc5H4hE" +
+	"E7xeK8/vtYLU8D0uwe01Cgqb9nbRTrQ2LX4kxhxp0FOnYr0h0hwZpdXSkGohynBdhDE2P695crY5klx7e9aYq6fAMA9y5h4W" +
+	"nHlFbcqbDyxulpRuMJlMqQnFLpi7Bz5ShxoNsTQgSazBGKpWhmg0ekOGwRSu45UWIT2vuGVLGDhv4suv/fR2zq3ZVV+O6Ta0" +
+	"WbnTHhWd4fIUNavMdnlTYyMhF/cQn5N4n1p9iX1E8r9x1+M9iAfdnSO1s2vGO5w2rW72jvtnbJul0yYIad0mT8rMTErW6/X6" +
+	"5KTMzEkZWUlJeoNBn5SUxd1MCk91ZGXnzhm26IMPFo2YlZud5Ug0JvnKTZEpGbme4iJPbkZKpElOFRVLKYnWtOpL7FfcTWSX" +
+	"b6ppuNracHsI34XyCTdkepMHJ6cmCDqdTpeQkJI8JClNoPzcePq2XODn33ii6yKZdIPBYLdnZ3o8mdl2u8FgMCZS9h7s8gR5" +
+	"884sIUBD0R7uaS4J2RGyO+x354hl2Nlk6f6oyJyskoLlBSVZWdFRUZbM7OLCZYUlmTmRUfvI0j03fpt8qF15S09uXExMrDu3" +
+	"vKxDh7LyXHdsTExcrqdlebvDE3+T7mytJlwp96nkE+c/yFpnw6LhLpyOK/30i5E7ykqLszItUVFR2RmlReXlRaUZ2VGUiqzi" +
+	"0rKdw7/AV5eRQadiovNyy5t1aN9CpiPG42kppzwxMafIAITRZJTCebntKAyNRkheIgkEWjHzCn9/zPFGg7yKYjRITU4jnUGt" +
+	"CcpSZ9mHDnRya3rymsAp7tpndDxUqIw2ZlsMWmeLYfrkbh0mepLI1WUDR0WkZ+eT719+5tDP/dq2Cu/zUUmLdvmJDrOgizWF" +
+	"jeo2pn96y0Rnecu0jHBPZSt7st6hj8kpcOoW9BhXwI7MSitNFnQRloKE/BUVHcs6lwz4YlpB5dCUB/ts3bSLwPCVj5VlPefq" +
+	"4HLbHbFmQ0x6VLup/drZ87vGW0ta9OxQrBUS40t1ica8mKRywT17QKlk19ZAH24G+yBSoA5ytC262QlW+VSugnqFUA5NRoMt" +
+	"wWlzu2puqHPnumQhStumMvN6kytHFgUVpIKH/flJRZGt8wrhLXJgbFpWog3KevQ1FzkTFQsH66w2S99W+WFCvLd5L/WCQtZr" +
+	"d+bEeJOHnHaZ+vZSq9oU/Wc7UxKe6BoZW1BMupFWLbwxRujZaURE69S47HZ72qzqVIR1Ol24tnxErM3RzRmlN2R1Sm3ujCXr" +
+	"NxfF6bSWos7PDymndXAU+Z7dwzuRFaH6kTFd9IwlDooxxj73zMa1c2aWFhcXzJ322MZnhvTv+WhiYmJiu/K+vUeQ71uXLV38" +
+	"zJOvvLp72+qHyltnlpWQW1u2vnxw65ZFD7QsCy4rASHIcgct1GKnw5blrmcqYPTI3n3LK2gBj/YYMOSZjY9Nn5tfXFw644G1" +
+	"G8n3mWUtH1i0ZevBl7duAb6kLLN1+UOrt+1+9ZUnn1m8tKy11LbhR/Ira+O1KJRqD2t0ZsGB3bk6L5sw4/5p08nHSx5a/DCv" +
+	"/ZGUkBxS+MPPcOab/8Ab1+rCUj/LLMGkk6gSPF6PBsN/IePhxS1aLiEfzygsmMprr5Gi/3xz+fLPP3x3GT6AUxL8CmJgB/IG" +
+	"xNEelUZzYgeSf2jAP1AQFzGwiEU+mm8PMbAPyPmUWFAC7IV3iRs4+jeQzYdkmjhiYG/UwXmDjBb34S7wmLgrCGcUMXBMMM5o" +
+	"3E98EjbgLuK++jhziIE9WgfnUdJKovOVOnR2Jgb2s2CcXSQ6jzZCJ36A6JkveQNSIMSZFYxWb1Yw7KcDCRn46aPXx0A09z5E" +
+	"j7ku580gemaFnBecXr2WcXoDednj18eQH2+5yI9SZoAW5BC7jjegRIQ4oy2BXqLrSAW3K8dTDLmeQjDaEvhYukATB7FgMLnY" +
+	"tSe+2Th4vte7YNDGb04Uz6vsMnRY58p5r6155NKNx6auvu/Rm5fWr+618u9tq6Isq7b+vbIXAphODrFv8QaUhBDnR0wbdU1R" +
+	"dMJmMJtqCqPTQZhePK9D52FDU5I6zCs+8U1lu+ys+V7vvCHtKr858VqvlX9vXWWJat16298re62uaHvp5qP5BaunPnbjUkUF" +
+	"HTtVf8c9z72Mwqj/PfjH73TIzupAcNu0NoXRq7Vxz5Np5CdylUx/Ecqe2QNlh32ReNPZ3y5/dBa/T94h7eEweE/BqJMnyWbx" +
+	"kbfxN5lnUsWrqA5+RwP89HJtaf/WKB10la5eNgpuoWFpBGZ3PPFMuy39R8/MLmptTyEv4UNkU4OiyfVxD3VYssli/acsrbnX" +
+	"3tJOPj/zMUJ16fDUp8Nej4hUMMo/6HlbI93Pli6ILYFG6Prw+OHmS7v3GOdu0bJVp2lT+nTr0q1g5qDuK8vf+XyEObxvAwqb" +
+	"PzBHH/WZPSozOSP9Vbcr22PNumwxP7p64DyemSTfAxCgU4Ms9SnlbHqgF4u7XW6bthExiRWvvkqmwTLg5s4lXzcUD0STP/+z" +
+	"a9dH2F1PJimN6KYRGdgSFO5G9ePLGDg2o6JLlwr3A6N7b+z40vlTe+K8sFDc3JCGv5KiyktLypOKf0qMO7Bpy/6Qfh/X5TsK" +
+	"5TSgRqJDDu7NJOCaS3up2iRCze6MRrQjvpednZ2VRf+8d+WdI8+8fXhdwVDMj+iXNrBn2+kNSTvdtlmztm2bNcPF5M8f/vPP" +
+	"0Y3LnrdC4ciolAtRhrryMjSoQwII0JhkqqphBzkKETBY9DYoMV5MYcI3S/uKwfzTuxjvIAEuwL4z0ICMBpM5HCCgMHeupxFh" +
+	"fOt1e/PyPW4viT/x0uHXhltN/Y48+9yRvqb4EXB/akZGanpGRkOZbC7Mzy8sLChovWr9+lX95ygVk7rOXrBgdtdJCuUcWJee" +
+	"kZFO/wXuIq9t6w11mKOrIZa6csjH2Y1xoA/EbLclOBoh2/ve1bMvP33m8Nr8oQw/ol/GgB6tp2uzs7NycrKysxsanwWg/v4/" +
+	"t45tWva8AIUjIpMvROrx4jYtWrRp07x5XTlHokSUdSebFxROngm6KboRHW+E5xZuWbt4Ab5AL8Sy22z26QsWTJ++YEFD+o4e" +
+	"xhtSDjmI1TBhw/rx49dvmICP/PeDD3788YMP/ktlGFK9gQe+GMWiJFQu3xYRvLtsreO3Ai4t57a7tC6joLPWHb1Y6050VpOf" +
+	"Z04fMoxKbdiQ6TPBOKM2JX73CyEAa6+Lj86D/KVLJk7x5OV5pkxcspS8tXTJpMme/HzP5ElLuM/zPRMnLnpo62MPLZo40ZMv" +
+	"px7bKqWqHmBW568vqLr0MPdss5IH5j762MG9jz0694GSZnJq70EphVBdHumNRHfjkdq7IG86aT7upq2+lln875knvaFK7NF1" +
+	"9Sp7eYylsHhQm7bte/TcVPrs0GHRkS10gwY/868lMv/hhwc+sSOjV1FR4cCiomiLe5czqX//LW1btOhjnjiR7XEnMQXLqCMa" +
+	"fzcZMcFLlG7ZA4u+kkYMXr/l5hXumkVOHv17iY0aVVQwfvzZlq3bVBav6bC0dZsWZdMfWDQuL//+CYUtW3Wt6NKm2bMDB40g" +
+	"Y/6t5MSZUR06TD0weHBEROxWZ1JR0ZQxRYWLszL79BsSHhG3xhwR1rXbqmEPM8o7VzQ2SIZWlIra3k2KChAYV+DGW/f/QUJv" +
+	"kTUwyfHS5s1rSDSE/1sx/CIuZNS+Px8iP0L0Vua3OzIbXF9KUf+7cUpPPGlrKwM1xG7Zwy5QVf4v5uQbXEgqrPFC/OHRoy++" +
+	"WbaiXatmrTrMWbduTmF+YdGCfyuOoQ+TL0N5fsL4V05+Gh21K1mnX/fAnHXhEXFMr6bXAxoNedRdLY68rhBoRHqj33cxWGBO" +
+	"h1ZDk6b/QzUhfwwuyL9v8JCC/PyCE96JnjWV7dQpzZpV7hww4I1dx4YMnTHrXe8k8vC/bkbL21UsnL+hXbv5Cyvawa31sXHj" +
+	"xn3RymEfP+H4y18//jikQ+wzz0YCaqodSkQt7iY5TjCCDf5PfdEO/AKZtREmiVUn/y3jg1pVbWZtsPgu/RAXxFsqaoE6oHF3" +
+	"44/WDIPk4uW/DMSd67FK8xaJW95KRwz6oNHp/0ESxDeShiT4sKiwsPDWgFFHoPmRUQNGFxa+RracmO4aPWDAmDEDBoxO+9e1" +
+	"I6245GJxSUnJ2HElxa2iV41c/fTTq0euirZ07Hjf88eOPX/fdojuN2pUv/6jRsFHd5RkcPsqQu3RmLtK0d9DeWt2z6R2pdXI" +
+	"SX2Ot85S4P+hcxcveyfJzaq0eYed/Qe8sfP4kCEzZ7znzcv7fmh+fn7+0MH5BQX5Lf51Hx9OW9XYL1va7ePG01a1nZwjP+5+" +
+	"NjQU69pVLFxQ0a5dxYKFFe2YR++pLuahMjT4blJUBKwUfZABVlmAVqzVeMGlVbi9/9dhYXS7JGeH9h0qnY7PQN+2TZu25Jc1" +
+	"bcjgeUsemjqNbPz538rs3dmz/vrzf//78/e5s+dL8jr/+ONQAumQ9oRvJLPavt7RhEEkrpGXiq6U0FUDm9bGGe3aO9cWuILH" +
+	"Xl9LqgFPuAP9EPvww6ytYH2+bzJnvSMhtXTYJO+mOuNUXmGsHxTYeLdxBR60f/AgXYvI6GFDny3d1LNH+8qWg4oLLTHl9lWr" +
+	"u+LnSNidyLa+NXGiuU+LFm239O+f5NzltkQXFQ0sLCrqlbHjiYEPP8zFNpGXbOk+5xKoGW7LfEgkS4sjgdGmw2kMCk9+l67w" +
+	"8YEjBg1+ulmbtl27tmpZOOH+/Lxxix6YXtaiTeulHdYUV7Zp3fLs+PEFRWTwHVhkoiwPD1vVrWtYaPSauIjwIf36ZGYtLiwa" +
+	"M6WoKMm5NTYiYvDgA1M7dGCKmzwHwSgMpUr7m0qQIhbSpZXEO3PSjKyBCtIMflyzeTPZeAdy2RfoGE5Ub6VjuIcYtXgnsmrl" +
+	"L0gxc7QBocvD+sAijF8ldx2hwn+LCvML6bCrQ6uWzdutKHvz4ujRh+OFeCu8RMrv1HqLxWfjIsLXzXlgnd7g3BUV/enJV8ZP" +
+	"4PlQsD9859FCsFzDpbEWgtq6QukCeSil1ZhNwczInJrv0jSqpJHSkMH35RfAwqJJ3ndnzRg65NiuNwYM2FnZrFmKul3lGs9E" +
+	"L2l/p/qTSsdE7dptkMdEkZHP7iY/UAv09cvHJ4y3O1p9MW5cXCzsaZqeeLoSDEYBXOC+c5WBZzBH1myEh8gdpwPNHq66xI5o" +
+	"JTaxvw2TVjA61EpZdj9x0uvITFa5o5CWiKy0p/AGrbPcpVtgRhf6hx5FKaOnn4DhrxUWjh4w6gg5cWRUUlOGHvi94pJxY0tK" +
+	"SoovlhS3smyXhxQdO1pqBho/9B81qn+/UaPw0qaNP+NQBo01bvfbG63GKa8lmU21QwmHFFE/eLPvLk3kanFenve9GTOHDDm+" +
+	"840BA57o0LxUrkaTvCBIQ4PB0jCB3LoDr/siQ0Of3Q3RkLmdVqPx4+z2ll+OpdVo5D2NAYL1Gu3f466Zn0mXlFnllmPVaTVO" +
+	"MNasVN1Zmaytb3p6n759+qSn/0y+bldZ2Q4SNrX3dYRH1059YueSO1mvC/6u+q9Z9brqzO2+kbSLdKy337WLRIBGo83cfnYM" +
+	"dRyhOzw0gozA7SNtyTfQzteaOUpeBCteSCJh0k2YJMUsnsReYa8gnub3ggXszDqx80Y86bVScT0sx4efEznIFTu0lnC/x+1n" +
+	"82he4NwcowRW4RvCbC+G70kMLsq5mQOTSAWpkGMhW9gr7CMyXiekA8P8LK7ZiPeTK3h8KWMSuefw4U9aix0oXgjh9jM+SrNe" +
+	"YOjJIr0wGt//GOTjDPFj8tZj4nL8GpT45s71QYlEx/3cAnY1XfdEVA90uzahRg+lICTQyH253hLsEmq0U0IVbAYTNz9EFUE2" +
+	"hpsMGUkajTIkRBXxjO+LSKegDAlRCs5IxvFMhCokRKnRJFFXDxilxVtyTJFaMkmjVhm0epMpKtoUqT1mSIg05ZgiEwzHtJGm" +
+	"6CiTSa81qNQaMkmPUD1Z0ei+SmATfa2YV2AZmYb7dIJVkqDuks/wFbSR8rE1+WR/JR1CYNO66Ieh34JWcCsB33fxIvlm3LjD" +
+	"Hg+zmDwGoykSOAZryKTaz3VZQygIZwgKRRppnd2tM/OMm7rz1CJnE5/+/vunyWNtapHvW0Y+ePBB8sEy3Et8tvYj8z2A+4x9" +
+	"WtI7liIxX4VfiN43j1nAPi1+JX4FdiAIo/fR69x+tiXCMuegV2L8ARAxYgkZy35bFY8XQgT5lQbNISWoQX4GBJr/d/yruCGQ" +
+	"n/wKEYH8gN6v/pvbz9EI1UjJ4BDyppwJLxOnidNq5D4EmRDSawOb3lSIbsG/NT5aq4m3Ohxp+DIVoxib5nBY4zVavBC+yUpN" +
+	"sQt63RkqyjM6vWBPSZV53ynJk+IM7Kv7UfpnF7fF2QjGLGkf9Fj1r2x/Tu/3/aL1gwmhl5lT1zPogXvBcHGiOBHVqXMhkiY5" +
+	"VtLktoWwmkxe6HuP2bxd1tp2nCXv01FbsVCyFREI2d20ErmUwBil0Rm+Is7H87+FI6TtJphI1orzN1+HP66TSBIJJddhI2y8" +
+	"TvbeGx7dXfA4UCT3MntArt80KJpLbwSb2yuAAhiFwDiBwX/O6XNKnLjEIE5lJs7BZZsfEEMPwgfwx3WRvE1y3nZA5J1wugWn" +
+	"2wVGxuYUQNALZj048QrDErz2VJ85ohqPE9UH8R8PbBZfxbfedlB8IrkOT5K9Ek4J3z8IcY+wG5EKaRESnIKTMgvmALfMzNZE" +
+	"6IMdQ+FIH9L2fonhL7a/jR/ZTiLfJpHw0naKaTsJbYALXKAAm5t6LeoFrWBUAjNTxjJU/KIP/MctfoEdFB+7UUK1HT/yNvmJ" +
+	"hG73k4YwmoKGcSfZjSiUtmU9IxNmc7oFp14hSPT9s4RRDIUj6aTt0HTRkf7FCPylRKIdH4CK7SRyGIkcWjgMKm7JZDbE6RXc" +
+	"Xj+htTjT8Rfpfqy+f4JwtoeKYYVDJazba3ECxFRf5LLZ/UgIzOJcjR/Nk/BDTGJR5yHDJo8fPaB75+TkZEe3DsOHTRk/amC3" +
+	"fDuOga1kGDOhdVmfjMzM1P69J06YP2vy6IG9smiqpO12OAtnt0t+3vdDC24/c6rG3zMOJSAHSkEZCIHC5bUFf9wMVQH1i6KB" +
+	"68HltJmDPvixNZ41O4M+456FH5+FQVv3799KdjxLonGCZ40nMeiD+9z0/9eJBkcH0/GFC4+DifwEo/zP5XnSI7Qv5kpqrLGX" +
+	"jrnoqN3r9CrBBnYtaNnU7bx93iOFpdu+zyGVMK+aLAAXzIXZB8rLdq1OURwg+TCR9IJnC8BCepIfKd7RMITbz+z023kEbsqa" +
+	"W3BzgkLQOwUzO3f5U08tJ8fFyzjmsPj94cM45jB+7u/jw4Yd/7tTl7ch+u23Jfo2VL8p9ekB+px0PEhpdCqcStACYwOB+Xtb" +
+	"aeEj8+z89h9yyIswlyzMrYa5qJo5eUCRsmZnWfmB6nwSC8+SH0gv6A7PSvG2/HznUL88W4JCGi/6z3tD/ZAUNTchSlWHNxrY" +
+	"n+Y/WljSrLPNSG5a4hPKbUIUWSjYk1OSUp1xcRE6XUQczI1VhYaGR4GWiRCa85NSFK0dGgYyY+kB7Rh4NlGj02lttpSstKyM" +
+	"1ATSU4iXDhDGknNmZ5/Wrct2+e0rDOb2M7ukmGa8ghJTY9iF+n58Qk2fQlMmbt+TKxLCQ8k8e7wQHRUeLn6v1cbFpDjS0hwp" +
+	"MXFaLY4JD4+KFuLtsCA0PGEFXjj8+PA+Tn2EJsbiSEzKSs0Q4o06nTE+IT01KynRYYnRROidfYYfl+jaUH1G0gvdB5aqDF34" +
+	"qxFT/UDI4D84S19QDbI/JfLNhQiG3IihQSaiyEJZaHHO1KTUJGc8zI0SbOUJ8RbQGG2dm5UUPso+smpXWevWfZxmck6Ip2gS" +
+	"Y0jPhNSMrLSsFJtNSz074dmYiIiI8FjIZDSO1gqp/oxEe7n9bC9kktaAEXjsPNPQUzSxxg+TjgT976CRy1915kTmjUsQ9t4E" +
+	"ld5/AY3f7dd3PCwiKtpqbWuFEibC9yW5aG1rtRstYUzHINdgekmNJmzcB+QofDCk8/1ElLx8g31+H+uS6IyO0USEL/bkLQqP" +
+	"0EXHJwBI7+PiLYHcWish04ZS+7sE4rltzA8omloVfZ1w5xwbFKzcHmCFtkP/7yWF0bE6W6IjJSvTk7EORpPH1rmGZKTbcvXR" +
+	"xmjmcUGj1WqE3t9911v6hRc6Ckr1iToaZ3xE+/YjbA59bJQts8DhTE3PcaWnOn+dMuVXZ2q6Kyc9Va67a6EH9wizDw2Xzg1F" +
+	"QFAc+1KoibDgvwxXek1vek7IwPR1biY4/DG/hZqr/uRLdQOLcJngqA2wHw8mbj3HXWRD+Aie5zmeiWQYzLOKEJ4oeJ5n2c4c" +
+	"5hWcQvxcEabm8EiGGYk5nuMVCuxUcCEc5jqzLM/zCsKHKFgeM0wkw3M8z0fwIexFDi/XhfM3WY5hWI4PV7LqoWpOoQjhOYZl" +
+	"WI4LOaxiOJbhQ8N1/Pe8gf+e14XzHMswDM+oDodwHMuwDL0NVsGph6pZpfySY2/yflmhX7lHWL18xiowv2iasLx6T66TqSss" +
+	"s/6OwjKDycytxwxHNnKKgLw6BOTVld5uzLLj/PLaS+XFLGCY5QF5daPyYtj7abQKXtE1IK8OAXkpOBjFMXi5ThFCS2D9MlOE" +
+	"fBkSEiyzm7UyAytv4CE3WGrEFyy2kJAvQxR+sbEUa4jUvkejp7n97ACkR/HSOh+Vi83rd5XNBHo8B2w0KbiFwJlAwS3FlOf2" +
+	"zWo3t8XgkIo1Cc2aJaypCBncfF47wg7QmiwO0cksHOqOCA2NcA/1zcWfOywmLV7Ysfvq3ZGnn3R6PM4nT0c++Uh38UJxYUWb" +
+	"YatWJTOrk5NXrRrWpqKwWPb7Jofwz7wDRSEEKeBMZBzIS+/8ckpB3JCCN8eBF/8c8nI2GYsBumO2zGM5Oeyt79PM5DlOeaVZ" +
+	"Kyc5pO4KXY4wL6Z3aj7sCMB7431dtlwz8WEMAjSQHMI/yfj1bpN08zmimM0K6QpKpMAcnzDQ2arZFSVHnjOnX35r+CmLpwWD" +
+	"oTsAGZtzhBz6lgnjTde2MPvGvwdwZFjzTum+dkfIvq5qKleO2Jhl/P2IQTxSIqTkJOfNM3CaFJNSOEmO42XkGJSL0/j7q76s" +
+	"Os+mM9liiG8VQ23SdvIbw0vnStRyJE6nQK9ZMQqgWkfwSFEUN3Fb//mAz5kx458PZiDUEMamdenpIFdrc+NN69aNEzeJogTy" +
+	"3N9fz+BzZsht5iT5DR/hdYijI3eXEmwnAf4k1QB/4oXMGt8k3yQ533ryG14q51OCTQkuvJRU/wFAqv/AC32TmDXMGoSqqyk+" +
+	"dhuv0zg0bRDSKDRt4Efp+XryGztRet5Wet6WPkdA16u4V7mv6NxLCQqnlh5xtmmd9HYc6eMEpgWetahizZI1LcvWLFnTbhGe" +
+	"xX0lNlvTRUxg5m6ousYebteuqj1r2OBbiL/usga/Hrhr5B/uBHeB4uVs4DVr6dFol9bs1bq00sfMtJwjPrSo3ZqH1rRsueah" +
+	"Ne0WKfDra7rgr30LN7CGqvbt2rGHq65tYOaKCV3WyDg1qD/3DTdLWnm+w33OCq8ZaJEe7+0HyXiFp0Xzyk7NyjyuuBhLrCe3" +
+	"ZfPKyrIW3txYNf/0IrJ9Ltm+6CVlfEbXbmPHzpg/aHzn7ulpqRldu48dM+OBYWO7wJl8IT7OWlTQvk333q3bFdFUXEl+pSX2" +
+	"6FoyHZaufbdnx+n9BuRkZ3l6D5g+7aFlE+VUbl/KRzLqz93y82Fu9HIGmWKznxFHI/dV+/ln5m7sMm7YnJljxnbvmp6Wnta9" +
+	"8/jB82iqS0a88shCysjjC59RqOJceS3KKts3b5XribXExLk8LZt1at+8DM481zc3KztnQL/pE5c9NG36gN4eOdWx57syI0dj" +
+	"LZX5JXFx8UJ+UbvWvbu3aV9QZI2LFyS7VX1ZUcUlIjvKR5V0lRmcXoGvvS7REfD4D1ylaBac3sSAlqSrl+RseukuJ1Od4CSK" +
+	"KrJ94SFyUxWV1rvXp/982qt3WqR6z3Pq2mSUGiIOLRTt+9u1KymNVyugxWForlDHl5a0+5u8fpicUKjo73byexV+ewWZvvZM" +
+	"6+bThg4ZNmzI0GnNW99s02K6nJjeos2ZtWqbrVV51zgrJJKvrHFdy1vZxHPkK0iMi6W/bdLbWASoD3pBeZXtTG2K3g1mhWAU" +
+	"zIo+MGThoZD+f+06tBAXrYCla8/cPLM2sL7wgvIfOb8sI7fg9Cr/Jo8vOvTXrpD+hxb5SZMBZPw0f0QAv9Mrw/lLURQcWgSD" +
+	"KfjfZ2pKO7OWTJewnFkbwEFpDJfvyjIrwE+pAoaFLP5bXbGQbIchCysUf/41uyX27ui6FpZSPF13tKtDM4X3OgV3DQ3ukBtl" +
+	"ZX/NUvyvnUxDu79V2Nuu3Y6u/vK77pBsYYAHDhllDAozV0OFjIsi+vv+li35qn8eDZDDj/WjpTjbtaslK4A+mDfpJF4Ap9al" +
+	"tSm1f88+JGM6pFh88SJWnfHDnwGP78lgviTYAE/0ni5orlhcI9bZFy/iWzUSJWeZfgGeaLmstI4oKMxBgnXLxXdt2VLx/N+X" +
+	"a6Qbe/Eifj+Yj9O+J/1rPzIdMi6j4HWC3L/4aYLmin1lZX93uXhREReQNH5/Q7t25Oxppt/pGmkH8xSLEChsbpfX7P/n8iOz" +
+	"KZzSP8jsPb5w+Q/f/0D/7y0G2H13ufTgezx+6M0tRP5vaA33/ifV1RDOHsDT+GYahbkH/U37FQjHm/E0NlV+hrfUPuObaZxy" +
+	"vrrv0GI8DZvkZ2hx7bP6+YPfsanyO7zljnCN5GFS2APsOplm+luG8z8LKq/2Hd5c+w5vuf079kDjedDi2jxo8e3fsQfungdv" +
+	"aTqexvMyKZRmWT9MSq1+GvIf/K6uvG8L12getLgWHi1u+O52/DcGj7fcPs/d+MdbavmX81KZ3I2fO+fBW+6ehz1w57z16sdd" +
+	"87AHmp4Xb2l6XvZAU2CYFAojt1f6u1E8Dd7Vrz+3gbttHmyqq8s7waPFdyy/yXgaz8uk1Oatlc3t8dw5D95y9zzsgabnRYvv" +
+	"noc90PS8eEvT87IHmgLDpNTqg9apu8uvsTx19dkUPHfOG2SfmowPLb47Przl7nnvJr+6MA3qX5Pl2JS8eEvT87IH7h0GLW56" +
+	"XvbAvcPgLfcOwx64N1gyCU+jM2aal0yqfVZ/vBL8rv545TZwt81T1/7dGR4tvmP5TcZz+7z17V9j7+rbv8bz1LVpd8LTtLx1" +
+	"bc6d8TUlb+O2rCl03hmmrv27s/zwltvB17dpTZPfnfDVtTlNk9/d6bs3vE2Hqd9G71oP75q3cVvW5Hp5V5jGbUuT62kT6f93" +
+	"5dwLrGyPqE28u9zR4rvVj6bguXPeIPvYZHxocVPpuze8TYeplXFT5NiUvHhL0/OyB+4dBi1uel72wL3D4C33DsMeuHfYIPvb" +
+	"ZPnjLXfDV8fG3rP8m4IfLb573jvY6Xuu7/fOx73A1rEx99wOmgKDt9w7DHvg38OixfcOwx7497B4y7+HZQ/8GxxMCsUhj3+Z" +
+	"FPlZA7wN3tWf/9+FngZ56s//7yLHO5XfZDyN52VSavPWzm3vPv5tPA/ecvc87IGm50WL756HPdD0vHhL0/OyB5oCw6TU6qN2" +
+	"bnX38e+d9NkUPHfOe7v5f9PHv3etb/dI5+1gGtS/JsuxKXnxlqbnZQ/cOwxa3PS87IF7h8Fb7h2GPXAvsExKrT0K2MY7y/3u" +
+	"9qgpeO6ct/Hxb9Pk2kR7eY903g6m8frbFDk2JS/e0vS87IF7h0GLm56XPXDvMHjLvcOwB+4FtoH9bbL875T3zuPfJtvlu+JH" +
+	"i++et2nj3ybb638t/8Zhb2u/77kdNAUGb7l3GPbAv4dFi+8dhj3w72Hxln8Pyx749zgQkmHIpLvjJ5PuXt+agudOeeva/6bh" +
+	"Q4ubTt+94G06TEDWTcHbtLx16+y90Ns0mLp15F7kfC/0/7ty7gW2rv1vmvzxlrvhq2/L703+TcFf17bem/ybTv+/K+feYevb" +
+	"mntpB02DadyG/5t20TTYxm3ov2kn98Lf/63cf4MjaPzfZL013d7eC96mwTQyP7hnvdx7f/Fv+LgX2Dpj3HtuP02BaWRu8K/b" +
+	"T1NgGxm7/+v203T+/m/l/hscjcw/7rEfurf29H9pV/dSXiNj/3/ZT/279vbv+fw3OBodK//Lfuzftcf/i17/DY47zBH+j/3c" +
+	"/629/r/Qe1NwyT7P3I47+Dyzb/m2tWzJDBXTAz7P+PwdfJ65/Xf0eWaGlZX5tl68iD+5m8/zHwhxkdyXSFvn3ErgyEpr+GhR" +
+	"xZoxj4xZU7EIPuK+FH1ruvhGsvnTqjqz+6dVvcVs6rIGs/XwCEHnVPxHVFovJFk1iDC7pguzqeqtaez+qs7T2HzfSOlgCkaP" +
+	"o/5cAf8xskrxtepfwuI/neI0K+QDbwp/YBezwtT4xaownR5IadWsslOzlu7cOIslOi+3VWT00wvJ1WYuV1zMRekgCgxZeKRz" +
+	"q7Fdu6WnpWZ16j52zMw5w8Z27Z6ehn8JnEiRz6f0qGhbki+EKo6uBbc1nz6RT6PA0rXvqQRXP3o+ZeW4mX3o+RQp1QhPMg/g" +
+	"9PINj6BI/25zsYzX6ZVPPLKDLXG5Oc3I1YVPR0e2ys2z1B5CaZXribFcTEvv1nWsfJClU3ZqWnr3rmNbdT6yEIbQoyyp+Bd6" +
+	"8CTfSt5de1QRKuSXtK3oUXMURTqYQg+v9HPRwyt9Zo5bKacE1XtryXTpcIvE04/kELuENyCtdBI5yxgHZodbS8M8xwJWmKRw" +
+	"9UatgQauF+pHznfhsaGtV6So4mcNUy1djy2Zcysr52aSm988Nnh+Xt78wY99AyE/HCniWu5gn91dwOUd/ZHcaNuBITN6rqrq" +
+	"tbLXjEcu/fDY1F33PfrjpfV1aNFIJ+k1XgdDg2WbzFqDogS8IOTQGPq1IfR5m0ApcWspTQxev0w5bFa8KnVl61Dxe9B+89jg" +
+	"eXl58wY/9g25kUUJy4IQCO/QFrQ/Hs3jCnZX9drRkis6wv26/tKPj963a+pjP1x6ZEavleyzq3oixKLT4g2unB+JsHROLgwh" +
+	"0NpBC0YmDPTgBngMr/FtZReeFyfhNeer5rILOURYIm5hzxLtNfFNIpJNcP0a3ECIQaerRf4Mp5DO3KkQ4rQurRJTLNxv58Xn" +
+	"azAwnOrW/5hd5DvyHdmEI67hiEZg6akMLT0gb4THPvkE5uM1vm3sAoZTkU3iGxAnzuZU4q/X5LN3p6u38ZO44QjLEXy0eiUF" +
+	"ZdtAr4/EFDEZf4Y/xQvID9jjA9xd3MO9Le7B3YPgOArHUSBGy08KgPjmkD1Da/OLMRApfoQQgiA4GQpqgYIAkD/vdn4SN8JP" +
+	"mfw/sxl/Kl7ESRSI0eLu5DtSQArIdzLsXeBoQcniZxTuVh6FgDg4A2cgToarPfeIlAy8JQd3CZxxpHgR4so5Funlk40ubSCi" +
+	"sPTPDS9/eu3TP/+kf/DfOFT8g36uyV849JqfNj8OSxCOunhkXNmfrvt03acTJshftfjq4KzBy6Bc0pGv5r5BDLKiAjl2iz5B" +
+	"OlIWHGJSjvtH/yVa2VobyloTa60PPgDnSfrVpROm9h3QPzNjwIDJU0A5ZWrfgf0yMgcMnjCFvA0jwTV/frPS0mbz55P3ySby" +
+	"fiDFZIjnmW9z80aMqdqwdu6CEaNyPR7PiDFVazc8sHDEWFcuWbNy9Z7nzr6557nVK9esWbn6uT1vnn1uz+qVdXmIQR7pvP6/" +
+	"o5/T0gjINMyzoGU2/ys2cBe4QFI/I6lwgf3u3rk56lfRNRz6/5AvPb13ExRKcCrhX/K1GSqh/WPkBeiwkbxADm+A9uTQv2GQ" +
+	"/IesgsnPkNVk5bMwhax+BibDfUg6L2shmeov+RCNI/QlhDTO0JfwLvmbK0dIowh9SXFFagfRJJP5jQ+RbhZ0C3LwAukEs4JX" +
+	"MC6jIAdqcDqcXpPX49UvhWirakjxlNUtszVva92FfCwhv6erhhTft7osW/O2xlPIp+DQa3Bla3c3QMf8FaYCgL6k6JpYUecJ" +
+	"XhtUdmrDsiPAZbQphAbl29yCdykka+vQEH6jOhzCyPdxdeiw+CA0HN/8Gfg6JX/781e/kMw6j2DvL1/VkwXYjVy9ojm3va54" +
+	"8EOQQN5PrVNoPPkK3Ml1qMNVB2+9Ig6sW+K1F269iqfVeSb3AwEaSlBbhOyUgnpk1Bug1NOYVJVpbAkn4/Fyerl+m8wmRp9X" +
+	"FhOvr0OrTtEyzxsTExOTF3hXS7RO0cbtjt0cYVVpoi2R5Fp0TKQ+NT5e9VtJfJiCXKjLjJmJLymtaNejl3RQNUwBzrra/4VJ" +
+	"KCjs1DrFpg4lE7TRJlintRiHZGd3TVQHeGYdfAgKQS2lO8oUThoKhvG6zApnIKprHSmUgv9Isdvlb7zmeqp5w9BVF6rXJOXO" +
+	"6vvxgG7x1kJvF7VKW4f9MEdip6I8m7DFaHAkZYeFNZcFkKWVBAC73CX9DM9H6DJB9/BPo/oO7t6tVYmDY4mvDm8fqDSJzYv6" +
+	"9+7vLfKmpBl4lvmpvlJrdcogp9wjMDTSU736LmgFRTCP8CAwRIyOgq1kSWwd3YRCBZkRoR5cyws+Rs6Kf8EynAKH6hT/ki/p" +
+	"4rZutQ/q1rFCVErrWAQEl+vVm1webwmWIh47HYoERT1CTTWXBVOa8YGMlPA6co1tXux2pJQnaLStk1Mzkwvi67bVIe1KEqxW" +
+	"oXkpWUQZ7Gbiv6tbm84kg1bfoZ87Py2zg14PJvikzvu3E7u37N/7bM+ebctxM3JW5ieDPSDJt9Df49I6QMlOrKHaH64yHBS8" +
+	"UzLptezWRtfFPYElvvn9yzu0S1INKQaoEXlm9kuL5jdf1GHzcxrVkGJCAswmZfcsWrA2v9vg1lQHcABbE+fgsVu7u3GA3lfU" +
+	"a1edG75vbWLr5n8HP8cTF+YleTqs2V+YhhBTo5Mo1BX1lOJj1arAoaBkGw1xYDYx3ttpQ1FPkTWNxG8qGKqtkGxnjWoyUlPK" +
+	"tZqE8pQUe8RtdNS5vnJDeUP7smYJVquttKxjbgTVIETW0Rfo9R0y0/Ld/Tro9fjKt7fTnaO+3j8EpdCuTf9eZ3sOaNEmwUxe" +
+	"IWeD5WJDTtpPeLWC987tphSAcWkFm9Y1Ctpp6vIFEeQlTV0jAJr42eTU7L4dmI1Vdej51Dfq7zoPzuOTxARXiQkhBNXvkEwW" +
+	"+BDUDCGo30f51xdc9XvTmkAO8lzTLUUMcTpseEZGHaJSP101mkYzHr3qslDHKMVvynakxsfrtQlCfKo9x6zVAIRrIrFV9NTt" +
+	"XZ/aPhUKJkHB1O0Y6rwhiTqjTchJz80eZLMZda9jjdYSrdOhOmOB5IY80UBf3npGlvHScRAeHV+32106A1Tkz5nP1TVakQ9N" +
+	"J3+BagYUEkvd9v74xsXghOSHjsPrdUld8ehD5DPy+eI6tLkQ0muolhs0gyiwaengrR7ldLavta2qbLkhvK4VHZJfedKqDCup" +
+	"Q32xzWg9idOAGTtIX9fuvBrTdgj5g3SDvTtd4WpfUZ2XzGFNXMYzpBuqTytoGvbgDqcjClxS8Ml6ApXuvHUx8yrL1tXrqkcW" +
+	"VJ60qjX5dVjwxhmsJ/EIIo4dYCAf1ZWqKabdEAiFvaTbTpc2jJlQ563vOY0l4xnYizAdJzK/KbJQLBIQ4gJLIF5qErP0gVge" +
+	"NJ6NIovGKcUVdejqTelZE0hBS98OZtBzm3t6OuStNOX340bU/IT138p9j0XkJdk0qTzjncsTFzGDfDsaLe+ftG/lcfCPKJlL" +
+	"YL/U8GiTlD4Nh7hyro3GEfqWNA5+Ep6BE/R59StBz52hT+IX4QR9jzaKL9J5NnmPK+foLJ/O7I3gVmIjXRVoRVcDfNuYofS7" +
+	"4BZ8eAvPJ4fJS7fgw2vwoX9uHwxrBiNo3UCBT7MLKVTVXGZYAXx4i2RzbW+R7Gsk+xZUSGsRQXDhwaUyLq2tbsmXL19uUDhp" +
+	"QX5FjeAJosDu0tqCqPBtvXy5ASUPk19rcCxsSIu9AS2+kd9914CY++rQsrARWpi6tDzfCCn4aC0t/LZ/KxdYFUwLv60hLUpo" +
+	"glyYTyQ0Ep4yrpxToVhUKM0rjbUh32wJNRdpyeuNHv2d34ZJsuDKBz7Rc9CC0BA+fHn3jlt6DXyi59AHlZwibHn3yo395XdK" +
+	"Tn436IkegxaE8/K7fr7PGSclG7r/0alVAc8wfHFl1z86tCpkMcMXtyOXpccs5ovbd/Pn4ErasQ+fO/c7dPoZOvw/5gckedr/" +
+	"NT9VX0gaSLpXdv4gh34mB34/51+Hwlw5+6y0XhQGRrscR0aKY+O10zZMhUae17aGpaLvwSPlZDrmRovr2IXM4XPnfh+qXnqd" +
+	"3L/0+nsvSuKpi0/vBiMXjI9z20+zC0nk08HIZooLmSTmMCVq1RgZ28/HKHEYnRZPSrJ2SatFZnrbsMcri7TOb6c3SLrBv0Gu" +
+	"MUzey0XpNlmY+a0SJrQOyLX05dK0RFms0nNZ+oMW4LY1VeX9Vm+r21EBGyanSd8F51t9rJYEarwvVZJ3HqOrrSH/b+iWa8Y9" +
+	"0y2+5a8RTaO6tiLQdeJHuHL+QcQgBQpHRmnVwQj0kw5uTstJlUNrr234Sb6L8u+AqHC/69fJlOvXxWNr1/5+jjz2ocJHf8Do" +
+	"DxvFL0X2ph+K1shJhbjtsoUKfHyfk6fkwnC/6+KD133v/fEhjD73+1p++h8fksfO/b7Wb2OWcuVcAbKgeOSobZOZUCvSePC3" +
+	"OcEoQI0d2dmDtjtDbbML97esL6XyF9TUgsVy+1H6Gxgvta/yX7hXgxR/z3QwNe3/znSAX6m3JaJWj5QGFVfOxyELSkDJd6Gh" +
+	"jma5Vn4zpF/RrZNMxuKwABmNaPv7RqjxTQyo3lqj+nujSV+vNtyWpv716kfj5PirC7eltrogFr0hvsWV8y2C6jpdjaUfuVi/" +
+	"RN6orYiS/PsEeCcvXsdzrjMuP1KmZ1A9b4CbXpQgfWSkAc7Ka+s44wz8psWQF69fh1XXr+NWfqzwcS3xtB39wJXzacHtlPbx" +
+	"9ntop0/8fu53n/H3c7/DNL+6eFSrrsbKAM5t19rdXBPb6hPnfj93q/O5389BN78C2Nm1PNB9mc1cOXdJHvMpwQ1ao2TxahH6" +
+	"trGX6UDiw1tH5HHJLfISaghLexglpqCnA4MRCf7yLZJ9S5wP7aFCHpL4xyJBsOF1Sq4dqMml4/mXLzekYCH5tTE8tVRoA0Oj" +
+	"ACWXLzekBb8dGBu9Ub2LK+fG++UcZBEpTVxtFZHFy67/Qrz5hW/QH9DhZ+j0+7lGcQRbbTDCGw2qwfovviBnv/hCLDh37ndy" +
+	"4GdySOKnL1fOnQ3gkORSIxuuvprZz8iB36GTuFn6qvq7Lj31cEljADcE6GlYLT/7nRz43Ub/kMIakqiekYUr5yZJ9/4h/7U9" +
+	"Cv8tK4H72/zX9jABefXfWNl9eZiCUz44tOcTA3tt6dh9eTgfErpgUM8nBoK/CXzUvV0xz2C2sFWHP7pWFtNRU0GrTr52tVzc" +
+	"U9l+vu5cdtUDAXZvW3wt67T9ETNXzv0tydCO0hAy++1Tk+RQI2N2URMEUkVNGftC42T1Ddbtv6arvu7ZRU0T1p1JC66/KJ0r" +
+	"55pJ+4qJsjX3u2MIbldNSGGT2WWUY1LSzleiBrcoENKiTQyZcVNtya7sfLRTZXZ02A0yizVFp6aIK2ke0FxmvWycs03p4G/z" +
+	"XX1zXK6cvllF344oaOO0k7TLsk3qzpVzMxFDa7yd2nqWY0GL7O5EkFrxAvIMeQ56QG/yjO8zJhl6l12/zkZVd+9Kqsm3MOPB" +
+	"a3Ngfn08XsEo6LWIM0qYEiV6AXozyb7PyDPQG3qQ58qucwqIJfPnXHuQPAzxAF27++fUIRIeNTJRPHppDM44vUIQXW9gbt+R" +
+	"yXQcvu/lyWR6IyRC5f0Xr5MX7r/IKepRescymFqa65VRn/qgAupzQccM/blybrBU13R0HuvvRYMkW2OXyJ+kHzwNSnLYd5Yp" +
+	"g1Lag064fp1ZXm1PrEbkWxgx4tr90BOh2+ONghqqA3ihlCnznSWHQQlPk341OCGW7L7/2giyGeIBJdrl8f5aCacKxSEURKHW" +
+	"KATNfujHrOBa1aeW9C2Dn8StY17OIJ/C0y1IJB429kg627Iu8VW9rs+//t79PeW/CDUoN6iuQCPlnq7LDjP9dsXW40+cWr9c" +
+	"Bp0Wq7hy7kVpDlz+f5zTB+szMA8OW9Gt0+be0mgvJGgePHJWqJKT3w3eETQP7l9fohB2LxNirkuDavL/lkfQBtWtf8lj1Zd1" +
+	"9XevLDaotXL9mSnx6EIl/3KeWqs9xnu7ueqRkjSbzE5+q4TxrWWuBy3AFQ3Utrgps9av6mvr/wUftRq6Zz7Et+tppmlcNFQI" +
+	"tU2TuXL2m8DYyT+udwfmq63qTxm2/g6dfrfRP/Bm3TWqOniktbua9bvaqYE8DtsKnX4nB/AI6YsNqZ1LYvRd9UdcP/ZtxMt+" +
+	"Y4KZOncIWqcSs1+TP4rFh9bhWQTwsVfEh8hxPJPRQ3KZWLoTvw5TfP98KDaDUfgkxbO3+iPOUYPHS/8JdoUSK7SQWoRnrxWX" +
+	"FJE/ceExPIscFxcfY/RlYrOd+GTZig/FUrIRv/4ho0AN6VHQfwLnVWKvlv1apqYYQsU3jolLoCWe9QosLsOv7xRLy1Z8iE/C" +
+	"KLHZh75/UEN6tIKTuqsIWrMSQyqoi/DMteJiqBZbH8OzoaX4ECwmn5XhkzvFZmQVo/gQv042iqUUTzYawO1nn0ZKpEfIrgTp" +
+	"KiqtYPc6jYJbYXYLOJ7o4RfYSW/7IgOw9dVXxSGvvoq3D8CJOJF8CXb58q/0jz8eOPDjjwciBBLOHfJdYTJGPxJmvm++H4xg" +
+	"BGBGiKV7BdKdYvQSLDDDL/AL+Ye4me9xoviVL4b5nuarfp/9jbtQm4/9leiJHkLgXfhV/AonMt/7YhAEyRbJKsY7axTLvh2k" +
+	"TgRB8kMgqZEZEdAd+3YdndXBK6sK7wzoJ6uOWuri5SR1MCNqlJAVJPmAnGTZB0m+rrzrSVmCk+XBh1A4oFcG0HtX6X1sClkq" +
+	"5G9ZirJs4HcqSTlmdvV73Bv4FlL7Y9676UTNa2YH0yDsh3wvsPYXFubSYNRXbxKHP9b3aKzi3uDykNrvxyy4BbfCCexgGLLw" +
+	"haovmcpDC2EwlyeFoXbcvErjWUtwrarf43b4y6Juy4JRMHrNAM+Q7YsOsXbfC4cWke1SYfAFBSPTZZm0wipuh1wevTnLaaTD" +
+	"c4UTnpkLQxYdYiqrvjy0SC7u6k34ghKJ0TPVDO/k7JKtQGatoLULWuEZbt6tn9hDVR18vPWfb6bg7Qg1mhca5GU0eHudvFjW" +
+	"JjA0ezjNN5Kbt4wrutWFZkeN5NUKNC/3ev2cGHHVDLe4Jp+Sw29gr/iO2ByfwK+LzYbhGXiG+DCj8d303UQN8uup++cq7Alk" +
+	"xwbxYfbmHfIr6X16q7AHe6tUtSB4RgCgfn5Bya5ix9fSgw11yEGhNXxykieuFhmllUIbSkbpKBt5qcW3uV1uvdEmfcBoc9sC" +
+	"39IvOeUy2mry6I30Ge94bwT9j7x15PxLzH9GjDh/5PxL5K2RH4wcOZL+Mf04dQq89N57cPjsWV/i1KlTp3J7f5xCv6feOjNl" +
+	"6o+Bd/DR1Ck/ynXJWn2WT+BtiKWSwIKVAQbIF74K5iU8XlzvMyCEg/IoEbKAoFeywqa/mIS/xBm+6hqA5b6DTEccSuL9gBgt" +
+	"qS7nTnM+xNGaKjvzMrT9wnXslTT6zki8HW8Th4KbPApj8UUYSx71/cj0g+dJV9+TpCuCIBzIK8EukUF+D8qFEKC46qN8CrfU" +
+	"78srKAF2kgdgEcPBIvJAa/wT/kmMFCNlnocRpHiOT6JUmTTgsZqRBnirczicuwLnfoKPScZPJPNKM/ZZSNmwgXxa1Yt8umGD" +
+	"1F53cCJfSWWlZLTM+zhTPOebybf3Ped7DspZK0I4KI9S9vjltMwzIoer4DPSUQbgzpDrvofINfwR06MWFAGFYIfL923opVj4" +
+	"9ENn3tKvVNC6dkPfjz766CM8yNdqxw5m2w7uArlBfPTfo+QSvAws/RfAhdfwBml/RgKvQSih2/fUU9Di8ccff5y89hQeL8E9" +
+	"TgbLqKTbM1kE1d/46bFI9Hi8nmJwGaQ7obWCllfwsWCjfjW7oa8hzLjanduP0mUON6zxuAdwF8SyEIM1P+ThijaYPEcu4VsK" +
+	"U3xhyMMVbQEhjFQIsT7uAopAMRS7laVepoJMnIve/e31UDd5o0u7G5cCAv3SpaT6e/LU9u3Q98SwPO+E4SfOcRfIlZ/nzRVv" +
+	"MeOoz1JHr+ftcR2IT2rju8kIbiR3AYVIazv0DmhHKkRh6o6vtVlRFAhWyQklDsx6gckAG94wbuDAcesmjPOUk2vkBtaC6q/5" +
+	"C1tPzj9OVu/KGdGNKe0zckQvsoD8j/Rb+BC5BAYwcy3PfPrIa+m6eQtIL7hvUheEUWH1TnYLexLZpFuAzAqTUSu4a4al7lyP" +
+	"4PL6Zw9aeUzKmKTjCWzekJUl5JXmO6eO6NB3eO9WfwBX2T8iqnjeqEOVo86Nuj6b/Ph5cpu+c9iTw5+delJc8PSI/qnjBowZ" +
+	"8PCbL0+M0jozMkAbE9tXvEiIfe34ZKkORFa3YFrz66n+wAIuujPMGCUJCAlOhU3vcEs1Aqpg4+aNB//q7u36NLk2rLxgc3fP" +
+	"jh3cdnIKu8T3qxE5vb2DtxpFfgoFfffBl4CgRPYr2E1O8C9xFzQ865XK20x+4D7hDciKUDK4tUFXuhilKhO4pZ4m2DXkOKcI" +
+	"11uF5OH5BeQ1aNG2S5eh+/r2gTLy6kTYtN7rjbYZ9D17bBI/5w3is0MyMqdPv0xcJERuw7vJCe597oK8nkprjTSG0oIA7BsQ" +
+	"Qp7uJV4lT0Ff+B3WizPAJm6F5ri9eJi7IP4HC2JxgF72F94grV9SULrlLWPaAi3gPphGDND5FHm1JZlOXuMNvoOwRSRkHgkB" +
+	"C3VLl3B0ISe4h7kLkoUTjBIpghuEUexoMZN5mDzts+NzYkvuwhWfl9JwhXkH+eF+YGexg+rDjWYmib/gw+RVMQbrCcMOuiVu" +
+	"Fn8Ayy08Qu5De1Vf5pZx11GEdKOTW/DXLqRBrhwTk+PR0KqlMbHNoS050uv88v+RN6Dof0svXNn25JPbtjz5JPvb5VupXy+c" +
+	"CQfxbjgwq2oJgcuEkFgiyXQEv4C7gAwI2TWIuo/R0yogi1YJAj+f3CDXytuOm0De/Yq8S47AUciBXHh5LblCroHtoYXMC74v" +
+	"GXvVce6C71smntKrRIhbxl1ACtri6b2jIP3TwzEc1x36k3AylIRDPxxD1rzMvgmaW6ncBfFLcR+F7S3x+guKQNHSeptWMNIm" +
+	"gzTIluDQUzaly90dsJMcgba9Pl7xPygib/xv2Sc/bdu1a9uWp576+jJ34euFM0knsQfpNIudKZIYkfz6m4iAtgluCzsbmRGC" +
+	"LEYAwQI6V04cxILNnSsvc7L4b7KdTIUWVQe6Xu+7pe+XUclRNrMOnxCbs4N8t7AVYNjeEfOKhHwhIQYhQB6EQs5yN6X2lsUI" +
+	"apDQUsz0MjQJs9Qcun9BJv3xK5lETkL7n/4LHX7c2R4Sl3XvejEiytSW5aqq2IlVa7kLVafZ4qrT+C8Y3L/v4out4nSxCFAz" +
+	"hBSU7sbKCKKe53eTJ/bsJjvIX5C/+zkIe25oJZj6bun7VWRKZBl+S8xnsI+wg3w+hhVZeBX6BFhBGJUjxD7GHkB6apvBQotg" +
+	"FE6v099+FbyTuoraHEYD3BhzC8rIVLL99IDmg83KoX2eWJDQxmQlKRmmTngneZnhq3bgmOQnM144XZxnK523r409pZ0FP2SR" +
+	"2jBCbH+pbtD2J/3bDX1xb/EZ8hR3QXyLXBKXSO0UIWY6OyiQzyjIDXQTGUleYweRHpeIU2ob0xDi8tgxyITi5TvNtRpOSNRq" +
+	"vB6XUT7wptUAb9RKPofM9ofnPkJKyeuk2fpZjxkdjgdgcvZMljGZ2THzHyVfEG2zZnADDFsX/IC7dX/gxjB4GL4j/+M/JjMG" +
+	"//ZQq3JKV/UPzMO8QVqVCxykkw53uYUcauVs7oBv15aUlJSUuS28qlb9HgN4+TR5FUo6dp6k0esTIdukVvXqNAfHLOn38y/f" +
+	"Vh17cWyOCzCHGNSx+jL7JDsbKeReEjkSeIMpxxMCVqRBVgQmc64710Fvpzd68N9tRrVpM6qN2BOcWElvPxL/Ip/j9OXtJ16Y" +
+	"NBAe6NTpvo4dcT7kkbfFi+RtyIMhyn7q0Cf27HkiPGyybJPWIMT0l20SSJMY+R/TT8yFt8lgeJyUs4Oqdnz/1xfnvkDV1dUX" +
+	"q0MghxmpU+CpCInDJBwc3so8wH0jjZRdFnCBwH317X++Im8RH/eN+Bge7ZPv7XyQGNjz3E3kpmVJNdZoMMVDHEinTcxSN53g" +
+	"v/rSqY0DuaVnyjd6JrpyTGynCecHzJneacz6b48vaj1haWV568JVC8jP2c3TW85fsODmpMkzu8x+6N1+/YH9beJV7mnyhjev" +
+	"faeiskgh573jf81oHjevNbk0AyAz3hhucUOr9pXdOpS3jxNyRo4+9J+bdtlGG9gfZXvoclMTbQSFyczJtGWCoys17dTQD2o/" +
+	"GFUP7/Gf8/t23vz5CvMO2K74vAvSW75Aizi8X8JVPYcdyg6SaqYg3cDH8C6Tx5WjYDia5G0JFhAUiS6Pgi0iP5m0LRe/1yyy" +
+	"f0tx+qPmVHJVp2/T8ruVzztmQkwUpB87E433bh29h7SYykzfSj7Gc5c9Bi2ZzP37YD8CFEMS2fnsQNoPA29ShINgdTgF3uSi" +
+	"9/M5vKwtwasXaPFevcCG8gPMUb+SK+TyTQx6mLNy5WyVZYyCHHzlNdNCiBkd1W/V3hEQCZ4F5zYueeLQausEKOqybFfnA/uZ" +
+	"uWL6PnwAn0cIUAVJZJeyg6RzI7zJaGCFhESnWzAGNOfVe5wJCnq5sCLRm6NgIyyPkK/JlU/InypQ86t7P/Dk5shMc/sev7Ul" +
+	"38+G2Ejw7HsrEjYdW3rgyLuPP907qe/oyYv6Ve2CNk/i/UTJdDuwHw4ghFGH6p9ZL38/UkpnMD2Sp6krxyt5VVot4LHrafdY" +
+	"Ci4Ffexx5VAT6YgAvTeRYRxRuy7aCiNX3YwBk+WlqifIMld+LIxkEqenrMfKS0pli8JHDvZc+zj2juCzoEURk3w40nqWzHj2" +
+	"dMzTA4bCKpj3bR9wGYwHHi5qnlbc+rlku95JzigTyBypjrch6ewYdiDSUp0zeo8tIACXR8Gwbcm37fpGD5j1Wi9yeTnERkPy" +
+	"Sx9ETyPpuCeev2+v+DTzwr59sN93Xm6b7UiGIpYdiGIa4MqRPowgP2MnkE/aDzYP6PHd/eTKThBiIemtFw1QcuiEYSn5eqq5" +
+	"//wDg0gG7oHn79vn87EDn3/+wIEDvs4Mu2+fSPemEqtvsB/xS5BaGjfL9dRLDRu9ctOW4LWALYFxu4ymQvAKjFTyNmH0+h3l" +
+	"+h4j5vaMAH0iuSa+8odpTLddj/M/hfQUwkE1AQpefs3MMPv2kbiHIK/F3NS/WjTfjz+FGAi/Io71kN4HDyKEUfvqXxQsOwgp" +
+	"UYJUtkNqI16Q5OXnT/CyNodXqkUmpjCq04I3Ss0j5x6AiWA8cSl6GfluctS0qc9MIVdjyQ8ffWBZATEWUFS9wHB74TCp2IoP" +
+	"4J5Uqh0YZv9eX9U7X8FrzOH9+wAkfSHElrKDUAQdvwaL2KRgXiI/TTH1n/Kmi1wZDlHRUHToZCzD7ttLjuBd+/fh1yj97Ugm" +
+	"O45fgpQoukZ2rhwvnWBIbY4R9LKuCmKG9fqspXHEuh1/DyFfdSPfnnvNtK45JGdGg+vF10xUGaTHvhOfipvx/Nf3M5cxL954" +
+	"64BU39tU/6KIZAcGZOT0l6H3y8Xj5IPrhUfBfRs1YNprzc39Zh+7Qb6xkk+/fi6uS/TAyW9MIt/vpLUu5+SxSEj55GA0w++B" +
+	"bcS1dSt5+cTHcBJ334vxc75b7MADB2hFlNraDfZ5fgkKQUkIASuV7PFKbvJcolfvsUvzGltCMQhGgyuHDhb0LiNTSebrSu37" +
+	"c14IhfuGm3Y8S34mO6N7m/GSBF1Zt5emTwVdTrtXRiy1pnVkXkhfRMb+OWM8niMW7mjWjOercP+nx35eMf7czlalFa+T8w8F" +
+	"9PQgvwRZgvXk1AhWs1FwO2wJRr+Y/yb/nWwcsPqJ6eSn7fGwFx7B5Gc8Yl+sBgrn/WGkUvb52LzzMOeJ2fnNd5Fvpx44ILe1" +
+	"6htSW6OjzoCxNOdIXy4P/aLCTsB/kxOnXjWsgJipUQNWPn8fRE3Td1391GyIeoL8aCJvz/l276uvMi/4ru7F+xiT7+o+7TbG" +
+	"VPXE/tcDZbAPswNRRFAZXpOEHP9IPjz1qmkZxN5nHrbiyckSwr2v7mdO+q7uw/h5xlz1RMAm8GZ+ibQbKTBeXkjw6vmAcc/x" +
+	"ctJfWusY7jfyWavwX8hfL0UVnnp2o6EFWDN0/dMHQGTX0MI3Vw8fD7YBs0gGDunw2El4cdJj8Bn5bvU0RvxowAp8GO8Tnb5V" +
+	"0n2g1xUh/BLpjrrayhzUMANFMzC6MyS21oP3xddNS8k3U439tzw2mVyNJOdPv6pdBZap8SN6Qhv8M+4m7l3wvGx/9vpu7X1t" +
+	"P3Pa99M+ST6/sMv4JUgr3eav91dyfymfzgbvwKmRA2aenAhxz5KfjeTP7181sYOxQ/zMd2XfK0xo1Y79p/YjhFE3ksgOZwci" +
+	"hWQ5JUoTJVtGh2tmXkjA/m6XYSqiBq3bPgJWGiE8ThwSNbTLumOr95N51x5JXwWWSMg4ejKZ2jBxabvtZdfKH33kwPMvQTlk" +
+	"MG8dPAjkXUkf6QozV4Fi/TqtFUjNl1PQM3qBW0heOXTYQM6eetW0Aqz3m0fM3TsNombqu648cJ8VEnRzh5DP95/av18Wxz4m" +
+	"0vfTvv2M6Rr5r2+0uB4hBnUh6fyPkh3Qo3Sp53PIlgA8Nl7hr69e3uZU6OWqQdn0yJ0Fw3hDh63aVm4ePvMY/sQ8ctQbzY3z" +
+	"Hlp5jVw2n1/iCqkkX+SbXyBvnEiaBZHGqj+eN4Lm7/1JTKsJGA6Qls+fhOf2w37SYx/sJ8/s359eSArh1TdPQTbT/ODBgwfJ" +
+	"+3Lfl6FQ80uQGSG7XmD0nELQC4xd0qXdKzCCnmNu9CdftCkiExLJ9wsgvgJWFLYHW0kGLI8Cy3TyVScyZaJ4AreAB2f/wkwk" +
+	"1odgPe4qzibnZ2z0PY5j5kptoDNC7ENyO4I61VEvwB+Q8+LrpofJT5NNvR7ZcT/5cQdEMvsD3R3t/Wr6VnYZOwhpgtuipDO9" +
+	"gK+TMydfNa2G6PvNfZccnggxK5eQD/efkjXD7mEifcXi2gCeRPZBdiAyBeGRdY+RYHO7chLxN+T866+alkLs/ZEDFh6ZCFHz" +
+	"3GQCOdW+xWZIf+plGel+xuxbS3qSSy8clPF2JunsQ/wSyXeboVTVbXvw+ahu5MOyx1tAjDcMMl88bVpKrk42DVjHsGIL8b+Y" +
+	"Z/NwjPjCcj/jMs5KYmeHs4OkVQTaEGwsor2qR/CowS9C3oS7t35p6vE3yE+k2roBoiO/B+502lhyZZR5xID3x1vWkk937TyE" +
+	"wXWDtRw8AOQ9MRq/tm+f2PytZxFCGHWqvs6+xi9BCmSs6S+8YGMwZ7WAzs4IehfTjCwwFscsIiY4P2BokricvOtobsMbBkJy" +
+	"y2HM284XycJPD+HVYj/yYzr0gmNgKcPJ4ucBuWQorOwg2rL9JEsqazAmwlcnRvdY+MrULuSj4icgPgbyj5yIBvX3Jy0P0/FC" +
+	"lwOrJzH83n1iLAmDj9lBBw/u27fP14Hh9+713ZLLsbP3+8fT/rG7x2zVauw8PbRjpV2OwkaFx3kYtoJcscVMan+p8yMPw6vi" +
+	"0Y/PW976AJrxnzxx6L+PQ1z0RdCfTMO2b/btET8fDWQ6GY6h4sc519+dwBYdPATUkEi8Ubu1hR0orW+xFhAYq1SPOGzHL4pt" +
+	"ez4G5mUwO3E4xM4QxOHZMJ8kAi5mqn2VN/Fp33lSHUtex34ZsRPYpVIfYTIj2j8wTkGarAoYpHPSvF7QeR1OuF85CUrgty98" +
+	"ERz0DeGmLZsJRXqi3vbZqM+fmhFHPsF/n3LiBbtfgiToNAygS+/O88Up5PQc7zvDntpEnoa3Trz7t6yX6l8URexAuX+mVtAC" +
+	"NYMpj5ATGLHw28jXJvLFmy8ltjEMmvbKfeSHJyEm8ntgzkT3MfUf8Ml0iNp/CrLhh317fFXsgIMH94kb9p1kuIDu2WmyfQGP" +
+	"IkHBgAB6neAyyhaeKp8pi+o09cP+cIYcIaNecMCCbdtmWtyQc/JFYyfyE8Pugz3ibnEe7sG+DmWHjpEN++Cg+A0C1KH6F7Yr" +
+	"h6W2gQUrtZy8y+rVezjW6xaMPJ1GKFjkFDeQo440iE81zwY2qq2e/EmeBXDimVtmfG6FePIB5IDlj+ePwp+bBw/+r+/JaYVF" +
+	"vgDts+X2TG2jLCH/hIzax6vjyAetgZw5eTRpDkRNipnV/8zIckjJe1B8DhvHSYbW9/dz+BT+AbvF9+X60p5ksNfYgRJOwUuN" +
+	"fu1IwK4X2C7kqmbsQPJ12ewH9hqXg2Wqbv59wI2DmPL7SsQXMFdObc9Xq59neHmdsUv1Df4n/mHEo0R/27V6OUHvUXCMJBKP" +
+	"XYsFVhpwKRLMWruVYSrIHHOxCWZFMyvIlQFRfcnX/exiX/KHo30UfhzDFhgL5L8wdjqGx8BgZw6mvkrmHzr0JX70rNIoTqHS" +
+	"wlEn86ELYPxapbkvTCDHnOQbhNHw6svso4wKRSABIV2ux+uxmuOAniC1Oh12gzkOcuhB4QycEI5HdG+9GU5vat2jR+vNpHRL" +
+	"a4hwRzavaJFlsWS1qGieFQv2Jx/1TJvmefTJRz3Tp3t+LM0wRkam5Mdb81PoN0KIQX2rL/PjuJvSuqMdIR3W6DyJVtak02Aa" +
+	"BgOEmqhJINTc6f3Z7NkfQjwUQ/yHs2d/SL4lp8m3TGff53FlD+7vXrmlTO37jrHHlj24rwdNQAbEQSHEnZs16xz5jrxJvjs3" +
+	"axZWz++03dnhWDwkzJN+xCGEEFdDD91TikJx0hy7hE6/wkEhoPrUaYUMAK0QB8zFUZviyLc/jd4UB1bxv43Qh3MgLnYTOUC+" +
+	"idsEYYlH5uP+iUfmi280Qhs7kjw974hvPPSdfwQhjAYgxH7BXUAqFEZbuZqunlN/ZMYrsF+QucdfIQ/AbLKaHU1Wv/Ew+RUK" +
+	"yBm8AI+9ckV8FNr9iFADHHq6jKmUfP/0CmEALHrlOCwkD8Hkqsdg8uklEMFdIGegQJwnPnrlCh5LXvxR0tVIhNhz3AWkRKHS" +
+	"WNoouGsI0SsE9lzVY3AfWcmOJitPPUx+XbIEIq5cuYIB4ytXRAJhr4hpr1A8vRFiF3MXpD1vtbynAII2GWsFGET+AQWTSG4B" +
+	"L5ZAH/I024VuKsKiW6nyPoh/jVvDM9l10yhfSu+W99E0PEqqm4ZOkn6XVF/mSrmbSIsSUC5qhdoipA/UcqNczTMgUM/lag53" +
+	"e68VtEsGdd4Pfx3oNHhwpwNEub/zIBjRRugzvE+Z01nWZ3ifFk6y+a45drDz2Q8Kxjm7d3eOKygaS7/HFjosYRERUQmOhCj6" +
+	"5XPc+T10AkwIonu5CPEbuQvSnqR/vTf43xI8G6LxHPI1JIgPkh/FxSCQ/9B1YJxPLvkmk0t4prgEbMxqaX8PIW6CtL+n9tc/" +
+	"rWCk+8PcBLL99+tkLnsf2U1KYQysJpO5C1fEU5gVN+GRCDEUlvkzSNd038j/wRuhn+9F6E92QX/ciy6j4t70b1VH+rd+uUpM" +
+	"16ItAAIttif0rFoJC6//Lv4NY8gG7oLYRTx1hbtAJsPqqg54ZB3bokBmeX1OYzaZtXzDVuwPO4eLZs3KLS8nIxtrvmPXNRdm" +
+	"5bLla8nItaV2ZpHdt7uxplvpe6DjC32Hv7e2BPlp6MqP4x9CIcgqndMxmMGRQGNZyJuUEinUc86skMnRgylHIgdOlWGo3LM5" +
+	"bxbs3P/4trdWyFQ9vn/H4++tHTaL0nVKJJXPbc6bha+smYPPOzuUrfVltPvy+TOjAoS1/+7geyPJDzJtbJiY5ehQtvb9lQix" +
+	"VLaYr9ELvTk/SDP0s1tWTOADa6hSYK30d02QmhAgJ0JcpqQnlAxAN3jAxWWS7eS9G2QukwArSG8MR0hb7oLvL/9eBDdA3seD" +
+	"oDqJe9DQOORpAuQpeJ5WRt8wWg2ZrQiq30aIr92D1AuS3wH9H/B26EvoziM+SzqQlqSUTMBLRA8cgkTuArkKJrHYdx2uEDM+" +
+	"zejICZl3tmMw71CPd/wb9CNPQj9fX5l59mAtw+zBBnU0RvIFU9bsLoIWdrP3ia/isqqV0BfGSDuS5BJ7SGoUDFqCw9iBzJp6" +
+	"9k/64L+oASSP0b/4nPQ3TDaA9BNsz5g85G/nzIeyLF01Hgcu7ZK//mLa/PUXYOYctQi+TELkscv91Zf58dxNqS46bAlYo3Pl" +
+	"eLW8zZoJCl5IcGpNrhydBtsSFFqPyxoPXo+QY+bH9Rpy9MKFo0N6jZhEXnxHq32bHJ44svfgY598cmxw75ETofNprfY0dJ40" +
+	"gn0ljvxNssnfceSn2HM63blYiIyDEPgQQuIg2vKRXv+RReqPHkOIw5K/Q7wsf5vW5Q74TgiSM4bMDWN0ufEgcuv06dOngf8G" +
+	"+OPHjx8nt775BtqQl6ENJBCwQQV5iX7IJUK+pk+xVeK3ujpQ1zQ88zwK7IMNl/fBXP6Kt5t5RdyBB3EXZMtXA4dZCofSJLgA" +
+	"vXrp9FmgyroDv5hOcIh0ILdIJbxAP8DDIWaUn5oaGiXkuE7bk6gAaTcu0NYSyVfcBV+ErK8O1ZfZa7KfCmis2lynYNKw1kS3" +
+	"BlGnMi2r4OEHGD8AEj4lb4ufkdNwHxzz/cKOJmPfXwZf0n50+UPDSPdbN78CMxTvBxtZvm/nePnsiTTW425KG1zZ/licQf0a" +
+	"W2/0d5e37+VGNm/TIjMmJrNFm+aZsWRz/dGh9i4Z2OWFqXqz2ekuyHWaTM7cEesezBo1KuvBdQ9mjR6dJS4vTDGYTE53gdth" +
+	"Njvcdd9Kcg3Ye60UXSzXb+SNBsnKO3C90Wu/hkb+w9kwYlbe5j2bJ7VoMUn6wle3BSz8tu2ShT8HcdveX1vWocPiI8OHHVlM" +
+	"v+WyS6SyTdLeCC2Jzr+9INlyqTyuliC666ZwUrklOjCcmpW3uUe3SnLpFXDowwV/yWRzLX3Feb2PvPZRdPQamUh8hRLggAGv" +
+	"kZ22pL4BMoYGkbp6wZAvTt0KUxdTeuvZ3GSotbnMQfIU6Qp9oRr6kG44n9kqLsEzfcOQv+8O6iPq9BBBvUMjXTfipPL6BdlY" +
+	"fUMrS/ExBAaQnTCgqlJG1gH6s+NhFNlYtZ7+Df6NPfRvcL+ulaL03F3T1K2ySdr+Hfo3rvAP1rYIVvj/ZC5r6hz/aEDvcVgq" +
+	"Vgr+Kh/F5WW9m01SH2821RAJp/ylCuF68sUxSKjs1mNz3qyA3tdYoj567eU+ecW15OKr/vKHDe+bZCM7X4MBtEP/YDuluFgd" +
+	"duvUF0MWrN6+LUC2X1ZcKncTxaMklI0Qi7QaZKVU8Ak0qFsCNhp0LuorlSPFHsTSrneuw0Z/uOgv+J7MgIfxiontN1zYILz4" +
+	"yScvChsubGg/cXhGevOMDKuQnmG1ZmTEwhMwAobDE9vHbJv2zDPTOpaSv//7E/m7tCNNbRuznb1WOb6yclwHsahyfGWHcZUI" +
+	"AVqLEPdaI+OAWdCX9tfkKRIJP3EXxKfI1+KzuBckVFfTls2NluxyIQrmLwRpUTOEUIDsGkZCQKtBQjDLCl7hcruoyyd1/6Tz" +
+	"y1r+BVYlM9RMZk88SrmHcmg1SeZ/TBf9mGNLlx4bre88RpbEJPIKVstM4TdkJqsMNeIYu1UWx4MjHt+06YNNm7aPeFCWydax" +
+	"24P7I32gP5JI00qkBfqmR3/au/enR+Ueirx84gQhJ06QV8iluvOhFBTczoP7N7l5cxeqOrCHpHmUv9zOgTGb7BQPGJHd1NGT" +
+	"eUZ8Eo7jN+BzyCOXiJnm74sQ9wZ3QfK0czgdWoGGE5L9zNwCDTqkNZlNWoHWfNlh0ShovB4vHnJ28REY0TM+BfqmxPeE4Uce" +
+	"PHt28RGyuWd8CnkqJb4n2XLkwbPMKuuU7WDe3DFOD9HkR31c31XkyvbJAjlqnbKdXKHPaXwq+hzM2ycLfvpf8vvggyCZlGp8" +
+	"hEymrlLcBTEdWlDPKISCaXdJNszdKPmSx1OjLLg/hPUNmYB1ZEItI+kxMiN4FODExnlJJESow40h1s+NgBCgacw19qCsN6CB" +
+	"wgWtCyrw+k+qzpxnP/cRM1yR5xNzyQTmJXYAUiALEhDitDTiIm9iBC11iKH777Qyl0IJPWxM3cpweHJeMrTSQStV937jD3f7" +
+	"6sfy7vnFDmhFjgM8BnPSS7pnl7fCopjOJLQgZ1KS3joxZEvv/JJ26dhOtsKwQKwipktQDKOnxRcRoO1MAf6ANyBOGvnatMJ2" +
+	"nCmSD3jDt4SygR5gCvBe+T3Y6FAJZok4k3zAub4VHRSe/IY/gPfoe85FFQAfieI5yEn/Fn8hwZPf8F75vSwTvFc8J0IO+e1b" +
+	"+Ke6Gs1FiHmJu6nhuQ7SmG0TOc59xV3QOM0fIeTzaHjzR/Wef+Z//lm95+f9zy/Ue37B//zT2+Cvm99Bn2sUMlSjeD6rl/+C" +
+	"lP+zBvkD+D+pyX8pCP+5evkdFINGYf6o3vNafj9qlM6Pb1vuxwg1gv/TBuWeb0D/pTryPFdPbp80kKec/9xt5PPJbeRz/jZ0" +
+	"ftooX4Hnt8dzu/rwUaP4P7oN/vP15OCgfGkU5k+Cngfr/UKD5x83oD8Yz+3k/0kDvj69Df3n71JPGtd7Q3l+chs6P75N/bzQ" +
+	"KP217eJ8vXr+6W3wfHJHOTfUy0cN5BOc/7PbtKNPGtTnC43W5wBft8P/8W30+8lt9Hj+NvXq09vI4dMG5Z6/jdzO35Ge2+G/" +
+	"HV+308uFBvkvNMh/KSj/7ej89Db15+Pb1p+Pa9Z6fdwFHY/s9dIuJPkMIcRul9bAdXTUY0105yYKLrpeY6Peni52u3iSVJPr" +
+	"oAP4HvqSp04MnzBhuHj1ZxHzVS3gifFvjwvg5TZLeJ2BNWj2GyntrTlb8q3clwfmWuw3VSnwKvSF18BGD2xJcHTsOpi7qeNR" +
+	"Sg3e9yU8GQjJa17S3F8l4ZFXu7QC+zN5moQGFrmwGfpgQfwPXdwSD+P2FA+qvsykUDzmnoFyqP+njkeOumloI6W7IER9L3U8" +
+	"Kq6RW2cpnVw3rT4bSFPfdR2PsoLXWHQ8yg5ec9fxKFNKP1h9md0tle+pyT9Seu+W9JKIEHtZGovGIASSd2ohRNGTlVY6mPFm" +
+	"gJMR9HHgwv8bP3Dg+HXQR7xB/vfXggWtJxUch8lPZo/ojjfQEyMwD1TYfSs1cFIEniUrJ3UJ3ifQ8VhRN43y6/GQKqUzqi9z" +
+	"uZRm9eeSTp9BiDnH/SavFso+tFpBq6HBOJiPmm9o17sreerDaz2OccvJql79ps7zOZj/gfbnXIrLg5DiZQl3AUK1cxidNIep" +
+	"rq6+jhDXVkoXIYQYtAAh9hNJHlpkrZWIlgn8gAxw5yYmg19A+FiX7t27nHh39OhRY94Vf580C3gIw6Xi3+QnXz/colOHys5Q" +
+	"hFcOGTp4MCwR/5t4auXFT26lHv9w/CZUTxaJddPan+vpMymw5si2aLiWwLbw9cbtxc9wsriDeQVsYAIb9ABb8HqaTlpP89f9" +
+	"01KdyJHfV1/msqT3eTXzmCzuN6SVysh1BASOBCvSclnkqfGz54wnT0EcOCAR4pl5Rx9//KhvATMPisFDzpLT1dVIgxDznlRv" +
+	"v5Dq2SCEOHnOY6ZalP2gXSDQaPvYnavzehKTgfnfnE+++c8F+IysIx+QC2MH9us3cBxkQAZMoPshV//z9U/k0uCpwyHkmd2g" +
+	"HD51sCzDJQjBu7BIwyPtuzSdTi750xHv+t+zXWGRxql7Cz+MkIbXvSXDkUvycxSBuwY9T6Pw7CANj+JepmnqjCenY1+mvNB1" +
+	"3xeZYnkNkUZn4eiuDpPh+xiuQktynCmufoU8IK9RLAE98yJzozavMZBX+tyo/opU0zIyqy+z3eh4GkbLMf2rLzP72dkaHsYE" +
+	"dMgYpb20XnI/iBBeS2nCqZLORlVfVr7IFdFWzSU4s6Td2izZK1+KHy/wCmzUyLu5ciwHV442l3E4hcDNB+d7zrm8cuH8Vd8+" +
+	"0POfB8a3bzsRrOWt2z02fuSgAYv2HVg4aODI8Y+1LW8L8RPbth83J7bZtGbNpjXb/UjVhctfrex+i/zvHfJLVlp6VlZ6WhaY" +
+	"zwL7V++Vly5fqFq/W6KvR/UPITHsIOSS/DOkEuUIEwkRUIdKviGVTodTMaVDRnrfYW+1GTNkyMwvZp0a2+at4X3TMzuAIdtu" +
+	"W+iau6KkW2WHrqUr5mctSHBksYMWvHxwx+X5q9q1atWu3bJ2q+Zf3nHw5QXD3nx5bjWq6BKm1YZ3rgA09+U3h1FZ9kEopID2" +
+	"dcwJ2tcxW3i3JONEhPhz7CCNg/kPfY5+Z3+XdDq8+rLiXe4m0kvr51nyXN/GS81DaiR2eWknR+fOxdwbe+le6t4ocpC8So6T" +
+	"tTARWkIZdBQnPL5//+Pb9+9nZuM+t1JxryryEGyFMTAWtpKHhhLftZ+JD9ifrwGLMOpcfZkfwc5GOslOS+UpeIEekBCsyOzS" +
+	"cv5jQhoHP5y89qBuyUPkFvGRryARWOAhXLdI7Dl64sTR4yZNomcb4NTra1ZDHk6CPPL963Dyhz3ktT17oMUe/xq54lPuAsLy" +
+	"PVoMUDc2v6GhB5vY/jDpZYgQv+tOnnyb/Fc6fzbrbdgOv5InsR138W0iN3Ev8Vnugvg0ZY3i3Fx9me/BzkYJkhef/zQXVbpN" +
+	"xuspBHduzXFBq0k2vNKJQb6HdFxw2+nQpfT8HFTcd/S1mfNHSscFQfsbMQwf0aVt3zl4nnRY8N2dJbmkhB1ESjNiuneUjwve" +
+	"IOPaV7SslM8LYjSBnFDQsYhGWtmXV378htRVAiAYNQoBhvN54o7XyC3ffHJreP9c/AD5uhtMY1+BaLpVQc+4kRN4WKt38by+" +
+	"4vzDEt6R5Ad+DjvIj9dNm3rNrlAJgNZm1CigD5dAyp/50ffuj54RPbDjcjtmxSeXxAfo4TfyA7Y4RHIJD4onaz/2+0chpHhB" +
+	"Oj+KXFqPLiqwWGfU4O7f9+NjxR3kKfLPfrDFQDucTYmjK019xa+I7D80HCF+BDsIaSV4r0crkSVItOARjydaOIGUk9cul8wF" +
+	"lHh/bzhy5JLvAWaQnbSdT3xyXxDyrbz3p5fjC2gF+ajcbvlUHBsp7iAv+w/KyefhJBpayifk/OeaFFj2N5e8H+hVMYKWsTH0" +
+	"fNN9311mMkk5kBPPfbKHnnMSP8JZl3wP4NmkHzwpLq7trzQOlCO1w5lwEMVVVwfODWkcqIv/uaV6DGJpfkUMd1OyuXIb9e/w" +
+	"MQnIaECuHOTORUygsWo1CLeDZPIZHKV/29wk/wPVzV9BSf76FR6Fd4mbjCXjSC68x2yjrZesxXuCGyeYyTDyKNlAhgVaL0Is" +
+	"pY37lZ0t0aCVTrG5tDb6YWoaKuNvvRqElVevQsurV1NfPXPm1aNvvnkMusADZBHZR54nC2Aefpe8Dy7yPlwOtFJAgeNNtAX7" +
+	"+3TlG9xNjRPN5A6hOI1TlpKG18vjzo7VlxXPsrPpc/b/R957wDdZ/I/j935WWrqSZnU3aZqk0N00CaW0pYOyyy6jBcreU0YZ" +
+	"smTIBnGBgICogMgSFBFBxS0q+gH3QAG3CIiLJtf/6+5J0iRNJ6J+f/+PH9o+99xzd+9x73V374utHkffR1aPkwpyI0IoBGXi" +
+	"fsIz/DW3NQM1ikVGlIqsKA91QN3RQrQEIb1JqSP/wOs3Eo9sZYY6fsfX8Yy8nuUk9CskAvmpMRrougwNFIuxtGwgP0kAnyx4" +
+	"Ea1BOuTaLFliv7FkCRMo/sZVS5Zw6qVLbXO3Dx68fdCeHYMH7yiHAzsGDdoxaA8tsj9AfpXjh91fDVi9Z89q1rQHX+6jCg5R" +
+	"9sGXHs8AWLtnz1pIfxyi+ihDglV9IHKPyX5k6VLVli1b+E5btmzBxVu2bIEey39cvvxH5vO7f1i+/Ie76U+cQcvE/+M0twe2" +
+	"/5l9e86UL33irRGR0dHho97ct7T8zSf2nRm49IkzIyOioyNGnnli6UD8/hbiv+BRAlm70Tn3jtCDxFJ6ZpzoxRxwHjemb/25" +
+	"D3AhfLCvvGzF3finYsuE1lYJHyzXalriY/hRXEDD1j/MmnEZv4q/X7kSdIWFi+9ynDm2L+cOI4QASXAxH8lVoGiHhWKipzgV" +
+	"EsF1pZwhkYpJCReNN35/bNjQocOO7Whd1r592UJ8Cm/gKj7/ceasi0e+mjXzx/LlTy8vx73Zuxy+2AVRdlFY3A6l03FPnmDp" +
+	"QM6gi8O0z3YeN2eWi7JPghCnd8oNpfi9kjCGYyRF5ao7n30J38NVfI670FOFcJR8dy9CfEv+PAqj68X0iKIs04GxTItJS+6p" +
+	"g1/ggyeO4cfUSX0Ipl45BuzS8iJOMv6Hb7EZFD+17GFfzkm+/OXHctHG3IwQt0FQSAUmAbnNt1Cv+RUqzicGkYM+3/OrRbsP" +
+	"dGaTnJxAH/zkk1/t4c6euDmdX31zegP12C0nqnY3oh4z5oTts0bUg8wTWOqzHtm0LSfyidbbc4I7e3O6r7aAxLS/27cPT9vj" +
+	"hAI1VM8BRYP1HFA0WM8BhU8YeLd6J07wqxG46vCkBs3tOHAPY8XT+NXi+Uzne9qCzOSosQfWkir4zIkG69gfbLiObYRHHZ7s" +
+	"XSXGAQyEtfY3xKEiQOOYLcws/hKZN0DuQxIvCO3Y84teU5dP7cVf+qznZPtMZuXknrTug8ws/gKtqzPSu0CtatjkqMs8+FnP" +
+	"ycxK+8zJPRGDdjJbYAp/geZpQXKTTKIzksvvHjkTfU/0mTP8BXzqhx/wy68hVKuuzGpSy8gxuEdoVWYL5P/wAz7F3Pnaa9XV" +
+	"aCdCMIUtkkrkjOtc7FyEmOv8OSQl9kYGo6P/NHKVRCUPPf5D6A8/RNqY3kF+Wx/j/UEH/UAGBtDh1ZeHT8RVeCBytbFOPKdg" +
+	"kmUYdPQfbUMduvvd0HffjXwXfgn2W7SK8wd0DNBuQPi7bUMH7MbH6Pej4S12On+V4kahljBmqVYWD0t6/HInG4il7NmPviyB" +
+	"9HOMBj8AYn1Uyk7n5tL6Ulf9O/vR+v7MYPzXU44PjoKYk2EUP9hjf19NFgHxbDdd0r+Kfyy2TJhMtlSJu/tunnDmD4Dv7A4Z" +
+	"txkXE7lCYw1EsrvCG1qaNkOllAlOOWxmu5JMEvktR95B80jsGr7/KDdk6DH7feVFTIsNRKgbn3SmkRh05aK99Ox0IpDFuBgu" +
+	"lkTw51Er59lyJbgudNUSR0zmdjdSIpihRr2wq/FjszpPLSnetAk/u2r1qhWrV69cvrZzpy5dN+4GOdY69Uyfm7gkQKmdMnrd" +
+	"F9PviInNgbjOBoPZPHxUpvk3GGx7hTuMGBSGO/Ln+W2oJUoXdzXUHkgM1IyDXG5KsncAFf5mOAaF7ER894aSKSUdNm3Gn00a" +
+	"NmbkkNNlKzt17trlHgP+6dT27XDR2lsH3bgKyMUv3cQl/mrt1NFrv7hjeqhcDy3NrcmIzJk/HDpHUnxUI6wvsX4Ji4gMn1t9" +
+	"kZtCffBeCCEWDcfD2TXcXIo/E9ElesdtbNQ1lYnZE8iVglQtiX+Kh+PFxLEkPPQpHjU03XTH9OdKevTsZdlk7puRYUoeO2Xc" +
+	"DGtO2zZj23ft265n7969f/xtKuN/YbG2d99Fx0ePlEo1+Hpam7z8rt1zLBfy248dVdxeZ8zDn+pD5d9evu+UI0bDjqFnSB1R" +
+	"BWLV72FO4mJYgudhG1fxyXufYDEWO6T6InudxBJQKYWLZOzZT/07GVK4PDwxxqll6Z8sI4MpzxAfrxTvguuwlfqsW+E6fqQP" +
+	"8fseYfxg6jGns8eft5nZt24mghRfJWWi/SthuLmoBcpAOaijM5JGzQm9M1WMzukFWrQmN+8vg1h7nj4gqNROD5EZIvp7rI26" +
+	"hX0K3qTO4Q3gT852JJE5Om1IxZixxDeUj6B18ZgYoceok7MX9oE/HZ7gcuotRlOP8ZVjLlfRHB4UUuMuwrKek4Kj+nYX13/9" +
+	"qy/6XaT75UPpOROHByFiTB4KWtbd8RdSoYh4C/gEnvG0/W0HHgc8Yx8LU55hR247cGDbwwcO8Fdd3kMyQSS+AUabmek1zMP/" +
+	"pzkSJAO5uUiDEpGZ9k27djGaBwI1aos77lweB58OHOjxp9iGn1xTcPcIL7SBZM5q3MLhTSeOnzJl/LjJk7m5oquBqndYEjxQ" +
+	"NXmy/U4nnu5yjx8QPIVR/lIiBAEADhSByiEipYhd9Myz7Gk8vtJ+w8lg09MfmDcRyvDXhIPs++0nRYRUPTLn4WfZBbZF+DS5" +
+	"JYy03736omDn5qIEZEJIT6JH5O5DX3hQyZQKldin1g0j/PG1z/zY1z/A8ro3BmTXC5+9AwrwSdujIh52nnpVKivt7gH4L/jX" +
+	"xO/m7NvEtKza7uInRHPASE449/m7TSnQsuzHNTNpHAyGcpIQxn6JzDEphfI0l3Mzkc4dEb4VdO4keMwbmbkOKMNBrgXnvKg1" +
+	"G0B2nSQvucM1VOznzfW/4F/xpySHCUJucNTEf2RULIi7i0FLZQP7DGTjsWV4eJ8ayLIHQRqUi/TDP+KrImy2HuxBOn+ccJEY" +
+	"UBpC2jrAIZmt5VrWpNSxbsBrdUpW8AFb5ZiQuYuhcN26T56yjxAxAJUmbwBnjXoPNuKJ57GfCwtiXj+iF8dTWNVekjAYSCay" +
+	"DHL/WC1hmA8WKLf/0G7vqgszexm04YKbJMxlX/rrxefuA110ePdQkV9xsXA3Nxe1RJn1QU2p6NL2eeAGvC/I5bNm34QC3D+/" +
+	"T99Vx0f06qJU48Ui/LWAn4N/wx+wAVWWE507/9imS+capqX6Iaf6IneU6D32Xqcvw75J9AUb7lxT4z/izxNukGvpBinnehiJ" +
+	"bB2FgeXlcAPusc8mu8Dt3+PH9u3jzw/GtlMkA5XtCslHxd3hyNdGbtQKFxTOtpQ6WU1bZtOrUHD8uJiRipHjOXgmPvncc4Ji" +
+	"7UsYSE4q+06Sj4p7+SXk3MvL/0Q3GrVAiGgVkmeRbCFPh4HMUFt7XAllzM3n7RNIVrYl7BD7YIfNhRDbTVDQiDzJECnTykie" +
+	"ncn4JDOK3W/nF9jGMJcRoM4Iccf48yjICbfMkaPODgOfeor0cPgwf/4Cttkz8OfsNeAQoAlELwsKx746V/gPOkLhZ5/h5z/7" +
+	"TFDgr7HNFsxep3I9uvqisIC/ipQoBhmJXFeriCfGkOCu0cDqZKZQq4XeXkyOYMmdR7LYEig6tjF6yeLIB47hE/j5Zx+IXLwk" +
+	"euOzULitf1s5s0retv9u+x2yvP7bHu6fx1995zH8G7bhn/e8884ekAMHAY+9g79fPHD16oGL7ffeO2jx4kGifuPPcXPdx6I2" +
+	"KcVAs9Fg1NHk87XHwlwFbsPUiNMvqCo3YBu+ec9s5YunI6bdA8IEqzYYtgdpWo/F/YMM1gljW+u5uVtmnfz83QVbtix49/OT" +
+	"s7ZUo74ZnTtn9MW9ytr07dvGua+Q+NY0dpdIx2FxhtaNgvgXuClardvfbAh0Oka2fTtV77Fjx/Az+Hm8HqZAoUPnssNcyvfc" +
+	"Oaf6PXfOFXz/3l33+tDDu6mvEIn0CNXoVlEjxxkFHf0jQ22Ru94xcxzKlHkIOLJ/Fdt+Xb/uV2zDH4MRuF/Xrf91/NSp48dO" +
+	"mcLO9Q7xLV8uBvmWL69GzneIQdGOvIISmoNDRvdd6oDmF+S0dP++Ukv29DOcuJD+DZMHAKH4Cq6+9Mor+NFVq7iTeMjE1yeA" +
+	"miyy34ltS5fSKUplYil3nuZjikUIESgyLFIgm8BoXjJ6RMFAXUil1sxGvjt58rsTbYvugYLCnWOPX716fOzOwq++wifZWXvw" +
+	"S3t2Q7uqLz7nPtg1bBB+xn4TPzNoGP7u8379EIPyEBJW8OdRDDIg5Bo7mTBxEkGtcNxuTAzubNBSCBOBsbgBgh8Nn2FpA2GR" +
+	"ppmji3OmTni/I5RRcE/x5wlU9puMoI3rkJKYrjpmHVM2/9Gp4+k+AtI3LhUmcnNRPNFE4N2fKUPtDizZk2jQq9Qqq0VMRAWX" +
+	"2rr3+0JySygs2Dnu+C+/HH9tOX5eD2MWjupntUx8dxIM1uqK3QcgwDUXKtbYv2FZPnMvMKV9tm/fvedhx75mvJDEu1EA0iGR" +
+	"01UO7ta5gtYKRkI2Nau15CgTG7LtwBM7Hz5wYE4kPoLXEVaHDjDhxafjvy/aVo6fDrjRfi/3Mbb9dLVaZOSqQHgQJlBmH4M3" +
+	"2PC95UmDz7abFjz8bBE5E4tL+VhuNlIjLUL6YHAcVVGpzVoH0XVmk1scu1Phdgr6uO2FULRhAz5x4cL5URMnjhozceLO4eXQ" +
+	"iRGgQ/nwx6pafd6v3+fsz7vxC7t3Qzvx7LyTj8ktkSIfE6XIiurATDWE0iRyhoOVTxHmhbJXXrnk5AP2BB40cfjISfZijHm/" +
+	"7cnJ2632H3+w1/CyoPDiZffmZTU87eBl3BefvOceKPjqKxdDu3jZ/j/7EWHp5+Xln+OvXBxdJxwg7iZ1zUqKPOeULFsFZZc8" +
+	"JqUTENv07TyPbQSIjO1paXXDQZtv7pwUVtp3u0BwTEqEEKAMhNgr/HnEO/cYsD/jx+wPs89xh0FHYxal8DlXgVgxlvAQsZe5" +
+	"ir9+F+MZpYKJv0rPlMsUKpNMg2RmqXMLgWNO8QVLJ+BP8dUxi9dux0eh+4izd505zy7Hu5wR4LvBsuObN6vmSRZcXD4Lvyy2" +
+	"y6dyc8WYrkJlUmqMBhoLDwaxYYtJw60vLMcXRw6N1h+nEZK1x2Ais/ez5eU/zrzjctvvzcXw5CZbqtBi1ynR7nkHIUbOVYQK" +
+	"hGaIQZtAy6Q41/XBkRaOScHj4T7yD7RHjhxBCICB35kOIn6ArmMBA0Up36Xw5+2n8WAgYTF0gN0L4jlbBCR+sfQ8f95+nkkk" +
+	"/Tri06ECe4L2S2JAlwUF8kNx4g5vXZxSYSKmCWFPZ3zBSAIhGplUm8GMCY1s1WNixTNQdORIXFz5/fZnhw4/hr8D8xA2sY86" +
+	"rqALFMDj9vDz9hPLUlN+nDn7LaY/KMHqyCcqtHPaNOBc+aTLeXfB99Dxa3wT78BhdCkPcaiqhKx0csjmzHvampvr0DjUtMl0" +
+	"j1hR4qq49bu/xVWBXoEqZi8uJWucTIsNkxxxKq5i17BBVxBb/VP1Rd7OX6UnRROJR8fp4smav9GaaSaqVKWWWdW8iSWYEWjW" +
+	"PHoPhVWmM/NDF+Pv78UHX56eWpo9BdZB+bLfJ96TjWf/OGTggMmTz04eEOoX1mHCa5ZB8f+bXFwMZ+ceiLlsDAmGsbCttRX/" +
+	"Zrq8HFqZonSwcOTeP+7qsyhECnGbEBkTLubbcrORP0pDWQ4vjMZ/5I6rMcQ0t06xaFVLiGCOc+XwA+fZeWb6iMnwUHB06ymF" +
+	"28cdm50ZPSFust+1Y1RWrtXsx2/jzwYfGtB3Z69t9+KxQWGW0SNbWxh21q77JZK1OYm97B2Gle8s7qtfaGxbUAX55cMfw++l" +
+	"L2prP4Ov44saLagt5s27wJoR16NXj3UIqn8S97E5YpOgBbVezYMWJDKSq926x5kqtg2TkY3z4BIuwUs240cXZz/vTBmb99Ll" +
+	"h+w/wiNmfM/vyZfzxTZp/tg62nQlkdXCS1o8Dzbi2fi9gfjkmrgDzmSyhocWLcOZMCYWP/Nx+qIcgl+EhEg6ThWRFXItWGUi" +
+	"Gmm7MonRqjfKeLWZ8KX2deh4V5vn8Z3423fxM4uzn4fDY+HFYfx5+yPtmTA8IOWbfNtbTEkH+19wX/Llgvs+q0aD3/4FIcRQ" +
+	"fJi5uSiAWmsGM+VXwkS8g1/Fw0EyrVmWaeZpx2xHEEII92aZOfw85d8DA3Pla7SPku0bBC7mRX8/Em2F0pIlk5lVu4YNmtVz" +
+	"SfqiLPvAyfCQWjkfd4SOaQsREuHkt/PnURTSUW9eBE2mdRxKEiRuqZnlhF94JZC4J8NRojAy8J+XEv1pm0uQl7+oJktz2oTU" +
+	"6EC8F2LTBt3DmO1vMW8mX85nQofHZ3a53H1d+ZCaXM3QIjkybQYLQzR3IZGW7A5uLpXPjluu9GreOSpxBqvZuxN7J95ZYGkB" +
+	"27W4LaUkFOTumCo1xLOpKmX/4lnrF20RgcxhVh2bNJLladvVF/ki/ipK9cx1qVfzKrUzVaJbikveSOaT1TPLZTljboMvy0MG" +
+	"47/wtYW42pnhsp/9ajYEBwYNBu88l8rTl4eFyEad+OOapSa9pfLpy4MCgxwZLh1j4wZxc5HJc2wqtd5xMoze9OWRgJM3Wqk5" +
+	"SPcZ7h/9Yt/yAYX9W7fSwzbtLxGK0is/jTg/peKtMYMrCoZlGXV4Rtz3oUH9Prs44GUuB19NSzOZE1MC/AXJukW9pKE9nnjq" +
+	"iE4H0szMNllpqUF+gmTFop6BQT0fPbwvho5vMUI88TXVnuexqClMs91aLcA6FhboKe0OhqjQbwb0dawsJJLD2ty7UbK2qd2e" +
+	"6NtTtCPHMVs44C/QtXwkNym14skrh0+qM5tIWpPjn0DBp927f4pPftKjxydQwF8YO3bsBPsGGIa3MZMnTBg3jvpk6cxq7hQf" +
+	"gwRkoBazGLngldoYcN1IYzDSn3Rjpppykj7OeSaMvVJobduxBXyEDZIB3dst69BhQ8dBcxJSZ3d69NlnH+0yIyV1Sretz9je" +
+	"L+rQoaiguJiLyCrOzy3M6lBsMDwUGZmcnpq6Hyz7U9LSkw9qK45UVBypcK3LCtVEh7OfO317uj/S37mzh/zHFeBH6d7II/x5" +
+	"gipxf2TtfcnkC524NVlOtyVnA7cNP3rJtTHZ6VzcTGQEYp3h7ye8PtG5Z5LmlA50nCo2OZJJ74FOYP4MzNARd8Rn8Dv8+aoT" +
+	"rN72KVd0M5GNtX3l+JbhJBOd9tQesv1ZMvHPjeRdFkL+PcU1dJoAGUgqZJoNWc79CCXffA+d8Gk89cY1fPjb7/Az0BbW33CG" +
+	"E0l6ZZJamT9ftYG0tZs7y3Si66X0XsXdsJ07e+KEaPP1Z7cwU/jViEMIlGAEZgrOHwDf8qtP4DPbaf4OQGnMGGaruH4pN5KN" +
+	"GIcWMsGDpcyYucyeEwhQLGQyBz3eb2Bip8ogs4L9k7xXI8QcF9+bxPebmYS5wSV87olaY9CBFZgp6Th8AJxit0CP7fgMpfdu" +
+	"dgvTiesvlch7IuQo68+MYabQsl6usjTIZLbSst6uMpKo+yAt6yOWecFEhgSH4DPpYPt1urDL7PFqv9TV1m5mDNOJjRfHYX9Q" +
+	"rAeZzBRa1stVRoz3rbSst1jmhSdHn1dkU+1f0YVi9k/H+DOYrVypVCIvQwi/6dV+qav93ZDJdIKr4jhsI8R6CDFTaFkvscwT" +
+	"984+q4Ln2j/iV1elneBzvfBTUYNH1/jLauB0tV/q6nM3QkwndEIcR1Wac20dykR+I/kRDu2hmw8ADay+yIUIa+nqgFSrkZnl" +
+	"WpYk0pSZM5FMq0JyLgQn45g2kN7+xBE8hNyh9zAwIfZzTPoSiMfP7f8NVV//DPZD3i84DP9M5/HA6q+5EOF+5E/3R0p1Akv2" +
+	"kcr0Uo3MLNVbjAYjOXaolHBy5upzTzFHTkCsrQtM4NI3AJjzhvFgZpjtkCjg17vj+2CU3H4/M641fNB3unbY1TWmkoc6VuA2" +
+	"Yj8XuYHCdkdeUqkuTuboR0s7MlmMFl2cUiulazMxoGQz3//6y/Ogx4y9Gm4kJQOMGoL/guEwBoS0O2Yxd8FAvG8ASXjhb9/L" +
+	"dJwCn/XOKRq+DCrxYxP7tMf0fPtAhAQ97TNN7JNVMqYMGVisBiYHpNoMImw1MqWUj5eZM8lFYDKlIlTNybVkQxVn3/sIMI/t" +
+	"rfq0a4+Fm+HpdfMzHxwH7+FUnBWbNfDBl67A94AfCL757AZuCBTjS+vxo5Bn1+Rpd8DUV0cx/Mw2Jvv1VfYz2ljGMnnq6DH4" +
+	"z0H/wznFPfHl+xlmPULgwP12qrukNI8YqzCpjLJMXRxJ0EVQw4VgDU4ELbSxHn2NeeJuiMHng+EgHIWPsT9gUOAj55jP2IUL" +
+	"92IbMLANz3BrV4sQCBKVTCnVyKyk7YwcyCRRcgubqRNIDISg+2d4+VtblzBI0R49MbKy91DYcB+rxh/5wSE4xt34uLID/m0G" +
+	"FhjFDfzWV59se9oGYFzBPPz0TngMIRZZqr/mxgjbUQukoLHPXMiDTOKHqbyJnGmxGszkL0HCyWCeoaw1O2WCDN47fe7sa9AK" +
+	"B+FAfctNsBLuY1kI5waHRfvFXduZOdB+E9rhk8V4F/QJsj/JlOfn39v/vudhJ45JiL4H3+vgLUFN6RxPNKwuTimj3TKs1hJq" +
+	"lWXqBKVMqtWEg1RroSSXMy8+vGvXw2yp32t417D7A+5ejDNxPrz+7J3W+8fACuCO37hhTyN5WD7A5fjSChjxAfzxE/OgPd7+" +
+	"FNONKWKSmPs65tu/ILhGiL0sbBdzXbDa0HCxf0WoWsuE01nKXpqI3567+qmCAvzWDPgYDjF/4q14PAyFB+CVa9dw9ut4+TOw" +
+	"AibDAqal/UNKv0tciLCOzkhGq5HJtaxC7aSdykzyaSgVEpWMCwFswIYQSFYfPTFhQ589X8GDY/bvhw9xEAMQjJ96D17b99dN" +
+	"+GXBd59MuHTHfPyRqLMGVv/qxwvbUWuE9BZrPGsmU4HE7ixkNihYbWgOhBIotLJM8RedGqpQtUXNgZZYgSxEdJ6DdxrgyAPL" +
+	"hj7+6IiJB+DJs/iVq3DPvfj7OTPxd/DAWvmhLdOGw9egwRX6L+4/ef2tSQXX4fgs+Gv5+vXLsaziCC6FN45mA7Mbz4blp06R" +
+	"n7uxbcJL+IOhfkwcztwzEF91yo4g4WHkT2NEBmNcNLjLjgw12fMgIbJDxvw5z/LqK0+BErfGSvgoc+OTj7L4MjwDb7OL4qBq" +
+	"H/4QVP72q4z0ESYYtFOOMbAR7sIOu29G9ddcK2EdjVFTeSGROfsJB2lLsqkiGgiO2ICXhvZ98RmIxbE4CYbADSwt2ZAGXzJP" +
+	"QkASHoCvAMdhCfzFRsGDv+4eZsBBFO/DERIChO10z4qU1SIHrziwLAWRY0QyCC1s+ElI2nEn/vKhuyAQP/MDPHdk4sTN8DJz" +
+	"Gk/Hb8AGMDHox232ZObT+3/ECL+GZ4AV1sBBJunee+3nRDp/RfVFjENfWLUSKgMsRronMjNephU4BydhC1YGQkLwiweg/OEO" +
+	"Oxj8MLQDaPfuBXgZR8Pv739kx3lPMiA5NHcj+G23T31ldDd8wdFHsPCsyP9ajUwrNWWEA69SSvUyzmjRm/VcMI7DrcBw8BT+" +
+	"9VX7U9CWYfDL7FPwFUZML7wDgthTUXM349EMcMw9SwZcXCPyKEJCibCdep9S0CKZOdMYJxFxFe0okcotWmW8JJ4gTC6U4F/x" +
+	"Zmj/4FbAH+19EHrjLfhH/DvzvH3WgxA4YvoT8D+GeRRPwL/AUkg4dQaewCVweO+Z1/AlPAtC4P7JcC8wv1+HF0v2PWi/5sg7" +
+	"xLWq0bdkD7mUylADud9WoVJyrSgDxBx74X9sCpi7fQk/2P9iyrgeVd3xH2cWb/v12E38513nr4nnEHh/YTuSUqksDwaJTAi1" +
+	"ZoSaSShIzkq4UIlMRSLUZpklVG9k1Jb78hh45IHND1YsTYEQUHzGMPgKfgqfxl/DPsgPY9Ywlzu++Ch+fz0+w4B1FSTufDPP" +
+	"ruXkxWPwgifwW2/iKPjiLGQehLuGFYvzPq/6IhcpbKf8EKrVkGyoClOGmcxuklfAQBdQKfdxEYDbYAUbD5npZ/ZBh6GAbzAD" +
+	"nnl2adHApTAH7PZvwAYJ+PFPTrO7J8/G+2HE6OubN+OpCDnlsYTK49ZOeUz6AQFpdXHxZi3xD4i3SFWRmWi7GJAopXpOZnay" +
+	"v0qiYk49smX3A6yVwe/hX/u8hk9Bxtreucu3G9dkr9zSrjg4CHAXnAGn3pwwZRvshXMvcFdeOIF3nj2L+5zD7/74SLtBO6a9" +
+	"9EPe5es9LDPzx9nPMAyTuZiJWjAbd/zuwHDksKeChbXUp5dq44mODCXcyxhF5chnqM1aLhhrcFIUWJOZ/W9sHzGEjcVfhcGs" +
+	"RctACZ9iP4Cqv6oRtkEg2GACw467+RPMYY5U0fYRCCnCdpSMkNViRU7oMlRqpYoK2Zrpr1aSZKucXJsCRkHyZq+Z+HV48emp" +
+	"k567+AW57+/J9+7fgX/YugUArOMLYV07fA+zbdoT737ezQJh8EUxw+3b9/tfA7/EC2HeF8/t+BBnwesf7DqHF+AfVSGVGKeP" +
+	"4WAVcsIcJKxH0Y75KteS+6XMMsHIERtIK0SDQp1hlWm5IKzDrQKhlQwOvlE6BrfuDX0X3b8qFxTwAQ6Ct0CJr3x+GTaaGQbe" +
+	"0j96yb7YcAn6MSaR1zlO2I786DoM1R2MxiGuQ7UqtZnOYa05U2fWUGozV+/OeYqBOaCgYhs+Tnmge2/8y08QnzdwCwxkR7fE" +
+	"F8bh94APtf/GRCxjgiH2z/YdJk/ucH3pUvwQhWsmQlyisJ3CRSy5aLCoNaxDmWVKDER0MFqNXMKUtGCGDh07Bl5jZuJndhzt" +
+	"PBtfZGA4LIUg7qdl2SPfTQKs7v8Xvh/Wb3/kfYAC21buDJzD/xP3A5ZVf80tF9ZRS0MlUcmkSKJBchkj1zJKmRBKoiAGh5o2" +
+	"WLUKFVfOHP4E/2bDv+PLDJMFOV3YGXePnMsMuTH/yeMA2he4r7cunVyN8E/4cQaWQyYshhjohadcwPP34C8/efD53+Gboate" +
+	"Hb3AObcucUHCWuSPlE7cEh3Fi7pQa1HLtMxfXRbv2/c2KLARG+CTLnNAwa5Mwcd64+OQo7Z/y3Roz3SAeLgp6ryLXIKLVlIN" +
+	"ayZ6T1RDWqWDTASbVo1cSTqBu2EOPJWzAv8MA7cMzIP4n/AvvXs8mAIfA1bi1vAZ/gNM40DTEs7ByKVLrxMytf8jFuzXltm/" +
+	"YQKc+jxVWIcE2qciG4y6OK2OLJnLHWqKsCRLg/Ymo1Ztgk1l97UcO5i570D86qH5xCLWgjUdDrzQYTEeAdvYWEiLOsc9NuH8" +
+	"+a/wMszBXy/8/Nll2AEdoefWrU7dLspyEW+ZJPlLjW7XyiwmTsYGnEpZefoIxBInCmJXJcGXzIdfAZhxOf4NoLP9VSadm4Nb" +
+	"aMB+ivJCcvVF9qpDnkr0ErpYJdNYzIZUkOutvNVilBCBqgoB9pfJ+KtuEILfx3eAaj48u+Yl/PQ0KMpO63n01fs6jc3qzL71" +
+	"+fYdM8+98Qf4tR+6VjsYZh3+8tisiWzqzT53frLeabd9zQU7daJD1xIpKiO/qE43Z5Lt3BqZQqJSKyQql+o9nnv2VG7eu2cT" +
+	"8dXHHmMslXczk+bDk9jMFOADn6wAvlsBgKRi7q/4nsUj+lROGj7ZkXfvK8obBoR4p/ZzdGQlXE471JmptCZmhFLKdMcGiHn+" +
+	"aNLTu40c3+rIU5FfMjy7dsuSe2PhTy6z6gyuOrcLgO3Mtge/uZ2u42eWDxsxdX7PKYdxlpO/x3EhLltPobaYqXUEGVZDuKiE" +
+	"pazKJCP7BWDh4WPPPIU/OZz4OXwEn34zaNWN7y/8yQz8YMyYD5iZn39etUdzE/JgJVNofxbPwaeqURi+dvq0E5dfcUHCLpcc" +
+	"NNMrKRw2drycV2pleilDTKMgrMUpoH/q6RXnc0Gixg/hD9mnZs+4AnuxFb59yf7srtMf/wGwXXoBzobhG/jeoQvx764+Ihx+" +
+	"WE0fZinBXC5YBR0n0ypMGVaTjJGouBAch1uC8eCzjz1WNmJWSP+BuCUYDq0de83v4lfwMdmAterTo0dhHKoOWL8siYHnjj1T" +
+	"jfCBg7sQQiydU0uF7fQuKCKbyO1bROdKs4EgEOjczbQaiCMkU4qpCplfXz5w9CRec+Dlk0eZdWRaPfssfAqfzJgxg3mS+eWn" +
+	"Z9hEjKHvo7bYAtwfPv997iwIhh/w2MH42qv4jxpdfx/V9a3E+IFaplBTeWQwKqVac4ZZZtHFyZVSk1LDmi2hVpXaYuJClfy0" +
+	"owfhwFHQ4bihnezXv4Onn2L+sF8E+Bh/fMe0t8FwmHsQ1OzXeNkPAPm8/f3g+HDc79en2dzL+Puf8Y0/Ge7no9AL4OAhwPuP" +
+	"IvHOgxThB5pPPoRKFkdGeXr3CBFqAi+mvSbZqVmtnN500APPD89Xrhi1CwRYGNQubNbMe5nCaPukPvjj3peqThp6aJh7O4A6" +
+	"H/jvTiWy50p247H7X9xfPAb27u9kf+NCAuaYRS9BBhN4sw2TYB83Cb8t4mU4tvKHhVOoBWoj4kUSDJJQk0WukHAyho9nM60k" +
+	"7KuU8lTp6+IkRouV3EVmMFtJOvEMs4HGdLiNa5PyFXKALQtaBENLOZ6Ch4bGVRQmpwIPVTw+x6+ZsXz0C11m4yqsSASZDtYs" +
+	"Y/DvEAUQBNcgKF2qHdcOv+fP3tUmPx/3xgqlEn7vNkGaE3ZHGX5gyWn84ahNJ06NMH8OpTB0QPvSfYc+/2A0CMAdxzcoHNUX" +
+	"2X2CGrFIKe6YdnoSoWTy0yG+yRTYTzJf44F4UAxExMCqpfgY6AAKBPVHtjuxES5/8mU1+usY9AHmBH5S3BMstptG14zD6ekK" +
+	"esqvdutvXb8OJdeuwUYf7afh3yAA/2Z7wFcnnKOPnx1n86JrzhJKasFh1qoQE37tGn7q+nWmTnh+FvuzfGT7zr3HsyvxkzW4" +
+	"WiDiCrTgAxrGCon462+Z7j5aX4CP2+6qDYkDVxLOiSswsXVBkPKX/YONTL79FPNj7Q4kHD5u/Mhmd+/i1YUUV86xT0R+dJWa" +
+	"0bAmsgxbGwA2VGV/W/WYcvpMOeT7AILkNyhbu7aqu0+6k3mhZ7dQfop04yfqiahEUS+nYSnZJ5QKL+IpuL8W1BGr5swZacTf" +
+	"aqES1gnqj24+jDvAcwD47BNwgO2xfhH+4hvYisvc+hgg9uGig48+mBJIxF9dZgw+exmAj998q85uECv2I9FQutDTsm508dXb" +
+	"UJDbf7mXwvW4rx4lGnzc8FEVU3efTtgmOnqkdMqw1tUjm0OI9ajqBznwPkF0UOuvqXV0yaDhCLEnKb0UTnqx2lCZVCOTyrUG" +
+	"xizV/EH57bUR+NNx+Oux+NP0dLZMUH9kD8bV+CjkQjB0wE/jX/Hp3WBYzMS72hwgtinSx6tNZijE4a+/YKyerQ7Ax+1t6mjW" +
+	"1S7BTZg7brzaZnMdSAmFBM/mnbx7oa4u6Dyp5ldTfNDbPpRaCCXtMy5PjroCMocfC68SarOvwFG8/zuYuAD/snASXMYHDsGa" +
+	"/WUVu2EVQVQs/hS/BnkQC9aN+D1I3YjfwFfxITBDK0Y1d65TXpJ+RXkZXyPJ6u2dNVy7hg9fv84+VXf/DhFqz2pwEJw4Bonc" +
+	"IU8TvORpY0YChFfYI3UORyIXhzP1I/vDDQ3ISYsBTlpAA9gopTzFhtSNDMJfXRrbL5GVendZWV/nnJGKTdX0GXImoO4BODnw" +
+	"WgODoHKAYykfxjv4UBTVojdPZACrYzMTwShXO2I60IJO1L/wONw5HKLU8MCivK7+F/3bZd0Ts7kwp0PxQUgkknUb7gjPY3x2" +
+	"z352W2F3vBamFWaDbNiYMaIuov2KfOjGAQ33jhx6/Gi9/TvY8eaxegYh8iIZh8Tg4MWkmpHwjcJFqDgafIji5Hp9Y5IYnDxZ" +
+	"FVDfqJw0GeCkCTSMFWY8qPCVL5hp9SKFKKI360WIs2/Cky1r6++6B8AOq1HnHesdhFNVTGkMDl5ENM8YyHXkHvGG0TDP/uqK" +
+	"u1+1MYn1DuFF3OOdd+DgX1EN4ILmtxAUjrXGmp3mSq14MsSRP0ImtZKcVBaTa5s5tLX/UNp69APA083mVWSj+YsTU/ccqdle" +
+	"zmz6TKfT93LdkquA3C0j17ScSM7t6xDivxHEdTCluAFFK+7Cdj7VbE+RmcgbE5txuCgqAqaUl7/4++GiyEiQ45/Jrnv8/Pbt" +
+	"5eUvCoofI6OKSkqe6def/lGNbNvZCvIPECkUbQIDQtwJQYFYah2SfafkpH40eHRmMbEzoT1+ruBwUSZzCD+H78XPbdt27AVB" +
+	"QZLd/GgtKqlya5reSatztKt03qFOGnUdzSCnNNsyC/BzvxdZbDYy4GOC4q+f+JZW5zjFhhjSjmSioECtaL7pejCj80QO2UTr" +
+	"gaDCiEjmMH4Ob2TccMT+RMDyxpQTmBpcifc+kLFMFhR0lriPxFSzach5YMUnlZiF+Lk/iiIj7XbfZCII8E0pkT92Cwqaycd5" +
+	"672ji2yguSNIpwq14wZ+8fp9esnHtpiiqAhHZ4+/WFYOXfGRqKjej+KfTnXvsX17ZCTttKqXs7f+/Z4poUd0I6OqUf8B9Mhu" +
+	"SVFUpBj7GFJ9UZLM/4L60p19jnO5dXbuOKHrdmw3Fpw7v1znn+mx41QQ51MGs56fPvWdw3NmQjcyzl6P0XHu2GGMzzUnt9SR" +
+	"LWFJyXHa/q3tPch2sPHDZ1WMHDVmcIU2anTWh09+djGLmxEZtXzIoCHld3f1BiMvzRDf0tA6TU/2ioVHmBPV6gD/hC5ko5hM" +
+	"qgdpZkREm6y0MFWwX8zORw/vQwjQ3dUX/csFBYWXgEayZNE7mpUKk5Y8mrQynYHApVUqyKkBtbidTea8PZ9iQ6F0e9ISPDir" +
+	"ZbKFeP+BwYNZdujorampM2dCQHinhPQDsOR9C8cExzGlEBiiaNdqxHSy47vTI8MOlJV/Rf84OLAMijhJiKJdy5F3sBWDBz3x" +
+	"aK9+uLp3Revtw+bgakjSRneeUoZ/Tlk+K1JnEE9O7hw2aNbMS7BPHW47S/6e/RVEzXuwVVtaAVUrHXd1V1/0HywoUBGBmcKm" +
+	"UrqyR8m84MxMBYNjClAWUItHyXNA6/qEH4WvWDg2qGUrOvD9z0AREySOO7wDAZb1Y47Yu/K8lADapo0T0md4OSTFRfUvf5WO" +
+	"9spFZp87QKh6nXULgZXQ+cZqS1aMzlDa78FN9jRa2QELLqX0y2sSLI40AFqz+2brhsGQdHpk+IGj+IRzI/bzjQPAmTpgMmza" +
+	"KG7UtqUixLjG3g51cO6s88lDOgdfmrTeALkzIZgp03JLIdh+sNMjww4NcOOfZ50sCFWMvjbH2T9/duZMtqLq92rEdB02aNbc" +
+	"L934Blo6eK9rXewG6KneFS56BDwnKFBpk+hBgMsg+XHUHqCJJ7FzXEkcJI0kk2xor+H7j/ICKc2mR4/z0nsSfmND5e1atW2T" +
+	"1XjKDbSkXrlY6kQFBXpGJCla2DFdozP26/+ALRVVV6M5qAAe5SpCDXwaQlIJr8Q7YDsC6M4r2CeFHHp20RQDGRarKRg0Buix" +
+	"sMWA+we0WMhvxd9tFXKKO3cuXr/Jkb9yDipgN9G2imhbYdXTSVuoGCEun6ug6+lyi9VizSRoUgTzOq3MxMLBLQsTYwBaBemy" +
+	"By88MBlP/GArV2E7rF4/7Ct1a1Di56ceeGL+hEI9BLJ9KK22ICQkcBUoUsw5L576yMxlrKAl+dZp2wz3DgzE99g/aN0bf5je" +
+	"t9yC98BEpqW1LySk9x1MM3d1iX5gMv4IDL3vn9orFibbj0Y/MBkS8E/9t84Q++mOEN+eqxAzz1DCavmiXfgD28fcb+w021p2" +
+	"2hVqqzyOEP8QPQOjFucD2UOdmcLptGZtRgwHX+MPuIv4Q79IY6fy9vaxzANzj6zsZ1Ux79tTmM79cBD82mdSeYYu8EpEXvm6" +
+	"g8jVd6EIo1ybEcMqglnaXC6bmcLqtN2ZB1d9sKRUoyldcn6VfTSzqfOkgpbBwS0LJnW2j+Yq8sfet+ngpvvG5v+stfYtm1nW" +
+	"16p1tsn/gPwQ0orWiRIYAX60DYZW7Bt2GzuNqcQzn7aVusHk58j8qc2IYZRA4WJ0/ENVgczlza/NNdkszI5QS59OXEXbdR89" +
+	"BmX2edBy0sKeUR4wBIlYyTSICGH4AvzBgBX2cOabTa/NzWDU9u8Z9Zl1V9quc+zlKa3+g34X4/guhdFpZVQ1m8WsATrSDMsX" +
+	"4A9b6Dq0xq88+FBJWVF4cqs1zNVJu8bmhzMD7Y8xZR369zLgNl9+oPo0ZNBMePVKSu+7xHXV00wgX8xzzpM95NgL375qPnzw" +
+	"AXzABP7EBNpvOHM+8qP5A1IjH1cdilCowLdzK39dauQj0OJa5aR+BBNlC6njO4/3N39ye78Z7+R2kRyTfBgtNSLHvmZc6CgP" +
+	"9yp31ld6lHdHiFNyFVIjH40xfSPmqepefYVlHeU9aXlLZ332Z0f547RczIP8OEJcF9p+NC1NdLTfAyFmvqO+ns70JLSv5h2b" +
+	"6vEuGW1xvHus+htuATfR1V6q8xvowex2fbNdKuHTgHXCghXsYse79+lX6a4xH/Aoz3CVn+EP0fJ5tNzkwsk6bhLpO5GUmmtw" +
+	"xWzxwInVbaw1sLd21H8cKzizWK4i5W2c5Qhx/bgKV/vZjvK2CHFSj/ZzXO28yA0h9T8gpbkI1QFXXg3v0PIIBtmCXDwTWv0H" +
+	"V+Q2zvya8cD7Iu9WTaNvkmp4g5Zrqhe48wbp11Hexw2fFC7+gNTAa5ALLnFuc085zijITEBzSUp50AJ1fKR6o04GGyKhuBsU" +
+	"4D8hCz+Bl3bEzwPb61n+PF6FW+ag6ocO437tcDYTn3fzR2Bgd7uZzAza5wwcKOxktkoN/DqabzyKNVQLYrnkMLNVahRLpII6" +
+	"RszbjAOZCKY/KYc0Wh7rLGdZj3KNz/YjmeNe7dMSj/aTaTuR6GWf7TvLfbcfXav96Draj66j/WjP9qt/E3Zyd7vjh70ulksO" +
+	"c3eL+GGv17SPEBPBx4h44O6uaR8hlvUo991+NHPcq31a4tF+Mm0nGr3ss31nuXv7bznb57vCDvsnrvbfkhrFEo/2R/FDSXn1" +
+	"E3iKV/vu5c7235G8JXSWGvjn6Pgj+HLuOC1/xe8xobPUKJZIBbXW2Q6nF8thHy2Pc5bz+Y7yi7Rc57P9aL6cP+LRPi3x0X40" +
+	"7OOP+Gg/Gi7yR1ztr8GBfCo7Smrgyxz0bYtOOsoLSbniQbGcOYpWi3zCLWdzCB2ZFMInik1O/uELPco3+2w/nDmLMny0H85U" +
+	"VH9L2qmWOtoPh5eqpTXtV0v5Qo9y3+1HMmfRake5e/uRjBW19Rh/JLzkc/zOct/tRzNn0aM+2o9mOqGxHu1H19F+tEf7U/A5" +
+	"NpXcs8MPEvEAhficWM5lk3LFds/y6q/YVO6gW301FyGWc9ncQbf6rnI2ld/rqh/hXp/f66of4VH/D2d9vms1rhZc9f9w1neW" +
+	"Vz+FA9k93E9SAz9EbIeJYsvFcm4N95PUKJZIBcUuhBz1T/Kpzvp8V2BxrrM+n0rnI4tznfXRjOqDkgqS/5I/7dhTc1AyTVCg" +
+	"DHJeVyLoNMZ41mDV1uRAI5EUMSpjBRkv0IgLibfQgIUQt2fFq7/jn+/CHwG0gYKUvZ3u2/lgeVhwMf4t2V/bn+1rxzlKIQpm" +
+	"a5JiM9osXNVlUHh8VB6krCwfPHB4m3CdMic+PmoEzMvrrtMu0oClGr0WlhIQHtXSfjYtPqIaueZgGhmz4j3XcwF9fr8GJkkH" +
+	"qYHAJJWo27I1sE6TdJAaxRKpoIivaY/UV7edjsRWa9p1K39fLEcz7Ltp+2Qu2vFOqcC/4Son7RsUCY45bcc7STv2T8T2FS3F" +
+	"crgPn3OU0/YVrdzLXeM3qsPZzcKumvarD3q0T9+6j9/RfjgqcpW7t+8oR1Pxfn4rc0Uq8OcozafiQGEN0xUZEHJS0mAkqeOJ" +
+	"mR4vy/Qmv1bFV8T7zxySmPWAsc+09x6e/+QK/Eo8FBqOphebrC9kxJRBQQQTNDRgO66G7GGPfJYclzQcxq8+uH3p4c5KWeuH" +
+	"h8za9UWyiEs6Fl5F+TIe68Ux0fJAYQ0XJTUoMh18HG9PbIb+WFT9vNCLC5IK/EfiPRA4UBjD3EBalEBP3ZC9aFqZVqWUauMY" +
+	"Y5ojY73Wkf7PnInkClUGOTeaC+bMOEHJcbBrGmTgJzfi8peuK4a0nvlkMBQEpmiDFCDNKNv0YJu4gZu39GduVKOJy6vRwqnY" +
+	"DuodeuulLua4yN4R8qyXIc3OTJ07YpXkasSiNukLZzp4rfp5bh0Zp+Irpzzj/sfckAqKrx1wvM5/IXxA53sGXywV+AnOcuE1" +
+	"4QOpQXHWIR8y+GIXT0yQGhTi/TIRqIj/zMUTpPx9j3IiN67yGVIDP5XiuxJlYL1Yzt1PyhWXvMvZq9w0qZFfiEYReUK+c9Z3" +
+	"L1dccpazV/lCV/sb0HCc6mq/0NW+Wzl7lTvorK+OgPtYjav9g876znI0wx4qtGYPSw38NMfcO4kDabmfpIzpTefqScxIBcU3" +
+	"HnamQR0r1idWpZudZlBrasoRiHYX05/6v+SOA60ja7OWCc9pcwc+1T8nIYmcl396aDmgpPRxG8RvSFtIgcjGcqPgyDuWA1qR" +
+	"u1jm62HF7Yd/LX6blJDTf+JE8eMN49KTHLKmOp7CJfDTxGdsp/DUwLHPYW+Go5fxPqmg6OYsZ1mP8hKEENiq+K8RkgQgP4Rk" +
+	"Gk6iy9RbTPgafiBwIeT+8JkwrVOHE3kpCBDiv2Z5Rz1zhppT6gzxn/0AuQsD8QPCtE0peSc6dEII7COFIIQkRiRHSJYq5riQ" +
+	"CBKd854HblGfIKO2W1o79klu5MiDI1qHjxHyQBfBtohPa7m8c6dgIUb0QZAQxDzkaCfWlWTBJDiukxDb6WjOZ2ra+U1sJynZ" +
+	"1U51NcrkjjMFwmaphJ9ePalGjjN3C5ulRrFMKvBja+pKgqQSfoZYFwEaTO7fgPEinR1ajWQk0cKOJH154YxfRo+BQuiTEKao" +
+	"Rl27Hoqj7WAVU1D9u1TCz3T2KbbNvy6V8LM8x0FjDbO8xvGsYxyVnnUlQVIjX+lZF3/n6Gt2TV38HXM3IKmRn+1RV8q9xYwU" +
+	"bkgl/BwvXNyQGvk5HnULuK+YNIkglfBzvcYgSI38XI+6y1ACa2CvSQW+O633GfG7JbukBr4vncPzqifDS47y1yS7pEaxRCoo" +
+	"ltDvVyEEn0uuu+rfWT0ZBovlzGukXLHEvdwhC96XGvk7fcgat3JR1qDVOAA+FxSu9udXT3aWM68JClf7znI6x7j3nbKDvxPF" +
+	"4VznXGM/ou1/YC+qmXPUB+NIuahrnHOO6iD38hLnWXY6HoHv6zzLTsZBc7V4rGk9JEoBfOqONjlwB7ej6qlx6UnVqNxxH84M" +
+	"/K6HLxrGtsLviuUOX5SWuPmiWx2+dBiY8VY3X3Qry3qUi7qyf/Vp9hIXITXwUxw2bjC7pLoazUKIm8JFUN9gDLtEKigu1Ph+" +
+	"bC/XeMKZ48wJp+/H9pIaxRJP35L9TpRJbC9P3e1R7u5bdnW1H+HePteV6sJa7XNfk/La7XuUa3z6HmFsO6Tz4XuEMcerT4l4" +
+	"c/geYUwG3urme2zlCz3KPX0bgbSPwNkuzaNRI1vE2xGogaWxygRtvOPOHAM5A2AxaXiZQWfgCvNbd8nutnZiO0uydFiXZwb1" +
+	"PA8hx+/FZ/GsI2vmlx/usmx26W9PQQ7wgYHBTEHbdbOq0X39543vqCt85PXZ48o34Z+Xwaubv3jqiTtLWIW8JaA8QzUCQEjU" +
+	"UwQuGqMlwzFIZBYrTYUs0EQmTsVltbCBhaP6rGr3UH7Rx/uqPuo1csbs0af277tj4PF5C4f1gz/DQwKDhBaAJKDL6FiNNAVd" +
+	"y7vr4wDFxbfPEfsheKL7cjzbtXp2K1G5sMMu6Txg7hMHnnD00de9/1YKhbkku9szQ1MhKLttb62jpzSvYYSmhxdVoxlZNTTh" +
+	"dtTQnMngApw053aINOQCpILiQZcPsJzLkRoUm5z12cfFcr6Qlm92Lxf9O8JjxL9DIPp1bC+6a9UFlSARjDIVAdwoXtTE5oRl" +
+	"JeQXzdiVPuXLJ5euHbZVHZbfejV0nRHREj6ZnJdUjcaOjQ2zouqcqORe/VbN3rzL1EuUCaK/usPlf4bBfAqP6K9SeEiJVFBs" +
+	"d8pMdg9jkArEP0Qg+oWMgYxPT2milWncBuYYMbtOEXfH6+/ikaBavbJjPkAKFLTwi80kYy6J6Jn1/LCRgIoKJ458ZsPsOM2d" +
+	"2UnVrr7Yx12+axizmpU5fVH2cYrr1aysxhfFgf79qF8yVPRFcWCLdswVVIyQnqTo01InxOwSmSIm6RwSf3v6LGqnFWExZqaC" +
+	"ljzy8wvkcVAg07SdQkXtgOsdkrt3sI7vlLnMP/bO/LxuOR0m/K902VOD8Z9DoGAYwKK4tOHFPXRmCMmCAk1IuPom23rYa29n" +
+	"DHkHDPhjKp0zrcGB0R2HLo0PHfjMzKPtIuJHQt+Xj7yz9+GWAW0Ki8ZMn9Puhy2d22YR/q++KNzJXEHJBNcO3k8VjUG1F3wS" +
+	"D8BgSkH3a/1zEpIBoKBlWJd2ShcQvfIXtB0lDr9du8slJYCSMsZu2BI/LBvA0ue8Y6R7X5s6IMwxSjFvzpXqi5JRzBWUiRDv" +
+	"jsIGxuXCOz/OrdsudQ4v2SjiWObovKKOATpRWcMH3EEa24tjNSI/iOUt2rmXK3Y7fKeLwp1iOfMxLd/jLJeM8ijf62o/krks" +
+	"Ffjh4tkrHOh/mbmMsgin0Vz1dTOSOCsc5q3SnQ+1rDO9PclDxa+z5oSH1sVNKdCKCQjSDH5y45rHxp9tl2BoW7K3u1x1D17d" +
+	"Nmft1HHmtKTCEW1jAmN8MtTAIzEJyclfzh1rGv5iRpauGlVKg8oAZaRaoyJVTPcMAhORT8JQ5jKKETOveVDVNXpy+0yc0aB3" +
+	"HzhsHjrQRcycwfs2rnl8/NnHtuD7/yovLYLDuWumjjOnJhUNz5k2zUHKkWZxLP+rKIsJe9N9GOI4JInMZWQknKYX+zPWMRwX" +
+	"Mj0GxD93P15POk/yMbC8lobsbnu7q+1P1AysrCwmrK334EREyR9yDc/JB6Ic4j5gZSI/OMovu5crDjp1gTCU/VjUEWyhVFAc" +
+	"cpZLEj3KD4u+OUJCNbX7RlI+W4SQ5HVBgSKJBtQLLRm63aIB8IX+9+P19qN4LpG5d651Z5gg+84abikrA8QrqkqfHFkDbsBG" +
+	"N2qIPNFVUKBAujffvRN5S/Aci0RgR9S03cJ+FG8CKRTcuebe1ZQd3NgNb3Pv+H8VZS66q5ywtoQ6IPWeNdDXox8nnFH34/Vu" +
+	"E8MHoKEeE8AN9wZ+pKh7WMwWVlc7aUBtZUxpdcxF266O8i60/FkXbVUe5cdJOdpB7YhWUoEfjRACNBYHcpgNQSkIEXFpjfcw" +
+	"5Ry/6Y1XFLtOC0djFGBfec/zIDtGjLnSe9Z275xqjIgMDBnUAyb2HBQSEBndVW+ZvbR0HB76yl0lA0oAHiS23I7Nx1ZOnxIb" +
+	"26pVq7hEa2Zycqa1ZXyrVqn5OmVKNTo/wGnXZXCtaJ4OamDSKyx4Tpx9BnFDj15mYComWHoV7nxvL76Cj56eM3zrjLI4c4sW" +
+	"R6dB5Xbm13alkxJyFGnDqtHgIXcAatmn3dCVa9cNDKhGvKMPHUvP9LmkTD3wxjF+X707rnSIOcTYNToyIGRQT5jYY1BIYGRM" +
+	"V2OIeUjpuHcfeQRQSlBifmqrVvEt3QFLDEqpRjW4561SAz9apC3s43ZUV4s04EQ7XGAflwqKF1w2XAZvlRrFmlJB8aKrXCfW" +
+	"h1do/Zdq1oR43rUmFMa/zU12rgnxvNQolniuCYnlTBQ32XNNyFHeh5vsWhOqwB35azzhzfWOOO4Sbj4tDxTWs+/SuO8SNksq" +
+	"qOPd2ic8GA77uPlSQa13a18sv0jLDW6xQoUYI0SMI0aoQFq6205pEL0NtUopSJwbMcQpKHIEsc2N8cZ4s1fg181VZV/JTOsY" +
+	"F6soh+6dK9tm5LTsGm0pbhMeDUxhZ/h6+Nz5+/GlcvwXiYxuME8ZM3BiSoHTtS3k1t8BkoDYRJVC0PSd1MoYMNs/OKKlWi5t" +
+	"mZrSw5hYtaeDXA5MTP/TJGKakq3UGouGPskecvjCTr5OExQoVpQsGT7XJOSU7+AFnAIjRoeEdMC/JfnFltEFCBUfdXZ12RBA" +
+	"xprFhoVa12JDZCv8xvsDd3fs4uyrQFCI/K3T1tWby7Znfh1yzneXMFuTqEnPXrSyS5e6enZb5qB0xF/wmykdxVx0i/AXwkJB" +
+	"gWLIWHhOL67MiEaa1RIOTvro4vjykfhX/Ar+BN8EyIKCPJ7bMGP+emsHKEgy5vb5Ilt6rtfQvl1Bu7J81MJpcZHJmV2HAuLG" +
+	"Vd3fjSLZEe8UFF7xTrOsrngn1w05acOyggIpENI5P3EzG3WhNcFOGvx0fOyg7sSJBO75OJB7gcS++UmUf+fjQP4YMw/FiTYE" +
+	"MSHEzcmEBEbnvT013pXRwcwSp1+dwY2ZcLiqkyJOik9OWNYLNhSvXdtxXlJiZOTkwhnbtfLfYhQBUS3io0Mi/NUWnb5vYWiR" +
+	"JgsvgXlftu8bqL53SV583rixurhqlC17Vh3VUh2o8GMYeWxmVEQw1XXVZ9krzDwkQ0kkY1FN50TumTL0rktvXbfjOiUlsztG" +
+	"yce1iI8KieLYIItZh6dohhavW9Nxt1LSf8Lhc1PChDaDNoxtndHyIulXiCD9amOiFAlHKvrnxfd91Jy2tn3fwuCQNECGWMdY" +
+	"uG+YeUjrukOlpjerpV5UiaNl7/foV0nGc0/h/FYEWUUzdmjlN7yGrHf1n0fGVDTSHVNuI3Zb76E+4TmHT1jBypzrPayMysoK" +
+	"Zq5UUGQ6ZRwTwX4sNSi6ifXBzBaK5SxLy0vcy8UYFvOFVCAxLMSIsSvmijNPrDPYYsqQG8xuDi4pNzpuK2ZmB0k4rq8hIacf" +
+	"cFDAxIbqc/2L3uyd1zZk2LmMzKMvVUBOgilS3UKjs6TBDq3y9bW9IpKqUVysLh9C0o6fXFmEEEKsc00HBaFEmmvY4tyuTrpl" +
+	"DUYZ58YUgksrmzNFGSxxyWW4MiAoRNcyXAoQHDq7xAwr7ReyZX0mvf/mksHfzK3o0CHdXzOhJZOqiDFYIuSTZBItC53bJhti" +
+	"tckxMTp5JDwQygPKev/405lZ2/tNHR0PKCBX1bH9lGEG/GJuRk5rvjBGG58jQaxz3QmFo3iUihDZ4mmy0EHSVTKFhKLJqJNp" +
+	"xcERcGSif0iA28mtnnP6+4klG6E3fnJjycTvT89ZzR2B32QFXfsOzE9IT0/IH9i3awGgl3euhb7txlQurEYLK8e06wtrd74M" +
+	"qKhbZnpWB7xyZoes9MxuRc7YL3OvGPNFjBjzZe5FWnrTTJzRgS/yH2Vsk0znCGNQoSh3EwPZkMksjhrwRp/+kvC7hq38rG+P" +
+	"NJnksaN+WX1f+RwHfLh5a0mZlU+M0BkiIjWa2EkM37PPksykHa1bAbSxbs7qWY3KRj7QuTcgv8goSzu4t6UxDCA6JipZLjfW" +
+	"XqtB4FyrQSbqY3PGeKPBzZs0G2oGb8pw8aHzEINSwXV+Yhd+pyv+iy6l53HstJQNu2fcBer0FlFJBcntl307qFtahHXz8J45" +
+	"UkmCKfuTlhAz9q9VZSMXFbWS6RLiImT6AH2fYamd1Lpjm6vRkY2szJAUFln5lHNfk/t6WTjTSVwvw4GO9TJa0rx1purq6rXV" +
+	"X3M7JME09h8HSVJBcc1Zzq/0KL/uuKuG1F9LY+dx6KazPinnV3qUi/W74ABuB43lX3PE8ic5y/mVxF4XS8T6CFAX0r6gEPOA" +
+	"UQYhhg9R4VoQjRtu+52Zha/ghcuGDFpRUYxzoT0sjAjVQgFbgN/7sCz/ZKsbeoAkbrYtP9G64hiz39ku6Q9FU+/Wu0232D57" +
+	"93G8qVbbJAaFT45Kj7trh3fzDpWY1wohxKJkfEnyoWQMYpGWSDJ/zpxpyaOL0jXXq5EzLyEQDNHEseQY9rwt0ZbIzTLktOlT" +
+	"/M1nvY9MLN/TqVtpqqlNRTa0l4ypOll1kivA7ycnd8nNGvDQsFOvJbTCrxYUdi9OKc9sM2xBr/R78RYY4cqjwR+m95K0QMgf" +
+	"JKyWNQKrZdlNTPifm+xvbvqTCY+GioP8+Zt3zYANePIM/k7bEXibjP1I9c9cGP8d3X8chxDIrOK4WecfJGmdWkzRIt4WCC91" +
+	"WT5j5mOJXVZMmvoYfq38EPRdNevBB+bei4/sHMWMxZsZbkyH9hOY8fghhptj7V8KIN+2/MD2FcuOPLZoq1zMI8We439FPEK8" +
+	"P6j9gVXYi5njpzAPVdGsH+t3zn7GfkaE7QhCXADN8aumGU05tUqukkoETkt3uFukxhQwHlkOUx7eGtHCkIWP4/PLlxQWqcP9" +
+	"ghc8xp+HZcvwT2Pwi3e/dwQyAvWGPoOAHbBxQjDljyMIsQv468SO0iu1Mp7VK8V/8BK+zryVhCfD8+KPvXv56wvxRx1f7uH4" +
+	"4fG9I+9x7W+ZbfZhNR/Sb5YjxIbw5+l+fxm9tJRm9WdDnn/eXvn88/x5fM52Fz4HSeydkETogxDXmj/vOKtKcxWTvsi28iPs" +
+	"Ets8ZiZ+EMaQfyRDMjsLMvA7+DuSSYRHy6sv8jf5X1EIUqBwFINo9mWB08QbGGmoJV7DqUIhF6xaVqaNAZYcLtHLtCnA35z0" +
+	"HLbjy9j+3KRJzwELkcA+N8n+8th7NPiyDX+juecwRIy5B36HSM09TAm+cHbu3LOggTagIX/hC/Yt+p3T8SuQPX0nkwLZ+p2v" +
+	"4Vem70ROnMFLFB7qHxBsQTC9TwrQkeqL3Bj+V9RCfCcjrCEBsQr8fNj+PmM+jAP58zgLLl+wf2o/fwF+dLTJ+fHnkUC4X02O" +
+	"EMBL8OCT9rL9EAxfwZ3f2O/mz4v1/K7wv6JAhOQmf1btz8q0R/bDBTgAD8PDp+w78VA8FE/B1/nzOA++xCZ4+5x9CLyNTTDT" +
+	"FugaP3+I/xX50TbICJ1tkO9d35LvHN/w9Jtf+fNu93y76Ej+kzt+H+Hzbr7IRoPC9jnIbeIvQlRuD36HkBUy7E87LiU3Ubnj" +
+	"pK8EqZERIZShUghxhkxkyUxh4oIZRQyTYYn3IjjrP7Fnz4k94Jeioqnbn9w+rbBw2vYnt8NpXyRnFw2YM2DAHHzo8AuH53ft" +
+	"Ol/89Wttgjto8AH/HZKJtpRBF0dOclhM5Kgsz/z1btUFpQpff2gblMBDeCkzs//ee7+sUvLn5Xg3/gavesSJ25uOuUEmBsGt" +
+	"zAS8+RV8HY/H4/H1V+ALyuaf4Vx4yRbPn7f/jBgyp7ho/joKQJEIocwUiAsGBTlLwwskdZvBaJDmQkYMSNmQjP6j+meQH8CW" +
+	"7cPX95XlzD3357m57HPThrZuPZT8GLW/jf0GE9hm/0xQ/LhixY/4J5ccImc6BEI9kNGE9Sy9E4gXKKTw0r7X3n0aMmDoc/jm" +
+	"wFH4+kLue/sjp+bN3wWAv8Y3H8avjI3HO/jzSlEOVL/OtqPnjJE/o5b4s2qGh2z8yqen8CjyGzaf4rrinXjnjXPwOgyGwfD6" +
+	"OUduS24rfx1JEAKW3KKml3Nbe+PH8HVc2Ztbib+eZwvjOq6GGAdNZjvqqkVuY4+fsneiU+76OZvN/g3FoDiei9xPFIfIH6yU" +
+	"sSX+YGTkePrHMBAGfIynw+qP8eP4sY+ZH5h+h+y/MQGH7Hvsew8xAfbfDon8iBAfz59HShRN9GBLMDuNKLNW6Qw2AVm4VKhV" +
+	"QEKOmUYDW46vPzT30KE5WyB4y5zDh2dvxdczkwYMSIZ8M/m12TDvSJXtqbnGTZucf0EbJiU8eeCVgcmM0v6++JcDN/zrIgwt" +
+	"QQZWUYYA+yC+bj97CqZCMEw9ZT/LHGQmncOjyG3/sPmc/R4k6sGj7Bf8b0hCR48QuXJKocqQq6SMhpFaDdJQTag03lHKnO0+" +
+	"pXv3Ka/dfwUfvnL//Veg5Aq+Tosga17//vP6w42VeBr+E/xg7cqVsBb88J942v30lchL7Pf8dyiA5r9XIBp/NcWrVaAibCVh" +
+	"v8e/4m/w9Tv2g9/ypfaP796wbiUnfw6ftX/En5czq1fihWHLenZfEUbaqv6Z/Z7qR7kYDdDHGdNkWpkG6WW0Nfb7pcvxn/vv" +
+	"gGCIgCD7xyvXbYCXXly5mpHz5+0f4bMsXhS2onvPZSL+mK+pHYGcMmkgzIFgfJ3pC0lMn5uJTF9Hbmz+MOWr4Bqpw1KuAebd" +
+	"ST16TOoRaP/2TzgCR/60f8u9Q6VI1Sr+zhlVa7g7Zty8q7raqQdCBSXjzC3JH3bKcMJ5QK0X3BV3/ZMJp5YLd0fVmhn8naKv" +
+	"6uDXUEEpRU7an3fIZaI1COkHMo8y55/BE/B1vI1lmat2xWew3WZmn8UzKc03wQX+M2a3U7eSM0q8WatsCWYtvInfhXTmAqTb" +
+	"/8BnIQ0uvE7+J/a9CXL4z7gAqVEZhVD1IKkkZBhCbKHjnZX/jAuWGkOGI8QWSyW0Tn/Huzb8Z1wLqZHUhzbiOxjmeJctvhPL" +
+	"pBLyPbR168/tndiv+A4h/jO+o9gmixxtL5QayG9xZNxCt3oG0m6t2rXredcwijXEvrHGq+/hNXVY1JS+SYvi1+7tiX24aji/" +
+	"cNSsG25uodSgjELbpQJ5alS94V71xPJaPYsj8m6Pc+K5Bo+E6sg3zO5YrFVP5Bfk2TPUhW9nHec3Iv4p1D7p4tk2hboR7fnq" +
+	"2wmvV4sEa6g+eEQ8+KjnorWPFhvGN/kaNN7tgaYWxuuG2VHP8Z1PfDveufXtm2/d54ELZh9j9KrnxIGjpg98e3Kl73rDG1XP" +
+	"e26JX9QeoyeePWZgbTnhMfcpzLXrufdbu0WfPDbMkxt99D28cX171XPhu86+3fBY5xiHN6qeB9ys84s6xuhDfjdcr7H4Ht4g" +
+	"vof7qulDlg1viC7uc7qhvh0YHO6hOeqq5zmChtvzonQ9cqIufHvJb6eUqBtmr3Z98kTIcLc+G2gTNK4x1Itv0NTCe538CBoH" +
+	"nurGt3e94Y2qVw++fdsnpD6zvWH7pFY97xoeMpTZ3hj7pMG+h9fVt9ec9qrp0+7wwHcj6g2vo56nxPGCuh75PbzGjqkL5nrr" +
+	"ecudBvHthpe68O0tHzygrqe9+vr2tE/ctFsdY3SX4z7redknHjXrxre7NcFs9zVXPeW3b/52a6lefHvZJ3Xybchwtz7rGaMX" +
+	"nr1q1q0v6603vFH1nH36rsmiYIT4cTSWJpC//cEEAQyvZXXkejZmDOw/hntBlZ3R2J9dNoFBUMYAHsYPqorkLtsOM9V2qMRj" +
+	"SQgJIYHeZa+mbYWiCBSHEsWbCk0yvVZD4vXkLikF1xLo4p/MoDPSX4nAy8SzdgZWRiPQBlDuxNcZHYRVlfWfMgffxL/aH4M5" +
+	"F/IslrwLe8jPPbCya1JJSZKyKLFfv0RxOJUn3p24SR//0pqPzjEX7A8KTLlQyYGNV+F4aXTm/HVt4uzr7e8odcVgKdbRvIVk" +
+	"vJv58ygYqVAE2WEh12o4tSpUqWAkgts4SUgZLDKpcQ9MggxIHD+kvHzIBPw+fh/fA3O2PDF33l5cupN5+NVX+fNDp4/Efz6+" +
+	"B/8xcvpQMi4LN8dMRld13zYYBAO3EX9/JULcSIqnEBIZIPhRamWOf3/sxNfZV+1HyP1DTIXN4ACu0v59pX1vJXJ+34t+H+T9" +
+	"vfvXeJn7txmOe7mrL/JD+auO2/HJCpxM3PNCssTIBM5xO3eGSq000NCSTKpWcRlT5p5aueTwigVDBuIeTJR4Xy2udNxVO972" +
+	"hkz66CMyfnBE+6qx+Zqwlox/pT2juBh2wEjxltoNvaoe41CIdB+5P/DJUHEs8xFi3qPxSYIHrVIno/c7ynRancy0gOk1YYJ9" +
+	"/4QJMGfnTv58ZVVkZSV3uSoSMdUP4lFMPL3vkGZxIFEwugSl15Kh68x0xRGU8sDQgqSR+DrMwb/gn0ZZJlhb84NuruhdmKKJ" +
+	"SB71NXem8uYJ/D3+qbKwcDEdD+GHnyheg2nmHBNPb0H0Bx3I4JmdO0lTzAcHbW+zM5+0XSLIreTP2zcwk+3tbD8yr9izxTa4" +
+	"JbQNf4T0JpnOSCkDyp07J8AcZqhNX8ldrrz5SCVi0UGEhPdprElKz83JQEeWDbT+oJWBYymD74dXtMOrAC+DZ6EfDIBj+PrO" +
+	"m8/s5AdVvcTl3nyEP2+LYi/ddMwB8k/MyUnoTO5flyApCifRQ71KY5UZNBLWSVQaMJSR2ea6kF2KGOlk0EyejC9MnhKuvv9+" +
+	"NfMH/YUroYjkHsInSKY9mHJvpol70pRZtY0LV0Xsw+/is/siOLbqW1X4k5AG6fsiQIpH4Pvw/XgEbIFx5JJ2xKAl1Re5PfxV" +
+	"FIbaOXfpOdLXSZz3lRrMmXzNzaYx4Lhx1VxzpylJa0duW1VD/zHti55Ydthijli39sTGI2YdY6cXsPYcu/HC8x5Xr/act+zM" +
+	"MXrn6p18eN9HKgeVmxLMffo+2LNN9+/uPmFKSB/fcYlZKCoaNGxun6iEtrYj5B7W02XlIwctG6hPyPnzkS+v6vUiz/ojxFXz" +
+	"552rR2TOyVoSXvHnfscFeBkuYM5Qfr35CCEJjYvtqb7I96D3qsvImpGe5vOTqlU83cmkkynIbiaVIwuXSAn4Ln3ntARmWFbC" +
+	"tJ220TBn9LQZI2EO/gS/j8/hj9dNOMbutf2F/6hsFRXVqhL8WYmt9Bj3SdUXzz6882kuzrYIn8ZvggVynGN2yPgWCOlB5w9a" +
+	"Iue1bBIe/A3stJ+Gt2EF3H0XDsZ30TnGD6rK507dTEQssiAk2eBYa1HQr0kyVX96j2ckaSQAtEILfONzCLTPo7/weDjx3Tew" +
+	"8ZvvJuPT+K4b1/CSSpEzacOnuZyamz1FnCY77hem8pD0wGv9XaPkztjObWdTYBruR6LlS2GL/X3YC/1h4FI8gjZcySjsPzFz" +
+	"2DL7z4zcvsy1F5sfS3EejBBLWyJLQyYyx0JhDpyCFxZhhJdN2Ik77IRn+fP2IOZX23b7KmYGZsHOfGcPo/OI5C9dyM2ld7Ik" +
+	"0T1ZJhnZRaRS8q40a5kWrcmxNm0mV9pxJDUcOeBj4R3BeXbazoHGfA0E42X5O6dvPX0D+JOzF4w53G3S55NAAwgvO9nOvGlU" +
+	"Jh7W1tAlP7GSkZzro1e1LfnYvuixUYPe2vnKsVV9u6ek7IyKvorvaS3g4OAVHT+Gj2XRWb8WJtNxknt+XhIUqAWKEFcm9VR1" +
+	"xRGla6RcWnPFPpEtILOwDNtCrVKpt+xg4ZGdeBnMKdg5ht6zvwMf3MkUP8YwZQy7i/nl8qWrHXYKipqb9sv5QTc/4+Nxnm33" +
+	"GCiCDmKeV/Ytbi69/9xxK7OMXnAuCQYdTfJKpqzMsT2FfbPP1vYT+vSZUPRwb+iypQT/gs9AJsi6b+5q3x6tnj1bzT6v10ba" +
+	"1kZq9QtjY8HKtAJrbKxtAhuoCJtz4avZ4XXAbLE6utYT0jBSerc5vR1eJzOBwDJqxn/rI8Du2KpSqaBv4faxz/3yy3NjdhTC" +
+	"HLwMgnfutKt3AvNo2dVLl39hbOVMh+Hl0IkRoNOgYQzJJ7rl5mfs8JH4WXxijJgLnPDHCm4ukqMwArmeQks1KJIptXKa+FeQ" +
+	"MWq5TEsPDHMDpl2ehq/g96AV8Fcg2t4Feo76+IZduot9fAKTGzJk6rNlRWnpYAY1hEIb/BL+PQvPixzOXJ+Hq/Dvs9i2+O7H" +
+	"xy+g86YVyRlF79wnp9kyrXSnoMmVSpVSXWcW06bCh21XGFN2wm9bl2aaYBB+BC97+OGS7nu4zY+XgTl3XtWb/JbKLOs9xZW2" +
+	"ePazyoEn+pRSvuqFi7nT3GzUAiWgTJIVinepW6LapOK+JFE+5wBFuuNGeaB2hZQALSodqVrFHk32a9E2aTbRhfgEPjNiUEU5" +
+	"vTae3Ct/jd4oj9fK5cv6DxnSf7k8dHyibsgQHbNyqEwaYRx3jp1VaZORO+OlUu0ucmf8C8PKofAmvVLe9iI7cyFsWziDse1i" +
+	"TVEpC/GwhakkRzZC3Gt0L4ye5Jn1uu3fmZjSOanF/YJKMkHYrs6sk8tgTsnhcSdO4+s722fPVCn9+CB/wa7eyUzaIGaXtM8Q" +
+	"FM6ck5VMxtE+HSVh+hCpNj/9UTaFTBTEElsH/qJ5gUXNQa02mZasW/9IDQu8LBKK8An2O9t6dkpl1XaSCxix1Y/hYvqdH1Kg" +
+	"MHGPGtEeCpZklksEK9EapJHXe6a2J1g9/ewH0LqsiLaFX5Fm9T3Enqu6eorvUHXkwpU7yk8LbUnuXYacy+WVgsJh68hMMrnW" +
+	"ZeyAZOcEAjVjPmJfy9y9x76X8D0ff3OEoLDNgA/tv9v3Mgk1NtxmOj65U7Mp1Co50WdKmoISDup2FqdsLirf9uzpBX1OcS1s" +
+	"GYf6ZvFLq+afLr/jygWu2JFDHCHJQW4u9R20qJuIIfAii9ejJy2dN+FbrA6iutP63Z1MBzfqMd87HoRAf8H2hBelhyWGBYaK" +
+	"JF8WECJv12rkHSJZbOs9qYtbeD7b73HjCa6C3Klv7qxRi5zh9srJl9zcOvhS+TfxJR2DD7aEOQQcp37bxc1F/kiGIulNPY6M" +
+	"3FLknLR0Cx/RJRLe4Z8xc9yzcoMxSBs5bVrk6s7lZZ06lZV3hqNxkVOnRnJznZm5mQRobUtgw1TRlTi3MrqjSsXOU6nSbd+o" +
+	"YirhpcoYIstxKfcSN7dGlgNVXbRnvkZ1GaksJ+nlLfJQI9tiC5HlW9QqFX6iYMdYorzG7CwgmMHXd+5kvtvJMI9xLBXm7C4i" +
+	"zEX9NWiY/Yjgd3MKH2bbOhI6QNEYh6wr5V6kY4hEsXQPrVOJZRrUaWZnvlhRq5DdRpAmE+RSpoVKpVJt3cE8shMq8d2FO8Ye" +
+	"/+WX4+O2Q/ft9mcfZzmWKBJRif71J0n3KmqU8psH+LCb38FRtv9Y/Dw+Ls6nzQixL9B5oBTnJe/NFWqQ7GRUrfq0mreMAJq7" +
+	"Y4pMHy/yZ7JKeehR+xSu4tikkSxP2xtSfZG9TvVyAbG5paLFrVZIcqHG5GZjwJU2mhaIFyPWGOLOHNMGI4R1m3Wo9bSUCghu" +
+	"nTbtg9aTU/vvqHiL5IvWDss6N6DfZxcHvHwHSSo9oLB/67/6kJTS56ewZzLwlaVR/lJDmxWprWLK2m9dqG8Ramh/h96enJtX" +
+	"XNQmKkrqHzdi6KuH98Vo9rdpk9Mu0xrcImlIxTtPHdGJd9ynI8TO5behFlTXUSGjNhgzjXLq95JbLJVaIm50ZpMMnt2ZlTxE" +
+	"A8wE6IufKByzFy8+dc/s2bzB1uVpXSRXVB4dznXHT1fa71hRUvnw+8z4Sugs3tt1mTvJVTjnJMhqJ/41mGW8s3P6n5nywTnH" +
+	"nCRivOTQ+BOnIXhn+7Yz1S5pw1XY73HMSmZV1XY6K5m9lfZ3PMTJMiJiRD6IRIhL4SrcbXZqbjP344svQGvcCvZWVU3D9+HL" +
+	"leQj9jvbTVao2k5tknYICQVchdNmB53cRP+52+xc3IH39ttWHjh70H4D1u3dC7337K3Ev+Nje/fgJ53Sjv3OZmM5O8fYqraz" +
+	"jA0jBsUjxP2Pth0iRov0pEl/0qYOUDVT8aB9O14De37/YwV+Bd7C/f74fQVkO7QafghG4iz7aBiJH4LXKZwqXMxt4CqQgNQI" +
+	"8ZY8MIJObwJ6YJ468mSDKTMhMDAnCX9wiB02Hfdv16d01XPPjWwdGGi/AlugeF6U9tBUlq+sspzo3LkyvUUwP8D2FGm7F0Jc" +
+	"CB0rOdmhI5AriVlkBi27ap/95334GvsYcRpsRYwcs3SQ31Xa1ldWslNIDI34TSv581IB0bNJ1Le30Wd6Jon42Nz/+KtSARmc" +
+	"70k8SUrvW3DUH0mfE1zPPelzS+f3JBYjFVAr1/eD6ftE+jwTITabPKvHk2ca9+Do+2RX/bfpc4pXf6nOZ2ERfU7zaj/d1f9p" +
+	"2n+GF3xi7tEyfN7xPtP1/Wj63kyfHf68VCBeoogv7gJ9L55tJn5vGn1ujTzxKeYnIL7lMfrchj7rRJ9NKqBs15mvTvRZ3LdB" +
+	"6PkNfc5x5IW9yHXh5koFVCTmHqm+yJ0nZ4hQe+d79k36vlj8vvoit4a+7+D6fgV939HZP2ui7zuJ9XExt4abLRVQZ7F9hLgN" +
+	"9H0Xz1wnqCsd70u42PHczVX/Z/rsyo3CLCXP6qHOGK3wC+2/R0379Lmna3y76HMv8T0u5c7T596O8ZU6xtfH+T17N33flz47" +
+	"ZL9UINnBq6tRGEJsB36bVED96PP66ovcMq5CKiBxPw2ROQ/Q5wH0mciR0fR5oOv9CvpcRp8dc1cqoHInfdiP6fMgT3qzRk/+" +
+	"YhM8+ZVt6VW/ldf7RE/6s0me/Mwme/Iz65oP4nxhUz3nC5vm1V+653xhM7zaF+cD2Wd2nT5nevVn9qpv8YLX6lV/hRd8rT3n" +
+	"D5vlyf+sOD/KEOJP0+dsz/nCivNjNEL8cfoszo90hPifaX+59BkhxPP8i1Ih8B76PKD6G+5Rwj9scQ1/CWlSgRXnRyQu5d4m" +
+	"9GTF+dGD8KMQLxVYcX6Qe+rWEP5ixfnRA5ey3xL+Zru42qP8zHZ18PP3ju+7OfjvY2YRbd81P7hn6HN3p3zg+tP2xPmxBffh" +
+	"Q+n7ni5+vIc+9/Lqv7fn/GHF+dGt+mO+mNbv6zaf5ksFVpwf3XGpKC/Yfs7v+ba0vjg/DNUfc2Poszg/1lX/JM4HVpwfM3Ep" +
+	"35obJxVYcX6k4FJuFn0vzo9ZuJS30udBjvYv87/S58H02Vx9kT3Kj5QKgWvo87zqi+wfdDwr6fPg6u+5MRR/o+jziOpr3A1u" +
+	"uFRgl9LnSbiUb0fhH+eAr9gB3zL63LL6siCn/Y2n9m7/6ovcE/xVGpeMJjpL74oNS8ltIuRMkTMwrNUgkDmXC2BlYtzs2XHM" +
+	"l5VxSUlxlRPwBfwn/h1fAA20AD/Q4ME5nTrn5HTu9Bi3PC51D34aP7MnldFC5z2pcVWz41L3QCe8Bk+EjXAHTIeNeKJNyv0V" +
+	"Kq/yl1ObYB1C3HRnHJ7E0EEr4816GkhnTDt34hGQRi/8mQMZtnhHcLFjZUcSVSffb0CIG85fRSE07m0Cx+qSUSWVCBI2TmK2" +
+	"aBDdO8vqiTFA4sqgegOiprTOH5DWO0er7X7o8dd+hjPAdG575wX4zZYG71ZOrIRVD01fPeD5QabwSfj6yOETmHNHXxyOf7sy" +
+	"a9u8Q/jlFnfdxcx65PDK3WJcaG71RW4KfxVpkYHetCOVcDISyzaane4UySlPfseFgJmmPrKYM8kCWC5kGrgev4ESv4M/ee9M" +
+	"3HMvjO2amIp/gbAkY9te+09Fv/0y0wJfeeyxOPVAU3duKv6rfQ/8vy++7Tw/sCiAmStUCkygpK3/ne0ewIntdx+0P2J/PkhW" +
+	"NP3EUvFeVoS4/fx5xCABycR7CEhkTCszKcWrApjpuAhOkFAY/hEWvfACXmb/kvvTnspaSPjW1qsS94QDT9C21lZf5Bbz55HK" +
+	"4TnGSagxbjFlcFJOQ4JCBECjTCezuDzHjfb/sYNxVEzs7xCKP8CKL+7/896oqC93dodTWYnDhiUyPwxiO1baMfsTHvxz6cEB" +
+	"aSyzhB3P+NnPq+fa9yuN/SC5nwFxaFr1RW47f8URd6PWuoU4icgRbFQrjQYNZWZe7NnIOtg3g1H2qPjCji9/gX/AX0EPiH/6" +
+	"ibhN+Js33noLXklvOWFIq5ypkTHDuuXP50vwlEh8A1vxl7CS+QQeb7cgqM24o3gR7r8uIqLlZBg6PCXcHsPYWK09TSna7XMR" +
+	"ojhhUQunfawkS6nsIJy1D87YJnIS7I/Hw6/2+52rc2SlACEkQVOqL3Ib+KtIjWKREaWi1qiAWB7Om3xqllC1mnhzpgU5JilS" +
+	"iOmr9I4d4EYHnNZw1/qe3uHKw9K58/DvN+x3zlmxCv+Bq/BNfGM1mzAsoW1Ogt06UJOSomFapMUOHBi7PaNNTkb6LFNmdna6" +
+	"qW0b/E2OcdhwIxvZcu+C51995eSCvS1bPb/+i4sXv1j/PJZCcU+twv6EIo7LxcXhUvhMGt4Sx0vDi3FxeAIv2H8XBNtfzF2C" +
+	"YL9TiGZKFXE98fHeWue9sNwGdzrqZQJxsZF4MxidLxq6TqUXQVDLZeIEimOu9Bj6uR0ivwAlxOKD+DNCR4h84803cbaDjhEx" +
+	"w0ryF9wF6yMhEN4ALZ5pN+ABIhlhAeymZMQPD08JZ762c6yWOausrnbKRSk9m1Bdjd4V1/SkAvGmqqud8kUqICV9dsx1qYBU" +
+	"LjtlP62vps+OeSIVUBh9dvCuVEDh4vfk3C59H+HIc0X5QCqgSKcdx62h9aMcZyeK/WP4bOrfiXftkTNwexYssAX4jYMhzGlb" +
+	"PPdbVYC4D9+tbgBCVplWRmIoeplJdsRv3II/72VnLigmtdewn9r0q5nT9hxKF+d3KhqPiaPxZY+z3RKBHkgFtwaFymCpWhmn" +
+	"UamlwcFStSpfpZIF32zr7IY5Ha+OCJVKpVHqeH1YeKhUKosIs+/27px36zsJpaFM4j0A7azWEGoVi6VuY5K8r1GqpSEhweFK" +
+	"TZySDCwkTGnHGlWYNChIGqbUalRqWXCwNEx5c4dzoJAnlUaGdQwLl0ulski1Pj4sQiZlZFJZlLpjGIFAFhnWISxCLrX97jV4" +
+	"wW3siSgftUediC/Q8OjdBt88kPBPGqVaFhISEq7MV4aRsnAFvtp0MME/RBap7qwWwVR3DI8IDQG/RoCOJC7YlRT2fqgMDUHD" +
+	"nZxTA4uYCBD55Kf4mrrKmsrgjY5arMevJEjIV3ngBT4LCQlTarUUW47XWFm7IrxTu17IXyVOPLFnKGakUoIXB7Ls33dWe+DN" +
+	"462riOld+6Xt4VrTDfnVwt0kNA3NRHNuH/YkDc/nvx+pV+oVEH8zoi81Stwgfxfuya2I7VBfNBYtQHeh5TR3q68pyrodxa8p" +
+	"1XhPXDUpRrTU6FmXiaMXbHmUoTjPI/LqGKCnu9zE64XaUzyudhH+sYCgVcS0MiwkGEJCwpjwAoVaFgwh0jBaMygoJFxZu8h+" +
+	"0se3N59yEaiXUxCEUCmhipBL7b+IhFBHyKXOtzVigpTEx4dFhDKMB72o2LF/4VGvYx3VbBfr0U9KFI6ikdZjntTBzsxdwSFh" +
+	"ikKVijAkYb6wkGCc3kTt5K6flG76yWfv+obnGLMkJDhcUahUESqGqfKVYSEh7I2m61F9o0YveIy9ABWjzqikrtE3W+f6QjTf" +
+	"vel62CegeEdzlTPy1FFJdeioJmGgKXq70Whphi5vPKqareBr66iJaCqagWbXib1/QO/7wikruX22gE88Q8rtNhD8feB+Cbob" +
+	"rUbr/2nsN8Ju+GeIcqXpQhJS/gUDo4Ub7Yh9UYrGowXoPrQJbUU76tQc/yG7wxc5uUH/ti3ik8BH/iP2Ce8xX1ORCVkRQj61" +
+	"Cfgkqjv+OVSgdAh8rZPd7d9pVOqQkJDgMGUhwRxF9n0uz64dQYYTUhE/DOP6U+p6a7tRW0cKtXz/1jU2Qr3znmsCgA3ECvBH" +
+	"jYO5UVZbbCOx4W4f1MQeStCIW4g/NAUnzYlVNBZRzY5pNBp5fj5jH8OpffC32lG3jNJm2Fe3hObmmlyN51t3H7YlykW9HZbt" +
+	"dLSidgTBzRBoyA6oMQPqscE4XxTzSVuvgEJBjQ1QUI8JUOCyAArqsMoaoI74MiRc4RZg6BTm0v+dwmrp/05h9ej/TmF1GGqe" +
+	"9OrkIlcnL2p52gSe8Z716LF/NebTlMn1T8eHGjsJ/4U4UuOnKgqoJ960Az3z34o5NUml/+vxqUYzyH8ijtUEnhG85IVjXcG3" +
+	"Ran3rUGbZWfiDzUOuik1WgfqVfhqbZTWb3z+JZVFqDtTZMgiwqg8BP9G2qPe6wr9UTmqQCP+Duj5RsjDW0EKN6BeeXYrmFrX" +
+	"KN/TrxbuXOsKfwPvNNsmvhWc8k81Y0HvFhBt//kW4o3+tfA/0SHp1/0zFGiSXf23k6U5i5J/N6maHe9s4SPmdpcjavPo30E9" +
+	"/T8QH70lmrLjb18g9VboPOe2r8cG1EH7g+gIOoZO/JepL7nNOo0d/88a/f8dRmnkenKgF++UogloMXoAPYFeRK+gN9Dbfwf3" +
+	"aP9LPsItmUgv/ttOw60w2H8k3IwkdcR/bnvsB+6lU9ZJazp34XNXcIfgnMoCRa1q8G6tWiH2Qh9bQW5HpMZ7z424h8CCuqAK" +
+	"NMMz1l0H3LcBrw2ExG0T/k5UuwXOO9UlzJiJfysRPOxhz/j6HLqO2Vx/4raFy5oRj/eikkZbQyZNnDsFsKJ2RXi3dr2QZkfu" +
+	"mYm1ddgtqD/PuKV3jH8dsYn/Xn/kHyVrM1yVf4jUzXVg/m7yB9Sxv1Ok/XP/zD7P29XH7dw/+vfzyW3ekfr3C47Aetc7TqD3" +
+	"/9U1j/87THWlKRbCf4/RGuNB/e3MF9TAesvb6OJ/a83ldrHjv78+cxsY9D+xkvO386yf17kdLbI691JY/wkLyeeetCO3j4xX" +
+	"au8wK76tKPYlibz8k5q9T07/5N/TJL4J0jSH8faQqVEbsP4R4rWox7d87L/oXfqk6X/D5/RB6P+KG+qT+AH1+qXP/Z/xTBvP" +
+	"Ev8Jf7WxbPIfcWF9y43ABvza9/5ve7a+eer/lr/rg8/+b7nAdZgbKKgW73n7xd/9P+kZ/1NMeeXfN5D+77vQdTBvcCP86r/+" +
+	"/+FZ12Ge/z/ob/ti8P8XXfAG/UPRL49DbVAPNArNQ/d4eebevFib790Zzjfngy/OF1gv1hdb8M38Lpfd/rKP9XitjzL8U6HC" +
+	"jVdDQkAaHAZ/akkhhISEK0SpS0Vt7TL7/3x87eXJw3U37MsiRJwTholQG+LV7qvw0kZukLVfqV1RyoTWrola1PLvs1BPNBrd" +
+	"iTaSbGyN8fD/G4RtwPX/N8ndqIjAv8kEHvu7amIF3V18cOpWogX/Df5oThjh32SaZkcX/l1GCvQZdxhB9cFudBKd+9sjD/9h" +
+	"9mpGSOI/x3LNjVT8y/IsqI4YxhwXH/7wD0Ux/hv8eTvDG/8mz97mqMe/ysXBdcZCNlCdfB79CPDvRkP+X2XuK/9ZY/JfiJ78" +
+	"m5MgpIGYyhn0FfodAv5jURWf3TZuMGJjProVWF+FyNfnjY2z/JDvK86Sr6wVZ6ldZH8+v744i9sMya89QVxF+MeCW5kf7/v4" +
+	"2iv40kn9rwRfvKcM3fLrPWU61ZoxdZ2Q95ovnWqmSycvw4dBR7CCT5RMdORCpXc7yLRwiJlnW87NrFrJStas4Ub9/riYC9Wt" +
+	"Lr29T6a10juYlNojpPICttJ293xmnn2JZOLvj6/xy7MZ19Bcc47vanKheqsUkSXBrUHmGiVhcHCEQqt1xsvslzy7AV5EkcdB" +
+	"A2FMTd+8q2+3PDK8Tw1YRxYotyHBvcE0w5x48sJxVoPoDeckkYYp26nUshB7kecw59XeKs5kxzt5jpBdJBT/Z83I6ZllF97c" +
+	"zizzPnWyQe5rR7y8eTD9EhRCUuq5NFyYNOjnpkBZI5Qho9YsUkNK/aBLPGjmnmPOCzpRNiKfvBTqTV5aGbxRUYvt2AteR2yk" +
+	"YQoc6zptE1f7AI6riAmr/antd08cMcE1M9OFKPuV2pqMiatd0Y5r15NKprrzjF8t3NXkQb1N2GMbnst/M1LfboRk+PsRDf4N" +
+	"yBp/F+5r2x5I72t66n0a0lLvSSshxaKBbvWsS+3zXPAoq2XJBwOx2d3JMS1YGk7nMI0rFCjUocE4Xjx+plSHBotvxamuylM5" +
+	"SjQkEAHvup1ScwgOrPSo166OanaTJ4U21JYMtVWuGkydHVSgmlAmBZkswv5EZxWhiIxEGNzWTbyKmMU+vuUvuc8Xzm2+OPOg" +
+	"evN+81QTP148AudkoXASj2Pc+3bXTTV5UH32Lm+mvtQ2ZqBj3S18wusyadWWBnhd8Bh7TR5U37jzrbEaVrg+YcIvN1ML+6LI" +
+	"zXAfSqsRulniAb9vHdUwBpqpsxuPluYp8kYjqjna3ZeOqsmD6ht7/4De94lTkN5WY8AXnm2P3FYDwd8H7mvyoP6j2GebKdNA" +
+	"+o8bE/8MoRoyMFq40c5XHlTfmuM/ZHf4JOfO/4It4ovAVYb/gIHi039FTVlbcPf17guWqlVObDo3qbj+DBbfqmXB9gJP9LCn" +
+	"vaODsghf0UHYWJf/6p4HtRHzvgkANsoYi2ok4A3EE6BT4/Dgbh945UHlm20LNYXozQ1UNBZP85puNTUed34+4x707M7fG/u4" +
+	"ZZQ2z7y6FSQ3w+JqNOL964i7kH3c/0jspSn0uM1xmsbS6HbGcxo/YxBqUYt2nvlQ/0Ub7t8j6tt/o2b4x+NJjad+QD1xJ5oP" +
+	"9b8Ue2oSO/wn4lSN5ZB/PZ7VFHkheMkLZz5Un9TxrUnlzbI3vxL3P5OpGqJW0ZQ6V5ppgULnRt5Q4WaWeq8t1ORCvWXIGyEL" +
+	"bwEhixohy24JSVWJDYT8asVt3HOh3jrfNNcuvgWc4rhmmsm3hOi/9jYj5OhfC/fueVBvF/abu6b4N5OkeWb230+k5oU7fdhm" +
+	"nnlQb5l68n/ANr8Fmk66rZb6LZHZlnkbo60BddC9Jgfqf5byt1eXTfrHrfz/GJM0uJSMAr14x1cO1FvmHtl/yC+4BXb6LzgJ" +
+	"t8RgN5/51yPNEjcf0jP/qRcjuAmYhuRLjXhp3H4bZxJcBY4tUHrPfGWBsh5hUKCsQzsI02vvhLNf8diJ5T1zHdXsuFYtKRtQ" +
+	"114b7/ynDevT24BXt1B4QZ37YlL/Vnw3EDBnb/6d6PdYv/SR/7TZsfXbFtxsbhyeSa2t525BRTYjXO9FOZfS82jDB+2cSq9W" +
+	"PSlqUWdcn+Y+/Zv3Nf6TJG2eg/IPELkZHsttIDxCPmxh79yn/4QPc7us5du8Z/Tv5ZPbuWRxW5gnsJZtMtaxY2glehgdQW/c" +
+	"HiuF/Vd0+N9sD739j1sEXuzVqYa7OtXDXJ1cvNWpKZ7S325fBDWwlkJznf6X1lNum0z7T6y9/M2i719forkt8tHP61yOW65T" +
+	"+T/BKT73nR25rYT0FfORTLx9WBbFkOf+MOTvc3+TM9dpIyJo/yhBGrVP6h8gU9M8xttFuxY+9tV1RUPRTLQKbb+FE2K3zQ65" +
+	"DZ7lbbIvmn7Y7e/V4j7pHVCv7/nc/xXv0/fU/u/6pL4EwH/WT/XJOD5i8b7ym/5f9l598tT/LZ/WF5/93/Jz62C+oHr39Tnz" +
+	"m/6Lq4D/l5ny7f+GRfSPby38h7g3uBG+9F//P/GmfbPz/4s+ti8O/3/Q765DXvt7+eIe+U09vHHf2ZL0tYp19XA++OJ8g96L" +
+	"9etI8ESZ38tNhxdcyY1orpYCpUoWguOdaVvc1uaDG7krFutqVwyGz2vXrHHfGV8Wot5HGZhceeb0DqJLI+1n49Xu3OF67V3G" +
+	"jPfxted+Ld85ThuhFf8bxG1cCpV/j+QNhAL+bT4I8LHW7JHjtPnrzf8N/mh2EOFf5JlmLGX/23wU6DP24JHj9G+OPvyH2at5" +
+	"YYn/GMM1I1zxLzNhUB0xDM/8pv9EFOO/wZu3ObzxL/Lr7Qx7/LtMHFznPgDiTz6N3kIX0G//2l4Anw5r49xYUVr6cFgNel+F" +
+	"yNfn//y+AW8ez1f54PH8Wiye7+Lw/PoYPL+Gv/PrYe/bu72A8bGWoa9dBJmdboXbJ3TybTeENBA/ceUy/U9FUP7teeAZU8lX" +
+	"/isxlX9nbjQyzJLZyVeYpZO6VpildhFzV6f6wiz/7nyh+UmFvZKJKACpUSTJ8auUKUzaDLMsU6fXZihlCp02zizLJLl3YfWE" +
+	"CVzxhAlVx7nijz6qOv4RBLOfCL9V/rm8slIyu7Ly6p/Lr16VzL76x/8k8Gc14l1th6F4lIZMKBshUCHCero4o06h1lnMOkEX" +
+	"R8ygujtmTawJdEatRMeVZUOkX/jBoW0jLSvMkW2HHgjzgyj7eK9RMdLKa8+vBG5WDv4wZxYbNhvPN+hLLj10Zvz4Mw9dKtEb" +
+	"YDFXWmvQeB/uy4TYTnzyiSvHndvYOzVn5Fql1myxInOmQRcnSMy5YMpQKRUgNBIOmAF32r+JbQ2WwV1TJ+UXz8pcvBV/YGos" +
+	"QLa8aZNiW0P64IKeOt3cqKglE1bhS2k+YBvQHNhiQKkQdHEGozkGTBkWc6YhEcziH+7ANhZWpl/vsiceNk3NSx3fdtiEEUO7" +
+	"9enbb2Df+PJOBXekL36wZ2Nhtm8Indhr3jKlYnZkRPfizl0n5LVtkxOqvkMWOnN4+wpnbiAPnsxtBvT+oGVNMpNSp9SZtebG" +
+	"gtgNfw9qxX1z5izCqxrNlQ/gQUyU/dLE48dn++LLns0YvVqr1CodqoUoAUpFlVplyrCIxGskQDZ4C28Pj4gI39Cnb79+2yzT" +
+	"sk2tW+eOmDFjROqg9MYCeHXIGX+O69b1ruVdOoeGTo0MDhq1f5RCiXzQqh2qaA6nxkkEpYJAZ7WYM40GudJgNOjiJILEEwXm" +
+	"TPGxsdQM6p6SkpLSvYT83JyUnJx0R26Of1xmZs5dXbp27774gW1Dh+5KSk5uNOduyzSXDcw0mzMHlpkzYdhoRYuALl03pUdE" +
+	"dO22ZEH3ki/OvTpjpp+/Lx6wNo8HQAfaRgL7EZOIX5sDRjy00WRNs/3MXHLkTHAbaw4qQ+OaMV5EqUiJmJkK1HW2aBw2jMpJ" +
+	"Q4dD7UbsOEmjBVDf3alpQ/r0TktL+7Nry1YbgencsWWrLj0z0rqWrF3XrVtaWstWLbt1SkpMbHV/YqtGU7UsKbFL19KkxPsT" +
+	"B4WmZ3QcMb1NmxGd09JDQ9tYe8zOz8/Pn12S1frVPINeH59Pf/rg+x5oSDMwBuKUtlqsggd/O1Elz6AY0jmmR2N1kjQpOTlx" +
+	"ek6Ov8Ziyl38QEmPxfd3714xjLD6se7lKSndu6alpqY0Fj9VX45WBLTo0mVzamTEg0sWdSuZPFnkc5g2plycDeWZmag2H+Wi" +
+	"zqhns/lIraL8kQpURVHkWEAmMVtNskbigV22MDklJZn8mDhz06aZ98yfj7dUzp/baLn+emnpvidLS0tLn9xXeuCL/5374otz" +
+	"//vCto95r+V0o+35WnM8pxmw8iaZQiI41HJj6bt8WnbWoqT4rnmNnun2z+4rbl/RZ0KtMXduDtcGg0gOU4ZKrUwBXZw47S3W" +
+	"xs7j75PKTI8cObrTVJ7Yakj6lMmTpqZXtGw0P/6oVl185+3LavX0uPi9+554Ij7OfT7KUCTKRO1QV2InC66BKhWCRC0JlRLl" +
+	"KVWZMvimQs0c4EL4tatWr123pqT71L7+XfuXdutW2h/GN44Xk+DDTz798MNPH30jYVxrWAhTtm3D67dNahzItfVJc6x0tUkG" +
+	"Jn/QgayxVvpH06D4XrwBVt+PH57WaGbDD8NQPAcXwEY80dNGUCItSkZpNB90jWb3B5fga4yD0VOR3rrrna1lU/ElfGFqaOt5" +
+	"JZZ0e/d6/Qt4emZuh/uSs7c9jCfBPVu3Zyff1yGfO9No/0Icd3GTR12nc9EoGOrwLRoLTCN8CxGu0ibD1WjHonG0qt+vaCy8" +
+	"TfArnHyY3VTIvZyKxoHn7lM0mhPr9CnEsZc0deQNOxSNAqZef6KxwDXKnxDhzEGDmsydTXUmGkfFRvoSjebW5vgSIk4ym0F7" +
+	"6kg0CtAaP6LR5KzlR4jjbIP6o9FNHestOBGNo2OTfIhGU/PWfAgRX91QeZN5vYkOROP0TuP8h8biphn+g4iPbNQBlTSPf+pz" +
+	"HhqFg7p8h0bL70b7Ds650kQ4PR2HxtHV5Tc0emb78BvE8XZoMqfW7TQ0bt769BkazYO+fYbTWCE86/AZ0lEO6lS/z9A0iOty" +
+	"GIb6Andw4/wFnNY4eGvRq8kWt7uz0Di94e4rNJrB6vYVBBSKYpAWtUQI0vwhDdIa4xx0hyl4PV6H99frDzB/2a/bbzKC0KI+" +
+	"F4DzGou14ZHUafDXNa46bPx6BlinWV9rvB0aHm+jDfk68Vq/7V4PHA2a6z54IalBiLwM9DqH7W6T18cN7ma4N37zGhxNw0Z3" +
+	"XQOs186uZ8B1mdbeuExBJQ2OvsmGdJ3YbqTtXB+3NNJc9qaRvjE0osZxXYOvsYfrQ7vDBEaCV/+JqBPq3+AYbsHorRPnTbJz" +
+	"68N8c0zbWvyWi7o1iIWmGrN1ytTG2a/1wNxok7UWvZNQa5TXSHrXZ6TWBVtddml9MqwBU7TWnElscPyexmeddHDZm/XNHJeJ" +
+	"WWscrRvmmLqNyjrnhU87sj5e8GU6evB3GIpDKaiNa7RsfSZkHZA4x5vq02bEW7yhcIyXyfdpJrKTfYSQa+G3YcvK3QisUz66" +
+	"2331EdrD1KvZ0+JPLVQEci3I/UEO2roGwx5YCI/g/YvwfvzkYrwfdmJvo5lPxx3wL7gDyPAv8CzI2Dt9ocGNdjqUirJRLons" +
+	"8haz4Nzua8pQG8yZGqVC4pI/EsGoUDfCAGW/MbXblKa+Kzhrc2HyFNgzJaloc+uQJaq0Bwsy7ZH1GqW2rlWnWQgsvC8zfo18" +
+	"8bgffhi3WL4mPvOe4kDgX6nqKrnWOFtVhKnPLUJUlyHbJPjqMG6bDmhjbF4R7hG3CHdjDeKm0bl+I7np+GiK7ezk8U63hhlP" +
+	"w7pp4Lsb283gct82uAhX+a1B1aCB3iRA6zXamw54w7a8iIMuaPwtcn0TDf2mUb+Rxn8zZkGTfQIRXwW3zDPEYWgSEmqciGaw" +
+	"QW3fQoSjIxqFZtwaLM13PJrGA01yRprBCbfoo4j4pLuebmkeNc2BaZoubZxT03TcNcfXEfHVCfVF5X8H/9XjCDUJR3U5R83Q" +
+	"OY33mZxz8Zbw4OFQNY0vXE5WMySLL99LhKfvLc6EOh2zpskNn85aM3i8AR9OhLkb6nererRux69pRK3y6QY2A/BTPv3D2vS+" +
+	"RQ/IzVFsml50dx6bwcAePiXn5lPKUSyNY7BaNWtVs1YtK+dBrufloK/bvxyIP/+x7OcfB/yIP+2/BZbjj/oRX7M//gjuxpVe" +
+	"I+cm4XdO4rdPngTTSfgNT07AX+M+X34JuyEuge3r0+8EtKl6nPC9MAkFICSXIq0GyejPTfAxdIce8CFuiQ/iQ9jI/AAzmBD7" +
+	"NbwKr7bfYAIRYlzfEn8Z8eKnnEwKbu2wL0I0bMCT8UXIqXrIu9EQeB7aQg68cM1+E3Pwu3cXbn0EoFCE3FsG8Q/hO2zEh/Ah" +
+	"nAAfQXfoDh/bPoJouAdPwheZH8SGYLrYcNU9zv6QV9tKn21zMml9zePTdXdAAfLsQ+2J4XqxPRs2QpQTc/gSnuiNfpiOX8aF" +
+	"zt5wu9rwuLcPSCbV04daPbEJkINPky5go3cneC6wYGOEa7gdPk368+hD7tVHmqyuHupqnf/d1TCqzU+uMXvw0wv4NOTgi3gy" +
+	"bODae9MmxGu8zF+e9EF+aEX1OOEvSSmSIBkKRzqUiExoAbm5EWVaMlQKIc5K9AJ1r+MVQpwh05KhdpU461gcb4SMXNYqV0ty" +
+	"OXOmwWgw5gJ1yoNBqVBLiNQyxEmIpDJazJlOjaMmp+eUihhWbbTKY9jQDLXDgSc1gxkJL9FLBPIzGOIMRt6oN6QCb9SnsKQ5" +
+	"h/pitmT3zs7uPVaaEBjSEpZk927btrciJCFQmoAfUEQrFNHn5OTnhqHFLcP9mODZAeZRo0vS2mYktsnv379Dl+k50hkKMDxg" +
+	"AOUMadsZXTr071+QnZiek95tzChzQGUI4xfW0rSiyHh00Z1b+qwpmrmiB8zkcBwwHLyhwHnRD371YDTOU8DrLANYy8HMHitm" +
+	"Fq7us+XORU8b2NbrR49eP3pxTlhobphsBn34THywx1mMRotxEP15IT5rUL9caCNUxXYdP31j184PWk0Jurv69xw7fld5QZ6h" +
+	"d5/IYJYNjuzT25BXUL5r/Nie/e/SJZisD3buunH6+K6xVQJ+NbffoCyBEZadfGb1ymn7Xp/w+o75pogiHcTG7m+3b+LKlRP3" +
+	"tdsfGwu6ogjT/B2vT3h937SVq585uQwhFIAKq8f5FQorkT9S0GhpAkpCa9Em9DCygwQhIPQ1EWIbCeEd9rFJqat5AVa1xGgF" +
+	"pc6sVwv0NYnPkHOSxKI2WlXqXM6qMmXkclbCF8YUThcXzISAIEkBjSAxpEIKq4sLZiWUK2J4U4Y1hlNb9DX9gYT2kGk1Goy6" +
+	"uBTBmALmzFywEoaJYdSqWLJ+rlGr1OTEJksYI4YhSj5YkghCCDiPnRKGiyPsSf8DQWMUwwmsJrm1NSW5tTVS2jIoRKlIGz6c" +
+	"CUmxWlOSra2r3ko5ZMoysFnDsX/brBA+M2X7nYNzp2ebuJCsbJWUiwoI9YfOuugMbaIiLSCpVSoTF2k0SkOiNcEyWVYP6xX8" +
+	"xS/WHq1lspDY6BCZwRgZx6S2SmqRrmilNUXrOoF/aGAkL2WOyRKCQpRyeG6TIct0qKpnQGhmsH+M2Zgani7L8s/OjE/QJM9p" +
+	"E5mcrFZqQ7Lj0++FORsz4ttItUpVckpE9uwUjVGf2dYvS5YRlmawRPuHmOSBgbGlforo7PQ5B+emtY2W+5VqApiv++FP+oVE" +
+	"ZaypmMq8N2nxpEmLJ0XmK2Wx0eGh596HVWIJczT5k+Nh99q7vf/+xpnxRbLwLtnWOL+gouwu4bKi+JlBIfIx5n7djIqAQI0s" +
+	"vUWrrtaUTCYhQicPjpOFh5fcNXLixJF3lYSHy+KC5bqIBCYzxdq1VYt0mSYwQGHs1s88Rh5S4uiT2fzcvWHHP6n6pVVSj887" +
+	"+ftFBiWFZPm3Gd8rc4g5LqN3qDEs3KjsFR1ZUhIZ3UtpDA8zhvbOiDMPyew1vo1/VkhSUKSff6fPeyS1Sku4o6AorWOKITLS" +
+	"kNIxrajgjgR8QaWKVxoNyA9ZqsdJSiQKKukSUAbKRyVoATqGEBC+pP/UxAsUdHGgFuKMVlUGK9S416YMlYSILHUuWA18LjjN" +
+	"LvK+xvHWxQk8EW+M0WAUJMSTJL+DWaVCrVfzKvGHSmO1WPVWnv4wmDNzWaslD9QxoLYQ+ZdHjhvHcEoFYXhipBoZiaDVGA2s" +
+	"xGg1GCVGZljv3F6ZXbuYe+b1GrqoMCe3YDGsbj1p/6TWPSt/MHSKjs9LD01NbJNXVqxN5A25MgWs5gbmtW2VJk/Pi4/uNDEg" +
+	"LDE+mA0INLaB8VJ/fwUXZvhk5dhVa8au+NQQxsv9W8hA2wJ/GagECMP2Qfc+As8+snEQxmoAeTC+4F9VHCLIAqI4ZVywZeGl" +
+	"PoPPDO5zaaElOE7JRQXIhJCh/sOCooerh4898DK2Y9vp/eOGhQ2LDB7mD6uHtUtKajdsRFQU7K7MyalMAaFrVIAuPrlDWtJQ" +
+	"Q1p8cIgkUy+LLhyalNYhOV4XEMWxqrCo4EA2+/HF01NG5c4M3/F4m5eVypfbPL4jclr+yJTpixMLktq3m50759tZS5bM+nZO" +
+	"7uysrkkFOfPWZY2blb8xbMbqNv6qFUODgoauUPm3WT0jbGP+rHFZ6+aFFAT37JeSm4L/WLtt22oISMlN6dkvuIDKwk3V4yTP" +
+	"SQKoToxGepRO97mNQQ+iveLded6akY0T+YNwA3LoQjZT5A/CHeCqSTnNQtnMEKqhHJPLONhGkgKinIsBNWGzUA2nVqlFT4UK" +
+	"rUQgPyWCxpgCVAQaRGeAOAAkUwRhNqprVWpVNlisBj0JETllMA0YMm92HtO585jFivRgRUawKkEbqUrQwkedxnbuPPY7VZQ2" +
+	"gfwDRpERosiwm4bMeLbfTvOQB36f/v482Hjf5C6REVElPWe0GxkVPrLdjJ4lURFRXac8B+0hrP/gEFVExSJJYHjM7B6SoEzT" +
+	"5mFLsX3psM2mzCBJj8rY8EDJ4iER6uAh/V5URgfK440SZV6UjpVYUrnvgtMsEi4uqp1CYoyXB0ZxftN79Zrea0y6PDhdHox7" +
+	"KTXxseQfE0jLMa+MjdcoY+NhvFjDvrvPrv5d+3wwDX/x1uZjy+5LShucvnLs5PZmc/vJY1emD05Lum/Zsc0C2jXv7oeEXcuT" +
+	"LGV5XcbOnTLpSWvXrtYnJ02ZO7ZLXpklafku4aG759nPKCUhvdrEmB5IVS6f26+iot/c5crUB0wxbXqFSBDyd7P3VHRNMRNl" +
+	"oVxUQE8eOL1KXZxBn2lx/sm7m2tuf8u1UqRBvMUq07EynUylt1h1vFmnYgo6mTM7d840d2L8Oo/p0iXT3Mn2urf9O9vbqvyT" +
+	"G4KfxWn4GDOxR9G9c+5tDx/mhw+0jysLZ6uze/bMbtO7dxvH7089TWV82suUHocxvh/GfRnQCvT4MRiIP221qdeIY8dGecKv" +
+	"pn6pGbVBeaiQ7mSqA1CguNBlGuIEpdbtb0kdCIDQBqFly+cfmj/H2sVqnzb/4PzZ1q7WN+qEn/mhfngZ1apXXlnVe9as3s7f" +
+	"++pEgD/qWz3OTyFMojkWA5HMgYUBaD5CYNapeIuVeNgylZqApacQyuuA38EYComYRoJklkgFRsJoNayBpakmqNrJA4NVrJlp" +
+	"pLomlWgHg9Gg1ZDwfx7QqkaB+b4sgvmoXcRAPGtj0ZvdizbaN3KD8XGcip/t7kASbHH8YWsFn0AJ9ICPmD5mZWRa+yiVtIXh" +
+	"xE/4ID788wmjv1QdVZQWobT0ZubAPRAFMbAewrRxUWppy7l6ZatVr+Gv8KU3V7ZS6uckkLphADuOjerRa4QDXQEEdctFNMJ+" +
+	"b/zudBIgOjJWERarN1hiw7M0+Pv38E0QzoIqJis81mLQx4bLY6KincRTyCNiw7PuTg4OeW3zhQsPvSoNSr47Kzw2UiHGPWvP" +
+	"y7aNmpHyWjNSr1WpVfFWi6952L1Bx3SBkw/Z87ajMb0XwISFvWvPwPqdV/ysiLm5M8rXwtpyL/ic8y4X1TmzGgGgNcNqga+c" +
+	"fPGQYzrZWjUZwKefXtibXV0niRsHoaQWfNkon558VKskCsdM4G8NWh63CYewsPZpYbaYvwluLmlh67jEiEhLks6suUUUuNFY" +
+	"QEEoHOmpL45Ar5aoJXqjkjdajVZoFOty/tCi/eHD7fHv6/EfxU89VYxbNotxOeM7k1atnPRO377vTFq5apL9t6byrQQtrB4n" +
+	"AclwFIJiUStkRp1QP3oO26pSqzgrsYSIPWNw6otUIHauROBrZqve4V/q4gyQGa/VELNYJXPUtxr0FrOjVG3RatTEBiKbeL4d" +
+	"HRG/EMYv1EWMiUvehdN2Jf/uhPj1wHfxb/j3d4PeYFs7Jrb9T+dcx3nwLQyAgfDNpbecuDpziW+/x4mnJz6FltCq7bbXX9u2" +
+	"7bXX4b0OSYY1sNaY1DEr4+hRE/SsQcmF4Kys4Aut3Ob+zF69mfJlJ08uW1xuw486kQhltnLG0rnntGk9O1ve/W3SpCjnfJCk" +
+	"IRYF0xs+k1EWnQ+8RA++caPmjWB1uBZeWCU/waJR62U1iJMxK0HaHv/CCLVwwDzTHv/S3l49OiKesIEPFDqRAYdnQeAsWPeQ" +
+	"E1Vb+dHnJp/HJzyBhp8mnZ9sz+2QZFjrE1OEeZjoVq1+sp/9Fr/nwkvqt0waQrxrXoSgSGREGSgLofog9bI9nAhKBjqR4NuG" +
+	"waplb8AeJ27gNefUgvfqhaeWmbFcxMUIcUrVhsuE8nzA1USZ5wM6YzOEnTdsvzZRuCHkDh+R6VZUSDMN1ANPhtVADBwkMymQ" +
+	"BplNCokqGiy1acrThW/q1mQaDexv3qYgPkbAmTuuLNvQ8jt8bA4gmABo9uSME3XSl1vboVekThMWEhADwPhQZwS8lmkTeBgH" +
+	"zJv4MkS9cX77iOHcJ2DyTW1LYXxCmNpPnsULKaiRtAbiOZpNClWGxax1+xsaT2t2swMZeKQDGfalTSU284s39HhYQ9SugU9N" +
+	"dXcHsk5H0hkqhRDQGQ1mg9ViUqlValOm1aIl5SZH1jeJglYwZlotdeo2LzzxyjCVBZIfYNZZwT+utT463B8CY+3rrLGB4B8e" +
+	"rc+K84fW65gHksGixoUNgn++Fha5F4L1efm5754s6qoMLwKNJj04ukOH6OB0jQaKwpVdi06+m5ufpw/GrerHi32vB2IRQpwb" +
+	"nnTIRG1Up22vU1Kakx85oBMkbhjQe2tLrcP8r3GVug6/79z9952/b3i32sKrpJYuLOViFarX8df40pvKGv/o9PbuI9auHUH+" +
+	"dd/uxdBlnnqOzY4Iv3Ah3HOes46ISUuEwEy2J2qV8gzHqHV1UZeLg4W2PFgw9GnH+L6zfdQgzdjVy5cvX27f5xgPk9UAg7qP" +
+	"MZjaVuniGN30QyMcBEbGVtjea45bAFOXL2dWixrA6QV83BQZKub0J1zjwi353RBW8SG2oiqhsfi8vHw5zG78XBdz3bVE1rp9" +
+	"f14cZ82uEbPW46kRvj5UshW2MdDboX8zoVcmxXpDfj2/fDmTvFw0Q1jG9Qfyc43fDwUiKVJQy9SCCqi3QaN3VoJaud4aBEa9" +
+	"mm0Si/D7s8PSiI+xla1ogd9qD2Zbh/ZgaV/VHK5h9y00d7dYuptx++XLmUevTr42adK1yfiAJx9taBofOTUQ5SN5E3UOmQHp" +
+	"zdExU5cvZ9VNViw+563eCOomztveJlZqsn3ZHBrsmwv3z4XnmjZ1vfEdS7nLKFfXGSNjrXI2zplJlDgxRmeCUTF+S/6UGJjF" +
+	"pb+W2rc2OGe4Mb/2/XVlqr6FKu9gXnhAbKopekKwqp34d2p8gDLvUJ4yZGIUWz4EgofghQ1EBL8hla7jfmOj44bB8ATtkCFZ" +
+	"CXHDhiXEDRkbEzdsWFxC1hBPuaBy+PB1RWBYq9GqVkrUSonRaqyT12oHYJ7tuDj7e4vl++zFHe1+DU8f77gLjs8tmbmmf0pK" +
+	"/zUzS3JB1+DEEdz0dRxqSaVESd2WTW1vo1GA8q+qwyxM8gPM2taMf1yb+Ohwf6ZFrO1IbZ+jsknwMwd82DFQ4uGHYHUTUeIl" +
+	"/0mmo7Z12/WNgr92HOqLptG5lr2OBzUNqhqYAlGYCyaPsTdRULIbakBgZM2RmN+7g8C82GTZWTsm2hrl1jkj61ql0Ju1Sh2F" +
+	"V6sjADdvdYLo8E/mH5wPpuPWrtamrkgMX77cAbVnrLeFw9o0oSwnXO5zr+kg1Uw0u39TYbJ7TKvvGg+RZ2yXnIcl0d26V1PE" +
+	"QCAvhgXBC5ZG2FPMBTEmCJucwGI/d0gaXC85JEYD33EIEIkbLBIPezWUUsdM18aaZUzJHfs5JEQlGsg2oLm3alKd1ybvgrO7" +
+	"UrRjwuMXPv30gvgITtU8u8qub206etTUumOScS1xjBBCbrZlCJJTnUHidu1RKbFaXFqc7s7/mzDC3jExmCh5VfAEiSSya6SE" +
+	"Yf5+FD1M1X1cQpZc2ratlB3xtyFM4mXf1fCLmjfyPjFDI6CNxQ73F4lk4oG10IDb0eAn7tsMbPCjJ52f/IUn6PDTucnn7dea" +
+	"ioAafpGiKLrnykrXkh353jxgqVMg1BsSZr3Z7tta8OCXG5Z11+qPFc/wYkLo6gkpXK5fprDX6ooa2wI9eK+W/aFDmah7PdLS" +
+	"XVXXwijZVlGzwJRHT9c46jZCjt5wxhv/VwulbJ+8zOJox3JTdHtzHtH+DQlWNtSp220ve6KPvWv1sJ7BeVadY60pL6jXsNWk" +
+	"oqc9JqX6g/iyPrinUctIPrgDnmv6WpI3+dm4Jq0juetEokfCaH6cLIcHxVrltaFTq8ievkxrXTqfOk6w+te+v9o21KZWZXY4" +
+	"pBelh1cta8TOA+oxXac/7Bu84NQ5/PWT9VP6b1qvBm0m0iCeBAM1EqF569XcDNt08IfZ0MKGmVnKvAp8dUhuUxesbbu+g2J8" +
+	"fGhF1+kzunrqQXc7IBsVNtcS8AT11s0An2A31xLwBD/YY094OCpBA9EoNAFVoiVoJVqP7kdb0E6EgB62EQ8tgxMuCqUrIqzU" +
+	"yQxGmc4cDHFGndmQaaWPMkEiUykkSp0QZyBVBIlMZ/YIF3uwkcVqFiTyTIPrfI9RUXMEBpQqtZnsIlOSbSZGpUqtFyTkb0Gi" +
+	"VKk57ZjCrkNGdGg/Co9wIYn5yYm5qlVOrKblRGpzIoctNOUYjTExObExRmNOxsLhETlxkTnptadQdaxSGROlVmjsn0ZrNNG/" +
+	"jC7qMGJwt4KxlbGKsMholTIGvtdERZfHxEZpf42JNapsT8ZGqwy/xkZroriIXhNmdOo+ebLte4rtbyjmweokyENLj0N2Sks8" +
+	"aYClQ3y7tlvaQg4H9nHM/cDh0223tG0XX2wdABtbpeBXTizb4mXY7grTasNUOo2tBewMCcUbuk+e3L3TjAm9wjU6VZhWC7Mf" +
+	"gRar5Qkt/K4r/A0xYUu0ye+HR29J1tolAaf9FbX5PsARa0tGZjfZ5fjdyEntklv2JOdfVYXNZG+5m+jC/d0e8prC3zU2m8wx" +
+	"r/NRB6qDa92jxjZ1Rctzlqd100ap5YGBGqk/F6CLMkub4Ub7xATz+vSMkAAuME6hDA6JV0jhhaZ6114S75b2bnhC3Zy9G77J" +
+	"3bTNG7VkOO/a68ajQCRHOWgYQnpRQnn4oH/zPjb+phMY+3X8B0FFjadqW/9371t7i0LNTPfwab/7uzeo1dhoEiR32mjOfcRN" +
+	"1PrMp8tPLV9+Cl9o1jrUwHP333/ufja7aQoeIV++vZ5mZGxAozNOKOsKfjagy4uGFBYOsUsangN1KW+sGNely7gu8FgDILqv" +
+	"C0npKRgdzRlU7LYu5CLZLa0N2UdTCjLhf9/6UIpI1l7NXCKSoNjqtpLhlD/FyEUsGo/uRQgZRIg1jt+poFfzeivvQoQ5BYyM" +
+	"LJOs5odq5Cp1DCilbDBIlDrxCk85CQOTmR0CwRANOrMQZyTvDDSXgTGFbI0PJYIjGvSqDKv4Eb1xjf4SZaKEnOQhlch/Sm5j" +
+	"J4Ii+7pOZNv6zwR7TC5FpT2SItb+RX6Jct4gq3nrT8zDP20xWwfPU3XLn6ObHjEJdJlaIXCIPqOvLmzO8B8nzg3T9c3QDw7i" +
+	"4zJ1zKSI6XGQOP7KsLDEuzJCLFLwaxGQEhySvOh1+6tvLkqWBqWoJEyIJcS0OCmMTeg9o2fPGb3Jz5m9rky6Nvnq1cnXbB9Q" +
+	"OuDKt3LL0kENxt7jW3x5EPzwnwcutBjfG3+Mv08vy33r2/jYSVlXITQqQTfx16lJ+nZRbfOqusZoSqry2ka10ydN/XWiLiEK" +
+	"X7maNSk2/ttPDXrtgOyRpXHyosqQZa0tacEtjq6/cGHd0YDgtEnLQiqL5HGlI7MHIORrf3Y21c1lNMuzm+GndpM5VjdLVO0h" +
+	"i3wbjQ3NaHbNXb0rZt45oO+iMYtKB9w5a0jvJUlOQ3KW0/TDb9ay+kJE4lkbnuoRo+7eWDZ81arh5F/ZxrtHOYzC7g4DLtzL" +
+	"crMnULIwhoakXAsX/jqjUjQYDUOj0Hg0BU1Hs+kOb6f+khHLXKkmlrdO6fonM8lMMqNVbTZoJEqrWkKfPWsYiTVObXg3hMU7" +
+	"ECqXmWRyk9moNpklRplSbTaAWaJkBYnSKjNLjMqaoESbnMg4ns9JzcnJzcnJyenZfvn9eNX9y9v3yqH/y03J5XltVE527aDF" +
+	"USpPZTm/5eTMSWWTUtX2Djm5YaqUJKYmPLF3HV4KvyYljOb8cfLd9H9Ma/whSPMmj51bUTF37OQ8fBVa2l8X38H//LkxCUk4" +
+	"EO5c/4QnhsNF0TuCaW1/HUIOpV4YzsWBbkH8HBWefCH1EOySq2fH408XxXHDRfkbYJJMoGfDndqFZLBAoFWyJqXWLNfpffzl" +
+	"kkVNtDb9P4e+X36Jd174EHf0/uvLD2xfUUwxzTE6JRPG4BvlYyCw3Ov3zQUUIWxR07dY1Yp7JSIrQkDsMLWV2GHGumIfnBM/" +
+	"bnWZ3qWjRjn/jwc0HOwqoJMToO/IkX1Hje47alTf0WzCkKnThkydOmTa1CFTD9Qf8YgSJ+AIty+Qx36oFo4syKiJK/vNCnqw" +
+	"dk/1+HHj5UKAQz+SNf14R8TWY0RkRcZk1ir1RlFTkhtKQUtX8eUSkBlDgRFNXlatrXU2HDrCgpdgoW2km1KzbWbesWcw1bb3" +
+	"Dn773V9PK9605Z1R8PlhT4j7t54If5N9sdZx+ONkP883Tq20HMLw0I0fvwGybfhlaLsN/wJPAXPqwoVTuHqrqDcyq8dJSiUK" +
+	"5IeiUS+0Dm1FT6Cj6Dn0IkLIcSzSSvciEN8uGDRiFgqy09rjXKO4Ua/mbKOvk5CSxhx4FCTOI48Go+PQoz4zBchZch1lC63H" +
+	"E5CmlSpTRh4oLVaBnCOKM6rYu2f0aj1hQNm41utHjV17Fx+R/gc+9adZwy5bP9G+XQKJPD4vCQKlAn8rVZRvJKcc1w9Sh+Cf" +
+	"5CANkuBPOTAIvk5H3sHVc/qRG8xW+MkHKwbKwsbuE88/PjkmMrivvH+oXxnHXJAnZyfJZ5PltofkSdlJ8jnzD83HozXxYclG" +
+	"bXz03NKU/Ex8hzyrdQpjSyFHJG36Ak14UYdFG9fd2aW3Wl0Ar8UPUxaqOuePzZvQJv3rWUuWzLzY2jwub3RuV2Whslemj1OS" +
+	"z3drX99pyKJukiJJab/4dnFt22L7anIekm1n0bSL614hKeqZOLB9+wFJq19+eXXNX5iVBEhabOC5JcONxS2GDvUvJDxUWj3O" +
+	"Ty5RoDzUEQ1Cu9HT6GX0DjqHPkEI3BhBU3Milnh9JA+N0Sf/kIlPnESuXgbkqV2SB5mUTxpkLWf6wFSgLObgMAGazFzCDcbf" +
+	"X8GGGT5eNQZ/O/buzwzhnNzfH1Qd5xhDUhISw+lZTPytTF5+7yNwbNeG8rBg/KMCQkIk4YkJqcGt7uxkj5zR2zqhf/m41utH" +
+	"j1m7hI9M/x2f+tMSyyxfP8H2gTajdWpPSTk3kJdIA6I5ZVyQZdHFvoPfGiJym4IcOhe4wdwQge2ZYknTGlOlQcqEMU+ett98" +
+	"+ckxhrBgDbNZkdg2OXQOYbYt8qRs8U/CbGrCbFFzS1P/P/b+Ba7qKnv8xt9rfy7ngJYgmOQVFUHNCyoqKikpKal5Sw2REq9Z" +
+	"mVFhWlnjBS+VFTVkSpSMmUJpWUNFRWXGmJWZU9owZZOVUzaZOWXlJJzzf+19zkFAbZrnN8/3+f1/z6OvtfbnrL332mvtvT/r" +
+	"s/aVi5J8NzZN7t/DWtSp65nO13ZJ6Hp+11W9UzpePqphf0vL7pTSe1XX858+Yze9qG/HrkmRra0RnjT3N3S/pPNbRyZ17dg3" +
+	"pXeXOdNDnfCKnG7XS+Vv7ID1bVgXplLEE5TxBnv/nQ0zUW2kl+ly/5MGzbjiwSPm/U6dLQ8eKk/oGzh0Xvds+a+ZtFlXXds/" +
+	"ucfWD57odlHS1dUf/j9i3+TZySnJE9wL0trEpfT2HAocP49Sz3p6p7Rrd3EXT7dOfVMmFzSK6RwfOH9+FiM3p23YxaMuvThc" +
+	"jf4fNXbrh029fODFMY3aNb/kgthzznF7tGvSrXXzdo3PHzqkS/zUW63oZuc3bmT1wa13l8AIlrGWrb92j0DH/9uvBJDgtSnB" +
+	"7fSBS6E69gpsTOvdq3a/WlLfX7s8QM3/n7wOQK0LS+rcOVlv1u+qBnTRG/jv6JSkd/P3u6B/YId/v9OvDEj+H7kJQB5MHHJh" +
+	"zxEjXn3vwiFd0oe3jLhxSGJEy+HpXYZc+N6rI0b0vBC0XxjqB5aZOWlDJ/qRxnyeMvdKOJ5uehE+6n/v2ySkdpN+6EzYc3pR" +
+	"/6r/P7hQwrrr+cVx589pm/jY1+bk2bx5+6/3Xfi/480Svt/d2+mC9L59ngusE4X6zbnEkEASV1PCc7wK8t/sI+fV9pLgl2yw" +
+	"BPsJ9XqG28yt7Ry136nQZ6pffELdPhVVd1ukCn1Xrej/Ri9RHm/dfqJC3yzrVE/xhdfvG6Iah3qHdep7ZAX7x7TGVt1+9ftT" +
+	"mzLDF4xLvjZzyjXJVslv7SfLbjpTP2kSt/SiUE9xAl8oJ9hXrKj6ncPTynPX5aH+4Q19fbzBHtIke0mXel3r5IALRy+4Z3L3" +
+	"bpPvWTD6QvnIfClpdFrfmcWjbOJpyqlg+3+5B/0XrUzfXs098e09HfvGNjdlNA31HW0iOzqejlb0/8aWRv0weNvgSS1HtfQ9" +
+	"95i8/9ikxc+XLTkZ6kNSafaZbTDW0yr538vwRM2YMTUl5cfnnsu+9175xXQideG8/dfv23f9fsCp7U+xdCWJmdxr5oo9gUWP" +
+	"Pkl9Ah+vfnq01l3OMMFmmRaN9Uh8RN/B0rBTtJU2UqdTEEuoRzhN2/WKq+tZ95BTA8Qm4tbpfhtuGtZar53MePqa5PELxnX3" +
+	"nNP64tx603KfBlrFt2aJr3GT1lFNT2vfpglhp1rXd0/Nv3z3hdpWWu6Qpz6RvnW6ibSLiGl5bsJHd13tO2y6VpNWdTqWveru" +
+	"snMH67WXrpbV58KF3bvPiGk9+NyyenN61R8FqnznInErr5nWsNmm7S7eVafNfPukS22bfelrczLU7hcsHbfqnqem1/aRCSXz" +
+	"rg31kMB+6FD7JXETt7GMldxdf8T9f/cnwTq1f0WbHBpJvyjj9dz0/4iVv6XeZpW8vFcqpNGK0BYK9dH/uBkfPm1k7vyRX321" +
+	"8THfS3KH2XExVe++ONvZ9jq72/Ti11n3sdeZKe1jluJD2/e6tZ/TosNiX8Fv3Nx52uF226P3tfXqr/e13Rvf9X3fG75U2S4X" +
+	"Soq84hvqq5Rc1bjmuG+17+6af6om0wdcNv7mceMiA0voSM1T9lHfT+5sLJB2Voean/E/tM2d7VuFqmnu4B/j/GT8ZaR3sw4d" +
+	"ezfrUBPzwQcyY98+56effvoJCPDwZ5t0SDvp4JuG3+ff5vzkO2Hiq3+xj/qvc07QAYInptzW0iGpb2CJStscvabVO9oNzPb4" +
+	"+vSy3M7nt467XWWPbj+kQ5+oDk3adbhdpo1uP9hp20HZLVJ6uU60c83S82MSzm2S0t+Ndq5Zcl5AFtc+6isPyhLVQXzOH5/x" +
+	"o0URr5HlU/uof6XzE66WNapDgqdDTak021X8xz8W61R/+EODNFbv5v16WzWlb+kUu+yj4t2wIahXjW0f9U93fuIcXTtGiX69" +
+	"zU3NNc6SppenXTwpakmTh5cvf9j56cKUlAuXrFyJ1Lzg4Hs6KJ+uzfKffnIC9aiqf7KP+v9VW9/tpIP+Myi+X0xtrvf5eSag" +
+	"h+8Eqvo6+6g/pzZtVAetjNTMfXabHzX22W0+fyhtQG/0v5Ws0eX26d2sw8qVa9boMo0icwzdA/16N+uQ0LtZh89WrvxMpzBp" +
+	"rEAayQ/ukyKqd7MOzYMpc1atusEklvw1M2vT+91ano1Ap41qmH7mGslfE5LB7wZl80Cz3npxTksYTBRYe6jPs0mAq07nCYaf" +
+	"6Qyf1eYyCGG+/0fPs+oR2kNH12M7bkKcFd+vXe0faOjXN6HdefrHQIns0N75NuEe3y++pz+4wVclMkCGdH/iopEvrv6464C2" +
+	"MqRJYvfsofN/2JDU+VIlrS+V2LuyrsycMuT8HpdMuuGqkd1+f+nFfsDv9x8F1UJlRLjRl4Z+W5b5PdroO9//J+8m6yBdzV85" +
+	"CpScoCc2HbuudLH9gtdHn9qp5dS78d5z2w33dcl+6r7+fVIza37n+33uKaEPS98BjcLvaNZ1zhV5z/5udI9Y3zVDu53X5YKY" +
+	"mAubS5Mmfpo4Ya2TlbQeKedoNYYNOT+88TnFT1110YrUAUM6zx/38LL4qTeOSGnTpfPoWYPN2XGl9bA7WgdpQvfANysh3lP7" +
+	"ty6sdpHtdH26CaG/cNC3X99T+8vUtnbdzk/o0qJ5bFTX7pk1zyq3b7sR0XLdB5dkeM5fNv2u96em92szu8viTDWmVfzA1hHS" +
+	"ukXPTq2b1rwphEVf7MrwCXlJXf+Q3EVkQLeNk7c+VDykd1xQJuci6yCxXADEBgusrVNXXwMf2btXv4BIEvp7C82i5e8y644x" +
+	"PWLXrNl2wbSt9z2TNeWZ+57K7vLMmjWxPUZLz0VdzlvQvLN10NTFHUuj/TSJ6tUrqokQvfSOh8fN7+xTSXNG92vTpt/oObpN" +
+	"s32N3XxVwLmmTeMT9E5nN9CvksRsqugYlxDfr2/drtc+Ib5+VTXvHWk/4nZp0SG+Rcu27dpeJ/2bDp70SLWvyleSO8rj6SBD" +
+	"EsP7rju6dlrz6MlvXRaouAMTx/Zo6tkoF3dOiBFp3aZVt6ioBF/VvNnLBs2wrVduW7iwW7fUAa0enJ46XjnjTtViv8L+4071" +
+	"V92uEW7z9qHfuk4j3OYdflN/BuRueSFwT3RHXcF6J0KvfoP0pv32CfFJ+lSDRDdvPqRbtyHNm9+cPGBT8oAByZsGHIhrcX6v" +
+	"jvG9Wpy/pDw1NTW1PHXwYL+f+b4Nnmet8REJzc9XL6mKiPjmbSDC07yzKvFt0OX79qlB1uGIhOad+aNvn05HpTU+wm3eNiCf" +
+	"37WsULzfrRMfq+MDNsFeFZHQvKV6yfo+wm3eJpAPVDenjaZTaa86xU/rW48e4HOPr7Ez1NkdkeDcTqk/OcKNXltbnyudOYb+" +
+	"oW9fhBu9rrZeh9ajF9bh82VEQvNWqrsztgGfpYY+xT7SgE9dekM+LWSHPekMfFrId9b3Z+ATotfhY/8hIqF5jOplN4qI13wi" +
+	"PM1bqn6kmPpvbK+0LwzEW5t1vcgOv3uKr8l/pvjCwLfISbEqPMOxIEz6iFVRnWZVuA/6Nj8rk+t+VxuB1UHvOvJ0GCSx5z26" +
+	"JGrSnZc3XXLuwxL+sH209pvKS75hzmhnP00hqp1Etqvzxx8kst1Lkqw6SPKMaS9WP5iVVvO1b5ezvzrFqvxl8Ke33PxN1kpr" +
+	"W3WK6cIUgnWZna3LlXb6mxLZLrJDn94yRYaWlfle2b7dzvbF7PCNkOd3AMq305em5tvZnEc7XXJk72ZuB2NwopvpTeBxUU07" +
+	"9va0T2jvcc3Fng/LkId6zTl37LQXZzc/r7Ef25JmFc7t/a9UMjFhSodmdnZ14vvy6IybRZS0kJYSJR/IqP6e7q38fsl0M6wH" +
+	"vOMjEriBUjUowtP8XHuoZEBt3OSIBE1TMyI8Oo1QJ06/S810+kCcGlQb9453fkRC8yb2Nep39Xg2M/l0XKTdpEFcpjszGNfE" +
+	"Oql+F+ApjRvERdp3N4gb5F5kPWNkibDT5LJ6srR3M6y3vTdHJDSPsoc2KC+Qb/KpfAE9g/mmW297rwvopxZEeHR+ya2TL6Cf" +
+	"a3ia/MF8M+y/e6+PSGje1B4q8yM8zZs4y9UttXFz6sVFOttOxVlve1fXiYuw01RBsLxB1jMmLtoeKmPrxQ13BwXrOhRn6tzE" +
+	"dXUHWY/Xi4u049XvavM97j0ZkaBesi9SKRGeQJpaOb/2vqbjnLeUfkONTDqueoN91P+4Oy8iQZX7m5IZ4apnQ35g0JcjUu92" +
+	"mONbK/m+nECc/wuri7OfJtAnMuCUR7aLjNA++ZAHR02+TDL3fDvx5RFyY0ZW7u+sj6rDfd99k+T3+//oa+xcp+IjEpzF6q5/" +
+	"tYlwm0/W/DTd/kXF08L4Xq7HbRdpFugS4hPanRe4+DayQ3vrvuj2N+16zzdLzlt9V/pFIt1lSLi3bVKni575fHSLcf1fmT5L" +
+	"SBt63awX7r+lfeztA7v6tY1a4vubu9hTqcu0ukp+hBu9r9aG3xeg+5809P2nbHg9+oeBb4GvxjPFekLT+bDm5gg3+stAet8W" +
+	"dZ+dGkjvaxwRr799ER79JfFtCcRbVr340fXite732DfqeLF8gyLc6I0hG6OWu9HGj24XGX3eQGmmN+s9LEPSsjzzXtzhRp98" +
+	"aWXWN99+5vezAOwcdTTCjT5oZM3xf+HMcL6LcOWQ+T3G/4V9vXNBhCt/N78LfderRXZ2RDw/QISrHtlp/DZagppsZwfHClbv" +
+	"qIR2VjvpW7ih8Be59Q3fCutwdb6Vs/BkMcp3wjdMTbGz8RKNOaPZL6qD5Ua1s/SVxv30ECfxsuSJbZ/c8Hy7xXLr9oRvX/yw" +
+	"WXLWZe19f4roP/EZa9/JY685w0+WHTx6U9YbWibx5WgbGZGgtvnnIRGuls3vZ53vUvmkVlaP2meFGVlLZbgU2/cEZI0K+val" +
+	"P//888/2PT/5Nv0Ews1gDXT2mzrUJjqyd2S7yN6RC9Qbzz9fc2GZ/ax08H1SfYPvE+lg3YfIUrDv5BgWdGzWro+9qvqXY8eO" +
+	"GfvIBvsd9bP3+4h451Yth5MLKiNED5tVS59fS39J/ew9HBGvU0Z4dKwcr+UTW5t+wVn4LKzHR6dfUMunq9/PTnuP2uepiIh3" +
+	"bg/xkeMhepjU0hdqerA/3eXsx4FALTwsQ7Y6+09e4Devuv9oMN4DoXpqulWGbBXsYCKTRj4J8Aik0Sl0gl8uMG2i+5myXsRD" +
+	"M0BPIybFt49yO7RXEU1jmzaL9vSOlPVJI5KSRrw8bOy2f/1Lsv/VIU8N/cfdd//j7pperaWZbJFmvm8+vDHLHxhH1PI7/xQ/" +
+	"aee2T2gX2Tu+T1KQaZCn6uFbPax4mG91XoeGnFsLWTd+GORNiLf1h4aySohthOrQPiHI19cywPBf28YOq2VZy9A3wfdNa0S/" +
+	"O1a1s59waBcp7VqKcTDkMt9b0vexx6Sf702ZrfKscb4w+bn6ad8iU+2BOjX5Gus6rZOzqcyqm1WQhpkDed8LlFknb/2cdfLV" +
+	"LArIaVdaRXihXSNpJ+2kd6R12S8nfa/KapkubR9WQ2petYpqZp4qw6QPD6SP7H0qR36RtKnNIqhgpkCehwJldDAZ2jWSpoHE" +
+	"q32v/HJSp62eqXPV+lNOY6uIsEDfbBfqnzJYhm7Vsqh68pi0jUJ9sF1tXzXJA3LUl2VqgPep9KHUocTVM39FDrWk5ndW5VZB" +
+	"ioTT5AgL1H1QCqv7WdIaGTwgwbT21JP3mcRF1TMDqVFMA3uyc4xGtAWi20ivQWYLkyvtIvW2+qRB0quNnsV3PZHt5Jm2SYOT" +
+	"2mqkVtV0aRd71S23vPfj3gUL5sS2lwL7nsyR3bqN1OhkJ/WPxhF9y6vK584tryrvG9nId96pcXqgvHYNyjMyNiyyXokn10hB" +
+	"vTLrlFjdyY8gZyjVlGl9eUYdf71EX5uzl3ey0+ml1QR0DLSpMwlHt5S0i4zqbendm1a4b8/u7ZlKnbzPvkH9VVpLtq+4OlxC" +
+	"9fKreX753Glzhjz21LPmkYlnKIcx4D7oHKMZsdpqmGFEez3279sutmlr/bJFBv7Yjtmwb5f87Pvo93/dZ9sjxkm4dCxQ/bae" +
+	"/KnHzD4de1+tinx/kc7HKjfcstx3cM2juudZRfPuuH3BoHEzatvalNWc9oEe+29Ka2q/dPbidI/91QIDdXg4pNuZy/s3pVU/" +
+	"dpbCqmfW0828t3ajwHc+9N7q/w+rHb7Fsti3WHVz9vvxvenHN7OO3TV5zqlrFwK24VQ2/W2rlzFgc68MlFU336lcp/LUzKwv" +
+	"26kyekeqlsEydmxVRYL0F4KfYpSvhc7juQQ4V498I+tm9LWUD1S32sye9BPlAftRy6KejJ3r5LauqL7/VNZTuULvib3GfP+j" +
+	"QDpEmj/FqzMH/lSFlnho6o1Z03znydCvlhxtt0wV+WbmPH9zzUxnf82bX311wxX+2vdgjanXZrWeRJBDiGvTrUEWNcsNS11j" +
+	"IRZBrqfsUx8jU3RdmWp5qg5BoWquDrB8/alaqUJMt/u+r7XtbmDs307/6SLp0y6yXZQ5pdS8XaQadX6jZN+T1is121bc3aFT" +
+	"E8eRVf2enLbC6v1XP773fW/mLht6fss6fSfEq0Nku4a8mqqLmiQ24CVcfuNZedmTnf1EnM6rWUNOrRuyuesJCPoeFzv7zTc9" +
+	"UiIDr/JE35uSLEO32o/6npduMqFO37i4js8QSN1UrgomF6y6GWp9w7UB/rV5anOcyvDLBVi6nj3X20OI4Hzi9PsY2A8Z2Mim" +
+	"5Qq885HtOgYnqFtLu4e//n5VVqfuG9Z8enKrL7LjTWMvkZ4197S9cODC6HMkUY5++PNN3a6tOLbQF+9Htb9xR8XNvs+/W9x2" +
+	"4H1TZ9wBlv9osMymtCTe9Lo+v6HUplt/vVh+S7nujlO6nlbuvyvV9+qvFFoTf3qRwbYuD9itdpF1/0sv3z/kPBminVIZaqUJ" +
+	"ennQT01FbbuXB8bsHSLr52wqaXWzCqp+5lq7d1fIXp49d5281RXBMaXrOPuJpFl9a2T+G4LrnOwWZNE4JETNbb7jIR1Coqi0" +
+	"morab5nmGUXz07VpZ3poXbnqMT0l4SkNhSDr4HfrLyF55Qy869R0kPHJrtYDpzOurgiyVbV+pRX0DYyD3ztSPpHBvtd9rwYd" +
+	"xpqZtW53yAcxeRrVzdMumMvq8cvnW61KnU9/mgKObK0fUr+sU7l8kcE82j4G3d5AG9mVzn6UfsOlXZSlXfZIq7lv2WvP+HLt" +
+	"W33lcrO9v/oDX0ZowBgoJ5An3OSRyHanctU8KDf7yutmPDWMDOZ9qE55gbz1c+aeyheyK+5GZz8WjbW8+u+xiDngeIGY5m7X" +
+	"y24WYV+gH1XJO2rUzNk3XJFa/bw6Rz7dKuf6/rbuYfVCoLZU0fjRw8f73tRuhOq/4RdfTaH5ugfe51AZrU8ro/dZSwnb+qvF" +
+	"6KquWxChsoacVZ96ZZ2tpF8pp2Zm8JsX/BYHvct2wZG92vy271UZ8rYM2Wql+X6yon0/yf317L295pStqJuzqXqxNqvu3w0y" +
+	"B+zE16fsxKm8dXLWzWimDgK+sNPFfOtjQHpHdmjvRp8XFWkOqDbtHXr11Jy01BuzsksOL/5ux1Z1gUT5vpVUq78U5Tx/c/Wb" +
+	"qv9XX22Xc2tmBvyv2vcowLfFKb4S2e4snGuOqfIzM9e+UkP2wb5c3VDu0/ifmfuZede8WdfOh0F9y2Ndp02ZvV/wU31BPdve" +
+	"qL41NBYwkFywTmWoZ8/D6uY5lSOY/uQFp9uHRhIWmANo/uprvqVm1iD9RsHqVv2Bn6CBaGgfGolE9j6Va8INckm9bHXMw2n2" +
+	"IZS3bs7VdTP+ckGg/xhf0dxUHhxJ6llJ7U5FmknkwB+KbNYust6oM6dVx6tuueWqlvG+f6iM9m2vHpiS0ndehzaytOba2rGn" +
+	"5IZF6bFl33PPnS/47m4c0W9KVlXWZYOjvbl+rOa1A9E6Pqv5O8gdItv1+a2SNJWlv0UU/jNZrH2hOvlVWX6bJL5vf5McvtV1" +
+	"xahjh8Lq2iFjg6zRW+39vp9UeF37Ya851ZdDqQPWwxqt/dM6GU7Zm7C66UOpQ4mNkQnKYW8KjMVOzdoEraFqvFUVBcyS+QwH" +
+	"ZbE31bdlda2gamy+3HUynZIpUE7dfKdyncpUPbPO2CtMl3Rq5kl/Ex7Wm7Ks0Vt9kW9bRaEhXsDwU2+81Shgrevnbrr1VHb9" +
+	"dTiNgapTf/VGwnVz+16tzfzLBXXKrn3nGpFQfy7HOvX+ndbnZX1tJxod6mYyJNDJ+l+ru3ud3lP9aJ13T+bXdrOMAaa713nf" +
+	"GtG5vgxSt7//mhS+RFn6WwThVyWhzvt2Wn38iiy/RZLfKodvdf12MTaooS1s1+y3WUNrnu8fv8ke+pl/diNUbxxvzoKfbod+" +
+	"TaJ6tujXROI/l8naF6qjfyPTb5XI9+1vk6eegfT7axKtT/y93OYRHlVUPctXHVj3UiucA87+pglNpoNaEWFC6+0Ijw6dc2rT" +
+	"fNIgjVhvRXiazKiXpj6fGWC9dRqfM6c5E58ZdeQ5G5+GaX6Nz4zfwKeBPIhq4l7uHPAMN6MjT4eEDkFwPp7bt+8rfQ3M9Qw/" +
+	"tnDhwoXHQCj1TXJfcPZzrhmFirFxBnVwrvCV+5r7bpdL5LAsk0zfyMM21Tj7q7E5Odr3iW8SQqFvkrPBzm6Yv5l0sKf6Knzv" +
+	"+Z6RIdJbxshQ33VValzN03Z2zRY1ofp2nR0h3zfMXmFn0xE6mr2ffSLbNYtW5s9i166g0659fEJwRd0aeslj0596QdLUOU2i" +
+	"UzvPukmG+N71fTJ12+SJG8atf3DD9CuOfqG25Ezxfdt95YKWHeK3rFOdqyN93/u+iG0nzfv2KdwYWAv25dsDrfER8dHFgb1R" +
+	"0tuXb+gb6tFjpMy3IUR3fg7SEwL0CI8zyu/zuzre/5k90FlYm6+lNLc/r6U/UUtvLc2d6ND6tl7zb95ZbfM1Dqxv16PHWCm+" +
+	"9LPSE5rHUE2n+vHW82bPVysrtj7d3haR0Ly1amW3CNGZ72scnmo9HxEfXRLQxxlmxWp9nXeDe8Q2uLdbhyPio0sD8VYXa7yO" +
+	"t54JxXtmm/gnzhQf4K+OBvl7mrd0svxuYG+Te7s6GuTrad7SSg3RPbPV0SC/WnqAj72tVs4W9odWbEN+9rYgv4TmLdRLVuyp" +
+	"/AG+9rYg39PiNf+wv6u/R8RHbwvsP3CyavWfZujPBOhqda3eFxj6s3XpAT72tlo+LexFVqzf7Jtwp9nbavm0kKoQ3XOBva2W" +
+	"T5DOEvDscqMj4qPLA3Sr0Bof5DPK0F8M0NXWEN1znqG/VJfO1b7Gts8ZFhEfvT3I/w7r+UB6u5ebGhEf/XqIbm8L0juY9DuC" +
+	"9PHW84G9hHqvbUR883aB98HKC+0hNHse45u3D9BVL9++AN3sfUwIUEJ7IAN8nOggH93f3rXvaMjPiQ7yM7kD8XX5mvgOZ4pn" +
+	"fk1JPTlbWtEBvjUf1ZOzpewI8Kv5KCinodTKafah2hIR3zwuoJfdyLo2tMfTcUzJrezr6+/1DNIn2Nc34HOylo9ux1o+7giz" +
+	"Z3CL/VJ9PkH6F4beAcz+62fC+rv6vAtRSaHNr+1i9ZZmvRe3Y1yk2ZfbTlyPe15z57yoNhLYu+vaHdrFJsTN76/3M1/yoGol" +
+	"53S7upGKGHGyxvdtWIdJarHqVb1nVgtv8yutg5+0i+iXfp6/xwW3+v5+suaQ72ie7y8y4O4pV2bOGHB+h/FZkZee+1ZfadSh" +
+	"3YIu0tc3s/egyAlNX+riOz5ikp9Wffu0Ghzy7z3d3GjaGM+hV7++ekGhoYR2ZLTboakz9KomEXJez4Awl6vFqmf1e7NbeM6b" +
+	"ah0U66V9QsL5HcNvDj+91J6+NSW/rx1PeN51o4kLlffrFaJLvbZRk+FnKvNA+4h+6c183btOXCwkJEXf0OSs+rZsGdgDu81z" +
+	"o3nvAu3b2Smubd9EQ/9zgK5urqUPMfT369JZ4t/lvmnoe4Pv0ZfOsFo+N9byiZE450Atnxtr+QTpLKkpqcenZche1XxUT56W" +
+	"Ehfq//XkCdIDenmGR8RHdzpdL0PvfLpeht6lLt3sQa7L55T93FePz/kheXz76vE5v6483qRaPi2sQndjSB5vUi2fFqTV0od4" +
+	"k2r5BOnB/W6PRsRH7wvUm73V4w3tzTb0/cH6vCBEtyxD/7AuPcDHm1TLp4WdHCpXtfEm1fJpwfMhumV5k2r5BOksqT7oLnaj" +
+	"a/m0VNk1HwW+V6qFG13LpyWPhL5jluVG1/JpSbmmc0P1m+49VmREfHRS0C5+Wf2m6UfZ6jaT7xHVwvqrjpE+vkci4vWebKNP" +
+	"H2toIN6y6sXH1o3nhuqn6vHvrLKrn6rHX+8l/2vtXvLT+Ju95H+t3Ut+Gn9fY/ceu1GQv/5+uUG+jVU3lRHYM673Pof2kut6" +
+	"qEePBZQoUMPNHiwzmxLVO8pMf4vqfri7r0LS1IWywXdlzRv2DTLTV4TOwx3+L6znzTxQO3PvWrS5uDFwCWC/yIA3qm/bGSR9" +
+	"OuoVg+fl4gHTB/TqMWl0+pNT5j5/2YEvh03sf2G8XOx7WS62Pu457o6ZA3pn9Rg2Nm2Ib2eXTm++OuPhjAEXjur2hszUsgfL" +
+	"+6Cpp2kGyHxAAHVleebIjxZObZLyI2296H8f7LjgZCg8cWn1U+f2D5uFcOokkoDnOl9rODfvxKX/+uzc/kF67b+Y3vZ4phg1" +
+	"nwC1BKx0UdZ2nna93O4o8j19GegOZ55K4mlrBI9aI/i99QZxrpc3VAXTVQ0zVAUV6g/gKDIcxXuO4n5HcZujGO8o7nUUNwZ/" +
+	"5ziKqeoz9qjPyNU8QmB34w5Pb25xHiDMWUSpk84U5xdK7W8C4BxmiutSqrZq8K9zCii111Dq6UKpprttmOIMC4Z3U+r0IM/+" +
+	"mjCnilLN0/NP+jr30MHp6z/qJDNe66JltkZwu+vlJWsdWOuYYH/MGCeBQjvNhOPtzxljtaODfnZiKVQLNPjfsN8KPHsWs07T" +
+	"7e8D+XQ66x4K7UuYat1AjBNLvv0MLd1NpNobaWk/w3l2ouH1pfoD/9Chqctg3TuKIl1PdgFDHMV5Oo21jsbWOp7x9MZnN+Fe" +
+	"exP3mjw1zNA0HWeto1jTrASmWwmMs9ZJujOKIruAXN0+1jq+sRKYZzfhansTWz25ZAbhCl33pt7PAJ73QLdFqB1CoLYSFWgL" +
+	"lNrKANdL61A7nAZevnTb0Mm0RV3QbTGGUqcbhbrezwSeuxmj28L+vD6oBf6Pgm1xWC2gran/YDs0BNO/vmeYbou6oNvCtLUO" +
+	"HyDMtH3DUOue9ivhL5TqNtf669DUj5bx34S6P+v0Zwt1XTqx/u88ilJnGw+qrbRSCwJ1rRYQq7aSoBZwjmkDXQ/B0JlCqf2d" +
+	"/u1fp/upeU/eotDAmkCaYLhUh9ZNgd+2otBe739KbfF/GiibwoahJ4orXZdCQ2vDFFO3DULvVUzxHGSMfgf1exAMl9b+1u/l" +
+	"JUw9W6jfWf3eNAxNf9Ht9htD/b7rd073MdO+wffevHsNwuD7HeF86F9qbI9+jxeR7iTQ3dihYFubPj7Bv9S5iSWmrX8ItKfb" +
+	"miu0fbO/8a+z2vkfMm34HLZnBYVWe0rVu4SF2kGtJcwe4v9W2w3X9a8L1aU7gmn290z0vMMU937GOMX+pUaOgC3rYV9CS12W" +
+	"9S//m6Y+zqNlqH5cixInlrvcNvR0PqbUecV/1CnkvFo972O5cx8j7SLS7ESuNvY59LstQ5yEQP+xX6XU+gdhaivPNepFaXgX" +
+	"SsMyGO9Jo9RzbqBfecso9d5BqWcthaF3RG3FUQuwQn3gt7aReQ8avG/a3uh3/rT3wtSf/+eG/U3rZicyVvf1ujKH8oUNp9R5" +
+	"IvCN0HXdsBxtl4xtaCBHw/dVbfU/rxb4f1Fb6aLfu9PkCPTva2v7eUOdG/bvE9xmTyUz+J5/o98rtz/jnQ/IsV9lTG3bNZDn" +
+	"bO9dKAz1d+suCt0LKbSeJkbXjWc/4zU4D/i/ctL9O+w0/3F7jd9nf+3/vZFLl1VMuj2XS51FhDtVXGDKPqW/sSduTyY7zzLe" +
+	"uZtw6x7/x4Hy/Cfsj8k37RT4BprvpwanM+OdPwa+oxrc0cy1FTOsI8xw/sYMJ40Zmq9OY96pPMbYG+hmP0C3oJ029sb6lkLr" +
+	"Atbp0HYotH3m+1vknmu+wfcHYZ0T63/DeY5C57zAt8C9izH2V1zhvMw861MKNdhbKdRtZN3DOnsEhQbWM9XAJUxVNf43rHb+" +
+	"I/YUCkPvmwa3mELnPu6332dd7Tfd2BZutn2Mt48w3i5irF3E1fq9qgumfe6lUOtrf8sY+19aft+bzsUUWp9RaOoomNY7nvHe" +
+	"VxnvHct4j0OMs4oYdxsxnhl0cPtQ6DxCoX0RnVUNne1P/Vc4T/mvsIYzQ4MU+P+qLmZGLRznfJ3WgnNVV/Kt7eSrCl5RFcYv" +
+	"OKQe40MDk+miwVrHsSAUB+EWDSqJPwX8DEnXftKp30zQNHUdlxkI8rPWUWOtE0v5mKGymGGt41FrnYyz1rHbWie9rXX83lrH" +
+	"VrXC+F3vqp9oJQXMUK9yoTpB06Asz5wBNujQfpnSAC9+D9UvQU0JVH8K1T+A3r5cfRXUnAs190NNK6i5HGp6Qk1MIF3NAajp" +
+	"BdV5wXTngq8x+JoHwH/FKb41aVDzGfhmgO9VqFkPNf2hJglqJgXC6h+hurpOedlQ8zjUXAY1DwfL03JVBuX89FS5dWXWcHIJ" +
+	"VBfBv/4FJy+BX5pATTBNTVGAR83F4GsWzKPlfAqq/xWQXetY80+oOR9qrg6krcmC6l/qwLNQMw/zr+bZoCxXBJ79cVD9LlR/" +
+	"HoTdAaiZHXyudFxK7UgKPVModW1Kdd/SYOxZIwqtdZKr33/rR8ZYxxmj/X1tQ6xukqzbz3mFHtovdc/z7/MM9X9qn+v/yL3a" +
+	"/zfPuf733Ov9b6s9dAmNBbR/ErJF2i6G/Ez9zXIKAnGhcYC9mjIdp5/1eEDnMeOAvv6jmq7HAG4brtLfSrWVwZpuf89kbZOM" +
+	"f7+He+0D3Kdpxo59RUv7e8bqePsixoZ8TLWHfKc1hfa99DV+tk53EWN1nPMsl9aOIR5mjL2WeM3T+QM32xvp7rzAAneI4dEh" +
+	"WNYYHWqa+c49zUz7da50ptLRE+nfp0Ntv9whdHYymVlr64N+ifEL7vGvc87levs11riPMNteRifP35jiepjr1AR9yqcodTLp" +
+	"6AxntP0UE5ytTLP3kelcY+LX2V/T2x5MT11GSH5j83V5G1lkP0aB8de1jU9niq5z52JWOglc6Hi4UNN0GBojuJcRZtpO03uQ" +
+	"FxwvDND9wDuGCd72gTTO58Hv8z30MWFoDBhse/dq5ml+3r20dFpzi05j0n1OqfMLE+qOAUPtbupGt/s99PF0CLa71v/PFHj/" +
+	"TmnY3ZS6b5DpmR8ox0lnvvcmSp27mW/8AA9jtL223qDQ2ctV+tvl6UJLuweZun9rsL+g0BnGLOdzCrU9N+34rzrfv6549PfB" +
+	"E8csPfZzJlFo/5ObPY9T6PmRQudq4tylFNr3mPS3uX7zHUoOfSudDsRZbwR56e+SGe/Q1nzPOzDO/ZxC5xLi7C+C8uix5b8o" +
+	"NO0+hDFuYzKdUcR5LqHUbUShJ45znRq6hb1JqbfpKR9e14cnlUJH93FFuqeCCM9jRNTxLcIcISIUetOY5FnGGE8FU9y+jAlv" +
+	"wxzvDUwKlVvbL5+lJ5zcCjUeqJkagF8uttSJSy11Yp97iFJnj/G1L7Bng2m3hMB4xOj9NknWYTo6f6bU/jOFuv09XQJ9wLSD" +
+	"fle07p/XhhfYX9DYM4ZuzvfEubp/7KXQc4xSdySFYRdQ6hlPofsRV7ndmFUr59eE6baqO1Yw4T100O3mVYR5kvDovqPbr25o" +
+	"xkUzOMfZR1go1P28VlYt450UulUkOruJNzbqXxTW6h7k5dX994XAnMLZfOJaH7A1V9QNT6sX7RMXMTZki2vDs/mM6czX/U+/" +
+	"K0G964dBGUPtot8Zd2md9gnWU224iCV2NuO8GSxx5zHOey5l7nrynZ8os2vI9x4j0TOOeO2be0ZS6DZiir2WMe52wpw38Zix" +
+	"lLbHvzBBv19hFzHBs41Znicp9ZxDoect5ntGkmzseQLja8d6wX4Q1pYJnvXMqm3vUF1/zFpnMGudC0l00uhknodzi/0Ma50e" +
+	"rHWXBOLst1lrW6x1j7DWeZIbnPastJ/G61zELU5HYp1IOjuPsNb+kaudjuCcz9XOUNbaB0l3BxHrtOFqHe8MpVSnt31M0zwd" +
+	"l6vteNY6fjo5LhHO7WS6nRinprJd3cwc1Q/UNlAX+Y9b48GaSrr7OsvsR7nbiSTdieFu+1GW2RtItyPN893ODYE4u5i71QDu" +
+	"9pzH3fZD5vcyTbMvJ9GEj3KDpqlk/9t2sf9+azExJs9jhJky2jLOfpu7g/mWuS8G8/+BcdaN3G1+P+FfZy9gnPMxyU4Vy9RD" +
+	"vKi2kQmqPUgToAb4Kvj7YZBnQC4CuRz8HwRAPyu9MbK9nr/Sv9VWysC5FqjWz1YB+ZruXEihPZMxVj/G2APNXM5mtZDN6vPA" +
+	"s/0Fm1QbbrU7McaOZow9hTGOxWanD2N0OmsoY+xDbLLfMXNJm+0KNts7iLb/SIpzKZvtiYxx5hNnf0NTexeb7WhutSq41epF" +
+	"odOKQvtdf40G9woWeCeyQFVQBvxNfUaZnjOznmKFWuA/oBZwt32Lv0wtYLW7jvlmXjCF+XYqGTrU4CRyTwisZ8jRYHf0l2kI" +
+	"+475Bp4OgOdWlmiwv+EPGtTN/qPeK5jvXk+2u44l7mCWOMO4w+3IDboMXbYuVwOw8yywAdgHzAVpCdJa0611PG/PkgfsWSL2" +
+	"LM4Lwip7FvPtWQy1Z9EyCAPsWSww/bOCXOsIoz0pAZlA7lEtuDKk69mgbh00BCuJnH8Hofqqha8a/K5blw3qU9ejrsMzganX" +
+	"68n2TGV+WALzdWjqOQj695lAt0M96MgNp8F2lmj9dBvVhVB7aTBteHobaOhnz+KRum0QnGO/w1E8cAYoC8IS3QetY4yxvjZz" +
+	"zi2Nf6JhGBN06C5ljHWCQg32MgrtHyi0etHSk0Wh9Tgtne+DefQ49/5AGqc5Y3Sc4deeMSZfCPTv9owBngZuApkILABSAr91" +
+	"KOOAZ4Jjz1dCMqka/1GzRvA0Y5zpjHFmMsaOoaOTwaVOJunOraQ7zRjtRDLameavcv5OnDOEoU4KF2twH2Oe8wM3uwO524BD" +
+	"N5PnLjKcVYx02pPi/MCVnrmMc/oyzdlIhlvOGPtHLoKTl9ozSbdnVt8DJ0fWh+pjUL1Op4GTze33GW49zzq3J+usF/wV9lYu" +
+	"sO/x/925mSVQ44WTi+CkHlMO1umh+h7nXm5x7qW7XUN/I9cgJmtwW3Gz0fdm8tzVXBO2l3Ea3JFMcoYz3P6CNFcx0p5HH2cF" +
+	"3Zy5tHQLyLSG4rVvZrgbSaybyWT7d4x1E0hziomyVxHn5jPWXhAATXcHkub0Icm+k3H2AgbZixlu38xY5wsuc0cw0Z7HWHsR" +
+	"fe3FjHX60dKNJtYewVj7R4baI4i0h3GhM5auVjWZ9rlk2rcyzlVkWj4y7SZkWj/T3fkpGAeZngoy7eYG5jufkBnK53wbpIWT" +
+	"aV9EX7c9mbaHTOeTQLz1C3E63r41kN86yc12VCCP9QXdDB/H0DOtmkBoDyDTjSPTzjwlm4nrT6aaQLeGaxnuMkobQKEGzwRK" +
+	"PUMJaxh6L6bUm0RpKHSXBZ/7UxgKdX41gFLrPeZZTeivQf2FeXXB1vTpzNPp6tK9FYR5WhDmfZ3SsMspDMkZAncaK88E/0be" +
+	"lfXC/qdCndfuyDx5nHmnrcV8ysoGcJUGTyGlnrspPS38A6Weh1hZL3yAq+qG1q3M86RQauBKVnrGcZUzmZHOZErrwMg6YGie" +
+	"OZR6bEbqOWePTWltGKKfLf5cSq2HmafB3c5IdzulofA/KndOvfJOL/cM8aFy7aX0spdSWgd61QFDczModRPo5faj1B1BaW2Y" +
+	"YcJetWHD+H6UWh2YZ33PPB16WlHoacXIELhl9KoD+vdI79eUen+h0PsYpd6/MDIU6jj7NeZZv2ee/dpv5HUgwCvswgCPUOj9" +
+	"C6XeV+kVChvGa/667zkXMdK5iNI6MLIOGJqnO6Xul4x0f6LU05bSUBiiny1eh9Zq5lljmKdD93FGhsDbrQHoNYxNp4fhl1Ia" +
+	"3v/soTOVec4aCh09p3EKCuuAoXmSKfX0p9AzglKPHoOEwhD9bPEjKLXuC9gG60nmea6n1HM9hR4PvTweSkPgDKsHI0Og+bpf" +
+	"MdK6L1jfD3BFXXCfYmUQCjV4cwI2zJ54Bntwyi7MCELAHgTfb90HPV5GerwUBmFk3d/2jcxzXUq1Ht6vzw6etvX4mLBRLvNC" +
+	"EDY+AKHfbgvmhXVh3hn6aW2fDrZFWBAK6/627qOled9/MZBt3c88DXrO2ft1oIxz8wLQeDrzGkef+m197T9qP+g/6u7xHw3z" +
+	"+I82SvUfPWeh/6i9zH/UrQjS+gdpMf6j7lj/Ue/d/qPhb/qPnqNO5fUe9R9t1OtUOg3OVv9R7+eB/M4E/1F7LbPsh8w8REuX" +
+	"4Ph/GnFmvuJvFNp/I852zLrtDOPr3cs4vb5ofeb/VM9HOY2C81E6/fZaiNFrs8F18XE2XGGvJ96Anqu6n0JnNEXu+9zhQIfa" +
+	"NY5ExtuPM0evU9T6knCFsz4w/2UtxbG/ASuXa6w3uMaKCcKL9Lfe4RrZpsG/3VrGNeoLrrGXco31ThA+or/1BMOt8Vxj3W7y" +
+	"OdZTXONAe2sk0w3cyHQnNhDWBfVmAPSzFDDdWsp0W/8eznRrGdPVL4HQWhZM/3YwbkIw3cNMtwYxzTqPplZrelmrGaGO0Frz" +
+	"0msn1vAGaXQYTKPnqYz831LoTCPMepZCU8Yvp8o18j3PdLU4KPNwpqvfMV25wfB3TLdaMF1lMF1NZbp6gun2roBsdqMAWAOC" +
+	"8n/FdCuM6eqvAT3sXky37mW6Osg0a1BQTmG6Vcl0axrTrWFMt5owHaofawBjNNTOMZ1a764fNtjf8O/C37rvQYdmvb3ePofT" +
+	"9hp0cV2zR2ZYiB7c/yBqK5eoBbQOrac3DIN7G9bZaxio90kEf8cFQv9hPVes1wkbhmfb81Ab/rt11+DcZW1Yf//Dugbhhf9u" +
+	"H8S/2w/xH++L0O39DC1DYWjO7N+Fp83theZGz7KXwqzV6rXS4Nqo7mO6va12/k916N5EqV5POet+m/9S+J/0xzOFaiu3qAVk" +
+	"B/fVrPt37X/WMLiv49+FDdurdk/HvwlPm7NuELofU2pvIMKscf0K1O73ugPLswzXfRnlHsVyLTxuD1w7sDZwGjjP4jrleDz/" +
+	"wPIcwvVcgfL8Hiu0bn82cPfgun/FEwZWGLjeO1Dep7E8a/B4NuHq9bUA+PeoPf4H1R76qz3+n9Qef43a4//Zug3XWo7HeQjL" +
+	"2YzrxKKcsVh2Jh47E/dM+8A0uNfiui/g8b6N5X0P11OA8ryNZdbhfgXcZ/F4V+B6/4DluQfXrK/8GtyF636Jx3sCK0zhel5F" +
+	"eY5jeWbj8byKG6r32noM1ova499r1iBDMofKD/L9X23H/9V2+a/p/Suyu2P8fr0+rJ8DewYkNxCaPQO/P6Pc2/x+s5a80+/X" +
+	"68nG91zHIGsduTqP2oOj9hCn9vhf1WvLag8e05/0PrqtDLAX+v16Ddpah97fcJnO07AP1O5tCf3We8r6+o96Mvx+s24dhiew" +
+	"HyKwL+JM9eN5wu8369x7/H69zq3XoQN7Kox+eHpDKFRTQXaD9AZHmXlXJAd4gTP+M3txa5ih1/GsdWZv6IwgP72HlDr7PvJ0" +
+	"aL/MK3ofhf0yecH9FD2sdXSzCyVMg5rF/fa73O/pzf1qCY/yK/+0XOFXMvCcBxkoXxJn9SPOvpO4us/OMF5SP3Ov+pmtrp/l" +
+	"9jLuMPPo68D5yf+mBnWlv9hzBXG6DqW37/7gnuPbPdcy2PmZOzRYz5BpF/CktY67DLxBnPqM13R+6w2m2UJfT28y9W/XKzH2" +
+	"Ed52PjJ7lu92vRw2+w6Gkel6mamfNcg6/qN/VgJ9jc+ZQC8rgcutBIZZCYy0ErjYSmCAtZvbQqCimKGiyDJ7dBLoq/fh1u7T" +
+	"/U/yB31h41ueoGnQp5yh/V69dybo+87QdLOP5m2mu9u42FFMcRR9HcWdem+x2SeliA3/llLvFko9/yTGXcpwR9HPUf5Pgmmb" +
+	"hTuUOorzHcXgII8wN5ExjiJPxzuKLE1zFP2h+lqofrQOzIST5frZ24Q473XEaZ/SW06xexPzPR8T500hzp7LbY6H+Z73Kfa8" +
+	"yEXu72nkzqTciSXZiaWpPYohjsNUtwtp1iz/Vx6LCP1ue0sZpPc+Ow4znNnBtf/X6e8cYIRTSZmbS5rTigc9l/OPsGRcT28J" +
+	"c64n2pnHeLsTPfR7p8G53f+zuh4J7SNxn2Kkc4j5boLZgz3SSWW5s5ehZv/BCi62MujjvECWk0YH6x6S7SPMVxUMdvcwLqyM" +
+	"O5ynGeyM9pfbz1Pq9qXUbcx5TgHdbUWEvYYxzi3g/EJHJ4HB9uekqAW0VAt8/3KHM9I6xqzQvuzwrbX7s6fXCcd5cukV/H15" +
+	"MBwXfNZzxZmhfZzuHko9Xwd08nxNWNgvhDUOJ8wbafZZdPBG0CE8lg61+zN+CeylN/uDp1LoDqfQ0yw4//8tLb37aBleQUtX" +
+	"j/02mrCl9zMt+6m98rpfhQ0nW/MyYZ290p5c+rpe/c7JTNfL1cFQ/57hejnZADIDaWjuegkLpssK5psVjB9/Or8QT65uEGr4" +
+	"3PVKP9cr0+qEW4NxUa6Xzq6XquDv9a6XZafyqnWnyuHqOr91/KRT6ZgYTDOxDi2zviwyIyjvjDq/f3C9bAjCD3XSjztdt4b1" +
+	"p5/p6XpF11VqIDwb6HiuC4A0D+addJb6Cj33DJVhvs+KDOsf9Knd49OFSY4NGtxcVmhwZvNG3We7gDLnCVYauCBwdkSDo8gM" +
+	"nBuRRNfLR/YSida22H6XtCBc5MRS6vgpNXlyuceAl/3WOrndWkeGtY73dOhUyXl1wZ5JS/dpWoaNp6XdmBYeL8PCbuICu3Hg" +
+	"twZHcZ0G1Zq5Oqx7HqUBLNSh+iOP/Eqas8GOM9Bed71s92yizLOJZ/4v8GwIlQbOCYDnTpZr0Hsuz1J+Q3i5Afy79C/8Fqjb" +
+	"Hg15OC8HwPVS8hvgnnpQwCN1wfoqAA3pp0Gg7D83lMXTm3kagu/GWOsrbnKqeCUEtWkjIQiTNdhLjO2vsJewLsjnNDC6/oVM" +
+	"Da6XJ4O81gVAJtWVI/xClmv4L/SHBvqpn7UPFwLvRJaf6T0NPTeIXx4M37An0t+eyGwngZtP2aQAWHlCyFcLhc77apXzPpmh" +
+	"UMtiHcRxn+Jh85zNo1Y2C0OhPVO22TPp5B3Fag3h3YPhTOaGdwf3ElaafZcJFIbGQm5fmus07iW0tdZxU31QsafTTtGd2SrJ" +
+	"mc1DgbDuMw9pWYzte5fNulznThxH6TMh/rlG/xjm/hawJ/qPBkA+tyeKnmvvG4Cz/ZbPg1AYgFD+enz+g/iG0DD9/27QsD6M" +
+	"TuODv8cH4DR9/wP6bwH5PBDWPm+rA0Ga6nIqnRQGfxee+h0InTv9R//XQFU6d8Ip0DT776fizpYn8Gz6728A+7MAhH5bPwXA" +
+	"9Ps6YD0SgLP9/k/TaXDTmWndGwjddGYHw18F6x0Dm93GbHarT4F6C6cunKk8DXp8e6Z6qJvGey4zT0vTQI56fH9hs4FhQdC6" +
+	"5bLO05sr3Fwy9bOby3w3lwUa7Lasc28ytHXuLHI02PvJ8Ywgx/szd4TBUjeXx91cNmqw9nNXkM8qN5fCINwX5Bv6XWxPJ8/N" +
+	"ZX0wzAnSdZpM+89kOlNZZ29lnbORddaRYPm5FOvQaV37+7fCA54lrAtBgKZ+r0P7Wh5xhgXAzeV+N1d6urmi4z51c/mHmysv" +
+	"ublcFtRvi5vLe26uiJvL9CDvQW4ua4Nxj7q5EuvmmrHdO26u9HJz5S43V3q4uRJ5BnqXAF3au7lscnOlj5vLu26utHNzeezU" +
+	"b3ZYsyi01+o6kvFuLqXWLDaoByi0buNhZzqFzjJuDtbh6n8DhfXrRpq6Q1h5RvjN9St9AsBUN5dn3Vy+t/5u2nRuoG2lkZvL" +
+	"U24u3wTq1MD+IGQ657LOM5BHPH/hfu98HnEXGz/oe33m2fXKZNeLNxAaeNv1ylDXy0gz7orSvo8sD7Sh9HWG8agGtw1znWEy" +
+	"2xkmHVwvl9YF09aB8dSlwfCiOvFzXa/xHVa6XuJdL4+5XnoEwzGul0WuV6K1f+96+dr18khwTPa5nqupD2bM8E/XK22CtJKg" +
+	"3LqcEcHn0UG4IEi/4AzQLSATq4J8+gfzhviFQMetCYL2X+53vcwIQqjc+4O6rHa9zHe95LteLg9CgE8uU9xcrmsA61yvesH1" +
+	"qmtdr7yvfkeR+h1z7f4U2f2ZG9BRcl2vdA2UJ31cLzcE+MucIHwZhNWuV550veK4Xjnf9Uov1yvjXK+63fWq7q5XhrheWfBb" +
+	"/ZdA//ivwtXB9qwbng3erQNBmrzUIE18EP4VhBsDIK2CbVAQTBdqu9DzVa6XlcE203C1NYIZ1gim6m+RtZRHDYzgdX3ngZPI" +
+	"604iv3e9jAq2dYSeM/BO5Orw8WSGj68Nz/asw6udYnCK5dyzg9Xl/+z4/7fD/+nt+/+1/69Bnbm140HQc43rnbl8qcGM11cF" +
+	"4QzzCcZPPjVvkRSCRj+emtf69+B/uwFN521p7un4L889nDZH9X89/n8F9HdmS52w56k5U2leh14nvnZ+VMPFBn5lrKDB0IcQ" +
+	"a+ASVgahjRlHDOFiDfYawswdEGv8nznDCNf7QeyPGWj2THwevKMgtKcluKfC7UmGp0twfUKfvy+kpfdt+noKzR6LYaGzuPp8" +
+	"qTnfrteNHfL1WXC9N0vvJTG8/sR4+xNa2TsYYa9ktL2DdM91jNRzsp7rGGU/Qbr9LiM915FuVzFan2fyzGek/XdGutMM/VL7" +
+	"G0baHzHBcxMjPTmM9+i9858wzq5ipM5rv8s4+zUutfcz1nOboY+zTzLBfo1xntUmbpz9HKPt9xnnDGOUZzzj7V+Y5b7JFA1O" +
+	"MlcG4Sr7k1NrwrX3AP2JlZ42rHRupdTWe5VupTSUV+nznRNIMGdBf+9/y/6QUmc0pfbfWWnyT2Sl5zbmO+t4SKcJ3mNTqn+b" +
+	"vMmMriuLTqOeCpxvrpUvnSnuGO43dwkF78Ux7RLkZ86kOcEwgZv1+qaZ62owN2bouf/xWOv/KDB7zUJ7WH8JnNPWYPaG6fW4" +
+	"Av9b1t7A/UMGkoP7zL45de+TWb/S/ftuc3Y3LtRfdJxed7P2srne3U+LWNKgT61UW4PtFdzzqtfq9N5QNZ6Vajzd7I9YqUGN" +
+	"p6sazwp7ICs1hO5l0Xe86LspQudgvZ+T6ZlFnPcmZrl+xnn+ZvYsF3reY567mas8h5jg3s+ssKaUerqd2lvmWcAUdzBjwmOZ" +
+	"4vmJMe43JLnfEeY8gaf2jGrwzGkozVnuSPhNoD7jGgORbAiB1Yvb1HvcZn3BVRpq7/6ZS5jblZjQ2Uyz9/QSpoY9T5jnAcaE" +
+	"DSbMU8oY7+uEeR5ijOdPhLmP1QlzA6E+H67vA7DLCbN/JNxtQ6n1FYX2PxhhfcBct4jWzgHCQvo5w8xeyzD3Awa7rzE4dObT" +
+	"GROgh0J7Db30WmTofiJzx9ibrNN3aum7y3S96TtDzBnwlRQ6h/3fOgn+b50C/7f2W/5vXdf/rRPr/9a52/+tdY//W6eH/1v7" +
+	"Ev+3brZ/qX0N19g/0lOf27a/MXv8Rpq10q2Uht1Ff7mHEnmVDPmGRPHRVnlpbn43oJvwN9BN/gZ05eUZew6ZBv5IpnuHOe8S" +
+	"gNfp6+aQaQ8K/PZeSaa9hEx7GfPd65hh8iwl090coDlTGGfnM9idFqSvCqZfyDh7BTfbB5kS/D3fvjOQx25FN/sWMu27mG/4" +
+	"zSbTvolMez+Z7lEyPVcw3o1mhn3umcHy1Qc79hQ4350O9qyzgN5ruZ8OziTinUl08Hro4A2jg/NnOnguZ2pYDavCfiI/7Bvy" +
+	"ddh4Ivn6jCjIGH22097FMHcHRc4Wxrjr2eyUMMbtavbmbHa+Zow7x6yHvqHPhdaDCykMQcMzqVYcY63bGavPo1rtGFvvPGof" +
+	"xtSeQw2dQT3D+VN9XtWeGcxXwWanNWPMucn1zFdl/qPqmP/of/u3Of/4e+bbz/uPOt1P/33qfKb/aAD+s9/mjGsncs4WmjOb" +
+	"twbPbt7qLwudq7R7+I9q+E9/m/OWtwbPXd7KDSE99RlMdyJLbJ//qL3df9T5jiVuJEus6/xHrX/5j5ozmcv8ZWcL9RlNT/qp" +
+	"eqvl24DO//K/6upAqO9ZAXUzSfrsrwlv58rA7zq0+mFEMByiw9qzyWWB88mqjLtD+uhzyqoscFbZXXdKfnO/RBmFDfuJOZ96" +
+	"BQv+XX/6X+0fDfuDPsMb3o35nmj/Uc9l/qOn/dbne99nvvuj/6gn5vTf5hz1cyxxd/mPeqzTfwfPV19tf+M/akec/rvh+2DO" +
+	"Cd9Odu1vfT64jCW1/U+fAb6OJbX1MYw7nMe5w57vP2p/cKp/NqzXUL9s2N8a9i8ny3+fs8B/n5PFhc4CLnSyGOksYGQoPFOP" +
+	"8tp00/eA2O9QZs5JvkaZ3SsA1hpW2kcoc5cYH6jMPkqZPY2ysPmUuf0oczcF4pwsytynKLM3stJ+izJrJyudDmTa71Pm3BTY" +
+	"1+G2I9N+gDL7+gBYa8jU5Wq+OtTp3O5k1vX//j/474JzC+c69wbONtnfcZcG7UtbY7hDvetf5/yRUr0vzbOEbcaP0n6zhzDn" +
+	"OKXOXYR53qav8y7dnEn+o06k2dM1Lri3a5y5B/Vxujj9zR5/feboDrXAv9n2mztL7vDaZg/zjOA9qOPMPag7gvegbqKnE0um" +
+	"3YOW7lJS7Z+IM2cDLgvcb/dr971az3Dzf+O+1/+xe13/C3dW6edfu7fKseF/4n4qs89L3/uk76LSd0K9zLzg3ovOwXsI7vs3" +
+	"d/3O+K13Aof9mbL/E8D52/8Z4LnifxDm8sb/aHkNoc769RlhKXd6lzPUs4S+3stO0b1XM/EM6Rf/W37/g3AGGduGnhutPi19" +
+	"kvdqJulQ63oWWAc1TwfvGHw6cJdgjQeqDwagxoEave/7Z6iex0qQOacg9LveOKSuj1XHvwrFy13105/uc9b1L3/92XoZtIyu" +
+	"y0vWt4E7OO0jktkAmtV5HmQfkfZnCNvX+T3cPiJdg2F7fc5S66jnrswdNV/5y+r6hXV9Ou3nmj3UV7AgdPdr4FsU2Bduzjwu" +
+	"YJ25Y+IZs3a+NHiXz5nA3PUT/JYd1WDugDmmQ/9RfReMPsOswZzjfUaH/qOBe1j8RwNQ53kY0+xhOqxP13fHuEt16D8auEPG" +
+	"fzQA+tnXUj8H7oUJnI3Wd8vYP+jQf1TfMWP10qH/qL5rxpOlQ/9Rfa+M9bgO/Uf13TPO9zpsIE8dmfWdNO79Oqxflr6jxmmu" +
+	"w/o86+lr7qrRYQM5z/ZcN32dZ32f6L/zyTx/U01q7x/TPkOn4FinLtwaHPfUgbrjnLM91xv71HluMMY5NZ6pO9Y/y3O98U2d" +
+	"57B45nte9h/1vBQYd9s9AmPneuOfOs8Nx+lneq77Tpz1ue44/izPeo5Pz6dzB/qfQFwLxFUxEN64MRH0831ABP391xLBAP9G" +
+	"Ihjon0wEKYa+XmOJN3iA7wPiaEI8cUQa3NrgNgb3808jjmT/68TR3z+fOMMtjhR/CnFM9r9AHFkGP2zSFBn8iMGPGrzepHzN" +
+	"pHldY4nTPCXe4C46jXQ1uLvBiQb3MrivLkuSNQfpb/IOMM8HTN7j5vlHTQ+7Q6cMW2zwUoOP6djwan8aceE13EhcI/RzI+FG" +
+	"OtLfv5WODPDn05EUg9drLH0NTjbYxMpxjcPuMHixwUsNPubPJ54IehBPX+KJl1YGtzE41uD2Bn+kcXg1I4lvBCNJIIJ4Ekjx" +
+	"X0sC6zWWZIMHGHxc47BjGofX+A+Q0Ej8B+hME98LdCbC/zqdifR9QGda+/PpTBuD4/zN6EyafxWdSfdvpTPjDZ7ov53OZPgL" +
+	"6UyWSbnUpFxmcJ7Byw1eYfAGw+Exgzca/LjBmwzebHCJwU8Y/k8avMXgrQaXGfycwc8b/ILB5Qa/ZPDLRp4Kg18x+FWDXzMS" +
+	"vm7wDiPPG6asSvP8J/O80zy/aZ73G25/MfivBn9s8CcaSxODIw2OMriZwc11ncj5Brc0+AJdunQzuIfBPQ3ubXAfg/trqcTU" +
+	"hhhN5WmD/2nwjzo27J8ah1cTT+fwGt9WOjcS31a60M9/gC6k+D+gC+s1lnhNkQH+D+hOhH803Yn3b6Q7vQxO9h+gO+kGjzc4" +
+	"w+As/+10506TpsDgBw1eY/BDBq81uND/Ft152OQqMvgRgx81+AmDnzR4i8FbDS4z+DmDnzf4BYPLDX7J4JcNrjD4FYNfNfg1" +
+	"I9vrBu8yMuwx+BMdK9H+QrpLM/OcaHBPjcNriKd7IyGeHvTyv0AP+vm20oNk89zf9yU9GOCfRg9SfK/Tgyz/fHpQYGIfNHiN" +
+	"wQ8ZvNbgQoMfNrjI4EcMftTg9YbPa4bP6xpLtKZLvC5XEs3zAJ0mvNrXmR6N9J3TvWji/4Be5s3tRaR5jvO/RS/6GzzQ/wK9" +
+	"SDF4qaEsMzjP4OUGrzB4vU4jA/wv0JsmvlX0JsJ/gN5Emuc4fxq9SfO/RW8mGXoW8fRmqaEvMzjP4OUGrzB4g0n/mMEbDX7c" +
+	"4E0Gbza4xODXDLfXNZZ2mr900Di8mv70Dq/xTaN3I3RsI/FNI8no28d8HfqYr0Mf+vk30sfYzD5kmef15vk18/y6xhJvsLaf" +
+	"fdE2vK/EGtzev5F+NPEdoB8Rvg/oR6TvLfoZO9bP2LF+6K9DP/rpfkCa7wT9GOY7Rj/SDR5v8ER/W/oxyTxnmOdMg7P859CP" +
+	"pYbDMoPzDF5u8AqDN/iz6MdjhvNG8/y4wZsM3mxwiT+FfpQa/k/4u9KPJ83zFvO81eAyfyr9eM7QnzfPLxhcbvBLpqyX/RfS" +
+	"jwoj2yvm+VWDXzNyvm7wW4bDewZ/orE003mluc4lLQ1uZ+gdDO6pKeE1Wv5G4jtBMnH+10lmqcHLDM4zeLnBK/yv058mvhP0" +
+	"J8J/Lf2JNM+t/RvpTxuD4/zz6U8v/wH6k+bPpz/pBo8ylPHmOcP/Av1ZalIuMzjP4OUGrzC4wKR/0OA1Bj9k8FqDCw3eYLg9" +
+	"ZvBGgx83eJPBmw0uMfgJg580eIvBWw0uM/g5g583+AWDyw1+yeCXjbQVBr9i8KsGf6JjJVpLIs3Mc09ND6/xt6V/I/GnMYAm" +
+	"/skMIMK/kQFEmufW/tcZQBuD4ww9zf8CA0g3eLzBE/0HGMBSE7vM4DyDlxu8wuANJuVjBm80+HGDNxm82eASg58w+EmDtxi8" +
+	"1eAyg58z+HmDXzC43OCXDP5EY2lmcHMtlbT0H2AgEf7JDCTe/wIDjaUdSLr/LQZyp3kuMPhBg9cY/JDBaw0uNPgJk/5Jg7cY" +
+	"vNXgXSZ2j8GfaIqxqAONbR9obHsKTXxbSTFvfQqR5rm1fzQptDE4zp9CipEtxciWQpo/jRTSDR5vcIb/WlJYalIuMzjP4OUG" +
+	"rzD4TpO3wOAHDV5j8EMGrzVY98MUNhiejxm80eDHDd5k8GaDSwx+wuAnDd5i8FaDywx+zuDnDX7B4HKDXzL4ZSNzhcGvGPyq" +
+	"wbuMJHsM/kSnlGj/RlKkmXnuqdOE1/hWkdJIfB9woflGpJHlf500XjP4df/rXEITfz6XmNhLiNTP4dXcwyWNYBKjTfuOZrzB" +
+	"Txj8pMFbDN5qcJnBzxn8vMEvGFxu8Ev+Dxht2nS0NPO/xUSa+D5gIpG+A0wMr2YSE833YrJ5ayab9yWTJv7bySTSfztZNPFP" +
+	"I4tIfxpLzXdkqfmOLDWjjKVmlLHUjDKWmlHGUjPKWGpGGUvNKGOpGWUsNaOMpWaUsdSMMpaaUcZSM8pYakYZS80oY6kZZSw1" +
+	"o4ylZpSx1IwylppRxlIzylhqRhlLzShjqRllLDWjjKVmlLHUjDKWmlHGUjPKWGpGGUvNKGOpGWUsNaOMpWaUsdSMMpaaUcZS" +
+	"M8pYakYZy4y+y4y+y4y+y4y+y4y+y4y+y4y+y4y+y4y+y4y+y4y+y4y+y4y+y4y+y4y+y4y+y4y+y4y+y4y+y4y+y4y+y4y+" +
+	"y4y+y4y+y4y+y4y+y4y+y4y+y4y+y4y+y4y+y4y+y4y+y4y+y4y+y4y+y4y+y4y+y4y+y4y+eUbfPKNvntE3z+ibZ/TNM/rm" +
+	"GX3zjL55Rt88o2+e0TfP6Jtn9M0z+uYZffOMvnlG3zyjb57RN8/om2f0zTP65hl984y+eUbfPKNvntE3z+ibZ/TNM/rmGX3z" +
+	"jL55Rt88o2+e0TfP6Jtn9M0z+uYZffOMvsuNvsuNvsuNvsuNvsuNvsuNvsuNvsuNvsuNvsuNvsuNvsuNvsuNvsuNvsuNvsuN" +
+	"vsuNvsuNvsuNvsuNvsuNvsuNvsuNvsuNvsuNvsuNvsuNvsuNvsuNvsuNvsuNvsuNvsuNvsuNvsuNvsuNvsuNvsuNvsuNvsuN" +
+	"viuMviuMviuMviuMviuMviuMviuMviuMviuMviuMviuMviuMviuMviuMviuMviuMviuMviuMviuMviuMviuMviuMviuMviuM" +
+	"viuMviuMviuMviuMviuMviuMviuMviuMviuMviuMviuMviuMviuMviuMviuMviuMvquMXb3TjPHvNGP8O80Y/04zxr/TjPHv" +
+	"NGP8O80Y/04zxr/TjPEfMJ7zA2aM/4AZ4z9gxvgPmDH+A2aM/4AZ4z9gxvgPmDF+gRllFJi8BWaUUWBGGQVmlFFgRhkFZpRR" +
+	"YEYZBWaUUWBGGQVmlFFgRhkFZpRRYEYZDxqeDxqeDxqeDxqeDxqeDxqeDxqeDxqeDxqeDxqeDxqeDxqeDxqeDxqeawzPNYbn" +
+	"GsNzjeG5xvBcY3iuMTzXGJ5rDM81hucaw3ON4bnG8FxjeD5keD5keD5keD5keD5keD5keD5keD5keD5keD5keD5keD5keD5k" +
+	"eD5keK41PNcanmsNz7WG51rDc63hudbwXGt4rjU81xqeaw3PtYbnWsNzreFZaHgWGp6Fhmchcf4DFNLf4IEmTYp5XmrwMoPz" +
+	"DF5u8AqD12ssA/wHeNj48A8bH/5h48M/bHz4h40P/7Dx4YtMmiKTpsikKTJpikyaIpPmEZPmEZPmEZPmEZPmEZPmEZPmUZPm" +
+	"UZPmUZPmUZPmUZPmUZNmvfHW1htvbb3x1tYbb2298dbWG29tvfHW1htvbb3x1tYbb2298dbWG29tvfHW1htvbb3x1tYbb229" +
+	"8dbWG29tvfHW1htvbb3x1tYbb2298dbWG29tvfHW1htvbb3x1tYbb2298dbWG29tvfHW1htvbb3x1tYbb2298dbWG29tvfHW" +
+	"1htvbb3x1tYbb2298dbWG29tvfHW1htvbb3x1tYbb2298dbWG29tvfHW1htvbb3x1tYbb2298dY2GD9tg/HTNhg/7TFDecxQ" +
+	"HjOUjYay0VA2GsrjhvK4oTxuKJsMZZOhbDKUzYay2VA2G8prxr96zfhXr5vn183zDmOrdxhbvcPY6h3GVu8wtnqHsdU7jK3e" +
+	"YWz1DmOrdxhbvcPY6h3GVu8wtnqHsdU7jK3eYWz1DmOrdxhbvcPY6h3GVu8wtnqHsdU7jK3eYWz1DmOrdxhbvcPY6h3GVu8w" +
+	"tnqHsdU7jK3eYWz1DmOrdxhbvcPY6h3GVu8wtnqHsdU7jK3eYWx1pdG30uhbafStNPpWGn0rjb6VRt9Ko2+l0bfS6Ftp9K00" +
+	"+lYafSuNvpVG30qjb6XRt9LoW2n0rTT6Vhp9K42+lUbfSqNvpdG30uhbafStNPpWGn0rjb6VRt9Ko2+l0bfS6Ftp9K00+lYa" +
+	"fSuNvpVG30qj706j706j706j706j706j706j706j706j706j706j706j706j706j706j706j706j706j706j706j706j706j" +
+	"706j706j706j706j706j706j706j706j706j706j706j706j706j706j6U6j6U6j6U6j6U6j6S7z/d1lvr+7zPd3l/n+7jLf" +
+	"313m+7vLfH93me/vLvP9fdvketvketvketvketvketvketvketvketvk2m1y7Ta5dptcu02u3SbXbpNrt8m12+TabXLtMbn2" +
+	"mFx7TK49Jtcek2uPybXH5Npjcu0xufaaL8he4yHsNR7CXuMh7DUewl7jIew1HsJe4yHsNR7C+ybX+ybX+ybX+ybX+ybX+ybX" +
+	"+ybX+ybX+ybXQTP/fNDMPx80888HzfzzQTP/fNLY8JNm3vWkmXc9aeZdT5p515Nm3vWkmXc9aWz1SWOrTxpbfdLY6pPGVp80" +
+	"swQnzbzrSTPvetLMu540864nzbzrSTPvetLMu540swQnzbzrSTPvetLMu540864nzbyr38jmN7L5jWx+I5vfyOY3svmNbH4j" +
+	"m9/I5jey+Y1sfiOb38jmN7L5jWx+I5vfyOY3svmNbH4jm9/I5jey+Y1sfiOb38jm17KJYow/XCwtoVhaQrG0hGJpCcXSEoql" +
+	"JRRLSyiWllAsLaFYWkKxtIRiaQnF0hKKpSUUS0solpZQLC2hWFpCsbSEYmkJxdISiqUlFEtLKJaWUKzwak1phG+VnKethJyn" +
+	"rYScp2du5Tw9cyvn6ZlbOU/P3Mp5euZWztMzt3KenrmV8/TMrTTXI31prkf60lyP9KW5HulLC8OzheHZwvBsYXi2MDxbGJ4t" +
+	"DM8WhmcLw7OF4dnS8GxpeLY0PFsanrF6llhi9SyxxOpZYmlvKO0Npb2hxOt5Y4nXPonE63ljidfzxhKv540lXs8bS7yeN5Z4" +
+	"PW8s8XreWOL1vLHE63ljidfzxhKv540lXs8bS7yeN5Z4PW8s8XreWOL1vLHE63ljidfzxhKv540lng2G52MGbzT4cYM3GbzZ" +
+	"4BJ/isTreWOJ5wmDnzR4i8FbDS4z+DmDnzf4BYPLDX7JlPWykarC4FcMftXg14ycrxv8lkn/nsGfaKznjSVezxtLvJ43lng9" +
+	"byzxet5Y4iVe14yePZZ4PXss8Xr2WBK1NyiJ2huURO0NSqL2BiVRe4OSqL1BGaC9QRlgan6A9gZFz4iOFj0jqnGcP0UGaG9Q" +
+	"Bph3Qc+OpomeHdV4vMEZ/mtFz47qlMsMzjN4ucErDL7T5C0w+EGD1xj8kMFrDS70HxA9j6p5PmbwRoMfN3iTwZsNLjH4CYOf" +
+	"NHiLwVsNLjP4OYOfN/gFg8sNfsngl43MFQa/YvCrBu8ykuwx+BOdUnuDomdc9XNPnUZ7gzJAe4MyWI8i1e16fVbdTjf/RnU7" +
+	"/YlXt5PCSHW7Xo1Vt+vVWHW7Xo1Vt+vVWHW7Xo1Vt7PKd0LdznqdUgYwMvwHvbIf/oNe2Q//QX/Zw3/QK/vhP+iV/fAf9Mp+" +
+	"+A96ZT/8B72yH35Slxt+UpcbflKXG35Slxt+UpcbflKXG35Slxt+UpcbflKXG35Slxt+UpcbftKUW0M/37TwGm3ZwmsY4O8c" +
+	"XkOKrzC8hvUaS7yOlQG+wnA/cb4T4X5Tol/P6Yb7WWooywzOM3i5wSsMXuX7INyvxxPhft2PGokuq5HoshqJLquR6LIaiS6r" +
+	"keiyGokuK8rVNiHK1T0zytU2IcrVNiHK1TYhytU2IcrVNiHK1TYhytU2IcrVNiHK1TYhytU2IcrVNiHK1TYhytU2IcrVNiHK" +
+	"1TYhytU2IcrVNiHK1TYhytU2IcrVa0lRrrYJUa5eS4py9VpSlKvXkqJcvZYU5WqbEOVqmxDl6rWkKFfbhChXryVFuXotKcrV" +
+	"a0lRrrYJUa5eS4py9VpSlKvXkqJcbROiXL2WFOVqmxDl6rWkKFevJUW52iZEudomRLnaJkS52iZEudomRLnaJkS52iZEudom" +
+	"RLnaJkS52iZEudoaRLnaGkS52hpEtdEr0VFt9Ep0VBu9Eh3VRq9ER7XRK9FRbfRKdFQbvRId1UavREe10SvRUW30SnRUG70S" +
+	"HdVGr0RHtdEr0VFt9Ep0VBu9Eh3VRq9ER7XRK9FRbfRKdFQbvRId1UavREe10SvRUW30SnRUG70SHdVGr0RHtdEr0VFt9Ep0" +
+	"VBu9Eh3VRq9ER7XRK9FRbfRKdFQbvRId1UavREe10SvRUW30SnRUG70SHdVGr0RHtdEr0VFt9Ep0VBu9Eh3VRq9ER7XRK9FR" +
+	"bfRKdFQbvVoR1UavVkS11TPnUW31zHlUWz1zHtVWz5xHtdUz51Ft9cx5VFs9cx7VVs+cR7XVM+dRbfXMeVRbPXMe1VbPnEe1" +
+	"1TPnUW31zHnU+Khr/G9HXRZ1jX9t1ISoa/zLoiZGXeNfGDXJ0DMMnmxiM03sFPOcZehXGjzVULJN7DTzPN3QZxo8y3CbbWKv" +
+	"MrFzNJ3Qv64NIBtYBVSDFINKAXUA1AmwksDKAasCrGNgR4OdCPYosOeAvQvsanAmgLMX3Ahwc8E9Bp4Y8JSANw68Y8G7GLxb" +
+	"wLsPvNUQNhfCMyC8ABrZ0CgbGlVAo2PQOBYar4LGFdC4Cs5pDOekwTlz4JwSOOcAnJsC566Cc49Dkwxosg+aVENEGkTkQMQ2" +
+	"iDgOkXEQOQEiF0LkWoisgMjD0NSGpnHQdC00PQFREyCqDKJbQXQ2RB+EZknQLBuaFUGzfdDsGJw3E84rg+YR0DwHmu+FmAiI" +
+	"WQUxe+H8ODh/FJy/Gs7fDS0aQ4sUaFEMLSqgZSy0XByEMmiVCK1WQetW0HoRtC6BNjOhzSpoOxPaHoTYWIhNhdgsaJcO7WZC" +
+	"u0pob0P7DGi/G9ofgQ4ToMNq6FACHY5AXAzE5UJcOcRVQdwJ6BgDHVdBx2LoWA4d90HHYxDfGOLzIL4K4o9BghcSYiEhGRIm" +
+	"QEIOJKyGhBJIqIROSdBpFHSaCZ0WQacC6LQNOu2GzhHQeSN0roDO+6DzMejSGLp0gi4L4QIvXBALFyTDBWPhgrlwQR5cUAxd" +
+	"s6BrLnRdDV03QtcK6FoFXY9BNy90i4NuqdBtL3Q7DN2B7jHQPQm6j4Xuc6H7RuiRDD0mQI+50GMV9NC0CkhsBYlJkDgWEudA" +
+	"Yh4kFkNiOSTuhcTD0BPoGQM9E6FnOvTMhp4LoWc+9CyBnpXQcx/0SodeM6HXYui1FnqVQa/d0OsQ9KqG3tHQOxF6p0PvbOi9" +
+	"EHrnQ+8S6L0deldB72OQ5IWkOEhKhaR8SDoOfSKgT1fokw59ZkKfxdCnCPqUQZ/d0Ocw9LWhbyz0TYG+GdA3F/quhr4boe8B" +
+	"6HsC+kVDv0Tolw79sqHfIuhXAP22QL/DkNwKkpMgeRQkz4TkRZBcAMlbILkS+sdB/7XQvwz674X+R2CAFwbEwoBkGLAFBk6A" +
+	"gXNhYB4MLIKBZTBwNww8BAOrISUaUrpCShqkZEFKLqSshpSNkFIBKfsg5Rhc6IUL0+DCjXDhdriwCi48DoMiYFBXGJQGg7Jg" +
+	"0EIYlA+DtsCgShh0AAadgMHRMDgRBqfD4JkweBEMLoDBW2BwJQw+AIOPQ2pXSC2Gi7rCRSlw0Ua4qBqG5MCQ3TA0FYZugaEV" +
+	"kJYEaXmQdgQuzoWLS2BYDAwrgGFVMLwrDN8C6XGQXg7pR+CSVnDJQrhkF1xyAkakwojdMDIaRsbByGIYWQ4j98KoNBhVBpdG" +
+	"w6V5cOkRGL0QxgBjImDMIhhzCMbGwdhFMLYCxu6DsUdgXBaM2w7jo2H8Khi/Fy6LgMvy4LITMGEOTLRh4iKYeAQmZcCkSrg8" +
+	"Bi5PgstHweUFcPlGuLwCLt8Hlx+BDBsyWkFGEmSMgoyZkLEIMtZCxjbI2A0Zh2AyMLkVTE6GyRNg8lyYnAeTi2ByGUzeDZN1" +
+	"umrIjIbMOMhMgcwJkDkXMvMgswgyy2BKCkw5CFNOQFYEZHWCrFTIyoCsHMhaBVnFkFUOWXsh6whcYcMVreCKJLhiFFwxE67Y" +
+	"DVfGwpXJcOVYuHIOXLkYrlwLV26DK3fBlQfhyhMwNQKmdoKpqTA1A6bmwNRVMLUYppbD1L0w9XDgE5kdA9mJkJ0O2dmQvRCy" +
+	"8yG7BLK3Q3YVZB+DaV6YFgvTkmHaWJg2B6YthukRMH0tTN8G03fBjGiYsRdmFsCsZJiVB7P2wqzDMBuYHQOzE2F2OszOhtkL" +
+	"YXY+zC6B2dthdhXMPgZXeeGqWLhqFcxJgjnlMOcQXL0FrsmBa6rg2plw7XGY2xXmLoLrWsF1M+G6AzCvK8zbAtdHwPWxcP1q" +
+	"yOkKObmQsx1uSIYbtsONXeHGRXDjbrgpCW4qgVwv5M6B3CqYnwjzt8HNcXDzWlgQAQtyYWErWNgVFlbALTlwK3BrGtxaBbdl" +
+	"wW27YFFXWLQIFu2D29Ph9iq4IxruSIU75sIdlfC7GPjdKPjdblg8ARYfgCU5sGQfLE2DpQth6TFYNhOWVcGyw5CXBHmLIe8g" +
+	"LO8KyxfD8u2wvBpW5MCKQ7AyD1ZWwqpOsCoHVh2AOzvBnXPhzn1wVzLcVQR3VcLdNtydDncvhLt3weokWF0C96TCPcVwL3Bv" +
+	"Nty7D+7Lgvvmwn3VkL8Y8vMh/zjcnwj3T4D7q+CBNHigDH6fDgUxUHAIHsyHNTGw5jA8lA9rG8PaUbB2G6w9AuuyYN1BKEyB" +
+	"wi1QeBgeToKHF8HDB6FoFBTlQdEuKKqComPwCPBIBDySBI/MhUdK4FHg0Sx4NA8e3QKP7oNHq2F9LKxPh/UzYX0urC+A9WWw" +
+	"fi+sPwTFNhTHQnE6FM+E4oVQvBqKt0HxPvgD8IdW8Iex8Ie58Idy+MMJ2JABG/Jgw3bYcBweS4XHsuCxY7AxDjYuho3FsLES" +
+	"Nh6GxxvD413h8VR4PAMenwOPL4LHj8AmYFM0bOoEm1Jg01jYNBM2LYRNBbCpBDbHwOausDkVNk+AzTmweTVsLofN+6DEhpJY" +
+	"KEmHkhwoWQ0lxVBSBiVVUHICSmOgNAtK86G0DEqr4AkbnoiFJ0bBE4vgibXwxBZ44jg8GQFPxsGTyfDkBHhyITy5F548BE+e" +
+	"gC2NYUsKbBkLW2bCloWwZTVsKYYtFbClCracgK0xsDUdts6FrQWw9Rg8FQdPrYanSuCpCnhqLzx1CJ46Dk9HwNPJ8HQWPJ0H" +
+	"T2+Bp3fDtlGwbTdsOwTPtIJn0oKwCJ5ZC8/shmdteDYGnl0MzxbAsyXwbCU8exD+OAH+OAf+mA9/LIY/boM/HoKyGChLgrKx" +
+	"UJYNZTlQthbKSqBsO5RVQdlhKDsBz8XAc4nwXDo8lwHP5cJz+fDcFniuEp47CM+dgOcj4PlYeD4Rnk+F5+fC84vg+dXwfBG8" +
+	"0BheaAUvdIUXMuCFOfDCQnihDF6ohBf2wQuHoDwWyhOhPBXKd0F5FbyYBC9uhBcr4MV98OIReMmGl1rBS0nw0ih4aRe8nA0v" +
+	"b4GKZKjYBa/Ewiu58MpheDUOXs2GV4vh1W3w6gl4bS68tghe2wvbU2B7MbwOvJ4Or2+EHdGwYyHsqIQ3EuGNdHgjG95YCG/k" +
+	"wxsl8MZhqJwLlcfgTxnwp1z402rYGQE7F8GbreDNVfDmCdg1B3btg12H4K0seKsC3joCb2fD2+XwTi68Uw27s2F3BbybDHui" +
+	"Yc8q2HMC3lsLexNh717481z4cwn8+RC8nwPvV8H7h+H9E/CBFz5YCB8chg9OwD4v7IuBfRmwrwD2A/uzYH8u7F8N+zfC/gr4" +
+	"MBE+TIcPN8KHZfBhJXy4Dz48BH8ZC38pg6oIqOoEValQlQFVq6GqCKpOwF+98NdO8NeF8NdV8NdD8FE2fFQAH22Bjw7Dx7Hw" +
+	"cSJ8nAofj4WPF8LHq+DjtfBxCXx8DA7kwIHFcKAMDhyDT+Lgk5nwSRl8shv+Fgt/Wwh/OwafpsOnGfDpETg4AQ7mwsHtcHAv" +
+	"fNYVPsuHzyrhswPweQx8PgE+z4fPK+HzffB5NXzRGL5oBV+kwRcT4Isj8EU1HEqEQ3PgUBEcqoK/x8Lfk+Hvq+HvB+HLCfBl" +
+	"CXy5Hb6sgq9S4KsJ8NU2+Go7fLUXvjoIXx2DwxlwuAy+bgxfx8HXKfD1BPh6FfyjE/wjGf6xFv5xHL5ZCEeAI2lwZBUcOQTf" +
+	"psK3JXA0Ao6uhu9s+O4gHNsF/+wK/8yHfxbDP7fBP4/D98nw/Vj4fg780Al+2AfHi+DHPPhxI/xYCT8egB+Pw09j4acy+Bn4" +
+	"eS38vBt+Pg4nWsGJLPjXavhlLPwyB35ZDL8cgpPpcHILVCdDdTZU50F1BVQfh5qZmHOUvljwrQJfMfjKwQ/408G/FvyHEBIR" +
+	"FiPsQqQxIqMQWY3ILkTZiFqIqH2IVYHYBYi9BbErEfsE4nRFnBTEmYs42xDnGOKmIG4e4lYiHhvxZCOeCsTbCvEuQry7Ee8B" +
+	"JCwHCTuChK9GGkUjjeKQRilIowlIo11I4zik8UKk8S7knBjknGzknI3IOYeRc9OQc4uQcw8jTRKRJqlIkyykyUKkSQHSZAvS" +
+	"pAJpshtpcgiJAImIQCJikYhkJGIsEjEHiViMRKxFIrYhEduRiL1IxGEkEiQyBolMRCJHIZFzkMiFSOQqJLIYiSxHIvcikYeR" +
+	"piBNY5CmiUjTdKRpNtJ0IdI0H2lagjStQqJsJKoVEpWERK1Gog4h0UlIdAESfQRploI0y0eaHULOW4Q0j0CalyAxaUhMBXJ+" +
+	"EnJ+CdIiGmkRh7RYhbQ4jLRMQVquRVoeQVpWI60aI62ykFZbkNaNkdZzkdZ7kTapSJttSNt0pG0G0nYO0nYh0nYV0vYgEpuE" +
+	"xJYj7Toh7UqQ9hFI+zSk/UKkQwTSYQ7SoQqJS0TiViMdbaRjEtIxD4nvisQXI/HHkYQYJGER0ikW6ZSLdNqLdF6LdD6MdJmL" +
+	"dNmOXDATuaAc6ZqOdM1HujVGus1FurdCuuci3fOQ7lVIj0VIj11I4mIksQTpmYT0rEB67kZ65SC9ypBeVUivE0jvVKR3NtJ7" +
+	"N9L7GJI0E0najvRJRPrkI32OIH0nIH3zkL4HkH55SL9jSPIoJDkH6W8j/Vch/Q8iA1KQAceRgWORgceRlLFISh6SchC5cC5y" +
+	"YSUyKB0ZVIEMXoQMPoSkpiCpRchFrZCLcpGL8pCLSpAhXZEhOixHhuYiQ/ciQ6uRtK5I2lgkbS6SVoSk7UPSjiAXe5GLk5GL" +
+	"s5CLVyEXlyAXlyMXVyEXn0CGRSPDEpFhE5BhOciw1ciwjciwvcjwCGR4EjK8CBl+CElPQ9LnIunbkPTdyCU2ckkKckk+csk2" +
+	"5JLDyIhEZEQ2MqIIGWkjI2ORkROQkRuRkUeQUSnIqCJk1DHk0mTk0hzk0hJkdCtk9EJkdD4yWv/ejow+gIzxImOKkLHpyNh8" +
+	"ZOwhZJwXGZeNjCtAxoOMn4OMX4yMX4uM34aM34WMP4iMr0Yui0Yu64pcloZcloVclotcdhC57AQyIRWZkIFMKEImlCETc5GJ" +
+	"q5FJ6cikbGTSbmTSIeTyucjlecjlxcjl5cjle5GMfCTjCDJ5ETK5AMkEycxHpqQgUyYgUxYjWa2QrDQkKxvJOoZc0Ri5Ih+5" +
+	"Yh9yZSvkykTkyjTkSv27Gpkag0xNR6aWINmxSHYJMs2LTJuATNuITDuOTF+ITN+GTD+GzACZkYTMKEBmpiEzs5GZ5cjMvcjM" +
+	"w8gskFmxyKxkZNZYZNYcZNZiZNZaZNY2ZNYuZNZBZNYJZHYEMrsTMjsVmZ2BzM5BZucjs0uQ2duR2VXI7GPIVV7kqljkqmTk" +
+	"qrHIVXOQqxYjVxUjV5UjV+1FrjqMzPEic2KROanInAxkTg4yZxUypxi5eixy9Rzk6lXI1fp3OXL1XuTqw8g1INcUINcmItem" +
+	"I9dmI9cuRK7NR64tQa7djlxbhVx7DJnrRebGInOTkbljkblzkLmLkbnFyHU2cl0r5LoU5LoJyHXVyLxWyLwkZN4oZN5MZN4i" +
+	"ZN5qZN5GZF4FMm83Mu8AMu8IMq8aub4xcn0+ktMYyVmI5KxCctYiOSXIDV7khhTkhrnIDWuRG3YhNxxDboxBbkxDblyE3FiF" +
+	"3HgcuSkZuWkuclMBclMFctMhJDcDyV2N5B5H5sch8ycg84uQ+QeRmxsjNy9Cbt6G3FyNLOiELMhAFpQjC44gC1ORhXOQhWuR" +
+	"W1oht6Qht+Qgt5Qgt3ZCbi1Dbt2L3BaL3JaC3JaN3JaH3LYPue0EsigCWZSELMpAFi1CFpUgiw4gi04gtxchtx9D7shF7qhC" +
+	"fpeE/C4fWdwKWbwIWbwXWZKGLClGlkYjS3OQpZXIshhk2UYkrzGStwjJO4wsT0WWb0RWdEVWFCErY5CVG5FViciqjcidqcid" +
+	"Zcide5G7YpG75iB3LUbu2o3c3Qq5ezFy92FkdSqyeguyuhq5Zw5yb2Pk3gnIvXORe/ci9yUi961C8mOQ/CQkfxFyfyfk/gnI" +
+	"/XOQ+7chD7RCHihAHqhGfl+CFLRCCrYgD85BHixH1qxG1pxAHipH1i5E1h5A1nVF1i1C1h1GChORwjyk8DDycDrycA7ycBVS" +
+	"lIYUlSOPNEYeKUAeOYg8mog8uhp5tApZ3xVZvw0p7ooUz0WK9fNx5A/ZyB82Ihu8yIYcZMNu5LFU5LHVyGP7kI2dkI0zkY0H" +
+	"kMdbIY/PRTbZyKZRyKZyZHMysnkXUtIKKVmNlHqR0kVIaSXyRCzyxG7kySTkyXzkyRPIlgnIli3I1kRk6yJk63HkqSzkaRt5" +
+	"Oht5+iCyLRXZVoE80wp5ZhXyTBXybBbybBXyx07IHwuQssZI2WKk7BDyXBryXAXyfBLyfAnyQgzywkLkhRNI+QSkPA8p34u8" +
+	"CPJiDPJiOvJiNvLiauTFjciLFciL+5CXbOSlVshLSchLo5CXZiIvLUJeKkBe2oK8VIm8dAB56TjycmPk5Tjk5RTk5QnIy3OR" +
+	"l/OQl4uQl8uQlw8iFSAVsUhFFlKxFqnYh7wSjbyShrwyF3klD3mlGHllF/LKEeRVG3m1FfJqEvLqKOTVhcir+cirFcir+5DX" +
+	"QF6LQV5LRF5LR17LRV5bjby2EXmtAnlNpzmCbLeR7a2Q7UnI9lHI9pnI9kXI9gJk+xZkeyWy/QCy/Tjyeivk9WTk9cXI62uR" +
+	"1w8grx9HdqQjO7KRHQuRHfnIjkPIjmrkjWjkja7IG2nIG1nIG7nIG6uRNzYib1Qgb+xD3jiCVNpIZSukMgmpHIVUzkQqy5HK" +
+	"vUjlYeRPIH+KQf6Ug/xpFfKng8ifTiA7xyI75yA7FyM7q5Cdx5A3vcibscibycibY5E35yBvLkbeXIu8uQ15cxfy5kHkzRPI" +
+	"rghkVydkVyqyKwPZtQV5y0beaoW8lYS8NQp5ayby1kbkrQrkrWrk7Wjk7QnI23ORt/OQtyuRtw8gbx9H3mmMvBOHvJOCvDMB" +
+	"eWcu8k4e8k4R8k4Z8s5u5J1DyDvVyO5oZHdXZHcasnsRsrsM2b0P2X0Eebcx8m4c8m468m428u5C5N0i5N0y5N3dyLuHkHer" +
+	"kT3RyJ6uyJ40ZE8WsicX2bMa2bMR2VOB7NmH7DmCvGcj77VC3ktF3luLvFeO7PUie2ORvTnI3lXI3ipk7zHkz17kzxnIn3OQ" +
+	"P69C/lyM/Lkc+fNe5M+HkfdB3o9B3l+EvF+BfBCLfJCFfJCLfLAN+WAXss9G9rVC9iUh+3KQfauQfcXIvnJk315k32FkP8j+" +
+	"GGR/IrI/HdmfjexfiOzPR/aXIPu3I/urkA8jkA8nIB/mIx+WIB8eQj6sRv6ShPxlFPKXmchfipG/lCN/2Yv85TBSBVIVg1Ql" +
+	"IlXpSFU2UrUQqcpHqkqQqu1IVRVSdQz5qxf5ayzy1wnIX3cjH4F8lIF8VIZ8tBv5OAv5OBc5YCMHWiEHkpADo5ADM5EDi5AD" +
+	"BciBLciBSuTAAeTAceSTxsgnccgnKcgnE5BP5iKfHEP+Fov8LRn52xzkb4uRv21D/rYL+dtB5NPGyKdxyKcpyKcTkE/nIp/m" +
+	"IZ8WIZ+WIZ/uRj49hHxajRyMRg52RQ6mIQezkIO5yMHVyMFy5OAu5DOQz9KQz1Yhn+nfB5HPY5HPk5HPFyGfFyCfVyFftEK+" +
+	"SEK+WIR8UYB8sQX54ghyqCtyaBVyqBg5VI38PRr5+1zk73nI3w8gfz+OfNkY+TIO+XIh8uVh5KsM5KtdyOFk5PBY5PAc5PBh" +
+	"5GuQrxcjX69Fvt6GfL0L+cco5B9lyDetkG+SkG+KkG/KkCOtkCNJyJEC5MhG5Iim7UaOHEKOVCPfRiPfdkW+TUO+zUK+zUW+" +
+	"3Y4cbYwczUCObkSOViBH9yFHjyDf2ch3rZDvkpDvRiHf7UWOFSDHDiLHTiD/TEX+mYH8sxj5ZznyPcj3qcj3i5Hv1yLfVyHf" +
+	"H0N+SER+SEd+yEN+OIgcT0GOFyDHK5EfY5EfJyA/zkV+zEN+LEJ+LEN+3I38eAj5sRr5KRr5KRH5KQP5aTXy027k52jk5znI" +
+	"z/nIz5XICRs5MQo5UYycqEL+1RX511rkl1jkl+3IL8eRk6nIyWKkOgap3ohUH0dqRiE1eYgvBn1yW/xxiD8D8a9G/JUoIlDs" +
+	"QklXlKSgZC1KTqBUJ5QqR6lDKCsWZSWirLkoaxHKWo2yilDWFpR1HGWPQtkHUE4xyjmAcmNQbjbK3YfypKM8GSjzF+APo7wT" +
+	"UN7dKO8BlPcIyluNCstChS1Cha1GhZWhwipR4XGo8CRU+FxUeBmqUSKq0WpUowpUoyOoxlmoxqtRjUtQjQ+gzolGnZOEOmcu" +
+	"6pwi1Dm7UefGos49gGoSh2qSj2pSjooAFZGMiihHRaahIotQTRehmh5ERSWjoragoqNR0RtRzZJQzSpRzQ6jzmuFOq8I1dyL" +
+	"ap6Nar4LFWOjYlJQMcWomEOo81NR5xehWkSjWiSjWhxAtYxFtSxBtYpGtVqIarUa1aoY1aoM1WoXqtUBVKtjqNYTUK3noFov" +
+	"QrWuRrVpjGqTjmpzANW2FartYlTbw6jYhah2jVHtilDtDqLax6Lab0S1r0Z1SER1mIPqsBbVYR8qLg0VtxAVtw0VtxfVMRvV" +
+	"sQoVPwoVX46KP4RKGItKOIDqtBrVaR+qcxKqcxmqSydUlzmoLntRXU6gLuiEumAh6oKDqK7pqK5lqK5VqK4nUN1aobqloLrN" +
+	"RXVbi+q2DdVtF6rbQVS3E6juEajunVDdU1HdM1Ddc1DdV6G6F6O6l6O670V1P4zqAapHDKpHIqpHOqpHNqrHQlSPfFSPbage" +
+	"u1A9DqJ6nEAlRqASO6ESU1GJ2ajExajEtajEbajEXajEg6jEE6ieEaienVA9U1E9M1A9c1A9V6F6FqN6lqN67kX1PIzqBapX" +
+	"DKpXIqpXOqpXNqrXQlSvfFSvElSv7aheVahex1C9I1C9O6F6p6J6Z6B656B6r0L1Lkb1Lkf13ovqfRiVBCopBpWUiEpKRyVl" +
+	"o5IWopLyUUklqKTtqKQqVNIxVB8vqk8sqk8yqs9YVJ85qD6LUX3WovpsQ/XZhepzENXnBKpvBKpvJ1TfVFTfDFTfHFTfVai+" +
+	"xai+5ai+e1F9D6P6geoXg+qXjOqXgeqXg+q3CtWvGNWvHNVvL6rfYVQyqOQYVHIiKjkdlZyNSl6ISs5HJZegkrejkqtQycdQ" +
+	"/b2o/p1Q/dNR/bNR/Rej+q9F9d+G6r8L1f8gqv8J1IAI1IBOqAGpqAEZqAE5qAGrUAOKUQPKUQP2ogYcRg0ENTAGNTARNTAd" +
+	"NTAbNXAhamA+amAJauB21MAq1MBjqBQvKiUWlZKMShmLSpmDSlmMStmNujACdeEu1IVVqAuPoQZ5UYNiUYOSUYPGogbNQQ1a" +
+	"jBq0FjVoG2rQLtSgg6hBJ1CDI1CDO6EGp6IGZ6AG56AGr0INLkYNLkcN3osafBiVCio1BpWaiEpNR6Vmo1IXolLzUaklqNRd" +
+	"qNSDqNQTqIsiUBd1Ql2UirooA3VRDuqiVaiLilEXlaMu2ou66DBqCKghMaghiagh6agh2aghC1FD8lFDSlBDtqOGVKGGHEMN" +
+	"9aKGxqKGJqOGjkUNnYMauhg1dC1q6DbU0F2ooQdRQ0+g0iJQaZ1QaamotAxUWg4qbRUqrRiVVo5K24tKO4y6GNTFMaiLE1EX" +
+	"p6MuzkZdvBB1cT7q4hLUxdtRF1ehLj6GGuZFDYtFDUtGDRuLGrYINawCNWwfatgR1HAbNbwVangSavgo1PCZqOGLUMMLUMO3" +
+	"oIZXooYfQA0/jkpvjEqPQ6WnotIzUOk5qPRVqPRiVHo5Kn0vKv0I6hIv6pJY1CXJqEvGoi6Zg7pkMeqStahLtqEu2YW65CDq" +
+	"khOoERGoEZ1QI9JQI7JRIxaiRuSjRpSgRmxHjTiAGnECNTICNbITamQqamQGamQOauQq1Mhi1Mhy1Mh9qJHHUKO8qFGxqFHJ" +
+	"qFFjUaPmokatQo0qRo0qR43aixp1GHUpqEtjUJcmoi4dhbp0JurSRahLC1CXbkFdWom69ADq0uOo0Y1Ro+NQo1NQoyegRs9F" +
+	"jV6FGl2MGl2OGr0PNfoIaoyNGtMKNSYJNWYUasxM1JhFqDEFqDFbUGN2ocYcRI05gRobgRrbCTU2DTU2GzV2IWrsKtTYYtTY" +
+	"baixu1Bjq1Bjj6HGgRoXgxrXCTUuFTVuLGrcHNS4hahx+ahxJahx21HjqlDjjqHGe1HjY1Hjk1Hjx6LGz0GNX4wavxY1fhtq" +
+	"/C7U+IOo8SdQl0WgLuuEuiwVdVkG6rIc1GWrUJcVoy4rR122F3XZYdQEUBNiUBMSURPSUROyURMWoibkoyaUoCZsR02oQk04" +
+	"hproRU2MRU1MRk0ci5o4BzVxMWriWtTEbaiJu1ATD6ImnkBNikBN6oSalIqalIGalIOatAo1qRg1qRw1aS9q0mHUpBOoyyNQ" +
+	"l8eiLk9EXZ6BunwO6vLFqMvXoi7fhrp8F+ryg6jLT6AyIlAZsaiMZFRGOiojG5WxCJVRgMrYgsqoRGUcQGUcR022UZNboSYn" +
+	"oSaPQk2eiZq8CDV5NWpyCWrydtTkKtTkY6hMLyozFpWZiMpMR2VmozIXojLzUZklqMztqMwqVOZh1BQbNaUVakpX1JQU1JQJ" +
+	"qClzUVPyUFOKUFPKUFN2o6YcQE05jsqyUVmtUFldUVkT/guQg8pajMoqQmWVo7L2obKOoa7woq6IRV2RhrpiDuqK1agrtqGu" +
+	"OIK6shPqypmoK4tQV+5FTY1BTZ2LmroKNXUjaupeVHZjVHar3wAnUNNt1PQ41PQU1PQs1PSFqOn5qOnbUNP3oaZXo2bEoWZk" +
+	"oGbkomYcQc0ENTMZNTMHNbMINXM7auYB1MwTqFmdULMyULNyULNWoWZtRM2qQs1ujJo9CjV7NWp2MWr2PtTsI6ir5qCuWo26" +
+	"6ghqDqg5E1Bz8lFzylFX26irs1FXr0ZdXYW6Jh11zWLUNZX/OVwbh7o2HXXtYtS1x1BzF6HmbkRdNxZ13V7UPBs1LxY1Lx01" +
+	"Lw81bzvq+hjU9V1R16eirp+Aun4O6vpFqOvzUddvRF1fjrp+N+r6g6jrj6NyvKicVqicRFROGionA5UzF5WzGJVTgMopQeVU" +
+	"oHL2onIOoXJOoG5ojLohFnVDEuqGdNQN21E35qJu8qJuSkfdVIbKTUflVqDmb0HdvAi1oCtqQT5qQQlqYRpqYT5q4WHUrXNQ" +
+	"t9mo24pQi5JQi4pRt6eibt+NugPUHYtRdxxC/S4d9bu5qN+tRv2uDPW7A6jFEajFo1CL81BL0lFL41BLi1BLy1DLElHLdqPy" +
+	"clF5eai8tai8bajlx1ArvaiVGaiVZahVrVCrtqPunIC6Kwl1Vxnq7jTU3atQq2NQq3ej7lmMuuc46t581H1FqPwi1P3pqPsr" +
+	"UA+MQj1wGPX7dNTvC1C/P4wq6Ioq2IgqKEMVVKIe3IJ68DDqwROoNV7UQ7Goh46g1uai1h5CrStHFW5DFR5AFcWhihaiHolF" +
+	"PVKEejQW9ehi1KO7UOsTUevLUMUZqOJdqOITqD+koP6Qj/rDCdSGGNSGFNSGuajHvKjHylAbM1AbC1Abj6MeL0A9Xol6/BBq" +
+	"k43a1BW1aRRq01zUptWoTVtQm3ajNh1BbW6M2twVtXkUavNc1ObVqM1bUJsrUZv3oTYfQZU0RpXEoUqSUCWjUCVzUSUbUSUV" +
+	"qJLdqJJDqFIbVRqNKo1DlSahStNQpRNQpXNRpatRpRtRpWWo0t2o0iOoJ6JRT3RFPZGCemIC6olc1BN5qCcKUE9sRD1Rhnoy" +
+	"C/XkCdTWTqityait6aitJaitu1BbT6Ce6oR6KgP11CrUU7tRT0egnk5FPZ2DeroY9fRe1LYY1LZs1LYTqGfyUc9UoZ6NRT07" +
+	"B/XsNtSzJ1B/TEX9cRHqj+WoPx5HlSWiyhajnvOinstHPT8K9YIXVQ6qPBtVvhFVXoV6MQL1YjrqxcWoF8tRLx5DvZSDeukY" +
+	"6uVE1MtFqIpUVMUh1Cte1CuJqFcyUK8sRr1SgnplL+qV46hXY1CvJqFeHYt6dS7q1TLUq9Wo19JQr61GvVaCeq0Stb0Tansx" +
+	"6nUb9Xoa6vU81OuVqB02akc2akcFakc16o0U1BuLUG9UoCpBVaaiKlejKqtQf+qE+tMi1J+2o3Z6UTtHoXYWoHbuRb3ZGPXm" +
+	"KNSbq1Fv7kbtaozaNQe1qwL1VjTqrRzUW/tQb6ej3i5CvX0Q9U4c6p2FqN1jUbvnonbvQr3bGPXuWNS7W1B7bNSeLNSeXag9" +
+	"1aj3ElHvLUa9V4Ha2xW1txK19wTqz9mo9yNQ76ei3s9BvV+F+mAu6oNK1L6uqH3bUftjUPuTUPuzUPvzUPs17QDqwxjUhxNQ" +
+	"H85EfZiH+nAv6sNDqL+A+ksS6i+jUH+ZifrLMVRVEaqqAlV1EPXXUai/bkP9dTfqI1AfdUJ9lIP6qAj10S7Ux9Goj7NRH29H" +
+	"fVyF+vgE6hNQf1uEOjgH9VkK6vM5qC/Wor6oQH1xEPVFNepQJ9ShVNShRahDW1CHdqP+3hX199WoL72oL0ehvtyC+qox6qtc" +
+	"1FcHUYezUIf3ob6eg/qHF/WPCtQ3GagjEahvbdS3i1Df7kMdXY36DtR3MajvklHfZaC+W4j6rgj1XQXqWDTq2GrUsb2of0aj" +
+	"/jkB9c+NqH8eRn2/D/VDFuqHg6jjnVDHs1DHy1HHq1E/JqF+nIP6cQvqxyOon8aifipD/VSN+jkV9fM+1IlU1IkC1InDqH+N" +
+	"Rf2rDPVLIuqXLaiTWajqxqjqsajqAlRNDKqmGFVzAOWLQfnmonzbUf6xKP9BLMZisR1LvFgyFksqsFQnLLUIS+3FskZhWbux" +
+	"7CQsuwjL8WI5sVjOKixnG5ZTheU2xnLTsNy5WG4+licDy+vF8u7DCtuIFZ6B1Sgdq3Ec/z92/gU8rqn9/8ffM2utvWf27Dmf" +
+	"gmJUaappmz2zZ5JWVJPMniaattGmB7Q0TaZNNCfJpAeKogiKoAiKIiiKIiiKIiiKoiiirSqCoiiK4n9lcqctj+f5fs6fz/+6" +
+	"fp7r6doz2Xu911p77/t13+udlqnbwexLwezbwRwhMMciMMcaMMdWMKcFzJkN5iwDc84Hc64Ac24Cc6lgrhIw1xIw1wYwdyaY" +
+	"exGYeweYZz6Yl4N5dTDvDDDvMjDvRjBfCszvAvPPB/OvAfNvBwv0AwuUgQWWgAW2ggVdYMFRYMEGsOBqsAyAZWSDZYwCy0iB" +
+	"ZWwHOyAL7IAGsAM6wA7YDnagD+zAhWAHdoAduAPsoA6wfgvBDs4FO3gX2CGlYId0gB2yE+zQTLBDW8EO3QkWioOFloKF1oEd" +
+	"BrDDysAOWwHWPw+s/1qwwzPBDm8FO7wLbEAm2IDlYEf4wI5YBnbkALAjF4IduRFs4CiwgSvBBm4AG7gTLNMHlpkLlrkSbJAK" +
+	"NqgKbNAisEG7wI6aCnbUerDBOtjgFWBZFrCsSrCsTWBDRoENSYEN2QU2NAQ2dCrY0MVgQ1eBDV0PNnQn2LBSsGENYMNawYat" +
+	"Ahu2AWzYbrDsEFh2EVh2A1h2K1j2djBtA5i2CyycARbOBQtPBQvPBwuvA4v0A4tUgUWWg+kqmN4ANnwA2PBWsBEcbIQPbMQA" +
+	"sBE62Ig42IgU2IhOsKMtYEePAjt6GtjRS8GOXg929A6wvAFgeUVgeZVgeS1geSvB8taD5e0EO2YA2DELwY5ZBXbMerCRABs5" +
+	"FWzkErCRK8BG7gI7NgR27DqwYzeBjSoFG7UWbFQ3WP58sIJssEILWGE7WLwSzMgFMzrBEh1go1vAiqaCFaXAilrBilaCFa0D" +
+	"K9oDVqyDFdeAFa8AK94KdlwI7LgqsOM6wI7bBHbcHrAxIbAxcbAxVWBjloCNWQU2ZiPYmF1gJRlgJXlgJTPAShaBlSwHK1kL" +
+	"VrIdbKwKNlYHGzsDbOwSsLEdYGO3g41zgY3LBRs3A2xcC9i4DrBxW8HGW8DG54KNrwQbvwRsfAfY+K1gpSpYqQ5WOg2stAWs" +
+	"tAOstAvseIAdnwV2/FSw4xeBHb8C7PiNYBMANiETbMJUsAlLwCasBZuwC2xiJtjEaWATW8EmrgMrA1hZLlhZA1hZO1jZJrBJ" +
+	"KtikUWCTUmCT2sAmdYJN2gk2uR/Y5DjY5BqwyW1gkzvBJu8GmzIAbEop2JTFYFNWgU3pApvqApuaBza1CmzqMrCp68FOANgJ" +
+	"cbATUmAnrAA7oRvsxH5gJ5aAnbgE7MS1YCfuBDspG+yk+WAnrQGblgE2rQhs2nywaSvApm0Cm7YbbLoPbHoZ2PQGsOlLwKav" +
+	"AJveCTZ9K9j0PWAnZ4CdrIOdPBXs5EVgJ7eDnbwe7OStYCfvATslA+wUHeyUUrBTasBOaQE7pR3slLVgp3SBnbIbbIYPbEY2" +
+	"2IwSsBlVYDMWg81oAyuvBKsIgVVsB6vYDVapglVmglXmgVWWglWmwCpbwSo7wCo3gVXuAEtysGQmWDIOlmwAS3aCJbvAZulg" +
+	"s5aCzd4AVjUVrGor2KnLwE5dDXbqdrA5AJuTBTanCGxOCmzOUrA5nWBztoLVWMBqBoDVlIDVVIHVLAWrWQVW0wVWsxusdgBY" +
+	"7Siw2hqw2haw2g6w2g1gtXvA6jLA6uJgdTPA6paA1a0Eq9sKVj8ArL4DrL4brKEU7LQisNP2gDWuBmvcBdZUAtbUDpYCWGoa" +
+	"WKoTrNkH1pwH1lwJ1rwGbK4ONrcFbG4X2DwL2DwdbN4MsHmtYPM2gs3PAJuvg80vBZtfBTZ/EdgCDrYgG2zBNLAFi8EWrARb" +
+	"0A12ug52+gyw01vATt8JdkY22BkNYGesAjtjJ9jCBrCFO8HObAA7cyfYWRlgZ40CO6sK7KylYGetAjurC+ys3WBnh8AWucAW" +
+	"LQQ7JxvsYgvYkg1gl1rALq0Bu3QN2GUhsMs6wC5fBNZaAta6CuyKErCrFoNdkwt2TQPYNd1g104Du7YD7DoX2PUrwa7fBXZD" +
+	"JtgNe8CWrQW7sQzsxm6wm1aC3ZwCW54LtnwPWHsDWPsOsDtUsDsrwe5eDHYPwO5ZDHbPbrCV08BWbgW7twHsvg6w+zeBrUqB" +
+	"PWABe2AN2ANdYA/sAXuwH9iDeWAPTgN7cCHYg8vAHlwD1pEJ9jAHe3gr2CNLwB5dAbY6F2z1OrDHFoE9tgPs8algj3eCPdEP" +
+	"7In5YGtUsDWrwZ4MgT3ZCvZUJthTLWBPjwJ7eg/Y2k6wZ3LBntkE9mwW2LPrwJ5rA+v0gT2fB/ZiFdg6gL3UD+xlHeyVIrD1" +
+	"XWCvbgR7rQzs9Qyw17eDbZgPtmEH2BtLwN7YCPZmCuytPLC3WsHeWgu2cSvY2+vA3rGAvVMJ9s46sHczwd6dD/ZuJ9imqWDv" +
+	"ZYC9D7AuFaxrJ9hmDrYlG2xrCOwjHWzbVLCPc8E+3gP2iQXs0zywz5aCdbeAfR4C+7wV7IsisC/jYDsWg31tAftmA9jOGrBv" +
+	"s8C+XQr2XQjsuzyw7yrBvlsI9t1SsO9Wgn3XCfb9ALDvK8G+7wbbtRXshxawH5eB/VQE9tM6sN1VYD/XgP2aB/bbRrA/FoP9" +
+	"sQ4cFnAsAccmcNMqcHMbOFsIzi3gfA+4NABczgC3qOCWdeDWLHBrN7htFLi6FtyRC+5IgTt2gTtLwJ0rwJ27wV2V4K514O5s" +
+	"cPdScE8euLcfuC8L3N8GHsgCD+4EP2AJ+EFF4IdawEOrwPuXgfffCn54K/iRueADN4EPsoAfZQEfrINn9QPPagcfYgEfUgM+" +
+	"ZBX4kJ3gQ9eAD1sFnp0Nnl0Jnr0cPHs7uOYD11LgWjd4eAN4pApcHwAebQfPqQEfsR78aB386N3geTPA83aDH9MCPrIIfORG" +
+	"8GPLwI9dDz4qD3xUK/io3eD508DzO8ALUuAFq8ELVfDCaeCF68Dji8ETueBFueDF88HHrAAfGwcv9YFPVsGnVIGfsBr8xIXg" +
+	"J40Cn6aCT28FP8UFPqMBvHwDeEU/8Irl4BUbwSst4JW54JVV4JVrwSu7wCt3gyd94Mk88OQM8ORy8ORW8Fld4LNbwKumgVdt" +
+	"Aq+eCn7qfPA508DnrAOvyQWvSYHXdIDXdIHXWsBrs8Frp4HXLgavXQVe2wVeZwGvqwGv6wKvzwOv7wRvqAFv2AR+WiV4owW8" +
+	"sRK8KRO8aT14qga8uRR8bgp83mrw+e3gC9aCn74H/IxN4GeGwM+qBD97KfiifuCLusHP2Qh+bhX4eVngi13gi1vBz18IfkEV" +
+	"+IWjwC9sAL9wGfiF68Av3AXeMgC8ZR14y07wizLAL6oBv2gt+EXd4BdngF9cBH5xFfjFa8AvcYFfkgd+SQ34JR3gl+wBX1IK" +
+	"vmQ5+JId4JfmgV/aCn7pdvDLpoJftgf88jLwy5eBX74LvHUZ+BU6+BVd4FeuAb9qKvjSxeBXrwG/pgz8mg7wa33g15aCX7sY" +
+	"/NrV4NduBW+zgLeFwNtywdtawdu2g1+3Evz6FPgNNeA3LAO/YT34Mhf4slLwZa3gy3aA35gJfmMl+I2rwG/cA35TJfhNleA3" +
+	"zQe/aQn4TSvAb1oDftMm8Jt2gd+cAX5zFvjNReA3V4Lf3AJ+czv4zevAb94BvjwDfHkW+PIi8OWV4MtbwJe3gy9fB758B/gt" +
+	"GeC36OC3TAW/ZSH4LSvAb1kPfstu8FsHgN86FfzWGvBbW8BvbQe/dR34rdvBb7OA35YFfttU8NtS4Le1gd+2Bvy2bvB2Fbw9" +
+	"F7y9Ery9Dby9A7x9E3j7HvDbM8FvLwG/fT747e3gt28Cv30P+B2Z4HeUgd/RAn5HB/gd3eB39gO/cyr4nTXgd7aA39kOfuc6" +
+	"8Du3g6+wgK/IAl8xFXxFCnxFG/iKNeArusHvUsHvygW/qxL8rjbwuzrA79oEftce8Lszwe8uAb97Pvjd7eB3bwK/ew/4PZng" +
+	"95SB39MCfk8H+D3d4Cv7ga+cCr4yBb6yDXzlGvCV3eD3quD35oLfWwl+bxv4vWvA7+0Gv88Hfl8R+H0p8PtWgN/XBX5/Bvj9" +
+	"eeD3V4HfvxT8/nXg9+8CX5UJvmoa+Ko28FWd4Kt2gz+QBf5AFfgDy8Af2AT+oA/8wangD9aAP9gC/mA7+IPrwB/cDv6QBfyh" +
+	"LPCHpoI/lAJ/qA38oTXgD3WDd6jgHbngHZXgHW3gHR3gHZvAO/aAP5wJ/nAJ+MPzwR9uB394E/jDe8AfyQR/pAz8kRbwRzrA" +
+	"H+kGf7Qf+KNTwR9NgT/aBv7oGvBHu8FXq+Crc8FXV4KvbgNfvQZ8dTf4Yz7wx4rAH0uBP7YC/LEu8MczwB/PA3+8CvzxpeCP" +
+	"rwN/fBf4E5ngT0wDf6IN/IlO8Cd2g6/JAl9TBb5mGfiaTeBP+sCfnAr+ZAr8yTbwJ9eAP9kN/pQK/lQu+FOV4E+1gT+1Bvyp" +
+	"bvCnfeBPF4E/nQJ/egX4013gazPA1+aBr60CX7sUfO068LW7wJ/JBH9mGvgzbeDPdII/sxv82SzwZ6vAn10G/uwm8Od84M9N" +
+	"BX9uIfhzK8Cf2wjeaQHv1ME7q8A7l4F3bgJ/noM/nwv+fA348yvAn+8CfyED/IUy8BfawF/oBH9hN/iLWeAvVoG/uAz8xU3g" +
+	"63zg66aCr1sCvm4d+Esc/KUi8JcWg7+0DvxlFfzlqeAvt4O/vBP8lVzwVxaCry8Ff3UA+KurwF/LA3+tBfy1TvDXAf56Lvjr" +
+	"DeCvLwN/fSP4BhV8Qxb4hgbwDevA31gC/mYl+Ful4G/NB39rF/jGxeBvW8Df3gP+Thf4uzXgm/LA3wP4+xng768C/2AxeFcI" +
+	"vGs9+IfzwT/sBt88FXxzFfjmReCbl4Nv7gLf4gPfMg18y3LwLd3gW7PAt6bAt64B/2gJ+LZp4NtWgm/bDf7xKvDtleDbO8E/" +
+	"CYF/sgL80yzwT9eCf1YK/tlK8G4VvLsBvHsD+Ocq+OdF4J+3gn++CfyLfuBfzAD/YiX4F7vAv2wA/3In+I4W8K9C4F8tB/+q" +
+	"G/zrbPCvl4J/vQv8mxLwb5aD78wA39kK/m0K/Lup4N+HwL/vBt+VDb5rPviuteA/WMB/KAX/oQ38hx3gP/rAf8wD/7ES/MdW" +
+	"8B/XgP/YDf6TD/ynEvCfFoP/tBr8p53guzPBd08D390CvrsDfPdW8J8t4D/ngv9cBf5zG/jP68B/SYH/0gb+ywbwX33gv+aB" +
+	"/1oF/uty8F87wfcAfE8J+J5W8D07wH8rAf+tE/z3UvDfV4H/vhb8jyzwPxrA/+gA/2MHBAZAYBEENkKYXBCmGgjTWgjzAAhz" +
+	"C4R5JwTLgGCjINgqCO6C4Isg+CoI3gnBd0CIVgjRBSEVQUhrIGQdQt4AYekHYVkMYQWEdRGEdS2EMgBCWQ5hy4SwtUDYdkCo" +
+	"2RDqcgi7CmEvg7CvhXBkQTjWQzh1COd8COdaCFcGhKsGwrUBwp0N4W6D8KgQnjIIzzoIbxaEtwXC2w3hy4PwLYHwZ0D4WyAC" +
+	"GRCBlRCBnRBBHSK4ECK4GiK4DiK4CSLYDRHcDZGxFeKALIgDqiAOaIc4YDvEgVUQBwHioBKIgxZCHLQK4qA9EP2KIPothOi3" +
+	"GqLfOoh+uyEOXgRxcDfEwbshDglBHJINcchCiENWQBzKIQ7dCBEqhQjthDhsAMRhMyAOWwPRvwiifzfE4YshDl8Ncfg6iAEt" +
+	"EAPWQQzYBHEEII7IhjhiLcQROyCObIU4cjXEwCyIgXGIgZUQAxdDDGyHGNgJMXAjxMAdEJkcIrMfRKYOkVkCkVkJkbkQInMp" +
+	"ROZKiMxOiMwuiMxdEINUiEEDIAblQQwqgxhUAzFoMcSgZRCDOiAGrYcYtB1i0B6Io3wQR2VBHBWHOGoaxFEpiKOWQBzVDnHU" +
+	"GoijNkIctQNiMIcY3A9isA4xuARicCXE4IUQg5dCDF4JMbgTYnAXxOBdEFkqRNYAiKw8iKwyiKwaiKzFEFnLILI6ILLWQ2Rt" +
+	"h8jaAzHEBzEkC2JIHGLINIghKYghSyCGtEMMWQMxZCPEkO0QQ3ZBDM2DGFoCMXQaxNDFEEOXQgxthxjaBTF0B8TQPRDDVIhh" +
+	"/SCGZUEMy4MYVgIxbBrEsBqIYQshhi2BGLYTIhsQ2S6I7BBEdjZEdg1E9kKI7CUQ2csgNBVC6wehZUFoeRBaA4S2CEJrhdCW" +
+	"Q2jbIbRdEOFSiPAMiHADRLgFIrwcIrwKIrwWIrwDIuKDiAyAiOgQkThEpAwisgtCb4XQV0DoayGiOkR0OUR0F0RsEURsE0RO" +
+	"FkRODUTOSoicnRC52RC5RRC5MyByt0MML4MYnoIYvhTi6H4QR7dCHN0NkZcJkTcDIq8DIq8TIm8jRN52iGNUiGN0iGOmQRyz" +
+	"GOKYFRDHbIA4ZjfEyBDEyCKIkTUQI5dAjFwFMXIjxMjdEMdmQBwbhzi2AeLYZRDHroM4tgtiVAhi1AyIUa0Qo9ZC5KsQ+SmI" +
+	"/D0QBW0QBSsgCuMQhQ0QhYsgCrdDxFdDGDqEsRUiMQoisQlidAhi9HKI0bshipZAFO2EKG6FKF4OUbwKongPxHFFEMctgjhu" +
+	"BcRxXRBjVIgxbRAlIYiSNRBj4xBjl0OMs0CMWwwxPgQxfjlEqQpRWgNRuhHieB3i+OUQx6+FOL4LYoIOMaEFYkIbxIS1EBO2" +
+	"Q0wExEQXxMSFEBNXQ5S5IMraISZNg5i0CmIyh5hcAzF5B8SUhRBTNkFMTUGc4IM4oQrihA6IE0sgTpwGceISiBM3Qpzkgjgp" +
+	"C+Kk+RAnLYU4aTXESZsgTtoNMS0DYloexLRSiGmVENPmQ0xbAjFtFcS0TohpmyCm7YCYDojpAyCm50JML4GYPgNiegpiehvE" +
+	"9JUQ09dCTN8IMb0b4mRAnFwGcfIaiFOyIU7ZADEjE2LGUojyDIjypRDl3RAziyBm7oaomAFRsRaiMhuicilE5Q6IZANEcg3E" +
+	"rFEQs1ZAzC6FmL0KoioOUbUCorofRPViiFM5xKllEKeug5iTBzGnFaIGEDUNEDXbIWpLIGpXQdT1g6hbClEfgqhfCdGQgmhY" +
+	"D3FaNsRpyyFO2w3ROA2isROiKROiqRUixSFSKYjUOojmEETzYojmPRBzF0HMc0HMa4GYr0LMXwyxQIVYsBzi9H4Qp7dDnJEJ" +
+	"ccZyiIUZEAsbIBauhjgzDnHmWoiziiDOzoVYlAWxaDfEOR0Q57ZAnLcI4rw2iPPWQ5y3HWKxDrF4GcTiTojzMyDOr4E4vw3i" +
+	"/C6IC3wQF6QgLlgGcUEHxAXrIS7YAXGhCnHhAIgL8yAuLIO4sAbiwsUQFy6DuLAD4sINEBfugGjhEC0hiJZciJZSiJYqiJZF" +
+	"EC1tEC2rIFrWQbRshWjZDXGRC+KiTIiLRkFcNBXiogaIi1ogLloOcdFqiIt9EBdnQVwch7h4GsTFKYiLl0Bc3A5x8RqIizdC" +
+	"XLwD4hIOcUk/iEt0iEtKIC6phLhkIcQlSyEuWQlxSSfEJV0Ql+yCWKJCLBkAsSQPYkkZxJIaiCWLIZYsg1jSAbFkPcSS7RBL" +
+	"9kBc6oO4NAvi0jjEpdMgLk1BXLoE4tJ2iEvXQFy6EeLSHRCXcYjL+kFcpkNcVgJxWSXEZQshLlsKcdlKiMs6IS7rgrhsF8Tl" +
+	"KsTlAyAuz4O4vAzi8hqIyxdDXL4M4vIOiMs7IVqrIFrnQ7S2QLQuh2hdDdG6AaK1G+IKQFyRAXFFNsQVRRBXzIC4Yj7EFa0Q" +
+	"V6yAuGI1xJU6xJUlEFdWQly5EOLKpRBXtkNclQdxVQnEVdMgrkpBXLUE4qp2iKvWQFy1EeKqHRBLOcTSfhBLdYilJRBLKyGW" +
+	"LoRYuhRi6UqIpZ0QS7sgrnZBXF0GcXUlxNUpiKuXQFzdjv/8/9dDXL0L4po8iGsWQ1zTBnHNKohrOiGu2Q1xrQpxbQji2lyI" +
+	"a0sgrq2EuHY+xLWtENe2Q1y7BuLaDRDXdkNcuxuizQXRlgnRNgqibSpEWwNEWwtE23KIttUQbRsg2rohrgPEdRkQ12VCXDcD" +
+	"4rr5ENe1Qly3AuK6tRDXbYK4bifE9RaI60MQ1+dCXF8KcX0VxPWLIK5vg7h+FcT16yCu3wpx/W6IG1wQN4QgblgNsSwEsawd" +
+	"YtkaiGUbIZbtgLiRQ9zog7gxBXHjEogb2yFu7IC4yQVxUybETaMgbiqFuDkEcfNKiOVFEMtnQCyfD7G8FWL5CojlqyFuWQJx" +
+	"qw/i1lUQt66DuHUrxK27IW5zQdwWgrhtOUS7CtG+BKK9HaJ9DUT7Roj2HRDteyBub4W4fRfEHVUQdyyCuKMN4o5VEHesg7hj" +
+	"K8QduyHudEHcmQlx5yiIO6dC3NkAcWcLxJ1tECuqIFZshLirFOKuGRB3rYe4m0PcXQRx91SIu3dD3LMYYuUAiJV5ECvLIFbW" +
+	"QKzs+W4pxL0uiHs7Ie4rg7ivEuJ+QNzvgrh/JcT9nRD3d0HcvwtilQqxagDEqjyIVWUQq2ogVi2GWLUMYtUuiAem/ZP/d0E8" +
+	"mAHx4BKIB1dDPOSDeKgM4qEWiId6Pu+C6GiB6GiH6OiCeJhDPFwE8fBCiIc3QjwyA+KRdohHuiAeHQXxaCvEo+sgHt0FsToE" +
+	"sboSYvV2iMdCEI8tgXhsE8TjAyAeL4F4vBXi8eUQj2+AeHwrxBM+iCdKIJ6ognhiMcQTyyGeWAPxxCaINYBYkwmxpgxizUKI" +
+	"Ne0QazZArNkN8WQI4skiiCcbIJ5sg3iyE+LJHRBP+SCeGgXxVBXEU60QT62GeGorxNMWiKd1iKenQTy9GOLpVRBPb4JYC4i1" +
+	"mRBrSyHWzodY2w6xdj3E2l0Qz4QgnimCeKYB4pk2iGfWQjzTDfGsD+LZPIhnKyGebYV4djXEs1shnrNAPJcN8dxUiOcWQzy3" +
+	"EuK5jRCdgOjMhOgsheicD9G5HKJzHUTnLojn+0E8H4d4vgHi+TaI59dCPN8N8YIL4oVciBcqIV5YAvFCB8QLWyFetEC8mA3x" +
+	"4lSIFxdBvLgC4sWNEC/ugVg3AGJdKcS6+RDrlkOsWwfxkg/ipU6Il/MgXl4I8fJqiFfiEK8shHhlI8T6DIj1cYj1yyFe1SFe" +
+	"3Q3xWhbEayshXuuGeL0E4vXlEK93Q2xohXjDBfHGYog3LRBv9rTdEG+tgNiYDbGxCmLjWoi3LRBvl0K8MwrinXUQ75ZCvLsR" +
+	"YtNUiE0rIN5rgHhvI8T7MyDeXwbx/m6ID4ogPmiH+GA7xAe7ILo4RJcPomsARJcO0RWH6CqD6KqE6EpBdC2G6FoK0dUO0dUB" +
+	"0dUJ0bURoms7RNcuiA85xIc+iA8HQHyoQ3wYh/iwDOLDSogPUxAfLob4cCnEh+0QH3ZAfNgJ8eFGiA+3Q3y4C2Izh9jsg9g8" +
+	"AGKzDrE5DrG5DGJzJcTmFMTmxRCbl0JsbofY3AGxuRNi80aIzdshNu+C2MIhtvggtgyA2KJDbIlDbCmD2FIJsSUFsWUxxJal" +
+	"EFvaIbZ0QGzphNiyEWLLdogtuyC2coitPoitAyC26hBb4xBbyyC2VkJsTUFsXQyxdSnE1naIrR0QWzshtm6E2LodYusuiI84" +
+	"xEc+iI8GQHykQ3wUh/ioDOKjSoiPUhAfLYb4aCnER+0QH3VAfNQJ8dFGiI+2Q3y0C2Ibh9jmg9g2AGKbDrEtDrGtDGJbJcS2" +
+	"FMS2xRDblkJsa4fY1gGxrRPi42kQHy+D2B6C2L4a4hMO8ckiiE8zID4tg/isCOKzLojPdkB8tgeiW4Xo7gfRnQXRnQfRXQLR" +
+	"PQ2iuwaieyFE9wqI7q0Qn0+D+CID4ksfxI5SiK/WQ3yzCOKbPRA7V0N8uxriu10QuxogfsyC2B2C2N0F8fMmiF99EHtaIX4f" +
+	"BQl5kMwNkFgNJL4CkpQJSd4OyboVktoPkqMVkrMTkjsXkmc3JN8uSMFsSAeshNRvBqSDN0E6dCmkw1ohHb4e0pFFkAZxSIN3" +
+	"QxqyGtKwtZDCFkh6K6ScMkgjpkI6pgXSMR2QjtkBaWQVpGMHQBrVD1L+VEgFGyHFWyAZHJKxFlJiLaTReyAVz4c0Roc0NhPS" +
+	"2O2Qxm2FVNoP0vFLIU0shTSpBNKURZCmzod0Qgekk7IhTdsB6eTtkMpDkCpaIVV2QpqVC2n2LkjVOyHVZEGqa4N0Wgmkxk5I" +
+	"qUWQ5jZAmr8S0hmZkM7cDmnRJkjnLoO0uB3SBTsgXdQAaYkO6fIBkK4sg3TlKkhX1UBaWgTp6jikazika3ZCarNAauuAdF03" +
+	"pOv3QLphB6RlyyHdOB/STVMh3eyDdPN6SMvbId2yDNKtUyHdNgpSexmk9j2Q7siCdGcepBWZkFZsgnTXSkh3t0C6ZxSke9ZD" +
+	"WrkQ0r0lkO7tgnRfG6T7F0FaBUgPWCA9sBPSgx2QHsqD9FA3pI5lkB7OhPRwO6RH5kN6tAHS6hZIj82A9HglpCeWQlozDdKT" +
+	"KyE91QXp6W5Ia1dDemYlpGdXQHpuIaTOfpA6p0LqXAPp+QxIzy+E9Hw3pBdKIL2wAtKLKqSXfJBe2Q3ptRCkDXmQ3siD9GYe" +
+	"pLc2QtrYAentpZDeaYP0bg2kTe2Q3lsF6f0WSB9UQepKQfqwDNLmhZC2lELasgvS1pWQPhoFaRsgbdsF6eOVkLZXQvpkKqRP" +
+	"syB9NgpStw9Sdzekz9dC+qIV0pfTIO2YBumrJZC+XgLpm7WQdm6A9G07pO8WQ/q+EtKuGZB+nAbpp1GQdpdB+jkT0i8pSL/m" +
+	"QtqTAek3H6TfuyCjH2RTG2TzashsCWSuQxY+yPJUyNZNkNU4ZEcJZNdiyJ61kP0uyIENkDOWQD5gBuQDFkI+oB3yAZ2QD8yG" +
+	"fGAp5ANTkA9cBvnADZAP3AH5oAzIBxVBPmgG5IMWQz6oA/JB2yH3s0DuNwpyvw7I/Toh99sI+eAlkA8JQT5kBeRDKyEfmoJ8" +
+	"6GLIhy6FfGg75EN3QQ75IId0yKEyyKEayKHFkEPLIIc6IIfWQw5thxzaA/kwH+TDsiAfFod82DTIh6UgH7YU8mEdkA/bCPmw" +
+	"XZD7D4DcvwZy/2WQD7dAPnwA5MNHQT58GuTD50M+fCnkw1dBPnw95MO7IQ/gkAeEIA/IgzxgKuQBKcgDWiEPWAl5wDrIA7ZD" +
+	"PgKQj+gH+YhcyEeUQj6iBvIR7ZCPWAP5yAzIR2ZDPrII8pEzIB85H/KRbZCPXA35yA2Qj9wDeaAKeWA/yAOzIA+MQx7YAHng" +
+	"WsgDN0EeuBNypg9yZhxy5gzImfMhZ7ZCzlwBOXMt5MxNkDN3Qh5kgTwoBHnQKMiDKiEPWgx50EbIR2VDPmoR5KNWQz5qF+TB" +
+	"mZAHT4U8eAnkwWsgD+6GnJUBOSsOOasBctYyyFmdkIfokIesgDw0A/LQEshDF0Ee2gF5WAjysFbIw/ZAzs6GnF0FObsNcvZ6" +
+	"yJoKWRsFWWuBrG2EHA5BDtdADq+GHN4DOZIJOTINcqQFcmQPZD0FWe+GHA1Bjs6AHG2DHN0BOVYEObYMcmwD5BwL5Jw8yDkN" +
+	"kHNWQs7ZCjk3Djl3FeThPsjDSyAPb4E8fC3kERmQR8yHPGID5BF7IB+dDfnoaZCPboV89HrIeYCc1wL5GB/kY5ZBPmYd5GN2" +
+	"Qx45APLIMsgjl0AeuQbyyN2Qj82FfGwK8rG7IY9aDDlfhZy/DHJBNuSCNZALNkAu2AW5MANyYR7kwhmQCxdDLlwBuXA95MKd" +
+	"kOMhyPEZkOPLIRsZkI1syEYRZKMKsrEIstEG2VgF2VgH2eiGnADkRAbkRC7kxFTIifmQE62QEysgJ9ZCTmyFPBqQR4cgj86F" +
+	"PLoU8ugqyKMXQR7dBnn0Ksij10EevRXy6N2Qi1yQizIhF42CXDQVclED5KIWyEUrIBetg1zUDbkYkIszIBdnQy4uglw8A3Lx" +
+	"fMjFrZCLV0AuXgv5uBrIx3VBHjMV8pgGyGNaII9ZDnnMashjNkAe0w25BJBLMiCXZEMuKYJcMgNyyXzIJa2QS1ZALlkLuWQT" +
+	"5JKdkMdaII8NQR6bC3lsKeSxVZDHLoI8tg3y2FWQx66DPHYr5HGAPC4EedwoyONmQB63CPK45ZDHrYY8bgPkcd2Qx/eDPH4J" +
+	"5PHLYQIydDYOgzEfKsxwYRQsgPgKgAUcwIGmkTABAIA2Uxim9JEJftNPdGwGNwfpmOFA8wQ65jjQfBsdC6jmTXQsIYP1nW+B" +
+	"m5XRsQ0Hs1vp2O65MXMPHTug53E6dsGWdzwdu+HIOwMMJm4FTNkAHZtwlOkVOjbDYrbTMYNu7kfHHLq5lo4FMsxP0bGEbPMn" +
+	"dGxBf5ZNxzaMYLPo2H7ECLaWjh2oOvp6OnYhkDecjt3olzcdhahHAxagEdWYjSqkEEImKjAIIYSRjWxEEMJMLEAIBahGCk1I" +
+	"oRFJlKMWWQihGHWowFCEkI8a1CCECXv7akp/SqIJSTRiLpKoxFAo/wbV2F7VMpRjLmpxKupRh9npcZSj6t+pGEcSp6Ick9GM" +
+	"ECpQhfJ0b8n0FeXpGYVQjTqE0IBmzEQNqlGBECpRj1qUp3+mAIX1DQsaq2dXpUKZFYNC4ezsSGjmglBBdaop1Zgsr80KFddV" +
+	"DA3l19SEJvSc1RSakGxKNs5NVg5V/uHSWM+lZeVza0+tr5sdKiiv+icXxpOnlk9uDlVUldfNTjaFyhuToeq6UEPzzJrqilBl" +
+	"fW15dZ2Cv0xxYnqCPZMrTC9cJZLpzz0LAupwYnldU6iwvq4yWdeUrAQKUI+anp8X1NdU/ns6DO279G+7Dv03dTg5fZebUJ3u" +
+	"oOfZGYoIcoDJycam6vq6UHhoJOfPun2q/6g55K+aPZJ7FYf83RRmpTvpfepS9E70DXhWfV2qKZRKltci/WSm0IARGIZhqKQ+" +
+	"5qIZQ9GEejSjERVIpvtrTD+VQ1GHJFJAVSrVMGLYsMrkqeVzm4c21Tc3ViRn1TfOTg6tS6aAxH4j6HuK+96kf3xfe37WM9lk" +
+	"+u1Kogb1mIdB6Xfpv+YN6elpNGqwAA2oSl9ZjVo0pOeVols7C43pK3re355e5/5lJf86j30RoPlPEeCfzUaB8rdz731ayhH6" +
+	"06r9YyxSMOQ/8T8Fyv9KVA39P+ZcjSYo6aNU+puep7A2vdZzEEI9Zv0/x9Izs9J0f7Xp3va9d9XpMVWlf5akec1Oq9TRXc+i" +
+	"+957t3rVep+x3uc9Kz2u+vTdr0tf30Dvdq9CPWYiRc9YNT0F5ek+eldaoT5T6VH89XmqSJ/X8xz29t7XQ8/ZvWPvfZb7gkHP" +
+	"3eq/31PSP33nytMBo6dtSo+rAtUop/kp6begAs2oTfeSSv+kb31moRo19CZl7h3jPoWeYNgz/hTm0dPfo7hvTXq+aUi/NZVo" +
+	"To9z32gqUU13rBoz0ZweT986KP9CIYve5grUoBmVe9dkXvoZqEpHpRStTC2q/zKjvjk0/ump7B1tM5qo/76703Nci+Tee63s" +
+	"F0GaUJX++d/NI2vvPIelI0go3XPv+9DbdzWt6p/v/r+edd/K9Y62Ye8TnYJCn3qfun0zmpdej9p/k0Lf2zArHdXraIbJ/RQr" +
+	"03/2aGSl256VOBVJVCC195y++zcrjaTeyNZ3hyoIXdV770cTRqTfzjIaXTlmoj4dGfbdg/1j0b4V+MdIUIf6dL8VeyN/37l9" +
+	"70rD38aA/a8Lpedcnh65gpm0Kvuetd7V6GVJ+b+4n/VpCobo3tem2+S/4273nLMgPd5Z6SjQ0/fQP63Uv7q2Z00WEFt61XvW" +
+	"fBaq90a0nrGnKOr1ftM70p41rdzvnu//1PURtByNtF7NqEl/UvbOqDI90p77Vbffasz+E1d7lfpiaDkqaCRNezX+uj5N/885" +
+	"9Y1SoRnse8LKUfPvGsGfdf66Hn83tiy63zXp66r/STRX9t6dxnScLUftn/rt+6Zp7xPZ9778lR5JinPJ9Cz6lOalZ1WZvr7/" +
+	"3/Cw/955//UKBf330rb/fk9Z7ztT8he+zEy/7/X7jbWZ3oO+52Qu6lH9NyuWxPz0OtfRm9yABqJXeTqiJvdesf997x1z3zfK" +
+	"374pVekIH0q3TTTGJOr+xXPSF+v+LnZXpknQm2Lvv15/t6rKfiu3/z38j76rTZTIh2gmfW9b35tUnuZaX+7RSFf8uceG9BM9" +
+	"B+Xpc0I0r96nStkbVf87I9U/n9VMekdSxMNZe1eqCEZaZzzGoSytMx4JlGEK8jEh/bNiTEQIpZiA8ZiMYsRhIJ6+L/mYSD/v" +
+	"n34bp6A43eN4TEr31dvHBOSn+z4BoXTfofTnnk9jUIxxiKevNTA1rWFgIiZCwXhMSPc9FqUoQTEMOq/nikKUYBLi6ePR6Sy0" +
+	"V28cxqfbkvR1PWPpHWkZxu+n+udRFacV+0Y2FgYmoBBF9NN8FKA43V/P+LPS+VHP8TgaZ+/KTUj33rNGPT339FmISShJf+r5" +
+	"dhImoBTjMTG9nvnpOfeOdlx6DglMoLkY6RH03oneERViPEpxQvqM0ShCGbJIqYzOzErfx575xNPX96iOSZ/VO7LxdJcnILRf" +
+	"L0NpLXvH0bP+k/cqT0zPvwQl6bXtme/EtIKBfIzd22/fszM63UPPuJX0akxKzy8/vQ7j0woF6fN6VrFnPUv2PnET9rsrhen1" +
+	"6rlvWenaMj89k9F7785fZ9LX2/535++eDmWvwuj0/Iz0SpWkz56IUhgoRPHeb3qfx+L0XAtRtve7Qnrue5+Jkv1WtzA9x547" +
+	"ezwm0Rm9/f11Fj33aUp6/Ptm0XsH8unPwv3WbN/dH0d3t288ZWnlsr9ZlSnpd9FIn5WfvtcT974jifT7O5ZGPmnvE7YvBkyi" +
+	"53P83pH9eX373qO+8/4tsaO3rz7tP9/BePp5KqERTty7Gr1nKP+i397YZWB+Ou41ENea/oHc+2eN+7LR/fPOrP1i7f6ZQG8U" +
+	"Hp0+t/Yv5+37trda6mXWvlpn/9zt7yrsvuo4609Z777sozd2N6PpT+PvyYjmptmTSs+jLyupT+eB9Xszk3npnyb3q1Z6907q" +
+	"/1Tn9SiXp9mftVerj0X1+2U5lelR15Ba09+s5j8nlPIPlWFDmve9KvPSxynKTHrm10zn9nx/+l+q4ca/VFP77kHob+9B31z+" +
+	"LnPYf/0b0/e7gWqp6vQK9+STQ6nfxr112b416VmB3n232r/c9X1PX09vI/5hVyGVzoj3jbwyvdYK7eH1aCrpeNW3x/W/v+v0" +
+	"X72r/n9pP0j5035Q6H9sP0j52/2g0P/wfpDyb9oP+nMmX7HfmJr+oc74t+2g/t0Oi/K/tq8U+od9JeX/21fab19J+dP78P9/" +
+	"+0rKnwj7v7evpPwf3VdS/nZfKfQ/vK+k/Iv9gv+ZfSXl372vFPpv2VdS/sm+0j+j7z/fXeqtz3szif9ru0vKX3aXQv+Lu0vK" +
+	"v1jd0H4r+H97l0lJP2Oh/wO7TMr/4V0m5S+7TKH/lV0m5f+5yxT6H9tlUv4du0yh/7ZdJiW9BpMxFsdh/N7VzkfR/+DekfK3" +
+	"9/x/a+9I+Ye9o9D/2t6R8k/3jkL/g3tHyr9j7yj0v7Z31BdZ/zlR/nHHR/kP7PiE/pt2fJT/1I5P6L9ox0fZb8fnX+07/Ffs" +
+	"0KT+of9R++00KBhKn4YCifQvaPX8Ml3Pr+Pt/Q2+UGZTMhmamaypnzdoaOjf8Kt3Q0OjaxY0VDWFqmsb6htTycrQrMb62lB+" +
+	"Y3Iu/RJYn0b6V/2ae3/Vb38ZRdmnPjnZWB7qHdre3xdUhvzL/xTlP/5LiaG/KFc3KeWhVGN5ZbK2vHFOqH7WX3tRlNJkY211" +
+	"U/rX66qbQlXJxuTMBaHZjeV1qWRlVmhWYzLZc1lFVXnj7GRWKFUfKq9bEGpINjbV14XqZ6bKq+uq62aHykMV9Q0LlPpZoVRV" +
+	"sm+dKirqaxvK6xb0nJCqqm4K1VRX9Pz2XSizf3pJ+g8KlddVhsqbmuorqstTyUqlsr6iuTZZlypP9YxnVnVNsimU2dNj+oLQ" +
+	"xPpZqXnljcn+g9IjaUw2NNZXNlck091UVjelGqtnNqeSPWNQ/nRBVqi6rqKmubJnJPOqU1X1zalQTXVtNQn1KDT2LmWqPtTc" +
+	"lMxKTycrVJvsmbWSfkCaqrL208jq0RxW3xhqStbU9JxdnWwK0fT/It0zuFR9z5rVVqeU3qVLC82rqq/9xwt6bsOs5sa66qaq" +
+	"ZPrCyvpQU31WqKl55qnJilTPNz3zm1VfU1M/r2dCFfV1ldU982gaoShlVclQ+cz6ucn0DHqfovQA9j4EdfWp6opkU++3PXel" +
+	"Yd8T0PuzUFNVeU2NMjNJq5as7HlLyv80z/q6ZKi+MVRb35j822mHUgsakrPKK5JNQ3sH9eef1pYvCM1MhmrrK6tnVfc8aOU1" +
+	"qWRjz0F9Y6i8sjI9896l63lByxtT1RXNNeWNSo9QZbKpenZdehize9/V+sb0E1pekUo2NvVc0Teepr8q9XSplFf2Llh5zd93" +
+	"QNf0jWNfb1mh+rqaBaHq/R5zpWc6jcm68trec3sOmnoWsue+9L0eyepUVbIxfdG8+sbKplD/ve9h/1D9vh8o/Xte2/7pJatu" +
+	"CpXQ+zIzWVGf7rW5pia9JnPrq/cOLDk/laxLhcobGmqqK8pn1iR7ftA79/rG9IGy76ZUladCVeVNoZnJZN2f1qTnqdv3dFeG" +
+	"musqacD7hqqkB9c7w391V5vqa3re6vRt67lJ5aGanujRGGrqO7GhvGJO+exkaGZzKlRXr/Q8qv++h+pPUjMXhKpTTcmaWT2D" +
+	"KjJCifHjykITxyfKpuRPMELFE0OlE8ZPLo4b8VD//Imh4on9s0JTisuKxk8qC03JnzAhf1zZCaHxiVD+uBNCY4rHxbNCxtTS" +
+	"CcbEicr4CaHisaUlxUY8K1Q8rrBkUrx43OhQwaSy0LjxZaGS4rHFZUY8VDY+fSl1VWxM7OlsrDGhsCh/XFl+QXFJcdkJWUqi" +
+	"uGycMXFiKDF+Qig/VJo/oay4cFJJ/oRQ6aQJpeMnGqH8cfHQuPHjisclJhSPG22MNcaV9XRUOL70hAnFo4vKskKl+WXGuLIs" +
+	"pWxCftwYmz9hTFZo/ITQ+LIiY0IofcrQUPG40LjxIWNyz8UTi/JLSkIFxWUTyyYY+WN7zu1ZndHjxo81lMT4SePi+WXF48eF" +
+	"CoxQSXF+QYnRO7ZxJ4QKS/KLx2aF4vlj80cbE/eJlBT3TWffcig9F4w2xhkT8kuyQhNLjcLinoPicfHiCUZhWfrM4rgxrqzn" +
+	"2/ETQoXjx000jp9kjCsrzi/pk8hSphQZaYnicaH8caH8wvTI0tMfVzYhv6efsvETyvYOZUrxRCMrlD+heGLPHUlMGD82K9Rz" +
+	"P8cn0k/ApIlGz6nF42i8Pfeo57t/fDrGT0hfTROMG/klxeNGT+wZRlmRofzp3KGKYsyvSDakep5terl7Q2M6jPbGzqz0U9sb" +
+	"BOpnhUbX1dfSd+lDJVHfXFeZpk5vdNsH7B4cZ/WG3nT4mJnsIVFv6K2cm2xMVTf1hJL6RqW+J5jMq25Kv+kNjfW19b3MCzWV" +
+	"1ySzeq7qeYvSZ4Uqk+U11XWzm/YO808vlNIHw4bG6vrG0LzG6lQqWRcqb05V1TdWn04Ybqyv3TeD0L4Z9KjsCw69429MNjUk" +
+	"K1LVc5M1C4aGEvWNPSxLj6S6blZ9Yy1NPb18FakRfalCKjQ73XllfUqpb5w9NKQo6YzrP506/Vv/UsZ/TR6k9OZBof9IHqTs" +
+	"y4NC/8E8SPnHPIiCfEW6p6Y+ZvxNgrovYVH+M7lSqC9XUv5v5EpK7334b8uVlN4X9j+VKyn/hbmSsi9XCv0HcyXlT3nBfyBX" +
+	"Uv5ZrhT6t+dKyn650v6v75/SpVD/niDxX5UuKfWNf1n5/0i6pPxpuOm68b86ZVLq6kP/6ZRJ+S9NmRRKmUL/8ZRJ+WvKFPqP" +
+	"pEzK36ZMoX9PyqSU5U8ee9z4nmHnF/2HsiNl38z/M9mR0pcdhf4z2ZGyf3YU+g9lR8rfZkeh/0x21POw/ulF2Zv4KP808Qn9" +
+	"OxIf5V8nPqF/Q+KjpBOfP+cO/++EJtV3/qh00qAMDc1qHPqf+TuDw9L7dnNQjWHpvbPKtKs3NO2vNmDYX9zCf/03DIfNq55T" +
+	"Pay6rjI5f2hDVcMwipj/7O9y7v+3NfGv/17nX/8upxnp//44Cyfib/7L0AGYYAYDh4AEGRZYocAGFXY44IQLbnjghQ9+BBBE" +
+	"Bg7AgTgI/XAwDsGhCOEw9MfhGIAjcCQGIhODcBQGIwtDMBTDkA0NYUSgI4oYcpCL4RiBo5GHYzASx2JUehe8ML1bnUg7IsU4" +
+	"DmNQgrFpP6QUx2NC+ndrJ2EypmAqTsCJOAnTMB0n4xTMQDlW4jYsxvl4EtegGxfgMlyCG3EX2k1mXIz3cR6uwnf4HpfiWrTg" +
+	"OWzGt7gJd+MH7MKPuBX34iW8iPswExVoRSVeQRLr8DJex3q8itfwOWbhLWzAG7gfs7ETV+AdbMTbqMKX+AoX4dT0k1GLGtRh" +
+	"OepxWnpPtym9dzsX8/AF5uN0LMAZOBMLsRq34GychUU4BzvwNR43MRM3CZNkkk0W/IbfTVaTYrKZVPxhgslucpicJpPJZXKb" +
+	"PCavyWfymwKmoCnDdIDpQNNB2I2fTf1MB5sOMR1qCpkOM/U3HW4aYDrCdKRpoCnTNMh0lGkwfsG7pizTENNQ0zBTtkkzhU0R" +
+	"k26KmmKmHFOuabhpBLbhY9PRpjzTMaaRpmNNo0z5pgJToSluMkwJ02hTkakYq/CA6TjTGFOJaaxpnGm8qdR0vGmCaSJ+xR5s" +
+	"xyemMtMk02TTFNNU0wmmE00nmaaZpptONp1immEqN800VZgqTUnTLNNsU5WpGk+YTjXNMdWYavEpPjPVmepNDabTTI2mJlPK" +
+	"1Gyaa5pnmm9aYDrddIZpoelM01mms02LcIfpHNO5pvNMi03nmy4wXWhqMV1kuth0iWmJ6VLTZabLTa2mK0xXmq4yLTVdbbrG" +
+	"dK2pzXSd6XrTDaZlphtNN5luNi033WK61XSbqd10u+kO052mFaa7THeb7jGtNN1rus90v2mV6QHTg6aHTB2mh02PmB41rTY9" +
+	"Znrc9IRpjelJ01Omp01rTc+YnjU9Z+o0PW96wfSiaZ3pJdPLpldM602vml4zvW7aYHrD9KbpLdNG09umd0zvmjaZ3jO9b/rA" +
+	"1GX60LTZtMW01fSRaZvpY9N20yemT02fmbpNn5u+MH1p2mH6yvS16RvTTtO3pu9M35t2mX4w/Wj6ybTb9LPpF9Ovpj2m30y/" +
+	"m/4ww2wym83MzM3CLJlls8VsNStmm1k1280Os9PsMrvNHrPX7DP7zQFz0JxhPsB8oPkgcz/zweZDzIeaQ+bDzP3Nh5sHmI8w" +
+	"H2keaM40DzIfZR5szjIPMQ81DzNnmzVz2Bwx6+aoOWbOMeeah5tHmI8255mPMY80H2seZc43F5gLzXGzYU6YR5uLzMXm48xj" +
+	"zCXmseZx5vHmUvPx5gnmieYy8yTzZPMU81TzCeYTzSeZp5mnm082n2KeYS43zzRXmCvNSfMs82xzlbnafKp5jrnGXGuuM9eb" +
+	"G8ynmRvNTeaUudk81zzPPN+8wHy6+QzzQvOZ5rPMZ5sXmc8xn2s+z7zYfL75AvOF5hbzReaLzZeYl5gvNV9mvtzcar7CfKX5" +
+	"KvNS89Xma8zXmtvM15mvN99gXma+0XyT+WbzcvMt5lvNt5nbzbeb7zDfaV5hvst8t/ke80rzveb7zPebV5kfMD9ofgib8JG5" +
+	"w/yw+RHzo+bV5sfMj5ufMK8xP2l+yvy0ea35GfOz5ufwAbrwIbbiPWwxd5qfN79gftG8zvyS+WXzK+b15lfNr5lfN28wv2F+" +
+	"0/yWeaP5bfM75nfNm8zvmd83f2DuMn9o3mzeYt5q/si8zfyxebv5E/On5s/M3ebPzV+YvzTvMH9l/tr8jXmn+Vvzd+bvzbvM" +
+	"P5h/NP9k3m3+2fyL+VfzHvNv5t/NfzAwEzMzxjgTTGIyszArU5iNqczOHMzJXMzNPMzLfMzPAizIMtgB7EB2EOvHDmaHsENZ" +
+	"iB3G+rPD2QB2BDuSDWSZbBA7ig1mWWwIG8qGsWymsTCLMJ1FWYzlsFw2nI1gR7M8dgwbyY5lo1g+K2CFLM4MlmCjWRErZsex" +
+	"MayEjWXj2HhWyo5nE9hEVsYmsclsCpvKTmAnspPYNDadncxOYTNYOW5mM1kFq2RJNovNZlWsmp3K5rAaVsvqWD1rYKexRtbE" +
+	"UqyZzWXzcAObzxaw09kZbCE7k53FzmaL2DnsXHYeW8zOZxewC1kLu4hdzC5hS9il7DJ2OWtlV7Ar2VVsKbuaXcOuZW3sOnY9" +
+	"u4EtYzeym9jNbDm7hd3KbmPt7HZ2B7uTrWB3sbvZPWwlu5fdx+5nq9gD7EH2EOtgD7NH2KNsNXuMPc6eYGvYk+wp9jRby55h" +
+	"z7LnWCd7nr3AXmTr2EvsZfYKW89eZa+x19kG9gZ7k73FNrK32TvsXbaJvcfeZx+wLvYh28y2sK3sI7aNfcy2s0/Yp+wz1s0+" +
+	"Z1+wL9kO9hX7mn3DdrJv2Xfse7aL/cB+ZD+x3exn9gv7le1hv7Hf2R8c3MTNnHHOBZe4zC3cyhVu4yq3cwd3chd3cw/3ch/3" +
+	"8wAP8gx+AD+QH8T78YP5IfxQHuKH8f78cD6AH8GP5AN5Jh/Ej+KDeRYfwofyYTybazzMI1znUR7jOTyXD+cj+NE8jx/DR/Jj" +
+	"+Siezwt4IY9zgyf4aF7Ei/lxfAwv4WP5OD6el/Lj+QQ+kZfxSXwyn8Kn8hP4ifwkPo1P5yfzU/gMXs5n8gpeyZN8Fp/Nq3g1" +
+	"P5XP4TW8ltfxet7AT+ONvImneDOfy+fx+XwBP52fwRfyM/lZ/Gy+iJ/Dz+Xn8cX8fH4Bv5C38Iv4xfwSvoRfyi/jl/NWfgW/" +
+	"kl/Fl/Kr+TX8Wt7Gr+PX8xv4Mn4jv4nfzJfzW/it/Dbezm/nd/A7+Qp+F7+b38NX8nv5ffx+voo/wB/kD/EO/jB/hD/KV/PH" +
+	"+OP8Cb6GP8mf4k/ztfwZ/ix/jnfy5/kL/EW+jr/EX+av8PX8Vf4af51v4G/wN/lbfCN/m7/D3+Wb+Hv8ff4B7+If8s18C9/K" +
+	"P+Lb+Md8O/+Ef8o/4938c/4F/5Lv4F/xr/k3fCf/ln/Hv+e7+A/8R/4T381/5r/wX/ke/hv/nf8hIEzCLJjgQghJyMIirEIR" +
+	"NqEKu3AIp3AJt/AIr/AJvwiIoMgQB4gDxUGinzhYHCIOFSFxmOgvDhcDxBHiSDFQZIpB4igxWGSJIWKoGCayhSbCIiJ0ERUx" +
+	"kSNyxXAxQhwt8sQxYqQ4VowS+aJAFIq4MERCjBZFolgcJ8aIEjFWjBPjRak4XkwQE0WZmCQmiyliqjhBnChOEtPEdHGyOEXM" +
+	"EOVipqgQlSIpZonZokpUi1PFHFEjakWdqBcN4jTRKJpESjSLuWKemC8WiNPFGWKhOFOcJc4Wi8Q54lxxnlgszhcXiAtFi7hI" +
+	"XCwuEUvEpeIycbloFVeIK8VVYqm4WlwjrhVt4jpxvbhBLBM3ipvEzWK5uEXcKm4T7eJ2cYe4U6wQd4m7xT1ipbhX3CfuF6vE" +
+	"A+JB8ZDoEA+LR8SjYrV4TDwunhBrxJPiKfG0WCueEc+K50SneF68IF4U68RL4mXxilgvXhWvidfFBvGGeFO8JTaKt8U74l2x" +
+	"Sbwn3hcfiC7xodgstoit4iOxTXwstotPxKfiM9EtPhdfiC/FDvGV+Fp8I3aKb8V34nuxS/wgfhQ/id3iZ/GL+FXsEb+J38Uf" +
+	"EiSTZJaYxCUhSZIsWSSrpEg2SZXskkNySi7JLXkkr+ST/FJACkoZ0gHSgdJBUj/pYOkQ6VApJB0m9ZcOlwZIR0hHSgOlTGmQ" +
+	"dJQ0WMqShkhDpWFStqRJYSki6VJUikk5Uq40XBohHS3lScdII6VjpVFSvlQgFUpxyZAS0mipSCqWjpPGSCXSWGmcNF4qlY6X" +
+	"JkgTpTJpkjRZmiJNlU6QTpROkqZJ06WTpVOkGVK5NFOqkCqlpDRLmi1VSdXSqdIcqUaqleqkeqlBOk1qlJqklNQszZXmSfOl" +
+	"BdLp0hnSQulM6SzpbGmRdI50rnSetFg6X7pAulBqkS6SLpYukZZIl0qXSZdLrdIV0pXSVdJS6WrpGulaqU26TrpeukFaJt0o" +
+	"3STdLC2XbpFulW6T2qXbpTukO6UV0l3S3dI90krpXuk+6X5plfSA9KD0kNQhPSw9Ij0qrZYekx6XnpDWSE9KT0lPS2ulZ6Rn" +
+	"peekTul56QXpRWmd9JL0svSKtF56VXpNel3aIL0hvSm9JW2U3pbekd6VNknvSe9LH0hd0ofSZmmLtFX6SNomfSxtlz6RPpU+" +
+	"k7qlz6UvpC+lHdJX0tfSN9JO6VvpO+l7aZf0g/Sj9JO0W/pZ+kX6Vdoj/Sb9Lv0hQzbJZpnJXBayJMuyRbbKimyTVdkuO2Sn" +
+	"7JLdskf2yj7ZLwfkoJwhHyAfKB8k95MPlg+RD5VD8mFyf/lweYB8hHykPFDOlAfJR8mD5Sx5iDxUHiZny5ocliOyLkflmJwj" +
+	"58rD5RHy0XKefIw8Uj5WHiXnywVyoRyXDTkhj5aL5GL5OHmMXCKPlcfJ4+VS+Xh5gjxRLpMnyZPlKfJU+QT5RPkkeZo8XT5Z" +
+	"PkWeIZfLM+UKuVJOyrPk2XKVXC2fKs+Ra+RauU6ulxvk0+RGuUlOyc3yXHmePF9eIJ8unyEvlM+Uz5LPlhfJ58jnyufJi+Xz" +
+	"5QvkC+UW+SL5YvkSeYl8qXyZfLncKl8hXylfJS+Vr5avka+V2+Tr5OvlG+Rl8o3yTfLN8nL5FvlW+Ta5Xb5dvkO+U14h3yXf" +
+	"Ld8jr5Tvle+T75dXyQ/ID8oPyR3yw/Ij8qPyavkx+XH5CXmN/KT8lPy0vFZ+Rn5Wfk7ulJ+XX5BflNfJL8kvy6/I6+VX5dfk" +
+	"1+UN8hvym/Jb8kb5bfkd+V15k/ye/L78gdwlfyhvlrfIW+WP5G3yx/J2+RP5U/kzuVv+XP5C/lLeIX8lfy1/I++Uv5W/k7+X" +
+	"d8k/yD/KP8m75Z/lX+Rf5T3yb/Lv8h8WWEwWs4VZuEVYJItssVisFsVis6gWu8VhcVpcFrfFY/FafBa/JWAJWjIsB1gOtBxk" +
+	"6Wc52HKI5VBLyHKYpb/lcMsAyxGWIy0DLZmWQZajLIMtWZYhlqGWYZZsi2YJWyIW3RK1xCw5llzLcMsIy9GWPMsxlpGWYy2j" +
+	"LPmWAkuhJW4xLAnLaEuRpdhynGWMpcQy1jLOMt5SajneMsEy0VJmmWSZbJlimWo5wXKi5STLNMt0y8mWUywzLOWWmZYKS6Ul" +
+	"aZllmW2pslRbTrXMsdRYai11lnpLg+U0S6OlyZKyNFvmWuZZ5lsWWE63nGFZaDnTcpblbMsiyzmWcy3nWRZbzrdcYLnQ0mK5" +
+	"yHKx5RLLEsullsssl1taLVdYrrRcZVlqudpyjeVaS5vlOsv1lhssyyw3Wm6y3GxZbrnFcqvlNku75XbLHZY7LSssd1nuttxj" +
+	"WWm513Kf5X7LKssDlgctD1k6LA9bHrE8allteczyuOUJyxrLk5anLE9b1lqesTxrec7SaXne8oLlRcs6y0uWly2vWNZbXrW8" +
+	"ZnndssHyhuVNy1uWjZa3Le9Y3rVssrxned/ygaXL8qFls2WLZavlI8s2y8eW7ZZPLJ9aPrN0Wz63fGH50rLD8pXla8s3lp2W" +
+	"by3fWb637LL8YPnR8pNlt+Vnyy+WXy17LL9Zfrf8YYXVZDVbmZVbhVWyylaL1WpVrDararVbHVan1WV1Wz1Wr9Vn9VsD1qA1" +
+	"w3qA9UDrQdZ+1oOth1gPtYash1n7Ww+3DrAeYT3SOtCaaR1kPco62JplHWIdah1mzbZq1rA1YtWtUWvMmmPNtQ63jrAebc2z" +
+	"HmMdaT3WOsqaby2wFlrjVsOasI62FlmLrcdZx1hLrGOt46zjraXW460TrBOtZdZJ1snWKdap1hOsJ1pPsk6zTreebD3FOsNa" +
+	"bp1prbBWWpPWWdbZ1iprtfVU6xxrjbXWWmettzZYT7M2WpusKWuzda51nnW+dYH1dOsZ1oXWM61nWc+2LrKeYz3Xep51sfV8" +
+	"6wXWC60t1ousF1svsS6xXmq9zHq5tdV6hfVK61XWpdarrddYr7W2Wa+zXm+9wbrMeqP1JuvN1uXWW6y3Wm+ztltvt95hvdO6" +
+	"wnqX9W7rPdaV1nut91nvt66yPmB90PqQtcP6sPUR66PW1dbHrI9bn7CusT5pfcr6tHWt9Rnrs9bnrJ3W560vWF+0rrO+ZH3Z" +
+	"+op1vfVV62vW160brG9Y37S+Zd1ofdv6jvVd6ybre9b3rR9Yu6wfWjdbt1i3Wj+ybrN+bN1u/cT6qfUza7f1c+sX1i+tO6xf" +
+	"Wb+2fmPdaf3W+p31e+su6w/WH60/WXdbf7b+Yv3Vusf6m/V36x8KFJNiVpjCFaFIiqxYFKuiKDZFVeyKQ3EqLsWteBSv4lP8" +
+	"SkAJKhnKAcqBykFKP+Vg5RDlUCWkHKb0Vw5XBihHKEcqA5VMZZBylDJYyVKGKEOVYUq2oilhJaLoSlSJKTlKrjJcGaEcreQp" +
+	"xygjlWOVUUq+UqAUKnHFUBLKaKVIKVaOU8YoJcpYZZwyXilVjlcmKBOVMmWSMlmZokxVTlBOVE5SpinTlZOVU5QZSrkyU6lQ" +
+	"KpWkMkuZrVQp1cqpyhylRqlV6pR6pUE5TWlUmpSU0qzMVeYp85UFyunKGcpC5UzlLOVsZZFyjnKucp6yWDlfuUC5UGlRLlIu" +
+	"Vi5RliiXKpcplyutyhXKlcpVylLlauUa5VqlTblOuV65QVmm3KjcpNysLFduUW5VblPalduVO5Q7lRXKXcrdyj3KSuVe5T7l" +
+	"fmWV8oDyoPKQ0qE8rDyiPKqsVh5THleeUNYoTypPKU8ra5VnlGeV55RO5XnlBeVFZZ3ykvKy8oqyXnlVeU15XdmgvKG8qbyl" +
+	"bFTeVt5R3lU2Ke8p7ysfKF3Kh8pmZYuyVflI2aZ8rGxXPlE+VT5TupXPlS+UL5UdylfK18o3yk7lW+U75Xtll/KD8qPyk7Jb" +
+	"+Vn5RflV2aP8pvyu/GGDzWQz25iN24RNssk2i81qU2w2m2qz2xw2p81lc9s8Nq/NZ/PbArYgHsRDtgzbAbYD8QgeRaftIHTg" +
+	"YTxv64dz8SwutB1sO8R2KO6xhWyH2frbDrcNsB1hO9I20JaJF2yDbEfZBtuybENsQ23DbNl4Ck/bNFvYFrHptqgtZsux5dqG" +
+	"Y41thO1oW57tGNtI27G2UbZ8W4Gt0Ba3GbaEbbStyFZsO842xlZiG2sbZxtvK7Udb5tgm2grs02yTbZNsU21nWA70XaSbZpt" +
+	"uu1k2ym2GbZy20xbha3SlrTNss22Vdmqbafa5thqbLW2Olu9rcF2mq3R1mRL2Zptc23zbPNtC2yn286wLbSdaTvLdrZtke0c" +
+	"27m282yLbefbLrBdaGuxXWS72HaJbQl+sl1qu8x2ua3VdoXtSttVtqW2q23X2K61tdmus11vu8G2zHaj7Sbbzbbltltst9pu" +
+	"s7XbbrfdYbvTtsJ2l+1u2z22lbZ7bffZ7retsj1ge9D2kK3D9rDtEdujttW2x2yP256wrbE9aXvK9rRtre0Z27O252ydtudt" +
+	"L9hetK2zvWR7GUtsr9jW2161vWZ73bbB9obtTdtbto22t23v2N61bbK9Z3vf9oGty/ahbbNti22r7SPbNtvHtu22T2yf2j6z" +
+	"dds+t31h+9K2w/aV7WvbN7adtm9t39m+t+2y/WD70faTbbftZ9svtl9te2y/2X63/aFCNalmlalcFaqkyqpFtaqKalNV1a46" +
+	"VKfqUt2qR/WqPtWvBtSgmqEeoB6oHqT2Uw9WD1EPVUPqYWp/9XB1gHqEeqQ6UM1UB6lHqYPVLHWIOlQdpmarmhpWI6quRtWY" +
+	"mqPmqsPVEerRap56jDpSPVYdpearBWqhGlcNNaGOVovUYvU4dYxaoo5Vx6nj1VL1eHWCOlEtUyepk9Up6lT1BPVE9SR1mjpd" +
+	"PVk9RZ2hlqsz1Qq1Uk2qs9TZapVarZ6qzlFr1Fq1Tq1XG9TT1Ea1SU2pzepcdZ46X12gnq6eoS5Uz1TPUs9WF6nnqOeq56mL" +
+	"1fPVC9QL1Rb1IvVi9RJ1iXqpepl6udqqXqFeqV6lLlWvVq9Rr1Xb1OvU69Ub1GVoU29Ub1Jvxgp1uXqLeqt6m9qu3q7eod6J" +
+	"69UVuA7fqHepd6v3qCvVe9X71Ptxu7pKfUB9EFeqD6kd6sPqI+qj6mr1MfVx9Ql1jfqk+hSWqU+ra9Vn1GfV59RO9Xn1BfVF" +
+	"dZ36kvqy+oq6Xn1VfU19Xd2gvqG+qb6lblTfVt9R31U3qe+p7+NO9QO1S/1Q3axuUbeqH6nb1I/V7eon6qfqZ2q3+rn6hfql" +
+	"ukP9Sv1a/UbdqX6Ly9Xv1O/VXViKq9Uf1B/Vn9Td6s/qL+qv6h71N/V39Q877Ca72c7s3C7skl22W+xWu2K32VW73e6wO+0u" +
+	"u9vusXvtPrvfHrAH7Rn2A+wH2g+y97MfbD/Efqg9ZD/M3t9+uH2A/Qj7kfaB9kz7IPtR9sH2LPsQ+1D7MHu2XbOH7RG7bo/a" +
+	"Y/Yce659uH2E/Wh7nv0Y+0j7sfZR9nx7gb3QHrcb9oR9tL3IXmw/zj7GXmIfax9nH28vtR9vn2CfaC+zT7JPtk+xT7WfYD/R" +
+	"fpJ9mn26/WT7KfYZ9nL7THuFvdKetM+yz7ZX2avtp9rn2GvstfY6e729wX6avdHeZE/Zm+1z7fPs8+0L7Kfbz7AvtJ9pP8t+" +
+	"tn2R/Rz7ufbz7Ivt59svsF9ob7FfZL/Yfol9if1S+2X2y+2t9ivsV9qvsi+1X22/xn6tvc1+nf16+w32ZfYb7TfZb7Yvt99i" +
+	"v9V+m73dfrv9Dvud9hX2u+x32++xr7Tfa7/Pfr99lf0B+4P2h+wd9oftj9gfta+2P2Z/3P6EfY39SftT9qfta+3P2J+1P2fv" +
+	"tD9vf8H+on2d/SX7y/ZX7Ovtr9pfs79u32B/w/6m/S37Rvvb9nfs79o32d+zv2//wN5l/9C+2b7FvtX+kX2b/WP7dvsn9k/t" +
+	"n9m77Z/bv7B/ad9h/8r+tf0b+077t/bv7N/bd9l/sP9o/8m+2/6z/Rf7r/Y99t/sv9v/cMBhcpgdzMEdwiE5ZIfFYXUoDptD" +
+	"ddgdDofT4XK4HR6H1+Fz+B0BR9CR4TjAcaDjIEc/x8GOQxyHOkKOwxz9HYc7BjiOcBzpGOjIdAxyHOUY7MhyDHEMdQxzZDs0" +
+	"R9gRceiOqCPmyHHkOoY7RjiOduQ5jnGMdBzrGOXIdxQ4Ch1xh+FIOEY7ihzFjuMcYxwljrGOcY7xjlLH8Y4JjomOMsckx2TH" +
+	"FMdUxwmOEx0nOaY5pjtOdpzimOEod8x0VDgqHUnHLMdsR5Wj2nGqY46jxlHrqHPUOxocpzkaHU2OlKPZMdcxzzHfscBxuuMM" +
+	"x0LHmY6zHGc7FjnOcZzrOM+x2HG+4wLHhY4Wx0WOix2XOJY4LnVc5rjc0eq4wnGl4yrHUsfVjmsc1zraHNc5rnfc4FjmuNFx" +
+	"k+Nmx3LHLY5bHbc52h234zHHHY47HSscdznudtzjWOm413Gf437HKscDjgcdDzk6HA87HnE86ljteMzxuOMJxxrHk46nHE87" +
+	"1jqecTzreM7R6Xje8YLjRcc6x0uOlx2vONY7XnW85njdscHxhuNNx1uOjY63He843nVscrzneN/xgaPL8aFjs2OLY6vjI8c2" +
+	"x8eO7Y5PHJ86PnN0Oz53fOH40rHD8ZXja8c3jp2Obx3fOb537HL84PjR8ZNjt+Nnxy+OXx17HL85fnf84YTT5DQ7mZM7hVNy" +
+	"yk6L0+pUnDan6rQ7HU6n0+V0Oz1Or9Pn9DsDzqAzw3mA80DnQc5+zoOdhzgPdYachzn7Ow93DnAe4TzSOdCZ6RzkPMo52Jnl" +
+	"HOIc6hzmzHZqzrAz4tSdUWfMmePMdQ53jnAe7cxzHuMc6TzWOcqZ7yxwFjrjTsOZcI52FjmLncc5xzhLnGOd45zjnaXO450T" +
+	"nBOdZc5JzsnOKc6pzhOcJzpPck5zTnee7DzFOcNZ7pzprHBWOpPOWc7ZzipntfNU5xxnjbPWWeesdzY4T3M2OpucKWezc65z" +
+	"nnO+c4HzdOcZzoXOM51nOc92LnKe4zzXeZ5zsfN85wXOC50tzoucFzsvcS5xXuq8zHm5s9V5hfNK51XOpc6rndc4r3W2Oa9z" +
+	"Xu+8wbnMeaPzJufNzuXOW5y3Om9ztjtvd97hvNO5wnmX827nPc6Vznud9znvd65yPuB80PmQs8P5sPMR56PO1c7HnI87n3Cu" +
+	"cT7pfMr5tHOt8xnns87nnJ3O550vOF90rnO+5HzZ+YpzvfNV52vO150bnG8433S+5dzofNv5jvNd5ybne873nR84u5wfOjc7" +
+	"tzi3Oj9ybnN+7Nzu/MT5qfMzZ7fzc+cXzi+dO5xfOb92fuPc6fzW+Z3ze+cu5w/OH50/OXc7f3b+4vzVucf5m/N35x8uuEwu" +
+	"s4u5uEu4JJfssrisLsVlc6kuu8vhcrpcLrfL4/K6fC6/K+AKujJcB7gOdB3k6uc62HWI61BXyHWYq7/rcNcA1xGuI10DXZmu" +
+	"Qa6jXINdWa4hrqGuYa5sl+YKuyIu3RV1xVw5rlzXcNcI19GuPNcxrpGuY12jXPmuAlehK+4yXAnXaFeRq9h1nGuMq8Q11jXO" +
+	"Nd5V6jreNcE10VXmmuSa7Jrimuo6wXWi6yTXNNd018muU1wzXOWuma4KV6Ur6Zrlmu2qclW7TnXNcdW4al11rnpXg+s0V6Or" +
+	"yZVyNbvmuua55rsWuE53neFa6DrTdZbrbNci1zmuc13nuRa7zndd4LrQ1eK6yHWx6xLXEtelrstcl7taXVe4rnRd5Vrqutp1" +
+	"jetaV5vrOtf1rhtcy1w3um5y3exa7rrFdavrNle763bXHa47XStcd7nudt3jWum613Wf637XKtcDrgddD7k6XA+7HnE96lrt" +
+	"esz1uOsJ1xrXk66nXE+71rqecT3res7V6Xre9YLrRdc610uul12vuNa7XnW95nrdtcH1hutN11uuja63Xe+43nVtcr3net/1" +
+	"gavL9aFrs2uLa6vrI9c218eu7a5PXJ+6PnN1uz53feH60rXD9ZXra9c3rp2ub13fub537XL94PrR9ZNrt+tn1y+uX117XL+5" +
+	"fnf94Ybb5Da7mZu7hVtyy26L2+pW3Da36ra7HW6n2+V2uz1ur9vn9rsD7qA7w32A+0D3Qe5+7oPdh7gPdYfch7n7uw93D3Af" +
+	"4T7SPdCd6R7kPso92J3lHuIe6h7mznZr7rA74tbdUXfMnePOdQ93j3Af7c5zH+Me6T7WPcqd7y5wF7rjbsOdcI92F7mL3ce5" +
+	"x7hL3GPd49zj3aXu490T3BPdZe5J7snuKe6p7hPcJ7pPck9zT3ef7D7FPcNd7p7prnBXupPuWe7Z7ip3tftU9xx3jbvWXeeu" +
+	"dze4T3M3upvcKXeze657nnu+e4H7dPcZ7oXuM91nuc92L3Kf4z7XfZ57sft89wXuC90t7ovcF7svcS9xX+q+zH25u9V9hftK" +
+	"91Xupe6r3de4r3W3ua9zX+++wb3MfaP7JvfN7uXuW9y3um9zt7tvd9/hvtO9wn2X+273Pe6V7nvd97nvd69yP+B+0P2Qu8P9" +
+	"sPsR96Pu1e7H3I+7n3CvcT/pfsr9tHut+xn3s+7n3J3u590vuF90r3O/5H7Z/Yp7vftV92vu190b3G+433S/5d7oftv9jvtd" +
+	"9yb3e+733R+4u9wfuje7t7i3uj9yb3N/7N7u/sT9qfszd7f7c/cX7i/dO9xfub92f+Pe6f7W/Z37e/cu9w/uH90/uXe7f3b/" +
+	"4v7Vvcf9m/t39x8eeEwes4d5uEd4JI/ssXisHsVj86geu8fhcXpcHrfH4/F6fB6/J+AJejI8B3gO9Bzk6ec52HOI51BPyHOY" +
+	"p7/ncM8AzxGeIz0DPZmeQZ6jPIM9WZ4hnqGeYZ5sj+YJeyIe3RP1xDw5nlzPcM8Iz9GePM8xnpGeYz2jPPmeAk+hJ+4xPAnP" +
+	"aE+Rp9hznGeMp8Qz1jPOM95T6jneM8Ez0VPmmeSZ7Jnimeo5wXOi5yTPNM90z8meUzwzPOWemZ4KT6Un6Znlme2p8lR7TvXM" +
+	"8dR4aj11nnpPg+c0T6OnyZPyNHvmeuZ55nsWeE73nOFZ6DnTc5bnbM8izzmecz3neRZ7zvdc4LnQ0+K5yHOx5xLPEs+lnss8" +
+	"l3taPVd4rvRc5VnqudpzjedaT5vnOs/1nhs8yzw3em7y3OxZ7rnFc6vnNk+753bPHZ47PSs8d3nu9tzjWem513Of537PKs8D" +
+	"ngc9D3k6PA97HvE86lnteczzuOcJzxrPk56nPE971nqe8Tzrec7T6Xne84LnRc86z0uelz2veNZ7XvW85nnds8HzhudNz1ue" +
+	"jZ63Pe943vVs8rzned/zgafL86Fns2eLZ6vnI882z8ee7Z5PPJ96PvN0ez73fOH50rPD85Xna883np2ebz3feb737PL84PnR" +
+	"85Nnt+dnzy+eXz17PL95fvf84YXX5DV7mZd7hVfyyl6L1+pVvDav6rV7HV6n1+V1ez1er9fn9XsD3qA3w3uA90DvQd5+3oO9" +
+	"h3gP9Ya8h3n7ew/3DvAe4T3SO9Cb6R3kPco72JvlHeId6h3mzfZq3rA34tW9UW/Mm+PN9Q73jvAe7c3zHuMd6T3WO8qb7y3w" +
+	"FnrjXsOb8I72FnmLvcd5x3hLvGO947zjvaXe470TvBO9Zd5J3sneKd6p3hO8J3pP8k7zTvee7D3FO8Nb7p3prfBWepPeWd7Z" +
+	"3ipvtfdU7xxvjbfWW+et9zZ4T/M2epu8KW+zd653nne+d4H3dO8Z3oXeM71nec/2LvKe4z3Xe553sfd87wXeC70t3ou8F3sv" +
+	"8S7xXuq9zHu5t9V7hfdK71Xepd6rvdd4r/W2ea/zXu+9wbvMe6P3Ju/N3uXeW7y3em/ztntv997hvdO7wnuX927vPd6V3nu9" +
+	"93nv967CWjzjfcD7oPchb4f3Ye8j3ke9q72PeR/3PuFd433S+5T3ae9a7zPeZ73PeTu9z3tf8L7oXed9yfuy9xXveu+r3te8" +
+	"r3s3eN/wvul9y7vR+7b3He+73k3e97zvez/wdnk/9G72bvFu9X7k3eb92Lvd+4n3U+9n3m7v594vvF96d3i/8n7t/ca70/ut" +
+	"9zvv995d3h+8P3p/8u72/uz9xfurd4/3N+/v3j988Jl8Zh/zcZ/wST7ZZ/FZfYrP5lN9dp/D5/S5fG6fx+f1+Xx+X8AX9GX4" +
+	"DvAd6DvI1893sO8Q36G+kO8wX3/f4b4BviN8R/oG+jJ9g3xH+Qb7snxDfEN9w3zZPs0X9kV8ui/qi/lyfLm+4b4RvqN9eb5j" +
+	"fCN9x/pG+fJ9Bb5CX9xn+BK+0b4iX7HvON8YX4lvrG+cb7yv1He8b4Jvoq/MN8k32TfFN9V3gu9E30m+ab7pvpN9p/hm+Mp9" +
+	"M30Vvkpf0jfLN9tX5av2neqb46vx1frqfPW+Bt9pvkZfky/la/bN9c3zzfct8J3uO8O30Hem7yzf2b5FvnN85/rO8y32ne+7" +
+	"wHehr8V3ke9i3yW+Jb5LfZf5Lve1+q7wXem7yrfUd7XvGt+1vjbfdb7rfTf4lvlu9N3ku9m33HeL71bfbb523+2+O3x3+lb4" +
+	"7vLd7bvHt9J3r+8+3/2+Vb4HfA/6HvJ1+B72PeJ71Lfa95jvcd8TvjW+J31P+Z72rfU943vW95yv0/e87wXfi751vpd8L/te" +
+	"8a33vep7zfe6b4PvDd+bvrd8G31v+97xvevb5HvP977vA1+X70PfZt8W31bfR75tvo99232f+D71febr9n3u+8L3pW+H7yvf" +
+	"175vfDt93/q+833v2+X7wfej7yffbt/Pvl98v/r2+H7z/e77ww+/yW/2Mz/3C7/kl/0Wv9Wv+G1+1W/3O/xOv8vv9nv8Xr/P" +
+	"7/cH/EF/hv8A/4H+g/z9/Af7D/Ef6g/5D/P39x/uH+A/wn+kf6A/0z/If5R/sD/LP8Q/1D/Mn+3X/GF/xK/7o/6YP8ef6x/u" +
+	"H+E/2p/nP8Y/0n+sf5Q/31/gL/TH/YY/4R/tL/IX+4/zj/GX+Mf6x/nH+0v9x/sn+Cf6y/yT/JP9U/xT/Sf4T/Sf5J/mn+4/" +
+	"2X+Kf4a/3D/TX+Gv9Cf9s/yz/VX+av+p/jn+Gn+tv85f72/wn+Zv9Df5U/5m/1z/PP98/wL/6f4z/Av9Z/rP8p/tX+Q/x3+u" +
+	"/zz/Yv/5/gv8F/pb/Bf5L/Zf4l/iv9R/mf9yf6v/Cv+V/qv8S/1X+6/xX+tv81/nv95/g3+Z/0b/Tf6b/cv9t/hv9d/mb/ff" +
+	"7r/Df6d/hf8u/93+e/wr/ff67/Pf71/lf8D/oP8hf4f/Yf8j/kf9q/2P+R/3P+Ff43/S/5T/af9a/zP+Z/3P+Tv9z/tf8L/o" +
+	"X+d/yf+y/xX/ev+r/tf8r/s3+N/wv+l/y7/R/7b/Hf+7/k3+9/zv+z/wd/k/9G/2b/Fv9X/k3+b/2L/d/4n/U/9n/m7/5/4v" +
+	"/F/6d/i/8n/t/8a/0/+t/zv/9/5d/h/8P/p/8u/2/+z/xf+rf4//N//v/j8CCJgC5gAL8IAISAE5YAlYA0rAFlAD9oAj4Ay4" +
+	"Au6AJ+AN+AL+QCAQDGQEDggcGDgo0C9wcOCQwKGBUOCwQP/A4YEBgSMCRwYGBjIDgwJHBQYHsgJDAkMDwwLZAS0QDkQCeiAa" +
+	"iAVyArmB4YERgaMDeYFjAiMDxwZGBfIDBYHCQDxgBBKB0YGiQHHguMCYQElgbGBcYHygNHB8YEJgYqAsMCkwOTAlMDVwQuDE" +
+	"wEmBaYHpgZMDpwRmBMoDMwMVgcpAMjArMDtQFagOnBqYE6gJ1AbqAvWBhsBpgcZAUyAVaA7MDcwLzA8sCJweOCOwMHBm4KzA" +
+	"2YFFgXMC5wbOCywOnB+4IHBhoCVwUeDiwCWBJYFLA5cFLg+0Bq4IXBm4KrA0cHXgmsC1gbbAdYHrAzcElgVuDNwUuDmwPHBL" +
+	"4NbAbYH2wO2BOwJ3BlYE7grcHbgnsDJwb+C+wP2BVYEHAg8GHgp0BB4OPBJ4NLA68Fjg8cATgTWBJwNPBZ4OrA08E3g28Fyg" +
+	"M/B84IXAi4F1gZcCLwdeCawPvBp4LfB6YEPgjcCbgbcCGwNvB94JvBvYFHgv8H7gg0BX4MPA5sCWwNbAR4FtgY8D2wOfBD4N" +
+	"fBboDnwe+CLwZWBH4KvA14FvAjsD3wa+C3wf2BX4IfBj4KfA7sDPgV8Cvwb2BH4L/B74I4igKWgOsiAPiqAUlIOWoDWoBG1B" +
+	"NWgPOoLOoCvoDnqC3qAv6A8GgsFgRvCA4IHBg4L9ggcHDwkeGgwFDwv2Dx4eHBA8InhkcGAwMzgoeFRwcDArOCQ4NDgsmB3U" +
+	"guFgJKgHo8FYMCeYGxweHBE8OpgXPCY4MnhscFQwP1gQLAzGg0YwERwdLAoWB48LjgmWBMcGxwXHB0uDxwcnBCcGy4KTgpOD" +
+	"U4JTgycETwyeFJwWnB48OXhKcEawPDgzWBGsDCaDs4Kzg1XB6uCpwTnBmmBtsC5YH2wInhZsDDYFU8Hm4NzgvOD84ILg6cEz" +
+	"gguDZwbPCp4dXBQ8J3hu8Lzg4uD5wQuCFwZbghcFLw5eElwSvDR4WfDyYGvwiuCVwauCS4NXB68JXhtsC14XvD54Q3BZ8Mbg" +
+	"TcGbg8uDtwRvDd4WbA/eHrwjeGdwRfCu4N3Be4Irg/cG7wveH1wVfCD4YPChYEfw4eAjwUeDq4OPBR8PPhFcE3wy+FTw6eDa" +
+	"4DPBZ4PPBTuDzwdfCL4YXBd8Kfhy8JXg+uCrwdeCrwc3BN8Ivhl8K7gx+HbwneC7wU3B94LvBz8IdgU/DG4ObgluDX4U3Bb8" +
+	"OLg9+Enw0+Bnwe7g58Evgl8GdwS/Cn4d/Ca4M/ht8Lvg98FdwR+CPwZ/Cu4O/hz8JfhrcE/wt+DvwT8ykGHKMGewDJ4hMqQM" +
+	"OcOSYc1QMmwZaoY9w5HhzHBluDM8Gd4MX4Y/I5ARzMjIOCDjwIyDMvplHJxxSMahGaGMwzL6ZxyeMSDjiIwjMwZmZGYMyjgq" +
+	"Y3BGVsaQjKEZwzKyM7SMcEYkQ7c2zUpVLWioStbJ+bXlFY31dXJ5byvlz2xMzk1K5elGzq+fXV+XnCOX97a2worqxorm2lk1" +
+	"yfm2in3HSmFlfaq8oiJZl1Iq9h5K8Yryni4re5t4RWN9eUo2SDBJgkavYDLdKMa+jpJ7D2WDhpHsbSWjt8dkurGN3m9Qs/cb" +
+	"1Oh9fc3ee6iOrqivrS2nD7P3+2Ar2q+fqn3HvGhmeSOvmlneKBWnqmsqk1J1upGLaSbVNJPi3plUpxu5mMZc3duai48zV59q" +
+	"O24/jVP3Hatj9h/VnD99mN2YTNbVlNdVVldIJeUVzamkVJNu1JL9z6vZ74NU0rtANemGl1TWp3hNZX1KGtd7fV3v9eP2v75u" +
+	"/+vH9V5f17vAdeUN9U2pxvqGqiQz6mazZN1seTxNvp4mP7538vXpxj6+qrludnljc21NeXPKXr//J2lC7xgae8cwYf8xNO4/" +
+	"hgm9Y2jsbSb2XtWUbmwT91vGpv2WsWz/3lL791bW202qd0XKem5pqueWTuq9pc3pRp5Es2qmWU3qnVVzuhGTGqvrZovmnj/t" +
+	"k/40w+b9P8mT6NY301szZb/Rztvv+IT9jhfsO5ZO7J3r6elGOXHfY3z63kNRU183u0lurqvO1nKzqdWoDVMboVanNkptjNoc" +
+	"anOpHU5tPrUF1BZSG6fWoDbR2w4n/eGkP5x0h5PucNIdTrrDSXc46Q4n3eGkO5x0h5PucNIdTrrDE2J8VX1jnajv+bP3u3ya" +
+	"cz5p55N2Pmnnk3Y+aeeTdj5p55N2Pmnnk3Y+aecbYlJas3mfZgHNt4C0C0i7gLQLSLuAtAtIu4C0C0i7gLQLSLuAtAtIu4Dm" +
+	"XUDrXUjrXUj6haRfSPqFpF9I+oWkX0j6haRfSPqFpF9I+oWkX0j6haRfSPpx0o+Tfpz046QfJ/046cdJP076cdKPk36c9OOk" +
+	"Hyf9OOnHST9O+gbpG6RvkL5B+gbpG1FpdO9bPzvd0LekbpC6QeoGqRukbpC6QeoGqSdIPUHqCVJPkHqC1BM0+wTNPkGzT5B+" +
+	"YriS3xNJel/y8r2Hcr7R25Yn061tfFNNeVNV73H9vuN0L+HsbGo1asPURqjVqY1SG6M2h9pcaodTm09tAbWF1MapNajtXY2w" +
+	"Rvoa6Wukr5G+Rvoa6Wukr+WoE/eP1U37faAzaCQajUSjkWg0Eo1GotFIwjSSMI0kTCMJ00jCNJIwjSRMIwnTSoRpJcK0EmHS" +
+	"D5N+mPTDpB8m/TDpR0g/QvoR0o+QfoT0I6QfiVkq61M1yaamU+kLGkCEBhChAURoABEaQIQGEKEBRGgAOg1ApwHoNACdBqDT" +
+	"AHQagE4LoNMC6KSvk75O+jrp66Svk75O+jrpR0k/SvpR0o+SfpT0o6QfJf0o6UdJP0r6UdKPkn6U9KOkHyX9KOnHSD9G+jHS" +
+	"j5F+jPRjpB8j/Rjpx0g/Rvox0o+Rfoz0Y6QfI/0Y6eeQfg7p55B+DunnkH4O6eeQfg7p55B+DunnkH4O6eeQfg7p55B+DulT" +
+	"GhCmNCBMaUCY0oAwpQFhSgPClAaEKQ0IUxoQpjQgTGlAmNKAMKUBYUoDwpQGhCkNCFMaEKY0IDyc9CkdCFM6EKZ0IEzpQJjS" +
+	"gTClA2FKB8KUDoQpHQhTOhCmdCBM6UB4OOnnk34+6VNKEKaUIEwpQZhSgjClBGFKCcKUEoQpJQhTShCmlCBMKUGYUoJwPunn" +
+	"k34B6VNaEKa0IExpQZjSgjClBWFKC8KUFoQpLQhTWhCmtCBMaUGY0oIwpQVhSgvClBaEKS0IU1oQprQgTGlBmNKCMKUFYUoD" +
+	"wpQGhCkNCFMaEKY0IExpQJjSgDClAWFKA8KUBoQpDQhTGhCmNCBMaUCY0oAwpQFhwnqYsB4mrIcJ62HCepiwHiashw3qz6D+" +
+	"DOqPwB4msIcJ5GECeZhAHiZQhxM51tmN5XN7/l2UmdY0UnuO0j+LZIet6YJgv290aqPUxqjNoTbXXlVfPyf9T+fsd1U+tQXU" +
+	"FlIbp9agtncNIoTTCOE0QjiNEE4jhNMI4TRCOI1oNAotl9rh1JI+QTRCEI0QRCME0QhBNEIQjRBEI+GwWlmfSv8TnvsmRSSN" +
+	"EEkjRNIIkTRCJI0QSSNE0giRNEIkjRBJI0TSCJE0QiSNEEkjRNIIkTRCJI0QSSMR0o+QPoE0QiCNEEgjBNIIgTRCII0QSCME" +
+	"0giBNEIgjRBIIwTSCIE0QiCNEEgjBNIIgTRCII0QSCME0giBNEIgjRBIIwTSCAE0QgCNEEAjBMoIgTJCgIwQGCMExgiBMUJg" +
+	"jBAYIwTGCIExQsCKELAiOX3fkx4BK0LAihCwIgSsCAEqQoCKEKAiBKgIASqSkxCp+rr6JntldbIx2VTdlP6k5Nc0VJWnD63l" +
+	"dfWpZE2yulw1Gpqqa+rr0l9bjBT9vLiejtTxtdU9hXnvh0n7nayMr03O7j3JXV2fKv+Tlkhr8YJkqlyMLq+tLe8d2HBdJj1+" +
+	"YjJVzoxUuSirSqbKeY+gGFPe0FAulZTXzqwsN49tNo9rNk+tlmkE5tJqNqGqXkysnl1bzsrKm2UaDSutqmaFVdWstKlaLd5v" +
+	"JE46oe+zUr53AdTk/tNO9k27um/avuY/X9o7qfT1fGbPpGb3TEpUJmtS5TL1xU/vmVLPD1PpKfV0Juakp1STnlLvGhQUmuua" +
+	"zfOr5freebHGqnqpqWdSmkg3LFXeLJM+a6iqZhVV1ayhqVrU9yy4uv9aO/8yTLV+/7vVvP/dqt97t3qHQVCJxLOl9HA1C60W" +
+	"PZsElUhc5w1V1ZqUvr7vZ/Q8Ul0ZoboyQnVlhOrKCNWVEaorIwSgCAEoQgCKEIAiBKAIAShCAIoQgCIEoAgBKEIAihCAIlRZ" +
+	"RqiyjBCQIgSkCAEpQpVlhCrLCFWWEaosIwSsCFWWEaosI1RZRqiyjFBlGUmQfoL0E6SfIP0E6SdIP9Grr1MtqVMtqVMtqVMt" +
+	"qRMCdUKgTgjUCYE61ZI61ZI6wU8n+OkEP53gpxP8dIKfTvDTCX46wU8n+OkEP53gpxP8dIKfTvDTCX46wU8n+OkEP53gpxP8" +
+	"dIKfTvDTCX46VZA6VZA6cU8n7unEPZ24pxP3dOKeTtzTiXs6cU8n7unEPZ24pxP3dOKeTtzTiXs6cU8n7unEPZ24pxP3dOKe" +
+	"TtzTiXs6cU8n7unEPZ24pxP3dOKeTtzTiXs6cU8n7unEPZ24pxP3dOKeTtzTiXs6cU8n7unEPZ24p1MBqRP/dOKfTvzTqYDU" +
+	"qYDUqYDUiYs6cVGnAlInPupUQOrESZ04qRMndeKkTpzUiZM6cVKnAlKnAlKnAlKnAlKnAlKnAlKnAlKnAlKnAlKnAlKnAlKn" +
+	"AlKnAlInHuvEY514rBOPdeKxTjzWicc68VinAlKnAlInPuvEZ534rBOfdeKzTgWkTgWkTgWkTgWkTgWkTgWkTgWkTgWkTgWk" +
+	"TgWkTgWkTgWkTgWkTgWkTgWkTgWkTgWkTgWkTgWkTgWkTgWkTgWkTgWkTgWkTgWkTgWkTgWkTgWkTgWkTgWkTgWkTgWkTgWk" +
+	"TgWkTgWkTgWkTgWkTgWkTgWkTgWkTgWkTgWkTgWkTgWkTgWkTgWkTgWkTgWkTgWkTgWkTgWkTgWkTgWkTgWkTgWkTgWkTgWk" +
+	"TgWkTgWkTgWkTgWkTgWkTgWkTgWkTgWkTgWkTgWkTgWkTgWkTgWkTgWkTvvKOu0r61RQ6lRQ6lRQ6lRQ6lRQ6lRQ6lRQ6sR+" +
+	"nQpKnQpKnQpKndivU0GpU0GpU0GpE/914r9O/NeJ/zrxXyf+68R/nfivE/914r9O/NeJ/zrxXyf+68R/nfivE/914r9O/NeJ" +
+	"/zrxXyf+68R/nfivE/914r9O/NeJ/zrxXyf+68R/nfivE/914r9O/NeJ/zrxXyf+68R/nfivE/+jxP8o8T9K/I8S/6PE/yjx" +
+	"P0r8jxL/o8T/KPE/SvyPEv+jxP8o8T9K/I8S/6PE/yjxP0r8jxL/o8T/KPE/SvyPEv+jxP8o8T9K/I8S/6PE/yjxP0r8jxL/" +
+	"o8T/KPE/SvyPEv+jxP8o8T9KnI4Sp6PE6ShxOhrpO4/GSZyOEqejxOkocTpKnI4Sp6PE6ShxOkqcjhKno8TpKHE6SpyOEqej" +
+	"xOkocTpKnI4Sp6PE6ShxOkqcjhKno8TpKHE6SpyOEqejxOkocTpKnI4Sp6PE6ShxOkpcjhKXo8TlKHE5SlyOEpejxOUo8ThK" +
+	"PI4Sj6PE4yjxOEo8jhKPo8TjKPE4SjyOEo+jxOMo8ThKPI4Sj6PE4yjxOEo8jhKPo8TjKPE4SjyOEo+jxOMo8ThKPI4Sj6PE" +
+	"4yjxOEo8jhKPo8TjKPE4SjyOEo+jxOMo8ThKPI4Sj6PE4ShxOEp8iRJfosSXKPElSnyJEl+ixJco8SVKfIkSX6IFff3SfIgv" +
+	"UeJLlPgSJb5EiS9R4kuU+BIlvkSJJ1HiSZR4ECUeRIkHUeJBlHgQJR5EiQfReN/1NF7iQZR4ECUeRIkHUeJBlHgQJR5EiQdR" +
+	"4kGUeBAlHkSJB1HiQZR4ECUeRIkHUeJBlHgQpTgfpTgfpTgfpTgfpTgfo/gao/gao7gao7gao3gao3gYozgXo3gWozgWozgW" +
+	"ozgWozgWozgWozomRnVMjOqYGNUxMapjYlTHxKiOiVEdE6M6JkZ1TIzqmBjFxxjFxxjFxxjFxxjFxxjFxxjFxxjFxxjFxxjF" +
+	"xxjFvRjFvRjFvRjFvRjFvRjFvRjFvRjFvRjFvRjFvZje1y/Ni+JejOJejOJejOJejOJejOJejOJejOJejOJejOJejOqSGMW7" +
+	"GNUXMYpnMYpnMYpnMYpnMYpnMYpnMYpnMYpnMYpnsVhfvzR+imcximcximcximcximcxilsxiksxiksxiksxiksxiksxiksx" +
+	"iksxiksxiksxiksxiksxiksxiksxiksxiksxqhNiFJ9iFJ9iVCfEqE6IUZ0QozohRnVCjOqEGNUJMaoTYlQnxKhOiFGdEKM6" +
+	"IUZ1QozqhBjVCTGqE2JUJ8SoTohRnRCjOiFGdUKM6oQY1QkxqhNiVCfEqE6IUZ0QozohRnVCjOqEGNUJMaoTYlQnxKhOiFGd" +
+	"EKM6IUZ1QozieIzieIzieIzieIzieIzieIzieIzieIzieIzieIzieIzieIzieIzieIzieIzieIzidIzidIzy/hjl+THK82OU" +
+	"38cojscoTsco/sUo/sUo/sUo/sX64l+i73zSozw3RnlujPLcHOJKDnElh7iSQ1zJobolh+qWHBp/Do0/h8afU9jXXz61BdQW" +
+	"Uhun1qC2dz1yaH45xKkc4lQOcSqHOJVD888hTuUQp3KIUznEqRziVA5xKoc4lUOcyiFO5RCncohTOcSpHOJUDnEqhziVQ5zK" +
+	"IU7lEKdyqD7Jofokh+qTHKpPcqg+yaH7lkP3LYfuWw7dtxy6bzl033LoPuX03SeqRwzKYw2KHwbFD4PihkFxwqA4YVB8MCge" +
+	"GPR+G/R+G/R+G/R+G/ReG/ReG/ReG/ReG8P7+jGopfHQ+2zQ+2zQ+2zQe2zQ+2vQ+2rQ+2rQe2rQe2rQe2rQe2rQe2rQe2rQ" +
+	"e2rQe2rQe2rQe2rQe2rQe2rQe2rQ+2nQ+2nQ+2nQ82/Q82/Q82/Q82/Q82/Qc2/Q827Q827Q827Q827Q827Q827Q823Q823Q" +
+	"823Q823Q823Q823Q823Q823Q823Q823Q82z0Ps9adm881bJ711/L7l1/Lbt3/bXs3niqZffeBy27N55q2b33Q8vujadadm88" +
+	"1bJ774+W3Xt/tOzeeKpl5/fpGdQmetsC0i8g/QLSLyD9AtIvIP0C0i8g/QLSLyD9AtIvIP0C0i8g/QLSLyD9QtIvJP1C0i8k" +
+	"/ULSLyT9OJ0fp/PjdH6czo/T+fG+82m8cRpvnMYbp/HGabxxGm+cxtt3f+I03jiN1yB9g/QN0jdI3yB9g/QN0jdI3yB9g/QN" +
+	"0jdI3yB9g/QN0jdIP0H6CdJPkH6C9BOknyD9BOknSD9B+gnST5B+gvR790k08kE08kE08kE08kE08kE08kE08kE08j808j80" +
+	"8j808j808j808j808j808j808j808j808j808j808j808j808j808j808j808j808j808j008j008j008j008j008j008j00" +
+	"8j008j008j008j008j008j008j008j008j008j008j008j008j008j008j008j008j008j008js08js08js08js08js08js0" +
+	"8js08js08js08js08js08js08js08js08js08js08js08js08js08js08jk08jk08jk08jk08jk08jk08jk08jc08jc08jc0" +
+	"8jc08jc08jc08jc08jc08jc08jc08jc08jc08jc08jc08jc08jc08jc08jc08jc08jc08jc08jc08jc08jc08jc08jc08jc0" +
+	"8jc08jc08jc08jc08jc08jc08jc08jc08jc08jc08jc08jc08jc08jc08jc08jc08jc08jc08jc08jc08jc08jc08jc08jc0" +
+	"8jc08jc08jc08jc08jc08jc08jc08jc08jc08jc08jc08jc08jc08jc08jc08jc08jc08jc08jc08jc08jc08jc08jc08jc0" +
+	"8jc08jc08jc08jc08jc08jc08jc08jc08jc08jc08jc08jc08jc08jc08jc08jc08jc08jc08jc08jc08jc08jc08jc08jc0" +
+	"8jc08jc08jc08jc08jc08jc08jc08jM08jM08jM08jM08jM08jM08jM08jM08jM08jM08jM08jM08jM08jM08jM08jM08jM0" +
+	"8jM08jM08jM08jM08jM08jM08jM08jM08jM08jM08jM08jM08jM08jM08jM08jM08jM08jM08jM08jM08jM08jM08jM08jM0" +
+	"8jM08jM08jE08jE08jE08jE08jE08jE08jE08jE08jE08jE08jE08jE08jE08jE08jE08jE0vY/PCdJPkH6vj6GRj6GRj6GR" +
+	"j6GRj6GRj6GRj6GRj6GRj6GRD6GRD6GRD6GRD6GRD6GRD6GRD6GRD6GRD6GRD6GRD6GRD6GRD6GRD6GRD6GRD6GRD6GRD6GR" +
+	"D6GRD6GRD6GRD6FFicdR4nGUeBwlHkeJx1HicZR4HCUeR4nHUeJxlHhM/oZG/oZG/oZG/oZG/oZG/oZG/oZG/oZG/oZG/oZG" +
+	"/oZG/oZG/oZG/oZGvoZGvoZGvoZGvoZGvoZGvoZGvoZGvoZGvoZGvoZGvoZGvoZGvoZGvoZGvoZGvoZGvoZGfoZGfoZGfoZG" +
+	"foZGfoYWJS5Hicvkb2jkb2jkb2jkb2jkb2jkb2jkb2hR4jL5HBr5HBr5HBr5HBr5HBr5HBr5HBr5HBr5HBr5HBr5Exr5Exr5" +
+	"Exr5Exr5Exr5Exr5Exr5Exr5Exr5Exr5Exr5Exr5Exr5Exr5Exr5Exr5Exr5Exr5EFqUeBklXkaJl1HiZZR4GSVeRomXUeJl" +
+	"lHgZJV5GiZdR4mWUOBclzkWJc1HiXJQ4FyXORfP7rqN1Ic5FiXNR4lyUOBclzkWJc1HiXJQ4Rz6CRr6BRvvQGu1Da7Qvq9G+" +
+	"rEb7slqM8qIY5UUxyotov1aL0X2M0X2kfViN9mE12ofVaB9Wo31YjfZhNdqH1WgfVqN9WI32YTXah9VoH1ajfViN9mE12ofV" +
+	"aB9Wo31YjfZhNdqH1WgfVqN9WI32YTXah9VoH1ajfViN9mE12ofVaB9Wo31YLU7xPE7xPE7xPE7xPE7xPE7xPE7xPE7xPN7r" +
+	"S2txqsviVJfFqS6LZ/fpxKk1qO2dZ5x4ECcexIkHceJBnHgQJw7EiQNx4kCcOBAnDsSJA3HiQJw4ECcOxIkDceJAnDgQJw7E" +
+	"iQNxivtxivtxivtxivtxivtxivtxivtxivtxivtxivdxivdxivdxivdxivdxivdxivdxivdxivdxivdxivdxivdxivdxivfx" +
+	"SJ8uzZPifpzifpzifpzifpzifpzifpzepzi9T3GK+3GK+3GK+3GK+3GK+3GK+3GK+3GK+3GK+3Gqx+IU/+MU/+MU/+MU/+MU" +
+	"/+MU/+MU/+MU/+MU/+MU/+MU9+MU9+MU9+MU9+MU9+MU9+MU9+MU9+MU9+MU9+MU9+MU9+MU9+MU9+MU3+MU3+MUz+MUx+MU" +
+	"D+L0nsX73jOqG+JUN8SpbohTPI1TPI1TPI1TPI1TPI1TPI1TPI1TPI1TPI1TPI1TPI1TPI1TPI1TPI1TPI1TPI1T3RCnuiFO" +
+	"dUOc6oY41Q1xqhviVDfEqW6IU90Qp7ohTnVDnOqGONUNcaob4rQ/F6f9uTjVCXGqE+JUJ8SpTohTnRCnOsGgeGVQvDIoXhkU" +
+	"rwyKVwbFK4PilUHxyqB4ZWT39ZdPbQG1hdTGqTWo7R2vQfHKoHhlULwyKF4ZFK8Myl8NilsGxS2D4pZBccuguGVQ3DIobhkU" +
+	"twyKWwbFLYPilkFxy6C4ZVDcMih/NSh/NSiOGRTHDIpjBsUxg+KYQXHMoDhmUBwzKI4ZlL8aFM8MimcGxTOD4plB8cygeGZQ" +
+	"PDMonhkUzwyKZwbFM4PimUHxzKB4ZlA8I19DMyieGRTPDIpnBsUzg+KZQfHMoHhmUDwzKJ4ZFM8MimcGxTOD4plB8cygeGZQ" +
+	"PDMonhkUzwyKZwbFM4PimUHxzKB4ZlA8MyieGRTPDIpnBsUzg/JZg+KaQXHNoLhmUFwzKK4ZFNcMimsGxTWD4ppBcc2guGZQ" +
+	"XDMorhkU1wzaZzJon8mgfSaD8imD8imD8imD8imD8imD8imD8imD8imD8imD4qZBcdOgvNigvNigOGpQXmxQPDUoLzZyEtKU" +
+	"9N8ok+b1NlN6/x2HeenGOqXvr0VY5/Ud9V5HeRn5Xhr5XppBeRn5X5pBeZlBeRn5YZpBeZlBeZlBeZlBeZlBeZlBeRn5Zxr5" +
+	"Zxr5Zxr5Z5pBeRn5aBr5aBr5aBr5aBr5aBr5aBr5aJpBvCA/TSM/TSM/TTOIF+SraQbxgvw1zSBeGMQL8ts08ts0g3hBvptG" +
+	"vptGvptGvptGvptGvptGvptGvptGvptGvptGvptGvptGvptmEC/If9PIf9PIf9MM4oVBvCA/TiM/TiM/TiM/TiM/TjOIJ+TL" +
+	"aQbxhPw5zejjCe07kT+nkT+nkT+nGbTvZNC+E/l1Gvl1Gvl1Gvl1Gvl1Gvl1Gvl1Gvl1Gvl1Gvl1mkH7TgbtO5F/p/X5dwbV" +
+	"OwbtOxm072RQ/WPQvpNB+04G7TsZtO9k0L6TQftOBu07GbTvZNC+k0H+kEH7TwbtPxm0/2TQ/pNB+09GQpNO6H0RF6Qb+pbU" +
+	"affJoN0ng3afDNp9Mmj3yaDdJ4N2nxJE9wTRPUF0TxDdE0T3BNE9QXRPEN0TRPcE0T1BdE9k9/VfSG2cWoPa3tkliO4JonuC" +
+	"6J4guieI7gmie4JoniCaJ4jmCaJ5gmieIJoniN4JoneC6J0geieI3gmid4LonSB6J4jeCaJ3guidIHoniN6JcJ8ezY/onSB6" +
+	"J4jeCaJ3guidIHoniN4JoneC6J0geieI3gmid4LonSB6J4jeCaJ3guidIHoniN4JoneC6J0geieI3gmidYJonSBaJ4jWCaJ1" +
+	"gmidIDoniM4JonOC6JwgOieIzgmic4LonCAqJ4jGiWhfvzR+om+C6Jsg+iaIvgmib4LomyD6Joi+CaJvguibIPomiL4Jom+C" +
+	"6Jsg+iaIvgmib4LomyD6Joi+CaJvguibIPomiL4Jom+C6Jsg+iaIvgmib4Lom6BdkATtgiRoFyRBuyAJ2gVJUNWTINomiLYJ" +
+	"om2CaJsg2iaItgmibSK3T4/mS7RNEG0TRNsE0TZBtE0QbRNE2wTRNkG0TRBtE0TbBNE2QbRNEG0TRNsE0TZBtE0QbRNE2wTR" +
+	"NkG0TRBtE0TbBNE2QbRNEG0TRNsE0TZBtE0QbRNE2wTRNkG0TRBtE0TbBNE2QbRNEG0TRNkEUTZBlE0QZRNE2QRRNkGUTRBl" +
+	"E0TZBFE2QZRNEGUTRNkEUTZBlE0QXRNE1wTRNUF0TRBdE0TXBNE1QXRNEF0TRNcE0TVBdE0QXRNE1wTRNEE0TRBNE0TTBNE0" +
+	"QTRNEEUTRNEEUTRBFE0QRRNE0QRRNEEUTRBFE0TRBFE0QRRNEEUTRNEEUTRBFE0QRRNE0QRRNEGuTYJcmwRxM0G8TBAvE8TL" +
+	"BPEyQbxM9PGS3JoEuTWJXrcmnN3L0XB2L0fD2b0cDWf3cjSc3cvRcHYvR8PZvRwNZ/dyNJzdy9Fwdi9Hw9m9HA1n93I0nN3L" +
+	"0XB2L0fD2dl9uoneViN9TVNmVc9ubkxWljdV0VckqcXszXWVycamivrGZOXMGvtpzfWpZM8/BdfYlKykc6i7cMRRX9fzTxYm" +
+	"62qS5ZXJRkdqXv1+H+ksGnyYBh+mwYdp8GEafJgGH6bBh2nwYVKLaFJtdV36H8RLVtTX0VgitGQRGn+ElixCqhFSjRRYk/Mr" +
+	"asprK2fW0DekEyGdCOnotEg63SSdbpJON0knJZ2UdFLSSUmn+ek0P53mp9P8dNLVSVcn3SjpRkk3SrpR0o3STKOkHyX9KOlH" +
+	"ST9K+lHSj5J+lPSjpB8l/Sjpx0g/Rvox0o+Rfoz0Y9RvjPqNUb8x6jdG/cao3xzqN4f6zaF+cmgeOTSPHJpHDs0jh+aRQ3o5" +
+	"pJdDejmkl0N6OaSXS3q5pJdL88ileeSSfi7p55J+Lunnkn4u6eeSfi7p55J+Lunnkv5w0h1OusNJdzjpDifd4aQ7nHSHk+5w" +
+	"0h1OusNJdzjpDifd/Gx7RX1NfV1tfV0yVd64gL4N85rqxnL6QBL5Makh2ZRM9X1NCvmkkJ/PK+vrZnOjubGevqE55dOc8mlN" +
+	"C2huBTS3AppbAc2tgOZWQMIFpFRASgU0lwLqP079xam/OK1FnNYiTnOO//+4O/c/ObKzvHf7TK2k0Uja8UqytbsWvskk2UWZ" +
+	"eutyTm/A1qmuen1ZB2ObkGBw7NZMS5rsaEbu6dnFSTbZJBBIAsECYxObgDGJc8OQkJthbewkJNgEDOZi7rmTEDC5X2Dg0+bT" +
+	"/X6796z/BPTDnKruU/WcU3X6eU/V0fke6mwBQXIMNN9a7gtpQVqSVqQ1qScNpAPShnRI2pJ2pFb/PN86+eobk9Fj06MJHyCc" +
+	"I5wjjI3mOcK5P/3EeHc8OZxORoeHfIa4PZVInjcn3/Dsk7cbdybjw+3J7p3prjH8JMd0c+ESCJdAKIFQAqEEQgmw3xz7zbHf" +
+	"HPvNsd8c+82lPTU+nO7eHk2x+xwDzguUC5QLrkFBCYoyG+2N79xiD32MOMeI8wL9Av0C/QJ9bDnHlnNsOceWc2w5x5ZzbDkv" +
+	"qT/2nGPPOfacY8859pxjyzk2nGO/OfabV3Jy/gO7tTvZOTV94mCxwQ3EgXMcOMeBcxw4x4HzKp462B+Pd2/emt7amN6ajNk+" +
+	"PH1j9/Hl9sbh+PHxPjscR5Vx5BxHznHkHEfOceS8pjw15akpT015asqDc+c4d45z5zh3jnPnOHeOc+c4d+7R9+jj5DlOnuPk" +
+	"OU6e4+Q5Tp7j5DlOnuPkOU6e4+Q5Tg7kVYC8CpBXAfIqQF4FyKvkYXBqNJkcPLE3vjE9sdg6urO+SCfzy2tf7hw8sW9b1w+m" +
+	"t06RbYdfGaYMr1XgtQq8VoHXKvBaBV6rwGsVeK2SD6hCpAqRKkSqEKlCpAo4NsxWyaPfWJXs+uGYDylEpBCRQkQKgXvnuHeO" +
+	"e+e4N/BWAd4qwFslb8qz26PJZHd0czwZT48mXIuGsjRcC0wdhqvAcBUYrgLDVWC4CgxXgeEqMFwFhqvAcBUYrgLDVWC4CgxX" +
+	"geEqMFwFhqvAcBUYrgLDVWC4CgxXyYdq13Ln+t68Zawvd47unFluLtrHKte8iax25q3EztNSLoIVbFeB7SqwXQW2q8B2Fdiu" +
+	"AttVYLsKbFeB7Sr5Msh1XJeO69JxXTr0O/Q79Dv0O/Q79Dv0O/Q79Dv0O/QVfUVf0Vf0FX1FX9FX9BV9RV/RV/QVfUWfp6Fc" +
+	"9dTR/u78wWJkXXLZyk+Pv2r3cDren+6uPitPjm/fmb79cDw9eXMy5y7tT0+M98a353BoY2jNN8kcTx4ebd+a3hotPxiStqSm" +
+	"DcNVYLYKzFaB2SqS1xujw+l4snv42O0RDUDstaIIARxMq0g+3LgzObhzMJlH69He+sFkemuOwR7tZaP9m3v26wXSKkBaBUir" +
+	"AGkVIK0iUq/vHdzc3R7N+een2DyYbOzuT+fPYNtzkexof9kxANIqQFoFSKsAaRUgrQKkVYC0ihTFqemt8WR842BCCXmGEkK3" +
+	"ELqhtAqUVoHSKlI0Jw53b+/ujSbsUwJCN5RWgdIqUFoFSqtAaRUpy1PbB/s3J0er+0jwhtMq8FkFPqvAZxX4rAKfVeCzCnxW" +
+	"gc8q8FkFPqvAZxX4rAKfVeCzCnxWgc8q8FkFPqvAZxX4rAKfVeCzilR6evy2o93HR3vj/W2uLtEbNqvAYhVYrAKLVWCxCixW" +
+	"gcUqsFgFFqvAYhVYrAKLVWCxCixWgcUqsFgFFqvAYhVYrAKLVWCxCixWgcUqsFgFFqvAYhVYrAKLVSTki1/DeHJ4dP1wPD27" +
+	"3Jn/GU9P7R9M7QtyVxuT8ZwAT+7ljuUmDyXjiQxKq0BpFSitAqVVoLQKlFaB0ipQWgVKq0BpFRmU63MS/d74zt7RIR/VZ+2j" +
+	"20d70907e2/nY0pCdwBeq8BrFXitAq9V4LUKvFaB1yrwWgVeq8BrFYnlmTvjyZ3xfOGDo9Xvik4B1FaB2ipQWwVqq0BtFait" +
+	"ArVVoLYK1FaB2ipQWwVqq0BtFaitArVVoLYK1FaB2ipQWwVqq0BtFaitArVVoLYK1FaB2ipQWwVqq0BtFRmWJ3YOps/4Lh0B" +
+	"oSMAxVWguAoUV4HiKlBcBYqrQHEVKK4CxVWguAoUV4HiKlBcBYqrQHEVKK4iBHwh4AsBXwj4QsAXAj7UV4H6KlBfBeqrQH0V" +
+	"qK8C9VWgvgrUV4H6KlBfBeqrCAFfCPhQYAUKrECBFSHgCwFfCPhCwIcSK0LAFwK+EPCFgC8EfCHgCwFfCPhCwBcCvhDwxf6z" +
+	"uhQ8xRdbeXbr4IjebcEzPNRZgTorUGcF6qwUPMMXPMPDmxV4swJvVuDNCrxZKbb0zGT8OPF0/8C8BeqsQJEVKLICNVagxgrU" +
+	"WClyXZ8H4puT0d70zmrzOmcjloOJFTCxAiZWwMQKWFgBCyvgRwX8qIAfFXCjAl5UCt5RFbxLKvCdAt8p8J0C3ynwnQLfKfCd" +
+	"At8p8J2Cx5CCx5ACxylwnALHKXCcAscpcJwCxylwnALHKYbLlHLQwsFJCvhIYfqdMP1OmLYlTNsSpm0J07aEaVvCtC1h2pYw" +
+	"bUuYtiVlPTj5Rs23tpZv3qutfP5BnnxQkJakFWlN6kkD6YA0kjYn36hb6Qlb0o5U5xmKZzLQ4phiIVVezDNIkoEi8A6pyv08" +
+	"Q5lkoAy8QKryRRlCkoEy0GiZZSHMshBmWQizLKSSRREGz5yA9ss0C2GahTDNQphmIUyzkEoWJaiTE1ACOqPMsxDmWQjzLIR5" +
+	"FlIVixL4Z05AX5SJFsJEC2GihTDRQphoIVWxKEGVnIAS0BmFJCXMuBBmXAgzLoQZF8KMC2HGhTDjQphxIcy4EGZcCDMuhBkX" +
+	"wowLYcaFMONCqnLeDspFOzj5RpWSjSpfbggbxWCZZ/mJLPNItcy8xUa5zFyEZZ7Vhl9u1Ms8q43lV+VSIl+JLs8sy6KWq8zL" +
+	"M5fL8pTL8pTLM5fLw6tVBVfnSVowfVjwUwJ+SsBPCfgpAT8l4KeE6R3C9A5heocwvUOY3iFM7xCmdwjTO4TpHcL0DmF6hyym" +
+	"d9y5vnew/Zjt88aJ6R1SheLEzn76PfqMHVTBZ+m3qNNPrUJzYu9G+j3q9FOroCcmU/t+b3p4a7QzzhZ/T+w8ZukiF57P1A9h" +
+	"6odUvKqq6JtW9E0r+qYVMaIiRlTEiIoYUQ301I3dvb3xzvWDr7rnVSJby4tNkGAOiTCHRJhDIswhEeaQCHNIpIqDe16Vh6os" +
+	"FkmVr9vpJ+PtKRkoAVGCSSQCrEuqJj8xnb+NOrrDPgWgXwqsS4B1CbAuAdYlVTOw4yfoNY3t85IPWJcA6xJgXQKsS4B1CbAu" +
+	"qYaFHb93g3306ZcC75KKfmk1HNxjDw/scuHpjlbDbnFheG0O8ktAfgnILwH5JSC/BOSXgPySqvWndvcfv360tzeezrdSSXqk" +
+	"YL8E7JeA/RKm6wjYLwH7JUzfEbBfAvZLwH5J1VXrB3fG+ybKR1SeUA75SyB/SUWntKJTWtEpreiUVnRKIYQJhDCBECYQwgRC" +
+	"mFR0Sis6pRWd0opOaUWntKJTWtEpreiUVnRKKzqlFZ3SGmuqGVqqGVqq6SDUdBBqOgiQygRSmdR0ECCWCcQyqemWQi6Tmv5B" +
+	"Tf+gZoip5o1UTfegpntQ84aq5g0V5DOp6R3UOfp0YGs6BzWdAwhpUtOhrekb1PQNIKdJTd8AgppAUBMIagJBTSCoCQQ1gaAm" +
+	"ENQEgppAUBMIagJBTSCoCQQ1gaAmENQEgppAUBMIagJBTSCoCQQ1gaAmENSkLganDm/v7o1vjLbHG7v7j6923OHRPlkoAl2D" +
+	"utB7boxvj/jx1GW+luwgT7cA0JowwU2Y4CaA1gTQmgBaE0BrAmhNAK0JoDUBtCaA1gTQmgBaE0BrAmhNAK1JzZsqgGtS86aq" +
+	"5k0VADapeVNV86aq5k1VzZuqutLs8M4yxoBpk7qWte29o+vslNmt8WgyPbGzO7q9/I8XENoEQpvU9eD07aNDnq3GZ5Pt5f+4" +
+	"ANYmwNqESYHCpEBhUqAwKVCYFChMChQmBQqTAoVJgVIT/WuiP5A3AfImQN4EyJsAeRMgbwLkTZhcKEwuFCYXCpMLhcmFwuRC" +
+	"YXKhMLlQmFwoTC4UJhcKkwuFyYXC5EJhcqEwuVCYXChMLhQmFwqTC4XJhcLkQmFyoTC5UJhcKEwuFCYXCpMLhcmFAtxNgLsJ" +
+	"cDcB7ibA3QS4mwB3E+BuAtxNgLsJcDcB7ibA3QS4mwB3E+BuAtxNgLsJcDcB7ibA3QS4mwB3E+BuAtxNgLsJcDepid818bsm" +
+	"fte8V6qJah6L91vL/YK0JK1JPWkgHZAOSVvSjtTq47Fyj5V7rNxj5R4r91i5x8o9Vu6xco+Ve6zcY+UeK/dYucfKPVbusXKP" +
+	"lXus3GPlHiv3WLnHyj1W7rFwj4V7LNxj4R4L91i4x8I9Fu6xcI+FeyzcY+EeC/dYuMfCPRbuebbzPNt5/ouA578IePzb49+e" +
+	"RzvPo53n0c7zaOfxcI+Hezzc4+EeD/d4uMfDPR7u8XCPd3s82+PZHs/2eLbHmz2e7PFkjyd7PNnjyR5P9niyx4U9bz08bz08" +
+	"bz08bz08bz08VuyxYs8IgmcEwTOC4PFfj//6eqlHffBfj/96/Nfjvx7/9fivx389/uvxX4//evzX478e//X4r8d/Pf7r8V+P" +
+	"/3r81+O/Hv/1+K/Hfz3+6/Ffj/96/Nfjvx7/9fivx389/uvxX4//evzX478e//X4rMdnPT7r8VmPz3qetDx+6/Fbj996/Nbj" +
+	"tx6/9fitx289fuvxW4/fevzW47cev/X4rcdvPX7r8VuPz3p81uOzHp/1+KzHZz0+6/FZz3t/z3t/z3t/z3t/z/OV5/kKCKUA" +
+	"oRTgjQKUUYAyiucRwvMI4XmEANYowBgFGKMAYxRgjAKMUYAxiucRwfOIAJxRgDMKcEbxujw/5ecRwfOI4HlECDwiBOJHIH4E" +
+	"4kcgfgQeEQJxJBBHAnEkEEcCjwiBR4RAXAnElUBcCcSVQFwJxJVAXAnElUBcCcSVQFwJxJVAXAnElUBcCcSVQFwJxJVAXAnE" +
+	"lUBcCcSVQFwJxJVAXAnElUBcCcSVwCNCIL4E4ksgvgTiSyC+BOJLIL4E4ksgvgTiSyC+BOJLIL4E4ksgvgTiSyC+BOJLIL4E" +
+	"4ksgvgTiSyC+BOJLIL4E4ksgvgTiSyC+BOJLIL4E4ksgvgTiSyC+BJ4RAnEm8IwQiDeBeBOIN4F4E3hGCDwjBJ4RAnEo8IwQ" +
+	"iEeBeBSIR4F4FIhHgXgUiEeB/48WeCsfiE+B+BSIT4H4FIhPgfgUiE+B+BSIT4H4FIhPgfgUiE+B+BSIT4H4FIhPgfgUiE+B" +
+	"+BSIT4H4FIhPgfgUiE+B+BSIT4H4FIhPgfgUiE+B+BSIT4H4FIhPgfgUiE+B+BSIT4H4FIhPgfgUiE+B+BSIT4H4FIhPgfgU" +
+	"iE+B+BSIT4H4FHg+CDwfBJ4PAnErELcCcSsQtwJxKxC3AnErELcCcSsQtwJxKxC3AnErELcCcSsQtwJxKxC3AnErELcCcSsQ" +
+	"twJxKxC3As8JgfgViF+B+BWIX4H4FYhfgfgViF+B+BWIX4H4FYhfgfgViF+B94OB54vA80Xg+SLwfBH4D2yBeBeId4H3g4H3" +
+	"g4Fx68C4dWDcOvCiMPCiMDB6Fhg9C7wpDLwpDLwpDLwpDLwpDLwpDLwpDIxbB8atA+PWgbeEgbeEgbeEgbeEgbeEgbeEgbgd" +
+	"eEsYeJ4KvCUMvCUMjO4F4nsgvgfieyC+B+J7IL4HXhEGXhEGXhEGXhEG4n8g/gfifyD+B+J/IP4H4n8g/gfifyD+B+J/IP4H" +
+	"4n8g/gfifyD+D4jnA+L5gPg9IH4PiA8D4sMAXxjgCwPu54D7OeB+DrifA+7ngPs54H4OuJ8DrtOAcg8od6RfEumXRPolkf5E" +
+	"pD8R6U9E+hOR/kSkPxHpT0T6E5H+RKQ/EelPRPoTkf5EpD8R6U9E+hOR/kQkfkf8PuL3EZ+N+GzEZyM+G/HZiM9GrmfkekZ8" +
+	"NuKzEZ+N+GzEZyM+G/HZiM9GfDbisxGfjfhsxGcjPhvx2YjPRnw24rMRn434bMRnIz4b8dmIz0Z8NuKzEZ+N+GzE/yL+F/G/" +
+	"iP9F/C/ifxH/i/hfxP8i/hfxv4j/Rfwv8nuItKuG9tTQnhraU0M/t6Gf29DPbfhdNPwuGvq5Df3cZmt53oZ0SNqSdqRWz4Z2" +
+	"2dAuG9plQ7tsaJcN7bKhXTa0y4Z22dAuG9plQz+2oR/b0I9t6Mc29GMb+rEN/aqGftWQ/s+Q/s+Q/s+Q/s+wXuarSGtSTxpI" +
+	"B6SRtCEdkrakHamVe0j/Z0j/Z0j/Z0j/Z0j/Z0j/Z0j/Z0j/Z0i/Z0i/Z0i/Z0i/Z8jvccjvccjvERiZACMTYGQCjEyAkQkw" +
+	"MgFGJsDIBBiZACMTYGQCjEyAkQkwMgFGJsDIBBiZACMTYGQCjEyAkUlLewBKJkDJBCiZACUToGQClEyAkglQMgFKJkDJBCiZ" +
+	"ACUToGQClExa2kvLcw9wMQEuJsDFBLiYABcT4GICXEyAiwlwMQEuJsDFBLiYABcT4GICXEyAiwlwMQEuJsDFBLiYABcT4GIC" +
+	"XEyAiwlwMQEuJsDFBLiYABcT4GICXEyAiwlwMQEuJsDFBLiYABcT4GICXEyAiwlwMQEuJsDFBLiYtDynABkTIGMCZEyAjAmQ" +
+	"MQEyJkDGBMiYABmTlt8XECgB6iRAnASIkwBxEiBOAoSpbK1fWLbWLyxb6xeWrfULS2BaJTCtEphWCUyrBKZVAtMqW+sXlq31" +
+	"C8vW+oVla/3Csh0uddXSFv0W/Rb9Fv0W/Rb9Fv0W/Rb9Fv0W/Rb9Fv0W/Rb9Fv0O/Q79Dv0O/Q79Dv0O/Q79Dv0O/Q79Dv0O" +
+	"/Q79Dv0OfUVf0Vf0FX1FX9FX9BV9RV/RV/QVfUVf0Vf0rV8YgR5HoMcR6HEEehyBHkegxxHocQR6HIEeR6DHEehxBHocgR5H" +
+	"oMcR6HEEehyBHkegxxHocQR6HIEeR6DHEehxBHocgR5HoMcR6HFkEccI/DgCP47AjyPw4wj8OAI/jsCPI/DjCPw4Aj+OwI8j" +
+	"8OMI/DgCP47AjyPw4wj8OAI/jsCPI/DjyNhsZGw2MjYbGZuNjL1Gxl4jY62RsdbI2GpkbDUythoZW40sWhVZtCqyaFVk0arI" +
+	"olWRIdHIkGhk0arIolWRRasi46CRcdDIOGhkvDAyXhgZL4yMF0bG/yLjf5Hxvsh4X2ScKjJOFb3F2egtzkbGrSLjVpFxq8i4" +
+	"VWTcKjJuFRm3ioxbRcatIuNWkXGryLhUZFwqMi4VGZeKjEtFxqEi41CRcajIOFRkHCoyDhW9UB+hPkJ9BL2C8haUt+D8Becv" +
+	"OH/B+QvOX3D+gvMXnL/g/MXy/NSnoD4F9SmoT4l+iT7tjvGkyHhSZDwpMp4UGU+KvuR8JeejXTKeFBlPir6iPrRLT7tkPCgy" +
+	"HhQZD4qMB0XGUyLjKZHxlMh4SmScJDJOEhkniYyTRMZJIuMkkfGRyPhIZBwjMo4RGceIjGNExjEi4xiRcYzIOEZkHCMyjhEZ" +
+	"x4i8p4+8p4+8p4+8p4+8p4+8p4+8p4/eJpRtSVddrdiqV1t+tRVWW4PVVnW1XB1Rro4oV0eUqyPK1RHF6ohidUSxOqJYHVGs" +
+	"jpDVEbI6QlZHyOoIWR2Rr47IV0fkqyPy1RH52uF0fPvE0f6usqa9spaLspaLspaLspaLspaLspaLspaLsqa9sqaLsqaLsqaL" +
+	"sqaLsqaLsqaLsqaLsqaLsqaLsqaLsqaLsqaLsqaLsqaLsqaLsqaLsqaLsqaLsqaLsqa9sraLsraLsraLsraLsraL8p/Llf9c" +
+	"rqztoqztoqztoqzpoqzpoqzpoqzpoqzpoqzpoqzpoqzpoqzpoqzpoqzpoqzpoqzpoqzpoqxlr6ztoqztoqztoqztoqztoqzt" +
+	"oqztoqztoqztoqztoqztorV1N5XHeeXxXXl8Vx7flcd35fFaebxWHq+Vx2vl8VobrnvDdeexWnmsVh6rlcdq5bFaeazWhuve" +
+	"cN0b4bxc/4br33D9G65/w/VvuP4N17/h+jdc/4br33D9G65/w/VvuP4N17/hujdc94br3nDdG657w/VuuM4N17kpOX/J+UvO" +
+	"W1KvkvOXnL/k/CXnLzl/Sb3KpQ71qqhHhU6FTkU9KvQq9Cr0KvQq9Cr0KvQq9Cr0KvRq6ldTvxr9Gv0a/Rr9Gv0a/Rr9Gv0a" +
+	"/Rr9Gv0a/Rr9Gn2Pvkffo+/R9+h79D36Hn2Pvkffo+/R9+h79D36Hv2AfkA/oB/QD+gH9AP6Af2AfkA/oB/QD+gH9AP6Af0B" +
+	"+gP0B+gP0B+gP0B/gP4A/QH6A/QH6A/QH6A/QH+A/gD9iH5EP6If0Y+cL3K+yPki52vJ11LOlnK2lLOlnC3lbClny3lbztty" +
+	"3pbytZSv47wd5+04b8d5O87bcV7lPEr5lPPZ44PCrlbY1Qq7WmFXK+xqhV2tsKsVdrXCrlbY1Qq7WmFXK+xqhV2tsKsVdrXC" +
+	"jlbY0cprB+W1g8L2Vdi+CttXYfsqbF+F6aswfRWmr8L0VZi+CtNXYfoqTF+F6asdv4OO3wFrViprVmrH74C1K7XjdwDTV2H6" +
+	"KkxfhemrMH0Vpq/C9FWYvgrTV2H6KkxfhemrMH0Vpq/C9FWYvgrTV2H6KkxfhemrMH0Vpq/C9FWYvgrTV2H6KkxfhemrMH0V" +
+	"pq/C9FWYvgrTV2H6KkxfhemrMH0Vpq/C9FWYvgrTV2H6KkxfhemrMH0Vpq/C9FWYvgrTV2H6KkxfhemrMH0Vpq/C9FWYvgrT" +
+	"V2H6KkxfhemrMH0Vpq/C9FWYvgrTV2H6KkxfhemrMH0Vpq/C9FWYvgrTV2H6KkxfhemrMH0Vpq/C9FWYvgrTV2H6Kkxfhemr" +
+	"MH0Vpq/C9FWYvgrTV2H6KkxfhemrMH0Vpq/C9FWYvgrTV2H6KkxfhemrMH0Vpq/C9FWYvgrTV2H6Kkxf7RR9RV/RV/QVfUVf" +
+	"0Vf0FX1FX9HHFzt8EJqhQjNUaIYKzVBV23uO8q1ia8uS3BKxpLCktKSypLbEWxIsGVgSLWksGVqCQmeJLpLc9HLTy00vN73c" +
+	"9HLTy00vN73c9HLTy00vN73c9HLTy01PTEhMSExI5kKtVbq1SrdW6dYq3VqlW6t0a5VurdKtVbq1SrdW6dYq3VqlW6t0a5Vu" +
+	"rdKtVbq1SrdW6dYq3VqlW6t0a5VurdKtVbq1SrdW6dYq3VqlW6t0a5Vui9z0xPTE9MT0qLuYnpiemJ6YnpiemJ6YnpiemJ6Y" +
+	"npiemF5heoXpFaZXmF5heoXpFaZXmF5heoXpFaZXmF5heoXpFaZXmF5peqXplaZXml5peqXplaZXml5peqXplaZXml5peqXp" +
+	"laZXml5lepXpVaZXmV5lepXpVQu9yipWWcUqq1FlNaqsRpXVqLKqVFaVyqpSWVUqq0pldaisuJUVt7LiVlbcquRki+JWVtzK" +
+	"yllZOSsrZ2XlrChn5S2x4lZW3MqEKhOqTKgyocqEKhOqTai2wtemV5tebXq16dWmV5tebXq16dWmV5tea8e1dlxrx7V2XGvH" +
+	"tXZca8e1HGflbK2crZWztXJ2Vs7OytlZOTsrZ2d6nel1pteZXmd6nel1pteZXmd6nel1pteZnpqemp6anpqemp6anpqemp6a" +
+	"npqemp6anpqemp6ani70avOz2vysNj+rzc9q87Pa/Kw2P6vNz7y1Al+xFywZWBItaSwZWtJa0lmyUPfWCry1Am+twFsr8NYK" +
+	"vLUCb63AWyvw1gq8tQJvrcBbK/C16dWmV5tebXre9LzpedPzpudNz5ueNz1vet70vOl50/Om503Pm543PW96wfSC6QXTC6YX" +
+	"TC+YXjC9YHrB9ILpBdMLphdML5heML1getbKvbVyb63cWyv31sq9tXJvrdxbK/fWyr21cm/t01v79NY+vbVPb+3TW/v01j69" +
+	"tU9v7dMv2qduLZxdtxbOrlsLZ9ethbPr1sLZdasgZ22JtyRYMrAkWtJYMrSktaSzRBdJaXql6ZWmV5peaXql6ZWmV5peaXql" +
+	"6ZWmV5peaXql6ZWmV5peZXqV6VWmV5leZXqV6VWmV5leZXqV6VWmV5leZXqV6VWmV5lebXq16dWmV5tebXq16dWmV5tebXq1" +
+	"6dWmV5tebXq16dWmV5ueNz1vet70vOl50/Om503Pm543PW963vS86XnT86bnTc+bXjC9YHrB9ILpBdMLphdML5heML1gesH0" +
+	"gukF0wumF0wvmN7A9AamNzC9gelF+y7ad9G+i3xnZYlWlmhliVaWaGWJVpZoZYlWlmhliVaWaGVpTKExhcYUGlNoTKExhcYU" +
+	"GlNoTKExhcYUGlNoTKExhaEpDE1haApDUxiawtAUhqYwNIWhKQxNYWgKQ1MYmsIQBbuerQm1JtSaUGtCrQm1JtSaUGtCrQm1" +
+	"JtSaUGtCrQm1JrQwK7W+sFpfWK0vrNYXVusLq/WF1frCan3h+et6SxYnK82XSrMgC4NqYVAtDKqFQbUwqBYG1cKgWhjUetGt" +
+	"13rRrdd60a3XetGt13rRrdd60a3XetGt13rRrdc6Nz2rSm1Vqa0qtVWltqrUVpXaqlJbVerc9HLTy00vN73c9HLTy01PTE9M" +
+	"T0xPTE9MSExITEhMSExITEhMyC5kbReyFlMwu6/tstZm97XZfW12X3Otze5rs/va7L42u6/N7muz+9rsvja7r83ua7P72uy+" +
+	"LotTO3uvnW6PJgf7J1uWlsrifNGp7Et393bG2Svny9+sD3cn20e350i5bDjPmzVzlP2p9mA62t4e7083XnW0f3M0Obq9Nzqa" +
+	"nm4Pjq7vjRcL56yPJqPru9tv2TmYbrApOwfTw+VOMd85m+68ZXQ2zfiW0TJruTjuaH93q667qzd290ernd393elq5/Z4Z9d2" +
+	"Yp5ki3mSLeZJtlrTs2l6Nk2yNTH5pomLb05Ttsnu/k37wg+Tk/lhcogfJifzbZqtTbO1SbaQ1iCkNQhpDYKk2STNJmm2Ks1W" +
+	"pdmqtKLp9WjS69HY9VjeoJujG2+5Pposvivy/Or26HB8otu/eXW0Nz03/1DqcHXnYLo3Pjy0/UG73D+9OGgrzF92sF3O99n2" +
+	"yefzPHmyvZXkz5Pt5efF5xw7TD5fnn8410g+X55HPmd7K9ku2K4/ZztPtreS7UFy7HJ78R5n/fpktGC5HEyz18wvll3dgd2e" +
+	"i7zFXOxc3TvYv3k4HU2WdyC9hU16C5v0Fg7TH8gw/YEM0x/IwNpNtvdMIVoT2JzvlD5cvX2wf3BzMrpzi08Gz3xybndOZx0/" +
+	"lt7iOSD8c/YH6f586cVn7w+b5b7b3jnIXj0virGFr14fHY4X162u8q26bJJtu6d12cz3k8+7ZFvJ0yV5yiTPfFsXtfbD+Mz1" +
+	"mu+sLtF8Z3Xx/LBJszVptibNNkyzDdNswzRbm2Zr02xtmq1Ls3Vpti7Npmk2TbMlP2HfbiXZ2q0kW7uVZsvTbHmaLU+zSZpN" +
+	"0mySZivSbEWarUizlWm2Ms1WptmqNFuVZqvSbHWarU6z1Wk2n2bzaTafZgtptpBmC2m2QZptkGYbpNnS9tam7a1N21ubtrc2" +
+	"bW9t2t7atL21aXtr0/bWpu2tTdtbm7a3Nm1vbdre2rS9tWl7a9P21qbtrUvbW5e2ty5tb13a3rq0vXVpe+vS9tal7a1L21uX" +
+	"trcubW9d2t66tL11aXvr0vbWpe2tS9tbl7a3Lm1vXdreurS9dWl769L21ll7O/WG3f2bo+sHj49PQ/SZWyLbebItyXaRbJfJ" +
+	"dpVs18m2Xzl+6burO7ujbfP3MLp6fd61m/sxnzTPfJK9fXVYHQZpZyKmO026k3YmBlvpTpHulOlOne74dCcVHcRn7zwT5AYx" +
+	"DXJpcQZNmq1Js6Udt0HacRukHbdB2nEbpB23QdpxG6QBeJAG4MGzAvCzrk7a1RqkXc+YXreY/G7mO0m2tBsY025gTLuBMb3w" +
+	"sUizFWm2tKsR065GTLsaMb0/0afZfJotpNlCmi2k2dJ7GtN7GtN7GtN7GtN7GtN7GtN7GtN7GtN7GtObFdObFdOb1aR3oUnv" +
+	"QpPehSa9C016F5r0LjRpi2/KNFuZZkt/C02dZqvTbOldaNK70KR3oUnvQpPehSa9C036M2sGabZBmi29vE16eZv08jbpT6ZJ" +
+	"fzKN/WTOsaTYwRP7i2eIzbcdzdfOOdhffbLBxJLFTq/Xi1/9nKf6L+vNeq5/pbfZc/2X9l/Ue6SX9V/aO+65/kt6F3uu/2I+" +
+	"e/Ei33zb9V+4+P7zek/3XP9yb6fn+i9YfPtg71LP9R/oXe65/v2LTy4t/j5/8fd5i78XF38v9M/3zvay/oXF3nzb9e9bbD93" +
+	"8fdc/2zvyV7WP7fYm2+7/pn+Ru/re1n/zOKzM72P9db6G/3TvS/vZYtvXH+j91RvrX+6v957WS9bfOP6p3vXemuLT1z/1OLI" +
+	"k4u/J3obi7/zI+75vndfzeJ9/XsW9coWf9cWudyiRs9ZfNJf/O1d++yT7rMvd7OZ+53ffjj7nZn77Yfd8cz91m++MvutJ91v" +
+	"vtL9/2P3/2bu/87c/5m5//20+18z9z9n7n/M3H+/7P7bzP3GZ9az35i5z6y7z1xb+/VfW89+Xdyvrbv/eux+9e7F7Fdn7r8c" +
+	"u/987H7l7sXsV2buP83cf5y5/zBz/37m/t3M/duZ+zfH7pd/6fnZL++4X3q++8X3Xc5+ccf9ws9fyX7h2P38Ffdzn7qS/dyx" +
+	"+9lPn89+9qL79M/cm336vPuZe91P/9Tp7Kdf6H7qtPvJT13JfvLYferuxexTV9xPvHMj+4mXuB//5Pnsx1/mPvljz80+ed79" +
+	"2HPdj969mP3oC9y/Pu9+5BNPZz8yc5/4+JuzTzztPvHU2sevffaHr2Qff7P7+LW1H77i/tXM/csd90PvuDf7oZn7Fw+6fz5z" +
+	"/2zmPvbRR7KPHbuPfvCB7KOPuB/8yP3ZD4r7yIc3s4/c7z789Lnsw5vu6R/YyJ4+535gw33/3YvZ98/ch2bun15w/+S57h/P" +
+	"3D+auX84c9/3PPcPLrm/f9F97wcfyL732H3PBx/IvufYffDuxeyDD7jvvnsx++4n3d+bub/7Mvd3Zu5vz9zfmrkPzNzfXHd/" +
+	"Y+a+6/1ns++aufefde+/tvad77ucfeexe9/di9n7LrvvuHsx+45j9+3v3Mi+/UH312fu2977dPZtM/fe97w5e+/T7r1Prb3n" +
+	"G69k73mze8+1tb82c9/67qvZt87cu6+6d929mL3r8rXPum9550b2LS9079xw33z3YvbNj7pvunsx+6aZu/uOe7O7F9077nXf" +
+	"eMX91Zn7hpn7+pn7KzP3l2fuL83c133tlezrZu5rr7i/OHNfM3NfLe4vvMv9+Zn7czP31CX3Z9fdn5m5J2fuT8/cnzp2f/LY" +
+	"vX3mnnj8A9kTM/f4B9zR9IHs6NhNH3CHx27ypHvbzN05eDg7eNjtH7vbx27v2D02c39i5nZn7tb2RnZL3M2ZuyFuvLOejWdu" +
+	"Z93tXFvbvr6ebW+46+tu9NYL2ehd7q39zeytF9xb1t0fn7k3z9xX9jezr5y5r3jTA9lXzNyb+pvZmx5wXz5zf+zY/dGZ+7L+" +
+	"Znbts182c39k5r70snvjefeG11/K3nDsXt/fzF5/yX3J6y5lX3LsXvfFm9nrLrkv3nR/+LJ77aPns9decI++ZjN79Lx7zavP" +
+	"Zq/ZdK8+61517F6p57NXXnB63nXHrh2ezdpzbnjWNfFK1hy72N/M4hV37RXnsmsz94qXn81ecc69/Kz7oi88k33RRfeFZ9wf" +
+	"2nGPzNzgvAsz5+9zdXV/Vl9xVXk+q+531cfWyvUzWXnelU+tFbKRFeddcW1NNly+9YEsn7mt/ma29QH3Bzfc1fvcFzz8SPYF" +
+	"x+7hC1eyhx9xD+24P7Djfv/M/b4L7vOft5l9/mX3she6K5fdS1+ymV176KWX3Us23Yt7Z7IXH7sXnXMvurb2wvPu89bd5cvu" +
+	"BQ9eyl5wxT147r7swUvuwQ/1e9furj1wxt1/6dHs/ifdpf5mdulR9/yZe96mu3jhSnbx2F3ob2YXrrjzO+6+Tffcmdvsb2ab" +
+	"M3fvjjt39t7s3H3u3MfWzt7rzj61dqa/mZ05dhviTq+fyU5fdKefWls/49avrZ2auZMzd2Lm7snWs3tmLlt32bW1tWPndtxz" +
+	"+pvZc2au3zuT9Tdd74zrf6i/8zXf0H/ooYd+L/zrPfR7998Ler87ADBT5g3EJQoA"
@@ -0,0 +1,64 @@
+//go:build ignore
+// +build ignore
+
+// genfonts writes fonts.go with the DejaVu fonts of the certificates, taken
+// from the font directory of the gofpdf module. DejaVu is free to
+// redistribute, its license is in LICENSE-DejaVu next to this file
+package main
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+var fonts = []struct{ name, file string }{
+	{"certificateFont", "DejaVuSansCondensed.ttf"},
+	{"certificateBoldFont", "DejaVuSansCondensed-Bold.ttf"},
+}
+
+func main() {
+	dir, err := exec.Command("go", "list", "-m", "-f", "{{.Dir}}", "github.com/jung-kurt/gofpdf").Output()
+	if err != nil {
+		log.Fatal(err)
+	}
+	var out bytes.Buffer
+	out.WriteString("// Code generated by genfonts.go; DO NOT EDIT.\n\npackage notify\n")
+	for _, f := range fonts {
+		ttf, err := ioutil.ReadFile(filepath.Join(strings.TrimSpace(string(dir)), "font", f.file))
+		if err != nil {
+			log.Fatal(err)
+		}
+		var gz bytes.Buffer
+		w, _ := gzip.NewWriterLevel(&gz, gzip.BestCompression)
+		if _, err := w.Write(ttf); err != nil {
+			log.Fatal(err)
+		}
+		if err := w.Close(); err != nil {
+			log.Fatal(err)
+		}
+		encoded := base64.StdEncoding.EncodeToString(gz.Bytes())
+		fmt.Fprintf(&out, "\n// %s is %s, gzipped and base64 encoded\nconst %s = \"\" +\n", f.name, f.file, f.name)
+		for len(encoded) > 0 {
+			n := 96
+			if n > len(encoded) {
+				n = len(encoded)
+			}
+			sep := " +"
+			if n == len(encoded) {
+				sep = ""
+			}
+			fmt.Fprintf(&out, "\t%q%s\n", encoded[:n], sep)
+			encoded = encoded[n:]
+		}
+	}
+	if err := ioutil.WriteFile("fonts.go", out.Bytes(), 0644); err != nil {
+		log.Fatal(err)
+	}
+}
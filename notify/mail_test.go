@@ -0,0 +1,144 @@
+package notify
+
+import (
+	"bufio"
+	"context"
+	"encoding/base64"
+	"io"
+	"io/ioutil"
+	"mime"
+	"mime/multipart"
+	"net"
+	"net/mail"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// smtpSink accepts one message like a local SMTP server and keeps it
+type smtpSink struct {
+	addr string
+	rcpt []string
+	data chan string
+}
+
+func newSMTPSink(t *testing.T) (*smtpSink, net.Listener) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	sink := &smtpSink{addr: l.Addr().String(), data: make(chan string, 1)}
+	go func() {
+		conn, err := l.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		r := bufio.NewReader(conn)
+		reply := func(s string) { conn.Write([]byte(s + "\r\n")) }
+		reply("220 localhost ESMTP sink")
+		for {
+			line, err := r.ReadString('\n')
+			if err != nil {
+				return
+			}
+			cmd := strings.ToUpper(strings.TrimSpace(line))
+			switch {
+			case strings.HasPrefix(cmd, "EHLO"), strings.HasPrefix(cmd, "HELO"):
+				reply("250 localhost")
+			case strings.HasPrefix(cmd, "RCPT TO:"):
+				sink.rcpt = append(sink.rcpt, strings.TrimSpace(line[len("RCPT TO:"):]))
+				reply("250 OK")
+			case strings.HasPrefix(cmd, "DATA"):
+				reply("354 End data with <CR><LF>.<CR><LF>")
+				var data strings.Builder
+				for {
+					l, err := r.ReadString('\n')
+					if err != nil {
+						return
+					}
+					if l == ".\r\n" {
+						break
+					}
+					data.WriteString(strings.TrimPrefix(l, "."))
+				}
+				sink.data <- data.String()
+				reply("250 OK")
+			case strings.HasPrefix(cmd, "QUIT"):
+				reply("221 Bye")
+				return
+			default:
+				reply("250 OK")
+			}
+		}
+	}()
+	return sink, l
+}
+
+func TestSMTPMailer(t *testing.T) {
+	sink, l := newSMTPSink(t)
+	defer l.Close()
+	mailer := NewSMTPMailer(sink.addr, "We Collect We Share <noreply@wcws.org>", "", "")
+	mailer.now = func() time.Time { return time.Date(2019, 12, 1, 10, 0, 0, 0, time.UTC) }
+
+	p := Pickup{
+		ID:              "abc",
+		Description:     "two bags of clothes",
+		GiverName:       "Hoàng",
+		PhoneNumber:     "0905123456",
+		Address:         "1 Lê Lợi, Huế",
+		TransactionTime: "2019-12-01T14:30:00+07:00",
+	}
+	email, err := ReceiptEmail("hoang@example.com", p, time.Date(2019, 12, 1, 10, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	require.NoError(t, mailer.Send(context.Background(), email))
+
+	var data string
+	select {
+	case data = <-sink.data:
+	case <-time.After(5 * time.Second):
+		t.Fatal("no message received")
+	}
+	assert.Equal(t, []string{"<hoang@example.com>"}, sink.rcpt)
+
+	msg, err := mail.ReadMessage(strings.NewReader(data))
+	require.NoError(t, err)
+	subject, err := new(mime.WordDecoder).DecodeHeader(msg.Header.Get("Subject"))
+	require.NoError(t, err)
+	assert.Equal(t, "Thank you for your donation to We Collect We Share", subject)
+	mediaType, params, err := mime.ParseMediaType(msg.Header.Get("Content-Type"))
+	require.NoError(t, err)
+	assert.Equal(t, "multipart/mixed", mediaType)
+
+	r := multipart.NewReader(msg.Body, params["boundary"])
+	text, err := r.NextPart()
+	require.NoError(t, err)
+	body, err := ioutil.ReadAll(base64Reader(text))
+	require.NoError(t, err)
+	assert.Contains(t, string(body), "Dear Hoàng")
+	assert.Contains(t, string(body), "two bags of clothes")
+	assert.Contains(t, string(body), "1 Lê Lợi, Huế")
+	assert.Contains(t, string(body), "Our volunteers picked it")
+	assert.NotContains(t, string(body), "0905123456", "the donation was picked up, nobody calls any more")
+
+	attachment, err := r.NextPart()
+	require.NoError(t, err)
+	assert.Equal(t, "donation-certificate-abc.pdf", attachment.FileName())
+	pdf, err := ioutil.ReadAll(base64Reader(attachment))
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(string(pdf), "%PDF"))
+}
+
+func TestValidEmail(t *testing.T) {
+	assert.True(t, ValidEmail("hoang@example.com"))
+	assert.True(t, ValidEmail(" hoang@example.com "))
+	assert.False(t, ValidEmail("hoang"))
+	assert.False(t, ValidEmail("Hoang <hoang@example.com>"))
+	assert.False(t, ValidEmail("hoang@localhost"))
+	assert.False(t, ValidEmail(""))
+}
+
+func base64Reader(r io.Reader) io.Reader {
+	return base64.NewDecoder(base64.StdEncoding, r)
+}
@@ -0,0 +1,113 @@
+// Package notify tells donors and volunteers about the donations
+package notify
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"text/template"
+	"time"
+)
+
+// Event is a step of the life of a donation
+type Event string
+
+// Events sent to the donors and the volunteers
+const (
+	// EventConfirmation is sent to the donor when the donation is created
+	EventConfirmation Event = "confirmation"
+	// EventAssigned is sent to the volunteer assigned to the pickup
+	EventAssigned Event = "assigned"
+	// EventReminder is sent to the donor shortly before the pickup
+	EventReminder Event = "reminder"
+)
+
+// Pickup is what the messages tell about a donation
+type Pickup struct {
+	ID              string
+	Description     string
+	GiverName       string
+	PhoneNumber     string
+	Address         string
+	TransactionTime string
+	VolunteerName   string
+	VolunteerPhone  string
+}
+
+// Notification is a message about a pickup for one recipient
+type Notification struct {
+	Event  Event
+	To     string
+	Pickup Pickup
+}
+
+// Notifier sends the notifications
+type Notifier interface {
+	Notify(ctx context.Context, n Notification) error
+}
+
+// DefaultTemplates are the SMS sent for each event, they are executed with
+// the Pickup
+var DefaultTemplates = map[Event]string{
+	EventConfirmation: "We Collect We Share: thank you {{.GiverName}}! We received your donation ({{.Description}}) and will call you to pick it up {{.When}}.",
+	EventAssigned:     "We Collect We Share: new pickup for you. {{.Description}} from {{.GiverName}} ({{.PhoneNumber}}) at {{.Address}}, {{.When}}.",
+	EventReminder:     "We Collect We Share: reminder, {{with .VolunteerName}}{{.}}{{else}}a volunteer{{end}} will pick up your donation ({{.Description}}) {{.When}}.",
+}
+
+// When is the pickup time as written in the messages
+func (p Pickup) When() string {
+	return "on " + FormatTime(p.TransactionTime)
+}
+
+// Gateway sends text messages
+type Gateway interface {
+	SendSMS(ctx context.Context, to, body string) error
+}
+
+// SMSNotifier sends the notifications as text messages
+type SMSNotifier struct {
+	gateway   Gateway
+	templates map[Event]*template.Template
+}
+
+// NewSMSNotifier returns a notifier sending the messages with the gateway.
+// The templates override the DefaultTemplates of their events
+func NewSMSNotifier(gateway Gateway, templates map[Event]string) (*SMSNotifier, error) {
+	n := &SMSNotifier{gateway: gateway, templates: make(map[Event]*template.Template)}
+	for event, text := range DefaultTemplates {
+		if t, ok := templates[event]; ok {
+			text = t
+		}
+		t, err := template.New(string(event)).Option("missingkey=error").Parse(text)
+		if err != nil {
+			return nil, fmt.Errorf("template %s: %v", event, err)
+		}
+		n.templates[event] = t
+	}
+	return n, nil
+}
+
+// Notify renders the message of the event and sends it
+func (n *SMSNotifier) Notify(ctx context.Context, notification Notification) error {
+	t, ok := n.templates[notification.Event]
+	if !ok {
+		return fmt.Errorf("no template for event %q", notification.Event)
+	}
+	if notification.To == "" {
+		return fmt.Errorf("no phone number to send the %s to", notification.Event)
+	}
+	var body bytes.Buffer
+	if err := t.Execute(&body, notification.Pickup); err != nil {
+		return err
+	}
+	return n.gateway.SendSMS(ctx, notification.To, body.String())
+}
+
+// FormatTime writes a pickup time given as RFC 3339 like "Dec 1, 2019 at
+// 2:30 PM", other times are kept as the donor said them
+func FormatTime(s string) string {
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return t.Format("Jan 2, 2006 at 3:04 PM")
+	}
+	return s
+}
@@ -0,0 +1,85 @@
+package notify
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type sent struct {
+	to, body string
+}
+
+type recordGateway struct {
+	sent []sent
+}
+
+func (g *recordGateway) SendSMS(ctx context.Context, to, body string) error {
+	g.sent = append(g.sent, sent{to, body})
+	return nil
+}
+
+var pickup = Pickup{
+	ID:              "t1",
+	Description:     "two bags of clothes",
+	GiverName:       "Hoang",
+	PhoneNumber:     "0905123456",
+	Address:         "12 Bach Dang, Da Nang",
+	TransactionTime: "2019-12-01T14:30:00+07:00",
+	VolunteerName:   "Lan",
+}
+
+func TestSMSNotifierTemplates(t *testing.T) {
+	gw := &recordGateway{}
+	n, err := NewSMSNotifier(gw, map[Event]string{EventReminder: "See you {{.When}}, {{.GiverName}}"})
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	require.NoError(t, n.Notify(ctx, Notification{Event: EventConfirmation, To: "+84905123456", Pickup: pickup}))
+	require.NoError(t, n.Notify(ctx, Notification{Event: EventAssigned, To: "+84905000111", Pickup: pickup}))
+	require.NoError(t, n.Notify(ctx, Notification{Event: EventReminder, To: "+84905123456", Pickup: pickup}))
+	assert.Equal(t, []sent{
+		{"+84905123456", "We Collect We Share: thank you Hoang! We received your donation (two bags of clothes) and will call you to pick it up on Dec 1, 2019 at 2:30 PM."},
+		{"+84905000111", "We Collect We Share: new pickup for you. two bags of clothes from Hoang (0905123456) at 12 Bach Dang, Da Nang, on Dec 1, 2019 at 2:30 PM."},
+		{"+84905123456", "See you on Dec 1, 2019 at 2:30 PM, Hoang"},
+	}, gw.sent)
+
+	assert.Error(t, n.Notify(ctx, Notification{Event: EventConfirmation, Pickup: pickup}))
+	assert.Error(t, n.Notify(ctx, Notification{Event: "unknown", To: "+1", Pickup: pickup}))
+
+	_, err = NewSMSNotifier(gw, map[Event]string{EventReminder: "{{.Unknown"})
+	assert.Error(t, err)
+}
+
+func TestTwilioGateway(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/2010-04-01/Accounts/AC123/Messages.json", r.URL.Path)
+		user, pass, ok := r.BasicAuth()
+		assert.True(t, ok)
+		assert.Equal(t, "AC123", user)
+		assert.Equal(t, "secret", pass)
+		require.NoError(t, r.ParseForm())
+		if r.PostForm.Get("To") == "bad" {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"code": 21211, "message": "The 'To' number bad is not a valid phone number.", "status": 400}`))
+			return
+		}
+		assert.Equal(t, "+84905123456", r.PostForm.Get("To"))
+		assert.Equal(t, "+15005550006", r.PostForm.Get("From"))
+		assert.Equal(t, "hello", r.PostForm.Get("Body"))
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"sid": "SM1", "status": "queued"}`))
+	}))
+	defer srv.Close()
+
+	g := NewTwilioGateway(srv.URL+"/", "AC123", "secret", "+15005550006")
+	require.NoError(t, g.SendSMS(context.Background(), "+84905123456", "hello"))
+
+	err := g.SendSMS(context.Background(), "bad", "hello")
+	require.Error(t, err)
+	assert.Equal(t, 21211, err.(*GatewayError).Code)
+}
@@ -0,0 +1,134 @@
+package notify
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"fmt"
+	"io/ioutil"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/jung-kurt/gofpdf"
+)
+
+// EventReceipt is the email sent to the donor with the certificate of the
+// donation
+const EventReceipt Event = "receipt"
+
+var receiptTemplate = template.Must(template.New("receipt").Parse(`Dear {{.GiverName}},
+
+Thank you for your donation to We Collect We Share! Our volunteers picked it
+up and it will soon reach someone in need. Here is your receipt.
+
+  Item:      {{.Description}}
+  Picked up: {{.Address}}
+  Time:      {{.FormattedTime}}
+  Receipt:   {{.ID}}
+
+Your donation certificate is attached to this email.
+
+The We Collect We Share team
+`))
+
+// ReceiptEmail returns the thank-you receipt of the pickup for the donor, with
+// the certificate attached
+func ReceiptEmail(to string, p Pickup, issued time.Time) (Email, error) {
+	var text bytes.Buffer
+	if err := receiptTemplate.Execute(&text, p); err != nil {
+		return Email{}, err
+	}
+	certificate, err := Certificate(p, issued)
+	if err != nil {
+		return Email{}, err
+	}
+	return Email{
+		To:      to,
+		Subject: "Thank you for your donation to We Collect We Share",
+		Text:    text.String(),
+		Attachments: []Attachment{{
+			Name:        fmt.Sprintf("donation-certificate-%s.pdf", p.ID),
+			ContentType: "application/pdf",
+			Data:        certificate,
+		}},
+	}, nil
+}
+
+// FormattedTime is the pickup time as written in the receipts
+func (p Pickup) FormattedTime() string {
+	return FormatTime(p.TransactionTime)
+}
+
+//go:generate go run genfonts.go
+
+// certificateFamily is the font of the certificates, DejaVu covers the
+// Vietnamese names which the core fonts don't
+const certificateFamily = "DejaVu"
+
+// fontBytes decodes a font of fonts.go
+func fontBytes(encoded string) ([]byte, error) {
+	gz, err := gzip.NewReader(base64.NewDecoder(base64.StdEncoding, strings.NewReader(encoded)))
+	if err != nil {
+		return nil, err
+	}
+	defer gz.Close()
+	return ioutil.ReadAll(gz)
+}
+
+// Certificate renders the donation certificate of the pickup as a PDF
+func Certificate(p Pickup, issued time.Time) ([]byte, error) {
+	regular, err := fontBytes(certificateFont)
+	if err != nil {
+		return nil, err
+	}
+	bold, err := fontBytes(certificateBoldFont)
+	if err != nil {
+		return nil, err
+	}
+	pdf := gofpdf.New("L", "mm", "A5", "")
+	pdf.AddUTF8FontFromBytes(certificateFamily, "", regular)
+	pdf.AddUTF8FontFromBytes(certificateFamily, "B", bold)
+	pdf.SetTitle("Donation certificate", true)
+	pdf.SetAuthor("We Collect We Share", true)
+	pdf.AddPage()
+	w, h := pdf.GetPageSize()
+
+	pdf.SetDrawColor(46, 125, 50)
+	pdf.SetLineWidth(1.5)
+	pdf.Rect(8, 8, w-16, h-16, "D")
+
+	pdf.SetY(24)
+	pdf.SetFont(certificateFamily, "B", 24)
+	pdf.SetTextColor(46, 125, 50)
+	pdf.CellFormat(0, 12, "Certificate of Donation", "", 1, "C", false, 0, "")
+
+	pdf.SetTextColor(0, 0, 0)
+	pdf.SetFont(certificateFamily, "", 12)
+	pdf.Ln(4)
+	pdf.CellFormat(0, 8, "We Collect We Share gratefully acknowledges the donation of", "", 1, "C", false, 0, "")
+	pdf.SetFont(certificateFamily, "B", 18)
+	pdf.CellFormat(0, 12, p.GiverName, "", 1, "C", false, 0, "")
+	pdf.SetFont(certificateFamily, "", 13)
+	pdf.MultiCell(0, 7, p.Description, "", "C", false)
+
+	pdf.Ln(6)
+	pdf.SetFont(certificateFamily, "", 10)
+	for _, line := range []string{
+		"Pickup: " + p.Address,
+		"Time: " + p.FormattedTime(),
+	} {
+		pdf.CellFormat(0, 6, line, "", 1, "C", false, 0, "")
+	}
+
+	pdf.SetY(h - 28)
+	pdf.SetFont(certificateFamily, "", 9)
+	pdf.SetTextColor(90, 90, 90)
+	pdf.CellFormat(0, 5, fmt.Sprintf("Certificate %s, issued on %s", p.ID, issued.Format("January 2, 2006")), "", 1, "C", false, 0, "")
+
+	var buf bytes.Buffer
+	if err := pdf.Output(&buf); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
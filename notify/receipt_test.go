@@ -0,0 +1,45 @@
+package notify
+
+import (
+	"bytes"
+	"compress/zlib"
+	"io/ioutil"
+	"testing"
+	"time"
+	"unicode/utf16"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// utf16BE is how the text is written with the UTF-8 fonts of the certificate
+func utf16BE(s string) []byte {
+	var b []byte
+	for _, c := range utf16.Encode([]rune(s)) {
+		b = append(b, byte(c>>8), byte(c))
+	}
+	return b
+}
+
+func TestCertificateVietnameseName(t *testing.T) {
+	pdf, err := Certificate(Pickup{
+		ID:          "abc",
+		GiverName:   "Nguyễn Thị Hương",
+		Description: "hai túi quần áo",
+		Address:     "1 Lê Lợi, Huế",
+	}, time.Date(2019, 12, 1, 10, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	require.True(t, bytes.HasPrefix(pdf, []byte("%PDF")))
+	assert.Contains(t, string(pdf), "/FontFile2", "the font is embedded")
+
+	// the page is the first stream
+	start := bytes.Index(pdf, []byte("stream\n"))
+	require.True(t, start > 0)
+	r, err := zlib.NewReader(bytes.NewReader(pdf[start+len("stream\n"):]))
+	require.NoError(t, err)
+	page, err := ioutil.ReadAll(r)
+	require.NoError(t, err)
+	assert.Contains(t, string(page), string(utf16BE("Nguyễn Thị Hương")))
+	assert.Contains(t, string(page), string(utf16BE("hai túi quần áo")))
+	assert.Contains(t, string(page), string(utf16BE("Pickup: 1 Lê Lợi, Huế")))
+}
@@ -0,0 +1,79 @@
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// TwilioBaseURL is the base URL of the Twilio API
+const TwilioBaseURL = "https://api.twilio.com"
+
+// TwilioGateway sends text messages with the Twilio Messages API, or any
+// gateway with the same API when BaseURL points to it
+type TwilioGateway struct {
+	BaseURL    string
+	AccountSID string
+	AuthToken  string
+	From       string
+	HTTPClient *http.Client
+}
+
+// NewTwilioGateway returns a gateway sending from the number with the
+// account, TwilioBaseURL is used when baseURL is empty
+func NewTwilioGateway(baseURL, accountSID, authToken, from string) *TwilioGateway {
+	if baseURL == "" {
+		baseURL = TwilioBaseURL
+	}
+	return &TwilioGateway{
+		BaseURL:    strings.TrimSuffix(baseURL, "/"),
+		AccountSID: accountSID,
+		AuthToken:  authToken,
+		From:       from,
+		HTTPClient: &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+// GatewayError is an error returned by the gateway
+type GatewayError struct {
+	Status  int    `json:"status"`
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+func (e *GatewayError) Error() string {
+	return fmt.Sprintf("sms gateway: error %d (status %d): %s", e.Code, e.Status, e.Message)
+}
+
+// SendSMS sends the body to the phone number
+func (g *TwilioGateway) SendSMS(ctx context.Context, to, body string) error {
+	form := url.Values{}
+	form.Set("To", to)
+	form.Set("From", g.From)
+	form.Set("Body", body)
+	u := fmt.Sprintf("%s/2010-04-01/Accounts/%s/Messages.json", g.BaseURL, url.PathEscape(g.AccountSID))
+	req, err := http.NewRequest(http.MethodPost, u, strings.NewReader(form.Encode()))
+	if err != nil {
+		return err
+	}
+	req = req.WithContext(ctx)
+	req.SetBasicAuth(g.AccountSID, g.AuthToken)
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	res, err := g.HTTPClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	if res.StatusCode/100 == 2 {
+		return nil
+	}
+	gwErr := &GatewayError{Status: res.StatusCode}
+	if err := json.NewDecoder(res.Body).Decode(gwErr); err != nil || gwErr.Message == "" {
+		gwErr.Message = res.Status
+	}
+	return gwErr
+}
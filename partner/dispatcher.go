@@ -0,0 +1,195 @@
+package partner
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"wcws/logging"
+)
+
+// Defaults of the retries: the delay doubles from DefaultRetryBase up to
+// DefaultRetryMax, a delivery is dead after DefaultMaxAttempts failures,
+// about 4 hours
+const (
+	DefaultMaxAttempts = 8
+	DefaultRetryBase   = 30 * time.Second
+	DefaultRetryMax    = time.Hour
+)
+
+// ErrNotDead is returned when redelivering a delivery which isn't dead
+var ErrNotDead = errors.New("the delivery isn't dead")
+
+// Dispatcher posts the events to the subscriptions wanting them and retries
+// the failed deliveries with an exponential backoff
+type Dispatcher struct {
+	Store       Store
+	HTTPClient  *http.Client
+	MaxAttempts int
+	RetryBase   time.Duration
+	RetryMax    time.Duration
+}
+
+// NewDispatcher returns a dispatcher of the subscriptions of the store, with
+// the default retries
+func NewDispatcher(store Store) *Dispatcher {
+	return &Dispatcher{
+		Store:       store,
+		HTTPClient:  &http.Client{Timeout: 10 * time.Second},
+		MaxAttempts: DefaultMaxAttempts,
+		RetryBase:   DefaultRetryBase,
+		RetryMax:    DefaultRetryMax,
+	}
+}
+
+// Publish queues a delivery of the event for every subscription wanting it
+// and attempts them. An event already queued for a subscription isn't queued
+// again
+func (d *Dispatcher) Publish(ctx context.Context, e Event, now time.Time) error {
+	subs, err := d.Store.Subscriptions(ctx)
+	if err != nil {
+		return err
+	}
+	for _, sub := range subs {
+		if !sub.Wants(e) {
+			continue
+		}
+		delivery := Delivery{
+			ID:             sub.ID + "_" + e.ID,
+			SubscriptionID: sub.ID,
+			Event:          e,
+			Status:         StatusPending,
+			NextAttempt:    now,
+			CreatedAt:      now,
+		}
+		added, err := d.Store.AddDelivery(ctx, delivery)
+		if err != nil {
+			return err
+		}
+		if added {
+			d.attempt(ctx, sub, delivery, now)
+		}
+	}
+	return nil
+}
+
+// DeliverDue attempts the pending deliveries due at now
+func (d *Dispatcher) DeliverDue(ctx context.Context, now time.Time) error {
+	pending, err := d.Store.Deliveries(ctx, StatusPending)
+	if err != nil {
+		return err
+	}
+	for _, delivery := range pending {
+		if delivery.NextAttempt.After(now) {
+			continue
+		}
+		sub, err := d.Store.Subscription(ctx, delivery.SubscriptionID)
+		if err != nil {
+			return err
+		}
+		if sub == nil {
+			delivery.Status = StatusDead
+			delivery.Attempts = append(delivery.Attempts, Attempt{Time: now, Error: "subscription deleted"})
+			if err := d.Store.SaveDelivery(ctx, delivery); err != nil {
+				return err
+			}
+			continue
+		}
+		d.attempt(ctx, *sub, delivery, now)
+	}
+	return nil
+}
+
+// Redeliver queues a dead delivery again, with all its attempts, and
+// attempts it
+func (d *Dispatcher) Redeliver(ctx context.Context, id string, now time.Time) (*Delivery, error) {
+	delivery, err := d.Store.Delivery(ctx, id)
+	if err != nil || delivery == nil {
+		return nil, err
+	}
+	if delivery.Status != StatusDead {
+		return nil, ErrNotDead
+	}
+	sub, err := d.Store.Subscription(ctx, delivery.SubscriptionID)
+	if err != nil {
+		return nil, err
+	}
+	if sub == nil {
+		return nil, fmt.Errorf("no subscription %q", delivery.SubscriptionID)
+	}
+	delivery.Status = StatusPending
+	delivery.Failures = 0
+	delivery.NextAttempt = now
+	return d.attempt(ctx, *sub, *delivery, now), nil
+}
+
+// attempt posts the delivery and saves its outcome
+func (d *Dispatcher) attempt(ctx context.Context, sub Subscription, delivery Delivery, now time.Time) *Delivery {
+	start := time.Now()
+	code, err := d.post(ctx, sub, delivery)
+	a := Attempt{Time: now, StatusCode: code, DurationMs: time.Since(start).Nanoseconds() / int64(time.Millisecond)}
+	logger := logging.FromContext(ctx).With("subscriptionId", sub.ID, "deliveryId", delivery.ID)
+	if err == nil {
+		delivery.Status = StatusDelivered
+	} else {
+		a.Error = err.Error()
+		delivery.Failures++
+		if delivery.Failures >= d.MaxAttempts {
+			delivery.Status = StatusDead
+			logger.Error("giving up on the partner delivery", "err", err)
+		} else {
+			delivery.NextAttempt = now.Add(d.backoff(delivery.Failures))
+			logger.Warn("delivering to the partner", "failures", delivery.Failures, "err", err)
+		}
+	}
+	delivery.Attempts = append(delivery.Attempts, a)
+	if err := d.Store.SaveDelivery(ctx, delivery); err != nil {
+		logger.Error("saving the partner delivery", "err", err)
+	}
+	return &delivery
+}
+
+// backoff is the delay after the failures of a delivery
+func (d *Dispatcher) backoff(failures int) time.Duration {
+	delay := d.RetryBase
+	for i := 1; i < failures && delay < d.RetryMax; i++ {
+		delay *= 2
+	}
+	if delay > d.RetryMax {
+		delay = d.RetryMax
+	}
+	return delay
+}
+
+// post sends the signed event and returns the status code of the partner
+func (d *Dispatcher) post(ctx context.Context, sub Subscription, delivery Delivery) (int, error) {
+	body, err := json.Marshal(delivery.Event)
+	if err != nil {
+		return 0, err
+	}
+	req, err := http.NewRequest(http.MethodPost, sub.URL, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HeaderEvent, string(delivery.Event.Type))
+	req.Header.Set(HeaderDelivery, delivery.ID)
+	req.Header.Set(HeaderSignature, Sign(sub.Secret, time.Now(), body))
+	res, err := d.HTTPClient.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer res.Body.Close()
+	_, _ = io.Copy(ioutil.Discard, res.Body)
+	if res.StatusCode/100 != 2 {
+		return res.StatusCode, fmt.Errorf("partner answered %s", res.Status)
+	}
+	return res.StatusCode, nil
+}
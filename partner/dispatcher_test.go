@@ -0,0 +1,136 @@
+package partner
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// partnerServer is a partner endpoint failing the first posts
+type partnerServer struct {
+	*httptest.Server
+	mu       sync.Mutex
+	failures int
+	events   []Event
+}
+
+func newPartnerServer(t *testing.T, secret string, failures int) *partnerServer {
+	p := &partnerServer{failures: failures}
+	p.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := ioutil.ReadAll(r.Body)
+		require.NoError(t, err)
+		assert.NoError(t, Verify(secret, r.Header.Get(HeaderSignature), body, time.Now(), time.Minute))
+		assert.NotEmpty(t, r.Header.Get(HeaderDelivery))
+		p.mu.Lock()
+		defer p.mu.Unlock()
+		if p.failures > 0 {
+			p.failures--
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		var e Event
+		require.NoError(t, json.Unmarshal(body, &e))
+		assert.Equal(t, string(e.Type), r.Header.Get(HeaderEvent))
+		p.events = append(p.events, e)
+	}))
+	return p
+}
+
+func (p *partnerServer) fail(n int) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.failures = n
+}
+
+func (p *partnerServer) received() []Event {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return append([]Event(nil), p.events...)
+}
+
+func newEvent(id string) Event {
+	return Event{ID: id, Type: EventCreated, Donation: Donation{ID: "t-1", Status: "pending", Description: "two bags of clothes"}}
+}
+
+func TestRetries(t *testing.T) {
+	p := newPartnerServer(t, "whsec_test", 2)
+	defer p.Close()
+	store := NewMemoryStore()
+	require.NoError(t, store.SaveSubscription(context.Background(), &Subscription{URL: p.URL, Secret: "whsec_test"}))
+	d := NewDispatcher(store)
+	ctx := context.Background()
+	now := time.Date(2019, 12, 1, 7, 30, 0, 0, time.UTC)
+
+	require.NoError(t, d.Publish(ctx, newEvent("t-1_pending"), now))
+	require.NoError(t, d.Publish(ctx, newEvent("t-1_pending"), now), "an event is only queued once")
+	pending, err := store.Deliveries(ctx, StatusPending)
+	require.NoError(t, err)
+	require.Len(t, pending, 1)
+	assert.Equal(t, now.Add(30*time.Second), pending[0].NextAttempt)
+
+	require.NoError(t, d.DeliverDue(ctx, now.Add(10*time.Second)))
+	assert.Empty(t, p.received(), "not due yet")
+	require.NoError(t, d.DeliverDue(ctx, now.Add(30*time.Second)))
+	delivery, err := store.Delivery(ctx, pending[0].ID)
+	require.NoError(t, err)
+	assert.Equal(t, now.Add(90*time.Second), delivery.NextAttempt, "the delay doubles")
+
+	require.NoError(t, d.DeliverDue(ctx, now.Add(90*time.Second)))
+	require.Len(t, p.received(), 1)
+	assert.Equal(t, "two bags of clothes", p.received()[0].Donation.Description)
+	delivery, err = store.Delivery(ctx, pending[0].ID)
+	require.NoError(t, err)
+	assert.Equal(t, StatusDelivered, delivery.Status)
+	require.Len(t, delivery.Attempts, 3)
+	assert.Equal(t, http.StatusServiceUnavailable, delivery.Attempts[0].StatusCode)
+	assert.Equal(t, "partner answered 503 Service Unavailable", delivery.Attempts[0].Error)
+	assert.Equal(t, http.StatusOK, delivery.Attempts[2].StatusCode)
+	assert.Empty(t, delivery.Attempts[2].Error)
+}
+
+func TestDeadLetters(t *testing.T) {
+	p := newPartnerServer(t, "whsec_test", 3)
+	defer p.Close()
+	store := NewMemoryStore()
+	sub := &Subscription{URL: p.URL, Secret: "whsec_test"}
+	require.NoError(t, store.SaveSubscription(context.Background(), sub))
+	d := NewDispatcher(store)
+	d.MaxAttempts = 2
+	ctx := context.Background()
+	now := time.Date(2019, 12, 1, 7, 30, 0, 0, time.UTC)
+
+	require.NoError(t, d.Publish(ctx, newEvent("t-1_pending"), now))
+	require.NoError(t, d.DeliverDue(ctx, now.Add(time.Hour)))
+	dead, err := store.Deliveries(ctx, StatusDead)
+	require.NoError(t, err)
+	require.Len(t, dead, 1)
+	assert.Len(t, dead[0].Attempts, 2)
+
+	_, err = d.Redeliver(ctx, "unknown", now)
+	assert.NoError(t, err)
+	delivery, err := d.Redeliver(ctx, dead[0].ID, now.Add(2*time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, StatusPending, delivery.Status, "the partner failed once more")
+	assert.Equal(t, 1, delivery.Failures)
+	_, err = d.Redeliver(ctx, dead[0].ID, now.Add(2*time.Hour))
+	assert.Equal(t, ErrNotDead, err)
+	require.NoError(t, d.DeliverDue(ctx, now.Add(3*time.Hour)))
+	assert.Len(t, p.received(), 1)
+
+	p.fail(1)
+	require.NoError(t, d.Publish(ctx, newEvent("t-1_done"), now))
+	require.NoError(t, store.DeleteSubscription(ctx, sub.ID))
+	require.NoError(t, d.DeliverDue(ctx, now.Add(3*time.Hour)))
+	delivery, err = store.Delivery(ctx, sub.ID+"_t-1_done")
+	require.NoError(t, err)
+	assert.Equal(t, StatusDead, delivery.Status)
+	assert.Equal(t, "subscription deleted", delivery.Attempts[1].Error)
+}
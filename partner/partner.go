@@ -0,0 +1,185 @@
+// Package partner tells the partner charities about the donations through
+// signed webhooks, so that they don't need access to the database
+package partner
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"wcws/geo"
+)
+
+// EventType is what happened to a donation
+type EventType string
+
+// Events sent to the partners
+const (
+	EventCreated       EventType = "transaction.created"
+	EventStatusChanged EventType = "transaction.status_changed"
+)
+
+// Headers of the deliveries
+const (
+	HeaderEvent     = "X-WCWS-Event"
+	HeaderDelivery  = "X-WCWS-Delivery"
+	HeaderSignature = "X-WCWS-Signature"
+)
+
+// Event is the JSON body posted to the partners
+type Event struct {
+	// ID is the same for every delivery of the event, the partners
+	// deduplicate with it
+	ID       string    `json:"id" firestore:"id"`
+	Type     EventType `json:"type" firestore:"type"`
+	Time     time.Time `json:"time" firestore:"time"`
+	Donation Donation  `json:"donation" firestore:"donation"`
+}
+
+// Donation is what the partners are told about a donation. The contact
+// details of the donor aren't shared
+type Donation struct {
+	ID             string  `json:"id" firestore:"id"`
+	Status         string  `json:"status" firestore:"status"`
+	PreviousStatus string  `json:"previousStatus,omitempty" firestore:"previousStatus,omitempty"`
+	Description    string  `json:"description" firestore:"description"`
+	Address        string  `json:"address" firestore:"address"`
+	Lat            float64 `json:"lat" firestore:"lat"`
+	Long           float64 `json:"lng" firestore:"lng"`
+	// PickupTime is RFC 3339
+	PickupTime string    `json:"pickupTime" firestore:"pickupTime"`
+	EventID    float64   `json:"eventId" firestore:"eventId"`
+	CreatedAt  time.Time `json:"createdAt" firestore:"createdAt"`
+}
+
+// Subscription is a partner endpoint and the events it wants
+type Subscription struct {
+	ID  string `json:"id" firestore:"-"`
+	URL string `json:"url" firestore:"url"`
+	// Secret signs the deliveries, it is only shown when the subscription is
+	// created
+	Secret string `json:"secret,omitempty" firestore:"secret"`
+	// Events are the types sent, all of them when it is empty
+	Events []EventType `json:"events,omitempty" firestore:"events"`
+	// Geofence limits the donations to the ones near the partner
+	Geofence  *Geofence `json:"geofence,omitempty" firestore:"geofence,omitempty"`
+	CreatedAt time.Time `json:"createdAt" firestore:"createdAt"`
+}
+
+// Geofence is a circle around the partner
+type Geofence struct {
+	Lat      float64 `json:"lat" firestore:"lat"`
+	Long     float64 `json:"lng" firestore:"lng"`
+	RadiusKm float64 `json:"radiusKm" firestore:"radiusKm"`
+}
+
+// Validate checks the subscription before it is saved
+func (s *Subscription) Validate() error {
+	u, err := url.Parse(s.URL)
+	if err != nil || (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" {
+		return errors.New("url: expected an http or https URL")
+	}
+	for _, e := range s.Events {
+		if e != EventCreated && e != EventStatusChanged {
+			return fmt.Errorf("events: unknown event %q", e)
+		}
+	}
+	if g := s.Geofence; g != nil {
+		if g.Lat < -90 || g.Lat > 90 || g.Long < -180 || g.Long > 180 {
+			return errors.New("geofence: invalid center")
+		}
+		if g.RadiusKm <= 0 {
+			return errors.New("geofence: the radius must be positive")
+		}
+	}
+	return nil
+}
+
+// Wants reports whether the event is sent to the subscription
+func (s *Subscription) Wants(e Event) bool {
+	if len(s.Events) > 0 {
+		found := false
+		for _, t := range s.Events {
+			found = found || t == e.Type
+		}
+		if !found {
+			return false
+		}
+	}
+	return s.Geofence == nil || s.Geofence.Contains(e.Donation.Lat, e.Donation.Long)
+}
+
+// Contains reports whether the coordinates are in the geofence. Donations
+// without a location are in none
+func (g *Geofence) Contains(lat, long float64) bool {
+	if lat == 0 && long == 0 {
+		return false
+	}
+	return DistanceKm(g.Lat, g.Long, lat, long) <= g.RadiusKm
+}
+
+// DistanceKm is the great-circle distance between two coordinates
+func DistanceKm(lat1, long1, lat2, long2 float64) float64 {
+	return geo.DistanceKm(lat1, long1, lat2, long2)
+}
+
+// NewSecret returns a random secret for a subscription
+func NewSecret() (string, error) {
+	b := make([]byte, 24)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return "whsec_" + hex.EncodeToString(b), nil
+}
+
+// Sign returns the signature header of the body sent at t: the timestamp and
+// the hex HMAC-SHA256 of "timestamp.body" with the secret, like
+// t=1575185400,v1=5257a8...
+func Sign(secret string, t time.Time, body []byte) string {
+	ts := strconv.FormatInt(t.Unix(), 10)
+	return "t=" + ts + ",v1=" + mac(secret, ts, body)
+}
+
+func mac(secret, ts string, body []byte) string {
+	h := hmac.New(sha256.New, []byte(secret))
+	h.Write([]byte(ts))
+	h.Write([]byte("."))
+	h.Write(body)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// Verify checks the signature header of a body received at now. Signatures
+// older than the tolerance are rejected, they may be replayed
+func Verify(secret, header string, body []byte, now time.Time, tolerance time.Duration) error {
+	var ts, sig string
+	for _, part := range strings.Split(header, ",") {
+		kv := strings.SplitN(strings.TrimSpace(part), "=", 2)
+		if len(kv) != 2 {
+			continue
+		}
+		switch kv[0] {
+		case "t":
+			ts = kv[1]
+		case "v1":
+			sig = kv[1]
+		}
+	}
+	unix, err := strconv.ParseInt(ts, 10, 64)
+	if err != nil || sig == "" {
+		return errors.New("malformed signature")
+	}
+	if d := now.Sub(time.Unix(unix, 0)); d > tolerance || d < -tolerance {
+		return errors.New("signature expired")
+	}
+	if !hmac.Equal([]byte(sig), []byte(mac(secret, ts, body))) {
+		return errors.New("signature mismatch")
+	}
+	return nil
+}
@@ -0,0 +1,61 @@
+package partner
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSignature(t *testing.T) {
+	now := time.Date(2019, 12, 1, 7, 30, 0, 0, time.UTC)
+	body := []byte(`{"id":"t-1_pending"}`)
+	header := Sign("whsec_test", now, body)
+	assert.Regexp(t, `^t=1575185400,v1=[0-9a-f]{64}$`, header)
+
+	assert.NoError(t, Verify("whsec_test", header, body, now.Add(time.Minute), 5*time.Minute))
+	assert.EqualError(t, Verify("whsec_other", header, body, now, 5*time.Minute), "signature mismatch")
+	assert.EqualError(t, Verify("whsec_test", header, []byte(`{"id":"t-2_pending"}`), now, 5*time.Minute), "signature mismatch")
+	assert.EqualError(t, Verify("whsec_test", header, body, now.Add(10*time.Minute), 5*time.Minute), "signature expired")
+	assert.EqualError(t, Verify("whsec_test", "v1=abc", body, now, 5*time.Minute), "malformed signature")
+}
+
+func TestWants(t *testing.T) {
+	danang := &Geofence{Lat: 16.0544, Long: 108.2022, RadiusKm: 10}
+	event := func(typ EventType, lat, long float64) Event {
+		return Event{Type: typ, Donation: Donation{Lat: lat, Long: long}}
+	}
+	for name, c := range map[string]struct {
+		sub   Subscription
+		event Event
+		want  bool
+	}{
+		"all":              {Subscription{}, event(EventStatusChanged, 0, 0), true},
+		"type":             {Subscription{Events: []EventType{EventCreated}}, event(EventCreated, 0, 0), true},
+		"other type":       {Subscription{Events: []EventType{EventCreated}}, event(EventStatusChanged, 0, 0), false},
+		"near":             {Subscription{Geofence: danang}, event(EventCreated, 16.074345, 108.223851), true},
+		"far":              {Subscription{Geofence: danang}, event(EventCreated, 21.0278, 105.8342), false},
+		"without location": {Subscription{Geofence: danang}, event(EventCreated, 0, 0), false},
+	} {
+		assert.Equal(t, c.want, c.sub.Wants(c.event), name)
+	}
+}
+
+func TestDistanceKm(t *testing.T) {
+	// Da Nang to Hanoi
+	assert.InDelta(t, 606, DistanceKm(16.0544, 108.2022, 21.0278, 105.8342), 5)
+	assert.Zero(t, DistanceKm(16.0544, 108.2022, 16.0544, 108.2022))
+}
+
+func TestValidate(t *testing.T) {
+	assert.NoError(t, (&Subscription{URL: "https://partner.example.org/hooks", Events: []EventType{EventCreated}}).Validate())
+	for name, sub := range map[string]Subscription{
+		"url":    {URL: "partner.example.org"},
+		"scheme": {URL: "ftp://partner.example.org"},
+		"event":  {URL: "https://partner.example.org", Events: []EventType{"transaction.deleted"}},
+		"radius": {URL: "https://partner.example.org", Geofence: &Geofence{Lat: 16, Long: 108}},
+		"center": {URL: "https://partner.example.org", Geofence: &Geofence{Lat: 160, Long: 108, RadiusKm: 5}},
+	} {
+		assert.Error(t, sub.Validate(), name)
+	}
+}
@@ -0,0 +1,332 @@
+package partner
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"cloud.google.com/go/firestore"
+	"google.golang.org/api/iterator"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// DeliveryStatus is where a delivery stands
+type DeliveryStatus string
+
+// Statuses of the deliveries. The dead ones failed too many times, they are
+// the dead-letter list
+const (
+	StatusPending   DeliveryStatus = "pending"
+	StatusDelivered DeliveryStatus = "delivered"
+	StatusDead      DeliveryStatus = "dead"
+)
+
+// Delivery is an event sent to a subscription, with the log of its attempts
+type Delivery struct {
+	ID             string         `json:"id" firestore:"-"`
+	SubscriptionID string         `json:"subscriptionId" firestore:"subscriptionId"`
+	Event          Event          `json:"event" firestore:"event"`
+	Status         DeliveryStatus `json:"status" firestore:"status"`
+	// Failures counts the failed attempts since the delivery was queued
+	Failures    int       `json:"failures" firestore:"failures"`
+	NextAttempt time.Time `json:"nextAttempt" firestore:"nextAttempt"`
+	CreatedAt   time.Time `json:"createdAt" firestore:"createdAt"`
+	Attempts    []Attempt `json:"attempts" firestore:"attempts"`
+}
+
+// Attempt is a post of a delivery to the partner
+type Attempt struct {
+	Time time.Time `json:"time" firestore:"time"`
+	// StatusCode is zero when the partner couldn't be reached
+	StatusCode int    `json:"statusCode,omitempty" firestore:"statusCode,omitempty"`
+	Error      string `json:"error,omitempty" firestore:"error,omitempty"`
+	DurationMs int64  `json:"durationMs" firestore:"durationMs"`
+}
+
+// Store keeps the subscriptions and the deliveries
+type Store interface {
+	// SaveSubscription creates or replaces the subscription, a new one gets
+	// an ID
+	SaveSubscription(ctx context.Context, sub *Subscription) error
+	Subscriptions(ctx context.Context) ([]Subscription, error)
+	// Subscription returns the subscription with the ID, or nil
+	Subscription(ctx context.Context, id string) (*Subscription, error)
+	DeleteSubscription(ctx context.Context, id string) error
+	// AddDelivery saves a new delivery. It returns false when there already
+	// is one with its ID, the event was already sent to the subscription
+	AddDelivery(ctx context.Context, d Delivery) (bool, error)
+	SaveDelivery(ctx context.Context, d Delivery) error
+	// Delivery returns the delivery with the ID, or nil
+	Delivery(ctx context.Context, id string) (*Delivery, error)
+	// Deliveries returns the deliveries with the status, the oldest first
+	Deliveries(ctx context.Context, status DeliveryStatus) ([]Delivery, error)
+	// SubscriptionDeliveries returns the deliveries of the subscription, the
+	// newest first
+	SubscriptionDeliveries(ctx context.Context, subscriptionID string) ([]Delivery, error)
+}
+
+func sortDeliveries(deliveries []Delivery, newestFirst bool) {
+	sort.SliceStable(deliveries, func(i, j int) bool {
+		if newestFirst {
+			return deliveries[i].CreatedAt.After(deliveries[j].CreatedAt)
+		}
+		return deliveries[i].CreatedAt.Before(deliveries[j].CreatedAt)
+	})
+}
+
+// MemoryStore keeps the subscriptions and the deliveries in memory, for the
+// tests and the local runs
+type MemoryStore struct {
+	mu            sync.Mutex
+	subscriptions map[string]Subscription
+	deliveries    map[string]Delivery
+	nextID        int
+}
+
+// NewMemoryStore returns an empty store
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{subscriptions: make(map[string]Subscription), deliveries: make(map[string]Delivery)}
+}
+
+// SaveSubscription creates or replaces the subscription
+func (s *MemoryStore) SaveSubscription(ctx context.Context, sub *Subscription) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if sub.ID == "" {
+		s.nextID++
+		sub.ID = fmt.Sprintf("memory-%d", s.nextID)
+	}
+	s.subscriptions[sub.ID] = *sub
+	return nil
+}
+
+// Subscriptions returns the subscriptions, the oldest first
+func (s *MemoryStore) Subscriptions(ctx context.Context) ([]Subscription, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var subs []Subscription
+	for _, sub := range s.subscriptions {
+		subs = append(subs, sub)
+	}
+	sort.Slice(subs, func(i, j int) bool { return subs[i].ID < subs[j].ID })
+	return subs, nil
+}
+
+// Subscription returns the subscription with the ID, or nil
+func (s *MemoryStore) Subscription(ctx context.Context, id string) (*Subscription, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	sub, ok := s.subscriptions[id]
+	if !ok {
+		return nil, nil
+	}
+	return &sub, nil
+}
+
+// DeleteSubscription deletes the subscription, its deliveries are kept
+func (s *MemoryStore) DeleteSubscription(ctx context.Context, id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.subscriptions, id)
+	return nil
+}
+
+// AddDelivery saves a new delivery, unless there is one with its ID
+func (s *MemoryStore) AddDelivery(ctx context.Context, d Delivery) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.deliveries[d.ID]; ok {
+		return false, nil
+	}
+	s.deliveries[d.ID] = d
+	return true, nil
+}
+
+// SaveDelivery replaces the delivery
+func (s *MemoryStore) SaveDelivery(ctx context.Context, d Delivery) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.deliveries[d.ID] = d
+	return nil
+}
+
+// Delivery returns the delivery with the ID, or nil
+func (s *MemoryStore) Delivery(ctx context.Context, id string) (*Delivery, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	d, ok := s.deliveries[id]
+	if !ok {
+		return nil, nil
+	}
+	return &d, nil
+}
+
+// Deliveries returns the deliveries with the status, the oldest first
+func (s *MemoryStore) Deliveries(ctx context.Context, status DeliveryStatus) ([]Delivery, error) {
+	return s.filter(func(d Delivery) bool { return d.Status == status }, false), nil
+}
+
+// SubscriptionDeliveries returns the deliveries of the subscription, the
+// newest first
+func (s *MemoryStore) SubscriptionDeliveries(ctx context.Context, subscriptionID string) ([]Delivery, error) {
+	return s.filter(func(d Delivery) bool { return d.SubscriptionID == subscriptionID }, true), nil
+}
+
+func (s *MemoryStore) filter(keep func(Delivery) bool, newestFirst bool) []Delivery {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var deliveries []Delivery
+	for _, d := range s.deliveries {
+		if keep(d) {
+			deliveries = append(deliveries, d)
+		}
+	}
+	sort.Slice(deliveries, func(i, j int) bool { return deliveries[i].ID < deliveries[j].ID })
+	sortDeliveries(deliveries, newestFirst)
+	return deliveries
+}
+
+// FirestoreStore keeps the subscriptions and the deliveries in the
+// partnerSubscriptions and partnerDeliveries collections
+type FirestoreStore struct {
+	client *firestore.Client
+}
+
+// NewFirestoreStore returns the store of the database of the client
+func NewFirestoreStore(client *firestore.Client) *FirestoreStore {
+	return &FirestoreStore{client: client}
+}
+
+func (s *FirestoreStore) subscriptions() *firestore.CollectionRef {
+	return s.client.Collection("partnerSubscriptions")
+}
+
+func (s *FirestoreStore) deliveries() *firestore.CollectionRef {
+	return s.client.Collection("partnerDeliveries")
+}
+
+// SaveSubscription creates or replaces the subscription
+func (s *FirestoreStore) SaveSubscription(ctx context.Context, sub *Subscription) error {
+	doc := s.subscriptions().NewDoc()
+	if sub.ID != "" {
+		doc = s.subscriptions().Doc(sub.ID)
+	}
+	if _, err := doc.Set(ctx, sub); err != nil {
+		return err
+	}
+	sub.ID = doc.ID
+	return nil
+}
+
+// Subscriptions returns the subscriptions
+func (s *FirestoreStore) Subscriptions(ctx context.Context) ([]Subscription, error) {
+	docs, err := s.subscriptions().OrderBy("createdAt", firestore.Asc).Documents(ctx).GetAll()
+	if err != nil {
+		return nil, err
+	}
+	var subs []Subscription
+	for _, doc := range docs {
+		var sub Subscription
+		if err := doc.DataTo(&sub); err != nil {
+			return nil, err
+		}
+		sub.ID = doc.Ref.ID
+		subs = append(subs, sub)
+	}
+	return subs, nil
+}
+
+// Subscription returns the subscription with the ID, or nil
+func (s *FirestoreStore) Subscription(ctx context.Context, id string) (*Subscription, error) {
+	doc, err := s.subscriptions().Doc(id).Get(ctx)
+	if status.Code(err) == codes.NotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	sub := &Subscription{}
+	if err := doc.DataTo(sub); err != nil {
+		return nil, err
+	}
+	sub.ID = doc.Ref.ID
+	return sub, nil
+}
+
+// DeleteSubscription deletes the subscription, its deliveries are kept
+func (s *FirestoreStore) DeleteSubscription(ctx context.Context, id string) error {
+	_, err := s.subscriptions().Doc(id).Delete(ctx)
+	return err
+}
+
+// AddDelivery creates the document of the delivery, unless it exists
+func (s *FirestoreStore) AddDelivery(ctx context.Context, d Delivery) (bool, error) {
+	_, err := s.deliveries().Doc(d.ID).Create(ctx, d)
+	if status.Code(err) == codes.AlreadyExists {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+// SaveDelivery replaces the delivery
+func (s *FirestoreStore) SaveDelivery(ctx context.Context, d Delivery) error {
+	_, err := s.deliveries().Doc(d.ID).Set(ctx, d)
+	return err
+}
+
+// Delivery returns the delivery with the ID, or nil
+func (s *FirestoreStore) Delivery(ctx context.Context, id string) (*Delivery, error) {
+	doc, err := s.deliveries().Doc(id).Get(ctx)
+	if status.Code(err) == codes.NotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	d := &Delivery{}
+	if err := doc.DataTo(d); err != nil {
+		return nil, err
+	}
+	d.ID = doc.Ref.ID
+	return d, nil
+}
+
+// Deliveries returns the deliveries with the status, the oldest first. They
+// are sorted here, ordering the query would need a composite index
+func (s *FirestoreStore) Deliveries(ctx context.Context, status DeliveryStatus) ([]Delivery, error) {
+	deliveries, err := s.query(ctx, s.deliveries().Where("status", "==", status))
+	sortDeliveries(deliveries, false)
+	return deliveries, err
+}
+
+// SubscriptionDeliveries returns the deliveries of the subscription, the
+// newest first
+func (s *FirestoreStore) SubscriptionDeliveries(ctx context.Context, subscriptionID string) ([]Delivery, error) {
+	deliveries, err := s.query(ctx, s.deliveries().Where("subscriptionId", "==", subscriptionID))
+	sortDeliveries(deliveries, true)
+	return deliveries, err
+}
+
+func (s *FirestoreStore) query(ctx context.Context, q firestore.Query) ([]Delivery, error) {
+	docs := q.Documents(ctx)
+	defer docs.Stop()
+	var deliveries []Delivery
+	for {
+		doc, err := docs.Next()
+		if err == iterator.Done {
+			return deliveries, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		var d Delivery
+		if err := doc.DataTo(&d); err != nil {
+			return nil, err
+		}
+		d.ID = doc.Ref.ID
+		deliveries = append(deliveries, d)
+	}
+}
@@ -0,0 +1,49 @@
+package route
+
+import (
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// MaxWaypoints is how many stops a Google Maps link can have between its
+// origin and its destination
+const MaxWaypoints = 9
+
+// GoogleMapsLinks returns the links of the route in Google Maps with the
+// travel mode, like driving or walking. The longer routes are split into
+// links of MaxWaypoints+1 visits, each leaving from the end of the previous
+// one
+func (r *Route) GoogleMapsLinks(travelMode string) []string {
+	var links []string
+	origin := r.Start
+	for rest := r.Visits; len(rest) > 0; {
+		n := len(rest)
+		if n > MaxWaypoints+1 {
+			n = MaxWaypoints + 1
+		}
+		leg := rest[:n]
+		rest = rest[n:]
+		q := url.Values{}
+		q.Set("api", "1")
+		q.Set("origin", coordinates(origin))
+		q.Set("destination", coordinates(leg[n-1].Point))
+		if n > 1 {
+			waypoints := make([]string, n-1)
+			for i, v := range leg[:n-1] {
+				waypoints[i] = coordinates(v.Point)
+			}
+			q.Set("waypoints", strings.Join(waypoints, "|"))
+		}
+		if travelMode != "" {
+			q.Set("travelmode", travelMode)
+		}
+		links = append(links, "https://www.google.com/maps/dir/?"+q.Encode())
+		origin = leg[n-1].Point
+	}
+	return links
+}
+
+func coordinates(p Point) string {
+	return strconv.FormatFloat(p.Lat, 'f', -1, 64) + "," + strconv.FormatFloat(p.Lng, 'f', -1, 64)
+}
@@ -0,0 +1,72 @@
+package route
+
+import (
+	"context"
+	"time"
+
+	"wcws/geo"
+)
+
+// Point is a location of a route
+type Point struct {
+	Lat float64 `json:"lat"`
+	Lng float64 `json:"lng"`
+}
+
+// Leg is the way from a point to another
+type Leg struct {
+	DistanceKm float64
+	Duration   time.Duration
+}
+
+// Matrix gives the legs between the points, legs[i][j] going from points[i]
+// to points[j]. It can be the straight lines of Haversine or the answers of
+// a routing service
+type Matrix interface {
+	Legs(ctx context.Context, points []Point) ([][]Leg, error)
+}
+
+// MatrixFunc is a function giving the legs between the points
+type MatrixFunc func(ctx context.Context, points []Point) ([][]Leg, error)
+
+// Legs calls the function
+func (f MatrixFunc) Legs(ctx context.Context, points []Point) ([][]Leg, error) {
+	return f(ctx, points)
+}
+
+// Defaults of Haversine, the motorbikes of the volunteers in the city
+const (
+	DefaultSpeedKmh = 20
+	DefaultDetour   = 1.3
+)
+
+// Haversine is the matrix of the great-circle distances between the points,
+// lengthened by the detour of the streets and travelled at the speed
+type Haversine struct {
+	// SpeedKmh is DefaultSpeedKmh when it is zero
+	SpeedKmh float64
+	// Detour is how much longer the streets are than the straight lines,
+	// DefaultDetour when it is zero
+	Detour float64
+}
+
+// Legs returns the legs between the points
+func (h Haversine) Legs(ctx context.Context, points []Point) ([][]Leg, error) {
+	speed := h.SpeedKmh
+	if speed == 0 {
+		speed = DefaultSpeedKmh
+	}
+	detour := h.Detour
+	if detour == 0 {
+		detour = DefaultDetour
+	}
+	legs := make([][]Leg, len(points))
+	for i, from := range points {
+		legs[i] = make([]Leg, len(points))
+		for j, to := range points {
+			km := geo.DistanceKm(from.Lat, from.Lng, to.Lat, to.Lng) * detour
+			legs[i][j] = Leg{DistanceKm: km, Duration: time.Duration(km / speed * float64(time.Hour))}
+		}
+	}
+	return legs, nil
+}
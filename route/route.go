@@ -0,0 +1,248 @@
+// Package route orders the pickups of a volunteer into a route keeping to
+// their windows. The route is built by inserting the stops where they cost
+// the least, then improved by moving them and reversing parts of it, which
+// is close enough to the best route for the few stops of a day
+package route
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// Stop is a place to visit
+type Stop struct {
+	ID    string
+	Point Point
+	// Start and End are the window of the visit, a visit arriving before
+	// Start waits for it. The stop can be visited any time when they are
+	// zero
+	Start, End time.Time
+	// Service is how long the visit lasts
+	Service time.Duration
+}
+
+// Visit is a stop of a route
+type Visit struct {
+	Stop
+	// Arrival is when the stop is reached, the visit starts after Wait
+	Arrival time.Time
+	Wait    time.Duration
+	// Late is how long after the end of the window the visit starts
+	Late time.Duration
+	// DistanceKm is the length of the leg from the previous stop
+	DistanceKm float64
+}
+
+// Route is the visits of the stops in order
+type Route struct {
+	Start     Point
+	Departure time.Time
+	Visits    []Visit
+	// Finish is when the last visit ends
+	Finish     time.Time
+	DistanceKm float64
+	// Late is the sum of the lateness of the visits
+	Late time.Duration
+}
+
+// MaxStops is the most stops of a route, planning more would hold the
+// request for too long
+const MaxStops = 100
+
+// ErrTooManyStops is returned for the routes of more than MaxStops stops
+var ErrTooManyStops = fmt.Errorf("a route has at most %d stops", MaxStops)
+
+// maxPasses bounds the improvements, a pass tries every move once. maxWork
+// bounds them by size: a pass of n stops costs about n³ steps
+const (
+	maxPasses = 50
+	maxWork   = 10000000
+)
+
+// Plan orders the stops into a route leaving the start at the departure,
+// with the legs of the matrix. Of the routes found, it keeps the one late
+// the least, then the one finishing first, then the shortest
+func Plan(ctx context.Context, m Matrix, start Point, departure time.Time, stops []Stop) (*Route, error) {
+	if len(stops) > MaxStops {
+		return nil, ErrTooManyStops
+	}
+	points := make([]Point, 0, len(stops)+1)
+	points = append(points, start)
+	for _, s := range stops {
+		points = append(points, s.Point)
+	}
+	legs, err := m.Legs(ctx, points)
+	if err != nil {
+		return nil, err
+	}
+	if len(legs) != len(points) {
+		return nil, fmt.Errorf("the matrix has %d rows for %d points", len(legs), len(points))
+	}
+	for _, row := range legs {
+		if len(row) != len(points) {
+			return nil, fmt.Errorf("the matrix has a row of %d legs for %d points", len(row), len(points))
+		}
+	}
+
+	p := &planner{legs: legs, stops: stops, departure: departure}
+	order := p.improve(ctx, p.insert())
+	r := &Route{Start: start, Departure: departure}
+	c := p.schedule(order, func(v Visit) {
+		r.Visits = append(r.Visits, v)
+	})
+	r.Finish, r.DistanceKm, r.Late = c.finish, c.km, c.late
+	return r, nil
+}
+
+type planner struct {
+	// legs[0] are the legs from the start, legs[i+1] the ones from the stop i
+	legs      [][]Leg
+	stops     []Stop
+	departure time.Time
+}
+
+// cost is what the routes are compared on
+type cost struct {
+	late   time.Duration
+	finish time.Time
+	km     float64
+}
+
+func (c cost) less(o cost) bool {
+	if c.late != o.late {
+		return c.late < o.late
+	}
+	if !c.finish.Equal(o.finish) {
+		return c.finish.Before(o.finish)
+	}
+	// the sums of the legs in another order differ by rounding
+	return c.km < o.km-1e-9
+}
+
+// schedule visits the stops in the order, giving each visit to fn when it
+// isn't nil, and returns the cost of the route
+func (p *planner) schedule(order []int, fn func(Visit)) cost {
+	var c cost
+	at, from := p.departure, 0
+	for _, i := range order {
+		s := p.stops[i]
+		leg := p.legs[from][i+1]
+		v := Visit{Stop: s, Arrival: at.Add(leg.Duration), DistanceKm: leg.DistanceKm}
+		begin := v.Arrival
+		if !s.Start.IsZero() && begin.Before(s.Start) {
+			v.Wait = s.Start.Sub(begin)
+			begin = s.Start
+		}
+		if !s.End.IsZero() && begin.After(s.End) {
+			v.Late = begin.Sub(s.End)
+		}
+		at = begin.Add(s.Service)
+		c.late += v.Late
+		c.km += leg.DistanceKm
+		if fn != nil {
+			fn(v)
+		}
+		from = i + 1
+	}
+	c.finish = at
+	return c
+}
+
+func (p *planner) cost(order []int) cost {
+	return p.schedule(order, nil)
+}
+
+// insert builds a route by inserting the stops closing first where they cost
+// the least, the stops without a window last
+func (p *planner) insert() []int {
+	pending := make([]int, len(p.stops))
+	for i := range pending {
+		pending[i] = i
+	}
+	sort.SliceStable(pending, func(a, b int) bool {
+		sa, sb := p.stops[pending[a]], p.stops[pending[b]]
+		if sa.End.IsZero() != sb.End.IsZero() {
+			return sb.End.IsZero()
+		}
+		return sa.End.Before(sb.End)
+	})
+	order := make([]int, 0, len(pending))
+	for _, i := range pending {
+		var best []int
+		var bestCost cost
+		for pos := 0; pos <= len(order); pos++ {
+			candidate := inserted(order, pos, i)
+			if c := p.cost(candidate); best == nil || c.less(bestCost) {
+				best, bestCost = candidate, c
+			}
+		}
+		order = best
+	}
+	return order
+}
+
+// passes returns how many improvement passes a route of n stops gets
+func passes(n int) int {
+	if n == 0 || maxWork/(n*n*n) >= maxPasses {
+		return maxPasses
+	}
+	return maxWork / (n * n * n)
+}
+
+// improve moves single stops elsewhere and reverses parts of the route while
+// it makes the route better, as long as the context isn't done
+func (p *planner) improve(ctx context.Context, order []int) []int {
+	current := p.cost(order)
+	for pass := 0; pass < passes(len(order)) && ctx.Err() == nil; pass++ {
+		improved := false
+		for i := range order {
+			for j := range order {
+				if i == j {
+					continue
+				}
+				candidate := inserted(removed(order, i), j, order[i])
+				if c := p.cost(candidate); c.less(current) {
+					order, current, improved = candidate, c, true
+				}
+			}
+		}
+		for i := 0; i < len(order)-1; i++ {
+			for j := i + 1; j < len(order); j++ {
+				candidate := reversed(order, i, j)
+				if c := p.cost(candidate); c.less(current) {
+					order, current, improved = candidate, c, true
+				}
+			}
+		}
+		if !improved {
+			break
+		}
+	}
+	return order
+}
+
+// inserted returns a copy of the order with the stop at the position
+func inserted(order []int, pos, stop int) []int {
+	out := make([]int, 0, len(order)+1)
+	out = append(out, order[:pos]...)
+	out = append(out, stop)
+	return append(out, order[pos:]...)
+}
+
+// removed returns a copy of the order without the stop at the position
+func removed(order []int, pos int) []int {
+	out := make([]int, 0, len(order)-1)
+	out = append(out, order[:pos]...)
+	return append(out, order[pos+1:]...)
+}
+
+// reversed returns a copy of the order with the stops from i to j reversed
+func reversed(order []int, i, j int) []int {
+	out := append([]int(nil), order...)
+	for ; i < j; i, j = i+1, j-1 {
+		out[i], out[j] = out[j], out[i]
+	}
+	return out
+}
@@ -0,0 +1,167 @@
+package route
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	ict       = time.FixedZone("ICT", 7*60*60)
+	departure = time.Date(2019, 12, 1, 8, 0, 0, 0, ict)
+)
+
+// lineMatrix puts the points on a line, a kilometer and 6 minutes apart for
+// each unit of their latitude
+var lineMatrix = MatrixFunc(func(ctx context.Context, points []Point) ([][]Leg, error) {
+	legs := make([][]Leg, len(points))
+	for i, from := range points {
+		legs[i] = make([]Leg, len(points))
+		for j, to := range points {
+			d := from.Lat - to.Lat
+			if d < 0 {
+				d = -d
+			}
+			legs[i][j] = Leg{DistanceKm: d, Duration: time.Duration(d * float64(6*time.Minute))}
+		}
+	}
+	return legs, nil
+})
+
+func stopIDs(r *Route) []string {
+	var ids []string
+	for _, v := range r.Visits {
+		ids = append(ids, v.ID)
+	}
+	return ids
+}
+
+func TestPlanShortest(t *testing.T) {
+	stops := []Stop{
+		{ID: "c", Point: Point{Lat: 3}},
+		{ID: "e", Point: Point{Lat: 5}},
+		{ID: "a", Point: Point{Lat: 1}},
+		{ID: "d", Point: Point{Lat: 4}},
+		{ID: "b", Point: Point{Lat: 2}},
+	}
+	r, err := Plan(context.Background(), lineMatrix, Point{}, departure, stops)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a", "b", "c", "d", "e"}, stopIDs(r))
+	assert.InDelta(t, 5, r.DistanceKm, 1e-9)
+	assert.Equal(t, departure.Add(30*time.Minute), r.Finish)
+	assert.Zero(t, r.Late)
+	assert.Equal(t, departure.Add(6*time.Minute), r.Visits[0].Arrival)
+}
+
+func TestPlanWindows(t *testing.T) {
+	at := func(h, m int) time.Time { return time.Date(2019, 12, 1, h, m, 0, 0, ict) }
+	stops := []Stop{
+		{ID: "near-late", Point: Point{Lat: 1}, Start: at(10, 0), End: at(11, 0), Service: 10 * time.Minute},
+		{ID: "far-early", Point: Point{Lat: 5}, Start: at(8, 0), End: at(9, 0), Service: 10 * time.Minute},
+	}
+	r, err := Plan(context.Background(), lineMatrix, Point{}, departure, stops)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"far-early", "near-late"}, stopIDs(r), "the nearest stop waits for its window")
+	assert.Zero(t, r.Late)
+	assert.Equal(t, at(8, 30), r.Visits[0].Arrival)
+	last := r.Visits[1]
+	assert.Equal(t, at(9, 4), last.Arrival)
+	assert.Equal(t, 56*time.Minute, last.Wait)
+	assert.Equal(t, at(10, 10), r.Finish)
+	assert.InDelta(t, 9, r.DistanceKm, 1e-9)
+
+	// both windows can't be kept, the route is late the least
+	stops = []Stop{
+		{ID: "a", Point: Point{Lat: 10}, End: at(9, 0)},
+		{ID: "b", Point: Point{Lat: -10}, End: at(9, 0)},
+	}
+	r, err = Plan(context.Background(), lineMatrix, Point{}, departure, stops)
+	require.NoError(t, err)
+	assert.Equal(t, 2*time.Hour, r.Late)
+	assert.Equal(t, 2*time.Hour, r.Visits[1].Late)
+}
+
+func TestPlanEmpty(t *testing.T) {
+	r, err := Plan(context.Background(), Haversine{}, Point{Lat: 16.06, Lng: 108.22}, departure, nil)
+	require.NoError(t, err)
+	assert.Empty(t, r.Visits)
+	assert.Equal(t, departure, r.Finish)
+	assert.Empty(t, r.GoogleMapsLinks("driving"))
+}
+
+func TestPlanMatrixErrors(t *testing.T) {
+	stops := []Stop{{ID: "a"}}
+	_, err := Plan(context.Background(), MatrixFunc(func(ctx context.Context, points []Point) ([][]Leg, error) {
+		return nil, errors.New("quota exceeded")
+	}), Point{}, departure, stops)
+	assert.EqualError(t, err, "quota exceeded")
+	_, err = Plan(context.Background(), MatrixFunc(func(ctx context.Context, points []Point) ([][]Leg, error) {
+		return [][]Leg{{{}, {}}, {{}}}, nil
+	}), Point{}, departure, stops)
+	assert.Error(t, err)
+}
+
+func TestPlanLimits(t *testing.T) {
+	stops := make([]Stop, MaxStops+1)
+	for i := range stops {
+		stops[i] = Stop{ID: strconv.Itoa(i), Point: Point{Lat: float64((i * 37) % 101)}}
+	}
+	_, err := Plan(context.Background(), lineMatrix, Point{}, departure, stops)
+	assert.Equal(t, ErrTooManyStops, err)
+
+	r, err := Plan(context.Background(), lineMatrix, Point{}, departure, stops[:MaxStops])
+	require.NoError(t, err)
+	assert.Len(t, r.Visits, MaxStops)
+	assert.Equal(t, maxPasses, passes(10))
+	assert.True(t, passes(MaxStops) < maxPasses, "the largest routes get fewer passes")
+}
+
+func TestHaversine(t *testing.T) {
+	points := []Point{{Lat: 16.0544, Lng: 108.2022}, {Lat: 16.0717, Lng: 108.2244}}
+	legs, err := Haversine{}.Legs(context.Background(), points)
+	require.NoError(t, err)
+	require.Len(t, legs, 2)
+	assert.Zero(t, legs[0][0].DistanceKm)
+	assert.InDelta(t, 3.97, legs[0][1].DistanceKm, 0.01, "3 km in a straight line")
+	assert.Equal(t, legs[0][1], legs[1][0])
+	assert.InDelta(t, 11.9, legs[0][1].Duration.Minutes(), 0.1)
+
+	legs, err = Haversine{SpeedKmh: 40, Detour: 1}.Legs(context.Background(), points)
+	require.NoError(t, err)
+	assert.InDelta(t, 3.05, legs[0][1].DistanceKm, 0.01)
+	assert.InDelta(t, 4.6, legs[0][1].Duration.Minutes(), 0.1)
+}
+
+func TestGoogleMapsLinks(t *testing.T) {
+	r := &Route{Start: Point{Lat: 16.0544, Lng: 108.2022}}
+	for i := 1; i <= 12; i++ {
+		r.Visits = append(r.Visits, Visit{Stop: Stop{Point: Point{Lat: 16 + float64(i)/100, Lng: 108.2}}})
+	}
+	links := r.GoogleMapsLinks("driving")
+	require.Len(t, links, 2)
+
+	u, err := url.Parse(links[0])
+	require.NoError(t, err)
+	assert.Equal(t, "www.google.com", u.Host)
+	assert.Equal(t, "/maps/dir/", u.Path)
+	q := u.Query()
+	assert.Equal(t, "1", q.Get("api"))
+	assert.Equal(t, "16.0544,108.2022", q.Get("origin"))
+	assert.Equal(t, "16.1,108.2", q.Get("destination"))
+	assert.Len(t, strings.Split(q.Get("waypoints"), "|"), MaxWaypoints)
+	assert.Equal(t, "driving", q.Get("travelmode"))
+
+	u, err = url.Parse(links[1])
+	require.NoError(t, err)
+	q = u.Query()
+	assert.Equal(t, "16.1,108.2", q.Get("origin"), "the second link leaves from the end of the first")
+	assert.Equal(t, "16.11", q.Get("waypoints")[:5])
+	assert.Equal(t, "16.12,108.2", q.Get("destination"))
+}
@@ -0,0 +1,73 @@
+// Package tracing exports the OpenTelemetry spans of the webhook
+package tracing
+
+import (
+	"io"
+	"os"
+
+	"go.opentelemetry.io/otel/api/global"
+	"go.opentelemetry.io/otel/api/kv"
+	"go.opentelemetry.io/otel/exporters/otlp"
+	"go.opentelemetry.io/otel/exporters/trace/stdout"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+
+	"wcws/config"
+)
+
+// Setup installs the global trace provider exporting the spans as
+// configured. The returned function flushes the spans, it is called on
+// shutdown. Nothing is exported without an exporter
+func Setup(cfg config.Tracing) (func(), error) {
+	if cfg.Exporter == "" {
+		return func() {}, nil
+	}
+	provider, err := sdktrace.NewProvider(
+		sdktrace.WithConfig(sdktrace.Config{DefaultSampler: sdktrace.ProbabilitySampler(cfg.SampleRatio)}),
+		sdktrace.WithResourceAttributes(kv.String("service.name", cfg.ServiceName)),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var processor sdktrace.SpanProcessor
+	var stop func() error
+	switch cfg.Exporter {
+	case "otlp":
+		opts := []otlp.ExporterOption{otlp.WithAddress(cfg.Endpoint)}
+		if cfg.Insecure {
+			opts = append(opts, otlp.WithInsecure())
+		}
+		exporter, err := otlp.NewExporter(opts...)
+		if err != nil {
+			return nil, err
+		}
+		if processor, err = sdktrace.NewBatchSpanProcessor(exporter); err != nil {
+			return nil, err
+		}
+		stop = exporter.Stop
+	default:
+		var w io.Writer = os.Stdout
+		if cfg.File != "" {
+			f, err := os.OpenFile(cfg.File, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0644)
+			if err != nil {
+				return nil, err
+			}
+			w, stop = f, f.Close
+		}
+		exporter, err := stdout.NewExporter(stdout.Options{Writer: w})
+		if err != nil {
+			return nil, err
+		}
+		processor = sdktrace.NewSimpleSpanProcessor(exporter)
+	}
+	provider.RegisterSpanProcessor(processor)
+	global.SetTraceProvider(provider)
+
+	return func() {
+		// unregistering the processor flushes the spans it holds
+		provider.UnregisterSpanProcessor(processor)
+		if stop != nil {
+			_ = stop()
+		}
+	}, nil
+}
@@ -0,0 +1,50 @@
+package tracing
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/api/global"
+
+	"wcws/config"
+)
+
+func TestFileExporter(t *testing.T) {
+	dir, err := ioutil.TempDir("", "wcws-tracing")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "spans.jsonl")
+
+	flush, err := Setup(config.Tracing{Exporter: "stdout", File: path, ServiceName: "wcws-test", SampleRatio: 1})
+	require.NoError(t, err)
+	ctx, parent := global.Tracer("test").Start(context.Background(), "webhook.welcome")
+	_, child := global.Tracer("test").Start(ctx, "store.ActiveEvents")
+	child.End()
+	parent.End()
+	flush()
+
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	defer f.Close()
+	var names []string
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var span struct{ Name string }
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &span))
+		names = append(names, span.Name)
+	}
+	assert.Equal(t, []string{"store.ActiveEvents", "webhook.welcome"}, names)
+}
+
+func TestDisabled(t *testing.T) {
+	flush, err := Setup(config.Tracing{})
+	require.NoError(t, err)
+	flush()
+}
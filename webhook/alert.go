@@ -0,0 +1,67 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"wcws/logging"
+)
+
+// Alerter tells the admins something needs their attention
+type Alerter interface {
+	Alert(ctx context.Context, text string) error
+}
+
+// LogAlerter writes the alerts to the log
+type LogAlerter struct{}
+
+// Alert writes the text to the log
+func (LogAlerter) Alert(ctx context.Context, text string) error {
+	logging.FromContext(ctx).Warn("alert", "text", text)
+	return nil
+}
+
+// WebhookAlerter posts the alerts to an incoming webhook accepting
+// {"text": "..."} like the Slack and Google Chat ones
+type WebhookAlerter struct {
+	URL        string
+	HTTPClient *http.Client
+	// Redactor masks the phone numbers, the coordinates and the credentials
+	// left in the texts, as they leave the webhook like the logs do
+	Redactor *logging.Redactor
+}
+
+// NewWebhookAlerter returns an alerter posting to the URL
+func NewWebhookAlerter(url string) *WebhookAlerter {
+	return &WebhookAlerter{URL: url, HTTPClient: &http.Client{Timeout: 5 * time.Second}, Redactor: logging.DefaultRedactor}
+}
+
+// Alert posts the text to the webhook
+func (a *WebhookAlerter) Alert(ctx context.Context, text string) error {
+	if a.Redactor != nil {
+		text = a.Redactor.String(text)
+	}
+	b, err := json.Marshal(map[string]string{"text": text})
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequest(http.MethodPost, a.URL, bytes.NewReader(b))
+	if err != nil {
+		return err
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("Content-Type", "application/json")
+	res, err := a.HTTPClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	if res.StatusCode/100 != 2 {
+		return fmt.Errorf("alert webhook answered %s", res.Status)
+	}
+	return nil
+}
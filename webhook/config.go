@@ -0,0 +1,74 @@
+package webhook
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/api/global"
+
+	"wcws/config"
+	"wcws/dialogflow"
+	"wcws/logging"
+	"wcws/notify"
+	"wcws/partner"
+	"wcws/route"
+)
+
+// NewFromConfig returns the server of the configuration, saving the donations
+// in its Firestore database. The notifications aren't started
+func NewFromConfig(ctx context.Context, cfg *config.Config) (*Server, error) {
+	store, err := NewFirestoreStore(ctx, cfg.Firestore)
+	if err != nil {
+		return nil, err
+	}
+	tracer := global.Tracer(tracerName)
+	metrics := NewMetrics()
+	instrumented := metrics.InstrumentStore(TraceStore(store, tracer))
+	// the scrapes aren't traced
+	metrics.WatchActiveEvents(store)
+	geocoder := TraceGeocoder(NewOpenCageFromConfig(cfg.OpenCage), "opencage", tracer)
+	s := New(instrumented, metrics.InstrumentGeocoder(geocoder, "opencage"))
+	s.Metrics = metrics
+	s.Limiter = NewRateLimiter(cfg.RateLimits)
+	s.Redactor = &logging.Redactor{CoordinatePrecision: cfg.Logging.CoordinatePrecision}
+	s.Alerter = LogAlerter{}
+	if cfg.Alerts.URL != "" {
+		alerter := NewWebhookAlerter(cfg.Alerts.URL)
+		alerter.Redactor = s.Redactor
+		s.Alerter = alerter
+	}
+	if cfg.GoogleActions.ClientID != "" {
+		s.IDTokens = dialogflow.NewIDTokenVerifier(cfg.GoogleActions.ClientID)
+	}
+	if cfg.SMS.AccountSID != "" {
+		gateway := notify.NewTwilioGateway(cfg.SMS.GatewayURL, cfg.SMS.AccountSID, cfg.SMS.AuthToken, cfg.SMS.From)
+		notifier, err := notify.NewSMSNotifier(gateway, nil)
+		if err != nil {
+			return nil, err
+		}
+		s.Notifier = notifier
+		s.ReminderBefore = cfg.SMS.ReminderBefore
+	}
+	if cfg.SMTP.Addr != "" {
+		s.Mailer = notify.NewSMTPMailer(cfg.SMTP.Addr, cfg.SMTP.From, cfg.SMTP.Username, cfg.SMTP.Password)
+	}
+	if cfg.Transcripts.Retention > 0 {
+		s.Transcripts = store.Transcripts()
+		s.TranscriptRetention = cfg.Transcripts.Retention
+	}
+	if cfg.Partners.Enabled {
+		s.Partners = partner.NewDispatcher(store.Partners())
+		s.Partners.MaxAttempts = cfg.Partners.MaxAttempts
+		s.Partners.RetryBase = cfg.Partners.RetryBase
+		s.Partners.RetryMax = cfg.Partners.RetryMax
+	}
+	if s.ExportLocation, err = cfg.Export.Location(); err != nil {
+		return nil, err
+	}
+	s.RouteMatrix = route.Haversine{SpeedKmh: cfg.Routes.SpeedKmh}
+	s.PickupWindow = cfg.Routes.PickupWindow
+	s.PickupDuration = cfg.Routes.PickupDuration
+	s.AdminToken = cfg.Admin.Token
+	s.MapToken = cfg.Map.Token
+	s.RouteSecret = cfg.Routes.Secret
+	return s, nil
+}
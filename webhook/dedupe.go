@@ -0,0 +1,133 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"wcws/dialogflow"
+)
+
+// DefaultDedupeTTL is how long the fulfillments are remembered. Dialogflow
+// gives up on a turn well before that
+const DefaultDedupeTTL = 10 * time.Minute
+
+// Deduper remembers the fulfillment sent for each response ID, so that a
+// request retried by Dialogflow after a timeout gets the same answer without
+// running the side effects of the action again
+type Deduper interface {
+	// Reserve claims the response ID for the caller, reserved is true when it
+	// must run the action and Put or Release the result. Otherwise it returns
+	// the fulfillment of the request holding the claim, waiting for it
+	Reserve(ctx context.Context, responseID string) (f *dialogflow.Fulfillment, reserved bool, err error)
+	// Put remembers the fulfillment sent for the reserved response ID
+	Put(ctx context.Context, responseID string, f *dialogflow.Fulfillment) error
+	// Release gives up the claim on the response ID, after the action failed
+	Release(ctx context.Context, responseID string) error
+}
+
+type dedupeEntry struct {
+	// body is nil while the request is running
+	body    []byte
+	done    chan struct{}
+	expires time.Time
+}
+
+// MemoryDeduper is a Deduper keeping the fulfillments in memory until their
+// TTL expires
+type MemoryDeduper struct {
+	ttl time.Duration
+	now func() time.Time
+
+	mu        sync.Mutex
+	entries   map[string]*dedupeEntry
+	nextSweep time.Time
+}
+
+// NewMemoryDeduper returns a deduper remembering the fulfillments for ttl
+func NewMemoryDeduper(ttl time.Duration) *MemoryDeduper {
+	return &MemoryDeduper{
+		ttl:     ttl,
+		now:     time.Now,
+		entries: make(map[string]*dedupeEntry),
+	}
+}
+
+// Reserve claims the response ID, or returns the fulfillment sent for it
+// once the request holding the claim is answered
+func (d *MemoryDeduper) Reserve(ctx context.Context, responseID string) (*dialogflow.Fulfillment, bool, error) {
+	for {
+		now := d.now()
+		d.mu.Lock()
+		d.sweep(now)
+		entry, ok := d.entries[responseID]
+		if !ok || (entry.body != nil && !now.Before(entry.expires)) {
+			d.entries[responseID] = &dedupeEntry{done: make(chan struct{}), expires: now.Add(d.ttl)}
+			d.mu.Unlock()
+			return nil, true, nil
+		}
+		body := entry.body
+		d.mu.Unlock()
+		if body != nil {
+			// fulfillments are kept marshalled, the caller may modify the one it gets
+			f := &dialogflow.Fulfillment{}
+			if err := json.Unmarshal(body, f); err != nil {
+				return nil, false, err
+			}
+			return f, false, nil
+		}
+		select {
+		case <-entry.done:
+		case <-ctx.Done():
+			return nil, false, ctx.Err()
+		}
+	}
+}
+
+// Put remembers the fulfillment sent for the response ID and hands it to the
+// requests waiting for it
+func (d *MemoryDeduper) Put(ctx context.Context, responseID string, f *dialogflow.Fulfillment) error {
+	b, err := json.Marshal(f)
+	if err != nil {
+		d.Release(ctx, responseID)
+		return err
+	}
+	now := d.now()
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	entry, ok := d.entries[responseID]
+	if !ok || entry.body != nil {
+		entry = &dedupeEntry{done: make(chan struct{})}
+		d.entries[responseID] = entry
+	}
+	entry.body, entry.expires = b, now.Add(d.ttl)
+	close(entry.done)
+	return nil
+}
+
+// Release forgets the response ID, one of the requests waiting for it runs
+// the action again
+func (d *MemoryDeduper) Release(ctx context.Context, responseID string) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if entry, ok := d.entries[responseID]; ok && entry.body == nil {
+		delete(d.entries, responseID)
+		close(entry.done)
+	}
+	return nil
+}
+
+// sweep drops the expired fulfillments, the claims are kept until they are
+// answered or released. It must be called with d.mu held
+func (d *MemoryDeduper) sweep(now time.Time) {
+	if !now.After(d.nextSweep) {
+		return
+	}
+	for k, e := range d.entries {
+		if e.body != nil && !now.Before(e.expires) {
+			delete(d.entries, k)
+		}
+	}
+	d.nextSweep = now.Add(d.ttl)
+}
@@ -0,0 +1,132 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"wcws/dialogflow"
+)
+
+func TestMemoryDeduperTTL(t *testing.T) {
+	now := time.Date(2019, 12, 1, 9, 0, 0, 0, time.UTC)
+	d := NewMemoryDeduper(time.Minute)
+	d.now = func() time.Time { return now }
+	ctx := context.Background()
+
+	_, reserved, err := d.Reserve(ctx, "r1")
+	require.NoError(t, err)
+	require.True(t, reserved)
+	f := &dialogflow.Fulfillment{FulfillmentText: "hello"}
+	require.NoError(t, d.Put(ctx, "r1", f))
+	f.FulfillmentText = "changed"
+
+	got, reserved, err := d.Reserve(ctx, "r1")
+	require.NoError(t, err)
+	require.False(t, reserved)
+	assert.Equal(t, "hello", got.FulfillmentText)
+
+	_, reserved, _ = d.Reserve(ctx, "r2")
+	assert.True(t, reserved)
+	require.NoError(t, d.Release(ctx, "r2"))
+
+	now = now.Add(time.Minute)
+	_, reserved, _ = d.Reserve(ctx, "r1")
+	assert.True(t, reserved, "the fulfillment expired")
+	require.NoError(t, d.Put(ctx, "r1", f))
+
+	now = now.Add(2 * time.Minute)
+	_, reserved, _ = d.Reserve(ctx, "r3")
+	assert.True(t, reserved)
+	assert.Len(t, d.entries, 1, "only the claim is left")
+}
+
+func TestMemoryDeduperWaits(t *testing.T) {
+	d := NewMemoryDeduper(time.Minute)
+	ctx := context.Background()
+	_, reserved, err := d.Reserve(ctx, "r1")
+	require.NoError(t, err)
+	require.True(t, reserved)
+
+	type result struct {
+		f        *dialogflow.Fulfillment
+		reserved bool
+	}
+	retries := make(chan result, 2)
+	for i := 0; i < 2; i++ {
+		go func() {
+			f, reserved, err := d.Reserve(ctx, "r1")
+			assert.NoError(t, err)
+			retries <- result{f, reserved}
+		}()
+	}
+	select {
+	case <-retries:
+		t.Fatal("a retry didn't wait for the first request")
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	require.NoError(t, d.Release(ctx, "r1"))
+	first := <-retries
+	require.True(t, first.reserved, "a retry runs the released request")
+	require.NoError(t, d.Put(ctx, "r1", &dialogflow.Fulfillment{FulfillmentText: "hello"}))
+	second := <-retries
+	require.False(t, second.reserved)
+	assert.Equal(t, "hello", second.f.FulfillmentText)
+
+	_, reserved, _ = d.Reserve(ctx, "r2")
+	require.True(t, reserved)
+	canceled, cancel := context.WithCancel(ctx)
+	cancel()
+	_, _, err = d.Reserve(canceled, "r2")
+	assert.Equal(t, context.Canceled, err)
+}
+
+func TestRetriedRequestSavesOneTransaction(t *testing.T) {
+	body, err := ioutil.ReadFile("testdata/golden/google_permission.request.json")
+	require.NoError(t, err)
+	srv := goldenServer()
+	notifier := &recordNotifier{}
+	srv.Notifier = notifier
+	e := echo.New()
+	srv.Register(e)
+
+	// the retry comes while the first request is still running
+	answers := make([]string, 3)
+	var wg sync.WaitGroup
+	for i := range answers {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			req := httptest.NewRequest(http.MethodPost, "/webhook", bytes.NewReader(body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, req)
+			assert.Equal(t, http.StatusOK, rec.Code)
+			answers[i] = rec.Body.String()
+		}(i)
+	}
+	wg.Wait()
+	assert.Equal(t, answers[0], answers[1])
+	assert.Equal(t, answers[0], answers[2])
+	assert.Len(t, srv.Store.(*MemoryStore).AddedTransactions(), 1)
+
+	// without the deduper the document ID still prevents a second donation,
+	// and a second confirmation
+	srv.Dedupe = nil
+	req := httptest.NewRequest(http.MethodPost, "/webhook", bytes.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	e.ServeHTTP(httptest.NewRecorder(), req)
+	assert.Len(t, srv.Store.(*MemoryStore).AddedTransactions(), 1)
+	srv.Wait()
+	assert.Len(t, notifier.events(), 1)
+}
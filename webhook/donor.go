@@ -0,0 +1,263 @@
+package webhook
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"wcws/dialogflow"
+	"wcws/logging"
+)
+
+// maxHistory is the number of donations listed to a donor
+const maxHistory = 10
+
+// DonorKeys are the identities a donor is recognized by, empty keys are
+// ignored. The phone numbers the donors give are never proven to be theirs, so
+// a phone number only matches a donor with the same platform identity: the
+// donations made without one are linked by their phone number, but nobody is
+// recognized by claiming a number
+type DonorKeys struct {
+	PSID         string
+	GoogleUserID string
+	ZaloUserID   string
+	// Phone is the normalized phone number the donor gave
+	Phone string
+}
+
+type donorKey struct {
+	field string
+	value string
+}
+
+// fields returns the keys with a value, by the name of their field in the
+// store
+func (k DonorKeys) fields() []donorKey {
+	var keys []donorKey
+	if k.PSID != "" {
+		keys = append(keys, donorKey{"psid", k.PSID})
+	}
+	if k.GoogleUserID != "" {
+		keys = append(keys, donorKey{"googleUserId", k.GoogleUserID})
+	}
+	if k.ZaloUserID != "" {
+		keys = append(keys, donorKey{"zaloUserId", k.ZaloUserID})
+	}
+	if k.Phone != "" {
+		keys = append(keys, donorKey{"phone", k.Phone})
+	}
+	return keys
+}
+
+// agrees reports whether the donor has the platform identities of the keys,
+// a donor found by phone number is only the same donor then
+func (k DonorKeys) agrees(d Donor) bool {
+	return d.PSID == k.PSID && d.GoogleUserID == k.GoogleUserID && d.ZaloUserID == k.ZaloUserID
+}
+
+// newDonorID returns the ID of a new donor with the keys
+func (k DonorKeys) newDonorID() string {
+	keys := k.fields()
+	if len(keys) == 0 {
+		return ""
+	}
+	prefix := strings.TrimSuffix(keys[0].field, "UserId")
+	return prefix + "-" + TransactionID(keys[0].value)
+}
+
+func (d Donor) key(field string) string {
+	switch field {
+	case "psid":
+		return d.PSID
+	case "googleUserId":
+		return d.GoogleUserID
+	case "zaloUserId":
+		return d.ZaloUserID
+	case "phone":
+		return d.Phone
+	}
+	return ""
+}
+
+// donorKeys returns the identity of the user of the request: their Messenger
+// PSID, or their Google account when they linked it and its ID token is valid.
+// The keys have no phone number, the donors are only recognized in the
+// conversation by their platform identity
+func (s *Server) donorKeys(ctx context.Context, dr dialogflow.Request) (DonorKeys, *dialogflow.GoogleAuthResponse) {
+	var keys DonorKeys
+	switch dialogflow.PlatformOf(dr) {
+	case dialogflow.Facebook:
+		keys.PSID = dr.SenderID()
+	case dialogflow.ActionsOnGoogle:
+		if account := s.googleAccount(ctx, dr); account != nil {
+			keys.GoogleUserID = account.UserID
+			return keys, account
+		}
+	}
+	return keys, nil
+}
+
+// googleAccount returns the Google account the user linked, or nil
+func (s *Server) googleAccount(ctx context.Context, dr dialogflow.Request) *dialogflow.GoogleAuthResponse {
+	if s.IDTokens == nil {
+		return nil
+	}
+	account, err := s.IDTokens.VerifyRequest(ctx, dr)
+	if err != nil {
+		if err != dialogflow.ErrNoIDToken {
+			logging.FromContext(ctx).Warn("rejected the ID token", "err", err)
+		}
+		return nil
+	}
+	return account
+}
+
+// recordDonor finds or creates the donor with the keys and the phone number
+// and remembers their name, their phone number and their email. Without any
+// key or phone number there is no donor to record, it returns nil
+func (s *Server) recordDonor(ctx context.Context, keys DonorKeys, name, phone, email string, donated bool) (*Donor, error) {
+	keys.Phone = NormalizePhone(phone)
+	if len(keys.fields()) == 0 {
+		return nil, nil
+	}
+	donor, err := s.Store.FindDonor(ctx, keys)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now().Unix()
+	if donor == nil {
+		donor = &Donor{ID: keys.newDonorID(), CreatedDate: now, PSID: keys.PSID, GoogleUserID: keys.GoogleUserID, ZaloUserID: keys.ZaloUserID}
+	}
+	if name != "" {
+		donor.Name = name
+	}
+	if email != "" {
+		donor.Email = email
+	}
+	if keys.Phone != "" {
+		donor.Phone = keys.Phone
+	}
+	if donated {
+		donor.LastDonation = now
+	}
+	if err := s.Store.SaveDonor(ctx, donor); err != nil {
+		return nil, err
+	}
+	return donor, nil
+}
+
+// knownDonorContext remembers the name and the phone number of a returning
+// donor in the information context, so that the agent doesn't ask for them
+// again
+func knownDonorContext(dr dialogflow.Request, donor *Donor) (*dialogflow.Context, error) {
+	params := map[string]interface{}{
+		"person": map[string]interface{}{"name": donor.Name},
+	}
+	if donor.Phone != "" {
+		params["phone-number"] = donor.Phone
+	}
+	return dr.NewContext("information", 5, params)
+}
+
+func donationsCount(n int) string {
+	if n == 1 {
+		return "1 donation"
+	}
+	return fmt.Sprintf("%d donations", n)
+}
+
+// donationHistoryHandler lists the last donations of the donor, recognized by
+// their identity on the platform only
+func (s *Server) donationHistoryHandler(e echo.Context, dr dialogflow.Request) (*dialogflow.Fulfillment, error) {
+	ctx := e.Request().Context()
+	keys, _ := s.donorKeys(ctx, dr)
+	donor, err := s.Store.FindDonor(ctx, keys)
+	if err != nil {
+		return nil, err
+	}
+	if donor == nil {
+		return dialogflow.NewBuilder(dr).
+			Text("We haven't received a donation from you yet. Do you have something unused to share?").
+			Build(), nil
+	}
+	transactions, err := s.Store.DonorTransactions(ctx, donor.ID)
+	if err != nil {
+		return nil, err
+	}
+	if len(transactions) == 0 {
+		return dialogflow.NewBuilder(dr).
+			Text(fmt.Sprintf("%s, we haven't received a donation from you yet. Do you have something unused to share?", donor.Name)).
+			Build(), nil
+	}
+	sort.Slice(transactions, func(i, j int) bool {
+		return transactions[i].CreatedDate > transactions[j].CreatedDate
+	})
+	text := fmt.Sprintf("%s, you have made %s with us, thank you so much!", donor.Name, donationsCount(len(transactions)))
+	if len(transactions) > maxHistory {
+		transactions = transactions[:maxHistory]
+	}
+	var choices []dialogflow.Choice
+	for i, t := range transactions {
+		choices = append(choices, dialogflow.Choice{
+			Key:         fmt.Sprintf("donation-%d", i+1),
+			Title:       t.Description,
+			Description: fmt.Sprintf("%s - %s", time.Unix(t.CreatedDate, 0).Format("Jan 2, 2006"), t.Status),
+		})
+	}
+	return dialogflow.NewBuilder(dr).
+		Text(text).
+		Choices("Your donations", choices).
+		Build(), nil
+}
+
+// verifiedEmail returns the email of the account if Google verified it
+func verifiedEmail(account *dialogflow.GoogleAuthResponse) string {
+	if account == nil || !account.VerifiedEmail {
+		return ""
+	}
+	return account.Email
+}
+
+// signInHandler asks Assistant users to link their Google account, so that
+// they are recognized on every device
+func (s *Server) signInHandler(e echo.Context, dr dialogflow.Request) (*dialogflow.Fulfillment, error) {
+	if account := s.googleAccount(e.Request().Context(), dr); account != nil {
+		return dialogflow.NewBuilder(dr).
+			Text(fmt.Sprintf("You are already signed in as %s. Do you have something unused?", account.Email)).
+			Build(), nil
+	}
+	if s.IDTokens == nil {
+		return dialogflow.NewBuilder(dr).Text("Sorry, signing in isn't available yet. Do you have something unused?").Build(), nil
+	}
+	return dialogflow.NewBuilder(dr).AskSignIn("To remember your name and your email").Build(), nil
+}
+
+// signInResultHandler saves the donor once they linked their account, the
+// agent then knows their name for the next donations
+func (s *Server) signInResultHandler(e echo.Context, dr dialogflow.Request) (*dialogflow.Fulfillment, error) {
+	ctx := e.Request().Context()
+	keys, account := s.donorKeys(ctx, dr)
+	if dr.SignInStatus() != dialogflow.SignInStatusOK || account == nil {
+		return dialogflow.NewBuilder(dr).Text("No problem! Do you have something unused?").Build(), nil
+	}
+	donor, err := s.recordDonor(ctx, keys, account.Name, "", verifiedEmail(account), false)
+	if err != nil {
+		return nil, err
+	}
+	known, err := knownDonorContext(dr, donor)
+	if err != nil {
+		return nil, err
+	}
+	name := account.GivenName
+	if name == "" {
+		name = donor.Name
+	}
+	return dialogflow.NewBuilder(dr).
+		Text(fmt.Sprintf("Thank you %s, we will remember you. Do you have something unused?", name)).
+		Context(known).
+		Build(), nil
+}
@@ -0,0 +1,264 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"wcws/dialogflow"
+)
+
+func postWebhook(t *testing.T, e *echo.Echo, body string) string {
+	req := httptest.NewRequest(http.MethodPost, "/webhook", bytes.NewReader([]byte(body)))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusOK, rec.Code)
+	return rec.Body.String()
+}
+
+const messengerPermission = `{
+	"responseId": "donor-1",
+	"session": "projects/wcws/agent/sessions/donor",
+	"queryResult": {
+		"action": "getPermission",
+		"parameters": {"address": "here"},
+		"outputContexts": [{
+			"name": "projects/wcws/agent/sessions/donor/contexts/information",
+			"lifespanCount": 4,
+			"parameters": {
+				"description": "a bike",
+				"person": {"name": "Lan"},
+				"phone-number": "+84 905 000 111",
+				"transaction-time.original": "tomorrow morning"
+			}
+		}]
+	},
+	"originalDetectIntentRequest": {
+		"source": "facebook",
+		"payload": {
+			"data": {"sender": {"id": "psid-lan"}},
+			"postback": {"data": {"lat": "16.06", "long": "108.22"}}
+		}
+	}
+}`
+
+func messengerRequest(responseID, action string) string {
+	return `{
+		"responseId": "` + responseID + `",
+		"session": "projects/wcws/agent/sessions/donor",
+		"queryResult": {"action": "` + action + `", "parameters": {}},
+		"originalDetectIntentRequest": {"source": "facebook", "payload": {"data": {"sender": {"id": "psid-lan"}}}}
+	}`
+}
+
+func TestReturningDonor(t *testing.T) {
+	srv := goldenServer()
+	e := echo.New()
+	srv.Register(e)
+
+	answer := postWebhook(t, e, messengerRequest("donor-0", "welcome"))
+	assert.Contains(t, answer, "Great! Welcome")
+
+	postWebhook(t, e, messengerPermission)
+	transactions := srv.Store.(*MemoryStore).AddedTransactions()
+	require.Len(t, transactions, 1)
+	assert.Equal(t, "psid-psid-lan", transactions[0].DonorID)
+
+	donor, err := srv.Store.FindDonor(context.Background(), DonorKeys{PSID: "psid-lan"})
+	require.NoError(t, err)
+	require.NotNil(t, donor)
+	assert.Equal(t, "Lan", donor.Name)
+	assert.Equal(t, "0905000111", donor.Phone)
+
+	answer = postWebhook(t, e, messengerRequest("donor-2", "welcome"))
+	assert.Contains(t, answer, "Welcome back, Lan! Thank you for your 1 donation.")
+	assert.Contains(t, answer, `"name":"projects/wcws/agent/sessions/donor/contexts/information"`)
+	assert.Contains(t, answer, `"phone-number":"0905000111"`)
+
+	answer = postWebhook(t, e, messengerRequest("donor-3", "history"))
+	assert.Contains(t, answer, "Lan, you have made 1 donation with us")
+	assert.Contains(t, answer, "a bike")
+}
+
+func TestDonorNotRecognizedByPhone(t *testing.T) {
+	srv := goldenServer()
+	e := echo.New()
+	srv.Register(e)
+
+	postWebhook(t, e, messengerPermission)
+	body, err := ioutil.ReadFile("testdata/golden/google_permission.request.json")
+	require.NoError(t, err)
+	body = bytes.Replace(body, []byte(`"0905123456"`), []byte(`"0905 000 111"`), 1)
+	body = bytes.Replace(body, []byte(`"source": "google",`), []byte(`"source": "google", "payload": {"user": {"userId": "g-lan"}},`), 1)
+	postWebhook(t, e, string(body))
+
+	transactions := srv.Store.(*MemoryStore).AddedTransactions()
+	require.Len(t, transactions, 2)
+	assert.Equal(t, "psid-psid-lan", transactions[0].DonorID)
+	assert.Equal(t, "phone-0905000111", transactions[1].DonorID, "the phone number and the unverified user ID don't identify the donor")
+	donor, err := srv.Store.FindDonor(context.Background(), DonorKeys{PSID: "psid-lan"})
+	require.NoError(t, err)
+	require.NotNil(t, donor)
+	assert.Empty(t, donor.GoogleUserID)
+	assert.Equal(t, "Lan", donor.Name)
+
+	history := strings.Replace(messengerRequest("donor-2", "history"), `"id": "psid-lan"`, `"id": "psid-other"`, 1)
+	history = strings.Replace(history, `"parameters": {}`, `"parameters": {}, "outputContexts": [{
+		"name": "projects/wcws/agent/sessions/donor/contexts/information",
+		"parameters": {"phone-number": "0905000111"}
+	}]`, 1)
+	answer := postWebhook(t, e, history)
+	assert.Contains(t, answer, "We haven't received a donation from you yet")
+	assert.NotContains(t, answer, "a bike")
+}
+
+func TestDonationsLinkedByPhone(t *testing.T) {
+	srv := goldenServer()
+	e := echo.New()
+	srv.Register(e)
+
+	body, err := ioutil.ReadFile("testdata/golden/google_permission.request.json")
+	require.NoError(t, err)
+	postWebhook(t, e, string(bytes.Replace(body, []byte("golden-google-permission"), []byte("phone-1"), 1)))
+	body = bytes.Replace(body, []byte(`"0905123456"`), []byte(`"+84 905 123 456"`), 1)
+	postWebhook(t, e, string(bytes.Replace(body, []byte("golden-google-permission"), []byte("phone-2"), 1)))
+	// the same number on Messenger is another donor, the PSID doesn't agree
+	postWebhook(t, e, strings.Replace(messengerPermission, "+84 905 000 111", "0905123456", 1))
+
+	transactions := srv.Store.(*MemoryStore).AddedTransactions()
+	require.Len(t, transactions, 3)
+	donorIDs := map[string]int{}
+	for _, trans := range transactions {
+		donorIDs[trans.DonorID]++
+	}
+	assert.Equal(t, map[string]int{"phone-0905123456": 2, "psid-psid-lan": 1}, donorIDs)
+
+	// the phone number alone doesn't make a returning donor in the conversation
+	answer := postWebhook(t, e, `{
+		"responseId": "phone-3",
+		"session": "projects/wcws/agent/sessions/phone",
+		"queryResult": {"action": "welcome", "parameters": {}, "outputContexts": [{
+			"name": "projects/wcws/agent/sessions/phone/contexts/information",
+			"parameters": {"phone-number": "0905123456"}
+		}]},
+		"originalDetectIntentRequest": {"source": "google", "payload": {}}
+	}`)
+	assert.Contains(t, answer, "Great! Welcome")
+	assert.NotContains(t, answer, "Hoang")
+}
+
+func TestHistoryOfUnknownDonor(t *testing.T) {
+	e := echo.New()
+	goldenServer().Register(e)
+	assert.Contains(t, postWebhook(t, e, messengerRequest("donor-0", "history")), "We haven't received a donation from you yet")
+}
+
+func googleIDToken(t *testing.T, key *rsa.PrivateKey) string {
+	enc := func(i interface{}) string {
+		b, err := json.Marshal(i)
+		require.NoError(t, err)
+		return base64.RawURLEncoding.EncodeToString(b)
+	}
+	signed := enc(map[string]string{"alg": "RS256", "kid": "k1"}) + "." + enc(map[string]interface{}{
+		"iss":            "https://accounts.google.com",
+		"aud":            "wcws.apps.googleusercontent.com",
+		"sub":            "g-hoang",
+		"exp":            time.Now().Add(time.Hour).Unix(),
+		"email":          "hoang@example.com",
+		"email_verified": true,
+		"name":           "Hoang Nguyen",
+		"given_name":     "Hoang",
+	})
+	hash := sha256.Sum256([]byte(signed))
+	sig, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, hash[:])
+	require.NoError(t, err)
+	return signed + "." + base64.RawURLEncoding.EncodeToString(sig)
+}
+
+// googleCerts serves the public key of the ID tokens signed with key
+func googleCerts(t *testing.T, key *rsa.PrivateKey) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"keys": []map[string]string{{
+			"kid": "k1",
+			"kty": "RSA",
+			"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+			"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+		}}})
+	}))
+}
+
+func TestGoogleAccountLinking(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	certs := googleCerts(t, key)
+	defer certs.Close()
+
+	srv := goldenServer()
+	srv.IDTokens = dialogflow.NewIDTokenVerifier("wcws.apps.googleusercontent.com")
+	srv.IDTokens.CertsURL = certs.URL
+	e := echo.New()
+	srv.Register(e)
+	token := googleIDToken(t, key)
+
+	answer := postWebhook(t, e, `{
+		"responseId": "link-1",
+		"session": "projects/wcws/agent/sessions/link",
+		"queryResult": {"action": "signIn", "parameters": {}},
+		"originalDetectIntentRequest": {"source": "google", "payload": {}}
+	}`)
+	assert.Contains(t, answer, "actions.intent.SIGN_IN")
+	assert.NotContains(t, answer, "permissions")
+
+	answer = postWebhook(t, e, `{
+		"responseId": "link-2",
+		"session": "projects/wcws/agent/sessions/link",
+		"queryResult": {"action": "signInResult", "parameters": {}},
+		"originalDetectIntentRequest": {"source": "google", "payload": {
+			"user": {"idToken": "`+token+`"},
+			"inputs": [{"arguments": [{"name": "SIGN_IN", "extension": {"status": "OK"}}]}]
+		}}
+	}`)
+	assert.Contains(t, answer, "Thank you Hoang, we will remember you")
+	assert.Contains(t, answer, `"person":{"name":"Hoang Nguyen"}`)
+
+	body, err := ioutil.ReadFile("testdata/golden/google_permission.request.json")
+	require.NoError(t, err)
+	body = bytes.Replace(body, []byte(`"person": {"name": "Hoang"},`), nil, 1)
+	body = bytes.Replace(body, []byte(`"source": "google",`), []byte(`"source": "google", "payload": {"user": {"idToken": "`+token+`"}},`), 1)
+	postWebhook(t, e, string(body))
+	transactions := srv.Store.(*MemoryStore).AddedTransactions()
+	require.Len(t, transactions, 1)
+	assert.Equal(t, "Hoang Nguyen", transactions[0].GiverName)
+	assert.Equal(t, "hoang@example.com", transactions[0].Email)
+	assert.Equal(t, "google-g-hoang", transactions[0].DonorID)
+
+	donor, err := srv.Store.FindDonor(context.Background(), DonorKeys{GoogleUserID: "g-hoang"})
+	require.NoError(t, err)
+	require.NotNil(t, donor)
+	assert.Equal(t, "hoang@example.com", donor.Email)
+
+	answer = postWebhook(t, e, `{
+		"responseId": "link-3",
+		"session": "projects/wcws/agent/sessions/link",
+		"queryResult": {"action": "welcome", "parameters": {}},
+		"originalDetectIntentRequest": {"source": "google", "payload": {"user": {"idToken": "`+token+`"}}}
+	}`)
+	assert.Contains(t, answer, "Welcome back, Hoang Nguyen! Thank you for your 1 donation.")
+}
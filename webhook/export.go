@@ -0,0 +1,217 @@
+package webhook
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"wcws/export"
+	"wcws/logging"
+)
+
+// exportColumn is a column of the exports of the donations, its value is in
+// the location of the export
+type exportColumn struct {
+	name  string
+	value func(t StoredTransaction, loc *time.Location) interface{}
+}
+
+func textColumn(name string, value func(t StoredTransaction) string) exportColumn {
+	return exportColumn{name, func(t StoredTransaction, loc *time.Location) interface{} { return value(t) }}
+}
+
+func numberColumn(name string, value func(t StoredTransaction) float64) exportColumn {
+	return exportColumn{name, func(t StoredTransaction, loc *time.Location) interface{} { return value(t) }}
+}
+
+// exportColumns are the columns the exports can have, named after the JSON
+// fields of the donations
+var exportColumns = []exportColumn{
+	textColumn("id", func(t StoredTransaction) string { return t.ID }),
+	{"createdDate", func(t StoredTransaction, loc *time.Location) interface{} {
+		if t.CreatedDate == 0 {
+			return nil
+		}
+		return time.Unix(t.CreatedDate, 0).In(loc)
+	}},
+	textColumn("status", func(t StoredTransaction) string { return t.Status }),
+	numberColumn("eventId", func(t StoredTransaction) float64 { return t.EventId }),
+	textColumn("giverName", func(t StoredTransaction) string { return t.GiverName }),
+	textColumn("phoneNumber", func(t StoredTransaction) string { return t.PhoneNumber }),
+	textColumn("email", func(t StoredTransaction) string { return t.Email }),
+	textColumn("address", func(t StoredTransaction) string { return t.Address }),
+	numberColumn("lat", func(t StoredTransaction) float64 { return t.Lat }),
+	numberColumn("lng", func(t StoredTransaction) float64 { return t.Long }),
+	textColumn("description", func(t StoredTransaction) string { return t.Description }),
+	{"transactionTime", func(t StoredTransaction, loc *time.Location) interface{} {
+		pickup, err := time.Parse(time.RFC3339, t.TransactionTime)
+		if err != nil {
+			return t.TransactionTime
+		}
+		return pickup.In(loc)
+	}},
+	textColumn("volunteer", func(t StoredTransaction) string { return t.VolunteerId }),
+	textColumn("donorId", func(t StoredTransaction) string { return t.DonorID }),
+	textColumn("imageURL", func(t StoredTransaction) string { return strings.Join(t.ImageURL, " ") }),
+}
+
+// DefaultExportColumns are the columns of the exports which don't choose
+var DefaultExportColumns = []string{"id", "createdDate", "status", "eventId", "giverName", "phoneNumber", "address", "description", "transactionTime", "volunteer"}
+
+// ExportOptions are the donations exported and how
+type ExportOptions struct {
+	// Format is export.CSV or export.XLSX
+	Format string
+	Filter TransactionFilter
+	// Columns are DefaultExportColumns when it is empty
+	Columns []string
+	// Location is the time zone of the dates, UTC when it is nil
+	Location *time.Location
+}
+
+func (o ExportOptions) columns() ([]exportColumn, error) {
+	names := o.Columns
+	if len(names) == 0 {
+		names = DefaultExportColumns
+	}
+	columns := make([]exportColumn, 0, len(names))
+	for _, name := range names {
+		found := false
+		for _, c := range exportColumns {
+			if c.name == name {
+				columns = append(columns, c)
+				found = true
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("unknown column %q", name)
+		}
+	}
+	return columns, nil
+}
+
+// Validate checks the format and the columns before anything is written
+func (o ExportOptions) Validate() error {
+	if o.Format != export.CSV && o.Format != export.XLSX {
+		return fmt.Errorf("unknown export format %q, expected csv or xlsx", o.Format)
+	}
+	_, err := o.columns()
+	return err
+}
+
+// ExportTransactions writes the donations of the store matching the filter
+// of the options to w, a row at a time after a row of headers
+func ExportTransactions(ctx context.Context, store Store, w io.Writer, opts ExportOptions) error {
+	columns, err := opts.columns()
+	if err != nil {
+		return err
+	}
+	loc := opts.Location
+	if loc == nil {
+		loc = time.UTC
+	}
+	out, err := export.New(opts.Format, w)
+	if err != nil {
+		return err
+	}
+	row := make([]interface{}, len(columns))
+	for i, c := range columns {
+		row[i] = c.name
+	}
+	if err := out.Write(row); err != nil {
+		return err
+	}
+	err = store.EachTransaction(ctx, opts.Filter, func(t StoredTransaction) error {
+		for i, c := range columns {
+			row[i] = c.value(t, loc)
+		}
+		return out.Write(row)
+	})
+	if err != nil {
+		return err
+	}
+	return out.Close()
+}
+
+// ParseExportDate parses a date of the filters, a day in the location like
+// 2019-12-01 or an RFC 3339 time. The end of a range is the end of its day
+func ParseExportDate(s string, loc *time.Location, end bool) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return t, nil
+	}
+	day, err := time.ParseInLocation("2006-01-02", s, loc)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid date %q, expected 2006-01-02", s)
+	}
+	if end {
+		day = day.AddDate(0, 0, 1)
+	}
+	return day, nil
+}
+
+// exportOptions reads the options of the query of the export endpoint
+func (s *Server) exportOptions(e echo.Context) (ExportOptions, error) {
+	opts := ExportOptions{Format: e.QueryParam("format"), Location: s.ExportLocation}
+	if opts.Format == "" {
+		opts.Format = export.CSV
+	}
+	if tz := e.QueryParam("tz"); tz != "" {
+		loc, err := time.LoadLocation(tz)
+		if err != nil {
+			return opts, fmt.Errorf("unknown time zone %q", tz)
+		}
+		opts.Location = loc
+	}
+	if opts.Location == nil {
+		opts.Location = time.UTC
+	}
+	if columns := e.QueryParam("columns"); columns != "" {
+		opts.Columns = strings.Split(columns, ",")
+	}
+	opts.Filter.Status = e.QueryParam("status")
+	if event := e.QueryParam("event"); event != "" {
+		id, err := strconv.ParseFloat(event, 64)
+		if err != nil {
+			return opts, fmt.Errorf("invalid event %q", event)
+		}
+		opts.Filter.EventID = id
+	}
+	var err error
+	if from := e.QueryParam("from"); from != "" {
+		if opts.Filter.From, err = ParseExportDate(from, opts.Location, false); err != nil {
+			return opts, err
+		}
+	}
+	if to := e.QueryParam("to"); to != "" {
+		if opts.Filter.To, err = ParseExportDate(to, opts.Location, true); err != nil {
+			return opts, err
+		}
+	}
+	return opts, opts.Validate()
+}
+
+// exportTransactions streams the donations selected by the query as a CSV
+// or XLSX attachment
+func (s *Server) exportTransactions(e echo.Context) error {
+	opts, err := s.exportOptions(e)
+	if err != nil {
+		return e.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+	name := fmt.Sprintf("transactions-%s.%s", time.Now().In(opts.Location).Format("20060102"), opts.Format)
+	res := e.Response()
+	res.Header().Set(echo.HeaderContentType, export.ContentType(opts.Format))
+	res.Header().Set(echo.HeaderContentDisposition, fmt.Sprintf("attachment; filename=%q", name))
+	res.WriteHeader(http.StatusOK)
+	ctx := e.Request().Context()
+	if err := ExportTransactions(ctx, s.Store, res, opts); err != nil {
+		// the status was sent, the download is left truncated
+		logging.FromContext(ctx).Error("exporting the donations", "err", err)
+	}
+	return nil
+}
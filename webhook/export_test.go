@@ -0,0 +1,118 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func exportStore(t *testing.T) *MemoryStore {
+	ict := time.FixedZone("ICT", 7*60*60)
+	store := NewMemoryStore(nil)
+	for id, trans := range map[string]Transactions{
+		"t-3": {Status: "pending", EventId: 2, GiverName: "Lan", PhoneNumber: "+84905123456", Description: "=1+1",
+			CreatedDate: time.Date(2019, 12, 3, 10, 0, 0, 0, ict).Unix()},
+		"t-1": {Status: "pending", EventId: 1, GiverName: "Minh", Description: "books, toys",
+			CreatedDate: time.Date(2019, 11, 30, 20, 0, 0, 0, ict).Unix()},
+		"t-2": {Status: "done", EventId: 1, GiverName: "Hoa", Description: "clothes",
+			CreatedDate: time.Date(2019, 12, 2, 1, 0, 0, 0, ict).Unix()},
+	} {
+		_, err := store.AddTransaction(context.Background(), id, trans)
+		require.NoError(t, err)
+	}
+	return store
+}
+
+func TestExportTransactions(t *testing.T) {
+	store := exportStore(t)
+	ict, err := time.LoadLocation("Asia/Ho_Chi_Minh")
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	opts := ExportOptions{Format: "csv", Columns: []string{"id", "createdDate", "status", "eventId", "description"}, Location: ict}
+	require.NoError(t, ExportTransactions(context.Background(), store, &buf, opts))
+	assert.Equal(t, `id,createdDate,status,eventId,description
+t-1,2019-11-30 20:00:00,pending,1,"books, toys"
+t-2,2019-12-02 01:00:00,done,1,clothes
+t-3,2019-12-03 10:00:00,pending,2,'=1+1
+`, buf.String())
+
+	buf.Reset()
+	opts.Columns = []string{"id"}
+	opts.Filter.From, err = ParseExportDate("2019-12-02", ict, false)
+	require.NoError(t, err)
+	opts.Filter.To, err = ParseExportDate("2019-12-02", ict, true)
+	require.NoError(t, err)
+	require.NoError(t, ExportTransactions(context.Background(), store, &buf, opts))
+	assert.Equal(t, "id\nt-2\n", buf.String(), "the days are local")
+
+	buf.Reset()
+	opts.Filter = TransactionFilter{Status: "pending", EventID: 2}
+	require.NoError(t, ExportTransactions(context.Background(), store, &buf, opts))
+	assert.Equal(t, "id\nt-3\n", buf.String())
+
+	opts.Columns = []string{"id", "password"}
+	assert.Error(t, opts.Validate())
+	opts.Columns = nil
+	opts.Format = "pdf"
+	assert.Error(t, opts.Validate())
+}
+
+func TestParseExportDate(t *testing.T) {
+	ict := time.FixedZone("ICT", 7*60*60)
+	day, err := ParseExportDate("2019-12-01", ict, false)
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2019, 11, 30, 17, 0, 0, 0, time.UTC), day.UTC())
+	end, err := ParseExportDate("2019-12-01", ict, true)
+	require.NoError(t, err)
+	assert.Equal(t, 24*time.Hour, end.Sub(day))
+	exact, err := ParseExportDate("2019-12-01T08:00:00Z", ict, true)
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2019, 12, 1, 8, 0, 0, 0, time.UTC), exact.UTC())
+	_, err = ParseExportDate("01/12/2019", ict, false)
+	assert.Error(t, err)
+}
+
+func TestExportEndpoint(t *testing.T) {
+	srv := New(exportStore(t), nil)
+	srv.AdminToken = "admin-token"
+	srv.ExportLocation = time.FixedZone("ICT", 7*60*60)
+	e := echo.New()
+	srv.Register(e)
+
+	rec := adminRequest(e, http.MethodGet, "/admin/transactions/export?status=done&columns=id,createdDate,giverName", "")
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	assert.Equal(t, "text/csv; charset=utf-8", rec.Header().Get(echo.HeaderContentType))
+	assert.Contains(t, rec.Header().Get(echo.HeaderContentDisposition), `attachment; filename="transactions-`)
+	assert.Equal(t, "id,createdDate,giverName\nt-2,2019-12-02 01:00:00,Hoa\n", rec.Body.String())
+
+	rec = adminRequest(e, http.MethodGet, "/admin/transactions/export?columns=id,createdDate&tz=UTC&from=2019-12-02", "")
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	assert.Equal(t, "id,createdDate\nt-3,2019-12-03 03:00:00\n", rec.Body.String())
+
+	rec = adminRequest(e, http.MethodGet, "/admin/transactions/export?format=xlsx", "")
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Header().Get(echo.HeaderContentDisposition), `.xlsx"`)
+	assert.Equal(t, "PK", rec.Body.String()[:2])
+
+	for _, query := range []string{"format=pdf", "columns=id,password", "event=one", "from=yesterday", "tz=Mars/Olympus"} {
+		rec = adminRequest(e, http.MethodGet, "/admin/transactions/export?"+query, "")
+		assert.Equal(t, http.StatusBadRequest, rec.Code, query)
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/admin/transactions/export", nil)
+	rec = httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusBadRequest, rec.Code, "the token is missing")
+	req.Header.Set(echo.HeaderAuthorization, "Bearer guess")
+	rec = httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+}
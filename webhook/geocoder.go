@@ -0,0 +1,129 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"wcws/config"
+	"wcws/dialogflow"
+)
+
+// Geocoder finds the address of coordinates
+type Geocoder interface {
+	Address(ctx context.Context, c dialogflow.Coordinates) (string, error)
+}
+
+// GeocoderFunc is an adapter to use a function as a Geocoder
+type GeocoderFunc func(ctx context.Context, c dialogflow.Coordinates) (string, error)
+
+// Address calls f
+func (f GeocoderFunc) Address(ctx context.Context, c dialogflow.Coordinates) (string, error) {
+	return f(ctx, c)
+}
+
+// errNoAddress is returned when nothing is found at the coordinates
+var errNoAddress = errors.New("opencage: no result")
+
+// OpenCageBaseURL is the base URL of the OpenCage geocoding API
+const OpenCageBaseURL = "https://api.opencagedata.com"
+
+// OpenCage is a Geocoder using the OpenCage API
+type OpenCage struct {
+	APIKey     string
+	BaseURL    string
+	HTTPClient *http.Client
+
+	mu      sync.Mutex
+	lastErr error
+	probed  time.Time
+}
+
+// NewOpenCage returns an OpenCage geocoder using the given API key
+func NewOpenCage(apiKey string) *OpenCage {
+	return &OpenCage{
+		APIKey:     apiKey,
+		BaseURL:    OpenCageBaseURL,
+		HTTPClient: &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+// NewOpenCageFromConfig returns the OpenCage geocoder of the configuration
+func NewOpenCageFromConfig(cfg config.OpenCage) *OpenCage {
+	g := NewOpenCage(cfg.APIKey)
+	if cfg.BaseURL != "" {
+		g.BaseURL = strings.TrimSuffix(cfg.BaseURL, "/")
+	}
+	return g
+}
+
+// Address returns the formatted address of the first result
+func (g *OpenCage) Address(ctx context.Context, c dialogflow.Coordinates) (string, error) {
+	address, err := g.address(ctx, c)
+	g.mu.Lock()
+	g.lastErr = err
+	if err == errNoAddress {
+		// the API works, there is nothing at the coordinates
+		g.lastErr = nil
+	}
+	g.mu.Unlock()
+	return address, err
+}
+
+// Ping returns the error of the last lookup. While the lookups fail, the API
+// is probed again at most once a minute so that its recovery is noticed
+// without spending the quota on every probe
+func (g *OpenCage) Ping(ctx context.Context) error {
+	g.mu.Lock()
+	err := g.lastErr
+	probe := err != nil && time.Since(g.probed) >= time.Minute
+	if probe {
+		g.probed = time.Now()
+	}
+	g.mu.Unlock()
+	if !probe {
+		return err
+	}
+	_, err = g.Address(ctx, dialogflow.Coordinates{Latitude: 16.0544, Longitude: 108.2022})
+	return err
+}
+
+func (g *OpenCage) address(ctx context.Context, c dialogflow.Coordinates) (string, error) {
+	var payload struct {
+		Results []struct {
+			Formatted string `json:"formatted"`
+		} `json:"results"`
+	}
+	apiURL := fmt.Sprintf("%s/geocode/v1/json?q=%f+%f&key=%s", g.BaseURL, c.Latitude, c.Longitude, url.QueryEscape(g.APIKey))
+	req, err := http.NewRequest(http.MethodGet, apiURL, nil)
+	if err != nil {
+		return "", err
+	}
+	res, err := g.HTTPClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return "", err
+	}
+	defer res.Body.Close()
+	resBody, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return "", err
+	}
+	if res.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("opencage: unexpected status %s", res.Status)
+	}
+	err = json.Unmarshal(resBody, &payload)
+	if err != nil {
+		return "", err
+	}
+	if len(payload.Results) == 0 {
+		return "", errNoAddress
+	}
+	return payload.Results[0].Formatted, nil
+}
@@ -0,0 +1,92 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"flag"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/xeipuuv/gojsonschema"
+
+	"wcws/dialogflow"
+)
+
+var update = flag.Bool("update", false, "update the golden files")
+
+// goldenServer returns a server with fixed events and address so that the
+// fulfillments only depend on the requests
+func goldenServer() *Server {
+	ict := time.FixedZone("ICT", 7*60*60)
+	store := NewMemoryStore([]Event{
+		{Name: "Winter clothes", Address: "12 Bach Dang, Da Nang", Status: true, Time: time.Date(2019, 12, 1, 9, 0, 0, 0, ict)},
+		{Name: "Books for kids", Address: "5 Tran Phu, Da Nang", Status: true, Time: time.Date(2019, 12, 8, 9, 0, 0, 0, ict)},
+	})
+	geocoder := GeocoderFunc(func(ctx context.Context, c dialogflow.Coordinates) (string, error) {
+		return "12 Bach Dang, Hai Chau, Da Nang", nil
+	})
+	return New(store, geocoder)
+}
+
+// TestGoldenFulfillments renders the fulfillment of every request in
+// testdata/golden and compares it with the checked-in JSON. Run
+// go test ./webhook -run Golden -update to regenerate the golden files
+func TestGoldenFulfillments(t *testing.T) {
+	schema, err := gojsonschema.NewSchema(gojsonschema.NewReferenceLoader("file://" + absPath(t, "testdata/webhook-response.schema.json")))
+	require.NoError(t, err)
+
+	defer func(f func(int) int) { randIntn = f }(randIntn)
+	randIntn = func(int) int { return 0 }
+
+	requests, err := filepath.Glob("testdata/golden/*.request.json")
+	require.NoError(t, err)
+	require.NotEmpty(t, requests)
+	for _, path := range requests {
+		name := strings.TrimSuffix(filepath.Base(path), ".request.json")
+		t.Run(name, func(t *testing.T) {
+			body, err := ioutil.ReadFile(path)
+			require.NoError(t, err)
+
+			e := echo.New()
+			goldenServer().Register(e)
+			req := httptest.NewRequest(http.MethodPost, "/webhook", bytes.NewReader(body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, req)
+			require.Equal(t, http.StatusOK, rec.Code)
+
+			var got bytes.Buffer
+			require.NoError(t, json.Indent(&got, rec.Body.Bytes(), "", "  "))
+			got.WriteByte('\n')
+
+			result, err := schema.Validate(gojsonschema.NewBytesLoader(got.Bytes()))
+			require.NoError(t, err)
+			for _, desc := range result.Errors() {
+				t.Errorf("does not match the WebhookResponse schema: %s", desc)
+			}
+
+			golden := filepath.Join("testdata/golden", name+".golden.json")
+			if *update {
+				require.NoError(t, ioutil.WriteFile(golden, got.Bytes(), 0644))
+			}
+			want, err := ioutil.ReadFile(golden)
+			require.NoError(t, err, "run with -update to create the golden file")
+			assert.Equal(t, string(want), got.String())
+		})
+	}
+}
+
+func absPath(t *testing.T, path string) string {
+	abs, err := filepath.Abs(path)
+	require.NoError(t, err)
+	return filepath.ToSlash(abs)
+}
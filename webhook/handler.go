@@ -0,0 +1,216 @@
+package webhook
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"wcws/dialogflow"
+	"wcws/logging"
+	"wcws/notify"
+)
+
+var (
+	errUnknownAction = errors.New("unknown action")
+	errMissingParams = errors.New("missing required parameters")
+	errNoLocation    = errors.New("no device location in request")
+)
+
+const logoURI = "https://image.freepik.com/free-vector/volunteers-with-charity-icons-illustration_53876-43180.jpg?fbclid=IwAR2bbsMINLoup2HAG8heP1Kq8KF9oimCDQvcrOXqb14d1VlP8UHFDkEMyNA"
+
+func (s *Server) welcomeHandler(e echo.Context, dr dialogflow.Request) (*dialogflow.Fulfillment, error) {
+	ctx := e.Request().Context()
+	events, _ := s.Store.ActiveEvents(ctx)
+	answer1 := "Great! Welcome to We Collect We Share application! Do you have something unused?"
+	var known *dialogflow.Context
+	keys, account := s.donorKeys(ctx, dr)
+	donor, err := s.Store.FindDonor(ctx, keys)
+	if err != nil {
+		logging.FromContext(ctx).Error("finding the donor", "err", err)
+	}
+	if donor == nil && account != nil {
+		// a signed in user is known even before their first donation
+		answer1 = fmt.Sprintf("Hi %s! Welcome to We Collect We Share application! Do you have something unused?", account.GivenName)
+		if known, err = knownDonorContext(dr, &Donor{Name: account.Name}); err != nil {
+			return nil, err
+		}
+	}
+	if donor != nil {
+		transactions, err := s.Store.DonorTransactions(ctx, donor.ID)
+		if err != nil {
+			logging.FromContext(ctx).Error("reading the donations of the donor", "donorId", donor.ID, "err", err)
+		}
+		answer1 = fmt.Sprintf("Welcome back, %s! Do you have something else unused?", donor.Name)
+		if len(transactions) > 0 {
+			answer1 = fmt.Sprintf("Welcome back, %s! Thank you for your %s. Do you have something else unused?", donor.Name, donationsCount(len(transactions)))
+		}
+		if known, err = knownDonorContext(dr, donor); err != nil {
+			return nil, err
+		}
+	}
+	eventsTitle := "We have some events for you"
+	if len(events) == 0 {
+		eventsTitle = "There are no events at the moment"
+	}
+	var choices []dialogflow.Choice
+	for _, v := range events {
+		choices = append(choices, dialogflow.Choice{
+			Key:         v.Name,
+			Title:       v.Name,
+			Description: fmt.Sprintf("%s - %s", v.Address, v.Time),
+		})
+	}
+	b := dialogflow.NewBuilder(dr).
+		Text(answer1).
+		Card("We Collect We Share", "Here we collect things from those who want to share to give those in need", dialogflow.Image{
+			ImageURI:          logoURI,
+			AccessibilityText: "We Collect We Share",
+		}).
+		Choices(eventsTitle, choices)
+	if known != nil {
+		b.Context(known)
+	}
+	return b.Build(), nil
+}
+
+func (s *Server) addLocationPermissionRequest(e echo.Context, dr dialogflow.Request) (*dialogflow.Fulfillment, error) {
+	address, err := DoesExistParams(dr, "address")
+	if err != nil {
+		return nil, err
+	}
+	any, err := DoesExistParams(dr, "any")
+	if err != nil {
+		return nil, err
+	}
+	if address == any {
+		return dialogflow.NewBuilder(dr).AskLocation("To send a volunteer to pick it up").Build(), nil
+	}
+	return nil, errMissingParams
+}
+
+func (s *Server) permissionHander(e echo.Context, dr dialogflow.Request) (*dialogflow.Fulfillment, error) {
+
+	address, err := DoesExistParams(dr, "address")
+	if err != nil {
+		return nil, err
+	}
+	if address {
+		trans := Transactions{}
+		var dfContext map[string]interface{}
+		err := dr.GetContext("information", &dfContext)
+		if err != nil {
+			return nil, err
+		}
+		phone, _ := dfContext["phone-number"].(string)
+		submission := Submission{Session: dr.Session, Sender: dr.SenderID(), Phone: phone}
+		release, ok := s.reserveDonation(e.Request().Context(), submission)
+		if !ok {
+			return dialogflow.NewBuilder(dr).Text(tooManyDonations).EndConversation().Build(), nil
+		}
+		created := false
+		defer func() {
+			if !created {
+				release()
+			}
+		}()
+		switch dialogflow.PlatformOf(dr) {
+		case dialogflow.Facebook, dialogflow.Telegram:
+			coordinates, err := SharedCoordinates(dr)
+			if err != nil {
+				return nil, err
+			}
+			address, err := s.Geocoder.Address(e.Request().Context(), coordinates)
+			if err != nil {
+				return nil, err
+			}
+			trans = Transactions{
+				Description:     dfContext["description"].(string),
+				GiverName:       dfContext["person"].(map[string]interface{})["name"].(string),
+				PhoneNumber:     dfContext["phone-number"].(string),
+				Address:         address,
+				Long:            coordinates.Longitude,
+				Lat:             coordinates.Latitude,
+				CreatedDate:     time.Now().Unix(),
+				Status:          "pending",
+				TransactionTime: dfContext["transaction-time.original"].(string),
+				EventId:         0,
+			}
+		default:
+			if dr.OriginalDetectIntentRequest.Payload.Device == nil {
+				return nil, errNoLocation
+			}
+			userLocation := dr.OriginalDetectIntentRequest.Payload.Device.LocationInfo
+			address, err := s.Geocoder.Address(e.Request().Context(), userLocation.Coordinates)
+			if err != nil {
+				return nil, err
+			}
+			trans = Transactions{
+				Description:     dfContext["any"].(string),
+				GiverName:       personName(dfContext),
+				PhoneNumber:     dfContext["phone-number"].(string),
+				Address:         address,
+				Long:            userLocation.Coordinates.Longitude,
+				Lat:             userLocation.Coordinates.Latitude,
+				CreatedDate:     time.Now().Unix(),
+				Status:          "pending",
+				TransactionTime: dfContext["transaction-time"].(map[string]interface{})["transaction-time"].(string),
+				EventId:         dfContext["event-number"].(float64),
+			}
+		}
+		keys, account := s.donorKeys(e.Request().Context(), dr)
+		if trans.GiverName == "" && account != nil {
+			trans.GiverName = account.Name
+		}
+		trans.Email = slotEmail(dfContext)
+		if trans.Email == "" {
+			trans.Email = verifiedEmail(account)
+		}
+		trans.ReceiptPending = trans.Email != ""
+		donor, err := s.recordDonor(e.Request().Context(), keys, trans.GiverName, trans.PhoneNumber, trans.Email, true)
+		if err != nil {
+			logging.FromContext(e.Request().Context()).Error("recording the donor", "err", err)
+		} else if donor != nil {
+			trans.DonorID = donor.ID
+		}
+		id, err := s.Store.AddTransaction(e.Request().Context(), TransactionID(dr.ResponseID), trans)
+		switch {
+		case err == ErrTransactionExists:
+			// a retry of the request which saved the donation, it was
+			// counted and notified then
+		case err != nil:
+			return nil, err
+		default:
+			created = true
+			s.Metrics.transactionCreated(trans, platformLabel(dr))
+			s.notifyCreated(StoredTransaction{ID: id, Transactions: trans})
+			s.publishCreated(StoredTransaction{ID: id, Transactions: trans})
+		}
+		thanksAnswer := GetThanksAnswer(trans.GiverName)
+		return dialogflow.NewBuilder(dr).
+			Speech(GetConfirmationText(thanksAnswer, trans), GetConfirmationSpeech(thanksAnswer, trans)).
+			ResetContexts().
+			Build(), nil
+	}
+	return nil, errMissingParams
+}
+
+// slotEmail returns the email the user gave for their receipt, the slot is
+// optional
+func slotEmail(dfContext map[string]interface{}) string {
+	email, _ := dfContext["email"].(string)
+	if !notify.ValidEmail(email) {
+		return ""
+	}
+	return strings.TrimSpace(email)
+}
+
+// personName returns the name the user gave, empty when the agent didn't ask
+// for it because they signed in
+func personName(dfContext map[string]interface{}) string {
+	person, _ := dfContext["person"].(map[string]interface{})
+	name, _ := person["name"].(string)
+	return name
+}
@@ -0,0 +1,64 @@
+package webhook
+
+import (
+	"context"
+	"net/http"
+	"sync/atomic"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+// readyTimeout bounds each check of the readiness probe
+const readyTimeout = 3 * time.Second
+
+// Pinger is implemented by the dependencies which can tell whether they are
+// reachable, the others are assumed to be
+type Pinger interface {
+	Ping(ctx context.Context) error
+}
+
+// Health is the answer of the probes
+type Health struct {
+	Status string            `json:"status"`
+	Checks map[string]string `json:"checks,omitempty"`
+}
+
+// Drain makes the server report it isn't ready, so that the load balancer
+// stops sending it requests before it shuts down
+func (s *Server) Drain() {
+	atomic.StoreInt32(&s.draining, 1)
+}
+
+// healthz tells the process is alive
+func (s *Server) healthz(e echo.Context) error {
+	return e.JSON(http.StatusOK, Health{Status: "ok"})
+}
+
+// readyz tells whether the store and the geocoder can be reached
+func (s *Server) readyz(e echo.Context) error {
+	health := Health{Status: "ok", Checks: map[string]string{}}
+	if atomic.LoadInt32(&s.draining) == 1 {
+		health.Status = "draining"
+	}
+	for name, dep := range map[string]interface{}{"store": s.Store, "geocoder": s.Geocoder} {
+		health.Checks[name] = "ok"
+		p, ok := dep.(Pinger)
+		if !ok {
+			continue
+		}
+		ctx, cancel := context.WithTimeout(e.Request().Context(), readyTimeout)
+		err := p.Ping(ctx)
+		cancel()
+		if err != nil {
+			health.Checks[name] = err.Error()
+			if health.Status == "ok" {
+				health.Status = "unavailable"
+			}
+		}
+	}
+	if health.Status != "ok" {
+		return e.JSON(http.StatusServiceUnavailable, health)
+	}
+	return e.JSON(http.StatusOK, health)
+}
@@ -0,0 +1,98 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"wcws/dialogflow"
+)
+
+type pingStore struct {
+	*MemoryStore
+	err error
+}
+
+func (s *pingStore) Ping(ctx context.Context) error {
+	return s.err
+}
+
+func probe(t *testing.T, e *echo.Echo, path string) (int, Health) {
+	req := httptest.NewRequest(http.MethodGet, path, nil)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	var health Health
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &health))
+	return rec.Code, health
+}
+
+func TestProbes(t *testing.T) {
+	store := &pingStore{MemoryStore: NewMemoryStore(nil)}
+	srv := New(store, GeocoderFunc(func(ctx context.Context, c dialogflow.Coordinates) (string, error) {
+		return "", nil
+	}))
+	e := echo.New()
+	srv.Register(e)
+
+	code, health := probe(t, e, "/healthz")
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, "ok", health.Status)
+
+	code, health = probe(t, e, "/readyz")
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, map[string]string{"store": "ok", "geocoder": "ok"}, health.Checks)
+
+	store.err = errors.New("firestore unreachable")
+	code, health = probe(t, e, "/readyz")
+	assert.Equal(t, http.StatusServiceUnavailable, code)
+	assert.Equal(t, "unavailable", health.Status)
+	assert.Equal(t, "firestore unreachable", health.Checks["store"])
+
+	store.err = nil
+	srv.Drain()
+	code, health = probe(t, e, "/readyz")
+	assert.Equal(t, http.StatusServiceUnavailable, code)
+	assert.Equal(t, "draining", health.Status)
+	code, _ = probe(t, e, "/healthz")
+	assert.Equal(t, http.StatusOK, code, "a draining server is alive")
+}
+
+func TestOpenCagePing(t *testing.T) {
+	var calls, failing int32 = 0, 1
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		if atomic.LoadInt32(&failing) == 1 {
+			w.WriteHeader(http.StatusPaymentRequired)
+			return
+		}
+		w.Write([]byte(`{"results": [{"formatted": "Da Nang"}]}`))
+	}))
+	defer api.Close()
+	g := NewOpenCage("key")
+	g.BaseURL = api.URL
+	ctx := context.Background()
+
+	assert.NoError(t, g.Ping(ctx), "no lookup yet")
+	assert.Equal(t, int32(0), atomic.LoadInt32(&calls))
+
+	_, err := g.Address(ctx, dialogflow.Coordinates{})
+	assert.Error(t, err)
+	assert.Error(t, g.Ping(ctx))
+	assert.Equal(t, int32(2), atomic.LoadInt32(&calls), "a failing API is probed")
+
+	atomic.StoreInt32(&failing, 0)
+	assert.Error(t, g.Ping(ctx), "probed at most once a minute")
+	assert.Equal(t, int32(2), atomic.LoadInt32(&calls))
+
+	_, err = g.Address(ctx, dialogflow.Coordinates{})
+	assert.NoError(t, err)
+	assert.NoError(t, g.Ping(ctx))
+}
@@ -0,0 +1,110 @@
+package webhook
+
+import (
+	"fmt"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"wcws/dialogflow"
+)
+
+func DoesExistParams(df dialogflow.Request, param string) (bool, error) {
+	if len(df.QueryResult.Parameters) > 0 {
+		for k, v := range df.QueryResult.Parameters {
+			if k == param && v != "" {
+				return true, nil
+			}
+		}
+	}
+	var contextParams interface{}
+	err := df.GetContext("information", &contextParams)
+	if err != nil {
+		return false, err
+	}
+	for k, v := range contextParams.(map[string]interface{}) {
+		switch v.(type) {
+		case string:
+			if k == param && v != "" {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
+// randIntn picks the thanks answer, tests replace it to get stable output
+var randIntn = rand.Intn
+
+func GetThanksAnswer(name string) string {
+	thanksArr := []string{
+		fmt.Sprintf("Great! thank you %s", name),
+		fmt.Sprintf("Thank you so much %s, have a good day!", name),
+		fmt.Sprintf("Thank you very much, %s!", name),
+		fmt.Sprintf("Glad you contacted us, %s, thank you!", name),
+		fmt.Sprintf("Glad to have you, %s, thanks for doing great things", name),
+	}
+	tt := len(thanksArr)
+	index := randIntn(tt)
+	return thanksArr[index]
+}
+
+// GetConfirmationText appends the pickup details of the transaction to the
+// thanks answer
+func GetConfirmationText(thanks string, trans Transactions) string {
+	when := trans.TransactionTime
+	if t, err := time.Parse(time.RFC3339, trans.TransactionTime); err == nil {
+		when = t.Format("Jan 2, 2006 at 3:04 PM")
+	}
+	return fmt.Sprintf("%s We will call you at %s to pick it up on %s.", thanks, trans.PhoneNumber, when)
+}
+
+// GetConfirmationSpeech is the spoken version of GetConfirmationText, so that
+// the phone number and the pickup time are read correctly on voice devices
+func GetConfirmationSpeech(thanks string, trans Transactions) *dialogflow.SSML {
+	speech := dialogflow.NewSSML().
+		Text(thanks).
+		Break(300 * time.Millisecond).
+		Text("We will call you at ").
+		Telephone(trans.PhoneNumber).
+		Text(" to pick it up on ")
+	if t, err := time.Parse(time.RFC3339, trans.TransactionTime); err == nil {
+		return speech.Date(t).Text(" at ").Time(t).Text(".")
+	}
+	return speech.Text(trans.TransactionTime + ".")
+}
+
+// SharedCoordinates returns the location the user shared on a messaging
+// platform: a location quick reply on Messenger or a location message on
+// Telegram
+func SharedCoordinates(dr dialogflow.Request) (dialogflow.Coordinates, error) {
+	if dialogflow.PlatformOf(dr) == dialogflow.Telegram {
+		return dr.TelegramLocation()
+	}
+	postBack, ok := dr.OriginalDetectIntentRequest.Payload.PostBack.(map[string]interface{})
+	if !ok {
+		return dialogflow.Coordinates{}, errNoLocation
+	}
+	data, ok := postBack["data"].(map[string]interface{})
+	if !ok {
+		return dialogflow.Coordinates{}, errNoLocation
+	}
+	lat, _ := data["lat"].(string)
+	long, _ := data["long"].(string)
+	latitude, err := strconv.ParseFloat(lat, 64)
+	if err != nil {
+		return dialogflow.Coordinates{}, err
+	}
+	longitude, err := strconv.ParseFloat(long, 64)
+	if err != nil {
+		return dialogflow.Coordinates{}, err
+	}
+	return dialogflow.Coordinates{Latitude: latitude, Longitude: longitude}, nil
+}
+
+func ErrResponse(e echo.Context) error {
+	return e.JSON(http.StatusOK, nil)
+}
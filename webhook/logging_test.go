@@ -0,0 +1,43 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+
+	"wcws/logging"
+)
+
+// donorFailingStore fails to save the donors with an error quoting their
+// phone number and location
+type donorFailingStore struct {
+	Store
+}
+
+func (s donorFailingStore) SaveDonor(ctx context.Context, donor *Donor) error {
+	return errors.New("cannot save the donor with phone 0905123456 at 16.074345,108.223851")
+}
+
+func TestLogsRedacted(t *testing.T) {
+	var buf bytes.Buffer
+	srv := goldenServer()
+	srv.Store = donorFailingStore{srv.Store}
+	e := echo.New()
+	e.Use(logging.Middleware(logging.New(&buf, logging.Info, logging.DefaultRedactor)))
+	srv.Register(e)
+
+	postWebhook(t, e, messengerPermission)
+
+	logs := buf.String()
+	assert.Contains(t, logs, `"msg":"recording the donor"`)
+	assert.Contains(t, logs, `"action":"getPermission"`)
+	assert.Contains(t, logs, `"responseId":`)
+	assert.Contains(t, logs, `"session":`)
+	assert.Contains(t, logs, `*******456 at 16.07,108.22`)
+	assert.NotContains(t, logs, "0905123456")
+	assert.NotContains(t, logs, "16.074345")
+}
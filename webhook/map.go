@@ -0,0 +1,226 @@
+package webhook
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"wcws/geo"
+)
+
+// mapQuery is what the map asks for: the donations with the status, the
+// open ones when it is empty and all of them when it is "all", in the box,
+// clustered for the zoom level
+type mapQuery struct {
+	status string
+	bbox   geo.BBox
+	zoom   int
+}
+
+func parseMapQuery(e echo.Context) (mapQuery, error) {
+	q := mapQuery{status: e.QueryParam("status")}
+	var err error
+	if bbox := e.QueryParam("bbox"); bbox != "" {
+		if q.bbox, err = geo.ParseBBox(bbox); err != nil {
+			return q, err
+		}
+	}
+	// the donations are always clustered, the exact location of a donor is
+	// never served
+	zoom := e.QueryParam("zoom")
+	if q.zoom, err = strconv.Atoi(zoom); err != nil || q.zoom < 0 || q.zoom > geo.MaxZoom {
+		return q, fmt.Errorf("invalid zoom %q, expected 0 to %d", zoom, geo.MaxZoom)
+	}
+	return q, nil
+}
+
+// pickupFeature is the donation on the map, without the personal data of
+// the donor
+func pickupFeature(t StoredTransaction) geo.Feature {
+	return geo.NewFeature(t.Lat, t.Long, map[string]interface{}{
+		"kind":            "pickup",
+		"id":              t.ID,
+		"status":          t.Status,
+		"eventId":         t.EventId,
+		"createdDate":     t.CreatedDate,
+		"transactionTime": t.TransactionTime,
+	})
+}
+
+func eventFeature(e Event) geo.Feature {
+	return geo.NewFeature(e.Lat, e.Long, map[string]interface{}{
+		"kind":    "event",
+		"name":    e.Name,
+		"address": e.Address,
+		"time":    e.Time.Format(time.RFC3339),
+	})
+}
+
+// mapFeatures serves the donations and the active events located in the box
+// of the query as GeoJSON. The donations are clustered by status for the
+// zoom of the query, a lone donation at the center of its cell, the events
+// never are
+func (s *Server) mapFeatures(e echo.Context) error {
+	q, err := parseMapQuery(e)
+	if err != nil {
+		return e.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+	ctx := e.Request().Context()
+
+	clusters := geo.NewClusterer(q.zoom, "status")
+	add := func(t StoredTransaction) error {
+		if (t.Lat == 0 && t.Long == 0) || !q.bbox.Contains(t.Lat, t.Long) {
+			return nil
+		}
+		clusters.Add(pickupFeature(t))
+		return nil
+	}
+	switch q.status {
+	case "":
+		pickups, err := s.Store.OpenPickups(ctx)
+		if err != nil {
+			return err
+		}
+		for _, t := range pickups {
+			add(t)
+		}
+	case "all":
+		err = s.Store.EachTransaction(ctx, TransactionFilter{}, add)
+	default:
+		err = s.Store.EachTransaction(ctx, TransactionFilter{Status: q.status}, add)
+	}
+	if err != nil {
+		return err
+	}
+	features := clusters.Features()
+
+	events, err := s.Store.ActiveEvents(ctx)
+	if err != nil {
+		return err
+	}
+	for _, ev := range events {
+		if (ev.Lat != 0 || ev.Long != 0) && q.bbox.Contains(ev.Lat, ev.Long) {
+			features = append(features, eventFeature(ev))
+		}
+	}
+
+	body, err := json.Marshal(geo.NewFeatureCollection(features))
+	if err != nil {
+		return err
+	}
+	return e.Blob(http.StatusOK, "application/geo+json", body)
+}
+
+// mapPage is the map of the features, reloaded as it is moved. It keeps the
+// token and the status of its own query, so it can be embedded with them
+var mapPage = template.Must(template.New("map").Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<meta name="viewport" content="width=device-width, initial-scale=1">
+<title>Pickups</title>
+<link rel="stylesheet" href="https://unpkg.com/leaflet@1.6.0/dist/leaflet.css" integrity="sha512-xwE/Az9zrjBIphAcBb3F6JVqxf46+CDLwfLMHloNu6KEQCAWi6HcDUbeOfBIptF7tcCzusKFjFw2yuvEpDL9wQ==" crossorigin="">
+<script src="https://unpkg.com/leaflet@1.6.0/dist/leaflet.js" integrity="sha512-gZwIG9x3wUXg2hdXF6+rVkLF/0Vi9U8D2Ntg4Ga5I5BZpVkVxlJWbSQtXPSiUTtC0TjtGOmxa1AJPuV0CPthew==" crossorigin=""></script>
+<style>
+html, body, #map { height: 100%; margin: 0; }
+.cluster { border-radius: 50%; background: rgba(214, 69, 65, 0.75); color: #fff; font: bold 12px sans-serif; display: flex; align-items: center; justify-content: center; }
+</style>
+</head>
+<body>
+<div id="map"></div>
+<script>
+var token = {{.Token}}, status = {{.Status}};
+var colors = {pending: "#d64541", assigned: "#f39c12", done: "#27ae60"};
+var map = L.map("map").setView([16.0544, 108.2022], 12);
+L.tileLayer("https://{s}.tile.openstreetmap.org/{z}/{x}/{y}.png", {
+  maxZoom: 19,
+  attribution: "&copy; OpenStreetMap contributors"
+}).addTo(map);
+var layer = L.layerGroup().addTo(map);
+
+function describe(p) {
+  if (p.kind === "event") {
+    return p.name + ", " + p.address;
+  }
+  if (p.cluster) {
+    return p.count + " pickups: " + Object.keys(p.status).map(function (s) { return p.status[s] + " " + s; }).join(", ");
+  }
+  return "Pickup " + p.status + (p.transactionTime ? ", " + p.transactionTime : "");
+}
+
+function marker(f) {
+  var p = f.properties, at = [f.geometry.coordinates[1], f.geometry.coordinates[0]];
+  if (p.kind === "event") {
+    return L.marker(at);
+  }
+  if (p.cluster) {
+    var size = Math.round(24 + 8 * Math.log(p.count));
+    return L.marker(at, {icon: L.divIcon({className: "cluster", html: String(p.count), iconSize: [size, size]})});
+  }
+  return L.circleMarker(at, {radius: 7, weight: 1, color: "#fff", fillColor: colors[p.status] || "#7f8c8d", fillOpacity: 0.9});
+}
+
+function load() {
+  var b = map.getBounds();
+  var query = "zoom=" + map.getZoom() + "&bbox=" + [b.getWest(), b.getSouth(), b.getEast(), b.getNorth()].map(function (v) { return v.toFixed(5); }).join(",");
+  if (status) {
+    query += "&status=" + encodeURIComponent(status);
+  }
+  var headers = token ? {Authorization: "Bearer " + token} : {};
+  fetch("/map/features?" + query, {headers: headers}).then(function (res) {
+    return res.json();
+  }).then(function (collection) {
+    layer.clearLayers();
+    collection.features.forEach(function (f) {
+      var popup = document.createElement("div");
+      popup.textContent = describe(f.properties);
+      marker(f).bindPopup(popup).addTo(layer);
+    });
+  });
+}
+
+map.on("moveend", load);
+load();
+</script>
+</body>
+</html>
+`))
+
+// showMap renders the map page
+func (s *Server) showMap(e echo.Context) error {
+	var page strings.Builder
+	err := mapPage.Execute(&page, map[string]string{"Token": e.QueryParam("token"), "Status": e.QueryParam("status")})
+	if err != nil {
+		return err
+	}
+	return e.HTML(http.StatusOK, page.String())
+}
+
+// mapAuth lets in the requests with the map token, as a bearer token or in
+// the token parameter, since an embedded page can't send headers
+func (s *Server) mapAuth(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(e echo.Context) error {
+		key := e.QueryParam("token")
+		if auth := e.Request().Header.Get(echo.HeaderAuthorization); strings.HasPrefix(auth, "Bearer ") {
+			key = strings.TrimPrefix(auth, "Bearer ")
+		}
+		if subtle.ConstantTimeCompare([]byte(key), []byte(s.MapToken)) != 1 {
+			return echo.ErrUnauthorized
+		}
+		return next(e)
+	}
+}
+
+// registerMap adds the map routes for the volunteers
+func (s *Server) registerMap(e *echo.Echo) {
+	m := e.Group("/map", s.mapAuth)
+	m.GET("", s.showMap)
+	m.GET("/features", s.mapFeatures)
+}
@@ -0,0 +1,129 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"wcws/geo"
+)
+
+func mapServer(t *testing.T) *echo.Echo {
+	ict := time.FixedZone("ICT", 7*60*60)
+	store := NewMemoryStore([]Event{
+		{Name: "Winter clothes", Address: "12 Bach Dang, Da Nang", Status: true, Time: time.Date(2019, 12, 1, 9, 0, 0, 0, ict), Lat: 16.0717, Long: 108.2244},
+		{Name: "Books for kids", Address: "5 Tran Phu, Da Nang", Status: true, Time: time.Date(2019, 12, 8, 9, 0, 0, 0, ict)},
+	})
+	for id, trans := range map[string]Transactions{
+		"t-1": {Status: "pending", GiverName: "Minh", PhoneNumber: "0905123456", Lat: 16.0600, Long: 108.2200},
+		"t-2": {Status: "assigned", GiverName: "Hoa", Lat: 16.0620, Long: 108.2210},
+		"t-3": {Status: "done", GiverName: "Lan", Lat: 16.0610, Long: 108.2230},
+		"t-4": {Status: "pending", GiverName: "Tuan", Lat: 10.7769, Long: 106.7009},
+		"t-5": {Status: "pending", GiverName: "Mai"},
+	} {
+		_, err := store.AddTransaction(context.Background(), id, trans)
+		require.NoError(t, err)
+	}
+	srv := New(store, nil)
+	srv.MapToken = "map-token"
+	e := echo.New()
+	srv.Register(e)
+	return e
+}
+
+func mapRequest(t *testing.T, e *echo.Echo, path string) geo.FeatureCollection {
+	req := httptest.NewRequest(http.MethodGet, path, nil)
+	req.Header.Set(echo.HeaderAuthorization, "Bearer map-token")
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	assert.Equal(t, "application/geo+json", rec.Header().Get(echo.HeaderContentType))
+	assert.NotContains(t, rec.Body.String(), "0905123456")
+	assert.NotContains(t, rec.Body.String(), "Minh")
+	var c geo.FeatureCollection
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &c))
+	return c
+}
+
+func featureIDs(c geo.FeatureCollection) []interface{} {
+	var ids []interface{}
+	for _, f := range c.Features {
+		if f.Properties["kind"] == "event" {
+			ids = append(ids, f.Properties["name"])
+		} else {
+			ids = append(ids, f.Properties["id"])
+		}
+	}
+	return ids
+}
+
+func TestMapFeatures(t *testing.T) {
+	e := mapServer(t)
+
+	c := mapRequest(t, e, "/map/features?zoom=20")
+	assert.Equal(t, "FeatureCollection", c.Type)
+	assert.ElementsMatch(t, []interface{}{"t-1", "t-2", "t-4", "Winter clothes"}, featureIDs(c),
+		"the open pickups and the events with a location")
+
+	c = mapRequest(t, e, "/map/features?status=done&zoom=20")
+	assert.ElementsMatch(t, []interface{}{"t-3", "Winter clothes"}, featureIDs(c))
+	assert.NotEqual(t, [2]float64{108.2230, 16.0610}, c.Features[0].Geometry.Coordinates, "the location of the donor isn't exact")
+	assert.InDelta(t, 108.2230, c.Features[0].Geometry.Coordinates[0], 0.002)
+	assert.InDelta(t, 16.0610, c.Features[0].Geometry.Coordinates[1], 0.002)
+
+	c = mapRequest(t, e, "/map/features?status=all&bbox=108.1,15.9,108.3,16.1&zoom=20")
+	assert.ElementsMatch(t, []interface{}{"t-1", "t-2", "t-3", "Winter clothes"}, featureIDs(c))
+
+	c = mapRequest(t, e, "/map/features?status=all&bbox=108.1,15.9,108.3,16.1&zoom=12")
+	require.Len(t, c.Features, 2)
+	cluster := c.Features[0].Properties
+	assert.Equal(t, true, cluster["cluster"])
+	assert.Equal(t, 3.0, cluster["count"])
+	assert.Equal(t, map[string]interface{}{"pending": 1.0, "assigned": 1.0, "done": 1.0}, cluster["status"])
+	assert.Equal(t, "event", c.Features[1].Properties["kind"], "the events aren't clustered")
+
+	for _, query := range []string{"bbox=108.1,15.9&zoom=12", "status=all", "zoom=-1", "zoom=21", "zoom=close"} {
+		req := httptest.NewRequest(http.MethodGet, "/map/features?"+query, nil)
+		req.Header.Set(echo.HeaderAuthorization, "Bearer map-token")
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+		assert.Equal(t, http.StatusBadRequest, rec.Code, query)
+	}
+}
+
+func TestMapPage(t *testing.T) {
+	e := mapServer(t)
+
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/map?token=map-token&status=%3C/script%3E", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Header().Get(echo.HeaderContentType), "text/html")
+	assert.Contains(t, rec.Body.String(), `var token = "map-token"`)
+	assert.Contains(t, rec.Body.String(), `/map/features?`)
+	assert.NotContains(t, rec.Body.String(), `"</script>"`, "the status is escaped")
+	assert.Equal(t, 2, strings.Count(rec.Body.String(), `integrity="sha512-`), "leaflet is checked")
+
+	rec = httptest.NewRecorder()
+	e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/map/features?token=map-token&zoom=12", nil))
+	assert.Equal(t, http.StatusOK, rec.Code, "the embedded page has no header")
+
+	for _, path := range []string{"/map", "/map?token=guess", "/map/features"} {
+		rec = httptest.NewRecorder()
+		e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+		assert.Equal(t, http.StatusUnauthorized, rec.Code, path)
+	}
+
+	rec = httptest.NewRecorder()
+	e = echo.New()
+	New(NewMemoryStore(nil), nil).Register(e)
+	e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/map", nil))
+	assert.Equal(t, http.StatusNotFound, rec.Code, "the map is disabled without a token")
+}
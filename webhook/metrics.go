@@ -0,0 +1,220 @@
+package webhook
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+
+	"wcws/dialogflow"
+	"wcws/notify"
+)
+
+// Metrics are the Prometheus metrics of the webhook. A nil *Metrics records
+// nothing
+type Metrics struct {
+	registry *prometheus.Registry
+
+	requests         *prometheus.CounterVec
+	requestDuration  *prometheus.HistogramVec
+	storeWrites      *prometheus.HistogramVec
+	storeErrors      *prometheus.CounterVec
+	geocoder         *prometheus.HistogramVec
+	geocoderFailures *prometheus.CounterVec
+	transactions     *prometheus.CounterVec
+}
+
+// NewMetrics returns the metrics of the webhook in their own registry, along
+// with the metrics of the Go runtime and of the process
+func NewMetrics() *Metrics {
+	m := &Metrics{
+		registry: prometheus.NewRegistry(),
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "wcws_webhook_requests_total",
+			Help: "Webhook calls by action, platform and outcome, success or error.",
+		}, []string{"action", "platform", "outcome"}),
+		requestDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name: "wcws_webhook_request_duration_seconds",
+			Help: "Time to answer the webhook calls by action and platform.",
+		}, []string{"action", "platform"}),
+		storeWrites: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name: "wcws_store_write_duration_seconds",
+			Help: "Latency of the writes to the store by operation.",
+		}, []string{"operation"}),
+		storeErrors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "wcws_store_write_errors_total",
+			Help: "Failed writes to the store by operation.",
+		}, []string{"operation"}),
+		geocoder: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name: "wcws_geocoder_duration_seconds",
+			Help: "Latency of the address lookups by provider.",
+		}, []string{"provider"}),
+		geocoderFailures: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "wcws_geocoder_failures_total",
+			Help: "Failed address lookups by provider.",
+		}, []string{"provider"}),
+		transactions: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "wcws_transactions_created_total",
+			Help: "Donations saved by event and platform, event 0 is no event.",
+		}, []string{"event", "platform"}),
+	}
+	m.registry.MustRegister(
+		prometheus.NewGoCollector(),
+		prometheus.NewProcessCollector(prometheus.ProcessCollectorOpts{}),
+		m.requests, m.requestDuration,
+		m.storeWrites, m.storeErrors,
+		m.geocoder, m.geocoderFailures,
+		m.transactions,
+	)
+	return m
+}
+
+// Handler serves the metrics to Prometheus
+func (m *Metrics) Handler() http.Handler {
+	return promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{})
+}
+
+// WatchActiveEvents exposes the number of active events of the store, read
+// when the metrics are scraped
+func (m *Metrics) WatchActiveEvents(store Store) {
+	m.registry.MustRegister(prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+		Name: "wcws_active_events",
+		Help: "Events with an active status.",
+	}, func() float64 {
+		ctx, cancel := context.WithTimeout(context.Background(), readyTimeout)
+		defer cancel()
+		events, err := store.ActiveEvents(ctx)
+		if err != nil {
+			return -1
+		}
+		return float64(len(events))
+	}))
+}
+
+// platformLabel is the platform of the request as written in the labels
+func platformLabel(dr dialogflow.Request) string {
+	return string(dialogflow.PlatformOf(dr))
+}
+
+func (m *Metrics) observeRequest(action, platform string, start time.Time, err error) {
+	if m == nil {
+		return
+	}
+	outcome := "success"
+	if err != nil {
+		outcome = "error"
+	}
+	m.requests.WithLabelValues(action, platform, outcome).Inc()
+	m.requestDuration.WithLabelValues(action, platform).Observe(time.Since(start).Seconds())
+}
+
+func (m *Metrics) transactionCreated(trans Transactions, platform string) {
+	if m == nil {
+		return
+	}
+	m.transactions.WithLabelValues(strconv.FormatFloat(trans.EventId, 'f', -1, 64), platform).Inc()
+}
+
+// InstrumentStore measures the writes to the store
+func (m *Metrics) InstrumentStore(store Store) Store {
+	return &instrumentedStore{Store: store, m: m}
+}
+
+type instrumentedStore struct {
+	Store
+	m *Metrics
+}
+
+func (s *instrumentedStore) observe(operation string, start time.Time, err error) {
+	s.m.storeWrites.WithLabelValues(operation).Observe(time.Since(start).Seconds())
+	if err != nil {
+		s.m.storeErrors.WithLabelValues(operation).Inc()
+	}
+}
+
+func (s *instrumentedStore) AddTransaction(ctx context.Context, id string, trans Transactions) (string, error) {
+	start := time.Now()
+	id, err := s.Store.AddTransaction(ctx, id, trans)
+	observed := err
+	if err == ErrTransactionExists {
+		// the retries of Dialogflow aren't failures of the store
+		observed = nil
+	}
+	s.observe("add_transaction", start, observed)
+	return id, err
+}
+
+func (s *instrumentedStore) SaveDonor(ctx context.Context, donor *Donor) error {
+	start := time.Now()
+	err := s.Store.SaveDonor(ctx, donor)
+	s.observe("save_donor", start, err)
+	return err
+}
+
+func (s *instrumentedStore) ClaimNotification(ctx context.Context, id string, event notify.Event, now, until time.Time) (bool, error) {
+	start := time.Now()
+	claimed, err := s.Store.ClaimNotification(ctx, id, event, now, until)
+	s.observe("claim_notification", start, err)
+	return claimed, err
+}
+
+func (s *instrumentedStore) ReleaseNotification(ctx context.Context, id string, event notify.Event) error {
+	start := time.Now()
+	err := s.Store.ReleaseNotification(ctx, id, event)
+	s.observe("release_notification", start, err)
+	return err
+}
+
+func (s *instrumentedStore) MarkNotified(ctx context.Context, id string, event notify.Event) error {
+	start := time.Now()
+	err := s.Store.MarkNotified(ctx, id, event)
+	s.observe("mark_notified", start, err)
+	return err
+}
+
+func (s *instrumentedStore) MarkPublished(ctx context.Context, id, status string) error {
+	start := time.Now()
+	err := s.Store.MarkPublished(ctx, id, status)
+	s.observe("mark_published", start, err)
+	return err
+}
+
+// Ping pings the store when it can be pinged
+func (s *instrumentedStore) Ping(ctx context.Context) error {
+	if p, ok := s.Store.(Pinger); ok {
+		return p.Ping(ctx)
+	}
+	return nil
+}
+
+// InstrumentGeocoder measures the lookups of the geocoder of the provider
+func (m *Metrics) InstrumentGeocoder(geocoder Geocoder, provider string) Geocoder {
+	return &instrumentedGeocoder{Geocoder: geocoder, provider: provider, m: m}
+}
+
+type instrumentedGeocoder struct {
+	Geocoder
+	provider string
+	m        *Metrics
+}
+
+func (g *instrumentedGeocoder) Address(ctx context.Context, c dialogflow.Coordinates) (string, error) {
+	start := time.Now()
+	address, err := g.Geocoder.Address(ctx, c)
+	g.m.geocoder.WithLabelValues(g.provider).Observe(time.Since(start).Seconds())
+	if err != nil {
+		g.m.geocoderFailures.WithLabelValues(g.provider).Inc()
+	}
+	return address, err
+}
+
+// Ping pings the geocoder when it can be pinged
+func (g *instrumentedGeocoder) Ping(ctx context.Context) error {
+	if p, ok := g.Geocoder.(Pinger); ok {
+		return p.Ping(ctx)
+	}
+	return nil
+}
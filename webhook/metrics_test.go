@@ -0,0 +1,68 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"wcws/dialogflow"
+)
+
+func TestMetrics(t *testing.T) {
+	srv := goldenServer()
+	metrics := NewMetrics()
+	srv.Store = metrics.InstrumentStore(srv.Store)
+	metrics.WatchActiveEvents(srv.Store)
+	failing := metrics.InstrumentGeocoder(GeocoderFunc(func(ctx context.Context, c dialogflow.Coordinates) (string, error) {
+		return "", errors.New("quota exceeded")
+	}), "opencage")
+	srv.Geocoder = metrics.InstrumentGeocoder(srv.Geocoder, "opencage")
+	srv.Metrics = metrics
+	e := echo.New()
+	srv.Register(e)
+
+	body, err := ioutil.ReadFile("testdata/golden/google_permission.request.json")
+	require.NoError(t, err)
+	postWebhook(t, e, string(body))
+	// the anonymous donors of Actions on Google aren't recorded
+	postWebhook(t, e, messengerPermission)
+	postWebhook(t, e, `{"responseId": "m-1", "queryResult": {"action": "made-up"}}`)
+	_, err = failing.Address(context.Background(), dialogflow.Coordinates{})
+	require.Error(t, err)
+
+	req := httptest.NewRequest(http.MethodGet, "/metrics", nil)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusOK, rec.Code)
+	scraped := rec.Body.String()
+	for _, line := range []string{
+		`wcws_webhook_requests_total{action="getPermission",outcome="success",platform="ACTIONS_ON_GOOGLE"} 1`,
+		`wcws_webhook_requests_total{action="unknown",outcome="error",platform="ACTIONS_ON_GOOGLE"} 1`,
+		`wcws_webhook_request_duration_seconds_count{action="getPermission",platform="ACTIONS_ON_GOOGLE"} 1`,
+		`wcws_webhook_requests_total{action="getPermission",outcome="success",platform="FACEBOOK"} 1`,
+		`wcws_store_write_duration_seconds_count{operation="add_transaction"} 2`,
+		`wcws_store_write_duration_seconds_count{operation="save_donor"} 2`,
+		`wcws_geocoder_duration_seconds_count{provider="opencage"} 3`,
+		`wcws_geocoder_failures_total{provider="opencage"} 1`,
+		`wcws_transactions_created_total{event="1",platform="ACTIONS_ON_GOOGLE"} 1`,
+		`wcws_active_events 2`,
+	} {
+		assert.Contains(t, scraped, line)
+	}
+}
+
+func TestNoMetrics(t *testing.T) {
+	e := echo.New()
+	goldenServer().Register(e)
+	req := httptest.NewRequest(http.MethodGet, "/metrics", nil)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
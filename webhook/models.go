@@ -0,0 +1,68 @@
+package webhook
+
+import "time"
+
+type Transactions struct {
+	Description     string   `json:"description" firestore:"description"`
+	Email           string   `json:"email" firestore:"email"`
+	GiverName       string   `json:"giverName" firestore:"giverName"`
+	PhoneNumber     string   `json:"phoneNumber" firestore:"phoneNumber"`
+	VolunteerId     string   `json:"volunteer" firestore:"volunteerId"`
+	Address         string   `json:"address" firestore:"address"`
+	Long            float64  `json:"lng" firestore:"lng"`
+	Lat             float64  `json:"lat" firestore:"lat"`
+	CreatedDate     int64    `json:"created_date" firestore:"createdDate"`
+	ImageURL        []string `json:"imageURL" firestore:"imageURL"`
+	Status          string   `json:"status" firestore:"status"`
+	TransactionTime string   `json:"transactionTime" firestore:"transactionTime"`
+	EventId         float64  `json:"eventId" firestore:"eventId"`
+	DonorID         string   `json:"donorId,omitempty" firestore:"donorId,omitempty"`
+	// Notified holds the notification events already sent
+	Notified map[string]bool `json:"notified,omitempty" firestore:"notified,omitempty"`
+	// ReceiptPending marks the donations whose donor gave an email and didn't
+	// get the receipt yet
+	ReceiptPending bool `json:"receiptPending,omitempty" firestore:"receiptPending,omitempty"`
+	// Sending holds the notification events being sent, with the Unix time
+	// their claim expires
+	Sending map[string]int64 `json:"sending,omitempty" firestore:"sending,omitempty"`
+	// PublishedStatus is the last status the partners were told about
+	PublishedStatus string `json:"publishedStatus,omitempty" firestore:"publishedStatus,omitempty"`
+}
+
+// Volunteer picks up the donations
+type Volunteer struct {
+	ID          string `json:"id" firestore:"-"`
+	Name        string `json:"name" firestore:"name"`
+	PhoneNumber string `json:"phoneNumber" firestore:"phoneNumber"`
+}
+
+// Donor is someone who donated, recognized by their Messenger page-scoped ID,
+// their verified Google user ID or their Zalo user ID. The phone number is
+// only the last one they gave. Their donations are the transactions with
+// their ID
+type Donor struct {
+	ID           string `json:"id" firestore:"-"`
+	Name         string `json:"name" firestore:"name"`
+	Phone        string `json:"phone,omitempty" firestore:"phone,omitempty"`
+	PSID         string `json:"psid,omitempty" firestore:"psid,omitempty"`
+	GoogleUserID string `json:"googleUserId,omitempty" firestore:"googleUserId,omitempty"`
+	ZaloUserID   string `json:"zaloUserId,omitempty" firestore:"zaloUserId,omitempty"`
+	Email        string `json:"email,omitempty" firestore:"email,omitempty"`
+	CreatedDate  int64  `json:"createdDate" firestore:"createdDate"`
+	LastDonation int64  `json:"lastDonation" firestore:"lastDonation"`
+}
+
+type Person struct {
+	Name string `json:"name"`
+}
+
+type Event struct {
+	Address     string    `json:"address"`
+	Name        string    `json:"name"`
+	Status      bool      `json:"status"`
+	Time        time.Time `json:"time"`
+	Description string    `json:"description"`
+	// Lat and Long are where the event is, it isn't on the map without them
+	Lat  float64 `json:"lat,omitempty"`
+	Long float64 `json:"lng,omitempty"`
+}
@@ -0,0 +1,226 @@
+package webhook
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"wcws/logging"
+	"wcws/notify"
+)
+
+// DefaultReminderBefore is how long before the pickup the donor is reminded
+const DefaultReminderBefore = 2 * time.Hour
+
+// confirmationDelay is how long the confirmation sent in the background of
+// the webhook has to succeed before NotifyPickups sends it again
+const confirmationDelay = time.Minute
+
+// maxConfirmationAge is how old a donation can be for its confirmation to be
+// sent, the donations created before the notifications were deployed aren't
+// confirmed days later
+const maxConfirmationAge = 24 * time.Hour
+
+// maxAssignedAge is how old a donation can be for its volunteer to be told
+// about the pickup, which isn't sent either once the pickup time passed
+const maxAssignedAge = 30 * 24 * time.Hour
+
+// notifyTimeout bounds the notifications sent in the background of a request
+const notifyTimeout = 30 * time.Second
+
+// notifyClaim is how long a notification is claimed by the instance sending
+// it, it is sent again after that if the instance didn't record it was sent
+const notifyClaim = 2 * notifyTimeout
+
+// notifyCreated confirms the donation to the donor without holding the
+// answer to the agent
+func (s *Server) notifyCreated(t StoredTransaction) {
+	if s.Notifier == nil {
+		return
+	}
+	s.background.Add(1)
+	go func() {
+		defer s.background.Done()
+		ctx, cancel := context.WithTimeout(context.Background(), notifyTimeout)
+		defer cancel()
+		s.notify(ctx, t, notify.EventConfirmation, t.PhoneNumber, nil)
+	}()
+}
+
+// claim claims the notification of the event of the transaction, it reports
+// whether this instance sends it
+func (s *Server) claim(ctx context.Context, logger *logging.Logger, t StoredTransaction, event notify.Event) bool {
+	now := time.Now()
+	claimed, err := s.Store.ClaimNotification(ctx, t.ID, event, now, now.Add(notifyClaim))
+	if err != nil {
+		logger.Error("claiming the notification", "err", err)
+		return false
+	}
+	return claimed
+}
+
+// release gives up the claim of the notification, to send it again later
+func (s *Server) release(ctx context.Context, logger *logging.Logger, t StoredTransaction, event notify.Event) {
+	if err := s.Store.ReleaseNotification(ctx, t.ID, event); err != nil {
+		logger.Error("releasing the notification", "err", err)
+	}
+}
+
+// sendReceipt emails the receipt with the donation certificate and records
+// it was sent
+func (s *Server) sendReceipt(ctx context.Context, t StoredTransaction, now time.Time) {
+	logger := logging.FromContext(ctx).With("transactionId", t.ID, "event", notify.EventReceipt)
+	if !s.claim(ctx, logger, t, notify.EventReceipt) {
+		return
+	}
+	email, err := notify.ReceiptEmail(t.Email, pickupOf(t, nil), now)
+	if err != nil {
+		logger.Error("writing the receipt", "err", err)
+		s.release(ctx, logger, t, notify.EventReceipt)
+		return
+	}
+	if err := s.Mailer.Send(ctx, email); err != nil {
+		logger.Error("sending the receipt", "err", err)
+		s.release(ctx, logger, t, notify.EventReceipt)
+		return
+	}
+	if err := s.Store.MarkNotified(ctx, t.ID, notify.EventReceipt); err != nil {
+		logger.Error("recording the receipt was sent", "err", err)
+	}
+}
+
+// sendReceipts emails the receipts of the donations picked up which weren't
+// sent yet
+func (s *Server) sendReceipts(ctx context.Context, now time.Time) error {
+	due, err := s.Store.PendingReceipts(ctx)
+	if err != nil {
+		return err
+	}
+	for _, t := range due {
+		if t.Email != "" && !t.Notified[string(notify.EventReceipt)] {
+			s.sendReceipt(ctx, t, now)
+		}
+	}
+	return nil
+}
+
+// Wait waits for the notifications sent in the background
+func (s *Server) Wait() {
+	s.background.Wait()
+}
+
+// RunNotifications sends the notifications due every interval, until the
+// context is done
+func (s *Server) RunNotifications(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if err := s.NotifyPickups(ctx, time.Now()); err != nil {
+			logging.FromContext(ctx).Error("notifying the pickups", "err", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// NotifyPickups sends the notifications due for the open pickups: the new
+// pickup to the assigned volunteer, the reminder to the donor before the
+// pickup and the confirmations which failed to be sent by the webhook. The
+// old donations aren't confirmed nor sent to their volunteer any more. The
+// donors who gave their email get the receipt once their donation is done
+func (s *Server) NotifyPickups(ctx context.Context, now time.Time) error {
+	if s.Mailer != nil {
+		if err := s.sendReceipts(ctx, now); err != nil {
+			logging.FromContext(ctx).Error("sending the receipts", "err", err)
+		}
+	}
+	if s.Notifier == nil {
+		return nil
+	}
+	pickups, err := s.Store.OpenPickups(ctx)
+	if err != nil {
+		return err
+	}
+	before := s.ReminderBefore
+	if before == 0 {
+		before = DefaultReminderBefore
+	}
+	for _, t := range pickups {
+		age := now.Sub(time.Unix(t.CreatedDate, 0))
+		if !t.Notified[string(notify.EventConfirmation)] && age > confirmationDelay && age <= maxConfirmationAge {
+			s.notify(ctx, t, notify.EventConfirmation, t.PhoneNumber, nil)
+		}
+
+		var volunteer *Volunteer
+		if t.VolunteerId != "" {
+			if volunteer, err = s.Store.Volunteer(ctx, t.VolunteerId); err != nil {
+				logging.FromContext(ctx).Error("reading the volunteer", "transactionId", t.ID, "volunteerId", t.VolunteerId, "err", err)
+				continue
+			}
+		}
+		pickup, pickupErr := time.Parse(time.RFC3339, t.TransactionTime)
+		passed := pickupErr == nil && !pickup.After(now)
+		if volunteer != nil && !t.Notified[string(notify.EventAssigned)] && age <= maxAssignedAge && !passed {
+			s.notify(ctx, t, notify.EventAssigned, volunteer.PhoneNumber, volunteer)
+		}
+
+		if pickupErr != nil || t.Notified[string(notify.EventReminder)] {
+			continue
+		}
+		if pickup.After(now) && pickup.Sub(now) <= before {
+			s.notify(ctx, t, notify.EventReminder, t.PhoneNumber, volunteer)
+		}
+	}
+	return nil
+}
+
+// notify sends the notification of the event, once it is claimed, and
+// records it was sent
+func (s *Server) notify(ctx context.Context, t StoredTransaction, event notify.Event, phone string, volunteer *Volunteer) {
+	p := pickupOf(t, volunteer)
+	logger := logging.FromContext(ctx).With("transactionId", t.ID, "event", event)
+	if !s.claim(ctx, logger, t, event) {
+		return
+	}
+	err := s.Notifier.Notify(ctx, notify.Notification{Event: event, To: InternationalPhone(phone), Pickup: p})
+	if err != nil {
+		logger.Error("sending the notification", "err", err)
+		s.release(ctx, logger, t, event)
+		return
+	}
+	if err := s.Store.MarkNotified(ctx, t.ID, event); err != nil {
+		logger.Error("recording the notification was sent", "err", err)
+	}
+}
+
+func pickupOf(t StoredTransaction, volunteer *Volunteer) notify.Pickup {
+	p := notify.Pickup{
+		ID:              t.ID,
+		Description:     t.Description,
+		GiverName:       t.GiverName,
+		PhoneNumber:     t.PhoneNumber,
+		Address:         t.Address,
+		TransactionTime: t.TransactionTime,
+	}
+	if volunteer != nil {
+		p.VolunteerName = volunteer.Name
+		p.VolunteerPhone = volunteer.PhoneNumber
+	}
+	return p
+}
+
+// InternationalPhone writes a phone number in the E.164 format the SMS
+// gateways expect, national numbers are Vietnamese
+func InternationalPhone(phone string) string {
+	if strings.HasPrefix(strings.TrimSpace(phone), "+") {
+		return "+" + digitsOf(phone)
+	}
+	national := NormalizePhone(phone)
+	if national == "" {
+		return ""
+	}
+	return "+84" + strings.TrimPrefix(national, "0")
+}
@@ -0,0 +1,259 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io/ioutil"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"wcws/notify"
+)
+
+type recordNotifier struct {
+	mu   sync.Mutex
+	fail bool
+	sent []notify.Notification
+}
+
+func (n *recordNotifier) Notify(ctx context.Context, notification notify.Notification) error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if n.fail {
+		return errors.New("gateway down")
+	}
+	n.sent = append(n.sent, notification)
+	return nil
+}
+
+func (n *recordNotifier) events() []string {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	var events []string
+	for _, s := range n.sent {
+		events = append(events, string(s.Event)+" "+s.To)
+	}
+	return events
+}
+
+func TestPickupNotifications(t *testing.T) {
+	notifier := &recordNotifier{}
+	srv := goldenServer()
+	srv.Notifier = notifier
+	store := srv.Store.(*MemoryStore)
+	store.AddVolunteer(Volunteer{ID: "v1", Name: "Lan", PhoneNumber: "0905 000 111"})
+	e := echo.New()
+	srv.Register(e)
+
+	body, err := ioutil.ReadFile("testdata/golden/google_permission.request.json")
+	require.NoError(t, err)
+	postWebhook(t, e, string(body))
+	srv.Wait()
+	assert.Equal(t, []string{"confirmation +84905123456"}, notifier.events())
+	assert.Equal(t, "Hoang", notifier.sent[0].Pickup.GiverName)
+
+	ctx := context.Background()
+	pickup := time.Date(2019, 12, 1, 14, 30, 0, 0, time.FixedZone("ICT", 7*60*60))
+	require.NoError(t, srv.NotifyPickups(ctx, pickup.Add(-3*time.Hour)))
+	assert.Len(t, notifier.sent, 1)
+
+	require.NoError(t, store.AssignVolunteer(TransactionID("golden-google-permission"), "v1"))
+	require.NoError(t, srv.NotifyPickups(ctx, pickup.Add(-3*time.Hour)))
+	require.NoError(t, srv.NotifyPickups(ctx, pickup.Add(-time.Hour)))
+	require.NoError(t, srv.NotifyPickups(ctx, pickup.Add(-time.Minute)))
+	assert.Equal(t, []string{
+		"confirmation +84905123456",
+		"assigned +84905000111",
+		"reminder +84905123456",
+	}, notifier.events())
+	assert.Equal(t, "Lan", notifier.sent[2].Pickup.VolunteerName)
+}
+
+func TestFailedConfirmationIsRetried(t *testing.T) {
+	notifier := &recordNotifier{fail: true}
+	srv := goldenServer()
+	srv.Notifier = notifier
+	ctx := context.Background()
+	created := time.Now()
+	id, err := srv.Store.AddTransaction(ctx, "", Transactions{
+		GiverName:   "Minh",
+		PhoneNumber: "+1 555 0100",
+		CreatedDate: created.Unix(),
+		Status:      "pending",
+	})
+	require.NoError(t, err)
+	srv.notifyCreated(StoredTransaction{ID: id})
+	srv.Wait()
+	assert.Empty(t, notifier.events())
+
+	notifier.fail = false
+	require.NoError(t, srv.NotifyPickups(ctx, created))
+	assert.Empty(t, notifier.events())
+	require.NoError(t, srv.NotifyPickups(ctx, created.Add(2*time.Minute)))
+	require.NoError(t, srv.NotifyPickups(ctx, created.Add(3*time.Minute)))
+	assert.Equal(t, []string{"confirmation +15550100"}, notifier.events())
+}
+
+func TestOldDonationsNotNotified(t *testing.T) {
+	notifier := &recordNotifier{}
+	srv := goldenServer()
+	srv.Notifier = notifier
+	store := srv.Store.(*MemoryStore)
+	store.AddVolunteer(Volunteer{ID: "v1", Name: "Lan", PhoneNumber: "0905 000 111"})
+	ctx := context.Background()
+	now := time.Now()
+	for _, trans := range []Transactions{
+		{PhoneNumber: "0905123456", CreatedDate: now.Add(-40 * 24 * time.Hour).Unix(), Status: "pending", TransactionTime: "tomorrow"},
+		{PhoneNumber: "0905123457", CreatedDate: now.Add(-2 * 24 * time.Hour).Unix(), Status: "pending", TransactionTime: now.Add(-time.Hour).Format(time.RFC3339)},
+	} {
+		id, err := srv.Store.AddTransaction(ctx, "", trans)
+		require.NoError(t, err)
+		require.NoError(t, store.AssignVolunteer(id, "v1"))
+	}
+	require.NoError(t, srv.NotifyPickups(ctx, now))
+	assert.Empty(t, notifier.events())
+}
+
+// slowNotifier holds the notifications long enough for the pollers to race
+type slowNotifier struct {
+	recordNotifier
+}
+
+func (n *slowNotifier) Notify(ctx context.Context, notification notify.Notification) error {
+	time.Sleep(10 * time.Millisecond)
+	return n.recordNotifier.Notify(ctx, notification)
+}
+
+func TestPollersNotifyOnce(t *testing.T) {
+	notifier := &slowNotifier{}
+	srv := goldenServer()
+	srv.Notifier = notifier
+	ctx := context.Background()
+	created := time.Now().Add(-time.Hour)
+	id, err := srv.Store.AddTransaction(ctx, "", Transactions{PhoneNumber: "0905123456", CreatedDate: created.Unix(), Status: "pending"})
+	require.NoError(t, err)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 3; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			assert.NoError(t, srv.NotifyPickups(ctx, time.Now()))
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, []string{"confirmation +84905123456"}, notifier.events())
+
+	// the claim of an instance which stopped before recording the
+	// notification expires
+	store := srv.Store.(*MemoryStore)
+	now := time.Now()
+	claimed, err := store.ClaimNotification(ctx, id, notify.EventReminder, now, now.Add(time.Minute))
+	require.NoError(t, err)
+	assert.True(t, claimed)
+	claimed, _ = store.ClaimNotification(ctx, id, notify.EventReminder, now.Add(30*time.Second), now.Add(time.Minute))
+	assert.False(t, claimed)
+	claimed, _ = store.ClaimNotification(ctx, id, notify.EventReminder, now.Add(time.Minute), now.Add(2*time.Minute))
+	assert.True(t, claimed)
+	require.NoError(t, store.ReleaseNotification(ctx, id, notify.EventReminder))
+	claimed, _ = store.ClaimNotification(ctx, id, notify.EventReminder, now, now.Add(time.Minute))
+	assert.True(t, claimed, "a released notification is claimed again")
+	claimed, _ = store.ClaimNotification(ctx, id, notify.EventConfirmation, now.Add(time.Hour), now.Add(2*time.Hour))
+	assert.False(t, claimed, "the notifications sent are never claimed")
+}
+
+func TestInternationalPhone(t *testing.T) {
+	for in, want := range map[string]string{
+		"0905123456":      "+84905123456",
+		"+84 905 123 456": "+84905123456",
+		"84905123456":     "+84905123456",
+		"+1 (555) 0100":   "+15550100",
+		"":                "",
+	} {
+		assert.Equal(t, want, InternationalPhone(in), in)
+	}
+}
+
+type recordMailer struct {
+	mu   sync.Mutex
+	sent []notify.Email
+}
+
+func (m *recordMailer) Send(ctx context.Context, email notify.Email) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.sent = append(m.sent, email)
+	return nil
+}
+
+func TestReceiptEmail(t *testing.T) {
+	mailer := &recordMailer{}
+	srv := goldenServer()
+	srv.Mailer = mailer
+	e := echo.New()
+	srv.Register(e)
+
+	body, err := ioutil.ReadFile("testdata/golden/google_permission.request.json")
+	require.NoError(t, err)
+	withEmail := strings.Replace(string(body), `"phone-number": "0905123456",`, `"phone-number": "0905123456", "email": "hoang@example.com",`, 1)
+	require.NotEqual(t, string(body), withEmail)
+	postWebhook(t, e, withEmail)
+	srv.Wait()
+	assert.Empty(t, mailer.sent, "the receipt waits for the pickup")
+
+	ctx := context.Background()
+	store := srv.Store.(*MemoryStore)
+	added := store.AddedTransactions()
+	require.Len(t, added, 1)
+	assert.True(t, added[0].ReceiptPending)
+	var id string
+	require.NoError(t, store.EachTransaction(ctx, TransactionFilter{}, func(t StoredTransaction) error {
+		id = t.ID
+		return nil
+	}))
+	require.NoError(t, store.AssignVolunteer(id, "v1"))
+	require.NoError(t, srv.NotifyPickups(ctx, time.Now()))
+	assert.Empty(t, mailer.sent)
+
+	require.NoError(t, store.SetStatus(id, "done"))
+	require.NoError(t, srv.NotifyPickups(ctx, time.Now()))
+	require.Len(t, mailer.sent, 1)
+	receipt := mailer.sent[0]
+	assert.Equal(t, "hoang@example.com", receipt.To)
+	assert.Contains(t, receipt.Text, "two bags of clothes")
+	require.Len(t, receipt.Attachments, 1)
+	assert.True(t, bytes.HasPrefix(receipt.Attachments[0].Data, []byte("%PDF")))
+
+	// the receipt was recorded, the poller doesn't send it again
+	pending, err := store.PendingReceipts(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, pending)
+	require.NoError(t, srv.NotifyPickups(ctx, time.Now().Add(time.Hour)))
+	assert.Len(t, mailer.sent, 1)
+}
+
+func TestNoReceiptWithoutEmail(t *testing.T) {
+	mailer := &recordMailer{}
+	srv := goldenServer()
+	srv.Mailer = mailer
+	e := echo.New()
+	srv.Register(e)
+
+	body, err := ioutil.ReadFile("testdata/golden/google_permission.request.json")
+	require.NoError(t, err)
+	postWebhook(t, e, strings.Replace(string(body), `"phone-number": "0905123456",`, `"phone-number": "0905123456", "email": "not an email",`, 1))
+	srv.Wait()
+	store := srv.Store.(*MemoryStore)
+	require.NoError(t, store.EachTransaction(context.Background(), TransactionFilter{}, func(t StoredTransaction) error {
+		return store.SetStatus(t.ID, "done")
+	}))
+	require.NoError(t, srv.NotifyPickups(context.Background(), time.Now()))
+	assert.Empty(t, mailer.sent)
+}
@@ -0,0 +1,223 @@
+package webhook
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"wcws/logging"
+	"wcws/partner"
+)
+
+// statusWindow is how long after their creation the changes of status of
+// the donations are published to the partners
+const statusWindow = 14 * 24 * time.Hour
+
+// publishTimeout bounds the publishing of a donation in the background of a
+// request
+const publishTimeout = 30 * time.Second
+
+// partnerEvent is the event of the current status of the donation, the
+// partners are told about every status once
+func partnerEvent(t StoredTransaction, now time.Time) partner.Event {
+	e := partner.Event{
+		ID:   t.ID + "_" + t.Status,
+		Type: partner.EventCreated,
+		Time: now,
+		Donation: partner.Donation{
+			ID:          t.ID,
+			Status:      t.Status,
+			Description: t.Description,
+			Address:     t.Address,
+			Lat:         t.Lat,
+			Long:        t.Long,
+			PickupTime:  t.TransactionTime,
+			EventID:     t.EventId,
+			CreatedAt:   time.Unix(t.CreatedDate, 0).UTC(),
+		},
+	}
+	if t.PublishedStatus != "" {
+		e.Type = partner.EventStatusChanged
+		e.Donation.PreviousStatus = t.PublishedStatus
+	}
+	return e
+}
+
+// publish tells the partners about the current status of the donation and
+// records it was published
+func (s *Server) publish(ctx context.Context, t StoredTransaction, now time.Time) error {
+	if err := s.Partners.Publish(ctx, partnerEvent(t, now), now); err != nil {
+		return err
+	}
+	return s.Store.MarkPublished(ctx, t.ID, t.Status)
+}
+
+// publishCreated tells the partners about the new donation without holding
+// the answer to the agent. PublishStatusChanges publishes it when it fails
+func (s *Server) publishCreated(t StoredTransaction) {
+	if s.Partners == nil {
+		return
+	}
+	s.background.Add(1)
+	go func() {
+		defer s.background.Done()
+		ctx, cancel := context.WithTimeout(context.Background(), publishTimeout)
+		defer cancel()
+		if err := s.publish(ctx, t, time.Now()); err != nil {
+			logging.FromContext(ctx).Error("publishing the donation", "transactionId", t.ID, "err", err)
+		}
+	}()
+}
+
+// PublishStatusChanges tells the partners about the recent donations whose
+// status changed since they were last told, and about the ones they weren't
+// told about at all
+func (s *Server) PublishStatusChanges(ctx context.Context, now time.Time) error {
+	if s.Partners == nil {
+		return nil
+	}
+	transactions, err := s.Store.RecentTransactions(ctx, now.Add(-statusWindow))
+	if err != nil {
+		return err
+	}
+	for _, t := range transactions {
+		if t.Status == t.PublishedStatus {
+			continue
+		}
+		if err := s.publish(ctx, t, now); err != nil {
+			logging.FromContext(ctx).Error("publishing the donation", "transactionId", t.ID, "err", err)
+		}
+	}
+	return nil
+}
+
+// RunPartners publishes the changes of status and retries the failed
+// deliveries every interval, until the context is done
+func (s *Server) RunPartners(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if err := s.PublishStatusChanges(ctx, time.Now()); err != nil {
+			logging.FromContext(ctx).Error("publishing the changes of status", "err", err)
+		}
+		if err := s.Partners.DeliverDue(ctx, time.Now()); err != nil {
+			logging.FromContext(ctx).Error("retrying the partner deliveries", "err", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Partners returns the store of the partner subscriptions of the database
+// of the store
+func (s *FirestoreStore) Partners() *partner.FirestoreStore {
+	return partner.NewFirestoreStore(s.client)
+}
+
+// registerPartners adds the admin routes managing the subscriptions of the
+// partners
+func (s *Server) registerPartners(admin *echo.Group) {
+	admin.GET("/partners/subscriptions", s.listSubscriptions)
+	admin.POST("/partners/subscriptions", s.createSubscription)
+	admin.DELETE("/partners/subscriptions/:id", s.deleteSubscription)
+	admin.GET("/partners/subscriptions/:id/deliveries", s.subscriptionDeliveries)
+	admin.GET("/partners/dead-letters", s.deadLetters)
+	admin.POST("/partners/deliveries/:id/retry", s.retryDelivery)
+}
+
+// listSubscriptions returns the subscriptions without their secrets
+func (s *Server) listSubscriptions(e echo.Context) error {
+	subs, err := s.Partners.Store.Subscriptions(e.Request().Context())
+	if err != nil {
+		return err
+	}
+	if subs == nil {
+		subs = []partner.Subscription{}
+	}
+	for i := range subs {
+		subs[i].Secret = ""
+	}
+	return e.JSON(http.StatusOK, subs)
+}
+
+// createSubscription saves the subscription posted, with a new secret unless
+// one is given, and returns it with its secret
+func (s *Server) createSubscription(e echo.Context) error {
+	sub := &partner.Subscription{}
+	if err := e.Bind(sub); err != nil {
+		return err
+	}
+	if err := sub.Validate(); err != nil {
+		return e.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+	if sub.Secret == "" {
+		secret, err := partner.NewSecret()
+		if err != nil {
+			return err
+		}
+		sub.Secret = secret
+	}
+	sub.ID = ""
+	sub.CreatedAt = time.Now().UTC()
+	if err := s.Partners.Store.SaveSubscription(e.Request().Context(), sub); err != nil {
+		return err
+	}
+	return e.JSON(http.StatusCreated, sub)
+}
+
+func (s *Server) deleteSubscription(e echo.Context) error {
+	sub, err := s.Partners.Store.Subscription(e.Request().Context(), e.Param("id"))
+	if err != nil {
+		return err
+	}
+	if sub == nil {
+		return e.NoContent(http.StatusNotFound)
+	}
+	if err := s.Partners.Store.DeleteSubscription(e.Request().Context(), sub.ID); err != nil {
+		return err
+	}
+	return e.NoContent(http.StatusNoContent)
+}
+
+// subscriptionDeliveries is the delivery log of the subscription, the newest
+// first
+func (s *Server) subscriptionDeliveries(e echo.Context) error {
+	deliveries, err := s.Partners.Store.SubscriptionDeliveries(e.Request().Context(), e.Param("id"))
+	return deliveriesJSON(e, deliveries, err)
+}
+
+// deadLetters are the deliveries which failed too many times
+func (s *Server) deadLetters(e echo.Context) error {
+	deliveries, err := s.Partners.Store.Deliveries(e.Request().Context(), partner.StatusDead)
+	return deliveriesJSON(e, deliveries, err)
+}
+
+func deliveriesJSON(e echo.Context, deliveries []partner.Delivery, err error) error {
+	if err != nil {
+		return err
+	}
+	if deliveries == nil {
+		deliveries = []partner.Delivery{}
+	}
+	return e.JSON(http.StatusOK, deliveries)
+}
+
+// retryDelivery sends a dead delivery again
+func (s *Server) retryDelivery(e echo.Context) error {
+	delivery, err := s.Partners.Redeliver(e.Request().Context(), e.Param("id"), time.Now())
+	if err == partner.ErrNotDead {
+		return e.JSON(http.StatusConflict, map[string]string{"error": err.Error()})
+	}
+	if err != nil {
+		return err
+	}
+	if delivery == nil {
+		return e.NoContent(http.StatusNotFound)
+	}
+	return e.JSON(http.StatusOK, delivery)
+}
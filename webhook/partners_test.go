@@ -0,0 +1,113 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"wcws/partner"
+)
+
+func adminRequest(e *echo.Echo, method, path, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, path, bytes.NewReader([]byte(body)))
+	req.Header.Set(echo.HeaderAuthorization, "Bearer admin-token")
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestPartnerWebhooks(t *testing.T) {
+	var mu sync.Mutex
+	var events []partner.Event
+	var secret string
+	charity := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := ioutil.ReadAll(r.Body)
+		require.NoError(t, err)
+		mu.Lock()
+		defer mu.Unlock()
+		assert.NoError(t, partner.Verify(secret, r.Header.Get(partner.HeaderSignature), body, time.Now(), time.Minute))
+		var e partner.Event
+		require.NoError(t, json.Unmarshal(body, &e))
+		events = append(events, e)
+	}))
+	defer charity.Close()
+
+	srv := goldenServer()
+	srv.Partners = partner.NewDispatcher(partner.NewMemoryStore())
+	srv.AdminToken = "admin-token"
+	e := echo.New()
+	srv.Register(e)
+
+	rec := adminRequest(e, http.MethodPost, "/admin/partners/subscriptions",
+		`{"url": "`+charity.URL+`", "geofence": {"lat": 16.0544, "lng": 108.2022, "radiusKm": 10}}`)
+	require.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+	var sub partner.Subscription
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &sub))
+	require.NotEmpty(t, sub.ID)
+	mu.Lock()
+	secret = sub.Secret
+	mu.Unlock()
+	assert.Contains(t, secret, "whsec_")
+	rec = adminRequest(e, http.MethodPost, "/admin/partners/subscriptions", `{"url": "hooks.example.org"}`)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	rec = adminRequest(e, http.MethodGet, "/admin/partners/subscriptions", "")
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.NotContains(t, rec.Body.String(), secret)
+
+	body, err := ioutil.ReadFile("testdata/golden/google_permission.request.json")
+	require.NoError(t, err)
+	postWebhook(t, e, string(body))
+	srv.Wait()
+
+	store := srv.Store.(*MemoryStore)
+	id := TransactionID("golden-google-permission")
+	require.NoError(t, store.SetStatus(id, "done"))
+	require.NoError(t, srv.PublishStatusChanges(context.Background(), time.Now()))
+	require.NoError(t, srv.PublishStatusChanges(context.Background(), time.Now()), "the change is only published once")
+
+	mu.Lock()
+	require.Len(t, events, 2)
+	created, changed := events[0], events[1]
+	mu.Unlock()
+	assert.Equal(t, partner.EventCreated, created.Type)
+	assert.Equal(t, id+"_pending", created.ID)
+	assert.Equal(t, "two bags of clothes", created.Donation.Description)
+	assert.InDelta(t, 16.074345, created.Donation.Lat, 1e-6)
+	assert.Equal(t, partner.EventStatusChanged, changed.Type)
+	assert.Equal(t, "done", changed.Donation.Status)
+	assert.Equal(t, "pending", changed.Donation.PreviousStatus)
+	b, err := json.Marshal(events)
+	require.NoError(t, err)
+	assert.NotContains(t, string(b), "0905123456", "the contact details of the donor aren't shared")
+	assert.NotContains(t, string(b), "Hoang")
+
+	rec = adminRequest(e, http.MethodGet, "/admin/partners/subscriptions/"+sub.ID+"/deliveries", "")
+	require.Equal(t, http.StatusOK, rec.Code)
+	var deliveries []partner.Delivery
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &deliveries))
+	require.Len(t, deliveries, 2)
+	for _, d := range deliveries {
+		assert.Equal(t, partner.StatusDelivered, d.Status)
+	}
+	rec = adminRequest(e, http.MethodGet, "/admin/partners/dead-letters", "")
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.JSONEq(t, "[]", rec.Body.String())
+	rec = adminRequest(e, http.MethodPost, "/admin/partners/deliveries/"+deliveries[0].ID+"/retry", "")
+	assert.Equal(t, http.StatusConflict, rec.Code)
+
+	rec = adminRequest(e, http.MethodDelete, "/admin/partners/subscriptions/"+sub.ID, "")
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+	rec = adminRequest(e, http.MethodDelete, "/admin/partners/subscriptions/"+sub.ID, "")
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
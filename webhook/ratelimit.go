@@ -0,0 +1,343 @@
+package webhook
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gomodule/redigo/redis"
+
+	"wcws/config"
+	"wcws/logging"
+)
+
+// tooManyDonations is the answer to a donor over a rate limit
+const tooManyDonations = "Thank you for being so generous! You have sent us many donations recently, our volunteers will get in touch with you before you can send more."
+
+// RateLimit allows Limit donations per Window. A zero limit disables it
+type RateLimit = config.RateLimit
+
+// ParseRateLimit parses a limit written like "5/1h", an empty string is no
+// limit
+func ParseRateLimit(s string) (RateLimit, error) {
+	return config.ParseRateLimit(s)
+}
+
+// NewRateLimiter returns the limiter of the configuration, counting in Redis
+// when there is a Redis URL and in memory otherwise
+func NewRateLimiter(cfg config.RateLimits) *RateLimiter {
+	l := &RateLimiter{Counter: NewMemoryCounter(), Session: cfg.Session, Sender: cfg.Sender, Phone: cfg.Phone}
+	if cfg.RedisURL != "" {
+		l.Counter = NewRedisCounter(cfg.RedisURL)
+	}
+	return l
+}
+
+// Counter counts the hits of a key in fixed windows
+type Counter interface {
+	// Incr adds a hit to the key and returns the number of hits in the
+	// current window, the window starts with the first hit
+	Incr(ctx context.Context, key string, window time.Duration) (int, error)
+	// Decr takes back a hit of the key in the current window
+	Decr(ctx context.Context, key string) error
+}
+
+// Submission identifies who is submitting a donation, empty fields aren't
+// limited
+type Submission struct {
+	Session string
+	Sender  string
+	Phone   string
+}
+
+// Limited tells which limit a submission hit
+type Limited struct {
+	Key   string
+	Limit RateLimit
+	// Rejected is the number of submissions rejected by the limit
+	Rejected int
+}
+
+// First reports whether this is the first submission rejected in the window,
+// so that admins are only alerted once
+func (l *Limited) First() bool {
+	return l.Rejected == 1
+}
+
+// RateLimiter limits the donations submitted per Dialogflow session, per
+// messaging platform sender and per phone number
+type RateLimiter struct {
+	Counter Counter
+	Session RateLimit
+	Sender  RateLimit
+	Phone   RateLimit
+}
+
+type limitCheck struct {
+	key   string
+	limit RateLimit
+}
+
+// checks are the limits of the submission, with the key they are counted by
+func (l *RateLimiter) checks(d Submission) []limitCheck {
+	var checks []limitCheck
+	for _, c := range []limitCheck{
+		{"session:" + d.Session, l.Session},
+		{"sender:" + d.Sender, l.Sender},
+		{"phone:" + NormalizePhone(d.Phone), l.Phone},
+	} {
+		if c.limit.Limit != 0 && !strings.HasSuffix(c.key, ":") {
+			checks = append(checks, c)
+		}
+	}
+	return checks
+}
+
+// Reserve counts the submission against its limits before the donation is
+// created, so that parallel submissions can't all pass. It returns the limit
+// the submission is over, if any, and then nothing stays counted. The
+// rejected submissions are counted apart to alert the admins once
+func (l *RateLimiter) Reserve(ctx context.Context, d Submission) (*Limited, error) {
+	checks := l.checks(d)
+	for i, c := range checks {
+		count, err := l.Counter.Incr(ctx, "wcws:ratelimit:"+c.key, c.limit.Window)
+		if err != nil {
+			l.release(ctx, checks[:i])
+			return nil, err
+		}
+		if count <= c.limit.Limit {
+			continue
+		}
+		l.release(ctx, checks[:i+1])
+		rejected, err := l.Counter.Incr(ctx, "wcws:ratelimit:rejected:"+c.key, c.limit.Window)
+		if err != nil {
+			return nil, err
+		}
+		return &Limited{Key: c.key, Limit: c.limit, Rejected: rejected}, nil
+	}
+	return nil, nil
+}
+
+// Release takes back the reservation of a submission whose donation wasn't
+// created
+func (l *RateLimiter) Release(ctx context.Context, d Submission) error {
+	return l.release(ctx, l.checks(d))
+}
+
+func (l *RateLimiter) release(ctx context.Context, checks []limitCheck) error {
+	var first error
+	for _, c := range checks {
+		if err := l.Counter.Decr(ctx, "wcws:ratelimit:"+c.key); err != nil && first == nil {
+			first = err
+		}
+	}
+	return first
+}
+
+// NormalizePhone keeps the digits of a phone number and writes Vietnamese
+// numbers in their national form, so that +84 905 123 456 and 0905123456 are
+// the same donor
+func NormalizePhone(phone string) string {
+	digits := digitsOf(phone)
+	switch {
+	case strings.HasPrefix(digits, "0084"):
+		return "0" + digits[4:]
+	case strings.HasPrefix(digits, "84") && len(digits) >= 11:
+		return "0" + digits[2:]
+	}
+	return digits
+}
+
+func digitsOf(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		if r >= '0' && r <= '9' {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+type memoryWindow struct {
+	count   int
+	expires time.Time
+}
+
+// MemoryCounter is a Counter for a single instance of the webhook
+type MemoryCounter struct {
+	now func() time.Time
+
+	mu      sync.Mutex
+	windows map[string]memoryWindow
+}
+
+// NewMemoryCounter returns an empty counter
+func NewMemoryCounter() *MemoryCounter {
+	return &MemoryCounter{now: time.Now, windows: make(map[string]memoryWindow)}
+}
+
+// Incr adds a hit to the key and returns the number of hits in the window
+func (c *MemoryCounter) Incr(ctx context.Context, key string, window time.Duration) (int, error) {
+	now := c.now()
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	w, ok := c.windows[key]
+	if !ok || !now.Before(w.expires) {
+		for k, w := range c.windows {
+			if !now.Before(w.expires) {
+				delete(c.windows, k)
+			}
+		}
+		w = memoryWindow{expires: now.Add(window)}
+	}
+	w.count++
+	c.windows[key] = w
+	return w.count, nil
+}
+
+// Decr takes back a hit of the key in the window
+func (c *MemoryCounter) Decr(ctx context.Context, key string) error {
+	now := c.now()
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	w, ok := c.windows[key]
+	if !ok || !now.Before(w.expires) || w.count == 0 {
+		return nil
+	}
+	w.count--
+	c.windows[key] = w
+	return nil
+}
+
+// RedisCounter is a Counter shared by the instances of the webhook, kept in
+// any server speaking the Redis protocol
+type RedisCounter struct {
+	pool *redis.Pool
+}
+
+// NewRedisCounter returns a counter for the server at addr, a URL like
+// redis://:password@host:6379/0 or a host:port
+func NewRedisCounter(addr string) *RedisCounter {
+	return &RedisCounter{pool: &redis.Pool{
+		MaxIdle:     4,
+		IdleTimeout: 5 * time.Minute,
+		Dial: func() (redis.Conn, error) {
+			opts := []redis.DialOption{
+				redis.DialConnectTimeout(2 * time.Second),
+				redis.DialReadTimeout(2 * time.Second),
+				redis.DialWriteTimeout(2 * time.Second),
+			}
+			if strings.Contains(addr, "://") {
+				return redis.DialURL(addr, opts...)
+			}
+			return redis.Dial("tcp", addr, opts...)
+		},
+	}}
+}
+
+// Incr adds a hit to the key and returns the number of hits in the window
+func (c *RedisCounter) Incr(ctx context.Context, key string, window time.Duration) (int, error) {
+	conn := c.pool.Get()
+	defer conn.Close()
+	count, err := redis.Int(conn.Do("INCR", key))
+	if err != nil {
+		return 0, err
+	}
+	expire := count == 1
+	if !expire {
+		// the expiry may be missing when the instance which created the key
+		// stopped right after the INCR
+		ttl, err := redis.Int64(conn.Do("PTTL", key))
+		if err != nil {
+			return 0, err
+		}
+		expire = ttl == -1
+	}
+	if expire {
+		if _, err := conn.Do("PEXPIRE", key, int64(window/time.Millisecond)); err != nil {
+			return 0, err
+		}
+	}
+	return count, nil
+}
+
+// Decr takes back a hit of the key in the window, the key is deleted when no
+// hit is left so that a window which expired in between doesn't go negative
+func (c *RedisCounter) Decr(ctx context.Context, key string) error {
+	conn := c.pool.Get()
+	defer conn.Close()
+	count, err := redis.Int(conn.Do("DECR", key))
+	if err != nil {
+		return err
+	}
+	if count <= 0 {
+		_, err = conn.Do("DEL", key)
+	}
+	return err
+}
+
+// Close closes the connections to the server
+func (c *RedisCounter) Close() error {
+	return c.pool.Close()
+}
+
+// reserveDonation counts the submission against the limits before its
+// donation is created, it returns false when the submission is over a limit
+// and the admins are alerted the first time. The returned func takes back the
+// reservation when the donation isn't created. Donations are allowed when the
+// counter fails
+func (s *Server) reserveDonation(ctx context.Context, sub Submission) (release func(), ok bool) {
+	release = func() {}
+	if s.Limiter == nil {
+		return release, true
+	}
+	limited, err := s.Limiter.Reserve(ctx, sub)
+	if err != nil {
+		logging.FromContext(ctx).Error("checking the donation limits", "err", err)
+		return release, true
+	}
+	if limited != nil {
+		if limited.First() && s.Alerter != nil {
+			if err := s.Alerter.Alert(ctx, limitAlert(limited, sub, s.redactor())); err != nil {
+				logging.FromContext(ctx).Error("alerting the admins", "err", err)
+			}
+		}
+		return release, false
+	}
+	return func() {
+		if err := s.Limiter.Release(ctx, sub); err != nil {
+			logging.FromContext(ctx).Error("releasing the donation limits", "err", err)
+		}
+	}, true
+}
+
+// limitAlert tells the admins which limit the submission is over, with the
+// phone number masked like in the logs and only the end of the session and
+// of the sender
+func limitAlert(limited *Limited, sub Submission, r *logging.Redactor) string {
+	phone := r.Field("phone", sub.Phone)
+	key := limited.Key
+	switch {
+	case strings.HasPrefix(key, "phone:"):
+		key = fmt.Sprintf("phone:%v", phone)
+	case strings.HasPrefix(key, "session:"):
+		key = "session:" + maskID(sub.Session)
+	case strings.HasPrefix(key, "sender:"):
+		key = "sender:" + maskID(sub.Sender)
+	}
+	return fmt.Sprintf("Donations from %s are over the limit of %s (session %s, sender %s, phone %v)",
+		key, limited.Limit, maskID(sub.Session), maskID(sub.Sender), phone)
+}
+
+// maskID keeps the last 4 characters of an ID, enough to find it in the logs
+func maskID(id string) string {
+	if i := strings.LastIndex(id, "/"); i >= 0 {
+		id = id[i+1:]
+	}
+	if len(id) <= 4 {
+		return strings.Repeat("*", len(id))
+	}
+	return "***" + id[len(id)-4:]
+}
@@ -0,0 +1,336 @@
+package webhook
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"wcws/dialogflow"
+)
+
+func TestParseRateLimit(t *testing.T) {
+	l, err := ParseRateLimit("5/24h")
+	require.NoError(t, err)
+	assert.Equal(t, RateLimit{Limit: 5, Window: 24 * time.Hour}, l)
+
+	l, err = ParseRateLimit("")
+	require.NoError(t, err)
+	assert.Equal(t, RateLimit{}, l)
+
+	for _, s := range []string{"5", "x/1h", "5/x", "-1/1h", "5/0s"} {
+		_, err := ParseRateLimit(s)
+		assert.Error(t, err, s)
+	}
+}
+
+func TestNormalizePhone(t *testing.T) {
+	for in, want := range map[string]string{
+		"0905123456":       "0905123456",
+		"+84 905 123 456":  "0905123456",
+		"0084-905-123-456": "0905123456",
+		"(0905) 123.456":   "0905123456",
+		"842":              "842",
+	} {
+		assert.Equal(t, want, NormalizePhone(in), in)
+	}
+}
+
+func TestMemoryCounterWindow(t *testing.T) {
+	now := time.Date(2019, 12, 1, 9, 0, 0, 0, time.UTC)
+	c := NewMemoryCounter()
+	c.now = func() time.Time { return now }
+	ctx := context.Background()
+
+	for i := 1; i <= 3; i++ {
+		n, err := c.Incr(ctx, "a", time.Hour)
+		require.NoError(t, err)
+		assert.Equal(t, i, n)
+	}
+	n, _ := c.Incr(ctx, "b", time.Hour)
+	assert.Equal(t, 1, n)
+
+	now = now.Add(time.Hour)
+	n, _ = c.Incr(ctx, "a", time.Hour)
+	assert.Equal(t, 1, n)
+	assert.Len(t, c.windows, 1)
+}
+
+func TestRateLimiterReserve(t *testing.T) {
+	l := &RateLimiter{
+		Counter: NewMemoryCounter(),
+		Session: RateLimit{Limit: 3, Window: time.Hour},
+		Phone:   RateLimit{Limit: 1, Window: time.Hour},
+	}
+	ctx := context.Background()
+
+	first := Submission{Session: "s1", Sender: "u1", Phone: "0905123456"}
+	limited, err := l.Reserve(ctx, first)
+	require.NoError(t, err)
+	assert.Nil(t, limited)
+	require.NoError(t, l.Release(ctx, first))
+	limited, err = l.Reserve(ctx, first)
+	require.NoError(t, err)
+	assert.Nil(t, limited, "a released reservation isn't counted")
+
+	limited, err = l.Reserve(ctx, Submission{Session: "s2", Phone: "+84 905 123 456"})
+	require.NoError(t, err)
+	require.NotNil(t, limited)
+	assert.Equal(t, "phone:0905123456", limited.Key)
+	assert.True(t, limited.First())
+
+	limited, _ = l.Reserve(ctx, Submission{Session: "s2", Phone: "0905123456"})
+	require.NotNil(t, limited)
+	assert.False(t, limited.First())
+
+	// the rejected submissions didn't count against the session
+	for i := 0; i < 3; i++ {
+		limited, _ = l.Reserve(ctx, Submission{Session: "s2"})
+		assert.Nil(t, limited)
+	}
+	limited, _ = l.Reserve(ctx, Submission{Session: "s2"})
+	require.NotNil(t, limited)
+	assert.Equal(t, "session:s2", limited.Key)
+}
+
+func TestRateLimiterParallel(t *testing.T) {
+	l := &RateLimiter{Counter: NewMemoryCounter(), Phone: RateLimit{Limit: 3, Window: time.Hour}}
+	ctx := context.Background()
+
+	var (
+		wg      sync.WaitGroup
+		mu      sync.Mutex
+		allowed int
+	)
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			limited, err := l.Reserve(ctx, Submission{Phone: "0905123456"})
+			if err == nil && limited == nil {
+				mu.Lock()
+				allowed++
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, 3, allowed)
+}
+
+// fakeRedis answers the INCR, DECR, DEL, PTTL and PEXPIRE commands of the Redis protocol
+type fakeRedis struct {
+	mu      sync.Mutex
+	values  map[string]int
+	expires map[string]int64
+}
+
+func startFakeRedis(t *testing.T) (*fakeRedis, net.Listener) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	f := &fakeRedis{values: map[string]int{}, expires: map[string]int64{}}
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			go f.serve(conn)
+		}
+	}()
+	return f, l
+}
+
+func (f *fakeRedis) serve(conn net.Conn) {
+	defer conn.Close()
+	r := bufio.NewReader(conn)
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return
+		}
+		n, _ := strconv.Atoi(strings.TrimSpace(line[1:]))
+		args := make([]string, n)
+		for i := range args {
+			if _, err := r.ReadString('\n'); err != nil {
+				return
+			}
+			arg, err := r.ReadString('\n')
+			if err != nil {
+				return
+			}
+			args[i] = strings.TrimSpace(arg)
+		}
+		f.mu.Lock()
+		switch strings.ToUpper(args[0]) {
+		case "INCR":
+			f.values[args[1]]++
+			fmt.Fprintf(conn, ":%d\r\n", f.values[args[1]])
+		case "DECR":
+			f.values[args[1]]--
+			fmt.Fprintf(conn, ":%d\r\n", f.values[args[1]])
+		case "DEL":
+			delete(f.values, args[1])
+			delete(f.expires, args[1])
+			fmt.Fprint(conn, ":1\r\n")
+		case "PTTL":
+			ttl, ok := f.expires[args[1]]
+			if !ok {
+				ttl = -1
+			}
+			fmt.Fprintf(conn, ":%d\r\n", ttl)
+		case "PEXPIRE":
+			f.expires[args[1]], _ = strconv.ParseInt(args[2], 10, 64)
+			fmt.Fprint(conn, ":1\r\n")
+		default:
+			fmt.Fprintf(conn, "-ERR unknown command '%s'\r\n", args[0])
+		}
+		f.mu.Unlock()
+	}
+}
+
+func TestRedisCounter(t *testing.T) {
+	f, l := startFakeRedis(t)
+	defer l.Close()
+	c := NewRedisCounter(l.Addr().String())
+	defer c.Close()
+	ctx := context.Background()
+
+	for i := 1; i <= 2; i++ {
+		n, err := c.Incr(ctx, "k", time.Minute)
+		require.NoError(t, err)
+		assert.Equal(t, i, n)
+	}
+	assert.Equal(t, int64(60000), f.expires["k"])
+	require.NoError(t, c.Decr(ctx, "k"))
+	f.mu.Lock()
+	assert.Equal(t, 1, f.values["k"])
+	f.mu.Unlock()
+	require.NoError(t, c.Decr(ctx, "k"))
+	require.NoError(t, c.Decr(ctx, "missing"))
+	f.mu.Lock()
+	assert.NotContains(t, f.values, "k")
+	assert.NotContains(t, f.values, "missing", "no key is left below zero")
+	f.mu.Unlock()
+
+	// a key left without expiry gets one
+	f.values["lost"] = 4
+	n, err := c.Incr(ctx, "lost", time.Second)
+	require.NoError(t, err)
+	assert.Equal(t, 5, n)
+	assert.Equal(t, int64(1000), f.expires["lost"])
+}
+
+type recordAlerter struct {
+	alerts []string
+}
+
+func (a *recordAlerter) Alert(ctx context.Context, text string) error {
+	a.alerts = append(a.alerts, text)
+	return nil
+}
+
+func TestDonationsOverLimit(t *testing.T) {
+	body, err := ioutil.ReadFile("testdata/golden/google_permission.request.json")
+	require.NoError(t, err)
+	alerter := &recordAlerter{}
+	srv := goldenServer()
+	srv.Limiter = &RateLimiter{Counter: NewMemoryCounter(), Phone: RateLimit{Limit: 2, Window: time.Hour}}
+	srv.Alerter = alerter
+	e := echo.New()
+	srv.Register(e)
+
+	var answers []string
+	for i := 0; i < 4; i++ {
+		b := bytes.Replace(body, []byte("golden-google-permission"), []byte(fmt.Sprintf("limit-%d", i)), 1)
+		req := httptest.NewRequest(http.MethodPost, "/webhook", bytes.NewReader(b))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+		require.Equal(t, http.StatusOK, rec.Code)
+		answers = append(answers, rec.Body.String())
+	}
+	assert.Len(t, srv.Store.(*MemoryStore).AddedTransactions(), 2)
+	assert.NotContains(t, answers[1], "many donations")
+	assert.Contains(t, answers[2], "many donations")
+	assert.Contains(t, answers[3], "many donations")
+	assert.Contains(t, answers[3], `"expectUserResponse":false`)
+	require.Len(t, alerter.alerts, 1)
+	assert.Contains(t, alerter.alerts[0], "phone:*******456")
+	assert.NotContains(t, alerter.alerts[0], "0905123456")
+	assert.Contains(t, alerter.alerts[0], "session ***lden")
+}
+
+func TestDonationsLimitReleased(t *testing.T) {
+	body, err := ioutil.ReadFile("testdata/golden/google_permission.request.json")
+	require.NoError(t, err)
+	srv := goldenServer()
+	geocoder := srv.Geocoder
+	srv.Geocoder = GeocoderFunc(func(ctx context.Context, c dialogflow.Coordinates) (string, error) {
+		return "", errors.New("geocoder down")
+	})
+	srv.Limiter = &RateLimiter{Counter: NewMemoryCounter(), Phone: RateLimit{Limit: 1, Window: time.Hour}}
+	e := echo.New()
+	srv.Register(e)
+
+	post := func(id string) string {
+		b := bytes.Replace(body, []byte("golden-google-permission"), []byte(id), 1)
+		req := httptest.NewRequest(http.MethodPost, "/webhook", bytes.NewReader(b))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+		return rec.Body.String()
+	}
+	post("failed")
+	assert.Empty(t, srv.Store.(*MemoryStore).AddedTransactions())
+
+	// the failed submission gave its reservation back
+	srv.Geocoder = geocoder
+	assert.NotContains(t, post("saved"), "many donations")
+	assert.Len(t, srv.Store.(*MemoryStore).AddedTransactions(), 1)
+	assert.Contains(t, post("other"), "many donations")
+}
+
+func TestZaloDonationsOverLimit(t *testing.T) {
+	z := newZaloTest(t)
+	defer z.api.Close()
+	z.handler.server.Limiter = &RateLimiter{Counter: NewMemoryCounter(), Phone: RateLimit{Limit: 1, Window: time.Hour}}
+
+	donate := func() string {
+		for _, text := range []string{"hi", "Winter clothes", "Minh", "0905123456", "skip", "tomorrow"} {
+			z.text(text)
+		}
+		return z.location()
+	}
+	assert.NotContains(t, donate(), "many donations")
+	assert.Contains(t, donate(), "many donations")
+	assert.Len(t, z.transactions(), 1)
+}
+
+func TestWebhookAlerterRedacts(t *testing.T) {
+	var got map[string]string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := ioutil.ReadAll(r.Body)
+		_ = json.Unmarshal(b, &got)
+	}))
+	defer srv.Close()
+
+	a := NewWebhookAlerter(srv.URL)
+	require.NoError(t, a.Alert(context.Background(), "Donor at 16.071234,108.224456 called from +84 905 123 456"))
+	assert.Equal(t, "Donor at 16.07,108.22 called from ********456", got["text"])
+}
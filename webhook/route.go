@@ -0,0 +1,289 @@
+package webhook
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"wcws/route"
+)
+
+// Defaults of the planned routes
+const (
+	DefaultPickupWindow   = time.Hour
+	DefaultPickupDuration = 10 * time.Minute
+	// defaultDeparture is when the volunteers leave, after midnight
+	defaultDeparture = 8 * time.Hour
+	// travelMode is how the Google Maps links are travelled, the motorbikes
+	// of the volunteers go where the cars do
+	travelMode = "driving"
+)
+
+// RouteStop is a pickup of a planned route
+type RouteStop struct {
+	TransactionID string     `json:"transactionId"`
+	GiverName     string     `json:"giverName"`
+	PhoneNumber   string     `json:"phoneNumber"`
+	Address       string     `json:"address"`
+	Description   string     `json:"description"`
+	Lat           float64    `json:"lat"`
+	Lng           float64    `json:"lng"`
+	WindowStart   *time.Time `json:"windowStart,omitempty"`
+	WindowEnd     *time.Time `json:"windowEnd,omitempty"`
+	Arrival       *time.Time `json:"arrival,omitempty"`
+	WaitMinutes   int        `json:"waitMinutes,omitempty"`
+	LateMinutes   int        `json:"lateMinutes,omitempty"`
+	// DistanceKm is the length of the leg from the previous stop
+	DistanceKm float64 `json:"distanceKm,omitempty"`
+}
+
+// VolunteerRoute is the route of the pickups assigned to a volunteer for a
+// day, in order
+type VolunteerRoute struct {
+	Volunteer   Volunteer   `json:"volunteer"`
+	Date        string      `json:"date"`
+	Start       route.Point `json:"start"`
+	Departure   time.Time   `json:"departure"`
+	Finish      time.Time   `json:"finish"`
+	DistanceKm  float64     `json:"distanceKm"`
+	LateMinutes int         `json:"lateMinutes"`
+	Stops       []RouteStop `json:"stops"`
+	// Unlocated are the pickups without coordinates, left out of the route
+	Unlocated []RouteStop `json:"unlocated,omitempty"`
+	// MapsLinks open the route in Google Maps, a link for every ten stops
+	MapsLinks []string `json:"mapsLinks"`
+}
+
+// pickupWindow is when the donation can be picked up, from the time chosen by
+// the donor. It is zero when the time is free text
+func (s *Server) pickupWindow(t StoredTransaction) (start, end time.Time) {
+	pickup, err := time.Parse(time.RFC3339, t.TransactionTime)
+	if err != nil {
+		return time.Time{}, time.Time{}
+	}
+	window := s.PickupWindow
+	if window == 0 {
+		window = DefaultPickupWindow
+	}
+	return pickup, pickup.Add(window)
+}
+
+func (s *Server) routeStop(t StoredTransaction) RouteStop {
+	stop := RouteStop{
+		TransactionID: t.ID,
+		GiverName:     t.GiverName,
+		PhoneNumber:   t.PhoneNumber,
+		Address:       t.Address,
+		Description:   t.Description,
+		Lat:           t.Lat,
+		Lng:           t.Long,
+	}
+	if start, end := s.pickupWindow(t); !start.IsZero() {
+		stop.WindowStart, stop.WindowEnd = &start, &end
+	}
+	return stop
+}
+
+// PlanRoute orders the pickups assigned to the volunteer on the day starting
+// at midnight, leaving the start at the departure. The pickups without a
+// time are planned every day until they are done
+func (s *Server) PlanRoute(ctx context.Context, volunteer Volunteer, start route.Point, day, departure time.Time) (*VolunteerRoute, error) {
+	pickups, err := s.Store.OpenPickups(ctx)
+	if err != nil {
+		return nil, err
+	}
+	duration := s.PickupDuration
+	if duration == 0 {
+		duration = DefaultPickupDuration
+	}
+	next := day.AddDate(0, 0, 1)
+	r := &VolunteerRoute{Volunteer: volunteer, Date: day.Format("2006-01-02"), Start: start, Stops: []RouteStop{}}
+	var stops []route.Stop
+	transactions := make(map[string]StoredTransaction)
+	for _, t := range pickups {
+		if t.Status != "assigned" || t.VolunteerId != volunteer.ID {
+			continue
+		}
+		from, until := s.pickupWindow(t)
+		if !from.IsZero() && (!from.Before(next) || until.Before(day)) {
+			continue
+		}
+		if t.Lat == 0 && t.Long == 0 {
+			r.Unlocated = append(r.Unlocated, s.routeStop(t))
+			continue
+		}
+		transactions[t.ID] = t
+		stops = append(stops, route.Stop{
+			ID:      t.ID,
+			Point:   route.Point{Lat: t.Lat, Lng: t.Long},
+			Start:   from,
+			End:     until,
+			Service: duration,
+		})
+	}
+
+	matrix := s.RouteMatrix
+	if matrix == nil {
+		matrix = route.Haversine{}
+	}
+	planned, err := route.Plan(ctx, matrix, start, departure, stops)
+	if err != nil {
+		return nil, err
+	}
+	r.Departure, r.Finish = planned.Departure, planned.Finish
+	r.DistanceKm = planned.DistanceKm
+	r.LateMinutes = int(planned.Late.Minutes())
+	for _, v := range planned.Visits {
+		stop := s.routeStop(transactions[v.ID])
+		arrival := v.Arrival
+		stop.Arrival = &arrival
+		stop.WaitMinutes = int(v.Wait.Minutes())
+		stop.LateMinutes = int(v.Late.Minutes())
+		stop.DistanceKm = v.DistanceKm
+		r.Stops = append(r.Stops, stop)
+	}
+	r.MapsLinks = planned.GoogleMapsLinks(travelMode)
+	if r.MapsLinks == nil {
+		r.MapsLinks = []string{}
+	}
+	return r, nil
+}
+
+// parsePoint parses coordinates like 16.0544,108.2022
+func parsePoint(s string) (route.Point, error) {
+	parts := strings.Split(s, ",")
+	if len(parts) == 2 {
+		lat, errLat := strconv.ParseFloat(strings.TrimSpace(parts[0]), 64)
+		lng, errLng := strconv.ParseFloat(strings.TrimSpace(parts[1]), 64)
+		if errLat == nil && errLng == nil && lat >= -90 && lat <= 90 && lng >= -180 && lng <= 180 {
+			return route.Point{Lat: lat, Lng: lng}, nil
+		}
+	}
+	return route.Point{}, fmt.Errorf("invalid start %q, expected lat,lng", s)
+}
+
+// routeQuery reads the start, the day and the departure of the route
+// endpoint. The day is today and the departure defaultDeparture by default,
+// or now when it is later
+func (s *Server) routeQuery(e echo.Context, now time.Time) (start route.Point, day, departure time.Time, err error) {
+	if start, err = parsePoint(e.QueryParam("start")); err != nil {
+		return
+	}
+	loc := s.ExportLocation
+	if loc == nil {
+		loc = time.UTC
+	}
+	now = now.In(loc)
+	day = time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, loc)
+	if date := e.QueryParam("date"); date != "" {
+		if day, err = time.ParseInLocation("2006-01-02", date, loc); err != nil {
+			err = fmt.Errorf("invalid date %q, expected 2006-01-02", date)
+			return
+		}
+	}
+	departure = day.Add(defaultDeparture)
+	if now.After(departure) && now.Before(day.AddDate(0, 0, 1)) {
+		departure = now
+	}
+	if depart := e.QueryParam("depart"); depart != "" {
+		clock, perr := time.Parse("15:04", depart)
+		if perr != nil {
+			err = fmt.Errorf("invalid departure %q, expected 15:04", depart)
+			return
+		}
+		departure = day.Add(time.Duration(clock.Hour())*time.Hour + time.Duration(clock.Minute())*time.Minute)
+	}
+	return
+}
+
+// volunteerRoute plans the route of the volunteer of the path
+func (s *Server) volunteerRoute(e echo.Context) error {
+	start, day, departure, err := s.routeQuery(e, time.Now())
+	if err != nil {
+		return e.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+	ctx := e.Request().Context()
+	volunteer, err := s.Store.Volunteer(ctx, e.Param("id"))
+	if err != nil {
+		return err
+	}
+	if volunteer == nil {
+		return e.JSON(http.StatusNotFound, map[string]string{"error": "no volunteer " + strconv.Quote(e.Param("id"))})
+	}
+	r, err := s.PlanRoute(ctx, *volunteer, start, day, departure)
+	if err == route.ErrTooManyStops {
+		return e.JSON(http.StatusUnprocessableEntity, map[string]string{"error": err.Error()})
+	}
+	if err != nil {
+		return err
+	}
+	return e.JSON(http.StatusOK, r)
+}
+
+// routeTokenValidity is how long the route tokens given to the admins open
+// the route, a week of pickups
+const routeTokenValidity = 7 * 24 * time.Hour
+
+// RouteToken returns the token opening the route of the volunteer, and only
+// theirs, until it expires
+func (s *Server) RouteToken(volunteerID string, expires time.Time) string {
+	exp := strconv.FormatInt(expires.Unix(), 10)
+	return exp + "." + s.routeMAC(volunteerID, exp)
+}
+
+func (s *Server) routeMAC(volunteerID, exp string) string {
+	mac := hmac.New(sha256.New, []byte(s.RouteSecret))
+	mac.Write([]byte(volunteerID + "\n" + exp))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// validRouteToken reports whether the token opens the route of the
+// volunteer at the time
+func (s *Server) validRouteToken(token, volunteerID string, now time.Time) bool {
+	i := strings.IndexByte(token, '.')
+	if i < 0 {
+		return false
+	}
+	exp := token[:i]
+	if subtle.ConstantTimeCompare([]byte(token[i+1:]), []byte(s.routeMAC(volunteerID, exp))) != 1 {
+		return false
+	}
+	expires, err := strconv.ParseInt(exp, 10, 64)
+	return err == nil && now.Before(time.Unix(expires, 0))
+}
+
+// routeAuth lets in the requests with an unexpired route token of the
+// volunteer of the path, as a bearer token or in the token parameter of the
+// links
+func (s *Server) routeAuth(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(e echo.Context) error {
+		key := e.QueryParam("token")
+		if auth := e.Request().Header.Get(echo.HeaderAuthorization); strings.HasPrefix(auth, "Bearer ") {
+			key = strings.TrimPrefix(auth, "Bearer ")
+		}
+		if !s.validRouteToken(key, e.Param("id"), time.Now()) {
+			return echo.ErrUnauthorized
+		}
+		return next(e)
+	}
+}
+
+// volunteerRouteToken gives the admins a route token of the volunteer, to
+// send them the link of their route, with the time it expires
+func (s *Server) volunteerRouteToken(e echo.Context) error {
+	expires := time.Now().Add(routeTokenValidity).Truncate(time.Second)
+	return e.JSON(http.StatusOK, map[string]string{
+		"volunteerId": e.Param("id"),
+		"token":       s.RouteToken(e.Param("id"), expires),
+		"expires":     expires.Format(time.RFC3339),
+	})
+}
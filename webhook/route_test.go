@@ -0,0 +1,166 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func routeServer(t *testing.T) (*Server, *echo.Echo) {
+	store := NewMemoryStore(nil)
+	store.AddVolunteer(Volunteer{ID: "v1", Name: "Lan", PhoneNumber: "0905 000 111"})
+	store.AddVolunteer(Volunteer{ID: "v2", Name: "Duc"})
+	for _, p := range []struct {
+		id, volunteer, when string
+		lat, lng            float64
+	}{
+		{"r-1", "v1", "2019-12-01T10:00:00+07:00", 16.0600, 108.1953},
+		{"r-2", "v1", "2019-12-01T08:30:00+07:00", 16.0600, 108.2281},
+		{"r-3", "v1", "whenever", 16.0690, 108.2281},
+		{"r-4", "v1", "tomorrow morning", 0, 0},
+		{"r-5", "v1", "2019-12-02T09:00:00+07:00", 16.0610, 108.2100},
+		{"r-6", "v2", "2019-12-01T09:00:00+07:00", 16.0620, 108.2100},
+		{"r-7", "", "2019-12-01T09:00:00+07:00", 16.0630, 108.2100},
+	} {
+		_, err := store.AddTransaction(context.Background(), p.id, Transactions{
+			Status: "pending", GiverName: "Donor " + p.id, TransactionTime: p.when, Lat: p.lat, Long: p.lng,
+		})
+		require.NoError(t, err)
+		if p.volunteer != "" {
+			require.NoError(t, store.AssignVolunteer(p.id, p.volunteer))
+		}
+	}
+	srv := New(store, nil)
+	srv.AdminToken = "admin-token"
+	srv.RouteSecret = "route-secret"
+	srv.ExportLocation = time.FixedZone("ICT", 7*60*60)
+	e := echo.New()
+	srv.Register(e)
+	return srv, e
+}
+
+// routeRequest asks the route of the volunteer with their token
+func routeRequest(srv *Server, e *echo.Echo, volunteerID, query string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, "/volunteers/"+volunteerID+"/route?"+query, nil)
+	req.Header.Set(echo.HeaderAuthorization, "Bearer "+srv.RouteToken(volunteerID, time.Now().Add(time.Hour)))
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestVolunteerRoute(t *testing.T) {
+	srv, e := routeServer(t)
+
+	rec := routeRequest(srv, e, "v1", "start=16.06,108.2&date=2019-12-01&depart=08:00")
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	var r VolunteerRoute
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &r))
+	assert.Equal(t, "Lan", r.Volunteer.Name)
+	assert.Equal(t, "2019-12-01", r.Date)
+	assert.Equal(t, "2019-12-01T08:00:00+07:00", r.Departure.Format(time.RFC3339))
+	var ids []string
+	for _, s := range r.Stops {
+		ids = append(ids, s.TransactionID)
+	}
+	assert.Equal(t, []string{"r-2", "r-3", "r-1"}, ids, "the pickups without a time are planned any day")
+	assert.Equal(t, "Donor r-2", r.Stops[0].GiverName)
+	assert.Equal(t, 18, r.Stops[0].WaitMinutes, "the volunteer is early for the first window")
+	last := r.Stops[2]
+	require.NotNil(t, last.WindowStart)
+	assert.True(t, last.Arrival.Before(*last.WindowStart))
+	assert.Equal(t, "2019-12-01T10:10:00+07:00", r.Finish.Format(time.RFC3339))
+	assert.Zero(t, r.LateMinutes)
+	assert.InDelta(t, 10, r.DistanceKm, 0.5)
+	require.Len(t, r.Unlocated, 1)
+	assert.Equal(t, "r-4", r.Unlocated[0].TransactionID)
+
+	require.Len(t, r.MapsLinks, 1)
+	u, err := url.Parse(r.MapsLinks[0])
+	require.NoError(t, err)
+	assert.Equal(t, "16.06,108.2", u.Query().Get("origin"))
+	assert.Equal(t, "16.06,108.2281|16.069,108.2281", u.Query().Get("waypoints"))
+	assert.Equal(t, "16.06,108.1953", u.Query().Get("destination"))
+
+	rec = routeRequest(srv, e, "v1", "start=16.06,108.2&date=2019-12-03")
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &r))
+	require.Len(t, r.Stops, 1)
+	assert.Equal(t, "r-3", r.Stops[0].TransactionID)
+
+	rec = routeRequest(srv, e, "v2", "start=16.06,108.2&date=2019-11-30")
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), `"stops":[]`)
+	assert.Contains(t, rec.Body.String(), `"mapsLinks":[]`)
+
+	rec = routeRequest(srv, e, "v9", "start=16.06,108.2")
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+	for _, query := range []string{"", "start=16.06", "start=north,108.2", "start=100,108.2", "start=16.06,108.2&date=01/12", "start=16.06,108.2&depart=8am"} {
+		rec = routeRequest(srv, e, "v1", query)
+		assert.Equal(t, http.StatusBadRequest, rec.Code, query)
+	}
+}
+
+func TestVolunteerRouteAuth(t *testing.T) {
+	srv, e := routeServer(t)
+	get := func(path, token string) int {
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		if token != "" {
+			req.Header.Set(echo.HeaderAuthorization, "Bearer "+token)
+		}
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+		return rec.Code
+	}
+	route := "/volunteers/v1/route?start=16.06,108.2"
+	later := time.Now().Add(time.Hour)
+	assert.Equal(t, http.StatusOK, get(route+"&token="+srv.RouteToken("v1", later), ""), "the links carry the token")
+	assert.Equal(t, http.StatusUnauthorized, get(route, ""))
+	assert.Equal(t, http.StatusUnauthorized, get(route, srv.RouteToken("v2", later)), "a volunteer only opens their route")
+	assert.Equal(t, http.StatusUnauthorized, get(route, srv.RouteToken("v1", time.Now().Add(-time.Minute))), "the token expired")
+	forged := strings.Replace(srv.RouteToken("v1", later), strconv.FormatInt(later.Unix(), 10), strconv.FormatInt(later.Add(24*time.Hour).Unix(), 10), 1)
+	assert.Equal(t, http.StatusUnauthorized, get(route, forged), "the expiry is signed")
+	assert.Equal(t, http.StatusUnauthorized, get(route, "not-a-token"))
+	assert.Equal(t, http.StatusUnauthorized, get(route, "admin-token"))
+	assert.Equal(t, http.StatusNotFound, get("/admin/volunteers/v1/route?start=16.06,108.2", "admin-token"))
+
+	rec := adminRequest(e, http.MethodGet, "/admin/volunteers/v1/route-token", "")
+	require.Equal(t, http.StatusOK, rec.Code)
+	var token map[string]string
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &token))
+	expires, err := time.Parse(time.RFC3339, token["expires"])
+	require.NoError(t, err)
+	assert.WithinDuration(t, time.Now().Add(routeTokenValidity), expires, time.Minute)
+	assert.Equal(t, srv.RouteToken("v1", expires), token["token"])
+	assert.Equal(t, http.StatusOK, get(route, token["token"]))
+
+	srv.RouteSecret = ""
+	e = echo.New()
+	srv.Register(e)
+	assert.Equal(t, http.StatusNotFound, get(route, srv.RouteToken("v1", later)), "the routes are disabled without a secret")
+}
+
+func TestRouteQueryDeparture(t *testing.T) {
+	srv, e := routeServer(t)
+	query := func(q string, now time.Time) time.Time {
+		c := e.NewContext(httptest.NewRequest(http.MethodGet, "/?start=16.06,108.2&"+q, nil), httptest.NewRecorder())
+		_, _, departure, err := srv.routeQuery(c, now)
+		require.NoError(t, err)
+		return departure.In(srv.ExportLocation)
+	}
+	morning := time.Date(2019, 12, 1, 6, 30, 0, 0, srv.ExportLocation)
+	noon := time.Date(2019, 12, 1, 12, 0, 0, 0, srv.ExportLocation)
+	assert.Equal(t, "2019-12-01 08:00", query("", morning).Format("2006-01-02 15:04"))
+	assert.Equal(t, "2019-12-01 12:00", query("", noon).Format("2006-01-02 15:04"), "the volunteer leaves now")
+	assert.Equal(t, "2019-12-02 08:00", query("date=2019-12-02", noon).Format("2006-01-02 15:04"))
+	assert.Equal(t, "2019-12-01 13:30", query("depart=13:30", noon).Format("2006-01-02 15:04"))
+}
@@ -0,0 +1,250 @@
+package webhook
+
+import (
+	"bytes"
+	"crypto/subtle"
+	"io/ioutil"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/echo/v4/middleware"
+	"go.opentelemetry.io/otel/api/trace"
+
+	"wcws/dialogflow"
+	"wcws/logging"
+	"wcws/notify"
+	"wcws/partner"
+	"wcws/route"
+)
+
+// Server serves the webhooks of the Dialogflow agents
+type Server struct {
+	Store    Store
+	Geocoder Geocoder
+	Dedupe   Deduper
+	Limiter  *RateLimiter
+	Alerter  Alerter
+	// IDTokens verifies the Google accounts linked by Assistant users, account
+	// linking is disabled when it is nil
+	IDTokens *dialogflow.IDTokenVerifier
+	// Notifier tells the donors and the volunteers about the pickups, they
+	// aren't notified when it is nil
+	Notifier notify.Notifier
+	// Mailer sends the receipts of the donations picked up to the donors who
+	// gave their email, they aren't sent when it is nil
+	Mailer notify.Mailer
+	// ReminderBefore is how long before the pickup the donor is reminded,
+	// DefaultReminderBefore when it is zero
+	ReminderBefore time.Duration
+	// Metrics are served on /metrics, nothing is measured when it is nil
+	Metrics *Metrics
+	// Tracer starts the spans of the webhook calls, the global tracer when
+	// it is nil
+	Tracer trace.Tracer
+	// Transcripts keeps the redacted turns of the conversations for
+	// TranscriptRetention, DefaultTranscriptRetention when it is zero. They
+	// aren't recorded when it is nil
+	Transcripts         TranscriptStore
+	TranscriptRetention time.Duration
+	// Redactor masks the personal data of the transcripts and of the alerts,
+	// logging.DefaultRedactor when it is nil
+	Redactor *logging.Redactor
+	// Partners tells the partner charities about the donations, they aren't
+	// told when it is nil
+	Partners *partner.Dispatcher
+	// ExportLocation is the time zone of the dates of the exports and of the
+	// days of the routes, UTC when it is nil
+	ExportLocation *time.Location
+	// RouteMatrix gives the legs of the routes of the volunteers,
+	// route.Haversine with its defaults when it is nil
+	RouteMatrix route.Matrix
+	// PickupWindow is how long after the time chosen by the donor a donation
+	// can be picked up, DefaultPickupWindow when it is zero. PickupDuration
+	// is how long a pickup takes, DefaultPickupDuration when it is zero
+	PickupWindow   time.Duration
+	PickupDuration time.Duration
+	// AdminToken authorizes the admin routes, they are disabled when it is
+	// empty
+	AdminToken string
+	// MapToken authorizes the volunteers on the map of the pickups, which is
+	// disabled when it is empty
+	MapToken string
+	// RouteSecret signs the route tokens of the volunteers, each one opens
+	// only the route of their volunteer until it expires. The routes are
+	// disabled when it is empty
+	RouteSecret string
+
+	background sync.WaitGroup
+	draining   int32
+}
+
+// New returns a server saving donations in the store and resolving the
+// addresses of the donors with the geocoder. Retried requests are answered
+// from memory for DefaultDedupeTTL
+func New(store Store, geocoder Geocoder) *Server {
+	return &Server{Store: store, Geocoder: geocoder, Dedupe: NewMemoryDeduper(DefaultDedupeTTL)}
+}
+
+// Register adds the webhook routes
+func (s *Server) Register(e *echo.Echo) {
+	e.POST("/webhook", s.webhook)
+	e.POST("/webhook/es", s.esWebhook)
+	e.POST("/webhook/cx", s.cxWebhook)
+	e.GET("/healthz", s.healthz)
+	e.GET("/readyz", s.readyz)
+	if s.Metrics != nil {
+		e.GET("/metrics", echo.WrapHandler(s.Metrics.Handler()))
+	}
+	if s.AdminToken != "" {
+		s.registerAdmin(e)
+	}
+	if s.MapToken != "" {
+		s.registerMap(e)
+	}
+	if s.RouteSecret != "" {
+		e.GET("/volunteers/:id/route", s.volunteerRoute, s.routeAuth)
+	}
+}
+
+// registerAdmin adds the admin routes, authorized by the bearer token
+func (s *Server) registerAdmin(e *echo.Echo) {
+	admin := e.Group("/admin", middleware.KeyAuth(func(key string, e echo.Context) (bool, error) {
+		return subtle.ConstantTimeCompare([]byte(key), []byte(s.AdminToken)) == 1, nil
+	}))
+	admin.GET("/transactions/export", s.exportTransactions)
+	if s.RouteSecret != "" {
+		admin.GET("/volunteers/:id/route-token", s.volunteerRouteToken)
+	}
+	if s.Transcripts != nil {
+		admin.GET("/transcripts", s.transcript)
+	}
+	if s.Partners != nil {
+		s.registerPartners(admin)
+	}
+}
+
+// webhook serves both ES and CX agents, the protocol is detected from the
+// payload
+func (s *Server) webhook(e echo.Context) error {
+	body, err := ioutil.ReadAll(e.Request().Body)
+	if err != nil {
+		return err
+	}
+	e.Request().Body = ioutil.NopCloser(bytes.NewReader(body))
+	if dialogflow.IsCXRequest(body) {
+		return s.cxWebhook(e)
+	}
+	return s.esWebhook(e)
+}
+
+func (s *Server) esWebhook(e echo.Context) error {
+	dr := dialogflow.Request{}
+	err := e.Bind(&dr)
+	if err != nil {
+		logging.FromContext(e.Request().Context()).Warn("invalid ES request", "err", err)
+		return err
+	}
+	rs, err := s.dispatch(e, dr)
+	if err != nil {
+		return ErrResponse(e)
+	}
+	return e.JSON(http.StatusOK, rs)
+}
+
+func (s *Server) cxWebhook(e echo.Context) error {
+	cr := dialogflow.CXRequest{}
+	err := e.Bind(&cr)
+	if err != nil {
+		logging.FromContext(e.Request().Context()).Warn("invalid CX request", "err", err)
+		return err
+	}
+	dr, err := cr.ESRequest("information")
+	if err != nil {
+		return e.JSON(http.StatusOK, dialogflow.CXResponse{})
+	}
+	rs, err := s.dispatch(e, dr)
+	if err != nil {
+		return e.JSON(http.StatusOK, dialogflow.CXResponse{})
+	}
+	crs, err := cr.CXResponse(rs)
+	if err != nil {
+		return e.JSON(http.StatusOK, dialogflow.CXResponse{})
+	}
+	return e.JSON(http.StatusOK, crs)
+}
+
+// dispatch runs the handler of the action of the request and makes sure the
+// fulfillment it returns is accepted by the platforms. A request already
+// answered gets the same fulfillment again, a request retried while the first
+// one runs waits for its fulfillment
+func (s *Server) dispatch(e echo.Context, dr dialogflow.Request) (rs *dialogflow.Fulfillment, err error) {
+	ctx, end := startSpan(e.Request().Context(), s.tracer(), "webhook."+dr.QueryResult.Action, requestAttributes(dr)...)
+	logger := logging.FromContext(ctx).With("session", dr.Session, "responseId", dr.ResponseID, "action", dr.QueryResult.Action)
+	ctx = logging.WithContext(ctx, logger)
+	e.SetRequest(e.Request().WithContext(ctx))
+	defer func(start time.Time) {
+		end(err)
+		action := dr.QueryResult.Action
+		if err == errUnknownAction {
+			// keeps the labels bounded whatever is posted
+			action = "unknown"
+		}
+		s.Metrics.observeRequest(action, platformLabel(dr), start, err)
+		s.recordTurn(ctx, dr, rs, err)
+	}(time.Now())
+	dedupe := s.Dedupe != nil && dr.ResponseID != ""
+	if dedupe {
+		answered, reserved, err := s.Dedupe.Reserve(ctx, dr.ResponseID)
+		switch {
+		case err != nil:
+			logger.Error("reserving the response", "err", err)
+			return nil, err
+		case !reserved:
+			return answered, nil
+		}
+		defer func() {
+			if rs == nil {
+				if err := s.Dedupe.Release(ctx, dr.ResponseID); err != nil {
+					logger.Error("releasing the response", "err", err)
+				}
+			}
+		}()
+	}
+	rs, err = s.runAction(e, dr)
+	if err != nil {
+		return nil, err
+	}
+	rs.Degrade()
+	if err := rs.Validate(); err != nil {
+		logger.Error("invalid fulfillment", "err", err)
+		return nil, err
+	}
+	if dedupe {
+		if err := s.Dedupe.Put(ctx, dr.ResponseID, rs); err != nil {
+			logger.Error("recording the answer", "err", err)
+		}
+	}
+	return rs, nil
+}
+
+// runAction runs the handler of the action of the request. For CX agents the
+// action is the fulfillment tag
+func (s *Server) runAction(e echo.Context, dr dialogflow.Request) (*dialogflow.Fulfillment, error) {
+	switch dr.QueryResult.Action {
+	case "welcome":
+		return s.welcomeHandler(e, dr)
+	case "collect":
+		return s.addLocationPermissionRequest(e, dr)
+	case "getPermission":
+		return s.permissionHander(e, dr)
+	case "history":
+		return s.donationHistoryHandler(e, dr)
+	case "signIn":
+		return s.signInHandler(e, dr)
+	case "signInResult":
+		return s.signInResultHandler(e, dr)
+	}
+	return nil, errUnknownAction
+}
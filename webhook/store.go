@@ -0,0 +1,683 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"cloud.google.com/go/firestore"
+	firebase "firebase.google.com/go"
+	"google.golang.org/api/iterator"
+	"google.golang.org/api/option"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"wcws/config"
+	"wcws/notify"
+)
+
+// ErrTransactionExists is returned with the ID of a donation already saved,
+// by an earlier try of the same request
+var ErrTransactionExists = errors.New("transaction already exists")
+
+// openStatuses are the statuses of the donations not picked up yet
+var openStatuses = []string{"pending", "assigned"}
+
+// Store reads the events and saves the donations and the donors
+type Store interface {
+	ActiveEvents(ctx context.Context) ([]Event, error)
+	// AddTransaction saves a donation and returns its ID, the given one or a
+	// new one when it is empty. It returns the ID with ErrTransactionExists
+	// when a donation was already saved with it
+	AddTransaction(ctx context.Context, id string, trans Transactions) (string, error)
+	// FindDonor returns the donor with one of the keys, or nil
+	FindDonor(ctx context.Context, keys DonorKeys) (*Donor, error)
+	SaveDonor(ctx context.Context, donor *Donor) error
+	DonorTransactions(ctx context.Context, donorID string) ([]Transactions, error)
+	// OpenPickups returns the donations which haven't been picked up yet
+	OpenPickups(ctx context.Context) ([]StoredTransaction, error)
+	// ClaimNotification claims the notification of the event until the time,
+	// so that it is sent once. It returns false when the notification was
+	// sent or is claimed until after now
+	ClaimNotification(ctx context.Context, id string, event notify.Event, now, until time.Time) (bool, error)
+	// ReleaseNotification gives up the claim of a notification which
+	// couldn't be sent
+	ReleaseNotification(ctx context.Context, id string, event notify.Event) error
+	// PendingReceipts returns the donations picked up whose receipt wasn't
+	// sent yet
+	PendingReceipts(ctx context.Context) ([]StoredTransaction, error)
+	// MarkNotified records that the notification of the event was sent
+	MarkNotified(ctx context.Context, id string, event notify.Event) error
+	// Volunteer returns the volunteer with the ID, or nil
+	Volunteer(ctx context.Context, id string) (*Volunteer, error)
+	// RecentTransactions returns the donations created since the time
+	RecentTransactions(ctx context.Context, since time.Time) ([]StoredTransaction, error)
+	// MarkPublished records the status the partners were told about
+	MarkPublished(ctx context.Context, id, status string) error
+	// EachTransaction calls fn with the donations matching the filter, the
+	// oldest first, without loading them all. It stops at the first error
+	EachTransaction(ctx context.Context, filter TransactionFilter, fn func(StoredTransaction) error) error
+}
+
+// TransactionFilter selects donations, its zero values select all of them
+type TransactionFilter struct {
+	Status  string
+	EventID float64
+	// From and To bound the creation of the donations, To excluded
+	From time.Time
+	To   time.Time
+}
+
+// matches reports whether the donation is selected by the status and the
+// event of the filter
+func (f TransactionFilter) matches(t Transactions) bool {
+	return (f.Status == "" || t.Status == f.Status) && (f.EventID == 0 || t.EventId == f.EventID)
+}
+
+// StoredTransaction is a saved donation with its ID
+type StoredTransaction struct {
+	ID string
+	Transactions
+}
+
+// TransactionID returns the ID of the document of the transaction created
+// while answering the request with the given response ID, so that a retried
+// request doesn't save the donation twice. It is empty when there is no
+// response ID and the store picks one
+func TransactionID(responseID string) string {
+	return strings.Replace(responseID, "/", "_", -1)
+}
+
+// FirestoreStore is the Store of the production, backed by the Firestore
+// database of the Firebase project
+type FirestoreStore struct {
+	client *firestore.Client
+}
+
+// NewFirestoreStore connects to the Firestore database of the configured
+// Firebase project
+func NewFirestoreStore(ctx context.Context, cfg config.Firestore) (*FirestoreStore, error) {
+	var fbConfig *firebase.Config
+	if cfg.ProjectID != "" {
+		fbConfig = &firebase.Config{ProjectID: cfg.ProjectID}
+	}
+	var opts []option.ClientOption
+	if cfg.CredentialsFile != "" {
+		opts = append(opts, option.WithCredentialsFile(cfg.CredentialsFile))
+	}
+	app, err := firebase.NewApp(ctx, fbConfig, opts...)
+	if err != nil {
+		return nil, err
+	}
+	client, err := app.Firestore(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &FirestoreStore{client: client}, nil
+}
+
+// Ping reads an event to check that the database can be reached
+func (s *FirestoreStore) Ping(ctx context.Context) error {
+	docs := s.client.Collection("events").Limit(1).Documents(ctx)
+	defer docs.Stop()
+	if _, err := docs.Next(); err != nil && err != iterator.Done {
+		return err
+	}
+	return nil
+}
+
+// ActiveEvents returns the events with an active status
+func (s *FirestoreStore) ActiveEvents(ctx context.Context) ([]Event, error) {
+	var events []Event
+	collections := s.client.Collection("events").Where("status", "==", true).Documents(ctx)
+	for {
+		var event Event
+		doc, err := collections.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		byteData, err := json.Marshal(doc.Data())
+		if err != nil {
+			return nil, err
+		}
+		err = json.Unmarshal(byteData, &event)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, event)
+	}
+	return events, nil
+}
+
+// AddTransaction saves a new donation in the document with the given ID. A
+// document which already exists is left untouched, it may have been updated
+// by the volunteers since, and ErrTransactionExists is returned
+func (s *FirestoreStore) AddTransaction(ctx context.Context, id string, trans Transactions) (string, error) {
+	if id == "" {
+		doc, _, err := s.client.Collection("transactions").Add(ctx, trans)
+		if err != nil {
+			return "", err
+		}
+		return doc.ID, nil
+	}
+	_, err := s.client.Collection("transactions").Doc(id).Create(ctx, trans)
+	if status.Code(err) == codes.AlreadyExists {
+		return id, ErrTransactionExists
+	}
+	if err != nil {
+		return "", err
+	}
+	return id, nil
+}
+
+// FindDonor returns the donor with one of the keys, or nil. A phone number
+// only matches a donor with the same platform identities
+func (s *FirestoreStore) FindDonor(ctx context.Context, keys DonorKeys) (*Donor, error) {
+	for _, k := range keys.fields() {
+		q := s.client.Collection("donors").Where(k.field, "==", k.value)
+		if k.field != "phone" {
+			q = q.Limit(1)
+		}
+		docs, err := q.Documents(ctx).GetAll()
+		if err != nil {
+			return nil, err
+		}
+		for _, doc := range docs {
+			donor := &Donor{}
+			if err := doc.DataTo(donor); err != nil {
+				return nil, err
+			}
+			if k.field == "phone" && !keys.agrees(*donor) {
+				continue
+			}
+			donor.ID = doc.Ref.ID
+			return donor, nil
+		}
+	}
+	return nil, nil
+}
+
+// SaveDonor creates or updates the donor
+func (s *FirestoreStore) SaveDonor(ctx context.Context, donor *Donor) error {
+	_, err := s.client.Collection("donors").Doc(donor.ID).Set(ctx, donor)
+	return err
+}
+
+// DonorTransactions returns the donations of the donor
+func (s *FirestoreStore) DonorTransactions(ctx context.Context, donorID string) ([]Transactions, error) {
+	docs, err := s.client.Collection("transactions").Where("donorId", "==", donorID).Documents(ctx).GetAll()
+	if err != nil {
+		return nil, err
+	}
+	var transactions []Transactions
+	for _, doc := range docs {
+		var trans Transactions
+		if err := doc.DataTo(&trans); err != nil {
+			return nil, err
+		}
+		transactions = append(transactions, trans)
+	}
+	return transactions, nil
+}
+
+// OpenPickups returns the pending and assigned donations
+func (s *FirestoreStore) OpenPickups(ctx context.Context) ([]StoredTransaction, error) {
+	var pickups []StoredTransaction
+	for _, status := range openStatuses {
+		docs, err := s.client.Collection("transactions").Where("status", "==", status).Documents(ctx).GetAll()
+		if err != nil {
+			return nil, err
+		}
+		for _, doc := range docs {
+			t := StoredTransaction{ID: doc.Ref.ID}
+			if err := doc.DataTo(&t.Transactions); err != nil {
+				return nil, err
+			}
+			pickups = append(pickups, t)
+		}
+	}
+	return pickups, nil
+}
+
+// PendingReceipts returns the donations done whose receipt is pending, the
+// marker keeps the query to the receipts to send
+func (s *FirestoreStore) PendingReceipts(ctx context.Context) ([]StoredTransaction, error) {
+	docs, err := s.client.Collection("transactions").Where("status", "==", "done").Where("receiptPending", "==", true).Documents(ctx).GetAll()
+	if err != nil {
+		return nil, err
+	}
+	var transactions []StoredTransaction
+	for _, doc := range docs {
+		t := StoredTransaction{ID: doc.Ref.ID}
+		if err := doc.DataTo(&t.Transactions); err != nil {
+			return nil, err
+		}
+		transactions = append(transactions, t)
+	}
+	return transactions, nil
+}
+
+// ClaimNotification claims the notification in a transaction, the instances
+// polling the pickups and the webhook don't send it twice
+func (s *FirestoreStore) ClaimNotification(ctx context.Context, id string, event notify.Event, now, until time.Time) (bool, error) {
+	ref := s.client.Collection("transactions").Doc(id)
+	var claimed bool
+	err := s.client.RunTransaction(ctx, func(ctx context.Context, tx *firestore.Transaction) error {
+		claimed = false
+		doc, err := tx.Get(ref)
+		if err != nil {
+			return err
+		}
+		var t Transactions
+		if err := doc.DataTo(&t); err != nil {
+			return err
+		}
+		if t.Notified[string(event)] || t.Sending[string(event)] > now.Unix() {
+			return nil
+		}
+		claimed = true
+		return tx.Update(ref, []firestore.Update{
+			{FieldPath: firestore.FieldPath{"sending", string(event)}, Value: until.Unix()},
+		})
+	})
+	return claimed, err
+}
+
+// ReleaseNotification removes the claim of the notification
+func (s *FirestoreStore) ReleaseNotification(ctx context.Context, id string, event notify.Event) error {
+	_, err := s.client.Collection("transactions").Doc(id).Update(ctx, []firestore.Update{
+		{FieldPath: firestore.FieldPath{"sending", string(event)}, Value: firestore.Delete},
+	})
+	return err
+}
+
+// MarkNotified records that the notification of the event was sent
+func (s *FirestoreStore) MarkNotified(ctx context.Context, id string, event notify.Event) error {
+	updates := []firestore.Update{
+		{FieldPath: firestore.FieldPath{"notified", string(event)}, Value: true},
+		{FieldPath: firestore.FieldPath{"sending", string(event)}, Value: firestore.Delete},
+	}
+	if event == notify.EventReceipt {
+		updates = append(updates, firestore.Update{Path: "receiptPending", Value: firestore.Delete})
+	}
+	_, err := s.client.Collection("transactions").Doc(id).Update(ctx, updates)
+	return err
+}
+
+// RecentTransactions returns the donations created since the time
+func (s *FirestoreStore) RecentTransactions(ctx context.Context, since time.Time) ([]StoredTransaction, error) {
+	docs, err := s.client.Collection("transactions").Where("createdDate", ">=", since.Unix()).Documents(ctx).GetAll()
+	if err != nil {
+		return nil, err
+	}
+	var transactions []StoredTransaction
+	for _, doc := range docs {
+		t := StoredTransaction{ID: doc.Ref.ID}
+		if err := doc.DataTo(&t.Transactions); err != nil {
+			return nil, err
+		}
+		transactions = append(transactions, t)
+	}
+	return transactions, nil
+}
+
+// MarkPublished records the status the partners were told about
+func (s *FirestoreStore) MarkPublished(ctx context.Context, id, status string) error {
+	_, err := s.client.Collection("transactions").Doc(id).Update(ctx, []firestore.Update{
+		{Path: "publishedStatus", Value: status},
+	})
+	return err
+}
+
+// EachTransaction calls fn with the donations matching the filter, the
+// oldest first. Only the dates are queried, filtering the status and the
+// event in the query would need composite indexes
+func (s *FirestoreStore) EachTransaction(ctx context.Context, filter TransactionFilter, fn func(StoredTransaction) error) error {
+	q := s.client.Collection("transactions").Query
+	if !filter.From.IsZero() {
+		q = q.Where("createdDate", ">=", filter.From.Unix())
+	}
+	if !filter.To.IsZero() {
+		q = q.Where("createdDate", "<", filter.To.Unix())
+	}
+	docs := q.OrderBy("createdDate", firestore.Asc).Documents(ctx)
+	defer docs.Stop()
+	for {
+		doc, err := docs.Next()
+		if err == iterator.Done {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		t := StoredTransaction{ID: doc.Ref.ID}
+		if err := doc.DataTo(&t.Transactions); err != nil {
+			return err
+		}
+		if !filter.matches(t.Transactions) {
+			continue
+		}
+		if err := fn(t); err != nil {
+			return err
+		}
+	}
+}
+
+// Volunteer returns the volunteer with the ID, or nil
+func (s *FirestoreStore) Volunteer(ctx context.Context, id string) (*Volunteer, error) {
+	doc, err := s.client.Collection("volunteers").Doc(id).Get(ctx)
+	if status.Code(err) == codes.NotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	v := &Volunteer{}
+	if err := doc.DataTo(v); err != nil {
+		return nil, err
+	}
+	v.ID = doc.Ref.ID
+	return v, nil
+}
+
+// MemoryStore is a Store keeping everything in memory, for tests and local
+// simulations
+type MemoryStore struct {
+	mu           sync.Mutex
+	events       []Event
+	transactions []StoredTransaction
+	donors       map[string]Donor
+	volunteers   map[string]Volunteer
+}
+
+// NewMemoryStore returns a store with the given events
+func NewMemoryStore(events []Event) *MemoryStore {
+	return &MemoryStore{events: events, donors: make(map[string]Donor), volunteers: make(map[string]Volunteer)}
+}
+
+// ActiveEvents returns the events with an active status
+func (s *MemoryStore) ActiveEvents(ctx context.Context) ([]Event, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var events []Event
+	for _, e := range s.events {
+		if e.Status {
+			events = append(events, e)
+		}
+	}
+	return events, nil
+}
+
+// AddTransaction saves a new donation, unless one was already saved with the
+// same ID
+func (s *MemoryStore) AddTransaction(ctx context.Context, id string, trans Transactions) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if id == "" {
+		id = fmt.Sprintf("memory-%d", len(s.transactions)+1)
+	}
+	if s.transaction(id) != nil {
+		return id, ErrTransactionExists
+	}
+	s.transactions = append(s.transactions, StoredTransaction{ID: id, Transactions: trans})
+	return id, nil
+}
+
+func (s *MemoryStore) transaction(id string) *StoredTransaction {
+	for i := range s.transactions {
+		if s.transactions[i].ID == id {
+			return &s.transactions[i]
+		}
+	}
+	return nil
+}
+
+// AddedTransactions returns the donations saved so far
+func (s *MemoryStore) AddedTransactions() []Transactions {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var transactions []Transactions
+	for _, t := range s.transactions {
+		transactions = append(transactions, t.Transactions)
+	}
+	return transactions
+}
+
+// FindDonor returns the donor with one of the keys, or nil. A phone number
+// only matches a donor with the same platform identities
+func (s *MemoryStore) FindDonor(ctx context.Context, keys DonorKeys) (*Donor, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, k := range keys.fields() {
+		for _, d := range s.donors {
+			if d.key(k.field) == k.value && (k.field != "phone" || keys.agrees(d)) {
+				return &d, nil
+			}
+		}
+	}
+	return nil, nil
+}
+
+// SaveDonor creates or updates the donor
+func (s *MemoryStore) SaveDonor(ctx context.Context, donor *Donor) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.donors[donor.ID] = *donor
+	return nil
+}
+
+// DonorTransactions returns the donations of the donor
+func (s *MemoryStore) DonorTransactions(ctx context.Context, donorID string) ([]Transactions, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var transactions []Transactions
+	for _, t := range s.transactions {
+		if t.DonorID == donorID {
+			transactions = append(transactions, t.Transactions)
+		}
+	}
+	return transactions, nil
+}
+
+// OpenPickups returns the pending and assigned donations
+func (s *MemoryStore) OpenPickups(ctx context.Context) ([]StoredTransaction, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var pickups []StoredTransaction
+	for _, t := range s.transactions {
+		for _, status := range openStatuses {
+			if t.Status == status {
+				pickups = append(pickups, copyTransaction(t))
+			}
+		}
+	}
+	return pickups, nil
+}
+
+// ClaimNotification claims the notification of the event until the time
+func (s *MemoryStore) ClaimNotification(ctx context.Context, id string, event notify.Event, now, until time.Time) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	t := s.transaction(id)
+	if t == nil {
+		return false, fmt.Errorf("no transaction %q", id)
+	}
+	if t.Notified[string(event)] || t.Sending[string(event)] > now.Unix() {
+		return false, nil
+	}
+	sending := map[string]int64{string(event): until.Unix()}
+	for k, v := range t.Sending {
+		if k != string(event) {
+			sending[k] = v
+		}
+	}
+	t.Sending = sending
+	return true, nil
+}
+
+// ReleaseNotification removes the claim of the notification
+func (s *MemoryStore) ReleaseNotification(ctx context.Context, id string, event notify.Event) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	t := s.transaction(id)
+	if t == nil {
+		return fmt.Errorf("no transaction %q", id)
+	}
+	t.Sending = withoutClaim(t.Sending, event)
+	return nil
+}
+
+// PendingReceipts returns the donations done whose receipt is pending
+func (s *MemoryStore) PendingReceipts(ctx context.Context) ([]StoredTransaction, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var transactions []StoredTransaction
+	for _, t := range s.transactions {
+		if t.Status == "done" && t.ReceiptPending {
+			transactions = append(transactions, copyTransaction(t))
+		}
+	}
+	return transactions, nil
+}
+
+// MarkNotified records that the notification of the event was sent
+func (s *MemoryStore) MarkNotified(ctx context.Context, id string, event notify.Event) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	t := s.transaction(id)
+	if t == nil {
+		return fmt.Errorf("no transaction %q", id)
+	}
+	notified := map[string]bool{string(event): true}
+	for k, v := range t.Notified {
+		notified[k] = v
+	}
+	t.Notified = notified
+	t.Sending = withoutClaim(t.Sending, event)
+	if event == notify.EventReceipt {
+		t.ReceiptPending = false
+	}
+	return nil
+}
+
+// withoutClaim returns a copy of the claims without the one of the event, the
+// maps are shared with the copies handed out
+func withoutClaim(sending map[string]int64, event notify.Event) map[string]int64 {
+	claims := make(map[string]int64, len(sending))
+	for k, v := range sending {
+		if k != string(event) {
+			claims[k] = v
+		}
+	}
+	return claims
+}
+
+// RecentTransactions returns the donations created since the time
+func (s *MemoryStore) RecentTransactions(ctx context.Context, since time.Time) ([]StoredTransaction, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var transactions []StoredTransaction
+	for _, t := range s.transactions {
+		if t.CreatedDate >= since.Unix() {
+			transactions = append(transactions, copyTransaction(t))
+		}
+	}
+	return transactions, nil
+}
+
+// MarkPublished records the status the partners were told about
+func (s *MemoryStore) MarkPublished(ctx context.Context, id, status string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	t := s.transaction(id)
+	if t == nil {
+		return fmt.Errorf("no transaction %q", id)
+	}
+	t.PublishedStatus = status
+	return nil
+}
+
+// EachTransaction calls fn with the donations matching the filter, the
+// oldest first
+func (s *MemoryStore) EachTransaction(ctx context.Context, filter TransactionFilter, fn func(StoredTransaction) error) error {
+	s.mu.Lock()
+	var transactions []StoredTransaction
+	for _, t := range s.transactions {
+		created := time.Unix(t.CreatedDate, 0)
+		if !filter.matches(t.Transactions) || created.Before(filter.From) || (!filter.To.IsZero() && !created.Before(filter.To)) {
+			continue
+		}
+		transactions = append(transactions, copyTransaction(t))
+	}
+	s.mu.Unlock()
+	sort.SliceStable(transactions, func(i, j int) bool { return transactions[i].CreatedDate < transactions[j].CreatedDate })
+	for _, t := range transactions {
+		if err := fn(t); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Volunteer returns the volunteer with the ID, or nil
+func (s *MemoryStore) Volunteer(ctx context.Context, id string) (*Volunteer, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	v, ok := s.volunteers[id]
+	if !ok {
+		return nil, nil
+	}
+	return &v, nil
+}
+
+// AddVolunteer adds a volunteer to the store
+func (s *MemoryStore) AddVolunteer(v Volunteer) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.volunteers[v.ID] = v
+}
+
+// AssignVolunteer assigns the pickup to the volunteer, like the coordinators
+// do in the Firestore console
+func (s *MemoryStore) AssignVolunteer(id, volunteerID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	t := s.transaction(id)
+	if t == nil {
+		return fmt.Errorf("no transaction %q", id)
+	}
+	t.VolunteerId = volunteerID
+	t.Status = "assigned"
+	return nil
+}
+
+// SetStatus changes the status of the donation, like the volunteers do once
+// it is picked up
+func (s *MemoryStore) SetStatus(id, status string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	t := s.transaction(id)
+	if t == nil {
+		return fmt.Errorf("no transaction %q", id)
+	}
+	t.Status = status
+	return nil
+}
+
+func copyTransaction(t StoredTransaction) StoredTransaction {
+	notified := make(map[string]bool, len(t.Notified))
+	for k, v := range t.Notified {
+		notified[k] = v
+	}
+	t.Notified = notified
+	t.Sending = withoutClaim(t.Sending, "")
+	return t
+}
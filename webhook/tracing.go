@@ -0,0 +1,198 @@
+package webhook
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/otel/api/global"
+	"go.opentelemetry.io/otel/api/kv"
+	"go.opentelemetry.io/otel/api/trace"
+
+	"wcws/dialogflow"
+	"wcws/notify"
+)
+
+// tracerName names the spans of the webhook
+const tracerName = "wcws/webhook"
+
+// tracer is the tracer of the server, the global one unless a test set one
+func (s *Server) tracer() trace.Tracer {
+	if s.Tracer != nil {
+		return s.Tracer
+	}
+	return global.Tracer(tracerName)
+}
+
+// startSpan starts a span and returns the function ending it with the error
+// of the call
+func startSpan(ctx context.Context, tracer trace.Tracer, name string, attrs ...kv.KeyValue) (context.Context, func(error)) {
+	ctx, span := tracer.Start(ctx, name, trace.WithAttributes(attrs...))
+	return ctx, func(err error) {
+		if err != nil {
+			span.RecordError(ctx, err)
+		}
+		span.End()
+	}
+}
+
+// requestAttributes describe the Dialogflow turn of a span
+func requestAttributes(dr dialogflow.Request) []kv.KeyValue {
+	return []kv.KeyValue{
+		kv.String("dialogflow.session", dr.Session),
+		kv.String("dialogflow.action", dr.QueryResult.Action),
+		kv.String("dialogflow.source", dr.OriginalDetectIntentRequest.Source),
+		kv.String("dialogflow.response_id", dr.ResponseID),
+	}
+}
+
+// TraceStore starts a span for every call to the store
+func TraceStore(store Store, tracer trace.Tracer) Store {
+	return &tracedStore{Store: store, tracer: tracer}
+}
+
+type tracedStore struct {
+	Store
+	tracer trace.Tracer
+}
+
+func (s *tracedStore) start(ctx context.Context, operation string) (context.Context, func(error)) {
+	return startSpan(ctx, s.tracer, "store."+operation, kv.String("store.operation", operation))
+}
+
+func (s *tracedStore) ActiveEvents(ctx context.Context) ([]Event, error) {
+	ctx, end := s.start(ctx, "ActiveEvents")
+	events, err := s.Store.ActiveEvents(ctx)
+	end(err)
+	return events, err
+}
+
+func (s *tracedStore) AddTransaction(ctx context.Context, id string, trans Transactions) (string, error) {
+	ctx, end := s.start(ctx, "AddTransaction")
+	id, err := s.Store.AddTransaction(ctx, id, trans)
+	if err == ErrTransactionExists {
+		end(nil)
+	} else {
+		end(err)
+	}
+	return id, err
+}
+
+func (s *tracedStore) FindDonor(ctx context.Context, keys DonorKeys) (*Donor, error) {
+	ctx, end := s.start(ctx, "FindDonor")
+	donor, err := s.Store.FindDonor(ctx, keys)
+	end(err)
+	return donor, err
+}
+
+func (s *tracedStore) SaveDonor(ctx context.Context, donor *Donor) error {
+	ctx, end := s.start(ctx, "SaveDonor")
+	err := s.Store.SaveDonor(ctx, donor)
+	end(err)
+	return err
+}
+
+func (s *tracedStore) DonorTransactions(ctx context.Context, donorID string) ([]Transactions, error) {
+	ctx, end := s.start(ctx, "DonorTransactions")
+	transactions, err := s.Store.DonorTransactions(ctx, donorID)
+	end(err)
+	return transactions, err
+}
+
+func (s *tracedStore) OpenPickups(ctx context.Context) ([]StoredTransaction, error) {
+	ctx, end := s.start(ctx, "OpenPickups")
+	pickups, err := s.Store.OpenPickups(ctx)
+	end(err)
+	return pickups, err
+}
+
+func (s *tracedStore) ClaimNotification(ctx context.Context, id string, event notify.Event, now, until time.Time) (bool, error) {
+	ctx, end := s.start(ctx, "ClaimNotification")
+	claimed, err := s.Store.ClaimNotification(ctx, id, event, now, until)
+	end(err)
+	return claimed, err
+}
+
+func (s *tracedStore) ReleaseNotification(ctx context.Context, id string, event notify.Event) error {
+	ctx, end := s.start(ctx, "ReleaseNotification")
+	err := s.Store.ReleaseNotification(ctx, id, event)
+	end(err)
+	return err
+}
+
+func (s *tracedStore) PendingReceipts(ctx context.Context) ([]StoredTransaction, error) {
+	ctx, end := s.start(ctx, "PendingReceipts")
+	transactions, err := s.Store.PendingReceipts(ctx)
+	end(err)
+	return transactions, err
+}
+
+func (s *tracedStore) MarkNotified(ctx context.Context, id string, event notify.Event) error {
+	ctx, end := s.start(ctx, "MarkNotified")
+	err := s.Store.MarkNotified(ctx, id, event)
+	end(err)
+	return err
+}
+
+func (s *tracedStore) Volunteer(ctx context.Context, id string) (*Volunteer, error) {
+	ctx, end := s.start(ctx, "Volunteer")
+	volunteer, err := s.Store.Volunteer(ctx, id)
+	end(err)
+	return volunteer, err
+}
+
+func (s *tracedStore) RecentTransactions(ctx context.Context, since time.Time) ([]StoredTransaction, error) {
+	ctx, end := s.start(ctx, "RecentTransactions")
+	transactions, err := s.Store.RecentTransactions(ctx, since)
+	end(err)
+	return transactions, err
+}
+
+func (s *tracedStore) MarkPublished(ctx context.Context, id, status string) error {
+	ctx, end := s.start(ctx, "MarkPublished")
+	err := s.Store.MarkPublished(ctx, id, status)
+	end(err)
+	return err
+}
+
+func (s *tracedStore) EachTransaction(ctx context.Context, filter TransactionFilter, fn func(StoredTransaction) error) error {
+	ctx, end := s.start(ctx, "EachTransaction")
+	err := s.Store.EachTransaction(ctx, filter, fn)
+	end(err)
+	return err
+}
+
+// Ping pings the store when it can be pinged, without a span so that the
+// probes don't flood the traces
+func (s *tracedStore) Ping(ctx context.Context) error {
+	if p, ok := s.Store.(Pinger); ok {
+		return p.Ping(ctx)
+	}
+	return nil
+}
+
+// TraceGeocoder starts a span for every lookup of the geocoder of the
+// provider
+func TraceGeocoder(geocoder Geocoder, provider string, tracer trace.Tracer) Geocoder {
+	return &tracedGeocoder{Geocoder: geocoder, provider: provider, tracer: tracer}
+}
+
+type tracedGeocoder struct {
+	Geocoder
+	provider string
+	tracer   trace.Tracer
+}
+
+func (g *tracedGeocoder) Address(ctx context.Context, c dialogflow.Coordinates) (string, error) {
+	ctx, end := startSpan(ctx, g.tracer, "geocoder.Address", kv.String("geocoder.provider", g.provider))
+	address, err := g.Geocoder.Address(ctx, c)
+	end(err)
+	return address, err
+}
+
+// Ping pings the geocoder when it can be pinged
+func (g *tracedGeocoder) Ping(ctx context.Context) error {
+	if p, ok := g.Geocoder.(Pinger); ok {
+		return p.Ping(ctx)
+	}
+	return nil
+}
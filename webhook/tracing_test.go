@@ -0,0 +1,43 @@
+package webhook
+
+import (
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/api/trace/testtrace"
+)
+
+func TestTracing(t *testing.T) {
+	tracer := testtrace.NewTracer()
+	srv := goldenServer()
+	srv.Tracer = tracer
+	srv.Store = TraceStore(srv.Store, tracer)
+	srv.Geocoder = TraceGeocoder(srv.Geocoder, "opencage", tracer)
+	e := echo.New()
+	srv.Register(e)
+
+	postWebhook(t, e, messengerPermission)
+
+	spans := tracer.Spans()
+	require.NotEmpty(t, spans)
+	root := spans[0]
+	assert.Equal(t, "webhook.getPermission", root.Name())
+	assert.True(t, root.Ended())
+	attrs := root.Attributes()
+	assert.Equal(t, "getPermission", attrs["dialogflow.action"].AsString())
+	assert.Equal(t, "facebook", attrs["dialogflow.source"].AsString())
+	assert.NotEmpty(t, attrs["dialogflow.session"].AsString())
+
+	var children []string
+	for _, s := range spans[1:] {
+		assert.Equal(t, root.SpanContext().TraceID, s.SpanContext().TraceID, s.Name())
+		assert.Equal(t, root.SpanContext().SpanID, s.ParentSpanID(), s.Name())
+		assert.True(t, s.Ended(), s.Name())
+		children = append(children, s.Name())
+	}
+	assert.Contains(t, children, "geocoder.Address")
+	assert.Contains(t, children, "store.AddTransaction")
+	assert.Contains(t, children, "store.SaveDonor")
+}
@@ -0,0 +1,445 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"cloud.google.com/go/firestore"
+	"github.com/labstack/echo/v4"
+	"google.golang.org/api/iterator"
+
+	"wcws/dialogflow"
+	"wcws/logging"
+)
+
+// DefaultTranscriptRetention is how long the turns are kept when the server
+// has no retention
+const DefaultTranscriptRetention = 30 * 24 * time.Hour
+
+// transcriptTimeout bounds the saving of a turn in the background of a
+// request
+const transcriptTimeout = 10 * time.Second
+
+// Turn is a redacted turn of a conversation: what Dialogflow understood of
+// the query of the user, and the fulfillment the webhook answered
+type Turn struct {
+	Time time.Time `json:"time"`
+	// Request only keeps the session, the response ID, the query text, the
+	// action, the parameters and the source of the request
+	Request dialogflow.Request `json:"request"`
+	// Fulfillment is nil when the webhook failed to answer, Error tells why
+	Fulfillment *dialogflow.Fulfillment `json:"fulfillment,omitempty"`
+	Error       string                  `json:"error,omitempty"`
+}
+
+// TranscriptStore keeps the turns of the conversations for the support
+type TranscriptStore interface {
+	// SaveTurn saves the turn, replacing the one with the same response ID
+	// when Dialogflow retried the request
+	SaveTurn(ctx context.Context, turn Turn) error
+	// SessionTurns returns the turns of the session in order
+	SessionTurns(ctx context.Context, session string) ([]Turn, error)
+	// DeleteTurns deletes the turns older than before and returns how many
+	// were deleted
+	DeleteTurns(ctx context.Context, before time.Time) (int, error)
+}
+
+// newTurn returns the turn of the request answered with the fulfillment or
+// the error. The parameters of the request and of the contexts are redacted
+// by their keys, and the names, phone numbers, emails and addresses they hold
+// are masked wherever they are repeated in the query and the fulfillment
+func newTurn(dr dialogflow.Request, rs *dialogflow.Fulfillment, err error, now time.Time, r *logging.Redactor) (Turn, error) {
+	var contexts dialogflow.Contexts
+	if rs != nil {
+		contexts = rs.OutputContexts
+	}
+	scrub := scrubber(r, dr.QueryResult.Parameters, contextParams(dr.QueryResult.OutputContexts), contextParams(contexts))
+	turn := Turn{
+		Time: now,
+		Request: dialogflow.Request{
+			Session:    dr.Session,
+			ResponseID: dr.ResponseID,
+			QueryResult: dialogflow.QueryResult{
+				QueryText:    scrub(dr.QueryResult.QueryText),
+				LanguageCode: dr.QueryResult.LanguageCode,
+				Action:       dr.QueryResult.Action,
+			},
+			OriginalDetectIntentRequest: dialogflow.OriginalDetectIntentRequest{Source: dr.OriginalDetectIntentRequest.Source},
+		},
+	}
+	if dr.QueryResult.Parameters != nil {
+		turn.Request.QueryResult.Parameters = scrubValue("", dr.QueryResult.Parameters, scrub, r).(map[string]interface{})
+	}
+	if err != nil {
+		turn.Error = scrub(err.Error())
+	}
+	if rs == nil {
+		return turn, nil
+	}
+	// the names of the contexts are kept, their parameters are redacted
+	var scrubbed dialogflow.Contexts
+	for _, c := range contexts {
+		if c == nil {
+			continue
+		}
+		sc := &dialogflow.Context{Name: c.Name, LifespanCount: c.LifespanCount}
+		var params interface{}
+		if json.Unmarshal(c.Parameters, &params) == nil && params != nil {
+			b, err := json.Marshal(scrubValue("", params, scrub, r))
+			if err != nil {
+				return turn, err
+			}
+			sc.Parameters = b
+		}
+		scrubbed = append(scrubbed, sc)
+	}
+	f := *rs
+	f.OutputContexts = nil
+	b, err := json.Marshal(f)
+	if err != nil {
+		return turn, err
+	}
+	var v interface{}
+	if err := json.Unmarshal(b, &v); err != nil {
+		return turn, err
+	}
+	if b, err = json.Marshal(scrubValue("", v, scrub, r)); err != nil {
+		return turn, err
+	}
+	turn.Fulfillment = &dialogflow.Fulfillment{}
+	if err := json.Unmarshal(b, turn.Fulfillment); err != nil {
+		return turn, err
+	}
+	turn.Fulfillment.OutputContexts = scrubbed
+	return turn, nil
+}
+
+// contextParams returns the decoded parameters of the contexts
+func contextParams(contexts dialogflow.Contexts) []interface{} {
+	var params []interface{}
+	for _, c := range contexts {
+		var p interface{}
+		if c != nil && json.Unmarshal(c.Parameters, &p) == nil {
+			params = append(params, p)
+		}
+	}
+	return params
+}
+
+// words are the runs of letters and digits of a text
+var words = regexp.MustCompile(`[\pL\pM\pN]+`)
+
+// scrubber returns the function masking the sensitive values of the decoded
+// parameters in a text, the words of the names on their own too, and then
+// the phone numbers, the emails and the coordinates the redactor finds
+func scrubber(r *logging.Redactor, params ...interface{}) func(string) string {
+	masks := make(map[string]string)
+	nameWords := make(map[string]string)
+	var collect func(key string, v interface{})
+	collect = func(key string, v interface{}) {
+		switch v := v.(type) {
+		case map[string]interface{}:
+			for k, e := range v {
+				collect(k, e)
+			}
+		case []interface{}:
+			for _, e := range v {
+				collect(key, e)
+			}
+		case string:
+			if len(v) > 1 && r.Sensitive(key) {
+				masks[v] = r.Field(key, v).(string)
+			}
+			if r.Name(key) {
+				// the given name alone is also written, like in "Hi Lan!"
+				for _, w := range words.FindAllString(v, -1) {
+					if len(w) > 1 {
+						nameWords[w] = r.Field("name", w).(string)
+					}
+				}
+			}
+		}
+	}
+	for _, p := range params {
+		collect("", p)
+	}
+	// the longest values are replaced first, a name may be part of another
+	values := make([]string, 0, len(masks))
+	for v := range masks {
+		values = append(values, v)
+	}
+	sort.Slice(values, func(i, j int) bool { return len(values[i]) > len(values[j]) })
+	var pairs []string
+	for _, v := range values {
+		pairs = append(pairs, v, masks[v])
+	}
+	replacer := strings.NewReplacer(pairs...)
+	return func(s string) string {
+		s = replacer.Replace(s)
+		if len(nameWords) > 0 {
+			s = words.ReplaceAllStringFunc(s, func(w string) string {
+				if mask, ok := nameWords[w]; ok {
+					return mask
+				}
+				return w
+			})
+		}
+		return r.String(s)
+	}
+}
+
+// scrubValue returns a copy of a decoded JSON value, the values of the
+// sensitive keys redacted by the redactor and the other strings scrubbed
+func scrubValue(key string, v interface{}, scrub func(string) string, r *logging.Redactor) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for k, e := range v {
+			m[k] = scrubValue(k, e, scrub, r)
+		}
+		return m
+	case []interface{}:
+		l := make([]interface{}, len(v))
+		for i, e := range v {
+			l[i] = scrubValue(key, e, scrub, r)
+		}
+		return l
+	case string:
+		if r.Sensitive(key) {
+			return r.Field(key, v)
+		}
+		return scrub(v)
+	}
+	return r.Field(key, v)
+}
+
+// redactor returns the redactor of the personal data, the default one when
+// none is set
+func (s *Server) redactor() *logging.Redactor {
+	if s.Redactor == nil {
+		return logging.DefaultRedactor
+	}
+	return s.Redactor
+}
+
+// recordTurn saves the turn of the request in the background, when the
+// transcripts are recorded
+func (s *Server) recordTurn(ctx context.Context, dr dialogflow.Request, rs *dialogflow.Fulfillment, failure error) {
+	if s.Transcripts == nil {
+		return
+	}
+	logger := logging.FromContext(ctx)
+	turn, err := newTurn(dr, rs, failure, time.Now(), s.redactor())
+	if err != nil {
+		logger.Error("redacting the turn", "err", err)
+		return
+	}
+	s.background.Add(1)
+	go func() {
+		defer s.background.Done()
+		ctx, cancel := context.WithTimeout(context.Background(), transcriptTimeout)
+		defer cancel()
+		if err := s.Transcripts.SaveTurn(ctx, turn); err != nil {
+			logger.Error("saving the turn", "err", err)
+		}
+	}()
+}
+
+// PruneTranscripts deletes the turns older than the retention
+func (s *Server) PruneTranscripts(ctx context.Context, now time.Time) error {
+	if s.Transcripts == nil {
+		return nil
+	}
+	retention := s.TranscriptRetention
+	if retention == 0 {
+		retention = DefaultTranscriptRetention
+	}
+	n, err := s.Transcripts.DeleteTurns(ctx, now.Add(-retention))
+	if n > 0 {
+		logging.FromContext(ctx).Info("pruned the transcripts", "turns", n)
+	}
+	return err
+}
+
+// RunTranscriptRetention prunes the transcripts every interval, until the
+// context is done
+func (s *Server) RunTranscriptRetention(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if err := s.PruneTranscripts(ctx, time.Now()); err != nil {
+			logging.FromContext(ctx).Error("pruning the transcripts", "err", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Transcript is the answer of the admin endpoint of the transcripts
+type Transcript struct {
+	Session string `json:"session"`
+	Turns   []Turn `json:"turns"`
+}
+
+// transcript replays the turns of the session given in the query, in order
+func (s *Server) transcript(e echo.Context) error {
+	session := e.QueryParam("session")
+	if session == "" {
+		return e.NoContent(http.StatusBadRequest)
+	}
+	turns, err := s.Transcripts.SessionTurns(e.Request().Context(), session)
+	if err != nil {
+		return err
+	}
+	if turns == nil {
+		turns = []Turn{}
+	}
+	return e.JSON(http.StatusOK, Transcript{Session: session, Turns: turns})
+}
+
+// MemoryTranscripts keeps the turns in memory, for the tests and the local
+// runs
+type MemoryTranscripts struct {
+	mu    sync.Mutex
+	turns []Turn
+}
+
+// NewMemoryTranscripts returns an empty transcript store
+func NewMemoryTranscripts() *MemoryTranscripts {
+	return &MemoryTranscripts{}
+}
+
+// SaveTurn saves the turn, replacing the one with the same response ID
+func (m *MemoryTranscripts) SaveTurn(ctx context.Context, turn Turn) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if id := turn.Request.ResponseID; id != "" {
+		for i, t := range m.turns {
+			if t.Request.ResponseID == id {
+				m.turns[i] = turn
+				return nil
+			}
+		}
+	}
+	m.turns = append(m.turns, turn)
+	return nil
+}
+
+// SessionTurns returns the turns of the session in order
+func (m *MemoryTranscripts) SessionTurns(ctx context.Context, session string) ([]Turn, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var turns []Turn
+	for _, t := range m.turns {
+		if t.Request.Session == session {
+			turns = append(turns, t)
+		}
+	}
+	sortTurns(turns)
+	return turns, nil
+}
+
+// DeleteTurns deletes the turns older than before
+func (m *MemoryTranscripts) DeleteTurns(ctx context.Context, before time.Time) (int, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	kept := m.turns[:0]
+	for _, t := range m.turns {
+		if !t.Time.Before(before) {
+			kept = append(kept, t)
+		}
+	}
+	n := len(m.turns) - len(kept)
+	m.turns = kept
+	return n, nil
+}
+
+func sortTurns(turns []Turn) {
+	sort.SliceStable(turns, func(i, j int) bool { return turns[i].Time.Before(turns[j].Time) })
+}
+
+// deleteBatch is the most documents Firestore deletes in a batch
+const deleteBatch = 500
+
+// FirestoreTranscripts keeps the turns in the transcripts collection, one
+// document per turn
+type FirestoreTranscripts struct {
+	client *firestore.Client
+}
+
+// Transcripts returns the transcript store of the database of the store
+func (s *FirestoreStore) Transcripts() *FirestoreTranscripts {
+	return &FirestoreTranscripts{client: s.client}
+}
+
+// SaveTurn saves the turn in the document of its response ID. The turn is
+// kept as JSON, the fulfillments don't map to Firestore documents
+func (s *FirestoreTranscripts) SaveTurn(ctx context.Context, turn Turn) error {
+	b, err := json.Marshal(turn)
+	if err != nil {
+		return err
+	}
+	doc := s.client.Collection("transcripts").NewDoc()
+	if id := TransactionID(turn.Request.ResponseID); id != "" {
+		doc = s.client.Collection("transcripts").Doc(id)
+	}
+	_, err = doc.Set(ctx, map[string]interface{}{
+		"session": turn.Request.Session,
+		"time":    turn.Time,
+		"turn":    string(b),
+	})
+	return err
+}
+
+// SessionTurns returns the turns of the session in order. They are sorted
+// here, ordering the query would need a composite index
+func (s *FirestoreTranscripts) SessionTurns(ctx context.Context, session string) ([]Turn, error) {
+	docs := s.client.Collection("transcripts").Where("session", "==", session).Documents(ctx)
+	defer docs.Stop()
+	var turns []Turn
+	for {
+		doc, err := docs.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		var turn Turn
+		raw, _ := doc.Data()["turn"].(string)
+		if err := json.Unmarshal([]byte(raw), &turn); err != nil {
+			return nil, err
+		}
+		turns = append(turns, turn)
+	}
+	sortTurns(turns)
+	return turns, nil
+}
+
+// DeleteTurns deletes the turns older than before, in batches
+func (s *FirestoreTranscripts) DeleteTurns(ctx context.Context, before time.Time) (int, error) {
+	deleted := 0
+	for {
+		docs, err := s.client.Collection("transcripts").Where("time", "<", before).Limit(deleteBatch).Documents(ctx).GetAll()
+		if err != nil || len(docs) == 0 {
+			return deleted, err
+		}
+		batch := s.client.Batch()
+		for _, doc := range docs {
+			batch.Delete(doc.Ref)
+		}
+		if _, err := batch.Commit(ctx); err != nil {
+			return deleted, err
+		}
+		deleted += len(docs)
+	}
+}
@@ -0,0 +1,169 @@
+package webhook
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"wcws/dialogflow"
+)
+
+func getTranscript(e *echo.Echo, token, session string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, "/admin/transcripts?session="+url.QueryEscape(session), nil)
+	req.Header.Set(echo.HeaderAuthorization, "Bearer "+token)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestTranscript(t *testing.T) {
+	srv := goldenServer()
+	srv.Transcripts = NewMemoryTranscripts()
+	srv.AdminToken = "admin-token"
+	e := echo.New()
+	srv.Register(e)
+
+	body, err := ioutil.ReadFile("testdata/golden/google_permission.request.json")
+	require.NoError(t, err)
+	postWebhook(t, e, string(body))
+	srv.Wait()
+	postWebhook(t, e, `{"responseId": "golden-made-up", "session": "projects/wcws/agent/sessions/golden",
+		"queryResult": {"queryText": "call me at 0905123456", "action": "made-up"}}`)
+	srv.Wait()
+
+	assert.Equal(t, http.StatusUnauthorized, getTranscript(e, "wrong", "projects/wcws/agent/sessions/golden").Code)
+
+	rec := getTranscript(e, "admin-token", "projects/wcws/agent/sessions/golden")
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.NotContains(t, rec.Body.String(), "Hoang")
+	assert.NotContains(t, rec.Body.String(), "0905123456")
+	var transcript Transcript
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &transcript))
+	require.Len(t, transcript.Turns, 2)
+
+	first := transcript.Turns[0]
+	assert.Equal(t, "golden-google-permission", first.Request.ResponseID)
+	assert.Equal(t, "getPermission", first.Request.QueryResult.Action)
+	assert.Equal(t, "google", first.Request.OriginalDetectIntentRequest.Source)
+	assert.Equal(t, map[string]interface{}{"address": "[REDACTED]"}, first.Request.QueryResult.Parameters)
+	require.NotNil(t, first.Fulfillment)
+	b, err := json.Marshal(first.Fulfillment)
+	require.NoError(t, err)
+	assert.Contains(t, string(b), "H***")
+	assert.Contains(t, string(b), "We will call you at *******456")
+
+	second := transcript.Turns[1]
+	assert.Equal(t, "made-up", second.Request.QueryResult.Action)
+	assert.Equal(t, "call me at *******456", second.Request.QueryResult.QueryText)
+	assert.Nil(t, second.Fulfillment)
+	assert.Equal(t, errUnknownAction.Error(), second.Error)
+
+	rec = getTranscript(e, "admin-token", "projects/wcws/agent/sessions/unknown")
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.JSONEq(t, `{"session": "projects/wcws/agent/sessions/unknown", "turns": []}`, rec.Body.String())
+}
+
+func TestTranscriptOfKnownDonor(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	certs := googleCerts(t, key)
+	defer certs.Close()
+
+	srv := goldenServer()
+	srv.IDTokens = dialogflow.NewIDTokenVerifier("wcws.apps.googleusercontent.com")
+	srv.IDTokens.CertsURL = certs.URL
+	srv.Transcripts = NewMemoryTranscripts()
+	e := echo.New()
+	srv.Register(e)
+
+	postWebhook(t, e, messengerPermission)
+	answer := postWebhook(t, e, messengerRequest("donor-2", "welcome"))
+	require.Contains(t, answer, "Welcome back, Lan!")
+
+	token := googleIDToken(t, key)
+	for _, action := range []string{"signIn", "signInResult"} {
+		answer = postWebhook(t, e, `{
+			"responseId": "link-`+action+`",
+			"session": "projects/wcws/agent/sessions/link",
+			"queryResult": {"action": "`+action+`", "parameters": {}},
+			"originalDetectIntentRequest": {"source": "google", "payload": {
+				"user": {"idToken": "`+token+`"},
+				"inputs": [{"arguments": [{"name": "SIGN_IN", "extension": {"status": "OK"}}]}]
+			}}
+		}`)
+		require.Contains(t, answer, "oang")
+	}
+	srv.Wait()
+
+	ctx := context.Background()
+	for _, session := range []string{"projects/wcws/agent/sessions/donor", "projects/wcws/agent/sessions/link"} {
+		turns, err := srv.Transcripts.SessionTurns(ctx, session)
+		require.NoError(t, err)
+		require.NotEmpty(t, turns)
+		b, err := json.Marshal(turns)
+		require.NoError(t, err)
+		for _, personal := range []string{"Lan", "0905000111", "905 000 111", "Hoang", "hoang@example.com"} {
+			assert.NotContains(t, string(b), personal, session)
+		}
+		assert.Contains(t, string(b), session+"/contexts/information", "the names of the contexts are kept")
+	}
+}
+
+func TestNoAdminWithoutToken(t *testing.T) {
+	srv := goldenServer()
+	srv.Transcripts = NewMemoryTranscripts()
+	e := echo.New()
+	srv.Register(e)
+	assert.Equal(t, http.StatusNotFound, getTranscript(e, "", "projects/wcws/agent/sessions/golden").Code)
+}
+
+func TestTranscriptRetried(t *testing.T) {
+	transcripts := NewMemoryTranscripts()
+	ctx := context.Background()
+	start := time.Date(2019, 12, 1, 7, 30, 0, 0, time.UTC)
+	turn := func(responseID string, at time.Time, action string) Turn {
+		return Turn{Time: at, Request: dialogflow.Request{
+			Session:     "s",
+			ResponseID:  responseID,
+			QueryResult: dialogflow.QueryResult{Action: action},
+		}}
+	}
+	require.NoError(t, transcripts.SaveTurn(ctx, turn("r-2", start.Add(time.Minute), "collect")))
+	require.NoError(t, transcripts.SaveTurn(ctx, turn("r-1", start, "welcome")))
+	require.NoError(t, transcripts.SaveTurn(ctx, turn("r-2", start.Add(2*time.Minute), "getPermission")))
+
+	turns, err := transcripts.SessionTurns(ctx, "s")
+	require.NoError(t, err)
+	require.Len(t, turns, 2)
+	assert.Equal(t, "welcome", turns[0].Request.QueryResult.Action)
+	assert.Equal(t, "getPermission", turns[1].Request.QueryResult.Action)
+}
+
+func TestPruneTranscripts(t *testing.T) {
+	transcripts := NewMemoryTranscripts()
+	srv := &Server{Transcripts: transcripts, TranscriptRetention: 24 * time.Hour}
+	ctx := context.Background()
+	now := time.Date(2019, 12, 10, 0, 0, 0, 0, time.UTC)
+	for i, age := range []time.Duration{48 * time.Hour, 25 * time.Hour, time.Hour} {
+		require.NoError(t, transcripts.SaveTurn(ctx, Turn{
+			Time:    now.Add(-age),
+			Request: dialogflow.Request{Session: "s", ResponseID: string(rune('a' + i))},
+		}))
+	}
+	require.NoError(t, srv.PruneTranscripts(ctx, now))
+	turns, err := transcripts.SessionTurns(ctx, "s")
+	require.NoError(t, err)
+	require.Len(t, turns, 1)
+	assert.Equal(t, "c", turns[0].Request.ResponseID)
+}
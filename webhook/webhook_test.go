@@ -0,0 +1,13 @@
+package webhook
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetThanksAnswer(t *testing.T) {
+	name := "Hoang"
+	rs := GetThanksAnswer(name)
+	assert.Equal(t, rs, "")
+}
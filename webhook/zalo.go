@@ -0,0 +1,280 @@
+package webhook
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+
+	"github.com/labstack/echo/v4"
+
+	"wcws/dialogflow"
+	"wcws/logging"
+	"wcws/notify"
+	"wcws/zalo"
+)
+
+// Zalo isn't integrated with Dialogflow, so the donation flow is driven here:
+// the slots the agent fills on the other platforms are asked one by one.
+const (
+	zaloAskDescription = iota
+	zaloAskName
+	zaloAskPhone
+	zaloAskEmail
+	zaloAskTime
+	zaloAskLocation
+)
+
+var zaloQuestions = map[int]string{
+	zaloAskDescription: "Welcome to We Collect We Share! What would you like to donate?",
+	zaloAskName:        "Great! What is your name?",
+	zaloAskPhone:       "What phone number can our volunteer call you at?",
+	zaloAskEmail:       "What email should we send your receipt to? Say \"skip\" if you don't want one.",
+	zaloAskTime:        "When can we pick it up?",
+	zaloAskLocation:    "Please share your location so we can send a volunteer.",
+}
+
+// DefaultZaloDonationTTL is how long an unfinished donation is kept after the
+// last message of the user
+const DefaultZaloDonationTTL = 30 * time.Minute
+
+// maxZaloEventAge is how far the time of an event can be from now, the older
+// events are replays of captured ones
+const maxZaloEventAge = 5 * time.Minute
+
+type zaloDonation struct {
+	step    int
+	trans   Transactions
+	updated time.Time
+	// saving is set while the donation is written, so that a location sent
+	// twice doesn't save it twice
+	saving bool
+}
+
+// ZaloHandler serves the webhook of the Zalo Official Account
+type ZaloHandler struct {
+	server *Server
+	client *zalo.Client
+	appID  string
+	secret string
+	// TTL is how long an unfinished donation is kept, DefaultZaloDonationTTL
+	// by default
+	TTL time.Duration
+	now func() time.Time
+
+	mu        sync.Mutex
+	donations map[string]*zaloDonation
+}
+
+// NewZaloHandler returns a handler replying to Zalo users with the client and
+// saving their donations with the server
+func NewZaloHandler(server *Server, client *zalo.Client, appID, secret string) *ZaloHandler {
+	return &ZaloHandler{
+		server:    server,
+		client:    client,
+		appID:     appID,
+		secret:    secret,
+		TTL:       DefaultZaloDonationTTL,
+		now:       time.Now,
+		donations: make(map[string]*zaloDonation),
+	}
+}
+
+// Webhook verifies the signature and the time of the event and replies to
+// the user
+func (h *ZaloHandler) Webhook(e echo.Context) error {
+	body, err := ioutil.ReadAll(e.Request().Body)
+	if err != nil {
+		return err
+	}
+	var event zalo.Event
+	if err := json.Unmarshal(body, &event); err != nil {
+		return e.NoContent(http.StatusBadRequest)
+	}
+	if event.AppID != h.appID || !zalo.VerifySignature(h.appID, body, event.Timestamp, h.secret, e.Request().Header.Get(zalo.SignatureHeader)) {
+		return e.NoContent(http.StatusUnauthorized)
+	}
+	if sent, err := event.Time(); err != nil || h.now().Sub(sent) > maxZaloEventAge || sent.Sub(h.now()) > maxZaloEventAge {
+		logging.FromContext(e.Request().Context()).Warn("rejected a Zalo event out of time", "timestamp", event.Timestamp)
+		return e.NoContent(http.StatusUnauthorized)
+	}
+	reply := h.handle(e, event)
+	if reply != "" {
+		if err := h.client.SendText(e.Request().Context(), event.Sender.ID, reply); err != nil {
+			logging.FromContext(e.Request().Context()).Error("replying on Zalo", "err", err)
+		}
+	}
+	return e.NoContent(http.StatusOK)
+}
+
+// donation returns the unfinished donation of the sender, dropping the
+// expired ones. It must be called with h.mu held
+func (h *ZaloHandler) donation(sender string, now time.Time) (*zaloDonation, bool) {
+	for id, d := range h.donations {
+		if !d.saving && now.Sub(d.updated) >= h.TTL {
+			delete(h.donations, id)
+		}
+	}
+	d, ok := h.donations[sender]
+	if !ok {
+		d = &zaloDonation{step: zaloAskDescription}
+		h.donations[sender] = d
+	}
+	d.updated = now
+	return d, ok
+}
+
+// handle moves the donation of the sender one step forward and returns the
+// reply to send
+func (h *ZaloHandler) handle(e echo.Context, event zalo.Event) string {
+	h.mu.Lock()
+	d, ok := h.donation(event.Sender.ID, h.now())
+	if !ok {
+		h.mu.Unlock()
+		return zaloQuestions[zaloAskDescription]
+	}
+	if event.EventName != zalo.EventUserSendLocation {
+		defer h.mu.Unlock()
+		return d.answer(event)
+	}
+	if d.step != zaloAskLocation || d.saving {
+		h.mu.Unlock()
+		return zaloQuestions[d.step]
+	}
+	lat, long, err := event.Message.Location()
+	if err != nil {
+		h.mu.Unlock()
+		return zaloQuestions[d.step]
+	}
+	d.saving = true
+	trans := d.trans
+	trans.ImageURL = append([]string(nil), d.trans.ImageURL...)
+	h.mu.Unlock()
+
+	reply, done := h.save(e, event, trans, lat, long)
+
+	h.mu.Lock()
+	d.saving = false
+	if done && h.donations[event.Sender.ID] == d {
+		delete(h.donations, event.Sender.ID)
+	}
+	h.mu.Unlock()
+	return reply
+}
+
+// answer records a text or an image of the user and returns the next question
+func (d *zaloDonation) answer(event zalo.Event) string {
+	switch event.EventName {
+	case zalo.EventUserSendImage:
+		d.trans.ImageURL = append(d.trans.ImageURL, event.Message.Images()...)
+		return "Thanks for the photo! " + zaloQuestions[d.step]
+	case zalo.EventUserSendText:
+		text := strings.TrimSpace(event.Message.Text)
+		if text == "" {
+			return zaloQuestions[d.step]
+		}
+		switch d.step {
+		case zaloAskDescription:
+			d.trans.Description = text
+		case zaloAskName:
+			d.trans.GiverName = text
+		case zaloAskPhone:
+			if !isPhoneNumber(text) {
+				return "This doesn't look like a phone number. " + zaloQuestions[d.step]
+			}
+			d.trans.PhoneNumber = text
+		case zaloAskEmail:
+			if !skipsEmail(text) {
+				if !notify.ValidEmail(text) {
+					return "This doesn't look like an email address. " + zaloQuestions[d.step]
+				}
+				d.trans.Email = text
+			}
+		case zaloAskTime:
+			d.trans.TransactionTime = text
+		case zaloAskLocation:
+			return zaloQuestions[d.step]
+		}
+		d.step++
+		return zaloQuestions[d.step]
+	}
+	return ""
+}
+
+// save saves the donation at the shared location and returns the reply, done
+// is false when the user may share the location again
+func (h *ZaloHandler) save(e echo.Context, event zalo.Event, trans Transactions, lat, long float64) (reply string, done bool) {
+	ctx := e.Request().Context()
+	submission := Submission{Sender: "zalo:" + event.Sender.ID, Phone: trans.PhoneNumber}
+	release, ok := h.server.reserveDonation(ctx, submission)
+	if !ok {
+		return tooManyDonations, true
+	}
+	created := false
+	defer func() {
+		if !created {
+			release()
+		}
+	}()
+	address, err := h.server.Geocoder.Address(ctx, dialogflow.Coordinates{Latitude: lat, Longitude: long})
+	if err != nil {
+		logging.FromContext(ctx).Error("finding the shared address", "lat", lat, "long", long, "err", err)
+		return "Sorry, we could not find this place. " + zaloQuestions[zaloAskLocation], false
+	}
+	trans.Address = address
+	trans.Lat = lat
+	trans.Long = long
+	trans.CreatedDate = time.Now().Unix()
+	trans.Status = "pending"
+	trans.ReceiptPending = trans.Email != ""
+	var id string
+	if event.Message.MsgID != "" {
+		id = TransactionID("zalo-" + event.Message.MsgID)
+	}
+	// the sender ID is the user of the Official Account, the events are signed
+	if donor, err := h.server.recordDonor(ctx, DonorKeys{ZaloUserID: event.Sender.ID}, trans.GiverName, trans.PhoneNumber, trans.Email, true); err != nil {
+		logging.FromContext(ctx).Error("recording the donor", "err", err)
+	} else if donor != nil {
+		trans.DonorID = donor.ID
+	}
+	id, err = h.server.Store.AddTransaction(ctx, id, trans)
+	switch {
+	case err == ErrTransactionExists:
+		// the event was sent again by Zalo
+	case err != nil:
+		logging.FromContext(ctx).Error("saving the donation", "msgId", event.Message.MsgID, "err", err)
+		return "Sorry, something went wrong, please share your location again.", false
+	default:
+		created = true
+		h.server.Metrics.transactionCreated(trans, "ZALO")
+		h.server.notifyCreated(StoredTransaction{ID: id, Transactions: trans})
+		h.server.publishCreated(StoredTransaction{ID: id, Transactions: trans})
+	}
+	return GetConfirmationText(GetThanksAnswer(trans.GiverName), trans), true
+}
+
+// skipsEmail reports whether the donor declined to give their email
+func skipsEmail(s string) bool {
+	switch strings.ToLower(s) {
+	case "skip", "no", "none", "không":
+		return true
+	}
+	return false
+}
+
+func isPhoneNumber(s string) bool {
+	digits := 0
+	for _, r := range s {
+		switch {
+		case unicode.IsDigit(r):
+			digits++
+		case r == '+' || r == ' ' || r == '-' || r == '.' || r == '(' || r == ')':
+		default:
+			return false
+		}
+	}
+	return digits >= 9 && digits <= 15
+}
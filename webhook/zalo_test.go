@@ -0,0 +1,162 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"wcws/dialogflow"
+	"wcws/zalo"
+)
+
+type zaloTest struct {
+	t       *testing.T
+	e       *echo.Echo
+	handler *ZaloHandler
+	store   *MemoryStore
+	api     *httptest.Server
+	replies []string
+	msgID   int
+}
+
+func newZaloTest(t *testing.T) *zaloTest {
+	z := &zaloTest{t: t, e: echo.New(), store: NewMemoryStore(nil)}
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Message struct{ Text string } `json:"message"`
+		}
+		b, _ := ioutil.ReadAll(r.Body)
+		_ = json.Unmarshal(b, &body)
+		z.replies = append(z.replies, body.Message.Text)
+		_, _ = w.Write([]byte(`{"error":0,"message":"Success"}`))
+	}))
+	z.api = api
+	srv := New(z.store, GeocoderFunc(func(ctx context.Context, c dialogflow.Coordinates) (string, error) {
+		return "12 Bach Dang, Da Nang", nil
+	}))
+	z.handler = NewZaloHandler(srv, zalo.NewClient(api.URL, "token"), "app", "secret")
+	z.e.POST("/zalo/webhook", z.handler.Webhook)
+	return z
+}
+
+// send posts a signed event of the user and returns the reply
+func (z *zaloTest) send(name string, message zalo.Message) string {
+	z.msgID++
+	message.MsgID = strconv.Itoa(z.msgID)
+	rec := z.post(zalo.Event{AppID: "app", EventName: name, Timestamp: zaloTimestamp(z.handler.now()), Sender: zalo.Party{ID: "u1"}, Message: message})
+	require.Equal(z.t, http.StatusOK, rec.Code)
+	require.NotEmpty(z.t, z.replies)
+	return z.replies[len(z.replies)-1]
+}
+
+// post posts the signed event
+func (z *zaloTest) post(event zalo.Event) *httptest.ResponseRecorder {
+	body, err := json.Marshal(event)
+	require.NoError(z.t, err)
+	req := httptest.NewRequest(http.MethodPost, "/zalo/webhook", strings.NewReader(string(body)))
+	req.Header.Set(zalo.SignatureHeader, zalo.Sign("app", body, event.Timestamp, "secret"))
+	rec := httptest.NewRecorder()
+	z.e.ServeHTTP(rec, req)
+	return rec
+}
+
+func zaloTimestamp(t time.Time) string {
+	return strconv.FormatInt(t.UnixNano()/int64(time.Millisecond), 10)
+}
+
+func (z *zaloTest) text(text string) string {
+	return z.send(zalo.EventUserSendText, zalo.Message{Text: text})
+}
+
+func (z *zaloTest) location() string {
+	return z.send(zalo.EventUserSendLocation, zalo.Message{Attachments: []zalo.Attachment{{
+		Type:    "location",
+		Payload: zalo.AttachmentPayload{Coordinates: &zalo.Coordinates{Latitude: "16.0717", Longitude: "108.2244"}},
+	}}})
+}
+
+func (z *zaloTest) transactions() []StoredTransaction {
+	var all []StoredTransaction
+	require.NoError(z.t, z.store.EachTransaction(context.Background(), TransactionFilter{}, func(t StoredTransaction) error {
+		all = append(all, t)
+		return nil
+	}))
+	return all
+}
+
+func TestZaloDonation(t *testing.T) {
+	z := newZaloTest(t)
+	defer z.api.Close()
+
+	assert.Equal(t, zaloQuestions[zaloAskDescription], z.text("hi"))
+	assert.Equal(t, zaloQuestions[zaloAskName], z.text("Winter clothes"))
+	assert.Equal(t, zaloQuestions[zaloAskPhone], z.text("Minh"))
+	assert.Contains(t, z.text("call me"), "phone number")
+	assert.Equal(t, zaloQuestions[zaloAskEmail], z.text("0905 123 456"))
+	assert.Equal(t, zaloQuestions[zaloAskTime], z.text("skip"))
+	assert.Equal(t, zaloQuestions[zaloAskLocation], z.text("tomorrow morning"))
+	assert.Equal(t, zaloQuestions[zaloAskLocation], z.text("at home"), "the location is shared, not typed")
+	assert.Contains(t, z.location(), "We will call you at 0905 123 456")
+
+	all := z.transactions()
+	require.Len(t, all, 1)
+	assert.Equal(t, "Minh", all[0].GiverName)
+	assert.Equal(t, "12 Bach Dang, Da Nang", all[0].Address)
+	assert.Equal(t, "pending", all[0].Status)
+	assert.Equal(t, "zalo-u1", all[0].DonorID)
+
+	assert.Equal(t, zaloQuestions[zaloAskDescription], z.location(), "a location sent again starts a new donation")
+	assert.Len(t, z.transactions(), 1)
+}
+
+func TestZaloDonationExpires(t *testing.T) {
+	z := newZaloTest(t)
+	defer z.api.Close()
+	now := time.Date(2020, 1, 1, 9, 0, 0, 0, time.UTC)
+	z.handler.now = func() time.Time { return now }
+
+	z.text("hi")
+	z.text("Winter clothes")
+	now = now.Add(DefaultZaloDonationTTL - time.Minute)
+	assert.Equal(t, zaloQuestions[zaloAskPhone], z.text("Minh"))
+
+	now = now.Add(DefaultZaloDonationTTL)
+	assert.Equal(t, zaloQuestions[zaloAskDescription], z.text("0905123456"), "the unfinished donation is forgotten")
+	assert.Len(t, z.handler.donations, 1)
+}
+
+func TestZaloSignature(t *testing.T) {
+	z := newZaloTest(t)
+	defer z.api.Close()
+	req := httptest.NewRequest(http.MethodPost, "/zalo/webhook", strings.NewReader(`{"app_id":"app","event_name":"user_send_text","timestamp":"1"}`))
+	req.Header.Set(zalo.SignatureHeader, "mac=forged")
+	rec := httptest.NewRecorder()
+	z.e.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	assert.Empty(t, z.replies)
+}
+
+func TestZaloReplayedEvent(t *testing.T) {
+	z := newZaloTest(t)
+	defer z.api.Close()
+	now := time.Date(2020, 1, 1, 9, 0, 0, 0, time.UTC)
+	z.handler.now = func() time.Time { return now }
+	event := zalo.Event{AppID: "app", EventName: zalo.EventUserSendText, Timestamp: zaloTimestamp(now.Add(-time.Minute)), Sender: zalo.Party{ID: "u1"}, Message: zalo.Message{MsgID: "1", Text: "hi"}}
+	assert.Equal(t, http.StatusOK, z.post(event).Code)
+
+	now = now.Add(10 * time.Minute)
+	assert.Equal(t, http.StatusUnauthorized, z.post(event).Code, "the captured event is too old")
+	event.Timestamp = "soon"
+	assert.Equal(t, http.StatusUnauthorized, z.post(event).Code)
+	assert.Len(t, z.replies, 1)
+}
@@ -0,0 +1,96 @@
+package zalo
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// DefaultBaseURL is the base URL of the Zalo Official Account API
+const DefaultBaseURL = "https://openapi.zalo.me"
+
+// Client sends messages to Zalo users on behalf of the Official Account
+type Client struct {
+	BaseURL     string
+	AccessToken string
+	HTTPClient  *http.Client
+}
+
+// NewClient returns a client for the API at the given base URL, DefaultBaseURL
+// is used when it is empty
+func NewClient(baseURL, accessToken string) *Client {
+	if baseURL == "" {
+		baseURL = DefaultBaseURL
+	}
+	return &Client{
+		BaseURL:     strings.TrimSuffix(baseURL, "/"),
+		AccessToken: accessToken,
+		HTTPClient:  &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+// APIError is an error returned by the Zalo API
+type APIError struct {
+	Code    int    `json:"error"`
+	Message string `json:"message"`
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("zalo: error %d: %s", e.Code, e.Message)
+}
+
+type recipient struct {
+	UserID string `json:"user_id"`
+}
+
+type sendRequest struct {
+	Recipient recipient   `json:"recipient"`
+	Message   interface{} `json:"message"`
+}
+
+type textMessage struct {
+	Text string `json:"text"`
+}
+
+// SendText sends a text message to the user
+func (c *Client) SendText(ctx context.Context, userID, text string) error {
+	return c.send(ctx, sendRequest{
+		Recipient: recipient{UserID: userID},
+		Message:   textMessage{Text: text},
+	})
+}
+
+func (c *Client) send(ctx context.Context, body sendRequest) error {
+	b, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+	u := c.BaseURL + "/v2.0/oa/message?access_token=" + url.QueryEscape(c.AccessToken)
+	req, err := http.NewRequest(http.MethodPost, u, bytes.NewReader(b))
+	if err != nil {
+		return err
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("Content-Type", "application/json")
+	res, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("zalo: unexpected status %s", res.Status)
+	}
+	var apiErr APIError
+	if err := json.NewDecoder(res.Body).Decode(&apiErr); err != nil {
+		return err
+	}
+	if apiErr.Code != 0 {
+		return &apiErr
+	}
+	return nil
+}
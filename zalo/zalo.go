@@ -0,0 +1,119 @@
+package zalo
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// SignatureHeader is the header holding the signature of webhook events
+const SignatureHeader = "X-ZEvent-Signature"
+
+// Event names handled by the webhook
+const (
+	EventUserSendText     = "user_send_text"
+	EventUserSendLocation = "user_send_location"
+	EventUserSendImage    = "user_send_image"
+	EventFollow           = "follow"
+)
+
+// Event is an event sent by Zalo to the webhook of the Official Account
+// https://developers.zalo.me/docs/api/official-account-api/webhook
+type Event struct {
+	AppID       string  `json:"app_id"`
+	UserIDByApp string  `json:"user_id_by_app,omitempty"`
+	EventName   string  `json:"event_name"`
+	Timestamp   string  `json:"timestamp"`
+	Sender      Party   `json:"sender"`
+	Recipient   Party   `json:"recipient"`
+	Message     Message `json:"message"`
+}
+
+// Time returns when the event was sent, its timestamp is in milliseconds
+func (e Event) Time() (time.Time, error) {
+	ms, err := strconv.ParseInt(e.Timestamp, 10, 64)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("zalo: invalid timestamp %q", e.Timestamp)
+	}
+	return time.Unix(0, ms*int64(time.Millisecond)), nil
+}
+
+// Party is the sender or the recipient of an event
+type Party struct {
+	ID string `json:"id"`
+}
+
+// Message is the message of an event
+type Message struct {
+	MsgID       string       `json:"msg_id,omitempty"`
+	Text        string       `json:"text,omitempty"`
+	Attachments []Attachment `json:"attachments,omitempty"`
+}
+
+// Attachment is an image, a location or a file sent by the user
+type Attachment struct {
+	Type    string            `json:"type"`
+	Payload AttachmentPayload `json:"payload"`
+}
+
+// AttachmentPayload holds the URL of an image or the coordinates of a location
+type AttachmentPayload struct {
+	URL         string       `json:"url,omitempty"`
+	Thumbnail   string       `json:"thumbnail,omitempty"`
+	Coordinates *Coordinates `json:"coordinates,omitempty"`
+}
+
+// Coordinates of a location attachment. Zalo sends them as strings
+type Coordinates struct {
+	Latitude  string `json:"latitude"`
+	Longitude string `json:"longitude"`
+}
+
+// Location returns the coordinates of the first location attachment
+func (m Message) Location() (lat, long float64, err error) {
+	for _, a := range m.Attachments {
+		if a.Type != "location" || a.Payload.Coordinates == nil {
+			continue
+		}
+		if lat, err = strconv.ParseFloat(a.Payload.Coordinates.Latitude, 64); err != nil {
+			return 0, 0, err
+		}
+		if long, err = strconv.ParseFloat(a.Payload.Coordinates.Longitude, 64); err != nil {
+			return 0, 0, err
+		}
+		return lat, long, nil
+	}
+	return 0, 0, errors.New("no location attachment")
+}
+
+// Images returns the URLs of the image attachments
+func (m Message) Images() []string {
+	var rs []string
+	for _, a := range m.Attachments {
+		if a.Type == "image" && a.Payload.URL != "" {
+			rs = append(rs, a.Payload.URL)
+		}
+	}
+	return rs
+}
+
+// Sign returns the signature Zalo sends along an event: the SHA-256 of the
+// app ID, the raw body, the timestamp of the event and the OA secret key
+func Sign(appID string, body []byte, timestamp, secret string) string {
+	h := sha256.New()
+	h.Write([]byte(appID))
+	h.Write(body)
+	h.Write([]byte(timestamp))
+	h.Write([]byte(secret))
+	return "mac=" + hex.EncodeToString(h.Sum(nil))
+}
+
+// VerifySignature checks the signature header of an event
+func VerifySignature(appID string, body []byte, timestamp, secret, signature string) bool {
+	expected := Sign(appID, body, timestamp, secret)
+	return subtle.ConstantTimeCompare([]byte(expected), []byte(signature)) == 1
+}
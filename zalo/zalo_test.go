@@ -0,0 +1,83 @@
+package zalo
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerifySignature(t *testing.T) {
+	body := []byte(`{"app_id":"123","event_name":"user_send_text","timestamp":"1573547400000"}`)
+	sig := Sign("123", body, "1573547400000", "secret")
+
+	assert.True(t, VerifySignature("123", body, "1573547400000", "secret", sig))
+	assert.False(t, VerifySignature("123", body, "1573547400000", "other", sig))
+	assert.False(t, VerifySignature("123", append(body, ' '), "1573547400000", "secret", sig))
+	assert.False(t, VerifySignature("123", body, "1573547400000", "secret", ""))
+}
+
+func TestEventTime(t *testing.T) {
+	sent, err := Event{Timestamp: "1573547400123"}.Time()
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2019, 11, 12, 8, 30, 0, 123e6, time.UTC), sent.UTC())
+	_, err = Event{}.Time()
+	assert.Error(t, err)
+}
+
+func TestMessageAttachments(t *testing.T) {
+	var event Event
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"event_name": "user_send_location",
+		"sender": {"id": "u1"},
+		"message": {"msg_id": "m1", "attachments": [
+			{"type": "image", "payload": {"url": "https://img/1.jpg", "thumbnail": "https://img/1s.jpg"}},
+			{"type": "location", "payload": {"coordinates": {"latitude": "16.074345", "longitude": "108.2238513"}}}
+		]}
+	}`), &event))
+
+	lat, long, err := event.Message.Location()
+	require.NoError(t, err)
+	assert.Equal(t, 16.074345, lat)
+	assert.Equal(t, 108.2238513, long)
+	assert.Equal(t, []string{"https://img/1.jpg"}, event.Message.Images())
+
+	_, _, err = Message{Text: "hi"}.Location()
+	assert.Error(t, err)
+}
+
+func TestClientSendText(t *testing.T) {
+	var got map[string]interface{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v2.0/oa/message", r.URL.Path)
+		assert.Equal(t, "token", r.URL.Query().Get("access_token"))
+		b, _ := ioutil.ReadAll(r.Body)
+		_ = json.Unmarshal(b, &got)
+		_, _ = w.Write([]byte(`{"error":0,"message":"Success"}`))
+	}))
+	defer srv.Close()
+
+	c := NewClient(srv.URL, "token")
+	require.NoError(t, c.SendText(context.Background(), "u1", "hello"))
+	assert.Equal(t, map[string]interface{}{
+		"recipient": map[string]interface{}{"user_id": "u1"},
+		"message":   map[string]interface{}{"text": "hello"},
+	}, got)
+}
+
+func TestClientAPIError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"error":-216,"message":"Access token is invalid"}`))
+	}))
+	defer srv.Close()
+
+	err := NewClient(srv.URL, "bad").SendText(context.Background(), "u1", "hello")
+	require.Error(t, err)
+	assert.Equal(t, -216, err.(*APIError).Code)
+}